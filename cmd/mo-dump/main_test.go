@@ -15,11 +15,18 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"fmt"
-	"github.com/stretchr/testify/require"
+	"go/constant"
 	"os"
 	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConvertValue(t *testing.T) {
@@ -107,3 +114,48 @@ func TestShowCreateTable(t *testing.T) {
 	}
 	os.Stdout = old
 }
+
+// TestDumpEnumAndSet dumps a table with ENUM and SET columns whose members and values
+// have quotes, and restores it from the dump.
+func TestDumpEnumAndSet(t *testing.T) {
+	enumValues := []string{"it's", `back\slash`, "b"}
+	setValues := []string{"x'y", "z"}
+	row := []string{"it's", "x'y,z"}
+
+	// the create table is shown like SHOW CREATE TABLE, the rows like showInsert
+	createSql := fmt.Sprintf("CREATE TABLE `t_enum` (\n`a` %s(%s) DEFAULT NULL,\n`b` %s(%s) DEFAULT NULL\n)",
+		types.T_enum.ToType().String(), types.EncodeEnumValues(enumValues),
+		types.T_set.ToType().String(), types.EncodeEnumValues(setValues))
+	old := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	showCreateTable(createSql, false)
+	fmt.Printf("INSERT INTO `t_enum` VALUES (%s,%s);\n",
+		convertValue(makeValue(row[0]), "ENUM"), convertValue(makeValue(row[1]), "SET"))
+	require.NoError(t, w.Close())
+	os.Stdout = old
+	var buf bytes.Buffer
+	_, err := buf.ReadFrom(r)
+	require.NoError(t, err)
+
+	// restore
+	ctx := context.TODO()
+	stmts, err := mysql.Parse(ctx, buf.String(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stmts))
+	p, err := plan.BuildPlan(plan.NewMockCompilerContext(false), stmts[0])
+	require.NoError(t, err)
+	cols := p.GetDdl().GetCreateTable().GetTableDef().Cols
+	for i, want := range [][]string{enumValues, setValues} {
+		got, err := types.DecodeEnumValues(cols[i].Typ.Enumvalues)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	insert, ok := stmts[1].(*tree.Insert)
+	require.True(t, ok)
+	values := insert.Rows.Select.(*tree.ValuesClause).Rows[0]
+	require.Equal(t, len(row), len(values))
+	for i, want := range row {
+		require.Equal(t, want, constant.StringVal(values[i].(*tree.NumVal).Value))
+	}
+}
@@ -18,8 +18,11 @@ import (
 	"encoding/binary"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -130,6 +133,19 @@ func ParseEntryList(es []*api.Entry) (any, []*api.Entry, error) {
 			}
 		}
 		return cmds, es[idx+1:], nil
+	case MO_COLUMNS_ID:
+		if e.EntryType != api.Entry_Update {
+			return e, es[1:], nil
+		}
+		bat, err := batch.ProtoBatchToBatch(e.Bat)
+		if err != nil {
+			return nil, nil, err
+		}
+		cmd, err := genAlterColumns(GenRows(bat))
+		if err != nil {
+			return nil, nil, err
+		}
+		return []AlterColumns{cmd}, es[1:], nil
 	default:
 		return e, es[1:], nil
 	}
@@ -204,6 +220,28 @@ func genUpdateConstraint(rows [][]any) []UpdateConstraint {
 	return cmds
 }
 
+// genAlterColumns generates the command from the rows of all the columns of
+// a table
+func genAlterColumns(rows [][]any) (cmd AlterColumns, err error) {
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][MO_COLUMNS_ATTNUM_IDX].(int32) < rows[j][MO_COLUMNS_ATTNUM_IDX].(int32)
+	})
+	for i, row := range rows {
+		if i == 0 {
+			cmd.DatabaseId = row[MO_COLUMNS_ATT_DATABASE_ID_IDX].(uint64)
+			cmd.DatabaseName = string(row[MO_COLUMNS_ATT_DATABASE_IDX].([]byte))
+			cmd.TableId = row[MO_COLUMNS_ATT_RELNAME_ID_IDX].(uint64)
+			cmd.TableName = string(row[MO_COLUMNS_ATT_RELNAME_IDX].([]byte))
+		}
+		var def engine.TableDef
+		if def, err = genTableDefs(row); err != nil {
+			return
+		}
+		cmd.Defs = append(cmd.Defs, def)
+	}
+	return
+}
+
 func genDropOrTruncateTables(rows [][]any) []DropOrTruncateTable {
 	cmds := make([]DropOrTruncateTable, len(rows))
 	for i, row := range rows {
@@ -256,7 +294,8 @@ func genTableDefs(row []any) (engine.TableDef, error) {
 	var attr engine.Attribute
 
 	attr.Name = string(row[MO_COLUMNS_ATTNAME_IDX].([]byte))
-	attr.Alg = compress.Lz4
+	attr.Alg = compress.T(row[MO_COLUMNS_ATT_COMPRESS_ALG_IDX].(int8))
+	attr.CompressLevel = int32(row[MO_COLUMNS_ATT_COMPRESS_LEVEL_IDX].(int8))
 	if err := types.Decode(row[MO_COLUMNS_ATTTYP_IDX].([]byte), &attr.Type); err != nil {
 		return nil, err
 	}
@@ -277,6 +316,13 @@ func genTableDefs(row []any) (engine.TableDef, error) {
 	attr.AutoIncrement = row[MO_COLUMNS_ATT_IS_AUTO_INCREMENT_IDX].(int8) == 1
 	attr.Primary = string(row[MO_COLUMNS_ATT_CONSTRAINT_TYPE_IDX].([]byte)) == "p"
 	attr.ClusterBy = row[MO_COLUMNS_ATT_IS_CLUSTERBY].(int8) == 1
+	attr.EnumValues = string(row[MO_COLUMNS_ATT_ENUM_IDX].([]byte))
+	if data := row[MO_COLUMNS_ATT_GENERATED_IDX].([]byte); len(data) > 0 {
+		attr.Generated = new(plan.GeneratedCol)
+		if err := types.Decode(data, attr.Generated); err != nil {
+			return nil, err
+		}
+	}
 	return &engine.AttributeDef{Attr: attr}, nil
 }
 
@@ -424,3 +470,97 @@ func BuildQueryResultPath(accountName, statementId string, blockIdx int) string
 func BuildQueryResultMetaPath(accountName, statementId string) string {
 	return fmt.Sprintf(QueryResultMetaPath, accountName, statementId)
 }
+
+// GetConstDefault returns the value of the default of a column of the type
+// typ if the default is a constant of the type. ok is false if the default
+// has to be evaluated, e.g. it is a function like now().
+func GetConstDefault(def *plan.Default, typ types.Type) (val any, isNull bool, ok bool) {
+	if def == nil || def.Expr == nil {
+		return nil, true, true
+	}
+	c := def.Expr.GetC()
+	if c == nil {
+		return nil, false, false
+	}
+	if c.Isnull {
+		return nil, true, true
+	}
+	switch v := c.Value.(type) {
+	case *plan.Const_Bval:
+		ok = typ.Oid == types.T_bool
+		val = v.Bval
+	case *plan.Const_I8Val:
+		ok = typ.Oid == types.T_int8
+		val = int8(v.I8Val)
+	case *plan.Const_I16Val:
+		ok = typ.Oid == types.T_int16
+		val = int16(v.I16Val)
+	case *plan.Const_I32Val:
+		ok = typ.Oid == types.T_int32
+		val = v.I32Val
+	case *plan.Const_I64Val:
+		ok = typ.Oid == types.T_int64
+		val = v.I64Val
+	case *plan.Const_U8Val:
+		ok = typ.Oid == types.T_uint8
+		val = uint8(v.U8Val)
+	case *plan.Const_U16Val:
+		ok = typ.Oid == types.T_uint16
+		val = uint16(v.U16Val)
+	case *plan.Const_U32Val:
+		ok = typ.Oid == types.T_uint32
+		val = v.U32Val
+	case *plan.Const_U64Val:
+		ok = typ.Oid == types.T_uint64
+		val = v.U64Val
+	case *plan.Const_Fval:
+		ok = typ.Oid == types.T_float32
+		val = v.Fval
+	case *plan.Const_Dval:
+		ok = typ.Oid == types.T_float64
+		val = v.Dval
+	case *plan.Const_Dateval:
+		ok = typ.Oid == types.T_date
+		val = types.Date(v.Dateval)
+	case *plan.Const_Timeval:
+		ok = typ.Oid == types.T_time
+		val = types.Time(v.Timeval)
+	case *plan.Const_Datetimeval:
+		ok = typ.Oid == types.T_datetime
+		val = types.Datetime(v.Datetimeval)
+	case *plan.Const_Timestampval:
+		ok = typ.Oid == types.T_timestamp
+		val = types.Timestamp(v.Timestampval)
+	case *plan.Const_Decimal64Val:
+		ok = typ.Oid == types.T_decimal64
+		val = types.Decimal64(v.Decimal64Val.A)
+	case *plan.Const_Decimal128Val:
+		ok = typ.Oid == types.T_decimal128
+		val = types.Decimal128{B0_63: uint64(v.Decimal128Val.A), B64_127: uint64(v.Decimal128Val.B)}
+	case *plan.Const_Sval:
+		switch typ.Oid {
+		case types.T_char, types.T_varchar, types.T_text,
+			types.T_binary, types.T_varbinary, types.T_blob:
+			ok = true
+		}
+		val = []byte(v.Sval)
+	}
+	return
+}
+
+// MakeDefaultVector returns a vector of the rows filled with the constant
+// default of a column of the type typ
+func MakeDefaultVector(def *plan.Default, typ types.Type, rows int, mp *mpool.MPool) (*vector.Vector, error) {
+	val, isNull, ok := GetConstDefault(def, typ)
+	if !ok {
+		return nil, moerr.NewNotSupportedNoCtx("non-constant default of the column")
+	}
+	vec := vector.NewVec(typ)
+	for i := 0; i < rows; i++ {
+		if err := vector.AppendAny(vec, val, isNull, mp); err != nil {
+			vec.Free(mp)
+			return nil, err
+		}
+	}
+	return vec, nil
+}
@@ -119,6 +119,10 @@ const (
 	SystemColAttr_HasUpdate       = "attr_has_update"
 	SystemColAttr_Update          = "attr_update"
 	SystemColAttr_IsClusterBy     = "attr_is_clusterby"
+	SystemColAttr_EnumValues      = "attr_enum"
+	SystemColAttr_Generated       = "attr_generated"
+	SystemColAttr_CompressAlg     = "attr_compress_alg"
+	SystemColAttr_CompressLevel   = "attr_compress_level"
 
 	BlockMeta_ID              = "block_id"
 	BlockMeta_EntryState      = "entry_state"
@@ -212,6 +216,10 @@ const (
 	MO_COLUMNS_ATT_HAS_UPDATE_IDX        = 19
 	MO_COLUMNS_ATT_UPDATE_IDX            = 20
 	MO_COLUMNS_ATT_IS_CLUSTERBY          = 21
+	MO_COLUMNS_ATT_ENUM_IDX              = 22
+	MO_COLUMNS_ATT_GENERATED_IDX         = 23
+	MO_COLUMNS_ATT_COMPRESS_ALG_IDX      = 24
+	MO_COLUMNS_ATT_COMPRESS_LEVEL_IDX    = 25
 
 	BLOCKMETA_ID_IDX         = 0
 	BLOCKMETA_ENTRYSTATE_IDX = 1
@@ -275,6 +283,16 @@ type UpdateConstraint struct {
 	Constraint   []byte
 }
 
+// AlterColumns replaces the columns of a table, the Defs are the
+// AttributeDefs of the columns in the order of attnum
+type AlterColumns struct {
+	DatabaseId   uint64
+	TableId      uint64
+	TableName    string
+	DatabaseName string
+	Defs         []engine.TableDef
+}
+
 type DropOrTruncateTable struct {
 	IsDrop       bool // true for Drop and false for Truncate
 	Id           uint64
@@ -336,6 +354,10 @@ var (
 		SystemColAttr_HasUpdate,
 		SystemColAttr_Update,
 		SystemColAttr_IsClusterBy,
+		SystemColAttr_EnumValues,
+		SystemColAttr_Generated,
+		SystemColAttr_CompressAlg,
+		SystemColAttr_CompressLevel,
 	}
 	MoTableMetaSchema = []string{
 		BlockMeta_ID,
@@ -397,6 +419,10 @@ var (
 		types.New(types.T_int8, 0, 0),       // att_has_update
 		types.New(types.T_varchar, 2048, 0), // att_update
 		types.New(types.T_int8, 0, 0),       // att_is_clusterby
+		types.New(types.T_varchar, 2048, 0), // att_enum
+		types.New(types.T_varchar, 2048, 0), // att_generated
+		types.New(types.T_int8, 0, 0),       // att_compress_alg
+		types.New(types.T_int8, 0, 0),       // att_compress_level
 	}
 	MoTableMetaTypes = []types.Type{
 		types.New(types.T_uint64, 0, 0),                    // block_id
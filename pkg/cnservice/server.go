@@ -439,6 +439,7 @@ func (s *service) getTxnClient() (c client.TxnClient, err error) {
 			txn.GetTxnMode(s.cfg.Txn.Mode),
 			txn.GetTxnIsolation(s.cfg.Txn.Isolation),
 		)
+		rt.SetGlobalVariables(runtime.SnapshotRetention, s.cfg.Txn.SnapshotRetention.Duration)
 		var sender rpc.TxnSender
 		sender, err = s.getTxnSender()
 		if err != nil {
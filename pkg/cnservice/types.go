@@ -161,6 +161,9 @@ type Config struct {
 		Isolation string `toml:"isolation"`
 		// Mode txn mode. optimistic or pessimistic, default is optimistic
 		Mode string `toml:"mode"`
+		// SnapshotRetention the AS OF queries older than it are rejected, it should not be
+		// greater than the snapshot retention of the DN. Default is 1h.
+		SnapshotRetention toml.Duration `toml:"snapshot-retention"`
 	} `toml:"txn"`
 }
 
@@ -231,6 +234,9 @@ func (c *Config) Validate() error {
 	if !txn.ValidTxnMode(c.Txn.Mode) {
 		return moerr.NewBadDBNoCtx("not support txn mode: " + c.Txn.Mode)
 	}
+	if c.Txn.SnapshotRetention.Duration == 0 {
+		c.Txn.SnapshotRetention.Duration = options.DefaultGCTTL
+	}
 	c.LockService.ServiceID = c.UUID
 	c.LockService.Validate()
 	return nil
@@ -77,6 +77,29 @@ const (
 	ErrDuplicateEntry       uint16 = 20307
 	ErrWrongValueCountOnRow uint16 = 20308
 	ErrBadFieldError        uint16 = 20309
+	// recursive common table expressions
+	ErrCTERecursiveRequiresUnion             uint16 = 20310
+	ErrCTERecursiveRequiresNonRecursiveFirst uint16 = 20311
+	ErrCTERecursiveForbidsAggregation        uint16 = 20312
+	ErrCTERecursiveRequiresSingleReference   uint16 = 20313
+	ErrCTEMaxRecursionDepth                  uint16 = 20314
+	// stored procedures
+	ErrProcedureAlreadyExists   uint16 = 20315
+	ErrNoProcedure              uint16 = 20316
+	ErrSPWrongNumberOfArgs      uint16 = 20317
+	ErrSPNotVarArg              uint16 = 20318
+	ErrSPUndeclaredVar          uint16 = 20319
+	ErrSPFetchNoData            uint16 = 20320
+	ErrSPUndeclaredCursor       uint16 = 20321
+	ErrSPCursorAlreadyOpen      uint16 = 20322
+	ErrSPCursorNotOpen          uint16 = 20323
+	ErrSPWrongNumberOfFetchArgs uint16 = 20324
+	ErrSPLabelMismatch          uint16 = 20325
+	ErrSPRecursionLimit         uint16 = 20326
+	ErrSPTooManyRows            uint16 = 20327
+	ErrSPNoRecursiveCreate      uint16 = 20328
+	// prepared statements
+	ErrStmtHasNoOpenCursor uint16 = 20329
 
 	// Group 4: unexpected state and io errors
 	ErrInvalidState                 uint16 = 20400
@@ -174,6 +197,10 @@ const (
 	ErrAppendableBlockNotFound   uint16 = 20625
 	ErrTAEDebug                  uint16 = 20626
 	ErrDuplicateKey              uint16 = 20626
+	// ErrSavepointNotExist rollback to or release a savepoint that does not exist
+	ErrSavepointNotExist uint16 = 20627
+	// ErrSnapshotTooOld read at a snapshot older than the retention window, whose data may be garbage collected
+	ErrSnapshotTooOld uint16 = 20628
 
 	// Group 7: lock service
 	// ErrDeadLockDetected lockservice has detected a deadlock and should abort the transaction if it receives this error
@@ -232,6 +259,27 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrWrongValueCountOnRow: {ER_WRONG_VALUE_COUNT_ON_ROW, []string{MySQLDefaultSqlState}, "Column count doesn't match value count at row %d"},
 	ErrBadFieldError:        {ER_BAD_FIELD_ERROR, []string{MySQLDefaultSqlState}, "Unknown column '%s' in '%s'"},
 
+	ErrCTERecursiveRequiresUnion:             {ER_CTE_RECURSIVE_REQUIRES_UNION, []string{MySQLDefaultSqlState}, "Recursive Common Table Expression '%s' should contain a UNION"},
+	ErrCTERecursiveRequiresNonRecursiveFirst: {ER_CTE_RECURSIVE_REQUIRES_NONRECURSIVE_FIRST, []string{MySQLDefaultSqlState}, "Recursive Common Table Expression '%s' should have one or more non-recursive query blocks followed by one or more recursive ones"},
+	ErrCTERecursiveForbidsAggregation:        {ER_CTE_RECURSIVE_FORBIDS_AGGREGATION, []string{MySQLDefaultSqlState}, "Recursive Common Table Expression '%s' can contain neither aggregation nor window functions in recursive query block"},
+	ErrCTERecursiveRequiresSingleReference:   {ER_CTE_RECURSIVE_REQUIRES_SINGLE_REFERENCE, []string{MySQLDefaultSqlState}, "In recursive query block of Recursive Common Table Expression '%s', the recursive table must be referenced only once, and not in any subquery"},
+	ErrCTEMaxRecursionDepth:                  {ER_CTE_MAX_RECURSION_DEPTH, []string{MySQLDefaultSqlState}, "Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value."},
+	ErrProcedureAlreadyExists:                {ER_SP_ALREADY_EXISTS, []string{"42000"}, "PROCEDURE %s already exists"},
+	ErrNoProcedure:                           {ER_SP_DOES_NOT_EXIST, []string{"42000"}, "PROCEDURE %s does not exist"},
+	ErrSPWrongNumberOfArgs:                   {ER_SP_WRONG_NO_OF_ARGS, []string{"42000"}, "Incorrect number of arguments for PROCEDURE %s; expected %d, got %d"},
+	ErrSPNotVarArg:                           {ER_SP_NOT_VAR_ARG, []string{"42000"}, "OUT or INOUT argument %d for routine %s is not a variable"},
+	ErrSPUndeclaredVar:                       {ER_SP_UNDECLARED_VAR, []string{"42000"}, "Undeclared variable: %s"},
+	ErrSPFetchNoData:                         {ER_SP_FETCH_NO_DATA, []string{"02000"}, "No data - zero rows fetched, selected, or processed"},
+	ErrSPUndeclaredCursor:                    {ER_SP_CURSOR_MISMATCH, []string{"42000"}, "Undefined CURSOR: %s"},
+	ErrSPCursorAlreadyOpen:                   {ER_SP_CURSOR_ALREADY_OPEN, []string{"24000"}, "Cursor is already open"},
+	ErrSPCursorNotOpen:                       {ER_SP_CURSOR_NOT_OPEN, []string{"24000"}, "Cursor is not open"},
+	ErrSPWrongNumberOfFetchArgs:              {ER_SP_WRONG_NO_OF_FETCH_ARGS, []string{"HY000"}, "Incorrect number of FETCH variables"},
+	ErrSPLabelMismatch:                       {ER_SP_LILABEL_MISMATCH, []string{"42000"}, "%s with no matching label: %s"},
+	ErrSPRecursionLimit:                      {ER_SP_RECURSION_LIMIT, []string{"HY000"}, "Recursive limit %d (as set by the max_sp_recursion_depth variable) was exceeded for routine %s"},
+	ErrSPTooManyRows:                         {ER_TOO_MANY_ROWS, []string{"42000"}, "Result consisted of more than one row"},
+	ErrSPNoRecursiveCreate:                   {ER_SP_NO_RECURSIVE_CREATE, []string{"2F003"}, "Can't create a PROCEDURE from within another stored routine"},
+	ErrStmtHasNoOpenCursor:                   {ER_STMT_HAS_NO_OPEN_CURSOR, []string{"HY000"}, "The statement (%d) has no open cursor."},
+
 	// Group 4: unexpected state or file io error
 	ErrInvalidState:                 {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "invalid state %s"},
 	ErrLogServiceNotReady:           {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "log service not ready"},
@@ -313,6 +361,8 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrAppendableSegmentNotFound: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "appendable segment not found"},
 	ErrAppendableBlockNotFound:   {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "appendable block not found"},
 	ErrDuplicateKey:              {ER_DUP_KEYNAME, []string{MySQLDefaultSqlState}, "duplicate key name '%s'"},
+	ErrSavepointNotExist:         {ER_SP_DOES_NOT_EXIST, []string{"42000"}, "SAVEPOINT %s does not exist"},
+	ErrSnapshotTooOld:            {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "snapshot %s is too old, the data older than %s has been garbage collected"},
 
 	// Group 7: lock service
 	ErrDeadLockDetected:     {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "deadlock detected"},
@@ -883,6 +933,86 @@ func NewBadFieldError(ctx context.Context, column, table string) *Error {
 	return newError(ctx, ErrBadFieldError, column, table)
 }
 
+func NewCTERecursiveRequiresUnion(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCTERecursiveRequiresUnion, name)
+}
+
+func NewCTERecursiveRequiresNonRecursiveFirst(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCTERecursiveRequiresNonRecursiveFirst, name)
+}
+
+func NewCTERecursiveForbidsAggregation(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCTERecursiveForbidsAggregation, name)
+}
+
+func NewCTERecursiveRequiresSingleReference(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCTERecursiveRequiresSingleReference, name)
+}
+
+func NewCTEMaxRecursionDepth(ctx context.Context, depth int64) *Error {
+	return newError(ctx, ErrCTEMaxRecursionDepth, depth)
+}
+
+func NewProcedureAlreadyExists(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrProcedureAlreadyExists, name)
+}
+
+func NewNoProcedure(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrNoProcedure, name)
+}
+
+func NewSPWrongNumberOfArgs(ctx context.Context, name string, expected, got int) *Error {
+	return newError(ctx, ErrSPWrongNumberOfArgs, name, expected, got)
+}
+
+func NewSPNotVarArg(ctx context.Context, idx int, name string) *Error {
+	return newError(ctx, ErrSPNotVarArg, idx, name)
+}
+
+func NewSPUndeclaredVar(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrSPUndeclaredVar, name)
+}
+
+func NewSPFetchNoData(ctx context.Context) *Error {
+	return newError(ctx, ErrSPFetchNoData)
+}
+
+func NewSPUndeclaredCursor(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrSPUndeclaredCursor, name)
+}
+
+func NewSPCursorAlreadyOpen(ctx context.Context) *Error {
+	return newError(ctx, ErrSPCursorAlreadyOpen)
+}
+
+func NewSPCursorNotOpen(ctx context.Context) *Error {
+	return newError(ctx, ErrSPCursorNotOpen)
+}
+
+func NewSPWrongNumberOfFetchArgs(ctx context.Context) *Error {
+	return newError(ctx, ErrSPWrongNumberOfFetchArgs)
+}
+
+func NewSPLabelMismatch(ctx context.Context, stmt, label string) *Error {
+	return newError(ctx, ErrSPLabelMismatch, stmt, label)
+}
+
+func NewSPRecursionLimit(ctx context.Context, limit int64, name string) *Error {
+	return newError(ctx, ErrSPRecursionLimit, limit, name)
+}
+
+func NewSPTooManyRows(ctx context.Context) *Error {
+	return newError(ctx, ErrSPTooManyRows)
+}
+
+func NewSPNoRecursiveCreate(ctx context.Context) *Error {
+	return newError(ctx, ErrSPNoRecursiveCreate)
+}
+
+func NewStmtHasNoOpenCursor(ctx context.Context, stmtID uint32) *Error {
+	return newError(ctx, ErrStmtHasNoOpenCursor, stmtID)
+}
+
 func NewRoleGrantedToSelf(ctx context.Context, from, to string) *Error {
 	return newError(ctx, ErrRoleGrantedToSelf, from, to)
 }
@@ -904,6 +1034,14 @@ func NewDuplicateKey(ctx context.Context, k string) *Error {
 	return newError(ctx, ErrDuplicateKey, k)
 }
 
+func NewSavepointNotExist(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrSavepointNotExist, name)
+}
+
+func NewSnapshotTooOld(ctx context.Context, snapshot, retention string) *Error {
+	return newError(ctx, ErrSnapshotTooOld, snapshot, retention)
+}
+
 func NewAppendableSegmentNotFound(ctx context.Context) *Error {
 	return newError(ctx, ErrAppendableSegmentNotFound)
 }
@@ -32,6 +32,8 @@ const (
 	TxnMode = "txn-mode"
 	// TxnIsolation runtime default txn isolation
 	TxnIsolation = "txn-isolation"
+	// SnapshotRetention how long the data of the past snapshots is kept for AS OF queries
+	SnapshotRetention = "snapshot-retention"
 )
 
 // Runtime contains the runtime environment for a MO service. Each CN/DN/LOG service
@@ -55,7 +55,7 @@ func New(typ types.Type, desc, nullsLast bool) Compare {
 			return newCompare(genericDescCompare[uint8], genericCopy[uint8], nullsLast)
 		}
 		return newCompare(genericAscCompare[uint8], genericCopy[uint8], nullsLast)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if desc {
 			return newCompare(genericDescCompare[uint16], genericCopy[uint16], nullsLast)
 		}
@@ -65,7 +65,7 @@ func New(typ types.Type, desc, nullsLast bool) Compare {
 			return newCompare(genericDescCompare[uint32], genericCopy[uint32], nullsLast)
 		}
 		return newCompare(genericAscCompare[uint32], genericCopy[uint32], nullsLast)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		if desc {
 			return newCompare(genericDescCompare[uint64], genericCopy[uint64], nullsLast)
 		}
@@ -15,15 +15,42 @@
 package compress
 
 import (
+	"github.com/DataDog/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
+const (
+	// DefaultZstdLevel is the level of zstd used when no level is given
+	DefaultZstdLevel = zstd.DefaultCompression
+	// MinZstdLevel and MaxZstdLevel are the range of the levels of zstd
+	MinZstdLevel = zstd.BestSpeed
+	MaxZstdLevel = zstd.BestCompression
+)
+
 var Algorithms map[string]int = map[string]int{
 	"lz4":  Lz4,
+	"zstd": Zstd,
 	"none": None,
 }
 
+// CompressBound returns the max size of the compressed data of n bytes
+func CompressBound(n int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(n)
+	case Zstd:
+		return zstd.CompressBound(n)
+	}
+	return n
+}
+
 func Compress(src, dst []byte, typ int) ([]byte, error) {
+	return CompressLevel(src, dst, typ, DefaultZstdLevel)
+}
+
+// CompressLevel compresses the src into the dst at the level,
+// the level only works for zstd, from zstd.BestSpeed to zstd.BestCompression.
+func CompressLevel(src, dst []byte, typ int, level int) ([]byte, error) {
 	switch typ {
 	case Lz4:
 		n, err := lz4.CompressBlock(src, dst, nil)
@@ -31,6 +58,10 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstd.CompressLevel(dst[:cap(dst)], src, level)
+	case None:
+		return append(dst[:0], src...), nil
 	}
 	return nil, nil
 }
@@ -43,6 +74,10 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstd.Decompress(dst[:cap(dst)], src)
+	case None:
+		return append(dst[:0], src...), nil
 	}
 	return nil, nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLz4(t *testing.T) {
@@ -41,3 +42,20 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestZstd(t *testing.T) {
+	xs := make([]int64, 1024)
+	for i := range xs {
+		xs[i] = int64(i % 7)
+	}
+	raw := types.EncodeSlice(xs)
+	for _, level := range []int{1, DefaultZstdLevel, 19} {
+		buf := make([]byte, CompressBound(len(raw), Zstd))
+		buf, err := CompressLevel(raw, buf, Zstd, level)
+		require.NoError(t, err)
+		require.Less(t, len(buf), len(raw))
+		data, err := Decompress(buf, make([]byte, len(raw)), Zstd)
+		require.NoError(t, err)
+		require.Equal(t, raw, data)
+	}
+}
@@ -177,6 +177,9 @@ type FrontendParameters struct {
 	// UnixSocketAddress listening unix domain socket
 	UnixSocketAddress string `toml:"unix-socket"`
 
+	//pgPort defines which port the mo-server listens on for the postgresql clients. 0 disables it.
+	PgPort int64 `toml:"pg-port"`
+
 	//guest mmu limitation. default: 1 << 40 = 1099511627776
 	GuestMmuLimitation int64 `toml:"guestMmuLimitation"`
 
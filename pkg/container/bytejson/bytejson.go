@@ -401,6 +401,270 @@ func (bj ByteJson) Query(paths []*Path) *ByteJson {
 	return mergeToArray(out)
 }
 
+// find returns the values matched by the path, unlike query the keys and indexes
+// that do not exist match nothing.
+func (bj ByteJson) find(cur []ByteJson, path *Path) []ByteJson {
+	if path.empty() {
+		return append(cur, bj)
+	}
+	sub, nPath := path.step()
+	switch sub.tp {
+	case subPathDoubleStar:
+		cur = bj.find(cur, &nPath)
+		if bj.Type == TpCodeObject || bj.Type == TpCodeArray {
+			cnt := bj.GetElemCnt()
+			for i := 0; i < cnt; i++ {
+				if bj.Type == TpCodeObject {
+					cur = bj.getObjectVal(i).find(cur, path)
+				} else {
+					cur = bj.getArrayElem(i).find(cur, path)
+				}
+			}
+		}
+	case subPathKey:
+		if bj.Type != TpCodeObject {
+			return cur
+		}
+		cnt := bj.GetElemCnt()
+		if sub.key == "*" {
+			for i := 0; i < cnt; i++ {
+				cur = bj.getObjectVal(i).find(cur, &nPath)
+			}
+			return cur
+		}
+		key := string2Slice(sub.key)
+		idx := sort.Search(cnt, func(i int) bool {
+			return bytes.Compare(bj.getObjectKey(i), key) >= 0
+		})
+		if idx < cnt && bytes.Equal(bj.getObjectKey(idx), key) {
+			cur = bj.getObjectVal(idx).find(cur, &nPath)
+		}
+	case subPathIdx:
+		if bj.Type != TpCodeArray {
+			// a value that is not an array is taken as an array of one element
+			if idx, _, _ := sub.idx.genIndex(1); idx == 0 || idx == subPathIdxALL {
+				cur = bj.find(cur, &nPath)
+			}
+			return cur
+		}
+		cnt := bj.GetElemCnt()
+		idx, _, _ := sub.idx.genIndex(cnt)
+		if idx == subPathIdxALL {
+			for i := 0; i < cnt; i++ {
+				cur = bj.getArrayElem(i).find(cur, &nPath)
+			}
+		} else if idx >= 0 && idx < cnt {
+			cur = bj.getArrayElem(idx).find(cur, &nPath)
+		}
+	case subPathRange:
+		cnt := 1
+		if bj.Type == TpCodeArray {
+			cnt = bj.GetElemCnt()
+		}
+		start, _, _ := sub.iRange.start.genIndex(cnt)
+		se := sub.iRange.genRange(cnt)
+		if se[0] == subPathIdxErr || start >= cnt {
+			return cur
+		}
+		for i := se[0]; i <= se[1] && i < cnt; i++ {
+			if i < 0 {
+				continue
+			}
+			if bj.Type == TpCodeArray {
+				cur = bj.getArrayElem(i).find(cur, &nPath)
+			} else {
+				cur = bj.find(cur, &nPath)
+			}
+		}
+	}
+	return cur
+}
+
+// Exists returns true if the path matches any value of the json document
+func (bj ByteJson) Exists(path *Path) bool {
+	return len(bj.find(nil, path)) > 0
+}
+
+// LookupAll returns all the values matched by the path in document order
+func (bj ByteJson) LookupAll(path *Path) []ByteJson {
+	return bj.find(nil, path)
+}
+
+// Lookup returns the first value matched by the path, false if the path matches nothing
+func (bj ByteJson) Lookup(path *Path) (ByteJson, bool) {
+	vals := bj.find(nil, path)
+	if len(vals) == 0 {
+		return Null, false
+	}
+	return vals[0], true
+}
+
+// Length returns the number of the members of the json object or the elements of the
+// json array, the length of a scalar is 1.
+func (bj ByteJson) Length() int {
+	if bj.Type == TpCodeObject || bj.Type == TpCodeArray {
+		return bj.GetElemCnt()
+	}
+	return 1
+}
+
+// Keys returns the keys of the json object as a json array, false if it is not an object
+func (bj ByteJson) Keys() (ByteJson, bool) {
+	if bj.Type != TpCodeObject {
+		return Null, false
+	}
+	cnt := bj.GetElemCnt()
+	keys := make([]ByteJson, cnt)
+	for i := 0; i < cnt; i++ {
+		keys[i] = ByteJson{Type: TpCodeString, Data: addString(nil, string(bj.getObjectKey(i)))}
+	}
+	return CreateByteJsonArray(keys), true
+}
+
+// Contains returns true if the candidate is contained in the json document, it follows
+// the rules of JSON_CONTAINS:
+//   - a scalar is contained in a scalar if they are equal.
+//   - an array is contained in an array if every element of it is contained in some element of the target.
+//   - a value that is not an array is contained in an array if it is contained in some element of the target.
+//   - an object is contained in an object if every key of it is in the target and its value is
+//     contained in the value of the key of the target.
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type {
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		cnt := candidate.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			path := Path{paths: []subPath{{tp: subPathKey, key: string(candidate.getObjectKey(i))}}}
+			val, ok := bj.Lookup(&path)
+			if !ok || !val.Contains(candidate.getObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	case TpCodeArray:
+		if candidate.Type != TpCodeArray {
+			return bj.elemContains(candidate)
+		}
+		cnt := candidate.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			if !bj.elemContains(candidate.getArrayElem(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	if candidate.Type == TpCodeObject || candidate.Type == TpCodeArray {
+		return false
+	}
+	return bj.scalarEqual(candidate)
+}
+
+// elemContains returns true if the candidate is contained in some element of the array
+func (bj ByteJson) elemContains(candidate ByteJson) bool {
+	cnt := bj.GetElemCnt()
+	for i := 0; i < cnt; i++ {
+		if bj.getArrayElem(i).Contains(candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal returns true if the two json values are equal, the numbers are compared by value
+func (bj ByteJson) Equal(other ByteJson) bool {
+	switch {
+	case bj.Type == TpCodeArray && other.Type == TpCodeArray:
+		cnt := bj.GetElemCnt()
+		if cnt != other.GetElemCnt() {
+			return false
+		}
+		for i := 0; i < cnt; i++ {
+			if !bj.getArrayElem(i).Equal(other.getArrayElem(i)) {
+				return false
+			}
+		}
+		return true
+	case bj.Type == TpCodeObject && other.Type == TpCodeObject:
+		cnt := bj.GetElemCnt()
+		if cnt != other.GetElemCnt() {
+			return false
+		}
+		for i := 0; i < cnt; i++ {
+			path := Path{paths: []subPath{{tp: subPathKey, key: string(bj.getObjectKey(i))}}}
+			val, ok := other.Lookup(&path)
+			if !ok || !bj.getObjectVal(i).Equal(val) {
+				return false
+			}
+		}
+		return true
+	case bj.canUnnest() || other.canUnnest():
+		return false
+	}
+	return bj.scalarEqual(other)
+}
+
+// IsMember returns true if the value is an element of the array, a json value which
+// is not an array is taken as the array of itself. It is value MEMBER OF(json_array).
+func (bj ByteJson) IsMember(value ByteJson) bool {
+	if bj.Type != TpCodeArray {
+		return bj.Equal(value)
+	}
+	cnt := bj.GetElemCnt()
+	for i := 0; i < cnt; i++ {
+		if bj.getArrayElem(i).Equal(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ArrayElems returns the elements of the array, a json value which is not an array
+// is taken as the array of itself like IsMember.
+func (bj ByteJson) ArrayElems() []ByteJson {
+	if bj.Type != TpCodeArray {
+		return []ByteJson{bj}
+	}
+	elems := make([]ByteJson, bj.GetElemCnt())
+	for i := range elems {
+		elems[i] = bj.getArrayElem(i)
+	}
+	return elems
+}
+
+func (bj ByteJson) scalarEqual(other ByteJson) bool {
+	isNumber := func(tp TpCode) bool {
+		return tp == TpCodeInt64 || tp == TpCodeUint64 || tp == TpCodeFloat64
+	}
+	if isNumber(bj.Type) && isNumber(other.Type) {
+		if bj.Type == other.Type {
+			return bj.GetUint64() == other.GetUint64()
+		}
+		return bj.toFloat() == other.toFloat()
+	}
+	if bj.Type != other.Type {
+		return false
+	}
+	switch bj.Type {
+	case TpCodeString:
+		return bytes.Equal(bj.GetString(), other.GetString())
+	case TpCodeLiteral:
+		return bj.Data[0] == other.Data[0]
+	}
+	return false
+}
+
+func (bj ByteJson) toFloat() float64 {
+	switch bj.Type {
+	case TpCodeInt64:
+		return float64(bj.GetInt64())
+	case TpCodeUint64:
+		return float64(bj.GetUint64())
+	}
+	return bj.GetFloat64()
+}
+
 func (bj ByteJson) canUnnest() bool {
 	return bj.Type == TpCodeArray || bj.Type == TpCodeObject
 }
@@ -514,3 +514,192 @@ func TestByteJson_Unquote(t *testing.T) {
 		require.Equal(t, kase.outStr, out)
 	}
 }
+
+func TestModify(t *testing.T) {
+	kases := []struct {
+		json       string
+		paths      []string
+		vals       []string
+		modifyType JsonModifyType
+		outStr     string
+	}{
+		{`{"a": 1, "b": [2, 3]}`, []string{"$.a", "$.c"}, []string{"10", "[true, false]"}, JsonModifySet, `{"a": 10, "b": [2, 3], "c": [true, false]}`},
+		{`{"a": 1, "b": [2, 3]}`, []string{"$.a", "$.c"}, []string{"10", "[true, false]"}, JsonModifyInsert, `{"a": 1, "b": [2, 3], "c": [true, false]}`},
+		{`{"a": 1, "b": [2, 3]}`, []string{"$.a", "$.c"}, []string{"10", "[true, false]"}, JsonModifyReplace, `{"a": 10, "b": [2, 3]}`},
+		{`{"a": 1, "b": [2, 3]}`, []string{"$.b[1]", "$.b[5]"}, []string{`"x"`, `"y"`}, JsonModifySet, `{"a": 1, "b": [2, "x", "y"]}`},
+		{`{"a": 1, "b": [2, 3]}`, []string{"$.b[last]"}, []string{"null"}, JsonModifyReplace, `{"a": 1, "b": [2, null]}`},
+		{`{"a": 1}`, []string{"$.a[1]"}, []string{"2"}, JsonModifyInsert, `{"a": [1, 2]}`},
+		{`{"a": 1}`, []string{"$.a[0]"}, []string{"2"}, JsonModifySet, `{"a": 2}`},
+		{`{"a": 1}`, []string{"$.x.y"}, []string{"2"}, JsonModifySet, `{"a": 1}`},
+		{`{"a": 1}`, []string{"$.b", "$.b.c"}, []string{`{}`, "2"}, JsonModifySet, `{"a": 1, "b": {"c": 2}}`},
+		{`[1, 2]`, []string{"$"}, []string{`{"a": 1}`}, JsonModifySet, `{"a": 1}`},
+		{`[1, 2]`, []string{"$"}, []string{`{"a": 1}`}, JsonModifyInsert, `[1, 2]`},
+	}
+	for _, kase := range kases {
+		bj, err := ParseFromString(kase.json)
+		require.Nil(t, err)
+		paths := make([]*Path, len(kase.paths))
+		vals := make([]ByteJson, len(kase.vals))
+		for i := range kase.paths {
+			p, err := ParseJsonPath(kase.paths[i])
+			require.Nil(t, err)
+			paths[i] = &p
+			vals[i], err = ParseFromString(kase.vals[i])
+			require.Nil(t, err)
+		}
+		out, err := bj.Modify(paths, vals, kase.modifyType)
+		require.Nil(t, err)
+		require.Equal(t, kase.outStr, out.String())
+	}
+
+	bj, err := ParseFromString(`{"a": 1}`)
+	require.Nil(t, err)
+	p, err := ParseJsonPath("$.*")
+	require.Nil(t, err)
+	_, err = bj.Modify([]*Path{&p}, []ByteJson{Null}, JsonModifySet)
+	require.NotNil(t, err)
+}
+
+func TestRemove(t *testing.T) {
+	kases := []struct {
+		json   string
+		paths  []string
+		outStr string
+	}{
+		{`{"a": 1, "b": [2, 3]}`, []string{"$.a"}, `{"b": [2, 3]}`},
+		{`{"a": 1, "b": [2, 3]}`, []string{"$.b[0]", "$.b[0]"}, `{"a": 1, "b": []}`},
+		{`{"a": 1, "b": [2, 3]}`, []string{"$.c", "$.b[2]"}, `{"a": 1, "b": [2, 3]}`},
+		{`[{"a": 1, "b": 2}]`, []string{"$[0].b"}, `[{"a": 1}]`},
+	}
+	for _, kase := range kases {
+		bj, err := ParseFromString(kase.json)
+		require.Nil(t, err)
+		paths := make([]*Path, len(kase.paths))
+		for i := range kase.paths {
+			p, err := ParseJsonPath(kase.paths[i])
+			require.Nil(t, err)
+			paths[i] = &p
+		}
+		out, err := bj.Remove(paths)
+		require.Nil(t, err)
+		require.Equal(t, kase.outStr, out.String())
+	}
+
+	bj, err := ParseFromString(`{"a": 1}`)
+	require.Nil(t, err)
+	p, err := ParseJsonPath("$")
+	require.Nil(t, err)
+	_, err = bj.Remove([]*Path{&p})
+	require.NotNil(t, err)
+}
+
+func TestContains(t *testing.T) {
+	kases := []struct {
+		target    string
+		candidate string
+		contains  bool
+	}{
+		{`{"a": 1, "b": 2, "c": {"d": 4}}`, `1`, false},
+		{`{"a": 1, "b": 2, "c": {"d": 4}}`, `{"a": 1}`, true},
+		{`{"a": 1, "b": 2, "c": {"d": 4}}`, `{"a": 1, "c": {"d": 4}}`, true},
+		{`{"a": 1, "b": 2, "c": {"d": 4}}`, `{"a": 2}`, false},
+		{`{"a": 1, "b": 2, "c": {"d": 4}}`, `{"e": null}`, false},
+		{`[1, 2, [3, 4]]`, `2`, true},
+		{`[1, 2, [3, 4]]`, `[1, 3]`, true},
+		{`[1, 2, [3, 4]]`, `[[3]]`, true},
+		{`[1, [2]]`, `[[1]]`, false},
+		{`[1, 2.0, "a"]`, `[2, "a"]`, true},
+		{`"a"`, `"a"`, true},
+		{`null`, `null`, true},
+	}
+	for _, kase := range kases {
+		target, err := ParseFromString(kase.target)
+		require.Nil(t, err)
+		candidate, err := ParseFromString(kase.candidate)
+		require.Nil(t, err)
+		require.Equal(t, kase.contains, target.Contains(candidate), "%s in %s", kase.candidate, kase.target)
+	}
+}
+
+func TestIsMember(t *testing.T) {
+	kases := []struct {
+		array  string
+		value  string
+		member bool
+	}{
+		{`[1, 2, [3, 4]]`, `2`, true},
+		{`[1, 2, [3, 4]]`, `2.0`, true},
+		{`[1, 2, [3, 4]]`, `3`, false},
+		{`[1, 2, [3, 4]]`, `[3, 4]`, true},
+		{`[1, 2, [3, 4]]`, `[4, 3]`, false},
+		{`[{"a": 1, "b": [2]}]`, `{"b": [2], "a": 1}`, true},
+		{`[{"a": 1, "b": [2]}]`, `{"a": 1}`, false},
+		{`["1", 2]`, `1`, false},
+		{`"a"`, `"a"`, true},
+		{`{"a": 1}`, `{"a": 1}`, true},
+	}
+	for _, kase := range kases {
+		array, err := ParseFromString(kase.array)
+		require.Nil(t, err)
+		value, err := ParseFromString(kase.value)
+		require.Nil(t, err)
+		require.Equal(t, kase.member, array.IsMember(value), "%s member of %s", kase.value, kase.array)
+	}
+}
+
+func TestExistsAndLookup(t *testing.T) {
+	bj, err := ParseFromString(`{"a": 1, "b": [2, {"c": null}]}`)
+	require.Nil(t, err)
+	kases := []struct {
+		path   string
+		exists bool
+	}{
+		{"$.a", true},
+		{"$.b[1].c", true},
+		{"$.b[2]", false},
+		{"$.c", false},
+		{"$.a[0]", true},
+		{"$.a[1]", false},
+		{"$**.c", true},
+		{"$.b[*].d", false},
+	}
+	for _, kase := range kases {
+		p, err := ParseJsonPath(kase.path)
+		require.Nil(t, err)
+		require.Equal(t, kase.exists, bj.Exists(&p), kase.path)
+	}
+
+	p, err := ParseJsonPath("$.b")
+	require.Nil(t, err)
+	val, ok := bj.Lookup(&p)
+	require.True(t, ok)
+	require.Equal(t, 2, val.Length())
+	_, ok = val.Keys()
+	require.False(t, ok)
+	keys, ok := bj.Keys()
+	require.True(t, ok)
+	require.Equal(t, `["a", "b"]`, keys.String())
+	require.Equal(t, 2, bj.Length())
+}
+
+func TestCreateAndMerge(t *testing.T) {
+	one, err := ParseFromString(`1`)
+	require.Nil(t, err)
+	str, err := ParseFromString(`"x"`)
+	require.Nil(t, err)
+	arr := CreateByteJsonArray([]ByteJson{one, Null, str})
+	require.Equal(t, `[1, null, "x"]`, arr.String())
+	require.Equal(t, `[]`, CreateByteJsonArray(nil).String())
+	require.Equal(t, `[1, null, "x", 1, null, "x", "x"]`, MergeArrays([]ByteJson{arr, arr, str}).String())
+
+	obj, err := CreateByteJsonObject([]string{"b", "a", "b"}, []ByteJson{one, arr, str})
+	require.Nil(t, err)
+	require.Equal(t, `{"a": [1, null, "x"], "b": "x"}`, obj.String())
+	obj2, err := CreateByteJsonObject([]string{"a"}, []ByteJson{one})
+	require.Nil(t, err)
+	merged, err := MergeObjects([]ByteJson{obj, obj2})
+	require.Nil(t, err)
+	require.Equal(t, `{"a": 1, "b": "x"}`, merged.String())
+	_, err = MergeObjects([]ByteJson{obj, arr})
+	require.NotNil(t, err)
+}
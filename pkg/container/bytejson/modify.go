@@ -0,0 +1,224 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Modify sets the values at the paths of the json document as JSON_SET, JSON_INSERT and
+// JSON_REPLACE do. The paths are evaluated from left to right, the document produced by
+// evaluating one path becomes the new value against which the next path is evaluated.
+func (bj ByteJson) Modify(paths []*Path, vals []ByteJson, modifyType JsonModifyType) (ByteJson, error) {
+	if len(paths) != len(vals) {
+		return Null, moerr.NewInvalidInputNoCtx("json modify with %d paths and %d values", len(paths), len(vals))
+	}
+	var doc interface{} = bj
+	for i, path := range paths {
+		if path.IsWildcard() {
+			return Null, moerr.NewInvalidInputNoCtx("path expressions may not contain the * and ** tokens or an array range in this situation")
+		}
+		doc = modifyTree(doc, path.paths, vals[i], modifyType)
+	}
+	return fromTree(doc)
+}
+
+// Remove removes the values at the paths of the json document as JSON_REMOVE does,
+// a path that does not exist is ignored.
+func (bj ByteJson) Remove(paths []*Path) (ByteJson, error) {
+	var doc interface{} = bj
+	for _, path := range paths {
+		if path.IsWildcard() {
+			return Null, moerr.NewInvalidInputNoCtx("path expressions may not contain the * and ** tokens or an array range in this situation")
+		}
+		if path.empty() {
+			return Null, moerr.NewInvalidInputNoCtx("the path expression '$' is not allowed in this situation")
+		}
+		doc = removeTree(doc, path.paths)
+	}
+	return fromTree(doc)
+}
+
+// CreateByteJsonArray creates a json array of the elements
+func CreateByteJsonArray(elems []ByteJson) ByteJson {
+	return *mergeToArray(elems)
+}
+
+// CreateByteJsonObject creates a json object of the keys and values, the last value
+// wins if a key is duplicated.
+func CreateByteJsonObject(keys []string, vals []ByteJson) (ByteJson, error) {
+	obj := make(map[string]interface{}, len(keys))
+	for i, key := range keys {
+		obj[key] = vals[i]
+	}
+	return fromTree(obj)
+}
+
+// MergeArrays concatenates the elements of the json arrays into one array, a value
+// that is not an array is taken as an array of one element.
+func MergeArrays(arrs []ByteJson) ByteJson {
+	elems := make([]ByteJson, 0, len(arrs))
+	for _, arr := range arrs {
+		if arr.Type != TpCodeArray {
+			elems = append(elems, arr)
+			continue
+		}
+		cnt := arr.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			elems = append(elems, arr.getArrayElem(i))
+		}
+	}
+	return CreateByteJsonArray(elems)
+}
+
+// MergeObjects merges the members of the json objects into one object, the later
+// value wins if a key is duplicated.
+func MergeObjects(objs []ByteJson) (ByteJson, error) {
+	obj := make(map[string]interface{})
+	for _, o := range objs {
+		if o.Type != TpCodeObject {
+			return Null, moerr.NewInvalidInputNoCtx("json merge of a non-object value")
+		}
+		cnt := o.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			obj[string(o.getObjectKey(i))] = o.getObjectVal(i)
+		}
+	}
+	return fromTree(obj)
+}
+
+// expand unfolds one level of the json container so its members can be modified,
+// the members are left as ByteJson until they are modified.
+func expand(node interface{}) interface{} {
+	bj, ok := node.(ByteJson)
+	if !ok {
+		return node
+	}
+	switch bj.Type {
+	case TpCodeObject:
+		cnt := bj.GetElemCnt()
+		obj := make(map[string]interface{}, cnt)
+		for i := 0; i < cnt; i++ {
+			obj[string(bj.getObjectKey(i))] = bj.getObjectVal(i)
+		}
+		return obj
+	case TpCodeArray:
+		cnt := bj.GetElemCnt()
+		arr := make([]interface{}, cnt)
+		for i := 0; i < cnt; i++ {
+			arr[i] = bj.getArrayElem(i)
+		}
+		return arr
+	}
+	return bj
+}
+
+func fromTree(node interface{}) (ByteJson, error) {
+	if bj, ok := node.(ByteJson); ok {
+		return bj, nil
+	}
+	var bj ByteJson
+	err := bj.UnmarshalObject(node)
+	return bj, err
+}
+
+func modifyTree(node interface{}, legs []subPath, val ByteJson, modifyType JsonModifyType) interface{} {
+	if len(legs) == 0 {
+		if modifyType == JsonModifyInsert {
+			return node
+		}
+		return val
+	}
+	leg, last := legs[0], len(legs) == 1
+	node = expand(node)
+	switch leg.tp {
+	case subPathKey:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return node
+		}
+		child, ok := obj[leg.key]
+		if !ok {
+			if last && modifyType != JsonModifyReplace {
+				obj[leg.key] = val
+			}
+			return obj
+		}
+		obj[leg.key] = modifyTree(child, legs[1:], val, modifyType)
+		return obj
+	case subPathIdx:
+		arr, ok := node.([]interface{})
+		if !ok {
+			// a value that is not an array is taken as an array of one element
+			idx, _, _ := leg.idx.genIndex(1)
+			if idx == 0 {
+				return modifyTree(node, legs[1:], val, modifyType)
+			}
+			if idx > 0 && last && modifyType != JsonModifyReplace {
+				return []interface{}{node, val}
+			}
+			return node
+		}
+		idx, _, _ := leg.idx.genIndex(len(arr))
+		if idx >= 0 && idx < len(arr) {
+			arr[idx] = modifyTree(arr[idx], legs[1:], val, modifyType)
+		} else if idx >= len(arr) && last && modifyType != JsonModifyReplace {
+			arr = append(arr, val)
+		}
+		return arr
+	}
+	return node
+}
+
+func removeTree(node interface{}, legs []subPath) interface{} {
+	leg, last := legs[0], len(legs) == 1
+	node = expand(node)
+	switch leg.tp {
+	case subPathKey:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return node
+		}
+		child, ok := obj[leg.key]
+		if !ok {
+			return obj
+		}
+		if last {
+			delete(obj, leg.key)
+		} else {
+			obj[leg.key] = removeTree(child, legs[1:])
+		}
+		return obj
+	case subPathIdx:
+		arr, ok := node.([]interface{})
+		if !ok {
+			idx, _, _ := leg.idx.genIndex(1)
+			if idx == 0 && !last {
+				return removeTree(node, legs[1:])
+			}
+			return node
+		}
+		idx, _, _ := leg.idx.genIndex(len(arr))
+		if idx < 0 || idx >= len(arr) {
+			return arr
+		}
+		if last {
+			return append(arr[:idx], arr[idx+1:]...)
+		}
+		arr[idx] = removeTree(arr[idx], legs[1:])
+		return arr
+	}
+	return node
+}
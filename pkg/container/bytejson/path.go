@@ -37,6 +37,19 @@ func (p *Path) init(subs []subPath) {
 	}
 }
 
+// IsWildcard returns true if the path contains the * or ** tokens or an array range
+func (p *Path) IsWildcard() bool {
+	if p.flag != 0 {
+		return true
+	}
+	for _, sub := range p.paths {
+		if sub.tp == subPathRange {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Path) empty() bool {
 	return len(p.paths) == 0
 }
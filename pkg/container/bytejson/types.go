@@ -57,6 +57,18 @@ type pathGenerator struct {
 
 type UnnestResult map[string][]byte
 
+// JsonModifyType is the way JSON_SET, JSON_INSERT and JSON_REPLACE modify the json document
+type JsonModifyType int
+
+const (
+	// JsonModifySet replaces the existing values and adds the values that do not exist
+	JsonModifySet JsonModifyType = iota
+	// JsonModifyInsert adds the values that do not exist only
+	JsonModifyInsert
+	// JsonModifyReplace replaces the existing values only
+	JsonModifyReplace
+)
+
 const (
 	numberIndices byte = iota + 1
 	lastIndices
@@ -315,11 +315,11 @@ func DecodeValue(val []byte, typ Type) any {
 		return DecodeFixed[int64](val)
 	case T_uint8:
 		return DecodeFixed[uint8](val)
-	case T_uint16:
+	case T_uint16, T_enum:
 		return DecodeFixed[uint16](val)
 	case T_uint32:
 		return DecodeFixed[uint32](val)
-	case T_uint64:
+	case T_uint64, T_set:
 		return DecodeFixed[uint64](val)
 	case T_float32:
 		return DecodeFixed[float32](val)
@@ -364,11 +364,11 @@ func EncodeValue(val any, typ Type) []byte {
 		return EncodeFixed(val.(int64))
 	case T_uint8:
 		return EncodeFixed(val.(uint8))
-	case T_uint16:
+	case T_uint16, T_enum:
 		return EncodeFixed(val.(uint16))
 	case T_uint32:
 		return EncodeFixed(val.(uint32))
-	case T_uint64:
+	case T_uint64, T_set:
 		return EncodeFixed(val.(uint64))
 	case T_float32:
 		return EncodeFixed(val.(float32))
@@ -0,0 +1,147 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// An ENUM value is stored as the 1-based ordinal of the member in uint16, 0 is the empty string
+// for the invalid values. A SET value is stored as the bitmask of the members in uint64, the i-th
+// member takes the i-th bit. The members themselves are kept in the column definition only.
+const (
+	MaxEnumLen = 65535
+	MaxSetLen  = 64
+)
+
+// EncodeEnumValues encodes the members of an ENUM or SET type as a list of quoted strings,
+// which is how they are written in the column definition, e.g. 'a','b','c'.
+func EncodeEnumValues(values []string) string {
+	var b strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('\'')
+		for j := 0; j < len(v); j++ {
+			if v[j] == '\'' || v[j] == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(v[j])
+		}
+		b.WriteByte('\'')
+	}
+	return b.String()
+}
+
+// DecodeEnumValues decodes the members encoded by EncodeEnumValues.
+func DecodeEnumValues(s string) ([]string, error) {
+	var values []string
+	for i := 0; i < len(s); {
+		if s[i] != '\'' {
+			return nil, moerr.NewInvalidInputNoCtx("invalid enum values %s", s)
+		}
+		var b strings.Builder
+		closed := false
+		for i++; i < len(s); i++ {
+			if s[i] == '\\' && i+1 < len(s) {
+				i++
+			} else if s[i] == '\'' {
+				closed = true
+				i++
+				break
+			}
+			b.WriteByte(s[i])
+		}
+		if !closed || (i < len(s) && (s[i] != ',' || i == len(s)-1)) {
+			return nil, moerr.NewInvalidInputNoCtx("invalid enum values %s", s)
+		}
+		if i < len(s) {
+			i++
+		}
+		values = append(values, b.String())
+	}
+	return values, nil
+}
+
+// ParseEnum returns the ordinal of the member s, which is matched case-insensitively with
+// the trailing spaces ignored. A number is taken as the ordinal as MySQL does.
+func ParseEnum(values []string, s string) (uint16, error) {
+	s = strings.TrimRight(s, " ")
+	for i, v := range values {
+		if strings.EqualFold(strings.TrimRight(v, " "), s) {
+			return uint16(i + 1), nil
+		}
+	}
+	if n, err := strconv.ParseUint(s, 10, 16); err == nil && n <= uint64(len(values)) {
+		return uint16(n), nil
+	}
+	return 0, moerr.NewDataTruncatedNoCtx("enum", "value '%s' is not a member", s)
+}
+
+// EnumToString returns the member of the ordinal.
+func EnumToString(values []string, v uint16) (string, error) {
+	if v == 0 {
+		return "", nil
+	}
+	if int(v) > len(values) {
+		return "", moerr.NewDataTruncatedNoCtx("enum", "ordinal %d is out of range", v)
+	}
+	return values[v-1], nil
+}
+
+// ParseSet returns the bitmask of the comma separated members in s, each of them is matched
+// case-insensitively with the trailing spaces ignored. A number is taken as the bitmask as MySQL does.
+func ParseSet(values []string, s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	var mask uint64
+	for _, member := range strings.Split(s, ",") {
+		member = strings.TrimRight(member, " ")
+		found := false
+		for i, v := range values {
+			if strings.EqualFold(strings.TrimRight(v, " "), member) {
+				mask |= 1 << i
+				found = true
+				break
+			}
+		}
+		if !found {
+			if n, err := strconv.ParseUint(s, 10, 64); err == nil && (len(values) == MaxSetLen || n < 1<<len(values)) {
+				return n, nil
+			}
+			return 0, moerr.NewDataTruncatedNoCtx("set", "value '%s' is not a member", member)
+		}
+	}
+	return mask, nil
+}
+
+// SetToString returns the members of the bitmask separated by comma, in the order of the definition.
+func SetToString(values []string, v uint64) (string, error) {
+	if len(values) < MaxSetLen && v >= 1<<len(values) {
+		return "", moerr.NewDataTruncatedNoCtx("set", "bitmask %d is out of range", v)
+	}
+	members := make([]string, 0, len(values))
+	for i, member := range values {
+		if v&(1<<i) != 0 {
+			members = append(members, member)
+		}
+	}
+	return strings.Join(members, ","), nil
+}
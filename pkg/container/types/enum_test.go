@@ -0,0 +1,76 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumValues(t *testing.T) {
+	values := []string{"a", "it's", `c:\`, ""}
+	s := EncodeEnumValues(values)
+	require.Equal(t, `'a','it\'s','c:\\',''`, s)
+	decoded, err := DecodeEnumValues(s)
+	require.NoError(t, err)
+	require.Equal(t, values, decoded)
+
+	for _, s := range []string{"a", "'a", "'a'b", "'a',"} {
+		_, err = DecodeEnumValues(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestEnum(t *testing.T) {
+	values := []string{"small", "Medium", "large"}
+	for s, want := range map[string]uint16{"small": 1, "MEDIUM ": 2, "3": 3, "0": 0} {
+		v, err := ParseEnum(values, s)
+		require.NoError(t, err)
+		require.Equal(t, want, v)
+	}
+	_, err := ParseEnum(values, "huge")
+	require.Error(t, err)
+	_, err = ParseEnum(values, "4")
+	require.Error(t, err)
+
+	s, err := EnumToString(values, 2)
+	require.NoError(t, err)
+	require.Equal(t, "Medium", s)
+	s, err = EnumToString(values, 0)
+	require.NoError(t, err)
+	require.Equal(t, "", s)
+	_, err = EnumToString(values, 4)
+	require.Error(t, err)
+}
+
+func TestSet(t *testing.T) {
+	values := []string{"a", "b", "c"}
+	for s, want := range map[string]uint64{"": 0, "a": 1, "c,A": 5, "b,b": 2, "7": 7} {
+		v, err := ParseSet(values, s)
+		require.NoError(t, err)
+		require.Equal(t, want, v)
+	}
+	_, err := ParseSet(values, "a,d")
+	require.Error(t, err)
+	_, err = ParseSet(values, "8")
+	require.Error(t, err)
+
+	s, err := SetToString(values, 6)
+	require.NoError(t, err)
+	require.Equal(t, "b,c", s)
+	_, err = SetToString(values, 8)
+	require.Error(t, err)
+}
@@ -69,6 +69,10 @@ const (
 	T_binary    T = 64
 	T_varbinary T = 65
 
+	// enum and set, stored as the ordinal and the bitmask of the members
+	T_enum T = 66
+	T_set  T = 67
+
 	// blobs
 	T_blob T = 70
 	T_text T = 71
@@ -211,6 +215,8 @@ var Types map[string]T = map[string]T{
 	"text": T_text,
 	"blob": T_blob,
 	"uuid": T_uuid,
+	"enum": T_enum,
+	"set":  T_set,
 
 	"transaction timestamp": T_TS,
 	"rowid":                 T_Rowid,
@@ -351,11 +357,11 @@ func (t T) ToType() Type {
 		typ.Size = 8
 	case T_uint8:
 		typ.Size = 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		typ.Size = 2
 	case T_uint32:
 		typ.Size = 4
-	case T_uint64:
+	case T_uint64, T_set:
 		typ.Size = 8
 	case T_float32:
 		typ.Size = 4
@@ -461,6 +467,10 @@ func (t T) String() string {
 		return "ROWID"
 	case T_uuid:
 		return "UUID"
+	case T_enum:
+		return "ENUM"
+	case T_set:
+		return "SET"
 	case T_interval:
 		return "INTERVAL"
 	}
@@ -472,6 +482,10 @@ func (t T) OidString() string {
 	switch t {
 	case T_uuid:
 		return "T_uuid"
+	case T_enum:
+		return "T_enum"
+	case T_set:
+		return "T_set"
 	case T_json:
 		return "T_json"
 	case T_bool:
@@ -547,11 +561,11 @@ func (t T) TypeLen() int {
 		return 8
 	case T_uint8:
 		return 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		return 2
 	case T_uint32:
 		return 4
-	case T_uint64:
+	case T_uint64, T_set:
 		return 8
 	case T_float32:
 		return 4
@@ -584,11 +598,11 @@ func (t T) FixedLength() int {
 		return 0
 	case T_int8, T_uint8, T_bool:
 		return 1
-	case T_int16, T_uint16:
+	case T_int16, T_uint16, T_enum:
 		return 2
 	case T_int32, T_uint32, T_date, T_float32:
 		return 4
-	case T_int64, T_uint64, T_datetime, T_time, T_float64, T_timestamp:
+	case T_int64, T_uint64, T_datetime, T_time, T_float64, T_timestamp, T_set:
 		return 8
 	case T_decimal64:
 		return 8
@@ -340,11 +340,11 @@ func NewFunctionResultWrapper(typ types.Type, mp *mpool.MPool, isConst bool, len
 		return newResultFunc[int64](v, mp)
 	case types.T_uint8:
 		return newResultFunc[uint8](v, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return newResultFunc[uint16](v, mp)
 	case types.T_uint32:
 		return newResultFunc[uint32](v, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return newResultFunc[uint64](v, mp)
 	case types.T_float32:
 		return newResultFunc[float32](v, mp)
@@ -467,11 +467,11 @@ func (v *Vector) ToConst(row, length int, mp *mpool.MPool) *Vector {
 		return NewConstFixed(v.typ, v.col.([]int64)[row], length, mp)
 	case types.T_uint8:
 		return NewConstFixed(v.typ, v.col.([]uint8)[row], length, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return NewConstFixed(v.typ, v.col.([]uint16)[row], length, mp)
 	case types.T_uint32:
 		return NewConstFixed(v.typ, v.col.([]uint32)[row], length, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return NewConstFixed(v.typ, v.col.([]uint64)[row], length, mp)
 	case types.T_float32:
 		return NewConstFixed(v.typ, v.col.([]float32)[row], length, mp)
@@ -571,11 +571,11 @@ func (v *Vector) Shrink(sels []int64, negate bool) {
 		shrinkFixed[int64](v, sels, negate)
 	case types.T_uint8:
 		shrinkFixed[uint8](v, sels, negate)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		shrinkFixed[uint16](v, sels, negate)
 	case types.T_uint32:
 		shrinkFixed[uint32](v, sels, negate)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		shrinkFixed[uint64](v, sels, negate)
 	case types.T_float32:
 		shrinkFixed[float32](v, sels, negate)
@@ -628,11 +628,11 @@ func (v *Vector) Shuffle(sels []int64, mp *mpool.MPool) error {
 		shuffleFixed[int64](v, sels, mp)
 	case types.T_uint8:
 		shuffleFixed[uint8](v, sels, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		shuffleFixed[uint16](v, sels, mp)
 	case types.T_uint32:
 		shuffleFixed[uint32](v, sels, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		shuffleFixed[uint64](v, sels, mp)
 	case types.T_float32:
 		shuffleFixed[float32](v, sels, mp)
@@ -742,7 +742,7 @@ func GetUnionOneFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			ws := MustFixedCol[uint8](w)
 			return appendOneFixed(v, ws[sel], nulls.Contains(w.nsp, uint64(sel)), mp)
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return func(v, w *Vector, sel int64) error {
 			ws := MustFixedCol[uint16](w)
 			return appendOneFixed(v, ws[sel], nulls.Contains(w.nsp, uint64(sel)), mp)
@@ -752,7 +752,7 @@ func GetUnionOneFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			ws := MustFixedCol[uint32](w)
 			return appendOneFixed(v, ws[sel], nulls.Contains(w.nsp, uint64(sel)), mp)
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return func(v, w *Vector, sel int64) error {
 			ws := MustFixedCol[uint64](w)
 			return appendOneFixed(v, ws[sel], nulls.Contains(w.nsp, uint64(sel)), mp)
@@ -1063,11 +1063,11 @@ func (v *Vector) String() string {
 		return vecToString[int64](v)
 	case types.T_uint8:
 		return vecToString[uint8](v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return vecToString[uint16](v)
 	case types.T_uint32:
 		return vecToString[uint32](v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return vecToString[uint64](v)
 	case types.T_float32:
 		return vecToString[float32](v)
@@ -1169,11 +1169,11 @@ func AppendAny(vec *Vector, val any, isNull bool, mp *mpool.MPool) error {
 		return appendOneFixed(vec, val.(int64), false, mp)
 	case types.T_uint8:
 		return appendOneFixed(vec, val.(uint8), false, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return appendOneFixed(vec, val.(uint16), false, mp)
 	case types.T_uint32:
 		return appendOneFixed(vec, val.(uint32), false, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return appendOneFixed(vec, val.(uint64), false, mp)
 	case types.T_float32:
 		return appendOneFixed(vec, val.(float32), false, mp)
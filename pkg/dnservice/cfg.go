@@ -99,6 +99,12 @@ type Config struct {
 		GlobalMinCount      int64         `toml:"global-min-count"`
 	}
 
+	GC struct {
+		// SnapshotRetention how long the data of the past snapshots is kept for the AS OF
+		// queries. The data is kept at least the gc ttl of the storage.
+		SnapshotRetention toml.Duration `toml:"snapshot-retention"`
+	}
+
 	LogtailServer struct {
 		ListenAddress              string        `toml:"listen-address"`
 		ServiceAddress             string        `toml:"service-address"`
@@ -0,0 +1,353 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// the statistics of the columns collected by ANALYZE TABLE are saved in mo_column_stats.
+// the rows saved by an ANALYZE TABLE get a version greater than the ones of the table, so the
+// sessions in all CNs know the statistics they cached are stale when the max version changes.
+const (
+	deleteColumnStatsFormat = `delete from mo_catalog.mo_column_stats where table_id = %d and column_name = '%s';`
+
+	insertColumnStatsFormat = `insert into mo_catalog.mo_column_stats(
+		table_id,
+		column_name,
+		row_count,
+		null_count,
+		ndv,
+		histogram,
+		mcv,
+		version,
+		update_time) values (%d, '%s', %d, %d, %d, '%s', '%s', %d, '%s');`
+
+	deleteTableColumnStatsFormat = `delete from mo_catalog.mo_column_stats where table_id = %d;`
+
+	getColumnStatsFormat = `select column_name, row_count, null_count, ndv, histogram, mcv from mo_catalog.mo_column_stats where table_id = %d;`
+
+	getColumnStatsVersionFormat = `select version from mo_catalog.mo_column_stats where table_id = %d order by version desc limit 1;`
+)
+
+// analyzeSampleBlockNum is the max number of the blocks read by ANALYZE TABLE.
+// The data in memory is always read.
+var analyzeSampleBlockNum = 64
+
+// sampleRanges picks the blocks evenly from the ranges of the table
+func sampleRanges(ranges [][]byte, num int) [][]byte {
+	var sampled [][]byte
+	blocks := ranges
+	if len(ranges) > 0 && engine.IsMemtable(ranges[0]) {
+		sampled = append(sampled, ranges[0])
+		blocks = ranges[1:]
+	}
+	if len(blocks) <= num {
+		return append(sampled, blocks...)
+	}
+	step := float64(len(blocks)) / float64(num)
+	for i := 0; i < num; i++ {
+		sampled = append(sampled, blocks[int(float64(i)*step)])
+	}
+	return sampled
+}
+
+func getAnalyzeColumns(ctx context.Context, stmt *tree.AnalyzeStmt, attrs []*engine.Attribute) ([]*engine.Attribute, error) {
+	var cols []*engine.Attribute
+	if len(stmt.Cols) == 0 {
+		for _, attr := range attrs {
+			if !attr.IsHidden && !attr.IsRowId && plan2.SupportColumnStats(attr.Type) {
+				cols = append(cols, attr)
+			}
+		}
+		return cols, nil
+	}
+	for _, col := range stmt.Cols {
+		var found *engine.Attribute
+		for _, attr := range attrs {
+			if !attr.IsHidden && !attr.IsRowId && strings.EqualFold(attr.Name, string(col)) {
+				found = attr
+				break
+			}
+		}
+		if found == nil {
+			return nil, moerr.NewBadFieldError(ctx, string(col), string(stmt.Table.ObjectName))
+		}
+		if !plan2.SupportColumnStats(found.Type) {
+			return nil, moerr.NewNotSupported(ctx, "analyze the column %s of type %s", found.Name, found.Type.String())
+		}
+		cols = append(cols, found)
+	}
+	return cols, nil
+}
+
+// doAnalyzeTable samples the blocks of the table, builds the histograms, the ndv and the most
+// common values of the columns and saves them into mo_column_stats.
+func doAnalyzeTable(ctx context.Context, ses *Session, stmt *tree.AnalyzeStmt) error {
+	var err error
+	dbName := string(stmt.Table.SchemaName)
+	tblName := string(stmt.Table.ObjectName)
+	tcc := ses.GetTxnCompileCtx()
+	if dbName, err = tcc.ensureDatabaseIsNotEmpty(dbName); err != nil {
+		return err
+	}
+	txnCtx, rel, err := tcc.getRelation(dbName, tblName)
+	if err != nil {
+		return err
+	}
+	attrs, err := rel.TableColumns(txnCtx)
+	if err != nil {
+		return err
+	}
+	cols, err := getAnalyzeColumns(ctx, stmt, attrs)
+	if err != nil {
+		return err
+	}
+
+	colNames := make([]string, len(cols))
+	builders := make([]*plan2.ColumnStatsBuilder, len(cols))
+	for i, col := range cols {
+		colNames[i] = col.Name
+		builders[i] = plan2.NewColumnStatsBuilder(col.Type)
+	}
+
+	if len(cols) > 0 {
+		ranges, err := rel.Ranges(txnCtx, nil)
+		if err != nil {
+			return err
+		}
+		rds, err := rel.NewReader(txnCtx, 1, nil, sampleRanges(ranges, analyzeSampleBlockNum))
+		if err != nil {
+			return err
+		}
+		for {
+			bat, err := rds[0].Read(txnCtx, colNames, nil, ses.GetMemPool())
+			if err != nil {
+				rds[0].Close()
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			for i := range builders {
+				builders[i].Add(bat.Vecs[i])
+			}
+			bat.Clean(ses.GetMemPool())
+		}
+		if err = rds[0].Close(); err != nil {
+			return err
+		}
+	}
+
+	rows, err := rel.Rows(txnCtx)
+	if err != nil {
+		return err
+	}
+	tableID := rel.GetTableID(txnCtx)
+	stats := make(map[string]*plan2.ColumnStats, len(cols))
+	for i, col := range cols {
+		stats[col.Name] = builders[i].Build(float64(rows))
+	}
+	if err = saveColumnStats(ctx, ses, tableID, stats); err != nil {
+		return err
+	}
+
+	return doAnalyzeTableResult(ses, dbName+"."+tblName)
+}
+
+func saveColumnStats(ctx context.Context, ses *Session, tableID uint64, stats map[string]*plan2.ColumnStats) error {
+	var err error
+	var version uint64
+	var buckets, mcv []byte
+	updateTime := types.CurrentTimestamp().String2(time.UTC, 0)
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+	version, err = getColumnStatsVersion(ctx, bh, tableID)
+	if err != nil {
+		goto handleFailed
+	}
+	version++
+	for name, cs := range stats {
+		buckets, err = json.Marshal(cs.Buckets)
+		if err != nil {
+			goto handleFailed
+		}
+		mcv, err = json.Marshal(cs.Mcv)
+		if err != nil {
+			goto handleFailed
+		}
+		err = bh.Exec(ctx, fmt.Sprintf(deleteColumnStatsFormat, tableID, escapeSqlString(name)))
+		if err != nil {
+			goto handleFailed
+		}
+		err = bh.Exec(ctx, fmt.Sprintf(insertColumnStatsFormat, tableID, escapeSqlString(name),
+			int64(cs.RowCount), int64(cs.NullCount), int64(cs.Ndv),
+			escapeSqlString(string(buckets)), escapeSqlString(string(mcv)), version, updateTime))
+		if err != nil {
+			goto handleFailed
+		}
+	}
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	return err
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// deleteColumnStatsOfPlan removes the statistics of the table dropped or truncated by the plan,
+// the truncated table gets a new table id and the statistics of the old one would be orphaned.
+func deleteColumnStatsOfPlan(ctx context.Context, ses *Session, pn *plan2.Plan) error {
+	var tableID uint64
+	switch ddl := pn.GetDdl(); {
+	case ddl.GetDropTable() != nil:
+		tableID = ddl.GetDropTable().GetTableId()
+	case ddl.GetTruncateTable() != nil:
+		tableID = ddl.GetTruncateTable().GetTableId()
+	}
+	if tableID == 0 {
+		return nil
+	}
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	return bh.Exec(ctx, fmt.Sprintf(deleteTableColumnStatsFormat, tableID))
+}
+
+// getColumnStatsVersion returns the max version of the statistics of the table, 0 if there is none
+func getColumnStatsVersion(ctx context.Context, bh BackgroundExec, tableID uint64) (uint64, error) {
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, fmt.Sprintf(getColumnStatsVersionFormat, tableID))
+	if err != nil {
+		return 0, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return 0, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return 0, nil
+	}
+	return erArray[0].GetUint64(ctx, 0, 0)
+}
+
+// refreshColumnStats loads the statistics of the table into the cache if they are not loaded
+// yet or changed since they were loaded.
+func refreshColumnStats(ctx context.Context, ses *Session, tableID uint64, s *plan2.StatsInfoMap) error {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	version, err := getColumnStatsVersion(ctx, bh, tableID)
+	if err != nil {
+		return err
+	}
+	if s.ColumnStats != nil && s.ColumnStatsVersion == version {
+		return nil
+	}
+	cs, err := loadColumnStats(ctx, ses, tableID)
+	if err != nil {
+		return err
+	}
+	s.ColumnStats = cs
+	s.ColumnStatsVersion = version
+	return nil
+}
+
+// loadColumnStats reads the statistics of the columns of the table saved by ANALYZE TABLE
+func loadColumnStats(ctx context.Context, ses *Session, tableID uint64) (map[string]*plan2.ColumnStats, error) {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, fmt.Sprintf(getColumnStatsFormat, tableID))
+	if err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]*plan2.ColumnStats)
+	if !execResultArrayHasData(erArray) {
+		return stats, nil
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		cs := &plan2.ColumnStats{}
+		name, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		for j, f := range []*float64{&cs.RowCount, &cs.NullCount, &cs.Ndv} {
+			v, err := erArray[0].GetInt64(ctx, i, uint64(j+1))
+			if err != nil {
+				return nil, err
+			}
+			*f = float64(v)
+		}
+		buckets, err := erArray[0].GetString(ctx, i, 4)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal([]byte(buckets), &cs.Buckets); err != nil {
+			return nil, err
+		}
+		mcv, err := erArray[0].GetString(ctx, i, 5)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal([]byte(mcv), &cs.Mcv); err != nil {
+			return nil, err
+		}
+		stats[name] = cs
+	}
+	return stats, nil
+}
+
+// doAnalyzeTableResult makes the result set of ANALYZE TABLE like the mysql
+func doAnalyzeTableResult(ses *Session, table string) error {
+	mrs := ses.GetMysqlResultSet()
+	for _, name := range []string{"Table", "Op", "Msg_type", "Msg_text"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{table, "analyze", "status", "OK"})
+	return nil
+}
@@ -0,0 +1,275 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sampleRanges(t *testing.T) {
+	ranges := [][]byte{{}}
+	for i := 0; i < 10; i++ {
+		ranges = append(ranges, []byte{byte(i)})
+	}
+	require.Equal(t, ranges, sampleRanges(ranges, 10))
+	require.Equal(t, [][]byte{{}, {0}, {2}, {5}, {7}}, sampleRanges(ranges, 4))
+	require.Equal(t, [][]byte{{0}, {5}}, sampleRanges(ranges[1:], 2))
+}
+
+func Test_getAnalyzeColumns(t *testing.T) {
+	ctx := context.TODO()
+	attrs := []*engine.Attribute{
+		{Name: "a", Type: types.T_int64.ToType()},
+		{Name: "b", Type: types.T_varchar.ToType()},
+		{Name: "c", Type: types.T_Rowid.ToType()},
+		{Name: "__mo_cpkey", Type: types.T_varchar.ToType(), IsHidden: true},
+	}
+	stmt := tree.NewAnalyzeStmt(tree.NewTableName("t", tree.ObjectNamePrefix{}), nil)
+	cols, err := getAnalyzeColumns(ctx, stmt, attrs)
+	require.NoError(t, err)
+	require.Equal(t, attrs[:2], cols)
+
+	stmt.Cols = tree.IdentifierList{"B"}
+	cols, err = getAnalyzeColumns(ctx, stmt, attrs)
+	require.NoError(t, err)
+	require.Equal(t, attrs[1:2], cols)
+
+	stmt.Cols = tree.IdentifierList{"__mo_cpkey"}
+	_, err = getAnalyzeColumns(ctx, stmt, attrs)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrBadFieldError))
+
+	stmt.Cols = tree.IdentifierList{"c"}
+	_, err = getAnalyzeColumns(ctx, stmt, attrs)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+}
+
+func Test_doAnalyzeTable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bt)
+	defer bhStub.Reset()
+
+	txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+	txnOperator.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
+	txnOperator.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+	txnClient := mock_frontend.NewMockTxnClient(ctrl)
+	txnClient.EXPECT().New().Return(txnOperator, nil).AnyTimes()
+	eng := mock_frontend.NewMockEngine(ctrl)
+	eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	eng.EXPECT().Hints().Return(engine.Hints{
+		CommitOrRollbackTimeout: time.Second,
+	}).AnyTimes()
+
+	ses := newTestSession(t, ctrl)
+	defer ses.Dispose()
+	ses.SetDatabaseName("db1")
+	ses.GetTxnCompileCtx().SetDatabase("db1")
+	ses.SetRequestContext(ctx)
+	ses.SetConnectContext(ctx)
+	ses.SetMysqlResultSet(&MysqlResultSet{})
+	ses.GetTxnHandler().storage = &engine.EntireEngine{Engine: eng}
+	ses.GetTxnHandler().txnClient = txnClient
+
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	for i := 0; i < 100; i++ {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(i%10), false, ses.GetMemPool()))
+		require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte("x"), i%2 == 0, ses.GetMemPool()))
+	}
+	reader := mock_frontend.NewMockReader(ctrl)
+	gomock.InOrder(
+		reader.EXPECT().Read(gomock.Any(), []string{"a", "b"}, gomock.Any(), gomock.Any()).Return(bat, nil),
+		reader.EXPECT().Read(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil),
+	)
+	reader.EXPECT().Close().Return(nil)
+
+	table := mock_frontend.NewMockRelation(ctrl)
+	table.EXPECT().TableColumns(gomock.Any()).Return([]*engine.Attribute{
+		{Name: "a", Type: types.T_int64.ToType()},
+		{Name: "b", Type: types.T_varchar.ToType()},
+		{Name: "__mo_rowid", Type: types.T_Rowid.ToType(), IsHidden: true, IsRowId: true},
+	}, nil).AnyTimes()
+	table.EXPECT().Ranges(gomock.Any(), gomock.Any()).Return([][]byte{{}}, nil)
+	table.EXPECT().NewReader(gomock.Any(), 1, gomock.Any(), [][]byte{{}}).Return([]engine.Reader{reader}, nil)
+	table.EXPECT().Rows(gomock.Any()).Return(int64(100), nil)
+	table.EXPECT().GetTableID(gomock.Any()).Return(uint64(42)).AnyTimes()
+	db := mock_frontend.NewMockDatabase(ctrl)
+	db.EXPECT().Relation(gomock.Any(), "t").Return(table, nil).AnyTimes()
+	eng.EXPECT().Database(gomock.Any(), "db1", gomock.Any()).Return(db, nil).AnyTimes()
+
+	// the statistics are saved with a version greater than the current one
+	bt.sql2result[fmt.Sprintf(getColumnStatsVersionFormat, 42)] = newMrsForProcedure([]interface{}{uint64(3)})
+	stmt := tree.NewAnalyzeStmt(tree.NewTableName("t", tree.ObjectNamePrefix{}), nil)
+	require.NoError(t, doAnalyzeTable(ctx, ses, stmt))
+
+	mrs := ses.GetMysqlResultSet()
+	require.Equal(t, uint64(1), mrs.GetRowCount())
+	require.Equal(t, []interface{}{"db1.t", "analyze", "status", "OK"}, mrs.Data[0])
+
+	var inserts []string
+	for _, sql := range bt.sqls {
+		if strings.HasPrefix(sql, "insert into mo_catalog.mo_column_stats") {
+			inserts = append(inserts, sql)
+		}
+	}
+	require.Len(t, inserts, 2)
+	require.Equal(t, "begin;", bt.sqls[0])
+	require.Equal(t, fmt.Sprintf(getColumnStatsVersionFormat, 42), bt.sqls[1])
+	require.Equal(t, "commit;", bt.sqls[len(bt.sqls)-1])
+	for _, sql := range inserts {
+		if strings.Contains(sql, "(42, 'a', ") {
+			require.Contains(t, sql, "(42, 'a', 100, 0, 10, ")
+		} else {
+			require.Contains(t, sql, "(42, 'b', 100, 50, 1, 'null', '[]', 4, ")
+		}
+	}
+}
+
+func Test_loadColumnStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+
+	stats, err := loadColumnStats(ctx, ses, 42)
+	require.NoError(t, err)
+	require.Empty(t, stats)
+
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"column_name", "row_count", "null_count", "ndv", "histogram", "mcv"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{"a", int64(100), int64(1), int64(10),
+		`[{"lower":1,"upper":10,"count":1,"ndv":10}]`, `[{"value":"1","freq":0.5}]`})
+	bt.sql2result[fmt.Sprintf(getColumnStatsFormat, 42)] = mrs
+
+	stats, err = loadColumnStats(ctx, ses, 42)
+	require.NoError(t, err)
+	require.Equal(t, map[string]*plan2.ColumnStats{
+		"a": {
+			RowCount:  100,
+			NullCount: 1,
+			Ndv:       10,
+			Buckets:   []plan2.HistogramBucket{{Lower: 1, Upper: 10, Count: 1, Ndv: 10}},
+			Mcv:       []plan2.McvItem{{Value: "1", Freq: 0.5}},
+		},
+	}, stats)
+}
+
+func Test_refreshColumnStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+
+	versionSql := fmt.Sprintf(getColumnStatsVersionFormat, 42)
+	loadSql := fmt.Sprintf(getColumnStatsFormat, 42)
+	countLoads := func() int {
+		n := 0
+		for _, sql := range bt.sqls {
+			if sql == loadSql {
+				n++
+			}
+		}
+		return n
+	}
+
+	// the table never analyzed is loaded once
+	s := plan2.NewStatsInfoMap()
+	require.NoError(t, refreshColumnStats(ctx, ses, 42, s))
+	require.NoError(t, refreshColumnStats(ctx, ses, 42, s))
+	require.NotNil(t, s.ColumnStats)
+	require.Empty(t, s.ColumnStats)
+	require.Equal(t, 1, countLoads())
+
+	// analyzed in another CN
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"column_name", "row_count", "null_count", "ndv", "histogram", "mcv"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{"a", int64(100), int64(1), int64(10), `null`, `[]`})
+	bt.sql2result[loadSql] = mrs
+	bt.sql2result[versionSql] = newMrsForProcedure([]interface{}{uint64(1)})
+	require.NoError(t, refreshColumnStats(ctx, ses, 42, s))
+	require.Equal(t, uint64(1), s.ColumnStatsVersion)
+	require.Contains(t, s.ColumnStats, "a")
+	require.NoError(t, refreshColumnStats(ctx, ses, 42, s))
+	require.Equal(t, 2, countLoads())
+
+	// mo_column_stats does not exist
+	bt.sql2err[versionSql] = moerr.NewNoSuchTable(ctx, "mo_catalog", "mo_column_stats")
+	err := refreshColumnStats(ctx, ses, 42, s)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNoSuchTable))
+}
+
+func Test_deleteColumnStatsOfPlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+
+	dropTable := &plan2.Plan{Plan: &plan.Plan_Ddl{Ddl: &plan.DataDefinition{
+		Definition: &plan.DataDefinition_DropTable{DropTable: &plan.DropTable{TableId: 43}},
+	}}}
+	require.NoError(t, deleteColumnStatsOfPlan(ctx, ses, dropTable))
+	require.Equal(t, []string{fmt.Sprintf(deleteTableColumnStatsFormat, 43)}, bt.sqls)
+
+	bt.sqls = nil
+	truncateTable := &plan2.Plan{Plan: &plan.Plan_Ddl{Ddl: &plan.DataDefinition{
+		Definition: &plan.DataDefinition_TruncateTable{TruncateTable: &plan.TruncateTable{TableId: 43}},
+	}}}
+	require.NoError(t, deleteColumnStatsOfPlan(ctx, ses, truncateTable))
+	require.Equal(t, []string{fmt.Sprintf(deleteTableColumnStatsFormat, 43)}, bt.sqls)
+
+	// the table dropped by IF EXISTS is not found
+	bt.sqls = nil
+	dropTable.GetDdl().GetDropTable().TableId = 0
+	require.NoError(t, deleteColumnStatsOfPlan(ctx, ses, dropTable))
+	require.Empty(t, bt.sqls)
+}
@@ -687,7 +687,7 @@ func (pt PrivilegeType) Scope() PrivilegeScope {
 	case PrivilegeTypeTableOwnership:
 		return PrivilegeScopeTable
 	case PrivilegeTypeExecute:
-		return PrivilegeScopeTable
+		return PrivilegeScopeDatabase
 	case PrivilegeTypeValues:
 		return PrivilegeScopeTable
 	}
@@ -727,6 +727,8 @@ var (
 		"mo_mysql_compatbility_mode": 0,
 		catalog.AutoIncrTableName:    0,
 		"mo_pubs":                    0,
+		"mo_column_stats":            0,
+		"mo_plan_baselines":          0,
 	}
 	createAutoTableSql = fmt.Sprintf("create table `%s`(name varchar(770) primary key, offset bigint unsigned, step bigint unsigned);", catalog.AutoIncrTableName)
 	//the sqls creating many tables for the tenant.
@@ -826,6 +828,24 @@ var (
     		creator int unsigned,
     		comment text
     		);`,
+		`create table mo_column_stats(
+				table_id bigint unsigned,
+				column_name varchar(256),
+				row_count bigint,
+				null_count bigint,
+				ndv bigint,
+				histogram text,
+				mcv text,
+				version bigint unsigned,
+				update_time timestamp,
+				primary key(table_id, column_name)
+			);`,
+		`create table mo_plan_baselines(
+				digest varchar(64) primary key,
+				fingerprint text,
+				hints text,
+				created_time timestamp
+			);`,
 	}
 
 	//drop tables for the tenant
@@ -838,6 +858,8 @@ var (
 		`drop table if exists mo_catalog.mo_user_defined_function;`,
 		`drop table if exists mo_catalog.mo_mysql_compatbility_mode;`,
 		`drop table if exists mo_catalog.mo_pubs;`,
+		`drop table if exists mo_catalog.mo_column_stats;`,
+		`drop table if exists mo_catalog.mo_plan_baselines;`,
 		fmt.Sprintf("drop table if exists mo_catalog.`%s`;", catalog.AutoIncrTableName),
 	}
 
@@ -1154,9 +1176,9 @@ const (
 					and rp.privilege_id = %d
 					and rp.privilege_level = "%s";`
 
-	checkUdfArgs = `select args,function_id from mo_catalog.mo_user_defined_function where name = "%s" and db = "%s";`
+	checkUdfArgs = `select args,function_id from mo_catalog.mo_user_defined_function where name = "%s" and db = "%s" and type = "FUNCTION";`
 
-	checkUdfExistence = `select function_id from mo_catalog.mo_user_defined_function where name = "%s" and db = "%s" and args = '%s';`
+	checkUdfExistence = `select function_id from mo_catalog.mo_user_defined_function where name = "%s" and db = "%s" and args = '%s' and type = "FUNCTION";`
 
 	//delete role from mo_role,mo_user_grant,mo_role_grant,mo_role_privs
 	deleteRoleFromMoRoleFormat = `delete from mo_catalog.mo_role where role_id = %d;`
@@ -1753,7 +1775,7 @@ var (
 		PrivilegeTypeIndex:             {PrivilegeTypeIndex, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeTableAll:          {PrivilegeTypeTableAll, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeTableOwnership:    {PrivilegeTypeTableOwnership, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
-		PrivilegeTypeExecute:           {PrivilegeTypeExecute, privilegeLevelRoutine, objectTypeFunction, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
+		PrivilegeTypeExecute:           {PrivilegeTypeExecute, privilegeLevelStar, objectTypeDatabase, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeValues:            {PrivilegeTypeValues, privilegeLevelTable, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 	}
 
@@ -4256,7 +4278,7 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.AlterDataBaseConfig:
 		objType = objectTypeNone
 		kind = privilegeKindNone
-	case *tree.CreateFunction:
+	case *tree.CreateFunction, *tree.CreateProcedure:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
 		writeDatabaseAndTableDirectly = true
@@ -4289,10 +4311,14 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		if len(st.Names) != 0 {
 			dbName = string(st.Names[0].SchemaName)
 		}
-	case *tree.DropFunction:
+	case *tree.DropFunction, *tree.DropProcedure:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
 		writeDatabaseAndTableDirectly = true
+	case *tree.CallStmt:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeExecute, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		dbName = string(st.Name.Name.SchemaName)
 	case *tree.Select, *tree.Do:
 		objType = objectTypeTable
 		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
@@ -4338,7 +4364,8 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
 		objType = objectTypeNone
 		kind = privilegeKindNone
-	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction, *tree.SetVar:
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction, *tree.SetVar,
+		*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint, *tree.SetTransaction:
 		objType = objectTypeNone
 		kind = privilegeKindNone
 	case *tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
@@ -4366,7 +4393,7 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		if st.Name != nil {
 			dbName = string(st.Name.SchemaName)
 		}
-	case *tree.MoDump:
+	case *tree.MoDump, *tree.AnalyzeStmt:
 		objType = objectTypeTable
 		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
 	case *tree.Kill:
@@ -4379,6 +4406,10 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		typs = append(typs, PrivilegeTypeAccountAll)
 		objType = objectTypeDatabase
 		kind = privilegeKindNone
+	case *tree.CreatePlanBaseline, *tree.DropPlanBaseline:
+		typs = append(typs, PrivilegeTypeAccountAll)
+		objType = objectTypeDatabase
+		kind = privilegeKindNone
 	default:
 		panic(fmt.Sprintf("does not have the privilege definition of the statement %s", stmt))
 	}
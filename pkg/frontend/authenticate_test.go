@@ -563,6 +563,9 @@ func Test_determinePrivilege(t *testing.T) {
 		{stmt: &tree.Delete{}},
 		{stmt: &tree.CreateIndex{}},
 		{stmt: &tree.DropIndex{}},
+		{stmt: &tree.AnalyzeStmt{}},
+		{stmt: &tree.CreatePlanBaseline{}},
+		{stmt: &tree.DropPlanBaseline{}},
 		{stmt: &tree.ShowIndex{}},
 		{stmt: &tree.ShowProcessList{}},
 		{stmt: &tree.ShowErrors{}},
@@ -2973,6 +2976,106 @@ func Test_determineUseDatabase(t *testing.T) {
 	})
 }
 
+func Test_determineCallProcedure(t *testing.T) {
+	newCall := func() *tree.CallStmt {
+		return &tree.CallStmt{
+			Name: tree.NewProcedureName("p", tree.ObjectNamePrefix{SchemaName: "db", ExplicitSchema: true}),
+		}
+	}
+	convey.Convey("call procedure succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := newCall()
+		priv := determinePrivilegeSetOfStatement(stmt)
+		convey.So(priv.objectType(), convey.ShouldEqual, objectTypeDatabase)
+		convey.So(priv.entries[0].privilegeId, convey.ShouldEqual, PrivilegeTypeExecute)
+		convey.So(priv.entries[0].databaseName, convey.ShouldEqual, "db")
+		ses := newSes(priv, ctrl)
+
+		rowsOfMoUserGrant := [][]interface{}{
+			{0, false},
+		}
+		roleIdsInMoRolePrivs := []int{0}
+		rowsOfMoRolePrivs := make([][][][]interface{}, len(roleIdsInMoRolePrivs))
+		for i := 0; i < len(roleIdsInMoRolePrivs); i++ {
+			rowsOfMoRolePrivs[i] = make([][][]interface{}, len(priv.entries))
+		}
+
+		//with privilege execute
+		rowsOfMoRolePrivs[0][0] = [][]interface{}{
+			{0, true},
+		}
+		//without privilege all, ownership
+		rowsOfMoRolePrivs[0][1] = [][]interface{}{}
+		rowsOfMoRolePrivs[0][2] = [][]interface{}{}
+
+		sql2result := makeSql2ExecResult2(0, rowsOfMoUserGrant, roleIdsInMoRolePrivs, priv.entries, rowsOfMoRolePrivs, nil, nil, nil, nil)
+
+		//the privilege on all levels of the database
+		for j, entry := range priv.entries {
+			for _, pl := range objectType2privilegeLevels[objectTypeDatabase] {
+				sql, _ := getSqlForPrivilege(context.TODO(), 0, entry, pl)
+				sql2result[sql] = newMrsForCheckRoleHasPrivilege(rowsOfMoRolePrivs[0][j])
+			}
+		}
+
+		bh := newBh(ctrl, sql2result)
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		ok, err := authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ses.GetRequestContext(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+	})
+	convey.Convey("call procedure fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := newCall()
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		rowsOfMoUserGrant := [][]interface{}{
+			{0, false},
+		}
+		roleIdsInMoRolePrivs := []int{0}
+		rowsOfMoRolePrivs := make([][][][]interface{}, len(roleIdsInMoRolePrivs))
+		for i := 0; i < len(roleIdsInMoRolePrivs); i++ {
+			rowsOfMoRolePrivs[i] = make([][][]interface{}, len(priv.entries))
+		}
+
+		//without privilege execute, all, ownership
+		rowsOfMoRolePrivs[0][0] = [][]interface{}{}
+		rowsOfMoRolePrivs[0][1] = [][]interface{}{}
+		rowsOfMoRolePrivs[0][2] = [][]interface{}{}
+
+		roleIdsInMoRoleGrant := []int{0}
+		rowsOfMoRoleGrant := make([][][]interface{}, len(roleIdsInMoRoleGrant))
+		rowsOfMoRoleGrant[0] = [][]interface{}{}
+
+		sql2result := makeSql2ExecResult2(0, rowsOfMoUserGrant, roleIdsInMoRolePrivs, priv.entries, rowsOfMoRolePrivs, roleIdsInMoRoleGrant, rowsOfMoRoleGrant, nil, nil)
+
+		//the privilege on all levels of the database
+		for j, entry := range priv.entries {
+			for _, pl := range objectType2privilegeLevels[objectTypeDatabase] {
+				sql, _ := getSqlForPrivilege(context.TODO(), 0, entry, pl)
+				sql2result[sql] = newMrsForCheckRoleHasPrivilege(rowsOfMoRolePrivs[0][j])
+			}
+		}
+
+		bh := newBh(ctrl, sql2result)
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		ok, err := authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ses.GetRequestContext(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
+
 func Test_determineUseRole(t *testing.T) {
 	//TODO:add ut
 }
@@ -95,6 +95,12 @@ func (tcc *TxnCompilerContext) GetTxnHandler() *TxnHandler {
 	return tcc.txnHandler
 }
 
+func (tcc *TxnCompilerContext) SetTxnHandler(txn *TxnHandler) {
+	tcc.mu.Lock()
+	defer tcc.mu.Unlock()
+	tcc.txnHandler = txn
+}
+
 func (tcc *TxnCompilerContext) GetUserName() string {
 	tcc.mu.Lock()
 	defer tcc.mu.Unlock()
@@ -272,7 +278,7 @@ func (tcc *TxnCompilerContext) ResolveUdf(name string, args []*plan.Expr) (strin
 	if err != nil {
 		goto handleFailed
 	}
-	sql = fmt.Sprintf(`select args, body from mo_catalog.mo_user_defined_function where name = "%s" and db = "%s";`, name, tcc.DefaultDatabase())
+	sql = fmt.Sprintf(`select args, body from mo_catalog.mo_user_defined_function where name = "%s" and db = "%s" and type = "FUNCTION";`, name, tcc.DefaultDatabase())
 	bh.ClearExecResultSet()
 	err = bh.Exec(ctx, sql)
 	if err != nil {
@@ -372,6 +378,7 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 	var primarykey *plan2.PrimaryKeyDef
 	var indexes []*plan2.IndexDef
 	var refChildTbls []uint64
+	var checks []*plan2.CheckDef
 	for _, def := range engineDefs {
 		if attr, ok := def.(*engine.AttributeDef); ok {
 			col := &plan2.ColDef{
@@ -382,14 +389,18 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 					Width:       attr.Attr.Type.Width,
 					Scale:       attr.Attr.Type.Scale,
 					AutoIncr:    attr.Attr.AutoIncrement,
+					Enumvalues:  attr.Attr.EnumValues,
 					Table:       tableName,
 					NotNullable: attr.Attr.Default != nil && !attr.Attr.Default.NullAbility,
 				},
-				Primary:   attr.Attr.Primary,
-				Default:   attr.Attr.Default,
-				OnUpdate:  attr.Attr.OnUpdate,
-				Comment:   attr.Attr.Comment,
-				ClusterBy: attr.Attr.ClusterBy,
+				Primary:       attr.Attr.Primary,
+				Default:       attr.Attr.Default,
+				OnUpdate:      attr.Attr.OnUpdate,
+				Comment:       attr.Attr.Comment,
+				ClusterBy:     attr.Attr.ClusterBy,
+				Generated:     attr.Attr.Generated,
+				Alg:           plan.CompressType(attr.Attr.Alg),
+				CompressLevel: attr.Attr.CompressLevel,
 			}
 			// Is it a composite primary key
 			if attr.Attr.Name == catalog.CPrimaryKeyColName {
@@ -434,6 +445,8 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 					refChildTbls = k.Tables
 				case *engine.PrimaryKeyDef:
 					primarykey = k.Pkey
+				case *engine.CheckDef:
+					checks = k.Checks
 				}
 			}
 		} else if commnetDef, ok := def.(*engine.CommentDef); ok {
@@ -503,6 +516,7 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 		ClusterBy:     clusterByDef,
 		OriginCols:    originCols,
 		Indexes:       indexes,
+		Checks:        checks,
 	}
 	return obj, tableDef
 }
@@ -668,7 +682,23 @@ func (tcc *TxnCompilerContext) Stats(obj *plan2.ObjectRef, e *plan2.Expr) (stats
 		cols, _ := table.TableColumns(ctx)
 		fixColumnName(cols, e)
 	}
-	stats, _ = table.Stats(ctx, e, tcc.GetSession().statsCache.GetStatsInfoMap(table.GetTableID(ctx)))
+	tableID := table.GetTableID(ctx)
+	s := tcc.GetSession().statsCache.GetStatsInfoMap(tableID)
+	if ses := tcc.GetSession(); dbName != catalog.MO_CATALOG && !ses.noColumnStats {
+		// the statistics saved by ANALYZE TABLE, the table without them is treated as analyzed nothing.
+		// they are kept until the version of them in mo_column_stats changes.
+		if err = refreshColumnStats(ses.GetRequestContext(), ses, tableID, s); err != nil {
+			if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+				// do not look for them again in the session
+				ses.noColumnStats = true
+			} else {
+				// plan without them this time and load them again next time
+				logErrorf(ses.GetDebugString(), "load the column statistics of table %d failed: %v", tableID, err)
+			}
+			s.ColumnStats = nil
+		}
+	}
+	stats, _ = table.Stats(ctx, e, s)
 	return stats
 }
 
@@ -0,0 +1,246 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"path"
+	"strconv"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+const cursorSpillDir = "cursor"
+
+// cursorMemoryLimit is the max size of the rows a cursor holds in memory,
+// the rows beyond it are spilled to the local file service.
+var cursorMemoryLimit int64 = 16 << 20
+
+func init() {
+	// the values of the rows besides the builtin types, see extractRowFromVector
+	gob.Register(bytejson.ByteJson{})
+	gob.Register(types.Date(0))
+	gob.Register(types.Rowid{})
+}
+
+// Cursor holds the result set of a prepared statement executed with
+// CURSOR_TYPE_READ_ONLY. Like mysql, the result set is materialized when the
+// statement is executed and the client reads it by COM_STMT_FETCH.
+// At most cursorMemoryLimit bytes of the rows are kept in memory, the others
+// are written into the files of the local file service in order, and are read
+// back when they are fetched.
+type Cursor struct {
+	mu     sync.Mutex
+	stmtID uint32
+	fs     fileservice.FileService
+	path   string
+	// mrs holds the columns and the rows not fetched yet, the rows spilled
+	// are fetched before them
+	mrs     *MysqlResultSet
+	next    int
+	memSize int64
+	// spilled is the files of the rows spilled not fetched yet, rows is the
+	// rows of the file being fetched
+	spilled []string
+	nFiles  int
+	rows    [][]interface{}
+}
+
+// NewCursor returns the cursor of the statement, the rows are not spilled if fs is nil.
+func NewCursor(stmtID uint32, fs fileservice.FileService) *Cursor {
+	return &Cursor{
+		stmtID: stmtID,
+		fs:     fs,
+		path:   path.Join(cursorSpillDir, uuid.NewString()),
+		mrs:    &MysqlResultSet{},
+	}
+}
+
+func (c *Cursor) GetStmtID() uint32 {
+	return c.stmtID
+}
+
+// setColumns records the columns of the result set
+func (c *Cursor) setColumns(mrs *MysqlResultSet) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, col := range mrs.Columns {
+		c.mrs.AddColumn(col)
+	}
+}
+
+// hasColumns checks the columns of the result set have been sent
+func (c *Cursor) hasColumns() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.mrs.Columns) != 0
+}
+
+// appendRows saves the first cnt rows of the mrs.
+// The rows are copied as the buffers of the pipeline are reused.
+func (c *Cursor) appendRows(ctx context.Context, mrs *MysqlResultSet, cnt uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := uint64(0); i < cnt; i++ {
+		row := make([]interface{}, len(mrs.Data[i]))
+		for j, v := range mrs.Data[i] {
+			if b, ok := v.([]byte); ok {
+				v = append([]byte(nil), b...)
+			}
+			row[j] = v
+		}
+		c.mrs.Data = append(c.mrs.Data, row)
+		c.memSize += cursorRowSize(row)
+	}
+	if c.memSize > cursorMemoryLimit {
+		return c.spill(ctx)
+	}
+	return nil
+}
+
+// spill writes the rows in memory not fetched yet into a file
+func (c *Cursor) spill(ctx context.Context) error {
+	if c.fs == nil {
+		return moerr.NewInternalError(ctx, "the result set of the cursor exceeds %d bytes", cursorMemoryLimit)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(c.mrs.Data[c.next:]); err != nil {
+		return err
+	}
+	name := path.Join(c.path, strconv.Itoa(c.nFiles))
+	vec := fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Size: int64(buf.Len()),
+				Data: buf.Bytes(),
+			},
+		},
+	}
+	if err := c.fs.Write(ctx, vec); err != nil {
+		return err
+	}
+	c.spilled = append(c.spilled, name)
+	c.nFiles++
+	c.mrs.Data = nil
+	c.next = 0
+	c.memSize = 0
+	return nil
+}
+
+// load reads the rows of the first file spilled
+func (c *Cursor) load(ctx context.Context) error {
+	vec := &fileservice.IOVector{
+		FilePath: c.spilled[0],
+		Entries: []fileservice.IOEntry{
+			{
+				Size: -1,
+			},
+		},
+	}
+	if err := c.fs.Read(ctx, vec); err != nil {
+		return err
+	}
+	var rows [][]interface{}
+	if err := gob.NewDecoder(bytes.NewReader(vec.Entries[0].Data)).Decode(&rows); err != nil {
+		return err
+	}
+	if err := c.fs.Delete(ctx, c.spilled[0]); err != nil {
+		return err
+	}
+	c.spilled = c.spilled[1:]
+	c.rows = rows
+	return nil
+}
+
+// fetch returns at most n rows that have not been fetched and
+// whether all rows have been fetched.
+func (c *Cursor) fetch(ctx context.Context, n uint32) (*MysqlResultSet, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	mrs := &MysqlResultSet{
+		Columns:    c.mrs.Columns,
+		Name2Index: c.mrs.Name2Index,
+	}
+	for uint32(len(mrs.Data)) < n {
+		if len(c.rows) == 0 && len(c.spilled) > 0 {
+			if err := c.load(ctx); err != nil {
+				return nil, false, err
+			}
+		}
+		want := int(n) - len(mrs.Data)
+		if len(c.rows) > 0 {
+			if want > len(c.rows) {
+				want = len(c.rows)
+			}
+			mrs.Data = append(mrs.Data, c.rows[:want]...)
+			c.rows = c.rows[want:]
+			continue
+		}
+		end := c.next + want
+		if end > len(c.mrs.Data) || end < c.next {
+			end = len(c.mrs.Data)
+		}
+		if end == c.next {
+			break
+		}
+		mrs.Data = append(mrs.Data, c.mrs.Data[c.next:end]...)
+		// release the rows fetched
+		for i := c.next; i < end; i++ {
+			c.memSize -= cursorRowSize(c.mrs.Data[i])
+			c.mrs.Data[i] = nil
+		}
+		c.next = end
+	}
+	done := len(c.rows) == 0 && len(c.spilled) == 0 && c.next == len(c.mrs.Data)
+	return mrs, done, nil
+}
+
+// close removes the files of the rows spilled not fetched yet
+func (c *Cursor) close(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.mrs.Data, c.next, c.memSize, c.rows = nil, 0, 0, nil
+	if len(c.spilled) == 0 {
+		return nil
+	}
+	err := c.fs.Delete(ctx, c.spilled...)
+	c.spilled = nil
+	return err
+}
+
+// cursorRowSize returns the approximate size of the row in memory
+func cursorRowSize(row []interface{}) int64 {
+	size := int64(0)
+	for _, v := range row {
+		switch v := v.(type) {
+		case []byte:
+			size += int64(len(v))
+		case string:
+			size += int64(len(v))
+		case bytejson.ByteJson:
+			size += int64(len(v.Data))
+		}
+		size += 16
+	}
+	return size
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	ctx := context.TODO()
+	col := &MysqlColumn{}
+	col.SetName("a")
+	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	mrs := &MysqlResultSet{}
+	mrs.AddColumn(col)
+
+	cursor := NewCursor(1, nil)
+	require.False(t, cursor.hasColumns())
+	cursor.setColumns(mrs)
+	require.True(t, cursor.hasColumns())
+
+	// the buffer of the rows is reused by the output queue
+	buf := []byte("x")
+	mrs.Data = [][]interface{}{{buf}, {buf}, {nil}}
+	require.NoError(t, cursor.appendRows(ctx, mrs, 2))
+	buf[0] = 'y'
+	require.NoError(t, cursor.appendRows(ctx, mrs, 3))
+
+	res, done, err := cursor.fetch(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, uint64(2), res.GetRowCount())
+	require.Equal(t, uint64(1), res.GetColumnCount())
+	require.Equal(t, []byte("x"), res.Data[0][0])
+	require.Equal(t, []byte("x"), res.Data[1][0])
+
+	res, done, err = cursor.fetch(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, []byte("y"), res.Data[0][0])
+	require.Equal(t, []byte("y"), res.Data[1][0])
+
+	res, done, err = cursor.fetch(ctx, 2)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, uint64(1), res.GetRowCount())
+	require.Nil(t, res.Data[0][0])
+
+	res, done, err = cursor.fetch(ctx, 2)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, uint64(0), res.GetRowCount())
+}
+
+func TestCursorSpill(t *testing.T) {
+	ctx := context.TODO()
+	defer func(limit int64) {
+		cursorMemoryLimit = limit
+	}(cursorMemoryLimit)
+	cursorMemoryLimit = 150
+
+	col := &MysqlColumn{}
+	col.SetName("a")
+	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	mrs := &MysqlResultSet{}
+	mrs.AddColumn(col)
+	mrs.Data = [][]interface{}{{[]byte("0123456789")}, {nil}, {int64(1)}}
+
+	// the rows beyond the limit can not be held without a file service
+	cursor := NewCursor(1, nil)
+	cursor.setColumns(mrs)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, cursor.appendRows(ctx, mrs, 3))
+	}
+	err := cursor.appendRows(ctx, mrs, 3)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInternal))
+
+	fs, err := fileservice.NewMemoryFS(defines.LocalFileServiceName)
+	require.NoError(t, err)
+	cursor = NewCursor(1, fs)
+	cursor.setColumns(mrs)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, cursor.appendRows(ctx, mrs, 3))
+	}
+	require.NotEmpty(t, cursor.spilled)
+
+	// the rows are fetched in the order they are appended
+	var rows [][]interface{}
+	for {
+		res, done, err := cursor.fetch(ctx, 4)
+		require.NoError(t, err)
+		rows = append(rows, res.Data...)
+		if done {
+			break
+		}
+	}
+	require.Equal(t, 30, len(rows))
+	for i := 0; i < 10; i++ {
+		require.Equal(t, []byte("0123456789"), rows[i*3][0])
+		require.Nil(t, rows[i*3+1][0])
+		require.Equal(t, int64(1), rows[i*3+2][0])
+	}
+	entries, err := fs.List(ctx, cursor.path)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// closing the cursor removes the files not fetched
+	cursor = NewCursor(1, fs)
+	cursor.setColumns(mrs)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, cursor.appendRows(ctx, mrs, 3))
+	}
+	require.NoError(t, cursor.close(ctx))
+	entries, err = fs.List(ctx, cursor.path)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
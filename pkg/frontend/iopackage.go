@@ -15,7 +15,15 @@
 package frontend
 
 import (
+	"bytes"
+	"compress/zlib"
+	"context"
 	"encoding/binary"
+	"io"
+	"net"
+
+	"github.com/DataDog/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 )
 
 const (
@@ -186,3 +194,169 @@ func (bio *IOPackageImpl) ReadUint64(data []byte, pos int) (uint64, int, bool) {
 		return binary.BigEndian.Uint64(data[pos : pos+8]), pos + 8, true
 	}
 }
+
+// the compression algorithms of the compressed protocol
+const (
+	CompressionZlib = iota + 1
+	CompressionZstd
+)
+
+const (
+	// CompressedHeaderLength is the length of the header of a compressed packet.
+	// int<3> length of the compressed payload, int<1> compressed sequence id,
+	// int<3> length of the payload before compression, 0 if the payload is not compressed.
+	CompressedHeaderLength = 7
+
+	// MinCompressLength is the min length of the payload to compress,
+	// a shorter payload is sent as it is.
+	MinCompressLength = 50
+
+	// DefaultZstdCompressionLevel is the level of zstd if the client does not give a valid one
+	DefaultZstdCompressionLevel = 3
+)
+
+// compressedConn reads and writes the compressed protocol on the connection.
+// The mysql packets are the stream inside the compressed packets, a compressed packet
+// can hold several packets or a part of a packet, so the codec above reads the packets
+// out of the decompressed stream as it does on a plain connection.
+//
+// The compressed sequence id is independent of the one of the packets. The client resets it
+// at the beginning of a command, the server continues the sequence id of the last compressed
+// packet read. The reads and the writes never run at the same time on a connection.
+type compressedConn struct {
+	net.Conn
+	algorithm int
+	level     int
+	seq       uint8
+	header    [CompressedHeaderLength]byte
+	// in is the decompressed data not read yet
+	in []byte
+}
+
+func newCompressedConn(conn net.Conn, algorithm, level int) *compressedConn {
+	return &compressedConn{
+		Conn:      conn,
+		algorithm: algorithm,
+		level:     level,
+	}
+}
+
+func (c *compressedConn) Read(p []byte) (int, error) {
+	for len(c.in) == 0 {
+		if err := c.readPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.in)
+	c.in = c.in[n:]
+	return n, nil
+}
+
+// readPacket reads a compressed packet and decompresses its payload into the data not read
+func (c *compressedConn) readPacket() error {
+	if _, err := io.ReadFull(c.Conn, c.header[:]); err != nil {
+		return err
+	}
+	length := int(c.header[0]) | int(c.header[1])<<8 | int(c.header[2])<<16
+	c.seq = c.header[3] + 1
+	rawLength := int(c.header[4]) | int(c.header[5])<<8 | int(c.header[6])<<16
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.Conn, payload); err != nil {
+		return err
+	}
+	if rawLength == 0 {
+		c.in = payload
+		return nil
+	}
+	raw, err := decompressPayload(c.algorithm, payload, rawLength)
+	if err != nil {
+		return err
+	}
+	c.in = raw
+	return nil
+}
+
+func (c *compressedConn) Write(p []byte) (int, error) {
+	for i := 0; i < len(p); {
+		n := Min(int(MaxPayloadSize), len(p)-i)
+		if err := c.writePacket(p[i : i+n]); err != nil {
+			return i, err
+		}
+		i += n
+	}
+	return len(p), nil
+}
+
+// writePacket writes the data in a compressed packet,
+// the data is compressed if it's long enough and compression makes it shorter.
+func (c *compressedConn) writePacket(data []byte) error {
+	payload, rawLength := data, 0
+	if len(data) >= MinCompressLength {
+		compressed, err := compressPayload(c.algorithm, c.level, data)
+		if err != nil {
+			return err
+		}
+		if len(compressed) < len(data) {
+			payload, rawLength = compressed, len(data)
+		}
+	}
+
+	packet := make([]byte, CompressedHeaderLength+len(payload))
+	packet[0] = byte(len(payload))
+	packet[1] = byte(len(payload) >> 8)
+	packet[2] = byte(len(payload) >> 16)
+	packet[3] = c.seq
+	packet[4] = byte(rawLength)
+	packet[5] = byte(rawLength >> 8)
+	packet[6] = byte(rawLength >> 16)
+	copy(packet[CompressedHeaderLength:], payload)
+	c.seq++
+
+	_, err := c.Conn.Write(packet)
+	return err
+}
+
+func compressPayload(algorithm, level int, data []byte) ([]byte, error) {
+	switch algorithm {
+	case CompressionZlib:
+		var buf bytes.Buffer
+		w := zlib.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		return zstd.CompressLevel(nil, data, level)
+	}
+	return nil, moerr.NewInternalError(context.Background(), "unknown compression algorithm %d", algorithm)
+}
+
+func decompressPayload(algorithm int, payload []byte, rawLength int) ([]byte, error) {
+	raw := make([]byte, rawLength)
+	switch algorithm {
+	case CompressionZlib:
+		r, err := zlib.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		if _, err = io.ReadFull(r, raw); err != nil {
+			return nil, err
+		}
+		return raw, nil
+	case CompressionZstd:
+		out, err := zstd.Decompress(raw, payload)
+		if err != nil {
+			return nil, err
+		}
+		if len(out) != rawLength {
+			return nil, moerr.NewInternalError(context.Background(), "the length of the decompressed payload %d != %d", len(out), rawLength)
+		}
+		return out, nil
+	}
+	return nil, moerr.NewInternalError(context.Background(), "unknown compression algorithm %d", algorithm)
+}
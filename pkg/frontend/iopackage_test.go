@@ -15,8 +15,11 @@
 package frontend
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -28,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBasicIOPackage_WriteUint8(t *testing.T) {
@@ -367,3 +371,56 @@ func Test_AppendUint(t *testing.T) {
 		convey.So(b, convey.ShouldEqual, true)
 	})
 }
+
+func TestCompressedConn(t *testing.T) {
+	short := []byte("select 1")
+	long := bytes.Repeat([]byte("select * from t where a = 1;"), 100)
+	for _, algorithm := range []int{CompressionZlib, CompressionZstd} {
+		client, server := net.Pipe()
+		clientConn := newCompressedConn(client, algorithm, DefaultZstdCompressionLevel)
+		serverConn := newCompressedConn(server, algorithm, DefaultZstdCompressionLevel)
+
+		// a command of the client starts at the compressed sequence id 0
+		go func() {
+			_, _ = clientConn.Write(short)
+			_, _ = clientConn.Write(long)
+		}()
+		got := make([]byte, len(short)+len(long))
+		_, err := io.ReadFull(serverConn, got)
+		require.NoError(t, err)
+		require.Equal(t, append(append([]byte{}, short...), long...), got)
+		require.Equal(t, uint8(2), serverConn.seq)
+
+		// the response continues the sequence id, the long payload is compressed
+		done := make(chan []byte)
+		go func() {
+			var header [CompressedHeaderLength]byte
+			_, _ = io.ReadFull(client, header[:])
+			payload := make([]byte, int(header[0])|int(header[1])<<8|int(header[2])<<16)
+			_, _ = io.ReadFull(client, payload)
+			done <- append(header[:], payload...)
+		}()
+		_, err = serverConn.Write(long)
+		require.NoError(t, err)
+		packet := <-done
+		require.Equal(t, uint8(2), packet[3])
+		require.Equal(t, len(long), int(packet[4])|int(packet[5])<<8|int(packet[6])<<16)
+		require.Less(t, len(packet), len(long))
+		raw, err := decompressPayload(algorithm, packet[CompressedHeaderLength:], len(long))
+		require.NoError(t, err)
+		require.Equal(t, long, raw)
+
+		// the short payload is sent as it is
+		go func() {
+			_, _ = serverConn.Write(short)
+		}()
+		packet = make([]byte, CompressedHeaderLength+len(short))
+		_, err = io.ReadFull(client, packet)
+		require.NoError(t, err)
+		require.Equal(t, []byte{byte(len(short)), 0, 0, 3, 0, 0, 0}, packet[:CompressedHeaderLength])
+		require.Equal(t, short, packet[CompressedHeaderLength:])
+
+		require.NoError(t, client.Close())
+		require.NoError(t, server.Close())
+	}
+}
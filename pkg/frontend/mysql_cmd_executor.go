@@ -35,6 +35,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
@@ -72,6 +73,10 @@ func abortTransactionErrorInfo() string {
 	return "Previous DML conflicts with existing constraints or data format. This transaction has to be aborted"
 }
 
+func txnCharacteristicsInTxnErrorInfo() string {
+	return "Transaction characteristics can't be changed while a transaction is in progress."
+}
+
 func writeWriteConflictsErrorInfo() string {
 	return "Write conflicts detected. Previous transaction need to be aborted."
 }
@@ -661,6 +666,32 @@ func doSetVar(ctx context.Context, ses *Session, sv *tree.SetVar) error {
 /*
 handle setvar
 */
+// doSetTransaction sets the isolation level of the transactions. The global and session
+// levels are kept in the transaction_isolation variable.
+func doSetTransaction(ctx context.Context, ses *Session, st *tree.SetTransaction) error {
+	level := st.Modes.Isolation
+	if level == tree.ISOLATION_LEVEL_NONE {
+		return nil
+	}
+	if _, err := getTxnIsolation(ctx, level); err != nil {
+		return err
+	}
+	switch {
+	case st.Global:
+		if err := ses.SetGlobalVar("transaction_isolation", level.String()); err != nil {
+			return err
+		}
+		return ses.SetGlobalVar("tx_isolation", level.String())
+	case st.Session:
+		return ses.SetSessionVar("transaction_isolation", level.String())
+	}
+	if ses.InActiveTransaction() {
+		return moerr.NewInternalError(ctx, txnCharacteristicsInTxnErrorInfo())
+	}
+	ses.GetTxnHandler().SetNextTxnIsolation(level)
+	return nil
+}
+
 func (mce *MysqlCmdExecutor) handleSetVar(ctx context.Context, sv *tree.SetVar) error {
 	ses := mce.GetSession()
 	err := doSetVar(ctx, ses, sv)
@@ -880,25 +911,22 @@ func constructVarBatch(ses *Session, rows [][]interface{}) (*batch.Batch, error)
 	return bat, nil
 }
 
-func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt) error {
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
-		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
+// handleAnalyzeStmt collects the statistics of the table used by the optimizer
+func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt, cwIndex, cwsLen int) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	err := doAnalyzeTable(requestCtx, ses, stmt)
+	if err != nil {
+		return err
 	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	return mce.GetDoQueryFunc()(requestCtx, sql)
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.GetMysqlResultSet())
+	resp := SetNewResponse(ResultResponse, 0, int(COM_QUERY), mer, cwIndex, cwsLen)
+
+	if err := proto.SendResponse(requestCtx, resp); err != nil {
+		return moerr.NewInternalError(requestCtx, "routine send response failed. error:%v ", err)
+	}
+	return nil
 }
 
 // Note: for pass the compile quickly. We will remove the comments in the future.
@@ -1149,6 +1177,44 @@ func (mce *MysqlCmdExecutor) handleDropFunction(ctx context.Context, df *tree.Dr
 	return doDropFunction(ctx, mce.GetSession(), df)
 }
 
+func (mce *MysqlCmdExecutor) handleCreateProcedure(ctx context.Context, cp *tree.CreateProcedure) error {
+	ses := mce.GetSession()
+	tenant := ses.GetTenantInfo()
+
+	return InitProcedure(ctx, ses, tenant, cp)
+}
+
+func (mce *MysqlCmdExecutor) handleDropProcedure(ctx context.Context, dp *tree.DropProcedure) error {
+	return doDropProcedure(ctx, mce.GetSession(), dp)
+}
+
+func (mce *MysqlCmdExecutor) handleCreatePlanBaseline(ctx context.Context, cpb *tree.CreatePlanBaseline) error {
+	return doCreatePlanBaseline(ctx, mce.GetSession(), cpb)
+}
+
+func (mce *MysqlCmdExecutor) handleDropPlanBaseline(ctx context.Context, dpb *tree.DropPlanBaseline) error {
+	return doDropPlanBaseline(ctx, mce.GetSession(), dpb)
+}
+
+// handleCallProcedure runs the procedure and sends the result sets of it,
+// the OK packet after them is sent as the other statements.
+func (mce *MysqlCmdExecutor) handleCallProcedure(ctx context.Context, call *tree.CallStmt) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	results, err := doCallProcedure(ctx, ses, call)
+	if err != nil {
+		return err
+	}
+	for _, mrs := range results {
+		mer := NewMysqlExecutionResult(0, 0, 0, 0, mrs)
+		resp := NewResponse(ResultResponse, SERVER_MORE_RESULTS_EXISTS, int(COM_QUERY), mer)
+		if err = proto.SendResponse(ctx, resp); err != nil {
+			return moerr.NewInternalError(ctx, "routine send response failed. error:%v ", err)
+		}
+	}
+	return nil
+}
+
 // handleGrantRole grants the role
 func (mce *MysqlCmdExecutor) handleGrantRole(ctx context.Context, gr *tree.GrantRole) error {
 	return doGrantRole(ctx, mce.GetSession(), gr)
@@ -1358,6 +1424,7 @@ func buildPlan(requestCtx context.Context, ses *Session, ctx plan2.CompilerConte
 		}
 		return ret, err
 	}
+	applyPlanBaseline(requestCtx, ses, stmt)
 	switch stmt := stmt.(type) {
 	case *tree.Select, *tree.ParenSelect, *tree.ValuesStatement,
 		*tree.Update, *tree.Delete, *tree.Insert,
@@ -1393,6 +1460,7 @@ GetComputationWrapper gets the execs from the computation engine
 */
 var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *process.Process, ses *Session) ([]ComputationWrapper, error) {
 	var cw []ComputationWrapper = nil
+	refreshPlanBaselines(proc.Ctx, ses)
 	if cached := ses.getCachedPlan(sql); cached != nil {
 		for i, stmt := range cached.stmts {
 			tcw := InitTxnComputationWrapper(ses, stmt, proc)
@@ -1417,7 +1485,7 @@ var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *
 		if err != nil {
 			v = int64(1)
 		}
-		stmts, err = parsers.Parse(proc.Ctx, dialect.MYSQL, sql, v.(int64))
+		stmts, err = parsers.Parse(proc.Ctx, ses.GetDialect(), sql, v.(int64))
 		if err != nil {
 			return nil, err
 		}
@@ -1626,6 +1694,38 @@ func getStmtExecutor(ses *Session, proc *process.Process, base *baseStmtExecutor
 			},
 			rt: st,
 		})
+	case *tree.SavePoint:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&SavepointExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			sp: st,
+		})
+	case *tree.RollbackToSavePoint:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&RollbackToSavepointExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			rsp: st,
+		})
+	case *tree.ReleaseSavePoint:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&ReleaseSavepointExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			rsp: st,
+		})
+	case *tree.SetTransaction:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&SetTransactionExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			st: st,
+		})
 	case *tree.SetRole:
 		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
 		ret = (&SetRoleExecutor{
@@ -1946,7 +2046,7 @@ var GetStmtExecList = func(db, sql, user string, eng engine.Engine, proc *proces
 		if err != nil {
 			return nil, err
 		}
-		stmts, err = parsers.Parse(proc.Ctx, dialect.MYSQL, sql, v.(int64))
+		stmts, err = parsers.Parse(proc.Ctx, ses.GetDialect(), sql, v.(int64))
 		if err != nil {
 			return nil, err
 		}
@@ -2154,6 +2254,10 @@ func (mce *MysqlCmdExecutor) processLoadLocal(ctx context.Context, param *tree.E
 		}
 		seq = uint8(packet.SequenceID + 1)
 		proto.SetSequenceID(seq)
+		// the end of the data sent by the CopyDone of the postgresql protocol
+		if packet.Length == 0 {
+			break
+		}
 
 		writeStart := time.Now()
 		if !skipWrite {
@@ -2202,6 +2306,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	proc.Lim.BatchRows = pu.SV.ProcessLimitationBatchRows
 	proc.Lim.MaxMsgSize = pu.SV.MaxMessageSize
 	proc.Lim.PartitionRows = pu.SV.ProcessLimitationPartitionRows
+	proc.Lim.MemoryLimit = ses.getQueryMemoryLimit()
 	proc.SessionInfo = process.SessionInfo{
 		User:              ses.GetUserName(),
 		Host:              pu.SV.Host,
@@ -2288,6 +2393,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		ses.SetMysqlResultSet(&MysqlResultSet{})
 		ses.sentRows.Store(int64(0))
 		stmt := cw.GetAst()
+		ses.SetCurrentStatement(stmt)
 		sqlType := ses.sqlSourceType[0]
 		if i < len(ses.sqlSourceType) {
 			sqlType = ses.sqlSourceType[i]
@@ -2324,9 +2430,19 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 		}
 
+		// the transaction shared with another session can not be ended by the statement
+		if ses.IsShareTxn() && stmt.GetQueryType() == tree.QueryTypeTCL {
+			err = moerr.NewNotSupported(requestCtx, "transaction statement %s in the shared transaction", stmt.GetStatementType())
+			logStatementStatus(requestCtx, ses, stmt, fail, err)
+			return err
+		}
+
 		//check transaction states
-		switch stmt.(type) {
+		switch st := stmt.(type) {
 		case *tree.BeginTransaction:
+			if st.Modes.Isolation != tree.ISOLATION_LEVEL_NONE {
+				ses.GetTxnHandler().SetNextTxnIsolation(st.Modes.Isolation)
+			}
 			err = ses.TxnBegin()
 			if err != nil {
 				goto handleFailed
@@ -2342,6 +2458,26 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err != nil {
 				goto handleFailed
 			}
+		case *tree.SavePoint:
+			err = ses.TxnSavepoint(string(st.Name))
+			if err != nil {
+				goto handleFailed
+			}
+		case *tree.RollbackToSavePoint:
+			err = ses.TxnRollbackToSavepoint(string(st.Name))
+			if err != nil {
+				goto handleFailed
+			}
+		case *tree.ReleaseSavePoint:
+			err = ses.TxnReleaseSavepoint(string(st.Name))
+			if err != nil {
+				goto handleFailed
+			}
+		case *tree.SetTransaction:
+			err = doSetTransaction(requestCtx, ses, st)
+			if err != nil {
+				goto handleFailed
+			}
 		}
 
 		switch st := stmt.(type) {
@@ -2355,7 +2491,8 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		ses.GetTxnCompileCtx().SetQueryType(TXN_DEFAULT)
 
 		switch st := stmt.(type) {
-		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+			*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint, *tree.SetTransaction:
 			selfHandle = true
 		case *tree.SetRole:
 			selfHandle = true
@@ -2447,7 +2584,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 		case *tree.AnalyzeStmt:
 			selfHandle = true
-			if err = mce.handleAnalyzeStmt(requestCtx, st); err != nil {
+			if err = mce.handleAnalyzeStmt(requestCtx, st, i, len(cws)); err != nil {
 				goto handleFailed
 			}
 		case *tree.ExplainStmt:
@@ -2568,6 +2705,31 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err = mce.handleDropFunction(requestCtx, st); err != nil {
 				goto handleFailed
 			}
+		case *tree.CreateProcedure:
+			selfHandle = true
+			if err = mce.handleCreateProcedure(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropProcedure:
+			selfHandle = true
+			if err = mce.handleDropProcedure(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.CallStmt:
+			selfHandle = true
+			if err = mce.handleCallProcedure(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreatePlanBaseline:
+			selfHandle = true
+			if err = mce.handleCreatePlanBaseline(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropPlanBaseline:
+			selfHandle = true
+			if err = mce.handleDropPlanBaseline(requestCtx, st); err != nil {
+				goto handleFailed
+			}
 		case *tree.Grant:
 			selfHandle = true
 			ses.InvalidatePrivilegeCache()
@@ -2698,7 +2860,14 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				mysql COM_QUERY response: End after the column has been sent.
 				send EOF packet
 			*/
-			err = proto.SendEOFPacketIf(0, 0)
+			cursor := ses.GetCursor()
+			if cursor != nil {
+				//the result set is saved in the cursor and fetched by COM_STMT_FETCH
+				cursor.setColumns(mrs)
+				err = proto.sendEOFOrOkPacket(0, SERVER_STATUS_CURSOR_EXISTS)
+			} else {
+				err = proto.SendEOFPacketIf(0, 0)
+			}
 			if err != nil {
 				goto handleFailed
 			}
@@ -2743,9 +2912,13 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				mysql COM_QUERY response: End after the data row has been sent.
 				After all row data has been sent, it sends the EOF or OK packet.
 			*/
-			err = proto.sendEOFOrOkPacket(0, 0)
-			if err != nil {
-				goto handleFailed
+			if cursor != nil {
+				ses.OpenCursor(cursor)
+			} else {
+				err = proto.sendEOFOrOkPacket(0, 0)
+				if err != nil {
+					goto handleFailed
+				}
 			}
 
 			/*
@@ -2761,6 +2934,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			*tree.CreateSequence, *tree.DropSequence,
 			*tree.Insert, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+			*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint, *tree.SetTransaction,
 			*tree.SetVar,
 			*tree.Load,
 			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
@@ -2811,6 +2985,15 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				}
 			}
 
+			switch cw.GetAst().(type) {
+			case *tree.DropTable, *tree.TruncateTable:
+				if cwft, ok := cw.(*TxnComputationWrapper); ok {
+					if err = deleteColumnStatsOfPlan(requestCtx, ses, cwft.plan); err != nil {
+						goto handleFailed
+					}
+				}
+			}
+
 			logInfof(ses.GetDebugString(), "time of Exec.Run : %s", time.Since(runBegin).String())
 
 			rspLen = cw.GetAffectedRows()
@@ -2927,10 +3110,13 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			*tree.CreateSequence, *tree.DropSequence,
 			*tree.CreateAccount, *tree.DropAccount, *tree.AlterAccount, *tree.AlterDataBaseConfig, *tree.CreatePublication, *tree.AlterPublication, *tree.DropPublication,
 			*tree.CreateFunction, *tree.DropFunction,
+			*tree.CreateProcedure, *tree.DropProcedure, *tree.CallStmt,
+			*tree.CreatePlanBaseline, *tree.DropPlanBaseline,
 			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
 			*tree.CreateRole, *tree.DropRole, *tree.Revoke, *tree.Grant,
 			*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword, *tree.Delete, *tree.TruncateTable, *tree.Use,
-			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+			*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint, *tree.SetTransaction:
 			resp := mce.setResponse(i, len(cws), rspLen)
 			if _, ok := stmt.(*tree.Insert); ok {
 				resp.lastInsertId = proc.GetLastInsertID()
@@ -3089,6 +3275,7 @@ func (mce *MysqlCmdExecutor) doComQueryInProgress(requestCtx context.Context, sq
 	proc.Lim.Size = pu.SV.ProcessLimitationSize
 	proc.Lim.BatchRows = pu.SV.ProcessLimitationBatchRows
 	proc.Lim.PartitionRows = pu.SV.ProcessLimitationPartitionRows
+	proc.Lim.MemoryLimit = ses.getQueryMemoryLimit()
 	proc.SessionInfo = process.SessionInfo{
 		User:              ses.GetUserName(),
 		Host:              pu.SV.Host,
@@ -3246,17 +3433,56 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 		if err != nil {
 			return NewGeneralErrorResponse(COM_STMT_EXECUTE, err), nil
 		}
+
+		// executing the statement again closes its cursor
+		stmtID := binary.LittleEndian.Uint32(data[0:4])
+		ses.CloseCursor(stmtID)
+		if data[4]&CURSOR_TYPE_READ_ONLY != 0 {
+			fs, err := fileservice.Get[fileservice.FileService](ses.GetParameterUnit().FileService, defines.LocalFileServiceName)
+			if err != nil {
+				return NewGeneralErrorResponse(COM_STMT_EXECUTE, err), nil
+			}
+			cursor := NewCursor(stmtID, fs)
+			ses.SetCursor(cursor)
+			defer func() {
+				ses.SetCursor(nil)
+				// the rows spilled by a failed execution are useless
+				if opened, err := ses.GetOpenCursor(stmtID); err != nil || opened != cursor {
+					_ = cursor.close(requestCtx)
+				}
+			}()
+		}
+
 		err = doComQuery(requestCtx, sql)
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, err)
 		}
 		return resp, nil
 
+	case COM_STMT_FETCH:
+		data := req.GetData().([]byte)
+		err = mce.handleStmtFetch(requestCtx, data)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_FETCH, err)
+		}
+		return resp, nil
+
+	case COM_STMT_SEND_LONG_DATA:
+		data := req.GetData().([]byte)
+
+		// there is no response to COM_STMT_SEND_LONG_DATA
+		err = mce.parseStmtSendLongData(requestCtx, data)
+		if err != nil {
+			logErrorf(ses.GetDebugString(), "send long data failed. error:%v", err)
+		}
+		return nil, nil
+
 	case COM_STMT_CLOSE:
 		data := req.GetData().([]byte)
 
 		// rewrite to "deallocate Prepare stmt_name"
 		stmtID := binary.LittleEndian.Uint32(data[0:4])
+		ses.CloseCursor(stmtID)
 		stmtName := getPrepareStmtName(stmtID)
 		sql = fmt.Sprintf("deallocate prepare %s", stmtName)
 		logInfo(ses.GetDebugString(), "query trace", logutil.ConnectionIdField(ses.GetConnectionID()), logutil.QueryField(sql))
@@ -3272,7 +3498,11 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 
 		//Payload of COM_STMT_RESET
 		stmtID := binary.LittleEndian.Uint32(data[0:4])
+		ses.CloseCursor(stmtID)
 		stmtName := getPrepareStmtName(stmtID)
+		if preStmt, err := ses.GetPrepareStmt(stmtName); err == nil {
+			preStmt.LongData = nil
+		}
 		sql = fmt.Sprintf("reset prepare %s", stmtName)
 		logInfo(ses.GetDebugString(), "query trace", logutil.ConnectionIdField(ses.GetConnectionID()), logutil.QueryField(sql))
 		err = doComQuery(requestCtx, sql)
@@ -3281,12 +3511,87 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 		}
 		return resp, nil
 
+	case COM_RESET_CONNECTION:
+		err = ses.ResetConnection()
+		if err != nil {
+			return NewGeneralErrorResponse(COM_RESET_CONNECTION, err), nil
+		}
+		return NewGeneralOkResponse(COM_RESET_CONNECTION), nil
+
+	case COM_CHANGE_USER:
+		var dbname string
+		dbname, err = ses.GetMysqlProtocol().HandleChangeUser(requestCtx, req.GetData().([]byte))
+		if err != nil {
+			// the err packet has been sent
+			logErrorf(ses.GetDebugString(), "change user failed. error:%v", err)
+			return nil, nil
+		}
+		err = ses.ResetConnection()
+		if err != nil {
+			return NewGeneralErrorResponse(COM_CHANGE_USER, err), nil
+		}
+		ses.SetDatabaseName(dbname)
+		return NewGeneralOkResponse(COM_CHANGE_USER), nil
+
 	default:
 		resp = NewGeneralErrorResponse(req.GetCmd(), moerr.NewInternalError(requestCtx, "unsupported command. 0x%x", req.GetCmd()))
 	}
 	return resp, nil
 }
 
+// handleStmtFetch sends the rows of the cursor opened by COM_STMT_EXECUTE
+func (mce *MysqlCmdExecutor) handleStmtFetch(requestCtx context.Context, data []byte) error {
+	// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_fetch.html
+	if len(data) < 8 {
+		return moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	numRows := binary.LittleEndian.Uint32(data[4:8])
+
+	ses := mce.GetSession()
+	cursor, err := ses.GetOpenCursor(stmtID)
+	if err != nil {
+		return err
+	}
+	mrs, done, err := cursor.fetch(requestCtx, numRows)
+	if err != nil {
+		ses.CloseCursor(stmtID)
+		return err
+	}
+	proto := ses.GetMysqlProtocol()
+	if err = proto.SendResultSetTextBatchRowSpeedup(mrs, mrs.GetRowCount()); err != nil {
+		return err
+	}
+
+	status := SERVER_STATUS_CURSOR_EXISTS
+	if done {
+		ses.CloseCursor(stmtID)
+		status = SERVER_STATUS_LAST_ROW_SENT
+	}
+	return proto.sendEOFOrOkPacket(0, status)
+}
+
+// parseStmtSendLongData saves the data of the parameter for the next execution
+func (mce *MysqlCmdExecutor) parseStmtSendLongData(requestCtx context.Context, data []byte) error {
+	// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_send_long_data.html
+	if len(data) < 6 {
+		return moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	paramID := binary.LittleEndian.Uint16(data[4:6])
+
+	preStmt, err := mce.GetSession().GetPrepareStmt(getPrepareStmtName(stmtID))
+	if err != nil {
+		return err
+	}
+	if preStmt.LongData == nil {
+		preStmt.LongData = make(map[uint16][]byte)
+	}
+	// the data may be sent in several packets
+	preStmt.LongData[paramID] = append(preStmt.LongData[paramID], data[6:]...)
+	return nil
+}
+
 func (mce *MysqlCmdExecutor) parseStmtExecute(requestCtx context.Context, data []byte) (string, error) {
 	// see https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
 	pos := 0
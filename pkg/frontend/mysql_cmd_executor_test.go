@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"testing"
@@ -944,6 +945,163 @@ func Test_CMD_FIELD_LIST(t *testing.T) {
 	})
 }
 
+func Test_mce_cursor(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("cursor, long data and reset connection", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng, txnClient)
+		convey.So(err, convey.ShouldBeNil)
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		var gSys GlobalSystemVariables
+		InitGlobalSystemVariables(&gSys)
+		ses := NewSession(proto, nil, pu, &gSys, true)
+		ses.SetRequestContext(ctx)
+		ses.SetConnectContext(ctx)
+		proto.ses = ses
+		mce := NewMysqlCmdExecutor()
+		mce.SetSession(ses)
+
+		// the cursor opened by COM_STMT_EXECUTE
+		res := make9ColumnsResultSet()
+		cursor := NewCursor(1, nil)
+		cursor.setColumns(res)
+		err = cursor.appendRows(ctx, res, res.GetRowCount())
+		convey.So(err, convey.ShouldBeNil)
+		ses.OpenCursor(cursor)
+
+		fetch := func(stmtID, numRows uint32) []byte {
+			data := make([]byte, 8)
+			binary.LittleEndian.PutUint32(data, stmtID)
+			binary.LittleEndian.PutUint32(data[4:], numRows)
+			return data
+		}
+		resp, err := mce.ExecRequest(ctx, ses, &Request{cmd: COM_STMT_FETCH, data: fetch(1, 1)})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		_, err = ses.GetOpenCursor(1)
+		convey.So(err, convey.ShouldBeNil)
+
+		resp, err = mce.ExecRequest(ctx, ses, &Request{cmd: COM_STMT_FETCH, data: fetch(1, 100)})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		_, err = ses.GetOpenCursor(1)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		// all rows have been fetched
+		resp, err = mce.ExecRequest(ctx, ses, &Request{cmd: COM_STMT_FETCH, data: fetch(1, 1)})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+		convey.So(moerr.IsMoErrCode(resp.data.(error), moerr.ErrStmtHasNoOpenCursor), convey.ShouldBeTrue)
+
+		// the long data is sent in several packets
+		stmt := &PrepareStmt{Name: getPrepareStmtName(1)}
+		err = ses.SetPrepareStmt(stmt.Name, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		for _, chunk := range []string{"abc", "def"} {
+			data := []byte{1, 0, 0, 0, 0, 0}
+			data = append(data, chunk...)
+			resp, err = mce.ExecRequest(ctx, ses, &Request{cmd: COM_STMT_SEND_LONG_DATA, data: data})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp, convey.ShouldBeNil)
+		}
+		convey.So(string(stmt.LongData[0]), convey.ShouldEqual, "abcdef")
+
+		// reset connection releases the state of the session
+		err = ses.SetUserDefinedVar("a", 1)
+		convey.So(err, convey.ShouldBeNil)
+		ses.SetLastInsertID(10)
+		ses.OpenCursor(NewCursor(1, nil))
+		resp, err = mce.ExecRequest(ctx, ses, &Request{cmd: COM_RESET_CONNECTION})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, OkResponse)
+		convey.So(ses.userDefinedVars, convey.ShouldBeEmpty)
+		convey.So(ses.GetLastInsertID(), convey.ShouldEqual, 0)
+		_, err = ses.GetPrepareStmt(stmt.Name)
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = ses.GetOpenCursor(1)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(ses.OptionBitsIsSet(OPTION_AUTOCOMMIT), convey.ShouldBeTrue)
+	})
+}
+
+func Test_mce_changeUser(t *testing.T) {
+	convey.Convey("change user invalidates the privileges of the previous user", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.CreateTable{}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+		proto := ses.GetMysqlProtocol().(*MysqlProtocolImpl)
+		proto.ses = ses
+		proto.SetSkipCheckUser(true)
+		proto.capability = DefaultCapability
+		mce := NewMysqlCmdExecutor()
+		mce.SetSession(ses)
+
+		// the role 0 of root has the privilege create table, the role 1 has nothing
+		rowsOfMoRolePrivs := make([][][][]interface{}, 2)
+		for i := range rowsOfMoRolePrivs {
+			rowsOfMoRolePrivs[i] = make([][][]interface{}, len(priv.entries))
+		}
+		rowsOfMoRolePrivs[0][0] = [][]interface{}{{0, true}}
+		sql2result := makeSql2ExecResult2(0, [][]interface{}{{0, false}}, []int{0, 1}, priv.entries, rowsOfMoRolePrivs, nil, nil, nil, nil)
+		for _, entry := range priv.entries {
+			pls, err := getPrivilegeLevelsOfObjectType(context.TODO(), entry.objType)
+			convey.So(err, convey.ShouldBeNil)
+			for _, pl := range pls {
+				for _, roleId := range []int{0, 1} {
+					sql, err := getSqlForPrivilege(context.TODO(), int64(roleId), entry, pl)
+					convey.So(err, convey.ShouldBeNil)
+					var rows [][]interface{}
+					if roleId == 0 && entry.privilegeId == PrivilegeTypeCreateTable {
+						rows = [][]interface{}{{0, true}}
+					}
+					sql2result[sql] = newMrsForWithGrantOptionPrivilege(rows)
+				}
+			}
+		}
+		for _, roleId := range []int64{0, 1} {
+			sql2result[getSqlForInheritedRoleIdOfRoleId(roleId)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		}
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		ctx := ses.GetRequestContext()
+		ok, err := authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ctx, ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+
+		data := []byte("u1\x00")
+		data = append(data, 0)
+		data = append(data, 0)
+		resp, err := mce.ExecRequest(ctx, ses, &Request{cmd: COM_CHANGE_USER, data: data})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, OkResponse)
+		convey.So(ses.GetTenantInfo().GetUser(), convey.ShouldEqual, "u1")
+
+		// the ids of the user are loaded from mo_user when the password is checked
+		ses.GetTenantInfo().UserID = 1
+		ses.GetTenantInfo().DefaultRoleID = 1
+		ses.priv = priv
+		ok, err = authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ctx, ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
+
 func Test_fakeoutput(t *testing.T) {
 	convey.Convey("fake outout", t, func() {
 		mrs := &MysqlResultSet{}
@@ -18,6 +18,8 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -53,7 +55,9 @@ var DefaultCapability = CLIENT_LONG_PASSWORD |
 	CLIENT_MULTI_RESULTS |
 	CLIENT_PLUGIN_AUTH |
 	CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA |
-	CLIENT_DEPRECATE_EOF
+	CLIENT_DEPRECATE_EOF |
+	CLIENT_COMPRESS |
+	CLIENT_ZSTD_COMPRESSION_ALGORITHM
 
 // DefaultClientConnStatus default server status
 var DefaultClientConnStatus = SERVER_STATUS_AUTOCOMMIT
@@ -137,6 +141,8 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -180,6 +186,9 @@ type MysqlProtocol interface {
 	GetStats() string
 
 	ParseExecuteData(ctx context.Context, stmt *PrepareStmt, data []byte, pos int) (names []string, vars []any, err error)
+
+	//HandleChangeUser authenticates the user of COM_CHANGE_USER and returns the database
+	HandleChangeUser(ctx context.Context, payload []byte) (string, error)
 }
 
 var _ MysqlProtocol = &MysqlProtocolImpl{}
@@ -303,6 +312,12 @@ type MysqlProtocolImpl struct {
 
 	//skip checking the password of the user
 	skipCheckUser bool
+
+	//the authentication method of the client
+	authPlugin string
+
+	//the compression level of zstd asked by the client
+	zstdLevel int
 }
 
 func (mp *MysqlProtocolImpl) GetSession() *Session {
@@ -399,6 +414,7 @@ type response41 struct {
 	database          string
 	clientPluginName  string
 	isAskForTlsHeader bool
+	zstdLevel         uint8
 }
 
 // handshake response 320
@@ -500,12 +516,16 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 		err = moerr.NewInternalError(requestCtx, "malform packet")
 		return
 	}
-	if flag != 0 {
-		// TODO only support CURSOR_TYPE_NO_CURSOR flag now
+	if flag != CURSOR_TYPE_NO_CURSOR && flag != CURSOR_TYPE_READ_ONLY {
+		// only read only cursor is supported
 		err = moerr.NewInvalidInput(requestCtx, "unsupported Prepare flag '%v'", flag)
 		return
 	}
 
+	// the parameters sent by COM_STMT_SEND_LONG_DATA are used by this execution only
+	longData := stmt.LongData
+	stmt.LongData = nil
+
 	// skip iteration-count, always 1
 	pos += 4
 
@@ -540,14 +560,6 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 			varName := getPrepareStmtSessionVarName(i)
 			names[i] = varName
 
-			// TODO :if params had received via COM_STMT_SEND_LONG_DATA, use them directly.
-			// ref https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
-
-			if nullBitmaps[i>>3]&(1<<(uint(i)%8)) > 0 {
-				vars[i] = nil
-				continue
-			}
-
 			if (i<<1)+1 >= len(stmt.ParamTypes) {
 				err = moerr.NewInvalidInput(requestCtx, "mysql protocol error, malformed packet")
 				return
@@ -555,6 +567,23 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 			tp := stmt.ParamTypes[i<<1]
 			isUnsigned := (stmt.ParamTypes[(i<<1)+1] & 0x80) > 0
 
+			// the value of the param received via COM_STMT_SEND_LONG_DATA is not in the packet.
+			// ref https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
+			if val, ok := longData[uint16(i)]; ok {
+				switch defines.MysqlType(tp) {
+				case defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB:
+					vars[i] = val
+				default:
+					vars[i] = string(val)
+				}
+				continue
+			}
+
+			if nullBitmaps[i>>3]&(1<<(uint(i)%8)) > 0 {
+				vars[i] = nil
+				continue
+			}
+
 			switch defines.MysqlType(tp) {
 			case defines.MYSQL_TYPE_NULL:
 				vars[i] = nil
@@ -1069,6 +1098,130 @@ func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
 	return bytes.Equal(hash1, auth)
 }
 
+// the status of the AuthMoreData packet of caching_sha2_password
+const (
+	cachingSha2FastAuthSuccess           uint8 = 3
+	cachingSha2PerformFullAuthentication uint8 = 4
+)
+
+// sha2Cache caches SHA256(SHA256(password)) of the users authenticated by caching_sha2_password
+// with the full authentication. The users in it can be authenticated by the scramble only.
+var sha2Cache = struct {
+	sync.Mutex
+	hashes map[string][]byte
+}{hashes: make(map[string][]byte)}
+
+func getSha2Cache(user string) []byte {
+	sha2Cache.Lock()
+	defer sha2Cache.Unlock()
+	return sha2Cache.hashes[user]
+}
+
+func setSha2Cache(user string, hash []byte) {
+	sha2Cache.Lock()
+	defer sha2Cache.Unlock()
+	if hash == nil {
+		delete(sha2Cache.hashes, user)
+	} else {
+		sha2Cache.hashes[user] = hash
+	}
+}
+
+// Algorithm: SHA256( SHA256( password ) )
+func cachingSha2Hash(password []byte) []byte {
+	hash1 := sha256.Sum256(password)
+	hash2 := sha256.Sum256(hash1[:])
+	return hash2[:]
+}
+
+// the server checks the scramble of caching_sha2_password with the cached hash of the password.
+// Algorithm: scramble = SHA256( password ) XOR SHA256( SHA256( SHA256( password ) ) + salt )
+// so SHA256( scramble XOR SHA256( hash + salt ) ) must be the hash.
+func checkCachingSha2Scramble(hash, salt, scramble []byte) bool {
+	if len(scramble) != sha256.Size {
+		return false
+	}
+	sha := sha256.New()
+	sha.Write(hash)
+	sha.Write(salt)
+	hash1 := sha.Sum(nil)
+	for i := range hash1 {
+		hash1[i] ^= scramble[i]
+	}
+	hash2 := sha256.Sum256(hash1)
+	return bytes.Equal(hash2[:], hash)
+}
+
+// isSecureConnection returns true if the connection is over TLS
+func (mp *MysqlProtocolImpl) isSecureConnection() bool {
+	_, ok := mp.tcpConn.RawConn().(*tls.Conn)
+	return ok
+}
+
+// acceptAuthPlugin returns true if the server authenticates the user with the authentication
+// method of the client. caching_sha2_password needs the password in the full authentication,
+// it's accepted if the connection is secure or the fast authentication can be done.
+func (mp *MysqlProtocolImpl) acceptAuthPlugin(plugin, user string) bool {
+	switch plugin {
+	case AuthNativePassword:
+		return true
+	case AuthCachingSha2Password:
+		return mp.isSecureConnection() || getSha2Cache(user) != nil
+	}
+	return false
+}
+
+// the server authenticates the user with caching_sha2_password.
+// The fast authentication checks the scramble with the cached hash of the password. Otherwise,
+// the server asks for the full authentication, the client sends the password in clear text on
+// the secure connection and the hash of the password is cached.
+func (mp *MysqlProtocolImpl) authenticateCachingSha2(ctx context.Context, password, scramble []byte) error {
+	user := mp.GetUserName()
+	if len(scramble) == 0 {
+		if len(password) == 0 {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+
+	hash := cachingSha2Hash(password)
+	if cached := getSha2Cache(user); cached != nil {
+		if bytes.Equal(cached, hash) && checkCachingSha2Scramble(hash, mp.GetSalt(), scramble) {
+			logDebugf(mp.getDebugStringUnsafe(), "caching_sha2_password fast authentication succeeded")
+			return mp.writePackets(mp.makeAuthMoreDataPayload(cachingSha2FastAuthSuccess))
+		}
+		// the password is changed or wrong
+		setSha2Cache(user, nil)
+	}
+
+	if !mp.isSecureConnection() {
+		return moerr.NewInternalError(ctx, "caching_sha2_password full authentication requires a secure connection")
+	}
+	if err := mp.writePackets(mp.makeAuthMoreDataPayload(cachingSha2PerformFullAuthentication)); err != nil {
+		return err
+	}
+	data, err := mp.readAuthPacket(ctx)
+	if err != nil {
+		return err
+	}
+	// string[NUL] password
+	data = bytes.TrimSuffix(data, []byte{0})
+	if !bytes.Equal(data, password) {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	setSha2Cache(user, hash)
+	return nil
+}
+
+// the server makes a AuthMoreData packet with the status of the authentication
+func (mp *MysqlProtocolImpl) makeAuthMoreDataPayload(status uint8) []byte {
+	data := make([]byte, HeaderOffset+2)
+	pos := HeaderOffset
+	pos = mp.io.WriteUint8(data, pos, 1)
+	pos = mp.io.WriteUint8(data, pos, status)
+	return data[:pos]
+}
+
 // the server authenticate that the client can connect and use the database
 func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse []byte) error {
 	var psw []byte
@@ -1085,7 +1238,12 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 		logDebugf(mp.getDebugStringUnsafe(), "authenticate user 2")
 
 		//TO Check password
-		if mp.checkPassword(psw, mp.GetSalt(), authResponse) {
+		if mp.authPlugin == AuthCachingSha2Password {
+			if err = mp.authenticateCachingSha2(ctx, psw, authResponse); err != nil {
+				return err
+			}
+			logInfof(mp.getDebugStringUnsafe(), "check password succeeded")
+		} else if mp.checkPassword(psw, mp.GetSalt(), authResponse) {
 			logInfof(mp.getDebugStringUnsafe(), "check password succeeded")
 		} else {
 			return moerr.NewInternalError(ctx, "check password failed")
@@ -1137,6 +1295,8 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 
 		authResponse = resp41.authResponse
 		mp.capability = mp.capability & resp41.capabilities
+		mp.authPlugin = resp41.clientPluginName
+		mp.zstdLevel = int(resp41.zstdLevel)
 
 		if nameAndCharset, ok3 := collationID2CharsetAndName[int(resp41.collationID)]; !ok3 {
 			return false, moerr.NewInternalError(ctx, "get collationName and charset failed")
@@ -1191,9 +1351,146 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 	if err != nil {
 		return false, err
 	}
+	mp.enableCompression()
 	return false, nil
 }
 
+// enableCompression switches the connection to the compressed protocol if the client asks for it.
+// The packets after the OK packet of the handshake are compressed.
+func (mp *MysqlProtocolImpl) enableCompression() {
+	var algorithm, level int
+	if mp.capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		algorithm = CompressionZstd
+		level = mp.zstdLevel
+		if level < 1 || level > 22 {
+			level = DefaultZstdCompressionLevel
+		}
+	} else if mp.capability&CLIENT_COMPRESS != 0 {
+		algorithm = CompressionZlib
+	} else {
+		return
+	}
+	logDebugf(mp.getDebugStringUnsafe(), "enable compressed protocol, algorithm %d level %d", algorithm, level)
+	mp.tcpConn.UseConn(newCompressedConn(mp.tcpConn.RawConn(), algorithm, level))
+}
+
+// HandleChangeUser handles the payload of COM_CHANGE_USER and returns the database.
+// On failure, the err packet has been sent and the connection keeps the previous user.
+func (mp *MysqlProtocolImpl) HandleChangeUser(ctx context.Context, payload []byte) (string, error) {
+	info, err := mp.analyseChangeUser(ctx, payload)
+	if err == nil {
+		err = mp.changeUser(ctx, info)
+	}
+	if err != nil {
+		logutil.Errorf("change user failed.error:%v", err)
+		fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
+		msg := fmt.Sprintf("Access denied for user %s. %s", info.username, err.Error())
+		if err2 := mp.sendErrPacket(fail.ErrorCode, fail.SqlStates[0], msg); err2 != nil {
+			logutil.Errorf("send err packet failed.error:%v", err2)
+			return "", err2
+		}
+		return "", err
+	}
+	return info.database, nil
+}
+
+// the server analyses the payload of COM_CHANGE_USER.
+// See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_change_user.html
+func (mp *MysqlProtocolImpl) analyseChangeUser(ctx context.Context, payload []byte) (response41, error) {
+	var info response41
+	var ok bool
+	pos := 0
+
+	//string[NUL]    user
+	info.username, pos, ok = mp.readStringNUL(payload, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get username failed")
+	}
+
+	//auth-response
+	if (mp.capability & CLIENT_SECURE_CONNECTION) != 0 {
+		var l uint8
+		l, pos, ok = mp.io.ReadUint8(payload, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth-response length failed")
+		}
+		info.authResponse, pos, ok = mp.readCountOfBytes(payload, pos, int(l))
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+	} else {
+		var auth string
+		auth, pos, ok = mp.readStringNUL(payload, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+		info.authResponse = []byte(auth)
+	}
+
+	//string[NUL]    schema-name
+	info.database, pos, ok = mp.readStringNUL(payload, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get database failed")
+	}
+
+	//int<2>         character-set
+	info.collationID = uint8(mp.collationID)
+	if pos < len(payload) {
+		var id uint16
+		if id, pos, ok = mp.io.ReadUint16(payload, pos); ok {
+			info.collationID = uint8(id)
+		}
+	}
+
+	//string[NUL]    auth plugin name
+	info.clientPluginName = AuthNativePassword
+	if (mp.capability&CLIENT_PLUGIN_AUTH) != 0 && pos < len(payload) {
+		if info.clientPluginName, _, ok = mp.readStringNUL(payload, pos); !ok {
+			return info, moerr.NewInternalError(ctx, "get auth plugin name failed")
+		}
+	}
+	return info, nil
+}
+
+// the server authenticates the user of COM_CHANGE_USER with the salt of the handshake
+func (mp *MysqlProtocolImpl) changeUser(ctx context.Context, info response41) error {
+	var err error
+	prevUser, prevPlugin := mp.username, mp.authPlugin
+	var prevTenant *TenantInfo
+	ses := mp.GetSession()
+	if ses != nil {
+		prevTenant = ses.GetTenantInfo()
+	}
+
+	mp.username = info.username
+	mp.authPlugin = info.clientPluginName
+	authResponse := info.authResponse
+	if !mp.acceptAuthPlugin(mp.authPlugin, mp.username) {
+		if authResponse, err = mp.negotiateAuthenticationMethod(ctx); err == nil {
+			mp.authPlugin = AuthNativePassword
+		}
+	}
+	if err == nil {
+		err = mp.authenticateUser(ctx, authResponse)
+	}
+	if err != nil {
+		mp.username, mp.authPlugin = prevUser, prevPlugin
+		if ses != nil {
+			ses.SetTenantInfo(prevTenant)
+			ses.UpdateDebugString()
+		}
+		return err
+	}
+
+	if nameAndCharset, ok := collationID2CharsetAndName[int(info.collationID)]; ok {
+		mp.collationID = int(info.collationID)
+		mp.collationName = nameAndCharset.collationName
+		mp.charset = nameAndCharset.charset
+	}
+	mp.database = info.database
+	return nil
+}
+
 // the server makes a handshake v10 packet
 // return handshake packet
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
@@ -1350,22 +1647,33 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 	}
 
 	if (info.capabilities & CLIENT_PLUGIN_AUTH) != 0 {
-		info.clientPluginName, _, ok = mp.readStringNUL(data, pos)
+		info.clientPluginName, pos, ok = mp.readStringNUL(data, pos)
 		if !ok {
 			return false, info, moerr.NewInternalError(ctx, "get auth plugin name failed")
 		}
+	}
 
-		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
-			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
-				return false, info, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
-			}
-			info.clientPluginName = AuthNativePassword
+	//drop client connection attributes
+	if (info.capabilities & CLIENT_CONNECT_ATTRS) != 0 {
+		if l, next, ok2 := mp.readIntLenEnc(data, pos); ok2 && l <= uint64(len(data)-next) {
+			pos = next + int(l)
 		}
 	}
 
-	//drop client connection attributes
+	//int<1>             zstd compression level
+	if (info.capabilities&CLIENT_ZSTD_COMPRESSION_ALGORITHM) != 0 && pos < len(data) {
+		info.zstdLevel = data[pos]
+	}
+
+	//to switch authenticate method
+	if (info.capabilities&CLIENT_PLUGIN_AUTH) != 0 && !mp.acceptAuthPlugin(info.clientPluginName, info.username) {
+		var err error
+		if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
+			return false, info, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
+		}
+		info.clientPluginName = AuthNativePassword
+	}
+
 	return true, info, nil
 }
 
@@ -1514,6 +1822,11 @@ func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context)
 		return nil, err
 	}
 
+	return mp.readAuthPacket(ctx)
+}
+
+// the server reads the packet of the client in the authentication
+func (mp *MysqlProtocolImpl) readAuthPacket(ctx context.Context) ([]byte, error) {
 	read, err := mp.tcpConn.Read(goetty.ReadOptions{})
 	if err != nil {
 		return nil, err
@@ -2158,8 +2471,8 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 	var err error = nil
 
 	binary := false
-	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE use binaryRow
-	if CommandType(cmd) == COM_STMT_EXECUTE {
+	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE and COM_STMT_FETCH use binaryRow
+	if CommandType(cmd) == COM_STMT_EXECUTE || CommandType(cmd) == COM_STMT_FETCH {
 		binary = true
 	}
 
@@ -17,10 +17,13 @@ package frontend
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
 	"database/sql"
 	"encoding/binary"
 	"fmt"
 	"math"
+	"net"
 	"reflect"
 	"strconv"
 	"sync"
@@ -2134,6 +2137,48 @@ func TestParseExecuteData(t *testing.T) {
 		convey.ShouldEqual(vars[0], 10)
 	})
 
+	convey.Convey("parseExecuteData with long data and cursor", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		st := tree.NewPrepareString(tree.Identifier(getPrepareStmtName(1)), "select ?, ?")
+		stmts, err := mysql.Parse(ctx, st.Sql, 1)
+		convey.So(err, convey.ShouldBeNil)
+		preparePlan, err := buildPlan(context.TODO(), nil, plan.NewEmptyCompilerContext(), st)
+		convey.So(err, convey.ShouldBeNil)
+		prepareStmt := &PrepareStmt{
+			Name:        preparePlan.GetDcl().GetPrepare().GetName(),
+			PreparePlan: preparePlan,
+			PrepareStmt: stmts[0],
+			LongData:    map[uint16][]byte{0: []byte("long data")},
+		}
+
+		var testData []byte
+		testData = append(testData, CURSOR_TYPE_READ_ONLY) //flag
+		testData = append(testData, 0, 0, 0, 0)            // skip iteration-count
+		testData = append(testData, 0)                     //nullBitmap
+		testData = append(testData, 1)                     // new param bound flag
+		testData = append(testData, uint8(defines.MYSQL_TYPE_BLOB), 0)
+		testData = append(testData, uint8(defines.MYSQL_TYPE_TINY), 0)
+		// the value of the first param is sent by COM_STMT_SEND_LONG_DATA
+		testData = append(testData, 10)
+
+		_, vars, err := proto.ParseExecuteData(ctx, prepareStmt, testData, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(vars, convey.ShouldResemble, []any{[]byte("long data"), int8(10)})
+		convey.So(prepareStmt.LongData, convey.ShouldBeNil)
+
+		testData[0] = CURSOR_TYPE_FOR_UPDATE
+		_, _, err = proto.ParseExecuteData(ctx, prepareStmt, testData, 0)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
 }
 
 func Test_resultset(t *testing.T) {
@@ -2640,3 +2685,153 @@ func TestMysqlProtocolImpl_Close(t *testing.T) {
 	assert.Nil(t, proto.lenEncBuffer)
 	assert.Nil(t, proto.binaryNullBuffer)
 }
+
+// scrambleCachingSha2 is the scramble of the client for caching_sha2_password
+func scrambleCachingSha2(password, salt []byte) []byte {
+	hash1 := sha256.Sum256(password)
+	hash2 := sha256.Sum256(hash1[:])
+	hash3 := sha256.Sum256(append(hash2[:], salt...))
+	for i := range hash1 {
+		hash1[i] ^= hash3[i]
+	}
+	return hash1[:]
+}
+
+func Test_cachingSha2Password(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	plain, _ := net.Pipe()
+	secure := tls.Server(plain, &tls.Config{})
+	var rawConn net.Conn = plain
+	var written [][]byte
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	ioses.EXPECT().RawConn().DoAndReturn(func() net.Conn { return rawConn }).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg any, _ goetty.WriteOptions) error {
+		written = append(written, msg.([]byte)[HeaderLengthOfTheProtocol:])
+		return nil
+	}).AnyTimes()
+	ioses.EXPECT().Read(gomock.Any()).Return(&Packet{Payload: []byte("111\x00")}, nil).AnyTimes()
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+
+	proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+	proto.SetUserName("sha2_user")
+	proto.authPlugin = AuthCachingSha2Password
+	password := []byte("111")
+	scramble := scrambleCachingSha2(password, proto.GetSalt())
+	setSha2Cache("sha2_user", nil)
+
+	// the full authentication needs a secure connection
+	require.False(t, proto.acceptAuthPlugin(AuthCachingSha2Password, "sha2_user"))
+	require.Error(t, proto.authenticateCachingSha2(ctx, password, scramble))
+	require.Empty(t, written)
+
+	// the client sends the password in clear text
+	rawConn = secure
+	require.True(t, proto.acceptAuthPlugin(AuthCachingSha2Password, "sha2_user"))
+	require.NoError(t, proto.authenticateCachingSha2(ctx, password, scramble))
+	require.Equal(t, [][]byte{{1, cachingSha2PerformFullAuthentication}}, written)
+	require.NotNil(t, getSha2Cache("sha2_user"))
+	require.Error(t, proto.authenticateCachingSha2(ctx, []byte("222"), scrambleCachingSha2([]byte("222"), proto.GetSalt())))
+	setSha2Cache("sha2_user", cachingSha2Hash(password))
+
+	// the user cached is authenticated by the scramble on a plain connection
+	rawConn = plain
+	written = nil
+	require.True(t, proto.acceptAuthPlugin(AuthCachingSha2Password, "sha2_user"))
+	require.NoError(t, proto.authenticateCachingSha2(ctx, password, scramble))
+	require.Equal(t, [][]byte{{1, cachingSha2FastAuthSuccess}}, written)
+
+	// a wrong scramble falls back to the full authentication
+	written = nil
+	require.Error(t, proto.authenticateCachingSha2(ctx, password, scrambleCachingSha2([]byte("222"), proto.GetSalt())))
+	require.Nil(t, getSha2Cache("sha2_user"))
+	require.Empty(t, written)
+
+	// the other methods are switched to mysql_native_password
+	require.True(t, proto.acceptAuthPlugin(AuthNativePassword, "sha2_user"))
+	require.False(t, proto.acceptAuthPlugin("sha256_password", "sha2_user"))
+}
+
+func Test_analyse41respCompression(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+	capabilities := CLIENT_PROTOCOL_41 | CLIENT_PLUGIN_AUTH | CLIENT_CONNECT_ATTRS | CLIENT_COMPRESS | CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	data := proto.io.AppendUint32(nil, capabilities)
+	data = append(data, 0xff, 0xff, 0xff, 0xff, 0x2d)
+	data = append(data, make([]byte, 23)...)
+	data = append(data, "abc\x00"...)
+	data = append(data, 0)
+	data = append(data, AuthNativePassword+"\x00"...)
+	//connection attributes
+	data = append(data, 4, 1, 'k', 1, 'v')
+	//zstd compression level
+	data = append(data, 7)
+
+	ok, resp41, err := proto.analyseHandshakeResponse41(context.TODO(), data)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, AuthNativePassword, resp41.clientPluginName)
+	require.Equal(t, uint8(7), resp41.zstdLevel)
+
+	// the connection switches to the compressed protocol after the handshake
+	plain, _ := net.Pipe()
+	var used net.Conn
+	ioses.EXPECT().RawConn().Return(plain).AnyTimes()
+	ioses.EXPECT().UseConn(gomock.Any()).Do(func(conn net.Conn) { used = conn }).AnyTimes()
+	proto.capability = DefaultCapability & resp41.capabilities
+	proto.zstdLevel = int(resp41.zstdLevel)
+	proto.enableCompression()
+	require.Equal(t, CompressionZstd, used.(*compressedConn).algorithm)
+	require.Equal(t, 7, used.(*compressedConn).level)
+
+	proto.capability = DefaultCapability & (CLIENT_PROTOCOL_41 | CLIENT_COMPRESS)
+	proto.enableCompression()
+	require.Equal(t, CompressionZlib, used.(*compressedConn).algorithm)
+}
+
+func Test_handleChangeUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+	proto.SetSkipCheckUser(true)
+	proto.capability = DefaultCapability
+	proto.username = "root"
+
+	//user, auth response, database, collation and auth plugin
+	data := []byte("dump\x00")
+	data = append(data, 0)
+	data = append(data, "db1\x00"...)
+	data = append(data, 0x21, 0)
+	data = append(data, AuthNativePassword+"\x00"...)
+
+	db, err := proto.HandleChangeUser(context.TODO(), data)
+	require.NoError(t, err)
+	require.Equal(t, "db1", db)
+	require.Equal(t, "dump", proto.GetUserName())
+	require.Equal(t, "db1", proto.GetDatabaseName())
+	require.Equal(t, 0x21, proto.collationID)
+
+	// malformed packet keeps the user
+	_, err = proto.HandleChangeUser(context.TODO(), []byte("root"))
+	require.Error(t, err)
+	require.Equal(t, "dump", proto.GetUserName())
+}
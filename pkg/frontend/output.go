@@ -117,6 +117,13 @@ func (oq *outputQueue) flush() error {
 			return nil
 		}
 
+		//the rows of the cursor are sent by COM_STMT_FETCH
+		if cursor := oq.ses.GetCursor(); cursor != nil && cursor.hasColumns() {
+			err := cursor.appendRows(oq.ses.GetRequestContext(), oq.mrs, oq.rowIdx)
+			oq.rowIdx = 0
+			return err
+		}
+
 		if err := oq.proto.SendResultSetTextBatchRowSpeedup(oq.mrs, oq.rowIdx); err != nil {
 			logErrorf(oq.ses.GetDebugString(), "flush error %v", err)
 			return err
@@ -0,0 +1,113 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/fagongzi/goetty/v2/codec"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// the max length of the startup message, the same as postgresql
+	pgMaxStartupLength = 10000
+	// the max length of the other messages
+	pgMaxMessageLength = 1 << 30
+)
+
+// pgMessage is a message of the postgresql frontend protocol.
+// The typ of the StartupMessage, the SSLRequest and the CancelRequest is 0.
+type pgMessage struct {
+	typ     byte
+	payload []byte
+}
+
+func NewPgCodec() codec.Codec {
+	return &pgCodec{}
+}
+
+// pgCodec decodes the messages of the postgresql frontend protocol.
+// The messages without type can only be the first message of the connection
+// and their first byte, the highest byte of the length, is always 0.
+//
+// CopyData and CopyDone are decoded into the Packet, so that the COPY FROM STDIN
+// reads the data as the LOAD DATA LOCAL INFILE does. CopyDone is the Packet
+// with the length 0.
+type pgCodec struct {
+}
+
+func (c *pgCodec) Decode(in *buf.ByteBuf) (interface{}, bool, error) {
+	readable := in.Readable()
+	if readable < 1 {
+		return nil, false, nil
+	}
+
+	var typ byte
+	headerLen := 4
+	maxLength := pgMaxStartupLength
+	if header := in.PeekN(0, 1); header[0] != 0 {
+		typ = header[0]
+		headerLen = 5
+		maxLength = pgMaxMessageLength
+	}
+	if readable < headerLen {
+		return nil, false, nil
+	}
+
+	// the length includes itself but not the type
+	length := int(binary.BigEndian.Uint32(in.PeekN(headerLen-4, 4)))
+	if length < 4 || length > maxLength {
+		return nil, false, moerr.NewInvalidInput(context.Background(), "invalid message length %d", length)
+	}
+	if readable < headerLen-4+length {
+		return nil, false, nil
+	}
+
+	in.Skip(headerLen)
+	var payload []byte
+	if length > 4 {
+		in.SetMarkIndex(in.GetReadIndex() + length - 4)
+		payload = in.ReadMarkedData()
+	}
+
+	switch typ {
+	case pgCopyData:
+		return &Packet{Length: int32(length), Payload: payload}, true, nil
+	case pgCopyDone:
+		return &Packet{Length: 0}, true, nil
+	case pgCopyFail:
+		msg, _, _ := bytes.Cut(payload, []byte{0})
+		return nil, false, moerr.NewInternalError(context.Background(), "COPY from stdin failed: %s", msg)
+	}
+	return &pgMessage{typ: typ, payload: payload}, true, nil
+}
+
+func (c *pgCodec) Encode(data interface{}, out *buf.ByteBuf, writer io.Writer) error {
+	x := data.([]byte)
+	xlen := len(x)
+	tlen, err := out.Write(x)
+	if err != nil {
+		return err
+	}
+	if tlen != xlen {
+		return errorLenOfWrittenNotEqLenOfData
+	}
+	return nil
+}
@@ -0,0 +1,1396 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+// the types of the messages of the postgresql v3 protocol
+const (
+	// frontend
+	pgBind      byte = 'B'
+	pgClose     byte = 'C'
+	pgCopyData  byte = 'd'
+	pgCopyDone  byte = 'c'
+	pgCopyFail  byte = 'f'
+	pgDescribe  byte = 'D'
+	pgExecute   byte = 'E'
+	pgFlush     byte = 'H'
+	pgParse     byte = 'P'
+	pgPassword  byte = 'p'
+	pgQuery     byte = 'Q'
+	pgSync      byte = 'S'
+	pgTerminate byte = 'X'
+
+	// backend
+	pgAuthentication       byte = 'R'
+	pgBackendKeyData       byte = 'K'
+	pgBindComplete         byte = '2'
+	pgCloseComplete        byte = '3'
+	pgCommandComplete      byte = 'C'
+	pgCopyInResponse       byte = 'G'
+	pgDataRow              byte = 'D'
+	pgEmptyQueryResponse   byte = 'I'
+	pgErrorResponse        byte = 'E'
+	pgNoData               byte = 'n'
+	pgParameterDescription byte = 't'
+	pgParameterStatus      byte = 'S'
+	pgParseComplete        byte = '1'
+	pgReadyForQuery        byte = 'Z'
+	pgRowDescription       byte = 'T'
+)
+
+// the codes of the messages without type
+const (
+	pgProtocolVersion3  uint32 = 196608
+	pgCancelRequestCode uint32 = 80877102
+	pgSSLRequestCode    uint32 = 80877103
+	pgGSSENCRequestCode uint32 = 80877104
+)
+
+const (
+	pgAuthOK          uint32 = 0
+	pgAuthMD5Password uint32 = 5
+)
+
+const (
+	pgFormatText   int16 = 0
+	pgFormatBinary int16 = 1
+)
+
+// the oids of the postgresql types
+const (
+	pgTypeBool        uint32 = 16
+	pgTypeBytea       uint32 = 17
+	pgTypeInt8        uint32 = 20
+	pgTypeInt2        uint32 = 21
+	pgTypeInt4        uint32 = 23
+	pgTypeText        uint32 = 25
+	pgTypeJson        uint32 = 114
+	pgTypeFloat4      uint32 = 700
+	pgTypeFloat8      uint32 = 701
+	pgTypeVarchar     uint32 = 1043
+	pgTypeDate        uint32 = 1082
+	pgTypeTime        uint32 = 1083
+	pgTypeTimestamp   uint32 = 1114
+	pgTypeTimestamptz uint32 = 1184
+	pgTypeNumeric     uint32 = 1700
+	pgTypeUuid        uint32 = 2950
+)
+
+// the epoch of the binary date and timestamp
+var (
+	pgEpoch     = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	pgEpochDate = types.DateFromCalendar(2000, 1, 1)
+)
+
+const pgTimestampLayout = "2006-01-02 15:04:05.999999999"
+
+// pgStatement is the statement prepared by the Parse message
+type pgStatement struct {
+	stmtID uint32
+	// the prepared statement, nil for the empty query and
+	// the statement executed directly
+	prepareStmt *PrepareStmt
+	// the sql of the statement that can not be prepared
+	sql string
+	// paramOrder[i] is the n of the $n that the i-th ? of the statement comes from
+	paramOrder []int
+	// the types of $1, $2 ...
+	paramTypes []uint32
+}
+
+// pgPortal is the statement bound with the parameters by the Bind message
+type pgPortal struct {
+	stmt          *pgStatement
+	paramFormats  []int16
+	params        [][]byte
+	resultFormats []int16
+}
+
+// format returns the format code of the i-th of the values
+func pgFormat(formats []int16, i int) int16 {
+	if len(formats) == 0 {
+		return pgFormatText
+	}
+	if len(formats) == 1 {
+		return formats[0]
+	}
+	if i >= len(formats) {
+		return pgFormatText
+	}
+	return formats[i]
+}
+
+var _ MysqlProtocol = &PgProtocolImpl{}
+
+// PgProtocolImpl implements the MysqlProtocol with the postgresql v3 protocol.
+// The MysqlCmdExecutor runs the sql from the postgresql clients and the
+// packets it sends are translated into the postgresql messages.
+type PgProtocolImpl struct {
+	ProtocolImpl
+
+	ses *Session
+
+	SV *config.FrontendParameters
+
+	skipCheckUser bool
+
+	username string
+	database string
+	// the parameters of the StartupMessage
+	params map[string]string
+	// the secret key of the BackendKeyData checked by the CancelRequest
+	secretKey uint32
+
+	bytesInOutBuffer          int
+	untilBytesInOutbufToFlush int
+
+	// the columns and the rows of the result set being sent
+	columns []*MysqlColumn
+	rows    uint64
+	// the portal being executed, nil for the simple query
+	portal *pgPortal
+	// the statement being prepared by the Parse message
+	prepared *pgStatement
+	// the ErrorResponse has been sent for the message being handled
+	errorSent bool
+
+	statements map[string]*pgStatement
+	portals    map[string]*pgPortal
+}
+
+func NewPgProtocol(connectionID uint32, tcp goetty.IOSession, maxBytesToFlush int, SV *config.FrontendParameters) *PgProtocolImpl {
+	salt := generate_salt(4)
+	return &PgProtocolImpl{
+		ProtocolImpl: ProtocolImpl{
+			io:           NewIOPackage(false),
+			tcpConn:      tcp,
+			salt:         salt,
+			connectionID: connectionID,
+		},
+		SV:                        SV,
+		secretKey:                 binary.BigEndian.Uint32(generate_salt(4)),
+		untilBytesInOutbufToFlush: maxBytesToFlush * 1024,
+		statements:                make(map[string]*pgStatement),
+		portals:                   make(map[string]*pgPortal),
+	}
+}
+
+func (mp *PgProtocolImpl) SetSession(ses *Session) {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mp.ses = ses
+}
+
+func (mp *PgProtocolImpl) GetSession() *Session {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.ses
+}
+
+func (mp *PgProtocolImpl) SetSkipCheckUser(b bool) {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mp.skipCheckUser = b
+}
+
+func (mp *PgProtocolImpl) GetDatabaseName() string {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.database
+}
+
+func (mp *PgProtocolImpl) SetDatabaseName(s string) {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mp.database = s
+}
+
+func (mp *PgProtocolImpl) GetUserName() string {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.username
+}
+
+func (mp *PgProtocolImpl) SetUserName(s string) {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mp.username = s
+}
+
+func (mp *PgProtocolImpl) isErrorSent() bool {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.errorSent
+}
+
+func (mp *PgProtocolImpl) setErrorSent(b bool) {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mp.errorSent = b
+}
+
+func (mp *PgProtocolImpl) setPortal(portal *pgPortal) {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mp.portal = portal
+}
+
+func (mp *PgProtocolImpl) setPrepared(stmt *pgStatement) {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mp.prepared = stmt
+}
+
+func (mp *PgProtocolImpl) getStatement(name string) *pgStatement {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.statements[name]
+}
+
+// setStatement saves the statement of the name, nil removes it
+func (mp *PgProtocolImpl) setStatement(name string, stmt *pgStatement) {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	if stmt == nil {
+		delete(mp.statements, name)
+		return
+	}
+	mp.statements[name] = stmt
+}
+
+func (mp *PgProtocolImpl) getPortalByName(name string) *pgPortal {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.portals[name]
+}
+
+// setPortalByName saves the portal of the name, nil removes it
+func (mp *PgProtocolImpl) setPortalByName(name string, portal *pgPortal) {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	if portal == nil {
+		delete(mp.portals, name)
+		return
+	}
+	mp.portals[name] = portal
+}
+
+func (mp *PgProtocolImpl) GetCapability() uint32 {
+	return 0
+}
+
+func (mp *PgProtocolImpl) GetRequest(payload []byte) *Request {
+	return &Request{
+		cmd:  COM_QUERY,
+		data: payload,
+	}
+}
+
+func (mp *PgProtocolImpl) ResetStatistics() {}
+
+func (mp *PgProtocolImpl) GetStats() string {
+	return ""
+}
+
+func (mp *PgProtocolImpl) HandleChangeUser(ctx context.Context, payload []byte) (string, error) {
+	return "", moerr.NewNotSupported(ctx, "change user in the postgresql protocol")
+}
+
+// HandleHandshake handles the StartupMessage.
+// It returns true if the client has to send the password by the PasswordMessage.
+func (mp *PgProtocolImpl) HandleHandshake(ctx context.Context, payload []byte) (bool, error) {
+	if len(payload) < 4 || binary.BigEndian.Uint32(payload) != pgProtocolVersion3 {
+		return false, moerr.NewInvalidInput(ctx, "unsupported frontend protocol")
+	}
+	params := make(map[string]string)
+	for data := payload[4:]; len(data) > 0 && data[0] != 0; {
+		var key, val []byte
+		key, data, _ = bytes.Cut(data, []byte{0})
+		val, data, _ = bytes.Cut(data, []byte{0})
+		params[string(key)] = string(val)
+	}
+	if params["user"] == "" {
+		return false, moerr.NewInvalidInput(ctx, "no user name specified in the startup packet")
+	}
+
+	mp.m.Lock()
+	mp.params = params
+	mp.username = params["user"]
+	mp.database = params["database"]
+	skipCheckUser := mp.skipCheckUser
+	mp.m.Unlock()
+
+	if skipCheckUser {
+		return false, mp.authenticate(ctx, nil)
+	}
+	data := pgBeginMessage(nil, pgAuthentication)
+	data = binary.BigEndian.AppendUint32(data, pgAuthMD5Password)
+	data = append(data, mp.GetSalt()...)
+	return true, mp.writeAndFlush(pgEndMessage(data, 0))
+}
+
+// authenticate checks the response of the PasswordMessage and finishes the startup
+// if the authentication succeeds. Otherwise, the ErrorResponse is sent.
+func (mp *PgProtocolImpl) authenticate(ctx context.Context, password []byte) error {
+	err := mp.authenticateUser(ctx, password)
+	if err != nil {
+		logutil.Errorf("authenticate user failed.error:%v", err)
+		msg := fmt.Sprintf("password authentication failed for user %s. %s", mp.GetUserName(), err.Error())
+		mp.m.Lock()
+		defer mp.m.Unlock()
+		if err2 := mp.writeUnsafe(makePgErrorResponse("28P01", msg)); err2 != nil {
+			return err2
+		}
+		if err2 := mp.flushUnsafe(); err2 != nil {
+			return err2
+		}
+		return err
+	}
+
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	data := pgBeginMessage(nil, pgAuthentication)
+	data = binary.BigEndian.AppendUint32(data, pgAuthOK)
+	data = pgEndMessage(data, 0)
+	for _, param := range [][2]string{
+		{"server_version", "13.0"},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"IntervalStyle", "postgres"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+		{"TimeZone", "UTC"},
+		{"is_superuser", "off"},
+		{"session_authorization", mp.username},
+		{"application_name", mp.params["application_name"]},
+	} {
+		start := len(data)
+		data = pgBeginMessage(data, pgParameterStatus)
+		data = pgAppendString(data, param[0])
+		data = pgAppendString(data, param[1])
+		data = pgEndMessage(data, start)
+	}
+	start := len(data)
+	data = pgBeginMessage(data, pgBackendKeyData)
+	data = binary.BigEndian.AppendUint32(data, mp.connectionID)
+	data = binary.BigEndian.AppendUint32(data, mp.secretKey)
+	data = pgEndMessage(data, start)
+	if err = mp.writeUnsafe(data); err != nil {
+		return err
+	}
+	return mp.sendReadyForQueryUnsafe()
+}
+
+func (mp *PgProtocolImpl) authenticateUser(ctx context.Context, password []byte) error {
+	ses := mp.GetSession()
+	username := mp.GetUserName()
+	mp.m.Lock()
+	skipCheckUser := mp.skipCheckUser
+	mp.m.Unlock()
+	if !skipCheckUser {
+		psw, err := ses.AuthenticateUser(username)
+		if err != nil {
+			return err
+		}
+		password, _, _ = bytes.Cut(password, []byte{0})
+		if !bytes.Equal(password, pgMD5Password(psw, []byte(username), mp.GetSalt())) {
+			return moerr.NewInternalError(ctx, "check password failed")
+		}
+	} else {
+		tenant, err := GetTenantInfo(ctx, username)
+		if err != nil {
+			return err
+		}
+		if ses != nil {
+			ses.SetTenantInfo(tenant)
+		}
+	}
+	logInfof(mp.GetDebugString(), "check password succeeded")
+	return nil
+}
+
+// pgMD5Password computes the response of the md5 authentication:
+// "md5" + md5(hex(md5(password + user)) + salt)
+func pgMD5Password(password, user, salt []byte) []byte {
+	hash := md5.Sum(append(append([]byte{}, password...), user...))
+	hash = md5.Sum(append([]byte(hex.EncodeToString(hash[:])), salt...))
+	return []byte("md5" + hex.EncodeToString(hash[:]))
+}
+
+// checkCancelRequest checks the pid and the secret key of the CancelRequest
+func (mp *PgProtocolImpl) checkCancelRequest(secretKey uint32) bool {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.secretKey == secretKey
+}
+
+// SendPrepareResponse records the statement prepared by the Parse message
+// and sends the ParseComplete.
+func (mp *PgProtocolImpl) SendPrepareResponse(ctx context.Context, stmt *PrepareStmt) error {
+	dcPrepare, ok := stmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return moerr.NewInternalError(ctx, "can not get Prepare plan in prepareStmt")
+	}
+	stmtID, err := GetPrepareStmtID(ctx, stmt.Name)
+	if err != nil {
+		return moerr.NewInternalError(ctx, "can not get Prepare stmtID")
+	}
+
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	prepared := mp.prepared
+	if prepared == nil {
+		return moerr.NewInternalError(ctx, "no statement is being prepared")
+	}
+	paramTypes := dcPrepare.Prepare.ParamTypes
+	if len(prepared.paramOrder) != len(paramTypes) {
+		return moerr.NewInternalError(ctx, "the statement has %d parameters, but %d are found", len(prepared.paramOrder), len(paramTypes))
+	}
+	prepared.stmtID = uint32(stmtID)
+	prepared.prepareStmt = stmt
+	for i, n := range prepared.paramOrder {
+		for len(prepared.paramTypes) < n {
+			prepared.paramTypes = append(prepared.paramTypes, 0)
+		}
+		if prepared.paramTypes[n-1] == 0 {
+			prepared.paramTypes[n-1] = pgTypeOfEngineType(ctx, types.T(paramTypes[i]))
+		}
+	}
+	for i, oid := range prepared.paramTypes {
+		if oid == 0 {
+			prepared.paramTypes[i] = pgTypeText
+		}
+	}
+	return mp.writeUnsafe(pgEndMessage(pgBeginMessage(nil, pgParseComplete), 0))
+}
+
+// ParseExecuteData returns the parameters of the portal being executed.
+func (mp *PgProtocolImpl) ParseExecuteData(ctx context.Context, stmt *PrepareStmt, data []byte, pos int) (names []string, vars []any, err error) {
+	mp.m.Lock()
+	portal := mp.portal
+	mp.m.Unlock()
+	if portal == nil {
+		err = moerr.NewInternalError(ctx, "no portal is being executed")
+		return
+	}
+	pgStmt := portal.stmt
+	names = make([]string, len(pgStmt.paramOrder))
+	vars = make([]any, len(pgStmt.paramOrder))
+	for i, n := range pgStmt.paramOrder {
+		if n > len(portal.params) {
+			err = moerr.NewInvalidInput(ctx, "bind message supplies %d parameters, but prepared statement requires %d", len(portal.params), len(pgStmt.paramTypes))
+			return
+		}
+		names[i] = getPrepareStmtSessionVarName(i)
+		vars[i], err = pgParamValue(ctx, pgStmt.paramTypes[n-1], pgFormat(portal.paramFormats, n-1), portal.params[n-1])
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (mp *PgProtocolImpl) SendColumnCountPacket(count uint64) error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mp.columns = make([]*MysqlColumn, 0, count)
+	mp.rows = 0
+	return nil
+}
+
+func (mp *PgProtocolImpl) SendColumnDefinitionPacket(ctx context.Context, column Column, cmd int) error {
+	mysqlColumn, ok := column.(*MysqlColumn)
+	if !ok {
+		return moerr.NewInternalError(ctx, "sendColumn need MysqlColumn")
+	}
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mp.columns = append(mp.columns, mysqlColumn)
+	return nil
+}
+
+// SendEOFPacketIf sends the RowDescription after the columns.
+// The RowDescription of the portal is sent by the Describe message.
+func (mp *PgProtocolImpl) SendEOFPacketIf(warnings uint16, status uint16) error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	if mp.portal != nil {
+		return nil
+	}
+	return mp.writeUnsafe(makePgRowDescription(mp.columns, nil))
+}
+
+func (mp *PgProtocolImpl) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	return mp.SendResultSetTextBatchRowSpeedup(mrs, cnt)
+}
+
+// SendResultSetTextBatchRowSpeedup sends the rows by the DataRow in the formats
+// requested by the Bind message.
+func (mp *PgProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.sendRowsUnsafe(mrs, cnt)
+}
+
+func (mp *PgProtocolImpl) sendRowsUnsafe(mrs *MysqlResultSet, cnt uint64) error {
+	ctx := mp.ses.GetRequestContext()
+	var formats []int16
+	if mp.portal != nil {
+		formats = mp.portal.resultFormats
+	}
+	var data []byte
+	var err error
+	for r := uint64(0); r < cnt; r++ {
+		start := len(data)
+		data = pgBeginMessage(data, pgDataRow)
+		data = binary.BigEndian.AppendUint16(data, uint16(mrs.GetColumnCount()))
+		for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+			column, err := mrs.GetColumn(ctx, i)
+			if err != nil {
+				return err
+			}
+			mysqlColumn, ok := column.(*MysqlColumn)
+			if !ok {
+				return moerr.NewInternalError(ctx, "sendColumn need MysqlColumn")
+			}
+			data, err = appendPgValue(ctx, data, mrs, mysqlColumn, r, i, pgFormat(formats, int(i)))
+			if err != nil {
+				return err
+			}
+		}
+		data = pgEndMessage(data, start)
+		if len(data) >= mp.untilBytesInOutbufToFlush {
+			if err = mp.writeUnsafe(data); err != nil {
+				return err
+			}
+			data = nil
+		}
+	}
+	mp.rows += cnt
+	if len(data) > 0 {
+		err = mp.writeUnsafe(data)
+	}
+	return err
+}
+
+func (mp *PgProtocolImpl) sendOKPacket(affectedRows uint64, lastInsertId uint64, status uint16, warnings uint16, message string) error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.sendCommandCompleteUnsafe(affectedRows)
+}
+
+// sendEOFOrOkPacket sends the CommandComplete after the rows
+func (mp *PgProtocolImpl) sendEOFOrOkPacket(warnings uint16, status uint16) error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.sendCommandCompleteUnsafe(mp.rows)
+}
+
+// sendLocalInfileRequest sends the CopyInResponse for the COPY FROM STDIN.
+// The client sends the data by the CopyData and ends it by the CopyDone.
+func (mp *PgProtocolImpl) sendLocalInfileRequest(filename string) error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	data := pgBeginMessage(nil, pgCopyInResponse)
+	// the text format without the formats of the columns
+	data = append(data, 0, 0, 0)
+	if err := mp.writeUnsafe(pgEndMessage(data, 0)); err != nil {
+		return err
+	}
+	return mp.flushUnsafe()
+}
+
+func (mp *PgProtocolImpl) SendResponse(ctx context.Context, resp *Response) error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+
+	switch resp.category {
+	case OkResponse:
+		return mp.sendCommandCompleteUnsafe(resp.affectedRows)
+	case EoFResponse:
+		return mp.sendCommandCompleteUnsafe(mp.rows)
+	case ErrorResponse:
+		err, _ := resp.data.(error)
+		if err == nil {
+			return mp.sendCommandCompleteUnsafe(0)
+		}
+		mp.errorSent = true
+		return mp.writeUnsafe(makePgErrorResponse(pgSqlState(err), err.Error()))
+	case ResultResponse:
+		mer := resp.data.(*MysqlExecutionResult)
+		if mer == nil {
+			return mp.sendCommandCompleteUnsafe(0)
+		}
+		if mer.Mrs() == nil {
+			return mp.sendCommandCompleteUnsafe(mer.AffectedRows())
+		}
+		mrs := mer.Mrs()
+		mp.columns = mp.columns[:0]
+		mp.rows = 0
+		for _, column := range mrs.Columns {
+			mysqlColumn, ok := column.(*MysqlColumn)
+			if !ok {
+				return moerr.NewInternalError(ctx, "sendColumn need MysqlColumn")
+			}
+			mp.columns = append(mp.columns, mysqlColumn)
+		}
+		if mp.portal == nil {
+			if err := mp.writeUnsafe(makePgRowDescription(mp.columns, nil)); err != nil {
+				return err
+			}
+		}
+		if err := mp.sendRowsUnsafe(mrs, mrs.GetRowCount()); err != nil {
+			return err
+		}
+		return mp.sendCommandCompleteUnsafe(mp.rows)
+	case LocalInfileRequest:
+		return moerr.NewInternalError(ctx, "unsupported response:%d ", resp.category)
+	default:
+		return moerr.NewInternalError(ctx, "unsupported response:%d ", resp.category)
+	}
+}
+
+func (mp *PgProtocolImpl) sendCommandCompleteUnsafe(rows uint64) error {
+	data := pgBeginMessage(nil, pgCommandComplete)
+	data = pgAppendString(data, pgCommandTag(mp.currentStatement(), rows))
+	return mp.writeUnsafe(pgEndMessage(data, 0))
+}
+
+// currentStatement returns the statement being executed.
+// For the EXECUTE, it is the prepared statement.
+func (mp *PgProtocolImpl) currentStatement() tree.Statement {
+	if mp.ses == nil {
+		return nil
+	}
+	stmt := mp.ses.GetCurrentStatement()
+	if execute, ok := stmt.(*tree.Execute); ok {
+		if prepareStmt, err := mp.ses.GetPrepareStmt(string(execute.Name)); err == nil {
+			stmt = prepareStmt.PrepareStmt
+		}
+	}
+	return stmt
+}
+
+// pgCommandTag makes the tag of the CommandComplete
+func pgCommandTag(stmt tree.Statement, rows uint64) string {
+	switch stmt.(type) {
+	case nil:
+		return ""
+	case *tree.Insert:
+		return fmt.Sprintf("INSERT 0 %d", rows)
+	case *tree.Update:
+		return fmt.Sprintf("UPDATE %d", rows)
+	case *tree.Delete:
+		return fmt.Sprintf("DELETE %d", rows)
+	case *tree.Load:
+		return fmt.Sprintf("COPY %d", rows)
+	case *tree.BeginTransaction:
+		return "BEGIN"
+	case *tree.CommitTransaction:
+		return "COMMIT"
+	case *tree.RollbackTransaction:
+		return "ROLLBACK"
+	case *tree.SetVar, *tree.SetTransaction:
+		return "SET"
+	case *tree.Use:
+		return "USE"
+	}
+	if stmt.GetQueryType() == tree.QueryTypeDQL || stmt.GetStatementType() == "Select" {
+		return fmt.Sprintf("SELECT %d", rows)
+	}
+	return strings.ToUpper(stmt.GetStatementType())
+}
+
+// sendRowDescription sends the RowDescription or the NoData for the Describe message
+func (mp *PgProtocolImpl) sendRowDescription(ctx context.Context, stmt *pgStatement, formats []int16) error {
+	var columns []*MysqlColumn
+	var err error
+	if stmt.prepareStmt != nil {
+		columns, err = pgColumnsOfPrepareStmt(ctx, stmt.prepareStmt)
+		if err != nil {
+			return err
+		}
+	}
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	if len(columns) == 0 {
+		return mp.writeUnsafe(pgEndMessage(pgBeginMessage(nil, pgNoData), 0))
+	}
+	return mp.writeUnsafe(makePgRowDescription(columns, formats))
+}
+
+// sendParameterDescription sends the ParameterDescription for the Describe message
+func (mp *PgProtocolImpl) sendParameterDescription(stmt *pgStatement) error {
+	data := pgBeginMessage(nil, pgParameterDescription)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(stmt.paramTypes)))
+	for _, oid := range stmt.paramTypes {
+		data = binary.BigEndian.AppendUint32(data, oid)
+	}
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.writeUnsafe(pgEndMessage(data, 0))
+}
+
+// sendMessage sends the message that has no content
+func (mp *PgProtocolImpl) sendMessage(typ byte) error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.writeUnsafe(pgEndMessage(pgBeginMessage(nil, typ), 0))
+}
+
+// sendError sends the ErrorResponse of the err
+func (mp *PgProtocolImpl) sendError(err error) error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mp.errorSent = true
+	return mp.writeUnsafe(makePgErrorResponse(pgSqlState(err), err.Error()))
+}
+
+// sendReadyForQuery sends the ReadyForQuery and flushes the messages to the client
+func (mp *PgProtocolImpl) sendReadyForQuery() error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.sendReadyForQueryUnsafe()
+}
+
+func (mp *PgProtocolImpl) sendReadyForQueryUnsafe() error {
+	status := byte('I')
+	if mp.ses != nil && mp.ses.InActiveMultiStmtTransaction() {
+		status = 'T'
+	}
+	data := pgBeginMessage(nil, pgReadyForQuery)
+	data = append(data, status)
+	if err := mp.writeUnsafe(pgEndMessage(data, 0)); err != nil {
+		return err
+	}
+	return mp.flushUnsafe()
+}
+
+func (mp *PgProtocolImpl) writeAndFlush(data []byte) error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	if err := mp.writeUnsafe(data); err != nil {
+		return err
+	}
+	return mp.flushUnsafe()
+}
+
+func (mp *PgProtocolImpl) flush() error {
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	return mp.flushUnsafe()
+}
+
+// writeUnsafe writes the data into the outbuf, the outbuf is flushed
+// when it is full or the client waits for the response.
+func (mp *PgProtocolImpl) writeUnsafe(data []byte) error {
+	if err := mp.tcpConn.Write(data, goetty.WriteOptions{}); err != nil {
+		return err
+	}
+	mp.bytesInOutBuffer += len(data)
+	if mp.bytesInOutBuffer >= mp.untilBytesInOutbufToFlush {
+		return mp.flushUnsafe()
+	}
+	return nil
+}
+
+func (mp *PgProtocolImpl) flushUnsafe() error {
+	mp.bytesInOutBuffer = 0
+	return mp.tcpConn.Flush(0)
+}
+
+// pgBeginMessage appends the type and the placeholder of the length of the message
+func pgBeginMessage(data []byte, typ byte) []byte {
+	return append(data, typ, 0, 0, 0, 0)
+}
+
+// pgEndMessage fills the length of the message beginning at data[start]
+func pgEndMessage(data []byte, start int) []byte {
+	binary.BigEndian.PutUint32(data[start+1:], uint32(len(data)-start-1))
+	return data
+}
+
+// pgAppendString appends the null terminated string
+func pgAppendString(data []byte, s string) []byte {
+	return append(append(data, s...), 0)
+}
+
+// pgReadString reads the null terminated string
+func pgReadString(data []byte, pos int) (string, int, bool) {
+	if pos > len(data) {
+		return "", 0, false
+	}
+	end := bytes.IndexByte(data[pos:], 0)
+	if end < 0 {
+		return "", 0, false
+	}
+	return string(data[pos : pos+end]), pos + end + 1, true
+}
+
+func makePgErrorResponse(sqlState, msg string) []byte {
+	data := pgBeginMessage(nil, pgErrorResponse)
+	data = pgAppendString(append(data, 'S'), "ERROR")
+	data = pgAppendString(append(data, 'V'), "ERROR")
+	data = pgAppendString(append(data, 'C'), sqlState)
+	data = pgAppendString(append(data, 'M'), msg)
+	data = append(data, 0)
+	return pgEndMessage(data, 0)
+}
+
+func makePgRowDescription(columns []*MysqlColumn, formats []int16) []byte {
+	data := pgBeginMessage(nil, pgRowDescription)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(columns)))
+	for i, column := range columns {
+		oid := pgTypeOfColumn(column)
+		data = pgAppendString(data, column.Name())
+		// the oid of the table and the number of the column
+		data = binary.BigEndian.AppendUint32(data, 0)
+		data = binary.BigEndian.AppendUint16(data, 0)
+		data = binary.BigEndian.AppendUint32(data, oid)
+		data = binary.BigEndian.AppendUint16(data, uint16(pgTypeLen(oid)))
+		// the type modifier
+		data = binary.BigEndian.AppendUint32(data, math.MaxUint32)
+		data = binary.BigEndian.AppendUint16(data, uint16(pgFormat(formats, i)))
+	}
+	return pgEndMessage(data, 0)
+}
+
+// pgSqlState maps the error to the SQLSTATE of postgresql
+func pgSqlState(err error) string {
+	var moErr *moerr.Error
+	if !errors.As(err, &moErr) {
+		return "XX000"
+	}
+	switch moErr.ErrorCode() {
+	case moerr.ErrSyntaxError, moerr.ErrParseError:
+		return "42601"
+	case moerr.ErrQueryInterrupted:
+		return "57014"
+	case moerr.ErrDivByZero:
+		return "22012"
+	case moerr.ErrNotSupported, moerr.ErrNYI:
+		return "0A000"
+	}
+	switch moErr.MySQLCode() {
+	case moerr.ER_DUP_ENTRY:
+		return "23505"
+	case moerr.ER_BAD_NULL_ERROR:
+		return "23502"
+	case moerr.ER_NO_SUCH_TABLE:
+		return "42P01"
+	case moerr.ER_BAD_FIELD_ERROR:
+		return "42703"
+	case moerr.ER_TABLE_EXISTS_ERROR:
+		return "42P07"
+	case moerr.ER_BAD_DB_ERROR:
+		return "3D000"
+	case moerr.ER_DB_CREATE_EXISTS:
+		return "42P04"
+	case moerr.ER_ACCESS_DENIED_ERROR:
+		return "28P01"
+	case moerr.ER_PARSE_ERROR, moerr.ER_SYNTAX_ERROR:
+		return "42601"
+	}
+	return "XX000"
+}
+
+// newPgColumn makes the column of the type as TxnComputationWrapper.GetColumns does
+func newPgColumn(ctx context.Context, name string, typ types.T) (*MysqlColumn, error) {
+	column := new(MysqlColumn)
+	column.SetName(name)
+	if err := convertEngineTypeToMysqlType(ctx, typ, column); err != nil {
+		return nil, err
+	}
+	setCharacter(column)
+	if typ == types.T_binary || typ == types.T_varbinary {
+		column.SetCharset(0x3f)
+	}
+	convertMysqlTextTypeToBlobType(column)
+	return column, nil
+}
+
+func pgColumnsOfPrepareStmt(ctx context.Context, stmt *PrepareStmt) ([]*MysqlColumn, error) {
+	dcPrepare, ok := stmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "can not get Prepare plan in prepareStmt")
+	}
+	colDefs := plan2.GetResultColumnsFromPlan(dcPrepare.Prepare.Plan)
+	columns := make([]*MysqlColumn, len(colDefs))
+	for i, colDef := range colDefs {
+		column, err := newPgColumn(ctx, colDef.Name, types.T(colDef.Typ.Id))
+		if err != nil {
+			return nil, err
+		}
+		columns[i] = column
+	}
+	return columns, nil
+}
+
+func pgTypeOfEngineType(ctx context.Context, typ types.T) uint32 {
+	column, err := newPgColumn(ctx, "", typ)
+	if err != nil {
+		return pgTypeText
+	}
+	return pgTypeOfColumn(column)
+}
+
+// pgTypeOfColumn returns the oid of the postgresql type of the column
+func pgTypeOfColumn(column *MysqlColumn) uint32 {
+	switch column.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		return pgTypeBool
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_YEAR:
+		return pgTypeInt2
+	case defines.MYSQL_TYPE_SHORT:
+		if !column.IsSigned() {
+			return pgTypeInt4
+		}
+		return pgTypeInt2
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+		if !column.IsSigned() {
+			return pgTypeInt8
+		}
+		return pgTypeInt4
+	case defines.MYSQL_TYPE_LONGLONG:
+		if !column.IsSigned() {
+			return pgTypeNumeric
+		}
+		return pgTypeInt8
+	case defines.MYSQL_TYPE_FLOAT:
+		return pgTypeFloat4
+	case defines.MYSQL_TYPE_DOUBLE:
+		return pgTypeFloat8
+	case defines.MYSQL_TYPE_DECIMAL:
+		return pgTypeNumeric
+	case defines.MYSQL_TYPE_DATE:
+		return pgTypeDate
+	case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		return pgTypeTimestamp
+	case defines.MYSQL_TYPE_TIME:
+		return pgTypeTime
+	case defines.MYSQL_TYPE_UUID:
+		return pgTypeUuid
+	case defines.MYSQL_TYPE_JSON:
+		return pgTypeJson
+	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_BLOB:
+		// the binary charset
+		if column.Charset() == 0x3f {
+			return pgTypeBytea
+		}
+		return pgTypeText
+	default:
+		return pgTypeText
+	}
+}
+
+// pgTypeLen returns the length of the type, -1 for the variable length types
+func pgTypeLen(oid uint32) int16 {
+	switch oid {
+	case pgTypeBool:
+		return 1
+	case pgTypeInt2:
+		return 2
+	case pgTypeInt4, pgTypeFloat4, pgTypeDate:
+		return 4
+	case pgTypeInt8, pgTypeFloat8, pgTypeTime, pgTypeTimestamp:
+		return 8
+	case pgTypeUuid:
+		return 16
+	default:
+		return -1
+	}
+}
+
+// appendPgValue appends the length and the value of the column i of the row r
+func appendPgValue(ctx context.Context, data []byte, mrs *MysqlResultSet, column *MysqlColumn, r, i uint64, format int16) ([]byte, error) {
+	if isNil, err := mrs.ColumnIsNull(ctx, r, i); err != nil {
+		return nil, err
+	} else if isNil {
+		return binary.BigEndian.AppendUint32(data, math.MaxUint32), nil
+	}
+	start := len(data)
+	data = append(data, 0, 0, 0, 0)
+	var err error
+	if format == pgFormatBinary {
+		data, err = appendPgBinaryValue(ctx, data, mrs, pgTypeOfColumn(column), r, i)
+	} else {
+		data, err = appendPgTextValue(ctx, data, mrs, pgTypeOfColumn(column), r, i)
+	}
+	if err != nil {
+		return nil, err
+	}
+	binary.BigEndian.PutUint32(data[start:], uint32(len(data)-start-4))
+	return data, nil
+}
+
+func appendPgTextValue(ctx context.Context, data []byte, mrs *MysqlResultSet, oid uint32, r, i uint64) ([]byte, error) {
+	value, err := mrs.GetValue(ctx, r, i)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return append(data, 't'), nil
+		}
+		return append(data, 'f'), nil
+	case float32:
+		return strconv.AppendFloat(data, float64(v), 'g', -1, 32), nil
+	case float64:
+		return strconv.AppendFloat(data, v, 'g', -1, 64), nil
+	case []byte:
+		if oid == pgTypeBytea {
+			data = append(data, '\\', 'x')
+			return append(data, hex.EncodeToString(v)...), nil
+		}
+		return append(data, v...), nil
+	case types.Date:
+		return append(data, v.String()...), nil
+	}
+	s, err := mrs.GetString(ctx, r, i)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, s...), nil
+}
+
+func appendPgBinaryValue(ctx context.Context, data []byte, mrs *MysqlResultSet, oid uint32, r, i uint64) ([]byte, error) {
+	switch oid {
+	case pgTypeBool:
+		value, err := mrs.GetValue(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		if b, ok := value.(bool); ok && b {
+			return append(data, 1), nil
+		} else if !ok {
+			v, err := mrs.GetInt64(ctx, r, i)
+			if err != nil {
+				return nil, err
+			}
+			if v != 0 {
+				return append(data, 1), nil
+			}
+		}
+		return append(data, 0), nil
+	case pgTypeInt2, pgTypeInt4, pgTypeInt8:
+		v, err := mrs.GetInt64(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		switch oid {
+		case pgTypeInt2:
+			return binary.BigEndian.AppendUint16(data, uint16(v)), nil
+		case pgTypeInt4:
+			return binary.BigEndian.AppendUint32(data, uint32(v)), nil
+		}
+		return binary.BigEndian.AppendUint64(data, uint64(v)), nil
+	case pgTypeFloat4, pgTypeFloat8:
+		value, err := mrs.GetValue(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		var v float64
+		switch val := value.(type) {
+		case float32:
+			v = float64(val)
+		case float64:
+			v = val
+		default:
+			s, err := mrs.GetString(ctx, r, i)
+			if err != nil {
+				return nil, err
+			}
+			if v, err = strconv.ParseFloat(s, 64); err != nil {
+				return nil, moerr.NewInvalidInput(ctx, "invalid float %s", s)
+			}
+		}
+		if oid == pgTypeFloat4 {
+			return binary.BigEndian.AppendUint32(data, math.Float32bits(float32(v))), nil
+		}
+		return binary.BigEndian.AppendUint64(data, math.Float64bits(v)), nil
+	case pgTypeDate:
+		value, err := mrs.GetValue(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		date, ok := value.(types.Date)
+		if !ok {
+			return nil, moerr.NewInternalError(ctx, "invalid date %v", value)
+		}
+		return binary.BigEndian.AppendUint32(data, uint32(int32(date)-int32(pgEpochDate))), nil
+	}
+
+	s, err := mrs.GetString(ctx, r, i)
+	if err != nil {
+		return nil, err
+	}
+	switch oid {
+	case pgTypeNumeric:
+		return appendPgNumeric(ctx, data, s)
+	case pgTypeTimestamp:
+		t, err := time.Parse(pgTimestampLayout, s)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid timestamp %s", s)
+		}
+		return binary.BigEndian.AppendUint64(data, uint64(t.UnixMicro()-pgEpoch.UnixMicro())), nil
+	case pgTypeTime:
+		v, err := parsePgTime(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(data, uint64(v)), nil
+	case pgTypeUuid:
+		v, err := types.ParseUuid(s)
+		if err != nil {
+			return nil, err
+		}
+		return append(data, v[:]...), nil
+	}
+	// text, json and bytea are the same as the text format
+	return append(data, s...), nil
+}
+
+// parsePgTime parses the [-]hh:mm:ss[.ffffff] into the microseconds
+func parsePgTime(ctx context.Context, s string) (int64, error) {
+	neg := strings.HasPrefix(s, "-")
+	parts := strings.Split(strings.TrimPrefix(s, "-"), ":")
+	if len(parts) != 3 {
+		return 0, moerr.NewInvalidInput(ctx, "invalid time %s", s)
+	}
+	hour, err1 := strconv.ParseInt(parts[0], 10, 64)
+	minute, err2 := strconv.ParseInt(parts[1], 10, 64)
+	second, err3 := strconv.ParseFloat(parts[2], 64)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return 0, moerr.NewInvalidInput(ctx, "invalid time %s", s)
+	}
+	v := (hour*60+minute)*60*1000000 + int64(math.Round(second*1000000))
+	if neg {
+		v = -v
+	}
+	return v, nil
+}
+
+// appendPgNumeric appends the binary numeric of the decimal string:
+// the number of the digits, the weight, the sign, the scale and the digits in base 10000.
+func appendPgNumeric(ctx context.Context, data []byte, s string) ([]byte, error) {
+	var sign uint16
+	str := s
+	if strings.HasPrefix(str, "-") {
+		sign = 0x4000
+		str = str[1:]
+	} else {
+		str = strings.TrimPrefix(str, "+")
+	}
+	intPart, fracPart, _ := strings.Cut(str, ".")
+	if len(intPart)+len(fracPart) == 0 || strings.Trim(intPart+fracPart, "0123456789") != "" {
+		return nil, moerr.NewInvalidInput(ctx, "invalid decimal %s", s)
+	}
+	scale := len(fracPart)
+	intPart = strings.TrimLeft(intPart, "0")
+	if n := len(intPart) % 4; n != 0 {
+		intPart = strings.Repeat("0", 4-n) + intPart
+	}
+	if n := len(fracPart) % 4; n != 0 {
+		fracPart += strings.Repeat("0", 4-n)
+	}
+	digits := make([]uint16, 0, (len(intPart)+len(fracPart))/4)
+	for str = intPart + fracPart; len(str) > 0; str = str[4:] {
+		d, _ := strconv.Atoi(str[:4])
+		digits = append(digits, uint16(d))
+	}
+	weight := len(intPart)/4 - 1
+	for len(digits) > 0 && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		weight, sign = 0, 0
+	}
+	data = binary.BigEndian.AppendUint16(data, uint16(len(digits)))
+	data = binary.BigEndian.AppendUint16(data, uint16(int16(weight)))
+	data = binary.BigEndian.AppendUint16(data, sign)
+	data = binary.BigEndian.AppendUint16(data, uint16(scale))
+	for _, d := range digits {
+		data = binary.BigEndian.AppendUint16(data, d)
+	}
+	return data, nil
+}
+
+// decodePgNumeric decodes the binary numeric into the decimal string
+func decodePgNumeric(ctx context.Context, data []byte) (string, error) {
+	if len(data) < 8 {
+		return "", moerr.NewInvalidInput(ctx, "invalid binary numeric")
+	}
+	ndigits := int(binary.BigEndian.Uint16(data))
+	weight := int(int16(binary.BigEndian.Uint16(data[2:])))
+	sign := binary.BigEndian.Uint16(data[4:])
+	scale := int(binary.BigEndian.Uint16(data[6:]))
+	if len(data) < 8+ndigits*2 {
+		return "", moerr.NewInvalidInput(ctx, "invalid binary numeric")
+	}
+	if sign == 0xC000 {
+		return "", moerr.NewInvalidInput(ctx, "NaN is not a valid decimal")
+	}
+	// digit returns the digit of the weight w
+	digit := func(w int) int {
+		if j := weight - w; j >= 0 && j < ndigits {
+			return int(binary.BigEndian.Uint16(data[8+j*2:]))
+		}
+		return 0
+	}
+	var buf strings.Builder
+	if sign == 0x4000 {
+		buf.WriteByte('-')
+	}
+	if weight < 0 {
+		buf.WriteByte('0')
+	}
+	for w := weight; w >= 0; w-- {
+		if w == weight {
+			buf.WriteString(strconv.Itoa(digit(w)))
+		} else {
+			buf.WriteString(fmt.Sprintf("%04d", digit(w)))
+		}
+	}
+	if scale > 0 {
+		var frac strings.Builder
+		for w := -1; frac.Len() < scale; w-- {
+			frac.WriteString(fmt.Sprintf("%04d", digit(w)))
+		}
+		buf.WriteByte('.')
+		buf.WriteString(frac.String()[:scale])
+	}
+	return buf.String(), nil
+}
+
+// pgParamValue decodes the value of the parameter of the Bind message.
+// The bool is decoded into the int8 as the mysql protocol has no bool.
+func pgParamValue(ctx context.Context, oid uint32, format int16, val []byte) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	if format == pgFormatText {
+		switch oid {
+		case pgTypeBytea:
+			if bytes.HasPrefix(val, []byte(`\x`)) {
+				b, err := hex.DecodeString(string(val[2:]))
+				if err != nil {
+					return nil, moerr.NewInvalidInput(ctx, "invalid bytea %s", val)
+				}
+				return b, nil
+			}
+			return append([]byte{}, val...), nil
+		case pgTypeBool:
+			switch strings.ToLower(string(val)) {
+			case "t", "true", "y", "yes", "on", "1":
+				return int8(1), nil
+			case "f", "false", "n", "no", "off", "0":
+				return int8(0), nil
+			}
+			return nil, moerr.NewInvalidInput(ctx, "invalid bool %s", val)
+		}
+		return string(val), nil
+	}
+
+	invalid := moerr.NewInvalidInput(ctx, "invalid binary parameter of type %d", oid)
+	switch oid {
+	case pgTypeBool:
+		if len(val) != 1 {
+			return nil, invalid
+		}
+		if val[0] != 0 {
+			return int8(1), nil
+		}
+		return int8(0), nil
+	case pgTypeInt2:
+		if len(val) != 2 {
+			return nil, invalid
+		}
+		return int16(binary.BigEndian.Uint16(val)), nil
+	case pgTypeInt4:
+		if len(val) != 4 {
+			return nil, invalid
+		}
+		return int32(binary.BigEndian.Uint32(val)), nil
+	case pgTypeInt8:
+		if len(val) != 8 {
+			return nil, invalid
+		}
+		return int64(binary.BigEndian.Uint64(val)), nil
+	case pgTypeFloat4:
+		if len(val) != 4 {
+			return nil, invalid
+		}
+		return math.Float32frombits(binary.BigEndian.Uint32(val)), nil
+	case pgTypeFloat8:
+		if len(val) != 8 {
+			return nil, invalid
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(val)), nil
+	case pgTypeNumeric:
+		return decodePgNumeric(ctx, val)
+	case pgTypeDate:
+		if len(val) != 4 {
+			return nil, invalid
+		}
+		return (pgEpochDate + types.Date(int32(binary.BigEndian.Uint32(val)))).String(), nil
+	case pgTypeTimestamp, pgTypeTimestamptz:
+		if len(val) != 8 {
+			return nil, invalid
+		}
+		t := time.UnixMicro(pgEpoch.UnixMicro() + int64(binary.BigEndian.Uint64(val))).UTC()
+		return t.Format(pgTimestampLayout), nil
+	case pgTypeUuid:
+		if len(val) != 16 {
+			return nil, invalid
+		}
+		var v types.Uuid
+		copy(v[:], val)
+		return v.ToString(), nil
+	case pgTypeBytea:
+		return append([]byte{}, val...), nil
+	case pgTypeText, pgTypeVarchar, pgTypeJson:
+		return string(val), nil
+	}
+	return nil, moerr.NewNotSupported(ctx, "binary parameter of type %d", oid)
+}
@@ -0,0 +1,293 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/fagongzi/goetty/v2"
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgCodec(t *testing.T) {
+	codec := NewPgCodec()
+	in := buf.NewByteBuf(1024)
+
+	// the startup message without type
+	startup := binary.BigEndian.AppendUint32(nil, 0)
+	startup = binary.BigEndian.AppendUint32(startup, pgProtocolVersion3)
+	startup = append(startup, "user\x00dump\x00\x00"...)
+	binary.BigEndian.PutUint32(startup, uint32(len(startup)))
+	_, _ = in.Write(startup[:3])
+	msg, ok, err := codec.Decode(in)
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, _ = in.Write(startup[3:])
+	msg, ok, err = codec.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, byte(0), msg.(*pgMessage).typ)
+	require.Equal(t, startup[4:], msg.(*pgMessage).payload)
+
+	// the query and the copy data
+	_, _ = in.Write(pgEndMessage(pgAppendString(pgBeginMessage(nil, pgQuery), "select 1"), 0))
+	_, _ = in.Write(pgEndMessage(append(pgBeginMessage(nil, pgCopyData), "1\t2\n"...), 0))
+	_, _ = in.Write(pgEndMessage(pgBeginMessage(nil, pgCopyDone), 0))
+	msg, ok, err = codec.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, pgQuery, msg.(*pgMessage).typ)
+	require.Equal(t, []byte("select 1\x00"), msg.(*pgMessage).payload)
+	msg, ok, err = codec.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []byte("1\t2\n"), msg.(*Packet).Payload)
+	msg, ok, err = codec.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int32(0), msg.(*Packet).Length)
+
+	_, _ = in.Write(pgEndMessage(pgAppendString(pgBeginMessage(nil, pgCopyFail), "canceled"), 0))
+	_, _, err = codec.Decode(in)
+	require.Error(t, err)
+
+	_, _ = in.Write([]byte{pgQuery, 0, 0, 0, 1})
+	_, _, err = codec.Decode(in)
+	require.Error(t, err)
+}
+
+func TestPgNumeric(t *testing.T) {
+	ctx := context.TODO()
+	for _, s := range []string{"0", "1", "-1", "12345.678", "0.0001", "-0.00012300", "100000000", "99999999.99"} {
+		data, err := appendPgNumeric(ctx, nil, s)
+		require.NoError(t, err)
+		out, err := decodePgNumeric(ctx, data)
+		require.NoError(t, err)
+		require.Equal(t, s, out)
+	}
+
+	data, err := appendPgNumeric(ctx, nil, "12345.678")
+	require.NoError(t, err)
+	// ndigits, weight, sign, dscale, digits
+	require.Equal(t, []byte{0, 3, 0, 1, 0, 0, 0, 3, 0, 1, 0x09, 0x29, 0x1a, 0x7c}, data)
+
+	_, err = appendPgNumeric(ctx, nil, "1e5")
+	require.Error(t, err)
+}
+
+func TestPgParamValue(t *testing.T) {
+	ctx := context.TODO()
+	testcases := []struct {
+		oid    uint32
+		format int16
+		val    []byte
+		want   any
+	}{
+		{pgTypeInt4, pgFormatText, []byte("42"), "42"},
+		{pgTypeBool, pgFormatText, []byte("true"), int8(1)},
+		{pgTypeBytea, pgFormatText, []byte(`\x0aff`), []byte{0x0a, 0xff}},
+		{pgTypeInt2, pgFormatBinary, []byte{0xff, 0xfe}, int16(-2)},
+		{pgTypeInt4, pgFormatBinary, []byte{0, 0, 1, 0}, int32(256)},
+		{pgTypeInt8, pgFormatBinary, binary.BigEndian.AppendUint64(nil, 1<<40), int64(1 << 40)},
+		{pgTypeFloat8, pgFormatBinary, binary.BigEndian.AppendUint64(nil, math.Float64bits(1.5)), 1.5},
+		{pgTypeBool, pgFormatBinary, []byte{1}, int8(1)},
+		{pgTypeDate, pgFormatBinary, binary.BigEndian.AppendUint32(nil, 366), "2001-01-01"},
+		{pgTypeTimestamp, pgFormatBinary, binary.BigEndian.AppendUint64(nil, 86400*1000000+500000), "2000-01-02 00:00:00.5"},
+		{pgTypeText, pgFormatBinary, []byte("abc"), "abc"},
+		{pgTypeInt4, pgFormatBinary, nil, nil},
+	}
+	for _, tc := range testcases {
+		v, err := pgParamValue(ctx, tc.oid, tc.format, tc.val)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, v)
+	}
+
+	_, err := pgParamValue(ctx, pgTypeInt4, pgFormatBinary, []byte{1})
+	require.Error(t, err)
+}
+
+func TestPgValue(t *testing.T) {
+	ctx := context.TODO()
+	mrs := &MysqlResultSet{}
+	for _, c := range []struct {
+		name string
+		typ  types.T
+	}{{"a", types.T_int32}, {"b", types.T_varchar}, {"c", types.T_varbinary}, {"d", types.T_date}, {"e", types.T_bool}} {
+		col, err := newPgColumn(ctx, c.name, c.typ)
+		require.NoError(t, err)
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]any{int32(7), []byte("x"), []byte{0xab}, types.DateFromCalendar(2000, 1, 3), true})
+	mrs.AddRow([]any{nil, nil, nil, nil, nil})
+
+	var oids []uint32
+	for _, col := range mrs.Columns {
+		oids = append(oids, pgTypeOfColumn(col.(*MysqlColumn)))
+	}
+	require.Equal(t, []uint32{pgTypeInt4, pgTypeText, pgTypeBytea, pgTypeDate, pgTypeBool}, oids)
+
+	text := [][]byte{[]byte("7"), []byte("x"), []byte(`\xab`), []byte("2000-01-03"), []byte("t")}
+	bin := [][]byte{{0, 0, 0, 7}, []byte("x"), {0xab}, {0, 0, 0, 2}, {1}}
+	for i := range mrs.Columns {
+		col := mrs.Columns[i].(*MysqlColumn)
+		data, err := appendPgValue(ctx, nil, mrs, col, 0, uint64(i), pgFormatText)
+		require.NoError(t, err)
+		require.Equal(t, append(binary.BigEndian.AppendUint32(nil, uint32(len(text[i]))), text[i]...), data)
+		data, err = appendPgValue(ctx, nil, mrs, col, 0, uint64(i), pgFormatBinary)
+		require.NoError(t, err)
+		require.Equal(t, append(binary.BigEndian.AppendUint32(nil, uint32(len(bin[i]))), bin[i]...), data)
+		data, err = appendPgValue(ctx, nil, mrs, col, 1, uint64(i), pgFormatText)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0xff, 0xff, 0xff, 0xff}, data)
+	}
+}
+
+func TestPgCommandTag(t *testing.T) {
+	require.Equal(t, "INSERT 0 3", pgCommandTag(&tree.Insert{}, 3))
+	require.Equal(t, "UPDATE 2", pgCommandTag(&tree.Update{}, 2))
+	require.Equal(t, "DELETE 1", pgCommandTag(&tree.Delete{}, 1))
+	require.Equal(t, "SELECT 5", pgCommandTag(&tree.Select{}, 5))
+	require.Equal(t, "COPY 4", pgCommandTag(&tree.Load{}, 4))
+	require.Equal(t, "BEGIN", pgCommandTag(&tree.BeginTransaction{}, 0))
+	require.Equal(t, "CREATE TABLE", pgCommandTag(&tree.CreateTable{}, 0))
+}
+
+func TestPgSqlState(t *testing.T) {
+	ctx := context.TODO()
+	require.Equal(t, "42601", pgSqlState(moerr.NewSyntaxError(ctx, "x")))
+	require.Equal(t, "23505", pgSqlState(moerr.NewDuplicateEntry(ctx, "1", "a")))
+	require.Equal(t, "42P01", pgSqlState(moerr.NewNoSuchTable(ctx, "db", "t")))
+	require.Equal(t, "XX000", pgSqlState(moerr.NewInternalError(ctx, "x")))
+}
+
+func TestPgMD5Password(t *testing.T) {
+	// the md5 of the password and the user is 3175bce1d3201d16594cebf9d7eb3f9d
+	got := pgMD5Password([]byte("postgres"), []byte("postgres"), []byte{1, 2, 3, 4})
+	require.Equal(t, "md568be9ed08db75f318087ab337aaea044", string(got))
+}
+
+func TestPgRoutineManager_Startup(t *testing.T) {
+	pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+	_, err := toml.DecodeFile("test/system_vars_config.toml", pu.SV)
+	require.NoError(t, err)
+	ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+	rm, err := NewRoutineManager(ctx, pu)
+	require.NoError(t, err)
+	rm.SetSkipCheckUser(true)
+	prm := newPgRoutineManager(rm)
+
+	addr := "127.0.0.1:6543"
+	app, err := goetty.NewApplication(addr, prm.Handler,
+		goetty.WithAppSessionOptions(
+			goetty.WithSessionCodec(NewPgCodec()),
+			goetty.WithSessionLogger(logutil.GetGlobalLogger())),
+		goetty.WithAppSessionAware(prm))
+	require.NoError(t, err)
+	require.NoError(t, app.Start())
+	defer func() {
+		_ = app.Stop()
+	}()
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(10*time.Second)))
+	reader := bufio.NewReader(conn)
+
+	// readMessage reads a message and returns its type and payload
+	readMessage := func() (byte, []byte) {
+		header := make([]byte, 5)
+		_, err := io.ReadFull(reader, header)
+		require.NoError(t, err)
+		payload := make([]byte, binary.BigEndian.Uint32(header[1:])-4)
+		_, err = io.ReadFull(reader, payload)
+		require.NoError(t, err)
+		return header[0], payload
+	}
+
+	// no tls is configured
+	sslRequest := binary.BigEndian.AppendUint32(nil, 8)
+	sslRequest = binary.BigEndian.AppendUint32(sslRequest, pgSSLRequestCode)
+	_, err = conn.Write(sslRequest)
+	require.NoError(t, err)
+	b, err := reader.ReadByte()
+	require.NoError(t, err)
+	require.Equal(t, byte('N'), b)
+
+	startup := binary.BigEndian.AppendUint32(nil, 0)
+	startup = binary.BigEndian.AppendUint32(startup, pgProtocolVersion3)
+	startup = append(startup, "user\x00dump\x00database\x00db\x00\x00"...)
+	binary.BigEndian.PutUint32(startup, uint32(len(startup)))
+	_, err = conn.Write(startup)
+	require.NoError(t, err)
+
+	typ, payload := readMessage()
+	require.Equal(t, pgAuthentication, typ)
+	require.Equal(t, pgAuthOK, binary.BigEndian.Uint32(payload))
+	params := make(map[string]string)
+	for {
+		typ, payload = readMessage()
+		if typ != pgParameterStatus {
+			break
+		}
+		key, pos, _ := pgReadString(payload, 0)
+		params[key], _, _ = pgReadString(payload, pos)
+	}
+	require.Equal(t, "UTF8", params["client_encoding"])
+	require.Equal(t, pgBackendKeyData, typ)
+	typ, payload = readMessage()
+	require.Equal(t, pgReadyForQuery, typ)
+	require.Equal(t, []byte{'I'}, payload)
+
+	// the empty query
+	_, err = conn.Write(pgEndMessage(pgAppendString(pgBeginMessage(nil, pgQuery), " "), 0))
+	require.NoError(t, err)
+	typ, _ = readMessage()
+	require.Equal(t, pgEmptyQueryResponse, typ)
+	typ, _ = readMessage()
+	require.Equal(t, pgReadyForQuery, typ)
+
+	// the messages after the error are skipped until the sync
+	bind := pgAppendString(pgBeginMessage(nil, pgBind), "")
+	bind = pgAppendString(bind, "missing")
+	bind = append(bind, 0, 0, 0, 0, 0, 0)
+	_, err = conn.Write(pgEndMessage(bind, 0))
+	require.NoError(t, err)
+	_, err = conn.Write(pgEndMessage(append(pgBeginMessage(nil, pgDescribe), 'P', 0), 0))
+	require.NoError(t, err)
+	_, err = conn.Write(pgEndMessage(pgBeginMessage(nil, pgSync), 0))
+	require.NoError(t, err)
+	typ, _ = readMessage()
+	require.Equal(t, pgErrorResponse, typ)
+	typ, _ = readMessage()
+	require.Equal(t, pgReadyForQuery, typ)
+
+	_, err = conn.Write(pgEndMessage(pgBeginMessage(nil, pgTerminate), 0))
+	require.NoError(t, err)
+}
@@ -0,0 +1,459 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/postgresql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+)
+
+// pgRoutineManager serves the connections of the postgresql clients.
+// The routines share the RoutineManager with the mysql clients, so that
+// the kill and the accounting work on both of them.
+type pgRoutineManager struct {
+	rm *RoutineManager
+}
+
+func newPgRoutineManager(rm *RoutineManager) *pgRoutineManager {
+	return &pgRoutineManager{rm: rm}
+}
+
+func (prm *pgRoutineManager) Created(rs goetty.IOSession) {
+	logutil.Debugf("get the postgresql connection from %s", rs.RemoteAddress())
+	rm := prm.rm
+	pu := rm.getParameterUnit()
+	pro := NewPgProtocol(nextConnectionID(), rs, int(pu.SV.MaxBytesInOutbufToFlush), pu.SV)
+	pro.SetSkipCheckUser(rm.GetSkipCheckUser())
+	exe := NewMysqlCmdExecutor()
+	exe.SetRoutineManager(rm)
+	exe.ChooseDoQueryFunc(pu.SV.EnableDoComQueryInProgress)
+
+	routine := NewRoutine(rm.getCtx(), pro, exe, pu.SV, rs)
+
+	ses := NewSession(routine.getProtocol(), nil, pu, GSysVariables, true)
+	ses.SetRequestContext(routine.getCancelRoutineCtx())
+	ses.SetConnectContext(routine.getCancelRoutineCtx())
+	ses.SetFromRealUser(true)
+	ses.setSkipCheckPrivilege(rm.GetSkipCheckUser())
+	ses.SetAutoIncrCaches(rm.autoIncrCaches)
+	ses.SetDialect(dialect.POSTGRESQL)
+
+	routine.setSession(ses)
+	pro.SetSession(ses)
+
+	// the client begins the startup, there is nothing to send
+	logDebugf(pro.GetDebugString(), "have done some preparation for the postgresql connection %s", rs.RemoteAddress())
+	rm.setRoutine(rs, routine)
+}
+
+func (prm *pgRoutineManager) Closed(rs goetty.IOSession) {
+	prm.rm.Closed(rs)
+}
+
+func (prm *pgRoutineManager) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
+	var err error
+	ctx, span := trace.Start(prm.rm.getCtx(), "pgRoutineManager.Handler")
+	defer span.End()
+	routine := prm.rm.getRoutine(rs)
+	if routine == nil {
+		err = moerr.NewInternalError(ctx, "routine does not exist")
+		logutil.Errorf("%s error:%v", getConnectionInfo(rs), err)
+		return err
+	}
+	routine.setInProcessRequest(true)
+	defer routine.setInProcessRequest(false)
+	protocol, ok := routine.getProtocol().(*PgProtocolImpl)
+	if !ok {
+		return moerr.NewInternalError(ctx, "protocol is not PgProtocol")
+	}
+	protoInfo := protocol.GetDebugString()
+	message, ok := msg.(*pgMessage)
+	if !ok {
+		// the CopyData after the COPY FROM STDIN failed
+		logDebugf(protoInfo, "skip the message %T", msg)
+		return nil
+	}
+
+	if !protocol.IsEstablished() {
+		err = prm.handleStartup(ctx, rs, routine, protocol, message)
+		if err != nil {
+			logErrorf(protoInfo, "error:%v", err)
+		}
+		return err
+	}
+
+	// after an error of the extended query, the messages are skipped until the Sync
+	if protocol.isErrorSent() && message.typ != pgSync && message.typ != pgQuery && message.typ != pgTerminate {
+		return nil
+	}
+
+	switch message.typ {
+	case pgQuery:
+		err = prm.handleQuery(ctx, routine, protocol, message.payload)
+	case pgParse:
+		err = prm.handleParse(ctx, routine, protocol, message.payload)
+	case pgBind:
+		err = prm.handleBind(ctx, protocol, message.payload)
+	case pgDescribe:
+		err = prm.handleDescribe(ctx, protocol, message.payload)
+	case pgExecute:
+		err = prm.handleExecute(ctx, routine, protocol, message.payload)
+	case pgClose:
+		err = prm.handleClose(ctx, routine, protocol, message.payload)
+	case pgSync:
+		protocol.setErrorSent(false)
+		err = protocol.sendReadyForQuery()
+	case pgFlush:
+		err = protocol.flush()
+	case pgTerminate:
+		protocol.Quit()
+	default:
+		err = protocol.sendError(moerr.NewInvalidInput(ctx, "unsupported message type %c", message.typ))
+	}
+	if err != nil {
+		logErrorf(protoInfo, "error:%v", err)
+	}
+	return err
+}
+
+// handleStartup handles the SSLRequest, the CancelRequest, the StartupMessage
+// and the PasswordMessage.
+func (prm *pgRoutineManager) handleStartup(ctx context.Context, rs goetty.IOSession, routine *Routine, protocol *PgProtocolImpl, message *pgMessage) error {
+	if message.typ == pgPassword {
+		if protocol.GetUserName() == "" {
+			return moerr.NewInvalidInput(ctx, "password message before the startup message")
+		}
+		if err := protocol.authenticate(ctx, message.payload); err != nil {
+			return err
+		}
+		prm.establish(protocol, routine)
+		return nil
+	}
+	if message.typ != 0 || len(message.payload) < 4 {
+		return moerr.NewInvalidInput(ctx, "invalid startup message")
+	}
+
+	switch code := binary.BigEndian.Uint32(message.payload); code {
+	case pgSSLRequestCode:
+		if protocol.IsTlsEstablished() || prm.rm.getTlsConfig() == nil {
+			return rs.Write([]byte{'N'}, goetty.WriteOptions{Flush: true})
+		}
+		if err := rs.Write([]byte{'S'}, goetty.WriteOptions{Flush: true}); err != nil {
+			return err
+		}
+		tlsConn := tls.Server(rs.RawConn(), prm.rm.getTlsConfig())
+		newCtx, cancelFun := context.WithTimeout(ctx, 20*time.Second)
+		defer cancelFun()
+		if err := tlsConn.HandshakeContext(newCtx); err != nil {
+			return err
+		}
+		rs.UseConn(tlsConn)
+		protocol.SetTlsEstablished()
+		return nil
+	case pgGSSENCRequestCode:
+		return rs.Write([]byte{'N'}, goetty.WriteOptions{Flush: true})
+	case pgCancelRequestCode:
+		if len(message.payload) < 12 {
+			return moerr.NewInvalidInput(ctx, "invalid cancel request")
+		}
+		prm.cancel(binary.BigEndian.Uint32(message.payload[4:]), binary.BigEndian.Uint32(message.payload[8:]))
+		// the connection of the CancelRequest is closed without response
+		protocol.Quit()
+		return nil
+	default:
+		needPassword, err := protocol.HandleHandshake(ctx, message.payload)
+		if err != nil {
+			return err
+		}
+		if !needPassword {
+			prm.establish(protocol, routine)
+		}
+		return nil
+	}
+}
+
+func (prm *pgRoutineManager) establish(protocol *PgProtocolImpl, routine *Routine) {
+	protocol.SetEstablished()
+	ses := routine.getSession()
+	if dbName := protocol.GetDatabaseName(); ses != nil && dbName != "" {
+		ses.SetDatabaseName(dbName)
+	}
+}
+
+// cancel cancels the query running on the connection of the BackendKeyData
+func (prm *pgRoutineManager) cancel(connectionID, secretKey uint32) {
+	var rt *Routine
+	rm := prm.rm
+	rm.mu.Lock()
+	for _, value := range rm.clients {
+		if value.getConnectionID() == connectionID {
+			rt = value
+			break
+		}
+	}
+	rm.mu.Unlock()
+	if rt == nil {
+		return
+	}
+	if protocol, ok := rt.getProtocol().(*PgProtocolImpl); ok && protocol.checkCancelRequest(secretKey) {
+		logutil.Infof("cancel the query on the connection %d", connectionID)
+		rt.killQuery(false, "")
+	}
+}
+
+// handleQuery handles the simple query
+func (prm *pgRoutineManager) handleQuery(ctx context.Context, routine *Routine, protocol *PgProtocolImpl, payload []byte) error {
+	protocol.setErrorSent(false)
+	protocol.setPortal(nil)
+	query, _, ok := pgReadString(payload, 0)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "invalid query message")
+	}
+	if strings.TrimSpace(query) == "" {
+		if err := protocol.sendMessage(pgEmptyQueryResponse); err != nil {
+			return err
+		}
+	} else if err := routine.handleRequest(&Request{cmd: COM_QUERY, data: []byte(query)}); err != nil {
+		return err
+	}
+	return protocol.sendReadyForQuery()
+}
+
+// handleParse prepares the statement by the COM_STMT_PREPARE. The statements
+// that can not be prepared, like BEGIN and SET, are executed by the COM_QUERY
+// when the portal is executed.
+func (prm *pgRoutineManager) handleParse(ctx context.Context, routine *Routine, protocol *PgProtocolImpl, payload []byte) error {
+	io := protocol.io
+	name, pos, ok := pgReadString(payload, 0)
+	var query string
+	var count uint16
+	if ok {
+		query, pos, ok = pgReadString(payload, pos)
+	}
+	if ok {
+		count, pos, ok = io.ReadUint16(payload, pos)
+	}
+	stmt := &pgStatement{paramTypes: make([]uint32, count)}
+	for i := 0; ok && i < int(count); i++ {
+		stmt.paramTypes[i], pos, ok = io.ReadUint32(payload, pos)
+	}
+	if !ok {
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "invalid parse message"))
+	}
+
+	if err := prm.closeStatement(routine, protocol, name); err != nil {
+		return err
+	}
+	if strings.TrimSpace(query) != "" {
+		var err error
+		_, stmt.paramOrder, err = postgresql.ToMySQL(ctx, query)
+		if err != nil {
+			return protocol.sendError(err)
+		}
+		stmts, err := parsers.Parse(ctx, dialect.POSTGRESQL, query, 1)
+		if err != nil {
+			return protocol.sendError(err)
+		}
+		if len(stmts) == 1 && pgRunsDirectly(stmts[0]) {
+			if len(stmt.paramOrder) != 0 {
+				return protocol.sendError(moerr.NewNotSupported(ctx, "parameters in %s", stmts[0].GetStatementType()))
+			}
+			stmt.sql = query
+		} else {
+			protocol.setPrepared(stmt)
+			err = routine.handleRequest(&Request{cmd: COM_STMT_PREPARE, data: []byte(query)})
+			protocol.setPrepared(nil)
+			if err != nil || stmt.prepareStmt == nil {
+				return err
+			}
+			protocol.setStatement(name, stmt)
+			return nil
+		}
+	}
+	protocol.setStatement(name, stmt)
+	return protocol.sendMessage(pgParseComplete)
+}
+
+// pgRunsDirectly returns true if the statement can not be prepared
+func pgRunsDirectly(stmt tree.Statement) bool {
+	switch stmt.(type) {
+	case *tree.SetVar, *tree.Use, *tree.Load, *tree.SetRole, *tree.SetDefaultRole, *tree.SetPassword:
+		return true
+	}
+	queryType := stmt.GetQueryType()
+	return queryType == tree.QueryTypeTCL || queryType == tree.QueryTypeDCL
+}
+
+func (prm *pgRoutineManager) handleBind(ctx context.Context, protocol *PgProtocolImpl, payload []byte) error {
+	io := protocol.io
+	portal := &pgPortal{}
+	var count, format uint16
+	var length uint32
+	portalName, pos, ok := pgReadString(payload, 0)
+	var stmtName string
+	if ok {
+		stmtName, pos, ok = pgReadString(payload, pos)
+	}
+	if ok {
+		count, pos, ok = io.ReadUint16(payload, pos)
+	}
+	for i := 0; ok && i < int(count); i++ {
+		format, pos, ok = io.ReadUint16(payload, pos)
+		portal.paramFormats = append(portal.paramFormats, int16(format))
+	}
+	if ok {
+		count, pos, ok = io.ReadUint16(payload, pos)
+	}
+	for i := 0; ok && i < int(count); i++ {
+		length, pos, ok = io.ReadUint32(payload, pos)
+		if !ok {
+			break
+		}
+		if int32(length) < 0 {
+			// the NULL
+			portal.params = append(portal.params, nil)
+			continue
+		}
+		if pos+int(length) > len(payload) {
+			ok = false
+			break
+		}
+		portal.params = append(portal.params, payload[pos:pos+int(length)])
+		pos += int(length)
+	}
+	if ok {
+		count, pos, ok = io.ReadUint16(payload, pos)
+	}
+	for i := 0; ok && i < int(count); i++ {
+		format, pos, ok = io.ReadUint16(payload, pos)
+		portal.resultFormats = append(portal.resultFormats, int16(format))
+	}
+	if !ok {
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "invalid bind message"))
+	}
+
+	portal.stmt = protocol.getStatement(stmtName)
+	if portal.stmt == nil {
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "prepared statement \"%s\" does not exist", stmtName))
+	}
+	if len(portal.params) != len(portal.stmt.paramTypes) {
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "bind message supplies %d parameters, but prepared statement \"%s\" requires %d",
+			len(portal.params), stmtName, len(portal.stmt.paramTypes)))
+	}
+	protocol.setPortalByName(portalName, portal)
+	return protocol.sendMessage(pgBindComplete)
+}
+
+func (prm *pgRoutineManager) handleDescribe(ctx context.Context, protocol *PgProtocolImpl, payload []byte) error {
+	if len(payload) < 1 {
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "invalid describe message"))
+	}
+	name, _, ok := pgReadString(payload, 1)
+	if !ok {
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "invalid describe message"))
+	}
+	switch payload[0] {
+	case 'S':
+		stmt := protocol.getStatement(name)
+		if stmt == nil {
+			return protocol.sendError(moerr.NewInvalidInput(ctx, "prepared statement \"%s\" does not exist", name))
+		}
+		if err := protocol.sendParameterDescription(stmt); err != nil {
+			return err
+		}
+		return protocol.sendRowDescription(ctx, stmt, nil)
+	case 'P':
+		portal := protocol.getPortalByName(name)
+		if portal == nil {
+			return protocol.sendError(moerr.NewInvalidInput(ctx, "portal \"%s\" does not exist", name))
+		}
+		return protocol.sendRowDescription(ctx, portal.stmt, portal.resultFormats)
+	}
+	return protocol.sendError(moerr.NewInvalidInput(ctx, "invalid describe message"))
+}
+
+// handleExecute executes the portal by the COM_STMT_EXECUTE with the parameters
+// of the portal. The max number of the rows is ignored and all rows are returned.
+func (prm *pgRoutineManager) handleExecute(ctx context.Context, routine *Routine, protocol *PgProtocolImpl, payload []byte) error {
+	name, _, ok := pgReadString(payload, 0)
+	if !ok {
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "invalid execute message"))
+	}
+	portal := protocol.getPortalByName(name)
+	if portal == nil {
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "portal \"%s\" does not exist", name))
+	}
+	stmt := portal.stmt
+	if stmt.prepareStmt == nil && stmt.sql == "" {
+		return protocol.sendMessage(pgEmptyQueryResponse)
+	}
+
+	protocol.setPortal(portal)
+	defer protocol.setPortal(nil)
+	if stmt.prepareStmt == nil {
+		return routine.handleRequest(&Request{cmd: COM_QUERY, data: []byte(stmt.sql)})
+	}
+	data := make([]byte, 5)
+	binary.LittleEndian.PutUint32(data, stmt.stmtID)
+	return routine.handleRequest(&Request{cmd: COM_STMT_EXECUTE, data: data})
+}
+
+func (prm *pgRoutineManager) handleClose(ctx context.Context, routine *Routine, protocol *PgProtocolImpl, payload []byte) error {
+	if len(payload) < 1 {
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "invalid close message"))
+	}
+	name, _, ok := pgReadString(payload, 1)
+	if !ok {
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "invalid close message"))
+	}
+	switch payload[0] {
+	case 'S':
+		if err := prm.closeStatement(routine, protocol, name); err != nil {
+			return err
+		}
+	case 'P':
+		protocol.setPortalByName(name, nil)
+	default:
+		return protocol.sendError(moerr.NewInvalidInput(ctx, "invalid close message"))
+	}
+	return protocol.sendMessage(pgCloseComplete)
+}
+
+// closeStatement deallocates the prepared statement by the COM_STMT_CLOSE
+func (prm *pgRoutineManager) closeStatement(routine *Routine, protocol *PgProtocolImpl, name string) error {
+	stmt := protocol.getStatement(name)
+	if stmt == nil {
+		return nil
+	}
+	protocol.setStatement(name, nil)
+	if stmt.prepareStmt == nil {
+		return nil
+	}
+	data := make([]byte, 4)
+	binary.LittleEndian.PutUint32(data, stmt.stmtID)
+	return routine.handleRequest(&Request{cmd: COM_STMT_CLOSE, data: data})
+}
@@ -0,0 +1,350 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+// A plan baseline pins the plan of the queries with the same fingerprint, the text without the
+// constants and the hints. CREATE PLAN BASELINE saves the hints reproducing the plan of the query
+// into mo_plan_baselines, and the hints replace the ones of the queries with the same digest.
+const (
+	insertPlanBaselineFormat = `insert into mo_catalog.mo_plan_baselines(
+		digest,
+		fingerprint,
+		hints,
+		created_time) values ('%s', '%s', '%s', '%s');`
+
+	deletePlanBaselineFormat = `delete from mo_catalog.mo_plan_baselines where digest = '%s';`
+
+	checkPlanBaselineFormat = `select digest from mo_catalog.mo_plan_baselines where digest = '%s';`
+
+	getPlanBaselinesFormat = `select digest, hints from mo_catalog.mo_plan_baselines;`
+)
+
+// planBaselineRefreshInterval is how often the plan baselines of an account are loaded again,
+// the baselines created or dropped on other CNs take effect after it.
+var planBaselineRefreshInterval = 10 * time.Second
+
+type accountPlanBaselines struct {
+	loadTime time.Time
+	// loading is true while the baselines are loaded again in the background
+	loading bool
+	// hints by the digest of the fingerprint
+	hints map[string]string
+}
+
+// planBaselineCache caches the plan baselines of the accounts on this CN
+type planBaselineCache struct {
+	sync.Mutex
+	accounts map[uint32]*accountPlanBaselines
+	// versions is increased when the plan baselines of the account change, the cached plans
+	// built with an older version are not used.
+	versions map[uint32]uint64
+}
+
+var planBaselines = &planBaselineCache{
+	accounts: make(map[uint32]*accountPlanBaselines),
+	versions: make(map[uint32]uint64),
+}
+
+func (c *planBaselineCache) version(accountId uint32) uint64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.versions[accountId]
+}
+
+func (c *planBaselineCache) invalidate(accountId uint32) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.accounts, accountId)
+	c.versions[accountId]++
+}
+
+// get returns the plan baselines of the account. They are loaded when the account has none cached,
+// the ones out of date are returned while they are loaded again in the background.
+func (c *planBaselineCache) get(ctx context.Context, ses *Session) map[string]string {
+	accountId := getAccountId(ctx)
+	c.Lock()
+	if cached := c.accounts[accountId]; cached != nil {
+		if !cached.loading && time.Since(cached.loadTime) >= planBaselineRefreshInterval {
+			cached.loading = true
+			go c.refresh(planBaselineRefreshContext(ctx), ses.GetParameterUnit(), ses.autoIncrCaches, accountId, cached)
+		}
+		c.Unlock()
+		return cached.hints
+	}
+	c.Unlock()
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	hints, err := loadPlanBaselines(ctx, bh)
+	if err != nil {
+		// the queries go on without the baselines, like the table doesn't exist before upgrading
+		logErrorf(ses.GetDebugString(), "load plan baselines failed: %v", err)
+		hints = make(map[string]string)
+	}
+	c.store(accountId, hints)
+	return hints
+}
+
+// refresh loads the plan baselines of the account again, it runs out of the queries and outlives
+// the session which found them out of date. The baselines loaded are dropped if the cached ones
+// were invalidated meanwhile.
+func (c *planBaselineCache) refresh(ctx context.Context, pu *config.ParameterUnit, autoIncrCaches defines.AutoIncrCaches, accountId uint32, cached *accountPlanBaselines) {
+	ctx, cancel := context.WithTimeout(ctx, planBaselineRefreshInterval)
+	defer cancel()
+	var hints map[string]string
+	mp, err := mpool.NewMPool("plan_baselines_refresh", 0, mpool.NoFixed)
+	if err == nil {
+		defer mpool.DeleteMPool(mp)
+		bh := NewBackgroundHandler(ctx, ctx, mp, pu, autoIncrCaches)
+		defer bh.Close()
+		hints, err = loadPlanBaselines(ctx, bh)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if err != nil {
+		logutil.Errorf("load plan baselines of account %d failed: %v", accountId, err)
+		cached.loading = false
+		cached.loadTime = time.Now()
+		return
+	}
+	if c.accounts[accountId] == cached {
+		c.storeLocked(accountId, hints)
+	}
+}
+
+// store caches the plan baselines loaded, the version of the account is increased if they are
+// changed.
+func (c *planBaselineCache) store(accountId uint32, hints map[string]string) {
+	c.Lock()
+	defer c.Unlock()
+	c.storeLocked(accountId, hints)
+}
+
+func (c *planBaselineCache) storeLocked(accountId uint32, hints map[string]string) {
+	if cached := c.accounts[accountId]; cached != nil && !samePlanBaselines(cached.hints, hints) {
+		c.versions[accountId]++
+	}
+	c.accounts[accountId] = &accountPlanBaselines{
+		loadTime: time.Now(),
+		hints:    hints,
+	}
+}
+
+// planBaselineRefreshContext returns the context of the account for loading the plan baselines,
+// it isn't canceled with the query.
+func planBaselineRefreshContext(ctx context.Context) context.Context {
+	refreshCtx := context.Background()
+	for _, key := range []any{defines.TenantIDKey{}, defines.UserIDKey{}, defines.RoleIDKey{}} {
+		if v := ctx.Value(key); v != nil {
+			refreshCtx = context.WithValue(refreshCtx, key, v)
+		}
+	}
+	return refreshCtx
+}
+
+func samePlanBaselines(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for digest, hints := range a {
+		if other, ok := b[digest]; !ok || other != hints {
+			return false
+		}
+	}
+	return true
+}
+
+func loadPlanBaselines(ctx context.Context, bh BackgroundExec) (map[string]string, error) {
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, getPlanBaselinesFormat)
+	if err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+
+	hints := make(map[string]string)
+	if !execResultArrayHasData(erArray) {
+		return hints, nil
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		digest, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		text, err := erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		hints[digest] = text
+	}
+	return hints, nil
+}
+
+// usePlanBaselines returns true for the sessions of the users, the baselines belong to the account
+// and the internal queries of the background sessions never use them.
+func usePlanBaselines(ses *Session) bool {
+	return ses != nil && !ses.IsBackgroundSession() && ses.GetTenantInfo() != nil
+}
+
+// refreshPlanBaselines loads the plan baselines again if they are out of date, so the cached plans
+// are dropped when the baselines are changed on other CNs.
+func refreshPlanBaselines(ctx context.Context, ses *Session) {
+	if !usePlanBaselines(ses) {
+		return
+	}
+	planBaselines.get(ctx, ses)
+}
+
+// planBaselineVersion returns the version of the plan baselines of the account of the session
+func (ses *Session) planBaselineVersion() uint64 {
+	var accountId uint32
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		accountId = tenant.GetTenantID()
+	}
+	return planBaselines.version(accountId)
+}
+
+// applyPlanBaseline replaces the hints of the query with the ones of its plan baseline
+func applyPlanBaseline(ctx context.Context, ses *Session, stmt tree.Statement) {
+	if !usePlanBaselines(ses) {
+		return
+	}
+	var sel *tree.Select
+	switch s := stmt.(type) {
+	case *tree.Select:
+		sel = s
+	case *tree.ExplainStmt:
+		sel, _ = s.Statement.(*tree.Select)
+	case *tree.ExplainAnalyze:
+		sel, _ = s.Statement.(*tree.Select)
+	}
+	if sel == nil {
+		return
+	}
+	clause := tree.FirstSelectClause(sel.Select)
+	if clause == nil {
+		return
+	}
+	baselines := planBaselines.get(ctx, ses)
+	if len(baselines) == 0 {
+		return
+	}
+	if hints, ok := baselines[tree.Digest(tree.Fingerprint(sel))]; ok {
+		clause.Hints = mysql.ParseOptimizerHints(hints)
+	}
+}
+
+// doCreatePlanBaseline saves the hints reproducing the current plan of the query, the existing
+// baseline of the query is replaced.
+func doCreatePlanBaseline(ctx context.Context, ses *Session, stmt *tree.CreatePlanBaseline) error {
+	var err error
+	outline, err := plan2.BuildPlanOutline(ses.GetTxnCompileCtx(), stmt.Stmt)
+	if err != nil {
+		return err
+	}
+	var hints string
+	if len(outline) > 0 {
+		hints = tree.String(outline, dialect.MYSQL)
+	}
+	fingerprint := tree.Fingerprint(stmt.Stmt)
+	digest := tree.Digest(fingerprint)
+	createdTime := types.CurrentTimestamp().String2(time.UTC, 0)
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, fmt.Sprintf(deletePlanBaselineFormat, digest))
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, fmt.Sprintf(insertPlanBaselineFormat, digest,
+		escapeSqlString(fingerprint), escapeSqlString(hints), createdTime))
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	planBaselines.invalidate(getAccountId(ctx))
+	return err
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+func doDropPlanBaseline(ctx context.Context, ses *Session, stmt *tree.DropPlanBaseline) error {
+	var err error
+	var erArray []ExecResult
+	digest := tree.Digest(tree.Fingerprint(stmt.Stmt))
+
+	// the baseline is deleted in the transaction of the statement
+	bh := ses.GetShareTxnBackgroundExec(ctx)
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, fmt.Sprintf(checkPlanBaselineFormat, digest))
+	if err != nil {
+		return err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		if stmt.IfExists {
+			return nil
+		}
+		return moerr.NewInvalidInput(ctx, "there is no plan baseline for the query")
+	}
+
+	err = bh.Exec(ctx, fmt.Sprintf(deletePlanBaselineFormat, digest))
+	if err != nil {
+		return err
+	}
+	planBaselines.invalidate(getAccountId(ctx))
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+func newPlanBaselineMrs(rows ...[]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"digest", "hints"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func Test_applyPlanBaseline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+	ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName})
+	planBaselines.invalidate(0)
+	defer planBaselines.invalidate(0)
+
+	parse := func(sql string) tree.Statement {
+		stmt, err := mysql.ParseOne(ctx, sql, 1)
+		require.NoError(t, err)
+		return stmt
+	}
+	stmt := parse("select /*+ HASH_JOIN() */ * from t1, t2 where t1.a = t2.a and t1.b = 1")
+
+	// no baseline
+	applyPlanBaseline(ctx, ses, stmt)
+	require.Equal(t, "select /*+ HASH_JOIN() */ * from t1 cross join t2 where t1.a = t2.a and t1.b = 1", tree.String(stmt, dialect.MYSQL))
+
+	// the baseline captured for another constant is used
+	digest := tree.Digest(tree.Fingerprint(parse("select * from t1, t2 where t1.a = t2.a and t1.b = 2")))
+	bt.sql2result[getPlanBaselinesFormat] = newPlanBaselineMrs([]interface{}{digest, "JOIN_ORDER(t2, t1) NO_HASH_JOIN(t2)"})
+	version := planBaselines.version(0)
+	stub := gostub.Stub(&planBaselineRefreshInterval, time.Hour)
+	defer stub.Reset()
+	// the baselines out of date are used while they are loaded again in the background
+	planBaselines.Lock()
+	planBaselines.accounts[0].loadTime = time.Now().Add(-time.Hour)
+	planBaselines.Unlock()
+	applyPlanBaseline(ctx, ses, stmt)
+	require.Equal(t, "select /*+ HASH_JOIN() */ * from t1 cross join t2 where t1.a = t2.a and t1.b = 1", tree.String(stmt, dialect.MYSQL))
+	// the cached plans are dropped since the baselines are changed
+	require.Eventually(t, func() bool {
+		return planBaselines.version(0) > version
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Zero(t, planBaselines.version(1))
+	applyPlanBaseline(ctx, ses, stmt)
+	require.Equal(t, "select /*+ JOIN_ORDER(t2, t1) NO_HASH_JOIN(t2) */ * from t1 cross join t2 where t1.a = t2.a and t1.b = 1", tree.String(stmt, dialect.MYSQL))
+
+	explain := parse("explain select * from t1, t2 where t1.a = t2.a and t1.b = 3")
+	version = planBaselines.version(0)
+	applyPlanBaseline(ctx, ses, explain)
+	require.Equal(t, "explain select /*+ JOIN_ORDER(t2, t1) NO_HASH_JOIN(t2) */ * from t1 cross join t2 where t1.a = t2.a and t1.b = 3", tree.String(explain, dialect.MYSQL))
+	require.Equal(t, version, planBaselines.version(0))
+
+	// the background sessions never use the baselines
+	stmt = parse("select * from t1, t2 where t1.a = t2.a and t1.b = 4")
+	ses.SetTenantInfo(nil)
+	applyPlanBaseline(ctx, ses, stmt)
+	require.Nil(t, stmt.(*tree.Select).Select.(*tree.SelectClause).Hints)
+}
+
+func Test_doDropPlanBaseline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+
+	stmt, err := mysql.ParseOne(ctx, "drop plan baseline for select * from t1 where a = 1", 1)
+	require.NoError(t, err)
+	drop := stmt.(*tree.DropPlanBaseline)
+	err = doDropPlanBaseline(ctx, ses, drop)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+	drop.IfExists = true
+	require.NoError(t, doDropPlanBaseline(ctx, ses, drop))
+
+	digest := tree.Digest(tree.Fingerprint(drop.Stmt))
+	bt.sql2result[fmt.Sprintf(checkPlanBaselineFormat, digest)] = newPlanBaselineMrs([]interface{}{digest, ""})
+	version := planBaselines.version(0)
+	require.NoError(t, doDropPlanBaseline(ctx, ses, drop))
+	require.Equal(t, fmt.Sprintf(deletePlanBaselineFormat, digest), bt.sqls[len(bt.sqls)-1])
+	require.Greater(t, planBaselines.version(0), version)
+	require.Zero(t, planBaselines.version(1))
+}
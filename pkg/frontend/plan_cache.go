@@ -25,6 +25,8 @@ type cachedPlan struct {
 	sql   string
 	stmts []tree.Statement
 	plans []*plan.Plan
+	// baselineVersion is the version of the plan baselines when the plans were built
+	baselineVersion uint64
 }
 
 // planCache uses LRU to cache plan for the same sql
@@ -42,8 +44,11 @@ func newPlanCache(capacity int) *planCache {
 	}
 }
 
-func (pc *planCache) cache(sql string, stmts []tree.Statement, plans []*plan.Plan) {
-	element := pc.lruList.PushFront(&cachedPlan{sql: sql, stmts: stmts, plans: plans})
+func (pc *planCache) cache(sql string, stmts []tree.Statement, plans []*plan.Plan, baselineVersion uint64) {
+	if element, ok := pc.cachePool[sql]; ok {
+		pc.lruList.Remove(element)
+	}
+	element := pc.lruList.PushFront(&cachedPlan{sql: sql, stmts: stmts, plans: plans, baselineVersion: baselineVersion})
 	pc.cachePool[sql] = element
 	if pc.lruList.Len() > pc.capacity {
 		toRemove := pc.lruList.Back()
@@ -52,19 +57,22 @@ func (pc *planCache) cache(sql string, stmts []tree.Statement, plans []*plan.Pla
 	}
 }
 
-// get gets a cached plan by its sql
-func (pc *planCache) get(sql string) *cachedPlan {
+// get gets a cached plan by its sql, the plan built with other plan baselines is not returned
+func (pc *planCache) get(sql string, baselineVersion uint64) *cachedPlan {
 	if element, ok := pc.cachePool[sql]; ok {
-		pc.lruList.MoveToFront(element)
 		cp := element.Value.(*cachedPlan)
+		if cp.baselineVersion != baselineVersion {
+			return nil
+		}
+		pc.lruList.MoveToFront(element)
 		return cp
 	}
 	return nil
 }
 
-func (pc *planCache) isCached(sql string) bool {
-	_, isCached := pc.cachePool[sql]
-	return isCached
+func (pc *planCache) isCached(sql string, baselineVersion uint64) bool {
+	element, isCached := pc.cachePool[sql]
+	return isCached && element.Value.(*cachedPlan).baselineVersion == baselineVersion
 }
 
 func (pc *planCache) clean() {
@@ -22,52 +22,66 @@ import (
 func Test_BasicGet(t *testing.T) {
 	pc := newPlanCache(5)
 
-	pc.cache("abc", nil, nil)
-	require.True(t, pc.isCached("abc"))
-	require.Equal(t, pc.get("abc").sql, "abc")
+	pc.cache("abc", nil, nil, 0)
+	require.True(t, pc.isCached("abc", 0))
+	require.Equal(t, pc.get("abc", 0).sql, "abc")
 
-	pc.cache("abcd", nil, nil)
-	require.True(t, pc.isCached("abcd"))
-	require.Equal(t, pc.get("abcd").sql, "abcd")
+	pc.cache("abcd", nil, nil, 0)
+	require.True(t, pc.isCached("abcd", 0))
+	require.Equal(t, pc.get("abcd", 0).sql, "abcd")
 
-	require.False(t, pc.isCached("abcde"))
+	require.False(t, pc.isCached("abcde", 0))
 }
 
 func Test_LRU(t *testing.T) {
 	pc := newPlanCache(3)
 
-	pc.cache("1", nil, nil)
-	pc.cache("2", nil, nil)
-	pc.cache("3", nil, nil)
-	require.True(t, pc.isCached("1"))
-	require.True(t, pc.isCached("2"))
-	require.True(t, pc.isCached("3"))
-
-	pc.cache("4", nil, nil)
-	require.True(t, pc.isCached("4"))
-	require.False(t, pc.isCached("1"))
-
-	require.Equal(t, pc.get("2").sql, "2")
-	pc.cache("5", nil, nil)
-	require.True(t, pc.isCached("5"))
-	require.True(t, pc.isCached("4"))
-	require.True(t, pc.isCached("2"))
-	require.False(t, pc.isCached("3"))
+	pc.cache("1", nil, nil, 0)
+	pc.cache("2", nil, nil, 0)
+	pc.cache("3", nil, nil, 0)
+	require.True(t, pc.isCached("1", 0))
+	require.True(t, pc.isCached("2", 0))
+	require.True(t, pc.isCached("3", 0))
+
+	pc.cache("4", nil, nil, 0)
+	require.True(t, pc.isCached("4", 0))
+	require.False(t, pc.isCached("1", 0))
+
+	require.Equal(t, pc.get("2", 0).sql, "2")
+	pc.cache("5", nil, nil, 0)
+	require.True(t, pc.isCached("5", 0))
+	require.True(t, pc.isCached("4", 0))
+	require.True(t, pc.isCached("2", 0))
+	require.False(t, pc.isCached("3", 0))
 }
 
 func Test_CleanCache(t *testing.T) {
 	pc := newPlanCache(3)
 
-	pc.cache("1", nil, nil)
-	pc.cache("2", nil, nil)
-	pc.cache("3", nil, nil)
-	require.True(t, pc.isCached("1"))
-	require.True(t, pc.isCached("2"))
-	require.True(t, pc.isCached("3"))
+	pc.cache("1", nil, nil, 0)
+	pc.cache("2", nil, nil, 0)
+	pc.cache("3", nil, nil, 0)
+	require.True(t, pc.isCached("1", 0))
+	require.True(t, pc.isCached("2", 0))
+	require.True(t, pc.isCached("3", 0))
 
 	pc.clean()
 
-	require.False(t, pc.isCached("1"))
-	require.False(t, pc.isCached("2"))
-	require.False(t, pc.isCached("3"))
+	require.False(t, pc.isCached("1", 0))
+	require.False(t, pc.isCached("2", 0))
+	require.False(t, pc.isCached("3", 0))
+}
+
+func Test_BaselineVersion(t *testing.T) {
+	pc := newPlanCache(3)
+
+	pc.cache("1", nil, nil, 1)
+	require.True(t, pc.isCached("1", 1))
+	// the plan built before the plan baselines changed is not used
+	require.False(t, pc.isCached("1", 2))
+	require.Nil(t, pc.get("1", 2))
+
+	pc.cache("1", nil, nil, 2)
+	require.Equal(t, 1, pc.lruList.Len())
+	require.Equal(t, "1", pc.get("1", 2).sql)
 }
@@ -0,0 +1,1317 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// the stored procedures are saved in mo_user_defined_function with type 'PROCEDURE'.
+const (
+	checkProcedureExistenceFormat = `select function_id from mo_catalog.mo_user_defined_function where name = "%s" and db = "%s" and type = "PROCEDURE";`
+
+	getProcedureFormat = `select args, body from mo_catalog.mo_user_defined_function where name = "%s" and db = "%s" and type = "PROCEDURE";`
+
+	deleteProcedureFormat = `delete from mo_catalog.mo_user_defined_function where name = "%s" and db = "%s" and type = "PROCEDURE";`
+)
+
+// procedureArg is the json form of a parameter of the stored procedure in the args column.
+type procedureArg struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Mode string `json:"mode"`
+}
+
+func escapeSqlString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`, `"`, `\"`).Replace(s)
+}
+
+// formatProcedureType formats the type which can be parsed again, the display width of the
+// time types is the length of the text, the precision of them is the scale.
+func formatProcedureType(typ *tree.T) string {
+	fs := strings.ToLower(typ.InternalType.FamilyString)
+	switch fs {
+	case "datetime", "timestamp", "time":
+		if typ.InternalType.Scale > 0 {
+			return fmt.Sprintf("%s(%d)", fs, typ.InternalType.Scale)
+		}
+		return fs
+	}
+	fmtctx := tree.NewFmtCtx(dialect.MYSQL)
+	typ.InternalType.Format(fmtctx)
+	return fmtctx.String()
+}
+
+func getProcedureDatabase(ses *Session, name *tree.ProcedureName) (string, error) {
+	if name.HasNoNameQualifier() {
+		if ses.DatabaseNameIsEmpty() {
+			return "", moerr.NewNoDBNoCtx()
+		}
+		return ses.GetDatabaseName(), nil
+	}
+	return string(name.Name.SchemaName), nil
+}
+
+type procedureLabel struct {
+	name string
+	loop bool
+}
+
+// checkProcedureBody checks the statements in the body of the procedure which can be found
+// before they are executed.
+func checkProcedureBody(ctx context.Context, stmt tree.Statement, labels []procedureLabel) error {
+	if ps, ok := stmt.(*tree.ProcedureStmt); ok {
+		stmt = ps.Stmt
+	}
+	checkStmts := func(stmts []tree.Statement, labels []procedureLabel) error {
+		for _, s := range stmts {
+			if err := checkProcedureBody(ctx, s, labels); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	findLabel := func(label tree.Identifier, loop bool) bool {
+		for _, l := range labels {
+			if strings.EqualFold(l.name, string(label)) && (l.loop || !loop) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch st := stmt.(type) {
+	case *tree.CreateProcedure:
+		return moerr.NewSPNoRecursiveCreate(ctx)
+	case *tree.CompoundStmt:
+		return checkStmts(st.Stmts, append(labels, procedureLabel{name: string(st.Label)}))
+	case *tree.IfStmt:
+		if err := checkStmts(st.Body, labels); err != nil {
+			return err
+		}
+		for _, elif := range st.Elifs {
+			if err := checkStmts(elif.Body, labels); err != nil {
+				return err
+			}
+		}
+		return checkStmts(st.Else, labels)
+	case *tree.WhileStmt:
+		return checkStmts(st.Body, append(labels, procedureLabel{name: string(st.Label), loop: true}))
+	case *tree.LoopStmt:
+		return checkStmts(st.Body, append(labels, procedureLabel{name: string(st.Label), loop: true}))
+	case *tree.RepeatStmt:
+		return checkStmts(st.Body, append(labels, procedureLabel{name: string(st.Label), loop: true}))
+	case *tree.DeclareHandler:
+		return checkProcedureBody(ctx, st.Stmt, labels)
+	case *tree.LeaveStmt:
+		if !findLabel(st.Label, false) {
+			return moerr.NewSPLabelMismatch(ctx, "LEAVE", string(st.Label))
+		}
+	case *tree.IterateStmt:
+		if !findLabel(st.Label, true) {
+			return moerr.NewSPLabelMismatch(ctx, "ITERATE", string(st.Label))
+		}
+	}
+	return nil
+}
+
+// InitProcedure saves the stored procedure into mo_user_defined_function
+func InitProcedure(ctx context.Context, ses *Session, tenant *TenantInfo, cp *tree.CreateProcedure) error {
+	var err error
+	var dbName string
+	var argsJson []byte
+	var erArray []ExecResult
+	var initMoProcedure string
+
+	dbName, err = getProcedureDatabase(ses, cp.Name)
+	if err != nil {
+		return err
+	}
+	name := string(cp.Name.Name.ObjectName)
+
+	err = checkProcedureBody(ctx, cp.Body, nil)
+	if err != nil {
+		return err
+	}
+
+	args := make([]procedureArg, len(cp.Args))
+	for i, arg := range cp.Args {
+		args[i] = procedureArg{
+			Name: arg.Name.Parts[0],
+			Type: formatProcedureType(arg.Type.(*tree.T)),
+			Mode: arg.InOutType.String(),
+		}
+	}
+	argsJson, err = json.Marshal(args)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	// validate duplicate procedure declaration
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, fmt.Sprintf(checkProcedureExistenceFormat, escapeSqlString(name), escapeSqlString(dbName)))
+	if err != nil {
+		return err
+	}
+
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+
+	if execResultArrayHasData(erArray) {
+		return moerr.NewProcedureAlreadyExists(ctx, name)
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	initMoProcedure = fmt.Sprintf(initMoUserDefinedFunctionFormat,
+		escapeSqlString(name),
+		escapeSqlString(string(argsJson)),
+		"", escapeSqlString(cp.Body.(*tree.ProcedureStmt).Source.Text), "SQL", escapeSqlString(dbName),
+		tenant.User, types.CurrentTimestamp().String2(time.UTC, 0), types.CurrentTimestamp().String2(time.UTC, 0), "PROCEDURE", "INVOKER", "", "utf8mb4", "utf8mb4_0900_ai_ci", "utf8mb4_0900_ai_ci")
+	err = bh.Exec(ctx, initMoProcedure)
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	return err
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+func doDropProcedure(ctx context.Context, ses *Session, dp *tree.DropProcedure) error {
+	var err error
+	var dbName string
+	var erArray []ExecResult
+
+	dbName, err = getProcedureDatabase(ses, dp.Name)
+	if err != nil {
+		return err
+	}
+	name := string(dp.Name.Name.ObjectName)
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, fmt.Sprintf(checkProcedureExistenceFormat, escapeSqlString(name), escapeSqlString(dbName)))
+	if err != nil {
+		return err
+	}
+
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+
+	if !execResultArrayHasData(erArray) {
+		if dp.IfExists {
+			return nil
+		}
+		return moerr.NewNoProcedure(ctx, dbName+"."+name)
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, fmt.Sprintf(deleteProcedureFormat, escapeSqlString(name), escapeSqlString(dbName)))
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	return err
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// doCallProcedure runs the procedure called by the CALL statement, it returns the result sets of
+// the SELECT statements in the procedure.
+func doCallProcedure(ctx context.Context, ses *Session, call *tree.CallStmt) ([]*MysqlResultSet, error) {
+	dbName, err := getProcedureDatabase(ses, call.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := ses.GetGlobalVar("lower_case_table_names")
+	if err != nil {
+		return nil, err
+	}
+
+	// the statements in the procedure are executed with the privileges of the caller
+	// (SQL SECURITY INVOKER) and in the transaction of the caller.
+	bh := ses.GetShareTxnBackgroundExec(ctx)
+	defer bh.Close()
+	sysBh := ses.GetBackgroundExec(ctx)
+	defer sysBh.Close()
+
+	routine := &procedureRoutine{
+		ctx:   ctx,
+		ses:   ses,
+		bh:    bh,
+		sysBh: sysBh,
+		lower: v.(int64),
+		db:    ses.GetDatabaseName(),
+	}
+
+	// the arguments of the top level CALL can only refer to the user variables
+	fmtctx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	args := make([]procedureArgRef, len(call.Args))
+	for i, arg := range call.Args {
+		arg.Format(fmtctx)
+		text := fmtctx.String()
+		fmtctx.Reset()
+		args[i] = procedureArgRef{
+			expr:  arg,
+			src:   &tree.ProcedureSource{Text: text},
+			start: 0,
+			end:   len(text),
+		}
+	}
+
+	if err = routine.call(nil, dbName, string(call.Name.Name.ObjectName), args); err != nil {
+		return nil, err
+	}
+	return routine.results, nil
+}
+
+// procedureRoutine runs the stored procedures called by a CALL statement. The statements are
+// executed by the background executor one by one after the local variables and the user
+// variables in them are replaced by their values.
+type procedureRoutine struct {
+	ctx context.Context
+	ses *Session
+	// bh shares the transaction and the privileges of the caller.
+	bh BackgroundExec
+	// sysBh reads the definitions of the procedures from mo_catalog.
+	sysBh BackgroundExec
+	lower int64
+	// db is the current database of the background session.
+	db string
+	// callStack is the names of the procedures being executed.
+	callStack []string
+	// results are the result sets of the SELECT statements to send to the client.
+	results []*MysqlResultSet
+}
+
+type procedureVar struct {
+	typ   *tree.T
+	value interface{}
+}
+
+type procedureCursor struct {
+	src *tree.ProcedureSource
+	// start is the offset of the SELECT statement of the cursor in src.
+	start int
+	rows  [][]interface{}
+	next  int
+	open  bool
+}
+
+type procedureHandler struct {
+	*tree.DeclareHandler
+	scope *procedureScope
+	// active is true when the handler is being executed, a handler does not handle the
+	// conditions raised by itself.
+	active bool
+}
+
+// procedureScope holds the variables, the cursors and the handlers declared in a BEGIN ... END
+type procedureScope struct {
+	parent   *procedureScope
+	vars     map[string]*procedureVar
+	cursors  map[string]*procedureCursor
+	handlers []*procedureHandler
+}
+
+func newProcedureScope(parent *procedureScope) *procedureScope {
+	return &procedureScope{
+		parent:  parent,
+		vars:    make(map[string]*procedureVar),
+		cursors: make(map[string]*procedureCursor),
+	}
+}
+
+func (scope *procedureScope) lookupVar(name string) *procedureVar {
+	name = strings.ToLower(name)
+	for s := scope; s != nil; s = s.parent {
+		if v, ok := s.vars[name]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+func (scope *procedureScope) lookupCursor(name string) *procedureCursor {
+	name = strings.ToLower(name)
+	for s := scope; s != nil; s = s.parent {
+		if c, ok := s.cursors[name]; ok {
+			return c
+		}
+	}
+	return nil
+}
+
+// procedureLeave unwinds to the statement labeled by LEAVE.
+type procedureLeave struct {
+	label string
+}
+
+func (l *procedureLeave) Error() string {
+	return "LEAVE " + l.label
+}
+
+// procedureIterate unwinds to the loop labeled by ITERATE.
+type procedureIterate struct {
+	label string
+}
+
+func (i *procedureIterate) Error() string {
+	return "ITERATE " + i.label
+}
+
+// procedureExit unwinds to the BEGIN ... END declaring the EXIT handler.
+type procedureExit struct {
+	scope *procedureScope
+}
+
+func (e *procedureExit) Error() string {
+	return "EXIT"
+}
+
+func isProcedureSignal(err error) bool {
+	switch err.(type) {
+	case *procedureLeave, *procedureIterate, *procedureExit:
+		return true
+	}
+	return false
+}
+
+// loopControl consumes the LEAVE and ITERATE of the loop labeled label, it returns true if the
+// loop should stop.
+func loopControl(label tree.Identifier, err error) (bool, error) {
+	switch e := err.(type) {
+	case nil:
+		return false, nil
+	case *procedureLeave:
+		if len(label) > 0 && strings.EqualFold(e.label, string(label)) {
+			return true, nil
+		}
+	case *procedureIterate:
+		if len(label) > 0 && strings.EqualFold(e.label, string(label)) {
+			return false, nil
+		}
+	}
+	return true, err
+}
+
+// procedureArgRef is an argument of CALL, which is the text from start to end in src.
+type procedureArgRef struct {
+	expr  tree.Expr
+	src   *tree.ProcedureSource
+	start int
+	end   int
+}
+
+type procedureToken struct {
+	id    int
+	val   string
+	start int
+	end   int
+}
+
+// scanProcedureTokens splits the text into the tokens, the offsets of them start from base.
+func scanProcedureTokens(text string, base int) []procedureToken {
+	var tokens []procedureToken
+	scanner := mysql.NewScanner(dialect.MYSQL, text)
+	for {
+		id, val := scanner.Scan()
+		if id == 0 || id == mysql.LEX_ERROR {
+			return tokens
+		}
+		if id == mysql.COMMENT {
+			continue
+		}
+		tokens = append(tokens, procedureToken{id: id, val: val, start: base + scanner.TokenStart, end: base + scanner.Pos})
+	}
+}
+
+// splitProcedureTokens splits the tokens by the commas out of any parentheses.
+func splitProcedureTokens(tokens []procedureToken) [][]procedureToken {
+	var parts [][]procedureToken
+	depth, begin := 0, 0
+	for i, token := range tokens {
+		switch token.id {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, tokens[begin:i])
+				begin = i + 1
+			}
+		}
+	}
+	return append(parts, tokens[begin:])
+}
+
+// findProcedureToken returns the index of the first token of id out of any parentheses.
+func findProcedureToken(tokens []procedureToken, ids ...int) int {
+	depth := 0
+	for i, token := range tokens {
+		switch token.id {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		default:
+			if depth != 0 {
+				continue
+			}
+			for _, id := range ids {
+				if token.id == id {
+					return i
+				}
+			}
+		}
+	}
+	return -1
+}
+
+// normalizeProcedureValue converts the value of a result set into nil, bool, int64, uint64,
+// float64 or string.
+func normalizeProcedureValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil, bool, int64, uint64, float64, string:
+		return v
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int:
+		return int64(v)
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uint32:
+		return uint64(v)
+	case float32:
+		return float64(v)
+	case []byte:
+		return string(v)
+	case *plan.Expr:
+		// decimal user variable
+		if c, ok := v.Expr.(*plan.Expr_C); ok {
+			switch d := c.C.Value.(type) {
+			case *plan.Const_Decimal64Val:
+				return types.Decimal64(d.Decimal64Val.A).Format(v.Typ.Scale)
+			case *plan.Const_Decimal128Val:
+				return types.Decimal128{B0_63: uint64(d.Decimal128Val.A), B64_127: uint64(d.Decimal128Val.B)}.Format(v.Typ.Scale)
+			}
+		}
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(value)
+}
+
+// procedureLiteral formats the value of a variable as a literal in SQL.
+func procedureLiteral(typ *tree.T, value interface{}) string {
+	switch v := normalizeProcedureValue(value).(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		quoted := "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v) + "'"
+		if typ == nil {
+			return quoted
+		}
+		switch typ.InternalType.Family {
+		case tree.StringFamily, tree.EnumFamily, tree.SetFamily:
+			return quoted
+		}
+		return fmt.Sprintf("cast(%s as %s)", quoted, formatProcedureType(typ))
+	}
+	return "null"
+}
+
+// isProcedureValueTrue is the truth of the condition of IF, WHILE and REPEAT.
+func isProcedureValueTrue(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case int64:
+		return v != 0
+	case uint64:
+		return v != 0
+	case float64:
+		return v != 0
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return err == nil && f != 0
+	}
+	return false
+}
+
+// procedureEdit replaces the text from start to end with text.
+type procedureEdit struct {
+	start int
+	end   int
+	text  string
+}
+
+// rewrite returns the text from start to end in src, in which the local variables and the user
+// variables are replaced by their values.
+func (r *procedureRoutine) rewrite(scope *procedureScope, src *tree.ProcedureSource, start, end int, edits []procedureEdit) (string, error) {
+	for _, offset := range src.Idents {
+		if offset < start || offset >= end {
+			continue
+		}
+		scanner := mysql.NewScanner(dialect.MYSQL, src.Text[offset:end])
+		_, name := scanner.Scan()
+		if v := scope.lookupVar(name); v != nil {
+			edits = append(edits, procedureEdit{start: offset, end: offset + scanner.Pos, text: procedureLiteral(v.typ, v.value)})
+		}
+	}
+
+	tokens := scanProcedureTokens(src.Text[start:end], start)
+	for i, token := range tokens {
+		if token.id != mysql.AT_ID || token.val == "" {
+			continue
+		}
+		// @var := expr assigns the user variable
+		if i+1 < len(tokens) && tokens[i+1].id == mysql.ASSIGNMENT {
+			continue
+		}
+		_, value, err := r.ses.GetUserDefinedVar(token.val)
+		if err != nil {
+			return "", err
+		}
+		edits = append(edits, procedureEdit{start: token.start, end: token.end, text: procedureLiteral(nil, value)})
+	}
+
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].start < edits[j].start
+	})
+	var sb strings.Builder
+	pos := start
+	for _, edit := range edits {
+		if edit.start < pos {
+			// inside a removed range
+			continue
+		}
+		sb.WriteString(src.Text[pos:edit.start])
+		sb.WriteString(edit.text)
+		pos = edit.end
+	}
+	sb.WriteString(src.Text[pos:end])
+	return sb.String(), nil
+}
+
+func (r *procedureRoutine) exec(sql string) error {
+	r.bh.ClearExecResultSet()
+	return r.bh.Exec(r.ctx, sql)
+}
+
+func (r *procedureRoutine) query(sql string) ([]*MysqlResultSet, error) {
+	return r.queryBy(r.bh, sql)
+}
+
+func (r *procedureRoutine) queryBy(bh BackgroundExec, sql string) ([]*MysqlResultSet, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(r.ctx, sql); err != nil {
+		return nil, err
+	}
+	var sets []*MysqlResultSet
+	for _, result := range bh.GetExecResultSet() {
+		mrs, ok := result.(*MysqlResultSet)
+		if !ok {
+			return nil, moerr.NewInternalError(r.ctx, "it is not the type of result set")
+		}
+		// the result set is appended for every batch of the result
+		if len(sets) != 0 && sets[len(sets)-1] == mrs {
+			continue
+		}
+		sets = append(sets, mrs)
+	}
+	return sets, nil
+}
+
+func (r *procedureRoutine) queryRows(sql string) ([][]interface{}, error) {
+	return r.queryRowsBy(r.bh, sql)
+}
+
+func (r *procedureRoutine) queryRowsBy(bh BackgroundExec, sql string) ([][]interface{}, error) {
+	sets, err := r.queryBy(bh, sql)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]interface{}
+	for _, mrs := range sets {
+		rows = append(rows, mrs.Data[:mrs.GetRowCount()]...)
+	}
+	return rows, nil
+}
+
+// eval evaluates the expression from start to end in src, the value is converted to typ if
+// it is not nil.
+func (r *procedureRoutine) eval(scope *procedureScope, src *tree.ProcedureSource, start, end int, typ *tree.T) (interface{}, error) {
+	expr, err := r.rewrite(scope, src, start, end, nil)
+	if err != nil {
+		return nil, err
+	}
+	sql := "select " + expr
+	if typ != nil {
+		sql = fmt.Sprintf("select cast((%s) as %s)", expr, formatProcedureType(typ))
+	}
+	rows, err := r.queryRows(sql)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return nil, nil
+	}
+	return normalizeProcedureValue(rows[0][0]), nil
+}
+
+func (r *procedureRoutine) evalCond(scope *procedureScope, src *tree.ProcedureSource) (bool, error) {
+	value, err := r.eval(scope, src, 0, len(src.Text), nil)
+	if err != nil {
+		return false, err
+	}
+	return isProcedureValueTrue(value), nil
+}
+
+// assign sets the local variable or the user variable starting with @.
+func (r *procedureRoutine) assign(scope *procedureScope, name string, value interface{}) error {
+	if strings.HasPrefix(name, "@") {
+		return r.ses.SetUserDefinedVar(name[1:], normalizeProcedureValue(value))
+	}
+	v := scope.lookupVar(name)
+	if v == nil {
+		return moerr.NewSPUndeclaredVar(r.ctx, name)
+	}
+	v.value = normalizeProcedureValue(value)
+	return nil
+}
+
+// raise runs the handler of the condition of err, it returns false if there is no handler.
+// The most specific handler in the innermost scope is chosen.
+func (r *procedureRoutine) raise(scope *procedureScope, err error) (bool, error) {
+	moErr, ok := err.(*moerr.Error)
+	if !ok {
+		return false, nil
+	}
+	for s := scope; s != nil; s = s.parent {
+		var handler *procedureHandler
+		best := 0
+		for _, h := range s.handlers {
+			if h.active {
+				continue
+			}
+			if priority := matchProcedureHandler(h.DeclareHandler, moErr); priority > best {
+				handler, best = h, priority
+			}
+		}
+		if handler == nil {
+			continue
+		}
+		handler.active = true
+		err = r.execStmt(newProcedureScope(handler.scope), handler.Stmt)
+		handler.active = false
+		if err == nil && handler.Action == tree.HANDLER_EXIT {
+			err = &procedureExit{scope: handler.scope}
+		}
+		return true, err
+	}
+	return false, nil
+}
+
+// matchProcedureHandler returns the priority of the handler for the error, 0 means unmatched.
+func matchProcedureHandler(handler *tree.DeclareHandler, err *moerr.Error) int {
+	state := err.SqlState()
+	best := 0
+	for _, cond := range handler.Conditions {
+		priority := 0
+		switch cond.Type {
+		case tree.HANDLER_ERROR_CODE:
+			if cond.ErrorCode == err.MySQLCode() {
+				priority = 3
+			}
+		case tree.HANDLER_SQLSTATE:
+			if cond.SqlState == state {
+				priority = 2
+			}
+		case tree.HANDLER_NOT_FOUND:
+			if strings.HasPrefix(state, "02") {
+				priority = 1
+			}
+		case tree.HANDLER_SQLWARNING:
+			if strings.HasPrefix(state, "01") {
+				priority = 1
+			}
+		case tree.HANDLER_SQLEXCEPTION:
+			if !strings.HasPrefix(state, "00") && !strings.HasPrefix(state, "01") && !strings.HasPrefix(state, "02") {
+				priority = 1
+			}
+		}
+		if priority > best {
+			best = priority
+		}
+	}
+	return best
+}
+
+// call runs the procedure db.name with the arguments evaluated in the scope of the caller.
+func (r *procedureRoutine) call(caller *procedureScope, db, name string, args []procedureArgRef) (err error) {
+	fullName := db + "." + name
+	for _, called := range r.callStack {
+		if strings.EqualFold(called, fullName) {
+			return moerr.NewSPRecursionLimit(r.ctx, 0, name)
+		}
+	}
+
+	// the CALL statement of the client is checked before it is executed
+	if caller != nil && r.ses.GetTenantInfo() != nil {
+		stmt := &tree.CallStmt{Name: tree.NewProcedureName(tree.Identifier(name), tree.ObjectNamePrefix{
+			SchemaName:     tree.Identifier(db),
+			ExplicitSchema: true,
+		})}
+		if err = authenticateUserCanExecuteStatement(r.ctx, r.ses, stmt); err != nil {
+			return err
+		}
+	}
+
+	rows, err := r.queryRowsBy(r.sysBh, fmt.Sprintf(getProcedureFormat, escapeSqlString(name), escapeSqlString(db)))
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return moerr.NewNoProcedure(r.ctx, fullName)
+	}
+	cp, err := r.parseProcedure(name, normalizeProcedureValue(rows[0][0]), normalizeProcedureValue(rows[0][1]))
+	if err != nil {
+		return err
+	}
+	if len(args) != len(cp.Args) {
+		return moerr.NewSPWrongNumberOfArgs(r.ctx, fullName, len(cp.Args), len(args))
+	}
+
+	scope := newProcedureScope(nil)
+	for i, param := range cp.Args {
+		typ := param.Type.(*tree.T)
+		v := &procedureVar{typ: typ}
+		if param.InOutType != tree.TYPE_IN && procedureArgTarget(caller, args[i].expr) == "" {
+			return moerr.NewSPNotVarArg(r.ctx, i+1, fullName)
+		}
+		if param.InOutType != tree.TYPE_OUT {
+			if v.value, err = r.eval(caller, args[i].src, args[i].start, args[i].end, typ); err != nil {
+				return err
+			}
+		}
+		scope.vars[strings.ToLower(param.Name.Parts[0])] = v
+	}
+
+	if !strings.EqualFold(r.db, db) {
+		if err = r.exec(fmt.Sprintf("use `%s`", db)); err != nil {
+			return err
+		}
+		if r.db != "" {
+			defer func(db string) {
+				if err2 := r.exec(fmt.Sprintf("use `%s`", db)); err == nil {
+					err = err2
+				}
+				r.db = db
+			}(r.db)
+		}
+		r.db = db
+	}
+
+	r.callStack = append(r.callStack, fullName)
+	err = r.execStmt(scope, cp.Body)
+	r.callStack = r.callStack[:len(r.callStack)-1]
+	if err != nil {
+		if isProcedureSignal(err) {
+			return moerr.NewInternalError(r.ctx, "unexpected %s in procedure %s", err.Error(), fullName)
+		}
+		return err
+	}
+
+	for i, param := range cp.Args {
+		if param.InOutType == tree.TYPE_IN {
+			continue
+		}
+		value := scope.vars[strings.ToLower(param.Name.Parts[0])].value
+		if err = r.assign(caller, procedureArgTarget(caller, args[i].expr), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// procedureArgTarget returns the variable receiving the value of the OUT or INOUT parameter,
+// it is a user variable or a local variable of the caller.
+func procedureArgTarget(caller *procedureScope, expr tree.Expr) string {
+	switch e := expr.(type) {
+	case *tree.VarExpr:
+		if !e.System {
+			return "@" + e.Name
+		}
+	case *tree.UnresolvedName:
+		if e.NumParts == 1 && caller.lookupVar(e.Parts[0]) != nil {
+			return e.Parts[0]
+		}
+	}
+	return ""
+}
+
+// parseProcedure rebuilds the CREATE PROCEDURE statement from mo_user_defined_function.
+func (r *procedureRoutine) parseProcedure(name string, argsJson, body interface{}) (*tree.CreateProcedure, error) {
+	var args []procedureArg
+	if s, ok := argsJson.(string); ok && s != "" {
+		if err := json.Unmarshal([]byte(s), &args); err != nil {
+			return nil, err
+		}
+	}
+	text, _ := body.(string)
+
+	var sb strings.Builder
+	sb.WriteString("create procedure `")
+	sb.WriteString(name)
+	sb.WriteString("` (")
+	for i, arg := range args {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%s `%s` %s", arg.Mode, arg.Name, arg.Type))
+	}
+	sb.WriteString(") ")
+	sb.WriteString(text)
+
+	stmt, err := mysql.ParseOne(r.ctx, sb.String(), r.lower)
+	if err != nil {
+		return nil, err
+	}
+	cp, ok := stmt.(*tree.CreateProcedure)
+	if !ok {
+		return nil, moerr.NewInternalError(r.ctx, "invalid procedure %s", name)
+	}
+	return cp, nil
+}
+
+func (r *procedureRoutine) execStmts(scope *procedureScope, stmts []tree.Statement) error {
+	for _, stmt := range stmts {
+		err := r.execStmt(scope, stmt)
+		if err == nil {
+			continue
+		}
+		if isProcedureSignal(err) {
+			return err
+		}
+		handled, err2 := r.raise(scope, err)
+		if !handled {
+			return err
+		}
+		if err2 != nil {
+			return err2
+		}
+	}
+	return nil
+}
+
+func (r *procedureRoutine) execStmt(scope *procedureScope, stmt tree.Statement) error {
+	if err := r.ctx.Err(); err != nil {
+		return err
+	}
+	ps := stmt.(*tree.ProcedureStmt)
+	src := ps.Source
+	switch st := ps.Stmt.(type) {
+	case *tree.CompoundStmt:
+		inner := newProcedureScope(scope)
+		err := r.execStmts(inner, st.Stmts)
+		switch e := err.(type) {
+		case *procedureLeave:
+			if len(st.Label) > 0 && strings.EqualFold(e.label, string(st.Label)) {
+				return nil
+			}
+		case *procedureExit:
+			if e.scope == inner {
+				return nil
+			}
+		}
+		return err
+	case *tree.IfStmt:
+		ok, err := r.evalCond(scope, st.CondSource)
+		if err != nil {
+			return err
+		} else if ok {
+			return r.execStmts(scope, st.Body)
+		}
+		for _, elif := range st.Elifs {
+			if ok, err = r.evalCond(scope, elif.CondSource); err != nil {
+				return err
+			} else if ok {
+				return r.execStmts(scope, elif.Body)
+			}
+		}
+		return r.execStmts(scope, st.Else)
+	case *tree.WhileStmt:
+		for {
+			ok, err := r.evalCond(scope, st.CondSource)
+			if err != nil || !ok {
+				return err
+			}
+			if stop, err := loopControl(st.Label, r.execStmts(scope, st.Body)); stop {
+				return err
+			}
+			if err = r.ctx.Err(); err != nil {
+				return err
+			}
+		}
+	case *tree.LoopStmt:
+		for {
+			if stop, err := loopControl(st.Label, r.execStmts(scope, st.Body)); stop {
+				return err
+			}
+			if err := r.ctx.Err(); err != nil {
+				return err
+			}
+		}
+	case *tree.RepeatStmt:
+		for {
+			if stop, err := loopControl(st.Label, r.execStmts(scope, st.Body)); stop {
+				return err
+			}
+			ok, err := r.evalCond(scope, st.CondSource)
+			if err != nil || ok {
+				return err
+			}
+		}
+	case *tree.LeaveStmt:
+		return &procedureLeave{label: string(st.Label)}
+	case *tree.IterateStmt:
+		return &procedureIterate{label: string(st.Label)}
+	case *tree.Declare:
+		return r.declareVars(scope, st, src)
+	case *tree.DeclareCursor:
+		tokens := scanProcedureTokens(src.Text, 0)
+		i := findProcedureToken(tokens, mysql.FOR)
+		if i < 0 || i+1 >= len(tokens) {
+			return moerr.NewInternalError(r.ctx, "invalid cursor declaration: %s", src.Text)
+		}
+		scope.cursors[strings.ToLower(string(st.Name))] = &procedureCursor{src: src, start: tokens[i+1].start}
+		return nil
+	case *tree.DeclareHandler:
+		scope.handlers = append(scope.handlers, &procedureHandler{DeclareHandler: st, scope: scope})
+		return nil
+	case *tree.OpenCursor:
+		cursor := scope.lookupCursor(string(st.Name))
+		if cursor == nil {
+			return moerr.NewSPUndeclaredCursor(r.ctx, string(st.Name))
+		}
+		if cursor.open {
+			return moerr.NewSPCursorAlreadyOpen(r.ctx)
+		}
+		sql, err := r.rewrite(scope, cursor.src, cursor.start, len(cursor.src.Text), nil)
+		if err != nil {
+			return err
+		}
+		if cursor.rows, err = r.queryRows(sql); err != nil {
+			return err
+		}
+		cursor.next, cursor.open = 0, true
+		return nil
+	case *tree.FetchCursor:
+		cursor := scope.lookupCursor(string(st.Name))
+		if cursor == nil {
+			return moerr.NewSPUndeclaredCursor(r.ctx, string(st.Name))
+		}
+		if !cursor.open {
+			return moerr.NewSPCursorNotOpen(r.ctx)
+		}
+		if cursor.next >= len(cursor.rows) {
+			return moerr.NewSPFetchNoData(r.ctx)
+		}
+		row := cursor.rows[cursor.next]
+		if len(row) != len(st.Vars) {
+			return moerr.NewSPWrongNumberOfFetchArgs(r.ctx)
+		}
+		cursor.next++
+		for i, name := range st.Vars {
+			if err := r.assign(scope, name, row[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *tree.CloseCursor:
+		cursor := scope.lookupCursor(string(st.Name))
+		if cursor == nil {
+			return moerr.NewSPUndeclaredCursor(r.ctx, string(st.Name))
+		}
+		if !cursor.open {
+			return moerr.NewSPCursorNotOpen(r.ctx)
+		}
+		cursor.rows, cursor.next, cursor.open = nil, 0, false
+		return nil
+	case *tree.CallStmt:
+		return r.callInProcedure(scope, st, src)
+	case *tree.SetVar:
+		return r.setVars(scope, st, src)
+	case *tree.Select:
+		if clause, ok := st.Select.(*tree.SelectClause); ok && len(clause.IntoVars) != 0 {
+			return r.selectInto(scope, clause.IntoVars, src)
+		}
+		return r.execResult(scope, src)
+	case *tree.ValuesStatement:
+		return r.execResult(scope, src)
+	default:
+		sql, err := r.rewrite(scope, src, 0, len(src.Text), nil)
+		if err != nil {
+			return err
+		}
+		return r.exec(sql)
+	}
+}
+
+// execResult runs the statement producing a result set. The background executor does not keep
+// the result set without any row, so it can not be sent to the client.
+func (r *procedureRoutine) execResult(scope *procedureScope, src *tree.ProcedureSource) error {
+	sql, err := r.rewrite(scope, src, 0, len(src.Text), nil)
+	if err != nil {
+		return err
+	}
+	sets, err := r.query(sql)
+	if err != nil {
+		return err
+	}
+	r.results = append(r.results, sets...)
+	return nil
+}
+
+func (r *procedureRoutine) declareVars(scope *procedureScope, st *tree.Declare, src *tree.ProcedureSource) error {
+	// a declaration without DEFAULT clause has the NULL value
+	var value interface{}
+	tokens := scanProcedureTokens(src.Text, 0)
+	if i := findProcedureToken(tokens, mysql.DEFAULT); i >= 0 {
+		if i+1 >= len(tokens) {
+			return moerr.NewInternalError(r.ctx, "invalid variable declaration: %s", src.Text)
+		}
+		var err error
+		if value, err = r.eval(scope, src, tokens[i+1].start, len(src.Text), st.ColumnType); err != nil {
+			return err
+		}
+	}
+	for _, name := range st.Variables {
+		scope.vars[strings.ToLower(name)] = &procedureVar{typ: st.ColumnType, value: value}
+	}
+	return nil
+}
+
+// setVars assigns the local variables and the user variables, the system variables are set
+// in the session of the client.
+func (r *procedureRoutine) setVars(scope *procedureScope, st *tree.SetVar, src *tree.ProcedureSource) error {
+	tokens := scanProcedureTokens(src.Text, 0)
+	if len(tokens) == 0 {
+		return moerr.NewInternalError(r.ctx, "invalid set statement: %s", src.Text)
+	}
+	parts := splitProcedureTokens(tokens[1:])
+	if len(parts) != len(st.Assignments) {
+		return moerr.NewInternalError(r.ctx, "invalid set statement: %s", src.Text)
+	}
+	for i, assign := range st.Assignments {
+		part := parts[i]
+		if len(part) == 0 {
+			return moerr.NewInternalError(r.ctx, "invalid set statement: %s", src.Text)
+		}
+		start, end := part[0].start, part[len(part)-1].end
+		eq := findProcedureToken(part, '=', mysql.ASSIGNMENT)
+		local := scope.lookupVar(assign.Name)
+		switch {
+		case eq >= 0 && eq+1 < len(part) && assign.System && !assign.Global && local != nil:
+			value, err := r.eval(scope, src, part[eq+1].start, end, local.typ)
+			if err != nil {
+				return err
+			}
+			local.value = value
+		case eq >= 0 && eq+1 < len(part) && !assign.System:
+			value, err := r.eval(scope, src, part[eq+1].start, end, nil)
+			if err != nil {
+				return err
+			}
+			if err = r.ses.SetUserDefinedVar(assign.Name, value); err != nil {
+				return err
+			}
+		default:
+			text, err := r.rewrite(scope, src, start, end, nil)
+			if err != nil {
+				return err
+			}
+			stmt, err := mysql.ParseOne(r.ctx, "set "+text, r.lower)
+			if err != nil {
+				return err
+			}
+			sv, ok := stmt.(*tree.SetVar)
+			if !ok {
+				return moerr.NewInternalError(r.ctx, "invalid set statement: %s", src.Text)
+			}
+			if err = doSetVar(r.ctx, r.ses, sv); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// selectInto runs SELECT ... INTO var_list without the INTO clause and assigns the variables.
+func (r *procedureRoutine) selectInto(scope *procedureScope, vars []string, src *tree.ProcedureSource) error {
+	tokens := scanProcedureTokens(src.Text, 0)
+	i := findProcedureToken(tokens, mysql.INTO)
+	if i < 0 {
+		return moerr.NewInternalError(r.ctx, "invalid select statement: %s", src.Text)
+	}
+	// skip var_name [, var_name] ... where var_name is ident, ident.ident or @ident
+	end := tokens[i].end
+	for j := i + 1; j < len(tokens); j++ {
+		end = tokens[j].end
+		if j+2 < len(tokens) && tokens[j+1].id == '.' {
+			j += 2
+			end = tokens[j].end
+		}
+		if j+1 >= len(tokens) || tokens[j+1].id != ',' {
+			break
+		}
+		j++
+	}
+
+	sql, err := r.rewrite(scope, src, 0, len(src.Text), []procedureEdit{{start: tokens[i].start, end: end}})
+	if err != nil {
+		return err
+	}
+	rows, err := r.queryRows(sql)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		// no data is a warning without any handler
+		if handled, err := r.raise(scope, moerr.NewSPFetchNoData(r.ctx)); handled {
+			return err
+		}
+		return nil
+	}
+	if len(rows) > 1 {
+		return moerr.NewSPTooManyRows(r.ctx)
+	}
+	if len(rows[0]) != len(vars) {
+		return moerr.NewInvalidInput(r.ctx, "the used SELECT statements have a different number of columns")
+	}
+	for j, name := range vars {
+		if err = r.assign(scope, name, rows[0][j]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// callInProcedure runs CALL in a procedure, the arguments are split from the source text.
+func (r *procedureRoutine) callInProcedure(scope *procedureScope, st *tree.CallStmt, src *tree.ProcedureSource) error {
+	var args []procedureArgRef
+	tokens := scanProcedureTokens(src.Text, 0)
+	i := 0
+	for i < len(tokens) && tokens[i].id != '(' {
+		i++
+	}
+	if i < len(tokens) && len(st.Args) != 0 {
+		depth, j := 0, i
+		for ; j < len(tokens); j++ {
+			if tokens[j].id == '(' {
+				depth++
+			} else if tokens[j].id == ')' {
+				if depth--; depth == 0 {
+					break
+				}
+			}
+		}
+		for _, part := range splitProcedureTokens(tokens[i+1 : j]) {
+			if len(part) == 0 {
+				return moerr.NewInternalError(r.ctx, "invalid call statement: %s", src.Text)
+			}
+			args = append(args, procedureArgRef{src: src, start: part[0].start, end: part[len(part)-1].end})
+		}
+	}
+	if len(args) != len(st.Args) {
+		return moerr.NewInternalError(r.ctx, "invalid call statement: %s", src.Text)
+	}
+	for i := range args {
+		args[i].expr = st.Args[i]
+	}
+
+	db := r.db
+	if !st.Name.HasNoNameQualifier() {
+		db = string(st.Name.Name.SchemaName)
+	}
+	return r.call(scope, db, string(st.Name.Name.ObjectName), args)
+}
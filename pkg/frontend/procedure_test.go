@@ -0,0 +1,384 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"go/constant"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+// procedureExecTest records the sqls executed and returns the result sets of them.
+type procedureExecTest struct {
+	currentSql string
+	sqls       []string
+	sql2result map[string]*MysqlResultSet
+	sql2err    map[string]error
+}
+
+func newProcedureExecTest() *procedureExecTest {
+	return &procedureExecTest{
+		sql2result: make(map[string]*MysqlResultSet),
+		sql2err:    make(map[string]error),
+	}
+}
+
+func (bt *procedureExecTest) Close() {
+}
+
+func (bt *procedureExecTest) Exec(ctx context.Context, s string) error {
+	bt.currentSql = s
+	bt.sqls = append(bt.sqls, s)
+	return bt.sql2err[s]
+}
+
+func (bt *procedureExecTest) GetExecResultSet() []interface{} {
+	if mrs, ok := bt.sql2result[bt.currentSql]; ok {
+		// the same result set is appended for every batch
+		return []interface{}{mrs, mrs}
+	}
+	return nil
+}
+
+func (bt *procedureExecTest) ClearExecResultSet() {
+}
+
+var _ BackgroundExec = &procedureExecTest{}
+
+func newMrsForProcedure(rows ...[]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for i := 0; len(rows) > 0 && i < len(rows[0]); i++ {
+		col := &MysqlColumn{}
+		col.SetName(fmt.Sprintf("c%d", i))
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func parseCreateProcedure(t *testing.T, sql string) *tree.CreateProcedure {
+	stmt, err := mysql.ParseOne(context.TODO(), sql, 1)
+	require.NoError(t, err)
+	return stmt.(*tree.CreateProcedure)
+}
+
+// setProcedure makes the procedure created by sql found in db.
+func (bt *procedureExecTest) setProcedure(t *testing.T, db, sql string) {
+	cp := parseCreateProcedure(t, sql)
+	args := "["
+	for i, arg := range cp.Args {
+		if i > 0 {
+			args += ","
+		}
+		args += fmt.Sprintf(`{"name":"%s","type":"%s","mode":"%s"}`, arg.Name.Parts[0], formatProcedureType(arg.Type.(*tree.T)), arg.InOutType)
+	}
+	args += "]"
+	name := string(cp.Name.Name.ObjectName)
+	bt.sql2result[fmt.Sprintf(getProcedureFormat, name, db)] = newMrsForProcedure(
+		[]interface{}{[]byte(args), []byte(cp.Body.(*tree.ProcedureStmt).Source.Text)})
+}
+
+func newProcedureTestSession(t *testing.T, ctrl *gomock.Controller, bt *procedureExecTest) (*Session, func()) {
+	ses := newTestSession(t, ctrl)
+	ses.SetDatabaseName("db1")
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bt)
+	shareBhStub := gostub.StubFunc(&NewShareTxnBackgroundHandler, bt)
+	return ses, func() {
+		shareBhStub.Reset()
+		bhStub.Reset()
+		ses.Dispose()
+	}
+}
+
+func Test_checkProcedureBody(t *testing.T) {
+	ctx := context.TODO()
+	kases := []struct {
+		sql string
+		err string
+	}{
+		{
+			sql: "create procedure p () l1: begin w: while 1 do iterate w; end while; leave l1; end",
+		},
+		{
+			sql: "create procedure p () begin create procedure q () begin end; end",
+			err: "Can't create a PROCEDURE from within another stored routine",
+		},
+		{
+			sql: "create procedure p () begin leave l1; end",
+			err: "LEAVE with no matching label: l1",
+		},
+		{
+			sql: "create procedure p () l1: begin loop iterate l1; end loop; end",
+			err: "ITERATE with no matching label: l1",
+		},
+	}
+	for _, kase := range kases {
+		cp := parseCreateProcedure(t, kase.sql)
+		err := checkProcedureBody(ctx, cp.Body, nil)
+		if kase.err == "" {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+			require.Equal(t, kase.err, err.Error())
+		}
+	}
+}
+
+func Test_initProcedure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+
+	tenant := &TenantInfo{
+		Tenant: sysAccountName,
+		User:   rootName,
+	}
+	cp := parseCreateProcedure(t, `create procedure p (in a int, out b datetime) begin select "x", 'y' into b; end`)
+	err := InitProcedure(ctx, ses, tenant, cp)
+	require.NoError(t, err)
+	require.Len(t, bt.sqls, 4)
+	require.Equal(t, fmt.Sprintf(checkProcedureExistenceFormat, "p", "db1"), bt.sqls[0])
+	require.Contains(t, bt.sqls[2], `[{\"name\":\"a\",\"type\":\"int\",\"mode\":\"in\"},{\"name\":\"b\",\"type\":\"datetime\",\"mode\":\"out\"}]`)
+	require.Contains(t, bt.sqls[2], `"begin select \"x\", \'y\' into b; end"`)
+	require.Contains(t, bt.sqls[2], `"PROCEDURE"`)
+
+	// duplicate procedure
+	bt.sql2result[fmt.Sprintf(checkProcedureExistenceFormat, "p", "db1")] = newMrsForProcedure([]interface{}{int64(1)})
+	err = InitProcedure(ctx, ses, tenant, cp)
+	require.Error(t, err)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrProcedureAlreadyExists))
+}
+
+func Test_doDropProcedure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+
+	dp := &tree.DropProcedure{Name: tree.NewProcedureName("p", tree.ObjectNamePrefix{})}
+	err := doDropProcedure(ctx, ses, dp)
+	require.Error(t, err)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNoProcedure))
+
+	dp.IfExists = true
+	require.NoError(t, doDropProcedure(ctx, ses, dp))
+
+	bt.sql2result[fmt.Sprintf(checkProcedureExistenceFormat, "p", "db1")] = newMrsForProcedure([]interface{}{int64(1)})
+	bt.sqls = nil
+	require.NoError(t, doDropProcedure(ctx, ses, dp))
+	require.Contains(t, bt.sqls, fmt.Sprintf(deleteProcedureFormat, "p", "db1"))
+}
+
+func Test_doCallProcedure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+
+	bt.setProcedure(t, "db1", `create procedure sum_to (in n int, out total int) begin
+  declare i, s int default 0;
+  while i < n do
+    set i = i + 1, s = s + i;
+  end while;
+  set total = s;
+  select s, @tag;
+end`)
+	results := map[string]interface{}{
+		"select cast((2) as int)":     int64(2),
+		"select cast((0) as int)":     int64(0),
+		"select 0 < 2":                true,
+		"select cast((0 + 1) as int)": int64(1),
+		"select 1 < 2":                true,
+		"select cast((1 + 1) as int)": int64(2),
+		"select cast((1 + 2) as int)": int64(3),
+		"select 2 < 2":                false,
+		"select cast((3) as int)":     int64(3),
+	}
+	for sql, value := range results {
+		bt.sql2result[sql] = newMrsForProcedure([]interface{}{value})
+	}
+	bt.sql2result["select 3, 'it''s'"] = newMrsForProcedure([]interface{}{int64(3), "it's"})
+	bt.sql2result[`select 3, 'it\'s'`] = newMrsForProcedure([]interface{}{int64(3), "it's"})
+	require.NoError(t, ses.SetUserDefinedVar("tag", "it's"))
+
+	call := &tree.CallStmt{
+		Name: tree.NewProcedureName("sum_to", tree.ObjectNamePrefix{}),
+		Args: tree.Exprs{tree.NewNumValWithType(constant.MakeInt64(2), "2", false, tree.P_int64), &tree.VarExpr{Name: "total"}},
+	}
+	rs, err := doCallProcedure(ctx, ses, call)
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	require.Equal(t, bt.sql2result[`select 3, 'it\'s'`], rs[0])
+	// the background session starts in the database of the caller
+	require.NotContains(t, bt.sqls, "use `db1`")
+
+	_, total, err := ses.GetUserDefinedVar("total")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), total)
+
+	// wrong number of arguments
+	call.Args = call.Args[:1]
+	_, err = doCallProcedure(ctx, ses, call)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSPWrongNumberOfArgs))
+
+	// OUT argument is not a variable
+	call.Args = tree.Exprs{call.Args[0], call.Args[0]}
+	_, err = doCallProcedure(ctx, ses, call)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSPNotVarArg))
+
+	call.Name = tree.NewProcedureName("no_such_procedure", tree.ObjectNamePrefix{})
+	_, err = doCallProcedure(ctx, ses, call)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNoProcedure))
+}
+
+func Test_doCallProcedureWithCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+
+	bt.setProcedure(t, "db1", `create procedure copy_ids (n int) begin
+  declare done int default 0;
+  declare v int;
+  declare cur cursor for select id from t where id > n;
+  declare continue handler for not found set done = 1;
+  open cur;
+  l: loop
+    fetch cur into v;
+    if done then leave l; end if;
+    insert into log values (v);
+  end loop l;
+  close cur;
+  fetch cur into v;
+end`)
+	bt.sql2result["select cast((1) as int)"] = newMrsForProcedure([]interface{}{int64(1)})
+	bt.sql2result["select cast((0) as int)"] = newMrsForProcedure([]interface{}{int64(0)})
+	bt.sql2result["select id from t where id > 1"] = newMrsForProcedure([]interface{}{int32(2)}, []interface{}{int32(3)})
+	bt.sql2result["select 0"] = newMrsForProcedure([]interface{}{int64(0)})
+	bt.sql2result["select 1"] = newMrsForProcedure([]interface{}{int64(1)})
+
+	call := &tree.CallStmt{
+		Name: tree.NewProcedureName("copy_ids", tree.ObjectNamePrefix{SchemaName: "db1", ExplicitSchema: true}),
+		Args: tree.Exprs{tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)},
+	}
+	_, err := doCallProcedure(ctx, ses, call)
+	// FETCH after CLOSE
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSPCursorNotOpen))
+	require.Contains(t, bt.sqls, "insert into log values (2)")
+	require.Contains(t, bt.sqls, "insert into log values (3)")
+}
+
+func Test_doCallProcedureWithHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+
+	bt.setProcedure(t, "db1", `create procedure outer_p (inout x varchar(10)) begin
+  declare y varchar(10);
+  begin
+    declare exit handler for 1062 set @err = 'dup';
+    insert into t values (x);
+    set @err = 'none';
+  end;
+  select name into y from t where name = x;
+  call inner_p(y);
+  set x = y;
+end`)
+	bt.setProcedure(t, "db2", `create procedure inner_p (inout y varchar(10)) begin
+  select name into y from t where name = y;
+  select 1 into y from t;
+end`)
+	bt.sql2result["select cast(('a') as varchar(10))"] = newMrsForProcedure([]interface{}{"a"})
+	bt.sql2err["insert into t values ('a')"] = moerr.NewDuplicateEntry(ctx, "a", "name")
+	bt.sql2result["select 'dup'"] = newMrsForProcedure([]interface{}{"dup"})
+	bt.sql2result["select name  from t where name = 'a'"] = newMrsForProcedure([]interface{}{[]byte("a")})
+	bt.sql2result["select 1  from t"] = newMrsForProcedure([]interface{}{int64(1)}, []interface{}{int64(1)})
+	require.NoError(t, ses.SetUserDefinedVar("x", "a"))
+
+	call := &tree.CallStmt{
+		Name: tree.NewProcedureName("outer_p", tree.ObjectNamePrefix{}),
+		Args: tree.Exprs{&tree.VarExpr{Name: "x"}},
+	}
+	_, err := doCallProcedure(ctx, ses, call)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNoProcedure))
+
+	// the procedure called without qualifier is in the database of the caller
+	bt.setProcedure(t, "db1", `create procedure inner_p (inout y varchar(10)) begin
+  select name into y from t where name = y;
+  select 1 into y from t;
+end`)
+	_, err = doCallProcedure(ctx, ses, call)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSPTooManyRows))
+	_, value, err := ses.GetUserDefinedVar("err")
+	require.NoError(t, err)
+	require.Equal(t, "dup", value)
+	require.False(t, strings.Contains(strings.Join(bt.sqls, ";"), "'none'"))
+}
+
+func Test_procedureRewrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bt := newProcedureExecTest()
+	ses, cleanup := newProcedureTestSession(t, ctrl, bt)
+	defer cleanup()
+	require.NoError(t, ses.SetUserDefinedVar("u", 1.5))
+
+	cp := parseCreateProcedure(t, "create procedure p (a datetime, b varchar(10), c int) begin select a, b, c, t.c, @u from t where c > `c`; end")
+	stmt := cp.Body.(*tree.ProcedureStmt).Stmt.(*tree.CompoundStmt).Stmts[0].(*tree.ProcedureStmt)
+
+	scope := newProcedureScope(nil)
+	for _, arg := range cp.Args {
+		scope.vars[arg.Name.Parts[0]] = &procedureVar{typ: arg.Type.(*tree.T)}
+	}
+	scope.vars["a"].value = "2023-01-02 03:04:05"
+	scope.vars["b"].value = `it's \ ok`
+	scope.vars["c"].value = int64(3)
+
+	r := &procedureRoutine{ctx: context.TODO(), ses: ses, bh: bt, lower: 1}
+	sql, err := r.rewrite(scope, stmt.Source, 0, len(stmt.Source.Text), nil)
+	require.NoError(t, err)
+	require.Equal(t, `select cast('2023-01-02 03:04:05' as datetime), 'it\'s \\ ok', 3, t.c, 1.5 from t where 3 > `+"`c`", sql)
+}
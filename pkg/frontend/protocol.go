@@ -432,6 +432,10 @@ func (fp *FakeProtocol) ParseExecuteData(ctx context.Context, stmt *PrepareStmt,
 	return nil, nil, nil
 }
 
+func (fp *FakeProtocol) HandleChangeUser(ctx context.Context, payload []byte) (string, error) {
+	return "", nil
+}
+
 func (fp *FakeProtocol) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
 	return nil
 }
@@ -229,8 +229,7 @@ type AnalyzeStmtExecutor struct {
 }
 
 func (ase *AnalyzeStmtExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
-	//TODO:
-	return nil
+	return doAnalyzeTable(ctx, ses, ase.as)
 }
 
 type ExplainAnalyzeExecutor struct {
@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -34,6 +35,9 @@ type MOServer struct {
 	uaddr string
 	app   goetty.NetApplication
 	rm    *RoutineManager
+	// pgApp serves the postgresql clients, nil if it is disabled
+	pgAddr string
+	pgApp  goetty.NetApplication
 }
 
 func (mo *MOServer) GetRoutineManager() *RoutineManager {
@@ -54,10 +58,21 @@ func (mo *MOServer) Start() error {
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
+	if mo.pgApp != nil {
+		logutil.Infof("Server Listening on : %s for the postgresql clients", mo.pgAddr)
+		if err := mo.pgApp.Start(); err != nil {
+			return err
+		}
+	}
 	return mo.app.Start()
 }
 
 func (mo *MOServer) Stop() error {
+	if mo.pgApp != nil {
+		if err := mo.pgApp.Stop(); err != nil {
+			return err
+		}
+	}
 	return mo.app.Stop()
 }
 
@@ -91,12 +106,30 @@ func NewMOServer(ctx context.Context, addr string, pu *config.ParameterUnit) *MO
 		logutil.Panicf("start server failed with %+v", err)
 	}
 	initVarByConfig(pu)
-	return &MOServer{
+	mo := &MOServer{
 		addr:  addr,
 		app:   app,
 		uaddr: pu.SV.UnixSocketAddress,
 		rm:    rm,
 	}
+	if pu.SV.PgPort != 0 {
+		mo.pgAddr = fmt.Sprintf("%s:%d", pu.SV.Host, pu.SV.PgPort)
+		prm := newPgRoutineManager(rm)
+		mo.pgApp, err = goetty.NewApplicationWithListenAddress(
+			[]string{mo.pgAddr},
+			prm.Handler,
+			goetty.WithAppLogger(logutil.GetGlobalLogger()),
+			goetty.WithAppSessionOptions(
+				goetty.WithSessionCodec(NewPgCodec()),
+				goetty.WithSessionLogger(logutil.GetGlobalLogger()),
+				goetty.WithSessionDisableCompactAfterGrow(),
+				goetty.WithSessionRWBUfferSize(1024*1024, 1024*1024)),
+			goetty.WithAppSessionAware(prm))
+		if err != nil {
+			logutil.Panicf("start server failed with %+v", err)
+		}
+	}
+	return mo
 }
 
 func initVarByConfig(pu *config.ParameterUnit) {
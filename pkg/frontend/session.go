@@ -35,6 +35,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
@@ -101,6 +102,16 @@ type Session struct {
 	prepareStmts map[string]*PrepareStmt
 	lastStmtId   uint32
 
+	//the open cursors of the prepared statements
+	cursors map[uint32]*Cursor
+	//the cursor requested by the executing COM_STMT_EXECUTE
+	cursor *Cursor
+
+	//the dialect of the sql from the client
+	dialectType dialect.DialectType
+	//the statement being executed by doComQuery
+	curStmt tree.Statement
+
 	requestCtx context.Context
 	connectCtx context.Context
 
@@ -116,6 +127,10 @@ type Session struct {
 
 	timeZone *time.Location
 
+	// txnIsolationSet is true if SET SESSION sets the transaction_isolation, or SET GLOBAL
+	// sets it before the session is created
+	txnIsolationSet bool
+
 	priv *privilege
 
 	errInfo *errInfo
@@ -143,6 +158,10 @@ type Session struct {
 
 	isBackgroundSession bool
 
+	// shareTxn is true when the background session runs the sql in the transaction of
+	// another session, the transaction is committed or rollbacked by that session.
+	shareTxn bool
+
 	tStmt *motrace.StatementInfo
 
 	ast tree.Statement
@@ -168,6 +187,9 @@ type Session struct {
 	planCache *planCache
 
 	statsCache *plan2.StatsCache
+	// noColumnStats is set if the account has no mo_column_stats, it is created with the account
+	// and the accounts created before ANALYZE TABLE was supported have no such table
+	noColumnStats bool
 
 	autoIncrCaches defines.AutoIncrCaches
 
@@ -254,6 +276,10 @@ func (ses *Session) Dispose() {
 
 	// Clean sequence record data.
 	ses.seqCurValues = nil
+
+	ses.mu.Lock()
+	ses.closeAllCursors()
+	ses.mu.Unlock()
 }
 
 type errInfo struct {
@@ -311,6 +337,7 @@ func NewSession(proto Protocol, mp *mpool.MPool, pu *config.ParameterUnit, gSysV
 	}
 	if flag {
 		ses.sysVars = gSysVars.CopySysVarsToSession()
+		ses.txnIsolationSet = gSysVars.TxnIsolationSet()
 		ses.userDefinedVars = make(map[string]interface{})
 		ses.prepareStmts = make(map[string]*PrepareStmt)
 	}
@@ -420,22 +447,37 @@ func (ses *Session) IsBackgroundSession() bool {
 	return ses.isBackgroundSession
 }
 
+func (ses *Session) SetShareTxn(b bool) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.shareTxn = b
+}
+
+func (ses *Session) IsShareTxn() bool {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.shareTxn
+}
+
 func (ses *Session) cachePlan(sql string, stmts []tree.Statement, plans []*plan.Plan) {
+	version := ses.planBaselineVersion()
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
-	ses.planCache.cache(sql, stmts, plans)
+	ses.planCache.cache(sql, stmts, plans, version)
 }
 
 func (ses *Session) getCachedPlan(sql string) *cachedPlan {
+	version := ses.planBaselineVersion()
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
-	return ses.planCache.get(sql)
+	return ses.planCache.get(sql, version)
 }
 
 func (ses *Session) isCached(sql string) bool {
+	version := ses.planBaselineVersion()
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
-	return ses.planCache.isCached(sql)
+	return ses.planCache.isCached(sql, version)
 }
 
 func (ses *Session) cleanCache() {
@@ -497,6 +539,21 @@ func (ses *Session) IfInitedTempEngine() bool {
 	return ses.InitTempEngine
 }
 
+// releaseTempEngine drops the temporary tables of the session, the temporary
+// engine is initialized again by the next statement using a temporary table.
+// The caller must hold the lock.
+func (ses *Session) releaseTempEngine() {
+	if !ses.InitTempEngine {
+		return
+	}
+	if ee, ok := ses.storage.(*engine.EntireEngine); ok {
+		ee.TempEngine = nil
+	}
+	ses.txnHandler.SetTempEngine(nil)
+	ses.tempTablestorage = nil
+	ses.InitTempEngine = false
+}
+
 func (ses *Session) GetTempTableStorage() *memorystorage.Storage {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
@@ -554,6 +611,12 @@ func (ses *Session) GetBackgroundExec(ctx context.Context) BackgroundExec {
 	return NewBackgroundHandler(ses.GetConnectContext(), ctx, ses.GetMemPool(), ses.GetParameterUnit(), ses.autoIncrCaches)
 }
 
+// GetShareTxnBackgroundExec generates a background executor which runs the sql in the
+// transaction of the session and checks the privileges of the user of the session.
+func (ses *Session) GetShareTxnBackgroundExec(ctx context.Context) BackgroundExec {
+	return NewShareTxnBackgroundHandler(ctx, ses)
+}
+
 func (ses *Session) GetIsInternal() bool {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
@@ -799,6 +862,121 @@ func (ses *Session) RemovePrepareStmt(name string) {
 	delete(ses.prepareStmts, name)
 }
 
+// SetCursor sets the cursor that saves the result set of the executing statement
+func (ses *Session) SetCursor(cursor *Cursor) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.cursor = cursor
+}
+
+func (ses *Session) GetCursor() *Cursor {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.cursor
+}
+
+// OpenCursor makes the cursor fetchable by COM_STMT_FETCH
+func (ses *Session) OpenCursor(cursor *Cursor) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	if ses.cursors == nil {
+		ses.cursors = make(map[uint32]*Cursor)
+	}
+	ses.cursors[cursor.GetStmtID()] = cursor
+}
+
+func (ses *Session) GetOpenCursor(stmtID uint32) (*Cursor, error) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	if cursor, ok := ses.cursors[stmtID]; ok {
+		return cursor, nil
+	}
+	return nil, moerr.NewStmtHasNoOpenCursor(ses.requestCtx, stmtID)
+}
+
+func (ses *Session) CloseCursor(stmtID uint32) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	if cursor, ok := ses.cursors[stmtID]; ok {
+		if err := cursor.close(ses.requestCtx); err != nil {
+			logErrorf(ses.debugStr, "close cursor of statement %d failed. error:%v", stmtID, err)
+		}
+		delete(ses.cursors, stmtID)
+	}
+}
+
+// closeAllCursors closes the open cursors, the caller must hold the lock
+func (ses *Session) closeAllCursors() {
+	for stmtID, cursor := range ses.cursors {
+		if err := cursor.close(ses.requestCtx); err != nil {
+			logErrorf(ses.debugStr, "close cursor of statement %d failed. error:%v", stmtID, err)
+		}
+	}
+	ses.cursors = nil
+}
+
+func (ses *Session) SetDialect(dialectType dialect.DialectType) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.dialectType = dialectType
+}
+
+// GetDialect returns the dialect of the sql from the client, mysql by default
+func (ses *Session) GetDialect() dialect.DialectType {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	if ses.dialectType == dialect.INVALID {
+		return dialect.MYSQL
+	}
+	return ses.dialectType
+}
+
+func (ses *Session) SetCurrentStatement(stmt tree.Statement) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.curStmt = stmt
+}
+
+func (ses *Session) GetCurrentStatement() tree.Statement {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.curStmt
+}
+
+// ResetConnection resets the session state for COM_RESET_CONNECTION and COM_CHANGE_USER.
+// The transaction is rolled back, the variables are set to the defaults,
+// the prepared statements, the cursors and the temporary tables are released.
+// The caches of the privileges, the plans and the statistics are cleaned,
+// because they are not keyed by the user or the account.
+func (ses *Session) ResetConnection() error {
+	err := ses.TxnRollback()
+	if err != nil {
+		return err
+	}
+	ses.mu.Lock()
+	ses.sysVars = ses.gSysVars.CopySysVarsToSession()
+	ses.userDefinedVars = make(map[string]interface{})
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	ses.closeAllCursors()
+	ses.cursor = nil
+	ses.lastInsertID = 0
+	ses.seqCurValues = make(map[uint64]string)
+	ses.seqLastValue = ""
+	ses.timeZone = time.Local
+	ses.txnIsolationSet = ses.gSysVars.TxnIsolationSet()
+	ses.accountId = 0
+	ses.priv = nil
+	ses.cache.invalidate()
+	ses.planCache.clean()
+	ses.statsCache = plan2.NewStatsCache()
+	ses.noColumnStats = false
+	ses.releaseTempEngine()
+	ses.mu.Unlock()
+	ses.ClearOptionBits(^uint32(0))
+	ses.SetOptionBits(OPTION_AUTOCOMMIT)
+	return nil
+}
+
 func (ses *Session) SetSysVar(name string, value interface{}) {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
@@ -891,6 +1069,36 @@ func (ses *Session) GetSessionVar(name string) (interface{}, error) {
 	}
 }
 
+// getTxnIsolationLevel returns the isolation level of the transactions of the session. It is
+// none if neither SET GLOBAL nor SET SESSION sets the level, then the transactions use the
+// isolation of the cluster.
+func (ses *Session) getTxnIsolationLevel() tree.IsolationLevel {
+	ses.mu.Lock()
+	val, set := ses.sysVars["transaction_isolation"], ses.txnIsolationSet
+	ses.mu.Unlock()
+	if !set {
+		return tree.ISOLATION_LEVEL_NONE
+	}
+	return isolationLevelOf(val)
+}
+
+// getQueryMemoryLimit returns the memory budget of a query, the operators spill to disk beyond it
+func (ses *Session) getQueryMemoryLimit() int64 {
+	val, err := ses.GetSessionVar("query_memory_limit")
+	if err != nil {
+		return 0
+	}
+	switch v := val.(type) {
+	case uint64:
+		return int64(v)
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	}
+	return 0
+}
+
 func (ses *Session) CopyAllSessionVars() map[string]interface{} {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
@@ -1366,6 +1574,27 @@ var NewBackgroundHandler = func(connCtx, reqCtx context.Context, mp *mpool.MPool
 	return bh
 }
 
+// NewShareTxnBackgroundHandler generates a background executor sharing the transaction
+// handler and the tenant of the session ses.
+var NewShareTxnBackgroundHandler = func(reqCtx context.Context, ses *Session) BackgroundExec {
+	bh := &BackgroundHandler{
+		mce: NewMysqlCmdExecutor(),
+		ses: NewBackgroundSession(ses.GetConnectContext(), reqCtx, ses.GetMemPool(), ses.GetParameterUnit(), GSysVariables, ses.autoIncrCaches),
+	}
+	bgs := bh.ses.Session
+	txnHandler := ses.GetTxnHandler()
+	bgs.mu.Lock()
+	bgs.txnHandler = txnHandler
+	bgs.mu.Unlock()
+	bgs.GetTxnCompileCtx().SetTxnHandler(txnHandler)
+	bgs.SetShareTxn(true)
+	bgs.SetTenantInfo(ses.GetTenantInfo())
+	bgs.SetFromRealUser(ses.GetFromRealUser())
+	bgs.setSkipCheckPrivilege(ses.skipCheckPrivilege())
+	bgs.SetDatabaseName(ses.GetDatabaseName())
+	return bh
+}
+
 func (bh *BackgroundHandler) Close() {
 	bh.mce.Close()
 	bh.ses.Close()
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
@@ -185,6 +186,75 @@ func TestTxnHandler_RollbackTxn(t *testing.T) {
 	})
 }
 
+func TestTxnHandler_Isolation(t *testing.T) {
+	convey.Convey("txn isolation", t, func() {
+		ctx := context.TODO()
+		iso, err := getTxnIsolation(ctx, tree.ISOLATION_LEVEL_READ_COMMITTED)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(iso, convey.ShouldEqual, txn.TxnIsolation_RC)
+
+		iso, err = getTxnIsolation(ctx, tree.ISOLATION_LEVEL_READ_UNCOMMITTED)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(iso, convey.ShouldEqual, txn.TxnIsolation_RC)
+
+		iso, err = getTxnIsolation(ctx, tree.ISOLATION_LEVEL_REPEATABLE_READ)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(iso, convey.ShouldEqual, txn.TxnIsolation_SI)
+
+		_, err = getTxnIsolation(ctx, tree.ISOLATION_LEVEL_SERIALIZABLE)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		convey.So(isolationLevelOf("read-committed"), convey.ShouldEqual, tree.ISOLATION_LEVEL_READ_COMMITTED)
+		convey.So(isolationLevelOf("REPEATABLE-READ"), convey.ShouldEqual, tree.ISOLATION_LEVEL_REPEATABLE_READ)
+		convey.So(isolationLevelOf("snapshot"), convey.ShouldEqual, tree.ISOLATION_LEVEL_NONE)
+	})
+}
+
+func TestSession_TxnIsolationLevel(t *testing.T) {
+	genSession := func(ctrl *gomock.Controller, gSysVars *GlobalSystemVariables) *Session {
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		session := NewSession(proto, nil, config.NewParameterUnit(&config.FrontendParameters{}, nil, txnClient, nil), gSysVars, true)
+		session.SetRequestContext(context.Background())
+		return session
+	}
+	convey.Convey("txn isolation level of the session", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		gSysVars := &GlobalSystemVariables{}
+		InitGlobalSystemVariables(gSysVars)
+
+		// the default level uses the isolation of the cluster
+		ses := genSession(ctrl, gSysVars)
+		convey.So(ses.getTxnIsolationLevel(), convey.ShouldEqual, tree.ISOLATION_LEVEL_NONE)
+
+		// an explicit default level is kept
+		err := ses.SetSessionVar("transaction_isolation", "REPEATABLE-READ")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.getTxnIsolationLevel(), convey.ShouldEqual, tree.ISOLATION_LEVEL_REPEATABLE_READ)
+		err = ses.SetSessionVar("tx_isolation", "READ-COMMITTED")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.getTxnIsolationLevel(), convey.ShouldEqual, tree.ISOLATION_LEVEL_READ_COMMITTED)
+
+		// SET GLOBAL applies to the sessions created after it
+		existSes := genSession(ctrl, gSysVars)
+		err = ses.SetGlobalVar("transaction_isolation", "REPEATABLE-READ")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(existSes.getTxnIsolationLevel(), convey.ShouldEqual, tree.ISOLATION_LEVEL_NONE)
+		newSes := genSession(ctrl, gSysVars)
+		convey.So(newSes.getTxnIsolationLevel(), convey.ShouldEqual, tree.ISOLATION_LEVEL_REPEATABLE_READ)
+	})
+}
+
 func TestSession_TxnBegin(t *testing.T) {
 	genSession := func(ctrl *gomock.Controller, gSysVars *GlobalSystemVariables) *Session {
 		ioses := mock_frontend.NewMockIOSession(ctrl)
@@ -244,6 +314,113 @@ func TestSession_TxnBegin(t *testing.T) {
 	})
 }
 
+func TestSession_TxnSavepoint(t *testing.T) {
+	genSession := func(ctrl *gomock.Controller, gSysVars *GlobalSystemVariables) *Session {
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
+		txnOperator.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		txnClient.EXPECT().New().Return(txnOperator, nil).AnyTimes()
+		eng := mock_frontend.NewMockEngine(ctrl)
+		hints := engine.Hints{CommitOrRollbackTimeout: time.Second * 10}
+		eng.EXPECT().Hints().Return(hints).AnyTimes()
+		eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		eng.EXPECT().Commit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		eng.EXPECT().Savepoint(gomock.Any(), gomock.Any(), "a").Return(nil).Times(1)
+		eng.EXPECT().RollbackToSavepoint(gomock.Any(), gomock.Any(), "a").Return(nil).Times(1)
+		eng.EXPECT().ReleaseSavepoint(gomock.Any(), gomock.Any(), "a").Return(nil).Times(1)
+		session := NewSession(proto, nil, config.NewParameterUnit(&config.FrontendParameters{}, eng, txnClient, nil), gSysVars, false)
+		session.SetRequestContext(context.Background())
+		session.SetConnectContext(context.Background())
+		return session
+	}
+	convey.Convey("savepoint", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		gSysVars := &GlobalSystemVariables{}
+		InitGlobalSystemVariables(gSysVars)
+
+		ses := genSession(ctrl, gSysVars)
+		// the savepoint is dropped at the end of the statement without the transaction
+		err := ses.TxnSavepoint("a")
+		convey.So(err, convey.ShouldBeNil)
+		err = ses.TxnRollbackToSavepoint("a")
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist), convey.ShouldBeTrue)
+
+		err = ses.TxnBegin()
+		convey.So(err, convey.ShouldBeNil)
+		err = ses.TxnSavepoint("a")
+		convey.So(err, convey.ShouldBeNil)
+		err = ses.TxnRollbackToSavepoint("a")
+		convey.So(err, convey.ShouldBeNil)
+		err = ses.TxnReleaseSavepoint("a")
+		convey.So(err, convey.ShouldBeNil)
+		err = ses.TxnCommit()
+		convey.So(err, convey.ShouldBeNil)
+	})
+}
+
+func TestSession_ShareTxnBackgroundExec(t *testing.T) {
+	convey.Convey("share txn", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
+		txnOperator.EXPECT().Commit(gomock.Any()).Return(nil).Times(1)
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		txnClient.EXPECT().New().Return(txnOperator, nil).Times(1)
+		eng := mock_frontend.NewMockEngine(ctrl)
+		hints := engine.Hints{CommitOrRollbackTimeout: time.Second * 10}
+		eng.EXPECT().Hints().Return(hints).AnyTimes()
+		eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		eng.EXPECT().Commit(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		ses := NewSession(proto, nil, config.NewParameterUnit(&config.FrontendParameters{}, eng, txnClient, nil), GSysVariables, false)
+		ses.SetRequestContext(context.Background())
+		ses.SetConnectContext(context.Background())
+		ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, User: "u1", DefaultRole: "r1"})
+		ses.SetDatabaseName("db1")
+
+		_, err = ses.GetTxnHandler().GetTxn()
+		convey.So(err, convey.ShouldBeNil)
+
+		bh := ses.GetShareTxnBackgroundExec(context.Background()).(*BackgroundHandler)
+		convey.So(bh.ses.IsShareTxn(), convey.ShouldBeTrue)
+		convey.So(bh.ses.GetTxnHandler(), convey.ShouldEqual, ses.GetTxnHandler())
+		convey.So(bh.ses.GetTxnCompileCtx().GetTxnHandler(), convey.ShouldEqual, ses.GetTxnHandler())
+		convey.So(bh.ses.GetTenantInfo(), convey.ShouldEqual, ses.GetTenantInfo())
+		convey.So(bh.ses.GetDatabaseName(), convey.ShouldEqual, "db1")
+
+		// the statements in the background session do not end the transaction
+		convey.So(bh.ses.TxnCommitSingleStatement(nil), convey.ShouldBeNil)
+		convey.So(bh.ses.TxnRollbackSingleStatement(nil), convey.ShouldBeNil)
+		convey.So(ses.GetTxnHandler().IsValidTxnOperator(), convey.ShouldBeTrue)
+		bh.Close()
+
+		convey.So(ses.TxnCommitSingleStatement(nil), convey.ShouldBeNil)
+		convey.So(ses.GetTxnHandler().IsValidTxnOperator(), convey.ShouldBeFalse)
+	})
+}
+
 func TestVariables(t *testing.T) {
 	genSession := func(ctrl *gomock.Controller, gSysVars *GlobalSystemVariables) *Session {
 		ioses := mock_frontend.NewMockIOSession(ctrl)
@@ -543,10 +720,10 @@ func TestSession_TxnCompilerContext(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		ctx := context.TODO()
 		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
-		txnOperator.EXPECT().Commit(ctx).Return(nil).AnyTimes()
-		txnOperator.EXPECT().Rollback(ctx).Return(nil).AnyTimes()
+		txnOperator.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
+		txnOperator.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
 		txnClient := mock_frontend.NewMockTxnClient(ctrl)
 		txnClient.EXPECT().New().Return(txnOperator, nil).AnyTimes()
 		eng := mock_frontend.NewMockEngine(ctrl)
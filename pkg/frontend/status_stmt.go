@@ -35,6 +35,9 @@ type BeginTxnExecutor struct {
 }
 
 func (bte *BeginTxnExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	if bte.bt.Modes.Isolation != tree.ISOLATION_LEVEL_NONE {
+		ses.GetTxnHandler().SetNextTxnIsolation(bte.bt.Modes.Isolation)
+	}
 	err := ses.TxnBegin()
 	if err != nil {
 		return err
@@ -61,6 +64,42 @@ func (rte *RollbackTxnExecutor) ExecuteImpl(ctx context.Context, ses *Session) e
 	return ses.TxnRollback()
 }
 
+type SavepointExecutor struct {
+	*statusStmtExecutor
+	sp *tree.SavePoint
+}
+
+func (spe *SavepointExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return ses.TxnSavepoint(string(spe.sp.Name))
+}
+
+type RollbackToSavepointExecutor struct {
+	*statusStmtExecutor
+	rsp *tree.RollbackToSavePoint
+}
+
+func (rspe *RollbackToSavepointExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return ses.TxnRollbackToSavepoint(string(rspe.rsp.Name))
+}
+
+type ReleaseSavepointExecutor struct {
+	*statusStmtExecutor
+	rsp *tree.ReleaseSavePoint
+}
+
+func (rspe *ReleaseSavepointExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return ses.TxnReleaseSavepoint(string(rspe.rsp.Name))
+}
+
+type SetTransactionExecutor struct {
+	*statusStmtExecutor
+	st *tree.SetTransaction
+}
+
+func (ste *SetTransactionExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doSetTransaction(ctx, ses, ste.st)
+}
+
 type SetRoleExecutor struct {
 	*statusStmtExecutor
 	sr *tree.SetRole
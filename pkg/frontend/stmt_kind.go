@@ -98,9 +98,13 @@ func StatementCanBeExecutedInUncommittedTransaction(ses *Session, stmt tree.Stat
 		return true, nil
 		//dml statement
 	case *tree.Insert, *tree.Update, *tree.Delete, *tree.Select, *tree.Load, *tree.MoDump, *tree.ValuesStatement:
+		return true, nil
+		//the procedure runs in the active transaction
+	case *tree.CallStmt:
 		return true, nil
 		//transaction
-	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+		*tree.SavePoint, *tree.RollbackToSavePoint, *tree.ReleaseSavePoint, *tree.SetTransaction:
 		return true, nil
 		//show
 	case *tree.ShowCreateTable,
@@ -192,6 +192,20 @@ func (mr *MockRelationMockRecorder) AddTableDef(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTableDef", reflect.TypeOf((*MockRelation)(nil).AddTableDef), arg0, arg1)
 }
 
+// AlterColumns mocks base method.
+func (m *MockRelation) AlterColumns(arg0 context.Context, arg1 []engine.TableDef) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlterColumns", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AlterColumns indicates an expected call of AlterColumns.
+func (mr *MockRelationMockRecorder) AlterColumns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlterColumns", reflect.TypeOf((*MockRelation)(nil).AlterColumns), arg0, arg1)
+}
+
 // DelTableDef mocks base method.
 func (m *MockRelation) DelTableDef(arg0 context.Context, arg1 engine.TableDef) error {
 	m.ctrl.T.Helper()
@@ -775,6 +789,20 @@ func (mr *MockEngineMockRecorder) Nodes() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nodes", reflect.TypeOf((*MockEngine)(nil).Nodes))
 }
 
+// ReleaseSavepoint mocks base method.
+func (m *MockEngine) ReleaseSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSavepoint", ctx, op, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSavepoint indicates an expected call of ReleaseSavepoint.
+func (mr *MockEngineMockRecorder) ReleaseSavepoint(ctx, op, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSavepoint", reflect.TypeOf((*MockEngine)(nil).ReleaseSavepoint), ctx, op, name)
+}
+
 // Rollback mocks base method.
 func (m *MockEngine) Rollback(ctx context.Context, op client.TxnOperator) error {
 	m.ctrl.T.Helper()
@@ -788,3 +816,31 @@ func (mr *MockEngineMockRecorder) Rollback(ctx, op interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockEngine)(nil).Rollback), ctx, op)
 }
+
+// RollbackToSavepoint mocks base method.
+func (m *MockEngine) RollbackToSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToSavepoint", ctx, op, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToSavepoint indicates an expected call of RollbackToSavepoint.
+func (mr *MockEngineMockRecorder) RollbackToSavepoint(ctx, op, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToSavepoint", reflect.TypeOf((*MockEngine)(nil).RollbackToSavepoint), ctx, op, name)
+}
+
+// Savepoint mocks base method.
+func (m *MockEngine) Savepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Savepoint", ctx, op, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Savepoint indicates an expected call of Savepoint.
+func (mr *MockEngineMockRecorder) Savepoint(ctx, op, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Savepoint", reflect.TypeOf((*MockEngine)(nil).Savepoint), ctx, op, name)
+}
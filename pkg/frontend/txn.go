@@ -19,6 +19,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage"
@@ -43,6 +44,10 @@ type TxnHandler struct {
 	txnCtxCancel context.CancelFunc
 	mu           sync.Mutex
 	entryMu      sync.Mutex
+
+	// nextIsolation is set by SET TRANSACTION or START TRANSACTION, it only
+	// applies to the next transaction.
+	nextIsolation tree.IsolationLevel
 }
 
 func InitTxnHandler(storage engine.Engine, txnClient TxnClient) *TxnHandler {
@@ -125,8 +130,21 @@ func (th *TxnHandler) NewTxnOperator() error {
 	rt := moruntime.ProcessLevelRuntime()
 	if rt != nil {
 		if v, ok := rt.GetGlobalVariables(moruntime.TxnOptions); ok {
-			opts = v.([]client.TxnOption)
+			opts = append(opts, v.([]client.TxnOption)...)
+		}
+	}
+
+	level := th.nextIsolation
+	th.nextIsolation = tree.ISOLATION_LEVEL_NONE
+	if level == tree.ISOLATION_LEVEL_NONE && th.ses != nil {
+		level = th.ses.getTxnIsolationLevel()
+	}
+	if level != tree.ISOLATION_LEVEL_NONE {
+		isolation, err := getTxnIsolation(th.ses.GetRequestContext(), level)
+		if err != nil {
+			return err
 		}
+		opts = append(opts, client.WithTxnIsolation(isolation))
 	}
 
 	th.txnOperator, err = th.txnClient.New(opts...)
@@ -139,6 +157,26 @@ func (th *TxnHandler) NewTxnOperator() error {
 	return err
 }
 
+// SetNextTxnIsolation sets the isolation level of the next transaction
+func (th *TxnHandler) SetNextTxnIsolation(level tree.IsolationLevel) {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	th.nextIsolation = level
+}
+
+// getTxnIsolation returns the txn isolation of the isolation level. The read
+// committed and read uncommitted are the RC, and the repeatable read is the
+// snapshot isolation.
+func getTxnIsolation(ctx context.Context, level tree.IsolationLevel) (txn.TxnIsolation, error) {
+	switch level {
+	case tree.ISOLATION_LEVEL_READ_UNCOMMITTED, tree.ISOLATION_LEVEL_READ_COMMITTED:
+		return txn.TxnIsolation_RC, nil
+	case tree.ISOLATION_LEVEL_REPEATABLE_READ:
+		return txn.TxnIsolation_SI, nil
+	}
+	return 0, moerr.NewNotSupported(ctx, "transaction isolation level %s", level.String())
+}
+
 // NewTxn commits the old transaction if it existed.
 // Then it creates the new transaction by Engin.New.
 func (th *TxnHandler) NewTxn() error {
@@ -342,6 +380,40 @@ func (th *TxnHandler) RollbackTxn() error {
 	return err
 }
 
+// Savepoint sets a savepoint in the transaction
+func (th *TxnHandler) Savepoint(name string) error {
+	return th.doSavepoint(name, th.GetStorage().Savepoint)
+}
+
+// RollbackToSavepoint rollbacks the transaction to the savepoint
+func (th *TxnHandler) RollbackToSavepoint(name string) error {
+	return th.doSavepoint(name, th.GetStorage().RollbackToSavepoint)
+}
+
+// ReleaseSavepoint releases the savepoint of the transaction
+func (th *TxnHandler) ReleaseSavepoint(name string) error {
+	return th.doSavepoint(name, th.GetStorage().ReleaseSavepoint)
+}
+
+func (th *TxnHandler) doSavepoint(name string, fn func(context.Context, client.TxnOperator, string) error) error {
+	th.entryMu.Lock()
+	defer th.entryMu.Unlock()
+	ses := th.GetSession()
+	if !th.IsValidTxnOperator() {
+		return moerr.NewSavepointNotExist(ses.GetRequestContext(), name)
+	}
+	txnCtx := th.GetTxnCtx()
+	if txnCtx == nil {
+		panic("context should not be nil")
+	}
+	if ses.tempTablestorage != nil {
+		txnCtx = context.WithValue(txnCtx, defines.TemporaryDN{}, ses.tempTablestorage)
+	}
+	txnOp := th.GetTxnOperator()
+	logDebugf(ses.GetDebugString(), "savepoint %s txnId:%s", name, txnOp.Txn().DebugString())
+	return fn(txnCtx, txnOp, name)
+}
+
 func (th *TxnHandler) GetStorage() engine.Engine {
 	th.mu.Lock()
 	defer th.mu.Unlock()
@@ -516,6 +588,32 @@ func (ses *Session) TxnRollback() error {
 	return err
 }
 
+/*
+TxnSavepoint sets a savepoint in the current transaction.
+
+In multi-statement transaction mode, the transaction is created implicitly.
+Otherwise, it does nothing, as the savepoint would be dropped at the end of the statement.
+*/
+func (ses *Session) TxnSavepoint(name string) error {
+	if !ses.InMultiStmtTransactionMode() {
+		return nil
+	}
+	if err := ses.TxnCreate(); err != nil {
+		return err
+	}
+	return ses.GetTxnHandler().Savepoint(name)
+}
+
+// TxnRollbackToSavepoint rollbacks the current transaction to the savepoint.
+func (ses *Session) TxnRollbackToSavepoint(name string) error {
+	return ses.GetTxnHandler().RollbackToSavepoint(name)
+}
+
+// TxnReleaseSavepoint releases the savepoint of the current transaction.
+func (ses *Session) TxnReleaseSavepoint(name string) error {
+	return ses.GetTxnHandler().ReleaseSavepoint(name)
+}
+
 /*
 TxnCommitSingleStatement commits the single statement transaction.
 
@@ -545,7 +643,12 @@ func (ses *Session) TxnCommitSingleStatement(stmt tree.Statement) error {
 		2, if it is in multi-statement mode:
 			if the statement is the one can be executed in the active transaction,
 				the transaction need to be committed at the end of the statement.
+		3, if the transaction is shared with another session:
+			it is committed by that session.
 	*/
+	if ses.IsShareTxn() {
+		return nil
+	}
 	if !ses.InMultiStmtTransactionMode() ||
 		ses.InActiveTransaction() && NeedToBeCommittedInActiveTransaction(stmt) {
 		err = ses.GetTxnHandler().CommitTxn()
@@ -584,7 +687,12 @@ func (ses *Session) TxnRollbackSingleStatement(stmt tree.Statement) error {
 			2, if it is in multi-statement mode (Case1,Case3,Case4):
 		        the transaction need to be rollback at the end of the statement.
 				(every error will abort the transaction.)
+			3, if the transaction is shared with another session:
+				it is rollbacked by that session.
 	*/
+	if ses.IsShareTxn() {
+		return nil
+	}
 	if !ses.InMultiStmtTransactionMode() ||
 		ses.InActiveTransaction() {
 		err = ses.GetTxnHandler().RollbackTxn()
@@ -87,6 +87,8 @@ type PrepareStmt struct {
 	PreparePlan *plan.Plan
 	PrepareStmt tree.Statement
 	ParamTypes  []byte
+	// the parameters sent by COM_STMT_SEND_LONG_DATA, param id -> data
+	LongData map[uint16][]byte
 }
 
 /*
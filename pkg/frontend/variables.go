@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 var (
@@ -809,6 +810,8 @@ type GlobalSystemVariables struct {
 	mu sync.Mutex
 	// name -> value/default
 	sysVars map[string]interface{}
+	// txnIsolationSet is true if SET GLOBAL sets the transaction_isolation
+	txnIsolationSet bool
 }
 
 // the set of variables
@@ -869,6 +872,14 @@ func (gsv *GlobalSystemVariables) CopySysVarsToSession() map[string]interface{}
 	return sesSysVars
 }
 
+// TxnIsolationSet returns true if SET GLOBAL sets the transaction_isolation,
+// the sessions created after it use the global level instead of the cluster's.
+func (gsv *GlobalSystemVariables) TxnIsolationSet() bool {
+	gsv.mu.Lock()
+	defer gsv.mu.Unlock()
+	return gsv.txnIsolationSet
+}
+
 // get system variable definition ,value.
 // return false, if there is no such variable.
 func (gsv *GlobalSystemVariables) GetGlobalSysVar(name string) (SystemVariable, interface{}, bool) {
@@ -909,6 +920,9 @@ func (gsv *GlobalSystemVariables) SetGlobalSysVar(ctx context.Context, name stri
 			return err
 		}
 		gsv.sysVars[name] = val
+		if name == "transaction_isolation" || name == "tx_isolation" {
+			gsv.txnIsolationSet = true
+		}
 	} else {
 		return moerr.NewInternalError(ctx, errorSystemVariableDoesNotExist())
 	}
@@ -960,6 +974,7 @@ var gSysVarsDefs = map[string]SystemVariable{
 		SetVarHintApplies: false,
 		Type:              InitSystemSystemEnumType("tx_isolation", "READ-UNCOMMITTED", "READ-COMMITTED", "REPEATABLE-READ", "SERIALIZABLE"),
 		Default:           "REPEATABLE-READ",
+		UpdateSessVar:     updateTxnIsolation,
 	},
 	"testglobalvar_dyn": {
 		Name:              "testglobalvar_dyn",
@@ -1169,6 +1184,7 @@ var gSysVarsDefs = map[string]SystemVariable{
 		SetVarHintApplies: false,
 		Type:              InitSystemSystemEnumType("transaction_isolation", "READ-UNCOMMITTED", "READ-COMMITTED", "REPEATABLE-READ", "SERIALIZABLE"),
 		Default:           "REPEATABLE-READ",
+		UpdateSessVar:     updateTxnIsolation,
 	},
 	"wait_timeout": {
 		Name:              "wait_timeout",
@@ -1226,6 +1242,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableUintType("sql_select_limit", 0, 18446744073709551615),
 		Default:           uint64(18446744073709551615),
 	},
+	"cte_max_recursion_depth": {
+		Name:              "cte_max_recursion_depth",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableUintType("cte_max_recursion_depth", 0, 4294967295),
+		Default:           uint64(1000),
+	},
 	"save_query_result": {
 		Name:              "save_query_result",
 		Scope:             ScopeBoth,
@@ -1250,6 +1274,39 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableUintType("query_result_maxsize", 0, 18446744073709551615),
 		Default:           uint64(100),
 	},
+	"query_memory_limit": {
+		Name:              "query_memory_limit",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableUintType("query_memory_limit", 0, 9223372036854775807),
+		Default:           uint64(0),
+	},
+}
+
+// updateTxnIsolation sets the isolation level of the transactions of the session,
+// tx_isolation is the alias of transaction_isolation.
+func updateTxnIsolation(sess *Session, vars map[string]interface{}, name string, val interface{}) error {
+	if _, err := getTxnIsolation(sess.requestCtx, isolationLevelOf(val)); err != nil {
+		return err
+	}
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	vars["transaction_isolation"] = val
+	vars["tx_isolation"] = val
+	sess.txnIsolationSet = true
+	return nil
+}
+
+// isolationLevelOf returns the isolation level of the value of transaction_isolation
+func isolationLevelOf(val interface{}) tree.IsolationLevel {
+	s, _ := val.(string)
+	for level := tree.ISOLATION_LEVEL_READ_UNCOMMITTED; level <= tree.ISOLATION_LEVEL_SERIALIZABLE; level++ {
+		if strings.EqualFold(s, level.String()) {
+			return level
+		}
+	}
+	return tree.ISOLATION_LEVEL_NONE
 }
 
 func updateTimeZone(sess *Session, vars map[string]interface{}, name string, val interface{}) error {
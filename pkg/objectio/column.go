@@ -20,6 +20,7 @@ import (
 	"encoding/binary"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 )
@@ -59,7 +60,8 @@ func (cb *ColumnBlock) GetData(ctx context.Context, m *mpool.MPool) (*fileservic
 		Offset: int64(cb.meta.location.Offset()),
 		Size:   int64(cb.meta.location.Length()),
 	}
-	data.Entries[0].ToObject = newDecompressToObject(int64(cb.meta.location.OriginSize()))
+	data.Entries[0].ToObject = newDecodeToObject(cb.meta.alg, cb.meta.encoding,
+		int64(cb.meta.location.OriginSize()), newPlainToObject)
 	err = cb.object.fs.Read(ctx, data)
 	if err != nil {
 		return nil, err
@@ -80,7 +82,8 @@ func (cb *ColumnBlock) GetIndex(ctx context.Context, dataType IndexDataType, rea
 			Size:   int64(cb.meta.bloomFilter.Length()),
 		}
 		var err error
-		data.Entries[0].ToObject = readFunc(int64(cb.meta.bloomFilter.OriginSize()))
+		data.Entries[0].ToObject = newDecodeToObject(compress.Lz4, EncodingPlain,
+			int64(cb.meta.bloomFilter.OriginSize()), readFunc)
 		err = cb.object.fs.Read(ctx, data)
 		if err != nil {
 			return nil, err
@@ -134,6 +137,9 @@ func (cb *ColumnBlock) MarshalMeta() ([]byte, error) {
 	if err = binary.Write(&buffer, endian, cb.meta.bloomFilter.OriginSize()); err != nil {
 		return nil, err
 	}
+	if err = binary.Write(&buffer, endian, cb.meta.encoding); err != nil {
+		return nil, err
+	}
 	if err = binary.Write(&buffer, endian, cb.meta.dummy); err != nil {
 		return nil, err
 	}
@@ -183,6 +189,9 @@ func (cb *ColumnBlock) UnMarshalMate(cache *bytes.Buffer) error {
 	if err = binary.Read(cache, endian, &cb.meta.bloomFilter.originSize); err != nil {
 		return err
 	}
+	if err = binary.Read(cache, endian, &cb.meta.encoding); err != nil {
+		return err
+	}
 	if err = binary.Read(cache, endian, &cb.meta.dummy); err != nil {
 		return err
 	}
@@ -0,0 +1,532 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// The lightweight encodings of the column data. They are applied to the
+// values of the marshaled vector before the compression, and chosen by
+// the statistics of the values when the block is written.
+const (
+	EncodingPlain uint8 = iota
+	// EncodingRLE stores the runs of the same value as (length, value)
+	EncodingRLE
+	// EncodingDict stores the distinct values and the index of every value
+	EncodingDict
+	// EncodingDelta stores the first value and the bit-packed differences
+	// between the adjacent values, for the integers
+	EncodingDelta
+	// EncodingFOR stores the min value and the bit-packed differences
+	// between the values and the min value, for the integers
+	EncodingFOR
+)
+
+const (
+	// the columns with fewer values are not encoded
+	minEncodeValues = 64
+	// the max number of the distinct values of the dictionary
+	maxDictSize = 1 << 16
+	// the header of the encoded values: the count (4B) and the width (1B)
+	encodingHeaderSize = 5
+)
+
+func EncodingString(encoding uint8) string {
+	switch encoding {
+	case EncodingPlain:
+		return "Plain"
+	case EncodingRLE:
+		return "RLE"
+	case EncodingDict:
+		return "Dict"
+	case EncodingDelta:
+		return "Delta"
+	case EncodingFOR:
+		return "FOR"
+	}
+	return "Unknown"
+}
+
+// vectorValues returns the start and the end of the values of the marshaled vector,
+// see vector.MarshalBinary.
+func vectorValues(buf []byte) (int, int, bool) {
+	pos := 1 + types.TSize + 4
+	if len(buf) < pos+4 {
+		return 0, 0, false
+	}
+	start := pos + 4
+	end := start + int(binary.LittleEndian.Uint32(buf[pos:]))
+	if end > len(buf) {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// integerKind returns whether the values of the type are the integers
+// and whether they are signed
+func integerKind(oid types.T) (isInteger bool, signed bool) {
+	switch oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp:
+		return true, true
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true, false
+	}
+	return false, false
+}
+
+// loadValue loads the i-th value of the width as the uint64, the signed one
+// is sign-extended, so that the arithmetic on the uint64 wraps as on the values.
+func loadValue(data []byte, i, width int, signed bool) uint64 {
+	p := data[i*width:]
+	switch width {
+	case 1:
+		if signed {
+			return uint64(int8(p[0]))
+		}
+		return uint64(p[0])
+	case 2:
+		if signed {
+			return uint64(int16(binary.LittleEndian.Uint16(p)))
+		}
+		return uint64(binary.LittleEndian.Uint16(p))
+	case 4:
+		if signed {
+			return uint64(int32(binary.LittleEndian.Uint32(p)))
+		}
+		return uint64(binary.LittleEndian.Uint32(p))
+	default:
+		return binary.LittleEndian.Uint64(p)
+	}
+}
+
+func storeValue(data []byte, v uint64, width int) []byte {
+	switch width {
+	case 1:
+		return append(data, byte(v))
+	case 2:
+		return binary.LittleEndian.AppendUint16(data, uint16(v))
+	case 4:
+		return binary.LittleEndian.AppendUint32(data, uint32(v))
+	default:
+		return binary.LittleEndian.AppendUint64(data, v)
+	}
+}
+
+// columnStats is the statistics of the values to choose the encoding
+type columnStats struct {
+	count    int
+	width    int
+	runs     int
+	rleSize  int
+	distinct int
+	// for the integers
+	isInteger bool
+	signed    bool
+	min, max  uint64
+	// the min and the max of the differences of the adjacent values
+	minDelta, maxDelta uint64
+}
+
+func collectStats(data []byte, width int, oid types.T) columnStats {
+	stats := columnStats{
+		count: len(data) / width,
+		width: width,
+	}
+	stats.isInteger, stats.signed = integerKind(oid)
+	stats.isInteger = stats.isInteger && (width == 1 || width == 2 || width == 4 || width == 8)
+
+	distinct := make(map[string]struct{})
+	runLen := 0
+	var prev []byte
+	var prevValue uint64
+	for i := 0; i < stats.count; i++ {
+		value := data[i*width : (i+1)*width]
+		if prev != nil && string(prev) == string(value) {
+			runLen++
+		} else {
+			if prev != nil {
+				stats.runs++
+				stats.rleSize += uvarintLen(uint64(runLen)) + width
+			}
+			prev, runLen = value, 1
+		}
+		if stats.distinct <= maxDictSize {
+			if _, ok := distinct[string(value)]; !ok {
+				distinct[string(value)] = struct{}{}
+				stats.distinct++
+			}
+		}
+
+		if !stats.isInteger {
+			continue
+		}
+		v := loadValue(data, i, width, stats.signed)
+		if i == 0 {
+			stats.min, stats.max = v, v
+		} else {
+			if less(v, stats.min, stats.signed) {
+				stats.min = v
+			}
+			if less(stats.max, v, stats.signed) {
+				stats.max = v
+			}
+			delta := wrapDelta(v-prevValue, width)
+			if i == 1 {
+				stats.minDelta, stats.maxDelta = delta, delta
+			} else {
+				if int64(delta) < int64(stats.minDelta) {
+					stats.minDelta = delta
+				}
+				if int64(delta) > int64(stats.maxDelta) {
+					stats.maxDelta = delta
+				}
+			}
+		}
+		prevValue = v
+	}
+	if prev != nil {
+		stats.runs++
+		stats.rleSize += uvarintLen(uint64(runLen)) + width
+	}
+	return stats
+}
+
+// wrapDelta wraps the difference of the values as the arithmetic of the width does,
+// so that the narrow values wrapping around have the small differences.
+func wrapDelta(delta uint64, width int) uint64 {
+	if width >= 8 {
+		return delta
+	}
+	shift := 64 - 8*width
+	return uint64(int64(delta<<shift) >> shift)
+}
+
+func less(a, b uint64, signed bool) bool {
+	if signed {
+		return int64(a) < int64(b)
+	}
+	return a < b
+}
+
+func uvarintLen(v uint64) int {
+	return (bits.Len64(v|1) + 6) / 7
+}
+
+// dictIndexWidth returns the width of the index of the dictionary
+func dictIndexWidth(distinct int) int {
+	if distinct <= 1<<8 {
+		return 1
+	}
+	return 2
+}
+
+// chooseEncoding returns the encoding that makes the values smallest,
+// EncodingPlain if none of them saves enough.
+func chooseEncoding(stats columnStats) uint8 {
+	plainSize := stats.count * stats.width
+	best, bestSize := EncodingPlain, plainSize*7/8
+	if stats.rleSize < bestSize {
+		best, bestSize = EncodingRLE, stats.rleSize
+	}
+	if stats.distinct <= maxDictSize {
+		size := 4 + stats.distinct*stats.width + 1 + stats.count*dictIndexWidth(stats.distinct)
+		if size < bestSize {
+			best, bestSize = EncodingDict, size
+		}
+	}
+	if stats.isInteger {
+		size := 8 + 1 + packedLen(stats.count, bits.Len64(stats.max-stats.min))
+		if size < bestSize {
+			best, bestSize = EncodingFOR, size
+		}
+		size = 8 + 8 + 1 + packedLen(stats.count-1, bits.Len64(stats.maxDelta-stats.minDelta))
+		if size < bestSize {
+			best = EncodingDelta
+		}
+	}
+	return best
+}
+
+// encodeColumn encodes the values of the marshaled vector by the encoding chosen
+// by their statistics. It returns the encoding and the encoded vector.
+func encodeColumn(buf []byte, typ types.Type) (uint8, []byte) {
+	start, end, ok := vectorValues(buf)
+	width := typ.TypeSize()
+	if !ok || width <= 0 || (end-start)%width != 0 || (end-start)/width < minEncodeValues {
+		return EncodingPlain, buf
+	}
+	data := buf[start:end]
+	stats := collectStats(data, width, typ.Oid)
+	encoding := chooseEncoding(stats)
+	if encoding == EncodingPlain {
+		return EncodingPlain, buf
+	}
+
+	encoded := make([]byte, 0, len(buf))
+	encoded = append(encoded, buf[:start-4]...)
+	// the length of the encoded values
+	encoded = append(encoded, 0, 0, 0, 0)
+	encoded = binary.LittleEndian.AppendUint32(encoded, uint32(stats.count))
+	encoded = append(encoded, byte(width))
+	switch encoding {
+	case EncodingRLE:
+		encoded = encodeRLE(encoded, data, width)
+	case EncodingDict:
+		encoded = encodeDict(encoded, data, width, stats.distinct)
+	case EncodingFOR:
+		encoded = encodeFOR(encoded, data, stats)
+	case EncodingDelta:
+		encoded = encodeDelta(encoded, data, stats)
+	}
+	binary.LittleEndian.PutUint32(encoded[start-4:], uint32(len(encoded)-start))
+	encoded = append(encoded, buf[end:]...)
+	return encoding, encoded
+}
+
+// decodeColumn restores the marshaled vector encoded by encodeColumn
+func decodeColumn(buf []byte, encoding uint8) ([]byte, error) {
+	if encoding == EncodingPlain {
+		return buf, nil
+	}
+	start, end, ok := vectorValues(buf)
+	if !ok || end-start < encodingHeaderSize {
+		return nil, moerr.NewInternalErrorNoCtx("object io: invalid encoded column")
+	}
+	src := buf[start:end]
+	count := int(binary.LittleEndian.Uint32(src))
+	width := int(src[4])
+	src = src[encodingHeaderSize:]
+
+	decoded := make([]byte, 0, start+count*width+len(buf)-end)
+	decoded = append(decoded, buf[:start-4]...)
+	decoded = binary.LittleEndian.AppendUint32(decoded, uint32(count*width))
+	var err error
+	switch encoding {
+	case EncodingRLE:
+		decoded, err = decodeRLE(decoded, src, count, width)
+	case EncodingDict:
+		decoded, err = decodeDict(decoded, src, count, width)
+	case EncodingFOR:
+		decoded, err = decodeFOR(decoded, src, count, width)
+	case EncodingDelta:
+		decoded, err = decodeDelta(decoded, src, count, width)
+	default:
+		err = moerr.NewInternalErrorNoCtx("object io: unknown encoding %d", encoding)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != start+count*width {
+		return nil, moerr.NewInternalErrorNoCtx("object io: invalid encoded column")
+	}
+	return append(decoded, buf[end:]...), nil
+}
+
+func encodeRLE(dst, data []byte, width int) []byte {
+	count := len(data) / width
+	for i := 0; i < count; {
+		value := data[i*width : (i+1)*width]
+		j := i + 1
+		for j < count && string(data[j*width:(j+1)*width]) == string(value) {
+			j++
+		}
+		dst = binary.AppendUvarint(dst, uint64(j-i))
+		dst = append(dst, value...)
+		i = j
+	}
+	return dst
+}
+
+func decodeRLE(dst, src []byte, count, width int) ([]byte, error) {
+	for n := 0; n < count; {
+		runLen, l := binary.Uvarint(src)
+		if l <= 0 || len(src) < l+width || n+int(runLen) > count {
+			return nil, moerr.NewInternalErrorNoCtx("object io: invalid rle column")
+		}
+		value := src[l : l+width]
+		for i := 0; i < int(runLen); i++ {
+			dst = append(dst, value...)
+		}
+		n += int(runLen)
+		src = src[l+width:]
+	}
+	return dst, nil
+}
+
+func encodeDict(dst, data []byte, width, distinct int) []byte {
+	count := len(data) / width
+	indexWidth := dictIndexWidth(distinct)
+	dict := make(map[string]int, distinct)
+	values := make([]byte, 0, distinct*width)
+	indexes := make([]byte, 0, count*indexWidth)
+	for i := 0; i < count; i++ {
+		value := data[i*width : (i+1)*width]
+		idx, ok := dict[string(value)]
+		if !ok {
+			idx = len(dict)
+			dict[string(value)] = idx
+			values = append(values, value...)
+		}
+		indexes = storeValue(indexes, uint64(idx), indexWidth)
+	}
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(dict)))
+	dst = append(dst, values...)
+	dst = append(dst, byte(indexWidth))
+	return append(dst, indexes...)
+}
+
+func decodeDict(dst, src []byte, count, width int) ([]byte, error) {
+	invalid := moerr.NewInternalErrorNoCtx("object io: invalid dict column")
+	if len(src) < 4 {
+		return nil, invalid
+	}
+	size := int(binary.LittleEndian.Uint32(src))
+	src = src[4:]
+	if len(src) < size*width+1 {
+		return nil, invalid
+	}
+	values := src[:size*width]
+	indexWidth := int(src[size*width])
+	indexes := src[size*width+1:]
+	if (indexWidth != 1 && indexWidth != 2) || len(indexes) < count*indexWidth {
+		return nil, invalid
+	}
+	for i := 0; i < count; i++ {
+		idx := int(loadValue(indexes, i, indexWidth, false))
+		if idx >= size {
+			return nil, invalid
+		}
+		dst = append(dst, values[idx*width:(idx+1)*width]...)
+	}
+	return dst, nil
+}
+
+func encodeFOR(dst, data []byte, stats columnStats) []byte {
+	bitWidth := bits.Len64(stats.max - stats.min)
+	dst = binary.LittleEndian.AppendUint64(dst, stats.min)
+	dst = append(dst, byte(bitWidth))
+	return packBits(dst, stats.count, bitWidth, func(i int) uint64 {
+		return loadValue(data, i, stats.width, stats.signed) - stats.min
+	})
+}
+
+func decodeFOR(dst, src []byte, count, width int) ([]byte, error) {
+	if len(src) < 9 {
+		return nil, moerr.NewInternalErrorNoCtx("object io: invalid for column")
+	}
+	min := binary.LittleEndian.Uint64(src)
+	bitWidth := int(src[8])
+	return unpackBits(dst, src[9:], count, bitWidth, func(dst []byte, v uint64) []byte {
+		return storeValue(dst, min+v, width)
+	})
+}
+
+func encodeDelta(dst, data []byte, stats columnStats) []byte {
+	bitWidth := bits.Len64(stats.maxDelta - stats.minDelta)
+	first := loadValue(data, 0, stats.width, stats.signed)
+	dst = binary.LittleEndian.AppendUint64(dst, first)
+	dst = binary.LittleEndian.AppendUint64(dst, stats.minDelta)
+	dst = append(dst, byte(bitWidth))
+	return packBits(dst, stats.count-1, bitWidth, func(i int) uint64 {
+		delta := loadValue(data, i+1, stats.width, stats.signed) - loadValue(data, i, stats.width, stats.signed)
+		return wrapDelta(delta, stats.width) - stats.minDelta
+	})
+}
+
+func decodeDelta(dst, src []byte, count, width int) ([]byte, error) {
+	if len(src) < 17 {
+		return nil, moerr.NewInternalErrorNoCtx("object io: invalid delta column")
+	}
+	value := binary.LittleEndian.Uint64(src)
+	minDelta := binary.LittleEndian.Uint64(src[8:])
+	bitWidth := int(src[16])
+	dst = storeValue(dst, value, width)
+	return unpackBits(dst, src[17:], count-1, bitWidth, func(dst []byte, v uint64) []byte {
+		value += minDelta + v
+		return storeValue(dst, value, width)
+	})
+}
+
+// packedLen returns the size of the count values packed in the bitWidth bits
+func packedLen(count, bitWidth int) int {
+	return (count*bitWidth + 7) / 8
+}
+
+// packBits appends the count values packed in the bitWidth bits, from the lowest bit
+func packBits(dst []byte, count, bitWidth int, value func(i int) uint64) []byte {
+	if bitWidth == 0 {
+		return dst
+	}
+	var acc uint64
+	var n int
+	for i := 0; i < count; i++ {
+		v := value(i)
+		acc |= v << n
+		if n+bitWidth >= 64 {
+			dst = binary.LittleEndian.AppendUint64(dst, acc)
+			// the bits of v not in acc
+			if n == 0 {
+				acc = 0
+			} else {
+				acc = v >> (64 - n)
+			}
+			n = n + bitWidth - 64
+		} else {
+			n += bitWidth
+		}
+	}
+	for ; n > 0; n -= 8 {
+		dst = append(dst, byte(acc))
+		acc >>= 8
+	}
+	return dst
+}
+
+// unpackBits unpacks the count values packed by packBits and appends them by the store
+func unpackBits(dst, src []byte, count, bitWidth int, store func(dst []byte, v uint64) []byte) ([]byte, error) {
+	if len(src) < packedLen(count, bitWidth) || bitWidth > 64 {
+		return nil, moerr.NewInternalErrorNoCtx("object io: invalid packed column")
+	}
+	mask := bitMask(bitWidth)
+	pos := 0
+	for i := 0; i < count; i++ {
+		var v uint64
+		for b := 0; b < bitWidth; {
+			byteIdx, bitIdx := (pos+b)/8, (pos+b)%8
+			take := 8 - bitIdx
+			if take > bitWidth-b {
+				take = bitWidth - b
+			}
+			v |= uint64(src[byteIdx]>>bitIdx) & (1<<take - 1) << b
+			b += take
+		}
+		pos += bitWidth
+		dst = store(dst, v&mask)
+	}
+	return dst, nil
+}
+
+func bitMask(bitWidth int) uint64 {
+	if bitWidth >= 64 {
+		return ^uint64(0)
+	}
+	return 1<<bitWidth - 1
+}
@@ -0,0 +1,173 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/require"
+)
+
+func newEncodingVector[T any](t *testing.T, typ types.Type, mp *mpool.MPool, n int, value func(i int) T) *vector.Vector {
+	return newEncodingVectorWithNulls(t, typ, mp, n, 0, value)
+}
+
+func newEncodingVectorWithNulls[T any](t *testing.T, typ types.Type, mp *mpool.MPool, n, nullEvery int, value func(i int) T) *vector.Vector {
+	vec := vector.NewVec(typ)
+	for i := 0; i < n; i++ {
+		require.NoError(t, vector.AppendFixed(vec, value(i), nullEvery > 0 && i%nullEvery == 0, mp))
+	}
+	return vec
+}
+
+func testEncodeColumn(t *testing.T, vec *vector.Vector, expect uint8) {
+	buf, err := vec.MarshalBinary()
+	require.NoError(t, err)
+	origin := append([]byte(nil), buf...)
+	encoding, encoded := encodeColumn(buf, *vec.GetType())
+	require.Equal(t, EncodingString(expect), EncodingString(encoding))
+	if encoding != EncodingPlain {
+		require.Less(t, len(encoded), len(origin))
+	}
+	decoded, err := decodeColumn(encoded, encoding)
+	require.NoError(t, err)
+	require.Equal(t, origin, decoded)
+}
+
+func TestEncodeColumn(t *testing.T) {
+	mp := mpool.MustNewZero()
+	n := 8192
+
+	// runs of the same value
+	testEncodeColumn(t, newEncodingVector(t, types.T_int64.ToType(), mp, n,
+		func(i int) int64 { return int64(i / 1000) }), EncodingRLE)
+	// few distinct values in random order
+	testEncodeColumn(t, newEncodingVector(t, types.T_float64.ToType(), mp, n,
+		func(i int) float64 { return float64(rand.Intn(100)) / 3 }), EncodingDict)
+	// small range around a large base, the nulls are kept
+	testEncodeColumn(t, newEncodingVectorWithNulls(t, types.T_int64.ToType(), mp, n, 10,
+		func(i int) int64 { return -1<<40 + rand.Int63n(1<<20) }), EncodingFOR)
+	// increasing values
+	testEncodeColumn(t, newEncodingVector(t, types.T_timestamp.ToType(), mp, n,
+		func(i int) types.Timestamp { return types.Timestamp(1<<50 + int64(i)*1000 + rand.Int63n(10)) }), EncodingDelta)
+	// wrapping arithmetic of the narrow signed values
+	testEncodeColumn(t, newEncodingVector(t, types.T_int8.ToType(), mp, n,
+		func(i int) int8 { return int8(i) }), EncodingDelta)
+	testEncodeColumn(t, newEncodingVector(t, types.T_uint16.ToType(), mp, n,
+		func(i int) uint16 { return uint16(65535 - i*7) }), EncodingDelta)
+	// random values
+	testEncodeColumn(t, newEncodingVector(t, types.T_int64.ToType(), mp, n,
+		func(i int) int64 { return rand.Int63() }), EncodingPlain)
+	// too few values
+	testEncodeColumn(t, newEncodingVector(t, types.T_int64.ToType(), mp, 10,
+		func(i int) int64 { return 1 }), EncodingPlain)
+
+	// varlena keeps the area
+	vec := vector.NewVec(types.T_varchar.ToType())
+	for i := 0; i < n; i++ {
+		s := fmt.Sprintf("level-%d", i%4)
+		if i%100 == 0 {
+			s = fmt.Sprintf("a long message that does not fit in the varlena %d", i)
+		}
+		require.NoError(t, vector.AppendBytes(vec, []byte(s), false, mp))
+	}
+	testEncodeColumn(t, vec, EncodingDict)
+}
+
+func TestDecodeInvalidColumn(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vec := newEncodingVector(t, types.T_int64.ToType(), mp, 1024,
+		func(i int) int64 { return int64(i / 100) })
+	buf, err := vec.MarshalBinary()
+	require.NoError(t, err)
+	encoding, encoded := encodeColumn(buf, *vec.GetType())
+	require.Equal(t, EncodingRLE, encoding)
+	_, err = decodeColumn(encoded[:len(encoded)/2], encoding)
+	require.Error(t, err)
+	_, err = decodeColumn(encoded, 100)
+	require.Error(t, err)
+}
+
+func TestObjectWriterCompression(t *testing.T) {
+	dir := InitTestEnv(ModuleName, t)
+	dir = path.Join(dir, "/local")
+	name := "compression.blk"
+	mp := mpool.MustNewZero()
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(c, nil)
+	require.NoError(t, err)
+
+	bat := batch.NewWithSize(3)
+	bat.Vecs[0] = newEncodingVector(t, types.T_int64.ToType(), mp, 8192,
+		func(i int) int64 { return int64(i / 1000) })
+	bat.Vecs[1] = newEncodingVector(t, types.T_int64.ToType(), mp, 8192,
+		func(i int) int64 { return rand.Int63() })
+	bat.Vecs[2] = newEncodingVector(t, types.T_int32.ToType(), mp, 8192,
+		func(i int) int32 { return int32(i) })
+	defer bat.Clean(mp)
+
+	writer, err := NewObjectWriter(name, service,
+		WithCompression(compress.Zstd, compress.DefaultZstdLevel),
+		WithColumnCompression(1, compress.Lz4, 0),
+		WithColumnCompression(2, compress.None, 0))
+	require.NoError(t, err)
+	_, err = writer.Write(bat)
+	require.NoError(t, err)
+	blocks, err := writer.WriteEnd(context.Background())
+	require.NoError(t, err)
+
+	reader, err := NewObjectReader(name, service)
+	require.NoError(t, err)
+	metas, err := reader.ReadMeta(context.Background(), []Extent{blocks[0].GetExtent()}, mp, nil)
+	require.NoError(t, err)
+	expects := []struct {
+		alg      uint8
+		encoding uint8
+	}{
+		{compress.Zstd, EncodingRLE},
+		{compress.Lz4, EncodingPlain},
+		{compress.None, EncodingDelta},
+	}
+	for i, expect := range expects {
+		col, err := metas[0].GetColumn(uint16(i))
+		require.NoError(t, err)
+		require.Equal(t, expect.alg, col.GetMeta().GetAlg())
+		require.Equal(t, expect.encoding, col.GetMeta().GetEncoding())
+	}
+
+	ioVec, err := reader.Read(context.Background(), blocks[0].GetExtent(),
+		[]uint16{0, 1, 2}, nil, mp, nil, newPlainToObject)
+	require.NoError(t, err)
+	for i, entry := range ioVec.Entries {
+		expect, err := bat.Vecs[i].MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, expect, entry.Object.([]byte))
+	}
+}
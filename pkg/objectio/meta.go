@@ -83,7 +83,7 @@ func (bh *BlockHeader) GetColumnCount() uint16 {
 // +--------+-------+----------+--------+---------+--------+--------+------------+---------+----------+------------+
 // |Type(1B)|Idx(2B)| Algo(1B) |Offset(4B)|Size(4B)|oSize(4B)|Min(32B)|Max(32B)|BFoffset(4b)|BFlen(4b)|BFoSize(4B) |
 // +--------+-------+----------+--------+---------+--------+--------+------------+---------+----------+------------+
-// |                                        Reserved(32B)                                             | Chksum(4B) |
+// | Enc(1B) |                                  Reserved(31B)                                          | Chksum(4B) |
 // +---------------------------------------------------------------------------------------------------------------+
 // ColumnMeta Size = 128B
 // Type = Metadata type, always 0, representing column meta, used for extension.
@@ -97,8 +97,9 @@ func (bh *BlockHeader) GetColumnCount() uint16 {
 // BFoffset = Bloomfilter data offset
 // Bflen = Bloomfilter data size
 // BFoSize = Bloomfilter original data size
+// Enc = Lightweight encoding of column data, applied before the compression
 // Chksum = Data checksum
-// Reserved = 32 bytes reserved space
+// Reserved = 31 bytes reserved space
 type ColumnMeta struct {
 	typ         uint8
 	idx         uint16
@@ -106,7 +107,8 @@ type ColumnMeta struct {
 	location    Extent
 	zoneMap     ZoneMap
 	bloomFilter Extent
-	dummy       [32]byte
+	encoding    uint8
+	dummy       [31]byte
 	checksum    uint32
 }
 
@@ -122,6 +124,10 @@ func (cm *ColumnMeta) GetAlg() uint8 {
 	return cm.alg
 }
 
+func (cm *ColumnMeta) GetEncoding() uint8 {
+	return cm.encoding
+}
+
 func (cm *ColumnMeta) GetLocation() Extent {
 	return cm.location
 }
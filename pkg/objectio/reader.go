@@ -144,7 +144,8 @@ func (r *ObjectReader) Read(ctx context.Context,
 				Offset: int64(col.GetMeta().location.Offset()),
 				Size:   int64(col.GetMeta().location.Length()),
 
-				ToObject: readFunc(int64(col.GetMeta().location.OriginSize())),
+				ToObject: newDecodeToObject(col.GetMeta().alg, col.GetMeta().encoding,
+					int64(col.GetMeta().location.OriginSize()), readFunc),
 			})
 		}
 	}
@@ -228,10 +229,10 @@ func (r *ObjectReader) readFooterAndUnMarshal(ctx context.Context, fileSize, siz
 type ToObjectFunc = func(r io.Reader, buf []byte) (any, int64, error)
 type ReadObjectFunc = func(size int64) ToObjectFunc
 
-// newDecompressToObject the decompression function passed to fileservice
-func newDecompressToObject(size int64) ToObjectFunc {
+// newDecodeToObject decompresses the data by the alg and decodes it by the encoding,
+// then passes the restored data to the readFunc.
+func newDecodeToObject(alg, encoding uint8, size int64, readFunc ReadObjectFunc) ToObjectFunc {
 	return func(reader io.Reader, data []byte) (any, int64, error) {
-		// decompress
 		var err error
 		if len(data) == 0 {
 			data, err = io.ReadAll(reader)
@@ -240,11 +241,22 @@ func newDecompressToObject(size int64) ToObjectFunc {
 			}
 		}
 		decompressed := make([]byte, size)
-		decompressed, err = compress.Decompress(data, decompressed, compress.Lz4)
+		decompressed, err = compress.Decompress(data, decompressed, int(alg))
 		if err != nil {
 			return nil, 0, err
 		}
-		return decompressed, int64(len(decompressed)), nil
+		decoded, err := decodeColumn(decompressed, encoding)
+		if err != nil {
+			return nil, 0, err
+		}
+		return readFunc(int64(len(decoded)))(nil, decoded)
+	}
+}
+
+// newPlainToObject returns the restored data as is
+func newPlainToObject(size int64) ToObjectFunc {
+	return func(reader io.Reader, data []byte) (any, int64, error) {
+		return data, int64(len(data)), nil
 	}
 }
 
@@ -23,7 +23,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
-	"github.com/pierrec/lz4"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -37,9 +36,10 @@ type ObjectWriter struct {
 	buffer *ObjectBuffer
 	name   string
 	lastId uint32
+	WriterOptions
 }
 
-func NewObjectWriter(name string, fs fileservice.FileService) (Writer, error) {
+func NewObjectWriter(name string, fs fileservice.FileService, opts ...WriterOptionFunc) (Writer, error) {
 	object := NewObject(name, fs)
 	writer := &ObjectWriter{
 		name:   name,
@@ -47,6 +47,13 @@ func NewObjectWriter(name string, fs fileservice.FileService) (Writer, error) {
 		buffer: NewObjectBuffer(name),
 		blocks: make([]BlockObject, 0),
 		lastId: 0,
+		WriterOptions: WriterOptions{
+			alg:   compress.Lz4,
+			level: compress.DefaultZstdLevel,
+		},
+	}
+	for _, f := range opts {
+		f(&writer.WriterOptions)
 	}
 	err := writer.WriteHeader()
 	return writer, err
@@ -79,11 +86,14 @@ func (w *ObjectWriter) Write(batch *batch.Batch) (BlockObject, error) {
 		if err != nil {
 			return nil, err
 		}
+		encoding := EncodingPlain
+		if !w.noEncoding {
+			encoding, buf = encodeColumn(buf, *vec.GetType())
+		}
 		originSize := len(buf)
-		// TODO:Now by default, lz4 compression must be used for Write,
-		// and parameters need to be passed in later to determine the compression type
-		data := make([]byte, lz4.CompressBlockBound(originSize))
-		if buf, err = compress.Compress(buf, data, compress.Lz4); err != nil {
+		alg, level := w.columnCompression(uint16(i))
+		data := make([]byte, compress.CompressBound(originSize, alg))
+		if buf, err = compress.CompressLevel(buf, data, alg, level); err != nil {
 			return nil, err
 		}
 		offset, length, err := w.buffer.Write(buf)
@@ -96,7 +106,8 @@ func (w *ObjectWriter) Write(batch *batch.Batch) (BlockObject, error) {
 			length:     uint32(length),
 			originSize: uint32(originSize),
 		}
-		block.(*Block).columns[i].(*ColumnBlock).meta.alg = compress.Lz4
+		block.(*Block).columns[i].(*ColumnBlock).meta.alg = uint8(alg)
+		block.(*Block).columns[i].(*ColumnBlock).meta.encoding = encoding
 		block.(*Block).columns[i].(*ColumnBlock).meta.typ = uint8(vec.GetType().Oid)
 	}
 	return block, nil
@@ -200,3 +211,57 @@ func (w *ObjectWriter) GetBlock(id uint32) *Block {
 	defer w.Unlock()
 	return w.blocks[id].(*Block)
 }
+
+type columnCompression struct {
+	alg   int
+	level int
+}
+
+type WriterOptions struct {
+	// alg and level are the compression of the columns without their own one
+	alg   int
+	level int
+	// columns is the compression of the columns by their index
+	columns map[uint16]columnCompression
+	// noEncoding true means NOT encode the columns before the compression
+	noEncoding bool
+}
+
+type WriterOptionFunc func(opt *WriterOptions)
+
+// WithCompression sets the compression of all the columns,
+// the level is only used by compress.Zstd
+func WithCompression(alg int, level int) WriterOptionFunc {
+	return WriterOptionFunc(func(opt *WriterOptions) {
+		opt.alg = alg
+		opt.level = level
+	})
+}
+
+// WithColumnCompression sets the compression of the column idx,
+// the level 0 means compress.DefaultZstdLevel
+func WithColumnCompression(idx uint16, alg int, level int) WriterOptionFunc {
+	if level == 0 {
+		level = compress.DefaultZstdLevel
+	}
+	return WriterOptionFunc(func(opt *WriterOptions) {
+		if opt.columns == nil {
+			opt.columns = make(map[uint16]columnCompression)
+		}
+		opt.columns[idx] = columnCompression{alg: alg, level: level}
+	})
+}
+
+// WithoutEncoding writes the columns without the lightweight encodings
+func WithoutEncoding() WriterOptionFunc {
+	return WriterOptionFunc(func(opt *WriterOptions) {
+		opt.noEncoding = true
+	})
+}
+
+func (opt *WriterOptions) columnCompression(idx uint16) (int, int) {
+	if c, ok := opt.columns[idx]; ok {
+		return c.alg, c.level
+	}
+	return opt.alg, opt.level
+}
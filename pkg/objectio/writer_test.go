@@ -119,7 +119,7 @@ func TestNewObjectWriter(t *testing.T) {
 	idxs[0] = 0
 	idxs[1] = 2
 	idxs[2] = 3
-	vec, err := objectReader.Read(context.Background(), blocks[0].GetExtent(), idxs, []uint32{blocks[0].GetExtent().id}, pool, nil, newDecompressToObject)
+	vec, err := objectReader.Read(context.Background(), blocks[0].GetExtent(), idxs, []uint32{blocks[0].GetExtent().id}, pool, nil, newPlainToObject)
 	assert.Nil(t, err)
 	vector1 := newVector(types.T_int8.ToType(), vec.Entries[0].Object.([]byte))
 	assert.Equal(t, int8(3), vector.MustFixedCol[int8](vector1)[3])
@@ -134,7 +134,7 @@ func TestNewObjectWriter(t *testing.T) {
 	buf := index.(*ZoneMap).data.([]byte)
 	assert.Equal(t, uint8(0x1), buf[31])
 	assert.Equal(t, uint8(0xa), buf[63])
-	index, err = blk.GetIndex(context.Background(), BloomFilterType, newDecompressToObject, pool)
+	index, err = blk.GetIndex(context.Background(), BloomFilterType, newPlainToObject, pool)
 	assert.Nil(t, err)
 	assert.Equal(t, "test index 0", string(index.(*BloomFilter).data.([]byte)))
 	assert.True(t, nb0 == pool.CurrNB())
@@ -154,7 +154,7 @@ func TestNewObjectWriter(t *testing.T) {
 	idxs[0] = 0
 	idxs[1] = 2
 	idxs[2] = 3
-	vec, err = objectReader.Read(context.Background(), bs[0].GetExtent(), idxs, []uint32{bs[0].GetExtent().id}, pool, nil, newDecompressToObject)
+	vec, err = objectReader.Read(context.Background(), bs[0].GetExtent(), idxs, []uint32{bs[0].GetExtent().id}, pool, nil, newPlainToObject)
 	assert.Nil(t, err)
 	vector1 = newVector(types.T_int8.ToType(), vec.Entries[0].Object.([]byte))
 	assert.Equal(t, int8(3), vector.MustFixedCol[int8](vector1)[3])
@@ -169,7 +169,7 @@ func TestNewObjectWriter(t *testing.T) {
 	buf = index.(*ZoneMap).data.([]byte)
 	assert.Equal(t, uint8(0x1), buf[31])
 	assert.Equal(t, uint8(0xa), buf[63])
-	index, err = blk.GetIndex(context.Background(), BloomFilterType, newDecompressToObject, pool)
+	index, err = blk.GetIndex(context.Background(), BloomFilterType, newPlainToObject, pool)
 	assert.Nil(t, err)
 	assert.Equal(t, "test index 0", string(index.(*BloomFilter).data.([]byte)))
 	assert.True(t, nb0 == pool.CurrNB())
@@ -452,6 +452,7 @@ type Group struct {
 	Types                []*plan.Type     `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
 	Aggs                 []*Aggregate     `protobuf:"bytes,6,rep,name=aggs,proto3" json:"aggs,omitempty"`
 	MultiAggs            []*MultiArguemnt `protobuf:"bytes,7,rep,name=MultiAggs,proto3" json:"MultiAggs,omitempty"`
+	GroupingSets         []int64          `protobuf:"varint,8,rep,packed,name=grouping_sets,json=groupingSets,proto3" json:"grouping_sets,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -539,6 +540,13 @@ func (m *Group) GetMultiAggs() []*MultiArguemnt {
 	return nil
 }
 
+func (m *Group) GetGroupingSets() []int64 {
+	if m != nil {
+		return m.GroupingSets
+	}
+	return nil
+}
+
 type Insert struct {
 	Affected uint64 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
 	IsRemote bool   `protobuf:"varint,2,opt,name=IsRemote,proto3" json:"IsRemote,omitempty"`
@@ -794,17 +802,18 @@ func (m *OnDuplicateKey) GetTableDef() *plan.TableDef {
 }
 
 type Join struct {
-	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
-	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
-	RelList              []int32      `protobuf:"varint,3,rep,packed,name=rel_list,json=relList,proto3" json:"rel_list,omitempty"`
-	ColList              []int32      `protobuf:"varint,4,rep,packed,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
-	Expr                 *plan.Expr   `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
-	Types                []*plan.Type `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
-	LeftCond             []*plan.Expr `protobuf:"bytes,7,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
-	RightCond            []*plan.Expr `protobuf:"bytes,8,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Ibucket                uint64                    `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
+	Nbucket                uint64                    `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
+	RelList                []int32                   `protobuf:"varint,3,rep,packed,name=rel_list,json=relList,proto3" json:"rel_list,omitempty"`
+	ColList                []int32                   `protobuf:"varint,4,rep,packed,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
+	Expr                   *plan.Expr                `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	Types                  []*plan.Type              `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
+	LeftCond               []*plan.Expr              `protobuf:"bytes,7,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
+	RightCond              []*plan.Expr              `protobuf:"bytes,8,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
+	RuntimeFilterBuildList []*plan.RuntimeFilterSpec `protobuf:"bytes,9,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *Join) Reset()         { *m = Join{} }
@@ -896,6 +905,13 @@ func (m *Join) GetRightCond() []*plan.Expr {
 	return nil
 }
 
+func (m *Join) GetRuntimeFilterBuildList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
 type AntiJoin struct {
 	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
 	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
@@ -1309,16 +1325,17 @@ func (m *RightJoin) GetRightCond() []*plan.Expr {
 }
 
 type SemiJoin struct {
-	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
-	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
-	Result               []int32      `protobuf:"varint,3,rep,packed,name=result,proto3" json:"result,omitempty"`
-	Expr                 *plan.Expr   `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	Types                []*plan.Type `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
-	LeftCond             []*plan.Expr `protobuf:"bytes,6,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
-	RightCond            []*plan.Expr `protobuf:"bytes,7,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Ibucket                uint64                    `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
+	Nbucket                uint64                    `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
+	Result                 []int32                   `protobuf:"varint,3,rep,packed,name=result,proto3" json:"result,omitempty"`
+	Expr                   *plan.Expr                `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	Types                  []*plan.Type              `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
+	LeftCond               []*plan.Expr              `protobuf:"bytes,6,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
+	RightCond              []*plan.Expr              `protobuf:"bytes,7,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
+	RuntimeFilterBuildList []*plan.RuntimeFilterSpec `protobuf:"bytes,8,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *SemiJoin) Reset()         { *m = SemiJoin{} }
@@ -1403,6 +1420,13 @@ func (m *SemiJoin) GetRightCond() []*plan.Expr {
 	return nil
 }
 
+func (m *SemiJoin) GetRuntimeFilterBuildList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
 type SingleJoin struct {
 	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
 	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
@@ -1752,15 +1776,16 @@ func (m *TableFunction) GetName() string {
 }
 
 type HashBuild struct {
-	NeedExpr             bool         `protobuf:"varint,1,opt,name=need_expr,json=needExpr,proto3" json:"need_expr,omitempty"`
-	NeedHash             bool         `protobuf:"varint,2,opt,name=need_hash,json=needHash,proto3" json:"need_hash,omitempty"`
-	Ibucket              uint64       `protobuf:"varint,3,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
-	Nbucket              uint64       `protobuf:"varint,4,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
-	Types                []*plan.Type `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
-	Conds                []*plan.Expr `protobuf:"bytes,6,rep,name=conds,proto3" json:"conds,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	NeedExpr               bool                      `protobuf:"varint,1,opt,name=need_expr,json=needExpr,proto3" json:"need_expr,omitempty"`
+	NeedHash               bool                      `protobuf:"varint,2,opt,name=need_hash,json=needHash,proto3" json:"need_hash,omitempty"`
+	Ibucket                uint64                    `protobuf:"varint,3,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
+	Nbucket                uint64                    `protobuf:"varint,4,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
+	Types                  []*plan.Type              `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
+	Conds                  []*plan.Expr              `protobuf:"bytes,6,rep,name=conds,proto3" json:"conds,omitempty"`
+	RuntimeFilterBuildList []*plan.RuntimeFilterSpec `protobuf:"bytes,7,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *HashBuild) Reset()         { *m = HashBuild{} }
@@ -1838,6 +1863,13 @@ func (m *HashBuild) GetConds() []*plan.Expr {
 	return nil
 }
 
+func (m *HashBuild) GetRuntimeFilterBuildList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
 type ExternalName2ColIndex struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Index                int32    `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -2010,11 +2042,12 @@ type Instruction struct {
 	// isFirst identifies whether it is the first instruction of analyzeInfo corresponding to idx
 	IsFirst bool `protobuf:"varint,25,opt,name=isFirst,proto3" json:"isFirst,omitempty"`
 	// isLast identifies whether it is the last instruction of analyzeInfo corresponding to idx
-	IsLast               bool       `protobuf:"varint,26,opt,name=isLast,proto3" json:"isLast,omitempty"`
-	RightJoin            *RightJoin `protobuf:"bytes,27,opt,name=right_join,json=rightJoin,proto3" json:"right_join,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	IsLast               bool               `protobuf:"varint,26,opt,name=isLast,proto3" json:"isLast,omitempty"`
+	RightJoin            *RightJoin         `protobuf:"bytes,27,opt,name=right_join,json=rightJoin,proto3" json:"right_join,omitempty"`
+	WinSpecList          []*plan.WindowSpec `protobuf:"bytes,28,rep,name=win_spec_list,json=winSpecList,proto3" json:"win_spec_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Instruction) Reset()         { *m = Instruction{} }
@@ -2239,6 +2272,13 @@ func (m *Instruction) GetRightJoin() *RightJoin {
 	return nil
 }
 
+func (m *Instruction) GetWinSpecList() []*plan.WindowSpec {
+	if m != nil {
+		return m.WinSpecList
+	}
+	return nil
+}
+
 type AnalysisList struct {
 	List                 []*plan.AnalyzeInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -2287,18 +2327,19 @@ func (m *AnalysisList) GetList() []*plan.AnalyzeInfo {
 }
 
 type Source struct {
-	SchemaName           string               `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
-	TableName            string               `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColList              []string             `protobuf:"bytes,3,rep,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
-	Block                string               `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
-	PushdownId           uint64               `protobuf:"varint,5,opt,name=pushdown_id,json=pushdownId,proto3" json:"pushdown_id,omitempty"`
-	PushdownAddr         string               `protobuf:"bytes,6,opt,name=pushdown_addr,json=pushdownAddr,proto3" json:"pushdown_addr,omitempty"`
-	Expr                 *plan.Expr           `protobuf:"bytes,7,opt,name=expr,proto3" json:"expr,omitempty"`
-	TableDef             *plan.TableDef       `protobuf:"bytes,8,opt,name=tableDef,proto3" json:"tableDef,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	SchemaName             string                    `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableName              string                    `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColList                []string                  `protobuf:"bytes,3,rep,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
+	Block                  string                    `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	PushdownId             uint64                    `protobuf:"varint,5,opt,name=pushdown_id,json=pushdownId,proto3" json:"pushdown_id,omitempty"`
+	PushdownAddr           string                    `protobuf:"bytes,6,opt,name=pushdown_addr,json=pushdownAddr,proto3" json:"pushdown_addr,omitempty"`
+	Expr                   *plan.Expr                `protobuf:"bytes,7,opt,name=expr,proto3" json:"expr,omitempty"`
+	TableDef               *plan.TableDef            `protobuf:"bytes,8,opt,name=tableDef,proto3" json:"tableDef,omitempty"`
+	Timestamp              *timestamp.Timestamp      `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RuntimeFilterProbeList []*plan.RuntimeFilterSpec `protobuf:"bytes,10,rep,name=runtime_filter_probe_list,json=runtimeFilterProbeList,proto3" json:"runtime_filter_probe_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *Source) Reset()         { *m = Source{} }
@@ -2397,6 +2438,13 @@ func (m *Source) GetTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Source) GetRuntimeFilterProbeList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterProbeList
+	}
+	return nil
+}
+
 type NodeInfo struct {
 	Mcpu                 int32    `protobuf:"varint,1,opt,name=mcpu,proto3" json:"mcpu,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -2474,6 +2522,7 @@ type ProcessLimitation struct {
 	BatchSize            int64    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 	PartitionRows        int64    `protobuf:"varint,4,opt,name=partition_rows,json=partitionRows,proto3" json:"partition_rows,omitempty"`
 	ReaderSize           int64    `protobuf:"varint,5,opt,name=reader_size,json=readerSize,proto3" json:"reader_size,omitempty"`
+	MemoryLimit          int64    `protobuf:"varint,6,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2547,6 +2596,13 @@ func (m *ProcessLimitation) GetReaderSize() int64 {
 	return 0
 }
 
+func (m *ProcessLimitation) GetMemoryLimit() int64 {
+	if m != nil {
+		return m.MemoryLimit
+	}
+	return 0
+}
+
 type ProcessInfo struct {
 	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Lim                  *ProcessLimitation `protobuf:"bytes,2,opt,name=lim,proto3" json:"lim,omitempty"`
@@ -3033,179 +3089,187 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 2747 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x8f, 0x1c, 0x47,
-	0x15, 0xcf, 0x7c, 0x77, 0xbf, 0x99, 0x9d, 0x5d, 0x77, 0xec, 0x78, 0xe2, 0x24, 0xb6, 0x69, 0x70,
-	0xe2, 0xe0, 0x78, 0x57, 0x59, 0x14, 0x14, 0x91, 0x90, 0x60, 0xef, 0x3a, 0x61, 0xc0, 0x1f, 0x9b,
-	0x5a, 0x47, 0x88, 0x08, 0xd1, 0xea, 0xed, 0xae, 0x99, 0xe9, 0x6c, 0x4f, 0x57, 0xbb, 0xaa, 0xdb,
-	0xd9, 0xcd, 0x89, 0x13, 0x07, 0xc8, 0x01, 0xc4, 0x3f, 0x90, 0x3f, 0x00, 0x4e, 0x9c, 0x11, 0xe2,
-	0xc6, 0x11, 0xce, 0xb9, 0xa0, 0x70, 0x85, 0x7f, 0x00, 0x71, 0x40, 0xef, 0x55, 0xf5, 0xc7, 0xcc,
-	0xec, 0xda, 0x0e, 0xe2, 0x80, 0x94, 0xdc, 0xea, 0x7d, 0x54, 0x57, 0xd5, 0x7b, 0xaf, 0x7e, 0xf5,
-	0xea, 0x55, 0xc3, 0x30, 0x8d, 0x52, 0x1e, 0x47, 0x09, 0xdf, 0x4c, 0xa5, 0xc8, 0x84, 0x63, 0x15,
-	0xf4, 0x85, 0xeb, 0xd3, 0x28, 0x9b, 0xe5, 0x07, 0x9b, 0x81, 0x98, 0x6f, 0x4d, 0xc5, 0x54, 0x6c,
-	0x91, 0xc2, 0x41, 0x3e, 0x21, 0x8a, 0x08, 0x6a, 0xe9, 0x8e, 0x17, 0x20, 0x8d, 0xfd, 0xc4, 0xb4,
-	0xd7, 0xb3, 0x68, 0xce, 0x55, 0xe6, 0xcf, 0x53, 0xcd, 0x70, 0x3f, 0x69, 0x42, 0xef, 0x0e, 0x57,
-	0xca, 0x9f, 0x72, 0x67, 0x03, 0x5a, 0x2a, 0x0a, 0x47, 0x8d, 0xcb, 0x8d, 0xab, 0x6d, 0x86, 0x4d,
-	0xe4, 0x04, 0xf3, 0x70, 0xd4, 0xd4, 0x9c, 0x60, 0x4e, 0x1c, 0x2e, 0xe5, 0xa8, 0x75, 0xb9, 0x71,
-	0x75, 0xc0, 0xb0, 0xe9, 0x38, 0xd0, 0x0e, 0xfd, 0xcc, 0x1f, 0xb5, 0x89, 0x45, 0x6d, 0xe7, 0x1b,
-	0x30, 0x4c, 0xa5, 0x08, 0xbc, 0x28, 0x99, 0x08, 0x8f, 0xa4, 0x1d, 0x92, 0x0e, 0x90, 0x3b, 0x4e,
-	0x26, 0x62, 0x17, 0xb5, 0x46, 0xd0, 0xf3, 0x13, 0x3f, 0x3e, 0x56, 0x7c, 0xd4, 0x25, 0x71, 0x41,
-	0x3a, 0x43, 0x68, 0x46, 0xe1, 0xa8, 0x47, 0xc3, 0x36, 0xa3, 0x10, 0xc7, 0xc8, 0xf3, 0x28, 0x1c,
-	0x59, 0x7a, 0x0c, 0x6c, 0x3b, 0xcf, 0x81, 0x7d, 0xe0, 0x67, 0xc1, 0xcc, 0x0b, 0x92, 0x6c, 0x64,
-	0x93, 0xaa, 0x45, 0x8c, 0x9d, 0x24, 0x73, 0x2e, 0x80, 0x15, 0xcc, 0x78, 0x70, 0xa8, 0xf2, 0xf9,
-	0x08, 0x2e, 0x37, 0xae, 0xae, 0xb1, 0x92, 0x46, 0x99, 0xe2, 0x0f, 0x72, 0x9e, 0x04, 0x7c, 0xd4,
-	0xd7, 0xfd, 0x0a, 0xda, 0x7d, 0x1f, 0xec, 0x1d, 0x91, 0x24, 0x3c, 0xc8, 0x84, 0x74, 0x2e, 0x41,
-	0xbf, 0xb0, 0xb9, 0x67, 0xec, 0xd2, 0x61, 0x50, 0xb0, 0xc6, 0xa1, 0xf3, 0x12, 0xac, 0x07, 0x85,
-	0xb6, 0x17, 0x25, 0x21, 0x3f, 0x22, 0x53, 0x75, 0xd8, 0xb0, 0x64, 0x8f, 0x91, 0xeb, 0x7e, 0xda,
-	0x00, 0x6b, 0x37, 0x52, 0x29, 0x4e, 0xcf, 0x39, 0x0f, 0xbd, 0x49, 0x9e, 0x04, 0xd5, 0x27, 0xbb,
-	0x48, 0x8e, 0x43, 0xe7, 0x4d, 0x58, 0x8f, 0x45, 0xe0, 0xc7, 0x5e, 0xd9, 0x7b, 0xd4, 0xbc, 0xdc,
-	0xba, 0xda, 0xdf, 0x7e, 0x7a, 0xb3, 0x8c, 0x85, 0x72, 0x76, 0x6c, 0x48, 0xba, 0xd5, 0x6c, 0xbf,
-	0x0b, 0x1b, 0x92, 0xcf, 0x45, 0xc6, 0x6b, 0xdd, 0x5b, 0xd4, 0xdd, 0xa9, 0xba, 0xff, 0x48, 0xfa,
-	0xe9, 0x5d, 0x11, 0x72, 0xb6, 0xae, 0x75, 0xcb, 0xee, 0xee, 0xef, 0x1b, 0xb0, 0x76, 0x27, 0x8f,
-	0xb3, 0xe8, 0x86, 0x9c, 0xe6, 0x7c, 0x9e, 0x64, 0x68, 0xf4, 0xdd, 0x48, 0x65, 0x34, 0x49, 0x8b,
-	0x51, 0xdb, 0xb9, 0x0a, 0xf6, 0xbb, 0x52, 0xe4, 0xe9, 0xad, 0xa3, 0xb4, 0x98, 0x1c, 0x6c, 0x52,
-	0x7c, 0x21, 0x87, 0x55, 0x42, 0xe7, 0x15, 0xe8, 0xdf, 0x93, 0x21, 0x97, 0x37, 0x8f, 0x49, 0xb7,
-	0xb5, 0xa2, 0x5b, 0x17, 0x3b, 0xcf, 0x83, 0xbd, 0xcf, 0x53, 0x5f, 0xfa, 0x38, 0x6b, 0x8c, 0x24,
-	0x9b, 0x55, 0x0c, 0x0c, 0x14, 0x52, 0x1e, 0x87, 0x14, 0x47, 0x1d, 0x56, 0x90, 0xee, 0x3d, 0xb0,
-	0x6f, 0x4c, 0xa7, 0x92, 0x4f, 0xfd, 0x8c, 0xa2, 0x46, 0xa4, 0xc6, 0xa6, 0x4d, 0x91, 0x52, 0x64,
-	0xe2, 0x02, 0x9a, 0x7a, 0x01, 0xd8, 0x76, 0x2e, 0x42, 0x9b, 0xeb, 0xf9, 0x34, 0x96, 0xe6, 0x43,
-	0x7c, 0xf7, 0xdf, 0x0d, 0xe8, 0xd0, 0x22, 0x30, 0xbe, 0x12, 0xce, 0x43, 0x8f, 0x3f, 0xf4, 0x63,
-	0x63, 0x03, 0x0b, 0x19, 0xb7, 0x1e, 0xfa, 0x31, 0xce, 0x28, 0x3a, 0xc8, 0x83, 0x43, 0x9e, 0x99,
-	0xcd, 0x51, 0x90, 0x28, 0x49, 0x8c, 0xa4, 0xa5, 0x25, 0x86, 0x74, 0x2e, 0x43, 0x07, 0x87, 0x50,
-	0xa3, 0xf6, 0x8a, 0x2d, 0xb4, 0x00, 0x35, 0xb2, 0xe3, 0x94, 0xab, 0x51, 0xa7, 0xae, 0x71, 0xff,
-	0x38, 0xe5, 0x4c, 0x0b, 0x9c, 0x97, 0xa0, 0xed, 0x4f, 0xa7, 0x6a, 0xd4, 0x5d, 0x8e, 0x8b, 0xd2,
-	0x0a, 0x8c, 0x14, 0x9c, 0xd7, 0xc0, 0xd6, 0xde, 0x44, 0xed, 0x1e, 0x69, 0x9f, 0xaf, 0xb4, 0x17,
-	0x1c, 0xcd, 0x2a, 0x4d, 0xf7, 0xb7, 0x6d, 0xe8, 0x8e, 0x13, 0xc5, 0x25, 0x6d, 0x21, 0x7f, 0x32,
-	0xe1, 0x41, 0xc6, 0x0b, 0x48, 0x28, 0x69, 0x94, 0x8d, 0x15, 0xa3, 0x08, 0x32, 0xd6, 0x2d, 0x69,
-	0xe7, 0x2a, 0x6c, 0x88, 0xc4, 0x0b, 0xf3, 0x34, 0x8e, 0x02, 0x3f, 0xc3, 0x9d, 0x73, 0x44, 0xde,
-	0xef, 0xb0, 0xa1, 0x48, 0x76, 0x0b, 0xf6, 0x38, 0x3c, 0x72, 0xde, 0x83, 0x33, 0x0b, 0x9a, 0xe4,
-	0x18, 0x6d, 0x9c, 0x2b, 0xd5, 0x5c, 0xf5, 0x74, 0x36, 0xef, 0x55, 0x7d, 0xd1, 0x64, 0xb7, 0x92,
-	0x4c, 0x1e, 0xb3, 0x75, 0xb1, 0xc8, 0x75, 0xbe, 0x06, 0x2d, 0xc9, 0x27, 0x14, 0x25, 0xfd, 0xed,
-	0x75, 0x6d, 0xbf, 0x7b, 0x07, 0x1f, 0xf2, 0x20, 0x63, 0x7c, 0xc2, 0x50, 0xe6, 0x5c, 0x03, 0x3b,
-	0xf3, 0x0f, 0x62, 0xee, 0x85, 0x7c, 0x42, 0xb8, 0xd3, 0xdf, 0x1e, 0x1a, 0x43, 0x23, 0x7b, 0x97,
-	0x4f, 0x98, 0x95, 0x99, 0x96, 0xf3, 0x16, 0x40, 0xea, 0x4b, 0x9e, 0x64, 0xb4, 0x0c, 0x6d, 0xc7,
-	0x4b, 0x2b, 0x73, 0xdb, 0x23, 0x95, 0x71, 0x78, 0xa4, 0x67, 0x65, 0xa7, 0x05, 0xed, 0x7c, 0x1b,
-	0x06, 0x3b, 0x71, 0xae, 0x32, 0x2e, 0xe9, 0xe3, 0x04, 0x60, 0xb4, 0x21, 0x71, 0xbc, 0xba, 0x84,
-	0x2d, 0xe8, 0x21, 0x46, 0x44, 0xe1, 0x11, 0x0d, 0x6a, 0x93, 0xed, 0xba, 0x51, 0x78, 0x34, 0x0e,
-	0x8f, 0x2e, 0xdc, 0x85, 0xb3, 0x27, 0x59, 0x02, 0x71, 0xf9, 0x90, 0x1f, 0x93, 0xa3, 0x6c, 0x86,
-	0x4d, 0x0c, 0xa6, 0x87, 0x7e, 0x9c, 0x6b, 0x07, 0x2d, 0x85, 0x1b, 0x09, 0xbe, 0xd3, 0x7c, 0xbd,
-	0x71, 0xe1, 0x4d, 0x18, 0x2e, 0xce, 0xfe, 0x84, 0x2f, 0x9d, 0xad, 0x7f, 0xa9, 0x53, 0xeb, 0xed,
-	0xfe, 0xac, 0x09, 0xf6, 0x9e, 0xe4, 0x26, 0x62, 0x2e, 0x41, 0x5f, 0x05, 0x33, 0x3e, 0xf7, 0xbd,
-	0xc4, 0x9f, 0x73, 0xf3, 0x05, 0xd0, 0xac, 0xbb, 0xfe, 0x9c, 0x2f, 0x9a, 0xbe, 0xf9, 0x18, 0xd3,
-	0xff, 0x14, 0xce, 0x55, 0xa6, 0xf7, 0x52, 0xc9, 0xbd, 0x88, 0x86, 0x31, 0x50, 0x72, 0xad, 0xf2,
-	0x42, 0x39, 0x83, 0xca, 0x11, 0x25, 0x4b, 0x7b, 0xc4, 0x49, 0x57, 0x04, 0x17, 0x6e, 0xc1, 0xf9,
-	0x53, 0xd4, 0xbf, 0x90, 0x09, 0xfe, 0xda, 0x84, 0x61, 0xcd, 0x23, 0x3f, 0xe4, 0xc7, 0x8f, 0xdc,
-	0x39, 0x27, 0xed, 0x8e, 0xe6, 0x89, 0xbb, 0xe3, 0xc7, 0x27, 0xed, 0x0e, 0xbd, 0xf6, 0xeb, 0xd5,
-	0xda, 0x17, 0x87, 0xfe, 0x62, 0xbb, 0xa4, 0xfd, 0xa4, 0xbb, 0xa4, 0xf3, 0x68, 0x57, 0xfd, 0xaf,
-	0x83, 0xd2, 0xfd, 0x79, 0x13, 0xda, 0x3f, 0x10, 0x51, 0x52, 0x87, 0xd9, 0xc6, 0xa9, 0x30, 0xdb,
-	0x5c, 0x84, 0xd9, 0x67, 0xc1, 0x92, 0x3c, 0xf6, 0x62, 0x44, 0x7e, 0x8d, 0x3b, 0x3d, 0xc9, 0xe3,
-	0xdb, 0x08, 0xfe, 0xcf, 0x82, 0x15, 0x08, 0x23, 0x6a, 0x6b, 0x51, 0x20, 0xb4, 0xa8, 0x38, 0x17,
-	0x3a, 0x27, 0x9f, 0x0b, 0x15, 0x34, 0x77, 0x4f, 0x87, 0x66, 0x3b, 0xe6, 0x93, 0x0c, 0x4f, 0xdf,
-	0xd0, 0x20, 0x45, 0xfd, 0x33, 0x16, 0x0a, 0x77, 0x44, 0x12, 0x3a, 0x2f, 0x03, 0xc8, 0x68, 0x3a,
-	0x33, 0x9a, 0xd6, 0xea, 0x21, 0x4a, 0x52, 0x54, 0x75, 0xff, 0xd1, 0x00, 0xeb, 0x46, 0x92, 0x45,
-	0xff, 0xb5, 0x31, 0x9e, 0x81, 0xae, 0xe4, 0x2a, 0x8f, 0x0b, 0x53, 0x18, 0xaa, 0x5c, 0x6e, 0xfb,
-	0x71, 0xcb, 0xed, 0x3c, 0xd1, 0x72, 0xbb, 0x4f, 0xbc, 0xdc, 0xde, 0xa3, 0x96, 0xfb, 0xcb, 0x26,
-	0xd8, 0xe3, 0x24, 0xe1, 0xf2, 0x2b, 0xe7, 0x27, 0xa1, 0xfb, 0x8b, 0x26, 0x58, 0xb7, 0xf9, 0x24,
-	0xfb, 0xca, 0x18, 0x49, 0xe8, 0xfe, 0xa9, 0x09, 0x36, 0x43, 0xea, 0xff, 0xcc, 0x1a, 0x2f, 0x03,
-	0xd0, 0x5a, 0x4f, 0x33, 0x09, 0x59, 0xe2, 0x3e, 0x99, 0xe5, 0x1a, 0xf4, 0xf5, 0x6a, 0xb5, 0x6e,
-	0x6f, 0x45, 0x57, 0x1b, 0xe3, 0xfe, 0xaa, 0x0d, 0xad, 0x27, 0xb6, 0xa1, 0xfd, 0x38, 0x34, 0xd9,
-	0xe7, 0xf3, 0x2f, 0x0b, 0x9a, 0x7c, 0xd2, 0x04, 0xd8, 0x8f, 0x92, 0x69, 0xcc, 0xbf, 0xda, 0x41,
-	0x49, 0xe8, 0xfe, 0xba, 0x09, 0xd6, 0x1d, 0x5f, 0x1e, 0x7e, 0x39, 0xbc, 0xef, 0x7c, 0x1d, 0x7a,
-	0x22, 0xd1, 0xee, 0x59, 0x35, 0x4b, 0x57, 0x24, 0xe8, 0x29, 0xd7, 0x87, 0xde, 0x9e, 0x14, 0x61,
-	0x1e, 0x2c, 0xba, 0xba, 0x71, 0xba, 0xab, 0x9b, 0x8b, 0xae, 0x2e, 0xd7, 0xd6, 0x3a, 0x65, 0x6d,
-	0xee, 0x6f, 0x1a, 0xb0, 0x46, 0x29, 0xd3, 0x3b, 0x79, 0x12, 0x64, 0x91, 0x48, 0x30, 0x97, 0xf4,
-	0xb3, 0x4c, 0x2a, 0x1a, 0xc6, 0x66, 0x9a, 0x70, 0x2e, 0x43, 0x5b, 0xf2, 0x4c, 0x99, 0x4b, 0xf5,
-	0xc0, 0xdc, 0x10, 0x44, 0x8c, 0x99, 0x16, 0x49, 0xd0, 0xce, 0xbe, 0x9c, 0xaa, 0x13, 0xae, 0xd2,
-	0xc4, 0x47, 0xff, 0xe0, 0x85, 0x79, 0xae, 0x4c, 0x29, 0xc6, 0x50, 0x78, 0x0d, 0xa6, 0x7c, 0xbc,
-	0x43, 0x69, 0x18, 0xb5, 0xdd, 0x3f, 0x34, 0xc0, 0xfe, 0xbe, 0xaf, 0x66, 0x37, 0xf3, 0x28, 0x0e,
-	0xab, 0xab, 0x2e, 0xba, 0xb1, 0x7e, 0xd5, 0x45, 0xf7, 0x15, 0xc2, 0x99, 0xaf, 0x66, 0xc5, 0x65,
-	0x0f, 0x19, 0xd8, 0xbd, 0x1e, 0x47, 0xad, 0x53, 0xe3, 0xa8, 0xbd, 0x72, 0x0f, 0x7e, 0x4c, 0x3c,
-	0x5c, 0x86, 0x0e, 0x3a, 0x58, 0x9d, 0x10, 0x0b, 0x5a, 0xe0, 0xde, 0x80, 0x73, 0xb7, 0x8e, 0x32,
-	0x2e, 0x13, 0x3f, 0xc6, 0x9b, 0xc5, 0xf6, 0x8e, 0x88, 0xa9, 0xd2, 0x52, 0x2e, 0xb6, 0x51, 0x2d,
-	0x16, 0x0d, 0x5e, 0x2f, 0xce, 0x68, 0xc2, 0xfd, 0x57, 0x03, 0x06, 0xc5, 0x37, 0xf6, 0x03, 0xff,
-	0x11, 0x7e, 0x09, 0x44, 0x7c, 0x8a, 0x5f, 0x50, 0xe2, 0xbc, 0x0b, 0xeb, 0x38, 0xcc, 0xb6, 0x87,
-	0x41, 0xa2, 0x07, 0x6a, 0x2d, 0x5f, 0x14, 0x4f, 0x9c, 0x2c, 0x5b, 0x4b, 0x16, 0xe6, 0xfe, 0x02,
-	0x40, 0x20, 0x39, 0xe6, 0xfa, 0xea, 0x41, 0x5c, 0x54, 0x41, 0x34, 0x67, 0xff, 0x41, 0x8c, 0x8e,
-	0x98, 0x44, 0x31, 0xd7, 0x71, 0xd8, 0xa1, 0x39, 0x5a, 0xc8, 0xa0, 0x40, 0xbc, 0x0e, 0x7d, 0x21,
-	0xa3, 0x69, 0x94, 0x78, 0x34, 0xdb, 0xee, 0x09, 0xb3, 0x05, 0xad, 0xb0, 0x23, 0x62, 0xe5, 0xfe,
-	0xca, 0x86, 0xfe, 0x38, 0x51, 0x99, 0xcc, 0x75, 0x4c, 0x2e, 0x97, 0x4e, 0x36, 0xa0, 0xa5, 0x6f,
-	0x26, 0xc8, 0xc0, 0xa6, 0xf3, 0x22, 0xb4, 0xfd, 0x24, 0x8b, 0x4c, 0xe1, 0xa4, 0x56, 0x52, 0x2a,
-	0xf2, 0x53, 0x46, 0x72, 0xe7, 0x3a, 0xf4, 0x4c, 0xfd, 0xc9, 0x00, 0xc2, 0x89, 0xc5, 0xab, 0x42,
-	0xc7, 0xd9, 0x04, 0x2b, 0x34, 0x85, 0x31, 0x83, 0x97, 0xb5, 0x4f, 0x17, 0x25, 0x33, 0x56, 0xea,
-	0xe0, 0xd5, 0xc5, 0x9f, 0x4e, 0xcd, 0xbd, 0x7d, 0xbd, 0x52, 0xa5, 0x9a, 0x0d, 0x43, 0x99, 0xb3,
-	0x0d, 0x10, 0x61, 0x12, 0xe9, 0x7d, 0x28, 0xa2, 0x84, 0x8a, 0x88, 0x0b, 0x93, 0x28, 0x13, 0x4c,
-	0x66, 0x47, 0x65, 0xae, 0xb9, 0x65, 0x10, 0x88, 0xba, 0x58, 0xcb, 0xf3, 0x28, 0xb2, 0x30, 0x8d,
-	0x44, 0x45, 0x07, 0xc5, 0xe7, 0x91, 0xee, 0x60, 0x2f, 0x77, 0x28, 0x4e, 0x59, 0x66, 0xa9, 0xe2,
-	0xbc, 0x7d, 0x0d, 0xfa, 0x8a, 0x0e, 0x23, 0xdd, 0x05, 0xa8, 0xcb, 0xd9, 0x5a, 0x97, 0xf2, 0xa4,
-	0x62, 0xa0, 0xaa, 0x53, 0x6b, 0x0b, 0xec, 0xb9, 0x2f, 0x0f, 0x75, 0xa7, 0xfe, 0xf2, 0x38, 0x05,
-	0x9e, 0x33, 0x6b, 0x5e, 0x20, 0xbb, 0x0b, 0x6d, 0xd2, 0x1d, 0x14, 0x77, 0xb6, 0x42, 0x57, 0xfb,
-	0x08, 0x65, 0xce, 0x35, 0xe8, 0xa5, 0x1a, 0xf6, 0x46, 0x6b, 0xa4, 0x76, 0xa6, 0x7e, 0x99, 0x26,
-	0x01, 0x2b, 0x34, 0x9c, 0xb7, 0x60, 0xa8, 0x6f, 0x82, 0x13, 0x03, 0x60, 0xa3, 0x21, 0xf5, 0xa9,
-	0x95, 0x93, 0x16, 0xf0, 0x8d, 0xad, 0x65, 0x0b, 0x70, 0xb7, 0x0d, 0x80, 0xd0, 0xe1, 0x1d, 0x20,
-	0xd4, 0x8c, 0xd6, 0x97, 0xdd, 0x51, 0xa2, 0x10, 0xb3, 0x67, 0x25, 0x20, 0xbd, 0x01, 0x6b, 0xdc,
-	0xec, 0x18, 0x4f, 0x05, 0x7e, 0x32, 0xda, 0xa0, 0x6e, 0xcf, 0xac, 0x6e, 0x28, 0xdc, 0xb9, 0x6c,
-	0xc0, 0xeb, 0xfb, 0xf8, 0x2a, 0x74, 0x4d, 0xa5, 0xe0, 0x0c, 0xf5, 0xda, 0x58, 0xae, 0xd7, 0x30,
-	0x23, 0x77, 0x6e, 0x2e, 0x5d, 0xc6, 0xf1, 0xb2, 0xea, 0x50, 0x9f, 0xd1, 0x69, 0x37, 0xec, 0x85,
-	0x6b, 0x3a, 0x5e, 0xf6, 0xb7, 0x01, 0x6a, 0xb5, 0x89, 0xa7, 0x97, 0x97, 0x57, 0x56, 0x16, 0x98,
-	0x9d, 0x96, 0x85, 0x92, 0x57, 0xc0, 0x12, 0x32, 0xe4, 0xd2, 0x3b, 0x38, 0x1e, 0x9d, 0xa5, 0x9d,
-	0x7a, 0xc6, 0x5c, 0xc2, 0x75, 0x49, 0x74, 0x3f, 0xe5, 0x01, 0xeb, 0x09, 0x4d, 0x38, 0xd7, 0x61,
-	0x90, 0x4a, 0x81, 0xb7, 0x73, 0xbd, 0xf5, 0xcf, 0xad, 0x96, 0x52, 0x8d, 0x9c, 0x90, 0xc0, 0x85,
-	0xee, 0x24, 0x8a, 0x33, 0x2e, 0x47, 0xcf, 0xac, 0x1c, 0xc8, 0x46, 0x82, 0x50, 0x17, 0x47, 0xf3,
-	0x28, 0x1b, 0x9d, 0x27, 0x68, 0xd6, 0x04, 0x1e, 0x20, 0x62, 0x32, 0x51, 0x3c, 0x1b, 0x8d, 0x88,
-	0x6d, 0x28, 0x02, 0x79, 0xf5, 0x4e, 0x24, 0x55, 0x36, 0x7a, 0x96, 0xf0, 0xbf, 0x20, 0xb1, 0x47,
-	0xa4, 0x6e, 0xfb, 0x2a, 0x1b, 0x5d, 0x20, 0x81, 0xa1, 0xd0, 0x28, 0xfa, 0x9c, 0xa6, 0x50, 0x7c,
-	0x6e, 0xd9, 0x28, 0x65, 0x22, 0x6f, 0x0e, 0x6c, 0x6c, 0xba, 0xaf, 0xc1, 0xe0, 0x06, 0x95, 0xff,
-	0x23, 0x45, 0xeb, 0xb8, 0x02, 0xed, 0xf2, 0x30, 0x2e, 0x0d, 0x44, 0x1a, 0x1f, 0xf3, 0x71, 0x32,
-	0x11, 0x8c, 0xc4, 0xee, 0x1f, 0x9b, 0xd0, 0xdd, 0x17, 0xb9, 0x0c, 0xf8, 0xe3, 0xeb, 0x4f, 0x2f,
-	0x00, 0xe8, 0x50, 0x26, 0x79, 0x53, 0x03, 0x2c, 0x71, 0x48, 0x5c, 0x3f, 0xe7, 0x5b, 0x84, 0xaf,
-	0xe5, 0x39, 0x7f, 0x16, 0x3a, 0x07, 0xb1, 0x08, 0x0e, 0x0d, 0x2a, 0x6b, 0x82, 0x1e, 0x08, 0x72,
-	0x35, 0x0b, 0xc5, 0x47, 0x89, 0x17, 0xe9, 0xda, 0x74, 0x9b, 0x41, 0xc1, 0x1a, 0x63, 0x12, 0xb2,
-	0x56, 0x2a, 0xf8, 0x61, 0x28, 0x09, 0xb7, 0x6c, 0x36, 0x28, 0x98, 0x37, 0xc2, 0x50, 0x96, 0xf9,
-	0x53, 0xef, 0x94, 0xfc, 0xe9, 0x9b, 0x50, 0x56, 0x5a, 0x0c, 0x34, 0x9d, 0x5e, 0x34, 0xdb, 0x06,
-	0xbb, 0x7c, 0xe1, 0x31, 0xb0, 0x74, 0x76, 0xb3, 0x7a, 0xf3, 0xb9, 0x5f, 0xb4, 0x58, 0xa5, 0xe6,
-	0xfe, 0x04, 0xac, 0xbb, 0x22, 0x24, 0x9b, 0xe2, 0xf1, 0x39, 0x0f, 0xd2, 0xdc, 0x9c, 0x04, 0xd4,
-	0x36, 0x8f, 0x31, 0xda, 0x5a, 0xe6, 0x31, 0x86, 0xd6, 0xd2, 0xd2, 0x47, 0x2c, 0xb6, 0x31, 0x44,
-	0x52, 0xff, 0x38, 0x16, 0x7e, 0x48, 0xc9, 0xb0, 0xcd, 0x0a, 0xd2, 0xfd, 0x5d, 0x03, 0xce, 0xec,
-	0x49, 0x11, 0x70, 0xa5, 0x6e, 0x63, 0x94, 0xf9, 0x04, 0x0a, 0x0e, 0xb4, 0x55, 0xf4, 0xb1, 0xf6,
-	0x51, 0x8b, 0x51, 0x1b, 0xbd, 0xa3, 0x1f, 0x74, 0xa4, 0xf8, 0x48, 0xd1, 0x78, 0x2d, 0xa6, 0x9f,
-	0x78, 0x98, 0xf8, 0x48, 0x55, 0x62, 0xea, 0xd8, 0xaa, 0x89, 0xf7, 0xb1, 0xf7, 0x15, 0x18, 0xa6,
-	0xbe, 0xcc, 0x22, 0xfc, 0xbc, 0xfe, 0x42, 0x9b, 0x54, 0xd6, 0x4a, 0x2e, 0x7d, 0xe5, 0x12, 0xf4,
-	0x25, 0xf7, 0x71, 0xef, 0xd1, 0x67, 0x3a, 0xa4, 0x03, 0x9a, 0x85, 0xdf, 0x71, 0xff, 0xd9, 0x80,
-	0xbe, 0x99, 0x2f, 0x59, 0x44, 0xaf, 0xbe, 0x51, 0xae, 0xfe, 0x3a, 0xb4, 0xe2, 0x68, 0x6e, 0xea,
-	0x57, 0xcf, 0x2d, 0xe0, 0xe6, 0xe2, 0x1a, 0x19, 0xea, 0xe1, 0xa1, 0x9d, 0x27, 0xd1, 0x91, 0x87,
-	0xe6, 0x36, 0x93, 0xb6, 0x90, 0x81, 0x9e, 0xa0, 0x97, 0xa8, 0xc4, 0x4f, 0xd5, 0x4c, 0x64, 0x26,
-	0xb0, 0x4a, 0xda, 0x79, 0x1d, 0x06, 0x8a, 0x2b, 0x85, 0xab, 0x89, 0x92, 0x89, 0x30, 0x87, 0xe3,
-	0xb9, 0xfa, 0x19, 0x43, 0x52, 0xda, 0x0a, 0x7d, 0x55, 0x11, 0xce, 0x2b, 0xe0, 0xf8, 0x66, 0x23,
-	0x79, 0x89, 0x08, 0x4d, 0xc2, 0xd0, 0xa5, 0xc4, 0x75, 0xa3, 0x90, 0xa0, 0xc7, 0x29, 0x05, 0xfe,
-	0xac, 0x01, 0xfd, 0xda, 0xa7, 0xe8, 0xa9, 0x4d, 0x71, 0x59, 0x24, 0x50, 0xd8, 0x46, 0xde, 0x4c,
-	0x98, 0x87, 0x14, 0x9b, 0x51, 0x1b, 0x79, 0x52, 0xc4, 0xbc, 0x88, 0x02, 0x6c, 0x63, 0xb8, 0x9b,
-	0x73, 0x9d, 0xa6, 0x1d, 0x9a, 0xcc, 0x6f, 0x50, 0x31, 0xc7, 0xf4, 0x76, 0x10, 0xfa, 0x99, 0x7f,
-	0xe0, 0xab, 0x22, 0x25, 0x2d, 0x69, 0x0c, 0xa3, 0x87, 0x5c, 0xe2, 0x5c, 0xcc, 0x4e, 0x29, 0x48,
-	0xb4, 0x23, 0x9a, 0xd0, 0xfb, 0x58, 0x24, 0x9c, 0x76, 0xca, 0x80, 0x59, 0xc8, 0xf8, 0x40, 0x24,
-	0xd4, 0xcd, 0x0f, 0x02, 0x91, 0x27, 0x19, 0x6d, 0x10, 0x9b, 0x15, 0xa4, 0xfb, 0x59, 0x1b, 0xac,
-	0x3d, 0x63, 0x31, 0x67, 0x17, 0xd6, 0xca, 0xf7, 0x3c, 0x4c, 0x34, 0x69, 0x8d, 0xc3, 0x7a, 0x9a,
-	0xb6, 0xb7, 0xdc, 0xa0, 0xac, 0x74, 0x90, 0xd6, 0xa8, 0xe5, 0x57, 0xc1, 0xe6, 0xca, 0xab, 0xe0,
-	0xf3, 0xd0, 0x7a, 0x20, 0x8f, 0x17, 0x5f, 0x98, 0xf6, 0x62, 0x3f, 0x61, 0xc8, 0x76, 0x5e, 0x85,
-	0x3e, 0x2e, 0xd7, 0x53, 0x84, 0x59, 0x26, 0x47, 0xaa, 0x1d, 0x51, 0x1a, 0xcb, 0x18, 0xa0, 0x92,
-	0xc1, 0xb5, 0x4d, 0xb0, 0x82, 0x59, 0x14, 0x87, 0x92, 0x27, 0x26, 0x67, 0x76, 0x56, 0xa7, 0xcc,
-	0x4a, 0x1d, 0xe7, 0x7b, 0xb0, 0x11, 0x55, 0xb9, 0x5d, 0xe5, 0xfe, 0x85, 0xf0, 0xa9, 0x65, 0x7f,
-	0x6c, 0xbd, 0xa6, 0x4e, 0x70, 0x77, 0x0e, 0x71, 0xdd, 0xe3, 0x89, 0x7e, 0x83, 0xb5, 0x58, 0x27,
-	0x52, 0xb7, 0x92, 0x90, 0x5e, 0x25, 0x54, 0x95, 0x23, 0x11, 0xde, 0x53, 0xd2, 0xf1, 0x22, 0xb4,
-	0x31, 0xd2, 0x56, 0x13, 0xa1, 0x02, 0x58, 0x18, 0xc9, 0xe9, 0x5d, 0x38, 0x57, 0x33, 0x4f, 0x23,
-	0x26, 0x86, 0x35, 0x90, 0xf9, 0x08, 0x10, 0x77, 0x11, 0x33, 0x31, 0x04, 0xaf, 0xc0, 0xb0, 0x58,
-	0x8b, 0xa7, 0xbd, 0xda, 0x27, 0xad, 0xb5, 0x82, 0xbb, 0x83, 0x4c, 0xe7, 0x6d, 0xd8, 0xc8, 0xf3,
-	0x28, 0x54, 0x5e, 0x26, 0x3c, 0xc9, 0xa7, 0x54, 0x4a, 0x1f, 0xd0, 0x32, 0x6b, 0x79, 0xc2, 0xfb,
-	0x79, 0x14, 0xde, 0x17, 0x8c, 0x4f, 0xc7, 0xe1, 0x11, 0x5b, 0x23, 0xfd, 0x82, 0x74, 0xdf, 0x86,
-	0x41, 0xdd, 0xcf, 0x8e, 0x0d, 0x9d, 0x3b, 0x5c, 0x4e, 0xf9, 0xc6, 0x53, 0x0e, 0x40, 0xf7, 0xae,
-	0x90, 0x73, 0x3f, 0xde, 0x68, 0x60, 0x5b, 0x3f, 0x6d, 0x6d, 0x34, 0x9d, 0x01, 0x58, 0x7b, 0xbe,
-	0xf4, 0xe3, 0x98, 0xc7, 0x1b, 0x2d, 0xf7, 0x0d, 0xb0, 0x8a, 0x07, 0x55, 0xba, 0x26, 0xe1, 0x66,
-	0x23, 0x68, 0xd4, 0x9b, 0xc7, 0x42, 0x06, 0x41, 0x7c, 0xf1, 0x7e, 0xdd, 0xac, 0xde, 0xaf, 0xdd,
-	0xf7, 0x60, 0x50, 0x9f, 0x5c, 0x91, 0x72, 0x37, 0xaa, 0x94, 0xfb, 0x84, 0x5e, 0x74, 0x09, 0x90,
-	0x62, 0xee, 0xd5, 0x10, 0xd8, 0x42, 0x06, 0x0e, 0x73, 0x73, 0xe7, 0xcf, 0x9f, 0x5f, 0x6c, 0xfc,
-	0xe5, 0xf3, 0x8b, 0x8d, 0xbf, 0x7d, 0x7e, 0xf1, 0xa9, 0x4f, 0xff, 0x7e, 0xb1, 0xf1, 0xc1, 0xab,
-	0xb5, 0x5f, 0x05, 0xe6, 0x7e, 0x26, 0xa3, 0x23, 0x7d, 0x09, 0x28, 0x88, 0x84, 0x6f, 0xa5, 0x87,
-	0xd3, 0xad, 0xf4, 0x60, 0xab, 0xb0, 0xd8, 0x41, 0x97, 0x7e, 0x0c, 0xf8, 0xd6, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x6c, 0x4f, 0xb6, 0x8d, 0x80, 0x20, 0x00, 0x00,
+	// 2870 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0xcb, 0x92, 0x1c, 0xc5,
+	0xb5, 0xf4, 0xbb, 0xea, 0x74, 0xcf, 0x43, 0x89, 0x1e, 0x25, 0x01, 0xd2, 0xd0, 0x5c, 0xc1, 0x70,
+	0x85, 0x46, 0xc1, 0xdc, 0xcb, 0x0d, 0xe2, 0x82, 0xc1, 0xd2, 0x48, 0xe0, 0xb6, 0xf5, 0x18, 0x72,
+	0x44, 0x10, 0x26, 0x1c, 0xae, 0xa8, 0xa9, 0xca, 0xee, 0x2e, 0x54, 0x9d, 0x59, 0xca, 0xac, 0x96,
+	0x66, 0x58, 0x79, 0x6d, 0xb3, 0x71, 0xf0, 0x03, 0x78, 0xef, 0x9d, 0x7f, 0xc0, 0xde, 0x38, 0xbc,
+	0xc4, 0x5b, 0xb3, 0x71, 0xe0, 0xad, 0xfd, 0x03, 0x5e, 0x39, 0xce, 0xc9, 0xaa, 0xea, 0xea, 0xee,
+	0x99, 0x91, 0x20, 0xbc, 0x70, 0x04, 0xec, 0xce, 0x2b, 0x2b, 0x33, 0xcf, 0x2b, 0x4f, 0x9e, 0x2c,
+	0x58, 0x4d, 0xe3, 0x54, 0x24, 0xb1, 0x14, 0x5b, 0xa9, 0x56, 0x99, 0x62, 0x4e, 0x81, 0x5f, 0xb8,
+	0x3a, 0x8a, 0xb3, 0xf1, 0x74, 0x7f, 0x2b, 0x54, 0x93, 0x6b, 0x23, 0x35, 0x52, 0xd7, 0x48, 0x60,
+	0x7f, 0x3a, 0x24, 0x8c, 0x10, 0x82, 0xec, 0xc0, 0x0b, 0x90, 0x26, 0x81, 0xcc, 0xe1, 0xb5, 0x2c,
+	0x9e, 0x08, 0x93, 0x05, 0x93, 0xd4, 0x12, 0xfa, 0x9f, 0xd5, 0xa1, 0x73, 0x47, 0x18, 0x13, 0x8c,
+	0x04, 0x5b, 0x87, 0x86, 0x89, 0x23, 0xaf, 0xb6, 0x51, 0xdb, 0x6c, 0x72, 0x04, 0x91, 0x12, 0x4e,
+	0x22, 0xaf, 0x6e, 0x29, 0xe1, 0x84, 0x28, 0x42, 0x6b, 0xaf, 0xb1, 0x51, 0xdb, 0xec, 0x71, 0x04,
+	0x19, 0x83, 0x66, 0x14, 0x64, 0x81, 0xd7, 0x24, 0x12, 0xc1, 0xec, 0xbf, 0x60, 0x35, 0xd5, 0x2a,
+	0xf4, 0x63, 0x39, 0x54, 0x3e, 0x71, 0x5b, 0xc4, 0xed, 0x21, 0x75, 0x20, 0x87, 0xea, 0x26, 0x4a,
+	0x79, 0xd0, 0x09, 0x64, 0x90, 0x1c, 0x1a, 0xe1, 0xb5, 0x89, 0x5d, 0xa0, 0x6c, 0x15, 0xea, 0x71,
+	0xe4, 0x75, 0x68, 0xda, 0x7a, 0x1c, 0xe1, 0x1c, 0xd3, 0x69, 0x1c, 0x79, 0x8e, 0x9d, 0x03, 0x61,
+	0xf6, 0x1c, 0xb8, 0xfb, 0x41, 0x16, 0x8e, 0xfd, 0x50, 0x66, 0x9e, 0x4b, 0xa2, 0x0e, 0x11, 0x76,
+	0x64, 0xc6, 0x2e, 0x80, 0x13, 0x8e, 0x45, 0xf8, 0xc0, 0x4c, 0x27, 0x1e, 0x6c, 0xd4, 0x36, 0x57,
+	0x78, 0x89, 0x23, 0xcf, 0x88, 0x87, 0x53, 0x21, 0x43, 0xe1, 0x75, 0xed, 0xb8, 0x02, 0xef, 0x7f,
+	0x08, 0xee, 0x8e, 0x92, 0x52, 0x84, 0x99, 0xd2, 0xec, 0x12, 0x74, 0x0b, 0x9d, 0xfb, 0xb9, 0x5e,
+	0x5a, 0x1c, 0x0a, 0xd2, 0x20, 0x62, 0xaf, 0xc0, 0x5a, 0x58, 0x48, 0xfb, 0xb1, 0x8c, 0xc4, 0x01,
+	0xa9, 0xaa, 0xc5, 0x57, 0x4b, 0xf2, 0x00, 0xa9, 0xfd, 0x2f, 0x6a, 0xe0, 0xdc, 0x8c, 0x4d, 0x8a,
+	0xcb, 0x63, 0xe7, 0xa0, 0x33, 0x9c, 0xca, 0x70, 0xf6, 0xc9, 0x36, 0xa2, 0x83, 0x88, 0xbd, 0x0d,
+	0x6b, 0x89, 0x0a, 0x83, 0xc4, 0x2f, 0x47, 0x7b, 0xf5, 0x8d, 0xc6, 0x66, 0x77, 0xfb, 0xd9, 0xad,
+	0xd2, 0x17, 0xca, 0xd5, 0xf1, 0x55, 0x92, 0x9d, 0xad, 0xf6, 0x07, 0xb0, 0xae, 0xc5, 0x44, 0x65,
+	0xa2, 0x32, 0xbc, 0x41, 0xc3, 0xd9, 0x6c, 0xf8, 0x47, 0x3a, 0x48, 0xef, 0xaa, 0x48, 0xf0, 0x35,
+	0x2b, 0x5b, 0x0e, 0xef, 0xff, 0xae, 0x06, 0x2b, 0x77, 0xa6, 0x49, 0x16, 0x5f, 0xd7, 0xa3, 0xa9,
+	0x98, 0xc8, 0x0c, 0x95, 0x7e, 0x33, 0x36, 0x19, 0x2d, 0xd2, 0xe1, 0x04, 0xb3, 0x4d, 0x70, 0xdf,
+	0xd7, 0x6a, 0x9a, 0xde, 0x3a, 0x48, 0x8b, 0xc5, 0xc1, 0x16, 0xf9, 0x17, 0x52, 0xf8, 0x8c, 0xc9,
+	0x5e, 0x83, 0xee, 0x3d, 0x1d, 0x09, 0x7d, 0xe3, 0x90, 0x64, 0x1b, 0x4b, 0xb2, 0x55, 0x36, 0x7b,
+	0x1e, 0xdc, 0x3d, 0x91, 0x06, 0x3a, 0xc0, 0x55, 0xa3, 0x27, 0xb9, 0x7c, 0x46, 0x40, 0x47, 0x21,
+	0xe1, 0x41, 0x44, 0x7e, 0xd4, 0xe2, 0x05, 0xda, 0xbf, 0x07, 0xee, 0xf5, 0xd1, 0x48, 0x8b, 0x51,
+	0x90, 0x91, 0xd7, 0xa8, 0x34, 0xd7, 0x69, 0x5d, 0xa5, 0xe4, 0x99, 0xb8, 0x81, 0xba, 0xdd, 0x00,
+	0xc2, 0xec, 0x22, 0x34, 0x85, 0x5d, 0x4f, 0x6d, 0x61, 0x3d, 0x44, 0xef, 0xff, 0xa6, 0x0e, 0x2d,
+	0xda, 0x04, 0xfa, 0x97, 0x14, 0x22, 0xf2, 0xc5, 0xa3, 0x20, 0xc9, 0x75, 0xe0, 0x20, 0xe1, 0xd6,
+	0xa3, 0x20, 0xc1, 0x15, 0xc5, 0xfb, 0xd3, 0xf0, 0x81, 0xc8, 0xf2, 0xe0, 0x28, 0x50, 0xe4, 0xc8,
+	0x9c, 0xd3, 0xb0, 0x9c, 0x1c, 0x65, 0x1b, 0xd0, 0xc2, 0x29, 0x8c, 0xd7, 0x5c, 0xd2, 0x85, 0x65,
+	0xa0, 0x44, 0x76, 0x98, 0x0a, 0xe3, 0xb5, 0xaa, 0x12, 0xf7, 0x0f, 0x53, 0xc1, 0x2d, 0x83, 0xbd,
+	0x02, 0xcd, 0x60, 0x34, 0x32, 0x5e, 0x7b, 0xd1, 0x2f, 0x4a, 0x2d, 0x70, 0x12, 0x60, 0x6f, 0x80,
+	0x6b, 0xad, 0x89, 0xd2, 0x1d, 0x92, 0x3e, 0x37, 0x93, 0x9e, 0x33, 0x34, 0x9f, 0x49, 0xb2, 0x97,
+	0x60, 0x65, 0x84, 0xbb, 0x8f, 0xe5, 0xc8, 0x37, 0x22, 0x33, 0x9e, 0xb3, 0xd1, 0xd8, 0x6c, 0xf0,
+	0x5e, 0x41, 0xdc, 0x13, 0x99, 0xe9, 0xff, 0xb6, 0x09, 0xed, 0x81, 0x34, 0x42, 0x53, 0x9c, 0x05,
+	0xc3, 0xa1, 0x08, 0x33, 0x51, 0xe4, 0x8d, 0x12, 0x47, 0xde, 0xc0, 0x70, 0x72, 0xb3, 0xdc, 0x04,
+	0x25, 0xce, 0x36, 0x61, 0x5d, 0x49, 0x3f, 0x9a, 0xa6, 0x49, 0x1c, 0x06, 0x19, 0x86, 0xd7, 0x01,
+	0xb9, 0x48, 0x8b, 0xaf, 0x2a, 0x79, 0xb3, 0x20, 0x0f, 0xa2, 0x03, 0xf6, 0x01, 0x9c, 0x9a, 0x93,
+	0x24, 0xeb, 0x59, 0x0d, 0x5e, 0x9e, 0x6d, 0xc8, 0x2e, 0x67, 0xeb, 0xde, 0x6c, 0x2c, 0xea, 0xf5,
+	0x96, 0xcc, 0xf4, 0x21, 0x5f, 0x53, 0xf3, 0x54, 0xf6, 0x22, 0x34, 0xb4, 0x18, 0x92, 0x2b, 0x75,
+	0xb7, 0xd7, 0xac, 0x92, 0xef, 0xed, 0x7f, 0x22, 0xc2, 0x8c, 0x8b, 0x21, 0x47, 0x1e, 0xbb, 0x02,
+	0x6e, 0x16, 0xec, 0x27, 0xc2, 0x8f, 0xc4, 0x90, 0x92, 0x53, 0x77, 0x7b, 0x35, 0xb7, 0x06, 0x92,
+	0x6f, 0x8a, 0x21, 0x77, 0xb2, 0x1c, 0x62, 0xef, 0x00, 0xa4, 0x81, 0x16, 0x32, 0xa3, 0x6d, 0x58,
+	0x65, 0x5f, 0x5a, 0x5a, 0xdb, 0x2e, 0x89, 0x0c, 0xa2, 0x03, 0xbb, 0x2a, 0x37, 0x2d, 0x70, 0xf6,
+	0x7f, 0xd0, 0xdb, 0x49, 0xa6, 0x26, 0x13, 0x9a, 0x3e, 0x4e, 0x59, 0x8e, 0xa2, 0x16, 0xe7, 0xab,
+	0x72, 0xf8, 0x9c, 0x1c, 0x26, 0x92, 0x38, 0x3a, 0xa0, 0x49, 0x5d, 0xd2, 0x5d, 0x3b, 0x8e, 0x0e,
+	0x06, 0xd1, 0xc1, 0x85, 0xbb, 0x70, 0xfa, 0x28, 0x4d, 0x60, 0xf2, 0x7e, 0x20, 0x0e, 0xc9, 0x50,
+	0x2e, 0x47, 0x10, 0x3d, 0xee, 0x51, 0x90, 0x4c, 0xad, 0x81, 0x16, 0x7c, 0x92, 0x18, 0xff, 0x5f,
+	0x7f, 0xb3, 0x76, 0xe1, 0x6d, 0x58, 0x9d, 0x5f, 0xfd, 0x11, 0x5f, 0x3a, 0x5d, 0xfd, 0x52, 0xab,
+	0x32, 0xba, 0xff, 0x8b, 0x3a, 0xb8, 0xbb, 0x5a, 0xe4, 0x1e, 0x73, 0x09, 0xba, 0x26, 0x1c, 0x8b,
+	0x49, 0xe0, 0xcb, 0x60, 0x22, 0xf2, 0x2f, 0x80, 0x25, 0xdd, 0x0d, 0x26, 0x62, 0x5e, 0xf5, 0xf5,
+	0x27, 0xa8, 0xfe, 0xe7, 0x70, 0x66, 0xa6, 0x7a, 0x3f, 0xd5, 0xc2, 0x8f, 0x69, 0x9a, 0x3c, 0xdf,
+	0x5c, 0x99, 0x59, 0xa1, 0x5c, 0xc1, 0xcc, 0x10, 0x25, 0xc9, 0x5a, 0x84, 0xa5, 0x4b, 0x8c, 0x0b,
+	0xb7, 0xe0, 0xdc, 0x31, 0xe2, 0xdf, 0x48, 0x05, 0x7f, 0xae, 0xc3, 0x6a, 0xc5, 0x22, 0x3f, 0x11,
+	0x87, 0x27, 0x46, 0xce, 0x51, 0xd1, 0x51, 0x3f, 0x32, 0x3a, 0x7e, 0x7a, 0x54, 0x74, 0xd8, 0xbd,
+	0x5f, 0x9d, 0xed, 0x7d, 0x7e, 0xea, 0x6f, 0x16, 0x25, 0xcd, 0xa7, 0x8d, 0x92, 0xd6, 0xc9, 0xa6,
+	0xfa, 0x77, 0x3b, 0x65, 0xff, 0x2f, 0x75, 0x68, 0xfe, 0x58, 0xc5, 0xb2, 0x9a, 0x8b, 0x6b, 0xc7,
+	0xe6, 0xe2, 0xfa, 0x7c, 0x2e, 0x3e, 0x0f, 0x8e, 0x16, 0x89, 0x9f, 0xe0, 0xf1, 0x60, 0xf3, 0x4e,
+	0x47, 0x8b, 0xe4, 0x36, 0x9e, 0x10, 0xe7, 0xc1, 0x09, 0x55, 0xce, 0x6a, 0x5a, 0x56, 0xa8, 0x92,
+	0xdb, 0xd5, 0xc3, 0xa3, 0x75, 0xf4, 0xe1, 0x31, 0xcb, 0xdf, 0xed, 0xe3, 0xf3, 0xb7, 0x9b, 0x88,
+	0x61, 0x86, 0x47, 0x74, 0xe4, 0x75, 0xaa, 0x52, 0xf4, 0x19, 0x07, 0x99, 0x3b, 0x4a, 0x46, 0xec,
+	0x55, 0x00, 0x1d, 0x8f, 0xc6, 0xb9, 0xa4, 0xb3, 0x7c, 0xd2, 0x12, 0x97, 0x44, 0x39, 0x9c, 0xd7,
+	0x53, 0x89, 0x85, 0x9d, 0x3f, 0x8c, 0x93, 0x4c, 0x68, 0x7f, 0x7f, 0x1a, 0x27, 0x91, 0xdd, 0x81,
+	0x5b, 0xa4, 0x7e, 0x1c, 0xc9, 0xad, 0xd8, 0x7b, 0x24, 0xb5, 0x97, 0x8a, 0x90, 0x9f, 0xd5, 0x55,
+	0xd2, 0x0d, 0x1c, 0x87, 0x3b, 0xed, 0xff, 0xbd, 0x06, 0xce, 0x75, 0x99, 0xc5, 0xdf, 0x5a, 0xc1,
+	0x67, 0xa1, 0xad, 0x85, 0x99, 0x26, 0x85, 0x7a, 0x73, 0xac, 0x54, 0x61, 0xf3, 0x49, 0x2a, 0x6c,
+	0x3d, 0x95, 0x0a, 0xdb, 0x4f, 0xad, 0xc2, 0xce, 0x09, 0x2a, 0xec, 0xff, 0xaa, 0x0e, 0xee, 0x40,
+	0x4a, 0xa1, 0xbf, 0x77, 0x28, 0x19, 0xf5, 0x7f, 0x59, 0x07, 0xe7, 0xb6, 0x18, 0x66, 0xdf, 0x2b,
+	0x43, 0x46, 0xfd, 0x3f, 0xd4, 0xc1, 0xe5, 0x88, 0xfd, 0x87, 0x69, 0xe3, 0x55, 0x00, 0xda, 0xeb,
+	0x71, 0x2a, 0x21, 0x4d, 0xdc, 0x27, 0xb5, 0x5c, 0x81, 0xae, 0xdd, 0xad, 0x95, 0xed, 0x2c, 0xc9,
+	0x5a, 0x65, 0xdc, 0x5f, 0xd6, 0xa1, 0xf3, 0xd4, 0x3a, 0x74, 0x4f, 0xd2, 0xe1, 0xef, 0xeb, 0xe0,
+	0xec, 0x89, 0xc9, 0x77, 0x24, 0x9b, 0x9c, 0x9c, 0x90, 0x9d, 0x6f, 0x97, 0x90, 0x3f, 0xab, 0x03,
+	0xec, 0xc5, 0x72, 0x94, 0x88, 0xef, 0xa3, 0x52, 0x46, 0xfd, 0x5f, 0xd7, 0xc1, 0xb9, 0x13, 0xe8,
+	0x07, 0xdf, 0x11, 0x8f, 0x7a, 0x09, 0x3a, 0x4a, 0x56, 0xfd, 0xa7, 0x2a, 0xd7, 0x56, 0x92, 0x5c,
+	0x24, 0x80, 0xce, 0xae, 0x56, 0xd1, 0x34, 0x9c, 0x37, 0x75, 0xed, 0x78, 0x53, 0xd7, 0xe7, 0x4d,
+	0x5d, 0xee, 0xad, 0x71, 0xcc, 0xde, 0xfa, 0x9f, 0xd7, 0x60, 0x85, 0x4a, 0xbb, 0xf7, 0xa6, 0x32,
+	0xcc, 0x62, 0x25, 0xb1, 0xe6, 0x0d, 0xb2, 0x4c, 0x1b, 0x9a, 0xc6, 0xe5, 0x16, 0x61, 0x1b, 0xd0,
+	0xd4, 0x78, 0x7b, 0xb4, 0x1d, 0x82, 0x5e, 0x7e, 0x93, 0x51, 0x09, 0x56, 0x84, 0xc4, 0x41, 0x3d,
+	0x07, 0x7a, 0x64, 0x8e, 0xe8, 0x0b, 0x10, 0x1d, 0xed, 0x83, 0xb7, 0xff, 0x89, 0xc9, 0xfb, 0x4a,
+	0x39, 0x86, 0x77, 0x7a, 0xba, 0x37, 0xb4, 0xa8, 0x5c, 0x24, 0x18, 0x9d, 0xc1, 0xfd, 0x51, 0x60,
+	0xc6, 0x14, 0x2d, 0xb3, 0x7b, 0x3b, 0x9a, 0xb1, 0x7a, 0x6f, 0x47, 0xf3, 0x15, 0xcc, 0x71, 0x60,
+	0xc6, 0xc5, 0xa5, 0x14, 0x09, 0x38, 0xbc, 0xea, 0x47, 0x8d, 0x63, 0xfd, 0xa8, 0xb9, 0x74, 0xa9,
+	0x7f, 0x82, 0x3f, 0x6c, 0x40, 0x0b, 0x0d, 0x6c, 0x8e, 0xf0, 0x05, 0xcb, 0x38, 0x39, 0x5f, 0x74,
+	0xbe, 0x5d, 0xbe, 0xb8, 0x0e, 0x67, 0x6e, 0x1d, 0x64, 0x42, 0xcb, 0x20, 0xc1, 0x5b, 0xd5, 0xf6,
+	0x8e, 0x4a, 0xa8, 0x15, 0x55, 0x2a, 0xb0, 0x36, 0x53, 0x20, 0x1a, 0xb1, 0xda, 0xbd, 0xb2, 0x48,
+	0xff, 0x9f, 0x35, 0xe8, 0x15, 0xdf, 0xd8, 0x0b, 0x83, 0x13, 0x6c, 0x1d, 0xaa, 0xe4, 0x18, 0x5b,
+	0x23, 0x87, 0xbd, 0x0f, 0x6b, 0x38, 0xcd, 0xb6, 0x8f, 0x8e, 0x67, 0x27, 0x6a, 0x2c, 0x5e, 0x92,
+	0x8f, 0x5c, 0x2c, 0x5f, 0x91, 0x73, 0x6b, 0x7f, 0x01, 0x20, 0xd4, 0x02, 0xef, 0x39, 0xe6, 0x61,
+	0x52, 0xb4, 0x89, 0x2c, 0x65, 0xef, 0x61, 0x82, 0xc6, 0x1d, 0xc6, 0x89, 0xb0, 0x7a, 0x6b, 0xd1,
+	0x1a, 0x1d, 0x24, 0x90, 0x73, 0x5f, 0x85, 0xae, 0xd2, 0xf1, 0x28, 0x96, 0x3e, 0xad, 0xb6, 0x7d,
+	0xc4, 0x6a, 0xc1, 0x0a, 0xec, 0xa8, 0xc4, 0xf4, 0xff, 0xe8, 0x42, 0x77, 0x20, 0x4d, 0xa6, 0xa7,
+	0xd6, 0xcf, 0x17, 0x7b, 0x4b, 0xeb, 0xd0, 0xb0, 0xb7, 0x32, 0x24, 0x20, 0xc8, 0x5e, 0x86, 0x66,
+	0x20, 0xb3, 0x38, 0xef, 0x2c, 0x55, 0x7a, 0x6e, 0x45, 0x1d, 0xcd, 0x89, 0xcf, 0xae, 0x42, 0x27,
+	0x6f, 0xd0, 0xe5, 0x49, 0xe6, 0xc8, 0xee, 0x5e, 0x21, 0xc3, 0xb6, 0xc0, 0x89, 0xf2, 0xce, 0xa1,
+	0xd7, 0x5a, 0xfc, 0x74, 0xd1, 0x53, 0xe4, 0xa5, 0x0c, 0x5e, 0xdb, 0x82, 0xd1, 0x28, 0xef, 0x59,
+	0xac, 0xcd, 0x44, 0xa9, 0xa9, 0xc5, 0x91, 0xc7, 0xb6, 0x01, 0x62, 0x29, 0x85, 0xf6, 0x3f, 0x51,
+	0xb1, 0xf4, 0x3a, 0x8b, 0x8b, 0x28, 0x0b, 0x61, 0xee, 0xc6, 0x05, 0xc8, 0xae, 0xe5, 0x59, 0x8d,
+	0x86, 0x38, 0x8b, 0xeb, 0x28, 0xaa, 0x45, 0x9b, 0xdd, 0x8a, 0x01, 0x46, 0x4c, 0x62, 0x3b, 0xc0,
+	0x5d, 0x1c, 0x50, 0x54, 0x03, 0xd8, 0x7a, 0xb5, 0x10, 0x7b, 0x03, 0xba, 0x86, 0x0e, 0x38, 0x3b,
+	0x04, 0x68, 0xc8, 0xe9, 0xca, 0x90, 0xf2, 0xf4, 0xe3, 0x60, 0x4a, 0x18, 0xe7, 0x99, 0x04, 0xfa,
+	0x81, 0x1d, 0xd4, 0x5d, 0x9c, 0xa7, 0x38, 0x23, 0xb8, 0x33, 0xc9, 0x21, 0xd6, 0x87, 0x26, 0xc9,
+	0xf6, 0x8a, 0xfb, 0x6a, 0x21, 0x6b, 0x6d, 0x84, 0x3c, 0x76, 0x05, 0x3a, 0xa9, 0x4d, 0xa5, 0xde,
+	0x0a, 0x89, 0x9d, 0xaa, 0x36, 0x12, 0x88, 0xc1, 0x0b, 0x09, 0xf6, 0x0e, 0xac, 0xda, 0x5b, 0xf0,
+	0x30, 0x4f, 0x8a, 0xde, 0xea, 0x46, 0x6d, 0xbe, 0xdf, 0x36, 0x97, 0x33, 0xf9, 0x4a, 0x56, 0x45,
+	0xd1, 0x1c, 0x98, 0x8e, 0x6c, 0xd0, 0x7b, 0x6b, 0x8b, 0xe6, 0x28, 0x33, 0x1b, 0x77, 0xc7, 0x05,
+	0xc8, 0xde, 0x82, 0x15, 0x91, 0x47, 0x8c, 0x6f, 0xc2, 0x40, 0x7a, 0xeb, 0x34, 0xec, 0xec, 0x72,
+	0x40, 0x61, 0xe4, 0xf2, 0x9e, 0xa8, 0x60, 0x6c, 0x13, 0xda, 0x79, 0x97, 0xe4, 0x14, 0x8d, 0x5a,
+	0x5f, 0xec, 0x55, 0xf1, 0x9c, 0xcf, 0x6e, 0x2c, 0x34, 0x22, 0xf0, 0xa2, 0xce, 0x68, 0x8c, 0x77,
+	0x5c, 0x77, 0x61, 0xae, 0x45, 0x81, 0x8d, 0x8e, 0x6d, 0x80, 0x4a, 0x5f, 0xe6, 0xd9, 0xc5, 0xed,
+	0x95, 0x5d, 0x15, 0xee, 0xa6, 0x05, 0xc8, 0x5e, 0x03, 0x47, 0x61, 0x87, 0xd7, 0xdf, 0x3f, 0xf4,
+	0x4e, 0x53, 0xa4, 0x9e, 0xca, 0x1b, 0x10, 0xb6, 0x67, 0x4c, 0xa9, 0xaf, 0xa3, 0x2c, 0xc2, 0xae,
+	0x02, 0xbe, 0x2b, 0x60, 0x67, 0xc2, 0x86, 0xfe, 0x99, 0xe5, 0x5e, 0x73, 0xce, 0xa7, 0x4c, 0xd0,
+	0x87, 0xb6, 0x4d, 0xb3, 0xde, 0xd9, 0xa5, 0x43, 0x3e, 0xe7, 0x60, 0xaa, 0x4b, 0xe2, 0x49, 0x9c,
+	0x79, 0xe7, 0x28, 0xdd, 0x5b, 0x04, 0x0f, 0x25, 0x35, 0x1c, 0x1a, 0x91, 0x79, 0x1e, 0x91, 0x73,
+	0x8c, 0x0e, 0x0e, 0xf3, 0x5e, 0xac, 0x4d, 0xe6, 0x9d, 0xa7, 0x33, 0xa5, 0x40, 0x71, 0x44, 0x6c,
+	0x6e, 0x07, 0x26, 0xf3, 0x2e, 0x10, 0x23, 0xc7, 0x50, 0x29, 0xf6, 0xec, 0x27, 0x57, 0x7c, 0x6e,
+	0x51, 0x29, 0xe5, 0x85, 0x23, 0x2f, 0x02, 0x10, 0x64, 0xff, 0x0b, 0x2b, 0x8f, 0x63, 0xe9, 0x9b,
+	0x54, 0x84, 0x76, 0x9f, 0xcf, 0xd3, 0x3e, 0xd7, 0xed, 0xf2, 0x3f, 0x8a, 0x65, 0xa4, 0x1e, 0x93,
+	0x62, 0xba, 0x8f, 0x63, 0x89, 0x00, 0x1d, 0x04, 0x6f, 0x40, 0xef, 0x3a, 0xbd, 0xaa, 0xc4, 0x86,
+	0x76, 0x7f, 0x19, 0x9a, 0x65, 0x59, 0x50, 0xaa, 0x95, 0x24, 0x3e, 0x15, 0xf8, 0x32, 0xc3, 0x89,
+	0xdd, 0xff, 0xbc, 0x01, 0xed, 0x3d, 0x35, 0xd5, 0xa1, 0x78, 0x72, 0xc7, 0xee, 0x05, 0x00, 0x1b,
+	0x00, 0xc4, 0xaf, 0xdb, 0xb4, 0x4c, 0x14, 0x62, 0x57, 0x2b, 0x8e, 0x06, 0x65, 0xe5, 0xb2, 0xe2,
+	0x38, 0x0d, 0xad, 0xfd, 0x44, 0x85, 0x0f, 0xf2, 0x5c, 0x6e, 0x11, 0x9c, 0x30, 0x9d, 0x9a, 0x71,
+	0xa4, 0x1e, 0x4b, 0x7c, 0x24, 0x69, 0x91, 0xae, 0xa1, 0x20, 0x0d, 0xb0, 0x1c, 0x5a, 0x29, 0x05,
+	0x82, 0x28, 0xd2, 0x94, 0xed, 0x5c, 0xde, 0x2b, 0x88, 0xd7, 0xa3, 0x48, 0x97, 0x95, 0x5c, 0xe7,
+	0x98, 0x4a, 0xee, 0xbf, 0xa1, 0xec, 0x4d, 0x79, 0xce, 0xc9, 0xbd, 0x2b, 0xb6, 0x0d, 0x6e, 0xf9,
+	0x70, 0x96, 0x27, 0xb3, 0xd3, 0x5b, 0x25, 0x65, 0xeb, 0x7e, 0x01, 0xf1, 0x99, 0xd8, 0x11, 0xa7,
+	0x7a, 0xaa, 0xd5, 0x7e, 0x7e, 0x3a, 0xc1, 0x37, 0x39, 0xd5, 0x77, 0x71, 0x1c, 0x19, 0xf3, 0x67,
+	0xe0, 0xe0, 0xeb, 0x0d, 0xda, 0x09, 0x0f, 0xf2, 0x49, 0x98, 0x4e, 0xf3, 0x33, 0x89, 0xe0, 0xfc,
+	0xdd, 0xcc, 0x5a, 0x20, 0x7f, 0x37, 0x23, 0xfd, 0x34, 0x88, 0x42, 0x30, 0x3a, 0x6b, 0x1a, 0x1c,
+	0x26, 0x2a, 0x88, 0xa8, 0xd4, 0x77, 0x79, 0x81, 0xf6, 0xbf, 0xac, 0xc1, 0xa9, 0x5d, 0xad, 0x42,
+	0x61, 0xcc, 0x6d, 0xf4, 0xf7, 0x80, 0xd2, 0x13, 0x83, 0xa6, 0x89, 0x3f, 0xb5, 0x76, 0x6f, 0x70,
+	0x82, 0xd1, 0xe2, 0xf6, 0xed, 0x4d, 0xab, 0xc7, 0x86, 0xe6, 0x6b, 0x70, 0xfb, 0x1a, 0xc7, 0xd5,
+	0x63, 0x33, 0x63, 0xd3, 0xc0, 0x46, 0x85, 0xbd, 0x87, 0xa3, 0x2f, 0xc3, 0x6a, 0x1a, 0xe8, 0x2c,
+	0xc6, 0xcf, 0xdb, 0x2f, 0x34, 0x49, 0x64, 0xa5, 0xa4, 0xd2, 0x57, 0x2e, 0x41, 0x57, 0x8b, 0x00,
+	0xb3, 0x00, 0x7d, 0xa6, 0x45, 0x32, 0x60, 0x49, 0xf4, 0x9d, 0x17, 0xa1, 0x37, 0x11, 0x13, 0xa5,
+	0x0f, 0x7d, 0x1b, 0xab, 0x6d, 0x92, 0xe8, 0x5a, 0x1a, 0xed, 0xa0, 0xff, 0x8f, 0x1a, 0x74, 0xf3,
+	0x2d, 0x91, 0xd2, 0xac, 0x82, 0x6a, 0xa5, 0x82, 0xae, 0x42, 0x23, 0x89, 0x27, 0x79, 0xa3, 0xf1,
+	0xb9, 0xb9, 0x24, 0x3f, 0xaf, 0x06, 0x8e, 0x72, 0x58, 0x61, 0x4c, 0x65, 0x7c, 0xe0, 0xa3, 0x6d,
+	0xf2, 0x7d, 0x39, 0x48, 0x40, 0x07, 0xa0, 0x77, 0x45, 0x19, 0xa4, 0x66, 0xac, 0xb2, 0xdc, 0x9f,
+	0x4b, 0x9c, 0xbd, 0x09, 0x3d, 0x23, 0x8c, 0xc1, 0x0d, 0xe3, 0x9b, 0x68, 0x7e, 0x92, 0x9f, 0xa9,
+	0x1e, 0x88, 0xc4, 0xa5, 0x08, 0xec, 0x9a, 0x19, 0xc2, 0x5e, 0x03, 0x16, 0xe4, 0xf1, 0xeb, 0x4b,
+	0x15, 0xe5, 0xfe, 0xd3, 0xa6, 0xca, 0x7d, 0xbd, 0xe0, 0xa0, 0x53, 0x90, 0x83, 0x7c, 0x55, 0x83,
+	0x6e, 0xe5, 0x53, 0xf4, 0x70, 0x6a, 0x84, 0x2e, 0xaa, 0x3d, 0x84, 0x91, 0x36, 0x56, 0xf9, 0xb3,
+	0x98, 0xcb, 0x09, 0x46, 0x9a, 0x56, 0x89, 0x28, 0x1c, 0x05, 0x61, 0x8c, 0xb2, 0xbc, 0x08, 0xa1,
+	0x65, 0x47, 0x79, 0xe9, 0xdb, 0x9b, 0x11, 0x07, 0xf4, 0xc8, 0x83, 0xef, 0xbb, 0xfb, 0x81, 0x29,
+	0x6a, 0xf2, 0x12, 0x47, 0x4f, 0x7b, 0x24, 0x34, 0xae, 0x25, 0x0f, 0xd0, 0x02, 0x45, 0x3d, 0x52,
+	0x60, 0x7c, 0xaa, 0xa4, 0xa0, 0x00, 0xed, 0x71, 0x07, 0x09, 0x1f, 0x2b, 0x49, 0xc3, 0x82, 0x30,
+	0x54, 0x53, 0x99, 0x51, 0x5c, 0xba, 0xbc, 0x40, 0xfb, 0x5f, 0x35, 0xc1, 0xd9, 0xcd, 0x35, 0xc6,
+	0x6e, 0xc2, 0x4a, 0xf9, 0x3a, 0x8b, 0x95, 0x36, 0xed, 0x71, 0xb5, 0x5a, 0x53, 0xee, 0x2e, 0x02,
+	0x54, 0x96, 0xf7, 0xd2, 0x0a, 0xb6, 0xf8, 0xc6, 0x5b, 0x5f, 0x7a, 0xe3, 0x7d, 0x1e, 0x1a, 0x0f,
+	0xf5, 0xe1, 0xfc, 0x7b, 0xe1, 0x6e, 0x12, 0x48, 0x8e, 0x64, 0xf6, 0x3a, 0x74, 0x71, 0xbb, 0xbe,
+	0xa1, 0x54, 0xe9, 0x35, 0x17, 0xcf, 0x53, 0x9b, 0x42, 0x39, 0xa0, 0x90, 0x85, 0xb1, 0xa0, 0x0b,
+	0xc7, 0x71, 0x12, 0x69, 0x21, 0xf3, 0x4b, 0x03, 0x5b, 0x5e, 0x32, 0x2f, 0x65, 0xd8, 0x0f, 0x61,
+	0x3d, 0x9e, 0x15, 0xa2, 0x33, 0xf3, 0xcf, 0xb9, 0x4f, 0xa5, 0x54, 0xe5, 0x6b, 0x15, 0x71, 0xca,
+	0xb2, 0x67, 0xf0, 0x10, 0xf2, 0x85, 0xb4, 0x2f, 0xea, 0x0e, 0x6f, 0xc5, 0xe6, 0x96, 0x8c, 0xe8,
+	0xf9, 0xc8, 0xcc, 0x0a, 0x3a, 0x3a, 0x9c, 0xe8, 0xa0, 0x79, 0x19, 0x9a, 0xe8, 0x69, 0xcb, 0x55,
+	0x5b, 0x91, 0x7b, 0x38, 0xf1, 0xe9, 0x95, 0x7f, 0x6a, 0xc6, 0xbe, 0x4d, 0xd4, 0xe8, 0xd6, 0x40,
+	0xea, 0xa3, 0x3c, 0x7c, 0x53, 0x3d, 0xb6, 0x2e, 0x78, 0x19, 0x56, 0x8b, 0xbd, 0xf8, 0xd6, 0xaa,
+	0x5d, 0x92, 0x5a, 0x29, 0xa8, 0x3b, 0x48, 0x64, 0xef, 0xc2, 0x3a, 0x3e, 0xeb, 0x1b, 0x3f, 0x53,
+	0xbe, 0x16, 0x23, 0x7a, 0xf3, 0xe8, 0x6d, 0x34, 0xe6, 0x8b, 0x9a, 0x0f, 0xa7, 0x71, 0x74, 0x5f,
+	0x71, 0x31, 0x1a, 0x44, 0x07, 0x7c, 0x85, 0xe4, 0x0b, 0xb4, 0xff, 0x2e, 0xf4, 0xaa, 0x76, 0x66,
+	0x2e, 0xb4, 0xee, 0x08, 0x3d, 0x12, 0xeb, 0xcf, 0x30, 0x80, 0xf6, 0x5d, 0xa5, 0x27, 0x41, 0xb2,
+	0x5e, 0x43, 0xd8, 0xbe, 0x41, 0xae, 0xd7, 0x59, 0x0f, 0x9c, 0xdd, 0x40, 0x07, 0x49, 0x22, 0x92,
+	0xf5, 0x46, 0xff, 0x2d, 0x70, 0x8a, 0xe7, 0x71, 0xba, 0x27, 0x62, 0xb0, 0x51, 0xf6, 0xb4, 0xc1,
+	0xe3, 0x20, 0x81, 0x4e, 0x96, 0xe2, 0x6f, 0x84, 0xfa, 0xec, 0x6f, 0x84, 0xfe, 0x07, 0xd0, 0xab,
+	0x2e, 0xae, 0xb8, 0x1f, 0xd4, 0x66, 0xf7, 0x83, 0x23, 0x46, 0xd1, 0x8d, 0x45, 0xab, 0x89, 0x5f,
+	0x49, 0xd2, 0x0e, 0x12, 0x70, 0x9a, 0x1b, 0x3b, 0x7f, 0xfa, 0xfa, 0x62, 0xed, 0xcb, 0xaf, 0x2f,
+	0xd6, 0xfe, 0xfa, 0xf5, 0xc5, 0x67, 0xbe, 0xf8, 0xdb, 0xc5, 0xda, 0xc7, 0xaf, 0x57, 0x7e, 0xfc,
+	0x98, 0x04, 0x99, 0x8e, 0x0f, 0xec, 0x8d, 0xa5, 0x40, 0xa4, 0xb8, 0x96, 0x3e, 0x18, 0x5d, 0x4b,
+	0xf7, 0xaf, 0x15, 0x1a, 0xdb, 0x6f, 0xd3, 0x6f, 0x1e, 0xff, 0xf3, 0xaf, 0x01, 0x00, 0x49, 0x49,
+	0xe6, 0xed, 0x4e, 0x22, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -3552,6 +3616,25 @@ func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.GroupingSets) > 0 {
+		dAtA3 := make([]byte, len(m.GroupingSets)*10)
+		var j2 int
+		for _, num1 := range m.GroupingSets {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintPipeline(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.MultiAggs) > 0 {
 		for iNdEx := len(m.MultiAggs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3656,21 +3739,21 @@ func (m *Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA3 := make([]byte, len(m.IdxIdx)*10)
-		var j2 int
+		dAtA5 := make([]byte, len(m.IdxIdx)*10)
+		var j4 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j4++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintPipeline(dAtA, i, uint64(j2))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintPipeline(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x4a
 	}
@@ -3754,21 +3837,21 @@ func (m *Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA9 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j8 int
+		dAtA11 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j10 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j10++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintPipeline(dAtA, i, uint64(j8))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintPipeline(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3928,21 +4011,21 @@ func (m *OnDuplicateKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA15 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j14 int
+		dAtA17 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j16 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j16++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintPipeline(dAtA, i, uint64(j14))
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintPipeline(dAtA, i, uint64(j16))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3978,6 +4061,20 @@ func (m *Join) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.RightCond) > 0 {
 		for iNdEx := len(m.RightCond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4033,40 +4130,40 @@ func (m *Join) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA18 := make([]byte, len(m.ColList)*10)
-		var j17 int
+		dAtA20 := make([]byte, len(m.ColList)*10)
+		var j19 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j17++
+				j19++
 			}
-			dAtA18[j17] = uint8(num)
-			j17++
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
-		i -= j17
-		copy(dAtA[i:], dAtA18[:j17])
-		i = encodeVarintPipeline(dAtA, i, uint64(j17))
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintPipeline(dAtA, i, uint64(j19))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA20 := make([]byte, len(m.RelList)*10)
-		var j19 int
+		dAtA22 := make([]byte, len(m.RelList)*10)
+		var j21 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j21++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA22[j21] = uint8(num)
+			j21++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintPipeline(dAtA, i, uint64(j19))
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintPipeline(dAtA, i, uint64(j21))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4162,21 +4259,21 @@ func (m *AntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA23 := make([]byte, len(m.Result)*10)
-		var j22 int
+		dAtA25 := make([]byte, len(m.Result)*10)
+		var j24 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j22++
+				j24++
 			}
-			dAtA23[j22] = uint8(num)
-			j22++
+			dAtA25[j24] = uint8(num)
+			j24++
 		}
-		i -= j22
-		copy(dAtA[i:], dAtA23[:j22])
-		i = encodeVarintPipeline(dAtA, i, uint64(j22))
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintPipeline(dAtA, i, uint64(j24))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4272,40 +4369,40 @@ func (m *InnerJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA26 := make([]byte, len(m.ColList)*10)
-		var j25 int
+		dAtA28 := make([]byte, len(m.ColList)*10)
+		var j27 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j25++
+				j27++
 			}
-			dAtA26[j25] = uint8(num)
-			j25++
+			dAtA28[j27] = uint8(num)
+			j27++
 		}
-		i -= j25
-		copy(dAtA[i:], dAtA26[:j25])
-		i = encodeVarintPipeline(dAtA, i, uint64(j25))
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintPipeline(dAtA, i, uint64(j27))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA28 := make([]byte, len(m.RelList)*10)
-		var j27 int
+		dAtA30 := make([]byte, len(m.RelList)*10)
+		var j29 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j27++
+				j29++
 			}
-			dAtA28[j27] = uint8(num)
-			j27++
+			dAtA30[j29] = uint8(num)
+			j29++
 		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintPipeline(dAtA, i, uint64(j27))
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintPipeline(dAtA, i, uint64(j29))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4401,40 +4498,40 @@ func (m *LeftJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA31 := make([]byte, len(m.ColList)*10)
-		var j30 int
+		dAtA33 := make([]byte, len(m.ColList)*10)
+		var j32 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j30++
+				j32++
 			}
-			dAtA31[j30] = uint8(num)
-			j30++
+			dAtA33[j32] = uint8(num)
+			j32++
 		}
-		i -= j30
-		copy(dAtA[i:], dAtA31[:j30])
-		i = encodeVarintPipeline(dAtA, i, uint64(j30))
+		i -= j32
+		copy(dAtA[i:], dAtA33[:j32])
+		i = encodeVarintPipeline(dAtA, i, uint64(j32))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA33 := make([]byte, len(m.RelList)*10)
-		var j32 int
+		dAtA35 := make([]byte, len(m.RelList)*10)
+		var j34 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j32++
+				j34++
 			}
-			dAtA33[j32] = uint8(num)
-			j32++
+			dAtA35[j34] = uint8(num)
+			j34++
 		}
-		i -= j32
-		copy(dAtA[i:], dAtA33[:j32])
-		i = encodeVarintPipeline(dAtA, i, uint64(j32))
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintPipeline(dAtA, i, uint64(j34))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4544,40 +4641,40 @@ func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA36 := make([]byte, len(m.ColList)*10)
-		var j35 int
+		dAtA38 := make([]byte, len(m.ColList)*10)
+		var j37 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j35++
+				j37++
 			}
-			dAtA36[j35] = uint8(num)
-			j35++
+			dAtA38[j37] = uint8(num)
+			j37++
 		}
-		i -= j35
-		copy(dAtA[i:], dAtA36[:j35])
-		i = encodeVarintPipeline(dAtA, i, uint64(j35))
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintPipeline(dAtA, i, uint64(j37))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA38 := make([]byte, len(m.RelList)*10)
-		var j37 int
+		dAtA40 := make([]byte, len(m.RelList)*10)
+		var j39 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j37++
+				j39++
 			}
-			dAtA38[j37] = uint8(num)
-			j37++
+			dAtA40[j39] = uint8(num)
+			j39++
 		}
-		i -= j37
-		copy(dAtA[i:], dAtA38[:j37])
-		i = encodeVarintPipeline(dAtA, i, uint64(j37))
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintPipeline(dAtA, i, uint64(j39))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4618,6 +4715,20 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.RightCond) > 0 {
 		for iNdEx := len(m.RightCond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4673,21 +4784,21 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA41 := make([]byte, len(m.Result)*10)
-		var j40 int
+		dAtA43 := make([]byte, len(m.Result)*10)
+		var j42 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j40++
+				j42++
 			}
-			dAtA41[j40] = uint8(num)
-			j40++
+			dAtA43[j42] = uint8(num)
+			j42++
 		}
-		i -= j40
-		copy(dAtA[i:], dAtA41[:j40])
-		i = encodeVarintPipeline(dAtA, i, uint64(j40))
+		i -= j42
+		copy(dAtA[i:], dAtA43[:j42])
+		i = encodeVarintPipeline(dAtA, i, uint64(j42))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4783,40 +4894,40 @@ func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA44 := make([]byte, len(m.ColList)*10)
-		var j43 int
+		dAtA46 := make([]byte, len(m.ColList)*10)
+		var j45 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j43++
+				j45++
 			}
-			dAtA44[j43] = uint8(num)
-			j43++
+			dAtA46[j45] = uint8(num)
+			j45++
 		}
-		i -= j43
-		copy(dAtA[i:], dAtA44[:j43])
-		i = encodeVarintPipeline(dAtA, i, uint64(j43))
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintPipeline(dAtA, i, uint64(j45))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA46 := make([]byte, len(m.RelList)*10)
-		var j45 int
+		dAtA48 := make([]byte, len(m.RelList)*10)
+		var j47 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j45++
+				j47++
 			}
-			dAtA46[j45] = uint8(num)
-			j45++
+			dAtA48[j47] = uint8(num)
+			j47++
 		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintPipeline(dAtA, i, uint64(j45))
+		i -= j47
+		copy(dAtA[i:], dAtA48[:j47])
+		i = encodeVarintPipeline(dAtA, i, uint64(j47))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4926,21 +5037,21 @@ func (m *MarkJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA49 := make([]byte, len(m.Result)*10)
-		var j48 int
+		dAtA51 := make([]byte, len(m.Result)*10)
+		var j50 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j48++
+				j50++
 			}
-			dAtA49[j48] = uint8(num)
-			j48++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
-		i -= j48
-		copy(dAtA[i:], dAtA49[:j48])
-		i = encodeVarintPipeline(dAtA, i, uint64(j48))
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintPipeline(dAtA, i, uint64(j50))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4996,40 +5107,40 @@ func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ColList) > 0 {
-		dAtA51 := make([]byte, len(m.ColList)*10)
-		var j50 int
+		dAtA53 := make([]byte, len(m.ColList)*10)
+		var j52 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA53[j52] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j50++
+				j52++
 			}
-			dAtA51[j50] = uint8(num)
-			j50++
+			dAtA53[j52] = uint8(num)
+			j52++
 		}
-		i -= j50
-		copy(dAtA[i:], dAtA51[:j50])
-		i = encodeVarintPipeline(dAtA, i, uint64(j50))
+		i -= j52
+		copy(dAtA[i:], dAtA53[:j52])
+		i = encodeVarintPipeline(dAtA, i, uint64(j52))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA53 := make([]byte, len(m.RelList)*10)
-		var j52 int
+		dAtA55 := make([]byte, len(m.RelList)*10)
+		var j54 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA53[j52] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA55[j54] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j52++
+				j54++
 			}
-			dAtA53[j52] = uint8(num)
-			j52++
+			dAtA55[j54] = uint8(num)
+			j54++
 		}
-		i -= j52
-		copy(dAtA[i:], dAtA53[:j52])
-		i = encodeVarintPipeline(dAtA, i, uint64(j52))
+		i -= j54
+		copy(dAtA[i:], dAtA55[:j54])
+		i = encodeVarintPipeline(dAtA, i, uint64(j54))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -5138,6 +5249,20 @@ func (m *HashBuild) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Conds) > 0 {
 		for iNdEx := len(m.Conds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5356,6 +5481,22 @@ func (m *Instruction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.WinSpecList) > 0 {
+		for iNdEx := len(m.WinSpecList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WinSpecList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
 	if m.RightJoin != nil {
 		{
 			size, err := m.RightJoin.MarshalToSizedBuffer(dAtA[:i])
@@ -5744,6 +5885,20 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for iNdEx := len(m.RuntimeFilterProbeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterProbeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.Timestamp != nil {
 		{
 			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -5904,6 +6059,11 @@ func (m *ProcessLimitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MemoryLimit != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.MemoryLimit))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ReaderSize != 0 {
 		i = encodeVarintPipeline(dAtA, i, uint64(m.ReaderSize))
 		i--
@@ -5957,21 +6117,21 @@ func (m *ProcessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.AnalysisNodeList) > 0 {
-		dAtA77 := make([]byte, len(m.AnalysisNodeList)*10)
-		var j76 int
+		dAtA79 := make([]byte, len(m.AnalysisNodeList)*10)
+		var j78 int
 		for _, num1 := range m.AnalysisNodeList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j76++
+				j78++
 			}
-			dAtA77[j76] = uint8(num)
-			j76++
+			dAtA79[j78] = uint8(num)
+			j78++
 		}
-		i -= j76
-		copy(dAtA[i:], dAtA77[:j76])
-		i = encodeVarintPipeline(dAtA, i, uint64(j76))
+		i -= j78
+		copy(dAtA[i:], dAtA79[:j78])
+		i = encodeVarintPipeline(dAtA, i, uint64(j78))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -6535,6 +6695,13 @@ func (m *Group) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if len(m.GroupingSets) > 0 {
+		l = 0
+		for _, e := range m.GroupingSets {
+			l += sovPipeline(uint64(e))
+		}
+		n += 1 + sovPipeline(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6719,8 +6886,14 @@ func (m *Join) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
-	if len(m.RightCond) > 0 {
-		for _, e := range m.RightCond {
+	if len(m.RightCond) > 0 {
+		for _, e := range m.RightCond {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
 			l = e.ProtoSize()
 			n += 1 + l + sovPipeline(uint64(l))
 		}
@@ -6987,6 +7160,12 @@ func (m *SemiJoin) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7200,6 +7379,12 @@ func (m *HashBuild) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7383,6 +7568,12 @@ func (m *Instruction) ProtoSize() (n int) {
 		l = m.RightJoin.ProtoSize()
 		n += 2 + l + sovPipeline(uint64(l))
 	}
+	if len(m.WinSpecList) > 0 {
+		for _, e := range m.WinSpecList {
+			l = e.ProtoSize()
+			n += 2 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7450,6 +7641,12 @@ func (m *Source) ProtoSize() (n int) {
 		l = m.Timestamp.ProtoSize()
 		n += 1 + l + sovPipeline(uint64(l))
 	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for _, e := range m.RuntimeFilterProbeList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7506,6 +7703,9 @@ func (m *ProcessLimitation) ProtoSize() (n int) {
 	if m.ReaderSize != 0 {
 		n += 1 + sovPipeline(uint64(m.ReaderSize))
 	}
+	if m.MemoryLimit != 0 {
+		n += 1 + sovPipeline(uint64(m.MemoryLimit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8803,6 +9003,82 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GroupingSets = append(m.GroupingSets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GroupingSets) == 0 {
+					m.GroupingSets = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GroupingSets = append(m.GroupingSets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupingSets", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -10353,6 +10629,40 @@ func (m *Join) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -12130,6 +12440,40 @@ func (m *SemiJoin) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -13497,6 +13841,40 @@ func (m *HashBuild) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -14767,6 +15145,40 @@ func (m *Instruction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinSpecList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinSpecList = append(m.WinSpecList, &plan.WindowSpec{})
+			if err := m.WinSpecList[len(m.WinSpecList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -15190,6 +15602,40 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterProbeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterProbeList = append(m.RuntimeFilterProbeList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterProbeList[len(m.RuntimeFilterProbeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -15502,6 +15948,25 @@ func (m *ProcessLimitation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+			}
+			m.MemoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
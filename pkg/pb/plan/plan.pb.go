@@ -12,6 +12,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -25,21 +26,25 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CompressType is the same as compress.T
 type CompressType int32
 
 const (
 	CompressType_None CompressType = 0
 	CompressType_Lz4  CompressType = 1
+	CompressType_Zstd CompressType = 2
 )
 
 var CompressType_name = map[int32]string{
 	0: "None",
 	1: "Lz4",
+	2: "Zstd",
 }
 
 var CompressType_value = map[string]int32{
 	"None": 0,
 	"Lz4":  1,
+	"Zstd": 2,
 }
 
 func (x CompressType) String() string {
@@ -286,7 +291,7 @@ func (x ForeignKeyDef_RefAction) String() string {
 }
 
 func (ForeignKeyDef_RefAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{23, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{24, 0}
 }
 
 type OrderBySpec_OrderByFlag int32
@@ -323,7 +328,60 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
+}
+
+type FrameBound_BoundType int32
+
+const (
+	FrameBound_PRECEDING   FrameBound_BoundType = 0
+	FrameBound_CURRENT_ROW FrameBound_BoundType = 1
+	FrameBound_FOLLOWING   FrameBound_BoundType = 2
+)
+
+var FrameBound_BoundType_name = map[int32]string{
+	0: "PRECEDING",
+	1: "CURRENT_ROW",
+	2: "FOLLOWING",
+}
+
+var FrameBound_BoundType_value = map[string]int32{
+	"PRECEDING":   0,
+	"CURRENT_ROW": 1,
+	"FOLLOWING":   2,
+}
+
+func (x FrameBound_BoundType) String() string {
+	return proto.EnumName(FrameBound_BoundType_name, int32(x))
+}
+
+func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{41, 0}
+}
+
+type FrameClause_FrameType int32
+
+const (
+	FrameClause_ROWS  FrameClause_FrameType = 0
+	FrameClause_RANGE FrameClause_FrameType = 1
+)
+
+var FrameClause_FrameType_name = map[int32]string{
+	0: "ROWS",
+	1: "RANGE",
+}
+
+var FrameClause_FrameType_value = map[string]int32{
+	"ROWS":  0,
+	"RANGE": 1,
+}
+
+func (x FrameClause_FrameType) String() string {
+	return proto.EnumName(FrameClause_FrameType_name, int32(x))
+}
+
+func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{42, 0}
 }
 
 type Node_NodeType int32
@@ -450,7 +508,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 0}
 }
 
 type Node_JoinFlag int32
@@ -496,7 +554,7 @@ func (x Node_JoinFlag) String() string {
 }
 
 func (Node_JoinFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 1}
 }
 
 type Node_AggMode int32
@@ -524,7 +582,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 2}
 }
 
 type Query_StatementType int32
@@ -561,7 +619,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{54, 0}
 }
 
 type TransationControl_TclType int32
@@ -589,7 +647,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{55, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -617,7 +675,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
 }
 
 type DataControl_DclType int32
@@ -657,7 +715,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{61, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -775,7 +833,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{62, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -809,16 +867,18 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{68, 0}
 }
 
 type Type struct {
-	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NotNullable          bool     `protobuf:"varint,2,opt,name=notNullable,proto3" json:"notNullable,omitempty"`
-	AutoIncr             bool     `protobuf:"varint,3,opt,name=auto_incr,json=autoIncr,proto3" json:"auto_incr,omitempty"`
-	Width                int32    `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
-	Scale                int32    `protobuf:"varint,5,opt,name=scale,proto3" json:"scale,omitempty"`
-	Table                string   `protobuf:"bytes,6,opt,name=table,proto3" json:"table,omitempty"`
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NotNullable bool   `protobuf:"varint,2,opt,name=notNullable,proto3" json:"notNullable,omitempty"`
+	AutoIncr    bool   `protobuf:"varint,3,opt,name=auto_incr,json=autoIncr,proto3" json:"auto_incr,omitempty"`
+	Width       int32  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Scale       int32  `protobuf:"varint,5,opt,name=scale,proto3" json:"scale,omitempty"`
+	Table       string `protobuf:"bytes,6,opt,name=table,proto3" json:"table,omitempty"`
+	// enumvalues is the members of ENUM and SET, encoded as a list of quoted strings
+	Enumvalues           string   `protobuf:"bytes,7,opt,name=enumvalues,proto3" json:"enumvalues,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -899,6 +959,13 @@ func (m *Type) GetTable() string {
 	return ""
 }
 
+func (m *Type) GetEnumvalues() string {
+	if m != nil {
+		return m.Enumvalues
+	}
+	return ""
+}
+
 // Const: if a const value can be reprensented by int64 or
 // double, use that, otherwise store a string representation.
 type Const struct {
@@ -2314,9 +2381,12 @@ type ColDef struct {
 	OnUpdate *OnUpdate    `protobuf:"bytes,9,opt,name=on_update,json=onUpdate,proto3" json:"on_update,omitempty"`
 	LowCard  bool         `protobuf:"varint,10,opt,name=low_card,json=lowCard,proto3" json:"low_card,omitempty"`
 	// XXX: Deprecated and to be removed soon.
-	ClusterBy            bool     `protobuf:"varint,11,opt,name=clusterBy,proto3" json:"clusterBy,omitempty"`
-	Primary              bool     `protobuf:"varint,12,opt,name=primary,proto3" json:"primary,omitempty"`
-	Pkidx                int32    `protobuf:"varint,13,opt,name=pkidx,proto3" json:"pkidx,omitempty"`
+	ClusterBy bool          `protobuf:"varint,11,opt,name=clusterBy,proto3" json:"clusterBy,omitempty"`
+	Primary   bool          `protobuf:"varint,12,opt,name=primary,proto3" json:"primary,omitempty"`
+	Pkidx     int32         `protobuf:"varint,13,opt,name=pkidx,proto3" json:"pkidx,omitempty"`
+	Generated *GeneratedCol `protobuf:"bytes,14,opt,name=generated,proto3" json:"generated,omitempty"`
+	// compress_level is the level of alg, 0 means the default level
+	CompressLevel        int32    `protobuf:"varint,15,opt,name=compress_level,json=compressLevel,proto3" json:"compress_level,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2446,6 +2516,20 @@ func (m *ColDef) GetPkidx() int32 {
 	return 0
 }
 
+func (m *ColDef) GetGenerated() *GeneratedCol {
+	if m != nil {
+		return m.Generated
+	}
+	return nil
+}
+
+func (m *ColDef) GetCompressLevel() int32 {
+	if m != nil {
+		return m.CompressLevel
+	}
+	return 0
+}
+
 type Default struct {
 	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
@@ -2565,6 +2649,69 @@ func (m *OnUpdate) GetOriginString() string {
 	return ""
 }
 
+type GeneratedCol struct {
+	Expr                 *Expr    `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	OriginString         string   `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
+	Stored               bool     `protobuf:"varint,3,opt,name=stored,proto3" json:"stored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeneratedCol) Reset()         { *m = GeneratedCol{} }
+func (m *GeneratedCol) String() string { return proto.CompactTextString(m) }
+func (*GeneratedCol) ProtoMessage()    {}
+func (*GeneratedCol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{20}
+}
+func (m *GeneratedCol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratedCol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeneratedCol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeneratedCol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratedCol.Merge(m, src)
+}
+func (m *GeneratedCol) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GeneratedCol) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratedCol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratedCol proto.InternalMessageInfo
+
+func (m *GeneratedCol) GetExpr() *Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *GeneratedCol) GetOriginString() string {
+	if m != nil {
+		return m.OriginString
+	}
+	return ""
+}
+
+func (m *GeneratedCol) GetStored() bool {
+	if m != nil {
+		return m.Stored
+	}
+	return false
+}
+
 type IndexOption struct {
 	CreateExtraTable     bool     `protobuf:"varint,1,opt,name=create_extra_table,json=createExtraTable,proto3" json:"create_extra_table,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2576,7 +2723,7 @@ func (m *IndexOption) Reset()         { *m = IndexOption{} }
 func (m *IndexOption) String() string { return proto.CompactTextString(m) }
 func (*IndexOption) ProtoMessage()    {}
 func (*IndexOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{20}
+	return fileDescriptor_2d655ab2f7683c23, []int{21}
 }
 func (m *IndexOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2632,7 +2779,7 @@ func (m *PrimaryKeyDef) Reset()         { *m = PrimaryKeyDef{} }
 func (m *PrimaryKeyDef) String() string { return proto.CompactTextString(m) }
 func (*PrimaryKeyDef) ProtoMessage()    {}
 func (*PrimaryKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{21}
+	return fileDescriptor_2d655ab2f7683c23, []int{22}
 }
 func (m *PrimaryKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2707,17 +2854,31 @@ type IndexDef struct {
 	TableExist     bool     `protobuf:"varint,6,opt,name=table_exist,json=tableExist,proto3" json:"table_exist,omitempty"`
 	Comment        string   `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
 	// currently not used
-	Option               *IndexOption `protobuf:"bytes,8,opt,name=option,proto3" json:"option,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Option *IndexOption `protobuf:"bytes,8,opt,name=option,proto3" json:"option,omitempty"`
+	// fulltext index, the index table stores the words of the parts
+	Fulltext bool `protobuf:"varint,9,opt,name=fulltext,proto3" json:"fulltext,omitempty"`
+	// the tokenizer which splits the parts into words
+	Parser string `protobuf:"bytes,10,opt,name=parser,proto3" json:"parser,omitempty"`
+	// functional index on a json path of the part, the index table stores the
+	// values at the path cast to key_type
+	JsonPath string `protobuf:"bytes,11,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`
+	KeyType  *Type  `protobuf:"bytes,12,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	// multi-valued index, every element of the json array at the path is stored
+	MultiValued bool `protobuf:"varint,13,opt,name=multi_valued,json=multiValued,proto3" json:"multi_valued,omitempty"`
+	// the expression of the functional index
+	OriginString string `protobuf:"bytes,14,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
+	// the value at the json path is unquoted before it is cast, CAST(doc->>'path' AS type)
+	JsonUnquote          bool     `protobuf:"varint,15,opt,name=json_unquote,json=jsonUnquote,proto3" json:"json_unquote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IndexDef) Reset()         { *m = IndexDef{} }
 func (m *IndexDef) String() string { return proto.CompactTextString(m) }
 func (*IndexDef) ProtoMessage()    {}
 func (*IndexDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{22}
+	return fileDescriptor_2d655ab2f7683c23, []int{23}
 }
 func (m *IndexDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2802,6 +2963,55 @@ func (m *IndexDef) GetOption() *IndexOption {
 	return nil
 }
 
+func (m *IndexDef) GetFulltext() bool {
+	if m != nil {
+		return m.Fulltext
+	}
+	return false
+}
+
+func (m *IndexDef) GetParser() string {
+	if m != nil {
+		return m.Parser
+	}
+	return ""
+}
+
+func (m *IndexDef) GetJsonPath() string {
+	if m != nil {
+		return m.JsonPath
+	}
+	return ""
+}
+
+func (m *IndexDef) GetKeyType() *Type {
+	if m != nil {
+		return m.KeyType
+	}
+	return nil
+}
+
+func (m *IndexDef) GetMultiValued() bool {
+	if m != nil {
+		return m.MultiValued
+	}
+	return false
+}
+
+func (m *IndexDef) GetOriginString() string {
+	if m != nil {
+		return m.OriginString
+	}
+	return ""
+}
+
+func (m *IndexDef) GetJsonUnquote() bool {
+	if m != nil {
+		return m.JsonUnquote
+	}
+	return false
+}
+
 type ForeignKeyDef struct {
 	Name                 string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Cols                 []uint64                `protobuf:"varint,2,rep,packed,name=cols,proto3" json:"cols,omitempty"`
@@ -2818,7 +3028,7 @@ func (m *ForeignKeyDef) Reset()         { *m = ForeignKeyDef{} }
 func (m *ForeignKeyDef) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyDef) ProtoMessage()    {}
 func (*ForeignKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{23}
+	return fileDescriptor_2d655ab2f7683c23, []int{24}
 }
 func (m *ForeignKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2892,7 +3102,10 @@ func (m *ForeignKeyDef) GetOnUpdate() ForeignKeyDef_RefAction {
 type CheckDef struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Name for anonymous constraints, __mo_chk_[INDEX_ID]
-	Check                *Expr    `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	Check *Expr `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	// The original expression, it is used by show create table
+	OriginString         string   `protobuf:"bytes,3,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
+	Enforced             bool     `protobuf:"varint,4,opt,name=enforced,proto3" json:"enforced,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2902,7 +3115,7 @@ func (m *CheckDef) Reset()         { *m = CheckDef{} }
 func (m *CheckDef) String() string { return proto.CompactTextString(m) }
 func (*CheckDef) ProtoMessage()    {}
 func (*CheckDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24}
+	return fileDescriptor_2d655ab2f7683c23, []int{25}
 }
 func (m *CheckDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2945,6 +3158,20 @@ func (m *CheckDef) GetCheck() *Expr {
 	return nil
 }
 
+func (m *CheckDef) GetOriginString() string {
+	if m != nil {
+		return m.OriginString
+	}
+	return ""
+}
+
+func (m *CheckDef) GetEnforced() bool {
+	if m != nil {
+		return m.Enforced
+	}
+	return false
+}
+
 type ClusterByDef struct {
 	Parts []*Expr `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
 	// XXX: Deprecated and to be removed soon.
@@ -2958,7 +3185,7 @@ func (m *ClusterByDef) Reset()         { *m = ClusterByDef{} }
 func (m *ClusterByDef) String() string { return proto.CompactTextString(m) }
 func (*ClusterByDef) ProtoMessage()    {}
 func (*ClusterByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{25}
+	return fileDescriptor_2d655ab2f7683c23, []int{26}
 }
 func (m *ClusterByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3013,7 +3240,7 @@ func (m *PropertyDef) Reset()         { *m = PropertyDef{} }
 func (m *PropertyDef) String() string { return proto.CompactTextString(m) }
 func (*PropertyDef) ProtoMessage()    {}
 func (*PropertyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{26}
+	return fileDescriptor_2d655ab2f7683c23, []int{27}
 }
 func (m *PropertyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3295,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{27}
+	return fileDescriptor_2d655ab2f7683c23, []int{28}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3122,7 +3349,7 @@ func (m *PropertiesDef) Reset()         { *m = PropertiesDef{} }
 func (m *PropertiesDef) String() string { return proto.CompactTextString(m) }
 func (*PropertiesDef) ProtoMessage()    {}
 func (*PropertiesDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28}
+	return fileDescriptor_2d655ab2f7683c23, []int{29}
 }
 func (m *PropertiesDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3177,7 +3404,7 @@ func (m *PartitionByDef) Reset()         { *m = PartitionByDef{} }
 func (m *PartitionByDef) String() string { return proto.CompactTextString(m) }
 func (*PartitionByDef) ProtoMessage()    {}
 func (*PartitionByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29}
+	return fileDescriptor_2d655ab2f7683c23, []int{30}
 }
 func (m *PartitionByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3281,7 +3508,7 @@ func (m *PartitionExpr) Reset()         { *m = PartitionExpr{} }
 func (m *PartitionExpr) String() string { return proto.CompactTextString(m) }
 func (*PartitionExpr) ProtoMessage()    {}
 func (*PartitionExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{30}
+	return fileDescriptor_2d655ab2f7683c23, []int{31}
 }
 func (m *PartitionExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3336,7 +3563,7 @@ func (m *PartitionColumns) Reset()         { *m = PartitionColumns{} }
 func (m *PartitionColumns) String() string { return proto.CompactTextString(m) }
 func (*PartitionColumns) ProtoMessage()    {}
 func (*PartitionColumns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31}
+	return fileDescriptor_2d655ab2f7683c23, []int{32}
 }
 func (m *PartitionColumns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3395,7 +3622,7 @@ func (m *PartitionItem) Reset()         { *m = PartitionItem{} }
 func (m *PartitionItem) String() string { return proto.CompactTextString(m) }
 func (*PartitionItem) ProtoMessage()    {}
 func (*PartitionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32}
+	return fileDescriptor_2d655ab2f7683c23, []int{33}
 }
 func (m *PartitionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3477,7 +3704,7 @@ func (m *ViewDef) Reset()         { *m = ViewDef{} }
 func (m *ViewDef) String() string { return proto.CompactTextString(m) }
 func (*ViewDef) ProtoMessage()    {}
 func (*ViewDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33}
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
 }
 func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3546,7 +3773,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
 }
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3744,7 +3971,7 @@ func (m *TableDef_DefType) Reset()         { *m = TableDef_DefType{} }
 func (m *TableDef_DefType) String() string { return proto.CompactTextString(m) }
 func (*TableDef_DefType) ProtoMessage()    {}
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{35, 0}
 }
 func (m *TableDef_DefType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3818,7 +4045,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3890,7 +4117,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3979,7 +4206,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
 }
 func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4026,7 +4253,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4075,7 +4302,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4125,21 +4352,151 @@ func (m *OrderBySpec) GetFlag() OrderBySpec_OrderByFlag {
 	return OrderBySpec_INTERNAL
 }
 
+type FrameBound struct {
+	Type FrameBound_BoundType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameBound_BoundType" json:"type,omitempty"`
+	// unbounded is set for UNBOUNDED PRECEDING/FOLLOWING, val is empty then
+	Unbounded            bool     `protobuf:"varint,2,opt,name=unbounded,proto3" json:"unbounded,omitempty"`
+	Val                  *Expr    `protobuf:"bytes,3,opt,name=val,proto3" json:"val,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrameBound) Reset()         { *m = FrameBound{} }
+func (m *FrameBound) String() string { return proto.CompactTextString(m) }
+func (*FrameBound) ProtoMessage()    {}
+func (*FrameBound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
+}
+func (m *FrameBound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameBound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameBound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrameBound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameBound.Merge(m, src)
+}
+func (m *FrameBound) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameBound) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameBound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrameBound proto.InternalMessageInfo
+
+func (m *FrameBound) GetType() FrameBound_BoundType {
+	if m != nil {
+		return m.Type
+	}
+	return FrameBound_PRECEDING
+}
+
+func (m *FrameBound) GetUnbounded() bool {
+	if m != nil {
+		return m.Unbounded
+	}
+	return false
+}
+
+func (m *FrameBound) GetVal() *Expr {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+type FrameClause struct {
+	Type                 FrameClause_FrameType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameClause_FrameType" json:"type,omitempty"`
+	Start                *FrameBound           `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *FrameBound           `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FrameClause) Reset()         { *m = FrameClause{} }
+func (m *FrameClause) String() string { return proto.CompactTextString(m) }
+func (*FrameClause) ProtoMessage()    {}
+func (*FrameClause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
+}
+func (m *FrameClause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameClause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameClause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrameClause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameClause.Merge(m, src)
+}
+func (m *FrameClause) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameClause) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameClause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrameClause proto.InternalMessageInfo
+
+func (m *FrameClause) GetType() FrameClause_FrameType {
+	if m != nil {
+		return m.Type
+	}
+	return FrameClause_ROWS
+}
+
+func (m *FrameClause) GetStart() *FrameBound {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *FrameClause) GetEnd() *FrameBound {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
 type WindowSpec struct {
-	PartitionBy          []*Expr        `protobuf:"bytes,1,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
-	OrderBy              []*OrderBySpec `protobuf:"bytes,2,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Lead                 int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
-	Lag                  int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PartitionBy []*Expr        `protobuf:"bytes,1,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
+	OrderBy     []*OrderBySpec `protobuf:"bytes,2,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Lead        int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
+	Lag         int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	// window_func is the window or aggregate function evaluated over the window
+	WindowFunc           *Expr        `protobuf:"bytes,5,opt,name=window_func,json=windowFunc,proto3" json:"window_func,omitempty"`
+	Frame                *FrameClause `protobuf:"bytes,6,opt,name=frame,proto3" json:"frame,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4196,6 +4553,79 @@ func (m *WindowSpec) GetLag() int32 {
 	return 0
 }
 
+func (m *WindowSpec) GetWindowFunc() *Expr {
+	if m != nil {
+		return m.WindowFunc
+	}
+	return nil
+}
+
+func (m *WindowSpec) GetFrame() *FrameClause {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+// RecursiveCte describes how a RECURSIVE_CTE node iterates,
+// the node's first child is the non-recursive part and the second one is the recursive part.
+type RecursiveCte struct {
+	// max_depth is the maximum number of iterations, see @@cte_max_recursion_depth
+	MaxDepth int64 `protobuf:"varint,1,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// distinct is set for UNION, rows which were already produced are discarded
+	Distinct             bool     `protobuf:"varint,2,opt,name=distinct,proto3" json:"distinct,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecursiveCte) Reset()         { *m = RecursiveCte{} }
+func (m *RecursiveCte) String() string { return proto.CompactTextString(m) }
+func (*RecursiveCte) ProtoMessage()    {}
+func (*RecursiveCte) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
+}
+func (m *RecursiveCte) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecursiveCte) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecursiveCte.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecursiveCte) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecursiveCte.Merge(m, src)
+}
+func (m *RecursiveCte) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RecursiveCte) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecursiveCte.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecursiveCte proto.InternalMessageInfo
+
+func (m *RecursiveCte) GetMaxDepth() int64 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *RecursiveCte) GetDistinct() bool {
+	if m != nil {
+		return m.Distinct
+	}
+	return false
+}
+
 type InsertCtx struct {
 	Ref                  *ObjectRef       `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
 	TableDef             *TableDef        `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
@@ -4214,7 +4644,7 @@ func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4326,7 +4756,7 @@ func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
 func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
 func (*UpdateCtx) ProtoMessage()    {}
 func (*UpdateCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4498,7 +4928,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4653,20 +5083,34 @@ type Node struct {
 	TblFuncExprList []*Expr        `protobuf:"bytes,25,rep,name=tbl_func_expr_list,json=tblFuncExprList,proto3" json:"tbl_func_expr_list,omitempty"`
 	// The pipeline will determine the parallelism by traversing the plan
 	// when it is received. Then the build is built based on this information.
-	Parallelism          int32         `protobuf:"varint,26,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
-	ClusterTable         *ClusterTable `protobuf:"bytes,27,opt,name=cluster_table,json=clusterTable,proto3" json:"cluster_table,omitempty"`
-	NotCacheable         bool          `protobuf:"varint,28,opt,name=not_cacheable,json=notCacheable,proto3" json:"not_cacheable,omitempty"`
-	InsertCtx            *InsertCtx    `protobuf:"bytes,29,opt,name=insert_ctx,json=insertCtx,proto3" json:"insert_ctx,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Parallelism  int32         `protobuf:"varint,26,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	ClusterTable *ClusterTable `protobuf:"bytes,27,opt,name=cluster_table,json=clusterTable,proto3" json:"cluster_table,omitempty"`
+	NotCacheable bool          `protobuf:"varint,28,opt,name=not_cacheable,json=notCacheable,proto3" json:"not_cacheable,omitempty"`
+	InsertCtx    *InsertCtx    `protobuf:"bytes,29,opt,name=insert_ctx,json=insertCtx,proto3" json:"insert_ctx,omitempty"`
+	WinSpecList  []*WindowSpec `protobuf:"bytes,30,rep,name=win_spec_list,json=winSpecList,proto3" json:"win_spec_list,omitempty"`
+	RecursiveCte *RecursiveCte `protobuf:"bytes,31,opt,name=recursive_cte,json=recursiveCte,proto3" json:"recursive_cte,omitempty"`
+	// nested_loop_join is set by the hint NO_HASH_JOIN, the join doesn't build the hashmap
+	// even if it has equality conditions.
+	NestedLoopJoin bool `protobuf:"varint,32,opt,name=nested_loop_join,json=nestedLoopJoin,proto3" json:"nested_loop_join,omitempty"`
+	// the runtime filters applied to the table scanned
+	RuntimeFilterProbeList []*RuntimeFilterSpec `protobuf:"bytes,33,rep,name=runtime_filter_probe_list,json=runtimeFilterProbeList,proto3" json:"runtime_filter_probe_list,omitempty"`
+	// the runtime filters built from the hash build side of the join
+	RuntimeFilterBuildList []*RuntimeFilterSpec `protobuf:"bytes,34,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	// index_scan_info is set if the table scanned is the index table of a secondary index
+	IndexScanInfo *IndexScanInfo `protobuf:"bytes,35,opt,name=index_scan_info,json=indexScanInfo,proto3" json:"index_scan_info,omitempty"`
+	// snapshot_ts is set by AS OF, the table scanned is read at the snapshot
+	// instead of the snapshot of the txn
+	SnapshotTs           *timestamp.Timestamp `protobuf:"bytes,36,opt,name=snapshot_ts,json=snapshotTs,proto3" json:"snapshot_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4898,6 +5342,192 @@ func (m *Node) GetInsertCtx() *InsertCtx {
 	return nil
 }
 
+func (m *Node) GetWinSpecList() []*WindowSpec {
+	if m != nil {
+		return m.WinSpecList
+	}
+	return nil
+}
+
+func (m *Node) GetRecursiveCte() *RecursiveCte {
+	if m != nil {
+		return m.RecursiveCte
+	}
+	return nil
+}
+
+func (m *Node) GetNestedLoopJoin() bool {
+	if m != nil {
+		return m.NestedLoopJoin
+	}
+	return false
+}
+
+func (m *Node) GetRuntimeFilterProbeList() []*RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterProbeList
+	}
+	return nil
+}
+
+func (m *Node) GetRuntimeFilterBuildList() []*RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
+func (m *Node) GetIndexScanInfo() *IndexScanInfo {
+	if m != nil {
+		return m.IndexScanInfo
+	}
+	return nil
+}
+
+func (m *Node) GetSnapshotTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.SnapshotTs
+	}
+	return nil
+}
+
+// IndexScanInfo describes the secondary index read by a table scan, the rows found are joined
+// back to the table unless the index covers all the columns of the table used by the query.
+type IndexScanInfo struct {
+	IndexName string `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// the table the index belongs to
+	TableName string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// the scan reads the index table only, the table isn't joined back
+	Covering             bool     `protobuf:"varint,3,opt,name=covering,proto3" json:"covering,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexScanInfo) Reset()         { *m = IndexScanInfo{} }
+func (m *IndexScanInfo) String() string { return proto.CompactTextString(m) }
+func (*IndexScanInfo) ProtoMessage()    {}
+func (*IndexScanInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
+}
+func (m *IndexScanInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexScanInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexScanInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexScanInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexScanInfo.Merge(m, src)
+}
+func (m *IndexScanInfo) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *IndexScanInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexScanInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexScanInfo proto.InternalMessageInfo
+
+func (m *IndexScanInfo) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *IndexScanInfo) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *IndexScanInfo) GetCovering() bool {
+	if m != nil {
+		return m.Covering
+	}
+	return false
+}
+
+// RuntimeFilterSpec connects the hash build of a join and the table scan on its probe
+// side, the scan skips the blocks and the rows which can't be joined with the keys of
+// the build side.
+type RuntimeFilterSpec struct {
+	Tag int32 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// the keys of the build side on the join node, the column filtered on the table
+	// scan node
+	Expr *Expr `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
+	// the build side with more distinct keys than it builds a bloom filter instead of
+	// an IN list
+	UpperLimit           int32    `protobuf:"varint,3,opt,name=upper_limit,json=upperLimit,proto3" json:"upper_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeFilterSpec) Reset()         { *m = RuntimeFilterSpec{} }
+func (m *RuntimeFilterSpec) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFilterSpec) ProtoMessage()    {}
+func (*RuntimeFilterSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
+}
+func (m *RuntimeFilterSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuntimeFilterSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuntimeFilterSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuntimeFilterSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFilterSpec.Merge(m, src)
+}
+func (m *RuntimeFilterSpec) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RuntimeFilterSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFilterSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFilterSpec proto.InternalMessageInfo
+
+func (m *RuntimeFilterSpec) GetTag() int32 {
+	if m != nil {
+		return m.Tag
+	}
+	return 0
+}
+
+func (m *RuntimeFilterSpec) GetExpr() *Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *RuntimeFilterSpec) GetUpperLimit() int32 {
+	if m != nil {
+		return m.UpperLimit
+	}
+	return 0
+}
+
 type IdList struct {
 	List                 []int64  `protobuf:"varint,1,rep,packed,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4909,7 +5539,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4956,7 +5586,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5014,7 +5644,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5150,7 +5780,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5239,7 +5869,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5343,7 +5973,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5390,7 +6020,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5437,7 +6067,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5490,7 +6120,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5599,7 +6229,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5654,7 +6284,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5795,7 +6425,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6075,7 +6705,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6130,7 +6760,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6185,7 +6815,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6239,7 +6869,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6295,7 +6925,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6392,7 +7022,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6449,7 +7079,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6506,6 +7136,76 @@ func (m *AlterTableAddFk) GetFkey() *ForeignKeyDef {
 	return nil
 }
 
+// AlterTableColumns changes the columns of a table, the rows of the table are copied with the new columns
+// unless in_place is set.
+type AlterTableColumns struct {
+	// cols are the visible columns of the table after the change.
+	Cols []*ColDef `protobuf:"bytes,1,rep,name=cols,proto3" json:"cols,omitempty"`
+	// exprs compute the new columns from a row of the table before the change,
+	// ColRef{rel_pos: 0, col_pos: i} refers to the i-th column of AlterTable.table_def.
+	Exprs []*Expr `protobuf:"bytes,2,rep,name=exprs,proto3" json:"exprs,omitempty"`
+	// in_place is set if the change only renames columns or appends columns with
+	// a constant default, so the engine could change the schema without copying rows.
+	InPlace              bool     `protobuf:"varint,3,opt,name=in_place,json=inPlace,proto3" json:"in_place,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableColumns) Reset()         { *m = AlterTableColumns{} }
+func (m *AlterTableColumns) String() string { return proto.CompactTextString(m) }
+func (*AlterTableColumns) ProtoMessage()    {}
+func (*AlterTableColumns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
+}
+func (m *AlterTableColumns) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableColumns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableColumns.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableColumns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableColumns.Merge(m, src)
+}
+func (m *AlterTableColumns) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableColumns) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableColumns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableColumns proto.InternalMessageInfo
+
+func (m *AlterTableColumns) GetCols() []*ColDef {
+	if m != nil {
+		return m.Cols
+	}
+	return nil
+}
+
+func (m *AlterTableColumns) GetExprs() []*Expr {
+	if m != nil {
+		return m.Exprs
+	}
+	return nil
+}
+
+func (m *AlterTableColumns) GetInPlace() bool {
+	if m != nil {
+		return m.InPlace
+	}
+	return false
+}
+
 type AlterTable struct {
 	Database             string               `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	TableDef             *TableDef            `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
@@ -6520,7 +7220,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6582,6 +7282,8 @@ type AlterTable_Action struct {
 	//
 	//	*AlterTable_Action_Drop
 	//	*AlterTable_Action_AddFk
+	//	*AlterTable_Action_Columns
+	//	*AlterTable_Action_AddIndex
 	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -6592,7 +7294,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{71, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6633,9 +7335,17 @@ type AlterTable_Action_Drop struct {
 type AlterTable_Action_AddFk struct {
 	AddFk *AlterTableAddFk `protobuf:"bytes,2,opt,name=add_fk,json=addFk,proto3,oneof" json:"add_fk,omitempty"`
 }
+type AlterTable_Action_Columns struct {
+	Columns *AlterTableColumns `protobuf:"bytes,3,opt,name=columns,proto3,oneof" json:"columns,omitempty"`
+}
+type AlterTable_Action_AddIndex struct {
+	AddIndex *CreateIndex `protobuf:"bytes,4,opt,name=add_index,json=addIndex,proto3,oneof" json:"add_index,omitempty"`
+}
 
-func (*AlterTable_Action_Drop) isAlterTable_Action_Action()  {}
-func (*AlterTable_Action_AddFk) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_Drop) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()    {}
+func (*AlterTable_Action_Columns) isAlterTable_Action_Action()  {}
+func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action() {}
 
 func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
@@ -6658,11 +7368,27 @@ func (m *AlterTable_Action) GetAddFk() *AlterTableAddFk {
 	return nil
 }
 
+func (m *AlterTable_Action) GetColumns() *AlterTableColumns {
+	if x, ok := m.GetAction().(*AlterTable_Action_Columns); ok {
+		return x.Columns
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetAddIndex() *CreateIndex {
+	if x, ok := m.GetAction().(*AlterTable_Action_AddIndex); ok {
+		return x.AddIndex
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*AlterTable_Action_Drop)(nil),
 		(*AlterTable_Action_AddFk)(nil),
+		(*AlterTable_Action_Columns)(nil),
+		(*AlterTable_Action_AddIndex)(nil),
 	}
 }
 
@@ -6683,7 +7409,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6774,7 +7500,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6837,7 +7563,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6901,7 +7627,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6968,7 +7694,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7012,7 +7738,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7087,7 +7813,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7137,7 +7863,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7210,7 +7936,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7294,7 +8020,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7356,7 +8082,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7410,7 +8136,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7461,7 +8187,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7539,7 +8265,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7608,7 +8334,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7662,7 +8388,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7710,7 +8436,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7764,7 +8490,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7810,7 +8536,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7848,6 +8574,8 @@ func init() {
 	proto.RegisterEnum("plan.Function_FuncFlag", Function_FuncFlag_name, Function_FuncFlag_value)
 	proto.RegisterEnum("plan.ForeignKeyDef_RefAction", ForeignKeyDef_RefAction_name, ForeignKeyDef_RefAction_value)
 	proto.RegisterEnum("plan.OrderBySpec_OrderByFlag", OrderBySpec_OrderByFlag_name, OrderBySpec_OrderByFlag_value)
+	proto.RegisterEnum("plan.FrameBound_BoundType", FrameBound_BoundType_name, FrameBound_BoundType_value)
+	proto.RegisterEnum("plan.FrameClause_FrameType", FrameClause_FrameType_name, FrameClause_FrameType_value)
 	proto.RegisterEnum("plan.Node_NodeType", Node_NodeType_name, Node_NodeType_value)
 	proto.RegisterEnum("plan.Node_JoinFlag", Node_JoinFlag_name, Node_JoinFlag_value)
 	proto.RegisterEnum("plan.Node_AggMode", Node_AggMode_name, Node_AggMode_value)
@@ -7877,6 +8605,7 @@ func init() {
 	proto.RegisterType((*ColDef)(nil), "plan.ColDef")
 	proto.RegisterType((*Default)(nil), "plan.Default")
 	proto.RegisterType((*OnUpdate)(nil), "plan.OnUpdate")
+	proto.RegisterType((*GeneratedCol)(nil), "plan.GeneratedCol")
 	proto.RegisterType((*IndexOption)(nil), "plan.IndexOption")
 	proto.RegisterType((*PrimaryKeyDef)(nil), "plan.PrimaryKeyDef")
 	proto.RegisterType((*IndexDef)(nil), "plan.IndexDef")
@@ -7899,13 +8628,18 @@ func init() {
 	proto.RegisterType((*ColData)(nil), "plan.ColData")
 	proto.RegisterType((*RowsetData)(nil), "plan.RowsetData")
 	proto.RegisterType((*OrderBySpec)(nil), "plan.OrderBySpec")
+	proto.RegisterType((*FrameBound)(nil), "plan.FrameBound")
+	proto.RegisterType((*FrameClause)(nil), "plan.FrameClause")
 	proto.RegisterType((*WindowSpec)(nil), "plan.WindowSpec")
+	proto.RegisterType((*RecursiveCte)(nil), "plan.RecursiveCte")
 	proto.RegisterType((*InsertCtx)(nil), "plan.InsertCtx")
 	proto.RegisterMapType((map[string]*Expr)(nil), "plan.InsertCtx.OnDuplicateExprEntry")
 	proto.RegisterMapType((map[string]int32)(nil), "plan.InsertCtx.ParentIdxEntry")
 	proto.RegisterType((*UpdateCtx)(nil), "plan.UpdateCtx")
 	proto.RegisterType((*AnalyzeInfo)(nil), "plan.AnalyzeInfo")
 	proto.RegisterType((*Node)(nil), "plan.Node")
+	proto.RegisterType((*IndexScanInfo)(nil), "plan.IndexScanInfo")
+	proto.RegisterType((*RuntimeFilterSpec)(nil), "plan.RuntimeFilterSpec")
 	proto.RegisterType((*IdList)(nil), "plan.IdList")
 	proto.RegisterType((*ColPosMap)(nil), "plan.ColPosMap")
 	proto.RegisterMapType((map[string]int32)(nil), "plan.ColPosMap.MapEntry")
@@ -7926,6 +8660,7 @@ func init() {
 	proto.RegisterType((*CreateTable)(nil), "plan.CreateTable")
 	proto.RegisterType((*AlterTableDrop)(nil), "plan.AlterTableDrop")
 	proto.RegisterType((*AlterTableAddFk)(nil), "plan.AlterTableAddFk")
+	proto.RegisterType((*AlterTableColumns)(nil), "plan.AlterTableColumns")
 	proto.RegisterType((*AlterTable)(nil), "plan.AlterTable")
 	proto.RegisterType((*AlterTable_Action)(nil), "plan.AlterTable.Action")
 	proto.RegisterType((*DropTable)(nil), "plan.DropTable")
@@ -7952,447 +8687,490 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 7033 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7c, 0x4b, 0x8f, 0x1b, 0xc7,
-	0xd6, 0xd8, 0xf0, 0xdd, 0x3c, 0x7c, 0x4c, 0xab, 0xf4, 0xa2, 0x64, 0x59, 0x1e, 0xb5, 0x64, 0x5b,
-	0x96, 0x6d, 0xf9, 0x7a, 0xfc, 0x76, 0x7c, 0x71, 0x2f, 0x87, 0xa4, 0x66, 0x78, 0xcd, 0x21, 0xe7,
-	0x16, 0x39, 0xd2, 0x75, 0x3e, 0x04, 0x44, 0x93, 0xdd, 0x9c, 0x69, 0x4d, 0xb3, 0x9b, 0xee, 0x6e,
-	0x6a, 0x66, 0x2e, 0xf0, 0x01, 0x17, 0x08, 0x10, 0x20, 0xab, 0x2c, 0xb2, 0xf8, 0x90, 0x4d, 0x72,
-	0x91, 0x55, 0xbe, 0x6f, 0x97, 0x5f, 0x10, 0x24, 0xab, 0x04, 0xc8, 0x22, 0x41, 0x92, 0x55, 0x36,
-	0xc1, 0x0d, 0x90, 0x6d, 0x10, 0x24, 0xbb, 0x04, 0x41, 0x70, 0x4e, 0x55, 0x37, 0xab, 0x67, 0x28,
-	0x4b, 0x57, 0xf1, 0x66, 0xa6, 0xeb, 0x3c, 0xaa, 0x4e, 0x55, 0x9d, 0x3a, 0x8f, 0x7a, 0x10, 0x60,
-	0xe1, 0x9a, 0xde, 0xe3, 0x45, 0xe0, 0x47, 0x3e, 0xcb, 0xe3, 0xf7, 0xed, 0x8f, 0x8f, 0x9c, 0xe8,
-	0x78, 0x39, 0x79, 0x3c, 0xf5, 0xe7, 0x9f, 0x1c, 0xf9, 0x47, 0xfe, 0x27, 0x84, 0x9c, 0x2c, 0x67,
-	0x54, 0xa2, 0x02, 0x7d, 0x09, 0x26, 0xe3, 0xaf, 0x32, 0x90, 0x1f, 0x9d, 0x2f, 0x6c, 0x56, 0x87,
-	0xac, 0x63, 0x35, 0x32, 0x5b, 0x99, 0x87, 0x05, 0x9e, 0x75, 0x2c, 0xb6, 0x05, 0x15, 0xcf, 0x8f,
-	0xfa, 0x4b, 0xd7, 0x35, 0x27, 0xae, 0xdd, 0xc8, 0x6e, 0x65, 0x1e, 0x6a, 0x5c, 0x05, 0xb1, 0xb7,
-	0xa0, 0x6c, 0x2e, 0x23, 0x7f, 0xec, 0x78, 0xd3, 0xa0, 0x91, 0x23, 0xbc, 0x86, 0x80, 0xae, 0x37,
-	0x0d, 0xd8, 0x35, 0x28, 0x9c, 0x3a, 0x56, 0x74, 0xdc, 0xc8, 0x53, 0x8d, 0xa2, 0x80, 0xd0, 0x70,
-	0x6a, 0xba, 0x76, 0xa3, 0x20, 0xa0, 0x54, 0x40, 0x68, 0x44, 0x8d, 0x14, 0xb7, 0x32, 0x0f, 0xcb,
-	0x5c, 0x14, 0x8c, 0x7f, 0x5f, 0x80, 0x42, 0xcb, 0xf7, 0xc2, 0x88, 0xdd, 0x80, 0xa2, 0x13, 0x7a,
-	0x4b, 0xd7, 0x25, 0xf1, 0x34, 0x2e, 0x4b, 0xec, 0x06, 0x14, 0x9c, 0xaf, 0x5f, 0x98, 0x2e, 0x09,
-	0x57, 0xd8, 0xdb, 0xe0, 0xa2, 0xc8, 0x1a, 0x50, 0x74, 0x3e, 0xfd, 0x12, 0x11, 0x39, 0x89, 0x90,
-	0x65, 0xc2, 0x7c, 0xb6, 0x8d, 0x98, 0x7c, 0x82, 0xa1, 0x32, 0x61, 0xbe, 0xfc, 0x1c, 0x31, 0x28,
-	0x5a, 0x8e, 0x30, 0x54, 0xc6, 0x56, 0x96, 0xd4, 0x0a, 0x4a, 0x57, 0xc3, 0x56, 0x96, 0x71, 0x2b,
-	0x4b, 0xd1, 0x4a, 0x49, 0x22, 0x64, 0x99, 0x30, 0xa2, 0x15, 0x2d, 0xc1, 0x24, 0xad, 0x2c, 0x45,
-	0x2b, 0xe5, 0xad, 0xcc, 0xc3, 0x3c, 0x61, 0x44, 0x2b, 0xd7, 0x20, 0x6f, 0x21, 0x1c, 0xb6, 0x32,
-	0x0f, 0x33, 0x7b, 0x1b, 0x9c, 0x4a, 0x08, 0x0d, 0x11, 0x5a, 0xc1, 0x81, 0x41, 0x68, 0x28, 0xa1,
-	0x13, 0x84, 0x56, 0x71, 0x34, 0x10, 0x3a, 0x91, 0xd0, 0x19, 0x42, 0x6b, 0x5b, 0x99, 0x87, 0x59,
-	0x84, 0x62, 0x89, 0xdd, 0x86, 0x92, 0x65, 0x46, 0x36, 0x22, 0xea, 0xb2, 0xcb, 0x31, 0x00, 0x71,
-	0x91, 0x33, 0x27, 0xdc, 0xa6, 0xec, 0x74, 0x0c, 0x60, 0x06, 0x54, 0x90, 0x2c, 0xc6, 0xeb, 0x12,
-	0xaf, 0x02, 0xd9, 0x17, 0x50, 0xb5, 0xec, 0xa9, 0x33, 0x37, 0x5d, 0xd1, 0xa7, 0x2b, 0x5b, 0x99,
-	0x87, 0x95, 0xed, 0xcd, 0xc7, 0xa4, 0x93, 0x09, 0x66, 0x6f, 0x83, 0xa7, 0xc8, 0xd8, 0xd7, 0x50,
-	0x93, 0xe5, 0x4f, 0xb7, 0x69, 0x60, 0x19, 0xf1, 0xe9, 0x29, 0xbe, 0x4f, 0xb7, 0xbf, 0xde, 0xdb,
-	0xe0, 0x69, 0x42, 0xf6, 0x00, 0xaa, 0xd8, 0x76, 0x18, 0x99, 0xf3, 0x05, 0x32, 0x5e, 0x95, 0x52,
-	0xa5, 0xa0, 0xd8, 0xad, 0xe7, 0xa1, 0xef, 0x21, 0xc1, 0x35, 0x39, 0x6e, 0x31, 0x80, 0x6d, 0x01,
-	0x58, 0xf6, 0xcc, 0x5c, 0xba, 0x11, 0xa2, 0xaf, 0xcb, 0x01, 0x54, 0x60, 0xec, 0x2e, 0x94, 0x97,
-	0x0b, 0xec, 0xe5, 0x53, 0xd3, 0x6d, 0xdc, 0x90, 0x04, 0x2b, 0x10, 0x2a, 0xab, 0x13, 0xee, 0x38,
-	0x5e, 0xe3, 0x26, 0xe9, 0xa2, 0x28, 0xb0, 0x3b, 0x90, 0x0b, 0x83, 0x69, 0xa3, 0x41, 0x3d, 0x01,
-	0xd1, 0x93, 0xce, 0xd9, 0x22, 0xe0, 0x08, 0xde, 0x29, 0x41, 0xe1, 0x85, 0xe9, 0x2e, 0x6d, 0xe3,
-	0x0e, 0x68, 0x07, 0x66, 0x60, 0xce, 0xb9, 0x3d, 0x63, 0x3a, 0xe4, 0x16, 0x7e, 0x28, 0x57, 0x1c,
-	0x7e, 0x1a, 0x3d, 0x28, 0x3e, 0x35, 0x03, 0xc4, 0x31, 0xc8, 0x7b, 0xe6, 0xdc, 0x26, 0x64, 0x99,
-	0xd3, 0x37, 0xae, 0x82, 0xf0, 0x3c, 0x8c, 0xec, 0xb9, 0x5c, 0x8b, 0xb2, 0x84, 0xf0, 0x23, 0xd7,
-	0x9f, 0x48, 0x6d, 0xd7, 0xb8, 0x2c, 0x19, 0x7d, 0x28, 0xb6, 0x7c, 0x17, 0x6b, 0xbb, 0x09, 0xa5,
-	0xc0, 0x76, 0xc7, 0xab, 0xd6, 0x8a, 0x81, 0xed, 0x1e, 0xf8, 0x21, 0x22, 0xa6, 0xbe, 0x40, 0x64,
-	0x05, 0x62, 0xea, 0x13, 0x22, 0x6e, 0x3f, 0xb7, 0x6a, 0xdf, 0xf8, 0x06, 0xca, 0xdc, 0x3c, 0x95,
-	0x55, 0x5e, 0x87, 0x62, 0x34, 0x71, 0xc7, 0xd2, 0x62, 0xe4, 0x79, 0x21, 0x9a, 0xb8, 0x5d, 0x0b,
-	0xc1, 0x58, 0xa1, 0x63, 0x51, 0x7d, 0x79, 0x5e, 0x98, 0xfa, 0x6e, 0xd7, 0x32, 0x46, 0x00, 0x2d,
-	0x3f, 0x08, 0xde, 0x58, 0x9c, 0x6b, 0x50, 0xb0, 0xec, 0x45, 0x74, 0x2c, 0xd6, 0x33, 0x17, 0x05,
-	0xe3, 0x11, 0x68, 0x38, 0xc4, 0x3d, 0x27, 0x8c, 0xd8, 0x5d, 0xc8, 0xbb, 0x4e, 0x18, 0x35, 0x32,
-	0x5b, 0xb9, 0x0b, 0x13, 0x40, 0x70, 0x63, 0x0b, 0xb4, 0x7d, 0xf3, 0xec, 0x29, 0x4e, 0x02, 0xd6,
-	0x46, 0xb3, 0x21, 0x47, 0x57, 0x4e, 0xcd, 0x23, 0x80, 0x91, 0x19, 0x1c, 0xd9, 0x11, 0x59, 0xc3,
-	0x3b, 0x90, 0x8b, 0xce, 0x17, 0x44, 0x91, 0x54, 0x87, 0x08, 0x8e, 0x60, 0xe3, 0x7f, 0x66, 0xa0,
-	0x32, 0x5c, 0x4e, 0x7e, 0x5c, 0xda, 0xc1, 0x39, 0xf6, 0xe8, 0xe1, 0x8a, 0xba, 0xbe, 0x7d, 0x43,
-	0x50, 0x2b, 0xf8, 0x15, 0x27, 0x76, 0xd1, 0xf3, 0x2d, 0x3b, 0x1e, 0xa1, 0x02, 0x2f, 0x62, 0xb1,
-	0x6b, 0xa1, 0xf9, 0xf5, 0x17, 0x72, 0xbc, 0xb3, 0xfe, 0x82, 0x6d, 0x41, 0x61, 0x7a, 0xec, 0xb8,
-	0x16, 0x19, 0xaa, 0x74, 0x8f, 0x04, 0x82, 0xdd, 0x02, 0x2d, 0xf0, 0x4f, 0xc7, 0xa1, 0xf3, 0xfb,
-	0xd8, 0x9c, 0x96, 0x02, 0xff, 0x74, 0xe8, 0xfc, 0xde, 0x36, 0x46, 0xd2, 0xa6, 0x03, 0x14, 0x87,
-	0xad, 0x66, 0xaf, 0xc9, 0xf5, 0x0d, 0xfc, 0xee, 0xfc, 0xae, 0x3b, 0x1c, 0x0d, 0xf5, 0x0c, 0xab,
-	0x03, 0xf4, 0x07, 0xa3, 0xb1, 0x2c, 0x67, 0x59, 0x11, 0xb2, 0xdd, 0xbe, 0x9e, 0x43, 0x1a, 0x84,
-	0x77, 0xfb, 0x7a, 0x9e, 0x95, 0x20, 0xd7, 0xec, 0xff, 0xa0, 0x17, 0xe8, 0xa3, 0xd7, 0xd3, 0x8b,
-	0xc6, 0x7f, 0xc8, 0x40, 0x79, 0x30, 0x79, 0x6e, 0x4f, 0x23, 0xec, 0x33, 0xaa, 0xa3, 0x1d, 0xbc,
-	0xb0, 0x03, 0xea, 0x76, 0x8e, 0xcb, 0x12, 0x76, 0xc4, 0x9a, 0x50, 0xe7, 0x72, 0x3c, 0x6b, 0x4d,
-	0x88, 0x6e, 0x7a, 0x6c, 0xcf, 0x4d, 0xea, 0x1c, 0xd2, 0x51, 0x09, 0xd5, 0xdf, 0x9f, 0x3c, 0xa7,
-	0xee, 0xe5, 0x38, 0x7e, 0xb2, 0x77, 0xa0, 0x22, 0xea, 0x18, 0x93, 0xee, 0x15, 0x68, 0x2c, 0x40,
-	0x80, 0xfa, 0xb8, 0x02, 0x6e, 0x42, 0xc9, 0x9a, 0x08, 0xa4, 0xf0, 0x14, 0x45, 0x6b, 0x42, 0x08,
-	0xe4, 0xa4, 0x5a, 0x05, 0xb2, 0x24, 0x39, 0x09, 0x44, 0x04, 0xb7, 0x40, 0xf3, 0x27, 0xcf, 0x05,
-	0x56, 0x23, 0x6c, 0xc9, 0x9f, 0x3c, 0x47, 0x94, 0xf1, 0x3f, 0x32, 0xa0, 0x3d, 0x59, 0x7a, 0xd3,
-	0xc8, 0xf1, 0x3d, 0x76, 0x1f, 0xf2, 0xb3, 0xa5, 0x37, 0x95, 0xf3, 0x2e, 0x2d, 0x59, 0xd2, 0x67,
-	0x4e, 0x48, 0xd4, 0x35, 0x33, 0x38, 0x42, 0x1d, 0xbd, 0xa4, 0x6b, 0x08, 0x37, 0xfe, 0x89, 0xac,
-	0xf1, 0x89, 0x6b, 0x1e, 0x31, 0x0d, 0xf2, 0xfd, 0x41, 0xbf, 0xa3, 0x6f, 0xb0, 0x2a, 0x68, 0xdd,
-	0xfe, 0xa8, 0xc3, 0xfb, 0xcd, 0x9e, 0x9e, 0xa1, 0xa9, 0x19, 0x35, 0x77, 0x7a, 0x1d, 0x3d, 0x8b,
-	0x98, 0xa7, 0x83, 0x5e, 0x73, 0xd4, 0xed, 0x75, 0xf4, 0xbc, 0xc0, 0xf0, 0x6e, 0x6b, 0xa4, 0x6b,
-	0x4c, 0x87, 0xea, 0x01, 0x1f, 0xb4, 0x0f, 0x5b, 0x9d, 0x71, 0xff, 0xb0, 0xd7, 0xd3, 0x75, 0x76,
-	0x15, 0x36, 0x13, 0xc8, 0x40, 0x00, 0xb7, 0x90, 0xe5, 0x69, 0x93, 0x37, 0xf9, 0xae, 0xfe, 0x6b,
-	0xa6, 0x41, 0xae, 0xb9, 0xbb, 0xab, 0xff, 0x21, 0x83, 0x5f, 0xcf, 0xba, 0x7d, 0xfd, 0x0f, 0x59,
-	0x56, 0x87, 0xf2, 0xfe, 0xa0, 0x3f, 0x18, 0x0d, 0xfa, 0xdd, 0x96, 0xfe, 0x87, 0xbc, 0xf1, 0xd7,
-	0x39, 0xc8, 0xa3, 0xc0, 0x3f, 0xad, 0xe6, 0xec, 0x2d, 0xc8, 0x4c, 0x69, 0x26, 0x2b, 0xdb, 0x15,
-	0x81, 0x23, 0x7f, 0xbc, 0xb7, 0xc1, 0x33, 0x38, 0x0a, 0x19, 0xa1, 0xaf, 0x95, 0xed, 0xba, 0x40,
-	0xc6, 0x96, 0x0d, 0xf1, 0x0b, 0x76, 0x07, 0x32, 0x2f, 0xa4, 0xf2, 0x56, 0x05, 0x5e, 0xd8, 0x36,
-	0xc4, 0xbe, 0x60, 0x5b, 0x90, 0x9b, 0xfa, 0xc2, 0xd7, 0x26, 0x78, 0x61, 0x1e, 0xf6, 0x36, 0x38,
-	0xa2, 0xd8, 0x7d, 0xc8, 0x05, 0xe6, 0x29, 0x4d, 0x74, 0x32, 0x13, 0x89, 0xfd, 0x41, 0xa2, 0xc0,
-	0x3c, 0x45, 0x21, 0x66, 0x34, 0xdd, 0x89, 0x10, 0xf1, 0x54, 0x62, 0x33, 0x33, 0xf6, 0x2e, 0xe4,
-	0xc2, 0xe5, 0x84, 0xa6, 0xbc, 0xb2, 0x7d, 0xe5, 0xd2, 0xc2, 0xc4, 0x6a, 0xc2, 0xe5, 0x84, 0xbd,
-	0x07, 0xf9, 0xa9, 0x1f, 0x04, 0xe4, 0x94, 0x13, 0x47, 0xb4, 0xb2, 0x58, 0xe8, 0x4c, 0x11, 0xcf,
-	0xb6, 0x20, 0x13, 0x91, 0x87, 0x4e, 0x88, 0x56, 0x26, 0x03, 0x1b, 0x8c, 0xd8, 0x03, 0x69, 0x87,
-	0x2a, 0xaa, 0x4c, 0xb1, 0x95, 0xc2, 0x7a, 0x10, 0xcb, 0x0c, 0xc8, 0xcd, 0xcd, 0x33, 0xf2, 0xdf,
-	0x09, 0x51, 0x6c, 0x9e, 0x50, 0xa6, 0xb9, 0x79, 0xb6, 0x53, 0x84, 0xbc, 0x7d, 0xb6, 0x08, 0x8c,
-	0x5b, 0x50, 0x4e, 0xbc, 0x27, 0xab, 0x42, 0xc6, 0x94, 0xeb, 0x2d, 0x63, 0x1a, 0x0f, 0xd1, 0x99,
-	0xc5, 0xfe, 0x31, 0x8d, 0xc3, 0x52, 0xbc, 0x0a, 0x33, 0x13, 0xe3, 0x3b, 0xa8, 0x72, 0x3b, 0x5c,
-	0xba, 0x51, 0xcb, 0x77, 0xdb, 0xf6, 0x8c, 0x7d, 0x04, 0x90, 0x94, 0x43, 0x69, 0x34, 0x57, 0xb3,
-	0xd0, 0xb6, 0x67, 0x5c, 0xc1, 0x1b, 0x7f, 0x37, 0x47, 0xae, 0xa4, 0x2d, 0xec, 0xbe, 0x34, 0xf0,
-	0x19, 0xc5, 0xc0, 0x27, 0xfe, 0x22, 0x9b, 0xf6, 0x57, 0xc7, 0x8e, 0x65, 0xd9, 0x5e, 0xec, 0x97,
-	0x44, 0x89, 0x3d, 0x80, 0x9c, 0xe9, 0x1e, 0x91, 0x6a, 0xd4, 0xb7, 0x59, 0xdc, 0xe8, 0x7c, 0x11,
-	0xd8, 0x61, 0x28, 0x74, 0xcf, 0x74, 0x8f, 0x62, 0xcd, 0x2c, 0xac, 0xd7, 0xcc, 0x5b, 0xa0, 0x79,
-	0x7e, 0x34, 0xa6, 0x98, 0xb0, 0x48, 0xb5, 0x97, 0x64, 0x64, 0xca, 0xde, 0x87, 0x92, 0xf4, 0xe6,
-	0x52, 0x31, 0x6a, 0x82, 0xb9, 0x2d, 0x80, 0x3c, 0xc6, 0xb2, 0x06, 0x7a, 0x9b, 0xf9, 0xdc, 0xf6,
-	0xa2, 0xd8, 0x24, 0xc8, 0x22, 0xfb, 0x10, 0xca, 0xbe, 0x37, 0x16, 0x2e, 0x5f, 0xea, 0x84, 0x9c,
-	0xa4, 0x81, 0x77, 0x48, 0x50, 0xae, 0xf9, 0xf2, 0x0b, 0x45, 0x71, 0xfd, 0xd3, 0xf1, 0xd4, 0x0c,
-	0x2c, 0x52, 0x0d, 0x8d, 0x97, 0x5c, 0xff, 0xb4, 0x65, 0x06, 0x16, 0xbb, 0x03, 0xe5, 0xa9, 0xbb,
-	0x0c, 0x23, 0x3b, 0xd8, 0x39, 0x27, 0x8d, 0xd0, 0xf8, 0x0a, 0x80, 0xed, 0x2f, 0x02, 0x67, 0x6e,
-	0x06, 0xe7, 0x22, 0x90, 0xe3, 0x71, 0x11, 0x1d, 0xd4, 0xe2, 0xc4, 0xb1, 0xce, 0x28, 0x94, 0x2b,
-	0x70, 0x51, 0x30, 0x7e, 0x84, 0x92, 0xec, 0x03, 0x5a, 0x20, 0xd4, 0x8d, 0xf4, 0xba, 0x15, 0x16,
-	0x08, 0xe1, 0xec, 0x3e, 0xd4, 0xfc, 0xc0, 0x39, 0x72, 0xbc, 0x71, 0x18, 0x05, 0x8e, 0x77, 0x24,
-	0xe7, 0xa5, 0x2a, 0x80, 0x43, 0x82, 0xb1, 0x7b, 0x50, 0xc5, 0xf1, 0x1b, 0x9b, 0x13, 0xc7, 0x75,
-	0xa2, 0x73, 0x39, 0x4b, 0x15, 0x84, 0x35, 0x05, 0xc8, 0x18, 0x80, 0x16, 0xf7, 0xf8, 0x67, 0x69,
-	0xd3, 0xf8, 0x5b, 0x50, 0xe9, 0x7a, 0x96, 0x7d, 0x36, 0x58, 0x90, 0xb9, 0xfd, 0x08, 0xd8, 0x34,
-	0xb0, 0xcd, 0xc8, 0x1e, 0xdb, 0x67, 0x51, 0x60, 0x8e, 0x45, 0x16, 0x20, 0x82, 0x7c, 0x5d, 0x60,
-	0x3a, 0x88, 0x18, 0x51, 0x42, 0xf0, 0xcf, 0x32, 0x50, 0x3b, 0x10, 0x43, 0xf4, 0xbd, 0x7d, 0xde,
-	0x16, 0x61, 0xd2, 0x34, 0x56, 0xe0, 0x3c, 0xa7, 0x6f, 0x76, 0x17, 0x2a, 0x8b, 0x13, 0xfb, 0x7c,
-	0x9c, 0x8a, 0x43, 0xca, 0x08, 0x6a, 0x91, 0xaa, 0x7e, 0x00, 0x45, 0x9f, 0x5a, 0x97, 0xc6, 0x4b,
-	0x5a, 0x05, 0x45, 0x2c, 0x2e, 0x09, 0x98, 0x01, 0xb5, 0xa4, 0x2a, 0x52, 0xef, 0x3c, 0x75, 0xa9,
-	0x22, 0x2b, 0x23, 0xcf, 0x72, 0x0d, 0x0a, 0x88, 0x0a, 0x1b, 0x85, 0xad, 0x1c, 0x06, 0x13, 0x54,
-	0x30, 0xfe, 0x6f, 0x06, 0x34, 0xaa, 0x51, 0xae, 0x19, 0xc7, 0x3a, 0x8b, 0xd7, 0x4c, 0x99, 0x17,
-	0x1c, 0xeb, 0xac, 0x6b, 0xb1, 0xb7, 0x01, 0x1c, 0x24, 0x19, 0x2b, 0x2b, 0xa7, 0x4c, 0x90, 0xb8,
-	0xe2, 0x85, 0x19, 0x44, 0x61, 0x23, 0x27, 0x2a, 0xa6, 0x02, 0x2e, 0xaa, 0xa5, 0xe7, 0xfc, 0xb8,
-	0x14, 0xb2, 0x68, 0x5c, 0x96, 0xd8, 0x43, 0xd0, 0x45, 0x65, 0x34, 0x84, 0xaa, 0x03, 0xad, 0x13,
-	0x9c, 0x46, 0x30, 0xf6, 0x95, 0x82, 0xc6, 0x3e, 0x43, 0x43, 0x25, 0x56, 0x0f, 0x10, 0xa8, 0x83,
-	0x10, 0x75, 0x5d, 0x94, 0xd2, 0xeb, 0x62, 0x35, 0x74, 0xda, 0x2b, 0x86, 0xce, 0xf8, 0x37, 0x59,
-	0xa8, 0x3d, 0xf1, 0x03, 0xdb, 0x39, 0xf2, 0x56, 0x73, 0x75, 0x29, 0xa4, 0x8d, 0xe7, 0x2f, 0xab,
-	0xcc, 0xdf, 0x3b, 0x50, 0x99, 0x09, 0xc6, 0x71, 0x34, 0x11, 0x31, 0x6d, 0x9e, 0x83, 0x04, 0x8d,
-	0x26, 0x2e, 0xea, 0x6d, 0x4c, 0x40, 0xcc, 0x79, 0x62, 0x8e, 0x99, 0xd0, 0x60, 0xb1, 0x6f, 0x69,
-	0x01, 0x5b, 0xb6, 0x6b, 0x47, 0x62, 0x18, 0xea, 0xdb, 0x6f, 0x4b, 0xf7, 0xa0, 0xca, 0xf4, 0x98,
-	0xdb, 0xb3, 0x26, 0x79, 0x0b, 0x5c, 0xcf, 0x6d, 0x22, 0x97, 0xbc, 0x72, 0xf1, 0x17, 0x5f, 0x93,
-	0x57, 0xac, 0x11, 0x63, 0x04, 0xe5, 0x04, 0x8c, 0x5e, 0x9d, 0x77, 0xa4, 0x27, 0xdf, 0x60, 0x15,
-	0x28, 0xb5, 0x9a, 0xc3, 0x56, 0xb3, 0xdd, 0xd1, 0x33, 0x88, 0x1a, 0x76, 0x46, 0xc2, 0x7b, 0x67,
-	0xd9, 0x26, 0x54, 0xb0, 0xd4, 0xee, 0x3c, 0x69, 0x1e, 0xf6, 0x46, 0x7a, 0x8e, 0xd5, 0xa0, 0xdc,
-	0x1f, 0x8c, 0x9b, 0xad, 0x51, 0x77, 0xd0, 0xd7, 0xf3, 0xc6, 0xaf, 0x41, 0x6b, 0x1d, 0xdb, 0xd3,
-	0x93, 0x97, 0x8d, 0x22, 0x85, 0x8a, 0xf6, 0xf4, 0x44, 0xba, 0xea, 0x0b, 0xa1, 0xa2, 0x3d, 0x3d,
-	0x31, 0xda, 0x50, 0x6d, 0xc5, 0x76, 0x07, 0x6b, 0xd9, 0x8a, 0x75, 0xeb, 0x72, 0xb8, 0x2c, 0xf5,
-	0x6c, 0x8d, 0x41, 0x37, 0xbe, 0x80, 0xca, 0x41, 0xe0, 0x2f, 0xec, 0x20, 0xa2, 0x4a, 0x74, 0xc8,
-	0x9d, 0xd8, 0xe7, 0x52, 0x12, 0xfc, 0x5c, 0x05, 0xd6, 0x59, 0x35, 0xb0, 0xde, 0x06, 0x2d, 0x66,
-	0x7b, 0x6d, 0x9e, 0x5f, 0xe1, 0x4a, 0x27, 0x1e, 0xc7, 0x0e, 0xb1, 0xb1, 0xc7, 0x00, 0x8b, 0x04,
-	0x20, 0xc5, 0x8e, 0xc3, 0x0e, 0x59, 0x39, 0x57, 0x28, 0x8c, 0x7f, 0x91, 0x83, 0xfa, 0x81, 0x19,
-	0x44, 0x0e, 0x4e, 0x85, 0xe8, 0xf4, 0xfb, 0x90, 0x8f, 0xce, 0x17, 0xb6, 0x8c, 0xd2, 0xaf, 0x26,
-	0x31, 0x8b, 0xa0, 0x21, 0xdf, 0x42, 0x04, 0xec, 0x5b, 0xa8, 0x2f, 0x62, 0xf0, 0x98, 0x6c, 0x9e,
-	0x18, 0xd8, 0x8b, 0x2c, 0x34, 0x5e, 0xb5, 0x85, 0x5a, 0x64, 0xbf, 0x84, 0x6b, 0x69, 0x5e, 0x3b,
-	0x0c, 0x57, 0xb6, 0x46, 0x1d, 0xe8, 0xab, 0x29, 0x46, 0x41, 0xc6, 0x5a, 0x70, 0x65, 0xc5, 0x3e,
-	0xf5, 0xdd, 0xe5, 0xdc, 0x0b, 0x65, 0x10, 0x75, 0xe3, 0x42, 0xeb, 0x2d, 0x81, 0xe5, 0xfa, 0xe2,
-	0x02, 0x84, 0x19, 0x50, 0x4d, 0x60, 0xfd, 0xe5, 0x9c, 0x16, 0x40, 0x9e, 0xa7, 0x60, 0xec, 0x33,
-	0x80, 0xa4, 0x1c, 0x36, 0x8a, 0x34, 0x9e, 0x17, 0xfb, 0xd7, 0x8d, 0xec, 0x39, 0x57, 0xc8, 0xd0,
-	0x9f, 0x99, 0xee, 0x91, 0x1f, 0x38, 0xd1, 0xf1, 0x9c, 0x6c, 0x43, 0x8e, 0xaf, 0x00, 0x64, 0x82,
-	0xc2, 0x71, 0xb8, 0x9c, 0x8c, 0x13, 0x16, 0xb2, 0x13, 0x1a, 0xaf, 0x3b, 0xe1, 0x70, 0x39, 0x49,
-	0xea, 0x45, 0x57, 0xb1, 0xea, 0xe5, 0x3c, 0x3c, 0x22, 0x1f, 0x5b, 0x56, 0x24, 0xdc, 0x0f, 0x8f,
-	0x8c, 0xdf, 0x40, 0x2d, 0x35, 0xd2, 0xaf, 0x74, 0x40, 0xb7, 0x40, 0xc3, 0xff, 0xe8, 0x7e, 0xa4,
-	0x32, 0x95, 0xb0, 0x3c, 0x8c, 0x02, 0xc3, 0x06, 0xfd, 0xe2, 0xb8, 0xb1, 0x07, 0x94, 0x6c, 0xd2,
-	0x00, 0x5f, 0x5e, 0x05, 0x31, 0x8a, 0x7d, 0xb8, 0x6e, 0x42, 0xb2, 0x64, 0x91, 0x2f, 0x0d, 0xbc,
-	0xf1, 0xdf, 0x33, 0x8a, 0xcc, 0x38, 0x7a, 0xec, 0x5d, 0x55, 0x95, 0x94, 0x85, 0xbb, 0xea, 0x3f,
-	0xd9, 0xe4, 0x0f, 0x40, 0xf7, 0x03, 0xcb, 0xf1, 0x4c, 0x4a, 0x7e, 0xc5, 0xd0, 0x61, 0x17, 0x6a,
-	0x7c, 0x53, 0xc2, 0x0f, 0x24, 0x98, 0x6d, 0x41, 0xc5, 0xb2, 0xc3, 0x69, 0xe0, 0xac, 0x7c, 0x58,
-	0x99, 0xab, 0x20, 0xd5, 0x7e, 0xe7, 0xd3, 0xf6, 0xfb, 0x7d, 0x28, 0xbb, 0x76, 0x18, 0x8e, 0xa3,
-	0x63, 0xd3, 0x23, 0x7f, 0x95, 0xee, 0xb4, 0x86, 0xc8, 0xd1, 0xb1, 0xe9, 0x21, 0xa1, 0xe3, 0x8d,
-	0x69, 0x29, 0xc6, 0xca, 0x91, 0x22, 0x74, 0x3c, 0x0a, 0x55, 0x43, 0xe3, 0x6d, 0x28, 0x3d, 0x75,
-	0xec, 0x53, 0x69, 0x99, 0x5e, 0x38, 0xf6, 0x69, 0x6c, 0x99, 0xf0, 0xdb, 0xf8, 0xc7, 0x1a, 0x68,
-	0xe4, 0x79, 0xda, 0x2f, 0xdf, 0x32, 0xf8, 0x73, 0x42, 0xc7, 0x2d, 0xe9, 0x2f, 0xf2, 0x6b, 0x02,
-	0x56, 0xe1, 0x3d, 0xde, 0x06, 0xe1, 0xca, 0xc6, 0xb4, 0xd4, 0x85, 0x07, 0x2c, 0x13, 0x44, 0xa6,
-	0xf5, 0x65, 0x11, 0x56, 0x84, 0x3f, 0xba, 0x32, 0x87, 0x5c, 0x01, 0xd8, 0x63, 0xd0, 0x50, 0x42,
-	0xca, 0x00, 0x4b, 0xea, 0x92, 0xa7, 0x3e, 0xc4, 0x99, 0x05, 0x2f, 0x45, 0x13, 0x17, 0x0b, 0x68,
-	0x51, 0x30, 0x14, 0x90, 0xc1, 0x7e, 0xbc, 0x7c, 0xd4, 0x08, 0x85, 0x13, 0x01, 0x7b, 0x08, 0x25,
-	0xf2, 0xc2, 0x76, 0xd8, 0xa8, 0xaa, 0xa6, 0x2b, 0x0e, 0x11, 0x78, 0x8c, 0x66, 0x1f, 0x40, 0x61,
-	0x76, 0x62, 0x9f, 0x87, 0x8d, 0x9a, 0xba, 0x24, 0x53, 0x9e, 0x87, 0x0b, 0x0a, 0xf6, 0x00, 0xea,
-	0x81, 0x3d, 0x1b, 0xd3, 0x66, 0x00, 0xba, 0xca, 0xb0, 0x51, 0x27, 0x4f, 0x58, 0x0d, 0xec, 0x59,
-	0x0b, 0x81, 0xa3, 0x89, 0x1b, 0xb2, 0xf7, 0xa0, 0x48, 0x3e, 0x20, 0x6c, 0x6c, 0xaa, 0x2d, 0xc7,
-	0x0e, 0x85, 0x4b, 0x2c, 0xdb, 0x86, 0xf2, 0x6a, 0xd9, 0x5e, 0xa7, 0x0e, 0x5d, 0xbb, 0x60, 0x0f,
-	0xc8, 0x8c, 0xf2, 0x15, 0x19, 0xfb, 0x14, 0x40, 0x86, 0xb3, 0xe3, 0xc9, 0x39, 0xed, 0x95, 0x55,
-	0x92, 0x80, 0x5e, 0x71, 0x37, 0x6a, 0xd0, 0xfb, 0x3e, 0x14, 0xd0, 0x4a, 0x87, 0x8d, 0x9b, 0x24,
-	0xcd, 0x95, 0xb4, 0x09, 0xa7, 0xde, 0x11, 0x9e, 0x3d, 0x04, 0x0d, 0x55, 0x68, 0x8c, 0x13, 0xd5,
-	0x50, 0xe3, 0x78, 0xa9, 0x6f, 0xbc, 0x84, 0xe8, 0xe1, 0x8f, 0x2e, 0xfb, 0x18, 0x2a, 0x32, 0xf0,
-	0x24, 0xdd, 0xb8, 0xb5, 0x2e, 0x99, 0x11, 0x04, 0x14, 0x1b, 0x3c, 0x82, 0xbc, 0x65, 0xcf, 0xc2,
-	0xc6, 0x3b, 0x44, 0x77, 0x43, 0x99, 0x60, 0xf4, 0xea, 0x6d, 0x7b, 0x26, 0x3c, 0x01, 0xd2, 0xb0,
-	0x3d, 0xa8, 0xa3, 0x3e, 0x6e, 0x53, 0x2c, 0x89, 0x33, 0xd4, 0xd8, 0x22, 0xae, 0x7b, 0x17, 0xb8,
-	0xfa, 0x92, 0x88, 0xe6, 0xb3, 0xe3, 0x45, 0xc1, 0x39, 0xaf, 0x79, 0x2a, 0x8c, 0x7d, 0x06, 0xf5,
-	0xa9, 0x3f, 0xa7, 0xc5, 0x6d, 0x8f, 0x49, 0x69, 0xee, 0x5d, 0x48, 0x7d, 0x51, 0xce, 0x5a, 0x42,
-	0x73, 0x80, 0x6a, 0x73, 0x1b, 0x34, 0x27, 0xec, 0xf9, 0xd3, 0x13, 0xdb, 0x6a, 0x18, 0x62, 0x7f,
-	0x3d, 0x2e, 0xb3, 0x6f, 0xa0, 0x46, 0x6a, 0x8d, 0x45, 0x94, 0xb8, 0x71, 0x5f, 0x75, 0x6b, 0x23,
-	0x15, 0xc5, 0xd3, 0x94, 0xb7, 0x77, 0x29, 0x91, 0xa0, 0xf5, 0xf0, 0xc5, 0x05, 0xb7, 0x9a, 0xd2,
-	0x63, 0xc5, 0xff, 0xee, 0x6d, 0xa8, 0xde, 0x75, 0xa7, 0x00, 0x39, 0xcb, 0x9e, 0xdd, 0xfe, 0x35,
-	0xb0, 0xcb, 0x3d, 0x7f, 0x95, 0x8f, 0x2f, 0x48, 0x1f, 0xff, 0x6d, 0xf6, 0xeb, 0x8c, 0xf1, 0x0d,
-	0xd4, 0x52, 0x6b, 0x6b, 0x6d, 0x7c, 0x23, 0x22, 0x61, 0x53, 0xec, 0x7b, 0x56, 0xb9, 0x28, 0x18,
-	0xff, 0x36, 0x03, 0x85, 0x61, 0x64, 0x46, 0x21, 0x7b, 0x0b, 0xca, 0x13, 0xd7, 0x9f, 0x9e, 0x8c,
-	0xbd, 0xe5, 0x5c, 0xee, 0x28, 0x6a, 0x04, 0x40, 0x47, 0x47, 0x21, 0x66, 0x18, 0x11, 0x6f, 0x86,
-	0xd3, 0x37, 0x9a, 0x17, 0x7f, 0x19, 0x4d, 0xbd, 0x88, 0xcc, 0x4b, 0x86, 0xcb, 0x12, 0x5a, 0xce,
-	0xc0, 0x3f, 0xa5, 0x0d, 0xb5, 0x3c, 0x21, 0xe2, 0x22, 0xc6, 0x9c, 0xc7, 0x66, 0x78, 0x3c, 0x37,
-	0x17, 0xab, 0xfd, 0xb6, 0x0c, 0xaf, 0x48, 0xd8, 0x10, 0x49, 0xde, 0x02, 0x61, 0x67, 0xc6, 0x58,
-	0x6f, 0x91, 0xf0, 0x1a, 0x01, 0x5a, 0x5e, 0x84, 0x56, 0x3b, 0xb4, 0x5d, 0x7b, 0x1a, 0x39, 0x2f,
-	0x30, 0xd5, 0x2a, 0x09, 0x76, 0x05, 0x64, 0x7c, 0x00, 0x25, 0x54, 0x02, 0x33, 0x32, 0xd1, 0xd1,
-	0x59, 0x66, 0x64, 0xae, 0xdb, 0xcb, 0x44, 0xb8, 0xf1, 0x09, 0x00, 0xf7, 0x4f, 0x43, 0x3b, 0x22,
-	0xea, 0x7b, 0x4a, 0x0e, 0x94, 0x2c, 0x12, 0x59, 0x95, 0x30, 0x8a, 0xc6, 0x7f, 0xce, 0x40, 0x65,
-	0x10, 0x58, 0xb8, 0x00, 0x87, 0x0b, 0x7b, 0xfa, 0x4a, 0x4f, 0x8a, 0x56, 0xd2, 0x77, 0x5d, 0x33,
-	0xf1, 0x43, 0x68, 0x25, 0x63, 0x00, 0xfb, 0x14, 0xf2, 0x33, 0xd7, 0x3c, 0xa2, 0xb1, 0x4b, 0x62,
-	0x63, 0xa5, 0xfa, 0xf8, 0xfb, 0x89, 0x6b, 0x1e, 0x71, 0x22, 0x35, 0xfe, 0x22, 0x69, 0x9f, 0xf6,
-	0xc4, 0xd4, 0x9d, 0xb0, 0x0d, 0xda, 0x5f, 0x1c, 0xb6, 0xf4, 0x0c, 0xd3, 0x20, 0xdf, 0xee, 0x0c,
-	0x5b, 0x22, 0x22, 0xc6, 0xd8, 0x78, 0x38, 0x7e, 0xd2, 0xe5, 0xc3, 0x91, 0x9e, 0xa7, 0x0d, 0x4b,
-	0x02, 0xf4, 0x9a, 0xc3, 0x91, 0xae, 0x31, 0x80, 0xe2, 0x61, 0xbf, 0xfb, 0xdb, 0xc3, 0x8e, 0xae,
-	0x1b, 0xff, 0x20, 0x03, 0xf0, 0xcc, 0xf1, 0x2c, 0xff, 0x94, 0x3a, 0xf7, 0xb1, 0x12, 0xfd, 0xa0,
-	0x59, 0xba, 0x3c, 0x8a, 0x95, 0xc5, 0xca, 0xa2, 0xb1, 0x8f, 0x40, 0xf3, 0x51, 0x34, 0x24, 0xcd,
-	0xaa, 0x36, 0x49, 0xe9, 0x11, 0x2f, 0xf9, 0xa2, 0x80, 0xda, 0xe4, 0xda, 0xa6, 0x25, 0xf7, 0xa1,
-	0xe9, 0x1b, 0xf5, 0x1d, 0x87, 0x43, 0x9c, 0x73, 0xe1, 0xa7, 0xf1, 0xc7, 0x3c, 0x94, 0xbb, 0x5e,
-	0x68, 0x07, 0x51, 0x2b, 0x3a, 0x63, 0xf7, 0x20, 0x17, 0xd8, 0xb3, 0x97, 0x6d, 0x29, 0x22, 0x8e,
-	0x7d, 0x18, 0xeb, 0x8e, 0x65, 0xcf, 0x64, 0xb0, 0x59, 0x4f, 0x9b, 0x18, 0xa9, 0x4b, 0x6d, 0xda,
-	0x6c, 0xd6, 0x31, 0xb9, 0x59, 0x2e, 0x5c, 0x67, 0x8a, 0xa9, 0xb3, 0x63, 0x9d, 0x51, 0x8e, 0x58,
-	0xe0, 0x75, 0xdf, 0x6b, 0xc7, 0xe0, 0xae, 0x75, 0xc6, 0x0e, 0xe0, 0x4a, 0x8a, 0x92, 0x26, 0x5d,
-	0xf8, 0xce, 0x07, 0xb1, 0x03, 0x92, 0x52, 0x3e, 0x1e, 0xac, 0x58, 0x71, 0x90, 0x84, 0x11, 0xdb,
-	0xf4, 0xd3, 0x50, 0x72, 0x64, 0xd6, 0xd9, 0x18, 0xfb, 0x23, 0xe2, 0x87, 0x4b, 0xfd, 0xc1, 0x54,
-	0x57, 0x6e, 0xf2, 0x8b, 0xa4, 0xf7, 0x8c, 0x02, 0x88, 0x02, 0x21, 0x50, 0xa8, 0x5f, 0x52, 0xe4,
-	0x69, 0x7b, 0x11, 0xe1, 0x4a, 0x54, 0xcb, 0xdd, 0x8b, 0xd2, 0x1c, 0x10, 0x45, 0xd7, 0x92, 0xc6,
-	0xb4, 0xbc, 0x88, 0xcb, 0xec, 0x2b, 0xa8, 0xc5, 0x3e, 0x47, 0xec, 0x16, 0x68, 0x6b, 0xdc, 0x0e,
-	0x8d, 0x1a, 0xaf, 0x4e, 0x95, 0xd2, 0xed, 0x3e, 0x5c, 0x5b, 0xd7, 0xc7, 0x35, 0xe6, 0x6a, 0x4b,
-	0x35, 0x57, 0x17, 0xb2, 0xa3, 0xc4, 0x74, 0xdd, 0xfe, 0x8e, 0x12, 0x0c, 0x45, 0xca, 0x3f, 0xcb,
-	0xf0, 0xfd, 0x4d, 0x11, 0xca, 0x22, 0x69, 0x4c, 0xa9, 0x48, 0xee, 0xa5, 0x2a, 0x72, 0x17, 0x72,
-	0x38, 0x5e, 0x59, 0xd5, 0xbb, 0x75, 0xad, 0x9e, 0x13, 0x46, 0x1c, 0x11, 0xec, 0x23, 0xa9, 0x42,
-	0x6d, 0xf4, 0x6d, 0x39, 0xd5, 0xd5, 0x27, 0x2a, 0xb4, 0x22, 0xc0, 0x74, 0x4a, 0x64, 0xb8, 0xe8,
-	0x33, 0xa5, 0x4a, 0x6c, 0x26, 0xa6, 0xe3, 0xc0, 0x0f, 0xf7, 0xcd, 0x45, 0x7c, 0xe8, 0xd5, 0xf2,
-	0xdd, 0x9f, 0x63, 0xde, 0xbf, 0x82, 0x4d, 0xdf, 0x1b, 0x07, 0x76, 0x18, 0x05, 0xce, 0x34, 0xa2,
-	0xaa, 0x4a, 0xeb, 0xab, 0xaa, 0xf9, 0x1e, 0x97, 0x64, 0x58, 0xe3, 0x7b, 0x69, 0x46, 0xac, 0x59,
-	0xa3, 0x9a, 0x15, 0x3a, 0x6c, 0xe0, 0x0b, 0xa8, 0x63, 0x8c, 0x6e, 0x86, 0x53, 0xd3, 0xb2, 0xa9,
-	0xfe, 0xf2, 0xfa, 0xfa, 0xab, 0xbe, 0xd7, 0x12, 0x54, 0x58, 0xfd, 0x76, 0x8a, 0x0d, 0x6b, 0x87,
-	0x35, 0x63, 0xbc, 0xe2, 0xc1, 0xa6, 0x3e, 0x4f, 0xf1, 0xe0, 0xa2, 0xad, 0xac, 0x1d, 0xf1, 0x15,
-	0x17, 0x2e, 0xdc, 0x1d, 0xb8, 0xae, 0x70, 0x29, 0xe3, 0x5f, 0x5d, 0x3f, 0xfe, 0x2c, 0xe1, 0x3e,
-	0x4c, 0x26, 0xe2, 0x63, 0x00, 0xdf, 0x1b, 0x87, 0xb6, 0x18, 0xc0, 0xda, 0xfa, 0x0e, 0x6a, 0xbe,
-	0x37, 0xb4, 0x69, 0xec, 0x1e, 0x25, 0xe4, 0xd8, 0xb1, 0xfa, 0x9a, 0x8e, 0x09, 0xda, 0x2e, 0x69,
-	0x50, 0x4c, 0x8b, 0x1d, 0xda, 0x5c, 0xdb, 0x21, 0x41, 0x8d, 0x9d, 0xf9, 0x96, 0x6c, 0x0b, 0x52,
-	0x2b, 0x1d, 0xd1, 0xd7, 0x77, 0xa4, 0x4e, 0x5c, 0xab, 0x4e, 0x3c, 0x4e, 0x99, 0x80, 0x2b, 0x2f,
-	0xd1, 0xbe, 0x64, 0xcd, 0x1b, 0xff, 0x2d, 0x07, 0x95, 0xa6, 0x67, 0xba, 0xe7, 0xbf, 0xb7, 0xbb,
-	0xde, 0xcc, 0x17, 0x3b, 0x67, 0x8b, 0x65, 0x34, 0x46, 0xf7, 0x2c, 0xb7, 0xbc, 0xcb, 0x04, 0x41,
-	0xbf, 0xc8, 0xde, 0x81, 0x8a, 0xbf, 0x8c, 0x12, 0xbc, 0xd8, 0x04, 0x07, 0x01, 0x22, 0x82, 0x84,
-	0x9f, 0x7c, 0x79, 0x4e, 0xe1, 0x27, 0x4f, 0xbe, 0xe2, 0x4f, 0x42, 0x81, 0x84, 0x9f, 0x08, 0xee,
-	0x43, 0x2d, 0x72, 0xe6, 0xd8, 0x65, 0x2f, 0x5c, 0xce, 0x6d, 0x4b, 0x5c, 0x19, 0x10, 0xa7, 0xd0,
-	0x2d, 0x09, 0xc3, 0x5a, 0xe6, 0xf6, 0xdc, 0x0f, 0xce, 0x45, 0x2d, 0x45, 0x51, 0x8b, 0x00, 0x51,
-	0x2d, 0x1f, 0x01, 0x3b, 0x35, 0x9d, 0x68, 0x9c, 0xae, 0x4a, 0xa4, 0xd5, 0x3a, 0x62, 0x46, 0x6a,
-	0x75, 0x37, 0xa0, 0x68, 0x39, 0xe1, 0x49, 0x77, 0x40, 0x06, 0x2f, 0xc7, 0x65, 0x09, 0xc3, 0x8e,
-	0xf0, 0xb3, 0xee, 0x60, 0x3c, 0x39, 0x97, 0x7b, 0xd5, 0x39, 0xae, 0x21, 0x60, 0xe7, 0x3c, 0xa2,
-	0x5d, 0x41, 0x42, 0x8a, 0xde, 0x4e, 0xfd, 0xa5, 0x27, 0x8e, 0x2f, 0x72, 0xbc, 0x8e, 0xf0, 0x2e,
-	0x82, 0x5b, 0x08, 0x65, 0x8f, 0xe0, 0x0a, 0x51, 0xca, 0x8e, 0x0b, 0xd2, 0x0a, 0x91, 0x6e, 0x22,
-	0x62, 0x40, 0x70, 0x41, 0x7b, 0x07, 0xca, 0x9e, 0x1d, 0x9d, 0xfa, 0x01, 0x4a, 0x53, 0x15, 0xa3,
-	0x97, 0x00, 0x30, 0x68, 0x0d, 0xa7, 0xa6, 0x87, 0xc2, 0xd3, 0xfe, 0x35, 0xca, 0x23, 0xcb, 0xec,
-	0x2e, 0x0e, 0x3c, 0xda, 0x78, 0xc2, 0xd6, 0xc5, 0x90, 0xac, 0x20, 0xc6, 0x7f, 0xac, 0x43, 0xbe,
-	0xef, 0x5b, 0x36, 0xfb, 0x05, 0x94, 0xe9, 0x98, 0xf4, 0xf2, 0x86, 0x0d, 0xa2, 0xe9, 0x0f, 0x45,
-	0xb6, 0x9a, 0x27, 0xbf, 0x5e, 0x7e, 0xb0, 0x7a, 0x0f, 0x0a, 0x21, 0x86, 0x89, 0x72, 0x0b, 0x46,
-	0x1e, 0x64, 0x51, 0xe4, 0xc8, 0x05, 0x86, 0x42, 0x86, 0xc0, 0xc7, 0xd5, 0x33, 0xa6, 0xc3, 0x9b,
-	0xfc, 0x9a, 0x90, 0x41, 0xe0, 0xe9, 0xac, 0xf9, 0x36, 0x68, 0x94, 0x74, 0x05, 0xb6, 0xc8, 0xa2,
-	0x0b, 0x3c, 0x29, 0xa3, 0xe0, 0xcf, 0x7d, 0xc7, 0x13, 0x82, 0x17, 0x2f, 0x09, 0xfe, 0x1b, 0xdf,
-	0xf1, 0x28, 0x2e, 0xd2, 0x90, 0x8a, 0x04, 0xbf, 0x0f, 0x25, 0xdf, 0x13, 0xed, 0x96, 0x2e, 0xb5,
-	0x5b, 0xf4, 0x3d, 0x6a, 0xf2, 0x43, 0xa8, 0xcc, 0x1c, 0x17, 0x9d, 0x1e, 0x11, 0x6a, 0x97, 0x08,
-	0x41, 0xa0, 0x89, 0xf8, 0x5d, 0xd0, 0x8e, 0x02, 0x7f, 0xb9, 0xc0, 0x90, 0xa6, 0x7c, 0x79, 0x6b,
-	0x83, 0x70, 0x3b, 0xe7, 0xd8, 0x6b, 0xfa, 0x74, 0xbc, 0x23, 0x5c, 0xc7, 0xd2, 0xec, 0xa5, 0x7a,
-	0x1d, 0xe3, 0x87, 0x36, 0xd5, 0x6a, 0x1e, 0x1d, 0x8d, 0xe5, 0xe9, 0xd6, 0xa5, 0x5a, 0xcd, 0xa3,
-	0x23, 0x6a, 0x5c, 0x8d, 0xa7, 0xaa, 0xaf, 0x8c, 0xa7, 0x14, 0x3f, 0x14, 0x89, 0xe3, 0x8e, 0xc4,
-	0x12, 0x24, 0xde, 0x31, 0xf1, 0x43, 0xd1, 0x19, 0xfb, 0x10, 0xb4, 0x53, 0xc7, 0x1b, 0x87, 0x0b,
-	0x7b, 0x4a, 0xea, 0x93, 0x9c, 0xc3, 0xad, 0x02, 0x40, 0x5e, 0x3a, 0x75, 0x3c, 0x8a, 0x04, 0xb7,
-	0xa0, 0xe0, 0x3a, 0x73, 0x27, 0xa2, 0xcb, 0x2d, 0x17, 0xfc, 0x38, 0x21, 0x98, 0x01, 0x45, 0x7f,
-	0x36, 0xc3, 0xce, 0xeb, 0x97, 0x48, 0x24, 0x26, 0x1d, 0x9b, 0x5d, 0x79, 0x45, 0x6c, 0xb6, 0x2d,
-	0xb3, 0x32, 0x24, 0x1e, 0xbf, 0xb0, 0xa7, 0x0d, 0xb6, 0xd6, 0x8c, 0x56, 0x62, 0x86, 0xa7, 0xf6,
-	0x14, 0x7d, 0xab, 0x3f, 0x79, 0x4e, 0xf6, 0xfc, 0xea, 0xfa, 0x18, 0xb1, 0xe8, 0x4f, 0x9e, 0xa3,
-	0x35, 0xff, 0x14, 0x2a, 0x01, 0x05, 0xfe, 0x63, 0xca, 0x0f, 0xae, 0xa9, 0x03, 0xb0, 0xca, 0x08,
-	0x38, 0x04, 0xab, 0xec, 0xe0, 0x3e, 0xd4, 0xc4, 0xd1, 0x8a, 0xd8, 0x97, 0x0f, 0x29, 0xb5, 0x2f,
-	0xf3, 0x2a, 0x01, 0xc5, 0x9e, 0x3d, 0x45, 0x03, 0x62, 0xaf, 0x9c, 0x66, 0xe1, 0x86, 0x2a, 0x84,
-	0xd8, 0x14, 0xa7, 0x59, 0xb0, 0xe2, 0x4f, 0xcc, 0x86, 0x26, 0x8e, 0x67, 0xa1, 0xe2, 0x44, 0xe6,
-	0x91, 0xc8, 0xe5, 0x0b, 0xbc, 0x22, 0x61, 0x23, 0xf3, 0x28, 0x64, 0x9f, 0x43, 0xd5, 0x14, 0x16,
-	0x7b, 0xec, 0x78, 0x33, 0x5f, 0xa6, 0xf0, 0x52, 0x15, 0x14, 0x5b, 0xce, 0x2b, 0xa6, 0x62, 0xd8,
-	0xbf, 0x02, 0x16, 0x6f, 0xc0, 0x50, 0xb0, 0x2a, 0xb4, 0xed, 0xd6, 0x25, 0x6d, 0xdb, 0x94, 0x3b,
-	0x30, 0xc9, 0xf5, 0x8f, 0x2d, 0xc0, 0xa0, 0xde, 0x74, 0x5d, 0xdb, 0x75, 0xc2, 0x79, 0xe3, 0x36,
-	0x59, 0x00, 0x15, 0x74, 0x39, 0x6e, 0x7c, 0xeb, 0xf5, 0xe2, 0x46, 0x1c, 0x41, 0xcf, 0x8f, 0xc6,
-	0x53, 0x73, 0x7a, 0x6c, 0x13, 0xe3, 0x1d, 0xca, 0xc4, 0xab, 0x9e, 0x1f, 0xb5, 0x62, 0x18, 0x8e,
-	0xa0, 0x30, 0x63, 0x34, 0x82, 0x6f, 0xab, 0x23, 0x98, 0x04, 0xb5, 0xe8, 0x62, 0xe4, 0xa7, 0xf1,
-	0x9f, 0x72, 0xa0, 0xc5, 0x46, 0x8c, 0x55, 0xa0, 0x74, 0xd8, 0xff, 0xbe, 0x3f, 0x78, 0xd6, 0xd7,
-	0x37, 0x30, 0xd3, 0x79, 0xda, 0xec, 0x1d, 0x76, 0xc6, 0xc3, 0x56, 0xb3, 0x2f, 0xae, 0x6a, 0xd0,
-	0x35, 0x01, 0x51, 0xce, 0xb2, 0x2b, 0x50, 0x7b, 0x72, 0xd8, 0xa7, 0xa3, 0x01, 0x01, 0xca, 0x21,
-	0xa8, 0xf3, 0x3b, 0x91, 0x4e, 0x09, 0x50, 0x1e, 0x41, 0xfb, 0xcd, 0x51, 0x87, 0x77, 0x63, 0x50,
-	0x01, 0x5b, 0x39, 0xe0, 0x83, 0xdf, 0x74, 0x5a, 0x23, 0x1d, 0xd8, 0x75, 0xb8, 0x92, 0xb0, 0xc4,
-	0xd5, 0xe9, 0x15, 0x4c, 0xcc, 0x62, 0x36, 0xfd, 0x1a, 0x56, 0xc2, 0x3b, 0xad, 0x43, 0x3e, 0xec,
-	0x3e, 0xed, 0x8c, 0x5b, 0xa3, 0x8e, 0x7e, 0x1d, 0x53, 0xb4, 0x61, 0xb7, 0xff, 0xbd, 0x7e, 0x83,
-	0xd5, 0xa0, 0x8c, 0x5f, 0xa2, 0xf6, 0x9b, 0x94, 0xc4, 0xed, 0xee, 0xea, 0x77, 0xb1, 0x8a, 0x76,
-	0x77, 0x38, 0xea, 0xf6, 0x5b, 0x23, 0xfd, 0x1d, 0xcc, 0xd3, 0x9e, 0x74, 0x7b, 0xa3, 0x0e, 0xd7,
-	0xb7, 0x90, 0xf7, 0x37, 0x83, 0x6e, 0x5f, 0xbf, 0x47, 0x37, 0x1c, 0x9a, 0xfb, 0x07, 0xbd, 0x8e,
-	0x6e, 0x50, 0x8d, 0x03, 0x3e, 0xd2, 0xef, 0xb3, 0x32, 0x14, 0x0e, 0xfb, 0x28, 0xc7, 0x03, 0xac,
-	0x9c, 0x3e, 0xc7, 0xcd, 0x5e, 0x4f, 0x7f, 0x57, 0xc9, 0xf6, 0xde, 0xc3, 0xef, 0x67, 0xdd, 0x7e,
-	0x7b, 0xf0, 0x4c, 0x7f, 0x1f, 0xc9, 0x76, 0xf8, 0xa0, 0xd9, 0x6e, 0x61, 0x52, 0xf8, 0x10, 0x2b,
-	0x18, 0x1e, 0xf4, 0xba, 0x23, 0xfd, 0x03, 0xa4, 0xda, 0x6d, 0x8e, 0xf6, 0x3a, 0x5c, 0x7f, 0x84,
-	0xdf, 0xcd, 0xe1, 0xb0, 0xc3, 0x47, 0xfa, 0x36, 0x7e, 0x77, 0xfb, 0xf4, 0xfd, 0x19, 0xd5, 0x7a,
-	0xd0, 0x6e, 0x8e, 0x3a, 0xfa, 0xe7, 0xf8, 0xdd, 0xee, 0xf4, 0x3a, 0xa3, 0x8e, 0xfe, 0x05, 0xd6,
-	0x4a, 0xd9, 0xe9, 0x10, 0x87, 0xea, 0x4b, 0x1c, 0x85, 0xa4, 0x48, 0xf2, 0x7c, 0x85, 0x0d, 0xed,
-	0x77, 0xfb, 0x87, 0x43, 0xfd, 0x6b, 0x24, 0xa6, 0x4f, 0xc2, 0x7c, 0x63, 0x3c, 0x07, 0x2d, 0x36,
-	0xf1, 0x48, 0xd5, 0xed, 0xf7, 0x3b, 0x5c, 0xdf, 0xc0, 0x4e, 0xf6, 0x3a, 0x4f, 0x46, 0x7a, 0x06,
-	0x81, 0xbc, 0xbb, 0xbb, 0x37, 0xd2, 0xb3, 0xf8, 0x39, 0x38, 0xc4, 0xa1, 0xc9, 0xd1, 0x20, 0x74,
-	0xf6, 0xbb, 0x7a, 0x1e, 0xbf, 0x9a, 0xfd, 0x51, 0x57, 0x2f, 0xd0, 0x20, 0x75, 0xfb, 0xbb, 0xbd,
-	0x8e, 0x5e, 0x44, 0xe8, 0x7e, 0x93, 0x7f, 0xaf, 0x97, 0x90, 0xa9, 0x79, 0x70, 0xd0, 0xfb, 0x41,
-	0xd7, 0x8c, 0x87, 0x50, 0x6a, 0x1e, 0x1d, 0xed, 0xa3, 0xbb, 0xd4, 0x20, 0xff, 0xe4, 0xb0, 0xd7,
-	0x13, 0xb7, 0x7c, 0x76, 0x06, 0xa3, 0xd1, 0x60, 0x5f, 0xcf, 0xe0, 0x9c, 0x8c, 0x06, 0x07, 0x7a,
-	0xd6, 0xb8, 0x03, 0x45, 0x11, 0xed, 0x51, 0xfe, 0x1a, 0x5f, 0x93, 0xca, 0xc9, 0xab, 0x51, 0x3e,
-	0x94, 0x93, 0xa8, 0x8b, 0x3d, 0x82, 0xdc, 0xdc, 0x5c, 0xc8, 0x4c, 0xa4, 0x71, 0x21, 0x26, 0x7b,
-	0xbc, 0x6f, 0x2e, 0x44, 0x42, 0x86, 0x44, 0xb7, 0xbf, 0x04, 0x2d, 0x06, 0xfc, 0x59, 0xb9, 0xcf,
-	0x5f, 0xe5, 0xa1, 0xdc, 0x56, 0x8c, 0xc9, 0x2b, 0x73, 0x1f, 0x25, 0xfb, 0xc8, 0xbe, 0x76, 0xf6,
-	0x91, 0x7b, 0x55, 0xf6, 0x91, 0x7f, 0xd3, 0xec, 0xa3, 0xf0, 0x7a, 0xd9, 0x47, 0xf1, 0x75, 0xb2,
-	0x8f, 0x07, 0x97, 0xb2, 0x8f, 0x12, 0xd5, 0x9e, 0xce, 0x37, 0xd2, 0x51, 0xbf, 0xf6, 0xaa, 0xa8,
-	0x3f, 0x1d, 0xc9, 0x97, 0x5f, 0x11, 0xc9, 0xa7, 0x73, 0x04, 0xf8, 0xc9, 0x1c, 0x61, 0x6d, 0xd4,
-	0x5f, 0x79, 0xbd, 0xa8, 0xff, 0x1e, 0x54, 0xa7, 0xa6, 0x37, 0x8e, 0x82, 0xa5, 0x87, 0x19, 0xb8,
-	0xbc, 0xf4, 0x50, 0xc1, 0xd8, 0x50, 0x82, 0x8c, 0xbf, 0xc9, 0x42, 0xe1, 0xb7, 0x4b, 0x3b, 0x38,
-	0x67, 0x5f, 0x42, 0x39, 0x8c, 0xe6, 0x91, 0x1a, 0x00, 0xde, 0x12, 0x0d, 0x10, 0x9e, 0xe2, 0x37,
-	0x7b, 0x6e, 0x7b, 0x91, 0x08, 0x03, 0x91, 0x96, 0x6c, 0xe9, 0x35, 0x8c, 0xf6, 0xec, 0x85, 0x38,
-	0xa3, 0x29, 0x70, 0x51, 0xc0, 0x48, 0x00, 0xa3, 0xc1, 0x38, 0x31, 0x86, 0x55, 0x44, 0xc6, 0x05,
-	0x02, 0x23, 0x01, 0xda, 0x56, 0x0c, 0xd7, 0x04, 0x7f, 0x12, 0x83, 0x71, 0xdf, 0xb1, 0x6d, 0xa2,
-	0x8b, 0x8b, 0x4f, 0xfb, 0x93, 0x32, 0x6b, 0x40, 0xc9, 0xf5, 0x4d, 0x6b, 0x64, 0x1e, 0xc5, 0xf7,
-	0x51, 0x64, 0xd1, 0x78, 0x06, 0xb5, 0x94, 0xb0, 0x69, 0x73, 0x8f, 0xab, 0xbc, 0xd3, 0x43, 0x4b,
-	0x93, 0x51, 0x8c, 0x53, 0x56, 0x31, 0x48, 0x39, 0xc5, 0x50, 0xe5, 0xc9, 0xf4, 0x74, 0xf8, 0x6e,
-	0x47, 0x2f, 0x18, 0xff, 0x34, 0x0b, 0x57, 0x46, 0x81, 0xe9, 0x85, 0xa6, 0x38, 0x83, 0xf2, 0xa2,
-	0xc0, 0x77, 0xd9, 0xb7, 0xa0, 0x45, 0x53, 0x57, 0x1d, 0xb7, 0x77, 0xe4, 0xcc, 0x5f, 0x24, 0x7d,
-	0x3c, 0x9a, 0xba, 0x34, 0x7a, 0xa5, 0x48, 0x7c, 0xb0, 0x8f, 0xa1, 0x30, 0xb1, 0x8f, 0x1c, 0x4f,
-	0x6e, 0x7c, 0x5c, 0xbf, 0xc8, 0xb8, 0x83, 0xc8, 0xbd, 0x0d, 0x2e, 0xa8, 0xd8, 0x2f, 0xa0, 0x38,
-	0xf5, 0xe7, 0x18, 0x60, 0xe5, 0xd4, 0x13, 0x4a, 0xb5, 0x21, 0xc4, 0xee, 0x6d, 0x70, 0x49, 0xc7,
-	0xbe, 0x04, 0x2d, 0xf0, 0x5d, 0x77, 0x62, 0x4e, 0x4f, 0xe4, 0xa9, 0x66, 0xe3, 0x22, 0x0f, 0x97,
-	0xf8, 0xbd, 0x0d, 0x9e, 0xd0, 0x1a, 0x8f, 0xa1, 0x24, 0x85, 0xc5, 0x01, 0xd8, 0xe9, 0xec, 0x76,
-	0xe5, 0xd8, 0xb5, 0x06, 0xfb, 0xfb, 0xdd, 0x91, 0x38, 0x51, 0xe7, 0x83, 0x5e, 0x6f, 0xa7, 0xd9,
-	0xfa, 0x5e, 0xcf, 0xee, 0x68, 0x50, 0x34, 0x69, 0x3f, 0xd9, 0xf8, 0x7b, 0x19, 0xd8, 0xbc, 0xd0,
-	0x01, 0xf6, 0x35, 0xe4, 0xe7, 0xbe, 0x15, 0x0f, 0xcf, 0x83, 0xb5, 0xbd, 0x54, 0xca, 0x68, 0x61,
-	0x39, 0x71, 0x18, 0xdf, 0x40, 0x3d, 0x0d, 0x57, 0xae, 0xf7, 0xd5, 0xa0, 0xcc, 0x3b, 0xcd, 0xf6,
-	0x78, 0xd0, 0xef, 0xfd, 0x20, 0xfc, 0x36, 0x15, 0x9f, 0xf1, 0xee, 0xa8, 0xa3, 0x67, 0x8d, 0xbf,
-	0x00, 0xfd, 0xe2, 0xc0, 0xb0, 0x5d, 0xd8, 0x9c, 0xfa, 0xf3, 0x85, 0x6b, 0xd3, 0x5e, 0xa5, 0x32,
-	0x65, 0x77, 0xd7, 0x8c, 0xa4, 0x24, 0xa3, 0x19, 0xab, 0x4f, 0x53, 0x65, 0xe3, 0xef, 0x00, 0xbb,
-	0x3c, 0x82, 0x3f, 0x5f, 0xf5, 0xff, 0x3c, 0x03, 0xf9, 0x03, 0xd7, 0xf4, 0xd8, 0x7d, 0x28, 0xd0,
-	0xd5, 0x39, 0xb9, 0x99, 0x59, 0x51, 0x56, 0x24, 0xaa, 0x05, 0xe1, 0xd8, 0x87, 0x90, 0x8b, 0xa6,
-	0xae, 0xd4, 0xa1, 0x9b, 0x2f, 0x51, 0xbe, 0xbd, 0x0d, 0x8e, 0x54, 0xec, 0x21, 0xe4, 0x2c, 0xcb,
-	0x95, 0x0a, 0x24, 0x0f, 0x9c, 0x30, 0x70, 0x6d, 0xdb, 0x33, 0xc7, 0x73, 0xe4, 0x45, 0x3e, 0x24,
-	0x61, 0xef, 0x42, 0xce, 0x9a, 0xba, 0x52, 0x6d, 0xae, 0xac, 0x28, 0x95, 0x0a, 0xad, 0xa9, 0xbb,
-	0x53, 0x04, 0x7a, 0x06, 0x61, 0x7c, 0x44, 0x17, 0xd5, 0x96, 0x73, 0x0f, 0x97, 0xb6, 0xf8, 0x5a,
-	0xb3, 0x15, 0x2c, 0x31, 0xc6, 0xff, 0xc9, 0x42, 0x45, 0xa9, 0x8c, 0x7d, 0x0e, 0x9a, 0x95, 0x5e,
-	0x45, 0xb7, 0x2e, 0xb5, 0xf8, 0xb8, 0x1d, 0xaf, 0x1f, 0x4b, 0xea, 0xe6, 0x37, 0x50, 0x43, 0xd3,
-	0xf8, 0xc2, 0x0c, 0x1c, 0x34, 0xb3, 0xa1, 0x1c, 0x03, 0x19, 0x63, 0x0e, 0xed, 0xe8, 0x69, 0x8c,
-	0xd9, 0xdb, 0xe0, 0xd5, 0x50, 0x29, 0xb3, 0x0f, 0xa0, 0xb4, 0x08, 0xec, 0x85, 0x19, 0xd8, 0x72,
-	0x2c, 0x6a, 0xf1, 0x29, 0x0c, 0x01, 0xf7, 0x36, 0x78, 0x8c, 0x47, 0x52, 0xfb, 0xcc, 0x9e, 0x2e,
-	0x23, 0x5b, 0x0e, 0x46, 0x2d, 0xee, 0x10, 0x01, 0x91, 0x54, 0xe2, 0xd9, 0x36, 0x06, 0xf6, 0xa6,
-	0xeb, 0xfa, 0x64, 0x70, 0x0b, 0x6a, 0xbe, 0xd0, 0x4e, 0xe0, 0xe2, 0xfe, 0x7b, 0x5c, 0x32, 0x8e,
-	0xa0, 0x24, 0x3b, 0x86, 0xa1, 0xcf, 0xb0, 0x33, 0x1a, 0x3f, 0x6d, 0xf2, 0x2e, 0x86, 0xa0, 0x43,
-	0x7d, 0x03, 0x97, 0xdf, 0x2e, 0x6f, 0xf6, 0xa5, 0xb9, 0xe2, 0x9d, 0xa7, 0x83, 0xef, 0x3b, 0x7a,
-	0x56, 0x6c, 0xdd, 0xf7, 0x7f, 0xd0, 0x73, 0x22, 0xcc, 0xec, 0x1c, 0x34, 0x39, 0x5a, 0xab, 0x0a,
-	0x94, 0x3a, 0xbf, 0xeb, 0xb4, 0x0e, 0x47, 0x1d, 0xbd, 0x80, 0x2b, 0xa2, 0xdd, 0x69, 0xf6, 0x7a,
-	0x83, 0x16, 0x9a, 0xb2, 0xe2, 0x4e, 0x19, 0x4a, 0x53, 0x31, 0x92, 0xc6, 0xbf, 0xac, 0x41, 0x3d,
-	0x3d, 0xeb, 0xec, 0x2b, 0xd0, 0x2c, 0x2b, 0x35, 0x03, 0x77, 0xd6, 0x69, 0xc7, 0xe3, 0xb6, 0x15,
-	0x4f, 0x82, 0xf8, 0xc0, 0x7c, 0x5f, 0xe8, 0x68, 0xf6, 0x92, 0x8e, 0xc6, 0x1a, 0xfa, 0x2b, 0xd8,
-	0x94, 0xd7, 0xce, 0x30, 0x8f, 0x9a, 0x98, 0xa1, 0x9d, 0x56, 0xc0, 0x16, 0x21, 0xdb, 0x12, 0xb7,
-	0xb7, 0xc1, 0xeb, 0xd3, 0x14, 0x84, 0x7d, 0x07, 0x75, 0x93, 0xb2, 0xf1, 0x84, 0x3f, 0xaf, 0x1e,
-	0x9d, 0x35, 0x11, 0xa7, 0xb0, 0xd7, 0x4c, 0x15, 0x80, 0x6a, 0x62, 0x05, 0xfe, 0x62, 0xc5, 0x5c,
-	0x50, 0xd5, 0xa4, 0x1d, 0xf8, 0x0b, 0x85, 0xb7, 0x6a, 0x29, 0x65, 0xf6, 0x25, 0x54, 0xa5, 0xe4,
-	0xab, 0x07, 0x33, 0xc9, 0x6a, 0x10, 0x62, 0x93, 0x87, 0xdf, 0xdb, 0xe0, 0x95, 0xe9, 0xaa, 0xc8,
-	0x3e, 0x83, 0x8a, 0x10, 0x58, 0xb0, 0x95, 0x54, 0x4d, 0x20, 0x69, 0x63, 0x2e, 0x30, 0x93, 0x12,
-	0xfb, 0x05, 0x00, 0xc9, 0xa9, 0xee, 0xb3, 0x6f, 0xae, 0x84, 0x8c, 0x59, 0xca, 0x56, 0x5c, 0x50,
-	0xc4, 0x13, 0xa7, 0xa5, 0xe5, 0xcb, 0xe2, 0xd1, 0x41, 0xe1, 0x4a, 0xbc, 0xf8, 0x74, 0x54, 0x8a,
-	0x27, 0xd8, 0xe0, 0x92, 0x78, 0x31, 0x97, 0x10, 0x4f, 0x30, 0xc5, 0xe2, 0x09, 0x9e, 0xca, 0x45,
-	0xf1, 0x62, 0x16, 0x12, 0x4f, 0x70, 0x7c, 0x07, 0xf5, 0x38, 0xfa, 0x90, 0x9d, 0xaa, 0xa6, 0x4e,
-	0xf9, 0x25, 0x2e, 0xee, 0x58, 0x2d, 0x52, 0x01, 0xc8, 0x1d, 0x1e, 0xfb, 0xa7, 0xca, 0xf2, 0xae,
-	0xa9, 0xdc, 0xc3, 0x63, 0xff, 0x54, 0x5d, 0xdf, 0xb5, 0x50, 0x05, 0xa0, 0xb4, 0xa2, 0x8b, 0x74,
-	0x49, 0xa2, 0xae, 0x4a, 0x4b, 0x3d, 0x7c, 0xea, 0xd8, 0xa7, 0x28, 0xad, 0x19, 0x17, 0x70, 0x50,
-	0xe8, 0x54, 0x33, 0x12, 0x8d, 0x6d, 0xaa, 0x83, 0x42, 0x67, 0xb9, 0x71, 0x4b, 0xe0, 0x26, 0x25,
-	0xd4, 0xad, 0xa5, 0xa7, 0xb2, 0xe9, 0xaa, 0x6e, 0x1d, 0x7a, 0x29, 0xc6, 0xaa, 0x20, 0x95, 0xac,
-	0xab, 0x55, 0x11, 0xda, 0x3f, 0x2e, 0x6d, 0x6f, 0x6a, 0xcb, 0xed, 0x8e, 0xd4, 0xaa, 0x18, 0x4a,
-	0xdc, 0x6a, 0x55, 0xc4, 0x90, 0x44, 0xaf, 0x13, 0x76, 0x76, 0x51, 0xaf, 0x15, 0x66, 0xd2, 0xeb,
-	0x84, 0x35, 0x59, 0x50, 0x09, 0xef, 0xd5, 0x4b, 0x0b, 0x4a, 0x61, 0x16, 0x0b, 0x2a, 0x06, 0x18,
-	0xff, 0x2b, 0x0f, 0x25, 0x69, 0x07, 0xd8, 0x55, 0xd8, 0x6c, 0xf1, 0x4e, 0x73, 0xd4, 0x19, 0xb7,
-	0x9b, 0xa3, 0xe6, 0x4e, 0x73, 0x88, 0xbe, 0x99, 0x41, 0xbd, 0x89, 0x59, 0xe8, 0x0a, 0x96, 0x41,
-	0xe3, 0xd6, 0xe6, 0x83, 0x83, 0x15, 0x28, 0xcb, 0x74, 0xa8, 0x4a, 0x5e, 0x71, 0x33, 0x3f, 0xc7,
-	0x36, 0xa1, 0x22, 0x18, 0x05, 0x80, 0x0e, 0x22, 0x89, 0x4b, 0x94, 0x0b, 0x0a, 0x4b, 0xb7, 0xdf,
-	0xee, 0xfc, 0x4e, 0x2f, 0xae, 0x58, 0x04, 0xa0, 0x94, 0xb0, 0x88, 0xb2, 0x86, 0xc2, 0x8c, 0xf8,
-	0x61, 0xbf, 0xb5, 0x6a, 0xa7, 0x8c, 0x4c, 0xb2, 0x9a, 0xa7, 0xdd, 0xce, 0x33, 0x1d, 0x90, 0x49,
-	0xd4, 0x42, 0xe5, 0x0a, 0x46, 0x17, 0x54, 0x09, 0x15, 0xab, 0xec, 0x26, 0x5c, 0x1d, 0xee, 0x0d,
-	0x9e, 0x8d, 0x05, 0x53, 0xd2, 0x85, 0x1a, 0xbb, 0x06, 0xba, 0x82, 0x10, 0xd5, 0xd7, 0xb1, 0x49,
-	0x82, 0xc6, 0x84, 0x43, 0x7d, 0x93, 0x6e, 0x1d, 0x22, 0x6c, 0x24, 0x4c, 0xbb, 0x8e, 0x5d, 0x11,
-	0xac, 0x83, 0xde, 0xe1, 0x7e, 0x7f, 0xa8, 0x5f, 0x41, 0x21, 0x08, 0x22, 0x24, 0x67, 0x49, 0x35,
-	0x2b, 0x87, 0x70, 0x95, 0x7c, 0x04, 0xc2, 0x9e, 0x35, 0x79, 0xbf, 0xdb, 0xdf, 0x1d, 0xea, 0xd7,
-	0x92, 0x9a, 0x3b, 0x9c, 0x0f, 0xf8, 0x50, 0xbf, 0x9e, 0x00, 0x86, 0xa3, 0xe6, 0xe8, 0x70, 0xa8,
-	0xdf, 0x48, 0xa4, 0x3c, 0xe0, 0x83, 0x56, 0x67, 0x38, 0xec, 0x75, 0x87, 0x23, 0xfd, 0x26, 0xbb,
-	0x0e, 0x57, 0x56, 0x12, 0xc5, 0xc4, 0x0d, 0x45, 0x50, 0xbe, 0xdb, 0x19, 0xe9, 0xb7, 0x12, 0x31,
-	0x5a, 0x83, 0x5e, 0xaf, 0x49, 0x3b, 0x17, 0xb7, 0x91, 0xa8, 0x37, 0x68, 0x7d, 0x1f, 0xf7, 0xe6,
-	0x2d, 0x94, 0xeb, 0xb0, 0xaf, 0x82, 0xee, 0x28, 0xaa, 0x31, 0xec, 0xfc, 0xf6, 0xb0, 0xd3, 0x6f,
-	0x75, 0xf4, 0xb7, 0x57, 0xaa, 0x91, 0xc0, 0xee, 0x26, 0xaa, 0x91, 0x80, 0xde, 0x49, 0xda, 0x8c,
-	0x41, 0x43, 0x7d, 0x6b, 0xa7, 0x4a, 0x6f, 0xc9, 0xa4, 0x23, 0x32, 0x0e, 0xa0, 0x9e, 0xf6, 0x1b,
-	0xcc, 0x80, 0x9a, 0x33, 0x1b, 0x7b, 0x7e, 0x24, 0xae, 0xda, 0x86, 0xf2, 0x62, 0x73, 0xc5, 0x99,
-	0xf5, 0xfd, 0x88, 0xee, 0xda, 0x52, 0x4e, 0x91, 0xb8, 0x01, 0x71, 0xd2, 0x9e, 0x94, 0x8d, 0x3d,
-	0xa8, 0xa5, 0x3c, 0x09, 0x7b, 0x0b, 0xca, 0xce, 0x2c, 0x5d, 0x99, 0xe6, 0xcc, 0x5e, 0xa3, 0xa6,
-	0x5d, 0xa8, 0xaa, 0x6e, 0xe5, 0xcd, 0x2b, 0x7a, 0x07, 0xca, 0x4f, 0x4e, 0xe2, 0xab, 0xcf, 0xea,
-	0xed, 0xeb, 0xb2, 0xbc, 0x6a, 0xf0, 0xd7, 0x59, 0xa8, 0x28, 0x7e, 0xe8, 0xb5, 0xc6, 0xe0, 0x0e,
-	0x94, 0x23, 0x7b, 0xbe, 0xf0, 0x03, 0x53, 0x7a, 0x6d, 0x8d, 0xaf, 0x00, 0x29, 0x71, 0x72, 0x69,
-	0x71, 0xd2, 0x7b, 0xb3, 0xf9, 0x57, 0xec, 0xcd, 0x7e, 0x0a, 0x55, 0xe5, 0x8a, 0x74, 0x28, 0x0f,
-	0x32, 0x2f, 0x6d, 0xcd, 0xae, 0xae, 0x4b, 0x87, 0xec, 0x3a, 0x14, 0x67, 0x27, 0x63, 0x6b, 0x22,
-	0x2e, 0xc1, 0x95, 0x79, 0x61, 0x76, 0xd2, 0x9e, 0xd0, 0x85, 0x93, 0x59, 0x62, 0x60, 0x4b, 0x22,
-	0x13, 0x9c, 0xc5, 0x66, 0xf4, 0x21, 0x94, 0x66, 0x27, 0xe2, 0x2a, 0x52, 0x2a, 0x51, 0x4f, 0xc6,
-	0x8d, 0x17, 0x67, 0x27, 0xf4, 0xac, 0xe2, 0x1f, 0x65, 0xa0, 0xbe, 0x72, 0xbe, 0x38, 0x41, 0xec,
-	0x91, 0xfa, 0x90, 0xac, 0x71, 0xd1, 0x3f, 0x23, 0xc9, 0xe3, 0xd1, 0xf9, 0x42, 0xbc, 0x81, 0x58,
-	0x77, 0x45, 0x77, 0x17, 0x72, 0xa3, 0xf3, 0x85, 0xc8, 0x8c, 0x70, 0x15, 0x8b, 0x88, 0x4d, 0xac,
-	0x5f, 0xda, 0x10, 0xfa, 0xbe, 0xf3, 0x83, 0xb8, 0x5f, 0x71, 0xc0, 0xbb, 0xfb, 0x4d, 0xfe, 0xc3,
-	0x18, 0x01, 0x64, 0xe7, 0x9e, 0x0c, 0x78, 0xa7, 0xbb, 0xdb, 0x27, 0x40, 0x9e, 0xf2, 0xa6, 0x55,
-	0xc3, 0x4d, 0xcb, 0x7a, 0x72, 0xa2, 0x3e, 0xbf, 0xca, 0xa4, 0x9e, 0x5f, 0x25, 0x97, 0xee, 0xd4,
-	0x9b, 0xec, 0x51, 0x72, 0xe3, 0x3c, 0xd6, 0x93, 0xdc, 0x4a, 0x4f, 0xd8, 0xfb, 0x90, 0x9f, 0x9d,
-	0xd8, 0xe7, 0xe9, 0xb8, 0x29, 0x7d, 0xcd, 0x8d, 0x08, 0x30, 0xcb, 0x85, 0x95, 0x20, 0x29, 0x6d,
-	0xc8, 0xfc, 0x94, 0x36, 0xbc, 0xc6, 0x2d, 0x0a, 0x27, 0x1c, 0xa7, 0xb7, 0x84, 0x73, 0xf1, 0x6d,
-	0x55, 0x75, 0x3b, 0x98, 0x7d, 0x0a, 0x25, 0x91, 0x4c, 0xc6, 0x7b, 0x03, 0x37, 0x2f, 0xce, 0xcb,
-	0x63, 0x79, 0x11, 0x3c, 0xa6, 0xbb, 0xed, 0x41, 0x51, 0x5e, 0x02, 0x7f, 0x04, 0x79, 0x74, 0x74,
-	0x32, 0x61, 0xba, 0xb6, 0x6e, 0x46, 0xe9, 0xb1, 0x2f, 0x4e, 0xfe, 0x63, 0x28, 0x9a, 0x96, 0x35,
-	0x9e, 0x9d, 0xa4, 0xf3, 0xef, 0x0b, 0xd3, 0x80, 0x89, 0x96, 0x89, 0x1f, 0x4a, 0x96, 0xfb, 0xbf,
-	0x33, 0x50, 0x4e, 0x42, 0xb2, 0x37, 0x5e, 0xdd, 0xab, 0x77, 0xd8, 0x39, 0xe5, 0x1d, 0x36, 0x7b,
-	0x04, 0x57, 0x2e, 0x3e, 0x2d, 0x10, 0x23, 0x51, 0xe6, 0x9b, 0xe9, 0xb7, 0x05, 0xe1, 0xe5, 0x5d,
-	0xf6, 0xc2, 0x6b, 0xee, 0xb2, 0xdf, 0x02, 0x31, 0x35, 0x63, 0xc7, 0xa2, 0xa0, 0x36, 0xcf, 0x4b,
-	0x54, 0xee, 0x5a, 0x17, 0x1f, 0x04, 0x94, 0xe8, 0x92, 0xa3, 0xf2, 0x20, 0xc0, 0xf8, 0x11, 0xca,
-	0x49, 0x08, 0xf5, 0xe6, 0x9d, 0xff, 0x73, 0x6c, 0x89, 0xf1, 0x97, 0xb1, 0xb1, 0x4f, 0x22, 0x98,
-	0xff, 0x4f, 0x63, 0x9f, 0x6e, 0x3e, 0xf7, 0x8a, 0xe6, 0xcf, 0x84, 0x3d, 0x4f, 0x1a, 0xff, 0x99,
-	0x67, 0x5c, 0x9d, 0x8c, 0x7c, 0x6a, 0x32, 0x8c, 0x4d, 0xe9, 0x93, 0x92, 0xd8, 0xeb, 0x5f, 0x65,
-	0x62, 0x83, 0x2f, 0x62, 0xec, 0x9f, 0x5a, 0xa0, 0x49, 0x6b, 0x59, 0xb5, 0xb5, 0xaf, 0xa0, 0x21,
-	0xef, 0x6f, 0x8a, 0x46, 0xe5, 0x2b, 0xa8, 0x31, 0x9a, 0x07, 0x21, 0xd6, 0x75, 0x81, 0xa7, 0x81,
-	0x58, 0x5d, 0xaf, 0x65, 0xef, 0x43, 0x41, 0xc4, 0xfe, 0xf9, 0x97, 0x64, 0x41, 0x5c, 0xe0, 0x2f,
-	0x3e, 0x79, 0x29, 0x5c, 0x7c, 0xf2, 0x62, 0x18, 0xd2, 0xc6, 0x88, 0x2e, 0x5c, 0x8b, 0xeb, 0x8d,
-	0x9f, 0xeb, 0x60, 0x01, 0x2d, 0x62, 0x39, 0xc9, 0x2b, 0xde, 0xa0, 0x9b, 0xe9, 0xe7, 0x3e, 0xb9,
-	0x8b, 0xcf, 0x7d, 0xd6, 0x3d, 0xe0, 0xc9, 0xaf, 0x7b, 0xc0, 0x63, 0xfc, 0x29, 0x03, 0xb5, 0x54,
-	0xaa, 0xf2, 0x06, 0xc2, 0xac, 0x5d, 0xd3, 0xb9, 0xd7, 0x5c, 0xd3, 0xf9, 0x37, 0x58, 0xd3, 0x85,
-	0x9f, 0x5c, 0xd3, 0xc5, 0x4b, 0x6b, 0xfa, 0x1f, 0x66, 0x92, 0x27, 0x2b, 0xa2, 0xb2, 0x75, 0xf6,
-	0x3a, 0xb3, 0xd6, 0x5e, 0xdf, 0x05, 0x30, 0xa7, 0x74, 0x81, 0xa1, 0xdb, 0x16, 0xfb, 0xc0, 0x35,
-	0xae, 0x40, 0xd8, 0x37, 0x70, 0x4b, 0xec, 0xfa, 0x88, 0xcc, 0x71, 0xec, 0xcf, 0xc6, 0x31, 0x36,
-	0xbe, 0xd8, 0x77, 0x43, 0x10, 0x88, 0x87, 0x4d, 0xb3, 0x66, 0x8c, 0x35, 0xba, 0x50, 0x4b, 0xa5,
-	0x79, 0xca, 0xdb, 0xfb, 0x8c, 0xfa, 0xf6, 0x9e, 0x6d, 0x41, 0xe1, 0xf4, 0xd8, 0x0e, 0xec, 0x35,
-	0x6f, 0x84, 0x05, 0xc2, 0xf8, 0x0e, 0xaa, 0xea, 0x86, 0x10, 0xfb, 0x08, 0x0a, 0x4e, 0x64, 0xcf,
-	0xe3, 0x7b, 0x9c, 0x37, 0x2e, 0xef, 0x19, 0xd1, 0x73, 0x0c, 0x41, 0x64, 0xfc, 0x31, 0x03, 0xfa,
-	0x45, 0x9c, 0xf2, 0x03, 0x01, 0x99, 0x97, 0xfc, 0x40, 0x40, 0x36, 0x25, 0xe4, 0x9a, 0x47, 0xfe,
-	0xab, 0xbb, 0x6f, 0xf9, 0x97, 0xdc, 0x7d, 0x63, 0xef, 0x81, 0x16, 0xd8, 0xf4, 0x28, 0xdb, 0x4a,
-	0xbf, 0xce, 0x14, 0x4f, 0x03, 0x62, 0x9c, 0xf1, 0xf7, 0x33, 0x50, 0x92, 0xbb, 0x57, 0x6b, 0x6f,
-	0xf5, 0x7e, 0x00, 0x25, 0xf1, 0x40, 0x3b, 0x7c, 0xd9, 0xa1, 0x4e, 0x8c, 0x67, 0x77, 0xc5, 0x9e,
-	0x5e, 0xfa, 0x11, 0xcd, 0x81, 0x6b, 0x7a, 0x9c, 0xe0, 0xa8, 0x4d, 0xb4, 0x45, 0x4f, 0xbb, 0x45,
-	0xc2, 0x37, 0x15, 0xe8, 0xe1, 0x8a, 0x39, 0xc7, 0x9c, 0x30, 0x34, 0x7e, 0x09, 0x25, 0xb9, 0x3b,
-	0xb6, 0x56, 0x94, 0x57, 0x3d, 0xe8, 0xde, 0x02, 0x58, 0x6d, 0x97, 0xad, 0xab, 0xc1, 0x70, 0xe5,
-	0x3d, 0x66, 0x4c, 0xaf, 0xe9, 0x24, 0xfb, 0x13, 0xd0, 0xdc, 0xf8, 0x66, 0x76, 0xe6, 0xe5, 0x37,
-	0xb3, 0x13, 0x22, 0xf6, 0x08, 0x12, 0xf3, 0xfe, 0xaa, 0xd8, 0xc5, 0x68, 0x02, 0xac, 0xf2, 0x78,
-	0xf6, 0x99, 0x8c, 0xbe, 0x10, 0x14, 0xab, 0xcf, 0xc5, 0xc6, 0xe8, 0xa8, 0x5d, 0x21, 0x33, 0xea,
-	0x50, 0x55, 0x37, 0x03, 0x1e, 0xdd, 0x83, 0xaa, 0xfa, 0x06, 0x97, 0xf6, 0xb5, 0x7d, 0xcf, 0x16,
-	0xd7, 0x73, 0x7b, 0xbf, 0xff, 0x5c, 0xcf, 0x3c, 0xfa, 0x4b, 0xe5, 0x71, 0x0b, 0xd1, 0xc8, 0x70,
-	0x92, 0xce, 0xac, 0x7b, 0xdd, 0x7e, 0xa7, 0xc9, 0x29, 0x78, 0xa4, 0x8b, 0xbc, 0x7b, 0xcd, 0xe1,
-	0x9e, 0x08, 0x34, 0x25, 0x86, 0x00, 0x39, 0x3a, 0xff, 0x6c, 0xf6, 0x77, 0x3b, 0xe2, 0x8c, 0x9a,
-	0x3e, 0x93, 0x84, 0xb3, 0x40, 0xe7, 0xa4, 0x98, 0x0b, 0x16, 0x31, 0x19, 0xc5, 0xaf, 0x04, 0x57,
-	0x7a, 0xf4, 0x6b, 0x68, 0xbc, 0x6c, 0xc3, 0x1a, 0x6b, 0x6d, 0xed, 0x35, 0xe9, 0x50, 0xa0, 0x0a,
-	0x5a, 0x7f, 0x30, 0x16, 0xa5, 0x0c, 0xab, 0x40, 0x89, 0x77, 0x7a, 0x1d, 0x4a, 0xef, 0x1f, 0xfd,
-	0x21, 0xa3, 0xcc, 0x52, 0xbc, 0xc1, 0x99, 0x00, 0x64, 0x77, 0x55, 0x10, 0xb7, 0x4d, 0x4b, 0xcf,
-	0xb0, 0x1b, 0xc0, 0x52, 0xa0, 0x9e, 0x3f, 0x35, 0x5d, 0x3d, 0x4b, 0x89, 0x7c, 0x0c, 0x7f, 0x16,
-	0x38, 0x91, 0xad, 0xe7, 0xd8, 0xdb, 0x70, 0x2b, 0x81, 0xf5, 0xfc, 0xd3, 0x83, 0xc0, 0xf1, 0x03,
-	0x27, 0x3a, 0x17, 0xe8, 0xfc, 0xce, 0xaf, 0xfe, 0xf5, 0x9f, 0xee, 0x66, 0xfe, 0xdd, 0x9f, 0xee,
-	0x66, 0xfe, 0xcb, 0x9f, 0xee, 0x6e, 0xfc, 0xf1, 0xbf, 0xde, 0xcd, 0xfc, 0x6d, 0xf5, 0xe7, 0x7a,
-	0xe6, 0x66, 0x14, 0x38, 0x67, 0xc2, 0xd9, 0xc5, 0x05, 0xcf, 0xfe, 0x64, 0x71, 0x72, 0xf4, 0xc9,
-	0x62, 0xf2, 0x09, 0xce, 0xe8, 0xa4, 0x48, 0xbf, 0xda, 0xf3, 0xd9, 0xff, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0x0f, 0x96, 0x5e, 0x53, 0xf8, 0x47, 0x00, 0x00,
+	// 7717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7c, 0x4b, 0x8c, 0x1b, 0x57,
+	0xb6, 0x98, 0xf8, 0x2f, 0x1e, 0x7e, 0xba, 0x74, 0x2d, 0x4b, 0x94, 0x2c, 0xcb, 0xad, 0xb2, 0x6c,
+	0xcb, 0xb2, 0x2d, 0x8f, 0x5a, 0xfe, 0xbf, 0x19, 0xcc, 0xb0, 0xd9, 0x54, 0x8b, 0x63, 0x8a, 0xec,
+	0xb9, 0x64, 0x4b, 0xe3, 0xf7, 0x10, 0x10, 0x45, 0x56, 0xb1, 0xbb, 0xdc, 0xc5, 0x2a, 0xba, 0xaa,
+	0xa8, 0xee, 0x1e, 0xe0, 0x01, 0x93, 0xcd, 0x03, 0x5e, 0xb6, 0x59, 0x04, 0xd9, 0x24, 0x83, 0xac,
+	0xf2, 0x1e, 0xb2, 0x09, 0x10, 0x20, 0xcb, 0x20, 0x59, 0x25, 0x40, 0x16, 0x09, 0x82, 0xb7, 0x4a,
+	0x16, 0xc9, 0x04, 0xc9, 0x36, 0x08, 0x92, 0x5d, 0xb2, 0x08, 0xce, 0xb9, 0xb7, 0xaa, 0x6e, 0x35,
+	0x29, 0x4b, 0x76, 0xbc, 0xe9, 0xae, 0x7b, 0xce, 0xb9, 0xff, 0x73, 0xcf, 0xef, 0x9e, 0x4b, 0x80,
+	0xa5, 0x6b, 0x7a, 0xf7, 0x97, 0x81, 0x1f, 0xf9, 0xac, 0x88, 0xdf, 0x37, 0x3e, 0x3a, 0x72, 0xa2,
+	0xe3, 0xd5, 0xf4, 0xfe, 0xcc, 0x5f, 0x7c, 0x7c, 0xe4, 0x1f, 0xf9, 0x1f, 0x13, 0x72, 0xba, 0x9a,
+	0x53, 0x89, 0x0a, 0xf4, 0x25, 0x2a, 0xdd, 0xd8, 0x8a, 0x9c, 0x85, 0x1d, 0x46, 0xe6, 0x62, 0x29,
+	0x00, 0xc6, 0x3f, 0xcf, 0x41, 0x71, 0x7c, 0xbe, 0xb4, 0x59, 0x13, 0xf2, 0x8e, 0xd5, 0xca, 0x6d,
+	0xe7, 0xee, 0x96, 0x78, 0xde, 0xb1, 0xd8, 0x36, 0xd4, 0x3c, 0x3f, 0x1a, 0xac, 0x5c, 0xd7, 0x9c,
+	0xba, 0x76, 0x2b, 0xbf, 0x9d, 0xbb, 0xab, 0x71, 0x15, 0xc4, 0xde, 0x80, 0xaa, 0xb9, 0x8a, 0xfc,
+	0x89, 0xe3, 0xcd, 0x82, 0x56, 0x81, 0xf0, 0x1a, 0x02, 0x7a, 0xde, 0x2c, 0x60, 0x57, 0xa0, 0x74,
+	0xea, 0x58, 0xd1, 0x71, 0xab, 0x48, 0x2d, 0x8a, 0x02, 0x42, 0xc3, 0x99, 0xe9, 0xda, 0xad, 0x92,
+	0x80, 0x52, 0x01, 0xa1, 0x11, 0x75, 0x52, 0xde, 0xce, 0xdd, 0xad, 0x72, 0x51, 0x60, 0xb7, 0x00,
+	0x6c, 0x6f, 0xb5, 0x78, 0x6e, 0xba, 0x2b, 0x3b, 0x6c, 0x55, 0x08, 0xa5, 0x40, 0x8c, 0x7f, 0x5f,
+	0x82, 0x52, 0xc7, 0xf7, 0xc2, 0x88, 0x5d, 0x85, 0xb2, 0x13, 0x7a, 0x2b, 0xd7, 0xa5, 0xe1, 0x6b,
+	0x5c, 0x96, 0xd8, 0x55, 0x28, 0x39, 0x5f, 0x3c, 0x37, 0x5d, 0x1a, 0x7c, 0xe9, 0xf1, 0x25, 0x2e,
+	0x8a, 0xac, 0x05, 0x65, 0xe7, 0xc1, 0x67, 0x88, 0x28, 0x48, 0x84, 0x2c, 0x13, 0xe6, 0xe1, 0x0e,
+	0x62, 0x8a, 0x09, 0xe6, 0xe1, 0x4e, 0x8c, 0xf9, 0xec, 0x13, 0xc4, 0xe0, 0xd0, 0x0b, 0x84, 0xa1,
+	0x32, 0xf6, 0xb2, 0xa2, 0x5e, 0x70, 0xf4, 0x0d, 0xec, 0x65, 0x15, 0xf7, 0xb2, 0x12, 0xbd, 0x54,
+	0x24, 0x42, 0x96, 0x09, 0x23, 0x7a, 0xd1, 0x12, 0x4c, 0xd2, 0xcb, 0x4a, 0xf4, 0x52, 0xdd, 0xce,
+	0xdd, 0x2d, 0x12, 0x46, 0xf4, 0x72, 0x05, 0x8a, 0x16, 0xc2, 0x61, 0x3b, 0x77, 0x37, 0xf7, 0xf8,
+	0x12, 0x2f, 0x5a, 0x12, 0x1a, 0x22, 0xb4, 0x86, 0xab, 0x83, 0xd0, 0x50, 0x42, 0xa7, 0x08, 0xad,
+	0xe3, 0x6a, 0x20, 0x74, 0x2a, 0xa1, 0x73, 0x84, 0x36, 0xb6, 0x73, 0x77, 0xf3, 0x08, 0xc5, 0x12,
+	0xbb, 0x01, 0x15, 0xcb, 0x8c, 0x6c, 0x44, 0x34, 0xe5, 0x94, 0x63, 0x00, 0xe2, 0x90, 0x5d, 0x10,
+	0xb7, 0x25, 0x27, 0x1d, 0x03, 0x98, 0x01, 0x35, 0x24, 0x8b, 0xf1, 0xba, 0xc4, 0xab, 0x40, 0xf6,
+	0x29, 0xd4, 0x2d, 0x7b, 0xe6, 0x2c, 0x4c, 0x57, 0xcc, 0xe9, 0xf2, 0x76, 0xee, 0x6e, 0x6d, 0x67,
+	0xeb, 0x3e, 0x31, 0x71, 0x82, 0x79, 0x7c, 0x89, 0x67, 0xc8, 0xd8, 0x17, 0xd0, 0x90, 0xe5, 0x07,
+	0x3b, 0xb4, 0xb0, 0x8c, 0xea, 0xe9, 0x99, 0x7a, 0x0f, 0x76, 0xbe, 0x78, 0x7c, 0x89, 0x67, 0x09,
+	0xd9, 0x1d, 0xa8, 0x27, 0xfc, 0x8d, 0x15, 0x5f, 0x93, 0xa3, 0xca, 0x40, 0x71, 0x5a, 0xdf, 0x86,
+	0xbe, 0x87, 0x04, 0x57, 0xe4, 0xba, 0xc5, 0x00, 0xb6, 0x0d, 0x60, 0xd9, 0x73, 0x73, 0xe5, 0x46,
+	0x88, 0x7e, 0x5d, 0x2e, 0xa0, 0x02, 0x63, 0xb7, 0xa0, 0xba, 0x5a, 0xe2, 0x2c, 0x9f, 0x9a, 0x6e,
+	0xeb, 0xaa, 0x24, 0x48, 0x41, 0xc8, 0xcc, 0x4e, 0xb8, 0xeb, 0x78, 0xad, 0x6b, 0x88, 0xe3, 0xa2,
+	0xc0, 0x6e, 0x42, 0x21, 0x0c, 0x66, 0xad, 0x16, 0xcd, 0x04, 0xc4, 0x4c, 0xba, 0x67, 0xcb, 0x80,
+	0x23, 0x78, 0xb7, 0x02, 0x25, 0x62, 0x6a, 0xe3, 0x26, 0x68, 0x07, 0x66, 0x60, 0x2e, 0xb8, 0x3d,
+	0x67, 0x3a, 0x14, 0x96, 0x7e, 0x28, 0x4f, 0x24, 0x7e, 0x1a, 0x7d, 0x28, 0x3f, 0x35, 0x03, 0xc4,
+	0x31, 0x28, 0x7a, 0xe6, 0xc2, 0x26, 0x64, 0x95, 0xd3, 0x37, 0x9e, 0x82, 0xf0, 0x3c, 0x8c, 0xec,
+	0x85, 0x3c, 0xab, 0xb2, 0x84, 0xf0, 0x23, 0xd7, 0x9f, 0x4a, 0x6e, 0xd7, 0xb8, 0x2c, 0x19, 0x03,
+	0x28, 0x77, 0x7c, 0x17, 0x5b, 0xbb, 0x06, 0x95, 0xc0, 0x76, 0x27, 0x69, 0x6f, 0xe5, 0xc0, 0x76,
+	0x0f, 0xfc, 0x10, 0x11, 0x33, 0x5f, 0x20, 0xf2, 0x02, 0x31, 0xf3, 0x09, 0x11, 0xf7, 0x5f, 0x48,
+	0xfb, 0x37, 0xbe, 0x84, 0x2a, 0x37, 0x4f, 0x65, 0x93, 0xaf, 0x43, 0x39, 0x9a, 0xba, 0x13, 0x29,
+	0x51, 0x8a, 0xbc, 0x14, 0x4d, 0xdd, 0x9e, 0x85, 0x60, 0x6c, 0xd0, 0xb1, 0xa8, 0xbd, 0x22, 0x2f,
+	0xcd, 0x7c, 0xb7, 0x67, 0x19, 0x63, 0x80, 0x8e, 0x1f, 0x04, 0x3f, 0x7a, 0x38, 0x57, 0xa0, 0x64,
+	0xd9, 0xcb, 0xe8, 0x58, 0x9c, 0x67, 0x2e, 0x0a, 0xc6, 0x3d, 0xd0, 0x70, 0x89, 0xfb, 0x4e, 0x18,
+	0xb1, 0x5b, 0x50, 0x74, 0x9d, 0x30, 0x6a, 0xe5, 0xb6, 0x0b, 0x17, 0x36, 0x80, 0xe0, 0xc6, 0x36,
+	0x68, 0x4f, 0xcc, 0xb3, 0xa7, 0xb8, 0x09, 0xec, 0x8a, 0xdc, 0x0d, 0xb9, 0xba, 0x72, 0x6b, 0xee,
+	0x01, 0x8c, 0xcd, 0xe0, 0xc8, 0x8e, 0x48, 0x5a, 0xde, 0x84, 0x42, 0x74, 0xbe, 0x24, 0x8a, 0xa4,
+	0x39, 0x44, 0x70, 0x04, 0x1b, 0xff, 0x2b, 0x07, 0xb5, 0xd1, 0x6a, 0xfa, 0xdd, 0xca, 0x0e, 0xce,
+	0x71, 0x46, 0x77, 0x53, 0xea, 0xe6, 0xce, 0x55, 0x41, 0xad, 0xe0, 0xd3, 0x9a, 0x38, 0x45, 0xcf,
+	0xb7, 0xec, 0x78, 0x85, 0x4a, 0xbc, 0x8c, 0xc5, 0x9e, 0x85, 0xe2, 0xd9, 0x5f, 0xca, 0xf5, 0xce,
+	0xfb, 0x4b, 0xb6, 0x0d, 0xa5, 0xd9, 0xb1, 0xe3, 0x5a, 0xad, 0xa2, 0x3a, 0x04, 0x9a, 0x91, 0x40,
+	0xb0, 0xeb, 0xa0, 0x05, 0xfe, 0xe9, 0x24, 0x74, 0x7e, 0x17, 0x8b, 0xdb, 0x4a, 0xe0, 0x9f, 0x8e,
+	0x9c, 0xdf, 0xd9, 0xc6, 0x58, 0xca, 0x7c, 0x80, 0xf2, 0xa8, 0xd3, 0xee, 0xb7, 0xb9, 0x7e, 0x09,
+	0xbf, 0xbb, 0xbf, 0xed, 0x8d, 0xc6, 0x23, 0x3d, 0xc7, 0x9a, 0x00, 0x83, 0xe1, 0x78, 0x22, 0xcb,
+	0x79, 0x56, 0x86, 0x7c, 0x6f, 0xa0, 0x17, 0x90, 0x06, 0xe1, 0xbd, 0x81, 0x5e, 0x64, 0x15, 0x28,
+	0xb4, 0x07, 0xdf, 0xe8, 0x25, 0xfa, 0xe8, 0xf7, 0xf5, 0xb2, 0xf1, 0x1f, 0x72, 0x50, 0x1d, 0x4e,
+	0xbf, 0xb5, 0x67, 0x11, 0xce, 0x19, 0xd9, 0xd1, 0x0e, 0x9e, 0xdb, 0x01, 0x4d, 0xbb, 0xc0, 0x65,
+	0x09, 0x27, 0x62, 0x4d, 0x69, 0x72, 0x05, 0x9e, 0xb7, 0xa6, 0x44, 0x37, 0x3b, 0xb6, 0x17, 0x66,
+	0xab, 0x20, 0xe9, 0xa8, 0x84, 0xec, 0xef, 0x4f, 0xbf, 0xa5, 0xe9, 0x15, 0x38, 0x7e, 0xb2, 0xb7,
+	0xa0, 0x26, 0xda, 0x98, 0x10, 0xef, 0x95, 0x84, 0x46, 0x10, 0xa0, 0x01, 0x9e, 0x80, 0x6b, 0x50,
+	0xb1, 0xa6, 0x02, 0x29, 0x34, 0x49, 0xd9, 0x9a, 0x12, 0x02, 0x6b, 0x52, 0xab, 0x02, 0x29, 0x75,
+	0x89, 0x00, 0x11, 0xc1, 0x75, 0xd0, 0xfc, 0xe9, 0xb7, 0x02, 0xab, 0x11, 0xb6, 0xe2, 0x4f, 0xbf,
+	0x45, 0x94, 0xf1, 0x3f, 0x73, 0xa0, 0x3d, 0x5a, 0x79, 0xb3, 0xc8, 0xf1, 0x3d, 0xf6, 0x36, 0x14,
+	0xe7, 0x2b, 0x6f, 0xd6, 0xca, 0xa9, 0x92, 0x2c, 0x99, 0x33, 0x27, 0x24, 0xf2, 0x9a, 0x19, 0x1c,
+	0x21, 0x8f, 0xae, 0xf1, 0x1a, 0xc2, 0x8d, 0x7f, 0x28, 0x5b, 0x7c, 0xe4, 0x9a, 0x47, 0x4c, 0x83,
+	0xe2, 0x60, 0x38, 0xe8, 0xea, 0x97, 0x58, 0x1d, 0xb4, 0xde, 0x60, 0xdc, 0xe5, 0x83, 0x76, 0x5f,
+	0xcf, 0xd1, 0xd6, 0x8c, 0xdb, 0xbb, 0xfd, 0xae, 0x9e, 0x47, 0xcc, 0xd3, 0x61, 0xbf, 0x3d, 0xee,
+	0xf5, 0xbb, 0x7a, 0x51, 0x60, 0x78, 0xaf, 0x33, 0xd6, 0x35, 0xa6, 0x43, 0xfd, 0x80, 0x0f, 0xf7,
+	0x0e, 0x3b, 0xdd, 0xc9, 0xe0, 0xb0, 0xdf, 0xd7, 0x75, 0xf6, 0x1a, 0x6c, 0x25, 0x90, 0xa1, 0x00,
+	0x6e, 0x63, 0x95, 0xa7, 0x6d, 0xde, 0xe6, 0xfb, 0xfa, 0xaf, 0x98, 0x06, 0x85, 0xf6, 0xfe, 0xbe,
+	0xfe, 0xfb, 0x1c, 0x7e, 0x3d, 0xeb, 0x0d, 0xf4, 0xdf, 0xe7, 0x59, 0x13, 0xaa, 0x4f, 0x86, 0x83,
+	0xe1, 0x78, 0x38, 0xe8, 0x75, 0xf4, 0xdf, 0x17, 0x8d, 0xbf, 0x2a, 0x40, 0x11, 0x07, 0xfc, 0xfd,
+	0x6c, 0xce, 0xde, 0x80, 0xdc, 0x8c, 0x76, 0xb2, 0xb6, 0x53, 0x13, 0x38, 0xd2, 0xc7, 0x8f, 0x2f,
+	0xf1, 0x1c, 0xae, 0x42, 0x4e, 0xf0, 0x6b, 0x6d, 0xa7, 0x29, 0x90, 0xb1, 0x64, 0x43, 0xfc, 0x92,
+	0xdd, 0x84, 0xdc, 0x73, 0xc9, 0xbc, 0x75, 0x81, 0x17, 0xb2, 0x0d, 0xb1, 0xcf, 0xd9, 0x36, 0x14,
+	0x66, 0xbe, 0xd0, 0xb5, 0x09, 0x5e, 0x88, 0x87, 0xc7, 0x97, 0x38, 0xa2, 0xd8, 0xdb, 0x50, 0x08,
+	0xcc, 0xd3, 0x56, 0x59, 0xdd, 0x89, 0x44, 0xfe, 0x20, 0x51, 0x60, 0x9e, 0xe2, 0x20, 0xe6, 0xad,
+	0x8a, 0x3a, 0x88, 0x78, 0x2b, 0xb1, 0x9b, 0x39, 0x7b, 0x07, 0x0a, 0xe1, 0x6a, 0x4a, 0x5b, 0x5e,
+	0xdb, 0xb9, 0xbc, 0x76, 0x30, 0xb1, 0x99, 0x70, 0x35, 0x65, 0xef, 0x42, 0x71, 0xe6, 0x07, 0x41,
+	0xab, 0xaa, 0x2a, 0xa2, 0x54, 0x62, 0xa1, 0x32, 0x45, 0x3c, 0xdb, 0x86, 0x5c, 0xd4, 0x02, 0x95,
+	0x28, 0x15, 0x19, 0xd8, 0x61, 0xc4, 0xee, 0x48, 0x39, 0x54, 0x53, 0xc7, 0x14, 0x4b, 0x29, 0x6c,
+	0x07, 0xb1, 0xcc, 0x80, 0xc2, 0xc2, 0x3c, 0x6b, 0xd5, 0x55, 0xa2, 0x58, 0x3c, 0xe1, 0x98, 0x16,
+	0xe6, 0xd9, 0x6e, 0x19, 0x8a, 0xf6, 0xd9, 0x32, 0x30, 0xae, 0x43, 0x35, 0xd1, 0x9e, 0xac, 0x0e,
+	0x39, 0x53, 0x9e, 0xb7, 0x9c, 0x69, 0xdc, 0x05, 0x90, 0xa8, 0x07, 0x3b, 0x5f, 0x64, 0x71, 0x58,
+	0x8a, 0x4f, 0x61, 0x6e, 0x6a, 0xfc, 0x1c, 0xea, 0xdc, 0x0e, 0x57, 0x6e, 0xd4, 0xf1, 0xdd, 0x3d,
+	0x7b, 0xce, 0x3e, 0x04, 0x48, 0xca, 0xa1, 0x14, 0x9a, 0xe9, 0x2e, 0xec, 0xd9, 0x73, 0xae, 0xe0,
+	0x8d, 0xff, 0x54, 0x80, 0xb2, 0xac, 0x98, 0x0a, 0xf8, 0x9c, 0x22, 0xe0, 0x13, 0x7d, 0x91, 0xcf,
+	0xea, 0xab, 0x63, 0xc7, 0xb2, 0x6c, 0x2f, 0xd6, 0x4b, 0xa2, 0xc4, 0xee, 0x40, 0xc1, 0x74, 0x8f,
+	0x88, 0x35, 0x9a, 0x3b, 0x2c, 0xee, 0x74, 0xb1, 0x0c, 0xec, 0x30, 0x14, 0xbc, 0x67, 0xba, 0x47,
+	0x31, 0x67, 0x96, 0x36, 0x73, 0xe6, 0x75, 0xd0, 0x3c, 0x3f, 0x9a, 0x90, 0x4d, 0x58, 0xa6, 0xd6,
+	0x2b, 0xd2, 0x72, 0x65, 0xef, 0x41, 0x45, 0x6a, 0x73, 0xc9, 0x18, 0x0d, 0x51, 0x79, 0x4f, 0x00,
+	0x79, 0x8c, 0x65, 0x2d, 0xd4, 0x36, 0x8b, 0x85, 0xed, 0x45, 0xb1, 0x48, 0x90, 0x45, 0xf6, 0x01,
+	0x54, 0x7d, 0x6f, 0x22, 0x54, 0x7e, 0xab, 0xaa, 0x6e, 0xd2, 0xd0, 0x3b, 0x24, 0x28, 0xd7, 0x7c,
+	0xf9, 0x85, 0x43, 0x71, 0xfd, 0xd3, 0xc9, 0xcc, 0x0c, 0x2c, 0x62, 0x0d, 0x8d, 0x57, 0x5c, 0xff,
+	0xb4, 0x63, 0x06, 0x16, 0xbb, 0x09, 0xd5, 0x99, 0xbb, 0x0a, 0x23, 0x3b, 0xd8, 0x3d, 0x27, 0x8e,
+	0xd0, 0x78, 0x0a, 0xc0, 0xfe, 0x97, 0x81, 0xb3, 0x30, 0x83, 0x73, 0x61, 0xc8, 0xf1, 0xb8, 0x88,
+	0x0a, 0x6a, 0x79, 0xe2, 0x58, 0x67, 0x64, 0xca, 0x95, 0xb8, 0x28, 0xb0, 0x9f, 0x41, 0xf5, 0xc8,
+	0xf6, 0xec, 0xc0, 0x8c, 0x6c, 0x8b, 0x6c, 0xb9, 0x5a, 0xbc, 0x7a, 0xfb, 0x31, 0x18, 0xd9, 0x35,
+	0x25, 0x62, 0xef, 0x40, 0x73, 0x26, 0x17, 0x76, 0xe2, 0xda, 0xcf, 0x6d, 0x61, 0xe6, 0x95, 0x78,
+	0x23, 0x86, 0xf6, 0x11, 0x68, 0x7c, 0x07, 0x15, 0xb9, 0x38, 0xec, 0x96, 0x60, 0xba, 0xac, 0x40,
+	0x10, 0xa2, 0x0d, 0xe1, 0xec, 0x6d, 0x68, 0xf8, 0x81, 0x73, 0xe4, 0x78, 0x93, 0x30, 0x0a, 0x1c,
+	0xef, 0x48, 0x6e, 0x78, 0x5d, 0x00, 0x47, 0x04, 0x63, 0xb7, 0xa1, 0x8e, 0x1b, 0x33, 0x31, 0xa7,
+	0x8e, 0xeb, 0x44, 0xe7, 0x72, 0xfb, 0x6b, 0x08, 0x6b, 0x0b, 0x90, 0x31, 0x04, 0x2d, 0x5e, 0xca,
+	0x9f, 0xa4, 0x4f, 0xe3, 0x04, 0xea, 0xea, 0x2a, 0xfc, 0x34, 0x13, 0x41, 0xd5, 0x15, 0xf9, 0x81,
+	0x6d, 0xc5, 0x1c, 0x2c, 0x4a, 0xc6, 0x9f, 0x40, 0xad, 0xe7, 0x59, 0xf6, 0xd9, 0x70, 0x49, 0x4a,
+	0xe3, 0x43, 0x60, 0xb3, 0xc0, 0x36, 0x23, 0x7b, 0x62, 0x9f, 0x45, 0x81, 0x39, 0x11, 0xbe, 0x8e,
+	0x70, 0x55, 0x74, 0x81, 0xe9, 0x22, 0x62, 0x8c, 0x70, 0xe3, 0x1f, 0xe7, 0xa0, 0x71, 0x20, 0x36,
+	0xfa, 0x6b, 0xfb, 0x7c, 0x4f, 0x18, 0x7b, 0xb3, 0xf8, 0x18, 0x16, 0x39, 0x7d, 0xb3, 0x5b, 0x50,
+	0x5b, 0x9e, 0xd8, 0xe7, 0x93, 0x8c, 0x35, 0x55, 0x45, 0x50, 0x87, 0x0e, 0xdc, 0xfb, 0x50, 0xf6,
+	0xa9, 0xf7, 0x56, 0x41, 0x95, 0x6d, 0xca, 0xb0, 0xb8, 0x24, 0x60, 0x06, 0x34, 0x92, 0xa6, 0xe8,
+	0x90, 0x16, 0x69, 0xaa, 0x35, 0xd9, 0x18, 0xe9, 0xc7, 0x2b, 0x50, 0x42, 0x54, 0xd8, 0x2a, 0x6d,
+	0x17, 0xd0, 0x24, 0xa2, 0x82, 0xf1, 0x5f, 0x0a, 0xa0, 0x51, 0x8b, 0xf2, 0xe4, 0x3b, 0xd6, 0x59,
+	0x7c, 0xf2, 0xab, 0xbc, 0xe4, 0x58, 0x67, 0x3d, 0x8b, 0xbd, 0x09, 0xe0, 0x20, 0xc9, 0x44, 0x39,
+	0xff, 0x55, 0x82, 0xc4, 0x0d, 0x2f, 0xcd, 0x20, 0x0a, 0x5b, 0x05, 0xd1, 0x30, 0x15, 0x70, 0x61,
+	0x57, 0x9e, 0xf3, 0xdd, 0x4a, 0x8c, 0x45, 0xe3, 0xb2, 0xc4, 0xee, 0x82, 0x2e, 0x1a, 0xa3, 0x25,
+	0x54, 0xcd, 0x80, 0x26, 0xc1, 0x69, 0x05, 0x63, 0x8d, 0x2f, 0x68, 0xec, 0x33, 0x14, 0xb7, 0x42,
+	0x06, 0x00, 0x81, 0xba, 0x08, 0x51, 0x4f, 0x77, 0x25, 0x7b, 0xba, 0xd3, 0xa5, 0xd3, 0x5e, 0xb6,
+	0x74, 0x37, 0x40, 0x9b, 0xaf, 0x5c, 0x37, 0xb2, 0xcf, 0x22, 0x92, 0x03, 0x1a, 0x4f, 0xca, 0x38,
+	0x87, 0xa5, 0x19, 0x84, 0x76, 0x40, 0xa7, 0xbe, 0xca, 0x65, 0x09, 0xbd, 0x66, 0x74, 0x36, 0x26,
+	0x4b, 0x33, 0x3a, 0x16, 0x7e, 0x1b, 0xd7, 0x10, 0x70, 0x60, 0x46, 0xc7, 0xec, 0x1d, 0xd0, 0x70,
+	0x2b, 0xa2, 0xf3, 0xa5, 0xdd, 0xaa, 0xab, 0xac, 0x49, 0xa2, 0xad, 0x72, 0x62, 0x9f, 0xe3, 0x07,
+	0x9e, 0xa0, 0xc5, 0xca, 0x8d, 0x9c, 0x09, 0x99, 0xa6, 0x16, 0xc9, 0x01, 0x8d, 0xd7, 0x08, 0x46,
+	0x4a, 0xc2, 0x5a, 0x67, 0xe0, 0xe6, 0xe6, 0x93, 0x48, 0x63, 0x59, 0x79, 0xdf, 0xad, 0xfc, 0xc8,
+	0xa6, 0xe3, 0xaf, 0xf1, 0x1a, 0xc2, 0x0e, 0x05, 0xc8, 0xf8, 0x37, 0x79, 0x68, 0x3c, 0xf2, 0x03,
+	0xdb, 0x39, 0xf2, 0x52, 0x76, 0x5c, 0xf3, 0x3d, 0x62, 0x16, 0xcd, 0x2b, 0x2c, 0xfa, 0x16, 0xd4,
+	0xe6, 0xa2, 0xe2, 0x24, 0x9a, 0x0a, 0xe7, 0xa3, 0xc8, 0x41, 0x82, 0xc6, 0x53, 0x17, 0x7b, 0x8f,
+	0x09, 0xa8, 0x72, 0x91, 0x2a, 0xc7, 0x95, 0x50, 0xb3, 0xb0, 0xaf, 0x48, 0xd2, 0x5a, 0xb6, 0x6b,
+	0x47, 0x62, 0xa7, 0x9b, 0x3b, 0x6f, 0x4a, 0x3d, 0xae, 0x8e, 0xe9, 0x3e, 0xb7, 0xe7, 0x6d, 0x52,
+	0xeb, 0x28, 0x78, 0xf7, 0x88, 0x9c, 0x7d, 0xa5, 0x4a, 0xe9, 0xf2, 0x2b, 0xd6, 0x15, 0x32, 0xc7,
+	0x18, 0x43, 0x35, 0x01, 0xa3, 0xf9, 0xc5, 0xbb, 0xd2, 0xe4, 0xba, 0xc4, 0x6a, 0x50, 0xe9, 0xb4,
+	0x47, 0x9d, 0xf6, 0x5e, 0x57, 0xcf, 0x21, 0x6a, 0xd4, 0x1d, 0x0b, 0x33, 0x2b, 0xcf, 0xb6, 0xa0,
+	0x86, 0xa5, 0xbd, 0xee, 0xa3, 0xf6, 0x61, 0x7f, 0xac, 0x17, 0x58, 0x03, 0xaa, 0x83, 0xe1, 0xa4,
+	0xdd, 0x19, 0xf7, 0x86, 0x03, 0xbd, 0x68, 0xfc, 0xed, 0x1c, 0x68, 0x9d, 0x63, 0x7b, 0x76, 0xf2,
+	0xa2, 0x65, 0x24, 0xa3, 0xde, 0x9e, 0x9d, 0xb4, 0xf2, 0xea, 0xde, 0xc7, 0x46, 0xbd, 0x3d, 0x3b,
+	0x59, 0xdf, 0xd6, 0xc2, 0x86, 0x6d, 0xbd, 0x01, 0x9a, 0xed, 0xcd, 0xfd, 0x60, 0x66, 0x5b, 0xf2,
+	0x00, 0x25, 0x65, 0x63, 0x0f, 0xea, 0x9d, 0x58, 0xc5, 0xe0, 0x30, 0xb6, 0xe3, 0x03, 0xb8, 0xee,
+	0x19, 0x09, 0xc4, 0x26, 0xdd, 0x6d, 0x7c, 0x0a, 0xb5, 0x83, 0xc0, 0x5f, 0xda, 0x41, 0x44, 0x8d,
+	0xe8, 0x50, 0x38, 0xb1, 0xcf, 0xe5, 0x54, 0xf0, 0x33, 0xf5, 0xa1, 0xf2, 0xaa, 0x0f, 0xb5, 0x03,
+	0x5a, 0x5c, 0xed, 0x95, 0xeb, 0xfc, 0x12, 0x1a, 0xb2, 0x8e, 0x63, 0x87, 0xd8, 0xd9, 0x7d, 0x80,
+	0x65, 0x02, 0x90, 0xc3, 0x8e, 0x2d, 0x4c, 0xd9, 0x38, 0x57, 0x28, 0x8c, 0x7f, 0x51, 0x80, 0xe6,
+	0x81, 0x19, 0x44, 0x0e, 0x6e, 0xa6, 0x98, 0xf4, 0x7b, 0x50, 0xa4, 0x23, 0x26, 0x1c, 0xb2, 0xd7,
+	0x12, 0xf3, 0x54, 0xd0, 0xd0, 0x59, 0x23, 0x02, 0xf6, 0x15, 0x34, 0x97, 0x31, 0x78, 0x42, 0x0a,
+	0x43, 0xec, 0xcc, 0xc5, 0x2a, 0xb4, 0x5e, 0x8d, 0xa5, 0x5a, 0x64, 0xbf, 0x80, 0x2b, 0xd9, 0xba,
+	0x76, 0x18, 0xa6, 0x02, 0x59, 0x5d, 0xe8, 0xd7, 0x32, 0x15, 0x05, 0x19, 0xeb, 0xc0, 0xe5, 0xb4,
+	0xfa, 0xcc, 0x77, 0x57, 0x0b, 0x2f, 0x94, 0xf6, 0xf2, 0xd5, 0x0b, 0xbd, 0x77, 0x04, 0x96, 0xeb,
+	0xcb, 0x0b, 0x10, 0x66, 0x40, 0x3d, 0x81, 0x0d, 0x56, 0x0b, 0x3a, 0x42, 0x45, 0x9e, 0x81, 0xb1,
+	0x87, 0x00, 0x49, 0x39, 0x6c, 0x95, 0xb7, 0x0b, 0x1b, 0xe6, 0xd7, 0x8b, 0xec, 0x05, 0x57, 0xc8,
+	0xd0, 0x74, 0x31, 0xdd, 0x23, 0x3f, 0x70, 0xa2, 0xe3, 0x05, 0x09, 0xd0, 0x02, 0x4f, 0x01, 0x24,
+	0xa7, 0xc3, 0x49, 0xb8, 0x9a, 0x4e, 0x92, 0x2a, 0x24, 0x4c, 0x35, 0xde, 0x74, 0xc2, 0xd1, 0x6a,
+	0x9a, 0xb4, 0x8b, 0xfc, 0x9c, 0xce, 0x72, 0x11, 0x1e, 0x91, 0x18, 0xad, 0x2a, 0x23, 0x7c, 0x12,
+	0x1e, 0x19, 0xbf, 0x86, 0x46, 0x66, 0xa5, 0x5f, 0xaa, 0xbd, 0xaf, 0x83, 0x86, 0xff, 0xf1, 0x8c,
+	0x48, 0x66, 0xaa, 0x60, 0x79, 0x14, 0x05, 0x86, 0x0d, 0xfa, 0xc5, 0x75, 0x63, 0x77, 0x28, 0xae,
+	0x80, 0x9f, 0x1b, 0x4e, 0x41, 0x8c, 0x62, 0x1f, 0x6c, 0xda, 0x90, 0x3c, 0xa9, 0xad, 0xb5, 0x85,
+	0x37, 0xfe, 0x47, 0x0e, 0x1a, 0x99, 0xd5, 0x43, 0x63, 0x2b, 0xad, 0xae, 0x9c, 0xfc, 0x74, 0xfe,
+	0xa4, 0xb8, 0xde, 0x07, 0xdd, 0x0f, 0x2c, 0xc7, 0x33, 0x29, 0xce, 0x21, 0x96, 0x0e, 0xa7, 0xd0,
+	0xe0, 0x5b, 0x12, 0x7e, 0x20, 0xc1, 0x18, 0xa1, 0xb5, 0xec, 0x70, 0x16, 0x38, 0xa9, 0xa2, 0xaf,
+	0x72, 0x15, 0xa4, 0x2a, 0xb9, 0x62, 0x56, 0xc9, 0xbd, 0x07, 0x55, 0x17, 0xcd, 0xbe, 0xe8, 0xd8,
+	0xf4, 0x5a, 0xa5, 0xb5, 0x49, 0x6b, 0x88, 0x1c, 0x1f, 0x9b, 0x1e, 0x12, 0x3a, 0xde, 0x44, 0x06,
+	0x61, 0xcb, 0xeb, 0x84, 0x8e, 0x47, 0x0a, 0x27, 0x34, 0xde, 0x84, 0xca, 0x53, 0xc7, 0x3e, 0x95,
+	0xa2, 0xed, 0xb9, 0x63, 0x9f, 0xc6, 0xa2, 0x0d, 0xbf, 0x8d, 0x7f, 0xa0, 0x81, 0x46, 0xea, 0x79,
+	0xef, 0xc5, 0xd1, 0xa1, 0x1f, 0xe2, 0x25, 0x6c, 0x43, 0x31, 0x51, 0x1a, 0x17, 0x7d, 0x13, 0xc2,
+	0xa0, 0xe5, 0x21, 0x4c, 0x00, 0x3a, 0xea, 0xc2, 0x4c, 0xa8, 0x12, 0x44, 0x46, 0x70, 0xaa, 0xc2,
+	0xf6, 0x0a, 0xbf, 0x73, 0x65, 0xb8, 0x20, 0x05, 0xb0, 0xfb, 0xa0, 0xe1, 0x08, 0xc9, 0xd9, 0xaf,
+	0xa8, 0x47, 0x9e, 0xe6, 0x10, 0x3b, 0x91, 0xbc, 0x12, 0x4d, 0x5d, 0x2c, 0xa0, 0x44, 0x41, 0x7b,
+	0xa9, 0x55, 0x53, 0x69, 0x33, 0x66, 0x1c, 0x27, 0x02, 0x76, 0x17, 0x2a, 0x64, 0xaa, 0xd8, 0x61,
+	0xab, 0xae, 0x8a, 0xae, 0xd8, 0x8e, 0xe2, 0x31, 0x9a, 0xbd, 0x0f, 0xa5, 0xf9, 0x89, 0x7d, 0x1e,
+	0xb6, 0x1a, 0xea, 0x91, 0xcc, 0xe8, 0x2e, 0x2e, 0x28, 0xd8, 0x1d, 0x68, 0x06, 0xf6, 0x7c, 0x42,
+	0x71, 0x1f, 0x54, 0xb6, 0x61, 0xab, 0x49, 0xba, 0xb4, 0x1e, 0xd8, 0xf3, 0x0e, 0x02, 0xc7, 0x53,
+	0x37, 0x64, 0xef, 0x42, 0x99, 0x94, 0x48, 0xd8, 0xda, 0x52, 0x7b, 0x8e, 0x35, 0x12, 0x97, 0x58,
+	0xb6, 0x03, 0xd5, 0xf4, 0xd8, 0xbe, 0x4e, 0x13, 0xba, 0x72, 0x41, 0x1e, 0x90, 0x18, 0xe5, 0x29,
+	0x19, 0x7b, 0x00, 0x20, 0x3d, 0x97, 0xc9, 0xf4, 0xbc, 0x75, 0x55, 0xf5, 0x3e, 0x54, 0x75, 0xa3,
+	0xfa, 0x37, 0xef, 0x41, 0x09, 0xa5, 0x74, 0xd8, 0xba, 0xb6, 0x5d, 0x48, 0xcd, 0x2c, 0x45, 0xad,
+	0x70, 0x81, 0x67, 0x77, 0x41, 0x43, 0x16, 0x9a, 0xe0, 0x46, 0xb5, 0x54, 0x97, 0x4d, 0xf2, 0x1b,
+	0xaf, 0x20, 0x7a, 0xf4, 0x9d, 0xcb, 0x3e, 0x82, 0x9a, 0xd4, 0x8e, 0xc4, 0x1b, 0xd7, 0x37, 0xf9,
+	0xad, 0x82, 0x80, 0xac, 0x8b, 0x7b, 0x50, 0xb4, 0xec, 0x79, 0xd8, 0x7a, 0x6b, 0xbb, 0x90, 0x4a,
+	0xd5, 0x98, 0x49, 0xd1, 0x21, 0x14, 0x9a, 0x00, 0x69, 0xd8, 0x63, 0x68, 0x22, 0x3f, 0xee, 0x90,
+	0xc1, 0x8d, 0x3b, 0xd4, 0xda, 0xa6, 0x5a, 0xb7, 0x2f, 0xd4, 0x1a, 0x48, 0x22, 0xda, 0xcf, 0xae,
+	0x17, 0x05, 0xe7, 0xbc, 0xe1, 0xa9, 0x30, 0xf6, 0x50, 0x78, 0x5d, 0x78, 0x8a, 0xed, 0x09, 0x31,
+	0xcd, 0xed, 0xed, 0xdc, 0xda, 0x38, 0x1b, 0x09, 0xcd, 0x01, 0xb2, 0xcd, 0x0d, 0xd0, 0x9c, 0xb0,
+	0xef, 0xcf, 0x4e, 0x6c, 0xab, 0x65, 0x08, 0x95, 0x1e, 0x97, 0xd9, 0x97, 0xd0, 0x20, 0xb6, 0xc6,
+	0x22, 0x8e, 0xb8, 0xf5, 0xb6, 0xaa, 0xd6, 0xc6, 0x2a, 0x8a, 0x67, 0x29, 0x6f, 0xec, 0x93, 0x6b,
+	0x87, 0x9f, 0xec, 0xd3, 0x0b, 0x6a, 0x35, 0xc3, 0xc7, 0x8a, 0xfe, 0xc5, 0x70, 0x78, 0x4a, 0xb8,
+	0x5b, 0x82, 0x82, 0x65, 0xcf, 0x6f, 0xfc, 0x0a, 0xd8, 0xfa, 0xcc, 0x5f, 0xa6, 0xe3, 0x4b, 0x52,
+	0xc7, 0x7f, 0x95, 0xff, 0x22, 0x67, 0x7c, 0x09, 0x8d, 0xcc, 0xd9, 0xda, 0x68, 0x20, 0x09, 0x77,
+	0xc1, 0x14, 0x21, 0xee, 0x3a, 0x17, 0x05, 0xe3, 0xdf, 0xe6, 0xa0, 0x34, 0x8a, 0xcc, 0x28, 0x44,
+	0xe3, 0x7a, 0xea, 0xfa, 0xb3, 0x93, 0x89, 0xb7, 0x5a, 0xc8, 0xe0, 0xb1, 0x46, 0x00, 0x54, 0x74,
+	0x64, 0xa4, 0x86, 0x11, 0xd5, 0xcd, 0x71, 0xfa, 0x46, 0xf1, 0xe2, 0xaf, 0xa2, 0x99, 0x17, 0x91,
+	0x78, 0xc9, 0x71, 0x59, 0x42, 0xc9, 0x19, 0xf8, 0xa7, 0x14, 0x3b, 0x2d, 0x12, 0x22, 0x2e, 0xa2,
+	0xd5, 0x7a, 0x6c, 0x86, 0xc7, 0x0b, 0x73, 0x99, 0x86, 0x56, 0x73, 0xbc, 0x26, 0x61, 0x18, 0x5e,
+	0xc5, 0x51, 0x08, 0xc9, 0x83, 0xed, 0x96, 0x09, 0xaf, 0x11, 0xa0, 0xe3, 0x45, 0x28, 0xb5, 0x43,
+	0xdb, 0xb5, 0x67, 0x91, 0xf3, 0x1c, 0x9d, 0xdf, 0x8a, 0xa8, 0xae, 0x80, 0x8c, 0xf7, 0xa1, 0x82,
+	0x4c, 0x60, 0x46, 0x26, 0x2a, 0x3a, 0xcb, 0x8c, 0xcc, 0x4d, 0x61, 0x6b, 0x84, 0x1b, 0x1f, 0x03,
+	0x70, 0xff, 0x34, 0xb4, 0x23, 0xa2, 0xbe, 0xad, 0x38, 0x8a, 0xc9, 0x21, 0x91, 0x4d, 0x09, 0xa1,
+	0x68, 0xfc, 0xc7, 0x1c, 0xd4, 0x86, 0x81, 0x85, 0x07, 0x70, 0xb4, 0xb4, 0x67, 0x2f, 0xd5, 0xa4,
+	0x28, 0x25, 0x7d, 0xd7, 0x35, 0x13, 0x3d, 0x54, 0xe5, 0x29, 0x80, 0x3d, 0x80, 0xe2, 0xdc, 0x35,
+	0x85, 0x11, 0x9a, 0x58, 0xd7, 0x4a, 0xf3, 0xf1, 0x37, 0x46, 0x3a, 0x39, 0x91, 0x1a, 0x7f, 0x06,
+	0x35, 0x05, 0x98, 0x09, 0x7a, 0x5e, 0xa2, 0x50, 0xf2, 0xa8, 0xa3, 0x63, 0x68, 0xb2, 0xb8, 0xd7,
+	0x1d, 0x75, 0x84, 0x4d, 0x8d, 0xd6, 0xf5, 0x68, 0xf2, 0xa8, 0xc7, 0x47, 0x63, 0xbd, 0x48, 0xb1,
+	0x69, 0x02, 0xf4, 0xdb, 0x23, 0x0c, 0x81, 0x02, 0x94, 0x0f, 0x07, 0xbd, 0xdf, 0x1c, 0x76, 0x75,
+	0xdd, 0xf8, 0x67, 0x39, 0x80, 0x47, 0x81, 0xb9, 0xb0, 0x77, 0xfd, 0x95, 0x67, 0xb1, 0xfb, 0x19,
+	0x33, 0xef, 0x86, 0x14, 0xa0, 0x09, 0xfe, 0x3e, 0xfd, 0x55, 0xac, 0xbd, 0x9b, 0x50, 0x5d, 0x79,
+	0x53, 0x04, 0xda, 0x96, 0xbc, 0x44, 0x49, 0x01, 0x18, 0x71, 0x8a, 0xaf, 0x0c, 0x2f, 0x5c, 0xe1,
+	0x3c, 0x37, 0x5d, 0xe3, 0x2b, 0xa8, 0x26, 0xcd, 0xa1, 0xdd, 0x7f, 0xc0, 0xbb, 0x9d, 0xee, 0x5e,
+	0x6f, 0xb0, 0xaf, 0x5f, 0xc2, 0x39, 0x74, 0x0e, 0x39, 0xef, 0x0e, 0xc6, 0x13, 0x3e, 0x7c, 0xa6,
+	0xe7, 0x10, 0xff, 0x68, 0xd8, 0xef, 0x0f, 0x9f, 0x21, 0x3e, 0x6f, 0xfc, 0x93, 0x1c, 0xd4, 0x68,
+	0x58, 0x1d, 0xd7, 0x5c, 0x85, 0x36, 0xfb, 0x38, 0x33, 0xee, 0x37, 0x94, 0x71, 0x0b, 0x02, 0xf1,
+	0xad, 0x0c, 0xfc, 0x5d, 0x28, 0x85, 0x91, 0x19, 0x44, 0xad, 0xbc, 0x1a, 0x7b, 0x4c, 0x67, 0xca,
+	0x05, 0x1a, 0xe3, 0x8a, 0xb6, 0x67, 0xb5, 0x0a, 0x2f, 0xa0, 0x42, 0xa4, 0xb1, 0x0d, 0xd5, 0xa4,
+	0x79, 0xdc, 0x07, 0x3e, 0x7c, 0x36, 0xd2, 0x2f, 0xb1, 0x2a, 0x94, 0x78, 0x7b, 0xb0, 0xdf, 0xd5,
+	0x73, 0xc6, 0x7f, 0xcb, 0x01, 0x3c, 0x73, 0x3c, 0xcb, 0x3f, 0x25, 0x16, 0xfa, 0x48, 0xb1, 0x31,
+	0x51, 0xf8, 0xaf, 0xf3, 0x6a, 0x6d, 0x99, 0xea, 0x0d, 0xf6, 0x21, 0x68, 0x3e, 0x32, 0x00, 0x92,
+	0xe6, 0x55, 0xc9, 0xaf, 0xf0, 0x0d, 0xaf, 0xf8, 0xa2, 0x80, 0x67, 0xd6, 0xb5, 0x4d, 0x4b, 0x5e,
+	0xec, 0xd0, 0x37, 0x4a, 0x15, 0x64, 0x3a, 0x71, 0xb1, 0x8c, 0x9f, 0xec, 0x03, 0xa8, 0x9d, 0xd2,
+	0x80, 0x84, 0xc2, 0x2e, 0xad, 0x6d, 0x11, 0x08, 0xb4, 0x54, 0xd5, 0xa5, 0x79, 0x10, 0xdf, 0x11,
+	0x24, 0xbd, 0x2b, 0xcb, 0xcb, 0x05, 0xde, 0xd8, 0xc7, 0xa0, 0xe8, 0x6c, 0x15, 0x84, 0xce, 0x73,
+	0xbb, 0x13, 0xd1, 0xb1, 0x5e, 0x98, 0x67, 0x13, 0x71, 0xd3, 0x24, 0x02, 0xa9, 0xda, 0xc2, 0x3c,
+	0xdb, 0xc3, 0x32, 0x0a, 0x68, 0xcb, 0x09, 0x23, 0xc7, 0x9b, 0x45, 0x92, 0x75, 0x92, 0xb2, 0xf1,
+	0x87, 0x22, 0x54, 0x7b, 0x5e, 0x68, 0x07, 0x51, 0x27, 0x3a, 0x63, 0xb7, 0xa1, 0x10, 0xd8, 0xf3,
+	0x17, 0x5d, 0x21, 0x20, 0x0e, 0x03, 0x8c, 0x42, 0x80, 0x58, 0xf6, 0x5c, 0xee, 0x69, 0x33, 0xab,
+	0x67, 0xa4, 0x40, 0xd9, 0xa3, 0xcb, 0x25, 0x1d, 0x7d, 0xe4, 0xd5, 0xd2, 0x75, 0x66, 0x18, 0x64,
+	0xc2, 0xc0, 0x20, 0x46, 0x53, 0x4a, 0xbc, 0xe9, 0x7b, 0x7b, 0x31, 0xb8, 0x67, 0x9d, 0xb1, 0x03,
+	0xb8, 0x9c, 0xa1, 0xa4, 0x93, 0x2f, 0x0c, 0xa8, 0x3b, 0xb1, 0x15, 0x22, 0x47, 0x79, 0x7f, 0x98,
+	0x56, 0xc5, 0x15, 0x14, 0x9a, 0x6c, 0xcb, 0xcf, 0x42, 0xc9, 0x9a, 0xb1, 0xce, 0x26, 0x38, 0x1f,
+	0x61, 0x44, 0xae, 0xcd, 0x07, 0x83, 0x42, 0xf2, 0x52, 0x4f, 0x84, 0x87, 0xce, 0xc8, 0x8a, 0x2c,
+	0x11, 0x02, 0x07, 0xf5, 0x0b, 0x72, 0x3f, 0x6c, 0x2f, 0x22, 0x5c, 0x85, 0x5a, 0xb9, 0x75, 0x71,
+	0x34, 0x07, 0x44, 0xd1, 0xb3, 0xa4, 0x46, 0xad, 0x2e, 0xe3, 0x32, 0xfb, 0x1c, 0x1a, 0xb1, 0xe1,
+	0x21, 0xe2, 0x6a, 0xda, 0x06, 0xdb, 0x83, 0x56, 0x8d, 0xd7, 0x67, 0x4a, 0xe9, 0xc6, 0x00, 0xae,
+	0x6c, 0x9a, 0xe3, 0x06, 0x9d, 0xb5, 0xad, 0xea, 0xac, 0x0b, 0x2e, 0x72, 0xa2, 0xbf, 0x6e, 0xfc,
+	0x9c, 0xbc, 0x4c, 0x65, 0x94, 0x3f, 0x48, 0xfb, 0xfd, 0x75, 0x19, 0xaa, 0x22, 0xf6, 0x90, 0x61,
+	0x91, 0xc2, 0x0b, 0x59, 0xe4, 0x16, 0x14, 0x70, 0xbd, 0xf2, 0xaa, 0x89, 0xd3, 0xb3, 0xf0, 0x16,
+	0x81, 0x23, 0x82, 0x7d, 0x28, 0x59, 0x68, 0x0f, 0x0d, 0x9c, 0x82, 0x6a, 0xef, 0x25, 0x2c, 0x94,
+	0x12, 0xa0, 0x4f, 0x2d, 0x02, 0x25, 0x68, 0x38, 0xb5, 0x8a, 0x6a, 0xbf, 0x1d, 0xba, 0x62, 0x7d,
+	0x62, 0x2e, 0xe3, 0x4b, 0x6e, 0x0c, 0x9f, 0xfe, 0x04, 0xfb, 0xfe, 0x39, 0x6c, 0xf9, 0xde, 0x24,
+	0xb0, 0x31, 0x8e, 0x31, 0x8b, 0xa8, 0xa9, 0xca, 0xe6, 0xa6, 0x1a, 0xbe, 0xc7, 0x25, 0x19, 0xb6,
+	0xf8, 0x6e, 0xb6, 0x22, 0xb6, 0xac, 0x51, 0xcb, 0x0a, 0x1d, 0x76, 0xf0, 0x29, 0x34, 0xd1, 0x51,
+	0x33, 0xc3, 0x99, 0x69, 0xd9, 0xd4, 0x7e, 0x75, 0x73, 0xfb, 0x75, 0xdf, 0xeb, 0x08, 0x2a, 0x6c,
+	0x7e, 0x27, 0x53, 0x0d, 0x5b, 0x87, 0x0d, 0x6b, 0x9c, 0xd6, 0xc1, 0xae, 0x3e, 0xc9, 0xd4, 0xc1,
+	0x43, 0x5b, 0xdb, 0xb8, 0xe2, 0x69, 0x2d, 0x3c, 0xb8, 0xbb, 0xf0, 0xba, 0x52, 0x4b, 0x59, 0xff,
+	0xfa, 0xe6, 0xf5, 0x67, 0x49, 0xed, 0xc3, 0x64, 0x23, 0x3e, 0x02, 0xf0, 0xbd, 0x49, 0x68, 0x8b,
+	0x05, 0x6c, 0x6c, 0x9e, 0xa0, 0xe6, 0x7b, 0x23, 0x1b, 0xbf, 0xd8, 0xbd, 0x84, 0x1c, 0x27, 0xd6,
+	0xdc, 0x30, 0x31, 0x41, 0xdb, 0x23, 0x0e, 0x8a, 0x69, 0x71, 0x42, 0x5b, 0x1b, 0x27, 0x24, 0xa8,
+	0x71, 0x32, 0x5f, 0xc1, 0x65, 0x49, 0xad, 0x4c, 0x44, 0xdf, 0x3c, 0x91, 0x26, 0xd5, 0x4a, 0x27,
+	0x71, 0x3f, 0x23, 0x02, 0x2e, 0xbf, 0x80, 0xfb, 0x92, 0x33, 0x6f, 0xfc, 0xf7, 0x02, 0xd4, 0xda,
+	0x9e, 0xe9, 0x9e, 0xff, 0xce, 0xee, 0x79, 0x73, 0x5f, 0xc4, 0x98, 0x97, 0xab, 0x68, 0x82, 0x36,
+	0x9a, 0x94, 0xcc, 0x55, 0x82, 0xa0, 0x71, 0x84, 0x81, 0x48, 0x7f, 0x15, 0x25, 0x78, 0x71, 0xe9,
+	0x05, 0x02, 0x44, 0x04, 0x49, 0x7d, 0x32, 0xe8, 0x0a, 0x4a, 0x7d, 0x32, 0xe7, 0xd2, 0xfa, 0x89,
+	0x3d, 0x98, 0xd4, 0x27, 0x82, 0xb7, 0xa1, 0x81, 0x09, 0x26, 0x93, 0x99, 0xef, 0x85, 0xab, 0x85,
+	0x6d, 0x89, 0x14, 0x21, 0x91, 0x75, 0xd2, 0x91, 0x30, 0x6c, 0x65, 0x61, 0x2f, 0xfc, 0xe0, 0x5c,
+	0xb4, 0x52, 0x16, 0xad, 0x08, 0x10, 0xb5, 0xf2, 0x21, 0xb0, 0x53, 0xd3, 0x89, 0x26, 0xd9, 0xa6,
+	0x44, 0x6c, 0x45, 0x47, 0xcc, 0x58, 0x6d, 0xee, 0x2a, 0x94, 0x2d, 0x27, 0x3c, 0xe9, 0x0d, 0x49,
+	0xe0, 0x15, 0xb8, 0x2c, 0xa1, 0x92, 0x0a, 0x1f, 0xf6, 0x86, 0x93, 0xe9, 0xb9, 0xbc, 0x9b, 0x2a,
+	0x70, 0x0d, 0x01, 0xbb, 0xe7, 0x11, 0xc5, 0xcf, 0x09, 0x29, 0x66, 0x3b, 0xf3, 0x57, 0x9e, 0xb8,
+	0xae, 0x2c, 0xf0, 0x26, 0xc2, 0x7b, 0x08, 0xee, 0x20, 0x94, 0xdd, 0x83, 0xcb, 0x44, 0x29, 0x27,
+	0x2e, 0x48, 0x6b, 0x44, 0xba, 0x85, 0x88, 0xe1, 0x2a, 0x4a, 0x68, 0x6f, 0x42, 0xd5, 0xb3, 0xa3,
+	0x53, 0x3f, 0xc0, 0xd1, 0xd4, 0xc5, 0xea, 0x25, 0x00, 0x54, 0x8c, 0xe1, 0xcc, 0xf4, 0x70, 0xf0,
+	0xad, 0x86, 0x1c, 0x8f, 0x2c, 0x63, 0x8a, 0x97, 0x43, 0x32, 0x9e, 0xb0, 0x4d, 0xb1, 0x24, 0x29,
+	0xc4, 0xf8, 0x4b, 0x06, 0xc5, 0x81, 0x6f, 0xd9, 0x78, 0xb7, 0x45, 0x69, 0x11, 0xeb, 0x51, 0x3b,
+	0x44, 0xd3, 0x1f, 0x32, 0x87, 0x34, 0x4f, 0x7e, 0xbd, 0x38, 0x91, 0xe2, 0x36, 0xd9, 0x4a, 0x74,
+	0xe3, 0xa0, 0x5c, 0x5c, 0x93, 0xfb, 0xc0, 0x05, 0x86, 0x2c, 0x9a, 0xc0, 0xc7, 0xd3, 0x33, 0xa1,
+	0xcb, 0xda, 0xe2, 0x06, 0x8b, 0x46, 0xe0, 0x29, 0xb7, 0xe4, 0x06, 0x68, 0xe4, 0x79, 0x07, 0xb6,
+	0x08, 0xa5, 0x94, 0x78, 0x52, 0xc6, 0x81, 0x7f, 0xeb, 0x3b, 0x9e, 0x18, 0x78, 0x79, 0x6d, 0xe0,
+	0xbf, 0xf6, 0x1d, 0x8f, 0x8c, 0x63, 0x0d, 0xa9, 0x68, 0xe0, 0x6f, 0x43, 0xc5, 0xf7, 0x44, 0xbf,
+	0x95, 0xb5, 0x7e, 0xcb, 0xbe, 0x47, 0x5d, 0x7e, 0x00, 0xb5, 0xb9, 0xe3, 0xa2, 0xd2, 0x23, 0x42,
+	0x6d, 0x8d, 0x10, 0x04, 0x9a, 0x88, 0xdf, 0x01, 0xed, 0x28, 0xf0, 0x57, 0x4b, 0xb4, 0xb8, 0xaa,
+	0x6b, 0x94, 0x15, 0xc2, 0xed, 0x9e, 0xe3, 0xac, 0xe9, 0xd3, 0xf1, 0x8e, 0xf0, 0x1c, 0xb7, 0x60,
+	0x8d, 0xb4, 0x16, 0xe3, 0x47, 0x36, 0xb5, 0x6a, 0x1e, 0x1d, 0x4d, 0xe4, 0x6d, 0xf6, 0x5a, 0xab,
+	0xe6, 0xd1, 0x11, 0x75, 0xae, 0x9a, 0x7b, 0xf5, 0x97, 0x9a, 0x7b, 0x8a, 0x1e, 0x8a, 0xc4, 0xf5,
+	0x66, 0x22, 0x09, 0x12, 0xed, 0x98, 0xe8, 0xa1, 0xe8, 0x8c, 0x7d, 0x00, 0xda, 0x29, 0xc6, 0xc2,
+	0x97, 0xf6, 0xac, 0xd5, 0x54, 0xad, 0xda, 0xd4, 0x3e, 0xe5, 0x95, 0x53, 0xc7, 0xc3, 0x0f, 0xd4,
+	0xe3, 0xae, 0xb3, 0x70, 0x22, 0xba, 0xe6, 0xb8, 0xa0, 0xc7, 0x09, 0xc1, 0x0c, 0x28, 0xfb, 0xf3,
+	0x39, 0x4e, 0x5e, 0x5f, 0x23, 0x91, 0x98, 0xac, 0x6d, 0x76, 0xf9, 0x25, 0xb6, 0xd9, 0x0e, 0x34,
+	0x12, 0xe2, 0xc9, 0x73, 0x7b, 0xd6, 0x62, 0x1b, 0xc5, 0x68, 0x2d, 0xae, 0xf0, 0xd4, 0x9e, 0xa1,
+	0x6e, 0xc5, 0x5c, 0x14, 0x94, 0xe7, 0xaf, 0x6d, 0xb6, 0x11, 0xcb, 0xfe, 0xf4, 0x5b, 0x94, 0xe6,
+	0x0f, 0xa0, 0x16, 0x90, 0xf7, 0x37, 0x21, 0x27, 0xf1, 0x8a, 0xba, 0x00, 0xa9, 0x5b, 0xc8, 0x21,
+	0x48, 0xbe, 0x51, 0x54, 0x89, 0x4b, 0x48, 0x71, 0x83, 0x15, 0x52, 0x7c, 0xa7, 0xca, 0xeb, 0x04,
+	0x14, 0xb7, 0x5b, 0x64, 0x0d, 0x88, 0x2b, 0x17, 0xda, 0x85, 0xab, 0xea, 0x20, 0xc4, 0xdd, 0x0a,
+	0xed, 0x82, 0x15, 0x7f, 0xa2, 0x4b, 0x3c, 0x75, 0x3c, 0x0b, 0x19, 0x27, 0x32, 0x8f, 0x44, 0x40,
+	0xa7, 0xc4, 0x6b, 0x12, 0x36, 0x36, 0x8f, 0x42, 0xf6, 0x09, 0xd4, 0x4d, 0x21, 0xb1, 0x27, 0x8e,
+	0x37, 0xf7, 0x65, 0x1c, 0x47, 0xb2, 0x82, 0x22, 0xcb, 0x79, 0xcd, 0x4c, 0x0b, 0xec, 0x73, 0x60,
+	0x71, 0x14, 0x8e, 0x8c, 0x55, 0xc1, 0x6d, 0xd7, 0xd7, 0xb8, 0x6d, 0x4b, 0x86, 0xe1, 0x92, 0x74,
+	0xaf, 0x6d, 0x40, 0x9f, 0xc3, 0x74, 0x5d, 0xdb, 0x75, 0xc2, 0x45, 0xeb, 0x06, 0x49, 0x00, 0x15,
+	0xb4, 0x6e, 0x37, 0xbe, 0xf1, 0x6a, 0x76, 0x23, 0xae, 0x20, 0xa6, 0x16, 0xcc, 0xcc, 0xd9, 0xb1,
+	0x4d, 0x15, 0x6f, 0x92, 0xb5, 0x5f, 0xf7, 0xfc, 0xa8, 0x13, 0xc3, 0x70, 0x05, 0x85, 0x18, 0xa3,
+	0x15, 0x7c, 0x53, 0x5d, 0xc1, 0xc4, 0xa8, 0x45, 0x15, 0x23, 0x3f, 0xd9, 0x27, 0xd0, 0x88, 0xf9,
+	0x58, 0xcc, 0xf1, 0xd6, 0x76, 0x21, 0xdd, 0x4b, 0x85, 0x99, 0x6b, 0x92, 0x99, 0x69, 0x96, 0x9f,
+	0x43, 0x23, 0x88, 0x1d, 0x94, 0xc9, 0x2c, 0xb2, 0x5b, 0x6f, 0xa9, 0x73, 0x50, 0x7d, 0x17, 0x8c,
+	0x04, 0xa6, 0x25, 0xd4, 0x03, 0x9e, 0x1d, 0x46, 0xb6, 0x35, 0x71, 0x7d, 0x7f, 0x39, 0x41, 0xd9,
+	0xd3, 0xda, 0x16, 0xf1, 0x79, 0x01, 0xef, 0xfb, 0xfe, 0x12, 0x65, 0x13, 0xe3, 0x70, 0x3d, 0x58,
+	0x79, 0xa4, 0x92, 0xa4, 0xc0, 0x59, 0x06, 0xfe, 0xd4, 0x16, 0x83, 0xbc, 0x4d, 0x83, 0xbc, 0x26,
+	0xbb, 0x13, 0x64, 0x8f, 0x88, 0x8a, 0xc6, 0x7a, 0x35, 0x50, 0x41, 0x07, 0x58, 0x8f, 0x86, 0xbd,
+	0xde, 0xe6, 0x74, 0x85, 0x81, 0x4b, 0x6a, 0xd3, 0xf8, 0x21, 0x6d, 0xee, 0x62, 0x3d, 0x6a, 0xf3,
+	0x4f, 0x60, 0x4b, 0xdc, 0x0c, 0xa3, 0x6e, 0x11, 0x2c, 0xf6, 0xb6, 0x1a, 0xc2, 0xa2, 0xa0, 0xd4,
+	0x68, 0x66, 0x7a, 0xc4, 0x64, 0x0d, 0x47, 0x2d, 0xb2, 0x4f, 0xa1, 0x16, 0x7a, 0xe6, 0x32, 0x3c,
+	0xf6, 0xa3, 0x49, 0x14, 0xb6, 0xee, 0xc8, 0x90, 0x67, 0x9a, 0x2a, 0x3d, 0x8e, 0xbf, 0x38, 0xc4,
+	0x84, 0xe3, 0xd0, 0xf8, 0x9b, 0x02, 0x68, 0xb1, 0xe6, 0xc1, 0x6b, 0xc1, 0xc3, 0xc1, 0xd7, 0x83,
+	0xe1, 0xb3, 0x81, 0x7e, 0x09, 0x63, 0x14, 0x4f, 0xdb, 0xfd, 0xc3, 0xee, 0x64, 0xd4, 0x69, 0x0f,
+	0x44, 0x3e, 0x1d, 0xe5, 0x72, 0x89, 0x72, 0x9e, 0x5d, 0x86, 0xc6, 0xa3, 0xc3, 0x01, 0x5d, 0x0b,
+	0x0a, 0x50, 0x01, 0x41, 0xdd, 0xdf, 0x8a, 0x40, 0x88, 0x00, 0x15, 0x11, 0xf4, 0xa4, 0x3d, 0xee,
+	0xf2, 0x5e, 0x0c, 0x2a, 0x61, 0x2f, 0x07, 0x7c, 0xf8, 0xeb, 0x6e, 0x67, 0xac, 0x03, 0x7b, 0x1d,
+	0x2e, 0x27, 0x55, 0xe2, 0xe6, 0xf4, 0x1a, 0x86, 0x54, 0xe2, 0x6a, 0xfa, 0x15, 0x6c, 0x84, 0x77,
+	0x3b, 0x87, 0x7c, 0xd4, 0x7b, 0xda, 0x9d, 0x74, 0xc6, 0x5d, 0xfd, 0x75, 0x74, 0xea, 0x47, 0xbd,
+	0xc1, 0xd7, 0xfa, 0x55, 0x8c, 0x43, 0xe0, 0x97, 0x68, 0xfd, 0x1a, 0x85, 0x5f, 0xf6, 0xf7, 0xf5,
+	0x5b, 0xd8, 0xc4, 0x5e, 0x6f, 0x34, 0xee, 0x0d, 0x3a, 0x63, 0xfd, 0x2d, 0x8c, 0xb0, 0x3c, 0xea,
+	0xf5, 0xc7, 0x5d, 0xae, 0x6f, 0x63, 0xdd, 0x5f, 0x0f, 0x7b, 0x03, 0xfd, 0x36, 0x42, 0x47, 0xed,
+	0x27, 0x07, 0xfd, 0xae, 0x6e, 0x50, 0x8b, 0x43, 0x3e, 0xd6, 0xdf, 0xc6, 0x30, 0xc1, 0xe1, 0x00,
+	0xc7, 0x71, 0x07, 0x1b, 0xa7, 0xcf, 0x09, 0x66, 0x07, 0xbe, 0xa3, 0xc4, 0x69, 0xde, 0xc5, 0xef,
+	0x67, 0xbd, 0xc1, 0xde, 0xf0, 0x99, 0xfe, 0x1e, 0x92, 0xed, 0xf2, 0x61, 0x7b, 0xaf, 0x83, 0xe1,
+	0x9c, 0xbb, 0xd8, 0xc0, 0xe8, 0xa0, 0xdf, 0x1b, 0xeb, 0xef, 0x23, 0xd5, 0x7e, 0x7b, 0xfc, 0xb8,
+	0xcb, 0xf5, 0x7b, 0xf8, 0xdd, 0x1e, 0x8d, 0xba, 0x7c, 0xac, 0xef, 0xe0, 0x77, 0x6f, 0x40, 0xdf,
+	0x0f, 0xa9, 0xd5, 0x83, 0xbd, 0xf6, 0xb8, 0xab, 0x7f, 0x82, 0xdf, 0x7b, 0xdd, 0x7e, 0x77, 0xdc,
+	0xd5, 0x3f, 0xc5, 0x56, 0x29, 0xae, 0x34, 0xc2, 0xa5, 0xfa, 0x0c, 0x57, 0x21, 0x29, 0xd2, 0x78,
+	0x3e, 0xc7, 0x8e, 0x9e, 0xf4, 0x06, 0x87, 0x23, 0xfd, 0x0b, 0x24, 0xa6, 0x4f, 0xc2, 0x7c, 0x69,
+	0x7c, 0x0b, 0x5a, 0xac, 0x97, 0x91, 0xaa, 0x37, 0x18, 0x74, 0x31, 0x41, 0x52, 0x83, 0x62, 0xbf,
+	0xfb, 0x68, 0xac, 0xe7, 0x10, 0xc8, 0x7b, 0xfb, 0x8f, 0xc7, 0x7a, 0x1e, 0x3f, 0x87, 0x87, 0xb8,
+	0x34, 0x05, 0x5a, 0x84, 0xee, 0x93, 0x9e, 0x5e, 0xc4, 0xaf, 0xf6, 0x60, 0xdc, 0xd3, 0x4b, 0xb4,
+	0x48, 0xbd, 0xc1, 0x7e, 0xbf, 0xab, 0x97, 0x11, 0xfa, 0xa4, 0xcd, 0xbf, 0xd6, 0x2b, 0x58, 0xa9,
+	0x7d, 0x70, 0xd0, 0xff, 0x46, 0xd7, 0x8c, 0xbb, 0x50, 0x69, 0x1f, 0x1d, 0x3d, 0x41, 0x1b, 0x47,
+	0x83, 0xe2, 0x23, 0xbc, 0x47, 0xa6, 0x54, 0xcc, 0xdd, 0xe1, 0x78, 0x3c, 0x7c, 0xa2, 0xe7, 0x70,
+	0x4f, 0xc6, 0xc3, 0x03, 0x3d, 0x6f, 0x38, 0xd0, 0xc8, 0x30, 0xf1, 0x85, 0xcc, 0x8a, 0xdc, 0xc5,
+	0xcc, 0x8a, 0xe4, 0xfa, 0x43, 0x4d, 0xbc, 0x88, 0x92, 0x04, 0x09, 0x34, 0x5a, 0xfc, 0xe7, 0x76,
+	0x72, 0x87, 0xac, 0xf1, 0xa4, 0x6c, 0xcc, 0xe1, 0xf2, 0xda, 0xc9, 0x43, 0x6f, 0x36, 0x32, 0x8f,
+	0xe2, 0x74, 0xe4, 0xc8, 0x3c, 0x4a, 0x62, 0x87, 0xf9, 0x17, 0xc4, 0x0e, 0xdf, 0x82, 0xda, 0x6a,
+	0xb9, 0x24, 0x1b, 0x05, 0xb5, 0xae, 0x08, 0xe1, 0x00, 0x81, 0xfa, 0x08, 0x31, 0x6e, 0x42, 0x59,
+	0x78, 0x1d, 0x14, 0xe6, 0x89, 0xd3, 0x73, 0x0b, 0x32, 0x25, 0xd7, 0x87, 0x6a, 0x62, 0xfd, 0xb3,
+	0x7b, 0x98, 0x11, 0xb7, 0x94, 0x1e, 0x71, 0xeb, 0x82, 0x6f, 0x70, 0xff, 0x89, 0xb9, 0x14, 0x81,
+	0x01, 0x24, 0xba, 0xf1, 0x19, 0x68, 0x31, 0xe0, 0x07, 0xf9, 0xe0, 0x7f, 0xaf, 0x08, 0xd5, 0x3d,
+	0x45, 0xa9, 0xbd, 0xd4, 0x07, 0x57, 0xbc, 0xe0, 0xfc, 0x2b, 0x7b, 0xc1, 0x85, 0x97, 0x79, 0xc1,
+	0xc5, 0x1f, 0xeb, 0x05, 0x97, 0x5e, 0xcd, 0x0b, 0x2e, 0xbf, 0x8a, 0x17, 0x7c, 0x67, 0xcd, 0x0b,
+	0xae, 0x50, 0xeb, 0x59, 0xbf, 0x37, 0xeb, 0x7d, 0x6a, 0x2f, 0xf3, 0x3e, 0xb3, 0x1e, 0x65, 0xf5,
+	0x25, 0x1e, 0x65, 0xd6, 0x57, 0x85, 0xef, 0xf5, 0x55, 0x37, 0x7a, 0x9f, 0xb5, 0x57, 0xf3, 0x3e,
+	0x6f, 0x43, 0x1d, 0x75, 0x46, 0x14, 0xac, 0x3c, 0x8c, 0x04, 0xc9, 0x64, 0xbb, 0x1a, 0xfa, 0x28,
+	0x12, 0x64, 0xfc, 0x75, 0x1e, 0x4a, 0xbf, 0xc1, 0x9c, 0x50, 0xf6, 0x19, 0x54, 0xc3, 0x68, 0x11,
+	0xa9, 0x8e, 0xc8, 0x75, 0xd1, 0x01, 0xe1, 0xc9, 0x8f, 0xb0, 0xf1, 0x86, 0x55, 0xb8, 0x23, 0x48,
+	0x8b, 0x5f, 0xf4, 0xf0, 0x25, 0xb2, 0x97, 0xe2, 0xc2, 0xb8, 0xc4, 0x45, 0x01, 0x2d, 0x52, 0xf4,
+	0x4a, 0xe2, 0x00, 0x0d, 0xa4, 0x9e, 0x01, 0x17, 0x08, 0xb4, 0x48, 0xe9, 0x8e, 0x23, 0xdc, 0xe0,
+	0x84, 0x48, 0x0c, 0x1e, 0xe5, 0x63, 0xdb, 0x44, 0x53, 0x2b, 0xce, 0xcf, 0x4a, 0xca, 0x78, 0x8f,
+	0xe1, 0xfa, 0xa6, 0x35, 0x36, 0x8f, 0xe2, 0x3c, 0x48, 0x59, 0x34, 0x9e, 0x41, 0x23, 0x33, 0xd8,
+	0xac, 0x06, 0x43, 0xc1, 0xd5, 0xed, 0xa3, 0xf0, 0xcc, 0x29, 0xf2, 0x36, 0xaf, 0xc8, 0xd8, 0x82,
+	0x22, 0x7b, 0x8b, 0x24, 0x4d, 0xbb, 0x7c, 0xbf, 0xab, 0x97, 0x8c, 0x7f, 0x94, 0x87, 0xcb, 0xe3,
+	0xc0, 0xf4, 0x42, 0x53, 0x5c, 0x88, 0x7b, 0x51, 0xe0, 0xbb, 0xec, 0x2b, 0xd0, 0xa2, 0x99, 0xab,
+	0xae, 0xdb, 0x5b, 0x72, 0xe7, 0x2f, 0x92, 0xde, 0x1f, 0xcf, 0x5c, 0x91, 0xee, 0x14, 0x89, 0x0f,
+	0xf6, 0x11, 0x94, 0xa6, 0xf6, 0x91, 0xe3, 0x49, 0x49, 0xf3, 0xfa, 0xc5, 0x8a, 0xbb, 0x88, 0xc4,
+	0x87, 0x37, 0x44, 0xc5, 0x7e, 0x86, 0x39, 0xa8, 0x8b, 0x58, 0xe4, 0xa4, 0x17, 0x7b, 0x4a, 0x47,
+	0x88, 0xc5, 0xc7, 0x35, 0x82, 0x8e, 0x7d, 0x86, 0xa9, 0xf2, 0xae, 0x3b, 0x35, 0x67, 0x27, 0x32,
+	0xc5, 0xa2, 0x75, 0xb1, 0x0e, 0x97, 0xf8, 0xc7, 0x97, 0x78, 0x42, 0x6b, 0xdc, 0x87, 0x8a, 0x1c,
+	0x2c, 0x2e, 0xc0, 0x6e, 0x77, 0xbf, 0x27, 0xd7, 0xae, 0x33, 0x7c, 0xf2, 0xa4, 0x37, 0x16, 0x09,
+	0x42, 0x7c, 0xd8, 0xef, 0xef, 0xb6, 0x3b, 0x5f, 0xeb, 0xf9, 0x5d, 0x0d, 0xca, 0x26, 0x5d, 0x6e,
+	0x19, 0x7f, 0x91, 0x83, 0xad, 0x0b, 0x13, 0x60, 0x5f, 0x40, 0x71, 0xe1, 0x5b, 0xf1, 0xf2, 0xdc,
+	0xd9, 0x38, 0x4b, 0xa5, 0x8c, 0x4a, 0x83, 0x53, 0x0d, 0xe3, 0x4b, 0x68, 0x66, 0xe1, 0x4a, 0x5a,
+	0x79, 0x03, 0xaa, 0xbc, 0xdb, 0xde, 0x9b, 0x0c, 0x07, 0xfd, 0x6f, 0x84, 0x29, 0x42, 0xc5, 0x67,
+	0xbc, 0x37, 0xee, 0xea, 0x79, 0xe3, 0xcf, 0x40, 0xbf, 0xb8, 0x30, 0x6c, 0x1f, 0xb6, 0xf0, 0xf6,
+	0xd1, 0xb5, 0x11, 0xa6, 0x6e, 0xd9, 0xad, 0x0d, 0x2b, 0x29, 0xc9, 0x68, 0xc7, 0x9a, 0xb3, 0x4c,
+	0xd9, 0xf8, 0x5b, 0xc0, 0xd6, 0x57, 0xf0, 0xa7, 0x6b, 0xfe, 0x9f, 0xe6, 0xa0, 0x78, 0xe0, 0x9a,
+	0x98, 0x45, 0x52, 0xa2, 0x94, 0xed, 0x56, 0x4e, 0xf5, 0xe9, 0xe9, 0x44, 0x22, 0x5b, 0x10, 0x8e,
+	0x7d, 0x00, 0x85, 0x68, 0xe6, 0x4a, 0x1e, 0xba, 0xf6, 0x02, 0xe6, 0xc3, 0xec, 0xea, 0x68, 0x86,
+	0x01, 0xce, 0x82, 0x65, 0xc5, 0x97, 0x3d, 0xf2, 0xf6, 0x1b, 0x1d, 0xa8, 0x3d, 0x7b, 0xee, 0x78,
+	0x8e, 0x4c, 0x20, 0x47, 0x12, 0x4c, 0x21, 0xb7, 0x66, 0x6e, 0xab, 0xa8, 0x3a, 0x34, 0x48, 0xa9,
+	0x34, 0x68, 0xcd, 0x5c, 0x4c, 0xd7, 0x46, 0x94, 0xf1, 0x21, 0x25, 0x48, 0xaf, 0x16, 0x98, 0x77,
+	0x29, 0xbf, 0x36, 0xdc, 0x98, 0x48, 0x8c, 0xf1, 0x7f, 0xf3, 0x50, 0x53, 0x1a, 0x63, 0x9f, 0x80,
+	0x66, 0xcd, 0xdc, 0x0d, 0xd2, 0x47, 0x21, 0xba, 0xbf, 0x17, 0x9f, 0x1f, 0x4b, 0x7c, 0xe0, 0x05,
+	0x31, 0x8a, 0xc6, 0xe7, 0x66, 0xe0, 0xa0, 0x98, 0x0d, 0x5b, 0x79, 0xd5, 0x4f, 0x18, 0xd9, 0xd1,
+	0xd3, 0x18, 0x83, 0x6f, 0xa5, 0x42, 0xa5, 0xcc, 0xde, 0xc7, 0x24, 0x64, 0x7b, 0x69, 0x06, 0xb6,
+	0x5c, 0x8b, 0x46, 0x7c, 0x25, 0x4c, 0x40, 0x7c, 0x3a, 0x25, 0xf1, 0x48, 0x6a, 0x9f, 0xd9, 0xb3,
+	0x55, 0x64, 0xb7, 0x8a, 0x2a, 0x69, 0x57, 0x00, 0x91, 0x54, 0xe2, 0xd9, 0x0e, 0x3a, 0x98, 0xa6,
+	0xeb, 0xfa, 0x24, 0x70, 0x4b, 0xaa, 0xdf, 0xba, 0x97, 0xc0, 0xc5, 0xbb, 0xab, 0xb8, 0x64, 0x1c,
+	0x41, 0x45, 0x4e, 0x0c, 0xad, 0x39, 0xcc, 0xb3, 0x7b, 0xda, 0xe6, 0x3d, 0xb4, 0xaa, 0xe5, 0xf5,
+	0xd4, 0x3e, 0x6f, 0x0f, 0xa4, 0xb8, 0xe2, 0xdd, 0xa7, 0xc3, 0xaf, 0xf1, 0xe5, 0x04, 0xdd, 0x23,
+	0x0e, 0xbe, 0xd1, 0x0b, 0xc2, 0x72, 0xee, 0x1e, 0xb4, 0x39, 0x4a, 0xab, 0x1a, 0x54, 0xba, 0xbf,
+	0xed, 0x76, 0x0e, 0xc7, 0x5d, 0xbd, 0x84, 0x27, 0x62, 0xaf, 0xdb, 0xee, 0xf7, 0x87, 0x1d, 0x14,
+	0x65, 0xe5, 0xdd, 0x2a, 0x26, 0xcd, 0xd0, 0x4a, 0x1a, 0xff, 0xb2, 0x01, 0xcd, 0xec, 0xae, 0xb3,
+	0xcf, 0x41, 0xb3, 0xac, 0xcc, 0x0e, 0xdc, 0xdc, 0xc4, 0x1d, 0xf7, 0xf7, 0xac, 0x78, 0x13, 0xc4,
+	0x07, 0xc6, 0x9d, 0x04, 0x8f, 0xe6, 0xd7, 0x78, 0x34, 0xe6, 0xd0, 0x5f, 0xc2, 0x96, 0x4c, 0x14,
+	0x46, 0x7f, 0x7e, 0x6a, 0x86, 0x76, 0x96, 0x01, 0x3b, 0x84, 0xdc, 0x93, 0xb8, 0xc7, 0x97, 0x78,
+	0x73, 0x96, 0x81, 0xb0, 0x9f, 0x43, 0xd3, 0x24, 0x87, 0x2a, 0xa9, 0x5f, 0x54, 0x9d, 0xa0, 0x36,
+	0xe2, 0x94, 0xea, 0x0d, 0x53, 0x05, 0x20, 0x9b, 0x58, 0x81, 0xbf, 0x4c, 0x2b, 0x97, 0x54, 0x36,
+	0xd9, 0x0b, 0xfc, 0xa5, 0x52, 0xb7, 0x6e, 0x29, 0x65, 0xf6, 0x19, 0xd4, 0xe5, 0xc8, 0xd3, 0x87,
+	0x9c, 0xc9, 0x69, 0x10, 0xc3, 0x26, 0x0d, 0x8f, 0x2f, 0x04, 0x67, 0x69, 0x91, 0x3d, 0x84, 0x9a,
+	0x18, 0xb0, 0xa8, 0x56, 0x51, 0x39, 0x81, 0x46, 0x1b, 0xd7, 0x02, 0x33, 0x29, 0xb1, 0x9f, 0x01,
+	0xd0, 0x38, 0xd5, 0xfb, 0x9e, 0xad, 0x74, 0x90, 0x71, 0x95, 0xaa, 0x15, 0x17, 0x94, 0xe1, 0x89,
+	0xd4, 0x8d, 0xea, 0xfa, 0xf0, 0xc8, 0xb6, 0x4e, 0x87, 0x17, 0xa7, 0x6a, 0xc8, 0xe1, 0x89, 0x6a,
+	0xb0, 0x36, 0xbc, 0xb8, 0x16, 0x98, 0x49, 0x29, 0x19, 0x9e, 0xa8, 0x53, 0xbb, 0x38, 0xbc, 0xb8,
+	0x4a, 0xd5, 0x8a, 0x0b, 0xb8, 0x6d, 0xb1, 0xf5, 0x21, 0x27, 0x55, 0xcf, 0xa4, 0x1c, 0x49, 0x5c,
+	0x3c, 0xb1, 0x46, 0xa4, 0x02, 0xb0, 0x76, 0x78, 0xec, 0x9f, 0x2a, 0xc7, 0xbb, 0xa1, 0xd6, 0x1e,
+	0x1d, 0xfb, 0xa7, 0xea, 0xf9, 0x6e, 0x84, 0x2a, 0x00, 0x47, 0x2b, 0xa6, 0x48, 0x19, 0x5b, 0x4d,
+	0x75, 0xb4, 0x34, 0x43, 0xcc, 0xb1, 0xc1, 0xd1, 0x9a, 0x71, 0x01, 0x17, 0x85, 0x52, 0x2c, 0x22,
+	0xd1, 0xd9, 0x96, 0xba, 0x28, 0x94, 0x58, 0x12, 0xf7, 0x04, 0x6e, 0x52, 0x42, 0xde, 0x5a, 0x79,
+	0x6a, 0x35, 0x5d, 0xe5, 0xad, 0x43, 0x2f, 0x53, 0xb1, 0x2e, 0x48, 0x65, 0xd5, 0xf4, 0x54, 0x84,
+	0xf6, 0x77, 0x2b, 0xdb, 0x9b, 0xd9, 0xad, 0xcb, 0xeb, 0xa7, 0x62, 0x24, 0x71, 0xe9, 0xa9, 0x88,
+	0x21, 0x09, 0x5f, 0x27, 0xd5, 0xd9, 0x45, 0xbe, 0x56, 0x2a, 0xd7, 0x2d, 0xa5, 0x9c, 0x1e, 0xa8,
+	0xa4, 0xee, 0x6b, 0x6b, 0x07, 0x4a, 0xa9, 0xdc, 0x30, 0x55, 0x80, 0xf1, 0xbf, 0x8b, 0x50, 0x91,
+	0x72, 0x00, 0xdf, 0x65, 0x75, 0x78, 0xb7, 0x3d, 0xee, 0x4e, 0xf6, 0xda, 0xe3, 0xf6, 0x6e, 0x7b,
+	0x84, 0xba, 0x99, 0x41, 0xb3, 0x8d, 0x8e, 0x75, 0x0a, 0xcb, 0xa1, 0x70, 0xdb, 0xe3, 0xc3, 0x83,
+	0x14, 0x94, 0xc7, 0x57, 0x5e, 0xb2, 0xae, 0x78, 0x11, 0x56, 0xc0, 0x8c, 0x02, 0x51, 0x51, 0x00,
+	0x28, 0x2b, 0x82, 0x6a, 0x89, 0x72, 0x49, 0xa9, 0xd2, 0x1b, 0xec, 0x75, 0x7f, 0xab, 0x97, 0xd3,
+	0x2a, 0x02, 0x50, 0x49, 0xaa, 0x88, 0xb2, 0x86, 0x83, 0x19, 0xf3, 0xc3, 0x41, 0x27, 0xed, 0xa7,
+	0x8a, 0x95, 0x64, 0x33, 0x4f, 0x7b, 0xdd, 0x67, 0x3a, 0x60, 0x25, 0xd1, 0x0a, 0x95, 0x6b, 0x68,
+	0x5d, 0x50, 0x23, 0x54, 0xac, 0xb3, 0x6b, 0xf0, 0xda, 0xe8, 0xf1, 0xf0, 0xd9, 0x44, 0x54, 0x4a,
+	0xa6, 0xd0, 0x60, 0x57, 0x40, 0x57, 0x10, 0xa2, 0xf9, 0x26, 0x76, 0x49, 0xd0, 0x98, 0x70, 0xa4,
+	0x6f, 0x61, 0x97, 0x04, 0x1b, 0x0b, 0xd1, 0xae, 0xe3, 0x54, 0x44, 0xd5, 0x61, 0xff, 0xf0, 0xc9,
+	0x60, 0xa4, 0x5f, 0xc6, 0x41, 0x10, 0x44, 0x8c, 0x9c, 0x25, 0xcd, 0xa4, 0x0a, 0xe1, 0x35, 0xd2,
+	0x11, 0x08, 0x7b, 0xd6, 0xe6, 0x83, 0xde, 0x60, 0x7f, 0xa4, 0x5f, 0x49, 0x5a, 0xee, 0x72, 0x3e,
+	0xe4, 0x23, 0xfd, 0xf5, 0x04, 0x30, 0x1a, 0xb7, 0xc7, 0x87, 0x23, 0xfd, 0x6a, 0x32, 0xca, 0x03,
+	0x3e, 0xec, 0x74, 0x47, 0xa3, 0x7e, 0x6f, 0x34, 0xd6, 0xaf, 0x61, 0x9c, 0x25, 0x1d, 0x51, 0x4c,
+	0xdc, 0x52, 0x06, 0xca, 0xf7, 0xbb, 0x63, 0xfd, 0x7a, 0x32, 0x8c, 0xce, 0xb0, 0x8f, 0x8f, 0xf5,
+	0x86, 0x03, 0xfd, 0x06, 0x12, 0xf5, 0x87, 0x9d, 0xaf, 0xe3, 0xd9, 0xbc, 0x81, 0xe3, 0x3a, 0x1c,
+	0xa8, 0xa0, 0x9b, 0x0a, 0x6b, 0x8c, 0xba, 0xbf, 0x39, 0xec, 0x0e, 0x3a, 0x5d, 0xfd, 0xcd, 0x94,
+	0x35, 0x12, 0xd8, 0xad, 0x84, 0x35, 0x12, 0xd0, 0x5b, 0x49, 0x9f, 0x31, 0x68, 0xa4, 0x6f, 0xef,
+	0xd6, 0xe9, 0x0d, 0xb3, 0x54, 0x44, 0xc6, 0x01, 0x34, 0xb3, 0x7a, 0x03, 0x1f, 0x7c, 0x38, 0xf3,
+	0x09, 0x06, 0x31, 0xe9, 0x71, 0x44, 0x28, 0x9f, 0xa2, 0xd4, 0x9c, 0xf9, 0xc0, 0x8f, 0xe8, 0x75,
+	0x04, 0xf9, 0x14, 0x89, 0x1a, 0x10, 0xb1, 0x83, 0xa4, 0x6c, 0x3c, 0x86, 0x46, 0x46, 0x93, 0xe0,
+	0x9d, 0x93, 0x33, 0xcf, 0x36, 0xa6, 0x39, 0xf3, 0x57, 0x68, 0x69, 0x1f, 0xea, 0xaa, 0x5a, 0xf9,
+	0xf1, 0x0d, 0xbd, 0x05, 0xd5, 0x47, 0x27, 0xf1, 0x63, 0x15, 0xf5, 0xbd, 0x4c, 0x55, 0xe6, 0x3d,
+	0xfd, 0x55, 0x1e, 0x6a, 0x8a, 0x1e, 0x7a, 0xa5, 0x35, 0xb8, 0x09, 0xd5, 0xc8, 0x5e, 0x2c, 0xfd,
+	0xc0, 0x94, 0x5a, 0x5b, 0xe3, 0x29, 0x20, 0x33, 0x9c, 0x42, 0x76, 0x38, 0xd9, 0x3b, 0x82, 0xe2,
+	0x4b, 0xee, 0x08, 0x1e, 0x40, 0x5d, 0x79, 0xd4, 0x12, 0xca, 0x0b, 0xf5, 0x8b, 0xf4, 0xb5, 0xf4,
+	0x81, 0x4b, 0x88, 0xf9, 0xb3, 0xf3, 0x93, 0x89, 0x35, 0x15, 0x19, 0xb9, 0x55, 0x4c, 0x03, 0xdd,
+	0x9b, 0x52, 0xf6, 0xdb, 0x3c, 0x11, 0xb0, 0x15, 0xc2, 0x68, 0xf3, 0x58, 0x8c, 0xde, 0x85, 0xca,
+	0xfc, 0x44, 0xe4, 0x45, 0x66, 0x1c, 0xf5, 0x64, 0xdd, 0x78, 0x79, 0x7e, 0x42, 0xcf, 0xf9, 0xfe,
+	0x7e, 0x0e, 0x9a, 0xa9, 0xf2, 0xc5, 0x0d, 0x62, 0xf7, 0xc4, 0x6b, 0x3b, 0x61, 0xf0, 0xb4, 0x2e,
+	0xea, 0x67, 0x24, 0xc1, 0x17, 0x2a, 0xe2, 0xed, 0xdd, 0xa6, 0xf7, 0x02, 0xfb, 0x50, 0x18, 0x9f,
+	0x2f, 0x85, 0x67, 0x84, 0xa7, 0x58, 0x58, 0x6c, 0xe2, 0xfc, 0x52, 0x8c, 0xeb, 0xeb, 0xee, 0x37,
+	0x22, 0xd9, 0xeb, 0x80, 0xf7, 0x9e, 0xb4, 0xf9, 0x37, 0x13, 0x04, 0x90, 0x9c, 0x7b, 0x34, 0xe4,
+	0xdd, 0xde, 0xfe, 0x80, 0x00, 0x45, 0xf2, 0x9b, 0xd2, 0x8e, 0xdb, 0x96, 0xf5, 0xe8, 0x44, 0x7d,
+	0xf6, 0x9b, 0xcb, 0x3c, 0xfb, 0x7d, 0x49, 0x08, 0x2c, 0xe6, 0x93, 0x42, 0xca, 0x27, 0x98, 0xc7,
+	0x8b, 0x29, 0xb5, 0x59, 0xbb, 0x29, 0x9b, 0x73, 0x4b, 0x04, 0x46, 0x00, 0x97, 0xd3, 0x71, 0xc4,
+	0x89, 0xe4, 0xdb, 0x99, 0x04, 0xbc, 0x4d, 0x49, 0xc9, 0xdb, 0x50, 0xc2, 0xd8, 0xd8, 0xa6, 0xc7,
+	0xc1, 0x02, 0x81, 0xb9, 0xeb, 0x8e, 0x37, 0x59, 0xba, 0xe6, 0xcc, 0x96, 0x81, 0xb9, 0x8a, 0xe3,
+	0x1d, 0x60, 0xd1, 0xf8, 0x3b, 0x05, 0x80, 0xb4, 0xd3, 0x0c, 0x07, 0xe6, 0xbe, 0x8f, 0x03, 0x5f,
+	0x21, 0x83, 0xc8, 0x09, 0x27, 0xd9, 0xeb, 0x90, 0x42, 0x9c, 0xae, 0xaf, 0x5e, 0x85, 0xb0, 0x07,
+	0x50, 0x11, 0x0e, 0x6c, 0x1c, 0x8f, 0xb8, 0x76, 0x91, 0x17, 0xee, 0xcb, 0xb7, 0x34, 0x31, 0xdd,
+	0x8d, 0xbf, 0xc9, 0x41, 0x59, 0xc0, 0x28, 0xdf, 0x36, 0xf0, 0xe3, 0xe7, 0xc4, 0x57, 0x36, 0xb1,
+	0x11, 0xfd, 0xb2, 0x05, 0x72, 0xdc, 0x7d, 0x28, 0x9b, 0x96, 0x35, 0x99, 0x9f, 0x64, 0x9d, 0xfe,
+	0x0b, 0x7b, 0x8f, 0xde, 0x9d, 0x89, 0x1f, 0xec, 0x61, 0x9a, 0xc3, 0x5f, 0x50, 0x3d, 0xbc, 0xb5,
+	0x4d, 0x42, 0x3f, 0x44, 0x52, 0xe2, 0xed, 0x2c, 0x76, 0x22, 0x2c, 0xb5, 0xe2, 0x8b, 0x8d, 0x42,
+	0xcd, 0xb4, 0x2c, 0xfa, 0x56, 0x3c, 0xf8, 0xff, 0x93, 0x83, 0x6a, 0x62, 0x6e, 0xfe, 0x68, 0xc9,
+	0x95, 0xfe, 0xf6, 0x49, 0x41, 0xfd, 0xed, 0x93, 0x7b, 0x70, 0xf9, 0xe2, 0x43, 0x37, 0xb1, 0xe2,
+	0x55, 0xbe, 0x95, 0x7d, 0xe9, 0x16, 0xae, 0xdf, 0x64, 0x95, 0x5e, 0xf1, 0x26, 0xeb, 0x3a, 0x08,
+	0x16, 0xc0, 0x3b, 0xf2, 0x32, 0xe5, 0xe1, 0x57, 0xa8, 0xdc, 0xb3, 0x2e, 0xbe, 0xdd, 0xaa, 0x6c,
+	0x17, 0xb2, 0x6f, 0xb7, 0x8c, 0xef, 0xa0, 0x9a, 0x98, 0x87, 0x3f, 0x7e, 0xf2, 0x3f, 0x44, 0x4e,
+	0x1a, 0x7f, 0x1e, 0x2b, 0xb2, 0xc4, 0x3a, 0xfb, 0xff, 0x54, 0x64, 0xd9, 0xee, 0x0b, 0x2f, 0xe9,
+	0xfe, 0x4c, 0xe8, 0xaa, 0xa4, 0xf3, 0x9f, 0x78, 0xc7, 0xd5, 0xcd, 0x28, 0x66, 0x36, 0xc3, 0xd8,
+	0x92, 0xfa, 0x36, 0xb1, 0x2b, 0xff, 0x55, 0x2e, 0x56, 0x66, 0xc2, 0x7f, 0xf8, 0x3e, 0x41, 0x90,
+	0xf4, 0x96, 0x57, 0x7b, 0xfb, 0x1c, 0x5a, 0x32, 0x51, 0x5e, 0x74, 0x2a, 0x5f, 0x16, 0x4f, 0x50,
+	0xf4, 0x89, 0x61, 0xbd, 0x2e, 0xf0, 0xb4, 0x10, 0xe9, 0x3b, 0x06, 0x4c, 0x9e, 0x7c, 0xe1, 0x69,
+	0x11, 0x3c, 0x26, 0xf0, 0x17, 0x1f, 0x60, 0x96, 0x2e, 0x3e, 0xc0, 0x34, 0x0c, 0x29, 0xcb, 0xc4,
+	0x14, 0xae, 0xc4, 0xed, 0xc6, 0x8f, 0x47, 0xb1, 0x60, 0xfc, 0x85, 0x3c, 0x63, 0x3f, 0x76, 0x9a,
+	0xd9, 0x2b, 0x92, 0xc2, 0xc5, 0x2b, 0x92, 0x4d, 0xcf, 0x49, 0x8b, 0x9b, 0x9e, 0x93, 0x1a, 0x7f,
+	0xcc, 0x41, 0x23, 0xe3, 0x86, 0xfd, 0x88, 0xc1, 0x6c, 0x3c, 0xd3, 0x85, 0x57, 0x3c, 0xd3, 0xc5,
+	0x1f, 0x71, 0xa6, 0x4b, 0xdf, 0x7b, 0xa6, 0xcb, 0x6b, 0x67, 0xfa, 0xef, 0xe6, 0x92, 0xb7, 0x81,
+	0xa2, 0xb1, 0x4d, 0x7a, 0x21, 0xb7, 0x51, 0x2f, 0xdc, 0x02, 0x30, 0x67, 0x94, 0x24, 0xd4, 0xdb,
+	0x13, 0xba, 0xad, 0xc1, 0x15, 0x08, 0xfb, 0x12, 0xae, 0x0b, 0x99, 0x2b, 0x64, 0xed, 0xc4, 0x9f,
+	0x4f, 0x62, 0x6c, 0x9c, 0xdb, 0x7b, 0x55, 0x10, 0x88, 0x67, 0xb6, 0xf3, 0x76, 0x8c, 0x35, 0x7a,
+	0xd0, 0xc8, 0xb8, 0xb0, 0xca, 0xef, 0xd9, 0xe4, 0xd4, 0xdf, 0xb3, 0x41, 0xd5, 0x7a, 0x7a, 0x6c,
+	0x07, 0xf6, 0x26, 0xd5, 0x4a, 0x08, 0xfc, 0x95, 0x03, 0x35, 0xd8, 0xc5, 0x3e, 0x84, 0x92, 0x13,
+	0xd9, 0x8b, 0x58, 0x5f, 0x5f, 0x5d, 0x8f, 0x87, 0xd1, 0xbb, 0x37, 0x41, 0x64, 0xfc, 0x21, 0x07,
+	0xfa, 0x45, 0x9c, 0xf2, 0xa3, 0x3b, 0xb9, 0x17, 0xfc, 0xe8, 0x4e, 0x3e, 0x33, 0xc8, 0x0d, 0x3f,
+	0x9c, 0x93, 0xe6, 0x97, 0x16, 0x5f, 0x90, 0x5f, 0xca, 0xde, 0x05, 0x2d, 0xb0, 0xe9, 0x87, 0x4e,
+	0xac, 0x0d, 0xc9, 0xcd, 0x09, 0xce, 0xf8, 0xcb, 0x1c, 0x54, 0x64, 0x64, 0x6e, 0xe3, 0xf3, 0x89,
+	0xf7, 0xa1, 0x22, 0x7e, 0xf4, 0x24, 0x7c, 0xd1, 0x85, 0x55, 0x8c, 0xc7, 0xcb, 0x3d, 0x44, 0x65,
+	0xd3, 0xdd, 0x31, 0xd8, 0xca, 0x09, 0x8e, 0xdc, 0x44, 0xd7, 0x0f, 0x14, 0x09, 0x13, 0xba, 0xa9,
+	0x44, 0x2f, 0x04, 0xcd, 0x05, 0xfa, 0xbb, 0xa1, 0xf1, 0x0b, 0xa8, 0xc8, 0xc8, 0xdf, 0xc6, 0xa1,
+	0xbc, 0xec, 0x47, 0x52, 0xb6, 0x01, 0xd2, 0x50, 0xe0, 0xa6, 0x16, 0x0c, 0x57, 0x3e, 0x18, 0xc1,
+	0xd0, 0x01, 0x5d, 0x88, 0x7e, 0x8c, 0xbf, 0xb4, 0x20, 0x9f, 0xc0, 0xe4, 0x5e, 0xfc, 0x04, 0x26,
+	0x21, 0x62, 0xf7, 0x20, 0x11, 0xef, 0x2f, 0xb3, 0x91, 0x8c, 0x36, 0x40, 0x1a, 0xa3, 0xc0, 0x37,
+	0x93, 0xc9, 0x43, 0x9a, 0x98, 0x7d, 0x2e, 0x76, 0x86, 0x63, 0xe2, 0x0a, 0x99, 0xd1, 0x84, 0xba,
+	0x1a, 0xe8, 0xb8, 0xf7, 0x01, 0xd4, 0xd5, 0xdf, 0xb5, 0xa0, 0x98, 0xbd, 0xef, 0xd9, 0xe2, 0x1d,
+	0x44, 0xff, 0x77, 0x9f, 0x88, 0x77, 0x10, 0x7f, 0x1a, 0x46, 0x96, 0x9e, 0xbf, 0xf7, 0xe7, 0xca,
+	0x7b, 0x42, 0xa2, 0x96, 0x46, 0x33, 0x25, 0x1b, 0xf4, 0x7b, 0x83, 0x6e, 0x9b, 0x93, 0x89, 0x4c,
+	0x75, 0x1e, 0xb7, 0x47, 0x8f, 0x85, 0x39, 0x2d, 0x31, 0x04, 0x28, 0xa4, 0x49, 0xfc, 0x94, 0x5c,
+	0x40, 0x9f, 0x89, 0x5b, 0x5d, 0xc2, 0x8a, 0xe4, 0xf1, 0x96, 0xd1, 0xe5, 0xc6, 0xaf, 0x04, 0x57,
+	0xb9, 0xf7, 0x2b, 0x68, 0xbd, 0x28, 0x2c, 0x8f, 0xad, 0x76, 0x1e, 0xb7, 0xe9, 0xea, 0xa3, 0x0e,
+	0xda, 0x60, 0x38, 0x11, 0xa5, 0x1c, 0x86, 0x59, 0x79, 0xb7, 0xdf, 0xa5, 0x20, 0xc6, 0xbd, 0xdf,
+	0xe7, 0x94, 0xfd, 0x8a, 0xc3, 0xb8, 0x09, 0x40, 0x4e, 0x5c, 0x05, 0x71, 0xdb, 0xb4, 0xf4, 0x1c,
+	0xbb, 0x0a, 0x2c, 0x03, 0xea, 0xfb, 0x33, 0xd3, 0xd5, 0xf3, 0x14, 0xae, 0x88, 0xe1, 0xcf, 0x02,
+	0x27, 0xb2, 0xf5, 0x02, 0x7b, 0x13, 0xae, 0x27, 0xb0, 0xbe, 0x7f, 0x7a, 0x10, 0x38, 0xf8, 0x20,
+	0xf5, 0x5c, 0xa0, 0x8b, 0xbb, 0xbf, 0xfc, 0xd7, 0x7f, 0xbc, 0x95, 0xfb, 0x77, 0x7f, 0xbc, 0x95,
+	0xfb, 0xcf, 0x7f, 0xbc, 0x75, 0xe9, 0x0f, 0xff, 0xf5, 0x56, 0xee, 0x4f, 0xd5, 0x5f, 0xcf, 0x5b,
+	0x98, 0x51, 0xe0, 0x9c, 0x09, 0xb5, 0x17, 0x17, 0x3c, 0xfb, 0xe3, 0xe5, 0xc9, 0xd1, 0xc7, 0xcb,
+	0xe9, 0xc7, 0xb8, 0xb7, 0xd3, 0x32, 0xfd, 0x66, 0xde, 0xc3, 0xff, 0x37, 0x00, 0x48, 0x05, 0x2c,
+	0x2b, 0x87, 0x4f, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -8419,6 +9197,13 @@ func (m *Type) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Enumvalues) > 0 {
+		i -= len(m.Enumvalues)
+		copy(dAtA[i:], m.Enumvalues)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Enumvalues)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Table) > 0 {
 		i -= len(m.Table)
 		copy(dAtA[i:], m.Table)
@@ -9766,6 +10551,23 @@ func (m *ColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CompressLevel != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.CompressLevel))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Generated != nil {
+		{
+			size, err := m.Generated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Pkidx != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.Pkidx))
 		i--
@@ -9986,6 +10788,62 @@ func (m *OnUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GeneratedCol) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratedCol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratedCol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stored {
+		i--
+		if m.Stored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OriginString) > 0 {
+		i -= len(m.OriginString)
+		copy(dAtA[i:], m.OriginString)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginString)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IndexOption) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -10081,20 +10939,20 @@ func (m *PrimaryKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Cols) > 0 {
-		dAtA26 := make([]byte, len(m.Cols)*10)
-		var j25 int
+		dAtA28 := make([]byte, len(m.Cols)*10)
+		var j27 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j25++
+				j27++
 			}
-			dAtA26[j25] = uint8(num)
-			j25++
+			dAtA28[j27] = uint8(num)
+			j27++
 		}
-		i -= j25
-		copy(dAtA[i:], dAtA26[:j25])
-		i = encodeVarintPlan(dAtA, i, uint64(j25))
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintPlan(dAtA, i, uint64(j27))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -10125,6 +10983,69 @@ func (m *IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.JsonUnquote {
+		i--
+		if m.JsonUnquote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.OriginString) > 0 {
+		i -= len(m.OriginString)
+		copy(dAtA[i:], m.OriginString)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginString)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.MultiValued {
+		i--
+		if m.MultiValued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.KeyType != nil {
+		{
+			size, err := m.KeyType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.JsonPath) > 0 {
+		i -= len(m.JsonPath)
+		copy(dAtA[i:], m.JsonPath)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.JsonPath)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Parser) > 0 {
+		i -= len(m.Parser)
+		copy(dAtA[i:], m.Parser)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Parser)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Fulltext {
+		i--
+		if m.Fulltext {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Option != nil {
 		{
 			size, err := m.Option.MarshalToSizedBuffer(dAtA[:i])
@@ -10232,20 +11153,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if len(m.ForeignCols) > 0 {
-		dAtA29 := make([]byte, len(m.ForeignCols)*10)
-		var j28 int
+		dAtA32 := make([]byte, len(m.ForeignCols)*10)
+		var j31 int
 		for _, num := range m.ForeignCols {
 			for num >= 1<<7 {
-				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j28++
+				j31++
 			}
-			dAtA29[j28] = uint8(num)
-			j28++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
-		i -= j28
-		copy(dAtA[i:], dAtA29[:j28])
-		i = encodeVarintPlan(dAtA, i, uint64(j28))
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintPlan(dAtA, i, uint64(j31))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -10255,20 +11176,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.Cols) > 0 {
-		dAtA31 := make([]byte, len(m.Cols)*10)
-		var j30 int
+		dAtA34 := make([]byte, len(m.Cols)*10)
+		var j33 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j30++
+				j33++
 			}
-			dAtA31[j30] = uint8(num)
-			j30++
+			dAtA34[j33] = uint8(num)
+			j33++
 		}
-		i -= j30
-		copy(dAtA[i:], dAtA31[:j30])
-		i = encodeVarintPlan(dAtA, i, uint64(j30))
+		i -= j33
+		copy(dAtA[i:], dAtA34[:j33])
+		i = encodeVarintPlan(dAtA, i, uint64(j33))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10306,6 +11227,23 @@ func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Enforced {
+		i--
+		if m.Enforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OriginString) > 0 {
+		i -= len(m.OriginString)
+		copy(dAtA[i:], m.OriginString)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginString)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Check != nil {
 		{
 			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
@@ -11000,20 +11938,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RefChildTbls) > 0 {
-		dAtA42 := make([]byte, len(m.RefChildTbls)*10)
-		var j41 int
+		dAtA45 := make([]byte, len(m.RefChildTbls)*10)
+		var j44 int
 		for _, num := range m.RefChildTbls {
 			for num >= 1<<7 {
-				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j41++
+				j44++
 			}
-			dAtA42[j41] = uint8(num)
-			j41++
+			dAtA45[j44] = uint8(num)
+			j44++
 		}
-		i -= j41
-		copy(dAtA[i:], dAtA42[:j41])
-		i = encodeVarintPlan(dAtA, i, uint64(j41))
+		i -= j44
+		copy(dAtA[i:], dAtA45[:j44])
+		i = encodeVarintPlan(dAtA, i, uint64(j44))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -11421,6 +12359,116 @@ func (m *OrderBySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FrameBound) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameBound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Val != nil {
+		{
+			size, err := m.Val.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Unbounded {
+		i--
+		if m.Unbounded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrameClause) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameClause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameClause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WindowSpec) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -11445,6 +12493,30 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Frame != nil {
+		{
+			size, err := m.Frame.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WindowFunc != nil {
+		{
+			size, err := m.WindowFunc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Lag != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.Lag))
 		i--
@@ -11486,6 +12558,48 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecursiveCte) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecursiveCte) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecursiveCte) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Distinct {
+		i--
+		if m.Distinct {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxDepth != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.MaxDepth))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *InsertCtx) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -11540,21 +12654,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA49 := make([]byte, len(m.IdxIdx)*10)
-		var j48 int
+		dAtA57 := make([]byte, len(m.IdxIdx)*10)
+		var j56 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA57[j56] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j48++
+				j56++
 			}
-			dAtA49[j48] = uint8(num)
-			j48++
+			dAtA57[j56] = uint8(num)
+			j56++
 		}
-		i -= j48
-		copy(dAtA[i:], dAtA49[:j48])
-		i = encodeVarintPlan(dAtA, i, uint64(j48))
+		i -= j56
+		copy(dAtA[i:], dAtA57[:j56])
+		i = encodeVarintPlan(dAtA, i, uint64(j56))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -11599,21 +12713,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA52 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j51 int
+		dAtA60 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j59 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j51++
+				j59++
 			}
-			dAtA52[j51] = uint8(num)
-			j51++
+			dAtA60[j59] = uint8(num)
+			j59++
 		}
-		i -= j51
-		copy(dAtA[i:], dAtA52[:j51])
-		i = encodeVarintPlan(dAtA, i, uint64(j51))
+		i -= j59
+		copy(dAtA[i:], dAtA60[:j59])
+		i = encodeVarintPlan(dAtA, i, uint64(j59))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -11799,21 +12913,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA56 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j55 int
+		dAtA64 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j63 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA64[j63] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j55++
+				j63++
 			}
-			dAtA56[j55] = uint8(num)
-			j55++
+			dAtA64[j63] = uint8(num)
+			j63++
 		}
-		i -= j55
-		copy(dAtA[i:], dAtA56[:j55])
-		i = encodeVarintPlan(dAtA, i, uint64(j55))
+		i -= j63
+		copy(dAtA[i:], dAtA64[:j63])
+		i = encodeVarintPlan(dAtA, i, uint64(j63))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -11832,21 +12946,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA58 := make([]byte, len(m.IdxIdx)*10)
-		var j57 int
+		dAtA66 := make([]byte, len(m.IdxIdx)*10)
+		var j65 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j57++
+				j65++
 			}
-			dAtA58[j57] = uint8(num)
-			j57++
+			dAtA66[j65] = uint8(num)
+			j65++
 		}
-		i -= j57
-		copy(dAtA[i:], dAtA58[:j57])
-		i = encodeVarintPlan(dAtA, i, uint64(j57))
+		i -= j65
+		copy(dAtA[i:], dAtA66[:j65])
+		i = encodeVarintPlan(dAtA, i, uint64(j65))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -12044,6 +13158,108 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SnapshotTs != nil {
+		{
+			size, err := m.SnapshotTs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.IndexScanInfo != nil {
+		{
+			size, err := m.IndexScanInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for iNdEx := len(m.RuntimeFilterProbeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterProbeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.NestedLoopJoin {
+		i--
+		if m.NestedLoopJoin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.RecursiveCte != nil {
+		{
+			size, err := m.RecursiveCte.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.WinSpecList) > 0 {
+		for iNdEx := len(m.WinSpecList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WinSpecList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
 	if m.InsertCtx != nil {
 		{
 			size, err := m.InsertCtx.MarshalToSizedBuffer(dAtA[:i])
@@ -12122,21 +13338,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc2
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA63 := make([]byte, len(m.BindingTags)*10)
-		var j62 int
+		dAtA74 := make([]byte, len(m.BindingTags)*10)
+		var j73 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA74[j73] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j62++
+				j73++
 			}
-			dAtA63[j62] = uint8(num)
-			j62++
+			dAtA74[j73] = uint8(num)
+			j73++
 		}
-		i -= j62
-		copy(dAtA[i:], dAtA63[:j62])
-		i = encodeVarintPlan(dAtA, i, uint64(j62))
+		i -= j73
+		copy(dAtA[i:], dAtA74[:j73])
+		i = encodeVarintPlan(dAtA, i, uint64(j73))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -12363,21 +13579,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if len(m.Children) > 0 {
-		dAtA73 := make([]byte, len(m.Children)*10)
-		var j72 int
+		dAtA84 := make([]byte, len(m.Children)*10)
+		var j83 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j72++
+				j83++
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			dAtA84[j83] = uint8(num)
+			j83++
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintPlan(dAtA, i, uint64(j72))
+		i -= j83
+		copy(dAtA[i:], dAtA84[:j83])
+		i = encodeVarintPlan(dAtA, i, uint64(j83))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -12420,6 +13636,106 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IndexScanInfo) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexScanInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexScanInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Covering {
+		i--
+		if m.Covering {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TableName) > 0 {
+		i -= len(m.TableName)
+		copy(dAtA[i:], m.TableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.TableName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IndexName) > 0 {
+		i -= len(m.IndexName)
+		copy(dAtA[i:], m.IndexName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuntimeFilterSpec) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuntimeFilterSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuntimeFilterSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpperLimit != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.UpperLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tag != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Tag))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IdList) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -12445,21 +13761,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA76 := make([]byte, len(m.List)*10)
-		var j75 int
+		dAtA88 := make([]byte, len(m.List)*10)
+		var j87 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA88[j87] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j75++
+				j87++
 			}
-			dAtA76[j75] = uint8(num)
-			j75++
+			dAtA88[j87] = uint8(num)
+			j87++
 		}
-		i -= j75
-		copy(dAtA[i:], dAtA76[:j75])
-		i = encodeVarintPlan(dAtA, i, uint64(j75))
+		i -= j87
+		copy(dAtA[i:], dAtA88[:j87])
+		i = encodeVarintPlan(dAtA, i, uint64(j87))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -12601,21 +13917,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnCascadeIdx) > 0 {
-		dAtA78 := make([]byte, len(m.OnCascadeIdx)*10)
-		var j77 int
+		dAtA90 := make([]byte, len(m.OnCascadeIdx)*10)
+		var j89 int
 		for _, num1 := range m.OnCascadeIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA90[j89] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j77++
+				j89++
 			}
-			dAtA78[j77] = uint8(num)
-			j77++
+			dAtA90[j89] = uint8(num)
+			j89++
 		}
-		i -= j77
-		copy(dAtA[i:], dAtA78[:j77])
-		i = encodeVarintPlan(dAtA, i, uint64(j77))
+		i -= j89
+		copy(dAtA[i:], dAtA90[:j89])
+		i = encodeVarintPlan(dAtA, i, uint64(j89))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -12634,21 +13950,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA80 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j79 int
+		dAtA92 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j91 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j79++
+				j91++
 			}
-			dAtA80[j79] = uint8(num)
-			j79++
+			dAtA92[j91] = uint8(num)
+			j91++
 		}
-		i -= j79
-		copy(dAtA[i:], dAtA80[:j79])
-		i = encodeVarintPlan(dAtA, i, uint64(j79))
+		i -= j91
+		copy(dAtA[i:], dAtA92[:j91])
+		i = encodeVarintPlan(dAtA, i, uint64(j91))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -12667,21 +13983,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA82 := make([]byte, len(m.IdxIdx)*10)
-		var j81 int
+		dAtA94 := make([]byte, len(m.IdxIdx)*10)
+		var j93 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA94[j93] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j81++
+				j93++
 			}
-			dAtA82[j81] = uint8(num)
-			j81++
+			dAtA94[j93] = uint8(num)
+			j93++
 		}
-		i -= j81
-		copy(dAtA[i:], dAtA82[:j81])
-		i = encodeVarintPlan(dAtA, i, uint64(j81))
+		i -= j93
+		copy(dAtA[i:], dAtA94[:j93])
+		i = encodeVarintPlan(dAtA, i, uint64(j93))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -12788,21 +14104,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA84 := make([]byte, len(m.Steps)*10)
-		var j83 int
+		dAtA96 := make([]byte, len(m.Steps)*10)
+		var j95 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j83++
+				j95++
 			}
-			dAtA84[j83] = uint8(num)
-			j83++
+			dAtA96[j95] = uint8(num)
+			j95++
 		}
-		i -= j83
-		copy(dAtA[i:], dAtA84[:j83])
-		i = encodeVarintPlan(dAtA, i, uint64(j83))
+		i -= j95
+		copy(dAtA[i:], dAtA96[:j95])
+		i = encodeVarintPlan(dAtA, i, uint64(j95))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -14099,6 +15415,71 @@ func (m *AlterTableAddFk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableColumns) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableColumns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableColumns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InPlace {
+		i--
+		if m.InPlace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Exprs) > 0 {
+		for iNdEx := len(m.Exprs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exprs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Cols) > 0 {
+		for iNdEx := len(m.Cols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -14247,6 +15628,48 @@ func (m *AlterTable_Action_AddFk) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTable_Action_Columns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_Columns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Columns != nil {
+		{
+			size, err := m.Columns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_AddIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AddIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddIndex != nil {
+		{
+			size, err := m.AddIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -14272,20 +15695,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA120 := make([]byte, len(m.ForeignTbl)*10)
-		var j119 int
+		dAtA134 := make([]byte, len(m.ForeignTbl)*10)
+		var j133 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA120[j119] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA134[j133] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j119++
+				j133++
 			}
-			dAtA120[j119] = uint8(num)
-			j119++
+			dAtA134[j133] = uint8(num)
+			j133++
 		}
-		i -= j119
-		copy(dAtA[i:], dAtA120[:j119])
-		i = encodeVarintPlan(dAtA, i, uint64(j119))
+		i -= j133
+		copy(dAtA[i:], dAtA134[:j133])
+		i = encodeVarintPlan(dAtA, i, uint64(j133))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -14721,20 +16144,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA126 := make([]byte, len(m.ForeignTbl)*10)
-		var j125 int
+		dAtA140 := make([]byte, len(m.ForeignTbl)*10)
+		var j139 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA126[j125] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA140[j139] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j125++
+				j139++
 			}
-			dAtA126[j125] = uint8(num)
-			j125++
+			dAtA140[j139] = uint8(num)
+			j139++
 		}
-		i -= j125
-		copy(dAtA[i:], dAtA126[:j125])
-		i = encodeVarintPlan(dAtA, i, uint64(j125))
+		i -= j139
+		copy(dAtA[i:], dAtA140[:j139])
+		i = encodeVarintPlan(dAtA, i, uint64(j139))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -14811,20 +16234,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA129 := make([]byte, len(m.AccountIDs)*10)
-		var j128 int
+		dAtA143 := make([]byte, len(m.AccountIDs)*10)
+		var j142 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA129[j128] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA143[j142] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j128++
+				j142++
 			}
-			dAtA129[j128] = uint8(num)
-			j128++
+			dAtA143[j142] = uint8(num)
+			j142++
 		}
-		i -= j128
-		copy(dAtA[i:], dAtA129[:j128])
-		i = encodeVarintPlan(dAtA, i, uint64(j128))
+		i -= j142
+		copy(dAtA[i:], dAtA143[:j142])
+		i = encodeVarintPlan(dAtA, i, uint64(j142))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -15036,21 +16459,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA133 := make([]byte, len(m.ParamTypes)*10)
-		var j132 int
+		dAtA147 := make([]byte, len(m.ParamTypes)*10)
+		var j146 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA133[j132] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA147[j146] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j132++
+				j146++
 			}
-			dAtA133[j132] = uint8(num)
-			j132++
+			dAtA147[j146] = uint8(num)
+			j146++
 		}
-		i -= j132
-		copy(dAtA[i:], dAtA133[:j132])
-		i = encodeVarintPlan(dAtA, i, uint64(j132))
+		i -= j146
+		copy(dAtA[i:], dAtA147[:j146])
+		i = encodeVarintPlan(dAtA, i, uint64(j146))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -15320,6 +16743,10 @@ func (m *Type) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.Enumvalues)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16039,6 +17466,13 @@ func (m *ColDef) ProtoSize() (n int) {
 	if m.Pkidx != 0 {
 		n += 1 + sovPlan(uint64(m.Pkidx))
 	}
+	if m.Generated != nil {
+		l = m.Generated.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.CompressLevel != 0 {
+		n += 1 + sovPlan(uint64(m.CompressLevel))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16088,6 +17522,29 @@ func (m *OnUpdate) ProtoSize() (n int) {
 	return n
 }
 
+func (m *GeneratedCol) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.OriginString)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Stored {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *IndexOption) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -16177,6 +17634,31 @@ func (m *IndexDef) ProtoSize() (n int) {
 		l = m.Option.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.Fulltext {
+		n += 2
+	}
+	l = len(m.Parser)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.JsonPath)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.KeyType != nil {
+		l = m.KeyType.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.MultiValued {
+		n += 2
+	}
+	l = len(m.OriginString)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.JsonUnquote {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16236,6 +17718,13 @@ func (m *CheckDef) ProtoSize() (n int) {
 		l = m.Check.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.OriginString)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Enforced {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16723,6 +18212,51 @@ func (m *OrderBySpec) ProtoSize() (n int) {
 	return n
 }
 
+func (m *FrameBound) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Unbounded {
+		n += 2
+	}
+	if m.Val != nil {
+		l = m.Val.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FrameClause) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Start != nil {
+		l = m.Start.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *WindowSpec) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -16747,6 +18281,32 @@ func (m *WindowSpec) ProtoSize() (n int) {
 	if m.Lag != 0 {
 		n += 1 + sovPlan(uint64(m.Lag))
 	}
+	if m.WindowFunc != nil {
+		l = m.WindowFunc.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Frame != nil {
+		l = m.Frame.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecursiveCte) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxDepth != 0 {
+		n += 1 + sovPlan(uint64(m.MaxDepth))
+	}
+	if m.Distinct {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17129,6 +18689,84 @@ func (m *Node) ProtoSize() (n int) {
 		l = m.InsertCtx.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if len(m.WinSpecList) > 0 {
+		for _, e := range m.WinSpecList {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.RecursiveCte != nil {
+		l = m.RecursiveCte.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.NestedLoopJoin {
+		n += 3
+	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for _, e := range m.RuntimeFilterProbeList {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.IndexScanInfo != nil {
+		l = m.IndexScanInfo.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.SnapshotTs != nil {
+		l = m.SnapshotTs.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexScanInfo) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Covering {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RuntimeFilterSpec) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tag != 0 {
+		n += 1 + sovPlan(uint64(m.Tag))
+	}
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.UpperLimit != 0 {
+		n += 1 + sovPlan(uint64(m.UpperLimit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17947,6 +19585,33 @@ func (m *AlterTableAddFk) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableColumns) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cols) > 0 {
+		for _, e := range m.Cols {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.Exprs) > 0 {
+		for _, e := range m.Exprs {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.InPlace {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -18015,6 +19680,30 @@ func (m *AlterTable_Action_AddFk) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTable_Action_Columns) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Columns != nil {
+		l = m.Columns.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_AddIndex) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddIndex != nil {
+		l = m.AddIndex.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *DropTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -18635,6 +20324,38 @@ func (m *Type) Unmarshal(dAtA []byte) error {
 			}
 			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enumvalues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enumvalues = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -21635,6 +23356,61 @@ func (m *ColDef) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Generated == nil {
+				m.Generated = &GeneratedCol{}
+			}
+			if err := m.Generated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressLevel", wireType)
+			}
+			m.CompressLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressLevel |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -21915,6 +23691,145 @@ func (m *OnUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GeneratedCol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratedCol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratedCol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stored = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *IndexOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22497,60 +24412,252 @@ func (m *IndexDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulltext", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Fulltext = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parser", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ForeignKeyDef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ForeignKeyDef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForeignKeyDef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyType == nil {
+				m.KeyType = &Type{}
+			}
+			if err := m.KeyType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiValued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiValued = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonUnquote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JsonUnquote = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForeignKeyDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForeignKeyDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForeignKeyDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22908,6 +25015,58 @@ func (m *CheckDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enforced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -25732,7 +27891,7 @@ func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WindowSpec) Unmarshal(dAtA []byte) error {
+func (m *FrameBound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25755,15 +27914,283 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WindowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrameBound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrameBound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameBound_BoundType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbounded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unbounded = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Val == nil {
+				m.Val = &Expr{}
+			}
+			if err := m.Val.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameClause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameClause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameClause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameClause_FrameType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &FrameBound{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &FrameBound{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25867,6 +28294,168 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowFunc == nil {
+				m.WindowFunc = &Expr{}
+			}
+			if err := m.WindowFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Frame == nil {
+				m.Frame = &FrameClause{}
+			}
+			if err := m.Frame.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecursiveCte) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecursiveCte: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecursiveCte: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
+			}
+			m.MaxDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDepth |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distinct", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Distinct = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -28553,6 +31142,496 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinSpecList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinSpecList = append(m.WinSpecList, &WindowSpec{})
+			if err := m.WinSpecList[len(m.WinSpecList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecursiveCte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecursiveCte == nil {
+				m.RecursiveCte = &RecursiveCte{}
+			}
+			if err := m.RecursiveCte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedLoopJoin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NestedLoopJoin = bool(v != 0)
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterProbeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterProbeList = append(m.RuntimeFilterProbeList, &RuntimeFilterSpec{})
+			if err := m.RuntimeFilterProbeList[len(m.RuntimeFilterProbeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexScanInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IndexScanInfo == nil {
+				m.IndexScanInfo = &IndexScanInfo{}
+			}
+			if err := m.IndexScanInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SnapshotTs == nil {
+				m.SnapshotTs = &timestamp.Timestamp{}
+			}
+			if err := m.SnapshotTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexScanInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexScanInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexScanInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Covering", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Covering = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeFilterSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeFilterSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeFilterSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			m.Tag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tag |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperLimit", wireType)
+			}
+			m.UpperLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -31931,8 +35010,293 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkCols = append(m.FkCols, &FkColName{})
-			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FkCols = append(m.FkCols, &FkColName{})
+			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableDrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			}
+			m.Typ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Typ |= AlterTableDrop_Typ(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fkey == nil {
+				m.Fkey = &ForeignKeyDef{}
+			}
+			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31958,7 +35322,7 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
+func (m *AlterTableColumns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31981,36 +35345,17 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableDrop: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableColumns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableColumns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
-			}
-			m.Typ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Typ |= AlterTableDrop_Typ(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -32020,112 +35365,31 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
+			m.Cols = append(m.Cols, &ColDef{})
+			if err := m.Cols[len(m.Cols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exprs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -32135,61 +35399,31 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Exprs = append(m.Exprs, &Expr{})
+			if err := m.Exprs[len(m.Exprs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InPlace", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -32199,28 +35433,12 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Fkey == nil {
-				m.Fkey = &ForeignKeyDef{}
-			}
-			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.InPlace = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -32515,6 +35733,76 @@ func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = &AlterTable_Action_AddFk{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableColumns{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_Columns{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CreateIndex{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AddIndex{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
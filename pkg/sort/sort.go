@@ -130,7 +130,7 @@ func Sort(desc, nullsLast, hasNull bool, os []int64, vec *vector.Vector, strCol
 		} else {
 			genericSort(col, os, genericGreater[uint8])
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := vector.MustFixedCol[uint16](vec)
 		if !desc {
 			genericSort(col, os, genericLess[uint16])
@@ -144,7 +144,7 @@ func Sort(desc, nullsLast, hasNull bool, os []int64, vec *vector.Vector, strCol
 		} else {
 			genericSort(col, os, genericGreater[uint32])
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		col := vector.MustFixedCol[uint64](vec)
 		if !desc {
 			genericSort(col, os, genericLess[uint64])
@@ -284,11 +284,33 @@ func GetVector(typ types.Type, input any, nsp []uint64) (*vector.Vector, int) {
 		// Make vector by string.
 		// There is another function which can make uuid by uuid directly
 		return testutil.MakeUuidVectorByString(input.([]string), nsp), len(input.([]string))
+	case types.T_json:
+		return makeJsonVector(input.([]string), nsp), len(input.([]string))
 	}
 
 	return nil, 0
 }
 
+func makeJsonVector(values []string, nsp []uint64) *vector.Vector {
+	vec := vector.NewVec(types.T_json.ToType())
+	for i, v := range values {
+		bj, err := types.ParseStringToByteJson(v)
+		if err != nil {
+			panic(err)
+		}
+		dt, _ := types.EncodeJson(bj)
+		if err = vector.AppendBytes(vec, dt, false, testutil.TestUtilMp); err != nil {
+			panic(err)
+		}
+		for _, n := range nsp {
+			if uint64(i) == n {
+				vec.GetNulls().Set(n)
+			}
+		}
+	}
+	return vec
+}
+
 func CompareResult(t *testing.T, typ types.Type, expected any, vec *vector.Vector, hasDecimalResult bool) bool {
 	switch typ.Oid {
 	case types.T_bool:
@@ -344,6 +366,14 @@ func CompareResult(t *testing.T, typ types.Type, expected any, vec *vector.Vecto
 	case types.T_uuid:
 		result := vector.MustFixedCol[types.Uuid](testutil.MakeUuidVectorByString(expected.([]string), nil))
 		require.Equal(t, result, vector.MustFixedCol[types.Uuid](vec))
+	case types.T_json:
+		want := expected.([]string)
+		require.Equal(t, len(want), vec.Length())
+		for i, w := range want {
+			bj, err := types.ParseStringToByteJson(w)
+			require.NoError(t, err)
+			require.Equal(t, bj.String(), types.DecodeJson(vec.GetBytesAt(i)).String())
+		}
 	default:
 		return false
 	}
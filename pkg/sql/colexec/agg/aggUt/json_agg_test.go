@@ -0,0 +1,61 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggut
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+)
+
+func TestJsonAgg(t *testing.T) {
+	jsonTyp := types.T_json.ToType()
+
+	testCases := []testCase{
+		// json_arrayagg test, the inputs are produced by json_array
+		{
+			op:       agg.AggregateJsonArrayAgg,
+			inputTyp: jsonTyp,
+
+			input:    []string{`[1]`, `["a"]`, `[null]`, `[{"b":2}]`},
+			inputNsp: nil,
+			expected: []string{`[1,"a",null,{"b":2}]`},
+
+			mergeInput:  []string{`[3]`, `[[4]]`},
+			mergeNsp:    nil,
+			mergeExpect: []string{`[1,"a",null,{"b":2},3,[4]]`},
+
+			testMarshal: true,
+		},
+		// json_objectagg test, the inputs are produced by json_object
+		{
+			op:       agg.AggregateJsonObjectAgg,
+			inputTyp: jsonTyp,
+
+			input:    []string{`{"a":1}`, `{"b":2}`, `{"a":3}`},
+			inputNsp: nil,
+			expected: []string{`{"a":3,"b":2}`},
+
+			mergeInput:  []string{`{"c":[1]}`},
+			mergeNsp:    nil,
+			mergeExpect: []string{`{"a":3,"b":2,"c":[1]}`},
+
+			testMarshal: true,
+		},
+	}
+
+	RunTest(t, testCases)
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// JsonAgg is the private structure of JSON_ARRAYAGG and JSON_OBJECTAGG.
+// the planner binds json_arrayagg(x) to the aggregate over json_array(x) and
+// json_objectagg(k, v) to the aggregate over json_object(k, v), so every input is
+// a json array or a json object, and the result merges the inputs of the group.
+type JsonAgg struct {
+	IsObject bool
+	// Vals holds the encoded json inputs of each group
+	Vals [][][]byte
+}
+
+func JsonAggReturnType(typs []types.Type) types.Type {
+	if typs[0].Oid == types.T_json {
+		return typs[0]
+	}
+	return types.Type{}
+}
+
+func NewJsonAgg(isObject bool) *JsonAgg {
+	return &JsonAgg{IsObject: isObject}
+}
+
+func (a *JsonAgg) Grows(cnt int) {
+	for i := 0; i < cnt; i++ {
+		a.Vals = append(a.Vals, nil)
+	}
+}
+
+func (a *JsonAgg) Eval(vs [][]byte) [][]byte {
+	for i := range vs {
+		if len(a.Vals[i]) == 0 {
+			continue
+		}
+		bjs := make([]bytejson.ByteJson, len(a.Vals[i]))
+		for j, v := range a.Vals[i] {
+			bjs[j] = types.DecodeJson(v)
+		}
+		var bj bytejson.ByteJson
+		if a.IsObject {
+			// the inputs are produced by json_object, the merge never fails
+			bj, _ = bytejson.MergeObjects(bjs)
+		} else {
+			bj = bytejson.MergeArrays(bjs)
+		}
+		vs[i], _ = types.EncodeJson(bj)
+	}
+	return vs
+}
+
+// Fill keeps the inputs of the group, the value of the group is made in Eval.
+// the last input is returned as the value of the group so that the group is
+// kept when the values are marshaled.
+func (a *JsonAgg) Fill(i int64, value []byte, ov []byte, z int64, isEmpty bool, isNull bool) ([]byte, bool) {
+	if !isNull {
+		v := append([]byte{}, value...)
+		for j := int64(0); j < z; j++ {
+			a.Vals[i] = append(a.Vals[i], v)
+		}
+		return v, false
+	}
+	return ov, isEmpty
+}
+
+func (a *JsonAgg) Merge(xIndex int64, yIndex int64, x []byte, y []byte, xEmpty bool, yEmpty bool, yAgg any) ([]byte, bool) {
+	if !yEmpty {
+		ya := yAgg.(*JsonAgg)
+		a.Vals[xIndex] = append(a.Vals[xIndex], ya.Vals[yIndex]...)
+		return y, false
+	}
+	return x, xEmpty
+}
+
+func (a *JsonAgg) MarshalBinary() ([]byte, error) {
+	return types.Encode(&a.Vals)
+}
+
+func (a *JsonAgg) UnmarshalBinary(data []byte) error {
+	// avoid resulting errors caused by morpc overusing memory
+	copyData := make([]byte, len(data))
+	copy(copyData, data)
+	return types.Decode(copyData, &a.Vals)
+}
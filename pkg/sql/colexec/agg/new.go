@@ -48,6 +48,8 @@ func ReturnType(op int, typ types.Type) (types.Type, error) {
 		otyp = StdDevPopReturnType([]types.Type{typ})
 	case AggregateMedian:
 		otyp = MedianReturnType([]types.Type{typ})
+	case AggregateJsonArrayAgg, AggregateJsonObjectAgg:
+		otyp = JsonAggReturnType([]types.Type{typ})
 	}
 	if otyp.Oid == types.T_any {
 		return typ, moerr.NewInternalErrorNoCtx("'%v' not support %s", typ, Names[op])
@@ -85,6 +87,10 @@ func New(op int, dist bool, typ types.Type) (Agg[any], error) {
 		return newAnyValue(typ, dist), nil
 	case AggregateMedian:
 		return newMedian(typ, dist), nil
+	case AggregateJsonArrayAgg:
+		return newJsonAgg(typ, dist, false), nil
+	case AggregateJsonObjectAgg:
+		return newJsonAgg(typ, dist, true), nil
 	}
 	panic(moerr.NewInternalErrorNoCtx("unsupported type '%s' for aggregate %s", typ, Names[op]))
 }
@@ -730,6 +736,21 @@ func newMedian(typ types.Type, dist bool) Agg[any] {
 	panic(moerr.NewNotSupportedNoCtx("median on type '%s'", typ))
 }
 
+func newJsonAgg(typ types.Type, dist bool, isObject bool) Agg[any] {
+	op := AggregateJsonArrayAgg
+	if isObject {
+		op = AggregateJsonObjectAgg
+	}
+	if typ.Oid != types.T_json {
+		panic(moerr.NewNotSupportedNoCtx("%s on type '%s'", Names[op], typ))
+	}
+	if dist {
+		panic(moerr.NewNotSupportedNoCtx("%s in distinct mode", Names[op]))
+	}
+	aggPriv := NewJsonAgg(isObject)
+	return NewUnaryAgg(op, aggPriv, false, typ, JsonAggReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+}
+
 func newGenericAnyValue[T any](typ types.Type, dist bool) Agg[any] {
 	aggPriv := NewAnyValue[T]()
 	if dist {
@@ -42,6 +42,8 @@ const (
 	AggregateAnyValue
 	AggregateMedian
 	AggregateGroupConcat
+	AggregateJsonArrayAgg
+	AggregateJsonObjectAgg
 )
 
 var Names = [...]string{
@@ -60,6 +62,8 @@ var Names = [...]string{
 	AggregateAnyValue:            "any",
 	AggregateMedian:              "median",
 	AggregateGroupConcat:         "group_concat",
+	AggregateJsonArrayAgg:        "json_arrayagg",
+	AggregateJsonObjectAgg:       "json_objectagg",
 }
 
 type Aggregate struct {
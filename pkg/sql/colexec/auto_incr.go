@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -945,7 +946,7 @@ func getAutoIncrTableDef() []engine.TableDef {
 
 	nameAttr := &engine.AttributeDef{Attr: engine.Attribute{
 		Name:    catalog.AutoIncrColumnNames[1],
-		Alg:     0,
+		Alg:     compress.Lz4,
 		Type:    types.T_varchar.ToType(),
 		Default: &plan.Default{},
 		Primary: true,
@@ -953,7 +954,7 @@ func getAutoIncrTableDef() []engine.TableDef {
 
 	numAttr := &engine.AttributeDef{Attr: engine.Attribute{
 		Name:    catalog.AutoIncrColumnNames[2],
-		Alg:     0,
+		Alg:     compress.Lz4,
 		Type:    types.T_uint64.ToType(),
 		Default: &plan.Default{},
 		Primary: false,
@@ -961,7 +962,7 @@ func getAutoIncrTableDef() []engine.TableDef {
 
 	stepAttr := &engine.AttributeDef{Attr: engine.Attribute{
 		Name:    catalog.AutoIncrColumnNames[3],
-		Alg:     0,
+		Alg:     compress.Lz4,
 		Type:    types.T_uint64.ToType(),
 		Default: &plan.Default{},
 		Primary: false,
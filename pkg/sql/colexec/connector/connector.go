@@ -17,6 +17,7 @@ package connector
 import (
 	"bytes"
 
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -35,7 +36,8 @@ func Call(_ int, proc *process.Process, arg any, _ bool, _ bool) (bool, error) {
 	if bat == nil {
 		return true, nil
 	}
-	if bat.Length() == 0 {
+	// the batch of a hash build spilled has no row
+	if _, ok := bat.Ht.(*colexec.SpilledJoinMap); bat.Length() == 0 && !ok {
 		return false, nil
 	}
 
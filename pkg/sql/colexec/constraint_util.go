@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -69,6 +70,7 @@ func FilterAndUpdateByRowId(
 	updateCols []map[string]int32,
 	parentIdxs []map[string]int32,
 	uniqueRels [][]engine.Relation,
+	secondaryRels [][]engine.Relation,
 ) (uint64, error) {
 	var affectedRows uint64
 	var delBatch *batch.Batch
@@ -88,6 +90,10 @@ func FilterAndUpdateByRowId(
 		tableDef := tableDefs[i]
 		updateCol := updateCols[i]
 		uniqueRel := uniqueRels[i]
+		var secondaryRel []engine.Relation
+		if len(secondaryRels) > 0 {
+			secondaryRel = secondaryRels[i]
+		}
 		var parentIdx map[string]int32 // nil means don't need check parent constraint
 		if len(parentIdxs) > 0 {
 			parentIdx = parentIdxs[i]
@@ -116,12 +122,24 @@ func FilterAndUpdateByRowId(
 				}
 			}
 
+			if err = FillGeneratedColumns(proc, updateBatch, tableDef); err != nil {
+				return 0, err
+			}
+
 			// check new rows not null
 			err := BatchDataNotNullCheck(updateBatch, tableDef, proc.Ctx)
 			if err != nil {
 				return 0, err
 			}
 
+			if err = BatchDataCheckConstraint(proc, updateBatch, tableDef); err != nil {
+				return 0, err
+			}
+
+			if err = ClearVirtualColumns(proc, updateBatch, tableDef); err != nil {
+				return 0, err
+			}
+
 			//  append hidden columns
 			//if info.compositePkey != "" {
 			//	util.FillCompositeClusterByBatch(updateBatch, info.compositePkey, proc)
@@ -145,6 +163,12 @@ func FilterAndUpdateByRowId(
 			// write unique key table
 			WriteUniqueTable(nil, proc, updateBatch, tableDef, info.updateNameToPos, info.pkPos, uniqueRel)
 
+			// write fulltext and functional index table
+			err = WriteSecondaryIndexTable(nil, proc, updateBatch, tableDef, info.updateNameToPos, info.pkPos, secondaryRel)
+			if err != nil {
+				return 0, err
+			}
+
 			// write origin table
 			err = rels[i].Write(proc.Ctx, updateBatch)
 			if err != nil {
@@ -228,6 +252,69 @@ func WriteUniqueTable(s3Writer *S3Writer, proc *process.Process, updateBatch *ba
 	return nil
 }
 
+// WriteSecondaryIndexTable writes the rows of the secondary, the fulltext and the functional
+// index tables, the buffers of them follow the ones of the unique index tables in the s3Writer.
+func WriteSecondaryIndexTable(s3Writer *S3Writer, proc *process.Process, updateBatch *batch.Batch,
+	tableDef *plan.TableDef, updateNameToPos map[string]int, pkPos int, rels []engine.Relation) error {
+	if tableDef.Indexes == nil || pkPos == -1 {
+		return nil
+	}
+
+	var ukBatch *batch.Batch
+	defer func() {
+		if ukBatch != nil {
+			ukBatch.Clean(proc.Mp())
+		}
+	}()
+
+	uIdx := 0
+	for _, indexDef := range tableDef.Indexes {
+		if indexDef.Unique {
+			uIdx++
+		}
+	}
+	sIdx := 0
+	for _, indexDef := range tableDef.Indexes {
+		if !util.IsSecondaryIndexTable(indexDef) {
+			continue
+		}
+		parts := make([]*vector.Vector, len(indexDef.Parts))
+		for p, column := range indexDef.Parts {
+			parts[p] = updateBatch.Vecs[updateNameToPos[column]]
+		}
+		var err error
+		if indexDef.JsonPath != "" {
+			ukBatch, err = util.BuildJsonIndexBatch(parts[0], updateBatch.Vecs[pkPos], indexDef, proc)
+		} else if !indexDef.Fulltext {
+			ukBatch, err = util.BuildSecondaryIndexBatch(parts, updateBatch.Vecs[pkPos], proc)
+		} else {
+			tokenizer, ok := fulltext.GetTokenizer(indexDef.Parser)
+			if !ok {
+				return moerr.NewInternalError(proc.Ctx, "unknown fulltext parser '%s'", indexDef.Parser)
+			}
+			ukBatch, err = util.BuildFullTextIndexBatch(parts, updateBatch.Vecs[pkPos], tokenizer, proc)
+		}
+		if err != nil {
+			return err
+		}
+
+		sIdx++
+		if ukBatch.Length() == 0 {
+			continue
+		}
+		if s3Writer == nil {
+			err = rels[sIdx-1].Write(proc.Ctx, ukBatch)
+		} else {
+			err = s3Writer.WriteS3Batch(ukBatch, proc, uIdx+sIdx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func filterRowIdForDel(proc *process.Process, bat *batch.Batch, idx int) *batch.Batch {
 	retVec := vector.NewVec(types.T_Rowid.ToType())
 	rowIdMap := make(map[types.Rowid]struct{})
@@ -455,3 +542,63 @@ func BatchDataNotNullCheck(tmpBat *batch.Batch, tableDef *plan.TableDef, ctx con
 	}
 	return nil
 }
+
+// BatchDataCheckConstraint evaluates the enforced check constraints of the table
+// over the rows of the batch, a row violates a constraint only if the expression
+// is false, null is treated as satisfied.
+func BatchDataCheckConstraint(proc *process.Process, bat *batch.Batch, tableDef *plan.TableDef) error {
+	if len(tableDef.Checks) == 0 {
+		return nil
+	}
+	name2pos := make(map[string]int32, len(bat.Attrs))
+	for i, attr := range bat.Attrs {
+		name2pos[attr] = int32(i)
+	}
+	for _, check := range tableDef.Checks {
+		if !check.Enforced {
+			continue
+		}
+		expr, err := resetColPosByName(proc, check.Check, name2pos)
+		if err != nil {
+			return err
+		}
+		vec, err := EvalExpr(bat, proc, expr)
+		if err != nil {
+			return err
+		}
+		violated := checkConstraintViolated(vec)
+		if !isBatchVector(bat, vec) {
+			vec.Free(proc.Mp())
+		}
+		if violated {
+			return moerr.NewConstraintViolation(proc.Ctx, "Check constraint '%s' is violated.", check.Name)
+		}
+	}
+	return nil
+}
+
+func checkConstraintViolated(vec *vector.Vector) bool {
+	if vec.IsConstNull() {
+		return false
+	}
+	cols := vector.MustFixedCol[bool](vec)
+	if vec.IsConst() {
+		return !cols[0]
+	}
+	nsp := vec.GetNulls()
+	for i, ok := range cols {
+		if !ok && !nulls.Contains(nsp, uint64(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isBatchVector(bat *batch.Batch, vec *vector.Vector) bool {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
@@ -56,6 +56,9 @@ type DeleteCtx struct {
 	OnSetRef          []*plan.ObjectRef
 	OnSetTableDef     []*plan.TableDef
 	OnSetUpdateCol    []map[string]int32
+
+	// fulltext and functional index tables of OnSetSource
+	OnSetSecondaryIndexSource [][]engine.Relation
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
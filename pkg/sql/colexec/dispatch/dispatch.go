@@ -98,7 +98,8 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 		return true, nil
 	}
 
-	if bat.Length() == 0 {
+	// the batch of a hash build spilled has no row
+	if _, ok := bat.Ht.(*colexec.SpilledJoinMap); bat.Length() == 0 && !ok {
 		return false, nil
 	}
 
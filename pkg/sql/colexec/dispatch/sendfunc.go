@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -52,6 +53,9 @@ func sendToAllLocalFunc(bat *batch.Batch, ap *Argument, proc *process.Process) (
 		jm.IncRef(refCountAdd)
 		jm.SetDupCount(int64(len(ap.LocalRegs)))
 	}
+	if jm, ok := bat.Ht.(*colexec.SpilledJoinMap); ok {
+		jm.IncRef(refCountAdd)
+	}
 
 	for _, reg := range ap.LocalRegs {
 		select {
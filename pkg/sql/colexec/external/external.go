@@ -700,9 +700,26 @@ func needRead(ctx context.Context, param *ExternalParam, proc *process.Process,
 		return true
 	}
 
+	dataLength := len(param.Filter.columns)
+	datas := make([][2]any, dataLength)
+	for i := 0; i < dataLength; i++ {
+		zm := indexes[i]
+		min := zm.GetMin()
+		max := zm.GetMax()
+		if min == nil || max == nil {
+			return true
+		}
+		datas[i] = [2]any{min, max}
+	}
+	return needReadByMinMax(param, proc, datas)
+}
+
+// needReadByMinMax evaluates the filter on the min/max values of the filter
+// columns of a block, or of a row group for parquet files.
+func needReadByMinMax(param *ExternalParam, proc *process.Process, datas [][2]any) bool {
 	notReportErrCtx := errutil.ContextWithNoReport(proc.Ctx, true)
 	// if expr match no columns, just eval expr
-	if len(param.Filter.columns) == 0 {
+	if len(datas) == 0 {
 		bat := batch.NewWithSize(0)
 		defer bat.Clean(proc.Mp())
 		ifNeed, err := plan2.EvalFilterExpr(notReportErrCtx, param.Filter.FilterExpr, bat, proc)
@@ -712,26 +729,17 @@ func needRead(ctx context.Context, param *ExternalParam, proc *process.Process,
 		return ifNeed
 	}
 
-	dataLength := len(param.Filter.columns)
-	datas := make([][2]any, dataLength)
-	dataTypes := make([]uint8, dataLength)
-	for i := 0; i < dataLength; i++ {
+	dataTypes := make([]uint8, len(datas))
+	for i := range datas {
 		idx := param.Filter.defColumns[i]
 		dataTypes[i] = uint8(param.Cols[idx].Typ.Id)
-
-		zm := indexes[i]
-		if err != nil {
-			return true
-		}
-		min := zm.GetMin()
-		max := zm.GetMax()
-		if min == nil || max == nil {
-			return true
-		}
-		datas[i] = [2]any{min, max}
 	}
 	// use all min/max data to build []vectors.
 	buildVectors := plan2.BuildVectorsByData(datas, dataTypes, proc.Mp())
+	for i, vec := range buildVectors {
+		// keep the width and the scale of the column, decimals need them
+		vec.SetType(makeType(param.Cols, int(param.Filter.defColumns[i])))
+	}
 	bat := batch.NewWithSize(param.Filter.maxCol + 1)
 	defer bat.Clean(proc.Mp())
 	for k, v := range param.Filter.columnMap {
@@ -865,6 +873,8 @@ func ScanZonemapFile(ctx context.Context, param *ExternalParam, proc *process.Pr
 func ScanFileData(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
 	if strings.HasSuffix(param.Fileparam.Filepath, ".tae") || param.Extern.QueryResult {
 		return ScanZonemapFile(ctx, param, proc)
+	} else if param.Extern.Format == tree.PARQUET {
+		return ScanParquetFile(ctx, param, proc)
 	} else {
 		return ScanCsvFile(ctx, param, proc)
 	}
@@ -1393,6 +1403,30 @@ func getOneRowData(bat *batch.Batch, Line []string, rowIdx int, param *ExternalP
 				}
 				cols[rowIdx] = d
 			}
+		case types.T_enum, types.T_set:
+			if isNullOrEmpty {
+				nulls.Add(vec.GetNulls(), uint64(rowIdx))
+				continue
+			}
+			values, err := types.DecodeEnumValues(param.Cols[colIdx].Typ.Enumvalues)
+			if err != nil {
+				return err
+			}
+			if id == types.T_enum {
+				d, err := types.ParseEnum(values, field)
+				if err != nil {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return moerr.NewInternalError(param.Ctx, "the input value '%v' is not enum type for column %d", field, colIdx)
+				}
+				vector.MustFixedCol[uint16](vec)[rowIdx] = d
+			} else {
+				d, err := types.ParseSet(values, field)
+				if err != nil {
+					logutil.Errorf("parse field[%v] err:%v", field, err)
+					return moerr.NewInternalError(param.Ctx, "the input value '%v' is not set type for column %d", field, colIdx)
+				}
+				vector.MustFixedCol[uint64](vec)[rowIdx] = d
+			}
 		default:
 			return moerr.NewInternalError(param.Ctx, "the value type %d is not support now", param.Cols[rowIdx].Typ.Id)
 		}
@@ -18,12 +18,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
@@ -832,3 +840,176 @@ func Test_fliterByAccountAndFilename(t *testing.T) {
 		})
 	}
 }
+
+// parquetTestData is a parquet file of two row groups, of 2 and 3 rows, with
+// the columns `id bigint not null` = 1..5 and `name varchar` = a, null, b, c, d.
+var parquetTestData = []byte{
+	0x50, 0x41, 0x52, 0x31, 0x15, 0x00, 0x15, 0x20, 0x15, 0x24, 0x2c, 0x15, 0x04, 0x15, 0x00, 0x15,
+	0x06, 0x15, 0x06, 0x00, 0x00, 0x10, 0x3c, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x15, 0x00, 0x15, 0x1a, 0x15, 0x1e, 0x2c, 0x15, 0x04,
+	0x15, 0x00, 0x15, 0x06, 0x15, 0x06, 0x00, 0x00, 0x0d, 0x30, 0x04, 0x00, 0x00, 0x00, 0x02, 0x01,
+	0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x61, 0x15, 0x00, 0x15, 0x30, 0x15, 0x2e, 0x2c, 0x15, 0x06,
+	0x15, 0x00, 0x15, 0x06, 0x15, 0x06, 0x00, 0x00, 0x18, 0x04, 0x03, 0x00, 0x09, 0x01, 0x3c, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x15,
+	0x00, 0x15, 0x2a, 0x15, 0x2e, 0x2c, 0x15, 0x06, 0x15, 0x00, 0x15, 0x06, 0x15, 0x06, 0x00, 0x00,
+	0x15, 0x50, 0x02, 0x00, 0x00, 0x00, 0x06, 0x01, 0x01, 0x00, 0x00, 0x00, 0x62, 0x01, 0x00, 0x00,
+	0x00, 0x63, 0x01, 0x00, 0x00, 0x00, 0x64, 0x15, 0x02, 0x19, 0x3c, 0x48, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x15, 0x04, 0x00, 0x15, 0x04, 0x25, 0x00, 0x18, 0x02, 0x69, 0x64, 0x00, 0x15,
+	0x0c, 0x25, 0x02, 0x18, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x25, 0x00, 0x00, 0x16, 0x0a, 0x19, 0x2c,
+	0x19, 0x2c, 0x26, 0x08, 0x1c, 0x15, 0x04, 0x19, 0x15, 0x00, 0x19, 0x18, 0x02, 0x69, 0x64, 0x15,
+	0x02, 0x16, 0x04, 0x16, 0x46, 0x16, 0x46, 0x26, 0x08, 0x3c, 0x36, 0x00, 0x28, 0x08, 0x02, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x08, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x26, 0x4e, 0x1c, 0x15, 0x0c, 0x19, 0x15, 0x00, 0x19, 0x18, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x15, 0x02, 0x16, 0x04, 0x16, 0x40, 0x16, 0x40, 0x26, 0x4e, 0x3c, 0x36, 0x02, 0x28,
+	0x01, 0x61, 0x18, 0x01, 0x61, 0x00, 0x00, 0x00, 0x16, 0x00, 0x16, 0x04, 0x00, 0x19, 0x2c, 0x26,
+	0x8e, 0x01, 0x1c, 0x15, 0x04, 0x19, 0x15, 0x00, 0x19, 0x18, 0x02, 0x69, 0x64, 0x15, 0x02, 0x16,
+	0x06, 0x16, 0x50, 0x16, 0x50, 0x26, 0x8e, 0x01, 0x3c, 0x36, 0x00, 0x28, 0x08, 0x05, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x08, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x26, 0xde, 0x01, 0x1c, 0x15, 0x0c, 0x19, 0x15, 0x00, 0x19, 0x18, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x15, 0x02, 0x16, 0x06, 0x16, 0x50, 0x16, 0x50, 0x26, 0xde, 0x01, 0x3c, 0x36, 0x00,
+	0x28, 0x01, 0x64, 0x18, 0x01, 0x62, 0x00, 0x00, 0x00, 0x16, 0x00, 0x16, 0x06, 0x00, 0x28, 0x15,
+	0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x6f, 0x6e, 0x65, 0x20, 0x74, 0x65, 0x73, 0x74, 0x20, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x00, 0xff, 0x00, 0x00, 0x00, 0x50, 0x41, 0x52, 0x31,
+}
+
+func newParquetTestParam(t *testing.T, filter *plan.Expr, attrs ...string) (*Argument, *process.Process) {
+	path := filepath.Join(t.TempDir(), "t.parquet")
+	require.NoError(t, os.WriteFile(path, parquetTestData, 0644))
+
+	colTypes := map[string]*plan.Type{
+		"id":                     {Id: int32(types.T_int64)},
+		"name":                   {Id: int32(types.T_varchar), Width: types.MaxVarcharLen},
+		"missing":                {Id: int32(types.T_int32)},
+		catalog.ExternalFilePath: {Id: int32(types.T_varchar), Width: types.MaxVarcharLen},
+	}
+	cols := make([]*plan.ColDef, len(attrs))
+	for i, attr := range attrs {
+		cols[i] = &plan.ColDef{Name: attr, Typ: colTypes[attr]}
+	}
+	proc := testutil.NewProcess()
+	proc.SessionInfo.TimeZone = time.UTC
+	arg := &Argument{
+		Es: &ExternalParam{
+			ExParamConst: ExParamConst{
+				Attrs:    attrs,
+				Cols:     cols,
+				FileList: []string{path},
+				Extern: &tree.ExternParam{
+					ExParamConst: tree.ExParamConst{
+						Filepath: path,
+						Format:   tree.PARQUET,
+						Tail:     &tree.TailParameter{},
+					},
+				},
+			},
+			ExParam: ExParam{
+				Fileparam: &ExFileparam{},
+				Filter:    &FilterParam{FilterExpr: filter},
+			},
+		},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	return arg, proc
+}
+
+func scanParquet(t *testing.T, arg *Argument, proc *process.Process) ([]*batch.Batch, error) {
+	var bats []*batch.Batch
+	for {
+		end, err := Call(0, proc, arg, false, false)
+		if err != nil {
+			return nil, err
+		}
+		if end {
+			return bats, nil
+		}
+		if bat := proc.InputBatch(); bat != nil && bat.Length() > 0 {
+			bats = append(bats, bat)
+		}
+	}
+}
+
+func TestScanParquetFile(t *testing.T) {
+	arg, proc := newParquetTestParam(t, nil, "name", "id", catalog.ExternalFilePath)
+	bats, err := scanParquet(t, arg, proc)
+	require.NoError(t, err)
+	var ids []int64
+	var names []string
+	for _, bat := range bats {
+		ids = append(ids, vector.MustFixedCol[int64](bat.Vecs[1])...)
+		for i := 0; i < bat.Length(); i++ {
+			if bat.Vecs[0].GetNulls().Contains(uint64(i)) {
+				names = append(names, "NULL")
+			} else {
+				names = append(names, bat.Vecs[0].GetStringAt(i))
+			}
+			require.Equal(t, arg.Es.FileList[0], bat.Vecs[2].GetStringAt(i))
+		}
+	}
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, ids)
+	require.Equal(t, []string{"a", "NULL", "b", "c", "d"}, names)
+	require.True(t, arg.Es.Fileparam.End)
+
+	// the row group of the ids 1 and 2 is skipped by its statistics
+	fid, _, _, err := function.GetFunctionByName(proc.Ctx, ">", []types.Type{types.T_int64.ToType(), types.T_int64.ToType()})
+	require.NoError(t, err)
+	i64typ := &plan.Type{Id: int32(types.T_int64)}
+	filter := &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_bool)},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: fid, ObjName: ">"},
+				Args: []*plan.Expr{
+					{Typ: i64typ, Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0, Name: "t.id"}}},
+					{Typ: i64typ, Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I64Val{I64Val: 2}}}},
+				},
+			},
+		},
+	}
+	arg, proc = newParquetTestParam(t, filter, "id")
+	bats, err = scanParquet(t, arg, proc)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(bats))
+	require.Equal(t, []int64{3, 4, 5}, vector.MustFixedCol[int64](bats[0].Vecs[0]))
+
+	// a column which is not in the file is null for load data only
+	arg, proc = newParquetTestParam(t, nil, "id", "missing")
+	_, err = scanParquet(t, arg, proc)
+	require.Error(t, err)
+	arg, proc = newParquetTestParam(t, nil, "id", "missing")
+	arg.Es.Extern.LoadFile = true
+	bats, err = scanParquet(t, arg, proc)
+	require.NoError(t, err)
+	for _, bat := range bats {
+		for i := 0; i < bat.Length(); i++ {
+			require.True(t, bat.Vecs[1].GetNulls().Contains(uint64(i)))
+		}
+	}
+
+	// the file of load local is written into a temporary file, which is removed after the scan
+	arg, proc = newParquetTestParam(t, nil, "id")
+	arg.Es.Extern.Local = true
+	var w *io.PipeWriter
+	proc.LoadLocalReader, w = io.Pipe()
+	go func() {
+		_, err := w.Write(parquetTestData)
+		_ = w.CloseWithError(err)
+	}()
+	bats, err = scanParquet(t, arg, proc)
+	require.NoError(t, err)
+	ids = ids[:0]
+	for _, bat := range bats {
+		ids = append(ids, vector.MustFixedCol[int64](bat.Vecs[0])...)
+	}
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, ids)
+	fs, err := fileservice.Get[fileservice.FileService](proc.FileService, defines.LocalFileServiceName)
+	require.NoError(t, err)
+	entries, err := fs.List(proc.Ctx, path.Join("spill", proc.Id))
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// a column of the file which is not convertible to the column of the table
+	arg, proc = newParquetTestParam(t, nil, "id")
+	arg.Es.Cols[0].Typ = &plan.Type{Id: int32(types.T_date)}
+	_, err = scanParquet(t, arg, proc)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+}
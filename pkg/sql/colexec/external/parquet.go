@@ -0,0 +1,283 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external/parquet"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// fileReaderAt serves the ranged reads of the parquet reader from the file
+// service, so that only the footer and the needed column chunks are fetched.
+type fileReaderAt struct {
+	ctx  context.Context
+	fs   fileservice.FileService
+	path string
+}
+
+func (r *fileReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	vec := fileservice.IOVector{
+		FilePath: r.path,
+		Entries: []fileservice.IOEntry{
+			0: {
+				Offset: off,
+				Size:   int64(len(p)),
+				Data:   p,
+			},
+		},
+	}
+	if err := r.fs.Read(r.ctx, &vec); err != nil {
+		return 0, err
+	}
+	return copy(p, vec.Entries[0].Data), nil
+}
+
+func openParquetFile(param *ExternalParam, proc *process.Process, pp *ParquetFileparam) (*parquet.File, error) {
+	if param.Extern.Local {
+		// the file of load local is streamed from the client, a parquet
+		// file needs random access to its footer and its column chunks,
+		// so it's written into a temporary file of the local file service.
+		fs, err := fileservice.Get[fileservice.FileService](proc.FileService, defines.LocalFileServiceName)
+		if err != nil {
+			return nil, err
+		}
+		pp.tmpFs, pp.tmpPath = fs, colexec.NewSpillPath(proc)
+		vec := fileservice.IOVector{
+			FilePath: pp.tmpPath,
+			Entries: []fileservice.IOEntry{
+				0: {
+					Size:           -1,
+					ReaderForWrite: proc.LoadLocalReader,
+				},
+			},
+		}
+		if err = fs.Write(param.Ctx, vec); err != nil {
+			return nil, err
+		}
+		return openParquetFileOf(param.Ctx, fs, pp.tmpPath)
+	}
+	fs, readPath, err := plan2.GetForETLWithType(param.Extern, param.Fileparam.Filepath)
+	if err != nil {
+		return nil, err
+	}
+	return openParquetFileOf(param.Ctx, fs, readPath)
+}
+
+func openParquetFileOf(ctx context.Context, fs fileservice.FileService, path string) (*parquet.File, error) {
+	entry, err := fs.StatFile(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	r := &fileReaderAt{
+		ctx:  ctx,
+		fs:   fs,
+		path: path,
+	}
+	return parquet.Open(ctx, r, entry.Size)
+}
+
+// closeParquetFile removes the temporary file of load local after the file
+// is read or the query ends.
+func closeParquetFile(ctx context.Context, param *ExternalParam) {
+	pp := param.Parqparam
+	param.Parqparam = nil
+	if pp == nil || pp.tmpPath == "" {
+		return
+	}
+	// the error is ignored as the file is useless
+	_ = pp.tmpFs.Delete(ctx, pp.tmpPath)
+}
+
+// initParquetParam maps the columns of the table to the columns of the
+// file, by name.
+func initParquetParam(param *ExternalParam, proc *process.Process) error {
+	pp := &ParquetFileparam{
+		columns:   make([]*parquet.Column, len(param.Attrs)),
+		appenders: make([]*parquet.Appender, len(param.Attrs)),
+	}
+	param.Parqparam = pp
+	f, err := openParquetFile(param, proc, pp)
+	if err != nil {
+		return err
+	}
+	pp.file = f
+	for i, attr := range param.Attrs {
+		if catalog.ContainExternalHidenCol(attr) {
+			continue
+		}
+		col := f.Column(attr)
+		if col == nil {
+			// the columns of the table that are not in the file are
+			// loaded as null, an external table must match its files.
+			if param.Extern.LoadFile {
+				continue
+			}
+			return moerr.NewInvalidInput(param.Ctx, "the column '%s' is not in the parquet file '%s'", attr, param.Fileparam.Filepath)
+		}
+		if pp.appenders[i], err = parquet.NewAppender(param.Ctx, col, makeType(param.Cols, i), proc.SessionInfo.TimeZone); err != nil {
+			return err
+		}
+		pp.columns[i] = col
+	}
+	return nil
+}
+
+// needReadRowGroup tells whether a row group may contain rows matching the
+// filter, according to the statistics of the file.
+func needReadRowGroup(param *ExternalParam, proc *process.Process, rg int) bool {
+	if !param.Filter.exprMono || param.Filter.FilterExpr == nil {
+		return true
+	}
+	pp := param.Parqparam
+	datas := make([][2]any, len(param.Filter.columns))
+	for i := range datas {
+		idx := int(param.Filter.defColumns[i])
+		a := pp.appenders[idx]
+		if a == nil {
+			return true
+		}
+		min, max, ok := a.MinMax(pp.file, rg)
+		if !ok {
+			return true
+		}
+		datas[i] = [2]any{min, max}
+	}
+	return needReadByMinMax(param, proc, datas)
+}
+
+func nextRowGroup(param *ExternalParam, proc *process.Process) error {
+	pp := param.Parqparam
+	for ; pp.rowGroup < pp.file.NumRowGroups(); pp.rowGroup++ {
+		if pp.file.RowGroupNumRows(pp.rowGroup) > 0 && needReadRowGroup(param, proc, pp.rowGroup) {
+			break
+		}
+	}
+	if pp.rowGroup >= pp.file.NumRowGroups() {
+		return nil
+	}
+	pp.rowsLeft = pp.file.RowGroupNumRows(pp.rowGroup)
+	pp.readers = make([]*parquet.ColumnReader, len(pp.columns))
+	for i, col := range pp.columns {
+		if col == nil {
+			continue
+		}
+		r, err := pp.file.ColumnReader(param.Ctx, pp.rowGroup, col)
+		if err != nil {
+			return err
+		}
+		pp.readers[i] = r
+	}
+	return nil
+}
+
+func getParquetBatch(param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	pp := param.Parqparam
+	bat := makeBatch(param, 0, proc)
+	if pp.rowsLeft == 0 {
+		if pp.readers != nil {
+			pp.rowGroup++
+			pp.readers = nil
+		}
+		if err := nextRowGroup(param, proc); err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+		if pp.readers == nil {
+			return bat, nil
+		}
+	}
+	n := ONE_BATCH_MAX_ROW
+	if int64(n) > pp.rowsLeft {
+		n = int(pp.rowsLeft)
+	}
+	for i, r := range pp.readers {
+		vec := bat.Vecs[i]
+		var err error
+		switch {
+		case catalog.ContainExternalHidenCol(param.Attrs[i]):
+			err = vector.AppendMultiBytes(vec, []byte(param.Fileparam.Filepath), false, n, proc.Mp())
+		case r == nil:
+			err = vector.AppendMultiFixed(vec, 0, true, n, proc.Mp())
+		default:
+			pp.values.Reset()
+			var cnt int
+			if cnt, pp.notNull, err = r.Read(n, &pp.values, pp.notNull[:0]); err == nil {
+				if cnt != n {
+					err = moerr.NewInvalidInput(param.Ctx, "the parquet file '%s' has less rows than its metadata", param.Fileparam.Filepath)
+				} else {
+					err = pp.appenders[i].Append(vec, &pp.values, pp.notNull, proc.Mp())
+				}
+			}
+		}
+		if err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	pp.rowsLeft -= int64(n)
+
+	sels := proc.Mp().GetSels()
+	if n > cap(sels) {
+		proc.Mp().PutSels(sels)
+		sels = make([]int64, n)
+	}
+	bat.Zs = sels[:n]
+	for k := 0; k < n; k++ {
+		bat.Zs[k] = 1
+	}
+	return bat, nil
+}
+
+// ScanParquetFile reads a parquet file row group by row group, the row
+// groups whose statistics do not match the filter are skipped.
+func ScanParquetFile(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	_, span := trace.Start(ctx, "ScanParquetFile")
+	defer span.End()
+	if param.plh == nil {
+		if err := initParquetParam(param, proc); err != nil {
+			return nil, err
+		}
+		param.plh = &ParseLineHandler{}
+	}
+	bat, err := getParquetBatch(param, proc)
+	if err != nil {
+		return nil, err
+	}
+	pp := param.Parqparam
+	if pp.readers == nil || (pp.rowsLeft == 0 && pp.rowGroup+1 >= pp.file.NumRowGroups()) {
+		closeParquetFile(param.Ctx, param)
+		param.plh = nil
+		param.Fileparam.FileFin++
+		if param.Fileparam.FileFin >= param.Fileparam.FileCnt {
+			param.Fileparam.End = true
+		}
+	}
+	bat.Cnt = 1
+	return bat, nil
+}
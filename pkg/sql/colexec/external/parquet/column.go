@@ -0,0 +1,319 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+)
+
+// ColumnReader iterates over the values of a column chunk page by page.
+type ColumnReader struct {
+	ctx    context.Context
+	col    *Column
+	codec  int32
+	buf    []byte
+	pos    int
+	remain int64
+
+	dict    *Values
+	hasDict bool
+
+	// the data page being read
+	notNull  []bool
+	values   Values
+	indices  []uint32
+	dictPage bool
+	rowPos   int
+	valPos   int
+}
+
+// Read appends the next n rows to values, notNull receives one entry per
+// row and values only the non-null ones. It returns the number of rows
+// read, which is less than n only at the end of the chunk.
+func (c *ColumnReader) Read(n int, values *Values, notNull []bool) (int, []bool, error) {
+	read := 0
+	for read < n && c.remain > 0 {
+		if c.rowPos >= len(c.notNull) {
+			if err := c.nextPage(); err != nil {
+				return read, notNull, err
+			}
+			continue
+		}
+		cnt := n - read
+		if left := len(c.notNull) - c.rowPos; cnt > left {
+			cnt = left
+		}
+		if int64(cnt) > c.remain {
+			cnt = int(c.remain)
+		}
+		rows := c.notNull[c.rowPos : c.rowPos+cnt]
+		nonNull := 0
+		for _, v := range rows {
+			if v {
+				nonNull++
+			}
+		}
+		if c.valPos+nonNull > c.pageValueCount() {
+			return read, notNull, errCorruptedPage
+		}
+		if c.dictPage {
+			if err := values.appendDict(c.col.Type, c.dict, c.indices[c.valPos:c.valPos+nonNull]); err != nil {
+				return read, notNull, err
+			}
+		} else {
+			values.appendRange(c.col.Type, &c.values, c.valPos, c.valPos+nonNull)
+		}
+		notNull = append(notNull, rows...)
+		c.rowPos += cnt
+		c.valPos += nonNull
+		c.remain -= int64(cnt)
+		read += cnt
+	}
+	return read, notNull, nil
+}
+
+func (c *ColumnReader) pageValueCount() int {
+	if c.dictPage {
+		return len(c.indices)
+	}
+	return c.values.Len(c.col.Type)
+}
+
+func (c *ColumnReader) nextPage() error {
+	for {
+		if c.pos >= len(c.buf) {
+			return moerr.NewInvalidInput(c.ctx, "unexpected end of the parquet column chunk '%s'", c.col.Name)
+		}
+		tr := &thriftReader{buf: c.buf[c.pos:]}
+		ph, err := readPageHeader(tr)
+		if err != nil {
+			return err
+		}
+		c.pos += tr.pos
+		size := int(ph.compressedSize)
+		if size < 0 || size > len(c.buf)-c.pos || ph.uncompressedSize < 0 {
+			return errCorruptedPage
+		}
+		body := c.buf[c.pos : c.pos+size]
+		c.pos += size
+
+		switch ph.typ {
+		case pageDictionary:
+			data, err := c.decompress(body, int(ph.uncompressedSize))
+			if err != nil {
+				return err
+			}
+			if ph.dictionary.encoding != encodingPlain && ph.dictionary.encoding != encodingPlainDictionary {
+				return moerr.NewNotSupported(c.ctx, "parquet dictionary encoding %d", ph.dictionary.encoding)
+			}
+			c.dict = &Values{}
+			if err = decodePlain(c.col.Type, c.col.TypeLength, data, int(ph.dictionary.numValues), c.dict); err != nil {
+				return err
+			}
+			c.hasDict = true
+		case pageData:
+			data, err := c.decompress(body, int(ph.uncompressedSize))
+			if err != nil {
+				return err
+			}
+			n := int(ph.data.numValues)
+			if c.col.Optional {
+				if ph.data.defEnc != encodingRLE {
+					return moerr.NewNotSupported(c.ctx, "parquet definition level encoding %d", ph.data.defEnc)
+				}
+				if len(data) < 4 {
+					return errCorruptedPage
+				}
+				l := int(binary.LittleEndian.Uint32(data))
+				if l < 0 || l > len(data)-4 {
+					return errCorruptedPage
+				}
+				if err = c.decodeLevels(data[4:4+l], n); err != nil {
+					return err
+				}
+				data = data[4+l:]
+			} else {
+				c.setRequired(n)
+			}
+			return c.decodePage(ph.data.encoding, data)
+		case pageDataV2:
+			h := &ph.dataV2
+			levels := int(h.defLength) + int(h.repLength)
+			if h.defLength < 0 || h.repLength < 0 || levels > len(body) {
+				return errCorruptedPage
+			}
+			n := int(h.numValues)
+			if c.col.Optional {
+				if err = c.decodeLevels(body[h.repLength:levels], n); err != nil {
+					return err
+				}
+			} else {
+				c.setRequired(n)
+			}
+			data := body[levels:]
+			if h.isCompressed {
+				if data, err = c.decompress(data, int(ph.uncompressedSize)-levels); err != nil {
+					return err
+				}
+			}
+			return c.decodePage(h.encoding, data)
+		}
+	}
+}
+
+func (c *ColumnReader) setRequired(n int) {
+	if cap(c.notNull) < n {
+		c.notNull = make([]bool, n)
+	}
+	c.notNull = c.notNull[:n]
+	for i := range c.notNull {
+		c.notNull[i] = true
+	}
+}
+
+func (c *ColumnReader) decodeLevels(data []byte, n int) error {
+	levels := make([]uint32, n)
+	if err := newHybridDecoder(data, 1).decode(levels); err != nil {
+		return err
+	}
+	c.notNull = c.notNull[:0]
+	for _, l := range levels {
+		c.notNull = append(c.notNull, l == 1)
+	}
+	return nil
+}
+
+func (c *ColumnReader) decodePage(encoding int32, data []byte) error {
+	nonNull := 0
+	for _, v := range c.notNull {
+		if v {
+			nonNull++
+		}
+	}
+	c.rowPos, c.valPos = 0, 0
+	c.values.Reset()
+	c.dictPage = false
+	switch encoding {
+	case encodingPlainDictionary, encodingRLEDictionary:
+		if !c.hasDict {
+			return moerr.NewInvalidInput(c.ctx, "the parquet column chunk '%s' has no dictionary page", c.col.Name)
+		}
+		c.dictPage = true
+		if cap(c.indices) < nonNull {
+			c.indices = make([]uint32, nonNull)
+		}
+		c.indices = c.indices[:nonNull]
+		if nonNull == 0 {
+			return nil
+		}
+		if len(data) == 0 || data[0] > 32 {
+			return errCorruptedPage
+		}
+		return newHybridDecoder(data[1:], int(data[0])).decode(c.indices)
+	default:
+		return decodeValues(c.col, encoding, data, nonNull, &c.values)
+	}
+}
+
+func (c *ColumnReader) decompress(data []byte, size int) ([]byte, error) {
+	switch c.codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		n, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, err
+		}
+		if n != size {
+			return nil, errCorruptedPage
+		}
+		return snappy.Decode(make([]byte, n), data)
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		buf := make([]byte, size)
+		if _, err = io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	case codecZstd:
+		buf, err := compress.Decompress(data, make([]byte, 0, size), compress.Zstd)
+		if err != nil {
+			return nil, err
+		}
+		if len(buf) != size {
+			return nil, errCorruptedPage
+		}
+		return buf, nil
+	case codecLZ4Raw:
+		return decompressLz4(data, size)
+	case codecLZ4:
+		// the deprecated LZ4 codec is the hadoop framing of lz4 blocks,
+		// some writers use plain blocks instead.
+		if buf, err := decompressHadoopLz4(data, size); err == nil {
+			return buf, nil
+		}
+		return decompressLz4(data, size)
+	default:
+		return nil, moerr.NewNotSupported(c.ctx, "parquet compression codec %d", c.codec)
+	}
+}
+
+func decompressLz4(data []byte, size int) ([]byte, error) {
+	buf, err := compress.Decompress(data, make([]byte, size), compress.Lz4)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) != size {
+		return nil, errCorruptedPage
+	}
+	return buf, nil
+}
+
+func decompressHadoopLz4(data []byte, size int) ([]byte, error) {
+	out := make([]byte, 0, size)
+	for len(data) > 0 {
+		if len(data) < 8 {
+			return nil, errCorruptedPage
+		}
+		rawLen := int(binary.BigEndian.Uint32(data))
+		compLen := int(binary.BigEndian.Uint32(data[4:]))
+		data = data[8:]
+		if compLen > len(data) || rawLen > size-len(out) {
+			return nil, errCorruptedPage
+		}
+		block, err := decompressLz4(data[:compLen], rawLen)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, block...)
+		data = data[compLen:]
+	}
+	if len(out) != size {
+		return nil, errCorruptedPage
+	}
+	return out, nil
+}
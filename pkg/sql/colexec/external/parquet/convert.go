@@ -0,0 +1,823 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// sourceKind is the meaning of a parquet column once its physical type and
+// its annotations are combined.
+type sourceKind int
+
+const (
+	srcBool sourceKind = iota
+	srcInt
+	srcUint
+	srcFloat
+	srcDecimal
+	srcString
+	srcJSON
+	srcDate
+	srcTime
+	srcTimestamp
+	srcInt96
+	srcUUID
+)
+
+const julianUnixEpoch = 2440588
+
+var (
+	unixEpochDate     = int32(types.DateFromCalendar(1970, 1, 1))
+	unixEpochDatetime = int64(types.DatetimeFromClock(1970, 1, 1, 0, 0, 0, 0))
+)
+
+type source struct {
+	kind      sourceKind
+	scale     int32
+	precision int32
+	unit      int16
+	utc       bool
+}
+
+func (col *Column) source(ctx context.Context) (source, error) {
+	lt := &col.logical
+	switch lt.kind {
+	case logicalString, logicalEnum:
+		return col.checkPhysical(ctx, source{kind: srcString}, TypeByteArray, TypeFixedLenByteArray)
+	case logicalJSON:
+		return col.checkPhysical(ctx, source{kind: srcJSON}, TypeByteArray)
+	case logicalUUID:
+		if col.Type != TypeFixedLenByteArray || col.TypeLength != 16 {
+			return source{}, col.invalidAnnotation(ctx)
+		}
+		return source{kind: srcUUID}, nil
+	case logicalDecimal:
+		return col.checkPhysical(ctx, source{kind: srcDecimal, scale: lt.scale, precision: lt.precision},
+			TypeInt32, TypeInt64, TypeByteArray, TypeFixedLenByteArray)
+	case logicalDate:
+		return col.checkPhysical(ctx, source{kind: srcDate}, TypeInt32)
+	case logicalTime:
+		if (lt.unit == unitMillis) != (col.Type == TypeInt32) {
+			return source{}, col.invalidAnnotation(ctx)
+		}
+		return col.checkPhysical(ctx, source{kind: srcTime, unit: lt.unit}, TypeInt32, TypeInt64)
+	case logicalTimestamp:
+		return col.checkPhysical(ctx, source{kind: srcTimestamp, unit: lt.unit, utc: lt.adjustedToUTC}, TypeInt64)
+	case logicalInteger:
+		if lt.signed {
+			return col.checkPhysical(ctx, source{kind: srcInt}, TypeInt32, TypeInt64)
+		}
+		return col.checkPhysical(ctx, source{kind: srcUint}, TypeInt32, TypeInt64)
+	case logicalUnknown:
+		// a column of nulls only
+		return source{kind: srcString}, nil
+	case logicalNone:
+	default:
+		return source{}, moerr.NewNotSupported(ctx, "the logical type of parquet column '%s'", col.Name)
+	}
+
+	if col.hasConvertedType {
+		switch col.convertedType {
+		case convertedUTF8, convertedEnum:
+			return col.checkPhysical(ctx, source{kind: srcString}, TypeByteArray, TypeFixedLenByteArray)
+		case convertedJSON:
+			return col.checkPhysical(ctx, source{kind: srcJSON}, TypeByteArray)
+		case convertedDecimal:
+			return col.checkPhysical(ctx, source{kind: srcDecimal, scale: col.scale, precision: col.precision},
+				TypeInt32, TypeInt64, TypeByteArray, TypeFixedLenByteArray)
+		case convertedDate:
+			return col.checkPhysical(ctx, source{kind: srcDate}, TypeInt32)
+		case convertedTimeMillis:
+			return col.checkPhysical(ctx, source{kind: srcTime, unit: unitMillis}, TypeInt32)
+		case convertedTimeMicros:
+			return col.checkPhysical(ctx, source{kind: srcTime, unit: unitMicros}, TypeInt64)
+		case convertedTimestampMillis:
+			return col.checkPhysical(ctx, source{kind: srcTimestamp, unit: unitMillis, utc: true}, TypeInt64)
+		case convertedTimestampMicros:
+			return col.checkPhysical(ctx, source{kind: srcTimestamp, unit: unitMicros, utc: true}, TypeInt64)
+		case convertedUint8, convertedUint16, convertedUint32, convertedUint64:
+			return col.checkPhysical(ctx, source{kind: srcUint}, TypeInt32, TypeInt64)
+		case convertedInt8, convertedInt16, convertedInt32, convertedInt64:
+			return col.checkPhysical(ctx, source{kind: srcInt}, TypeInt32, TypeInt64)
+		default:
+			return source{}, moerr.NewNotSupported(ctx, "the converted type %d of parquet column '%s'", col.convertedType, col.Name)
+		}
+	}
+
+	switch col.Type {
+	case TypeBoolean:
+		return source{kind: srcBool}, nil
+	case TypeInt32, TypeInt64:
+		return source{kind: srcInt}, nil
+	case TypeFloat, TypeDouble:
+		return source{kind: srcFloat}, nil
+	case TypeInt96:
+		return source{kind: srcInt96, utc: true}, nil
+	case TypeByteArray, TypeFixedLenByteArray:
+		return source{kind: srcString}, nil
+	}
+	return source{}, moerr.NewInvalidInput(ctx, "invalid physical type %d of parquet column '%s'", col.Type, col.Name)
+}
+
+func (col *Column) checkPhysical(ctx context.Context, src source, physical ...int32) (source, error) {
+	for _, p := range physical {
+		if col.Type == p {
+			return src, nil
+		}
+	}
+	return source{}, col.invalidAnnotation(ctx)
+}
+
+func (col *Column) invalidAnnotation(ctx context.Context) error {
+	return moerr.NewInvalidInput(ctx, "the annotation of parquet column '%s' does not match its physical type %d", col.Name, col.Type)
+}
+
+// Appender converts the values of a parquet column into a vector of a
+// MatrixOne type.
+type Appender struct {
+	ctx context.Context
+	col *Column
+	typ types.Type
+	loc *time.Location
+	src source
+
+	isNull []bool
+	buf    []byte
+}
+
+// NewAppender checks that the column can be loaded into the type, loc is
+// the session time zone used between timestamps and datetimes.
+func NewAppender(ctx context.Context, col *Column, typ types.Type, loc *time.Location) (*Appender, error) {
+	src, err := col.source(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+	a := &Appender{
+		ctx: ctx,
+		col: col,
+		typ: typ,
+		loc: loc,
+		src: src,
+	}
+	if !a.compatible() {
+		return nil, moerr.NewNotSupported(ctx, "load parquet column '%s' into a column of type %s", col.Name, typ.String())
+	}
+	return a, nil
+}
+
+func (a *Appender) compatible() bool {
+	k := a.src.kind
+	switch a.typ.Oid {
+	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return k == srcBool || k == srcInt || k == srcUint
+	case types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128:
+		return k == srcInt || k == srcUint || k == srcFloat || k == srcDecimal
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary:
+		return true
+	case types.T_json:
+		return k == srcString || k == srcJSON
+	case types.T_date, types.T_datetime, types.T_timestamp:
+		return k == srcDate || k == srcTimestamp || k == srcInt96
+	case types.T_time:
+		return k == srcTime
+	case types.T_uuid:
+		return k == srcUUID || k == srcString
+	}
+	return false
+}
+
+// Append appends the rows read by ColumnReader.Read to vec.
+func (a *Appender) Append(vec *vector.Vector, values *Values, notNull []bool, mp *mpool.MPool) error {
+	if cap(a.isNull) < len(notNull) {
+		a.isNull = make([]bool, len(notNull))
+	}
+	a.isNull = a.isNull[:len(notNull)]
+	for i, v := range notNull {
+		a.isNull[i] = !v
+	}
+	a.buf = a.buf[:0]
+	switch a.typ.Oid {
+	case types.T_bool:
+		return appendFixed(a, vec, values, notNull, mp, func(j int) (bool, error) {
+			v, err := a.getInt(values, j)
+			return v != 0, err
+		})
+	case types.T_int8:
+		return appendInt[int8](a, vec, values, notNull, mp, math.MinInt8, math.MaxInt8)
+	case types.T_int16:
+		return appendInt[int16](a, vec, values, notNull, mp, math.MinInt16, math.MaxInt16)
+	case types.T_int32:
+		return appendInt[int32](a, vec, values, notNull, mp, math.MinInt32, math.MaxInt32)
+	case types.T_int64:
+		return appendInt[int64](a, vec, values, notNull, mp, math.MinInt64, math.MaxInt64)
+	case types.T_uint8:
+		return appendUint[uint8](a, vec, values, notNull, mp, math.MaxUint8)
+	case types.T_uint16:
+		return appendUint[uint16](a, vec, values, notNull, mp, math.MaxUint16)
+	case types.T_uint32:
+		return appendUint[uint32](a, vec, values, notNull, mp, math.MaxUint32)
+	case types.T_uint64:
+		return appendUint[uint64](a, vec, values, notNull, mp, math.MaxUint64)
+	case types.T_float32:
+		return appendFixed(a, vec, values, notNull, mp, func(j int) (float32, error) {
+			v, err := a.getFloat(values, j)
+			return float32(v), err
+		})
+	case types.T_float64:
+		return appendFixed(a, vec, values, notNull, mp, func(j int) (float64, error) {
+			return a.getFloat(values, j)
+		})
+	case types.T_decimal64:
+		return appendFixed(a, vec, values, notNull, mp, func(j int) (types.Decimal64, error) {
+			v, err := a.getDecimal(values, j)
+			return types.Decimal64(v.B0_63), err
+		})
+	case types.T_decimal128:
+		return appendFixed(a, vec, values, notNull, mp, func(j int) (types.Decimal128, error) {
+			return a.getDecimal(values, j)
+		})
+	case types.T_date:
+		return appendFixed(a, vec, values, notNull, mp, func(j int) (types.Date, error) {
+			v, err := a.getDatetime(values, j)
+			return v.ToDate(), err
+		})
+	case types.T_datetime:
+		return appendFixed(a, vec, values, notNull, mp, func(j int) (types.Datetime, error) {
+			return a.getDatetime(values, j)
+		})
+	case types.T_timestamp:
+		return appendFixed(a, vec, values, notNull, mp, func(j int) (types.Timestamp, error) {
+			return a.getTimestamp(values, j)
+		})
+	case types.T_time:
+		return appendFixed(a, vec, values, notNull, mp, func(j int) (types.Time, error) {
+			return a.getTime(values, j), nil
+		})
+	case types.T_uuid:
+		return appendFixed(a, vec, values, notNull, mp, func(j int) (types.Uuid, error) {
+			return a.getUuid(values, j)
+		})
+	case types.T_json:
+		return appendBytes(a, vec, notNull, mp, func(j int) ([]byte, error) {
+			return a.getJson(values, j)
+		})
+	default:
+		return appendBytes(a, vec, notNull, mp, func(j int) ([]byte, error) {
+			return a.getBytes(values, j)
+		})
+	}
+}
+
+func appendFixed[T any](a *Appender, vec *vector.Vector, values *Values, notNull []bool, mp *mpool.MPool, get func(j int) (T, error)) error {
+	rs := make([]T, len(notNull))
+	j := 0
+	for i, ok := range notNull {
+		if !ok {
+			continue
+		}
+		v, err := get(j)
+		if err != nil {
+			return err
+		}
+		rs[i] = v
+		j++
+	}
+	return vector.AppendFixedList(vec, rs, a.isNull, mp)
+}
+
+func appendBytes(a *Appender, vec *vector.Vector, notNull []bool, mp *mpool.MPool, get func(j int) ([]byte, error)) error {
+	rs := make([][]byte, len(notNull))
+	j := 0
+	for i, ok := range notNull {
+		if !ok {
+			continue
+		}
+		v, err := get(j)
+		if err != nil {
+			return err
+		}
+		rs[i] = v
+		j++
+	}
+	return vector.AppendBytesList(vec, rs, a.isNull, mp)
+}
+
+type signed interface {
+	~int8 | ~int16 | ~int32 | ~int64
+}
+
+type unsigned interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+func appendInt[T signed](a *Appender, vec *vector.Vector, values *Values, notNull []bool, mp *mpool.MPool, min, max int64) error {
+	return appendFixed(a, vec, values, notNull, mp, func(j int) (T, error) {
+		v, err := a.getInt(values, j)
+		if err != nil {
+			return 0, err
+		}
+		if v < min || v > max {
+			return 0, a.invalidValue(v)
+		}
+		return T(v), nil
+	})
+}
+
+func appendUint[T unsigned](a *Appender, vec *vector.Vector, values *Values, notNull []bool, mp *mpool.MPool, max uint64) error {
+	return appendFixed(a, vec, values, notNull, mp, func(j int) (T, error) {
+		v, err := a.getUint(values, j)
+		if err != nil {
+			return 0, err
+		}
+		if v > max {
+			return 0, a.invalidValue(v)
+		}
+		return T(v), nil
+	})
+}
+
+func (a *Appender) invalidValue(v any) error {
+	return moerr.NewInternalError(a.ctx, "the input value '%v' is not %s type for column '%s'", v, a.typ.String(), a.col.Name)
+}
+
+func (a *Appender) rawInt(values *Values, j int) int64 {
+	if a.col.Type == TypeInt32 {
+		return int64(values.Int32s[j])
+	}
+	return values.Int64s[j]
+}
+
+func (a *Appender) rawUint(values *Values, j int) uint64 {
+	if a.col.Type == TypeInt32 {
+		return uint64(uint32(values.Int32s[j]))
+	}
+	return uint64(values.Int64s[j])
+}
+
+func (a *Appender) getInt(values *Values, j int) (int64, error) {
+	switch a.src.kind {
+	case srcBool:
+		if values.Bools[j] {
+			return 1, nil
+		}
+		return 0, nil
+	case srcUint:
+		v := a.rawUint(values, j)
+		if v > math.MaxInt64 {
+			return 0, a.invalidValue(v)
+		}
+		return int64(v), nil
+	default:
+		return a.rawInt(values, j), nil
+	}
+}
+
+func (a *Appender) getUint(values *Values, j int) (uint64, error) {
+	switch a.src.kind {
+	case srcBool:
+		if values.Bools[j] {
+			return 1, nil
+		}
+		return 0, nil
+	case srcUint:
+		return a.rawUint(values, j), nil
+	default:
+		v := a.rawInt(values, j)
+		if v < 0 {
+			return 0, a.invalidValue(v)
+		}
+		return uint64(v), nil
+	}
+}
+
+func (a *Appender) getFloat(values *Values, j int) (float64, error) {
+	switch a.src.kind {
+	case srcFloat:
+		if a.col.Type == TypeFloat {
+			return float64(values.Float32s[j]), nil
+		}
+		return values.Float64s[j], nil
+	case srcUint:
+		return float64(a.rawUint(values, j)), nil
+	case srcDecimal:
+		d, err := a.rawDecimal(values, j)
+		if err != nil {
+			return 0, err
+		}
+		return types.Decimal128ToFloat64(d, a.src.scale), nil
+	default:
+		return float64(a.rawInt(values, j)), nil
+	}
+}
+
+// rawDecimal returns the unscaled value of a decimal column.
+func (a *Appender) rawDecimal(values *Values, j int) (types.Decimal128, error) {
+	switch a.col.Type {
+	case TypeInt32, TypeInt64:
+		return decimal128FromInt64(a.rawInt(values, j)), nil
+	default:
+		return decimal128FromBytes(values.Bytes[j], func() error {
+			return a.invalidValue(values.Bytes[j])
+		})
+	}
+}
+
+func decimal128FromInt64(v int64) types.Decimal128 {
+	d := types.Decimal128{B0_63: uint64(v)}
+	if v < 0 {
+		d.B64_127 = math.MaxUint64
+	}
+	return d
+}
+
+// decimal128FromBytes decodes a big-endian two's complement integer.
+func decimal128FromBytes(b []byte, overflow func() error) (types.Decimal128, error) {
+	var ext byte
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		ext = 0xff
+	}
+	for len(b) > 16 {
+		if b[0] != ext {
+			return types.Decimal128{}, overflow()
+		}
+		b = b[1:]
+	}
+	var buf [16]byte
+	for i := range buf[:16-len(b)] {
+		buf[i] = ext
+	}
+	copy(buf[16-len(b):], b)
+	if len(b) == 16 && (buf[0]&0x80 != 0) != (ext == 0xff) {
+		return types.Decimal128{}, overflow()
+	}
+	return types.Decimal128{
+		B0_63:   binary.BigEndian.Uint64(buf[8:]),
+		B64_127: binary.BigEndian.Uint64(buf[:8]),
+	}, nil
+}
+
+func (a *Appender) getDecimal(values *Values, j int) (types.Decimal128, error) {
+	var d types.Decimal128
+	var err error
+	scale := int32(0)
+	switch a.src.kind {
+	case srcFloat:
+		var f float64
+		if f, err = a.getFloat(values, j); err != nil {
+			return d, err
+		}
+		if a.typ.Oid == types.T_decimal64 {
+			var d64 types.Decimal64
+			if d64, err = types.Decimal64FromFloat64(f, a.typ.Width, a.typ.Scale); err != nil {
+				return d, a.invalidValue(f)
+			}
+			return types.Decimal128{B0_63: uint64(d64)}, nil
+		}
+		if d, err = types.Decimal128FromFloat64(f, a.typ.Width, a.typ.Scale); err != nil {
+			return d, a.invalidValue(f)
+		}
+		return d, nil
+	case srcUint:
+		d = types.Decimal128{B0_63: a.rawUint(values, j)}
+	case srcDecimal:
+		if d, err = a.rawDecimal(values, j); err != nil {
+			return d, err
+		}
+		scale = a.src.scale
+	default:
+		d = decimal128FromInt64(a.rawInt(values, j))
+	}
+	width := a.typ.Width
+	if a.typ.Oid == types.T_decimal64 && width > 18 {
+		width = 18
+	}
+	if scale != a.typ.Scale {
+		r, err := d.Scale(a.typ.Scale - scale)
+		if err != nil {
+			return d, a.invalidValue(d.Format(scale))
+		}
+		d = r
+	} else if a.src.kind == srcDecimal && a.src.precision > 0 && a.src.precision <= width {
+		return d, nil
+	}
+	if !decimalFits(d, width) {
+		return d, a.invalidValue(d.Format(a.typ.Scale))
+	}
+	return d, nil
+}
+
+// decimalFits reports whether the unscaled value has at most width digits.
+func decimalFits(d types.Decimal128, width int32) bool {
+	if width <= 0 || width > 38 {
+		return true
+	}
+	if d.Sign() {
+		d = d.Minus()
+	}
+	var bound types.Decimal128
+	if width <= 19 {
+		bound = types.Decimal128{B0_63: types.Pow10[width]}
+	} else {
+		var err error
+		bound, err = types.Decimal128{B0_63: types.Pow10[19]}.Mul128(types.Decimal128{B0_63: types.Pow10[width-19]})
+		if err != nil {
+			return true
+		}
+	}
+	return d.Compare(bound) < 0
+}
+
+// unixMicros returns the microseconds since the unix epoch of a timestamp
+// or an INT96 column.
+func (a *Appender) unixMicros(values *Values, j int) int64 {
+	if a.src.kind == srcInt96 {
+		b := values.Bytes[j]
+		nanos := int64(binary.LittleEndian.Uint64(b))
+		days := int64(binary.LittleEndian.Uint32(b[8:])) - julianUnixEpoch
+		return days*86400*1000000 + nanos/1000
+	}
+	v := values.Int64s[j]
+	switch a.src.unit {
+	case unitMillis:
+		return v * 1000
+	case unitNanos:
+		return floorDiv(v, 1000)
+	}
+	return v
+}
+
+func floorDiv(v, d int64) int64 {
+	q := v / d
+	if v%d < 0 {
+		q--
+	}
+	return q
+}
+
+func (a *Appender) getDatetime(values *Values, j int) (types.Datetime, error) {
+	switch a.src.kind {
+	case srcDate:
+		return types.Date(values.Int32s[j] + unixEpochDate).ToDatetime(), nil
+	default:
+		dt := types.Datetime(a.unixMicros(values, j) + unixEpochDatetime)
+		if a.src.utc {
+			return types.Timestamp(dt).ToDatetime(a.loc), nil
+		}
+		return dt, nil
+	}
+}
+
+func (a *Appender) getTimestamp(values *Values, j int) (types.Timestamp, error) {
+	switch a.src.kind {
+	case srcDate:
+		return types.Date(values.Int32s[j] + unixEpochDate).ToTimestamp(a.loc), nil
+	default:
+		dt := types.Datetime(a.unixMicros(values, j) + unixEpochDatetime)
+		if a.src.utc {
+			return types.Timestamp(dt), nil
+		}
+		return dt.ToTimestamp(a.loc), nil
+	}
+}
+
+func (a *Appender) getTime(values *Values, j int) types.Time {
+	switch a.src.unit {
+	case unitMillis:
+		return types.Time(int64(values.Int32s[j]) * 1000)
+	case unitNanos:
+		return types.Time(floorDiv(values.Int64s[j], 1000))
+	}
+	return types.Time(values.Int64s[j])
+}
+
+func (a *Appender) getUuid(values *Values, j int) (types.Uuid, error) {
+	if a.src.kind == srcUUID {
+		var u types.Uuid
+		copy(u[:], values.Bytes[j])
+		return u, nil
+	}
+	u, err := types.ParseUuid(string(values.Bytes[j]))
+	if err != nil {
+		return u, a.invalidValue(string(values.Bytes[j]))
+	}
+	return u, nil
+}
+
+func (a *Appender) getJson(values *Values, j int) ([]byte, error) {
+	s := string(values.Bytes[j])
+	bj, err := types.ParseStringToByteJson(s)
+	if err != nil {
+		return nil, a.invalidValue(s)
+	}
+	b, err := types.EncodeJson(bj)
+	if err != nil {
+		return nil, a.invalidValue(s)
+	}
+	return b, nil
+}
+
+// getBytes returns the value as a string, the values that are not strings
+// in the file are formatted into the buffer of the appender, which is kept
+// until the next call of Append.
+func (a *Appender) getBytes(values *Values, j int) ([]byte, error) {
+	start := len(a.buf)
+	buf := a.buf
+	switch a.src.kind {
+	case srcString, srcJSON:
+		return values.Bytes[j], nil
+	case srcBool:
+		buf = strconv.AppendBool(buf, values.Bools[j])
+	case srcInt:
+		buf = strconv.AppendInt(buf, a.rawInt(values, j), 10)
+	case srcUint:
+		buf = strconv.AppendUint(buf, a.rawUint(values, j), 10)
+	case srcFloat:
+		if a.col.Type == TypeFloat {
+			buf = strconv.AppendFloat(buf, float64(values.Float32s[j]), 'g', -1, 32)
+		} else {
+			buf = strconv.AppendFloat(buf, values.Float64s[j], 'g', -1, 64)
+		}
+	case srcDecimal:
+		d, err := a.rawDecimal(values, j)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, d.Format(a.src.scale)...)
+	case srcDate:
+		buf = append(buf, types.Date(values.Int32s[j]+unixEpochDate).String()...)
+	case srcTime:
+		buf = append(buf, a.getTime(values, j).String2(a.fractionDigits())...)
+	case srcTimestamp, srcInt96:
+		dt, err := a.getDatetime(values, j)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, dt.String2(a.fractionDigits())...)
+	case srcUUID:
+		u, err := a.getUuid(values, j)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, u.ToString()...)
+	}
+	a.buf = buf
+	return buf[start:len(buf):len(buf)], nil
+}
+
+func (a *Appender) fractionDigits() int32 {
+	if a.src.unit == unitMillis {
+		return 3
+	}
+	return 6
+}
+
+// MinMax returns the minimum and the maximum of the column in a row group
+// as values of the target type, ok is false if the file has no statistics
+// or if the conversion to the target type does not keep their order.
+func (a *Appender) MinMax(f *File, rg int) (min, max any, ok bool) {
+	if !a.keepsOrder() {
+		return nil, nil, false
+	}
+	rawMin, rawMax, ok := f.Statistics(rg, a.col)
+	if !ok {
+		return nil, nil, false
+	}
+	var err error
+	if min, err = a.statValue(rawMin); err != nil {
+		return nil, nil, false
+	}
+	if max, err = a.statValue(rawMax); err != nil {
+		return nil, nil, false
+	}
+	return min, max, true
+}
+
+func (a *Appender) keepsOrder() bool {
+	switch a.src.kind {
+	case srcInt, srcUint, srcFloat, srcDecimal:
+		switch a.typ.Oid {
+		case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+			types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+			types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128:
+			return true
+		}
+	case srcString:
+		switch a.typ.Oid {
+		case types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary:
+			return true
+		}
+	case srcDate:
+		return a.typ.Oid == types.T_date || a.typ.Oid == types.T_datetime
+	case srcTimestamp:
+		// the conversion between instants and wall clocks depends on the
+		// time zone, which may go backwards.
+		if a.src.utc {
+			return a.typ.Oid == types.T_timestamp
+		}
+		return a.typ.Oid == types.T_datetime || a.typ.Oid == types.T_date
+	case srcTime:
+		return a.typ.Oid == types.T_time
+	}
+	return false
+}
+
+// statValue converts a value of the statistics, which is in the plain
+// encoding without the length prefix of the byte arrays.
+func (a *Appender) statValue(raw []byte) (any, error) {
+	values := &Values{}
+	if a.col.Type == TypeByteArray {
+		values.Bytes = [][]byte{raw}
+	} else if err := decodePlain(a.col.Type, a.col.TypeLength, raw, 1, values); err != nil {
+		return nil, err
+	}
+	intValue := func(min, max int64) (int64, error) {
+		v, err := a.getInt(values, 0)
+		if err == nil && (v < min || v > max) {
+			err = a.invalidValue(v)
+		}
+		return v, err
+	}
+	uintValue := func(max uint64) (uint64, error) {
+		v, err := a.getUint(values, 0)
+		if err == nil && v > max {
+			err = a.invalidValue(v)
+		}
+		return v, err
+	}
+	switch a.typ.Oid {
+	case types.T_int8:
+		v, err := intValue(math.MinInt8, math.MaxInt8)
+		return int8(v), err
+	case types.T_int16:
+		v, err := intValue(math.MinInt16, math.MaxInt16)
+		return int16(v), err
+	case types.T_int32:
+		v, err := intValue(math.MinInt32, math.MaxInt32)
+		return int32(v), err
+	case types.T_int64:
+		return intValue(math.MinInt64, math.MaxInt64)
+	case types.T_uint8:
+		v, err := uintValue(math.MaxUint8)
+		return uint8(v), err
+	case types.T_uint16:
+		v, err := uintValue(math.MaxUint16)
+		return uint16(v), err
+	case types.T_uint32:
+		v, err := uintValue(math.MaxUint32)
+		return uint32(v), err
+	case types.T_uint64:
+		return uintValue(math.MaxUint64)
+	case types.T_float32, types.T_float64:
+		v, err := a.getFloat(values, 0)
+		if err == nil && math.IsNaN(v) {
+			err = a.invalidValue(v)
+		}
+		if a.typ.Oid == types.T_float32 {
+			return float32(v), err
+		}
+		return v, err
+	case types.T_decimal64:
+		v, err := a.getDecimal(values, 0)
+		return types.Decimal64(v.B0_63), err
+	case types.T_decimal128:
+		return a.getDecimal(values, 0)
+	case types.T_date:
+		v, err := a.getDatetime(values, 0)
+		return v.ToDate(), err
+	case types.T_datetime:
+		return a.getDatetime(values, 0)
+	case types.T_timestamp:
+		return a.getTimestamp(values, 0)
+	case types.T_time:
+		return a.getTime(values, 0), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary:
+		return cloneBytes(values.Bytes[0]), nil
+	}
+	return nil, moerr.NewNotSupported(a.ctx, "statistics of parquet column '%s'", a.col.Name)
+}
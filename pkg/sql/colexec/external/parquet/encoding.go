@@ -0,0 +1,510 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+var errCorruptedPage = moerr.NewInternalErrorNoCtx("corrupted parquet page")
+
+// Values holds decoded values, only the slice of the physical type of the
+// column is used. BYTE_ARRAY, FIXED_LEN_BYTE_ARRAY and INT96 values all go
+// to Bytes.
+type Values struct {
+	Bools    []bool
+	Int32s   []int32
+	Int64s   []int64
+	Float32s []float32
+	Float64s []float64
+	Bytes    [][]byte
+}
+
+func (v *Values) Reset() {
+	v.Bools = v.Bools[:0]
+	v.Int32s = v.Int32s[:0]
+	v.Int64s = v.Int64s[:0]
+	v.Float32s = v.Float32s[:0]
+	v.Float64s = v.Float64s[:0]
+	v.Bytes = v.Bytes[:0]
+}
+
+func (v *Values) Len(typ int32) int {
+	switch typ {
+	case TypeBoolean:
+		return len(v.Bools)
+	case TypeInt32:
+		return len(v.Int32s)
+	case TypeInt64:
+		return len(v.Int64s)
+	case TypeFloat:
+		return len(v.Float32s)
+	case TypeDouble:
+		return len(v.Float64s)
+	default:
+		return len(v.Bytes)
+	}
+}
+
+// appendRange appends src[from:to] to v.
+func (v *Values) appendRange(typ int32, src *Values, from, to int) {
+	switch typ {
+	case TypeBoolean:
+		v.Bools = append(v.Bools, src.Bools[from:to]...)
+	case TypeInt32:
+		v.Int32s = append(v.Int32s, src.Int32s[from:to]...)
+	case TypeInt64:
+		v.Int64s = append(v.Int64s, src.Int64s[from:to]...)
+	case TypeFloat:
+		v.Float32s = append(v.Float32s, src.Float32s[from:to]...)
+	case TypeDouble:
+		v.Float64s = append(v.Float64s, src.Float64s[from:to]...)
+	default:
+		v.Bytes = append(v.Bytes, src.Bytes[from:to]...)
+	}
+}
+
+func appendIndexed[T any](dst []T, dict []T, idx []uint32) ([]T, error) {
+	for _, i := range idx {
+		if int(i) >= len(dict) {
+			return dst, errCorruptedPage
+		}
+		dst = append(dst, dict[i])
+	}
+	return dst, nil
+}
+
+// appendDict appends the dictionary entries referenced by idx to v.
+func (v *Values) appendDict(typ int32, dict *Values, idx []uint32) (err error) {
+	switch typ {
+	case TypeBoolean:
+		v.Bools, err = appendIndexed(v.Bools, dict.Bools, idx)
+	case TypeInt32:
+		v.Int32s, err = appendIndexed(v.Int32s, dict.Int32s, idx)
+	case TypeInt64:
+		v.Int64s, err = appendIndexed(v.Int64s, dict.Int64s, idx)
+	case TypeFloat:
+		v.Float32s, err = appendIndexed(v.Float32s, dict.Float32s, idx)
+	case TypeDouble:
+		v.Float64s, err = appendIndexed(v.Float64s, dict.Float64s, idx)
+	default:
+		v.Bytes, err = appendIndexed(v.Bytes, dict.Bytes, idx)
+	}
+	return err
+}
+
+func bitWidth(max uint64) int {
+	w := 0
+	for max != 0 {
+		w++
+		max >>= 1
+	}
+	return w
+}
+
+// hybridDecoder decodes the RLE / bit-packing hybrid encoding used for the
+// levels, the dictionary indices and the booleans.
+type hybridDecoder struct {
+	data  []byte
+	pos   int
+	width int
+
+	// current run
+	rle     bool
+	left    int
+	value   uint32
+	packed  []byte
+	packPos int
+}
+
+func newHybridDecoder(data []byte, width int) *hybridDecoder {
+	return &hybridDecoder{data: data, width: width}
+}
+
+func (d *hybridDecoder) nextRun() error {
+	header, n := binary.Uvarint(d.data[d.pos:])
+	if n <= 0 {
+		return errCorruptedPage
+	}
+	d.pos += n
+	if header&1 == 0 {
+		d.rle = true
+		d.left = int(header >> 1)
+		nb := (d.width + 7) / 8
+		if d.pos+nb > len(d.data) {
+			return errCorruptedPage
+		}
+		var v uint32
+		for i := 0; i < nb; i++ {
+			v |= uint32(d.data[d.pos+i]) << (8 * i)
+		}
+		d.pos += nb
+		d.value = v
+		return nil
+	}
+	d.rle = false
+	groups := int(header >> 1)
+	nb := groups * d.width
+	d.left = groups * 8
+	if nb > len(d.data)-d.pos {
+		// the last bit-packed run may be truncated, the missing values
+		// are padding.
+		nb = len(d.data) - d.pos
+		if d.width > 0 {
+			d.left = nb * 8 / d.width
+		}
+	}
+	d.packed = d.data[d.pos : d.pos+nb]
+	d.packPos = 0
+	d.pos += nb
+	return nil
+}
+
+// decode fills dst with the next len(dst) values.
+func (d *hybridDecoder) decode(dst []uint32) error {
+	if d.width == 0 {
+		for i := range dst {
+			dst[i] = 0
+		}
+		return nil
+	}
+	mask := uint64(1)<<d.width - 1
+	for i := 0; i < len(dst); {
+		if d.left == 0 {
+			if d.pos >= len(d.data) {
+				return errCorruptedPage
+			}
+			if err := d.nextRun(); err != nil {
+				return err
+			}
+			continue
+		}
+		n := len(dst) - i
+		if n > d.left {
+			n = d.left
+		}
+		if d.rle {
+			for j := 0; j < n; j++ {
+				dst[i+j] = d.value
+			}
+		} else {
+			for j := 0; j < n; j++ {
+				dst[i+j] = uint32(unpackBits(d.packed, d.packPos*d.width, d.width) & mask)
+				d.packPos++
+			}
+		}
+		d.left -= n
+		i += n
+	}
+	return nil
+}
+
+// unpackBits reads width bits starting at bit, least significant bit first.
+func unpackBits(data []byte, bit int, width int) uint64 {
+	var v uint64
+	for read := 0; read < width; {
+		idx := bit / 8
+		if idx >= len(data) {
+			break
+		}
+		off := bit % 8
+		n := 8 - off
+		if n > width-read {
+			n = width - read
+		}
+		v |= uint64((data[idx]>>off)&byte(1<<n-1)) << read
+		read += n
+		bit += n
+	}
+	return v
+}
+
+func decodePlain(typ int32, typeLength int32, data []byte, n int, out *Values) error {
+	switch typ {
+	case TypeBoolean:
+		if (n+7)/8 > len(data) {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			out.Bools = append(out.Bools, data[i/8]>>(i%8)&1 == 1)
+		}
+	case TypeInt32:
+		if n*4 > len(data) {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			out.Int32s = append(out.Int32s, int32(binary.LittleEndian.Uint32(data[i*4:])))
+		}
+	case TypeInt64:
+		if n*8 > len(data) {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			out.Int64s = append(out.Int64s, int64(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+	case TypeFloat:
+		if n*4 > len(data) {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			out.Float32s = append(out.Float32s, math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:])))
+		}
+	case TypeDouble:
+		if n*8 > len(data) {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			out.Float64s = append(out.Float64s, math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+	case TypeInt96, TypeFixedLenByteArray:
+		size := 12
+		if typ == TypeFixedLenByteArray {
+			size = int(typeLength)
+		}
+		if size <= 0 || n*size > len(data) {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			out.Bytes = append(out.Bytes, data[i*size:(i+1)*size:(i+1)*size])
+		}
+	case TypeByteArray:
+		pos := 0
+		for i := 0; i < n; i++ {
+			if pos+4 > len(data) {
+				return errCorruptedPage
+			}
+			l := int(binary.LittleEndian.Uint32(data[pos:]))
+			pos += 4
+			if l < 0 || l > len(data)-pos {
+				return errCorruptedPage
+			}
+			out.Bytes = append(out.Bytes, data[pos:pos+l:pos+l])
+			pos += l
+		}
+	default:
+		return errCorruptedPage
+	}
+	return nil
+}
+
+const maxDeltaBlockSize = 1 << 20
+
+// decodeDeltaBinaryPacked decodes n values and returns the number of bytes
+// consumed.
+func decodeDeltaBinaryPacked(data []byte, n int, out []int64) ([]int64, int, error) {
+	pos := 0
+	readUvarint := func() (uint64, error) {
+		v, k := binary.Uvarint(data[pos:])
+		if k <= 0 {
+			return 0, errCorruptedPage
+		}
+		pos += k
+		return v, nil
+	}
+	readZigzag := func() (int64, error) {
+		v, err := readUvarint()
+		return int64(v>>1) ^ -int64(v&1), err
+	}
+	blockSize, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	miniBlocks, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	first, err := readZigzag()
+	if err != nil {
+		return nil, 0, err
+	}
+	// every block takes at least one byte, which bounds the value count of
+	// a corrupted header.
+	if miniBlocks == 0 || blockSize == 0 || blockSize > maxDeltaBlockSize || blockSize%miniBlocks != 0 ||
+		total > uint64(len(data))*blockSize+1 {
+		return nil, 0, errCorruptedPage
+	}
+	perMini := int(blockSize / miniBlocks)
+	count := int(total)
+	if count > 0 {
+		out = append(out, first)
+	}
+	last := first
+	widths := make([]int, miniBlocks)
+	for decoded := 1; decoded < count; {
+		minDelta, err := readZigzag()
+		if err != nil {
+			return nil, 0, err
+		}
+		if pos+int(miniBlocks) > len(data) {
+			return nil, 0, errCorruptedPage
+		}
+		for i := range widths {
+			widths[i] = int(data[pos+i])
+			if widths[i] > 64 {
+				return nil, 0, errCorruptedPage
+			}
+		}
+		pos += int(miniBlocks)
+		for i := 0; i < int(miniBlocks) && decoded < count; i++ {
+			size := perMini * widths[i] / 8
+			if pos+size > len(data) {
+				return nil, 0, errCorruptedPage
+			}
+			mini := data[pos : pos+size]
+			for j := 0; j < perMini && decoded < count; j++ {
+				last += minDelta + int64(unpackBits(mini, j*widths[i], widths[i]))
+				out = append(out, last)
+				decoded++
+			}
+			pos += size
+		}
+	}
+	if n > count {
+		return nil, 0, errCorruptedPage
+	}
+	return out, pos, nil
+}
+
+func decodeDeltaLengthByteArray(data []byte, n int, out [][]byte) ([][]byte, int, error) {
+	lengths, pos, err := decodeDeltaBinaryPacked(data, n, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := 0; i < n; i++ {
+		l := int(lengths[i])
+		if l < 0 || l > len(data)-pos {
+			return nil, 0, errCorruptedPage
+		}
+		out = append(out, data[pos:pos+l:pos+l])
+		pos += l
+	}
+	return out, pos, nil
+}
+
+func decodeDeltaByteArray(data []byte, n int, out [][]byte) ([][]byte, error) {
+	prefix, pos, err := decodeDeltaBinaryPacked(data, n, nil)
+	if err != nil {
+		return nil, err
+	}
+	suffix, _, err := decodeDeltaLengthByteArray(data[pos:], n, nil)
+	if err != nil {
+		return nil, err
+	}
+	var prev []byte
+	for i := 0; i < n; i++ {
+		p := int(prefix[i])
+		if p < 0 || p > len(prev) {
+			return nil, errCorruptedPage
+		}
+		v := make([]byte, 0, p+len(suffix[i]))
+		v = append(v, prev[:p]...)
+		v = append(v, suffix[i]...)
+		out = append(out, v)
+		prev = v
+	}
+	return out, nil
+}
+
+func decodeByteStreamSplit(typ int32, typeLength int32, data []byte, n int, out *Values) error {
+	var size int
+	switch typ {
+	case TypeInt32, TypeFloat:
+		size = 4
+	case TypeInt64, TypeDouble:
+		size = 8
+	case TypeFixedLenByteArray:
+		size = int(typeLength)
+	default:
+		return moerr.NewNotSupportedNoCtx("BYTE_STREAM_SPLIT encoding of parquet physical type %d", typ)
+	}
+	if size <= 0 || n*size > len(data) {
+		return errCorruptedPage
+	}
+	plain := make([]byte, n*size)
+	for i := 0; i < n; i++ {
+		for k := 0; k < size; k++ {
+			plain[i*size+k] = data[k*n+i]
+		}
+	}
+	return decodePlain(typ, typeLength, plain, n, out)
+}
+
+// decodeValues decodes n non-dictionary encoded values of a data page.
+func decodeValues(col *Column, encoding int32, data []byte, n int, out *Values) error {
+	switch encoding {
+	case encodingPlain:
+		return decodePlain(col.Type, col.TypeLength, data, n, out)
+	case encodingRLE:
+		if col.Type != TypeBoolean {
+			break
+		}
+		if len(data) < 4 {
+			return errCorruptedPage
+		}
+		l := int(binary.LittleEndian.Uint32(data))
+		if l < 0 || l > len(data)-4 {
+			return errCorruptedPage
+		}
+		idx := make([]uint32, n)
+		if err := newHybridDecoder(data[4:4+l], 1).decode(idx); err != nil {
+			return err
+		}
+		for _, v := range idx {
+			out.Bools = append(out.Bools, v == 1)
+		}
+		return nil
+	case encodingDeltaBinaryPacked:
+		var vs []int64
+		var err error
+		switch col.Type {
+		case TypeInt32:
+			if vs, _, err = decodeDeltaBinaryPacked(data, n, nil); err != nil {
+				return err
+			}
+			for _, v := range vs[:n] {
+				out.Int32s = append(out.Int32s, int32(v))
+			}
+			return nil
+		case TypeInt64:
+			if vs, _, err = decodeDeltaBinaryPacked(data, n, nil); err != nil {
+				return err
+			}
+			out.Int64s = append(out.Int64s, vs[:n]...)
+			return nil
+		}
+	case encodingDeltaLengthByteArray:
+		if col.Type == TypeByteArray {
+			var err error
+			out.Bytes, _, err = decodeDeltaLengthByteArray(data, n, out.Bytes)
+			return err
+		}
+	case encodingDeltaByteArray:
+		if col.Type == TypeByteArray || col.Type == TypeFixedLenByteArray {
+			var err error
+			out.Bytes, err = decodeDeltaByteArray(data, n, out.Bytes)
+			return err
+		}
+	case encodingByteStreamSplit:
+		return decodeByteStreamSplit(col.Type, col.TypeLength, data, n, out)
+	}
+	return moerr.NewNotSupportedNoCtx("parquet encoding %d of column '%s'", encoding, col.Name)
+}
@@ -0,0 +1,275 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+var magic = []byte("PAR1")
+
+const footerSize = 8
+
+// maxFooterLength protects the reader from allocating a huge buffer for a
+// corrupted footer length.
+const maxFooterLength = 256 << 20
+
+// Column describes a leaf column of the parquet schema.
+type Column struct {
+	// Name is the dotted path of the column in the schema
+	Name string
+	// Index is the position of the column chunk in a row group
+	Index      int
+	Type       int32
+	TypeLength int32
+	Optional   bool
+	// Nested is set for the columns under a group or a repeated field,
+	// they can not be mapped to a flat table column.
+	Nested bool
+
+	convertedType    int32
+	hasConvertedType bool
+	scale            int32
+	precision        int32
+	logical          logicalType
+}
+
+// File is an opened parquet file, only its footer is kept in memory and
+// the column chunks are read on demand.
+type File struct {
+	r       io.ReaderAt
+	size    int64
+	meta    *fileMetaData
+	columns []*Column
+}
+
+// Open reads the footer of a parquet file of the given size.
+func Open(ctx context.Context, r io.ReaderAt, size int64) (*File, error) {
+	if size < int64(len(magic)+footerSize) {
+		return nil, moerr.NewInvalidInput(ctx, "the file is too small to be a parquet file")
+	}
+	footer := make([]byte, footerSize)
+	if _, err := r.ReadAt(footer, size-footerSize); err != nil && err != io.EOF {
+		return nil, err
+	}
+	if !bytes.Equal(footer[4:], magic) {
+		return nil, moerr.NewInvalidInput(ctx, "the file is not a parquet file")
+	}
+	n := int64(binary.LittleEndian.Uint32(footer))
+	if n > maxFooterLength || n > size-footerSize-int64(len(magic)) {
+		return nil, moerr.NewInvalidInput(ctx, "invalid parquet footer length %d", n)
+	}
+	buf := make([]byte, n)
+	if _, err := r.ReadAt(buf, size-footerSize-n); err != nil && err != io.EOF {
+		return nil, err
+	}
+	meta, err := readFileMetaData(&thriftReader{buf: buf})
+	if err != nil {
+		return nil, err
+	}
+	f := &File{
+		r:    r,
+		size: size,
+		meta: meta,
+	}
+	if err = f.buildColumns(ctx); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *File) buildColumns(ctx context.Context) error {
+	schema := f.meta.schema
+	if len(schema) == 0 {
+		return moerr.NewInvalidInput(ctx, "the parquet file has no schema")
+	}
+	pos := 1
+	var walk func(prefix string, nested bool, children int32) error
+	walk = func(prefix string, nested bool, children int32) error {
+		for i := int32(0); i < children; i++ {
+			if pos >= len(schema) {
+				return moerr.NewInvalidInput(ctx, "invalid parquet schema")
+			}
+			se := &schema[pos]
+			pos++
+			name := se.name
+			if prefix != "" {
+				name = prefix + "." + name
+			}
+			isNested := nested || se.repetition == repetitionRepeated
+			if se.numChildren > 0 {
+				if err := walk(name, true, se.numChildren); err != nil {
+					return err
+				}
+				continue
+			}
+			f.columns = append(f.columns, &Column{
+				Name:             name,
+				Index:            len(f.columns),
+				Type:             se.typ,
+				TypeLength:       se.typeLength,
+				Optional:         se.repetition == repetitionOptional,
+				Nested:           isNested,
+				convertedType:    se.convertedType,
+				hasConvertedType: se.hasConvertedType,
+				scale:            se.scale,
+				precision:        se.precision,
+				logical:          se.logical,
+			})
+		}
+		return nil
+	}
+	if err := walk("", false, schema[0].numChildren); err != nil {
+		return err
+	}
+	for i := range f.meta.rowGroups {
+		if len(f.meta.rowGroups[i].columns) != len(f.columns) {
+			return moerr.NewInvalidInput(ctx, "the row group %d of the parquet file does not match its schema", i)
+		}
+	}
+	return nil
+}
+
+// Columns returns the leaf columns in schema order.
+func (f *File) Columns() []*Column {
+	return f.columns
+}
+
+// Column looks up a leaf column by name, case-insensitively as the column
+// names of MatrixOne are.
+func (f *File) Column(name string) *Column {
+	for _, col := range f.columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	for _, col := range f.columns {
+		if strings.EqualFold(col.Name, name) {
+			return col
+		}
+	}
+	return nil
+}
+
+// NumRows returns the number of rows of the file.
+func (f *File) NumRows() int64 {
+	return f.meta.numRows
+}
+
+// NumRowGroups returns the number of row groups of the file.
+func (f *File) NumRowGroups() int {
+	return len(f.meta.rowGroups)
+}
+
+// RowGroupNumRows returns the number of rows of a row group.
+func (f *File) RowGroupNumRows(rg int) int64 {
+	return f.meta.rowGroups[rg].numRows
+}
+
+func (f *File) chunkRange(ctx context.Context, rg int, col *Column) (*columnMetaData, int64, int64, error) {
+	cc := &f.meta.rowGroups[rg].columns[col.Index]
+	if cc.filePath != "" {
+		return nil, 0, 0, moerr.NewNotSupported(ctx, "parquet column chunks in external file '%s'", cc.filePath)
+	}
+	if !cc.hasMeta {
+		return nil, 0, 0, moerr.NewInvalidInput(ctx, "the parquet column '%s' has no metadata", col.Name)
+	}
+	md := &cc.meta
+	start := md.dataPageOffset
+	if md.hasDictionaryOffset && md.dictionaryPageOffset > 0 && md.dictionaryPageOffset < start {
+		start = md.dictionaryPageOffset
+	}
+	length := md.totalCompressedSize
+	if start < int64(len(magic)) || length < 0 || start+length > f.size-footerSize {
+		return nil, 0, 0, moerr.NewInvalidInput(ctx, "invalid parquet column chunk of column '%s'", col.Name)
+	}
+	return md, start, length, nil
+}
+
+// ColumnReader reads the column chunk of a column in a row group, only the
+// bytes of that chunk are fetched from the underlying reader.
+func (f *File) ColumnReader(ctx context.Context, rg int, col *Column) (*ColumnReader, error) {
+	if col.Nested {
+		return nil, moerr.NewNotSupported(ctx, "nested or repeated parquet column '%s'", col.Name)
+	}
+	md, start, length, err := f.chunkRange(ctx, rg, col)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, length)
+	if _, err = f.r.ReadAt(buf, start); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return &ColumnReader{
+		ctx:    ctx,
+		col:    col,
+		codec:  md.codec,
+		buf:    buf,
+		remain: f.meta.rowGroups[rg].numRows,
+	}, nil
+}
+
+// Statistics returns the minimum and maximum value of a column in a row
+// group, in the plain encoding of the physical type of the column. ok is
+// false if the file carries no usable statistics.
+func (f *File) Statistics(rg int, col *Column) (min, max []byte, ok bool) {
+	cc := &f.meta.rowGroups[rg].columns[col.Index]
+	if col.Nested || !cc.hasMeta || !cc.meta.hasStatistics {
+		return nil, nil, false
+	}
+	st := &cc.meta.statistics
+	if st.minValue != nil && st.maxValue != nil {
+		return st.minValue, st.maxValue, true
+	}
+	// the deprecated min and max are ordered as signed values, they are only
+	// trustworthy for the types whose order does not depend on the annotation.
+	if st.min == nil || st.max == nil || col.unsigned() {
+		return nil, nil, false
+	}
+	switch col.Type {
+	case TypeInt32, TypeInt64, TypeFloat, TypeDouble, TypeBoolean:
+		return st.min, st.max, true
+	}
+	return nil, nil, false
+}
+
+// NullCount returns the number of nulls of a column in a row group, ok is
+// false if it is unknown.
+func (f *File) NullCount(rg int, col *Column) (int64, bool) {
+	cc := &f.meta.rowGroups[rg].columns[col.Index]
+	if !cc.hasMeta || !cc.meta.hasStatistics || !cc.meta.statistics.hasNull {
+		return 0, false
+	}
+	return cc.meta.statistics.nullCount, true
+}
+
+func (col *Column) unsigned() bool {
+	if col.logical.kind == logicalInteger {
+		return !col.logical.signed
+	}
+	if col.hasConvertedType {
+		switch col.convertedType {
+		case convertedUint8, convertedUint16, convertedUint32, convertedUint64:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,540 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+// The structures below mirror the ones of parquet.thrift, only the fields
+// needed by the reader are decoded and the rest is skipped.
+
+// physical types
+const (
+	TypeBoolean           int32 = 0
+	TypeInt32             int32 = 1
+	TypeInt64             int32 = 2
+	TypeInt96             int32 = 3
+	TypeFloat             int32 = 4
+	TypeDouble            int32 = 5
+	TypeByteArray         int32 = 6
+	TypeFixedLenByteArray int32 = 7
+)
+
+// field repetition types
+const (
+	repetitionRequired int32 = 0
+	repetitionOptional int32 = 1
+	repetitionRepeated int32 = 2
+)
+
+// converted types, the legacy way to annotate a physical type
+const (
+	convertedUTF8            int32 = 0
+	convertedMap             int32 = 1
+	convertedMapKeyValue     int32 = 2
+	convertedList            int32 = 3
+	convertedEnum            int32 = 4
+	convertedDecimal         int32 = 5
+	convertedDate            int32 = 6
+	convertedTimeMillis      int32 = 7
+	convertedTimeMicros      int32 = 8
+	convertedTimestampMillis int32 = 9
+	convertedTimestampMicros int32 = 10
+	convertedUint8           int32 = 11
+	convertedUint16          int32 = 12
+	convertedUint32          int32 = 13
+	convertedUint64          int32 = 14
+	convertedInt8            int32 = 15
+	convertedInt16           int32 = 16
+	convertedInt32           int32 = 17
+	convertedInt64           int32 = 18
+	convertedJSON            int32 = 19
+	convertedBSON            int32 = 20
+	convertedInterval        int32 = 21
+)
+
+// logical types, the values are the field ids of the LogicalType union
+const (
+	logicalNone      int16 = 0
+	logicalString    int16 = 1
+	logicalMap       int16 = 2
+	logicalList      int16 = 3
+	logicalEnum      int16 = 4
+	logicalDecimal   int16 = 5
+	logicalDate      int16 = 6
+	logicalTime      int16 = 7
+	logicalTimestamp int16 = 8
+	logicalInteger   int16 = 10
+	logicalUnknown   int16 = 11
+	logicalJSON      int16 = 12
+	logicalBSON      int16 = 13
+	logicalUUID      int16 = 14
+)
+
+// time units, the values are the field ids of the TimeUnit union
+const (
+	unitMillis int16 = 1
+	unitMicros int16 = 2
+	unitNanos  int16 = 3
+)
+
+// compression codecs
+const (
+	codecUncompressed int32 = 0
+	codecSnappy       int32 = 1
+	codecGzip         int32 = 2
+	codecLZO          int32 = 3
+	codecBrotli       int32 = 4
+	codecLZ4          int32 = 5
+	codecZstd         int32 = 6
+	codecLZ4Raw       int32 = 7
+)
+
+// encodings
+const (
+	encodingPlain                int32 = 0
+	encodingPlainDictionary      int32 = 2
+	encodingRLE                  int32 = 3
+	encodingBitPacked            int32 = 4
+	encodingDeltaBinaryPacked    int32 = 5
+	encodingDeltaLengthByteArray int32 = 6
+	encodingDeltaByteArray       int32 = 7
+	encodingRLEDictionary        int32 = 8
+	encodingByteStreamSplit      int32 = 9
+)
+
+// page types
+const (
+	pageData       int32 = 0
+	pageIndex      int32 = 1
+	pageDictionary int32 = 2
+	pageDataV2     int32 = 3
+)
+
+type fileMetaData struct {
+	version   int32
+	schema    []schemaElement
+	numRows   int64
+	rowGroups []rowGroup
+	createdBy string
+}
+
+type logicalType struct {
+	kind          int16
+	scale         int32
+	precision     int32
+	adjustedToUTC bool
+	unit          int16
+	bitWidth      int8
+	signed        bool
+}
+
+type schemaElement struct {
+	typ              int32
+	hasType          bool
+	typeLength       int32
+	repetition       int32
+	name             string
+	numChildren      int32
+	convertedType    int32
+	hasConvertedType bool
+	scale            int32
+	precision        int32
+	logical          logicalType
+}
+
+type rowGroup struct {
+	columns       []columnChunk
+	totalByteSize int64
+	numRows       int64
+}
+
+type columnChunk struct {
+	filePath string
+	meta     columnMetaData
+	hasMeta  bool
+}
+
+type columnMetaData struct {
+	typ                   int32
+	path                  []string
+	codec                 int32
+	numValues             int64
+	totalUncompressedSize int64
+	totalCompressedSize   int64
+	dataPageOffset        int64
+	dictionaryPageOffset  int64
+	hasDictionaryOffset   bool
+	statistics            statistics
+	hasStatistics         bool
+}
+
+type statistics struct {
+	max       []byte
+	min       []byte
+	nullCount int64
+	hasNull   bool
+	maxValue  []byte
+	minValue  []byte
+}
+
+type pageHeader struct {
+	typ              int32
+	uncompressedSize int32
+	compressedSize   int32
+	data             dataPageHeader
+	dictionary       dictionaryPageHeader
+	dataV2           dataPageHeaderV2
+}
+
+type dataPageHeader struct {
+	numValues int32
+	encoding  int32
+	defEnc    int32
+	repEnc    int32
+}
+
+type dictionaryPageHeader struct {
+	numValues int32
+	encoding  int32
+}
+
+type dataPageHeaderV2 struct {
+	numValues    int32
+	numNulls     int32
+	numRows      int32
+	encoding     int32
+	defLength    int32
+	repLength    int32
+	isCompressed bool
+}
+
+func cloneBytes(b []byte) []byte {
+	return append([]byte(nil), b...)
+}
+
+func readFileMetaData(r *thriftReader) (*fileMetaData, error) {
+	md := &fileMetaData{}
+	err := r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == ctI32:
+			md.version, err = r.readI32()
+		case id == 2 && typ == ctList:
+			var n int
+			if _, n, err = r.readListHeader(); err != nil {
+				return err
+			}
+			md.schema = make([]schemaElement, n)
+			for i := range md.schema {
+				if err = readSchemaElement(r, &md.schema[i]); err != nil {
+					return err
+				}
+			}
+		case id == 3 && typ == ctI64:
+			md.numRows, err = r.readI64()
+		case id == 4 && typ == ctList:
+			var n int
+			if _, n, err = r.readListHeader(); err != nil {
+				return err
+			}
+			md.rowGroups = make([]rowGroup, n)
+			for i := range md.rowGroups {
+				if err = readRowGroup(r, &md.rowGroups[i]); err != nil {
+					return err
+				}
+			}
+		case id == 6 && typ == ctBinary:
+			md.createdBy, err = r.readString()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return md, nil
+}
+
+func readSchemaElement(r *thriftReader, se *schemaElement) error {
+	return r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == ctI32:
+			se.typ, err = r.readI32()
+			se.hasType = true
+		case id == 2 && typ == ctI32:
+			se.typeLength, err = r.readI32()
+		case id == 3 && typ == ctI32:
+			se.repetition, err = r.readI32()
+		case id == 4 && typ == ctBinary:
+			se.name, err = r.readString()
+		case id == 5 && typ == ctI32:
+			se.numChildren, err = r.readI32()
+		case id == 6 && typ == ctI32:
+			se.convertedType, err = r.readI32()
+			se.hasConvertedType = true
+		case id == 7 && typ == ctI32:
+			se.scale, err = r.readI32()
+		case id == 8 && typ == ctI32:
+			se.precision, err = r.readI32()
+		case id == 10 && typ == ctStruct:
+			err = readLogicalType(r, &se.logical)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func readLogicalType(r *thriftReader, lt *logicalType) error {
+	return r.readStruct(func(id int16, typ byte) error {
+		if typ != ctStruct {
+			return r.skip(typ)
+		}
+		lt.kind = id
+		switch id {
+		case logicalDecimal:
+			return r.readStruct(func(id int16, typ byte) error {
+				var err error
+				switch {
+				case id == 1 && typ == ctI32:
+					lt.scale, err = r.readI32()
+				case id == 2 && typ == ctI32:
+					lt.precision, err = r.readI32()
+				default:
+					err = r.skip(typ)
+				}
+				return err
+			})
+		case logicalTime, logicalTimestamp:
+			return r.readStruct(func(id int16, typ byte) error {
+				switch {
+				case id == 1 && (typ == ctTrue || typ == ctFalse):
+					lt.adjustedToUTC = fieldBool(typ)
+					return nil
+				case id == 2 && typ == ctStruct:
+					return r.readStruct(func(id int16, typ byte) error {
+						if typ == ctStruct {
+							lt.unit = id
+						}
+						return r.skip(typ)
+					})
+				default:
+					return r.skip(typ)
+				}
+			})
+		case logicalInteger:
+			return r.readStruct(func(id int16, typ byte) error {
+				switch {
+				case id == 1 && typ == ctByte:
+					b, err := r.readByte()
+					lt.bitWidth = int8(b)
+					return err
+				case id == 2 && (typ == ctTrue || typ == ctFalse):
+					lt.signed = fieldBool(typ)
+					return nil
+				default:
+					return r.skip(typ)
+				}
+			})
+		default:
+			return r.skip(typ)
+		}
+	})
+}
+
+func readRowGroup(r *thriftReader, rg *rowGroup) error {
+	return r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == ctList:
+			var n int
+			if _, n, err = r.readListHeader(); err != nil {
+				return err
+			}
+			rg.columns = make([]columnChunk, n)
+			for i := range rg.columns {
+				if err = readColumnChunk(r, &rg.columns[i]); err != nil {
+					return err
+				}
+			}
+		case id == 2 && typ == ctI64:
+			rg.totalByteSize, err = r.readI64()
+		case id == 3 && typ == ctI64:
+			rg.numRows, err = r.readI64()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func readColumnChunk(r *thriftReader, cc *columnChunk) error {
+	return r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == ctBinary:
+			cc.filePath, err = r.readString()
+		case id == 3 && typ == ctStruct:
+			err = readColumnMetaData(r, &cc.meta)
+			cc.hasMeta = true
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func readColumnMetaData(r *thriftReader, cm *columnMetaData) error {
+	return r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == ctI32:
+			cm.typ, err = r.readI32()
+		case id == 3 && typ == ctList:
+			var n int
+			if _, n, err = r.readListHeader(); err != nil {
+				return err
+			}
+			cm.path = make([]string, n)
+			for i := range cm.path {
+				if cm.path[i], err = r.readString(); err != nil {
+					return err
+				}
+			}
+		case id == 4 && typ == ctI32:
+			cm.codec, err = r.readI32()
+		case id == 5 && typ == ctI64:
+			cm.numValues, err = r.readI64()
+		case id == 6 && typ == ctI64:
+			cm.totalUncompressedSize, err = r.readI64()
+		case id == 7 && typ == ctI64:
+			cm.totalCompressedSize, err = r.readI64()
+		case id == 9 && typ == ctI64:
+			cm.dataPageOffset, err = r.readI64()
+		case id == 11 && typ == ctI64:
+			cm.dictionaryPageOffset, err = r.readI64()
+			cm.hasDictionaryOffset = true
+		case id == 12 && typ == ctStruct:
+			err = readStatistics(r, &cm.statistics)
+			cm.hasStatistics = true
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func readStatistics(r *thriftReader, st *statistics) error {
+	return r.readStruct(func(id int16, typ byte) error {
+		var err error
+		var b []byte
+		switch {
+		case id == 1 && typ == ctBinary:
+			b, err = r.readBinary()
+			st.max = cloneBytes(b)
+		case id == 2 && typ == ctBinary:
+			b, err = r.readBinary()
+			st.min = cloneBytes(b)
+		case id == 3 && typ == ctI64:
+			st.nullCount, err = r.readI64()
+			st.hasNull = true
+		case id == 5 && typ == ctBinary:
+			b, err = r.readBinary()
+			st.maxValue = cloneBytes(b)
+		case id == 6 && typ == ctBinary:
+			b, err = r.readBinary()
+			st.minValue = cloneBytes(b)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func readPageHeader(r *thriftReader) (*pageHeader, error) {
+	ph := &pageHeader{}
+	ph.dataV2.isCompressed = true
+	err := r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == ctI32:
+			ph.typ, err = r.readI32()
+		case id == 2 && typ == ctI32:
+			ph.uncompressedSize, err = r.readI32()
+		case id == 3 && typ == ctI32:
+			ph.compressedSize, err = r.readI32()
+		case id == 5 && typ == ctStruct:
+			err = r.readStruct(func(id int16, typ byte) error {
+				var err error
+				switch {
+				case id == 1 && typ == ctI32:
+					ph.data.numValues, err = r.readI32()
+				case id == 2 && typ == ctI32:
+					ph.data.encoding, err = r.readI32()
+				case id == 3 && typ == ctI32:
+					ph.data.defEnc, err = r.readI32()
+				case id == 4 && typ == ctI32:
+					ph.data.repEnc, err = r.readI32()
+				default:
+					err = r.skip(typ)
+				}
+				return err
+			})
+		case id == 7 && typ == ctStruct:
+			err = r.readStruct(func(id int16, typ byte) error {
+				var err error
+				switch {
+				case id == 1 && typ == ctI32:
+					ph.dictionary.numValues, err = r.readI32()
+				case id == 2 && typ == ctI32:
+					ph.dictionary.encoding, err = r.readI32()
+				default:
+					err = r.skip(typ)
+				}
+				return err
+			})
+		case id == 8 && typ == ctStruct:
+			err = r.readStruct(func(id int16, typ byte) error {
+				var err error
+				switch {
+				case id == 1 && typ == ctI32:
+					ph.dataV2.numValues, err = r.readI32()
+				case id == 2 && typ == ctI32:
+					ph.dataV2.numNulls, err = r.readI32()
+				case id == 3 && typ == ctI32:
+					ph.dataV2.numRows, err = r.readI32()
+				case id == 4 && typ == ctI32:
+					ph.dataV2.encoding, err = r.readI32()
+				case id == 5 && typ == ctI32:
+					ph.dataV2.defLength, err = r.readI32()
+				case id == 6 && typ == ctI32:
+					ph.dataV2.repLength, err = r.readI32()
+				case id == 7 && (typ == ctTrue || typ == ctFalse):
+					ph.dataV2.isCompressed = fieldBool(typ)
+				default:
+					err = r.skip(typ)
+				}
+				return err
+			})
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ph, nil
+}
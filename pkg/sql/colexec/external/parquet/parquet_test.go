@@ -0,0 +1,393 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func timestampMicros(utc bool) func(w *thriftWriter) {
+	return func(w *thriftWriter) {
+		w.begin(logicalTimestamp)
+		w.boolean(1, utc)
+		w.begin(2)
+		w.begin(unitMicros)
+		w.end()
+		w.end()
+		w.end()
+	}
+}
+
+func dateLogical(w *thriftWriter) {
+	w.begin(logicalDate)
+	w.end()
+}
+
+func testColumns() []testColumn {
+	return []testColumn{
+		{
+			name:      "id",
+			typ:       TypeInt64,
+			converted: -1,
+			values:    []any{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7)},
+		},
+		{
+			name:      "name",
+			typ:       TypeByteArray,
+			optional:  true,
+			converted: convertedUTF8,
+			encoding:  encodingRLEDictionary,
+			values:    []any{"a", nil, "b", "a", nil, "c", "a"},
+		},
+		{
+			name:      "score",
+			typ:       TypeDouble,
+			optional:  true,
+			converted: -1,
+			values:    []any{1.5, 2.5, nil, 4.5, 5.5, nil, 7.5},
+		},
+		{
+			name:      "price",
+			typ:       TypeInt64,
+			converted: convertedDecimal,
+			scale:     2,
+			precision: 10,
+			values:    []any{int64(100), int64(-250), int64(12345), int64(0), int64(1), int64(99), int64(-1)},
+		},
+		{
+			name:       "amount",
+			typ:        TypeFixedLenByteArray,
+			typeLength: 9,
+			optional:   true,
+			converted:  convertedDecimal,
+			scale:      3,
+			precision:  20,
+			values: []any{
+				[]byte{0, 0, 0, 0, 0, 0, 0, 0x30, 0x39},
+				[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				nil,
+				[]byte{0, 0, 0, 0, 0, 0, 0, 0, 0},
+				[]byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0},
+				nil,
+				[]byte{0, 0, 0, 0, 0, 0, 0, 0, 0x01},
+			},
+		},
+		{
+			name:      "flag",
+			typ:       TypeBoolean,
+			converted: -1,
+			values:    []any{true, false, true, true, false, false, true},
+		},
+		{
+			name:      "day",
+			typ:       TypeInt32,
+			converted: -1,
+			logical:   dateLogical,
+			values:    []any{int32(0), int32(1), int32(-1), int32(19000), int32(10), int32(11), int32(12)},
+		},
+		{
+			name:      "ts",
+			typ:       TypeInt64,
+			optional:  true,
+			converted: -1,
+			logical:   timestampMicros(true),
+			values:    []any{int64(0), int64(1500000), nil, int64(-1), int64(86400000000), int64(5), int64(6)},
+		},
+		{
+			name:      "cnt",
+			typ:       TypeInt32,
+			converted: convertedUint32,
+			values:    []any{int32(-1), int32(0), int32(1), int32(2), int32(3), int32(4), int32(5)},
+		},
+	}
+}
+
+func readAll(t *testing.T, data []byte, names []string, typs []types.Type, batchRows int) (*File, []*vector.Vector, error) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	f, err := Open(ctx, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, err
+	}
+	vecs := make([]*vector.Vector, len(names))
+	appenders := make([]*Appender, len(names))
+	cols := make([]*Column, len(names))
+	for i, name := range names {
+		cols[i] = f.Column(name)
+		require.NotNil(t, cols[i], name)
+		if appenders[i], err = NewAppender(ctx, cols[i], typs[i], time.UTC); err != nil {
+			return nil, nil, err
+		}
+		vecs[i] = vector.NewVec(typs[i])
+	}
+	var values Values
+	var notNull []bool
+	for rg := 0; rg < f.NumRowGroups(); rg++ {
+		for i, col := range cols {
+			r, err := f.ColumnReader(ctx, rg, col)
+			if err != nil {
+				return nil, nil, err
+			}
+			for {
+				values.Reset()
+				var n int
+				n, notNull, err = r.Read(batchRows, &values, notNull[:0])
+				if err != nil {
+					return nil, nil, err
+				}
+				if n == 0 {
+					break
+				}
+				if err = appenders[i].Append(vecs[i], &values, notNull, mp); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	}
+	return f, vecs, nil
+}
+
+func checkColumns(t *testing.T, data []byte, batchRows int) {
+	names := []string{"id", "NAME", "score", "price", "amount", "flag", "day", "ts", "cnt"}
+	typs := []types.Type{
+		types.T_int64.ToType(),
+		types.T_varchar.ToType(),
+		types.T_float64.ToType(),
+		types.New(types.T_decimal64, 10, 2),
+		types.New(types.T_decimal128, 20, 3),
+		types.T_bool.ToType(),
+		types.T_date.ToType(),
+		types.New(types.T_timestamp, 0, 6),
+		types.T_uint32.ToType(),
+	}
+	f, vecs, err := readAll(t, data, names, typs, batchRows)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), f.NumRows())
+	for _, vec := range vecs {
+		require.Equal(t, 7, vec.Length())
+	}
+
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7}, vector.MustFixedCol[int64](vecs[0]))
+
+	strs := make([]string, 7)
+	for i := range strs {
+		if vecs[1].GetNulls().Contains(uint64(i)) {
+			strs[i] = "NULL"
+		} else {
+			strs[i] = vecs[1].GetStringAt(i)
+		}
+	}
+	require.Equal(t, []string{"a", "NULL", "b", "a", "NULL", "c", "a"}, strs)
+
+	require.True(t, vecs[2].GetNulls().Contains(2))
+	require.True(t, vecs[2].GetNulls().Contains(5))
+	require.Equal(t, 4.5, vector.MustFixedCol[float64](vecs[2])[3])
+
+	prices := vector.MustFixedCol[types.Decimal64](vecs[3])
+	require.Equal(t, "1.00", prices[0].Format(2))
+	require.Equal(t, "-2.50", prices[1].Format(2))
+	require.Equal(t, "123.45", prices[2].Format(2))
+
+	amounts := vector.MustFixedCol[types.Decimal128](vecs[4])
+	require.Equal(t, "12.345", amounts[0].Format(3))
+	require.Equal(t, "-0.001", amounts[1].Format(3))
+	require.True(t, vecs[4].GetNulls().Contains(2))
+	require.Equal(t, "18446744073709551.616", amounts[4].Format(3))
+
+	require.Equal(t, []bool{true, false, true, true, false, false, true}, vector.MustFixedCol[bool](vecs[5]))
+
+	days := vector.MustFixedCol[types.Date](vecs[6])
+	require.Equal(t, "1970-01-01", days[0].String())
+	require.Equal(t, "1970-01-02", days[1].String())
+	require.Equal(t, "1969-12-31", days[2].String())
+	require.Equal(t, "2022-01-08", days[3].String())
+
+	ts := vector.MustFixedCol[types.Timestamp](vecs[7])
+	require.Equal(t, "1970-01-01 00:00:00.000000", ts[0].String2(time.UTC, 6))
+	require.Equal(t, "1970-01-01 00:00:01.500000", ts[1].String2(time.UTC, 6))
+	require.True(t, vecs[7].GetNulls().Contains(2))
+	require.Equal(t, "1969-12-31 23:59:59.999999", ts[3].String2(time.UTC, 6))
+	require.Equal(t, "1970-01-02 00:00:00.000000", ts[4].String2(time.UTC, 6))
+
+	require.Equal(t, []uint32{math.MaxUint32, 0, 1, 2, 3, 4, 5}, vector.MustFixedCol[uint32](vecs[8]))
+}
+
+func TestReadColumns(t *testing.T) {
+	codecs := []int32{codecUncompressed, codecSnappy, codecGzip, codecZstd, codecLZ4Raw}
+	for _, codec := range codecs {
+		for _, v2 := range []bool{false, true} {
+			t.Run(fmt.Sprintf("codec %d v2 %v", codec, v2), func(t *testing.T) {
+				tf := &testFile{
+					columns:   testColumns(),
+					rowGroups: []int{3, 4},
+					pageRows:  2,
+					codec:     codec,
+					v2:        v2,
+				}
+				data := tf.build(t)
+				checkColumns(t, data, 2)
+				checkColumns(t, data, 8192)
+			})
+		}
+	}
+}
+
+func TestDeltaEncodings(t *testing.T) {
+	n := 300
+	ints := make([]any, n)
+	longs := make([]any, n)
+	strs := make([]any, n)
+	for i := 0; i < n; i++ {
+		ints[i] = int32(i*i - 1000)
+		longs[i] = int64(i) * -1234567891
+		strs[i] = fmt.Sprintf("prefix-%05d", i*7)
+	}
+	tf := &testFile{
+		columns: []testColumn{
+			{name: "a", typ: TypeInt32, converted: -1, encoding: encodingDeltaBinaryPacked, values: ints},
+			{name: "b", typ: TypeInt64, converted: -1, encoding: encodingDeltaBinaryPacked, values: longs},
+			{name: "c", typ: TypeByteArray, converted: convertedUTF8, encoding: encodingDeltaLengthByteArray, values: strs},
+			{name: "d", typ: TypeByteArray, converted: convertedUTF8, encoding: encodingDeltaByteArray, values: strs},
+		},
+		rowGroups: []int{n},
+	}
+	data := tf.build(t)
+	_, vecs, err := readAll(t, data, []string{"a", "b", "c", "d"},
+		[]types.Type{types.T_int32.ToType(), types.T_int64.ToType(), types.T_varchar.ToType(), types.T_text.ToType()}, 100)
+	require.NoError(t, err)
+	for i := 0; i < n; i++ {
+		require.Equal(t, ints[i], vector.MustFixedCol[int32](vecs[0])[i])
+		require.Equal(t, longs[i], vector.MustFixedCol[int64](vecs[1])[i])
+		require.Equal(t, strs[i], vecs[2].GetStringAt(i))
+		require.Equal(t, strs[i], vecs[3].GetStringAt(i))
+	}
+}
+
+func TestConversions(t *testing.T) {
+	tf := &testFile{
+		columns: []testColumn{
+			{name: "i", typ: TypeInt64, converted: -1, values: []any{int64(1), int64(-2), int64(300)}},
+			{name: "s", typ: TypeByteArray, converted: convertedUTF8, values: []any{`{"a":1}`, `[1,2]`, `"x"`}},
+			{name: "local", typ: TypeInt64, converted: -1, logical: timestampMicros(false), values: []any{int64(0), int64(1), int64(2)}},
+		},
+		rowGroups: []int{3},
+	}
+	data := tf.build(t)
+
+	// numbers and times are formatted into strings
+	_, vecs, err := readAll(t, data, []string{"i", "local"}, []types.Type{types.T_varchar.ToType(), types.T_varchar.ToType()}, 10)
+	require.NoError(t, err)
+	require.Equal(t, "-2", vecs[0].GetStringAt(1))
+	require.Equal(t, "1970-01-01 00:00:00.000001", vecs[1].GetStringAt(1))
+
+	// strings are parsed into json
+	_, vecs, err = readAll(t, data, []string{"s"}, []types.Type{types.T_json.ToType()}, 10)
+	require.NoError(t, err)
+	require.Equal(t, `[1, 2]`, types.DecodeJson(vecs[0].GetBytesAt(1)).String())
+
+	// a wall clock timestamp is a datetime
+	_, vecs, err = readAll(t, data, []string{"local"}, []types.Type{types.New(types.T_datetime, 0, 6)}, 10)
+	require.NoError(t, err)
+	require.Equal(t, "1970-01-01 00:00:00.000002", vector.MustFixedCol[types.Datetime](vecs[0])[2].String2(6))
+
+	// out of range
+	_, _, err = readAll(t, data, []string{"i"}, []types.Type{types.T_int8.ToType()}, 10)
+	require.Error(t, err)
+	_, _, err = readAll(t, data, []string{"i"}, []types.Type{types.T_uint64.ToType()}, 10)
+	require.Error(t, err)
+
+	// incompatible types
+	_, _, err = readAll(t, data, []string{"s"}, []types.Type{types.T_int64.ToType()}, 10)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+}
+
+func TestStatistics(t *testing.T) {
+	tf := &testFile{
+		columns:   testColumns(),
+		rowGroups: []int{3, 4},
+	}
+	data := tf.build(t)
+	ctx := context.Background()
+	f, err := Open(ctx, bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	require.Equal(t, 2, f.NumRowGroups())
+
+	check := func(name string, typ types.Type, rg int, min, max any) {
+		a, err := NewAppender(ctx, f.Column(name), typ, time.UTC)
+		require.NoError(t, err)
+		gotMin, gotMax, ok := a.MinMax(f, rg)
+		if min == nil {
+			require.False(t, ok, name)
+			return
+		}
+		require.True(t, ok, name)
+		require.Equal(t, min, gotMin, name)
+		require.Equal(t, max, gotMax, name)
+	}
+	check("id", types.T_int64.ToType(), 0, int64(1), int64(3))
+	check("id", types.T_int64.ToType(), 1, int64(4), int64(7))
+	check("id", types.T_int32.ToType(), 1, int32(4), int32(7))
+	check("name", types.T_varchar.ToType(), 0, []byte("a"), []byte("b"))
+	check("score", types.T_float64.ToType(), 1, 4.5, 7.5)
+	check("ts", types.New(types.T_timestamp, 0, 6), 1,
+		types.Timestamp(unixEpochDatetime-1), types.Timestamp(unixEpochDatetime+86400000000))
+	// the order of numbers is not the one of their strings
+	check("id", types.T_varchar.ToType(), 0, nil, nil)
+	// the order of the converted values depends on the time zone
+	check("ts", types.New(types.T_datetime, 0, 6), 0, nil, nil)
+
+	tf.noStats = true
+	data = tf.build(t)
+	f, err = Open(ctx, bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	check("id", types.T_int64.ToType(), 0, nil, nil)
+}
+
+func TestOpenInvalid(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("a,b,c\n1,2,3\n")
+	_, err := Open(ctx, bytes.NewReader(data), int64(len(data)))
+	require.Error(t, err)
+
+	tf := &testFile{
+		columns:   testColumns(),
+		rowGroups: []int{7},
+	}
+	data = tf.build(t)
+	// a corrupted footer
+	for _, i := range []int{len(data) - 9, len(data) - 20, len(data) - 50} {
+		broken := append([]byte(nil), data...)
+		broken[i] ^= 0xff
+		_, _, _ = readAll(t, broken, nil, nil, 10)
+	}
+	// a corrupted page
+	broken := append([]byte(nil), data...)
+	for i := 4; i < 40; i++ {
+		broken[i] = 0xff
+	}
+	_, _, err = readAll(t, broken, []string{"id"}, []types.Type{types.T_int64.ToType()}, 10)
+	require.Error(t, err)
+}
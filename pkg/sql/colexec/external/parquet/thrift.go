@@ -0,0 +1,237 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// thrift compact protocol field types
+const (
+	ctStop   = 0
+	ctTrue   = 1
+	ctFalse  = 2
+	ctByte   = 3
+	ctI16    = 4
+	ctI32    = 5
+	ctI64    = 6
+	ctDouble = 7
+	ctBinary = 8
+	ctList   = 9
+	ctSet    = 10
+	ctMap    = 11
+	ctStruct = 12
+)
+
+// maxThriftDepth bounds the nesting of skipped values so that a corrupted
+// footer can not blow up the stack.
+const maxThriftDepth = 64
+
+var errCorrupted = moerr.NewInternalErrorNoCtx("corrupted parquet metadata")
+
+// thriftReader decodes the thrift compact protocol, which is what parquet
+// uses for the file footer and the page headers.
+type thriftReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *thriftReader) readByte() (byte, error) {
+	if r.pos >= len(r.buf) {
+		return 0, errCorrupted
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *thriftReader) readVarint() (uint64, error) {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		return 0, errCorrupted
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *thriftReader) readZigzag() (int64, error) {
+	v, err := r.readVarint()
+	if err != nil {
+		return 0, err
+	}
+	return int64(v>>1) ^ -int64(v&1), nil
+}
+
+func (r *thriftReader) readI32() (int32, error) {
+	v, err := r.readZigzag()
+	return int32(v), err
+}
+
+func (r *thriftReader) readI64() (int64, error) {
+	return r.readZigzag()
+}
+
+func (r *thriftReader) readDouble() (float64, error) {
+	if r.pos+8 > len(r.buf) {
+		return 0, errCorrupted
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(r.buf[r.pos:]))
+	r.pos += 8
+	return v, nil
+}
+
+// readBinary returns a slice of the underlying buffer, callers that keep it
+// must copy it.
+func (r *thriftReader) readBinary() ([]byte, error) {
+	n, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.buf)-r.pos) {
+		return nil, errCorrupted
+	}
+	b := r.buf[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b, nil
+}
+
+func (r *thriftReader) readString() (string, error) {
+	b, err := r.readBinary()
+	return string(b), err
+}
+
+func (r *thriftReader) readListHeader() (byte, int, error) {
+	b, err := r.readByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	size := int(b >> 4)
+	if size == 15 {
+		n, err := r.readVarint()
+		if err != nil {
+			return 0, 0, err
+		}
+		// every element takes at least one byte
+		if n > uint64(len(r.buf)-r.pos) {
+			return 0, 0, errCorrupted
+		}
+		size = int(n)
+	}
+	return b & 0x0f, size, nil
+}
+
+// readStruct calls fn for every field of the struct. fn must either consume
+// the value of the field or call skip, boolean fields carry their value in
+// the field type and are decoded by fieldBool.
+func (r *thriftReader) readStruct(fn func(id int16, typ byte) error) error {
+	var last int16
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		typ := b & 0x0f
+		if typ == ctStop {
+			return nil
+		}
+		id := last + int16(b>>4)
+		if b>>4 == 0 {
+			v, err := r.readZigzag()
+			if err != nil {
+				return err
+			}
+			id = int16(v)
+		}
+		last = id
+		if err = fn(id, typ); err != nil {
+			return err
+		}
+	}
+}
+
+func fieldBool(typ byte) bool {
+	return typ == ctTrue
+}
+
+func (r *thriftReader) skip(typ byte) error {
+	return r.skipDepth(typ, 0)
+}
+
+func (r *thriftReader) skipDepth(typ byte, depth int) error {
+	if depth > maxThriftDepth {
+		return errCorrupted
+	}
+	var err error
+	switch typ {
+	case ctTrue, ctFalse:
+	case ctByte:
+		_, err = r.readByte()
+	case ctI16, ctI32, ctI64:
+		_, err = r.readVarint()
+	case ctDouble:
+		_, err = r.readDouble()
+	case ctBinary:
+		_, err = r.readBinary()
+	case ctList, ctSet:
+		var et byte
+		var n int
+		if et, n, err = r.readListHeader(); err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			// booleans inside containers take a whole byte
+			if et == ctTrue || et == ctFalse {
+				et = ctByte
+			}
+			if err = r.skipDepth(et, depth+1); err != nil {
+				return err
+			}
+		}
+	case ctMap:
+		var n uint64
+		if n, err = r.readVarint(); err != nil || n == 0 {
+			return err
+		}
+		var kv byte
+		if kv, err = r.readByte(); err != nil {
+			return err
+		}
+		kt, vt := kv>>4, kv&0x0f
+		if kt == ctTrue || kt == ctFalse {
+			kt = ctByte
+		}
+		if vt == ctTrue || vt == ctFalse {
+			vt = ctByte
+		}
+		for i := uint64(0); i < n; i++ {
+			if err = r.skipDepth(kt, depth+1); err != nil {
+				return err
+			}
+			if err = r.skipDepth(vt, depth+1); err != nil {
+				return err
+			}
+		}
+	case ctStruct:
+		err = r.readStruct(func(_ int16, typ byte) error {
+			return r.skipDepth(typ, depth+1)
+		})
+	default:
+		err = errCorrupted
+	}
+	return err
+}
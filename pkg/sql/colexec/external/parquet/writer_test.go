@@ -0,0 +1,576 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/stretchr/testify/require"
+)
+
+// A minimal parquet writer, enough to produce the files read by the tests.
+
+type thriftWriter struct {
+	buf   []byte
+	last  int16
+	stack []int16
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	w.buf = binary.AppendUvarint(w.buf, v)
+}
+
+func (w *thriftWriter) zigzag(v int64) {
+	w.varint(uint64(v<<1) ^ uint64(v>>63))
+}
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	if d := id - w.last; d > 0 && d <= 15 {
+		w.buf = append(w.buf, byte(d)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.zigzag(int64(id))
+	}
+	w.last = id
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, ctI32)
+	w.zigzag(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, ctI64)
+	w.zigzag(v)
+}
+
+func (w *thriftWriter) binary(id int16, b []byte) {
+	w.field(id, ctBinary)
+	w.varint(uint64(len(b)))
+	w.buf = append(w.buf, b...)
+}
+
+func (w *thriftWriter) boolean(id int16, v bool) {
+	if v {
+		w.field(id, ctTrue)
+	} else {
+		w.field(id, ctFalse)
+	}
+}
+
+func (w *thriftWriter) list(id int16, typ byte, n int) {
+	w.field(id, ctList)
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|typ)
+	} else {
+		w.buf = append(w.buf, 0xf0|typ)
+		w.varint(uint64(n))
+	}
+}
+
+// begin starts a struct field, or a struct element of a list if id is 0.
+func (w *thriftWriter) begin(id int16) {
+	if id != 0 {
+		w.field(id, ctStruct)
+	}
+	w.stack = append(w.stack, w.last)
+	w.last = 0
+}
+
+func (w *thriftWriter) end() {
+	w.buf = append(w.buf, ctStop)
+	w.last = w.stack[len(w.stack)-1]
+	w.stack = w.stack[:len(w.stack)-1]
+}
+
+type testColumn struct {
+	name       string
+	typ        int32
+	typeLength int32
+	optional   bool
+	converted  int32 // -1 if none
+	scale      int32
+	precision  int32
+	// logical writes the content of the LogicalType union
+	logical  func(w *thriftWriter)
+	encoding int32 // encodingPlain, encodingRLEDictionary or encodingDeltaBinaryPacked & co
+	values   []any // nil is null
+}
+
+type testFile struct {
+	columns   []testColumn
+	rowGroups []int
+	pageRows  int
+	codec     int32
+	v2        bool
+	noStats   bool
+}
+
+func hybridBitPacked(vals []uint32, width int) []byte {
+	groups := (len(vals) + 7) / 8
+	out := binary.AppendUvarint(nil, uint64(groups<<1|1))
+	packed := make([]byte, groups*width)
+	for i, v := range vals {
+		for b := 0; b < width; b++ {
+			if v>>b&1 == 1 {
+				bit := i*width + b
+				packed[bit/8] |= 1 << (bit % 8)
+			}
+		}
+	}
+	return append(out, packed...)
+}
+
+func hybridRLE(vals []uint32, width int) []byte {
+	var out []byte
+	for i := 0; i < len(vals); {
+		j := i
+		for j < len(vals) && vals[j] == vals[i] {
+			j++
+		}
+		out = binary.AppendUvarint(out, uint64((j-i)<<1))
+		for k := 0; k < (width+7)/8; k++ {
+			out = append(out, byte(vals[i]>>(8*k)))
+		}
+		i = j
+	}
+	return out
+}
+
+func encodePlain(typ int32, vals []any) []byte {
+	var out []byte
+	switch typ {
+	case TypeBoolean:
+		out = make([]byte, (len(vals)+7)/8)
+		for i, v := range vals {
+			if v.(bool) {
+				out[i/8] |= 1 << (i % 8)
+			}
+		}
+	default:
+		for _, v := range vals {
+			if typ == TypeByteArray {
+				out = binary.LittleEndian.AppendUint32(out, uint32(len(toBytes(v))))
+			}
+			out = append(out, encodeStat(typ, v)...)
+		}
+	}
+	return out
+}
+
+func toBytes(v any) []byte {
+	if s, ok := v.(string); ok {
+		return []byte(s)
+	}
+	return v.([]byte)
+}
+
+// encodeStat returns the plain encoding of a value without the length of
+// the byte arrays, as stored in the statistics.
+func encodeStat(typ int32, v any) []byte {
+	switch typ {
+	case TypeBoolean:
+		if v.(bool) {
+			return []byte{1}
+		}
+		return []byte{0}
+	case TypeInt32:
+		return binary.LittleEndian.AppendUint32(nil, uint32(v.(int32)))
+	case TypeInt64:
+		return binary.LittleEndian.AppendUint64(nil, uint64(v.(int64)))
+	case TypeFloat:
+		return binary.LittleEndian.AppendUint32(nil, math.Float32bits(v.(float32)))
+	case TypeDouble:
+		return binary.LittleEndian.AppendUint64(nil, math.Float64bits(v.(float64)))
+	default:
+		return toBytes(v)
+	}
+}
+
+func less(typ int32, a, b any) bool {
+	switch typ {
+	case TypeInt32:
+		return a.(int32) < b.(int32)
+	case TypeInt64:
+		return a.(int64) < b.(int64)
+	case TypeFloat:
+		return a.(float32) < b.(float32)
+	case TypeDouble:
+		return a.(float64) < b.(float64)
+	case TypeByteArray:
+		return bytes.Compare(toBytes(a), toBytes(b)) < 0
+	}
+	return false
+}
+
+func encodeDeltaBinaryPacked(vals []int64) []byte {
+	const blockSize, miniBlocks = 128, 4
+	out := binary.AppendUvarint(nil, blockSize)
+	out = binary.AppendUvarint(out, miniBlocks)
+	out = binary.AppendUvarint(out, uint64(len(vals)))
+	zigzag := func(v int64) uint64 { return uint64(v<<1) ^ uint64(v>>63) }
+	if len(vals) == 0 {
+		return binary.AppendUvarint(out, 0)
+	}
+	out = binary.AppendUvarint(out, zigzag(vals[0]))
+	for start := 1; start < len(vals); start += blockSize {
+		stop := start + blockSize
+		if stop > len(vals) {
+			stop = len(vals)
+		}
+		deltas := make([]int64, stop-start)
+		minDelta := int64(math.MaxInt64)
+		for i := range deltas {
+			deltas[i] = vals[start+i] - vals[start+i-1]
+			if deltas[i] < minDelta {
+				minDelta = deltas[i]
+			}
+		}
+		out = binary.AppendUvarint(out, zigzag(minDelta))
+		const per = blockSize / miniBlocks
+		widths := make([]int, miniBlocks)
+		for m := 0; m < miniBlocks; m++ {
+			for i := m * per; i < (m+1)*per && i < len(deltas); i++ {
+				if w := bitWidth(uint64(deltas[i] - minDelta)); w > widths[m] {
+					widths[m] = w
+				}
+			}
+			out = append(out, byte(widths[m]))
+		}
+		for m := 0; m < miniBlocks && m*per < len(deltas); m++ {
+			packed := make([]byte, per*widths[m]/8)
+			for i := 0; i < per && m*per+i < len(deltas); i++ {
+				v := uint64(deltas[m*per+i] - minDelta)
+				for b := 0; b < widths[m]; b++ {
+					if v>>b&1 == 1 {
+						bit := i*widths[m] + b
+						packed[bit/8] |= 1 << (bit % 8)
+					}
+				}
+			}
+			out = append(out, packed...)
+		}
+	}
+	return out
+}
+
+func encodeValues(col *testColumn, vals []any) []byte {
+	switch col.encoding {
+	case encodingDeltaBinaryPacked:
+		ints := make([]int64, len(vals))
+		for i, v := range vals {
+			if col.typ == TypeInt32 {
+				ints[i] = int64(v.(int32))
+			} else {
+				ints[i] = v.(int64)
+			}
+		}
+		return encodeDeltaBinaryPacked(ints)
+	case encodingDeltaLengthByteArray:
+		lengths := make([]int64, len(vals))
+		var data []byte
+		for i, v := range vals {
+			lengths[i] = int64(len(toBytes(v)))
+			data = append(data, toBytes(v)...)
+		}
+		return append(encodeDeltaBinaryPacked(lengths), data...)
+	case encodingDeltaByteArray:
+		prefix := make([]int64, len(vals))
+		suffix := make([]int64, len(vals))
+		var data, prev []byte
+		for i, v := range vals {
+			b := toBytes(v)
+			p := 0
+			for p < len(b) && p < len(prev) && b[p] == prev[p] {
+				p++
+			}
+			prefix[i] = int64(p)
+			suffix[i] = int64(len(b) - p)
+			data = append(data, b[p:]...)
+			prev = b
+		}
+		out := encodeDeltaBinaryPacked(prefix)
+		out = append(out, encodeDeltaBinaryPacked(suffix)...)
+		return append(out, data...)
+	}
+	return encodePlain(col.typ, vals)
+}
+
+func testCompress(t *testing.T, codec int32, data []byte) []byte {
+	switch codec {
+	case codecSnappy:
+		return snappy.Encode(nil, data)
+	case codecGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		_, err := w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	case codecZstd:
+		out, err := compress.Compress(data, make([]byte, compress.CompressBound(len(data), compress.Zstd)), compress.Zstd)
+		require.NoError(t, err)
+		return out
+	case codecLZ4Raw:
+		out, err := compress.Compress(data, make([]byte, compress.CompressBound(len(data), compress.Lz4)), compress.Lz4)
+		require.NoError(t, err)
+		return out
+	}
+	return data
+}
+
+func writePageHeader(w *thriftWriter, typ int32, uncompressed, compressed int, body func(w *thriftWriter)) {
+	w.begin(0)
+	w.i32(1, typ)
+	w.i32(2, int32(uncompressed))
+	w.i32(3, int32(compressed))
+	body(w)
+	w.end()
+}
+
+// writeChunk appends the pages of a column chunk to out and returns the
+// column metadata writer.
+func (tf *testFile) writeChunk(t *testing.T, out []byte, col *testColumn, rows []any) ([]byte, func(w *thriftWriter)) {
+	start := int64(len(out))
+	var dictOffset int64 = -1
+	var dict []any
+	dictIndex := make(map[string]int)
+	useDict := col.encoding == encodingRLEDictionary
+	if useDict {
+		for _, v := range rows {
+			if v == nil {
+				continue
+			}
+			key := string(encodeStat(col.typ, v))
+			if _, ok := dictIndex[key]; !ok {
+				dictIndex[key] = len(dict)
+				dict = append(dict, v)
+			}
+		}
+		raw := encodePlain(col.typ, dict)
+		comp := testCompress(t, tf.codec, raw)
+		w := &thriftWriter{}
+		writePageHeader(w, pageDictionary, len(raw), len(comp), func(w *thriftWriter) {
+			w.begin(7)
+			w.i32(1, int32(len(dict)))
+			w.i32(2, encodingPlainDictionary)
+			w.end()
+		})
+		dictOffset = int64(len(out))
+		out = append(out, w.buf...)
+		out = append(out, comp...)
+	}
+	dataOffset := int64(len(out))
+
+	pageRows := tf.pageRows
+	if pageRows == 0 {
+		pageRows = len(rows)
+	}
+	for from := 0; from < len(rows) || from == 0; from += pageRows {
+		to := from + pageRows
+		if to > len(rows) {
+			to = len(rows)
+		}
+		page := rows[from:to]
+		var levels []byte
+		var nonNull []any
+		defs := make([]uint32, len(page))
+		for i, v := range page {
+			if v != nil {
+				defs[i] = 1
+				nonNull = append(nonNull, v)
+			}
+		}
+		if col.optional {
+			levels = hybridRLE(defs, 1)
+		}
+		var values []byte
+		encoding := col.encoding
+		if useDict {
+			idx := make([]uint32, len(nonNull))
+			for i, v := range nonNull {
+				idx[i] = uint32(dictIndex[string(encodeStat(col.typ, v))])
+			}
+			width := bitWidth(uint64(len(dict) - 1))
+			values = append([]byte{byte(width)}, hybridBitPacked(idx, width)...)
+		} else {
+			values = encodeValues(col, nonNull)
+		}
+		w := &thriftWriter{}
+		if tf.v2 {
+			comp := testCompress(t, tf.codec, values)
+			writePageHeader(w, pageDataV2, len(levels)+len(values), len(levels)+len(comp), func(w *thriftWriter) {
+				w.begin(8)
+				w.i32(1, int32(len(page)))
+				w.i32(2, int32(len(page)-len(nonNull)))
+				w.i32(3, int32(len(page)))
+				w.i32(4, encoding)
+				w.i32(5, int32(len(levels)))
+				w.i32(6, 0)
+				w.end()
+			})
+			out = append(out, w.buf...)
+			out = append(out, levels...)
+			out = append(out, comp...)
+		} else {
+			var raw []byte
+			if col.optional {
+				raw = binary.LittleEndian.AppendUint32(raw, uint32(len(levels)))
+				raw = append(raw, levels...)
+			}
+			raw = append(raw, values...)
+			comp := testCompress(t, tf.codec, raw)
+			writePageHeader(w, pageData, len(raw), len(comp), func(w *thriftWriter) {
+				w.begin(5)
+				w.i32(1, int32(len(page)))
+				w.i32(2, encoding)
+				w.i32(3, encodingRLE)
+				w.i32(4, encodingRLE)
+				w.end()
+			})
+			out = append(out, w.buf...)
+			out = append(out, comp...)
+		}
+		if to == len(rows) {
+			break
+		}
+	}
+
+	var min, max any
+	nullCount := 0
+	for _, v := range rows {
+		if v == nil {
+			nullCount++
+			continue
+		}
+		if min == nil || less(col.typ, v, min) {
+			min = v
+		}
+		if max == nil || less(col.typ, max, v) {
+			max = v
+		}
+	}
+	size := int64(len(out)) - start
+	return out, func(w *thriftWriter) {
+		w.begin(3)
+		w.i32(1, col.typ)
+		w.list(2, ctI32, 1)
+		w.zigzag(int64(col.encoding))
+		w.list(3, ctBinary, 1)
+		w.varint(uint64(len(col.name)))
+		w.buf = append(w.buf, col.name...)
+		w.i32(4, tf.codec)
+		w.i64(5, int64(len(rows)))
+		w.i64(6, size)
+		w.i64(7, size)
+		w.i64(9, dataOffset)
+		if dictOffset >= 0 {
+			w.i64(11, dictOffset)
+		}
+		if !tf.noStats {
+			w.begin(12)
+			w.i64(3, int64(nullCount))
+			if min != nil && col.typ != TypeBoolean {
+				w.binary(5, encodeStat(col.typ, max))
+				w.binary(6, encodeStat(col.typ, min))
+			}
+			w.end()
+		}
+		w.end()
+	}
+}
+
+func (tf *testFile) build(t *testing.T) []byte {
+	out := append([]byte(nil), magic...)
+	total := 0
+	var groups []func(w *thriftWriter)
+	for _, n := range tf.rowGroups {
+		var chunks []func(w *thriftWriter)
+		var offsets []int64
+		for i := range tf.columns {
+			col := &tf.columns[i]
+			offsets = append(offsets, int64(len(out)))
+			var meta func(w *thriftWriter)
+			out, meta = tf.writeChunk(t, out, col, col.values[total:total+n])
+			chunks = append(chunks, meta)
+		}
+		rows := n
+		groups = append(groups, func(w *thriftWriter) {
+			w.begin(0)
+			w.list(1, ctStruct, len(chunks))
+			for i, meta := range chunks {
+				w.begin(0)
+				w.i64(2, offsets[i])
+				meta(w)
+				w.end()
+			}
+			w.i64(2, 0)
+			w.i64(3, int64(rows))
+			w.end()
+		})
+		total += n
+	}
+
+	w := &thriftWriter{}
+	w.i32(1, 1)
+	w.list(2, ctStruct, len(tf.columns)+1)
+	w.begin(0)
+	w.binary(4, []byte("schema"))
+	w.i32(5, int32(len(tf.columns)))
+	w.end()
+	for _, col := range tf.columns {
+		w.begin(0)
+		w.i32(1, col.typ)
+		if col.typeLength > 0 {
+			w.i32(2, col.typeLength)
+		}
+		if col.optional {
+			w.i32(3, repetitionOptional)
+		} else {
+			w.i32(3, repetitionRequired)
+		}
+		w.binary(4, []byte(col.name))
+		if col.converted >= 0 {
+			w.i32(6, col.converted)
+		}
+		if col.converted == convertedDecimal {
+			w.i32(7, col.scale)
+			w.i32(8, col.precision)
+		}
+		if col.logical != nil {
+			w.begin(10)
+			col.logical(w)
+			w.end()
+		}
+		w.end()
+	}
+	w.i64(3, int64(total))
+	w.list(4, ctStruct, len(groups))
+	for _, g := range groups {
+		g(w)
+	}
+	w.binary(6, []byte("matrixone test writer"))
+	w.buf = append(w.buf, ctStop)
+
+	out = append(out, w.buf...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(len(w.buf)))
+	return append(out, magic...)
+}
@@ -21,7 +21,9 @@ import (
 	"io"
 	"sync/atomic"
 
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external/parquet"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/blockio"
@@ -64,6 +66,7 @@ type ExParam struct {
 	plh       *ParseLineHandler
 	Fileparam *ExFileparam
 	Zoneparam *ZonemapFileparam
+	Parqparam *ParquetFileparam
 	Filter    *FilterParam
 }
 
@@ -80,6 +83,20 @@ type ZonemapFileparam struct {
 	offset int
 }
 
+type ParquetFileparam struct {
+	file      *parquet.File
+	columns   []*parquet.Column // the column of the file for each attr, nil if not in the file
+	appenders []*parquet.Appender
+	readers   []*parquet.ColumnReader // the column chunks of the current row group
+	rowGroup  int
+	rowsLeft  int64
+	values    parquet.Values
+	notNull   []bool
+	// the temporary file the file of load local is written into
+	tmpFs   fileservice.FileService
+	tmpPath string
+}
+
 type FilterParam struct {
 	maxCol      int
 	exprMono    bool
@@ -96,6 +113,9 @@ type Argument struct {
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	if arg.Es != nil {
+		closeParquetFile(proc.Ctx, arg.Es)
+	}
 }
 
 type ParseLineHandler struct {
@@ -0,0 +1,151 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// FillGeneratedColumns evaluates the generated columns of the table over the
+// other columns of the batch, in the order they are defined. The columns
+// referenced by a generation expression are resolved by name, so the batch
+// only needs to contain them, not to follow the layout of the table.
+func FillGeneratedColumns(proc *process.Process, bat *batch.Batch, tableDef *plan.TableDef) error {
+	var name2pos map[string]int32
+	for _, col := range tableDef.Cols {
+		if col.Generated == nil {
+			continue
+		}
+		if name2pos == nil {
+			name2pos = make(map[string]int32, len(bat.Attrs))
+			for i, attr := range bat.Attrs {
+				name2pos[attr] = int32(i)
+			}
+		}
+		pos, ok := name2pos[col.Name]
+		if !ok {
+			continue
+		}
+		expr, err := resetColPosByName(proc, col.Generated.Expr, name2pos)
+		if err != nil {
+			return err
+		}
+		vec, err := EvalExpr(bat, proc, expr)
+		if err != nil {
+			return err
+		}
+		newVec, err := materializeVector(proc, bat, vec)
+		if err != nil {
+			return err
+		}
+		bat.Vecs[pos].Free(proc.Mp())
+		bat.Vecs[pos] = newVec
+	}
+	return nil
+}
+
+// ClearVirtualColumns replaces the values of the virtual generated columns
+// with null, they are computed when the column is read and never stored.
+func ClearVirtualColumns(proc *process.Process, bat *batch.Batch, tableDef *plan.TableDef) error {
+	for _, col := range tableDef.Cols {
+		if col.Generated == nil || col.Generated.Stored {
+			continue
+		}
+		for i, attr := range bat.Attrs {
+			if attr != col.Name {
+				continue
+			}
+			typ := *bat.Vecs[i].GetType()
+			nullVec := vector.NewConstNull(typ, 1, proc.Mp())
+			newVec := vector.NewVec(typ)
+			err := newVec.UnionMulti(nullVec, 0, bat.Length(), proc.Mp())
+			nullVec.Free(proc.Mp())
+			if err != nil {
+				newVec.Free(proc.Mp())
+				return err
+			}
+			bat.Vecs[i].Free(proc.Mp())
+			bat.Vecs[i] = newVec
+			break
+		}
+	}
+	return nil
+}
+
+// resetColPosByName returns a copy of the expression whose column references
+// point to the columns of the same name in the batch.
+func resetColPosByName(proc *process.Process, expr *plan.Expr, name2pos map[string]int32) (*plan.Expr, error) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		pos, ok := name2pos[e.Col.Name]
+		if !ok {
+			return nil, moerr.NewInternalError(proc.Ctx, "column '%s' of generated column not found", e.Col.Name)
+		}
+		return &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					ColPos: pos,
+					Name:   e.Col.Name,
+				},
+			},
+		}, nil
+	case *plan.Expr_F:
+		args := make([]*plan.Expr, len(e.F.Args))
+		for i, arg := range e.F.Args {
+			newArg, err := resetColPosByName(proc, arg, name2pos)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = newArg
+		}
+		return &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: e.F.Func,
+					Args: args,
+				},
+			},
+		}, nil
+	default:
+		return expr, nil
+	}
+}
+
+// materializeVector makes a vector owned by nobody and holding one value
+// per row of the batch from the result of EvalExpr.
+func materializeVector(proc *process.Process, bat *batch.Batch, vec *vector.Vector) (*vector.Vector, error) {
+	fromBatch := isBatchVector(bat, vec)
+	if !vec.IsConst() {
+		if !fromBatch {
+			return vec, nil
+		}
+		return vec.Dup(proc.Mp())
+	}
+	newVec := vector.NewVec(*vec.GetType())
+	if err := newVec.UnionMulti(vec, 0, bat.Length(), proc.Mp()); err != nil {
+		newVec.Free(proc.Mp())
+		return nil, err
+	}
+	if !fromBatch {
+		vec.Free(proc.Mp())
+	}
+	return newVec, nil
+}
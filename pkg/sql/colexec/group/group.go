@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
@@ -63,13 +64,18 @@ func String(arg any, buf *bytes.Buffer) {
 
 func Prepare(_ *process.Process, arg any) error {
 	ap := arg.(*Argument)
-	ap.ctr = new(container)
-	ap.ctr.mapAggType = make(map[int32]int)
-	ap.ctr.inserted = make([]uint8, hashmap.UnitLimit)
-	ap.ctr.zInserted = make([]uint8, hashmap.UnitLimit)
+	ap.ctr = newContainer()
 	return nil
 }
 
+func newContainer() *container {
+	ctr := new(container)
+	ctr.mapAggType = make(map[int32]int)
+	ctr.inserted = make([]uint8, hashmap.UnitLimit)
+	ctr.zInserted = make([]uint8, hashmap.UnitLimit)
+	return ctr
+}
+
 func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
 	var end bool
 	var err error
@@ -200,18 +206,11 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 	if bat == nil {
 		if ctr.bat != nil {
 			if ap.NeedEval {
-				for i, ag := range ctr.bat.Aggs {
-					vec, err := ag.Eval(proc.Mp())
-					if err != nil {
-						return false, err
-					}
-					ctr.bat.Aggs[i] = nil
-					ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
-					anal.Alloc(int64(vec.Size()))
+				if err = ctr.evalAggs(proc, anal); err != nil {
+					return false, err
 				}
-				ctr.bat.Aggs = nil
-				for i := range ctr.bat.Zs { // reset zs
-					ctr.bat.Zs[i] = 1
+				if err = ctr.groupSpilled(ap, proc, anal); err != nil {
+					return false, err
 				}
 			}
 			ctr.bat.ExpandNulls()
@@ -229,12 +228,56 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 	defer bat.Clean(proc.Mp())
 	anal.Input(bat, isFirst)
 	proc.SetInputBatch(&batch.Batch{})
+	if err = ctr.processBatch(ap, bat, proc, anal); err != nil {
+		return false, err
+	}
+	if ctr.files != nil || ctr.noSpill || !proc.ExceedMemoryLimit() {
+		return false, nil
+	}
+	if !ap.NeedEval {
+		// the partial results are merged later, they are sent to free the memory
+		ctr.bat.ExpandNulls()
+		anal.Output(ctr.bat, isLast)
+		proc.SetInputBatch(ctr.bat)
+		ctr.bat = nil
+		ctr.cleanHashMap()
+		return false, nil
+	}
+	if len(ap.GroupingSets) == 0 {
+		if ctr.files, err = colexec.NewSpillFiles(proc, colexec.SpillPartitions); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// evalAggs replaces the aggregations of the groups with their results
+func (ctr *container) evalAggs(proc *process.Process, anal process.Analyze) error {
+	for i, ag := range ctr.bat.Aggs {
+		vec, err := ag.Eval(proc.Mp())
+		if err != nil {
+			return err
+		}
+		ctr.bat.Aggs[i] = nil
+		ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
+		anal.Alloc(int64(vec.Size()))
+	}
+	ctr.bat.Aggs = nil
+	for i := range ctr.bat.Zs { // reset zs
+		ctr.bat.Zs[i] = 1
+	}
+	return nil
+}
+
+func (ctr *container) processBatch(ap *Argument, bat *batch.Batch, proc *process.Process, anal process.Analyze) error {
+	var err error
+
 	if len(ctr.aggVecs) == 0 {
 		ctr.aggVecs = make([]evalVector, len(ap.Aggs))
 	}
 
 	if err := ctr.evalAggVector(bat, ap.Aggs, proc, anal); err != nil {
-		return false, err
+		return err
 	}
 	defer ctr.cleanAggVectors(proc.Mp())
 
@@ -245,7 +288,7 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 		}
 	}
 	if err := ctr.evalMultiAggs(bat, ap.MultiAggs, proc, anal); err != nil {
-		return false, err
+		return err
 	}
 	defer ctr.cleanMultiAggVecs(proc.Mp())
 	if len(ctr.groupVecs) == 0 {
@@ -257,7 +300,7 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 		vec, err := colexec.EvalExpr(bat, proc, expr)
 		if err != nil {
 			ctr.cleanGroupVectors(proc.Mp())
-			return false, err
+			return err
 		}
 		ctr.groupVecs[i].vec = vec
 		ctr.groupVecs[i].needFree = true
@@ -297,7 +340,7 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 		}
 		ctr.bat.Aggs = make([]agg.Agg[any], len(ap.Aggs)+len(ap.MultiAggs))
 		if err = ctr.getBatchAggs(ap); err != nil {
-			return false, err
+			return err
 		}
 		switch {
 		//case ctr.idx != nil:
@@ -305,15 +348,21 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 		case size <= 8:
 			ctr.typ = H8
 			if ctr.intHashMap, err = hashmap.NewIntHashMap(true, ap.Ibucket, ap.Nbucket, proc.Mp()); err != nil {
-				return false, err
+				return err
 			}
 		default:
 			ctr.typ = HStr
 			if ctr.strHashMap, err = hashmap.NewStrMap(true, ap.Ibucket, ap.Nbucket, proc.Mp()); err != nil {
-				return false, err
+				return err
 			}
 		}
 	}
+	if ctr.files != nil {
+		return ctr.processSpilled(bat, proc)
+	}
+	if len(ap.GroupingSets) > 0 {
+		return ctr.processGroupingSets(ap, bat, proc)
+	}
 	switch ctr.typ {
 	case H8:
 		err = ctr.processH8(bat, proc)
@@ -321,10 +370,157 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 		err = ctr.processHStr(bat, proc)
 	default:
 	}
-	if err != nil {
-		return false, err
+	return err
+}
+
+// processSpilled groups the rows of the groups in memory, the other rows are spilled to the partitions
+// by the hash of the group keys.
+func (ctr *container) processSpilled(bat *batch.Batch, proc *process.Process) error {
+	var itr hashmap.Iterator
+	var rows uint64
+
+	if ctr.typ == H8 {
+		itr = ctr.intHashMap.NewIterator()
+		rows = ctr.intHashMap.GroupCount()
+	} else {
+		itr = ctr.strHashMap.NewIterator()
+		rows = ctr.strHashMap.GroupCount()
 	}
-	return false, err
+	if len(ctr.inBuckets) == 0 {
+		ctr.inBuckets = make([]uint8, hashmap.UnitLimit)
+		ctr.parts = make([][]int32, len(ctr.files))
+	}
+	spilled := false
+	count := bat.Length()
+	ctr.spills = ctr.spills[:0]
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		copy(ctr.inBuckets, hashmap.OneUInt8s)
+		vals, _ := itr.Find(i, n, ctr.vecs, ctr.inBuckets)
+		for k := 0; k < n; k++ {
+			spill := false
+			if ctr.inBuckets[k] == 0 {
+				vals[k] = 0
+			} else if vals[k] == 0 {
+				spill, spilled = true, true
+			}
+			ctr.spills = append(ctr.spills, spill)
+		}
+		if err := ctr.batchFill(i, n, bat, vals, rows, proc); err != nil {
+			return err
+		}
+	}
+	if !spilled {
+		return nil
+	}
+	ctr.parts = colexec.PartitionRows(ctr.vecs, count, false, ctr.parts)
+	for i, sels := range ctr.parts {
+		n := 0
+		for _, sel := range sels {
+			if ctr.spills[sel] {
+				sels[n] = sel
+				n++
+			}
+		}
+		if n == 0 {
+			continue
+		}
+		rbat, err := colexec.UnionRows(bat, sels[:n], proc.Mp())
+		if err != nil {
+			return err
+		}
+		err = ctr.files[i].Write(proc.Ctx, rbat)
+		rbat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groupSpilled groups the rows spilled partition by partition, the groups of the partitions are
+// appended to the groups evaluated.
+func (ctr *container) groupSpilled(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	defer func() {
+		colexec.DeleteSpillFiles(proc.Ctx, ctr.files)
+		ctr.files = nil
+	}()
+	for _, f := range ctr.files {
+		if f.Batches() == 0 {
+			continue
+		}
+		sub := newContainer()
+		sub.noSpill = true
+		err := sub.groupFile(ap, f, proc, anal)
+		if err == nil {
+			_, err = ctr.bat.Append(proc.Ctx, proc.Mp(), sub.bat)
+		}
+		sub.free(proc)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctr *container) groupFile(ap *Argument, f *colexec.SpillFile, proc *process.Process, anal process.Analyze) error {
+	for i := 0; i < f.Batches(); i++ {
+		bat, err := f.Read(proc.Ctx, i, proc.Mp())
+		if err != nil {
+			return err
+		}
+		err = ctr.processBatch(ap, bat, proc, anal)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return ctr.evalAggs(proc, anal)
+}
+
+// processGroupingSets groups the batch by each grouping set, the group vectors not grouped by the set
+// are replaced with NULL, and the grouping id is replaced with the one of the set.
+func (ctr *container) processGroupingSets(ap *Argument, bat *batch.Batch, proc *process.Process) error {
+	var err error
+
+	gidPos := len(ap.Exprs) - 1
+	defer func() {
+		for i := range ctr.vecs {
+			if ctr.vecs[i] != ctr.groupVecs[i].vec {
+				ctr.vecs[i].Free(proc.Mp())
+			}
+			ctr.vecs[i] = ctr.groupVecs[i].vec
+		}
+	}()
+	for _, gid := range ap.GroupingSets {
+		for i := range ctr.vecs {
+			if ctr.vecs[i] != ctr.groupVecs[i].vec {
+				ctr.vecs[i].Free(proc.Mp())
+			}
+			switch {
+			case i == gidPos:
+				ctr.vecs[i] = vector.NewConstFixed(types.T_int64.ToType(), gid, bat.Length(), proc.Mp())
+			case gid&(1<<i) != 0:
+				ctr.vecs[i] = vector.NewConstNull(*ctr.groupVecs[i].vec.GetType(), bat.Length(), proc.Mp())
+			default:
+				ctr.vecs[i] = ctr.groupVecs[i].vec
+			}
+		}
+
+		switch ctr.typ {
+		case H8:
+			err = ctr.processH8(bat, proc)
+		case HStr:
+			err = ctr.processHStr(bat, proc)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (ctr *container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
@@ -465,7 +661,7 @@ func (ctr *container) batchFill(i int, n int, bat *batch.Batch, vals []uint64, h
 	}
 	if cnt > 0 {
 		for j, vec := range ctr.bat.Vecs {
-			if err := vec.UnionBatch(ctr.groupVecs[j].vec, int64(i), cnt, ctr.inserted[:n], proc.Mp()); err != nil {
+			if err := vec.UnionBatch(ctr.vecs[j], int64(i), cnt, ctr.inserted[:n], proc.Mp()); err != nil {
 				return err
 			}
 		}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -121,6 +122,80 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupingSets(t *testing.T) {
+	// group by grouping sets ((col0), ())
+	tc := newTestCase([]bool{false}, []types.Type{types.T_int64.ToType()}, []*plan.Expr{
+		newExpression(0),
+		{
+			Typ: &plan.Type{Id: int32(types.T_int64), NotNullable: true},
+			Expr: &plan.Expr_C{
+				C: &plan.Const{Value: &plan.Const_I64Val{I64Val: 0}},
+			},
+		},
+	}, []agg.Aggregate{{Op: 0, E: newExpression(0)}})
+	tc.arg.NeedEval = true
+	tc.arg.GroupingSets = []int64{0, 1}
+
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.InputBatch = newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	_, err = Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	tc.proc.Reg.InputBatch = nil
+	end, err := Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	require.True(t, end)
+
+	bat := tc.proc.Reg.InputBatch
+	require.Equal(t, Rows+1, bat.Length())
+	// the row of () has NULL for col0 and 1 for the grouping id
+	require.True(t, bat.Vecs[0].GetNulls().Contains(Rows))
+	require.Equal(t, int64(1), vector.MustFixedCol[int64](bat.Vecs[1])[Rows])
+	bat.Clean(tc.proc.Mp())
+	tc.proc.Reg.InputBatch = nil
+	tc.arg.Free(tc.proc, false)
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
+func TestGroupSpill(t *testing.T) {
+	tc := newTestCase([]bool{false}, []types.Type{types.T_int64.ToType()}, []*plan.Expr{newExpression(0)},
+		[]agg.Aggregate{{Op: agg.AggregateSum, E: newExpression(0)}})
+	tc.arg.NeedEval = true
+	// the groups of the first batch are kept in memory, the new groups are spilled
+	tc.proc.Lim.MemoryLimit = 1
+
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.InputBatch = newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	_, err = Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	require.NotNil(t, tc.arg.ctr.files)
+	tc.proc.Reg.InputBatch = newBatch(t, tc.flgs, tc.types, tc.proc, 2*Rows)
+	_, err = Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	tc.proc.Reg.InputBatch = nil
+	end, err := Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	require.True(t, end)
+	require.Nil(t, tc.arg.ctr.files)
+
+	bat := tc.proc.Reg.InputBatch
+	require.Equal(t, 2*Rows, bat.Length())
+	keys := vector.MustFixedCol[int64](bat.Vecs[0])
+	sums := vector.MustFixedCol[int64](bat.Vecs[1])
+	for i, key := range keys {
+		if key < Rows {
+			require.Equal(t, 2*key, sums[i])
+		} else {
+			require.Equal(t, key, sums[i])
+		}
+	}
+	bat.Clean(tc.proc.Mp())
+	tc.proc.Reg.InputBatch = nil
+	tc.arg.Free(tc.proc, false)
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []groupTestCase{
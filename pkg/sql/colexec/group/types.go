@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/multi_col/group_concat"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -64,6 +65,15 @@ type container struct {
 	mapAggType map[int32]int
 
 	bat *batch.Batch
+
+	// files are the partitions of the rows spilled. Once the memory limit is exceeded, the rows of
+	// the groups not in memory are spilled and grouped partition by partition at the end.
+	files     []*colexec.SpillFile
+	parts     [][]int32
+	inBuckets []uint8
+	spills    []bool
+	// noSpill is set for the containers grouping the partitions spilled
+	noSpill bool
 }
 
 type Argument struct {
@@ -75,20 +85,29 @@ type Argument struct {
 	Types     []types.Type
 	Aggs      []agg.Aggregate         // aggregations
 	MultiAggs []group_concat.Argument // multiAggs, for now it's group_concat
+	// GroupingSets are the grouping ids of ROLLUP, CUBE and GROUPING SETS. The last group expression
+	// is the grouping id, its low 32 bits are the group expressions not grouped by the set.
+	GroupingSets []int64
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 	ctr := arg.ctr
 	if ctr != nil {
-		mp := proc.Mp()
-		ctr.cleanBatch(mp)
-		ctr.cleanHashMap()
-		ctr.cleanAggVectors(mp)
-		ctr.cleanGroupVectors(mp)
-		ctr.cleanMultiAggVecs(mp)
+		ctr.free(proc)
 	}
 }
 
+func (ctr *container) free(proc *process.Process) {
+	mp := proc.Mp()
+	ctr.cleanBatch(mp)
+	ctr.cleanHashMap()
+	ctr.cleanAggVectors(mp)
+	ctr.cleanGroupVectors(mp)
+	ctr.cleanMultiAggVecs(mp)
+	colexec.DeleteSpillFiles(proc.Ctx, ctr.files)
+	ctr.files = nil
+}
+
 func (ctr *container) ToInputType(idx int) (t []types.Type) {
 	for i := range ctr.multiVecs[idx] {
 		t = append(t, *ctr.multiVecs[idx][i].vec.GetType())
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
@@ -43,13 +44,16 @@ func Prepare(proc *process.Process, arg any) error {
 		ap.ctr.evecs = make([]evalVector, len(ap.Conditions))
 		ap.ctr.nullSels = make([]int32, 0)
 	}
-	ap.ctr.bat = batch.NewWithSize(len(ap.Typs))
-	ap.ctr.bat.Zs = proc.Mp().GetSels()
+	ap.ctr.initBatch(ap, proc)
+	return nil
+}
+
+func (ctr *container) initBatch(ap *Argument, proc *process.Process) {
+	ctr.bat = batch.NewWithSize(len(ap.Typs))
+	ctr.bat.Zs = proc.Mp().GetSels()
 	for i, typ := range ap.Typs {
-		ap.ctr.bat.Vecs[i] = vector.NewVec(typ)
+		ctr.bat.Vecs[i] = vector.NewVec(typ)
 	}
-
-	return nil
 }
 
 func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (bool, error) {
@@ -70,7 +74,14 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (bool,
 			}
 			ctr.state = End
 		default:
-			if ctr.bat != nil {
+			if ctr.files != nil {
+				// the join probes the partitions, the build side is empty
+				ctr.bat.Ht = colexec.NewSpilledJoinMap(ctr.files)
+				proc.SetInputBatch(ctr.bat)
+				ctr.cleanHashMap()
+				ctr.bat = nil
+				ctr.files = nil
+			} else if ctr.bat != nil {
 				if ap.NeedHashMap {
 					ctr.bat.Ht = hashmap.NewJoinMap(ctr.sels, ctr.nullSels, nil, ctr.mp, ctr.hasNull)
 				}
@@ -108,6 +119,28 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 			return err
 		}
 		bat.Clean(proc.Mp())
+		if ctr.files == nil && proc.ExceedMemoryLimit() {
+			if !ap.CanSpill {
+				return moerr.NewInternalError(proc.Ctx, "the build side of the join exceeds query_memory_limit %d bytes, only the inner join spills to disk", proc.Lim.MemoryLimit)
+			}
+			if ctr.files, err = colexec.NewSpillFiles(proc, colexec.SpillPartitions); err != nil {
+				return err
+			}
+			// the keys spilled are not filtered, the scans waiting for the runtime filters
+			// need not wait until the build is done
+			ap.sendPassRuntimeFilters()
+		}
+		if ctr.files != nil {
+			if err = ctr.spill(ap, proc, anal); err != nil {
+				return err
+			}
+		}
+	}
+	if ctr.files != nil {
+		return nil
+	}
+	if err = ctr.sendRuntimeFilters(ap, proc); err != nil {
+		return err
 	}
 	if ctr.bat == nil || ctr.bat.Length() == 0 || !ap.NeedHashMap {
 		return nil
@@ -189,6 +222,65 @@ func (ctr *container) indexBuild() error {
 }
 */
 
+// spill spills the rows built to the partitions by the hash of the keys, the rows having a null key
+// are dropped as they are never joined.
+func (ctr *container) spill(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	ctr.cleanEvalVectors(proc.Mp())
+	if err := ctr.evalJoinCondition(ctr.bat, ap.Conditions, proc, anal); err != nil {
+		return err
+	}
+	if len(ctr.parts) == 0 {
+		ctr.parts = make([][]int32, len(ctr.files))
+	}
+	ctr.parts = colexec.PartitionRows(ctr.vecs, ctr.bat.Length(), true, ctr.parts)
+	ctr.cleanEvalVectors(proc.Mp())
+	for i, sels := range ctr.parts {
+		if len(sels) == 0 {
+			continue
+		}
+		bat, err := colexec.UnionRows(ctr.bat, sels, proc.Mp())
+		if err != nil {
+			return err
+		}
+		err = ctr.files[i].Write(proc.Ctx, bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	ctr.cleanBatch(proc.Mp())
+	ctr.initBatch(ap, proc)
+	return nil
+}
+
+// sendRuntimeFilters builds the runtime filters from the keys of all the rows built
+func (ctr *container) sendRuntimeFilters(ap *Argument, proc *process.Process) error {
+	for _, spec := range ap.RuntimeFilterSpecs {
+		if ctr.bat == nil || ctr.bat.Length() == 0 {
+			ap.RuntimeFilterRegistry.Send(colexec.NewRuntimeFilter(spec.Tag, colexec.RuntimeFilterDrop))
+			continue
+		}
+		vec, err := colexec.EvalExpr(ctr.bat, proc, spec.Expr)
+		if err != nil {
+			return err
+		}
+		ap.RuntimeFilterRegistry.Send(colexec.BuildRuntimeFilter(spec.Tag, vec, ctr.bat.Length(), int(spec.UpperLimit)))
+		if !ctr.isBatchVector(vec) {
+			vec.Free(proc.Mp())
+		}
+	}
+	return nil
+}
+
+func (ctr *container) isBatchVector(vec *vector.Vector) bool {
+	for _, v := range ctr.bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
+
 func (ctr *container) evalJoinCondition(bat *batch.Batch, conds []*plan.Expr, proc *process.Process, analyze process.Analyze) error {
 	for i, cond := range conds {
 		vec, err := colexec.EvalExpr(bat, proc, cond)
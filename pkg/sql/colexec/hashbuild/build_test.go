@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
@@ -88,6 +89,92 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+func TestBuildRuntimeFilter(t *testing.T) {
+	tc := newTestCase([]bool{false}, []types.Type{types.T_int8.ToType()},
+		[]*plan.Expr{
+			newExpr(0, types.T_int8.ToType()),
+		})
+	registry := colexec.NewRuntimeFilterRegistry()
+	tc.arg.RuntimeFilterSpecs = []*plan.RuntimeFilterSpec{
+		{Tag: 1, Expr: newExpr(0, types.T_int8.ToType()), UpperLimit: 1024},
+		{Tag: 2, Expr: newExpr(0, types.T_int8.ToType()), UpperLimit: 2},
+	}
+	tc.arg.RuntimeFilterRegistry = registry
+	registry.Expect(tc.arg.RuntimeFilterSpecs)
+
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	ok, err := Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	tc.proc.Reg.InputBatch.Ht.(*hashmap.JoinMap).Free()
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp())
+	tc.arg.Free(tc.proc, false)
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+
+	f, err := registry.Receive(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, colexec.RuntimeFilterIn, f.Kind)
+	// too many keys
+	f, err = registry.Receive(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, colexec.RuntimeFilterMinMax, f.Kind)
+}
+
+func TestBuildSpill(t *testing.T) {
+	tc := newTestCase([]bool{false}, []types.Type{types.T_int8.ToType()},
+		[]*plan.Expr{
+			newExpr(0, types.T_int8.ToType()),
+		})
+	registry := colexec.NewRuntimeFilterRegistry()
+	tc.arg.RuntimeFilterSpecs = []*plan.RuntimeFilterSpec{
+		{Tag: 1, Expr: newExpr(0, types.T_int8.ToType()), UpperLimit: 1024},
+	}
+	tc.arg.RuntimeFilterRegistry = registry
+	registry.Expect(tc.arg.RuntimeFilterSpecs)
+	tc.arg.CanSpill = true
+	tc.proc.Lim.MemoryLimit = 1
+
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	done := make(chan error, 1)
+	go func() {
+		_, err := Call(0, tc.proc, tc.arg, false, false)
+		done <- err
+	}()
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	// the filter is sent once the rows are spilled, before the build is done
+	f, err := registry.Receive(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, colexec.RuntimeFilterPass, f.Kind)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	require.NoError(t, <-done)
+	spilled, ok := tc.proc.Reg.InputBatch.Ht.(*colexec.SpilledJoinMap)
+	require.True(t, ok)
+	spilled.Free(tc.proc.Ctx)
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp())
+	tc.arg.Free(tc.proc, false)
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
+func TestBuildExceedMemoryLimit(t *testing.T) {
+	tc := newTestCase([]bool{false}, []types.Type{types.T_int8.ToType()},
+		[]*plan.Expr{
+			newExpr(0, types.T_int8.ToType()),
+		})
+	tc.proc.Lim.MemoryLimit = 1
+
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	_, err = Call(0, tc.proc, tc.arg, false, false)
+	require.ErrorContains(t, err, "query_memory_limit")
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkBuild(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []buildTestCase{
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -49,6 +50,10 @@ type container struct {
 	mp *hashmap.StrHashMap
 
 	nullSels []int32
+
+	// files are the partitions of the rows spilled as the memory limit is exceeded
+	files []*colexec.SpillFile
+	parts [][]int32
 }
 
 type Argument struct {
@@ -63,17 +68,30 @@ type Argument struct {
 	Conditions     []*plan.Expr
 
 	IsRight bool
+
+	// RuntimeFilterSpecs are the runtime filters built from the keys and sent to the registry
+	RuntimeFilterSpecs    []*plan.RuntimeFilterSpec
+	RuntimeFilterRegistry *colexec.RuntimeFilterRegistry
+
+	// CanSpill is set if the join probes the partitions spilled, the rows are spilled to the
+	// partitions by the hash of the keys once the memory limit is exceeded. The build of a
+	// join which can't spill fails if the memory limit is exceeded.
+	CanSpill bool
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	// the scans waiting for the runtime filters read all the rows if the filters are not built
+	arg.sendPassRuntimeFilters()
 	ctr := arg.ctr
 	if ctr != nil {
 		mp := proc.Mp()
 		ctr.cleanBatch(mp)
 		ctr.cleanEvalVectors(mp)
-		if !arg.NeedHashMap {
+		if !arg.NeedHashMap || pipelineFailed {
 			ctr.cleanHashMap()
 		}
+		colexec.DeleteSpillFiles(proc.Ctx, ctr.files)
+		ctr.files = nil
 	}
 }
 
@@ -99,3 +117,11 @@ func (ctr *container) cleanHashMap() {
 		ctr.mp = nil
 	}
 }
+
+// sendPassRuntimeFilters sends the filters letting all the rows pass, the filters sent
+// already are kept
+func (arg *Argument) sendPassRuntimeFilters() {
+	for _, spec := range arg.RuntimeFilterSpecs {
+		arg.RuntimeFilterRegistry.Send(colexec.NewRuntimeFilter(spec.Tag, colexec.RuntimeFilterPass))
+	}
+}
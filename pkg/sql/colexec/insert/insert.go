@@ -84,6 +84,12 @@ func Call(idx int, proc *process.Process, arg any, _ bool, _ bool) (bool, error)
 		return false, err
 	}
 
+	// write fulltext and functional index table
+	err = colexec.WriteSecondaryIndexTable(s3Writer, proc, bat, insertCtx.TableDef, nameToPos, pkPos, insertCtx.SecondaryIndexSource)
+	if err != nil {
+		return false, err
+	}
+
 	affectedRows := uint64(bat.Vecs[0].Length())
 	if insertArg.IsRemote {
 		s3Writer.WriteEnd(proc)
@@ -34,6 +34,8 @@ type InsertCtx struct {
 	Ref          *plan.ObjectRef
 	TableDef     *plan.TableDef
 	UniqueSource []engine.Relation
+	// fulltext and functional index tables
+	SecondaryIndexSource []engine.Relation
 
 	ParentIdx    map[string]int32
 	ClusterTable *plan.ClusterTable
@@ -60,11 +60,22 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 
 			if bat == nil {
 				ctr.state = End
+				if ctr.spilled != nil {
+					ctr.cleanBatch(proc.Mp())
+					ctr.state = ProbeSpilled
+				}
 				continue
 			}
 			if bat.Length() == 0 {
 				continue
 			}
+			if ctr.spilled != nil {
+				if err := ctr.spill(bat, ap, proc, anal, isFirst); err != nil {
+					ap.Free(proc, true)
+					return false, err
+				}
+				continue
+			}
 			if ctr.bat == nil || ctr.bat.Length() == 0 {
 				bat.Clean(proc.Mp())
 				continue
@@ -75,6 +86,22 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 			}
 			return false, nil
 
+		case ProbeSpilled:
+			bat, err := ctr.nextSpilled(ap, proc, anal)
+			if err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc, anal, isFirst, isLast); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			return false, nil
+
 		default:
 			ap.Free(proc, false)
 			proc.SetInputBatch(nil)
@@ -90,12 +117,130 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 
 	if bat != nil {
 		ctr.bat = bat
+		if spilled, ok := bat.Ht.(*colexec.SpilledJoinMap); ok {
+			var err error
+
+			ctr.spilled = spilled
+			ctr.files, err = colexec.NewSpillFiles(proc, len(spilled.Partitions))
+			return err
+		}
 		ctr.mp = bat.Ht.(*hashmap.JoinMap).Dup()
 		anal.Alloc(ctr.mp.Map().Size())
 	}
 	return nil
 }
 
+// spill spills the rows probed to the partitions of the build side by the hash of the keys, the
+// rows having a null key are dropped as they are never joined.
+func (ctr *container) spill(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool) error {
+	defer bat.Clean(proc.Mp())
+	anal.Input(bat, isFirst)
+	idxFlg := false
+	ctr.cleanEvalVectors(proc.Mp())
+	if err := ctr.evalJoinCondition(bat, ap.Conditions[0], proc, &idxFlg, anal); err != nil {
+		return err
+	}
+	defer ctr.cleanEvalVectors(proc.Mp())
+	if len(ctr.parts) == 0 {
+		ctr.parts = make([][]int32, len(ctr.files))
+	}
+	ctr.parts = colexec.PartitionRows(ctr.vecs, bat.Length(), true, ctr.parts)
+	for i, sels := range ctr.parts {
+		if len(sels) == 0 || ctr.spilled.Partitions[i].Rows() == 0 {
+			continue
+		}
+		rbat, err := colexec.UnionRows(bat, sels, proc.Mp())
+		if err != nil {
+			return err
+		}
+		err = ctr.files[i].Write(proc.Ctx, rbat)
+		rbat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextSpilled returns the next batch of the probe side spilled, the hash map of the partition is
+// built before its first batch is probed. It returns nil if all the partitions are joined.
+func (ctr *container) nextSpilled(ap *Argument, proc *process.Process, anal process.Analyze) (*batch.Batch, error) {
+	for ctr.partition < len(ctr.files) {
+		if ctr.chunk < ctr.files[ctr.partition].Batches() {
+			if ctr.mp == nil {
+				if err := ctr.buildSpilled(ap, ctr.spilled.Partitions[ctr.partition], proc, anal); err != nil {
+					return nil, err
+				}
+			}
+			bat, err := ctr.files[ctr.partition].Read(proc.Ctx, ctr.chunk, proc.Mp())
+			ctr.chunk++
+			return bat, err
+		}
+		ctr.cleanBatch(proc.Mp())
+		ctr.cleanHashMap()
+		ctr.partition++
+		ctr.chunk = 0
+	}
+	return nil, nil
+}
+
+// buildSpilled builds the hash map of a partition of the build side spilled
+func (ctr *container) buildSpilled(ap *Argument, f *colexec.SpillFile, proc *process.Process, anal process.Analyze) error {
+	for i := 0; i < f.Batches(); i++ {
+		bat, err := f.Read(proc.Ctx, i, proc.Mp())
+		if err != nil {
+			return err
+		}
+		if ctr.bat == nil {
+			ctr.bat = bat
+			continue
+		}
+		_, err = ctr.bat.Append(proc.Ctx, proc.Mp(), bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	idxFlg := false
+	ctr.cleanEvalVectors(proc.Mp())
+	if err := ctr.evalJoinCondition(ctr.bat, ap.Conditions[1], proc, &idxFlg, anal); err != nil {
+		return err
+	}
+	defer ctr.cleanEvalVectors(proc.Mp())
+	mp, err := hashmap.NewStrMap(false, ap.Ibucket, ap.Nbucket, proc.Mp())
+	if err != nil {
+		return err
+	}
+	var sels [][]int32
+	itr := mp.NewIterator()
+	count := ctr.bat.Length()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := mp.GroupCount()
+		vals, zvals, err := itr.Insert(i, n, ctr.vecs)
+		if err != nil {
+			mp.Free()
+			return err
+		}
+		for k, v := range vals[:n] {
+			if zvals[k] == 0 || v == 0 {
+				continue
+			}
+			if v > rows {
+				sels = append(sels, make([]int32, 0))
+			}
+			ai := int64(v) - 1
+			sels[ai] = append(sels[ai], int32(i+k))
+		}
+	}
+	ctr.mp = hashmap.NewJoinMap(sels, nil, nil, mp, false)
+	anal.Alloc(mp.Size())
+	return nil
+}
+
 func (ctr *container) probe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool, isLast bool) error {
 	defer bat.Clean(proc.Mp())
 	anal.Input(bat, isFirst)
@@ -110,6 +110,47 @@ func TestJoin(t *testing.T) {
 }
 
 /*
+func TestJoinSpill(t *testing.T) {
+	tc := newTestCase([]bool{false}, []types.Type{types.T_int8.ToType()}, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+		[][]*plan.Expr{
+			{
+				newExpr(0, types.T_int8.ToType()),
+			},
+			{
+				newExpr(0, types.T_int8.ToType()),
+			},
+		})
+	// the build side is spilled, the probe side is spilled by the partitions of the build side
+	tc.barg.CanSpill = true
+	tc.proc.Lim.MemoryLimit = 1
+	nb0 := tc.proc.Mp().CurrNB()
+	bat := hashBuild(t, tc)
+	_, ok := bat.Ht.(*colexec.SpilledJoinMap)
+	require.True(t, ok)
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- bat
+	rows := 0
+	for {
+		ok, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		if bat := tc.proc.Reg.InputBatch; bat != nil {
+			rows += bat.Length()
+			bat.Clean(tc.proc.Mp())
+		}
+	}
+	require.Equal(t, 2*Rows, rows)
+	tc.arg.Free(tc.proc, false)
+	tc.barg.Free(tc.proc, false)
+	require.Equal(t, nb0, tc.proc.Mp().CurrNB())
+}
+
 func TestLowCardinalityJoin(t *testing.T) {
 	tc := newTestCase([]bool{false}, []types.Type{types.T_varchar.ToType()}, []colexec.ResultPos{colexec.NewResultPos(1, 0)},
 		[][]*plan.Expr{
@@ -28,6 +28,7 @@ import (
 const (
 	Build = iota
 	Probe
+	ProbeSpilled
 	End
 )
 
@@ -47,6 +48,14 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *hashmap.JoinMap
+
+	// spilled is received if the build side is spilled, the probe side is spilled to the same
+	// partitions then, and the partitions are joined one by one.
+	spilled   *colexec.SpilledJoinMap
+	files     []*colexec.SpillFile
+	parts     [][]int32
+	partition int
+	chunk     int
 }
 
 type Argument struct {
@@ -57,6 +66,9 @@ type Argument struct {
 	Typs       []types.Type
 	Cond       *plan.Expr
 	Conditions [][]*plan.Expr
+
+	// RuntimeFilterSpecs are the runtime filters built by the hash build of the join
+	RuntimeFilterSpecs []*plan.RuntimeFilterSpec
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
@@ -66,6 +78,12 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		ctr.cleanBatch(mp)
 		ctr.cleanEvalVectors(mp)
 		ctr.cleanHashMap()
+		if ctr.spilled != nil {
+			ctr.spilled.Free(proc.Ctx)
+			ctr.spilled = nil
+		}
+		colexec.DeleteSpillFiles(proc.Ctx, ctr.files)
+		ctr.files = nil
 	}
 }
 
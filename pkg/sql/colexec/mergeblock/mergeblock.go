@@ -17,6 +17,7 @@ import (
 	"bytes"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -56,15 +57,16 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 		}()
 	}
 
-	for i := range ap.Unique_tbls {
+	indexTbls := append(append([]engine.Relation{}, ap.Unique_tbls...), ap.Secondary_tbls...)
+	for i := range indexTbls {
 		if ap.container.mp[i+1].Length() > 0 {
-			if err = ap.Unique_tbls[i].Write(proc.Ctx, ap.container.mp[i+1]); err != nil {
+			if err = indexTbls[i].Write(proc.Ctx, ap.container.mp[i+1]); err != nil {
 				return false, err
 			}
 		}
 
 		for _, bat := range ap.container.mp2[i+1] {
-			if err = ap.Unique_tbls[i].Write(proc.Ctx, bat); err != nil {
+			if err = indexTbls[i].Write(proc.Ctx, bat); err != nil {
 				return false, err
 			}
 		}
@@ -35,9 +35,11 @@ type Argument struct {
 	// 1. main table
 	Tbl engine.Relation
 	// 2. unique index tables
-	Unique_tbls  []engine.Relation
-	AffectedRows uint64
-	// 3. used for ut_test, otherwise the batch will free,
+	Unique_tbls []engine.Relation
+	// 3. fulltext and functional index tables, follow the unique index tables
+	Secondary_tbls []engine.Relation
+	AffectedRows   uint64
+	// 4. used for ut_test, otherwise the batch will free,
 	// and we can't get the result to check
 	notFreeBatch bool
 	container    *Container
@@ -56,7 +58,7 @@ func (arg *Argument) GetMetaLocBat(name string) {
 	bat.Vecs[0] = vector.NewVec(types.New(types.T_text,
 		0, 0))
 	arg.container.mp[0] = bat
-	for i := range arg.Unique_tbls {
+	for i := 0; i < len(arg.Unique_tbls)+len(arg.Secondary_tbls); i++ {
 		bat := batch.New(true, []string{name})
 		bat.Cnt = 1
 		bat.Vecs[0] = vector.NewVec(types.New(types.T_text,
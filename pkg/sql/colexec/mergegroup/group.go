@@ -22,6 +22,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -56,36 +58,62 @@ func Call(idx int, proc *process.Process, arg interface{}, isFirst bool, isLast
 	for {
 		switch ctr.state {
 		case Build:
-			if end, err := ctr.build(proc, anal, isFirst); err != nil {
+			end, flush, err := ctr.build(ap, proc, anal, isFirst)
+			if err != nil {
 				return false, err
 			} else if end {
 				return true, nil
 			}
+			if flush {
+				// the partial results are merged later, they are sent to free the memory
+				anal.Output(ctr.bat, isLast)
+				ctr.bat.ExpandNulls()
+				proc.SetInputBatch(ctr.bat)
+				ctr.bat = nil
+				ctr.cleanHashMap()
+				return false, nil
+			}
 			ctr.state = Eval
 		case Eval:
 			if ctr.bat != nil {
 				if ap.NeedEval {
-					for i, agg := range ctr.bat.Aggs {
-						vec, err := agg.Eval(proc.Mp())
-						if err != nil {
-							ctr.state = End
-							return false, err
-						}
-						ctr.bat.Aggs[i] = nil
-						ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
-						if vec != nil {
-							anal.Alloc(int64(vec.Size()))
-						}
-					}
-					ctr.bat.Aggs = nil
-					for i := range ctr.bat.Zs { // reset zs
-						ctr.bat.Zs[i] = 1
+					if err := ctr.evalAggs(proc, anal); err != nil {
+						ctr.state = End
+						return false, err
 					}
 				}
 				anal.Output(ctr.bat, isLast)
 				ctr.bat.ExpandNulls()
 			}
-			ctr.state = End
+			if ctr.files == nil {
+				ctr.state = End
+				continue
+			}
+			ctr.state = EvalSpilled
+			if ctr.bat != nil {
+				proc.SetInputBatch(ctr.bat)
+				ctr.bat = nil
+				return false, nil
+			}
+		case EvalSpilled:
+			if ctr.partition == len(ctr.files) {
+				ctr.state = End
+				continue
+			}
+			f := ctr.files[ctr.partition]
+			ctr.partition++
+			if f.Batches() == 0 {
+				continue
+			}
+			bat, err := ctr.mergeFile(f, proc, anal)
+			if err != nil {
+				ctr.state = End
+				return false, err
+			}
+			anal.Output(bat, isLast)
+			bat.ExpandNulls()
+			proc.SetInputBatch(bat)
+			return false, nil
 		case End:
 			proc.SetInputBatch(ctr.bat)
 			ctr.bat = nil
@@ -95,18 +123,20 @@ func Call(idx int, proc *process.Process, arg interface{}, isFirst bool, isLast
 	}
 }
 
-func (ctr *container) build(proc *process.Process, anal process.Analyze, isFirst bool) (bool, error) {
+// build merges the batches received, it returns flush if the partial results should be sent as
+// the memory limit is exceeded.
+func (ctr *container) build(ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool) (bool, bool, error) {
 	var err error
 	for {
 		if ctr.aliveMergeReceiver == 0 {
-			return false, nil
+			return false, false, nil
 		}
 
 		start := time.Now()
 		chosen, value, ok := reflect.Select(ctr.receiverListener)
 		if !ok {
 			logutil.Errorf("pipeline closed unexpectedly")
-			return true, nil
+			return true, false, nil
 		}
 		anal.WaitStop(start)
 
@@ -125,9 +155,78 @@ func (ctr *container) build(proc *process.Process, anal process.Analyze, isFirst
 		anal.Input(bat, isFirst)
 		if err = ctr.process(bat, proc); err != nil {
 			bat.Clean(proc.Mp())
-			return false, err
+			return false, false, err
+		}
+		if ctr.files != nil || !proc.ExceedMemoryLimit() {
+			continue
+		}
+		if !ap.NeedEval {
+			return false, true, nil
+		}
+		if ctr.canSpill() {
+			if ctr.files, err = colexec.NewSpillFiles(proc, colexec.SpillPartitions); err != nil {
+				return false, false, err
+			}
+		}
+	}
+}
+
+// canSpill returns true if the groups can be split into the partitions
+func (ctr *container) canSpill() bool {
+	if ctr.typ == H0 || ctr.bat == nil {
+		return false
+	}
+	for _, ag := range ctr.bat.Aggs {
+		if ag.GetOperatorId() == agg.AggregateGroupConcat {
+			return false
+		}
+	}
+	return true
+}
+
+// evalAggs replaces the aggregations of the groups with their results
+func (ctr *container) evalAggs(proc *process.Process, anal process.Analyze) error {
+	for i, ag := range ctr.bat.Aggs {
+		vec, err := ag.Eval(proc.Mp())
+		if err != nil {
+			return err
+		}
+		ctr.bat.Aggs[i] = nil
+		ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
+		if vec != nil {
+			anal.Alloc(int64(vec.Size()))
+		}
+	}
+	ctr.bat.Aggs = nil
+	for i := range ctr.bat.Zs { // reset zs
+		ctr.bat.Zs[i] = 1
+	}
+	return nil
+}
+
+// mergeFile merges the groups of a partition spilled and evaluates the aggregations
+func (ctr *container) mergeFile(f *colexec.SpillFile, proc *process.Process, anal process.Analyze) (*batch.Batch, error) {
+	sub := &container{
+		inserted:  make([]uint8, hashmap.UnitLimit),
+		zInserted: make([]uint8, hashmap.UnitLimit),
+	}
+	defer sub.free(proc)
+	for i := 0; i < f.Batches(); i++ {
+		bat, err := f.Read(proc.Ctx, i, proc.Mp())
+		if err != nil {
+			return nil, err
+		}
+		if err = sub.process(bat, proc); err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
 		}
 	}
+	if err := sub.evalAggs(proc, anal); err != nil {
+		return nil, err
+	}
+	bat := sub.bat
+	sub.bat = nil
+	return bat, nil
 }
 
 func (ctr *container) process(bat *batch.Batch, proc *process.Process) error {
@@ -166,6 +265,9 @@ func (ctr *container) process(bat *batch.Batch, proc *process.Process) error {
 			}
 		}
 	}
+	if ctr.files != nil {
+		return ctr.processSpilled(bat, proc)
+	}
 	switch ctr.typ {
 	case H0:
 		err = ctr.processH0(bat, proc)
@@ -180,6 +282,96 @@ func (ctr *container) process(bat *batch.Batch, proc *process.Process) error {
 	return nil
 }
 
+// processSpilled merges the groups in memory, the other groups are spilled to the partitions by the
+// hash of the group keys.
+func (ctr *container) processSpilled(bat *batch.Batch, proc *process.Process) error {
+	var itr hashmap.Iterator
+
+	defer bat.Clean(proc.Mp())
+	if ctr.typ == H8 {
+		itr = ctr.intHashMap.NewIterator()
+	} else {
+		itr = ctr.strHashMap.NewIterator()
+	}
+	if len(ctr.parts) == 0 {
+		ctr.parts = make([][]int32, len(ctr.files))
+	}
+	spilled := false
+	count := bat.Length()
+	ctr.spills = ctr.spills[:0]
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		copy(ctr.inserted[:n], hashmap.OneUInt8s)
+		vals, _ := itr.Find(i, n, bat.Vecs, ctr.inserted)
+		for k, v := range vals[:n] {
+			ctr.spills = append(ctr.spills, v == 0)
+			if v == 0 {
+				spilled = true
+				continue
+			}
+			ctr.bat.Zs[v-1] += bat.Zs[i+k]
+		}
+		copy(ctr.inserted[:n], ctr.zInserted[:n])
+		for j, ag := range ctr.bat.Aggs {
+			if err := ag.BatchMerge(bat.Aggs[j], int64(i), ctr.inserted[:n], vals); err != nil {
+				return err
+			}
+		}
+	}
+	if !spilled {
+		return nil
+	}
+	ctr.parts = colexec.PartitionRows(bat.Vecs, count, false, ctr.parts)
+	for i, sels := range ctr.parts {
+		n := 0
+		for _, sel := range sels {
+			if ctr.spills[sel] {
+				sels[n] = sel
+				n++
+			}
+		}
+		if n == 0 {
+			continue
+		}
+		rbat, err := colexec.UnionRows(bat, sels[:n], proc.Mp())
+		if err != nil {
+			return err
+		}
+		if err = unionAggs(rbat, bat.Aggs, sels[:n], proc); err == nil {
+			err = ctr.files[i].Write(proc.Ctx, rbat)
+		}
+		rbat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unionAggs sets the aggregations of the batch to the new aggregations of the groups
+func unionAggs(bat *batch.Batch, aggs []agg.Agg[any], sels []int32, proc *process.Process) error {
+	bat.Aggs = make([]agg.Agg[any], 0, len(aggs))
+	for _, ag := range aggs {
+		rag, err := agg.New(ag.GetOperatorId(), ag.IsDistinct(), ag.GetInputTypes()[0])
+		if err != nil {
+			return err
+		}
+		bat.Aggs = append(bat.Aggs, rag)
+		if err = rag.Grows(len(sels), proc.Mp()); err != nil {
+			return err
+		}
+		for k, sel := range sels {
+			if err = rag.Merge(ag, int64(k), int64(sel)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (ctr *container) processH0(bat *batch.Batch, proc *process.Process) error {
 	if ctr.bat == nil {
 		ctr.bat = bat
@@ -20,12 +20,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 const (
 	Build = iota
 	Eval
+	EvalSpilled
 	End
 )
 
@@ -50,6 +52,13 @@ type container struct {
 	aliveMergeReceiver int
 	// receiverListener is a structure to listen all the merge receiver.
 	receiverListener []reflect.SelectCase
+
+	// files are the partitions of the groups spilled. Once the memory limit is exceeded, the groups
+	// not in memory are spilled and merged partition by partition at the end.
+	files     []*colexec.SpillFile
+	partition int
+	parts     [][]int32
+	spills    []bool
 }
 
 type Argument struct {
@@ -60,12 +69,17 @@ type Argument struct {
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 	ctr := arg.ctr
 	if ctr != nil {
-		mp := proc.Mp()
-		ctr.cleanBatch(mp)
-		ctr.cleanHashMap()
+		ctr.free(proc)
 	}
 }
 
+func (ctr *container) free(proc *process.Process) {
+	ctr.cleanBatch(proc.Mp())
+	ctr.cleanHashMap()
+	colexec.DeleteSpillFiles(proc.Ctx, ctr.files)
+	ctr.files = nil
+}
+
 func (ctr *container) cleanBatch(mp *mpool.MPool) {
 	if ctr.bat != nil {
 		ctr.bat.Clean(mp)
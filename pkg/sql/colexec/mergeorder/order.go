@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
@@ -68,6 +69,10 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 	anal.Start()
 	defer anal.Stop()
 
+	if ctr.merging {
+		return ctr.mergeRuns(ap, proc, anal, isLast)
+	}
+
 	// get batch from merge receivers and do merge sort.
 	// save the unordered result in ctr.bat.
 	// save the ordered index list in ctr.finalSelectList
@@ -91,11 +96,23 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 		if err = mergeSort(proc, bat, ap, ctr, anal); err != nil {
 			break
 		}
+		if proc.ExceedMemoryLimit() {
+			if err = ctr.spillRun(proc); err != nil {
+				break
+			}
+		}
+	}
+	if err == nil && len(ctr.runs) > 0 && ctr.bat != nil {
+		err = ctr.spillRun(proc)
 	}
 	if err != nil {
 		ap.Free(proc, true)
 		return false, err
 	}
+	if len(ctr.runs) > 0 {
+		ctr.merging = true
+		return ctr.mergeRuns(ap, proc, anal, isLast)
+	}
 
 	// remove and clean unnecessary vector
 	// shuffle the ctr.bat
@@ -263,6 +280,126 @@ func (ctr *container) mergeSort2(bat2 *batch.Batch, proc *process.Process) error
 	return nil
 }
 
+// spillRun spills the rows sorted as a run and frees them
+func (ctr *container) spillRun(proc *process.Process) error {
+	if err := ctr.bat.Shuffle(ctr.finalSelectList, proc.Mp()); err != nil {
+		return err
+	}
+	run, err := colexec.NewSpillFile(proc)
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, run)
+	count := ctr.bat.Length()
+	sels := make([]int32, 0, colexec.SpillBatchRows)
+	for i := 0; i < count; i += colexec.SpillBatchRows {
+		sels = sels[:0]
+		for j := i; j < count && j < i+colexec.SpillBatchRows; j++ {
+			sels = append(sels, int32(j))
+		}
+		bat, err := colexec.UnionRows(ctr.bat, sels, proc.Mp())
+		if err != nil {
+			return err
+		}
+		err = run.Write(proc.Ctx, bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	ctr.cleanBatch(proc.Mp())
+	ctr.finalSelectList = nil
+	return nil
+}
+
+// mergeRuns merges the runs spilled, a batch of the rows merged is sent for each call
+func (ctr *container) mergeRuns(ap *Argument, proc *process.Process, anal process.Analyze, isLast bool) (bool, error) {
+	if ctr.cursors == nil {
+		ctr.cursors = make([]*runCursor, 0, len(ctr.runs))
+		for _, run := range ctr.runs {
+			c := &runCursor{run: run}
+			ctr.cursors = append(ctr.cursors, c)
+			if err := c.next(proc); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+		}
+	}
+	var rbat *batch.Batch
+	for rows := 0; rows < colexec.SpillBatchRows; rows++ {
+		var least *runCursor
+		for _, c := range ctr.cursors {
+			if c.bat != nil && (least == nil || ctr.compareRow(c, least) < 0) {
+				least = c
+			}
+		}
+		if least == nil {
+			break
+		}
+		if rbat == nil {
+			rbat = batch.NewWithSize(ctr.n)
+			rbat.Zs = proc.Mp().GetSels()
+			for i := range rbat.Vecs {
+				rbat.Vecs[i] = vector.NewVec(*least.bat.Vecs[i].GetType())
+			}
+		}
+		for i := range rbat.Vecs {
+			if err := rbat.Vecs[i].UnionOne(least.bat.Vecs[i], int64(least.row), proc.Mp()); err != nil {
+				rbat.Clean(proc.Mp())
+				ap.Free(proc, true)
+				return false, err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, least.bat.Zs[least.row])
+		if least.row++; least.row == least.bat.Length() {
+			if err := least.next(proc); err != nil {
+				rbat.Clean(proc.Mp())
+				ap.Free(proc, true)
+				return false, err
+			}
+		}
+	}
+	if rbat == nil {
+		proc.SetInputBatch(nil)
+		ap.Free(proc, false)
+		return true, nil
+	}
+	rbat.ExpandNulls()
+	anal.Output(rbat, isLast)
+	proc.SetInputBatch(rbat)
+	return false, nil
+}
+
+// compareRow compares the current rows of the cursors by the order columns
+func (ctr *container) compareRow(c0, c1 *runCursor) int {
+	for i, cmp := range ctr.cmps {
+		cmp.Set(0, c0.bat.Vecs[ctr.poses[i]])
+		cmp.Set(1, c1.bat.Vecs[ctr.poses[i]])
+		if r := cmp.Compare(0, 1, int64(c0.row), int64(c1.row)); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// next reads the next batch of the run, the batch is nil if all the rows are read
+func (c *runCursor) next(proc *process.Process) error {
+	if c.bat != nil {
+		c.bat.Clean(proc.Mp())
+		c.bat = nil
+	}
+	if c.idx == c.run.Batches() {
+		return nil
+	}
+	bat, err := c.run.Read(proc.Ctx, c.idx, proc.Mp())
+	if err != nil {
+		return err
+	}
+	c.bat, c.row = bat, 0
+	c.idx++
+	return nil
+}
+
 func generateSelectList(j int64) []int64 {
 	list := make([]int64, j)
 	var i int64
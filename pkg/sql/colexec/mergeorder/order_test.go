@@ -149,6 +149,37 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	tc := newTestCase([]types.Type{types.T_int64.ToType()}, []*plan.OrderBySpec{{Expr: newExpression(0), Flag: 0}})
+	// each batch received is spilled as a sorted run
+	tc.proc.Lim.MemoryLimit = 1
+
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newIntBatch(tc.types, tc.proc, Rows, tc.arg.Fs)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newIntBatch(tc.types, tc.proc, Rows, tc.arg.Fs)
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+
+	var result []int64
+	for {
+		end, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		if end {
+			break
+		}
+		require.Equal(t, 2, len(tc.arg.ctr.runs))
+		bat := tc.proc.Reg.InputBatch
+		result = append(result, vector.MustFixedCol[int64](bat.Vecs[0])...)
+		bat.Clean(tc.proc.Mp())
+	}
+	require.Equal(t, 2*Rows, len(result))
+	for j := 1; j < len(result); j++ {
+		require.True(t, result[j] >= result[j-1])
+	}
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs := []orderTestCase{
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -40,6 +41,20 @@ type container struct {
 	unionFlag                    []uint8
 	compare0Index, compare1Index []int32
 	finalSelectList              []int64
+
+	// runs are the rows sorted and spilled as the memory limit is exceeded, they are merged
+	// and sent batch by batch at the end.
+	runs    []*colexec.SpillFile
+	cursors []*runCursor
+	merging bool
+}
+
+// runCursor is the position of the next row of a run to merge
+type runCursor struct {
+	run *colexec.SpillFile
+	idx int
+	row int
+	bat *batch.Batch
 }
 
 type Argument struct {
@@ -52,6 +67,14 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 	if ctr != nil {
 		mp := proc.Mp()
 		ctr.cleanBatch(mp)
+		for _, c := range ctr.cursors {
+			if c.bat != nil {
+				c.bat.Clean(mp)
+				c.bat = nil
+			}
+		}
+		colexec.DeleteSpillFiles(proc.Ctx, ctr.runs)
+		ctr.runs = nil
 	}
 }
 
@@ -61,12 +61,27 @@ func Call(idx int, proc *proc, x any, _, _ bool) (bool, error) {
 		}
 	}
 
+	err = colexec.FillGeneratedColumns(proc, insertBatch, arg.TableDef)
+	if err != nil {
+		return false, err
+	}
+
 	// check new rows not null
 	err = colexec.BatchDataNotNullCheck(insertBatch, arg.TableDef, proc.Ctx)
 	if err != nil {
 		return false, err
 	}
 
+	err = colexec.BatchDataCheckConstraint(proc, insertBatch, arg.TableDef)
+	if err != nil {
+		return false, err
+	}
+
+	err = colexec.ClearVirtualColumns(proc, insertBatch, arg.TableDef)
+	if err != nil {
+		return false, err
+	}
+
 	err = genCompositePrimaryKey(insertBatch, proc, arg.TableDef)
 	if err != nil {
 		return false, err
@@ -21,11 +21,13 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/stretchr/testify/require"
@@ -144,3 +146,109 @@ func TestPreInsertNullCheck(t *testing.T) {
 	_, err2 := Call(0, proc, &argument2, false, false)
 	require.Error(t, err2, "should return error when insert null into primary key column")
 }
+
+func TestPreInsertGeneratedColumn(t *testing.T) {
+	proc := testutil.NewProc()
+	proc.Ctx = context.TODO()
+	colA := &plan.Expr{
+		Typ: i64typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{ColPos: 5, Name: "a"},
+		},
+	}
+	batch1 := &batch.Batch{
+		Vecs: []*vector.Vector{
+			testutil.MakeInt64Vector([]int64{1, 2, 3}, nil),
+			testutil.MakeScalarNull(types.T_int64, 3),
+			testutil.MakeScalarNull(types.T_int64, 3),
+		},
+		Zs: []int64{1, 1, 1},
+	}
+	argument1 := Argument{
+		SchemaName: "testDb",
+		TableDef: &plan.TableDef{
+			Cols: []*plan.ColDef{
+				{Name: "a", Typ: i64typ},
+				{Name: "b", Typ: i64typ, Generated: &plan.GeneratedCol{
+					Stored: true,
+					Expr: &plan.Expr{
+						Typ: i64typ,
+						Expr: &plan.Expr_F{
+							F: &plan.Function{
+								Func: &plan.ObjectRef{Obj: function.EncodeOverloadID(function.PLUS, 7), ObjName: "+"},
+								Args: []*plan.Expr{colA, colA},
+							},
+						},
+					},
+				}},
+				{Name: "c", Typ: i64typ, Generated: &plan.GeneratedCol{Expr: colA},
+					Default: &plan.Default{
+						NullAbility: false,
+					},
+				},
+			},
+		},
+	}
+	proc.SetInputBatch(batch1)
+	_, err := Call(0, proc, &argument1, false, false)
+	require.NoError(t, err)
+	result := proc.InputBatch()
+	require.Equal(t, []int64{2, 4, 6}, vector.MustFixedCol[int64](result.Vecs[1]))
+	require.Equal(t, 3, result.Vecs[2].Length())
+	require.True(t, result.Vecs[2].GetNulls().Contains(0))
+	require.True(t, result.Vecs[2].GetNulls().Contains(2))
+}
+
+func TestPreInsertCheckConstraint(t *testing.T) {
+	proc := testutil.NewProc()
+	proc.Ctx = context.TODO()
+	fid, _, _, err := function.GetFunctionByName(proc.Ctx, ">", []types.Type{types.T_int64.ToType(), types.T_int64.ToType()})
+	require.NoError(t, err)
+	check := &plan.CheckDef{
+		Name: "t_chk_1",
+		Check: &plan.Expr{
+			Typ: &plan.Type{Id: int32(types.T_bool)},
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: &plan.ObjectRef{Obj: fid, ObjName: ">"},
+					Args: []*plan.Expr{
+						{Typ: i64typ, Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 3, Name: "a"}}},
+						{Typ: i64typ, Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I64Val{I64Val: 0}}}},
+					},
+				},
+			},
+		},
+		Enforced: true,
+	}
+	tableDef := &plan.TableDef{
+		Cols:   []*plan.ColDef{{Name: "a", Typ: i64typ}},
+		Checks: []*plan.CheckDef{check},
+	}
+
+	// null satisfies the check constraint
+	proc.SetInputBatch(&batch.Batch{
+		Attrs: []string{"a"},
+		Vecs:  []*vector.Vector{testutil.MakeInt64Vector([]int64{1, 0, 3}, []uint64{1})},
+		Zs:    []int64{1, 1, 1},
+	})
+	_, err = Call(0, proc, &Argument{SchemaName: "testDb", TableDef: tableDef}, false, false)
+	require.NoError(t, err)
+
+	proc.SetInputBatch(&batch.Batch{
+		Attrs: []string{"a"},
+		Vecs:  []*vector.Vector{testutil.MakeInt64Vector([]int64{1, -2, 3}, nil)},
+		Zs:    []int64{1, 1, 1},
+	})
+	_, err = Call(0, proc, &Argument{SchemaName: "testDb", TableDef: tableDef}, false, false)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrConstraintViolation))
+
+	// the constraint which is not enforced is not checked
+	check.Enforced = false
+	proc.SetInputBatch(&batch.Batch{
+		Attrs: []string{"a"},
+		Vecs:  []*vector.Vector{testutil.MakeInt64Vector([]int64{1, -2, 3}, nil)},
+		Zs:    []int64{1, 1, 1},
+	})
+	_, err = Call(0, proc, &Argument{SchemaName: "testDb", TableDef: tableDef}, false, false)
+	require.NoError(t, err)
+}
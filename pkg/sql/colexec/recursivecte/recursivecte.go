@@ -0,0 +1,189 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	if ap.Distinct {
+		buf.WriteString(fmt.Sprintf("recursive cte(union, max depth %d)", ap.MaxDepth))
+	} else {
+		buf.WriteString(fmt.Sprintf("recursive cte(union all, max depth %d)", ap.MaxDepth))
+	}
+}
+
+func Prepare(_ *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	if ap.Distinct {
+		ap.ctr.keys = make(map[string]struct{})
+	}
+	return nil
+}
+
+// Call receives the rows of the non-recursive part first, then runs the recursive part
+// with the rows produced by the last iteration until no new row is produced.
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	for {
+		switch ctr.state {
+		case Build:
+			bat := proc.InputBatch()
+			if bat == nil {
+				ctr.state = Eval
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				return false, nil
+			}
+			anal.Input(bat, isFirst)
+			err := ctr.appendRows(ap, bat, proc)
+			bat.Clean(proc.Mp())
+			proc.SetInputBatch(&batch.Batch{})
+			if err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			return false, nil
+
+		case Eval:
+			if err := ctr.iterate(ap, proc); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			if ctr.bat == nil {
+				proc.SetInputBatch(nil)
+				return true, nil
+			}
+			anal.Output(ctr.bat, isLast)
+			proc.SetInputBatch(ctr.bat)
+			ctr.bat = nil
+			ap.Free(proc, false)
+			return true, nil
+		}
+	}
+}
+
+// iterate runs the recursive part until the working table is empty.
+func (ctr *container) iterate(ap *Argument, proc *process.Process) error {
+	mp := proc.Mp()
+	for depth := int64(1); ctr.working != nil && ctr.working.Length() > 0; depth++ {
+		if depth > ap.MaxDepth {
+			return moerr.NewCTEMaxRecursionDepth(proc.Ctx, depth)
+		}
+		working := ctr.working
+		ctr.working = nil
+		bat, err := ap.Iterate(working, proc)
+		working.Clean(mp)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		err = ctr.appendRows(ap, bat, proc)
+		bat.Clean(mp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendRows appends the new rows of bat to both the result and the working table.
+// For UNION, rows which are already in the result are not new.
+func (ctr *container) appendRows(ap *Argument, bat *batch.Batch, proc *process.Process) error {
+	var key []byte
+	sels := make([]int32, 0, len(bat.Zs))
+	for i := range bat.Zs {
+		if ap.Distinct {
+			key = encodeRow(bat.Vecs, i, key)
+			if _, ok := ctr.keys[string(key)]; ok {
+				continue
+			}
+			ctr.keys[string(key)] = struct{}{}
+		}
+		sels = append(sels, int32(i))
+	}
+	if len(sels) == 0 {
+		return nil
+	}
+
+	if ctr.bat == nil {
+		ctr.bat = newBatchLike(bat)
+	}
+	if ctr.working == nil {
+		ctr.working = newBatchLike(bat)
+	}
+	for _, b := range []*batch.Batch{ctr.bat, ctr.working} {
+		for i, vec := range b.Vecs {
+			if err := vec.Union(bat.Vecs[i], sels, proc.Mp()); err != nil {
+				return err
+			}
+		}
+		for _, sel := range sels {
+			b.Zs = append(b.Zs, bat.Zs[sel])
+		}
+	}
+	return nil
+}
+
+func newBatchLike(bat *batch.Batch) *batch.Batch {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.NewVec(*vec.GetType())
+	}
+	return rbat
+}
+
+// encodeRow encodes a row into buf, two rows are equal if and only if their encodings are equal.
+func encodeRow(vecs []*vector.Vector, row int, buf []byte) []byte {
+	buf = buf[:0]
+	for _, vec := range vecs {
+		i := row
+		if vec.IsConst() {
+			i = 0
+		}
+		if vec.IsConstNull() || vec.GetNulls().Contains(uint64(i)) {
+			buf = append(buf, 0)
+			continue
+		}
+		buf = append(buf, 1)
+		if vec.GetType().IsVarlen() {
+			bs := vec.GetBytesAt(i)
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(bs)))
+			buf = append(buf, bs...)
+		} else {
+			size := vec.GetType().TypeSize()
+			buf = append(buf, vec.UnsafeGetRawData()[i*size:(i+1)*size]...)
+		}
+	}
+	return buf
+}
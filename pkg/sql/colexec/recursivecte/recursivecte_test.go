@@ -0,0 +1,142 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type recursiveCteTestCase struct {
+	arg  *Argument
+	proc *process.Process
+	// want is the expected result of the CTE
+	want []int64
+	// err is the expected error code, 0 means no error
+	err uint16
+}
+
+var (
+	tcs []recursiveCteTestCase
+)
+
+func init() {
+	tcs = []recursiveCteTestCase{
+		// with recursive c(n) as (select 1 union all select n + 1 from c where n < 5)
+		newTestCase(mpool.MustNewZero(), false, 1000, func(n int64) []int64 {
+			if n < 5 {
+				return []int64{n + 1}
+			}
+			return nil
+		}, []int64{1, 2, 3, 4, 5}, 0),
+		// with recursive c(n) as (select 1 union all select n % 3 + 1 from c), stopped by the max depth
+		newTestCase(mpool.MustNewZero(), false, 10, func(n int64) []int64 {
+			return []int64{n%3 + 1}
+		}, nil, moerr.ErrCTEMaxRecursionDepth),
+		// with recursive c(n) as (select 1 union select n % 3 + 1 from c)
+		newTestCase(mpool.MustNewZero(), true, 10, func(n int64) []int64 {
+			return []int64{n%3 + 1}
+		}, []int64{1, 2, 3}, 0),
+		// with recursive c(n) as (select 1 union select n + 1 from c where n < 3 union select n from c)
+		newTestCase(mpool.MustNewZero(), true, 10, func(n int64) []int64 {
+			if n < 3 {
+				return []int64{n + 1, n}
+			}
+			return []int64{n}
+		}, []int64{1, 2, 3}, 0),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+	}
+}
+
+func TestRecursiveCte(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = newBatch(tc.proc, []int64{1})
+		_, err = Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = &batch.Batch{}
+		_, err = Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = nil
+		end, err := Call(0, tc.proc, tc.arg, false, false)
+		if tc.err != 0 {
+			require.True(t, moerr.IsMoErrCode(err, tc.err))
+			require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+			continue
+		}
+		require.NoError(t, err)
+		require.True(t, end)
+
+		bat := tc.proc.Reg.InputBatch
+		require.Equal(t, len(tc.want), bat.Length())
+		require.Equal(t, tc.want, vector.MustFixedCol[int64](bat.Vecs[0]))
+		bat.Clean(tc.proc.Mp())
+		tc.proc.Reg.InputBatch = nil
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
+// newTestCase creates a test case whose recursive part produces the rows returned by fn for each row of the working table.
+func newTestCase(m *mpool.MPool, distinct bool, maxDepth int64, fn func(int64) []int64, want []int64, err uint16) recursiveCteTestCase {
+	return recursiveCteTestCase{
+		proc: testutil.NewProcessWithMPool(m),
+		arg: &Argument{
+			MaxDepth: maxDepth,
+			Distinct: distinct,
+			Iterate: func(working *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+				var vs []int64
+				for _, n := range vector.MustFixedCol[int64](working.Vecs[0]) {
+					vs = append(vs, fn(n)...)
+				}
+				if len(vs) == 0 {
+					return nil, nil
+				}
+				return newBatch(proc, vs), nil
+			},
+		},
+		want: want,
+		err:  err,
+	}
+}
+
+func newBatch(proc *process.Process, vs []int64) *batch.Batch {
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(len(vs), types.T_int64.ToType(), proc.Mp(), false, vs),
+	}, nil)
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	Build = iota
+	Eval
+)
+
+type container struct {
+	state int
+
+	// bat stores all the rows of the CTE.
+	bat *batch.Batch
+	// working stores the rows produced by the last iteration, they are the input of the next iteration.
+	working *batch.Batch
+	// keys stores the encoded rows of bat, it is only used by UNION to remove the duplicate rows.
+	keys map[string]struct{}
+}
+
+type Argument struct {
+	ctr *container
+	// MaxDepth is the max number of iterations of the recursive part.
+	MaxDepth int64
+	// Distinct is true for UNION and false for UNION ALL.
+	Distinct bool
+	// Iterate runs the recursive part once with the rows of the working table,
+	// and returns the rows it produced, the returned batch can be nil if there is no row.
+	Iterate func(working *batch.Batch, proc *process.Process) (*batch.Batch, error)
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	ctr := arg.ctr
+	if ctr != nil {
+		mp := proc.Mp()
+		ctr.cleanWorking(mp)
+		ctr.cleanBatch(mp)
+		ctr.keys = nil
+	}
+}
+
+func (ctr *container) cleanBatch(mp *mpool.MPool) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(mp)
+		ctr.bat = nil
+	}
+}
+
+func (ctr *container) cleanWorking(mp *mpool.MPool) {
+	if ctr.working != nil {
+		ctr.working.Clean(mp)
+		ctr.working = nil
+	}
+}
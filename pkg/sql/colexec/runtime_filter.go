@@ -0,0 +1,304 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+)
+
+const (
+	// RuntimeFilterPass lets all the rows pass, like the hash build failed
+	RuntimeFilterPass = iota
+	// RuntimeFilterDrop filters all the rows, the build side has no key
+	RuntimeFilterDrop
+	// RuntimeFilterIn keeps the rows whose value is one of the keys
+	RuntimeFilterIn
+	// RuntimeFilterMinMax keeps the rows whose value is between the min and the max of the keys,
+	// it's used if there are too many keys
+	RuntimeFilterMinMax
+)
+
+// RuntimeFilter is built from the join keys of a hash build and filters the rows of the table
+// scanned on the probe side, the rows filtered can't be joined.
+type RuntimeFilter struct {
+	Tag  int32
+	Kind int
+
+	typ types.Type
+	// zm is the min and the max of the keys
+	zm *index.ZoneMap
+	// keys are the distinct keys for RuntimeFilterIn, set is the keys encoded
+	keys []any
+	set  map[string]struct{}
+}
+
+func NewRuntimeFilter(tag int32, kind int) *RuntimeFilter {
+	return &RuntimeFilter{Tag: tag, Kind: kind}
+}
+
+// BuildRuntimeFilter builds the runtime filter of the first rows of the keys, the filter keeps
+// the min and the max only if there are more than upperLimit distinct keys.
+func BuildRuntimeFilter(tag int32, vec *vector.Vector, rows int, upperLimit int) *RuntimeFilter {
+	if vec == nil || rows == 0 || vec.IsConstNull() {
+		return NewRuntimeFilter(tag, RuntimeFilterDrop)
+	}
+	f := &RuntimeFilter{
+		Tag:  tag,
+		Kind: RuntimeFilterIn,
+		typ:  *vec.GetType(),
+		zm:   index.NewZoneMap(*vec.GetType()),
+		set:  make(map[string]struct{}),
+	}
+	if vec.IsConst() {
+		rows = 1
+	}
+	for i := 0; i < rows; i++ {
+		v := containers.GetValue(vec, uint32(i))
+		if types.IsNull(v) {
+			continue
+		}
+		if err := f.zm.Update(v); err != nil {
+			return NewRuntimeFilter(tag, RuntimeFilterPass)
+		}
+		if f.Kind != RuntimeFilterIn {
+			continue
+		}
+		key := string(types.EncodeValue(v, f.typ))
+		if _, ok := f.set[key]; ok {
+			continue
+		}
+		if len(f.set) >= upperLimit {
+			f.Kind = RuntimeFilterMinMax
+			f.keys, f.set = nil, nil
+			continue
+		}
+		f.set[key] = struct{}{}
+		if _, ok := v.([]byte); ok {
+			// the bytes of the vector are freed after the hash build
+			v = []byte(key)
+		}
+		f.keys = append(f.keys, v)
+	}
+	if f.zm.GetMin() == nil {
+		// all the keys are null
+		return NewRuntimeFilter(tag, RuntimeFilterDrop)
+	}
+	return f
+}
+
+// MayContain returns false if no value in the zonemap of a block can pass the filter
+func (f *RuntimeFilter) MayContain(zm *index.ZoneMap) bool {
+	if zm.GetMin() == nil {
+		return f.Kind != RuntimeFilterDrop
+	}
+	switch f.Kind {
+	case RuntimeFilterDrop:
+		return false
+	case RuntimeFilterIn:
+		for _, key := range f.keys {
+			if zm.Contains(key) {
+				return true
+			}
+		}
+		return false
+	case RuntimeFilterMinMax:
+		return zm.Contains(f.zm.GetMin()) || f.zm.Contains(zm.GetMin())
+	}
+	return true
+}
+
+// Filter returns the rows of the vector passing the filter
+func (f *RuntimeFilter) Filter(vec *vector.Vector, sels []int64) []int64 {
+	sels = sels[:0]
+	for i := 0; i < vec.Length(); i++ {
+		if f.pass(containers.GetValue(vec, uint32(i))) {
+			sels = append(sels, int64(i))
+		}
+	}
+	return sels
+}
+
+func (f *RuntimeFilter) pass(v any) bool {
+	switch f.Kind {
+	case RuntimeFilterPass:
+		return true
+	case RuntimeFilterDrop:
+		return false
+	}
+	if types.IsNull(v) {
+		// null is never joined
+		return false
+	}
+	if f.Kind == RuntimeFilterIn {
+		_, ok := f.set[string(types.EncodeValue(v, f.typ))]
+		return ok
+	}
+	return f.zm.Contains(v)
+}
+
+// RuntimeFilterRegistry passes the runtime filters from the hash builds to the table scans of a
+// compile. A scan waits only for the filters expected, the ones of the joins compiled, so it never
+// waits for a hash build which doesn't exist. The first filter sent for a tag is used, all the
+// hash builds of a broadcast join build the same keys.
+type RuntimeFilterRegistry struct {
+	sync.Mutex
+	entries map[int32]*runtimeFilterEntry
+}
+
+type runtimeFilterEntry struct {
+	done   chan struct{}
+	filter *RuntimeFilter
+}
+
+func NewRuntimeFilterRegistry() *RuntimeFilterRegistry {
+	return &RuntimeFilterRegistry{
+		entries: make(map[int32]*runtimeFilterEntry),
+	}
+}
+
+// Expect tells the registry that a hash build will send the filters of the specs
+func (r *RuntimeFilterRegistry) Expect(specs []*plan.RuntimeFilterSpec) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	for _, spec := range specs {
+		if _, ok := r.entries[spec.Tag]; !ok {
+			r.entries[spec.Tag] = &runtimeFilterEntry{done: make(chan struct{})}
+		}
+	}
+}
+
+func (r *RuntimeFilterRegistry) Send(f *RuntimeFilter) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	e, ok := r.entries[f.Tag]
+	if !ok || e.filter != nil {
+		return
+	}
+	e.filter = f
+	close(e.done)
+}
+
+// Receive waits for the filter of the tag, the filter lets all the rows pass if it's not expected.
+func (r *RuntimeFilterRegistry) Receive(ctx context.Context, tag int32) (*RuntimeFilter, error) {
+	var e *runtimeFilterEntry
+	if r != nil {
+		r.Lock()
+		e = r.entries[tag]
+		r.Unlock()
+	}
+	if e == nil {
+		return NewRuntimeFilter(tag, RuntimeFilterPass), nil
+	}
+	select {
+	case <-e.done:
+		return e.filter, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// RuntimeFilterReader is implemented by the readers which skip the blocks by the runtime filters,
+// attrs[i] is the attribute filtered by filters[i].
+type RuntimeFilterReader interface {
+	SetRuntimeFilters(attrs []string, filters []*RuntimeFilter)
+}
+
+type runtimeFilterReader struct {
+	engine.Reader
+	registry *RuntimeFilterRegistry
+	specs    []*plan.RuntimeFilterSpec
+
+	received bool
+	drop     bool
+	attrs    []string
+	filters  []*RuntimeFilter
+	sels     []int64
+}
+
+// NewRuntimeFilterReader returns the reader filtering the rows by the runtime filters of the specs,
+// the filters are received before the first read.
+func NewRuntimeFilterReader(r engine.Reader, registry *RuntimeFilterRegistry, specs []*plan.RuntimeFilterSpec) engine.Reader {
+	return &runtimeFilterReader{
+		Reader:   r,
+		registry: registry,
+		specs:    specs,
+	}
+}
+
+func (r *runtimeFilterReader) Read(ctx context.Context, cols []string, expr *plan.Expr, mp *mpool.MPool) (*batch.Batch, error) {
+	if !r.received {
+		if err := r.receive(ctx); err != nil {
+			// the query is canceled, the rows must not look like the end of the data
+			return nil, err
+		}
+	}
+	if r.drop {
+		return nil, nil
+	}
+	bat, err := r.Reader.Read(ctx, cols, expr, mp)
+	if err != nil || bat == nil || len(r.filters) == 0 {
+		return bat, err
+	}
+	for i, f := range r.filters {
+		for j, col := range cols {
+			if col == r.attrs[i] && bat.Length() > 0 {
+				r.sels = f.Filter(bat.Vecs[j], r.sels)
+				if len(r.sels) < bat.Length() {
+					bat.Shrink(r.sels)
+				}
+				break
+			}
+		}
+	}
+	return bat, nil
+}
+
+func (r *runtimeFilterReader) receive(ctx context.Context) error {
+	for _, spec := range r.specs {
+		f, err := r.registry.Receive(ctx, spec.Tag)
+		if err != nil {
+			return err
+		}
+		switch f.Kind {
+		case RuntimeFilterPass:
+			continue
+		case RuntimeFilterDrop:
+			r.drop = true
+		}
+		r.attrs = append(r.attrs, spec.Expr.GetCol().GetName())
+		r.filters = append(r.filters, f)
+	}
+	r.received = true
+	if fr, ok := r.Reader.(RuntimeFilterReader); ok && len(r.filters) > 0 && !r.drop {
+		fr.SetRuntimeFilters(r.attrs, r.filters)
+	}
+	return nil
+}
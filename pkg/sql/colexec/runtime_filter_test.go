@@ -0,0 +1,181 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/stretchr/testify/require"
+)
+
+func newInt64Vector(t *testing.T, mp *mpool.MPool, vals []int64, nulls []bool) *vector.Vector {
+	vec := vector.NewVec(types.T_int64.ToType())
+	for i, v := range vals {
+		require.NoError(t, vector.AppendFixed(vec, v, nulls != nil && nulls[i], mp))
+	}
+	return vec
+}
+
+func newInt64ZoneMap(min, max int64) *index.ZoneMap {
+	zm := index.NewZoneMap(types.T_int64.ToType())
+	_ = zm.Update(min)
+	_ = zm.Update(max)
+	return zm
+}
+
+func TestRuntimeFilter(t *testing.T) {
+	mp := mpool.MustNewZero()
+	keys := newInt64Vector(t, mp, []int64{5, 3, 5, 0, 9}, []bool{false, false, false, true, false})
+	defer keys.Free(mp)
+
+	f := BuildRuntimeFilter(1, keys, keys.Length(), 10)
+	require.Equal(t, RuntimeFilterIn, f.Kind)
+	require.True(t, f.MayContain(newInt64ZoneMap(4, 6)))
+	require.False(t, f.MayContain(newInt64ZoneMap(6, 8)))
+	require.False(t, f.MayContain(newInt64ZoneMap(10, 20)))
+
+	probe := newInt64Vector(t, mp, []int64{1, 3, 5, 7, 9, 0}, []bool{false, false, false, false, false, true})
+	defer probe.Free(mp)
+	require.Equal(t, []int64{1, 2, 4}, f.Filter(probe, nil))
+
+	// the keys are more than the limit
+	f = BuildRuntimeFilter(1, keys, keys.Length(), 1)
+	require.Equal(t, RuntimeFilterMinMax, f.Kind)
+	require.True(t, f.MayContain(newInt64ZoneMap(6, 8)))
+	require.True(t, f.MayContain(newInt64ZoneMap(0, 3)))
+	require.False(t, f.MayContain(newInt64ZoneMap(10, 20)))
+	require.Equal(t, []int64{1, 2, 3, 4}, f.Filter(probe, nil))
+
+	// no key to join
+	require.Equal(t, RuntimeFilterDrop, BuildRuntimeFilter(1, keys, 0, 10).Kind)
+	allNulls := newInt64Vector(t, mp, []int64{0, 0}, []bool{true, true})
+	defer allNulls.Free(mp)
+	f = BuildRuntimeFilter(1, allNulls, allNulls.Length(), 10)
+	require.Equal(t, RuntimeFilterDrop, f.Kind)
+	require.False(t, f.MayContain(newInt64ZoneMap(0, 3)))
+	require.Empty(t, f.Filter(probe, nil))
+}
+
+func TestRuntimeFilterRegistry(t *testing.T) {
+	r := NewRuntimeFilterRegistry()
+	r.Expect([]*plan.RuntimeFilterSpec{{Tag: 1}})
+
+	// the filters not expected let all the rows pass
+	f, err := r.Receive(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, RuntimeFilterPass, f.Kind)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = r.Receive(ctx, 1)
+	require.Error(t, err)
+
+	// the first filter sent is used
+	r.Send(NewRuntimeFilter(1, RuntimeFilterDrop))
+	r.Send(NewRuntimeFilter(1, RuntimeFilterPass))
+	f, err = r.Receive(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, RuntimeFilterDrop, f.Kind)
+
+	var nilRegistry *RuntimeFilterRegistry
+	nilRegistry.Expect([]*plan.RuntimeFilterSpec{{Tag: 1}})
+	nilRegistry.Send(NewRuntimeFilter(1, RuntimeFilterDrop))
+	f, err = nilRegistry.Receive(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, RuntimeFilterPass, f.Kind)
+}
+
+type testBatchReader struct {
+	bats []*batch.Batch
+}
+
+func (r *testBatchReader) Close() error {
+	return nil
+}
+
+func (r *testBatchReader) Read(_ context.Context, _ []string, _ *plan.Expr, _ *mpool.MPool) (*batch.Batch, error) {
+	if len(r.bats) == 0 {
+		return nil, nil
+	}
+	bat := r.bats[0]
+	r.bats = r.bats[1:]
+	return bat, nil
+}
+
+func TestRuntimeFilterReader(t *testing.T) {
+	mp := mpool.MustNewZero()
+	keys := newInt64Vector(t, mp, []int64{3, 5}, nil)
+	defer keys.Free(mp)
+
+	specs := []*plan.RuntimeFilterSpec{{
+		Tag: 1,
+		Expr: &plan.Expr{
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "b"}},
+		},
+	}}
+	r := NewRuntimeFilterRegistry()
+	r.Expect(specs)
+	r.Send(BuildRuntimeFilter(1, keys, keys.Length(), 10))
+
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = newInt64Vector(t, mp, []int64{1, 2, 3, 4}, nil)
+	bat.Vecs[1] = newInt64Vector(t, mp, []int64{5, 4, 3, 2}, nil)
+	bat.SetZs(4, mp)
+	rd := NewRuntimeFilterReader(&testBatchReader{bats: []*batch.Batch{bat}}, r, specs)
+	result, err := rd.Read(context.Background(), []string{"a", "b"}, nil, mp)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Length())
+	require.Equal(t, []int64{1, 3}, vector.MustFixedCol[int64](result.Vecs[0]))
+	result.Clean(mp)
+
+	result, err = rd.Read(context.Background(), []string{"a", "b"}, nil, mp)
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	// nothing is read if no row can be joined
+	r = NewRuntimeFilterRegistry()
+	r.Expect(specs)
+	r.Send(NewRuntimeFilter(1, RuntimeFilterDrop))
+	rd = NewRuntimeFilterReader(&testBatchReader{bats: []*batch.Batch{batch.NewWithSize(2)}}, r, specs)
+	result, err = rd.Read(context.Background(), []string{"a", "b"}, nil, mp)
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestRuntimeFilterReaderCanceled(t *testing.T) {
+	mp := mpool.MustNewZero()
+	specs := []*plan.RuntimeFilterSpec{{
+		Tag: 1,
+		Expr: &plan.Expr{
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "b"}},
+		},
+	}}
+	r := NewRuntimeFilterRegistry()
+	r.Expect(specs)
+	rd := NewRuntimeFilterReader(&testBatchReader{bats: []*batch.Batch{batch.NewWithSize(2)}}, r, specs)
+
+	// the filter is never sent, the canceled query is an error but not the end of the data
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := rd.Read(ctx, []string{"a", "b"}, nil, mp)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sort"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
@@ -59,6 +60,14 @@ type S3Writer struct {
 	tableBatchSizes []uint64
 
 	sels []int64
+
+	// compressions is the compression of the columns of the main table by their names
+	compressions map[string]columnCompression
+}
+
+type columnCompression struct {
+	alg   int
+	level int
 }
 
 const (
@@ -78,6 +87,10 @@ func (w *S3Writer) SetMp(attrs []*engine.Attribute) {
 		if attrs[i].Primary {
 			w.pk[attrs[i].Name] = struct{}{}
 		}
+		w.compressions[attrs[i].Name] = columnCompression{
+			alg:   int(attrs[i].Alg),
+			level: int(attrs[i].CompressLevel),
+		}
 		if attrs[i].Default == nil {
 			continue
 		}
@@ -89,6 +102,7 @@ func (w *S3Writer) Init(num int) {
 	w.tableBatches = make([][]*batch.Batch, num)
 	w.buffers = make([]*batch.Batch, num)
 	w.pk = make(map[string]struct{})
+	w.compressions = make(map[string]columnCompression)
 	w.sels = make([]int64, options.DefaultBlockMaxRows)
 	for i := 0; i < int(options.DefaultBlockMaxRows); i++ {
 		w.sels[i] = int64(i)
@@ -103,7 +117,7 @@ func (w *S3Writer) AddSortIdx(sortIdx int) {
 func NewS3Writer(tableDef *plan.TableDef) *S3Writer {
 	uniqueNums := 0
 	for _, idx := range tableDef.Indexes {
-		if idx.Unique {
+		if idx.Unique || util.IsSecondaryIndexTable(idx) {
 			uniqueNums++
 		}
 	}
@@ -111,11 +125,12 @@ func NewS3Writer(tableDef *plan.TableDef) *S3Writer {
 	s3Writer := &S3Writer{
 		sortIndex: make([]int, 0, 1),
 		pk:        make(map[string]struct{}),
-		// main table and unique tables
+		// main table, unique tables, secondary, fulltext and functional index tables
 		buffers:         make([]*batch.Batch, uniqueNums+1),
 		tableBatches:    make([][]*batch.Batch, uniqueNums+1),
 		tableBatchSizes: make([]uint64, uniqueNums+1),
 		sels:            make([]int64, options.DefaultBlockMaxRows),
+		compressions:    make(map[string]columnCompression),
 	}
 
 	for i := 0; i < int(options.DefaultBlockMaxRows); i++ {
@@ -153,6 +168,10 @@ func NewS3Writer(tableDef *plan.TableDef) *S3Writer {
 		if def.Primary {
 			s3Writer.pk[def.Name] = struct{}{}
 		}
+		s3Writer.compressions[def.Name] = columnCompression{
+			alg:   int(def.Alg),
+			level: int(def.CompressLevel),
+		}
 	}
 	if tableDef.CompositePkey != nil {
 		s3Writer.pk[tableDef.CompositePkey.Name] = struct{}{}
@@ -302,7 +321,7 @@ func (w *S3Writer) MergeBlock(idx int, length int, proc *process.Process, cacheO
 	}
 	// just write ahead, no need to sort
 	if sortIdx == -1 {
-		if err := w.generateWriter(proc); err != nil {
+		if err := w.generateWriter(proc, idx); err != nil {
 			return err
 		}
 
@@ -344,11 +363,11 @@ func (w *S3Writer) MergeBlock(idx int, length int, proc *process.Process, cacheO
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[int64](), getFixedCols[int64](bats, pos, stopIdx), nulls)
 		case types.T_uint8:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[uint8](), getFixedCols[uint8](bats, pos, stopIdx), nulls)
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[uint16](), getFixedCols[uint16](bats, pos, stopIdx), nulls)
 		case types.T_uint32:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[uint32](), getFixedCols[uint32](bats, pos, stopIdx), nulls)
-		case types.T_uint64:
+		case types.T_uint64, types.T_set:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[uint64](), getFixedCols[uint64](bats, pos, stopIdx), nulls)
 		case types.T_float32:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[float32](), getFixedCols[float32](bats, pos, stopIdx), nulls)
@@ -371,7 +390,7 @@ func (w *S3Writer) MergeBlock(idx int, length int, proc *process.Process, cacheO
 		case types.T_char, types.T_varchar, types.T_blob, types.T_text:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[string](), getStrCols(bats, pos, stopIdx), nulls)
 		}
-		if err := w.generateWriter(proc); err != nil {
+		if err := w.generateWriter(proc, idx); err != nil {
 			return err
 		}
 		lens := 0
@@ -455,7 +474,7 @@ func getNewBatch(bat *batch.Batch) *batch.Batch {
 	return newBat
 }
 
-func (w *S3Writer) generateWriter(proc *process.Process) error {
+func (w *S3Writer) generateWriter(proc *process.Process, idx int) error {
 	segId, err := Srv.GenerateSegment()
 	if err != nil {
 		return err
@@ -464,7 +483,16 @@ func (w *S3Writer) generateWriter(proc *process.Process) error {
 	if err != nil {
 		return err
 	}
-	w.writer, err = blockio.NewBlockWriter(s3, segId)
+	var opts []objectio.WriterOptionFunc
+	// the columns of the index tables are compressed by default
+	if idx == 0 {
+		for i, attr := range w.buffers[idx].Attrs {
+			if c, ok := w.compressions[attr]; ok {
+				opts = append(opts, objectio.WithColumnCompression(uint16(i), c.alg, c.level))
+			}
+		}
+	}
+	w.writer, err = blockio.NewBlockWriter(s3, segId, opts...)
 	if err != nil {
 		return err
 	}
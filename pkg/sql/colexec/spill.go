@@ -0,0 +1,255 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// SpillPartitions is the number of the partitions an operator spills its state into
+	SpillPartitions = 16
+	// SpillBatchRows is the number of the rows of a batch spilled in a sorted run
+	SpillBatchRows = 8192
+
+	spillDir = "spill"
+)
+
+// SpillFile is a list of batches spilled to the local file service, each batch is written into
+// a file of its own and is read back by its index.
+type SpillFile struct {
+	fs    fileservice.FileService
+	path  string
+	names []string
+	rows  int
+}
+
+// NewSpillFile returns an empty spill file of the query
+func NewSpillFile(proc *process.Process) (*SpillFile, error) {
+	fs, err := fileservice.Get[fileservice.FileService](proc.FileService, defines.LocalFileServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return &SpillFile{
+		fs:   fs,
+		path: NewSpillPath(proc),
+	}, nil
+}
+
+// NewSpillPath returns a new path of the query in the spill directory of the local file service
+func NewSpillPath(proc *process.Process) string {
+	return path.Join(spillDir, proc.Id, uuid.NewString())
+}
+
+// NewSpillFiles returns the spill files of n partitions
+func NewSpillFiles(proc *process.Process, n int) ([]*SpillFile, error) {
+	files := make([]*SpillFile, n)
+	for i := range files {
+		f, err := NewSpillFile(proc)
+		if err != nil {
+			return nil, err
+		}
+		files[i] = f
+	}
+	return files, nil
+}
+
+// Batches returns the number of the batches written
+func (f *SpillFile) Batches() int {
+	return len(f.names)
+}
+
+// Rows returns the number of the rows written
+func (f *SpillFile) Rows() int {
+	return f.rows
+}
+
+// Write appends the batch to the file, the aggregations of the batch are written with their states.
+func (f *SpillFile) Write(ctx context.Context, bat *batch.Batch) error {
+	data, err := bat.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	name := path.Join(f.path, strconv.Itoa(len(f.names)))
+	vec := fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Size: int64(len(data)),
+				Data: data,
+			},
+		},
+	}
+	if err = f.fs.Write(ctx, vec); err != nil {
+		return err
+	}
+	f.names = append(f.names, name)
+	f.rows += bat.Length()
+	return nil
+}
+
+// Read reads the i-th batch written, the memory of the batch is allocated from the mpool.
+func (f *SpillFile) Read(ctx context.Context, i int, mp *mpool.MPool) (*batch.Batch, error) {
+	vec := &fileservice.IOVector{
+		FilePath: f.names[i],
+		Entries: []fileservice.IOEntry{
+			{
+				Size: -1,
+			},
+		},
+	}
+	if err := f.fs.Read(ctx, vec); err != nil {
+		return nil, err
+	}
+	bat := new(batch.Batch)
+	if err := bat.UnmarshalBinary(vec.Entries[0].Data); err != nil {
+		return nil, err
+	}
+	for j := range bat.Vecs {
+		vec, err := bat.Vecs[j].Dup(mp)
+		if err != nil {
+			for k := 0; k < j; k++ {
+				bat.Vecs[k].Free(mp)
+			}
+			return nil, err
+		}
+		bat.Vecs[j] = vec
+	}
+	for j, ag := range bat.Aggs {
+		if err := ag.WildAggReAlloc(mp); err != nil {
+			for k := 0; k < j; k++ {
+				bat.Aggs[k].Free(mp)
+			}
+			for k := range bat.Vecs {
+				bat.Vecs[k].Free(mp)
+			}
+			return nil, err
+		}
+	}
+	return bat, nil
+}
+
+// Delete removes the batches written
+func (f *SpillFile) Delete(ctx context.Context) error {
+	if len(f.names) == 0 {
+		return nil
+	}
+	err := f.fs.Delete(ctx, f.names...)
+	f.names = nil
+	f.rows = 0
+	return err
+}
+
+// DeleteSpillFiles removes the spill files, the errors are ignored as the files are useless
+func DeleteSpillFiles(ctx context.Context, files []*SpillFile) {
+	for _, f := range files {
+		if f != nil {
+			_ = f.Delete(ctx)
+		}
+	}
+}
+
+// PartitionRows splits the first rows by the hash of the keys into len(parts) partitions,
+// the rows having a null key are dropped if dropNull.
+func PartitionRows(keys []*vector.Vector, rows int, dropNull bool, parts [][]int32) [][]int32 {
+	for i := range parts {
+		parts[i] = parts[i][:0]
+	}
+	var buf []byte
+	for i := 0; i < rows; i++ {
+		buf = buf[:0]
+		hasNull := false
+		for _, vec := range keys {
+			row := i
+			if vec.IsConst() {
+				row = 0
+			}
+			if vec.IsConstNull() || nulls.Contains(vec.GetNulls(), uint64(row)) {
+				hasNull = true
+				buf = append(buf, 0)
+				continue
+			}
+			buf = append(buf, 1)
+			if vec.GetType().IsVarlen() {
+				buf = append(buf, vec.GetBytesAt(row)...)
+			} else {
+				size := vec.GetType().TypeSize()
+				buf = append(buf, vec.UnsafeGetRawData()[row*size:(row+1)*size]...)
+			}
+		}
+		if hasNull && dropNull {
+			continue
+		}
+		p := xxhash.Sum64(buf) % uint64(len(parts))
+		parts[p] = append(parts[p], int32(i))
+	}
+	return parts
+}
+
+// UnionRows returns a new batch of the rows of the batch, the aggregations are not copied.
+func UnionRows(bat *batch.Batch, sels []int32, mp *mpool.MPool) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	rbat.Zs = mp.GetSels()
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.NewVec(*vec.GetType())
+		if err := rbat.Vecs[i].Union(vec, sels, mp); err != nil {
+			rbat.Clean(mp)
+			return nil, err
+		}
+	}
+	for _, sel := range sels {
+		rbat.Zs = append(rbat.Zs, bat.Zs[sel])
+	}
+	return rbat, nil
+}
+
+// SpilledJoinMap is sent by a hash build instead of the JoinMap if the build side is spilled,
+// the partitions are shared by all the joins receiving it and are deleted by the last one.
+type SpilledJoinMap struct {
+	cnt        *int64
+	Partitions []*SpillFile
+}
+
+func NewSpilledJoinMap(partitions []*SpillFile) *SpilledJoinMap {
+	cnt := int64(1)
+	return &SpilledJoinMap{
+		cnt:        &cnt,
+		Partitions: partitions,
+	}
+}
+
+func (m *SpilledJoinMap) IncRef(ref int64) {
+	atomic.AddInt64(m.cnt, ref)
+}
+
+func (m *SpilledJoinMap) Free(ctx context.Context) {
+	if atomic.AddInt64(m.cnt, -1) != 0 {
+		return
+	}
+	DeleteSpillFiles(ctx, m.Partitions)
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpillFile(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	mp := proc.Mp()
+
+	f, err := NewSpillFile(proc)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		bat := batch.NewWithSize(1)
+		bat.Vecs[0] = newInt64Vector(t, mp, []int64{int64(i), 1, 2}, []bool{false, true, false})
+		bat.InitZsOne(3)
+		require.NoError(t, f.Write(proc.Ctx, bat))
+		bat.Clean(mp)
+	}
+	require.Equal(t, 2, f.Batches())
+	require.Equal(t, 6, f.Rows())
+
+	bat, err := f.Read(proc.Ctx, 1, mp)
+	require.NoError(t, err)
+	require.Equal(t, 3, bat.Length())
+	require.Equal(t, int64(1), vector.MustFixedCol[int64](bat.Vecs[0])[0])
+	require.True(t, bat.Vecs[0].GetNulls().Contains(1))
+	bat.Clean(mp)
+
+	require.NoError(t, f.Delete(proc.Ctx))
+	require.Equal(t, 0, f.Batches())
+	require.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestPartitionRows(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vec := newInt64Vector(t, mp, []int64{1, 2, 1, 0, 2}, []bool{false, false, false, true, false})
+	defer vec.Free(mp)
+
+	parts := PartitionRows([]*vector.Vector{vec}, vec.Length(), true, make([][]int32, SpillPartitions))
+	rows := 0
+	partOf := make(map[int32]int)
+	for i, sels := range parts {
+		rows += len(sels)
+		for _, sel := range sels {
+			partOf[sel] = i
+		}
+	}
+	// the row of the null key is dropped, the rows of the same key are in the same partition
+	require.Equal(t, 4, rows)
+	require.Equal(t, partOf[0], partOf[2])
+	require.Equal(t, partOf[1], partOf[4])
+
+	parts = PartitionRows([]*vector.Vector{vec}, vec.Length(), false, parts)
+	rows = 0
+	for _, sels := range parts {
+		rows += len(sels)
+	}
+	require.Equal(t, 5, rows)
+}
@@ -0,0 +1,280 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// jsonTableColumn is the column of json_table with its path parsed and its
+// position in the output batch, pos is -1 if the column is pruned.
+type jsonTableColumn struct {
+	param   *plan2.JsonTableColumnParam
+	path    bytejson.Path
+	pos     int
+	columns []*jsonTableColumn
+}
+
+// jsonTableCell is a value of a row of json_table before it is converted to the column type
+type jsonTableCell struct {
+	col    *jsonTableColumn
+	val    bytejson.ByteJson
+	isNull bool
+}
+
+func jsonTablePrepare(proc *process.Process, arg *Argument) error {
+	if len(arg.Args) != 1 {
+		return moerr.NewInvalidInput(proc.Ctx, "json_table: argument number must be 1")
+	}
+	_, _, err := jsonTableColumns(arg)
+	return err
+}
+
+func jsonTableCall(_ int, proc *process.Process, arg *Argument) (bool, error) {
+	var (
+		err     error
+		rbat    *batch.Batch
+		jsonVec *vector.Vector
+	)
+	defer func() {
+		if err != nil && rbat != nil {
+			rbat.Clean(proc.Mp())
+		}
+		if jsonVec != nil {
+			jsonVec.Free(proc.Mp())
+		}
+	}()
+	bat := proc.InputBatch()
+	if bat == nil {
+		return true, nil
+	}
+	path, cols, err := jsonTableColumns(arg)
+	if err != nil {
+		return false, err
+	}
+	jsonVec, err = colexec.EvalExpr(bat, proc, arg.Args[0])
+	if err != nil {
+		return false, err
+	}
+	var fn func(dt []byte) (bytejson.ByteJson, error)
+	switch {
+	case jsonVec.GetType().Oid == types.T_json:
+		fn = parseJson
+	case jsonVec.GetType().IsString():
+		fn = parseStr
+	default:
+		err = moerr.NewInvalidInput(proc.Ctx, fmt.Sprintf("json_table: argument must be json or string, but got %s", jsonVec.GetType().String()))
+		return false, err
+	}
+	rbat = batch.New(false, arg.Attrs)
+	rbat.Cnt = 1
+	for i := range arg.Rets {
+		rbat.Vecs[i] = vector.NewVec(dupType(arg.Rets[i].Typ))
+	}
+	count := bat.Length()
+	if jsonVec.IsConst() {
+		count = 1
+	}
+	rows := 0
+	for i := 0; i < count; i++ {
+		if jsonVec.GetNulls().Contains(uint64(i)) {
+			continue
+		}
+		var doc bytejson.ByteJson
+		if doc, err = fn(jsonVec.GetBytesAt(i)); err != nil {
+			return false, err
+		}
+		for k, val := range doc.LookupAll(&path) {
+			var cells [][]jsonTableCell
+			if cells, err = jsonTableRows(cols, val, k+1, len(arg.Attrs)); err != nil {
+				return false, err
+			}
+			for _, row := range cells {
+				for j := range row {
+					if err = appendJsonTableCell(rbat.Vecs[j], row[j], proc); err != nil {
+						return false, err
+					}
+				}
+			}
+			rows += len(cells)
+		}
+	}
+	rbat.InitZsOne(rows)
+	proc.SetInputBatch(rbat)
+	return false, nil
+}
+
+// jsonTableColumns decodes the param of json_table and returns the row path and the columns
+func jsonTableColumns(arg *Argument) (bytejson.Path, []*jsonTableColumn, error) {
+	param := plan2.JsonTableParam{}
+	if err := json.Unmarshal(arg.Params, &param); err != nil {
+		return bytejson.Path{}, nil, err
+	}
+	path, err := types.ParseStringToPath(param.Path)
+	if err != nil {
+		return bytejson.Path{}, nil, err
+	}
+	pos := make(map[string]int, len(arg.Attrs))
+	for i, attr := range arg.Attrs {
+		pos[attr] = i
+	}
+	cols, err := buildJsonTableColumns(param.Columns, pos)
+	return path, cols, err
+}
+
+func buildJsonTableColumns(params []*plan2.JsonTableColumnParam, pos map[string]int) ([]*jsonTableColumn, error) {
+	cols := make([]*jsonTableColumn, len(params))
+	for i, param := range params {
+		col := &jsonTableColumn{param: param, pos: -1}
+		if param.Type != tree.JSON_TABLE_COLUMN_NESTED {
+			if p, ok := pos[param.Name]; ok {
+				col.pos = p
+			}
+		}
+		if param.Type != tree.JSON_TABLE_COLUMN_ORDINALITY {
+			var err error
+			if col.path, err = types.ParseStringToPath(param.Path); err != nil {
+				return nil, err
+			}
+		}
+		if param.Type == tree.JSON_TABLE_COLUMN_NESTED {
+			var err error
+			if col.columns, err = buildJsonTableColumns(param.Columns, pos); err != nil {
+				return nil, err
+			}
+		}
+		cols[i] = col
+	}
+	return cols, nil
+}
+
+// jsonTableRows generates the rows of the columns for the value matched by the row path,
+// ord is the ordinality of the value. The rows of the sibling nested paths are not joined,
+// the columns of the other nested paths are null in the rows of one nested path.
+func jsonTableRows(cols []*jsonTableColumn, val bytejson.ByteJson, ord int, width int) ([][]jsonTableCell, error) {
+	base := make([]jsonTableCell, width)
+	for i := range base {
+		base[i].isNull = true
+	}
+	var rows [][]jsonTableCell
+	for _, col := range cols {
+		if col.param.Type == tree.JSON_TABLE_COLUMN_NESTED {
+			for k, v := range val.LookupAll(&col.path) {
+				subRows, err := jsonTableRows(col.columns, v, k+1, width)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, subRows...)
+			}
+			continue
+		}
+		if col.pos < 0 {
+			continue
+		}
+		cell, err := jsonTableColumnValue(col, val, ord)
+		if err != nil {
+			return nil, err
+		}
+		base[col.pos] = cell
+	}
+	if len(rows) == 0 {
+		return [][]jsonTableCell{base}, nil
+	}
+	for _, row := range rows {
+		for i := range base {
+			if row[i].isNull && !base[i].isNull {
+				row[i] = base[i]
+			}
+		}
+	}
+	return rows, nil
+}
+
+func jsonTableColumnValue(col *jsonTableColumn, val bytejson.ByteJson, ord int) (jsonTableCell, error) {
+	cell := jsonTableCell{col: col}
+	var err error
+	switch col.param.Type {
+	case tree.JSON_TABLE_COLUMN_ORDINALITY:
+		err = cell.val.UnmarshalObject(int64(ord))
+	case tree.JSON_TABLE_COLUMN_EXISTS:
+		exists := int64(0)
+		if val.Exists(&col.path) {
+			exists = 1
+		}
+		err = cell.val.UnmarshalObject(exists)
+	case tree.JSON_TABLE_COLUMN_PATH:
+		vals := val.LookupAll(&col.path)
+		switch len(vals) {
+		case 0:
+			return jsonTableResponse(col, col.param.OnEmpty, "missing value")
+		case 1:
+			cell.val = vals[0]
+		default:
+			return jsonTableResponse(col, col.param.OnError, "more than one value")
+		}
+	}
+	return cell, err
+}
+
+// jsonTableResponse returns the cell of the column as ON EMPTY or ON ERROR specifies
+func jsonTableResponse(col *jsonTableColumn, response *tree.JsonTableResponse, reason string) (jsonTableCell, error) {
+	cell := jsonTableCell{col: col, isNull: true}
+	if response == nil {
+		return cell, nil
+	}
+	switch response.Type {
+	case tree.JSON_TABLE_RESPONSE_ERROR:
+		return cell, moerr.NewInvalidInputNoCtx("json_table: %s for column '%s'", reason, col.param.Name)
+	case tree.JSON_TABLE_RESPONSE_DEFAULT:
+		val, err := types.ParseStringToByteJson(response.Default)
+		if err != nil {
+			return cell, err
+		}
+		cell.val, cell.isNull = val, false
+	}
+	return cell, nil
+}
+
+func appendJsonTableCell(vec *vector.Vector, cell jsonTableCell, proc *process.Process) error {
+	typ := vec.GetType()
+	if cell.isNull || (cell.val.IsNull() && typ.Oid != types.T_json) {
+		return vector.AppendAny(vec, nil, true, proc.Mp())
+	}
+	err := util.AppendJsonValue(vec, cell.val, proc)
+	if err == nil {
+		return nil
+	}
+	// the value can not be converted to the column type, it is handled as ON ERROR specifies
+	cell, err = jsonTableResponse(cell.col, cell.col.param.OnError, err.Error())
+	if err != nil {
+		return err
+	}
+	if cell.isNull {
+		return vector.AppendAny(vec, nil, true, proc.Mp())
+	}
+	return util.AppendJsonValue(vec, cell.val, proc)
+}
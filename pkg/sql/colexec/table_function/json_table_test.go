@@ -0,0 +1,140 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+var jsonTableColDefs = map[string]*plan.ColDef{
+	"id": {Name: "id", Typ: &plan.Type{Id: int32(types.T_uint32)}},
+	"a":  {Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}},
+	"b":  {Name: "b", Typ: &plan.Type{Id: int32(types.T_varchar), Width: 10}},
+	"e":  {Name: "e", Typ: &plan.Type{Id: int32(types.T_int32)}},
+	"c":  {Name: "c", Typ: &plan.Type{Id: int32(types.T_int64)}},
+}
+
+func jsonTableTestParam(onEmpty, onError *tree.JsonTableResponse) *plan2.JsonTableParam {
+	return &plan2.JsonTableParam{
+		Path: "$[*]",
+		Columns: []*plan2.JsonTableColumnParam{
+			{Type: tree.JSON_TABLE_COLUMN_ORDINALITY, Name: "id"},
+			{Type: tree.JSON_TABLE_COLUMN_PATH, Name: "a", Path: "$.a", OnError: onError},
+			{Type: tree.JSON_TABLE_COLUMN_PATH, Name: "b", Path: "$.b", OnEmpty: onEmpty},
+			{Type: tree.JSON_TABLE_COLUMN_EXISTS, Name: "e", Path: "$.c"},
+			{Type: tree.JSON_TABLE_COLUMN_NESTED, Path: "$.c[*]", Columns: []*plan2.JsonTableColumnParam{
+				{Type: tree.JSON_TABLE_COLUMN_PATH, Name: "c", Path: "$"},
+			}},
+		},
+	}
+}
+
+func runJsonTable(t *testing.T, param *plan2.JsonTableParam, attrs []string, jsons []string, jsonType types.T) ([][]any, error) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	dt, err := json.Marshal(param)
+	require.NoError(t, err)
+	rets := make([]*plan.ColDef, len(attrs))
+	for i, attr := range attrs {
+		rets[i] = jsonTableColDefs[attr]
+	}
+	arg := &Argument{
+		Attrs:  attrs,
+		Rets:   rets,
+		Params: dt,
+		Name:   "json_table",
+		Args: []*plan.Expr{{
+			Typ:  &plan.Type{Id: int32(jsonType)},
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}},
+		}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	fn := encodeStr
+	if jsonType == types.T_json {
+		fn = encodeJson
+	}
+	beforeMem := proc.Mp().CurrNB()
+	inputBat, err := makeUnnestBatch(jsons, jsonType, fn, proc)
+	require.NoError(t, err)
+	proc.SetInputBatch(inputBat)
+	_, err = Call(0, proc, arg, false, false)
+	inputBat.Clean(proc.Mp())
+	if err != nil {
+		require.Equal(t, beforeMem, proc.Mp().CurrNB())
+		return nil, err
+	}
+	bat := proc.InputBatch()
+	rows := make([][]any, bat.Length())
+	for i := range rows {
+		rows[i] = make([]any, len(attrs))
+		for j, vec := range bat.Vecs {
+			if vec.GetNulls().Contains(uint64(i)) {
+				continue
+			}
+			switch vec.GetType().Oid {
+			case types.T_uint32:
+				rows[i][j] = vector.GetFixedAt[uint32](vec, i)
+			case types.T_int32:
+				rows[i][j] = vector.GetFixedAt[int32](vec, i)
+			case types.T_int64:
+				rows[i][j] = vector.GetFixedAt[int64](vec, i)
+			case types.T_varchar:
+				rows[i][j] = vec.GetStringAt(i)
+			}
+		}
+	}
+	bat.Clean(proc.Mp())
+	require.Equal(t, beforeMem, proc.Mp().CurrNB())
+	return rows, nil
+}
+
+func TestJsonTable(t *testing.T) {
+	doc := `[{"a":1,"b":"x","c":[10,20]},{"a":"y"}]`
+	defaultB := &tree.JsonTableResponse{Type: tree.JSON_TABLE_RESPONSE_DEFAULT, Default: `"d"`}
+	defaultA := &tree.JsonTableResponse{Type: tree.JSON_TABLE_RESPONSE_DEFAULT, Default: `5`}
+	errorResp := &tree.JsonTableResponse{Type: tree.JSON_TABLE_RESPONSE_ERROR}
+
+	rows, err := runJsonTable(t, jsonTableTestParam(defaultB, defaultA), []string{"id", "a", "b", "e", "c"}, []string{doc}, types.T_varchar)
+	require.NoError(t, err)
+	require.Equal(t, [][]any{
+		{uint32(1), int64(1), "x", int32(1), int64(10)},
+		{uint32(1), int64(1), "x", int32(1), int64(20)},
+		{uint32(2), int64(5), "d", int32(0), nil},
+	}, rows)
+
+	// the columns may be pruned and reordered
+	rows, err = runJsonTable(t, jsonTableTestParam(nil, nil), []string{"c", "a"}, []string{doc, `[{"a":3}]`}, types.T_json)
+	require.NoError(t, err)
+	require.Equal(t, [][]any{
+		{int64(10), int64(1)},
+		{int64(20), int64(1)},
+		{nil, nil},
+		{nil, int64(3)},
+	}, rows)
+
+	_, err = runJsonTable(t, jsonTableTestParam(errorResp, nil), []string{"b"}, []string{doc}, types.T_varchar)
+	require.Error(t, err)
+	_, err = runJsonTable(t, jsonTableTestParam(nil, errorResp), []string{"a"}, []string{doc}, types.T_varchar)
+	require.Error(t, err)
+}
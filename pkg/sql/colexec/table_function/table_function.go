@@ -35,6 +35,8 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 		return metaScanCall(idx, proc, tblArg)
 	case "current_account":
 		return currentAccountCall(idx, proc, tblArg)
+	case "json_table":
+		return jsonTableCall(idx, proc, tblArg)
 	default:
 		return true, moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.Name))
 	}
@@ -55,6 +57,8 @@ func Prepare(proc *process.Process, arg any) error {
 		return metaScanPrepare(proc, tblArg)
 	case "current_account":
 		return currentAccountPrepare(proc, tblArg)
+	case "json_table":
+		return jsonTablePrepare(proc, tblArg)
 	default:
 		return moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.Name))
 	}
@@ -58,5 +58,10 @@ type UpdateCtx struct {
 	OnSetTableDef     []*plan.TableDef
 	OnSetUpdateCol    []map[string]int32
 
+	// fulltext and functional index tables of Source, OnCascadeSource and OnSetSource
+	SecondaryIndexSource          [][]engine.Relation
+	OnCascadeSecondaryIndexSource [][]engine.Relation
+	OnSetSecondaryIndexSource     [][]engine.Relation
+
 	ParentIdx []map[string]int32
 }
@@ -0,0 +1,240 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// windowState describes the sorted rows of a window, all the slices are indexed by sorted position.
+type windowState struct {
+	sels []int64
+
+	// partition and peer group of each position, the ends are exclusive.
+	partStart []int
+	partEnd   []int
+	peerStart []int
+	peerEnd   []int
+
+	// frame of each position, the ends are inclusive, and start > end means an empty frame.
+	frameStart []int
+	frameEnd   []int
+}
+
+func (ws *windowState) frameOf(i int) (int, int) {
+	return ws.frameStart[i], ws.frameEnd[i]
+}
+
+// rank computes row_number(), rank() and dense_rank().
+func (ws *windowState) rank(fid int32, typ types.Type, proc *process.Process) (*vector.Vector, error) {
+	rs := make([]int64, len(ws.sels))
+	var dense int64
+	for i, row := range ws.sels {
+		switch fid {
+		case function.ROW_NUMBER:
+			rs[row] = int64(i-ws.partStart[i]) + 1
+		case function.RANK:
+			rs[row] = int64(ws.peerStart[i]-ws.partStart[i]) + 1
+		default:
+			if i == ws.partStart[i] {
+				dense = 1
+			} else if i == ws.peerStart[i] {
+				dense++
+			}
+			rs[row] = dense
+		}
+	}
+	vec := vector.NewVec(typ)
+	if err := vector.AppendFixedList(vec, rs, nil, proc.Mp()); err != nil {
+		vec.Free(proc.Mp())
+		return nil, err
+	}
+	return vec, nil
+}
+
+// initFrame computes the frame of each position.
+// orderVec is the first order key of the window, it is only used by RANGE frames with offsets.
+func (ctr *container) initFrame(ws *windowState, frame *plan.FrameClause, orderVec *vector.Vector, desc bool, proc *process.Process) error {
+	n := len(ws.sels)
+	ws.frameStart = make([]int, n)
+	ws.frameEnd = make([]int, n)
+
+	if frame == nil {
+		frame = &plan.FrameClause{
+			Type:  plan.FrameClause_ROWS,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Unbounded: true},
+			End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Unbounded: true},
+		}
+	}
+	isRange := frame.Type == plan.FrameClause_RANGE
+
+	var keys []float64
+	var keyNulls []bool
+	if isRange && (hasOffset(frame.Start) || hasOffset(frame.End)) {
+		if orderVec == nil {
+			return moerr.NewInternalError(proc.Ctx, "RANGE frame with offset requires an order key")
+		}
+		var err error
+		if keys, keyNulls, err = orderKeys(ws.sels, orderVec, desc, proc); err != nil {
+			return err
+		}
+	}
+
+	start, err := ctr.newBoundFunc(ws, frame.Start, true, isRange, keys, keyNulls, proc)
+	if err != nil {
+		return err
+	}
+	end, err := ctr.newBoundFunc(ws, frame.End, false, isRange, keys, keyNulls, proc)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		ws.frameStart[i] = start(i)
+		ws.frameEnd[i] = end(i)
+	}
+	return nil
+}
+
+func hasOffset(b *plan.FrameBound) bool {
+	return b != nil && !b.Unbounded && b.Type != plan.FrameBound_CURRENT_ROW
+}
+
+// newBoundFunc returns a function which computes the position of the bound for each position.
+// start bounds are clamped to [partStart, partEnd], end bounds are clamped to [partStart-1, partEnd-1].
+func (ctr *container) newBoundFunc(ws *windowState, b *plan.FrameBound, isStart, isRange bool,
+	keys []float64, keyNulls []bool, proc *process.Process) (func(int) int, error) {
+	clamp := func(i, p int) int {
+		lo, hi := ws.partStart[i], ws.partEnd[i]
+		if !isStart {
+			lo, hi = lo-1, hi-1
+		}
+		if p < lo {
+			return lo
+		}
+		if p > hi {
+			return hi
+		}
+		return p
+	}
+
+	switch {
+	case b.Unbounded && isStart:
+		return func(i int) int { return ws.partStart[i] }, nil
+	case b.Unbounded:
+		return func(i int) int { return ws.partEnd[i] - 1 }, nil
+	case b.Type == plan.FrameBound_CURRENT_ROW && !isRange:
+		return func(i int) int { return i }, nil
+	case b.Type == plan.FrameBound_CURRENT_ROW && isStart:
+		return func(i int) int { return ws.peerStart[i] }, nil
+	case b.Type == plan.FrameBound_CURRENT_ROW:
+		return func(i int) int { return ws.peerEnd[i] - 1 }, nil
+	}
+
+	vec, err := ctr.evalExpr(b.Val, proc)
+	if err != nil {
+		return nil, err
+	}
+	if vec.IsConstNull() || vec.GetNulls().Contains(0) {
+		return nil, moerr.NewInvalidInput(proc.Ctx, "frame offset can not be null")
+	}
+	preceding := b.Type == plan.FrameBound_PRECEDING
+
+	if !isRange {
+		offset := int(vector.GetFixedAt[int64](vec, 0))
+		if preceding {
+			offset = -offset
+		}
+		return func(i int) int { return clamp(i, i+offset) }, nil
+	}
+
+	offset := vector.GetFixedAt[float64](vec, 0)
+	if preceding {
+		offset = -offset
+	}
+	return func(i int) int {
+		// rows with null keys are only peers of each other
+		if keyNulls[i] {
+			if isStart {
+				return ws.peerStart[i]
+			}
+			return ws.peerEnd[i] - 1
+		}
+		lo, hi := ws.partStart[i], ws.partEnd[i]
+		for lo < hi && keyNulls[lo] {
+			lo++
+		}
+		for hi > lo && keyNulls[hi-1] {
+			hi--
+		}
+		target := keys[i] + offset
+		if isStart {
+			// the first position whose key is not less than the target
+			return clamp(i, lo+sort.Search(hi-lo, func(k int) bool { return keys[lo+k] >= target }))
+		}
+		// the last position whose key is not greater than the target
+		return clamp(i, lo+sort.Search(hi-lo, func(k int) bool { return keys[lo+k] > target })-1)
+	}, nil
+}
+
+// orderKeys converts the order key of each position to float64.
+// keys of a descending order are negated so that keys are always ascending in a partition.
+func orderKeys(sels []int64, vec *vector.Vector, desc bool, proc *process.Process) ([]float64, []bool, error) {
+	keys := make([]float64, len(sels))
+	keyNulls := make([]bool, len(sels))
+	for i, row := range sels {
+		if vec.IsConstNull() || vec.GetNulls().Contains(uint64(row)) {
+			keyNulls[i] = true
+			continue
+		}
+		var v float64
+		idx := int(row)
+		switch vec.GetType().Oid {
+		case types.T_int8:
+			v = float64(vector.GetFixedAt[int8](vec, idx))
+		case types.T_int16:
+			v = float64(vector.GetFixedAt[int16](vec, idx))
+		case types.T_int32:
+			v = float64(vector.GetFixedAt[int32](vec, idx))
+		case types.T_int64:
+			v = float64(vector.GetFixedAt[int64](vec, idx))
+		case types.T_uint8:
+			v = float64(vector.GetFixedAt[uint8](vec, idx))
+		case types.T_uint16:
+			v = float64(vector.GetFixedAt[uint16](vec, idx))
+		case types.T_uint32:
+			v = float64(vector.GetFixedAt[uint32](vec, idx))
+		case types.T_uint64:
+			v = float64(vector.GetFixedAt[uint64](vec, idx))
+		case types.T_float32:
+			v = float64(vector.GetFixedAt[float32](vec, idx))
+		case types.T_float64:
+			v = vector.GetFixedAt[float64](vec, idx)
+		default:
+			return nil, nil, moerr.NewNotSupported(proc.Ctx, "RANGE frame with offset on order key of type %s", vec.GetType())
+		}
+		if desc {
+			v = -v
+		}
+		keys[i] = v
+	}
+	return keys, keyNulls, nil
+}
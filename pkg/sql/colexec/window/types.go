@@ -0,0 +1,75 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	Build = iota
+	Eval
+)
+
+type evalVector struct {
+	needFree bool
+	vec      *vector.Vector
+}
+
+type container struct {
+	state int
+
+	// bat stores all the input rows, the windows are computed after the whole input was received.
+	bat *batch.Batch
+
+	// vecs stores the vectors evaluated from the expressions of the current window.
+	vecs []evalVector
+}
+
+type Argument struct {
+	ctr *container
+	// WinSpecList stores the windows to compute,
+	// the result of each window is appended to the input columns in order.
+	WinSpecList []*plan.WindowSpec
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	ctr := arg.ctr
+	if ctr != nil {
+		mp := proc.Mp()
+		ctr.cleanEvalVectors(mp)
+		ctr.cleanBatch(mp)
+	}
+}
+
+func (ctr *container) cleanBatch(mp *mpool.MPool) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(mp)
+		ctr.bat = nil
+	}
+}
+
+func (ctr *container) cleanEvalVectors(mp *mpool.MPool) {
+	for i := range ctr.vecs {
+		if ctr.vecs[i].needFree && ctr.vecs[i].vec != nil {
+			ctr.vecs[i].vec.Free(mp)
+		}
+	}
+	ctr.vecs = ctr.vecs[:0]
+}
@@ -0,0 +1,448 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString("window([")
+	for i, w := range ap.WinSpecList {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(w.String())
+	}
+	buf.WriteString("])")
+}
+
+func Prepare(_ *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	return nil
+}
+
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	for {
+		switch ctr.state {
+		case Build:
+			bat := proc.InputBatch()
+			if bat == nil {
+				ctr.state = Eval
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				return false, nil
+			}
+			anal.Input(bat, isFirst)
+			if err := ctr.build(bat, proc); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			return false, nil
+
+		case Eval:
+			if ctr.bat == nil {
+				proc.SetInputBatch(nil)
+				return true, nil
+			}
+			if err := ctr.eval(ap, proc); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			anal.Output(ctr.bat, isLast)
+			proc.SetInputBatch(ctr.bat)
+			ctr.bat = nil
+			ap.Free(proc, false)
+			return true, nil
+		}
+	}
+}
+
+// build copies the input batch into the container, windows can only be computed with all the rows.
+func (ctr *container) build(bat *batch.Batch, proc *process.Process) error {
+	defer bat.Clean(proc.Mp())
+	proc.SetInputBatch(&batch.Batch{})
+
+	if ctr.bat == nil {
+		ctr.bat = batch.NewWithSize(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.NewVec(*vec.GetType())
+		}
+	}
+
+	flags := make([]uint8, len(bat.Zs))
+	for i := range flags {
+		flags[i] = 1
+	}
+	for i, vec := range ctr.bat.Vecs {
+		if err := vec.UnionBatch(bat.Vecs[i], 0, len(bat.Zs), flags, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	ctr.bat.Zs = append(ctr.bat.Zs, bat.Zs...)
+	return nil
+}
+
+// eval computes the windows one by one and appends their results to the container's batch.
+func (ctr *container) eval(ap *Argument, proc *process.Process) error {
+	for _, w := range ap.WinSpecList {
+		vec, err := ctr.evalWindow(w, proc)
+		ctr.cleanEvalVectors(proc.Mp())
+		if err != nil {
+			return err
+		}
+		ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
+	}
+	return nil
+}
+
+func (ctr *container) evalWindow(w *plan.WindowSpec, proc *process.Process) (*vector.Vector, error) {
+	n := len(ctr.bat.Zs)
+
+	// sort the rows by partition keys and then order keys,
+	// sels[i] is the row of the i-th position after sorting.
+	sels := make([]int64, n)
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+
+	partCmps := make([]compare.Compare, 0, len(w.PartitionBy))
+	for _, expr := range w.PartitionBy {
+		vec, err := ctr.evalExpr(expr, proc)
+		if err != nil {
+			return nil, err
+		}
+		// constant keys change neither the partitions nor the order
+		if vec.IsConst() {
+			continue
+		}
+		cmp := compare.New(*vec.GetType(), false, false)
+		cmp.Set(0, vec)
+		partCmps = append(partCmps, cmp)
+	}
+
+	orderCmps := make([]compare.Compare, 0, len(w.OrderBy))
+	var orderVec *vector.Vector
+	var orderDesc bool
+	for i, f := range w.OrderBy {
+		vec, err := ctr.evalExpr(f.Expr, proc)
+		if err != nil {
+			return nil, err
+		}
+		desc := f.Flag&plan.OrderBySpec_DESC != 0
+		nullsLast := desc
+		if f.Flag&plan.OrderBySpec_NULLS_FIRST != 0 {
+			nullsLast = false
+		} else if f.Flag&plan.OrderBySpec_NULLS_LAST != 0 {
+			nullsLast = true
+		}
+		if i == 0 {
+			orderVec, orderDesc = vec, desc
+		}
+		if vec.IsConst() {
+			continue
+		}
+		cmp := compare.New(*vec.GetType(), desc, nullsLast)
+		cmp.Set(0, vec)
+		orderCmps = append(orderCmps, cmp)
+	}
+
+	if len(partCmps) > 0 || len(orderCmps) > 0 {
+		sort.SliceStable(sels, func(i, j int) bool {
+			if r := compareRows(partCmps, sels[i], sels[j]); r != 0 {
+				return r < 0
+			}
+			return compareRows(orderCmps, sels[i], sels[j]) < 0
+		})
+	}
+
+	ws := &windowState{
+		sels:      sels,
+		partStart: make([]int, n),
+		partEnd:   make([]int, n),
+		peerStart: make([]int, n),
+		peerEnd:   make([]int, n),
+	}
+	for i := 0; i < n; i++ {
+		if i == 0 || compareRows(partCmps, sels[i-1], sels[i]) != 0 {
+			ws.partStart[i] = i
+			ws.peerStart[i] = i
+		} else {
+			ws.partStart[i] = ws.partStart[i-1]
+			if compareRows(orderCmps, sels[i-1], sels[i]) != 0 {
+				ws.peerStart[i] = i
+			} else {
+				ws.peerStart[i] = ws.peerStart[i-1]
+			}
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		if i == n-1 || ws.partStart[i+1] != ws.partStart[i] {
+			ws.partEnd[i] = i + 1
+		} else {
+			ws.partEnd[i] = ws.partEnd[i+1]
+		}
+		if i == n-1 || ws.peerStart[i+1] != ws.peerStart[i] {
+			ws.peerEnd[i] = i + 1
+		} else {
+			ws.peerEnd[i] = ws.peerEnd[i+1]
+		}
+	}
+
+	f, ok := w.WindowFunc.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil, moerr.NewInternalError(proc.Ctx, "window function expected, but got %v", w.WindowFunc)
+	}
+	overloadID := int64(uint64(f.F.Func.Obj) & function.DistinctMask)
+	fid, _ := function.DecodeOverloadID(overloadID)
+	typ := types.New(types.T(w.WindowFunc.Typ.Id), w.WindowFunc.Typ.Width, w.WindowFunc.Typ.Scale)
+
+	switch fid {
+	case function.ROW_NUMBER, function.RANK, function.DENSE_RANK:
+		return ws.rank(fid, typ, proc)
+
+	case function.LAG, function.LEAD:
+		return ctr.evalOffsetWindow(ws, f.F, fid == function.LAG, typ, proc)
+
+	case function.FIRST_VALUE, function.LAST_VALUE:
+		if err := ctr.initFrame(ws, w.Frame, orderVec, orderDesc, proc); err != nil {
+			return nil, err
+		}
+		return ctr.evalValueWindow(ws, f.F, fid == function.FIRST_VALUE, typ, proc)
+
+	default:
+		fn, err := function.GetFunctionByID(proc.Ctx, overloadID)
+		if err != nil {
+			return nil, err
+		}
+		if !fn.IsAggregate() {
+			return nil, moerr.NewNotSupported(proc.Ctx, "window function %s", f.F.Func.ObjName)
+		}
+		if err := ctr.initFrame(ws, w.Frame, orderVec, orderDesc, proc); err != nil {
+			return nil, err
+		}
+		return ctr.evalAggWindow(ws, f.F, fn.AggregateInfo, proc)
+	}
+}
+
+func compareRows(cmps []compare.Compare, i, j int64) int {
+	for _, cmp := range cmps {
+		if r := cmp.Compare(0, 0, i, j); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// evalExpr evaluates the expression on the container's batch,
+// and records the result vector so that it can be freed after the window is computed.
+func (ctr *container) evalExpr(expr *plan.Expr, proc *process.Process) (*vector.Vector, error) {
+	vec, err := colexec.EvalExpr(ctr.bat, proc, expr)
+	if err != nil {
+		return nil, err
+	}
+	needFree := true
+	for i := range ctr.bat.Vecs {
+		if ctr.bat.Vecs[i] == vec {
+			needFree = false
+			break
+		}
+	}
+	ctr.vecs = append(ctr.vecs, evalVector{needFree: needFree, vec: vec})
+	return vec, nil
+}
+
+// evalFullExpr is like evalExpr, but a constant result is expanded
+// so that the result can be read by row index.
+func (ctr *container) evalFullExpr(expr *plan.Expr, proc *process.Process) (*vector.Vector, error) {
+	vec, err := ctr.evalExpr(expr, proc)
+	if err != nil {
+		return nil, err
+	}
+	if !vec.IsConst() {
+		return vec, nil
+	}
+	full := vector.NewVec(*vec.GetType())
+	ctr.vecs = append(ctr.vecs, evalVector{needFree: true, vec: full})
+	if err := full.UnionMulti(vec, 0, len(ctr.bat.Zs), proc.Mp()); err != nil {
+		return nil, err
+	}
+	return full, nil
+}
+
+// evalOffsetWindow computes lag(expr, offset, default) and lead(expr, offset, default).
+func (ctr *container) evalOffsetWindow(ws *windowState, f *plan.Function, isLag bool, typ types.Type, proc *process.Process) (*vector.Vector, error) {
+	n := len(ws.sels)
+	vec, err := ctr.evalFullExpr(f.Args[0], proc)
+	if err != nil {
+		return nil, err
+	}
+	var offsetVec, defaultVec *vector.Vector
+	if len(f.Args) > 1 {
+		if offsetVec, err = ctr.evalFullExpr(f.Args[1], proc); err != nil {
+			return nil, err
+		}
+	}
+	if len(f.Args) > 2 {
+		if defaultVec, err = ctr.evalFullExpr(f.Args[2], proc); err != nil {
+			return nil, err
+		}
+	}
+
+	// src[row] is the row whose value is taken, or -1 to take the default
+	src := make([]int64, n)
+	for i, row := range ws.sels {
+		offset := int64(1)
+		if offsetVec != nil {
+			if offsetVec.GetNulls().Contains(uint64(row)) {
+				src[row] = -1
+				continue
+			}
+			offset = vector.GetFixedAt[int64](offsetVec, int(row))
+			if offset < 0 {
+				return nil, moerr.NewInvalidInput(proc.Ctx, "offset of %s should be non-negative", f.Func.ObjName)
+			}
+		}
+		if !isLag {
+			offset = -offset
+		}
+		pos := int64(i) - offset
+		if pos >= int64(ws.partStart[i]) && pos < int64(ws.partEnd[i]) {
+			src[row] = ws.sels[pos]
+		} else {
+			src[row] = -1
+		}
+	}
+
+	return buildResult(typ, src, vec, defaultVec, proc)
+}
+
+// evalValueWindow computes first_value(expr) and last_value(expr) over the frame.
+func (ctr *container) evalValueWindow(ws *windowState, f *plan.Function, isFirst bool, typ types.Type, proc *process.Process) (*vector.Vector, error) {
+	vec, err := ctr.evalFullExpr(f.Args[0], proc)
+	if err != nil {
+		return nil, err
+	}
+
+	src := make([]int64, len(ws.sels))
+	for i, row := range ws.sels {
+		start, end := ws.frameOf(i)
+		switch {
+		case start > end:
+			src[row] = -1
+		case isFirst:
+			src[row] = ws.sels[start]
+		default:
+			src[row] = ws.sels[end]
+		}
+	}
+
+	return buildResult(typ, src, vec, nil, proc)
+}
+
+// evalAggWindow computes an aggregate function over the frame, every row is a group of the aggregate.
+func (ctr *container) evalAggWindow(ws *windowState, f *plan.Function, op int, proc *process.Process) (*vector.Vector, error) {
+	vec, err := ctr.evalFullExpr(f.Args[0], proc)
+	if err != nil {
+		return nil, err
+	}
+	vecs := []*vector.Vector{vec}
+
+	ag, err := agg.New(op, false, *vec.GetType())
+	if err != nil {
+		return nil, err
+	}
+	if err = ag.Grows(len(ws.sels), proc.Mp()); err != nil {
+		ag.Free(proc.Mp())
+		return nil, err
+	}
+
+	fill := func(group int64, start, end int) error {
+		for k := start; k <= end; k++ {
+			if err := ag.Fill(group, ws.sels[k], 1, vecs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// if the frame shares its start with the previous row's frame and only grows,
+	// the previous result is merged and only the new rows are filled.
+	prevStart, prevEnd, prevRow := -1, -1, int64(-1)
+	for i, row := range ws.sels {
+		start, end := ws.frameOf(i)
+		if start > end {
+			continue
+		}
+		if start == prevStart && end >= prevEnd {
+			err = ag.Merge(ag, row, prevRow)
+			if err == nil {
+				err = fill(row, prevEnd+1, end)
+			}
+		} else {
+			err = fill(row, start, end)
+		}
+		if err != nil {
+			ag.Free(proc.Mp())
+			return nil, err
+		}
+		prevStart, prevEnd, prevRow = start, end, row
+	}
+
+	return ag.Eval(proc.Mp())
+}
+
+// buildResult builds the result vector in the original row order,
+// src[row] is the row of vec to take, -1 means the row of defaultVec or null if there is no default.
+func buildResult(typ types.Type, src []int64, vec, defaultVec *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	rvec := vector.NewVec(typ)
+	var err error
+	for row, sel := range src {
+		switch {
+		case sel >= 0:
+			err = rvec.UnionOne(vec, sel, proc.Mp())
+		case defaultVec != nil:
+			err = rvec.UnionOne(defaultVec, int64(row), proc.Mp())
+		default:
+			err = rvec.UnionNull(proc.Mp())
+		}
+		if err != nil {
+			rvec.Free(proc.Mp())
+			return nil, err
+		}
+	}
+	return rvec, nil
+}
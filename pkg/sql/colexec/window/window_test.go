@@ -0,0 +1,190 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type windowTestCase struct {
+	arg  *Argument
+	proc *process.Process
+	// expected result of the window, nil means null
+	want []any
+}
+
+var (
+	tcs []windowTestCase
+)
+
+func init() {
+	partition := []*plan.Expr{newExpression(0)}
+	order := []*plan.OrderBySpec{{Expr: newExpression(1), Flag: plan.OrderBySpec_ASC}}
+	tcs = []windowTestCase{
+		newTestCase(mpool.MustNewZero(), &plan.WindowSpec{
+			WindowFunc:  newFunction("row_number"),
+			PartitionBy: partition,
+			OrderBy:     order,
+		}, []any{int64(1), int64(2), int64(1), int64(3), int64(2)}),
+		newTestCase(mpool.MustNewZero(), &plan.WindowSpec{
+			WindowFunc:  newFunction("lag", newExpression(1)),
+			PartitionBy: partition,
+			OrderBy:     order,
+		}, []any{nil, int64(10), nil, int64(20), int64(30)}),
+		newTestCase(mpool.MustNewZero(), &plan.WindowSpec{
+			WindowFunc:  newFunction("sum", newExpression(1)),
+			PartitionBy: partition,
+			OrderBy:     order,
+			Frame: &plan.FrameClause{
+				Type:  plan.FrameClause_ROWS,
+				Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Unbounded: true},
+				End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+			},
+		}, []any{int64(10), int64(30), int64(30), int64(70), int64(80)}),
+		newTestCase(mpool.MustNewZero(), &plan.WindowSpec{
+			WindowFunc: newFunction("first_value", newExpression(1)),
+			OrderBy:    []*plan.OrderBySpec{{Expr: newExpression(1), Flag: plan.OrderBySpec_DESC}},
+			Frame: &plan.FrameClause{
+				Type:  plan.FrameClause_ROWS,
+				Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newInt64(1)},
+				End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+			},
+		}, []any{int64(20), int64(30), int64(40), int64(50), int64(50)}),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = newBatch(tc.proc, []int64{1, 1, 2}, []int64{10, 20, 30})
+		_, err = Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = &batch.Batch{}
+		_, err = Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = newBatch(tc.proc, []int64{1, 2}, []int64{40, 50})
+		_, err = Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = nil
+		end, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		require.True(t, end)
+
+		bat := tc.proc.Reg.InputBatch
+		require.Equal(t, 3, len(bat.Vecs))
+		require.Equal(t, 5, bat.Length())
+		rvec := bat.Vecs[2]
+		for i, want := range tc.want {
+			if want == nil {
+				require.True(t, rvec.GetNulls().Contains(uint64(i)))
+				continue
+			}
+			require.False(t, rvec.GetNulls().Contains(uint64(i)))
+			require.Equal(t, want, vector.GetFixedAt[int64](rvec, i))
+		}
+		bat.Clean(tc.proc.Mp())
+		tc.proc.Reg.InputBatch = nil
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
+func newTestCase(m *mpool.MPool, w *plan.WindowSpec, want []any) windowTestCase {
+	return windowTestCase{
+		proc: testutil.NewProcessWithMPool(m),
+		arg: &Argument{
+			WinSpecList: []*plan.WindowSpec{w},
+		},
+		want: want,
+	}
+}
+
+func newExpression(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+	}
+}
+
+func newInt64(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{
+				Value: &plan.Const_I64Val{I64Val: v},
+			},
+		},
+	}
+}
+
+func newFunction(name string, args ...*plan.Expr) *plan.Expr {
+	argTypes := make([]types.Type, len(args))
+	for i := range args {
+		argTypes[i] = types.T_int64.ToType()
+	}
+	overloadID, retType, _, err := function.GetFunctionByName(context.TODO(), name, argTypes)
+	if err != nil {
+		panic(err)
+	}
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(retType.Oid)},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: overloadID, ObjName: name},
+				Args: args,
+			},
+		},
+	}
+}
+
+// newBatch creates a batch with a partition column and a value column
+func newBatch(proc *process.Process, ps []int64, vs []int64) *batch.Batch {
+	typ := types.T_int64.ToType()
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(len(ps), typ, proc.Mp(), false, ps),
+		testutil.NewInt64Vector(len(vs), typ, proc.Mp(), false, vs),
+	}, nil)
+}
@@ -69,6 +69,8 @@ func New(addr, db string, sql string, uid string, ctx context.Context,
 		proc: proc,
 		stmt: stmt,
 		addr: addr,
+
+		runtimeFilters: colexec.NewRuntimeFilterRegistry(),
 	}
 }
 
@@ -117,8 +119,10 @@ func (c *Compile) Compile(ctx context.Context, pn *plan.Plan, u any, fill func(a
 	// generate logic pipeline for query.
 	c.scope, err = c.compileScope(ctx, pn)
 	if err != nil {
+		c.closeSnapshotTxns()
 		return err
 	}
+	expectRuntimeFilters(c.scope, c.runtimeFilters)
 	if len(c.scope.NodeInfo.Addr) == 0 {
 		c.scope.NodeInfo.Addr = c.addr
 	}
@@ -141,6 +145,7 @@ func (c *Compile) Run(_ uint64) (err error) {
 	defer func() {
 		// free pipeline
 		c.scope = nil
+		c.closeSnapshotTxns()
 	}()
 
 	switch c.scope.Magic {
@@ -426,8 +431,9 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 			rs.Instructions = append(rs.Instructions, vm.Instruction{
 				Op: vm.MergeBlock,
 				Arg: &mergeblock.Argument{
-					Tbl:         arg.InsertCtx.Source,
-					Unique_tbls: arg.InsertCtx.UniqueSource,
+					Tbl:            arg.InsertCtx.Source,
+					Unique_tbls:    arg.InsertCtx.UniqueSource,
+					Secondary_tbls: arg.InsertCtx.SecondaryIndexSource,
 				},
 			})
 		} else {
@@ -481,6 +487,34 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 	return rs, nil
 }
 
+// appendBatch copies all the rows of src to the end of dst.
+func appendBatch(dst, src *batch.Batch, proc *process.Process) error {
+	flags := make([]uint8, len(src.Zs))
+	for i := range flags {
+		flags[i] = 1
+	}
+	for i, vec := range dst.Vecs {
+		if err := vec.UnionBatch(src.Vecs[i], 0, len(src.Zs), flags, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	dst.Zs = append(dst.Zs, src.Zs...)
+	return nil
+}
+
+// dupBatch returns a copy of bat, the vectors of the copy are allocated from the mpool of proc.
+func dupBatch(bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.NewVec(*vec.GetType())
+	}
+	if err := appendBatch(rbat, bat, proc); err != nil {
+		rbat.Clean(proc.Mp())
+		return nil, err
+	}
+	return rbat, nil
+}
+
 func constructValueScanBatch(ctx context.Context, proc *process.Process, node *plan.Node) (*batch.Batch, error) {
 	if node == nil || node.TableDef == nil { // like : select 1, 2
 		bat := batch.NewWithSize(1)
@@ -517,6 +551,19 @@ func (c *Compile) compilePlanScope(ctx context.Context, n *plan.Node, ns []*plan
 		ds.DataSource = &Source{Bat: bat}
 		ds.NodeInfo = engine.Node{Addr: c.addr, Mcpu: 1}
 		return c.compileSort(n, c.compileProjection(n, []*Scope{ds})), nil
+	case plan.Node_SINK_SCAN:
+		if c.cteWorkingBatch == nil {
+			return nil, moerr.NewInternalError(ctx, "sink scan is only allowed in the recursive part of a recursive CTE")
+		}
+		ds := &Scope{Magic: Normal}
+		ds.Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
+		bat, err := dupBatch(c.cteWorkingBatch, c.proc)
+		if err != nil {
+			return nil, err
+		}
+		ds.DataSource = &Source{Bat: bat}
+		ds.NodeInfo = engine.Node{Addr: c.addr, Mcpu: 1}
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, []*Scope{ds}))), nil
 	case plan.Node_EXTERNAL_SCAN:
 		node := plan2.DeepCopyNode(n)
 		ss, err := c.compileExternScan(ctx, node)
@@ -563,6 +610,16 @@ func (c *Compile) compilePlanScope(ctx context.Context, n *plan.Node, ns []*plan
 			ss = c.compileGroup(n, ss, ns)
 		}
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+	case plan.Node_WINDOW:
+		curr := c.anal.curr
+		c.SetAnalyzeCurrent(nil, int(n.Children[0]))
+		ss, err := c.compilePlanScope(ctx, ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.SetAnalyzeCurrent(ss, curr)
+		ss = c.compileWindow(n, ss)
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_JOIN:
 
 		curr := c.anal.curr
@@ -603,6 +660,15 @@ func (c *Compile) compilePlanScope(ctx context.Context, n *plan.Node, ns []*plan
 		}
 		c.SetAnalyzeCurrent(children, curr)
 		return c.compileSort(n, c.compileUnion(n, ss, children, ns)), nil
+	case plan.Node_RECURSIVE_CTE:
+		curr := c.anal.curr
+		c.SetAnalyzeCurrent(nil, int(n.Children[0]))
+		ss, err := c.compilePlanScope(ctx, ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.SetAnalyzeCurrent(ss, curr)
+		return c.compileSort(n, c.compileRecursiveCte(ctx, n, ns, ss)), nil
 	case plan.Node_MINUS, plan.Node_INTERSECT, plan.Node_INTERSECT_ALL:
 		curr := c.anal.curr
 		c.SetAnalyzeCurrent(nil, int(n.Children[0]))
@@ -716,6 +782,12 @@ func (c *Compile) compileExternScan(ctx context.Context, n *plan.Node) ([]*Scope
 		}
 	}
 
+	// a parquet file can not be split by lines, it is read by row groups
+	// in a single scope and the files are distributed among the scopes.
+	if param.Format == tree.PARQUET {
+		param.Parallel = false
+	}
+
 	if n.ObjRef != nil {
 		param.SysTable = external.IsSysTable(n.ObjRef.SchemaName, n.TableDef.Name)
 	}
@@ -811,18 +883,25 @@ func (c *Compile) compileTableFunction(n *plan.Node, ss []*Scope) ([]*Scope, err
 }
 
 func (c *Compile) compileTableScan(n *plan.Node) ([]*Scope, error) {
-	nodes, err := c.generateNodes(n)
+	txnOp := c.proc.TxnOperator
+	if n.SnapshotTs != nil {
+		var err error
+		if txnOp, err = c.snapshotTxn(*n.SnapshotTs); err != nil {
+			return nil, err
+		}
+	}
+	nodes, err := c.generateNodes(n, txnOp)
 	if err != nil {
 		return nil, err
 	}
 	ss := make([]*Scope, 0, len(nodes))
 	for i := range nodes {
-		ss = append(ss, c.compileTableScanWithNode(n, nodes[i]))
+		ss = append(ss, c.compileTableScanWithNode(n, nodes[i], txnOp))
 	}
 	return ss, nil
 }
 
-func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scope {
+func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node, txnOp TxnOperator) *Scope {
 	var err error
 	var s *Scope
 	var tblDef *plan.TableDef
@@ -834,8 +913,8 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 	for j, col := range n.TableDef.Cols {
 		attrs[j] = col.Name
 	}
-	if c.proc != nil && c.proc.TxnOperator != nil {
-		ts = c.proc.TxnOperator.Txn().SnapshotTS
+	if txnOp != nil {
+		ts = txnOp.Txn().SnapshotTS
 	}
 	{
 		var cols []*plan.ColDef
@@ -843,14 +922,14 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 		if util.TableIsClusterTable(n.TableDef.GetTableType()) {
 			ctx = context.WithValue(ctx, defines.TenantIDKey{}, catalog.System_Account)
 		}
-		db, err = c.e.Database(ctx, n.ObjRef.SchemaName, c.proc.TxnOperator)
+		db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
 		if err != nil {
 			panic(err)
 		}
 		rel, err = db.Relation(ctx, n.TableDef.Name)
 		if err != nil {
 			var e error // avoid contamination of error messages
-			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
+			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, txnOp)
 			if e != nil {
 				panic(e)
 			}
@@ -871,16 +950,20 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 				cols = append(cols, &plan.ColDef{
 					Name: attr.Attr.Name,
 					Typ: &plan.Type{
-						Id:       int32(attr.Attr.Type.Oid),
-						Width:    attr.Attr.Type.Width,
-						Scale:    attr.Attr.Type.Scale,
-						AutoIncr: attr.Attr.AutoIncrement,
+						Id:         int32(attr.Attr.Type.Oid),
+						Width:      attr.Attr.Type.Width,
+						Scale:      attr.Attr.Type.Scale,
+						AutoIncr:   attr.Attr.AutoIncrement,
+						Enumvalues: attr.Attr.EnumValues,
 					},
-					Primary:   attr.Attr.Primary,
-					Default:   attr.Attr.Default,
-					OnUpdate:  attr.Attr.OnUpdate,
-					Comment:   attr.Attr.Comment,
-					ClusterBy: attr.Attr.ClusterBy,
+					Primary:       attr.Attr.Primary,
+					Default:       attr.Attr.Default,
+					OnUpdate:      attr.Attr.OnUpdate,
+					Comment:       attr.Attr.Comment,
+					ClusterBy:     attr.Attr.ClusterBy,
+					Generated:     attr.Attr.Generated,
+					Alg:           plan.CompressType(attr.Attr.Alg),
+					CompressLevel: attr.Attr.CompressLevel,
 				})
 				i++
 			}
@@ -897,11 +980,14 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 		NodeInfo: node,
 		DataSource: &Source{
 			Timestamp:    ts,
+			TxnOperator:  txnOp,
 			Attributes:   attrs,
 			TableDef:     tblDef,
 			RelationName: n.TableDef.Name,
 			SchemaName:   n.ObjRef.SchemaName,
 			Expr:         colexec.RewriteFilterExprList(n.FilterList),
+
+			RuntimeFilterSpecs: n.RuntimeFilterProbeList,
 		},
 	}
 	s.Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
@@ -1003,7 +1089,8 @@ func (c *Compile) compileUnionAll(n *plan.Node, ss []*Scope, children []*Scope)
 
 func (c *Compile) compileJoin(ctx context.Context, n, left, right *plan.Node, ss []*Scope, children []*Scope) []*Scope {
 	var rs []*Scope
-	isEq := plan2.IsEquiJoin(n.OnList)
+	// the hint NO_HASH_JOIN makes the equi join a nested loop join
+	isEq := plan2.IsEquiJoin(n.OnList) && !n.NestedLoopJoin
 
 	right_typs := make([]types.Type, len(right.ProjectList))
 	for i, expr := range right.ProjectList {
@@ -1250,6 +1337,84 @@ func (c *Compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
+// compileWindow merges all the scopes into one, because a window needs all the rows of its partitions.
+func (c *Compile) compileWindow(n *plan.Node, ss []*Scope) []*Scope {
+	rs := c.newMergeScope(ss)
+	rs.appendInstruction(vm.Instruction{
+		Op:      vm.Window,
+		Idx:     c.anal.curr,
+		IsFirst: c.anal.isFirst,
+		Arg:     constructWindow(n),
+	})
+	c.anal.isFirst = false
+	return []*Scope{rs}
+}
+
+// compileRecursiveCte merges the scopes of the non-recursive part into one,
+// the recursive part is compiled and run by the operator in each iteration.
+func (c *Compile) compileRecursiveCte(ctx context.Context, n *plan.Node, ns []*plan.Node, ss []*Scope) []*Scope {
+	rs := c.newMergeScope(ss)
+	rs.appendInstruction(vm.Instruction{
+		Op:      vm.RecursiveCte,
+		Idx:     c.anal.curr,
+		IsFirst: c.anal.isFirst,
+		Arg: constructRecursiveCte(n, func(working *batch.Batch, _ *process.Process) (*batch.Batch, error) {
+			return c.runRecursivePart(ctx, ns, n.Children[1], working)
+		}),
+	})
+	c.anal.isFirst = false
+	return []*Scope{rs}
+}
+
+// runRecursivePart runs the recursive part of a recursive CTE once,
+// the SINK_SCAN of the recursive part reads the rows of working.
+func (c *Compile) runRecursivePart(ctx context.Context, ns []*plan.Node, step int32, working *batch.Batch) (*batch.Batch, error) {
+	var rbat *batch.Batch
+	fill := func(_ any, bat *batch.Batch) error {
+		// a nil batch means the end of the result
+		if bat == nil {
+			return nil
+		}
+		if rbat == nil {
+			var err error
+			rbat, err = dupBatch(bat, c.proc)
+			return err
+		}
+		return appendBatch(rbat, bat, c.proc)
+	}
+
+	rc := New(c.addr, c.db, c.sql, c.uid, c.ctx, c.e, c.proc, c.stmt)
+	rc.info = c.info
+	rc.cnList = engine.Nodes{engine.Node{Addr: c.addr, Mcpu: c.NumCPU()}}
+	rc.anal = &anaylze{
+		qry:       c.anal.qry,
+		analInfos: c.anal.analInfos,
+		curr:      int(step),
+	}
+	rc.cteWorkingBatch = working
+
+	ss, err := rc.compilePlanScope(ctx, ns[step], ns)
+	if err != nil {
+		return nil, err
+	}
+	rs := rc.newMergeScope(ss)
+	updateScopesLastFlag([]*Scope{rs})
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op: vm.Output,
+		Arg: &output.Argument{
+			Func: fill,
+		},
+	})
+	rc.scope = rs
+	if err = rc.Run(0); err != nil {
+		if rbat != nil {
+			rbat.Clean(c.proc.Mp())
+		}
+		return nil, err
+	}
+	return rbat, nil
+}
+
 func (c *Compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	currentFirstFlag := c.anal.isFirst
 	for i := range ss {
@@ -1582,7 +1747,7 @@ func (c *Compile) newRightScope(s *Scope, ss []*Scope) *Scope {
 		Op:      vm.HashBuild,
 		Idx:     s.Instructions[0].Idx,
 		IsFirst: true,
-		Arg:     constructHashBuild(s.Instructions[0], c.proc),
+		Arg:     constructHashBuild(s.Instructions[0], c.runtimeFilters, c.proc),
 	})
 	rs.appendInstruction(vm.Instruction{
 		Op:  vm.Dispatch,
@@ -1667,7 +1832,7 @@ func (c *Compile) fillAnalyzeInfo() {
 	}
 }
 
-func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
+func (c *Compile) generateNodes(n *plan.Node, txnOp TxnOperator) (engine.Nodes, error) {
 	var err error
 	var db engine.Database
 	var rel engine.Relation
@@ -1678,14 +1843,14 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 	if util.TableIsClusterTable(n.TableDef.GetTableType()) {
 		ctx = context.WithValue(ctx, defines.TenantIDKey{}, catalog.System_Account)
 	}
-	db, err = c.e.Database(ctx, n.ObjRef.SchemaName, c.proc.TxnOperator)
+	db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
 	if err != nil {
 		return nil, err
 	}
 	rel, err = db.Relation(ctx, n.TableDef.Name)
 	if err != nil {
 		var e error // avoid contamination of error messages
-		db, e = c.e.Database(ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
+		db, e = c.e.Database(ctx, defines.TEMPORARY_DBNAME, txnOp)
 		if e != nil {
 			return nil, err
 		}
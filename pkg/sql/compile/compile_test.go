@@ -16,12 +16,19 @@ package compile
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
@@ -64,6 +71,12 @@ func init() {
 		//newTestCase("insert into R select * from R", new(testing.T)),
 		newTestCase("select count(*) from R group by uid", new(testing.T)),
 		newTestCase("select count(distinct uid) from R", new(testing.T)),
+		newTestCase("select uid, row_number() over (partition by uid order by price) from R", new(testing.T)),
+		newTestCase("select uid, sum(price), grouping(uid) from R group by rollup(uid)", new(testing.T)),
+		newTestCase("select uid, orderid, count(*) from R group by grouping sets ((uid, orderid), (uid), ())", new(testing.T)),
+		newTestCase("with recursive c(n) as (select 1 union all select n + 1 from c where n < 5) select * from c", new(testing.T)),
+		newTestCase("with recursive c as (select uid from R union select R.uid from R join c on R.uid = c.uid) select count(*) from c", new(testing.T)),
+		newTestCase("alter table R add index idx1 (uid)", new(testing.T)),
 	}
 }
 
@@ -93,6 +106,128 @@ func TestCompile(t *testing.T) {
 	}
 }
 
+func TestCheckSnapshotRetention(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Now()
+	old := timestamp.Timestamp{PhysicalTime: now.Add(-2 * time.Hour).UnixNano()}
+	recent := timestamp.Timestamp{PhysicalTime: now.Add(-time.Minute).UnixNano()}
+
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	// no retention
+	require.NoError(t, checkSnapshotRetention(ctx, old, now))
+
+	runtime.ProcessLevelRuntime().SetGlobalVariables(runtime.SnapshotRetention, time.Hour)
+	require.NoError(t, checkSnapshotRetention(ctx, recent, now))
+	err := checkSnapshotRetention(ctx, old, now)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSnapshotTooOld))
+}
+
+func TestSnapshotTableScan(t *testing.T) {
+	ctx := context.TODO()
+	e, txnClient, txnOp, compilerCtx := testengine.NewUnbound(ctx)
+	proc := testutil.NewProcess()
+	proc.TxnClient = txnClient
+	proc.TxnOperator = txnOp
+
+	count := func(sql string, reopen bool) int {
+		stmt, err := mysql.ParseOne(ctx, sql, 1)
+		require.NoError(t, err)
+		pn, err := plan2.BuildPlan(compilerCtx, stmt)
+		require.NoError(t, err)
+		rows := 0
+		c := New("test", "test", sql, "", ctx, e, proc, stmt)
+		require.NoError(t, c.Compile(ctx, pn, nil, func(_ any, bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.Length()
+			}
+			return nil
+		}))
+		if reopen {
+			// the relation of the scan is opened again when the scope is run, like a scope
+			// which is not compiled on this CN
+			var visit func(ss []*Scope)
+			visit = func(ss []*Scope) {
+				for _, s := range ss {
+					if s.DataSource != nil {
+						s.NodeInfo.Rel = nil
+					}
+					visit(s.PreScopes)
+				}
+			}
+			visit([]*Scope{c.scope})
+		}
+		require.NoError(t, c.Run(0))
+		return rows
+	}
+
+	// the rows of r are deleted by the txn of the query after the snapshot
+	rows := count("select * from r", false)
+	require.NotZero(t, rows)
+	snapshot, _ := runtime.ProcessLevelRuntime().Clock().Now()
+	db, err := e.Database(ctx, "test", txnOp)
+	require.NoError(t, err)
+	rel, err := db.Relation(ctx, "r")
+	require.NoError(t, err)
+	ranges, err := rel.Ranges(ctx, nil)
+	require.NoError(t, err)
+	rds, err := rel.NewReader(ctx, 1, nil, ranges)
+	require.NoError(t, err)
+	for {
+		bat, err := rds[0].Read(ctx, []string{catalog.Row_ID}, nil, proc.Mp())
+		require.NoError(t, err)
+		if bat == nil {
+			break
+		}
+		require.NoError(t, rel.Delete(ctx, bat, catalog.Row_ID))
+	}
+	require.NoError(t, rds[0].Close())
+	require.Equal(t, 0, count("select * from r", false))
+	asOf := fmt.Sprintf("select * from r as of ts '%d-%d'", snapshot.PhysicalTime, snapshot.LogicalTime)
+	require.Equal(t, rows, count(asOf, false))
+	require.Equal(t, rows, count(asOf, true))
+}
+
+func TestAlterTableColumns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+	c := &Compile{ctx: ctx}
+	s := &Scope{}
+	tableDef := &plan.TableDef{Name: "t"}
+	intTyp := &plan.Type{Id: int32(types.T_int32)}
+
+	rel := mock_frontend.NewMockRelation(ctrl)
+	rel.EXPECT().TableDefs(gomock.Any()).Return([]engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.T_int32.ToType()}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: types.T_int32.ToType()}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: catalog.Row_ID, Type: types.T_Rowid.ToType()}},
+		&engine.CommentDef{Comment: "comment"},
+	}, nil)
+	rel.EXPECT().AlterColumns(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, defs []engine.TableDef) error {
+		var names []string
+		for _, def := range defs {
+			names = append(names, def.(*engine.AttributeDef).Attr.Name)
+		}
+		require.Equal(t, []string{"a", "b2", "c"}, names)
+		return nil
+	})
+	db := mock_frontend.NewMockDatabase(ctrl)
+	db.EXPECT().Relation(gomock.Any(), "t").Return(rel, nil)
+
+	// rename b and append c, the table is changed in place
+	err := s.alterTableColumns(c, db, tableDef, &plan.AlterTableColumns{
+		Cols:    []*plan.ColDef{{Name: "a", Typ: intTyp}, {Name: "b2", Typ: intTyp}, {Name: "c", Typ: intTyp}},
+		InPlace: true,
+	})
+	require.NoError(t, err)
+
+	// the other changes would re-create the table
+	err = s.alterTableColumns(c, db, tableDef, &plan.AlterTableColumns{
+		Cols: []*plan.ColDef{{Name: "b", Typ: intTyp}, {Name: "a", Typ: intTyp}},
+	})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+}
+
 func TestCompileWithFaults(t *testing.T) {
 	// Enable this line to trigger the Hung.
 	// fault.Enable()
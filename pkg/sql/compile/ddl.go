@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -30,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -111,7 +113,10 @@ func (s *Scope) AlterView(c *Compile) error {
 
 func (s *Scope) AlterTable(c *Compile) error {
 	qry := s.Plan.GetDdl().GetAlterTable()
-	dbName := c.db
+	dbName := qry.GetDatabase()
+	if dbName == "" {
+		dbName = c.db
+	}
 	dbSource, err := c.e.Database(c.ctx, dbName, c.proc.TxnOperator)
 	if err != nil {
 		return err
@@ -184,6 +189,8 @@ func (s *Scope) AlterTable(c *Compile) error {
 			newCt.Cts = append(newCt.Cts, t)
 		case *engine.IndexDef:
 			newCt.Cts = append(newCt.Cts, t)
+		case *engine.CheckDef:
+			newCt.Cts = append(newCt.Cts, t)
 		}
 	}
 	if !originHasFkDef {
@@ -191,9 +198,11 @@ func (s *Scope) AlterTable(c *Compile) error {
 			Fkeys: newFkeys,
 		})
 	}
-	err = rel.UpdateConstraint(c.ctx, newCt)
-	if err != nil {
-		return err
+	if len(removeRefChildTbls) > 0 || len(addRefChildTbls) > 0 {
+		err = rel.UpdateConstraint(c.ctx, newCt)
+		if err != nil {
+			return err
+		}
 	}
 
 	// remove refChildTbls for drop foreign key clause
@@ -216,9 +225,76 @@ func (s *Scope) AlterTable(c *Compile) error {
 		}
 	}
 
+	// the columns are changed before the indexes are added
+	for _, action := range qry.Actions {
+		if act, ok := action.Action.(*plan.AlterTable_Action_Columns); ok {
+			if err := s.alterTableColumns(c, dbSource, qry.TableDef, act.Columns); err != nil {
+				return err
+			}
+		}
+	}
+	for _, action := range qry.Actions {
+		if act, ok := action.Action.(*plan.AlterTable_Action_AddIndex); ok {
+			if err := s.createIndex(c, act.AddIndex); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+// alterTableColumns changes the columns of the table.
+//
+// Only the changes which rename the columns or append the columns with a constant default
+// are supported: the engine keeps a new version of the table schema and the blocks written
+// with the old one are read with the defaults of the new columns, so no rows are copied and
+// the table keeps its id, privileges, comment and auto_increment state. The other changes
+// would re-create the table, so they are rejected.
+func (s *Scope) alterTableColumns(c *Compile, dbSource engine.Database, tableDef *plan.TableDef, qry *plan.AlterTableColumns) error {
+	tblName := tableDef.Name
+	if !qry.InPlace {
+		return moerr.NewNotSupported(c.ctx, "change the columns of table '%s' other than renaming them or appending the columns with a constant default", tblName)
+	}
+	rel, err := dbSource.Relation(c.ctx, tblName)
+	if err != nil {
+		return err
+	}
+	return alterTableColumnsInPlace(c, rel, tblName, qry)
+}
+
+// alterTableColumnsInPlace changes the schema of the table without copying the rows.
+// The columns keep their positions in the table: the visible ones are renamed by the first
+// columns of qry, the hidden ones are kept as they are, and the rest of qry is appended.
+func alterTableColumnsInPlace(c *Compile, rel engine.Relation, tblName string, qry *plan.AlterTableColumns) error {
+	oldDefs, err := rel.TableDefs(c.ctx)
+	if err != nil {
+		return err
+	}
+	newDefs := make([]engine.TableDef, 0, len(oldDefs)+len(qry.Cols))
+	i := 0
+	for _, def := range oldDefs {
+		attr, ok := def.(*engine.AttributeDef)
+		if !ok || attr.Attr.Name == catalog.Row_ID {
+			continue
+		}
+		if attr.Attr.Name == catalog.CPrimaryKeyColName || util.JudgeIsCompositeClusterByColumn(attr.Attr.Name) {
+			newDefs = append(newDefs, def)
+			continue
+		}
+		if i >= len(qry.Cols) {
+			return moerr.NewInternalError(c.ctx, "the columns of table %s are changed", tblName)
+		}
+		newAttr := *attr
+		newAttr.Attr.Name = qry.Cols[i].Name
+		newAttr.Attr.Comment = qry.Cols[i].Comment
+		newDefs = append(newDefs, &newAttr)
+		i++
+	}
+	newDefs = append(newDefs, planColsToExeCols(qry.Cols[i:])...)
+	return rel.AlterColumns(c.ctx, newDefs)
+}
+
 func (s *Scope) CreateTable(c *Compile) error {
 	qry := s.Plan.GetDdl().GetCreateTable()
 	// convert the plan's cols to the execution's cols
@@ -424,7 +500,11 @@ func (s *Scope) CreateTempTable(c *Compile) error {
 }
 
 func (s *Scope) CreateIndex(c *Compile) error {
-	qry := s.Plan.GetDdl().GetCreateIndex()
+	return s.createIndex(c, s.Plan.GetDdl().GetCreateIndex())
+}
+
+// createIndex creates the index for both CREATE INDEX and ALTER TABLE ADD INDEX.
+func (s *Scope) createIndex(c *Compile, qry *plan.CreateIndex) error {
 	d, err := c.e.Database(c.ctx, qry.Database, c.proc.TxnOperator)
 	if err != nil {
 		return err
@@ -491,34 +571,111 @@ func (s *Scope) CreateIndex(c *Compile) error {
 		if err != nil {
 			return err
 		}
-		bat, err := rds[0].Read(c.ctx, targetAttrs, nil, c.proc.Mp())
-		if err != nil {
-			return err
-		}
-		err = rds[0].Close()
+		indexR, err := d.Relation(c.ctx, indexDef.IndexTableName)
 		if err != nil {
 			return err
 		}
-
-		if bat != nil {
-			indexBat, cnt := util.BuildUniqueKeyBatch(bat.Vecs, targetAttrs, indexDef.Parts, qry.OriginTablePrimaryKey, c.proc)
-			indexR, err := d.Relation(c.ctx, indexDef.IndexTableName)
+		for {
+			bat, err := rds[0].Read(c.ctx, targetAttrs, nil, c.proc.Mp())
 			if err != nil {
+				rds[0].Close()
 				return err
 			}
+			if bat == nil {
+				break
+			}
+			indexBat, cnt := util.BuildUniqueKeyBatch(bat.Vecs, targetAttrs, indexDef.Parts, qry.OriginTablePrimaryKey, c.proc)
 			if cnt != 0 {
-				if err := indexR.Write(c.ctx, indexBat); err != nil {
-					return err
-				}
+				err = indexR.Write(c.ctx, indexBat)
 			}
 			indexBat.Clean(c.proc.Mp())
+			bat.Clean(c.proc.Mp())
+			if err != nil {
+				rds[0].Close()
+				return err
+			}
+		}
+		err = rds[0].Close()
+		if err != nil {
+			return err
 		}
 		// other situation is not supported now and check in plan
 	}
+	if util.IsSecondaryIndexTable(indexDef) {
+		return s.buildIndexTable(c, r, d, indexDef, qry.OriginTablePrimaryKey)
+	}
 
 	return nil
 }
 
+// buildIndexTable writes the existing rows into the new index table of a secondary index, a
+// fulltext index or a functional index on a json path.
+func (s *Scope) buildIndexTable(c *Compile, r engine.Relation, d engine.Database, indexDef *plan.IndexDef, pkName string) error {
+	var buildBatch func(parts []*vector.Vector, pkVec *vector.Vector) (*batch.Batch, error)
+	if !indexDef.Fulltext && indexDef.JsonPath == "" {
+		buildBatch = func(parts []*vector.Vector, pkVec *vector.Vector) (*batch.Batch, error) {
+			return util.BuildSecondaryIndexBatch(parts, pkVec, c.proc)
+		}
+	} else if indexDef.Fulltext {
+		tokenizer, ok := fulltext.GetTokenizer(indexDef.Parser)
+		if !ok {
+			return moerr.NewInternalError(c.ctx, "unknown fulltext parser '%s'", indexDef.Parser)
+		}
+		buildBatch = func(parts []*vector.Vector, pkVec *vector.Vector) (*batch.Batch, error) {
+			return util.BuildFullTextIndexBatch(parts, pkVec, tokenizer, c.proc)
+		}
+	} else {
+		buildBatch = func(parts []*vector.Vector, pkVec *vector.Vector) (*batch.Batch, error) {
+			return util.BuildJsonIndexBatch(parts[0], pkVec, indexDef, c.proc)
+		}
+	}
+	attrs := []string{pkName}
+	partPos := make([]int, len(indexDef.Parts))
+	for i, part := range indexDef.Parts {
+		if part != pkName {
+			partPos[i] = len(attrs)
+			attrs = append(attrs, part)
+		}
+	}
+	ret, err := r.Ranges(c.ctx, nil)
+	if err != nil {
+		return err
+	}
+	rds, err := r.NewReader(c.ctx, 1, nil, ret)
+	if err != nil {
+		return err
+	}
+	defer rds[0].Close()
+	indexR, err := d.Relation(c.ctx, indexDef.IndexTableName)
+	if err != nil {
+		return err
+	}
+	for {
+		bat, err := rds[0].Read(c.ctx, attrs, nil, c.proc.Mp())
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		parts := make([]*vector.Vector, len(partPos))
+		for i, pos := range partPos {
+			parts[i] = bat.Vecs[pos]
+		}
+		indexBat, err := buildBatch(parts, bat.Vecs[0])
+		if err == nil && indexBat.Length() > 0 {
+			err = indexR.Write(c.ctx, indexBat)
+		}
+		if indexBat != nil {
+			indexBat.Clean(c.proc.Mp())
+		}
+		bat.Clean(c.proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func (s *Scope) DropIndex(c *Compile) error {
 	qry := s.Plan.GetDdl().GetDropIndex()
 	d, err := c.e.Database(c.ctx, qry.Database, c.proc.TxnOperator)
@@ -970,6 +1127,12 @@ func planDefsToExeDefs(tableDef *plan.TableDef) ([]engine.TableDef, error) {
 		})
 	}
 
+	if len(tableDef.Checks) > 0 {
+		c.Cts = append(c.Cts, &engine.CheckDef{
+			Checks: tableDef.Checks,
+		})
+	}
+
 	if len(c.Cts) > 0 {
 		exeDefs = append(exeDefs, c)
 	}
@@ -991,12 +1154,15 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 			alg = compress.None
 		case plan.CompressType_Lz4:
 			alg = compress.Lz4
+		case plan.CompressType_Zstd:
+			alg = compress.Zstd
 		}
 		colTyp := col.GetTyp()
 		exeCols[i] = &engine.AttributeDef{
 			Attr: engine.Attribute{
 				Name:          col.Name,
 				Alg:           alg,
+				CompressLevel: col.CompressLevel,
 				Type:          types.New(types.T(colTyp.GetId()), colTyp.GetWidth(), colTyp.GetScale()),
 				Default:       planCols[i].GetDefault(),
 				OnUpdate:      planCols[i].GetOnUpdate(),
@@ -1004,6 +1170,8 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 				Comment:       col.GetComment(),
 				ClusterBy:     col.ClusterBy,
 				AutoIncrement: col.Typ.GetAutoIncr(),
+				EnumValues:    col.Typ.GetEnumvalues(),
+				Generated:     col.GetGenerated(),
 			},
 		}
 	}
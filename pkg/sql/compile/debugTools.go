@@ -64,6 +64,8 @@ var debugInstructionNames = map[int]string{
 	vm.Intersect:    "intersect",
 	vm.IntersectAll: "intersect all",
 	vm.HashBuild:    "hash build",
+	vm.Window:       "window",
+	vm.RecursiveCte: "recursive cte",
 }
 
 var debugMagicNames = map[int]string{
@@ -62,6 +62,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursivecte"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/semi"
@@ -69,9 +70,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -100,23 +103,25 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 	case vm.Group:
 		t := sourceIns.Arg.(*group.Argument)
 		res.Arg = &group.Argument{
-			NeedEval:  t.NeedEval,
-			Ibucket:   t.Ibucket,
-			Nbucket:   t.Nbucket,
-			Exprs:     t.Exprs,
-			Types:     t.Types,
-			Aggs:      t.Aggs,
-			MultiAggs: t.MultiAggs,
+			NeedEval:     t.NeedEval,
+			Ibucket:      t.Ibucket,
+			Nbucket:      t.Nbucket,
+			Exprs:        t.Exprs,
+			Types:        t.Types,
+			Aggs:         t.Aggs,
+			MultiAggs:    t.MultiAggs,
+			GroupingSets: t.GroupingSets,
 		}
 	case vm.Join:
 		t := sourceIns.Arg.(*join.Argument)
 		res.Arg = &join.Argument{
-			Ibucket:    t.Ibucket,
-			Nbucket:    t.Nbucket,
-			Result:     t.Result,
-			Cond:       t.Cond,
-			Typs:       t.Typs,
-			Conditions: t.Conditions,
+			Ibucket:            t.Ibucket,
+			Nbucket:            t.Nbucket,
+			Result:             t.Result,
+			Cond:               t.Cond,
+			Typs:               t.Typs,
+			Conditions:         t.Conditions,
+			RuntimeFilterSpecs: t.RuntimeFilterSpecs,
 		}
 	case vm.Left:
 		t := sourceIns.Arg.(*left.Argument)
@@ -215,12 +220,13 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 	case vm.Semi:
 		t := sourceIns.Arg.(*semi.Argument)
 		res.Arg = &semi.Argument{
-			Ibucket:    t.Ibucket,
-			Nbucket:    t.Nbucket,
-			Result:     t.Result,
-			Cond:       t.Cond,
-			Typs:       t.Typs,
-			Conditions: t.Conditions,
+			Ibucket:            t.Ibucket,
+			Nbucket:            t.Nbucket,
+			Result:             t.Result,
+			Cond:               t.Cond,
+			Typs:               t.Typs,
+			Conditions:         t.Conditions,
+			RuntimeFilterSpecs: t.RuntimeFilterSpecs,
 		}
 	case vm.Single:
 		t := sourceIns.Arg.(*single.Argument)
@@ -238,6 +244,18 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 			Limit: t.Limit,
 			Fs:    t.Fs,
 		}
+	case vm.Window:
+		t := sourceIns.Arg.(*window.Argument)
+		res.Arg = &window.Argument{
+			WinSpecList: t.WinSpecList,
+		}
+	case vm.RecursiveCte:
+		t := sourceIns.Arg.(*recursivecte.Argument)
+		res.Arg = &recursivecte.Argument{
+			MaxDepth: t.MaxDepth,
+			Distinct: t.Distinct,
+			Iterate:  t.Iterate,
+		}
 	case vm.Intersect:
 		t := sourceIns.Arg.(*intersect.Argument)
 		res.Arg = &intersect.Argument{
@@ -315,6 +333,7 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 			Nbucket:        t.Nbucket,
 			Typs:           t.Typs,
 			Conditions:     t.Conditions,
+			CanSpill:       t.CanSpill,
 		}
 	case vm.External:
 		t := sourceIns.Arg.(*external.Argument)
@@ -420,12 +439,14 @@ func constructDeletion(n *plan.Node, eg engine.Engine, proc *process.Process) (*
 		OnSetRef:          oldCtx.OnSetRef,
 		OnSetUpdateCol:    make([]map[string]int32, len(oldCtx.OnSetUpdateCol)),
 
+		OnSetSecondaryIndexSource: make([][]engine.Relation, len(oldCtx.OnSetRef)),
+
 		CanTruncate: oldCtx.CanTruncate,
 	}
 
 	if delCtx.CanTruncate {
 		for i, ref := range oldCtx.Ref {
-			rel, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
+			rel, _, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
 			if err != nil {
 				return nil, err
 			}
@@ -439,33 +460,34 @@ func constructDeletion(n *plan.Node, eg engine.Engine, proc *process.Process) (*
 			}
 		}
 		for i, ref := range oldCtx.Ref {
-			rel, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
+			rel, _, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
 			if err != nil {
 				return nil, err
 			}
 			delCtx.DelSource[i] = rel
 		}
 		for i, ref := range oldCtx.IdxRef {
-			rel, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
+			rel, _, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
 			if err != nil {
 				return nil, err
 			}
 			delCtx.IdxSource[i] = rel
 		}
 		for i, ref := range oldCtx.OnCascadeRef {
-			rel, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
+			rel, _, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
 			if err != nil {
 				return nil, err
 			}
 			delCtx.OnCascadeSource[i] = rel
 		}
 		for i, ref := range oldCtx.OnSetRef {
-			rel, uniqueRels, err := getRel(proc.Ctx, proc, eg, ref, oldCtx.OnSetDef[i])
+			rel, uniqueRels, secondaryRels, err := getRel(proc.Ctx, proc, eg, ref, oldCtx.OnSetDef[i])
 			if err != nil {
 				return nil, err
 			}
 			delCtx.OnSetSource[i] = rel
 			delCtx.OnSetUniqueSource[i] = uniqueRels
+			delCtx.OnSetSecondaryIndexSource[i] = secondaryRels
 		}
 		for i, idxMap := range oldCtx.OnSetUpdateCol {
 			delCtx.OnSetUpdateCol[i] = idxMap.Map
@@ -484,7 +506,7 @@ func constructOnduplicateKey(n *plan.Node, eg engine.Engine, proc *process.Proce
 	if oldCtx.GetClusterTable().GetIsClusterTable() {
 		ctx = context.WithValue(ctx, defines.TenantIDKey{}, catalog.System_Account)
 	}
-	originRel, indexRels, err := getRel(ctx, proc, eg, oldCtx.Ref, oldCtx.TableDef)
+	originRel, indexRels, _, err := getRel(ctx, proc, eg, oldCtx.Ref, oldCtx.TableDef)
 	if err != nil {
 		return nil, err
 	}
@@ -540,12 +562,13 @@ func constructInsert(n *plan.Node, eg engine.Engine, proc *process.Process) (*in
 		ParentIdx: oldCtx.ParentIdx,
 	}
 
-	originRel, indexRels, err := getRel(ctx, proc, eg, oldCtx.Ref, oldCtx.TableDef)
+	originRel, indexRels, secondaryRels, err := getRel(ctx, proc, eg, oldCtx.Ref, oldCtx.TableDef)
 	if err != nil {
 		return nil, err
 	}
 	newCtx.Source = originRel
 	newCtx.UniqueSource = indexRels
+	newCtx.SecondaryIndexSource = secondaryRels
 
 	return &insert.Argument{
 		InsertCtx: newCtx,
@@ -563,6 +586,8 @@ func constructUpdate(n *plan.Node, eg engine.Engine, proc *process.Process) (*up
 		UpdateCol:    make([]map[string]int32, len(oldCtx.UpdateCol)),
 		UniqueSource: make([][]engine.Relation, len(oldCtx.Ref)),
 
+		SecondaryIndexSource: make([][]engine.Relation, len(oldCtx.Ref)),
+
 		IdxSource: make([]engine.Relation, len(oldCtx.IdxRef)),
 		IdxIdx:    oldCtx.IdxIdx,
 
@@ -582,6 +607,9 @@ func constructUpdate(n *plan.Node, eg engine.Engine, proc *process.Process) (*up
 		OnSetTableDef:     oldCtx.OnSetDef,
 		OnSetUpdateCol:    make([]map[string]int32, len(oldCtx.OnSetUpdateCol)),
 
+		OnCascadeSecondaryIndexSource: make([][]engine.Relation, len(oldCtx.OnCascadeRef)),
+		OnSetSecondaryIndexSource:     make([][]engine.Relation, len(oldCtx.OnSetRef)),
+
 		ParentIdx: make([]map[string]int32, len(oldCtx.ParentIdx)),
 	}
 
@@ -607,35 +635,38 @@ func constructUpdate(n *plan.Node, eg engine.Engine, proc *process.Process) (*up
 		}
 	}
 	for i, ref := range oldCtx.Ref {
-		rel, uniqueRels, err := getRel(proc.Ctx, proc, eg, ref, oldCtx.TableDefs[i])
+		rel, uniqueRels, secondaryRels, err := getRel(proc.Ctx, proc, eg, ref, oldCtx.TableDefs[i])
 		if err != nil {
 			return nil, err
 		}
 		updateCtx.Source[i] = rel
 		updateCtx.UniqueSource[i] = uniqueRels
+		updateCtx.SecondaryIndexSource[i] = secondaryRels
 	}
 	for i, ref := range oldCtx.IdxRef {
-		rel, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
+		rel, _, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
 		if err != nil {
 			return nil, err
 		}
 		updateCtx.IdxSource[i] = rel
 	}
 	for i, ref := range oldCtx.OnCascadeRef {
-		rel, uniqueRels, err := getRel(proc.Ctx, proc, eg, ref, oldCtx.OnCascadeDef[i])
+		rel, uniqueRels, secondaryRels, err := getRel(proc.Ctx, proc, eg, ref, oldCtx.OnCascadeDef[i])
 		if err != nil {
 			return nil, err
 		}
 		updateCtx.OnCascadeSource[i] = rel
 		updateCtx.OnCascadeUniqueSource[i] = uniqueRels
+		updateCtx.OnCascadeSecondaryIndexSource[i] = secondaryRels
 	}
 	for i, ref := range oldCtx.OnSetRef {
-		rel, uniqueRels, err := getRel(proc.Ctx, proc, eg, ref, oldCtx.OnSetDef[i])
+		rel, uniqueRels, secondaryRels, err := getRel(proc.Ctx, proc, eg, ref, oldCtx.OnSetDef[i])
 		if err != nil {
 			return nil, err
 		}
 		updateCtx.OnSetSource[i] = rel
 		updateCtx.OnSetUniqueSource[i] = uniqueRels
+		updateCtx.OnSetSecondaryIndexSource[i] = secondaryRels
 	}
 	for i, idxMap := range oldCtx.OnCascadeUpdateCol {
 		updateCtx.OnCascadeUpdateCol[i] = idxMap.Map
@@ -716,10 +747,11 @@ func constructJoin(n *plan.Node, typs []types.Type, proc *process.Process) *join
 	}
 	cond, conds := extraJoinConditions(n.OnList)
 	return &join.Argument{
-		Typs:       typs,
-		Result:     result,
-		Cond:       cond,
-		Conditions: constructJoinConditions(conds, proc),
+		Typs:               typs,
+		Result:             result,
+		Cond:               cond,
+		Conditions:         constructJoinConditions(conds, proc),
+		RuntimeFilterSpecs: n.RuntimeFilterBuildList,
 	}
 }
 
@@ -734,10 +766,11 @@ func constructSemi(n *plan.Node, typs []types.Type, proc *process.Process) *semi
 	}
 	cond, conds := extraJoinConditions(n.OnList)
 	return &semi.Argument{
-		Typs:       typs,
-		Result:     result,
-		Cond:       cond,
-		Conditions: constructJoinConditions(conds, proc),
+		Typs:               typs,
+		Result:             result,
+		Cond:               cond,
+		Conditions:         constructJoinConditions(conds, proc),
+		RuntimeFilterSpecs: n.RuntimeFilterBuildList,
 	}
 }
 
@@ -836,6 +869,21 @@ func constructMark(n *plan.Node, typs []types.Type, proc *process.Process) *mark
 }
 */
 
+func constructWindow(n *plan.Node) *window.Argument {
+	return &window.Argument{
+		WinSpecList: n.WinSpecList,
+	}
+}
+
+func constructRecursiveCte(n *plan.Node,
+	iterate func(*batch.Batch, *process.Process) (*batch.Batch, error)) *recursivecte.Argument {
+	return &recursivecte.Argument{
+		MaxDepth: n.RecursiveCte.GetMaxDepth(),
+		Distinct: n.RecursiveCte.GetDistinct(),
+		Iterate:  iterate,
+	}
+}
+
 func constructOrder(n *plan.Node, proc *process.Process) *order.Argument {
 	return &order.Argument{
 		Fs: n.OrderBy,
@@ -906,15 +954,20 @@ func constructGroup(ctx context.Context, n, cn *plan.Node, ibucket, nbucket int,
 	for i, e := range cn.ProjectList {
 		typs[i] = types.New(types.T(e.Typ.Id), e.Typ.Width, e.Typ.Scale)
 	}
+	var groupingSets []int64
+	for _, expr := range n.GroupingSet {
+		groupingSets = append(groupingSets, expr.Expr.(*plan.Expr_C).C.Value.(*plan.Const_I64Val).I64Val)
+	}
 	// we need to store the
 	return &group.Argument{
-		Aggs:      aggs,
-		MultiAggs: multiaggs,
-		Types:     typs,
-		NeedEval:  needEval,
-		Exprs:     n.GroupBy,
-		Ibucket:   uint64(ibucket),
-		Nbucket:   uint64(nbucket),
+		Aggs:         aggs,
+		MultiAggs:    multiaggs,
+		Types:        typs,
+		NeedEval:     needEval,
+		Exprs:        n.GroupBy,
+		Ibucket:      uint64(ibucket),
+		Nbucket:      uint64(nbucket),
+		GroupingSets: groupingSets,
 	}
 }
 
@@ -1135,7 +1188,7 @@ func constructLoopMark(n *plan.Node, typs []types.Type, proc *process.Process) *
 	}
 }
 
-func constructHashBuild(in vm.Instruction, proc *process.Process) *hashbuild.Argument {
+func constructHashBuild(in vm.Instruction, runtimeFilters *colexec.RuntimeFilterRegistry, proc *process.Process) *hashbuild.Argument {
 	switch in.Op {
 	case vm.Anti:
 		arg := in.Arg.(*anti.Argument)
@@ -1155,10 +1208,13 @@ func constructHashBuild(in vm.Instruction, proc *process.Process) *hashbuild.Arg
 	case vm.Join:
 		arg := in.Arg.(*join.Argument)
 		return &hashbuild.Argument{
-			NeedHashMap:    true,
-			NeedSelectList: true,
-			Typs:           arg.Typs,
-			Conditions:     arg.Conditions[1],
+			NeedHashMap:           true,
+			NeedSelectList:        true,
+			Typs:                  arg.Typs,
+			Conditions:            arg.Conditions[1],
+			RuntimeFilterSpecs:    arg.RuntimeFilterSpecs,
+			RuntimeFilterRegistry: runtimeFilters,
+			CanSpill:              true,
 		}
 	case vm.Left:
 		arg := in.Arg.(*left.Argument)
@@ -1181,9 +1237,11 @@ func constructHashBuild(in vm.Instruction, proc *process.Process) *hashbuild.Arg
 	case vm.Semi:
 		arg := in.Arg.(*semi.Argument)
 		return &hashbuild.Argument{
-			NeedHashMap: true,
-			Typs:        arg.Typs,
-			Conditions:  arg.Conditions[1],
+			NeedHashMap:           true,
+			Typs:                  arg.Typs,
+			Conditions:            arg.Conditions[1],
+			RuntimeFilterSpecs:    arg.RuntimeFilterSpecs,
+			RuntimeFilterRegistry: runtimeFilters,
 		}
 	case vm.Single:
 		arg := in.Arg.(*single.Argument)
@@ -1334,8 +1392,9 @@ func exprRelPos(expr *plan.Expr) int32 {
 
 // Get the 'engine.Relation' of the table by using 'ObjectRef' and 'TableDef', if 'TableDef' is nil, the relations of its index table will not be obtained
 // the first return value is Relation of the original table
-// the second return value is Relations of index tables
-func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *plan.ObjectRef, tableDef *plan.TableDef) (engine.Relation, []engine.Relation, error) {
+// the second return value is Relations of unique index tables
+// the third return value is Relations of fulltext and functional index tables
+func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *plan.ObjectRef, tableDef *plan.TableDef) (engine.Relation, []engine.Relation, []engine.Relation, error) {
 	var dbSource engine.Database
 	var relation engine.Relation
 	var err error
@@ -1344,7 +1403,7 @@ func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *p
 	if ref.SchemaName != "" {
 		dbSource, err = eg.Database(ctx, ref.SchemaName, proc.TxnOperator)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		relation, err = dbSource.Relation(ctx, ref.ObjName)
 		if err == nil {
@@ -1352,7 +1411,7 @@ func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *p
 		} else {
 			dbSource, err = eg.Database(ctx, defines.TEMPORARY_DBNAME, proc.TxnOperator)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			newObjeName := engine.GetTempTableName(ref.SchemaName, ref.ObjName)
 			newSchemaName := defines.TEMPORARY_DBNAME
@@ -1360,18 +1419,19 @@ func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *p
 			ref.ObjName = newObjeName
 			relation, err = dbSource.Relation(ctx, newObjeName)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			isTemp = true
 		}
 	} else {
 		_, _, relation, err = eg.GetRelationById(ctx, proc.TxnOperator, uint64(ref.Obj))
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
 	var uniqueIndexTables []engine.Relation
+	var secondaryIndexTables []engine.Relation
 	if tableDef != nil {
 		uniqueIndexTables = make([]engine.Relation, 0)
 		if tableDef.Indexes != nil {
@@ -1385,7 +1445,7 @@ func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *p
 							indexTable, err = dbSource.Relation(ctx, indexdef.IndexTableName)
 						}
 						if err != nil {
-							return nil, nil, err
+							return nil, nil, nil, err
 						}
 						uniqueIndexTables = append(uniqueIndexTables, indexTable)
 					}
@@ -1393,7 +1453,22 @@ func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *p
 					continue
 				}
 			}
+			for _, indexdef := range tableDef.Indexes {
+				if !util.IsSecondaryIndexTable(indexdef) {
+					continue
+				}
+				var indexTable engine.Relation
+				if isTemp {
+					indexTable, err = dbSource.Relation(ctx, engine.GetTempTableName(oldDbName, indexdef.IndexTableName))
+				} else {
+					indexTable, err = dbSource.Relation(ctx, indexdef.IndexTableName)
+				}
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				secondaryIndexTables = append(secondaryIndexTables, indexTable)
+			}
 		}
 	}
-	return relation, uniqueIndexTables, err
+	return relation, uniqueIndexTables, secondaryIndexTables, err
 }
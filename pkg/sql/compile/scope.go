@@ -228,14 +228,19 @@ func (s *Scope) ParallelRun(c *Compile, remote bool) error {
 		if util.TableIsClusterTable(s.DataSource.TableDef.GetTableType()) {
 			ctx = context.WithValue(ctx, defines.TenantIDKey{}, catalog.System_Account)
 		}
-		db, err = c.e.Database(ctx, s.DataSource.SchemaName, s.Proc.TxnOperator)
+		// an AS OF table is read in the snapshot txn
+		txnOp := s.DataSource.TxnOperator
+		if txnOp == nil {
+			txnOp = s.Proc.TxnOperator
+		}
+		db, err = c.e.Database(ctx, s.DataSource.SchemaName, txnOp)
 		if err != nil {
 			return err
 		}
 		rel, err = db.Relation(ctx, s.DataSource.RelationName)
 		if err != nil {
 			var e error // avoid contamination of error messages
-			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, s.Proc.TxnOperator)
+			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, txnOp)
 			if e != nil {
 				return e
 			}
@@ -249,6 +254,11 @@ func (s *Scope) ParallelRun(c *Compile, remote bool) error {
 		}
 		s.NodeInfo.Data = nil
 	}
+	if len(s.DataSource.RuntimeFilterSpecs) > 0 {
+		for i := range rds {
+			rds[i] = colexec.NewRuntimeFilterReader(rds[i], c.runtimeFilters, s.DataSource.RuntimeFilterSpecs)
+		}
+	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
 		ss[i] = &Scope{
@@ -425,10 +435,11 @@ func newParallelScope(c *Compile, s *Scope, ss []*Scope) *Scope {
 					Idx:     in.Idx,
 					IsFirst: in.IsFirst,
 					Arg: &group.Argument{
-						Aggs:      arg.Aggs,
-						Exprs:     arg.Exprs,
-						Types:     arg.Types,
-						MultiAggs: arg.MultiAggs,
+						Aggs:         arg.Aggs,
+						Exprs:        arg.Exprs,
+						Types:        arg.Types,
+						MultiAggs:    arg.MultiAggs,
+						GroupingSets: arg.GroupingSets,
 					},
 				})
 			}
@@ -588,9 +599,11 @@ func copyScope(srcScope *Scope, regMap map[*process.WaitRegister]*process.WaitRe
 				LogicalTime:  srcScope.DataSource.Timestamp.LogicalTime,
 				NodeID:       srcScope.DataSource.Timestamp.NodeID,
 			},
+			TxnOperator: srcScope.DataSource.TxnOperator,
 			// read only.
-			Expr:     srcScope.DataSource.Expr,
-			TableDef: srcScope.DataSource.TableDef,
+			Expr:               srcScope.DataSource.Expr,
+			TableDef:           srcScope.DataSource.TableDef,
+			RuntimeFilterSpecs: srcScope.DataSource.RuntimeFilterSpecs,
 		}
 
 		// IF const run.
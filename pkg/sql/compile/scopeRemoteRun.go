@@ -75,6 +75,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -122,6 +123,22 @@ func fillEngineForInsert(s *Scope, e engine.Engine) {
 	}
 }
 
+// expectRuntimeFilters tells the registry the runtime filters built by the joins of the scope,
+// the table scans wait only for them.
+func expectRuntimeFilters(s *Scope, r *colexec.RuntimeFilterRegistry) {
+	for i := range s.Instructions {
+		switch arg := s.Instructions[i].Arg.(type) {
+		case *join.Argument:
+			r.Expect(arg.RuntimeFilterSpecs)
+		case *semi.Argument:
+			r.Expect(arg.RuntimeFilterSpecs)
+		}
+	}
+	for i := range s.PreScopes {
+		expectRuntimeFilters(s.PreScopes[i], r)
+	}
+}
+
 // cnMessageHandle deal the received message at cn-server.
 func cnMessageHandle(receiver messageReceiverOnServer) error {
 	switch receiver.messageTyp {
@@ -158,6 +175,7 @@ func cnMessageHandle(receiver messageReceiverOnServer) error {
 			return err
 		}
 		fillEngineForInsert(s, c.e)
+		expectRuntimeFilters(s, c.runtimeFilters)
 		s = refactorScope(c, c.ctx, s)
 
 		err = s.ParallelRun(c, s.IsRemote)
@@ -422,6 +440,8 @@ func generatePipeline(s *Scope, ctx *scopeContext, ctxId int32) (*pipeline.Pipel
 			Expr:         s.DataSource.Expr,
 			TableDef:     s.DataSource.TableDef,
 			Timestamp:    &s.DataSource.Timestamp,
+
+			RuntimeFilterProbeList: s.DataSource.RuntimeFilterSpecs,
 		}
 		if s.DataSource.Bat != nil {
 			data, err := types.Encode(s.DataSource.Bat)
@@ -525,6 +545,8 @@ func generateScope(proc *process.Process, p *pipeline.Pipeline, ctx *scopeContex
 			Expr:         dsc.Expr,
 			TableDef:     dsc.TableDef,
 			Timestamp:    *dsc.Timestamp,
+
+			RuntimeFilterSpecs: dsc.RuntimeFilterProbeList,
 		}
 		if len(dsc.Block) > 0 {
 			bat := new(batch.Batch)
@@ -652,13 +674,14 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 		}
 	case *group.Argument:
 		in.Agg = &pipeline.Group{
-			NeedEval:  t.NeedEval,
-			Ibucket:   t.Ibucket,
-			Nbucket:   t.Nbucket,
-			Exprs:     t.Exprs,
-			Types:     convertToPlanTypes(t.Types),
-			Aggs:      convertToPipelineAggregates(t.Aggs),
-			MultiAggs: convertPipelineMultiAggs(t.MultiAggs),
+			NeedEval:     t.NeedEval,
+			Ibucket:      t.Ibucket,
+			Nbucket:      t.Nbucket,
+			Exprs:        t.Exprs,
+			Types:        convertToPlanTypes(t.Types),
+			Aggs:         convertToPipelineAggregates(t.Aggs),
+			MultiAggs:    convertPipelineMultiAggs(t.MultiAggs),
+			GroupingSets: t.GroupingSets,
 		}
 	case *join.Argument:
 		relList, colList := getRelColList(t.Result)
@@ -671,6 +694,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Types:     convertToPlanTypes(t.Typs),
 			LeftCond:  t.Conditions[0],
 			RightCond: t.Conditions[1],
+
+			RuntimeFilterBuildList: t.RuntimeFilterSpecs,
 		}
 	case *left.Argument:
 		relList, colList := getRelColList(t.Result)
@@ -765,6 +790,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Types:     convertToPlanTypes(t.Typs),
 			LeftCond:  t.Conditions[0],
 			RightCond: t.Conditions[1],
+
+			RuntimeFilterBuildList: t.RuntimeFilterSpecs,
 		}
 	case *single.Argument:
 		relList, colList := getRelColList(t.Result)
@@ -781,6 +808,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 	case *top.Argument:
 		in.Limit = uint64(t.Limit)
 		in.OrderBy = t.Fs
+	case *window.Argument:
+		in.WinSpecList = t.WinSpecList
 	// we reused ANTI to store the information here because of the lack of related structure.
 	case *intersect.Argument: // 1
 		in.Anti = &pipeline.AntiJoin{
@@ -943,13 +972,14 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 	case vm.Group:
 		t := opr.GetAgg()
 		v.Arg = &group.Argument{
-			NeedEval:  t.NeedEval,
-			Ibucket:   t.Ibucket,
-			Nbucket:   t.Nbucket,
-			Exprs:     t.Exprs,
-			Types:     convertToTypes(t.Types),
-			Aggs:      convertToAggregates(t.Aggs),
-			MultiAggs: convertToMultiAggs(t.MultiAggs),
+			NeedEval:     t.NeedEval,
+			Ibucket:      t.Ibucket,
+			Nbucket:      t.Nbucket,
+			Exprs:        t.Exprs,
+			Types:        convertToTypes(t.Types),
+			Aggs:         convertToAggregates(t.Aggs),
+			MultiAggs:    convertToMultiAggs(t.MultiAggs),
+			GroupingSets: t.GroupingSets,
 		}
 	case vm.Join:
 		t := opr.GetJoin()
@@ -960,6 +990,8 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			Typs:       convertToTypes(t.Types),
 			Result:     convertToResultPos(t.RelList, t.ColList),
 			Conditions: [][]*plan.Expr{t.LeftCond, t.RightCond},
+
+			RuntimeFilterSpecs: t.RuntimeFilterBuildList,
 		}
 	case vm.Left:
 		t := opr.GetLeftJoin()
@@ -1049,6 +1081,8 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			Cond:       t.Expr,
 			Typs:       convertToTypes(t.Types),
 			Conditions: [][]*plan.Expr{t.LeftCond, t.RightCond},
+
+			RuntimeFilterSpecs: t.RuntimeFilterBuildList,
 		}
 	case vm.Single:
 		t := opr.GetSingleJoin()
@@ -1076,6 +1110,8 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			Limit: int64(opr.Limit),
 			Fs:    opr.OrderBy,
 		}
+	case vm.Window:
+		v.Arg = &window.Argument{WinSpecList: opr.WinSpecList}
 	// should change next day?
 	case vm.Intersect:
 		t := opr.GetAnti()
@@ -1296,6 +1332,7 @@ func convertToPipelineLimitation(lim process.Limitation) *pipeline.ProcessLimita
 		BatchSize:     lim.BatchSize,
 		PartitionRows: lim.PartitionRows,
 		ReaderSize:    lim.ReaderSize,
+		MemoryLimit:   lim.MemoryLimit,
 	}
 }
 
@@ -1307,6 +1344,7 @@ func convertToProcessLimitation(lim *pipeline.ProcessLimitation) process.Limitat
 		BatchSize:     lim.BatchSize,
 		PartitionRows: lim.PartitionRows,
 		ReaderSize:    lim.ReaderSize,
+		MemoryLimit:   lim.MemoryLimit,
 	}
 }
 
@@ -0,0 +1,91 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+)
+
+// snapshotTxn returns the read-only txn reading at the snapshot of the AS OF table scan.
+// The scans of the query at the same snapshot share the txn, which is closed after the
+// query is done.
+func (c *Compile) snapshotTxn(ts timestamp.Timestamp) (TxnOperator, error) {
+	if op, ok := c.snapshotTxns[ts.DebugString()]; ok {
+		return op, nil
+	}
+	if err := checkSnapshotRetention(c.ctx, ts, time.Now()); err != nil {
+		return nil, err
+	}
+	if c.proc.TxnClient == nil {
+		return nil, moerr.NewInternalError(c.ctx, "AS OF requires the txn client")
+	}
+	op, err := c.proc.TxnClient.New(client.WithTxnReadyOnly(), client.WithSnapshotTS(ts))
+	if err != nil {
+		return nil, err
+	}
+	if err = c.e.New(c.ctx, op); err != nil {
+		_ = op.Rollback(c.ctx)
+		return nil, err
+	}
+	if c.snapshotTxns == nil {
+		c.snapshotTxns = make(map[string]TxnOperator)
+	}
+	c.snapshotTxns[ts.DebugString()] = op
+	return op, nil
+}
+
+// closeSnapshotTxns closes the txns of the AS OF table scans
+func (c *Compile) closeSnapshotTxns() {
+	for _, op := range c.snapshotTxns {
+		if err := c.e.Rollback(c.ctx, op); err != nil {
+			logutil.Errorf("close the snapshot txn failed: %v", err)
+		}
+		if err := op.Rollback(c.ctx); err != nil {
+			logutil.Errorf("close the snapshot txn failed: %v", err)
+		}
+	}
+	c.snapshotTxns = nil
+}
+
+// checkSnapshotRetention returns an error if the snapshot is out of the retention window,
+// the data of the snapshot may have been garbage collected by the DN.
+func checkSnapshotRetention(ctx context.Context, ts timestamp.Timestamp, now time.Time) error {
+	rt := runtime.ProcessLevelRuntime()
+	if rt == nil {
+		return nil
+	}
+	v, ok := rt.GetGlobalVariables(runtime.SnapshotRetention)
+	if !ok {
+		return nil
+	}
+	retention, ok := v.(time.Duration)
+	if !ok || retention <= 0 {
+		return nil
+	}
+	horizon := now.Add(-retention)
+	if ts.PhysicalTime < horizon.UnixNano() {
+		return moerr.NewSnapshotTooOld(ctx,
+			time.Unix(0, ts.PhysicalTime).UTC().Format("2006-01-02 15:04:05.999999"),
+			horizon.UTC().Format("2006-01-02 15:04:05"))
+	}
+	return nil
+}
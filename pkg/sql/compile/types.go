@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
@@ -78,6 +79,11 @@ type Source struct {
 	Expr         *plan.Expr
 	TableDef     *plan.TableDef
 	Timestamp    timestamp.Timestamp
+	// TxnOperator is the txn the table is read in, it's the snapshot txn of an AS OF table scan
+	TxnOperator TxnOperator
+
+	// RuntimeFilterSpecs are the runtime filters the scan waits for
+	RuntimeFilterSpecs []*plan.RuntimeFilterSpec
 }
 
 // Col is the information of attribute
@@ -175,6 +181,15 @@ type Compile struct {
 	stmt tree.Statement
 
 	s3CounterSet perfcounter.CounterSet
+
+	// cteWorkingBatch stores the rows scanned by the recursive part of a recursive CTE in one iteration.
+	cteWorkingBatch *batch.Batch
+
+	// runtimeFilters passes the runtime filters from the hash builds to the table scans.
+	runtimeFilters *colexec.RuntimeFilterRegistry
+
+	// snapshotTxns are the read-only txns of the AS OF table scans, by their snapshot.
+	snapshotTxns map[string]TxnOperator
 }
 
 type RemoteReceivRegInfo struct {
@@ -0,0 +1,120 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// ParseOptimizerHints parses the text of the optimizer hints like
+// "JOIN_ORDER(t1, (t2 t3)) NO_HASH_JOIN(t2)". The comment delimiters /*+ and */ are optional.
+// Like the mysql, the hints are ignored from the first syntax error on.
+func ParseOptimizerHints(text string) tree.OptimizerHints {
+	text = strings.TrimSpace(text)
+	text = strings.TrimPrefix(text, "/*+")
+	text = strings.TrimSuffix(text, "*/")
+	p := &hintParser{text: text}
+	var hints tree.OptimizerHints
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.text) {
+			return hints
+		}
+		name := p.name()
+		if name == "" {
+			return hints
+		}
+		p.skipSpaces()
+		if !p.consume('(') {
+			return hints
+		}
+		args, ok := p.args()
+		if !ok {
+			return hints
+		}
+		hints = append(hints, &tree.OptimizerHint{Name: strings.ToUpper(name), Args: args})
+	}
+}
+
+type hintParser struct {
+	text string
+	pos  int
+}
+
+func (p *hintParser) skipSpaces() {
+	for p.pos < len(p.text) && strings.IndexByte(" \t\r\n", p.text[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *hintParser) consume(ch byte) bool {
+	if p.pos < len(p.text) && p.text[p.pos] == ch {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// name scans an identifier, it is lower cased unless it is quoted
+func (p *hintParser) name() string {
+	if p.consume('`') {
+		end := strings.IndexByte(p.text[p.pos:], '`')
+		if end < 0 {
+			return ""
+		}
+		name := p.text[p.pos : p.pos+end]
+		p.pos += end + 1
+		return name
+	}
+	start := p.pos
+	for p.pos < len(p.text) {
+		ch := p.text[p.pos]
+		if ch == '_' || ch == '$' || ch == '.' || ch >= '0' && ch <= '9' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= 0x80 {
+			p.pos++
+			continue
+		}
+		break
+	}
+	return strings.ToLower(p.text[start:p.pos])
+}
+
+// args scans the arguments until the closing parenthesis, they are separated by commas or spaces
+func (p *hintParser) args() ([]*tree.HintArg, bool) {
+	args := make([]*tree.HintArg, 0, 2)
+	for {
+		p.skipSpaces()
+		if p.consume(')') {
+			return args, true
+		}
+		if len(args) > 0 && p.consume(',') {
+			p.skipSpaces()
+		}
+		if p.consume('(') {
+			group, ok := p.args()
+			if !ok || len(group) == 0 {
+				return nil, false
+			}
+			args = append(args, &tree.HintArg{Group: group})
+			continue
+		}
+		name := p.name()
+		if name == "" {
+			return nil, false
+		}
+		args = append(args, &tree.HintArg{Name: name})
+	}
+}
@@ -22,9 +22,11 @@ func init() {
 		"account":                  ACCOUNT,
 		"accounts":                 ACCOUNTS,
 		"add":                      ADD,
+		"after":                    AFTER,
 		"action":                   ACTION,
 		"against":                  AGAINST,
 		"all":                      ALL,
+		"always":                   ALWAYS,
 		"alter":                    ALTER,
 		"algorithm":                ALGORITHM,
 		"analyze":                  ANALYZE,
@@ -55,11 +57,11 @@ func init() {
 		"btree":                    BTREE,
 		"bit_or":                   BIT_OR,
 		"bit_and":                  BIT_AND,
-		"call":                     UNUSED,
+		"call":                     CALL,
 		"cascade":                  CASCADE,
 		"case":                     CASE,
 		"cast":                     CAST,
-		"change":                   UNUSED,
+		"change":                   CHANGE,
 		"char":                     CHAR,
 		"character":                CHARACTER,
 		"charset":                  CHARSET,
@@ -80,7 +82,7 @@ func init() {
 		"condition":                UNUSED,
 		"constraint":               CONSTRAINT,
 		"consistent":               CONSISTENT,
-		"continue":                 UNUSED,
+		"continue":                 CONTINUE,
 		"connection":               CONNECTION,
 		"connect":                  CONNECT,
 		"convert":                  CONVERT,
@@ -88,6 +90,7 @@ func init() {
 		"cipher":                   CIPHER,
 		"chain":                    CHAIN,
 		"client":                   CLIENT,
+		"close":                    CLOSE,
 		"san":                      SAN,
 		"substr":                   SUBSTR,
 		"substring":                SUBSTRING,
@@ -99,13 +102,14 @@ func init() {
 		"create":                   CREATE,
 		"cluster":                  CLUSTER,
 		"cross":                    CROSS,
+		"cube":                     CUBE,
 		"current_date":             CURRENT_DATE,
 		"current_time":             CURRENT_TIME,
 		"current_timestamp":        CURRENT_TIMESTAMP,
 		"current_user":             CURRENT_USER,
 		"current_role":             CURRENT_ROLE,
 		"curtime":                  CURTIME,
-		"cursor":                   UNUSED,
+		"cursor":                   CURSOR,
 		"database":                 DATABASE,
 		"databases":                DATABASES,
 		"day":                      DAY,
@@ -134,7 +138,7 @@ func init() {
 		"drainer":                  DRAINER,
 		"each":                     UNUSED,
 		"else":                     ELSE,
-		"elseif":                   UNUSED,
+		"elseif":                   ELSEIF,
 		"enclosed":                 ENCLOSED,
 		"encryption":               ENCRYPTION,
 		"engine":                   ENGINE,
@@ -144,7 +148,7 @@ func init() {
 		"escape":                   ESCAPE,
 		"escaped":                  ESCAPED,
 		"exists":                   EXISTS,
-		"exit":                     UNUSED,
+		"exit":                     EXIT,
 		"explain":                  EXPLAIN,
 		"expansion":                EXPANSION,
 		"extended":                 EXTENDED,
@@ -156,7 +160,7 @@ func init() {
 		"events":                   EVENTS,
 		"engines":                  ENGINES,
 		"false":                    FALSE,
-		"fetch":                    UNUSED,
+		"fetch":                    FETCH,
 		"first":                    FIRST,
 		"float":                    FLOAT_TYPE,
 		"float4":                   UNUSED,
@@ -165,6 +169,7 @@ func init() {
 		"force":                    FORCE,
 		"foreign":                  FOREIGN,
 		"format":                   FORMAT,
+		"found":                    FOUND,
 		"from":                     FROM,
 		"full":                     FULL,
 		"fulltext":                 FULLTEXT,
@@ -172,7 +177,7 @@ func init() {
 		"fields":                   FIELDS,
 		"file":                     FILE,
 		"fixed":                    FIXED,
-		"generated":                UNUSED,
+		"generated":                GENERATED,
 		"geometry":                 GEOMETRY,
 		"geometrycollection":       GEOMETRYCOLLECTION,
 		"get":                      UNUSED,
@@ -181,6 +186,8 @@ func init() {
 		"grants":                   GRANTS,
 		"group":                    GROUP,
 		"group_concat":             GROUP_CONCAT,
+		"grouping":                 GROUPING,
+		"handler":                  HANDLER,
 		"having":                   HAVING,
 		"hash":                     HASH,
 		"high_priority":            HIGH_PRIORITY,
@@ -192,7 +199,7 @@ func init() {
 		"index":                    INDEX,
 		"indexes":                  INDEXES,
 		"infile":                   INFILE,
-		"inout":                    UNUSED,
+		"inout":                    INOUT,
 		"inner":                    INNER,
 		"insensitive":              UNUSED,
 		"insert":                   INSERT,
@@ -211,9 +218,10 @@ func init() {
 		"is":                       IS,
 		"issuer":                   ISSUER,
 		"isolation":                ISOLATION,
-		"iterate":                  UNUSED,
+		"iterate":                  ITERATE,
 		"join":                     JOIN,
 		"json":                     JSON,
+		"json_table":               JSON_TABLE,
 		"uuid":                     UUID,
 		"key":                      KEY,
 		"keys":                     KEYS,
@@ -222,7 +230,7 @@ func init() {
 		"language":                 LANGUAGE,
 		"last":                     LAST,
 		"leading":                  LEADING,
-		"leave":                    UNUSED,
+		"leave":                    LEAVE,
 		"left":                     LEFT,
 		"less":                     LESS,
 		"level":                    LEVEL,
@@ -243,7 +251,7 @@ func init() {
 		"long":                     UNUSED,
 		"longblob":                 LONGBLOB,
 		"longtext":                 LONGTEXT,
-		"loop":                     UNUSED,
+		"loop":                     LOOP,
 		"low_priority":             LOW_PRIORITY,
 		"local":                    LOCAL,
 		"master_bind":              UNUSED,
@@ -259,6 +267,7 @@ func init() {
 		"mod":                      MOD,
 		"month":                    MONTH,
 		"mode":                     MODE,
+		"modify":                   MODIFY,
 		"memory":                   MEMORY,
 		"modifies":                 UNUSED,
 		"multilinestring":          MULTILINESTRING,
@@ -293,7 +302,7 @@ func init() {
 		"open":                     OPEN,
 		"or":                       OR,
 		"order":                    ORDER,
-		"out":                      UNUSED,
+		"out":                      OUT,
 		"outer":                    OUTER,
 		"over":                     OVER,
 		"outfile":                  OUTFILE,
@@ -350,12 +359,20 @@ func init() {
 		"right":                    RIGHT,
 		"rlike":                    REGEXP,
 		"rollback":                 ROLLBACK,
+		"rollup":                   ROLLUP,
 		"role":                     ROLE,
 		"routine":                  ROUTINE,
 		"row":                      ROW,
 		"row_format":               ROW_FORMAT,
 		"row_count":                ROW_COUNT,
 		"rtree":                    RTREE,
+		"savepoint":                SAVEPOINT,
+		"of":                       OF,
+		"ordinality":               ORDINALITY,
+		"member":                   MEMBER,
+		"array":                    ARRAY,
+		"nested":                   NESTED,
+		"path":                     PATH,
 		"schema":                   SCHEMA,
 		"schemas":                  SCHEMAS,
 		"second":                   SECOND,
@@ -365,6 +382,7 @@ func init() {
 		"serializable":             SERIALIZABLE,
 		"session":                  SESSION,
 		"set":                      SET,
+		"sets":                     SETS,
 		"share":                    SHARE,
 		"show":                     SHOW,
 		"shutdown":                 SHUTDOWN,
@@ -375,9 +393,9 @@ func init() {
 		"spatial":                  SPATIAL,
 		"specific":                 UNUSED,
 		"sql":                      UNUSED,
-		"sqlexception":             UNUSED,
-		"sqlstate":                 UNUSED,
-		"sqlwarning":               UNUSED,
+		"sqlexception":             SQLEXCEPTION,
+		"sqlstate":                 SQLSTATE,
+		"sqlwarning":               SQLWARNING,
 		"sql_big_result":           SQL_BIG_RESULT,
 		"sql_cache":                SQL_CACHE,
 		"sql_calc_found_rows":      UNUSED,
@@ -392,7 +410,7 @@ func init() {
 		"stats_auto_recalc":        STATS_AUTO_RECALC,
 		"stats_persistent":         STATS_PERSISTENT,
 		"stats_sample_pages":       STATS_SAMPLE_PAGES,
-		"stored":                   UNUSED,
+		"stored":                   STORED,
 		"storage":                  STORAGE,
 		"straight_join":            STRAIGHT_JOIN,
 		"stream":                   STREAM,
@@ -425,6 +443,7 @@ func init() {
 		"unique":                   UNIQUE,
 		"unlock":                   UNLOCK,
 		"unsigned":                 UNSIGNED,
+		"until":                    UNTIL,
 		"update":                   UPDATE,
 		"usage":                    USAGE,
 		"use":                      USE,
@@ -439,13 +458,13 @@ func init() {
 		"varchar":                  VARCHAR,
 		"varcharacter":             UNUSED,
 		"varying":                  UNUSED,
-		"virtual":                  UNUSED,
+		"virtual":                  VIRTUAL,
 		"view":                     VIEW,
 		"visible":                  VISIBLE,
 		"week":                     WEEK,
 		"when":                     WHEN,
 		"where":                    WHERE,
-		"while":                    UNUSED,
+		"while":                    WHILE,
 		"with":                     WITH,
 		"write":                    WRITE,
 		"warnings":                 WARNINGS,
@@ -529,6 +548,7 @@ func init() {
 		"preceding":                PRECEDING,
 		"following":                FOLLOWING,
 		"groups":                   GROUPS,
+		"recursive":                RECURSIVE,
 		"table_number":             TABLE_NUMBER,
 		"table_values":             TABLE_VALUES,
 		"table_size":               TABLE_SIZE,
@@ -547,6 +567,8 @@ func init() {
 		"currval":                  CURRVAL,
 		"lastval":                  LASTVAL,
 		"publication":              PUBLICATION,
+		"plan":                     PLAN,
+		"baseline":                 BASELINE,
 		"subscriptions":            SUBSCRIPTIONS,
 		"publications":             PUBLICATIONS,
 	}
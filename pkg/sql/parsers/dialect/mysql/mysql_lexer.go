@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -52,6 +53,19 @@ type Lexer struct {
 	stmts      []tree.Statement
 	paramIndex int
 	lower      int64
+
+	// the type and the offset of the last token
+	lastToken  int
+	lastOffset int
+	// the offsets of the unqualified column names in expressions
+	identOffsets []int
+	// the statements in stored procedures waiting for the ';' following them
+	pendingStmts []pendingProcedureStmt
+}
+
+type pendingProcedureStmt struct {
+	stmt  *tree.ProcedureStmt
+	start int
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string, lower int64) *Lexer {
@@ -68,8 +82,11 @@ func (l *Lexer) GetParamIndex() int {
 }
 
 func (l *Lexer) Lex(lval *yySymType) int {
+	l.scanner.allowHints = l.lastToken == SELECT
 	typ, str := l.scanner.Scan()
 	l.scanner.LastToken = str
+	lval.offset = l.scanner.TokenStart
+	l.lastToken, l.lastOffset = typ, l.scanner.TokenStart
 
 	switch typ {
 	case INTEGRAL:
@@ -103,6 +120,53 @@ func (l *Lexer) AppendStmt(stmt tree.Statement) {
 	l.stmts = append(l.stmts, stmt)
 }
 
+// addIdentRef records the offset of a column name referenced in an expression, it may be
+// a local variable if the expression is in a stored procedure.
+func (l *Lexer) addIdentRef(name *tree.UnresolvedName, offset int) {
+	if name.NumParts == 1 && !name.Star && l.scanner.buf[offset] != '`' {
+		l.identOffsets = append(l.identOffsets, offset)
+	}
+}
+
+// procedureSource returns the source text between the offsets start and end.
+func (l *Lexer) procedureSource(start, end int) *tree.ProcedureSource {
+	text := strings.TrimRight(l.scanner.buf[start:end], " \t\r\n")
+	source := &tree.ProcedureSource{Text: text}
+	for _, offset := range l.identOffsets {
+		if offset >= start && offset < start+len(text) {
+			source.Idents = append(source.Idents, offset-start)
+		}
+	}
+	return source
+}
+
+// beginProcedureStmt wraps a statement in the body of a stored procedure, the source text
+// of it is filled by endProcedureStmts when the end of it is met.
+func (l *Lexer) beginProcedureStmt(stmt tree.Statement, start int) tree.Statement {
+	procStmt := &tree.ProcedureStmt{Stmt: stmt}
+	l.pendingStmts = append(l.pendingStmts, pendingProcedureStmt{stmt: procStmt, start: start})
+	return procStmt
+}
+
+// endProcedureStmts fills the source text of the pending statements, which all end at the
+// offset end, a handler and the statement of it end at the same ';'.
+func (l *Lexer) endProcedureStmts(end int) {
+	for _, pending := range l.pendingStmts {
+		pending.stmt.Source = l.procedureSource(pending.start, end)
+	}
+	l.pendingStmts = l.pendingStmts[:0]
+}
+
+// endProcedureBody fills the source text of the body of CREATE PROCEDURE, which ends before
+// the lookahead token if it has been read.
+func (l *Lexer) endProcedureBody() {
+	end := l.scanner.Pos
+	if l.lastToken == ';' || l.lastToken == 0 {
+		end = l.lastOffset
+	}
+	l.endProcedureStmts(end)
+}
+
 func (l *Lexer) toInt(lval *yySymType, str string) int {
 	ival, err := strconv.ParseUint(str, 10, 64)
 	if err != nil {
@@ -22,526 +22,569 @@ import (
 
 const LEX_ERROR = 57346
 const EMPTY = 57347
-const UNION = 57348
-const EXCEPT = 57349
-const INTERSECT = 57350
-const MINUS = 57351
-const SELECT = 57352
-const STREAM = 57353
-const INSERT = 57354
-const UPDATE = 57355
-const DELETE = 57356
-const FROM = 57357
-const WHERE = 57358
-const GROUP = 57359
-const HAVING = 57360
-const ORDER = 57361
-const BY = 57362
-const LIMIT = 57363
-const OFFSET = 57364
-const FOR = 57365
-const CONNECT = 57366
-const MANAGE = 57367
-const GRANTS = 57368
-const OWNERSHIP = 57369
-const REFERENCE = 57370
-const LOWER_THAN_SET = 57371
-const SET = 57372
-const ALL = 57373
-const DISTINCT = 57374
-const DISTINCTROW = 57375
-const AS = 57376
-const EXISTS = 57377
-const ASC = 57378
-const DESC = 57379
-const INTO = 57380
-const DUPLICATE = 57381
-const DEFAULT = 57382
-const LOCK = 57383
-const KEYS = 57384
-const NULLS = 57385
-const FIRST = 57386
-const LAST = 57387
-const VALUES = 57388
-const NEXT = 57389
-const VALUE = 57390
-const SHARE = 57391
-const MODE = 57392
-const SQL_NO_CACHE = 57393
-const SQL_CACHE = 57394
-const JOIN = 57395
-const STRAIGHT_JOIN = 57396
-const LEFT = 57397
-const RIGHT = 57398
-const INNER = 57399
-const OUTER = 57400
-const CROSS = 57401
-const NATURAL = 57402
-const USE = 57403
-const FORCE = 57404
-const LOWER_THAN_ON = 57405
-const ON = 57406
-const USING = 57407
-const SUBQUERY_AS_EXPR = 57408
-const LOWER_THAN_STRING = 57409
-const ID = 57410
-const AT_ID = 57411
-const AT_AT_ID = 57412
-const STRING = 57413
-const VALUE_ARG = 57414
-const LIST_ARG = 57415
-const COMMENT = 57416
-const COMMENT_KEYWORD = 57417
-const QUOTE_ID = 57418
-const INTEGRAL = 57419
-const HEX = 57420
-const BIT_LITERAL = 57421
-const FLOAT = 57422
-const HEXNUM = 57423
-const NULL = 57424
-const TRUE = 57425
-const FALSE = 57426
-const LOWER_THAN_CHARSET = 57427
-const CHARSET = 57428
-const UNIQUE = 57429
-const KEY = 57430
-const OR = 57431
-const PIPE_CONCAT = 57432
-const XOR = 57433
-const AND = 57434
-const NOT = 57435
-const BETWEEN = 57436
-const CASE = 57437
-const WHEN = 57438
-const THEN = 57439
-const ELSE = 57440
-const END = 57441
-const LOWER_THAN_EQ = 57442
-const LE = 57443
-const GE = 57444
-const NE = 57445
-const NULL_SAFE_EQUAL = 57446
-const IS = 57447
-const LIKE = 57448
-const REGEXP = 57449
-const IN = 57450
-const ASSIGNMENT = 57451
-const ILIKE = 57452
-const SHIFT_LEFT = 57453
-const SHIFT_RIGHT = 57454
-const DIV = 57455
-const MOD = 57456
-const UNARY = 57457
-const COLLATE = 57458
-const BINARY = 57459
-const UNDERSCORE_BINARY = 57460
-const INTERVAL = 57461
-const BEGIN = 57462
-const START = 57463
-const TRANSACTION = 57464
-const COMMIT = 57465
-const ROLLBACK = 57466
-const WORK = 57467
-const CONSISTENT = 57468
-const SNAPSHOT = 57469
-const CHAIN = 57470
-const NO = 57471
-const RELEASE = 57472
-const PRIORITY = 57473
-const QUICK = 57474
-const BIT = 57475
-const TINYINT = 57476
-const SMALLINT = 57477
-const MEDIUMINT = 57478
-const INT = 57479
-const INTEGER = 57480
-const BIGINT = 57481
-const INTNUM = 57482
-const REAL = 57483
-const DOUBLE = 57484
-const FLOAT_TYPE = 57485
-const DECIMAL = 57486
-const NUMERIC = 57487
-const DECIMAL_VALUE = 57488
-const TIME = 57489
-const TIMESTAMP = 57490
-const DATETIME = 57491
-const YEAR = 57492
-const CHAR = 57493
-const VARCHAR = 57494
-const BOOL = 57495
-const CHARACTER = 57496
-const VARBINARY = 57497
-const NCHAR = 57498
-const TEXT = 57499
-const TINYTEXT = 57500
-const MEDIUMTEXT = 57501
-const LONGTEXT = 57502
-const BLOB = 57503
-const TINYBLOB = 57504
-const MEDIUMBLOB = 57505
-const LONGBLOB = 57506
-const JSON = 57507
-const ENUM = 57508
-const UUID = 57509
-const GEOMETRY = 57510
-const POINT = 57511
-const LINESTRING = 57512
-const POLYGON = 57513
-const GEOMETRYCOLLECTION = 57514
-const MULTIPOINT = 57515
-const MULTILINESTRING = 57516
-const MULTIPOLYGON = 57517
-const INT1 = 57518
-const INT2 = 57519
-const INT3 = 57520
-const INT4 = 57521
-const INT8 = 57522
-const S3OPTION = 57523
-const SQL_SMALL_RESULT = 57524
-const SQL_BIG_RESULT = 57525
-const SQL_BUFFER_RESULT = 57526
-const LOW_PRIORITY = 57527
-const HIGH_PRIORITY = 57528
-const DELAYED = 57529
-const CREATE = 57530
-const ALTER = 57531
-const DROP = 57532
-const RENAME = 57533
-const ANALYZE = 57534
-const ADD = 57535
-const RETURNS = 57536
-const SCHEMA = 57537
-const TABLE = 57538
-const SEQUENCE = 57539
-const INDEX = 57540
-const VIEW = 57541
-const TO = 57542
-const IGNORE = 57543
-const IF = 57544
-const PRIMARY = 57545
-const COLUMN = 57546
-const CONSTRAINT = 57547
-const SPATIAL = 57548
-const FULLTEXT = 57549
-const FOREIGN = 57550
-const KEY_BLOCK_SIZE = 57551
-const SHOW = 57552
-const DESCRIBE = 57553
-const EXPLAIN = 57554
-const DATE = 57555
-const ESCAPE = 57556
-const REPAIR = 57557
-const OPTIMIZE = 57558
-const TRUNCATE = 57559
-const MAXVALUE = 57560
-const PARTITION = 57561
-const REORGANIZE = 57562
-const LESS = 57563
-const THAN = 57564
-const PROCEDURE = 57565
-const TRIGGER = 57566
-const STATUS = 57567
-const VARIABLES = 57568
-const ROLE = 57569
-const PROXY = 57570
-const AVG_ROW_LENGTH = 57571
-const STORAGE = 57572
-const DISK = 57573
-const MEMORY = 57574
-const CHECKSUM = 57575
-const COMPRESSION = 57576
-const DATA = 57577
-const DIRECTORY = 57578
-const DELAY_KEY_WRITE = 57579
-const ENCRYPTION = 57580
-const ENGINE = 57581
-const MAX_ROWS = 57582
-const MIN_ROWS = 57583
-const PACK_KEYS = 57584
-const ROW_FORMAT = 57585
-const STATS_AUTO_RECALC = 57586
-const STATS_PERSISTENT = 57587
-const STATS_SAMPLE_PAGES = 57588
-const DYNAMIC = 57589
-const COMPRESSED = 57590
-const REDUNDANT = 57591
-const COMPACT = 57592
-const FIXED = 57593
-const COLUMN_FORMAT = 57594
-const AUTO_RANDOM = 57595
-const RESTRICT = 57596
-const CASCADE = 57597
-const ACTION = 57598
-const PARTIAL = 57599
-const SIMPLE = 57600
-const CHECK = 57601
-const ENFORCED = 57602
-const RANGE = 57603
-const LIST = 57604
-const ALGORITHM = 57605
-const LINEAR = 57606
-const PARTITIONS = 57607
-const SUBPARTITION = 57608
-const SUBPARTITIONS = 57609
-const CLUSTER = 57610
-const TYPE = 57611
-const ANY = 57612
-const SOME = 57613
-const EXTERNAL = 57614
-const LOCALFILE = 57615
-const URL = 57616
-const PREPARE = 57617
-const DEALLOCATE = 57618
-const RESET = 57619
-const EXTENSION = 57620
-const INCREMENT = 57621
-const CYCLE = 57622
-const MINVALUE = 57623
-const PUBLICATION = 57624
-const SUBSCRIPTIONS = 57625
-const PUBLICATIONS = 57626
-const PROPERTIES = 57627
-const PARSER = 57628
-const VISIBLE = 57629
-const INVISIBLE = 57630
-const BTREE = 57631
-const HASH = 57632
-const RTREE = 57633
-const BSI = 57634
-const ZONEMAP = 57635
-const LEADING = 57636
-const BOTH = 57637
-const TRAILING = 57638
-const UNKNOWN = 57639
-const EXPIRE = 57640
-const ACCOUNT = 57641
-const ACCOUNTS = 57642
-const UNLOCK = 57643
-const DAY = 57644
-const NEVER = 57645
-const PUMP = 57646
-const MYSQL_COMPATBILITY_MODE = 57647
-const SECOND = 57648
-const ASCII = 57649
-const COALESCE = 57650
-const COLLATION = 57651
-const HOUR = 57652
-const MICROSECOND = 57653
-const MINUTE = 57654
-const MONTH = 57655
-const QUARTER = 57656
-const REPEAT = 57657
-const REVERSE = 57658
-const ROW_COUNT = 57659
-const WEEK = 57660
-const REVOKE = 57661
-const FUNCTION = 57662
-const PRIVILEGES = 57663
-const TABLESPACE = 57664
-const EXECUTE = 57665
-const SUPER = 57666
-const GRANT = 57667
-const OPTION = 57668
-const REFERENCES = 57669
-const REPLICATION = 57670
-const SLAVE = 57671
-const CLIENT = 57672
-const USAGE = 57673
-const RELOAD = 57674
-const FILE = 57675
-const TEMPORARY = 57676
-const ROUTINE = 57677
-const EVENT = 57678
-const SHUTDOWN = 57679
-const NULLX = 57680
-const AUTO_INCREMENT = 57681
-const APPROXNUM = 57682
-const SIGNED = 57683
-const UNSIGNED = 57684
-const ZEROFILL = 57685
-const ENGINES = 57686
-const LOW_CARDINALITY = 57687
-const ADMIN_NAME = 57688
-const RANDOM = 57689
-const SUSPEND = 57690
-const ATTRIBUTE = 57691
-const HISTORY = 57692
-const REUSE = 57693
-const CURRENT = 57694
-const OPTIONAL = 57695
-const FAILED_LOGIN_ATTEMPTS = 57696
-const PASSWORD_LOCK_TIME = 57697
-const UNBOUNDED = 57698
-const SECONDARY = 57699
-const USER = 57700
-const IDENTIFIED = 57701
-const CIPHER = 57702
-const ISSUER = 57703
-const X509 = 57704
-const SUBJECT = 57705
-const SAN = 57706
-const REQUIRE = 57707
-const SSL = 57708
-const NONE = 57709
-const PASSWORD = 57710
-const MAX_QUERIES_PER_HOUR = 57711
-const MAX_UPDATES_PER_HOUR = 57712
-const MAX_CONNECTIONS_PER_HOUR = 57713
-const MAX_USER_CONNECTIONS = 57714
-const FORMAT = 57715
-const VERBOSE = 57716
-const CONNECTION = 57717
-const TRIGGERS = 57718
-const PROFILES = 57719
-const LOAD = 57720
-const INFILE = 57721
-const TERMINATED = 57722
-const OPTIONALLY = 57723
-const ENCLOSED = 57724
-const ESCAPED = 57725
-const STARTING = 57726
-const LINES = 57727
-const ROWS = 57728
-const IMPORT = 57729
-const MODUMP = 57730
-const OVER = 57731
-const PRECEDING = 57732
-const FOLLOWING = 57733
-const GROUPS = 57734
-const DATABASES = 57735
-const TABLES = 57736
-const SEQUENCES = 57737
-const EXTENDED = 57738
-const FULL = 57739
-const PROCESSLIST = 57740
-const FIELDS = 57741
-const COLUMNS = 57742
-const OPEN = 57743
-const ERRORS = 57744
-const WARNINGS = 57745
-const INDEXES = 57746
-const SCHEMAS = 57747
-const NODE = 57748
-const LOCKS = 57749
-const TABLE_NUMBER = 57750
-const COLUMN_NUMBER = 57751
-const TABLE_VALUES = 57752
-const TABLE_SIZE = 57753
-const NAMES = 57754
-const GLOBAL = 57755
-const SESSION = 57756
-const ISOLATION = 57757
-const LEVEL = 57758
-const READ = 57759
-const WRITE = 57760
-const ONLY = 57761
-const REPEATABLE = 57762
-const COMMITTED = 57763
-const UNCOMMITTED = 57764
-const SERIALIZABLE = 57765
-const LOCAL = 57766
-const EVENTS = 57767
-const PLUGINS = 57768
-const CURRENT_TIMESTAMP = 57769
-const DATABASE = 57770
-const CURRENT_TIME = 57771
-const LOCALTIME = 57772
-const LOCALTIMESTAMP = 57773
-const UTC_DATE = 57774
-const UTC_TIME = 57775
-const UTC_TIMESTAMP = 57776
-const REPLACE = 57777
-const CONVERT = 57778
-const SEPARATOR = 57779
-const TIMESTAMPDIFF = 57780
-const CURRENT_DATE = 57781
-const CURRENT_USER = 57782
-const CURRENT_ROLE = 57783
-const SECOND_MICROSECOND = 57784
-const MINUTE_MICROSECOND = 57785
-const MINUTE_SECOND = 57786
-const HOUR_MICROSECOND = 57787
-const HOUR_SECOND = 57788
-const HOUR_MINUTE = 57789
-const DAY_MICROSECOND = 57790
-const DAY_SECOND = 57791
-const DAY_MINUTE = 57792
-const DAY_HOUR = 57793
-const YEAR_MONTH = 57794
-const SQL_TSI_HOUR = 57795
-const SQL_TSI_DAY = 57796
-const SQL_TSI_WEEK = 57797
-const SQL_TSI_MONTH = 57798
-const SQL_TSI_QUARTER = 57799
-const SQL_TSI_YEAR = 57800
-const SQL_TSI_SECOND = 57801
-const SQL_TSI_MINUTE = 57802
-const RECURSIVE = 57803
-const CONFIG = 57804
-const DRAINER = 57805
-const MATCH = 57806
-const AGAINST = 57807
-const BOOLEAN = 57808
-const LANGUAGE = 57809
-const WITH = 57810
-const QUERY = 57811
-const EXPANSION = 57812
-const ADDDATE = 57813
-const BIT_AND = 57814
-const BIT_OR = 57815
-const BIT_XOR = 57816
-const CAST = 57817
-const COUNT = 57818
-const APPROX_COUNT_DISTINCT = 57819
-const APPROX_PERCENTILE = 57820
-const CURDATE = 57821
-const CURTIME = 57822
-const DATE_ADD = 57823
-const DATE_SUB = 57824
-const EXTRACT = 57825
-const GROUP_CONCAT = 57826
-const MAX = 57827
-const MID = 57828
-const MIN = 57829
-const NOW = 57830
-const POSITION = 57831
-const SESSION_USER = 57832
-const STD = 57833
-const STDDEV = 57834
-const MEDIAN = 57835
-const STDDEV_POP = 57836
-const STDDEV_SAMP = 57837
-const SUBDATE = 57838
-const SUBSTR = 57839
-const SUBSTRING = 57840
-const SUM = 57841
-const SYSDATE = 57842
-const SYSTEM_USER = 57843
-const TRANSLATE = 57844
-const TRIM = 57845
-const VARIANCE = 57846
-const VAR_POP = 57847
-const VAR_SAMP = 57848
-const AVG = 57849
-const RANK = 57850
-const NEXTVAL = 57851
-const SETVAL = 57852
-const CURRVAL = 57853
-const LASTVAL = 57854
-const ARROW = 57855
-const ROW = 57856
-const OUTFILE = 57857
-const HEADER = 57858
-const MAX_FILE_SIZE = 57859
-const FORCE_QUOTE = 57860
-const PARALLEL = 57861
-const UNUSED = 57862
-const BINDINGS = 57863
-const DO = 57864
-const DECLARE = 57865
-const KILL = 57866
-const QUERY_RESULT = 57867
+const LOWER_THAN_INTO = 57348
+const INTO = 57349
+const UNION = 57350
+const EXCEPT = 57351
+const INTERSECT = 57352
+const MINUS = 57353
+const SELECT = 57354
+const STREAM = 57355
+const INSERT = 57356
+const UPDATE = 57357
+const DELETE = 57358
+const FROM = 57359
+const WHERE = 57360
+const GROUP = 57361
+const HAVING = 57362
+const ORDER = 57363
+const BY = 57364
+const LIMIT = 57365
+const OFFSET = 57366
+const FOR = 57367
+const CONNECT = 57368
+const MANAGE = 57369
+const GRANTS = 57370
+const OWNERSHIP = 57371
+const REFERENCE = 57372
+const LOWER_THAN_SET = 57373
+const SET = 57374
+const ALL = 57375
+const DISTINCT = 57376
+const DISTINCTROW = 57377
+const AS = 57378
+const EXISTS = 57379
+const ASC = 57380
+const DESC = 57381
+const DUPLICATE = 57382
+const DEFAULT = 57383
+const LOCK = 57384
+const KEYS = 57385
+const NULLS = 57386
+const FIRST = 57387
+const LAST = 57388
+const VALUES = 57389
+const NEXT = 57390
+const VALUE = 57391
+const SHARE = 57392
+const MODE = 57393
+const SQL_NO_CACHE = 57394
+const SQL_CACHE = 57395
+const JOIN = 57396
+const STRAIGHT_JOIN = 57397
+const LEFT = 57398
+const RIGHT = 57399
+const INNER = 57400
+const OUTER = 57401
+const CROSS = 57402
+const NATURAL = 57403
+const USE = 57404
+const FORCE = 57405
+const LOWER_THAN_ON = 57406
+const ON = 57407
+const USING = 57408
+const SUBQUERY_AS_EXPR = 57409
+const LOWER_THAN_STRING = 57410
+const ID = 57411
+const AT_ID = 57412
+const AT_AT_ID = 57413
+const STRING = 57414
+const VALUE_ARG = 57415
+const LIST_ARG = 57416
+const COMMENT = 57417
+const COMMENT_KEYWORD = 57418
+const QUOTE_ID = 57419
+const INTEGRAL = 57420
+const HEX = 57421
+const BIT_LITERAL = 57422
+const FLOAT = 57423
+const HEXNUM = 57424
+const NULL = 57425
+const TRUE = 57426
+const FALSE = 57427
+const LOWER_THAN_CHARSET = 57428
+const CHARSET = 57429
+const UNIQUE = 57430
+const KEY = 57431
+const OR = 57432
+const PIPE_CONCAT = 57433
+const XOR = 57434
+const AND = 57435
+const NOT = 57436
+const BETWEEN = 57437
+const CASE = 57438
+const WHEN = 57439
+const THEN = 57440
+const ELSE = 57441
+const END = 57442
+const LOWER_THAN_EQ = 57443
+const LE = 57444
+const GE = 57445
+const NE = 57446
+const NULL_SAFE_EQUAL = 57447
+const IS = 57448
+const LIKE = 57449
+const REGEXP = 57450
+const IN = 57451
+const ASSIGNMENT = 57452
+const ILIKE = 57453
+const MEMBER = 57454
+const SHIFT_LEFT = 57455
+const SHIFT_RIGHT = 57456
+const DIV = 57457
+const MOD = 57458
+const UNARY = 57459
+const COLLATE = 57460
+const BINARY = 57461
+const UNDERSCORE_BINARY = 57462
+const INTERVAL = 57463
+const BEGIN = 57464
+const START = 57465
+const TRANSACTION = 57466
+const COMMIT = 57467
+const ROLLBACK = 57468
+const WORK = 57469
+const CONSISTENT = 57470
+const SNAPSHOT = 57471
+const CHAIN = 57472
+const NO = 57473
+const RELEASE = 57474
+const PRIORITY = 57475
+const QUICK = 57476
+const BIT = 57477
+const TINYINT = 57478
+const SMALLINT = 57479
+const MEDIUMINT = 57480
+const INT = 57481
+const INTEGER = 57482
+const BIGINT = 57483
+const INTNUM = 57484
+const REAL = 57485
+const DOUBLE = 57486
+const FLOAT_TYPE = 57487
+const DECIMAL = 57488
+const NUMERIC = 57489
+const DECIMAL_VALUE = 57490
+const TIME = 57491
+const TIMESTAMP = 57492
+const DATETIME = 57493
+const YEAR = 57494
+const CHAR = 57495
+const VARCHAR = 57496
+const BOOL = 57497
+const CHARACTER = 57498
+const VARBINARY = 57499
+const NCHAR = 57500
+const TEXT = 57501
+const TINYTEXT = 57502
+const MEDIUMTEXT = 57503
+const LONGTEXT = 57504
+const BLOB = 57505
+const TINYBLOB = 57506
+const MEDIUMBLOB = 57507
+const LONGBLOB = 57508
+const JSON = 57509
+const ENUM = 57510
+const UUID = 57511
+const GEOMETRY = 57512
+const POINT = 57513
+const LINESTRING = 57514
+const POLYGON = 57515
+const GEOMETRYCOLLECTION = 57516
+const MULTIPOINT = 57517
+const MULTILINESTRING = 57518
+const MULTIPOLYGON = 57519
+const INT1 = 57520
+const INT2 = 57521
+const INT3 = 57522
+const INT4 = 57523
+const INT8 = 57524
+const S3OPTION = 57525
+const SQL_SMALL_RESULT = 57526
+const SQL_BIG_RESULT = 57527
+const SQL_BUFFER_RESULT = 57528
+const LOW_PRIORITY = 57529
+const HIGH_PRIORITY = 57530
+const DELAYED = 57531
+const CREATE = 57532
+const ALTER = 57533
+const DROP = 57534
+const RENAME = 57535
+const ANALYZE = 57536
+const ADD = 57537
+const RETURNS = 57538
+const SCHEMA = 57539
+const TABLE = 57540
+const SEQUENCE = 57541
+const INDEX = 57542
+const VIEW = 57543
+const TO = 57544
+const IGNORE = 57545
+const IF = 57546
+const PRIMARY = 57547
+const COLUMN = 57548
+const CONSTRAINT = 57549
+const SPATIAL = 57550
+const FULLTEXT = 57551
+const FOREIGN = 57552
+const KEY_BLOCK_SIZE = 57553
+const SHOW = 57554
+const DESCRIBE = 57555
+const EXPLAIN = 57556
+const DATE = 57557
+const ESCAPE = 57558
+const REPAIR = 57559
+const OPTIMIZE = 57560
+const TRUNCATE = 57561
+const MAXVALUE = 57562
+const PARTITION = 57563
+const REORGANIZE = 57564
+const LESS = 57565
+const THAN = 57566
+const PROCEDURE = 57567
+const TRIGGER = 57568
+const STATUS = 57569
+const VARIABLES = 57570
+const ROLE = 57571
+const PROXY = 57572
+const AVG_ROW_LENGTH = 57573
+const STORAGE = 57574
+const DISK = 57575
+const MEMORY = 57576
+const CHECKSUM = 57577
+const COMPRESSION = 57578
+const DATA = 57579
+const DIRECTORY = 57580
+const DELAY_KEY_WRITE = 57581
+const ENCRYPTION = 57582
+const ENGINE = 57583
+const MAX_ROWS = 57584
+const MIN_ROWS = 57585
+const PACK_KEYS = 57586
+const ROW_FORMAT = 57587
+const STATS_AUTO_RECALC = 57588
+const STATS_PERSISTENT = 57589
+const STATS_SAMPLE_PAGES = 57590
+const DYNAMIC = 57591
+const COMPRESSED = 57592
+const REDUNDANT = 57593
+const COMPACT = 57594
+const FIXED = 57595
+const COLUMN_FORMAT = 57596
+const AUTO_RANDOM = 57597
+const RESTRICT = 57598
+const CASCADE = 57599
+const ACTION = 57600
+const PARTIAL = 57601
+const SIMPLE = 57602
+const CHECK = 57603
+const ENFORCED = 57604
+const GENERATED = 57605
+const ALWAYS = 57606
+const STORED = 57607
+const VIRTUAL = 57608
+const RANGE = 57609
+const LIST = 57610
+const ALGORITHM = 57611
+const LINEAR = 57612
+const PARTITIONS = 57613
+const SUBPARTITION = 57614
+const SUBPARTITIONS = 57615
+const CLUSTER = 57616
+const TYPE = 57617
+const ANY = 57618
+const SOME = 57619
+const EXTERNAL = 57620
+const LOCALFILE = 57621
+const URL = 57622
+const PREPARE = 57623
+const DEALLOCATE = 57624
+const RESET = 57625
+const EXTENSION = 57626
+const INCREMENT = 57627
+const CYCLE = 57628
+const MINVALUE = 57629
+const PUBLICATION = 57630
+const SUBSCRIPTIONS = 57631
+const PUBLICATIONS = 57632
+const OPTIMIZER_HINTS = 57633
+const PLAN = 57634
+const BASELINE = 57635
+const PROPERTIES = 57636
+const PARSER = 57637
+const VISIBLE = 57638
+const INVISIBLE = 57639
+const BTREE = 57640
+const HASH = 57641
+const RTREE = 57642
+const BSI = 57643
+const ZONEMAP = 57644
+const LEADING = 57645
+const BOTH = 57646
+const TRAILING = 57647
+const UNKNOWN = 57648
+const EXPIRE = 57649
+const ACCOUNT = 57650
+const ACCOUNTS = 57651
+const UNLOCK = 57652
+const DAY = 57653
+const NEVER = 57654
+const PUMP = 57655
+const MYSQL_COMPATBILITY_MODE = 57656
+const SECOND = 57657
+const ASCII = 57658
+const COALESCE = 57659
+const COLLATION = 57660
+const HOUR = 57661
+const MICROSECOND = 57662
+const MINUTE = 57663
+const MONTH = 57664
+const QUARTER = 57665
+const REPEAT = 57666
+const REVERSE = 57667
+const ROW_COUNT = 57668
+const WEEK = 57669
+const REVOKE = 57670
+const FUNCTION = 57671
+const PRIVILEGES = 57672
+const TABLESPACE = 57673
+const EXECUTE = 57674
+const SUPER = 57675
+const GRANT = 57676
+const OPTION = 57677
+const REFERENCES = 57678
+const REPLICATION = 57679
+const SLAVE = 57680
+const CLIENT = 57681
+const USAGE = 57682
+const RELOAD = 57683
+const FILE = 57684
+const TEMPORARY = 57685
+const ROUTINE = 57686
+const EVENT = 57687
+const SHUTDOWN = 57688
+const NULLX = 57689
+const AUTO_INCREMENT = 57690
+const APPROXNUM = 57691
+const SIGNED = 57692
+const UNSIGNED = 57693
+const ZEROFILL = 57694
+const ENGINES = 57695
+const LOW_CARDINALITY = 57696
+const ADMIN_NAME = 57697
+const RANDOM = 57698
+const SUSPEND = 57699
+const ATTRIBUTE = 57700
+const HISTORY = 57701
+const REUSE = 57702
+const CURRENT = 57703
+const OPTIONAL = 57704
+const FAILED_LOGIN_ATTEMPTS = 57705
+const PASSWORD_LOCK_TIME = 57706
+const UNBOUNDED = 57707
+const SECONDARY = 57708
+const USER = 57709
+const IDENTIFIED = 57710
+const CIPHER = 57711
+const ISSUER = 57712
+const X509 = 57713
+const SUBJECT = 57714
+const SAN = 57715
+const REQUIRE = 57716
+const SSL = 57717
+const NONE = 57718
+const PASSWORD = 57719
+const MAX_QUERIES_PER_HOUR = 57720
+const MAX_UPDATES_PER_HOUR = 57721
+const MAX_CONNECTIONS_PER_HOUR = 57722
+const MAX_USER_CONNECTIONS = 57723
+const FORMAT = 57724
+const VERBOSE = 57725
+const CONNECTION = 57726
+const TRIGGERS = 57727
+const PROFILES = 57728
+const LOAD = 57729
+const INFILE = 57730
+const TERMINATED = 57731
+const OPTIONALLY = 57732
+const ENCLOSED = 57733
+const ESCAPED = 57734
+const STARTING = 57735
+const LINES = 57736
+const ROWS = 57737
+const IMPORT = 57738
+const MODUMP = 57739
+const OVER = 57740
+const PRECEDING = 57741
+const FOLLOWING = 57742
+const GROUPS = 57743
+const DATABASES = 57744
+const TABLES = 57745
+const SEQUENCES = 57746
+const EXTENDED = 57747
+const FULL = 57748
+const PROCESSLIST = 57749
+const FIELDS = 57750
+const COLUMNS = 57751
+const OPEN = 57752
+const ERRORS = 57753
+const WARNINGS = 57754
+const INDEXES = 57755
+const SCHEMAS = 57756
+const NODE = 57757
+const LOCKS = 57758
+const TABLE_NUMBER = 57759
+const COLUMN_NUMBER = 57760
+const TABLE_VALUES = 57761
+const TABLE_SIZE = 57762
+const NAMES = 57763
+const GLOBAL = 57764
+const SESSION = 57765
+const ISOLATION = 57766
+const LEVEL = 57767
+const READ = 57768
+const WRITE = 57769
+const ONLY = 57770
+const REPEATABLE = 57771
+const COMMITTED = 57772
+const UNCOMMITTED = 57773
+const SERIALIZABLE = 57774
+const LOCAL = 57775
+const EVENTS = 57776
+const PLUGINS = 57777
+const CURRENT_TIMESTAMP = 57778
+const DATABASE = 57779
+const CURRENT_TIME = 57780
+const LOCALTIME = 57781
+const LOCALTIMESTAMP = 57782
+const UTC_DATE = 57783
+const UTC_TIME = 57784
+const UTC_TIMESTAMP = 57785
+const REPLACE = 57786
+const CONVERT = 57787
+const SEPARATOR = 57788
+const TIMESTAMPDIFF = 57789
+const CURRENT_DATE = 57790
+const CURRENT_USER = 57791
+const CURRENT_ROLE = 57792
+const SECOND_MICROSECOND = 57793
+const MINUTE_MICROSECOND = 57794
+const MINUTE_SECOND = 57795
+const HOUR_MICROSECOND = 57796
+const HOUR_SECOND = 57797
+const HOUR_MINUTE = 57798
+const DAY_MICROSECOND = 57799
+const DAY_SECOND = 57800
+const DAY_MINUTE = 57801
+const DAY_HOUR = 57802
+const YEAR_MONTH = 57803
+const SQL_TSI_HOUR = 57804
+const SQL_TSI_DAY = 57805
+const SQL_TSI_WEEK = 57806
+const SQL_TSI_MONTH = 57807
+const SQL_TSI_QUARTER = 57808
+const SQL_TSI_YEAR = 57809
+const SQL_TSI_SECOND = 57810
+const SQL_TSI_MINUTE = 57811
+const RECURSIVE = 57812
+const CONFIG = 57813
+const DRAINER = 57814
+const MATCH = 57815
+const AGAINST = 57816
+const BOOLEAN = 57817
+const LANGUAGE = 57818
+const WITH = 57819
+const QUERY = 57820
+const EXPANSION = 57821
+const ADDDATE = 57822
+const BIT_AND = 57823
+const BIT_OR = 57824
+const BIT_XOR = 57825
+const CAST = 57826
+const COUNT = 57827
+const APPROX_COUNT_DISTINCT = 57828
+const APPROX_PERCENTILE = 57829
+const CURDATE = 57830
+const CURTIME = 57831
+const DATE_ADD = 57832
+const DATE_SUB = 57833
+const EXTRACT = 57834
+const GROUP_CONCAT = 57835
+const MAX = 57836
+const MID = 57837
+const MIN = 57838
+const NOW = 57839
+const POSITION = 57840
+const SESSION_USER = 57841
+const STD = 57842
+const STDDEV = 57843
+const MEDIAN = 57844
+const STDDEV_POP = 57845
+const STDDEV_SAMP = 57846
+const SUBDATE = 57847
+const SUBSTR = 57848
+const SUBSTRING = 57849
+const SUM = 57850
+const SYSDATE = 57851
+const SYSTEM_USER = 57852
+const TRANSLATE = 57853
+const TRIM = 57854
+const VARIANCE = 57855
+const VAR_POP = 57856
+const VAR_SAMP = 57857
+const AVG = 57858
+const RANK = 57859
+const NEXTVAL = 57860
+const SETVAL = 57861
+const CURRVAL = 57862
+const LASTVAL = 57863
+const ARROW = 57864
+const LONG_ARROW = 57865
+const ARRAY = 57866
+const ROW = 57867
+const OUTFILE = 57868
+const HEADER = 57869
+const MAX_FILE_SIZE = 57870
+const FORCE_QUOTE = 57871
+const PARALLEL = 57872
+const UNUSED = 57873
+const BINDINGS = 57874
+const MODIFY = 57875
+const CHANGE = 57876
+const AFTER = 57877
+const ROLLUP = 57878
+const CUBE = 57879
+const GROUPING = 57880
+const SETS = 57881
+const SAVEPOINT = 57882
+const OF = 57883
+const JSON_TABLE = 57884
+const ORDINALITY = 57885
+const NESTED = 57886
+const PATH = 57887
+const DO = 57888
+const DECLARE = 57889
+const CALL = 57890
+const CURSOR = 57891
+const HANDLER = 57892
+const CONTINUE = 57893
+const EXIT = 57894
+const SQLEXCEPTION = 57895
+const SQLWARNING = 57896
+const SQLSTATE = 57897
+const FOUND = 57898
+const ELSEIF = 57899
+const WHILE = 57900
+const LOOP = 57901
+const LEAVE = 57902
+const ITERATE = 57903
+const UNTIL = 57904
+const FETCH = 57905
+const CLOSE = 57906
+const OUT = 57907
+const INOUT = 57908
+const KILL = 57909
+const QUERY_RESULT = 57910
 
 var yyToknames = [...]string{
 	"$end",
@@ -549,6 +592,8 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"EMPTY",
+	"LOWER_THAN_INTO",
+	"INTO",
 	"UNION",
 	"EXCEPT",
 	"INTERSECT",
@@ -581,7 +626,6 @@ var yyToknames = [...]string{
 	"EXISTS",
 	"ASC",
 	"DESC",
-	"INTO",
 	"DUPLICATE",
 	"DEFAULT",
 	"LOCK",
@@ -660,6 +704,7 @@ var yyToknames = [...]string{
 	"IN",
 	"ASSIGNMENT",
 	"ILIKE",
+	"MEMBER",
 	"'|'",
 	"'&'",
 	"SHIFT_LEFT",
@@ -821,6 +866,10 @@ var yyToknames = [...]string{
 	"SIMPLE",
 	"CHECK",
 	"ENFORCED",
+	"GENERATED",
+	"ALWAYS",
+	"STORED",
+	"VIRTUAL",
 	"RANGE",
 	"LIST",
 	"ALGORITHM",
@@ -845,6 +894,9 @@ var yyToknames = [...]string{
 	"PUBLICATION",
 	"SUBSCRIPTIONS",
 	"PUBLICATIONS",
+	"OPTIMIZER_HINTS",
+	"PLAN",
+	"BASELINE",
 	"PROPERTIES",
 	"PARSER",
 	"VISIBLE",
@@ -1074,6 +1126,8 @@ var yyToknames = [...]string{
 	"CURRVAL",
 	"LASTVAL",
 	"ARROW",
+	"LONG_ARROW",
+	"ARRAY",
 	"ROW",
 	"OUTFILE",
 	"HEADER",
@@ -1082,14 +1136,47 @@ var yyToknames = [...]string{
 	"PARALLEL",
 	"UNUSED",
 	"BINDINGS",
+	"MODIFY",
+	"CHANGE",
+	"AFTER",
+	"ROLLUP",
+	"CUBE",
+	"GROUPING",
+	"SETS",
+	"SAVEPOINT",
+	"OF",
+	"JSON_TABLE",
+	"ORDINALITY",
+	"NESTED",
+	"PATH",
 	"DO",
 	"DECLARE",
+	"CALL",
+	"CURSOR",
+	"HANDLER",
+	"CONTINUE",
+	"EXIT",
+	"SQLEXCEPTION",
+	"SQLWARNING",
+	"SQLSTATE",
+	"FOUND",
+	"ELSEIF",
+	"WHILE",
+	"LOOP",
+	"LEAVE",
+	"ITERATE",
+	"UNTIL",
+	"FETCH",
+	"CLOSE",
+	"OUT",
+	"INOUT",
 	"KILL",
 	"QUERY_RESULT",
 	"';'",
 	"'@'",
 	"'{'",
 	"'}'",
+	"':'",
 }
 
 var yyStatenames = [...]string{}
@@ -1098,3589 +1185,5181 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:8952
+//line mysql_sql.y:9919
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 101,
-	21, 573,
-	-2, 554,
-	-1, 110,
-	215, 776,
-	-2, 847,
-	-1, 131,
-	42, 398,
-	215, 398,
-	242, 405,
-	243, 405,
-	421, 398,
-	-2, 431,
-	-1, 457,
-	291, 91,
-	396, 91,
-	-2, 1417,
-	-1, 520,
-	67, 1223,
-	-2, 1557,
-	-1, 521,
-	67, 1241,
-	-2, 1528,
-	-1, 525,
-	67, 1242,
-	-2, 1556,
-	-1, 548,
-	67, 1155,
-	-2, 1616,
-	-1, 549,
-	67, 1156,
-	-2, 1615,
-	-1, 550,
-	67, 1157,
-	-2, 1605,
+	-1, 106,
+	23, 615,
+	-2, 596,
+	-1, 117,
+	217, 852,
+	-2, 923,
+	-1, 140,
+	43, 437,
+	217, 437,
+	244, 444,
+	245, 444,
+	430, 437,
+	-2, 470,
+	-1, 486,
+	297, 92,
+	405, 92,
+	-2, 1596,
 	-1, 551,
-	67, 1580,
-	-2, 1600,
+	68, 1318,
+	-2, 1744,
 	-1, 552,
-	67, 1581,
-	-2, 1601,
-	-1, 553,
-	67, 1582,
-	-2, 1607,
-	-1, 554,
-	67, 1583,
-	-2, 1590,
-	-1, 555,
-	67, 1584,
-	-2, 1598,
+	68, 1336,
+	-2, 1715,
 	-1, 556,
-	67, 1585,
-	-2, 1608,
-	-1, 557,
-	67, 1586,
-	-2, 1609,
-	-1, 558,
-	67, 1587,
-	-2, 1614,
-	-1, 559,
-	67, 1588,
-	-2, 1619,
-	-1, 560,
-	67, 1589,
-	-2, 1620,
-	-1, 562,
-	67, 1220,
-	-2, 1409,
-	-1, 569,
-	67, 1229,
-	-2, 1435,
-	-1, 573,
-	67, 1233,
-	-2, 1474,
-	-1, 574,
-	67, 1234,
-	-2, 1552,
+	68, 1337,
+	-2, 1743,
+	-1, 579,
+	68, 1249,
+	-2, 1809,
+	-1, 580,
+	68, 1250,
+	-2, 1808,
+	-1, 581,
+	68, 1251,
+	-2, 1798,
 	-1, 582,
-	67, 1244,
-	-2, 1537,
+	68, 1773,
+	-2, 1793,
+	-1, 583,
+	68, 1774,
+	-2, 1794,
 	-1, 584,
-	67, 1246,
-	-2, 1547,
+	68, 1775,
+	-2, 1800,
 	-1, 585,
-	67, 1247,
-	-2, 1571,
-	-1, 596,
-	67, 1133,
-	-2, 1610,
-	-1, 597,
-	67, 1134,
-	-2, 1611,
-	-1, 598,
-	67, 1135,
-	-2, 1612,
+	68, 1776,
+	-2, 1783,
+	-1, 586,
+	68, 1777,
+	-2, 1791,
+	-1, 587,
+	68, 1778,
+	-2, 1801,
+	-1, 588,
+	68, 1779,
+	-2, 1802,
+	-1, 589,
+	68, 1780,
+	-2, 1807,
+	-1, 590,
+	68, 1781,
+	-2, 1812,
+	-1, 591,
+	68, 1782,
+	-2, 1813,
+	-1, 593,
+	68, 1315,
+	-2, 1588,
+	-1, 600,
+	68, 1324,
+	-2, 1618,
+	-1, 604,
+	68, 1328,
+	-2, 1658,
 	-1, 605,
-	21, 574,
-	-2, 537,
-	-1, 664,
-	416, 431,
-	417, 431,
-	-2, 399,
-	-1, 713,
-	104, 1409,
-	115, 1409,
-	135, 1409,
-	-2, 1384,
-	-1, 751,
-	21, 574,
-	-2, 537,
-	-1, 850,
-	21, 573,
-	-2, 1038,
-	-1, 1192,
-	67, 1291,
-	-2, 1554,
-	-1, 1193,
-	67, 1292,
-	-2, 1555,
-	-1, 1407,
-	1, 306,
-	68, 306,
-	543, 306,
-	-2, 811,
-	-1, 1649,
-	68, 1370,
-	136, 1370,
-	-2, 1539,
-	-1, 1650,
-	68, 1370,
-	136, 1370,
-	-2, 1538,
-	-1, 1651,
-	68, 1348,
-	136, 1348,
-	-2, 1525,
-	-1, 1652,
-	68, 1349,
-	136, 1349,
-	-2, 1530,
-	-1, 1653,
-	68, 1350,
-	136, 1350,
-	-2, 1462,
-	-1, 1654,
-	68, 1351,
-	136, 1351,
-	-2, 1456,
-	-1, 1655,
-	68, 1352,
-	136, 1352,
-	-2, 1400,
-	-1, 1656,
-	68, 1353,
-	136, 1353,
-	-2, 1527,
-	-1, 1657,
-	68, 1354,
-	136, 1354,
-	-2, 1460,
-	-1, 1658,
-	68, 1355,
-	136, 1355,
-	-2, 1455,
-	-1, 1659,
-	68, 1356,
-	136, 1356,
-	-2, 1448,
-	-1, 1661,
-	68, 1359,
-	136, 1359,
-	-2, 1571,
-	-1, 1662,
-	68, 1339,
-	136, 1339,
-	-2, 1557,
-	-1, 1663,
-	68, 1368,
-	136, 1368,
-	-2, 1528,
-	-1, 1664,
-	68, 1368,
-	136, 1368,
-	-2, 1556,
-	-1, 1665,
-	68, 1368,
-	136, 1368,
-	-2, 1418,
-	-1, 1666,
-	68, 1366,
-	136, 1366,
-	-2, 1547,
-	-1, 1667,
-	68, 1363,
-	136, 1363,
-	-2, 1440,
-	-1, 1668,
-	67, 1321,
-	68, 1321,
-	136, 1321,
-	358, 1321,
-	359, 1321,
-	360, 1321,
-	-2, 1399,
-	-1, 1669,
-	67, 1322,
-	68, 1322,
-	136, 1322,
-	358, 1322,
-	359, 1322,
-	360, 1322,
-	-2, 1401,
-	-1, 1670,
-	67, 1325,
-	68, 1325,
-	136, 1325,
-	358, 1325,
-	359, 1325,
-	360, 1325,
-	-2, 1529,
-	-1, 1671,
-	67, 1327,
-	68, 1327,
-	136, 1327,
-	358, 1327,
-	359, 1327,
-	360, 1327,
-	-2, 1512,
-	-1, 1672,
-	67, 1329,
 	68, 1329,
-	136, 1329,
-	358, 1329,
-	359, 1329,
-	360, 1329,
-	-2, 1461,
-	-1, 1673,
-	67, 1331,
-	68, 1331,
-	136, 1331,
-	358, 1331,
-	359, 1331,
-	360, 1331,
-	-2, 1444,
-	-1, 1674,
-	67, 1332,
-	68, 1332,
-	136, 1332,
-	358, 1332,
-	359, 1332,
-	360, 1332,
-	-2, 1445,
-	-1, 1675,
-	67, 1334,
-	68, 1334,
-	136, 1334,
-	358, 1334,
-	359, 1334,
-	360, 1334,
-	-2, 1398,
-	-1, 1676,
-	68, 1373,
-	136, 1373,
-	358, 1373,
-	359, 1373,
-	360, 1373,
-	-2, 1423,
-	-1, 1677,
-	68, 1373,
-	136, 1373,
-	358, 1373,
-	359, 1373,
-	360, 1373,
-	-2, 1436,
-	-1, 1678,
-	68, 1376,
-	136, 1376,
-	358, 1376,
-	359, 1376,
-	360, 1376,
-	-2, 1419,
-	-1, 1679,
-	68, 1373,
-	136, 1373,
-	358, 1373,
-	359, 1373,
-	360, 1373,
-	-2, 1497,
-	-1, 1692,
-	1, 804,
-	68, 804,
-	543, 804,
-	-2, 811,
-	-1, 1799,
-	21, 573,
-	-2, 665,
-	-1, 1964,
-	1, 805,
-	68, 805,
-	543, 805,
-	-2, 811,
-	-1, 1976,
-	65, 481,
-	136, 481,
-	-2, 942,
-	-1, 1993,
-	276, 1006,
-	-2, 985,
-	-1, 2241,
-	276, 1006,
-	-2, 986,
-	-1, 2366,
-	88, 811,
-	131, 811,
-	168, 811,
-	171, 811,
-	-2, 890,
-	-1, 2369,
-	88, 811,
-	131, 811,
-	168, 811,
-	171, 811,
-	-2, 890,
-	-1, 2379,
-	65, 481,
-	136, 481,
-	-2, 943,
-	-1, 2473,
-	88, 811,
-	131, 811,
-	168, 811,
-	171, 811,
-	-2, 891,
-	-1, 2757,
-	68, 862,
-	136, 862,
-	-2, 811,
-	-1, 2761,
-	68, 862,
-	136, 862,
-	-2, 811,
-	-1, 2775,
-	68, 866,
-	136, 866,
-	-2, 811,
-	-1, 2780,
-	68, 867,
-	136, 867,
-	-2, 811,
+	-2, 1739,
+	-1, 613,
+	68, 1339,
+	-2, 1724,
+	-1, 615,
+	68, 1341,
+	-2, 1734,
+	-1, 616,
+	68, 1342,
+	-2, 1762,
+	-1, 627,
+	68, 1227,
+	-2, 1803,
+	-1, 628,
+	68, 1228,
+	-2, 1804,
+	-1, 629,
+	68, 1229,
+	-2, 1805,
+	-1, 638,
+	23, 616,
+	-2, 579,
+	-1, 699,
+	425, 470,
+	426, 470,
+	-2, 438,
+	-1, 753,
+	105, 1588,
+	116, 1588,
+	137, 1588,
+	-2, 1559,
+	-1, 786,
+	23, 616,
+	-2, 579,
+	-1, 888,
+	23, 615,
+	-2, 1122,
+	-1, 1251,
+	68, 1387,
+	-2, 1741,
+	-1, 1252,
+	68, 1388,
+	-2, 1742,
+	-1, 1432,
+	69, 1465,
+	-2, 1470,
+	-1, 1473,
+	1, 330,
+	69, 330,
+	586, 330,
+	-2, 887,
+	-1, 1734,
+	69, 1545,
+	138, 1545,
+	-2, 1726,
+	-1, 1735,
+	69, 1545,
+	138, 1545,
+	-2, 1725,
+	-1, 1736,
+	69, 1444,
+	138, 1444,
+	-2, 1712,
+	-1, 1737,
+	69, 1445,
+	138, 1445,
+	-2, 1717,
+	-1, 1738,
+	69, 1446,
+	138, 1446,
+	-2, 1645,
+	-1, 1739,
+	69, 1447,
+	138, 1447,
+	-2, 1639,
+	-1, 1740,
+	69, 1448,
+	138, 1448,
+	-2, 1579,
+	-1, 1741,
+	69, 1449,
+	138, 1449,
+	-2, 1714,
+	-1, 1742,
+	69, 1450,
+	138, 1450,
+	-2, 1643,
+	-1, 1743,
+	69, 1451,
+	138, 1451,
+	-2, 1638,
+	-1, 1744,
+	69, 1452,
+	138, 1452,
+	-2, 1631,
+	-1, 1746,
+	69, 1455,
+	138, 1455,
+	-2, 1762,
+	-1, 1747,
+	69, 1435,
+	138, 1435,
+	-2, 1744,
+	-1, 1748,
+	69, 1543,
+	138, 1543,
+	-2, 1715,
+	-1, 1749,
+	69, 1543,
+	138, 1543,
+	-2, 1743,
+	-1, 1750,
+	69, 1543,
+	138, 1543,
+	-2, 1597,
+	-1, 1751,
+	69, 1541,
+	138, 1541,
+	-2, 1734,
+	-1, 1752,
+	69, 1538,
+	138, 1538,
+	-2, 1623,
+	-1, 1753,
+	68, 1417,
+	69, 1417,
+	138, 1417,
+	367, 1417,
+	368, 1417,
+	369, 1417,
+	-2, 1578,
+	-1, 1754,
+	68, 1418,
+	69, 1418,
+	138, 1418,
+	367, 1418,
+	368, 1418,
+	369, 1418,
+	-2, 1580,
+	-1, 1755,
+	68, 1421,
+	69, 1421,
+	138, 1421,
+	367, 1421,
+	368, 1421,
+	369, 1421,
+	-2, 1716,
+	-1, 1756,
+	68, 1423,
+	69, 1423,
+	138, 1423,
+	367, 1423,
+	368, 1423,
+	369, 1423,
+	-2, 1699,
+	-1, 1757,
+	68, 1425,
+	69, 1425,
+	138, 1425,
+	367, 1425,
+	368, 1425,
+	369, 1425,
+	-2, 1644,
+	-1, 1758,
+	68, 1427,
+	69, 1427,
+	138, 1427,
+	367, 1427,
+	368, 1427,
+	369, 1427,
+	-2, 1627,
+	-1, 1759,
+	68, 1428,
+	69, 1428,
+	138, 1428,
+	367, 1428,
+	368, 1428,
+	369, 1428,
+	-2, 1628,
+	-1, 1760,
+	68, 1430,
+	69, 1430,
+	138, 1430,
+	367, 1430,
+	368, 1430,
+	369, 1430,
+	-2, 1577,
+	-1, 1761,
+	69, 1548,
+	138, 1548,
+	367, 1548,
+	368, 1548,
+	369, 1548,
+	-2, 1602,
+	-1, 1762,
+	69, 1548,
+	138, 1548,
+	367, 1548,
+	368, 1548,
+	369, 1548,
+	-2, 1619,
+	-1, 1763,
+	69, 1551,
+	138, 1551,
+	367, 1551,
+	368, 1551,
+	369, 1551,
+	-2, 1598,
+	-1, 1764,
+	69, 1548,
+	138, 1548,
+	367, 1548,
+	368, 1548,
+	369, 1548,
+	-2, 1681,
+	-1, 1777,
+	1, 880,
+	69, 880,
+	586, 880,
+	-2, 887,
+	-1, 1897,
+	23, 615,
+	-2, 722,
+	-1, 2076,
+	1, 881,
+	69, 881,
+	586, 881,
+	-2, 887,
+	-1, 2091,
+	66, 523,
+	138, 523,
+	-2, 1018,
+	-1, 2114,
+	278, 1088,
+	-2, 1061,
+	-1, 2397,
+	590, 1576,
+	-2, 1474,
+	-1, 2430,
+	278, 1088,
+	-2, 1062,
+	-1, 2567,
+	89, 887,
+	133, 887,
+	170, 887,
+	173, 887,
+	-2, 966,
+	-1, 2570,
+	89, 887,
+	133, 887,
+	170, 887,
+	173, 887,
+	-2, 966,
+	-1, 2597,
+	66, 523,
+	138, 523,
+	-2, 1019,
+	-1, 2705,
+	89, 887,
+	133, 887,
+	170, 887,
+	173, 887,
+	-2, 967,
+	-1, 3152,
+	69, 938,
+	138, 938,
+	-2, 887,
+	-1, 3157,
+	69, 938,
+	138, 938,
+	-2, 887,
+	-1, 3171,
+	69, 942,
+	138, 942,
+	-2, 887,
+	-1, 3176,
+	69, 943,
+	138, 943,
+	-2, 887,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 32004
+const yyLast = 47840
 
 var yyAct = [...]int{
-	487, 1408, 1258, 2761, 2760, 2740, 2769, 1173, 2699, 2651,
-	468, 1746, 489, 2669, 2691, 2440, 2530, 2445, 2253, 2610,
-	1639, 2611, 2467, 2579, 2504, 2319, 2595, 2599, 1024, 2466,
-	2524, 2465, 2320, 2546, 606, 2443, 461, 881, 1325, 2514,
-	151, 151, 1368, 2492, 517, 1979, 151, 403, 410, 2472,
-	2389, 410, 2218, 1176, 1169, 2435, 2058, 1470, 2349, 2059,
-	2044, 2263, 2054, 1079, 1727, 2242, 1440, 2051, 470, 2057,
-	1793, 2317, 1863, 1647, 2080, 1507, 2312, 421, 1538, 2295,
-	2190, 466, 2193, 1701, 1732, 415, 1483, 2188, 1448, 2262,
-	745, 1645, 1862, 2216, 712, 1965, 984, 459, 1370, 601,
-	460, 2098, 408, 31, 1411, 2137, 1904, 465, 1335, 1534,
-	1515, 1463, 1508, 643, 2094, 718, 1516, 1000, 1794, 1533,
-	1441, 1947, 1943, 1443, 1321, 1997, 1782, 1257, 150, 150,
-	1728, 3, 1032, 1700, 394, 1355, 407, 19, 1343, 601,
-	404, 8, 1830, 1033, 1566, 1002, 919, 1535, 1108, 1167,
-	151, 721, 30, 1316, 1643, 469, 1088, 405, 6, 1172,
-	100, 406, 7, 1685, 458, 722, 43, 1013, 1627, 1545,
-	1222, 1206, 1379, 1378, 1905, 1158, 716, 467, 399, 762,
-	1514, 1494, 477, 1467, 964, 1511, 704, 1166, 1801, 1354,
-	2473, 396, 1071, 1396, 1009, 642, 1058, 1326, 1228, 1227,
-	603, 423, 16, 9, 4, 982, 1107, 1025, 409, 640,
-	141, 424, 43, 1552, 2131, 2131, 144, 605, 659, 1865,
-	1542, 146, 147, 2520, 2515, 2436, 670, 2318, 1339, 2588,
-	876, 1510, 604, 882, 2458, 2642, 145, 614, 747, 705,
-	782, 1850, 1248, 2554, 1539, 145, 1060, 2457, 145, 31,
-	39, 133, 111, 145, 392, 39, 133, 111, 2563, 413,
-	419, 1858, 2160, 145, 145, 39, 133, 111, 145, 1689,
-	1550, 145, 2113, 145, 1817, 816, 1818, 145, 743, 1451,
-	1452, 2106, 420, 19, 1125, 2687, 2555, 8, 1021, 685,
-	1481, 1118, 684, 142, 1945, 1831, 1041, 1061, 30, 1042,
-	1122, 680, 142, 719, 6, 142, 2685, 1115, 7, 99,
-	142, 809, 43, 99, 1392, 145, 1143, 39, 133, 111,
-	142, 1124, 1030, 1031, 2453, 142, 790, 1175, 1117, 792,
-	142, 615, 814, 1159, 142, 138, 1163, 591, 600, 590,
-	592, 593, 126, 594, 595, 715, 139, 1944, 1028, 714,
-	1621, 99, 1027, 1030, 1031, 797, 2522, 793, 798, 2099,
-	1162, 2614, 2615, 2589, 2590, 2581, 84, 2584, 728, 723,
-	727, 729, 142, 2673, 2674, 689, 2525, 2526, 2527, 2528,
-	2518, 2321, 1044, 2321, 2641, 2581, 800, 819, 820, 821,
-	818, 2100, 686, 2101, 1244, 607, 151, 755, 1241, 726,
-	765, 1845, 1243, 1240, 1242, 1246, 1247, 756, 1178, 2594,
-	1245, 2330, 410, 410, 2202, 151, 2350, 1464, 1546, 754,
-	1950, 1773, 1456, 2463, 1154, 2357, 1684, 750, 752, 786,
-	1460, 1624, 1938, 2204, 2260, 2194, 1164, 812, 813, 2538,
-	2126, 2124, 134, 135, 1855, 136, 137, 731, 110, 2452,
-	143, 688, 788, 811, 733, 2454, 785, 1161, 795, 1310,
-	1309, 1019, 2644, 2645, 791, 794, 2199, 2200, 2048, 454,
-	131, 724, 456, 1775, 852, 2198, 765, 455, 2541, 749,
-	2460, 2201, 2209, 1778, 753, 2689, 2680, 2215, 787, 2551,
-	2222, 2603, 732, 1972, 412, 411, 777, 2409, 2600, 822,
-	2754, 2613, 2770, 773, 1551, 807, 808, 2708, 851, 751,
-	110, 132, 143, 1177, 82, 2684, 860, 796, 2653, 1008,
-	1053, 687, 1479, 1480, 1184, 1187, 1188, 2570, 1043, 2715,
-	725, 2402, 131, 125, 124, 1185, 1756, 717, 865, 45,
-	1555, 1557, 1558, 2719, 1755, 1251, 1252, 1253, 1254, 1255,
-	1256, 1249, 1250, 2493, 2494, 2495, 2497, 2496, 789, 2649,
-	2650, 2277, 2653, 719, 1956, 1160, 43, 43, 1067, 2196,
-	1959, 1960, 1961, 1962, 2506, 802, 1066, 2393, 803, 758,
-	759, 767, 766, 2397, 2415, 2416, 775, 799, 1046, 1540,
-	1540, 1023, 1022, 1540, 418, 1007, 681, 127, 128, 129,
-	730, 1006, 2771, 2371, 2029, 2777, 805, 2741, 2547, 746,
-	2176, 1567, 990, 985, 782, 419, 2765, 981, 983, 2578,
-	770, 771, 1059, 140, 2334, 2130, 1851, 1808, 774, 760,
-	2082, 2084, 719, 1543, 1030, 1031, 1882, 961, 2129, 2213,
-	2552, 94, 643, 2643, 994, 130, 993, 95, 2184, 2553,
-	854, 855, 856, 857, 992, 1030, 1031, 767, 766, 414,
-	1554, 858, 959, 956, 957, 958, 997, 1020, 1887, 2086,
-	1886, 1885, 1883, 913, 1736, 1805, 683, 1029, 801, 682,
-	1263, 1064, 637, 638, 639, 1553, 151, 1026, 1055, 1455,
-	40, 1733, 1736, 1541, 604, 781, 1454, 1949, 681, 2459,
-	96, 776, 2690, 2591, 2592, 40, 1804, 601, 601, 601,
-	38, 112, 1083, 1083, 806, 151, 986, 987, 988, 989,
-	112, 991, 2694, 112, 2195, 995, 1859, 2205, 112, 1465,
-	2539, 2127, 410, 983, 1884, 1111, 1111, 804, 112, 112,
-	2764, 1750, 1453, 112, 2479, 1090, 112, 691, 112, 1120,
-	1953, 1954, 112, 2464, 1186, 2197, 2505, 40, 692, 1740,
-	892, 893, 1791, 2214, 1952, 2720, 1081, 1081, 817, 1141,
-	1556, 1110, 1110, 2776, 1051, 1457, 1085, 1155, 683, 1126,
-	2292, 682, 1083, 1459, 1083, 755, 2288, 2083, 717, 1371,
-	112, 2398, 2399, 2783, 1010, 1014, 1014, 1014, 782, 1737,
-	1017, 2395, 2782, 1089, 1833, 2394, 2367, 1174, 1035, 1036,
-	1633, 1038, 1039, 1040, 966, 2227, 1010, 1737, 1010, 1850,
-	1015, 1016, 1730, 1792, 968, 1940, 1731, 1734, 2030, 2032,
-	2033, 2034, 2031, 2139, 2138, 1838, 605, 693, 2695, 2773,
-	1136, 1137, 608, 695, 97, 98, 102, 1819, 1116, 1807,
-	1806, 999, 1123, 1539, 1179, 1180, 1181, 1182, 1183, 1638,
-	1054, 817, 1888, 1889, 1226, 1062, 1063, 1045, 1792, 1047,
-	817, 1602, 1150, 1530, 1266, 1267, 1268, 1276, 1735, 1598,
-	1034, 1371, 1597, 1037, 1739, 2356, 1978, 1282, 1283, 1743,
-	1741, 694, 608, 1171, 1742, 697, 696, 2755, 1224, 1225,
-	1290, 1291, 1077, 1078, 1260, 1477, 1149, 2774, 1637, 1792,
-	1146, 1065, 1270, 601, 1194, 1195, 1196, 1197, 1198, 1199,
-	1200, 1201, 1202, 1203, 1204, 1205, 43, 1145, 1152, 1140,
-	1217, 1218, 1189, 392, 1091, 43, 1127, 1139, 1105, 1132,
-	1104, 1168, 1112, 490, 499, 2750, 1074, 1075, 1076, 491,
-	817, 498, 492, 496, 495, 493, 494, 1311, 2744, 817,
-	1128, 1332, 2743, 2692, 2693, 1548, 605, 2724, 736, 741,
-	742, 1285, 1148, 1147, 1144, 698, 2701, 819, 820, 821,
-	818, 2663, 1165, 1687, 2621, 151, 998, 1353, 1083, 1357,
-	1333, 1359, 1360, 1275, 1170, 1259, 151, 1262, 2616, 643,
-	1337, 2292, 1369, 500, 1341, 1277, 1083, 1344, 1220, 962,
-	1055, 1577, 1068, 2751, 2738, 403, 1284, 2572, 1286, 2571,
-	2568, 1336, 1636, 1156, 1215, 1216, 1548, 1208, 2567, 1476,
-	1548, 2703, 2566, 497, 1391, 1548, 2382, 819, 820, 821,
-	818, 2565, 1397, 1397, 2702, 1055, 1055, 2228, 1055, 2664,
-	2096, 151, 2543, 1353, 1353, 1395, 1352, 1083, 1438, 1450,
-	2542, 2417, 1977, 1323, 1324, 2279, 2543, 1358, 1261, 601,
-	1980, 1083, 1011, 1350, 1314, 1853, 1317, 1318, 1497, 1686,
-	502, 101, 1576, 1852, 1364, 2573, 1157, 1705, 2543, 1361,
-	1362, 1363, 2077, 1287, 779, 780, 2543, 151, 1353, 1083,
-	2543, 1488, 151, 151, 1491, 1844, 1929, 1493, 1337, 2543,
-	1927, 1499, 1720, 1593, 1337, 1337, 1384, 1578, 1276, 1276,
-	1518, 1529, 1434, 1435, 393, 1276, 1276, 101, 2543, 1819,
-	1525, 1390, 1925, 2280, 1393, 1394, 738, 739, 740, 1403,
-	1399, 819, 820, 821, 818, 1461, 1380, 1356, 1382, 1383,
-	1010, 1340, 1306, 1377, 1369, 1328, 1923, 1331, 1083, 1537,
-	1792, 1388, 1385, 1334, 1910, 1374, 780, 1485, 1386, 1387,
-	1866, 1012, 1848, 1014, 1930, 1842, 1840, 1487, 1928, 1502,
-	1466, 1372, 1373, 1978, 1835, 1484, 2158, 1349, 1489, 1490,
-	1484, 1484, 748, 1365, 1366, 1704, 1531, 1389, 1519, 1495,
-	1924, 1376, 1634, 1606, 1605, 1596, 1381, 782, 1587, 1586,
-	1129, 960, 1560, 863, 1585, 768, 1356, 1400, 748, 1401,
-	1547, 1402, 1133, 720, 1924, 1803, 834, 101, 2232, 2121,
-	2733, 635, 817, 1003, 1513, 2721, 1398, 1004, 817, 2638,
-	1705, 1513, 1407, 1836, 1841, 2223, 1437, 1439, 1747, 2604,
-	1265, 1264, 1836, 2293, 2480, 1462, 1223, 1570, 1168, 2374,
-	1574, 1474, 1475, 1705, 2372, 1070, 2284, 1471, 1472, 1473,
-	1633, 817, 817, 817, 1873, 1482, 817, 817, 719, 1050,
-	1011, 1052, 817, 1056, 1057, 719, 1486, 2281, 1548, 1351,
-	1134, 1603, 2605, 748, 1503, 1564, 1565, 2481, 1610, 43,
-	1584, 2132, 2375, 2049, 2224, 1072, 1522, 2373, 1591, 1839,
-	1520, 1523, 1527, 1524, 1528, 1810, 1073, 757, 1096, 1097,
-	1098, 1099, 1100, 1101, 1102, 1103, 1604, 818, 1106, 1607,
-	1608, 1609, 1532, 2405, 1612, 1613, 1614, 1615, 1616, 1617,
-	1618, 1619, 1296, 1223, 1622, 1573, 1069, 2225, 459, 755,
-	1680, 842, 843, 835, 836, 837, 838, 839, 840, 841,
-	834, 1568, 151, 151, 151, 690, 1702, 821, 818, 2404,
-	719, 1648, 2102, 1559, 2007, 2006, 1709, 1055, 1897, 1012,
-	2001, 2386, 1561, 2759, 1996, 2747, 1713, 2709, 1214, 1562,
-	1563, 1280, 1572, 1208, 837, 838, 839, 840, 841, 834,
-	1055, 2718, 1281, 1211, 1213, 1210, 755, 1212, 1706, 1745,
-	833, 832, 842, 843, 835, 836, 837, 838, 839, 840,
-	841, 834, 1748, 2704, 1751, 1752, 1753, 1754, 1726, 1600,
-	1757, 1758, 1759, 1760, 1761, 1762, 1763, 1764, 1765, 1766,
-	1767, 1768, 1769, 1770, 2654, 2717, 1796, 1796, 1450, 1796,
-	1694, 1695, 1696, 2461, 819, 820, 821, 818, 1723, 835,
-	836, 837, 838, 839, 840, 841, 834, 2629, 1681, 2606,
-	819, 820, 821, 818, 1712, 2556, 1711, 1083, 151, 1875,
-	1620, 101, 101, 720, 2354, 1714, 1715, 2516, 2040, 1629,
-	1640, 1641, 2462, 755, 454, 2486, 1111, 456, 1450, 2038,
-	2483, 1825, 455, 1827, 2036, 2482, 1749, 2376, 1337, 1337,
-	1337, 2353, 1642, 2203, 1800, 1648, 2179, 1798, 2178, 1802,
-	2117, 1722, 1717, 2355, 2024, 1718, 1688, 2039, 2052, 2023,
-	1846, 1815, 1110, 1537, 1716, 1581, 2026, 2022, 2037, 1014,
-	1083, 2019, 1083, 2035, 1083, 819, 820, 821, 818, 755,
-	1710, 2013, 850, 825, 826, 827, 828, 829, 830, 831,
-	823, 2010, 2009, 1719, 1824, 1632, 1089, 819, 820, 821,
-	818, 1860, 1721, 1631, 1630, 2025, 1288, 1289, 1083, 1891,
-	1292, 1293, 1294, 1295, 1297, 1298, 1299, 1300, 1301, 1302,
-	1303, 1304, 1626, 1822, 1898, 1625, 1856, 2151, 2608, 1083,
-	1130, 1776, 1829, 979, 2189, 2598, 2679, 2441, 719, 2675,
-	1874, 819, 820, 821, 818, 2639, 2576, 2540, 1892, 1893,
-	2517, 819, 820, 821, 818, 1589, 1895, 1896, 819, 820,
-	821, 818, 1081, 2471, 1816, 2447, 2439, 2437, 1902, 1901,
-	2659, 1890, 2150, 2421, 1823, 2419, 2045, 1821, 2388, 1877,
-	2352, 2351, 2348, 1081, 2339, 1811, 1812, 1813, 819, 820,
-	821, 818, 1899, 1857, 2333, 819, 820, 821, 818, 1575,
-	1337, 1864, 2287, 1933, 1934, 1344, 2285, 1871, 1588, 2275,
-	1849, 2274, 1900, 2558, 2183, 2177, 1847, 1083, 969, 1854,
-	1957, 1931, 2128, 2089, 1353, 2027, 1946, 2020, 2748, 1168,
-	1976, 819, 820, 821, 818, 2016, 1982, 1867, 1868, 2446,
-	2015, 2014, 1635, 1906, 547, 546, 2529, 1628, 1911, 1504,
-	1346, 1991, 1941, 1881, 1131, 891, 819, 820, 821, 818,
-	1995, 887, 819, 820, 821, 818, 886, 864, 744, 2369,
-	2003, 2004, 2005, 2368, 2366, 1870, 2008, 833, 832, 842,
-	843, 835, 836, 837, 838, 839, 840, 841, 834, 2343,
-	1796, 2011, 2012, 1967, 2342, 2338, 2325, 2017, 2018, 2311,
-	2041, 145, 1973, 2310, 133, 111, 2233, 1935, 1932, 1353,
-	755, 1450, 1450, 1450, 1450, 2047, 1983, 2156, 1974, 1323,
-	1324, 2149, 755, 1450, 2141, 2136, 1796, 1966, 1092, 2093,
-	1993, 1939, 2060, 393, 1318, 1926, 31, 1922, 1921, 1083,
-	1611, 1985, 1601, 1599, 2060, 1987, 1998, 1595, 1998, 1594,
-	151, 151, 1955, 1592, 1583, 1580, 101, 1579, 142, 1999,
-	101, 2730, 1305, 1975, 1279, 1981, 1278, 1276, 1269, 1276,
-	19, 101, 2112, 145, 8, 2116, 1356, 1095, 2073, 1093,
-	101, 1083, 1990, 2772, 2123, 30, 1984, 1986, 1994, 2000,
-	2732, 6, 2726, 1988, 1989, 7, 2716, 2713, 2711, 43,
-	2628, 1337, 2574, 883, 1313, 1328, 1337, 1331, 2021, 2502,
-	833, 832, 842, 843, 835, 836, 837, 838, 839, 840,
-	841, 834, 1336, 2490, 2487, 2046, 2429, 2111, 2050, 2427,
-	142, 2061, 2062, 2063, 2064, 2412, 2411, 2410, 2091, 2092,
-	2407, 2075, 2135, 2109, 2076, 605, 2074, 2072, 2401, 2115,
-	2361, 1322, 2090, 1315, 2144, 1001, 2146, 2042, 2087, 2120,
-	2002, 2085, 1970, 1969, 2155, 1968, 2125, 1327, 2107, 1330,
-	1319, 755, 2414, 2105, 2097, 2114, 2103, 2192, 1920, 2110,
-	2336, 1834, 884, 2108, 1809, 1771, 1703, 2207, 2133, 151,
-	2119, 1209, 142, 1648, 1492, 819, 820, 821, 818, 755,
-	755, 755, 1348, 819, 820, 821, 818, 2134, 1450, 1702,
-	2154, 2231, 1320, 1153, 1119, 2140, 963, 2235, 911, 910,
-	909, 1726, 1726, 1726, 2147, 2148, 908, 2264, 2266, 907,
-	2264, 2264, 906, 819, 820, 821, 818, 2271, 905, 904,
-	2161, 2145, 1083, 1083, 2162, 2163, 2164, 2165, 903, 2166,
-	2167, 2168, 2169, 2170, 2171, 2172, 2173, 2758, 2153, 902,
-	901, 900, 2185, 899, 2180, 898, 2142, 2143, 897, 2270,
-	896, 895, 894, 151, 2229, 2187, 890, 2210, 2192, 2408,
-	2728, 819, 820, 821, 818, 2152, 1353, 1353, 2261, 2265,
-	2211, 2212, 2230, 2219, 2220, 2226, 1081, 1081, 1919, 1966,
-	889, 888, 885, 880, 879, 2272, 2273, 877, 819, 820,
-	821, 818, 876, 2234, 875, 874, 873, 2236, 2237, 2267,
-	2268, 819, 820, 821, 818, 2239, 872, 1891, 2269, 833,
-	832, 842, 843, 835, 836, 837, 838, 839, 840, 841,
-	834, 871, 631, 870, 869, 868, 867, 866, 2289, 2290,
-	1843, 1918, 862, 861, 2657, 1917, 784, 1708, 2283, 2278,
-	151, 1484, 2612, 2286, 2282, 1916, 2238, 2296, 2297, 1449,
-	1915, 1691, 772, 2300, 819, 820, 821, 818, 819, 820,
-	821, 818, 1914, 2299, 2304, 1958, 1820, 2291, 819, 820,
-	821, 818, 1506, 819, 820, 821, 818, 2307, 2308, 2309,
-	783, 2302, 2303, 2301, 2316, 819, 820, 821, 818, 2069,
-	2067, 2066, 2326, 2065, 2070, 2068, 2181, 2182, 2335, 2327,
-	2071, 1837, 1788, 1789, 83, 2337, 2328, 1913, 720, 2329,
-	609, 610, 611, 612, 2332, 720, 1937, 1912, 148, 2340,
-	1353, 1433, 42, 608, 101, 41, 2365, 1909, 2331, 2186,
-	819, 820, 821, 818, 2362, 2363, 2364, 1796, 1450, 2379,
-	819, 820, 821, 818, 1832, 633, 1307, 628, 389, 618,
-	819, 820, 821, 818, 1640, 1641, 630, 629, 1861, 388,
-	1083, 965, 2344, 1113, 1682, 2347, 390, 778, 2346, 391,
-	2593, 151, 1094, 1992, 1942, 1698, 2432, 622, 2431, 1367,
-	2266, 1347, 2360, 1265, 1264, 1908, 2359, 2381, 832, 842,
-	843, 835, 836, 837, 838, 839, 840, 841, 834, 1353,
-	850, 977, 978, 755, 2378, 975, 976, 2377, 819, 820,
-	821, 818, 2430, 2666, 2390, 1774, 2261, 2385, 627, 810,
-	973, 974, 626, 971, 972, 2060, 1436, 2434, 616, 1049,
-	1048, 2306, 621, 1526, 1005, 755, 2423, 2387, 2380, 967,
-	1337, 2413, 608, 2426, 2383, 2727, 2428, 2384, 2647, 619,
-	2635, 2418, 2420, 2633, 2455, 2601, 1907, 2060, 2586, 2406,
-	2433, 2425, 2424, 1903, 609, 610, 611, 612, 2422, 1894,
-	617, 2313, 755, 1083, 1083, 2585, 2583, 608, 755, 819,
-	820, 821, 818, 2575, 634, 2513, 819, 820, 821, 818,
-	1872, 2442, 819, 820, 821, 818, 2512, 2438, 2341, 2323,
-	1726, 2322, 2314, 970, 2095, 1371, 2661, 2660, 620, 2118,
-	2456, 1693, 1582, 819, 820, 821, 818, 769, 755, 1219,
-	2660, 755, 755, 755, 2470, 2661, 2403, 1081, 2390, 2477,
-	2474, 2324, 2476, 1018, 50, 2381, 2469, 1478, 1369, 1087,
-	2510, 1, 819, 820, 821, 818, 1779, 1345, 613, 2491,
-	2078, 2079, 2499, 2500, 2501, 2448, 2305, 2081, 2488, 1544,
-	1772, 1683, 2507, 2498, 2206, 996, 636, 1271, 2537, 1784,
-	1787, 1788, 1789, 1785, 1138, 1786, 1790, 735, 632, 764,
-	2508, 1135, 763, 761, 1221, 1745, 2484, 2485, 1784, 1787,
-	1788, 1789, 1785, 504, 1786, 1790, 1509, 2043, 2534, 2509,
-	2665, 755, 2698, 2627, 2668, 1151, 2535, 488, 2577, 2521,
-	2631, 2523, 2444, 755, 1549, 2545, 2544, 815, 2104, 655,
-	540, 2549, 2548, 515, 878, 1121, 1114, 2159, 737, 2557,
-	514, 2358, 2564, 1951, 2559, 2550, 2560, 625, 1799, 734,
-	656, 1623, 2519, 1308, 2569, 1329, 1312, 2478, 2370, 2221,
-	1971, 2768, 2757, 2739, 755, 2725, 2652, 2753, 2587, 2683,
-	2714, 2451, 2449, 2450, 2582, 2580, 2707, 2648, 425, 1458,
-	599, 702, 2503, 2245, 1505, 2597, 426, 1707, 2534, 2640,
-	2489, 623, 1690, 2596, 2622, 2625, 624, 2602, 1449, 1964,
-	1963, 1190, 824, 1207, 2174, 2175, 2607, 2255, 859, 464,
-	1571, 2626, 2617, 2618, 2619, 2620, 476, 1948, 2254, 2634,
-	2248, 2636, 2637, 2088, 2632, 2630, 49, 2243, 48, 47,
-	46, 1498, 2258, 2259, 155, 506, 154, 2624, 2244, 2646,
-	2670, 486, 485, 484, 483, 482, 1783, 1781, 1780, 2672,
-	1445, 2658, 2656, 2655, 1444, 1496, 1738, 1404, 2609, 2561,
-	2662, 2671, 2562, 2400, 2028, 2396, 2392, 2276, 755, 2240,
-	2241, 2676, 2247, 2678, 2249, 2677, 1697, 1431, 918, 914,
-	916, 917, 915, 1880, 1876, 2697, 1724, 2686, 2688, 1725,
-	2681, 2217, 980, 2536, 2345, 2534, 2696, 2700, 1646, 1644,
-	2298, 2705, 2294, 755, 934, 2208, 1517, 1342, 1936, 1446,
-	2706, 1433, 1442, 1777, 1692, 75, 74, 81, 123, 37,
-	2475, 602, 32, 2672, 2723, 1174, 27, 5, 29, 28,
-	14, 15, 755, 13, 755, 2671, 2722, 1142, 2762, 2729,
-	12, 2731, 18, 26, 25, 24, 93, 92, 1413, 2735,
-	23, 2700, 91, 755, 1174, 90, 1174, 2742, 89, 2749,
-	2746, 88, 2752, 2257, 22, 1729, 11, 87, 86, 85,
-	21, 80, 78, 20, 79, 1174, 76, 2756, 2737, 2763,
-	77, 61, 60, 2767, 59, 72, 2766, 2710, 71, 2712,
-	2251, 2775, 70, 69, 68, 2778, 67, 2763, 66, 2780,
-	2781, 2779, 2767, 654, 101, 58, 922, 57, 56, 55,
-	912, 54, 2250, 2252, 73, 65, 64, 63, 2734, 62,
-	53, 52, 51, 109, 942, 946, 948, 950, 952, 953,
-	955, 108, 959, 956, 957, 958, 107, 106, 937, 938,
-	939, 940, 920, 921, 943, 105, 923, 104, 924, 925,
-	926, 927, 928, 929, 930, 931, 932, 933, 935, 941,
-	103, 33, 34, 35, 36, 119, 118, 945, 947, 949,
-	951, 954, 120, 122, 121, 116, 114, 117, 115, 113,
-	44, 1449, 1449, 1449, 1449, 10, 1417, 2260, 17, 2,
-	0, 0, 0, 1449, 0, 0, 0, 1421, 0, 2246,
-	0, 0, 0, 0, 936, 2256, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1410, 0, 0,
-	0, 1412, 1414, 1416, 0, 1418, 1419, 1420, 1422, 1423,
-	1424, 1426, 1427, 1428, 1429, 845, 101, 849, 0, 0,
-	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
-	0, 0, 846, 848, 844, 0, 847, 833, 832, 842,
-	843, 835, 836, 837, 838, 839, 840, 841, 834, 0,
-	0, 0, 0, 1432, 326, 522, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 833, 832, 842, 843,
-	835, 836, 837, 838, 839, 840, 841, 834, 478, 0,
-	0, 0, 233, 0, 0, 258, 0, 0, 0, 513,
-	1430, 0, 318, 272, 0, 2157, 0, 0, 570, 578,
-	0, 0, 0, 0, 0, 0, 0, 1409, 0, 0,
-	471, 0, 0, 503, 547, 546, 490, 499, 0, 0,
-	214, 153, 491, 101, 498, 492, 496, 495, 493, 494,
-	0, 562, 0, 0, 0, 0, 1425, 0, 462, 475,
-	2531, 479, 0, 1415, 833, 832, 842, 843, 835, 836,
-	837, 838, 839, 840, 841, 834, 0, 0, 1449, 0,
-	0, 0, 0, 0, 472, 473, 0, 0, 0, 0,
-	523, 0, 474, 101, 0, 518, 500, 501, 0, 0,
-	205, 323, 339, 215, 314, 352, 220, 321, 210, 287,
-	310, 0, 0, 207, 337, 320, 269, 252, 253, 206,
-	0, 305, 231, 244, 227, 285, 497, 521, 525, 226,
-	584, 519, 347, 209, 0, 346, 284, 333, 338, 270,
-	264, 208, 335, 268, 263, 256, 235, 585, 248, 296,
-	262, 297, 249, 274, 273, 275, 0, 944, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 516, 0, 0, 0, 349,
-	0, 0, 568, 0, 0, 0, 322, 0, 0, 257,
-	0, 0, 0, 520, 0, 308, 290, 581, 463, 0,
-	306, 260, 334, 298, 340, 324, 348, 302, 299, 200,
-	325, 229, 271, 211, 213, 225, 232, 234, 236, 237,
-	280, 281, 293, 313, 327, 328, 329, 228, 221, 307,
-	222, 246, 223, 201, 315, 224, 203, 294, 332, 0,
-	242, 303, 267, 204, 266, 295, 331, 330, 212, 356,
-	362, 363, 368, 0, 369, 0, 0, 0, 377, 381,
-	382, 383, 385, 386, 387, 0, 0, 0, 0, 0,
-	371, 0, 0, 0, 0, 0, 0, 361, 240, 197,
-	198, 344, 566, 286, 0, 0, 580, 561, 563, 564,
-	567, 571, 572, 573, 574, 575, 577, 579, 583, 311,
-	0, 0, 0, 0, 0, 251, 292, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 319, 342, 354, 372, 375, 0, 0, 1449, 202,
-	374, 0, 2532, 0, 0, 1869, 2533, 0, 582, 0,
-	0, 0, 353, 0, 0, 0, 0, 0, 524, 276,
-	277, 278, 279, 569, 0, 219, 373, 301, 833, 832,
-	842, 843, 835, 836, 837, 838, 839, 840, 841, 834,
-	0, 0, 0, 0, 366, 367, 239, 245, 384, 247,
-	218, 291, 241, 351, 254, 0, 378, 0, 0, 0,
-	0, 0, 283, 250, 316, 255, 261, 304, 350, 289,
-	309, 216, 341, 317, 265, 1569, 0, 591, 565, 590,
-	592, 593, 589, 594, 595, 576, 481, 0, 528, 587,
-	586, 588, 0, 0, 0, 0, 0, 0, 833, 832,
-	842, 843, 835, 836, 837, 838, 839, 840, 841, 834,
-	0, 0, 0, 0, 0, 199, 0, 259, 0, 300,
-	238, 554, 533, 534, 535, 480, 536, 531, 532, 555,
-	526, 551, 552, 505, 529, 537, 550, 538, 553, 556,
-	557, 596, 597, 544, 598, 541, 558, 549, 548, 539,
-	527, 559, 560, 512, 507, 542, 543, 530, 545, 508,
-	509, 510, 511, 326, 522, 0, 357, 358, 359, 380,
-	343, 0, 230, 0, 288, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 478, 0, 0,
-	0, 233, 0, 0, 258, 0, 0, 0, 513, 0,
-	0, 318, 272, 0, 0, 0, 0, 570, 578, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 471,
-	0, 0, 503, 547, 546, 490, 499, 0, 0, 214,
-	153, 491, 0, 498, 492, 496, 495, 493, 494, 0,
-	562, 0, 0, 0, 0, 0, 0, 462, 475, 0,
-	479, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 472, 473, 0, 0, 0, 0, 523,
-	0, 474, 0, 0, 518, 500, 501, 0, 0, 205,
-	323, 339, 215, 314, 352, 220, 321, 210, 287, 310,
-	0, 0, 207, 337, 320, 269, 252, 253, 206, 0,
-	305, 231, 244, 227, 285, 497, 521, 525, 226, 584,
-	519, 347, 209, 0, 346, 284, 333, 338, 270, 264,
-	208, 335, 268, 263, 256, 235, 585, 248, 296, 262,
-	297, 249, 274, 273, 275, 0, 0, 0, 0, 0,
-	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 516, 0, 0, 0, 349, 0,
-	0, 568, 0, 0, 0, 322, 0, 0, 257, 0,
-	0, 0, 520, 0, 308, 290, 581, 463, 0, 306,
-	260, 334, 298, 340, 324, 348, 302, 299, 200, 325,
-	229, 271, 211, 213, 225, 232, 234, 236, 237, 280,
-	281, 293, 313, 327, 328, 329, 228, 221, 307, 222,
-	246, 223, 201, 315, 224, 203, 294, 332, 0, 242,
-	303, 267, 204, 266, 295, 331, 330, 212, 356, 362,
-	363, 368, 0, 369, 0, 0, 0, 377, 381, 382,
-	383, 385, 386, 387, 0, 0, 0, 0, 0, 371,
-	0, 0, 0, 1273, 1272, 1274, 361, 240, 197, 198,
-	344, 566, 286, 0, 0, 580, 561, 563, 564, 567,
-	571, 572, 573, 574, 575, 577, 579, 583, 311, 0,
-	0, 0, 0, 0, 251, 292, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	319, 342, 354, 372, 375, 0, 0, 0, 202, 374,
-	0, 0, 0, 0, 0, 0, 0, 582, 0, 0,
-	0, 353, 0, 0, 0, 0, 0, 524, 276, 277,
-	278, 279, 569, 0, 219, 373, 301, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 366, 367, 239, 245, 384, 247, 218,
-	291, 241, 351, 254, 0, 378, 0, 0, 0, 0,
-	0, 283, 250, 316, 255, 261, 304, 350, 289, 309,
-	216, 341, 317, 265, 0, 0, 591, 565, 590, 592,
-	593, 589, 594, 595, 576, 481, 0, 528, 587, 586,
-	588, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 199, 0, 259, 0, 300, 238,
-	554, 533, 534, 535, 480, 536, 531, 532, 555, 526,
-	551, 552, 505, 529, 537, 550, 538, 553, 556, 557,
-	596, 597, 544, 598, 541, 558, 549, 548, 539, 527,
-	559, 560, 512, 507, 542, 543, 530, 545, 508, 509,
-	510, 511, 326, 522, 0, 357, 358, 359, 380, 343,
-	0, 230, 0, 288, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 478, 0, 0, 0,
-	233, 0, 0, 258, 0, 0, 0, 513, 0, 0,
-	318, 272, 0, 0, 0, 0, 570, 578, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 471, 0,
-	0, 503, 547, 546, 490, 499, 0, 0, 214, 153,
-	491, 0, 498, 492, 496, 495, 493, 494, 0, 562,
-	0, 0, 0, 0, 0, 0, 462, 475, 0, 479,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 472, 473, 0, 0, 0, 0, 523, 0,
-	474, 0, 0, 518, 500, 501, 0, 0, 205, 323,
-	339, 215, 314, 352, 220, 321, 210, 287, 310, 0,
-	0, 207, 337, 320, 269, 252, 253, 206, 0, 305,
-	231, 244, 227, 285, 497, 521, 525, 226, 584, 519,
-	347, 209, 0, 346, 284, 333, 338, 270, 264, 208,
-	335, 268, 263, 256, 235, 585, 248, 296, 262, 297,
-	249, 274, 273, 275, 0, 0, 0, 0, 0, 376,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 516, 0, 0, 0, 349, 0, 0,
-	568, 0, 0, 0, 322, 0, 0, 257, 0, 0,
-	0, 520, 0, 308, 290, 581, 463, 0, 306, 260,
-	334, 298, 340, 324, 348, 302, 299, 200, 325, 229,
-	271, 211, 213, 225, 232, 234, 236, 237, 280, 281,
-	293, 313, 327, 328, 329, 228, 221, 307, 222, 246,
-	223, 201, 315, 224, 203, 294, 332, 0, 242, 303,
-	267, 204, 266, 295, 331, 330, 212, 356, 362, 363,
-	368, 0, 369, 0, 0, 0, 377, 381, 382, 383,
-	385, 386, 387, 0, 0, 0, 0, 0, 371, 0,
-	0, 0, 0, 0, 0, 361, 240, 197, 198, 344,
-	566, 286, 0, 0, 580, 561, 563, 564, 567, 571,
-	572, 573, 574, 575, 577, 579, 583, 311, 0, 0,
-	0, 0, 0, 251, 292, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 319,
-	342, 354, 372, 375, 0, 0, 0, 202, 374, 0,
-	2532, 0, 0, 0, 2533, 0, 582, 0, 0, 0,
-	353, 0, 0, 0, 0, 0, 524, 276, 277, 278,
-	279, 569, 0, 219, 373, 301, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 366, 367, 239, 245, 384, 247, 218, 291,
-	241, 351, 254, 0, 378, 0, 0, 0, 0, 0,
-	283, 250, 316, 255, 261, 304, 350, 289, 309, 216,
-	341, 317, 265, 0, 0, 591, 565, 590, 592, 593,
-	589, 594, 595, 576, 481, 0, 528, 587, 586, 588,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 199, 0, 259, 0, 300, 238, 554,
-	533, 534, 535, 480, 536, 531, 532, 555, 526, 551,
-	552, 505, 529, 537, 550, 538, 553, 556, 557, 596,
-	597, 544, 598, 541, 558, 549, 548, 539, 527, 559,
-	560, 512, 507, 542, 543, 530, 545, 508, 509, 510,
-	511, 326, 522, 0, 357, 358, 359, 380, 343, 0,
-	230, 0, 288, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 478, 0, 0, 0, 233,
-	1338, 0, 258, 0, 0, 0, 513, 0, 0, 318,
-	272, 0, 0, 0, 0, 570, 578, 0, 0, 0,
-	0, 0, 0, 0, 1468, 0, 0, 471, 0, 0,
-	503, 547, 546, 490, 499, 0, 0, 214, 153, 491,
-	0, 498, 492, 496, 495, 493, 494, 0, 562, 0,
-	0, 0, 0, 0, 0, 462, 475, 0, 479, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 472, 473, 0, 0, 0, 0, 523, 0, 474,
-	0, 0, 1469, 500, 501, 0, 0, 205, 323, 339,
-	215, 314, 352, 220, 321, 210, 287, 310, 0, 0,
-	207, 337, 320, 269, 252, 253, 206, 0, 305, 231,
-	244, 227, 285, 497, 521, 525, 226, 584, 519, 347,
-	209, 0, 346, 284, 333, 338, 270, 264, 208, 335,
-	268, 263, 256, 235, 585, 248, 296, 262, 297, 249,
-	274, 273, 275, 0, 0, 0, 0, 0, 376, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 516, 0, 0, 0, 349, 0, 0, 568,
-	0, 0, 0, 322, 0, 0, 257, 0, 0, 0,
-	520, 0, 308, 290, 581, 463, 0, 306, 260, 334,
-	298, 340, 324, 348, 302, 299, 200, 325, 229, 271,
-	211, 213, 225, 232, 234, 236, 237, 280, 281, 293,
-	313, 327, 328, 329, 228, 221, 307, 222, 246, 223,
-	201, 315, 224, 203, 294, 332, 0, 242, 303, 267,
-	204, 266, 295, 331, 330, 212, 356, 362, 363, 368,
-	0, 369, 0, 0, 0, 377, 381, 382, 383, 385,
-	386, 387, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 0, 0, 0, 361, 240, 197, 198, 344, 566,
-	286, 0, 0, 580, 561, 563, 564, 567, 571, 572,
-	573, 574, 575, 577, 579, 583, 311, 0, 0, 0,
-	0, 0, 251, 292, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 319, 342,
-	354, 372, 375, 0, 0, 0, 202, 374, 0, 0,
-	0, 0, 0, 0, 0, 582, 0, 0, 0, 353,
-	0, 0, 0, 0, 0, 524, 276, 277, 278, 279,
-	569, 0, 219, 373, 301, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 366, 367, 239, 245, 384, 247, 218, 291, 241,
-	351, 254, 0, 378, 0, 0, 0, 0, 0, 283,
-	250, 316, 255, 261, 304, 350, 289, 309, 216, 341,
-	317, 265, 0, 0, 591, 565, 590, 592, 593, 589,
-	594, 595, 576, 481, 0, 528, 587, 586, 588, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 199, 0, 259, 0, 300, 238, 554, 533,
-	534, 535, 480, 536, 531, 532, 555, 526, 551, 552,
-	505, 529, 537, 550, 538, 553, 556, 557, 596, 597,
-	544, 598, 541, 558, 549, 548, 539, 527, 559, 560,
-	512, 507, 542, 543, 530, 545, 508, 509, 510, 511,
-	145, 326, 522, 357, 358, 359, 380, 343, 0, 230,
-	0, 0, 288, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 478, 0, 0, 0, 233,
-	0, 0, 258, 0, 0, 0, 853, 0, 0, 318,
-	272, 0, 0, 0, 0, 570, 578, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 471, 0, 0,
-	503, 547, 546, 490, 499, 0, 0, 214, 153, 491,
-	0, 498, 492, 496, 495, 493, 494, 0, 562, 0,
-	0, 0, 0, 0, 0, 462, 475, 0, 479, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 472, 473, 0, 0, 0, 0, 523, 0, 474,
-	0, 0, 518, 500, 501, 0, 0, 205, 323, 339,
-	215, 314, 352, 220, 321, 210, 287, 310, 0, 0,
-	207, 337, 320, 269, 252, 253, 206, 0, 305, 231,
-	244, 227, 285, 497, 521, 525, 226, 584, 519, 347,
-	209, 0, 346, 284, 333, 338, 270, 264, 208, 335,
-	268, 263, 256, 235, 585, 248, 296, 262, 297, 249,
-	274, 273, 275, 0, 0, 0, 0, 0, 376, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 516, 0, 0, 0, 349, 0, 0, 568,
-	0, 0, 0, 322, 0, 0, 257, 0, 0, 0,
-	520, 0, 308, 290, 581, 463, 0, 306, 260, 334,
-	298, 340, 324, 348, 302, 299, 200, 325, 229, 271,
-	211, 213, 225, 232, 234, 236, 237, 280, 281, 293,
-	313, 327, 328, 329, 228, 221, 307, 222, 246, 223,
-	201, 315, 224, 203, 294, 332, 0, 242, 303, 267,
-	204, 266, 295, 331, 330, 212, 356, 362, 363, 368,
-	0, 369, 0, 0, 0, 377, 381, 382, 383, 385,
-	386, 387, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 0, 0, 0, 361, 240, 197, 198, 344, 566,
-	286, 0, 0, 580, 561, 563, 564, 567, 571, 572,
-	573, 574, 575, 577, 579, 583, 311, 0, 0, 0,
-	0, 0, 251, 292, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 319, 342,
-	354, 372, 375, 0, 0, 0, 202, 374, 0, 0,
-	0, 0, 0, 0, 0, 582, 0, 0, 0, 353,
-	0, 0, 0, 0, 0, 524, 276, 277, 278, 279,
-	569, 0, 219, 373, 301, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 366, 367, 239, 245, 384, 247, 218, 291, 241,
-	351, 254, 0, 378, 0, 0, 0, 0, 0, 283,
-	250, 316, 255, 261, 304, 350, 289, 309, 216, 341,
-	317, 265, 0, 0, 591, 565, 590, 592, 593, 589,
-	594, 595, 576, 481, 0, 528, 587, 586, 588, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 199, 0, 259, 112, 300, 238, 554, 533,
-	534, 535, 480, 536, 531, 532, 555, 526, 551, 552,
-	505, 529, 537, 550, 538, 553, 556, 557, 596, 597,
-	544, 598, 541, 558, 549, 548, 539, 527, 559, 560,
-	512, 507, 542, 543, 530, 545, 508, 509, 510, 511,
-	326, 522, 0, 357, 358, 359, 380, 343, 0, 230,
-	0, 288, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 478, 0, 0, 0, 233, 2736,
-	0, 258, 0, 0, 0, 513, 0, 0, 318, 272,
-	0, 0, 0, 0, 570, 578, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 471, 0, 0, 503,
-	547, 546, 490, 499, 0, 0, 214, 153, 491, 0,
-	498, 492, 496, 495, 493, 494, 0, 562, 0, 0,
-	0, 0, 0, 0, 462, 475, 0, 479, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	472, 473, 0, 0, 0, 0, 523, 0, 474, 0,
-	0, 518, 500, 501, 0, 0, 205, 323, 339, 215,
-	314, 352, 220, 321, 210, 287, 310, 0, 0, 207,
-	337, 320, 269, 252, 253, 206, 0, 305, 231, 244,
-	227, 285, 497, 521, 525, 226, 584, 519, 347, 209,
-	0, 346, 284, 333, 338, 270, 264, 208, 335, 268,
-	263, 256, 235, 585, 248, 296, 262, 297, 249, 274,
-	273, 275, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 516, 0, 0, 0, 349, 0, 0, 568, 0,
-	0, 0, 322, 0, 0, 257, 0, 0, 0, 520,
-	0, 308, 290, 581, 463, 0, 306, 260, 334, 298,
-	340, 324, 348, 302, 299, 200, 325, 229, 271, 211,
-	213, 225, 232, 234, 236, 237, 280, 281, 293, 313,
-	327, 328, 329, 228, 221, 307, 222, 246, 223, 201,
-	315, 224, 203, 294, 332, 0, 242, 303, 267, 204,
-	266, 295, 331, 330, 212, 356, 362, 363, 368, 0,
-	369, 0, 0, 0, 377, 381, 382, 383, 385, 386,
-	387, 0, 0, 0, 0, 0, 371, 0, 0, 0,
-	0, 0, 0, 361, 240, 197, 198, 344, 566, 286,
-	0, 0, 580, 561, 563, 564, 567, 571, 572, 573,
-	574, 575, 577, 579, 583, 311, 0, 0, 0, 0,
-	0, 251, 292, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 319, 342, 354,
-	372, 375, 0, 0, 0, 202, 374, 0, 0, 0,
-	0, 0, 0, 0, 582, 0, 0, 0, 353, 0,
-	0, 0, 0, 0, 524, 276, 277, 278, 279, 569,
-	0, 219, 373, 301, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	366, 367, 239, 245, 384, 247, 218, 291, 241, 351,
-	254, 0, 378, 0, 0, 0, 0, 0, 283, 250,
-	316, 255, 261, 304, 350, 289, 309, 216, 341, 317,
-	265, 0, 0, 591, 565, 590, 592, 593, 589, 594,
-	595, 576, 481, 0, 528, 587, 586, 588, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 199, 0, 259, 0, 300, 238, 554, 533, 534,
-	535, 480, 536, 531, 532, 555, 526, 551, 552, 505,
-	529, 537, 550, 538, 553, 556, 557, 596, 597, 544,
-	598, 541, 558, 549, 548, 539, 527, 559, 560, 512,
-	507, 542, 543, 530, 545, 508, 509, 510, 511, 326,
-	522, 0, 357, 358, 359, 380, 343, 0, 230, 0,
-	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 478, 0, 0, 0, 233, 1338, 0,
-	258, 0, 0, 0, 513, 0, 0, 318, 272, 0,
-	0, 0, 0, 570, 578, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 471, 0, 0, 503, 547,
-	546, 490, 499, 0, 0, 214, 153, 491, 0, 498,
-	492, 496, 495, 493, 494, 0, 562, 0, 0, 0,
-	0, 0, 0, 462, 475, 0, 479, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 472,
-	473, 0, 0, 0, 0, 523, 0, 474, 0, 0,
-	518, 500, 501, 0, 0, 205, 323, 339, 215, 314,
-	352, 220, 321, 210, 287, 310, 0, 0, 207, 337,
-	320, 269, 252, 253, 206, 0, 305, 231, 244, 227,
-	285, 497, 521, 525, 226, 584, 519, 347, 209, 0,
-	346, 284, 333, 338, 270, 264, 208, 335, 268, 263,
-	256, 235, 585, 248, 296, 262, 297, 249, 274, 273,
-	275, 0, 0, 0, 0, 0, 376, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	516, 0, 0, 0, 349, 0, 0, 568, 0, 0,
-	0, 322, 0, 0, 257, 0, 0, 0, 520, 0,
-	308, 290, 581, 463, 0, 306, 260, 334, 298, 340,
-	324, 348, 302, 299, 200, 325, 229, 271, 211, 213,
-	225, 232, 234, 236, 237, 280, 281, 293, 313, 327,
-	328, 329, 228, 221, 307, 222, 246, 223, 201, 315,
-	224, 203, 294, 332, 0, 242, 303, 267, 204, 266,
-	295, 331, 330, 212, 356, 362, 363, 368, 0, 369,
-	0, 0, 0, 377, 381, 382, 383, 385, 386, 387,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 0,
-	0, 0, 361, 240, 197, 198, 344, 566, 286, 0,
-	0, 580, 561, 563, 564, 567, 571, 572, 573, 574,
-	575, 577, 579, 583, 311, 0, 0, 0, 0, 0,
-	251, 292, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 319, 342, 354, 372,
-	375, 0, 0, 0, 202, 374, 0, 0, 0, 0,
-	0, 0, 0, 582, 0, 0, 0, 353, 0, 0,
-	0, 0, 0, 524, 276, 277, 278, 279, 569, 0,
-	219, 373, 301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 366,
-	367, 239, 245, 384, 247, 218, 291, 241, 351, 254,
-	0, 378, 0, 0, 0, 0, 0, 283, 250, 316,
-	255, 261, 304, 350, 289, 309, 216, 341, 317, 265,
-	0, 0, 591, 565, 590, 592, 593, 589, 594, 595,
-	576, 481, 0, 528, 587, 586, 588, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	199, 0, 259, 0, 300, 238, 554, 533, 534, 535,
-	480, 536, 531, 532, 555, 526, 551, 552, 505, 529,
-	537, 550, 538, 553, 556, 557, 596, 597, 544, 598,
-	541, 558, 549, 548, 539, 527, 559, 560, 512, 507,
-	542, 543, 530, 545, 508, 509, 510, 511, 326, 522,
-	0, 357, 358, 359, 380, 343, 0, 230, 0, 288,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 478, 0, 0, 0, 233, 0, 0, 258,
-	0, 0, 0, 513, 0, 0, 318, 272, 0, 0,
-	0, 0, 570, 578, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 471, 0, 0, 503, 547, 546,
-	490, 499, 0, 0, 214, 153, 491, 0, 498, 492,
-	496, 495, 493, 494, 0, 562, 0, 0, 0, 0,
-	0, 0, 462, 475, 0, 479, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 472, 473,
-	1109, 0, 0, 0, 523, 0, 474, 0, 0, 518,
-	500, 501, 0, 0, 205, 323, 339, 215, 314, 352,
-	220, 321, 210, 287, 310, 0, 0, 207, 337, 320,
-	269, 252, 253, 206, 0, 305, 231, 244, 227, 285,
-	497, 521, 525, 226, 584, 519, 347, 209, 0, 346,
-	284, 333, 338, 270, 264, 208, 335, 268, 263, 256,
-	235, 585, 248, 296, 262, 297, 249, 274, 273, 275,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
+	517, 3157, 1475, 3156, 3165, 2929, 3126, 2966, 497, 1321,
+	1232, 3064, 495, 2970, 3059, 519, 2930, 3043, 3011, 2910,
+	105, 2578, 2901, 1822, 2789, 2674, 2669, 2851, 2443, 2716,
+	2915, 2754, 2395, 31, 2916, 1723, 2861, 2880, 2518, 2228,
+	1068, 162, 162, 1732, 2884, 2697, 2779, 162, 432, 439,
+	639, 2698, 439, 2519, 921, 2805, 1389, 2672, 2769, 2765,
+	1436, 2742, 548, 2094, 2704, 2607, 2664, 2360, 1235, 2181,
+	2550, 2229, 2659, 1554, 2180, 2167, 2427, 1228, 2182, 1133,
+	2455, 1823, 44, 2177, 450, 1512, 444, 2174, 1891, 2431,
+	2201, 499, 1972, 2516, 1618, 1587, 2230, 2203, 2490, 2335,
+	2332, 488, 2330, 632, 634, 1027, 2361, 780, 2454, 1793,
+	1566, 489, 2396, 32, 1828, 1483, 1786, 1730, 2077, 2237,
+	678, 1401, 2016, 1971, 2278, 2358, 1614, 494, 1579, 1596,
+	752, 1595, 1613, 1385, 1588, 1547, 1044, 1880, 1515, 1513,
+	758, 2224, 2055, 1824, 2118, 1792, 2428, 3, 736, 2059,
+	632, 2389, 9, 1892, 1474, 1939, 2388, 19, 1046, 1422,
+	437, 162, 2387, 8, 1409, 959, 2386, 7, 1380, 1226,
+	1615, 2017, 1646, 1930, 759, 1438, 2384, 4, 1728, 2385,
+	6, 2383, 30, 2394, 29, 498, 761, 2393, 28, 1142,
+	31, 2392, 22, 1770, 1551, 2390, 10, 2391, 17, 631,
+	496, 1105, 1076, 756, 436, 1834, 1057, 1710, 1625, 1447,
+	433, 487, 1446, 1284, 435, 1265, 506, 1217, 428, 1844,
+	797, 1594, 1006, 1591, 1578, 1225, 1899, 434, 633, 1127,
+	1421, 1464, 746, 425, 1104, 1102, 762, 1290, 2705, 677,
+	1231, 636, 452, 16, 1520, 1291, 747, 638, 1025, 1053,
+	438, 675, 453, 2579, 152, 2270, 2270, 1632, 1974, 1069,
+	1622, 2816, 157, 694, 1788, 158, 155, 2973, 2823, 3083,
+	32, 705, 2718, 2978, 2977, 2984, 854, 855, 856, 853,
+	2727, 1390, 1077, 1000, 3053, 161, 161, 2903, 2999, 3091,
+	3051, 423, 854, 855, 856, 853, 447, 1277, 917, 421,
+	737, 3000, 442, 2906, 2111, 778, 922, 2775, 2770, 9,
+	2665, 2517, 1405, 1320, 19, 1480, 1479, 915, 1476, 2873,
+	8, 1590, 2276, 637, 7, 886, 887, 3101, 2871, 1311,
+	1108, 3069, 1106, 2934, 4, 2957, 647, 6, 2787, 30,
+	156, 29, 40, 142, 118, 28, 2690, 3019, 2869, 22,
+	2785, 2813, 1967, 10, 2676, 17, 156, 156, 868, 867,
+	877, 878, 870, 871, 872, 873, 874, 875, 876, 869,
+	817, 156, 156, 156, 40, 142, 118, 1184, 2689, 2001,
+	156, 1959, 2837, 1107, 156, 2302, 40, 142, 118, 156,
+	156, 104, 1181, 1177, 1619, 2814, 153, 622, 448, 621,
+	623, 624, 1202, 625, 626, 782, 2252, 1774, 1174, 1630,
+	532, 106, 153, 1183, 1924, 2245, 825, 851, 2214, 827,
+	832, 2213, 2212, 833, 104, 162, 790, 153, 153, 1176,
+	648, 449, 1085, 1925, 1218, 1086, 153, 1222, 2733, 1563,
+	153, 439, 439, 1528, 162, 153, 153, 828, 1065, 789,
+	3030, 835, 759, 2057, 1908, 422, 720, 1909, 106, 719,
+	1910, 1221, 1525, 1526, 761, 1072, 1074, 1075, 3092, 1071,
+	1074, 1075, 785, 787, 2721, 3055, 2764, 1940, 715, 2919,
+	2920, 844, 640, 2685, 1460, 2956, 1234, 849, 1307, 3028,
+	755, 754, 1304, 2874, 2875, 1704, 1306, 1303, 1305, 1309,
+	1310, 3015, 3016, 2520, 1308, 2777, 2056, 2863, 2866, 2238,
+	890, 2863, 2773, 2520, 762, 2780, 2781, 2782, 2783, 1237,
+	1954, 759, 1088, 791, 1548, 784, 821, 2239, 800, 2240,
+	830, 2879, 812, 761, 2529, 117, 2551, 154, 1626, 2558,
+	1538, 1871, 437, 437, 1223, 1769, 724, 2344, 2346, 823,
+	854, 855, 856, 853, 1213, 800, 1707, 140, 2263, 2797,
+	1964, 826, 829, 786, 760, 1220, 721, 2062, 106, 2050,
+	2959, 2960, 2695, 2336, 2265, 1374, 1373, 1542, 2452, 847,
+	848, 846, 2171, 762, 820, 822, 436, 436, 2800, 831,
+	2340, 3084, 433, 433, 2692, 2979, 435, 435, 1873, 2341,
+	2342, 2351, 1876, 2743, 2744, 2745, 2747, 2746, 3023, 434,
+	434, 2684, 1150, 1022, 2343, 483, 777, 2686, 485, 2357,
+	2365, 1326, 1063, 484, 3032, 723, 2918, 1236, 2810, 2888,
+	1635, 1637, 1638, 2084, 441, 757, 440, 2885, 490, 1314,
+	1315, 1316, 1317, 1318, 1319, 1312, 1313, 2630, 793, 794,
+	1631, 1024, 1026, 3146, 3166, 824, 1477, 1478, 3074, 834,
+	2964, 2965, 3027, 2968, 634, 1097, 2968, 3081, 1052, 788,
+	2624, 1087, 2621, 1219, 953, 2912, 2911, 678, 1561, 1562,
+	2844, 3089, 842, 843, 1854, 2338, 1003, 1853, 808, 2933,
+	2472, 805, 806, 2756, 2068, 722, 1090, 3046, 809, 2638,
+	2639, 2611, 892, 893, 894, 895, 2533, 2269, 2719, 2720,
+	1123, 1122, 2726, 810, 2615, 1051, 802, 801, 1067, 1066,
+	1073, 162, 1050, 1099, 896, 3167, 1620, 3127, 1243, 1246,
+	1247, 1789, 1790, 2677, 1070, 1112, 2806, 1620, 1115, 1244,
+	632, 632, 781, 802, 801, 2572, 2976, 2893, 2318, 632,
+	1620, 2958, 1137, 1137, 1033, 162, 1647, 1837, 1835, 1029,
+	1030, 1031, 1032, 2723, 1034, 2812, 2860, 837, 1038, 3085,
+	838, 1633, 795, 439, 1026, 1621, 2998, 2904, 2905, 2902,
+	3173, 2786, 1028, 2811, 1179, 448, 2937, 2071, 2072, 2073,
+	2074, 637, 1103, 3161, 2277, 2465, 1960, 1144, 840, 1917,
+	1623, 1114, 2268, 1037, 1200, 1832, 1036, 1074, 1075, 3052,
+	1035, 2112, 2326, 3047, 1829, 1832, 443, 1137, 2691, 1137,
+	790, 41, 119, 1634, 1968, 1041, 1185, 2205, 2207, 1135,
+	1135, 2215, 1139, 41, 817, 1064, 1118, 1120, 119, 119,
+	106, 106, 760, 1233, 1549, 1130, 1074, 1075, 1061, 3033,
+	2347, 1911, 2061, 119, 119, 119, 1079, 1080, 2798, 1082,
+	1083, 1084, 119, 762, 932, 933, 119, 762, 1636, 1111,
+	2337, 119, 119, 2266, 2876, 2877, 2755, 836, 2339, 811,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
+	1263, 1264, 1039, 1175, 1059, 1060, 1276, 1182, 1278, 1008,
+	1539, 638, 790, 1289, 757, 2065, 2066, 1010, 437, 2152,
+	2696, 888, 1288, 841, 1214, 816, 1339, 1209, 3160, 2064,
+	1329, 1330, 1331, 2616, 2617, 1233, 2613, 1341, 1043, 730,
+	2612, 1833, 1098, 1529, 1346, 1347, 839, 1541, 3044, 3045,
+	1530, 1833, 1349, 1195, 1196, 1230, 1826, 1354, 1355, 3172,
+	1827, 1830, 436, 632, 2280, 2279, 1206, 1089, 433, 1091,
+	1078, 1208, 435, 1081, 728, 1095, 1245, 1205, 1054, 1058,
+	1058, 1058, 1906, 1116, 1840, 434, 1913, 1912, 735, 1131,
+	1132, 1203, 732, 731, 1204, 2206, 726, 1248, 1109, 1110,
+	1054, 1527, 1054, 1211, 1121, 672, 673, 674, 2711, 1143,
+	727, 1396, 1831, 1398, 3097, 1721, 1165, 1170, 1171, 1186,
+	2513, 3179, 670, 2413, 1191, 1375, 1094, 421, 1096, 3178,
+	1100, 1101, 1160, 1159, 1145, 3169, 1772, 852, 162, 638,
+	1420, 1137, 1424, 1199, 1426, 1427, 2487, 716, 2483, 162,
+	3150, 1198, 817, 678, 1399, 1338, 1437, 1187, 1207, 1370,
+	1137, 1011, 1681, 734, 1099, 1680, 1942, 1227, 1224, 432,
+	1890, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 2092,
+	1229, 1161, 1162, 854, 855, 856, 853, 3147, 1459, 1402,
+	852, 2355, 1274, 1275, 2568, 2093, 1465, 1465, 852, 1099,
+	1099, 3142, 1099, 1717, 3170, 162, 1267, 1420, 1420, 1959,
+	1839, 1137, 1510, 1522, 1419, 1843, 1841, 1463, 1889, 2995,
+	1842, 733, 1387, 1388, 1531, 1532, 641, 1425, 718, 1720,
+	1439, 717, 2087, 1771, 852, 632, 2052, 1137, 1947, 1378,
+	857, 1381, 1382, 730, 2153, 2155, 2156, 2157, 2154, 889,
+	1927, 1428, 1429, 1430, 1439, 1324, 1628, 898, 716, 1216,
+	3140, 1619, 162, 1420, 1137, 725, 1571, 162, 162, 1574,
+	3143, 1524, 1576, 634, 1582, 1582, 3130, 3129, 1146, 3102,
+	904, 1506, 1507, 422, 1167, 1168, 1169, 1339, 1339, 1598,
+	1351, 3094, 729, 1932, 1339, 1339, 732, 731, 3066, 1605,
+	2093, 106, 1848, 1467, 1815, 106, 3057, 759, 1722, 854,
+	855, 856, 853, 1685, 759, 2356, 106, 1610, 1543, 761,
+	1445, 1559, 1042, 1437, 1890, 106, 761, 1137, 1617, 2995,
+	1322, 747, 1325, 641, 1055, 1454, 1455, 1568, 3056, 718,
+	1340, 1406, 717, 852, 3005, 1628, 1628, 1452, 1628, 1570,
+	1890, 2994, 1348, 2926, 1350, 2509, 1400, 2921, 1440, 1441,
+	3095, 1282, 1458, 1550, 2846, 1461, 1462, 3067, 1124, 762,
+	1448, 2845, 1450, 1451, 2487, 852, 762, 1572, 1573, 1215,
+	817, 1423, 1417, 1611, 2842, 1456, 1433, 1599, 1640, 1392,
+	1457, 1395, 1434, 1431, 2841, 1644, 1645, 3124, 814, 759,
+	1442, 3068, 1449, 769, 764, 768, 770, 852, 1468, 2840,
+	1444, 761, 1469, 3006, 1470, 437, 1593, 2600, 2839, 1658,
+	2995, 2801, 2802, 1593, 1931, 1397, 2802, 2640, 1466, 2474,
+	775, 2200, 1056, 2847, 767, 2514, 1556, 1557, 2041, 1511,
+	1797, 1509, 2414, 1054, 1473, 854, 855, 856, 853, 1471,
+	1004, 1423, 815, 2802, 783, 1453, 2095, 1962, 1535, 436,
+	1537, 762, 1534, 2802, 1536, 433, 1058, 1544, 2039, 435,
+	1555, 815, 1686, 854, 855, 856, 853, 1564, 2802, 1693,
+	1961, 2037, 434, 2035, 2022, 1953, 772, 2802, 1937, 1569,
+	2802, 1657, 1975, 774, 1957, 2557, 1927, 763, 2475, 1583,
+	1890, 1577, 1951, 1812, 1227, 1949, 1567, 2042, 1944, 1675,
+	1796, 1567, 1567, 765, 1718, 1689, 1602, 1600, 1603, 2300,
+	1604, 1607, 1688, 1679, 1608, 488, 790, 1765, 1677, 1669,
+	1668, 1667, 1660, 1609, 773, 1416, 1558, 2040, 1188, 162,
+	162, 162, 1002, 1612, 1794, 1000, 1659, 1627, 902, 1733,
+	2036, 869, 2036, 852, 1801, 1099, 1192, 1565, 1648, 803,
+	2418, 852, 2260, 1797, 1805, 1903, 783, 1328, 1327, 1047,
+	3111, 1945, 766, 1048, 1950, 3098, 1639, 1945, 1099, 1797,
+	2889, 1642, 1643, 1717, 852, 1992, 1817, 790, 2366, 2712,
+	1055, 852, 852, 2575, 2573, 1641, 1267, 852, 852, 852,
+	852, 1238, 1239, 1240, 1241, 1242, 762, 1653, 1845, 1126,
+	2488, 999, 996, 997, 998, 1628, 1628, 1997, 3037, 1996,
+	1995, 1993, 2479, 1521, 2890, 1193, 2476, 2271, 1894, 1894,
+	1522, 1894, 1128, 2713, 783, 2172, 1726, 2576, 2574, 1285,
+	1948, 1919, 771, 1129, 1914, 792, 2367, 1983, 1286, 1287,
+	759, 1703, 1418, 2626, 1818, 1323, 632, 632, 1285, 1360,
+	1654, 2625, 761, 1333, 1766, 856, 853, 1352, 1353, 1137,
+	162, 1356, 1357, 1358, 1359, 1361, 1362, 1363, 1364, 1365,
+	1366, 1367, 1368, 1994, 1273, 2953, 790, 853, 1056, 1125,
+	2368, 2241, 1934, 1819, 2131, 2130, 2122, 760, 2117, 1270,
+	1272, 1269, 1712, 1271, 760, 854, 855, 856, 853, 1733,
+	2604, 3088, 762, 106, 2175, 1898, 3036, 1896, 1814, 1900,
+	1955, 2693, 1344, 1617, 1847, 1808, 854, 855, 856, 853,
+	1137, 1803, 1137, 1345, 1137, 2331, 1727, 2898, 3154, 790,
+	1806, 1807, 1773, 2555, 3133, 3075, 1922, 854, 855, 856,
+	853, 2163, 1118, 1120, 1403, 3087, 3086, 3070, 1407, 2969,
+	2694, 1410, 1969, 854, 855, 856, 853, 1802, 2161, 1137,
+	1809, 2002, 2722, 1810, 872, 873, 874, 875, 876, 869,
+	1811, 2943, 2556, 1779, 1780, 1781, 2010, 1813, 2891, 888,
+	2162, 2829, 1137, 2815, 1836, 867, 877, 878, 870, 871,
+	872, 873, 874, 875, 876, 869, 2012, 2160, 1804, 1965,
+	1987, 2771, 1846, 1874, 1849, 1850, 1851, 1852, 1998, 1999,
+	1855, 1856, 1857, 1858, 1859, 1860, 1861, 1862, 1863, 1864,
+	1865, 1866, 1867, 1868, 2159, 483, 1905, 3149, 485, 2734,
+	1915, 2715, 1916, 484, 2149, 2714, 1135, 2014, 2000, 854,
+	855, 856, 853, 2577, 2554, 2345, 1058, 2321, 1985, 1923,
+	1920, 2320, 2256, 2147, 1929, 2146, 1966, 1938, 2145, 1135,
+	2142, 2011, 2293, 2158, 2136, 2133, 1403, 2132, 1671, 1715,
+	1403, 1403, 1137, 2148, 2009, 2069, 1714, 2053, 1981, 1420,
+	1713, 1709, 1708, 1958, 2986, 1189, 1021, 3117, 790, 1963,
+	3090, 2043, 2091, 2983, 3054, 3041, 1956, 3022, 2097, 2670,
+	1724, 1725, 1581, 1581, 1143, 3017, 2292, 854, 855, 856,
+	853, 2088, 2018, 2106, 2954, 1976, 1977, 2023, 2982, 2109,
+	1670, 790, 2858, 1991, 106, 3049, 2116, 2085, 31, 854,
+	855, 856, 853, 2003, 2799, 790, 2830, 2125, 2126, 2909,
+	2127, 2128, 2129, 854, 855, 856, 853, 2883, 1979, 854,
+	855, 856, 853, 2079, 854, 855, 856, 853, 1894, 2772,
+	1227, 2703, 854, 855, 856, 853, 2114, 2668, 2164, 2666,
+	854, 855, 856, 853, 2833, 2044, 2658, 1420, 790, 1522,
+	1522, 1522, 1522, 1387, 1388, 2098, 2679, 2047, 2657, 2644,
+	790, 1522, 2642, 2078, 1894, 2168, 2788, 854, 855, 856,
+	853, 2183, 1894, 1894, 1382, 2618, 2606, 2553, 32, 854,
+	855, 856, 853, 2183, 854, 855, 856, 853, 1137, 2552,
+	2549, 1651, 2540, 2532, 1655, 1973, 2482, 2113, 162, 162,
+	1897, 2067, 1582, 632, 2120, 2480, 2232, 2090, 2234, 2470,
+	1311, 2124, 2119, 2096, 2119, 2469, 1339, 9, 1339, 2325,
+	2319, 2251, 19, 2267, 2255, 1663, 2218, 2150, 8, 2196,
+	1137, 2099, 7, 2262, 2143, 1666, 2110, 2115, 2103, 2104,
+	2108, 2139, 4, 1673, 2121, 6, 2138, 30, 2137, 29,
+	2100, 578, 577, 28, 2102, 1719, 1711, 22, 1584, 2058,
+	2208, 10, 1687, 17, 1412, 1690, 1691, 1692, 1280, 1279,
+	1695, 1696, 1697, 1698, 1699, 1700, 1701, 1702, 1190, 762,
+	1705, 2144, 1423, 2169, 2105, 931, 927, 1402, 2184, 2185,
+	2186, 2187, 2250, 926, 2173, 903, 2195, 762, 2198, 2197,
+	854, 855, 856, 853, 762, 779, 2089, 2784, 638, 2220,
+	2570, 2569, 2248, 2567, 1392, 2259, 1395, 2199, 2254, 2544,
+	2216, 2543, 2285, 790, 2287, 2219, 2199, 2246, 2539, 2334,
+	2227, 2264, 2534, 2524, 2253, 2244, 2510, 2134, 2135, 2349,
+	2508, 162, 2419, 2140, 2141, 2247, 1733, 2242, 1798, 2249,
+	2236, 790, 790, 790, 2298, 2290, 2258, 2398, 2101, 2282,
+	2223, 2170, 2086, 1522, 1794, 2051, 2417, 2038, 2272, 1307,
+	2034, 2033, 2421, 1304, 1716, 1694, 2273, 1306, 1303, 1305,
+	1309, 1310, 1684, 2426, 2281, 1308, 2369, 1682, 2456, 2458,
+	1678, 2456, 2456, 2288, 2289, 1676, 790, 2629, 1674, 1665,
+	2286, 2466, 2411, 2678, 1662, 1137, 1137, 762, 2303, 1661,
+	1369, 1343, 2304, 2305, 2306, 2307, 1342, 2308, 2309, 2310,
+	2311, 2312, 2313, 2314, 2315, 1332, 854, 855, 856, 853,
+	156, 2283, 2284, 1149, 2322, 1147, 162, 2329, 3168, 3118,
+	2327, 2334, 2221, 2222, 1403, 3110, 1403, 3104, 2415, 1420,
+	1420, 870, 871, 872, 873, 874, 875, 876, 869, 3093,
+	762, 3082, 2078, 3079, 1403, 2353, 2453, 2412, 2635, 2457,
+	3077, 2463, 3004, 2416, 2410, 2942, 106, 2362, 2363, 2354,
+	2507, 2936, 1135, 1135, 2467, 2468, 153, 2210, 2935, 1800,
+	2425, 854, 855, 856, 853, 520, 529, 1522, 2461, 2459,
+	2460, 521, 2464, 528, 522, 526, 525, 523, 524, 1292,
+	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
+	1314, 1315, 1316, 1317, 1318, 1319, 1312, 1313, 2537, 2856,
+	2848, 2834, 2484, 2485, 2424, 923, 1377, 2752, 2740, 162,
+	2735, 2478, 2477, 2481, 1776, 2420, 2473, 2652, 2650, 2422,
+	2423, 854, 855, 856, 853, 2495, 530, 2633, 1980, 1521,
+	1521, 1521, 1521, 1984, 2632, 2631, 2628, 2623, 2620, 2505,
+	2499, 1521, 2004, 2005, 2535, 2562, 2291, 1386, 1379, 1045,
+	2007, 2008, 2512, 2165, 2123, 2352, 527, 2082, 2081, 2080,
+	2502, 2503, 2504, 1391, 2013, 868, 867, 877, 878, 870,
+	871, 872, 873, 874, 875, 876, 869, 106, 1394, 1383,
+	2032, 2525, 2527, 156, 1683, 1943, 142, 118, 2526, 3148,
+	2296, 924, 1420, 1918, 2528, 106, 1403, 2486, 2566, 2045,
+	2046, 1410, 106, 2531, 880, 1904, 885, 2541, 1869, 1795,
+	2547, 1649, 2498, 854, 855, 856, 853, 1268, 153, 1575,
+	1432, 881, 883, 879, 1415, 882, 884, 868, 867, 877,
+	878, 870, 871, 872, 873, 874, 875, 876, 869, 153,
+	2511, 1384, 1212, 1178, 2584, 2585, 2586, 2590, 1005, 2594,
+	1567, 1001, 2515, 1894, 1522, 2597, 2545, 3134, 951, 489,
+	2548, 950, 949, 2582, 2583, 877, 878, 870, 871, 872,
+	873, 874, 875, 876, 869, 2561, 1656, 948, 2560, 1137,
+	947, 946, 945, 944, 943, 942, 941, 2605, 940, 939,
+	938, 937, 936, 162, 860, 861, 862, 863, 864, 865,
+	866, 858, 2458, 935, 934, 106, 2637, 868, 867, 877,
+	878, 870, 871, 872, 873, 874, 875, 876, 869, 930,
+	929, 2599, 2295, 928, 1420, 925, 920, 919, 790, 917,
+	916, 915, 854, 855, 856, 853, 914, 106, 913, 2596,
+	912, 2595, 631, 1521, 911, 854, 855, 856, 853, 910,
+	909, 2183, 2453, 2530, 632, 2002, 2608, 908, 106, 2656,
+	2603, 907, 906, 905, 790, 868, 867, 877, 878, 870,
+	871, 872, 873, 874, 875, 876, 869, 2646, 901, 900,
+	899, 2636, 2634, 2211, 819, 2491, 2492, 2183, 807, 2643,
+	2990, 2641, 2687, 1882, 1885, 1886, 1887, 1883, 2645, 1884,
+	1888, 2988, 2648, 790, 1137, 1137, 2647, 2917, 2494, 790,
+	1581, 2070, 1928, 1586, 2563, 2564, 2565, 818, 2294, 2398,
+	2497, 2496, 1403, 2398, 2398, 2661, 2699, 1403, 2663, 2189,
+	2274, 2598, 2728, 2671, 2031, 2188, 2194, 2601, 1886, 1887,
+	2602, 854, 855, 856, 853, 2192, 2952, 2870, 2592, 3153,
+	2193, 1952, 2688, 854, 855, 856, 853, 854, 855, 856,
+	853, 2030, 2190, 1946, 2275, 2323, 2324, 2191, 84, 790,
+	2702, 2049, 790, 790, 790, 2709, 2706, 1521, 2708, 2593,
+	2029, 1135, 2608, 2701, 854, 855, 856, 853, 2297, 159,
+	1505, 1437, 2699, 2760, 2328, 2699, 2699, 2699, 2028, 1371,
+	1767, 2599, 2680, 854, 855, 856, 853, 1724, 1725, 813,
+	2027, 43, 42, 418, 2741, 3108, 2026, 2749, 2750, 2751,
+	2025, 854, 855, 856, 853, 2736, 2655, 2757, 2654, 2024,
+	2796, 417, 2748, 854, 855, 856, 853, 2627, 1941, 854,
+	855, 856, 853, 854, 855, 856, 853, 2878, 1817, 2738,
+	2107, 2758, 854, 855, 856, 853, 419, 420, 2054, 1783,
+	1435, 2653, 1414, 2768, 3008, 868, 867, 877, 878, 870,
+	871, 872, 873, 874, 875, 876, 869, 1328, 1327, 1872,
+	2817, 790, 2819, 2820, 2821, 2822, 1508, 2794, 1019, 1020,
+	1017, 1018, 1093, 790, 2021, 1092, 2803, 1015, 1016, 2020,
+	1013, 1014, 2462, 845, 2699, 2808, 2807, 2826, 2825, 2019,
+	2501, 2730, 2731, 3105, 1926, 1606, 2699, 854, 855, 856,
+	853, 2828, 854, 855, 856, 853, 2838, 2015, 1413, 1049,
+	2962, 2832, 854, 855, 856, 853, 1009, 2949, 2843, 2947,
+	2886, 2868, 2867, 790, 2865, 2857, 2767, 2763, 2762, 2006,
+	854, 855, 856, 853, 2667, 2872, 2542, 1982, 2398, 2522,
+	2398, 2398, 2398, 2398, 1521, 2864, 2699, 2521, 632, 1012,
+	641, 2862, 854, 855, 856, 853, 1281, 2766, 2660, 2882,
+	854, 855, 856, 853, 1439, 2992, 2991, 2991, 2927, 2931,
+	2729, 2257, 2226, 2887, 2881, 1778, 1664, 2939, 804, 854,
+	855, 856, 853, 642, 643, 644, 645, 2907, 2992, 2908,
+	642, 643, 644, 645, 2622, 2523, 641, 2922, 2923, 2924,
+	2925, 1877, 2827, 641, 2940, 1970, 1007, 1172, 2591, 1062,
+	51, 1560, 2948, 2971, 2950, 2951, 1141, 1, 1411, 2946,
+	2944, 2398, 646, 1882, 1885, 1886, 1887, 1883, 762, 1884,
+	1888, 2202, 2500, 2204, 1624, 2972, 1870, 1768, 2348, 2961,
+	1040, 671, 1334, 1197, 1148, 1164, 799, 1194, 798, 796,
+	2980, 1283, 2536, 534, 1589, 2166, 2759, 3007, 2899, 2538,
+	3063, 2985, 2941, 3014, 1503, 3002, 3003, 2989, 3010, 1210,
+	2987, 518, 2859, 2776, 2945, 2993, 2778, 2673, 3013, 1629,
+	850, 2243, 3001, 790, 690, 571, 546, 918, 1180, 1173,
+	2301, 1166, 544, 2398, 2971, 2997, 3018, 1505, 3020, 2559,
+	2063, 2809, 659, 1163, 691, 1706, 3024, 3035, 2774, 106,
+	3029, 3031, 1372, 106, 106, 1393, 2931, 1376, 2710, 3039,
+	3106, 2571, 2364, 2083, 3040, 3164, 3042, 3062, 3152, 3125,
+	3048, 3103, 3050, 2967, 1485, 3145, 3026, 3065, 3080, 2683,
+	2681, 2682, 3073, 2963, 3071, 454, 790, 1540, 630, 744,
+	2753, 1907, 1585, 455, 1799, 2955, 3072, 2739, 2580, 2581,
+	2398, 3076, 657, 3078, 1775, 658, 2076, 2075, 1249, 1233,
+	868, 867, 877, 878, 870, 871, 872, 873, 874, 875,
+	876, 869, 859, 1266, 2316, 2317, 897, 493, 3014, 3100,
+	890, 1652, 505, 2060, 2444, 2217, 50, 49, 790, 48,
+	790, 759, 47, 3013, 2971, 2971, 3099, 1933, 3107, 166,
+	3109, 536, 165, 761, 2931, 3112, 2938, 3113, 3114, 3119,
+	3012, 1233, 515, 1233, 514, 513, 3065, 512, 3115, 3121,
+	3120, 790, 511, 3128, 1881, 1879, 2398, 3137, 1878, 2931,
+	1517, 3132, 3135, 3116, 3139, 3141, 1901, 1403, 3144, 1516,
+	2649, 666, 3058, 2651, 1233, 2850, 2225, 1838, 1472, 2914,
+	2675, 2835, 2836, 762, 2732, 1480, 1479, 2619, 1476, 3155,
+	3151, 2151, 2299, 1489, 2614, 3159, 2610, 2471, 2429, 2430,
+	3163, 3162, 2436, 1782, 1493, 958, 954, 3171, 956, 957,
+	955, 1990, 1986, 3176, 3159, 3175, 3021, 1821, 3174, 3163,
+	2359, 1023, 2795, 3177, 1482, 2546, 1731, 1729, 1484, 1486,
+	1488, 2493, 1490, 1491, 1492, 1494, 1495, 1496, 1498, 1499,
+	1500, 1501, 868, 867, 877, 878, 870, 871, 872, 873,
+	874, 875, 876, 869, 2489, 2350, 1597, 1408, 2048, 1518,
+	1514, 1875, 2447, 1777, 76, 75, 82, 2434, 106, 131,
+	106, 106, 106, 106, 38, 776, 106, 132, 116, 2707,
+	635, 33, 27, 5, 2900, 2587, 2896, 1504, 1787, 1785,
+	1784, 2445, 2975, 2382, 2381, 668, 2380, 662, 2379, 652,
+	2378, 2377, 2737, 2376, 2437, 1978, 665, 664, 2375, 2374,
+	2373, 2432, 2372, 2371, 2370, 129, 2450, 2451, 113, 14,
+	15, 13, 2433, 663, 1502, 1201, 12, 656, 18, 868,
+	867, 877, 878, 870, 871, 872, 873, 874, 875, 876,
+	869, 1481, 26, 25, 24, 97, 96, 23, 95, 94,
+	93, 106, 92, 91, 11, 90, 89, 88, 87, 2438,
+	86, 21, 2793, 81, 79, 20, 80, 77, 78, 62,
+	1497, 61, 661, 60, 73, 72, 660, 1487, 71, 2804,
+	70, 69, 650, 68, 67, 689, 655, 2996, 59, 58,
+	649, 57, 56, 55, 974, 74, 66, 65, 2818, 64,
+	63, 54, 53, 52, 2824, 1650, 653, 115, 114, 112,
+	111, 110, 109, 108, 2831, 34, 35, 36, 37, 126,
+	125, 127, 130, 106, 128, 123, 121, 651, 124, 868,
+	867, 877, 878, 870, 871, 872, 873, 874, 875, 876,
+	869, 669, 122, 120, 45, 2849, 2852, 2, 2449, 0,
+	1825, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 654, 0, 0, 0, 2793,
+	0, 0, 0, 0, 0, 2440, 0, 0, 0, 2441,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	106, 0, 0, 0, 0, 0, 962, 2439, 2442, 974,
+	0, 0, 0, 0, 0, 0, 2448, 0, 0, 0,
+	0, 888, 0, 2913, 982, 986, 988, 990, 992, 993,
+	995, 0, 999, 996, 997, 998, 1477, 1478, 977, 978,
+	979, 980, 960, 961, 983, 667, 963, 0, 964, 965,
+	966, 967, 968, 969, 970, 971, 972, 973, 975, 981,
+	0, 0, 0, 0, 0, 0, 0, 985, 987, 989,
+	991, 994, 0, 0, 0, 0, 106, 0, 0, 0,
+	0, 0, 0, 0, 2974, 0, 0, 0, 0, 2452,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2435, 0, 0, 976, 0, 0, 2446, 0, 0,
+	0, 962, 0, 0, 0, 952, 0, 0, 0, 0,
+	0, 0, 0, 2852, 0, 0, 0, 0, 0, 982,
+	986, 988, 990, 992, 993, 995, 0, 999, 996, 997,
+	998, 0, 2793, 977, 978, 979, 980, 960, 961, 983,
+	0, 963, 0, 964, 965, 966, 967, 968, 969, 970,
+	971, 972, 973, 975, 981, 3038, 156, 349, 553, 0,
+	0, 0, 985, 987, 989, 991, 994, 0, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 507, 0, 3061, 248, 0, 0, 277, 0, 0,
+	0, 891, 0, 0, 341, 291, 0, 0, 0, 976,
+	601, 609, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 500, 3096, 0, 533, 578, 577, 520, 529,
+	0, 0, 229, 164, 521, 0, 528, 522, 526, 525,
+	523, 524, 0, 593, 0, 0, 0, 0, 0, 889,
+	491, 504, 0, 508, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 501, 502, 0,
+	0, 0, 0, 554, 3061, 503, 0, 3123, 549, 530,
+	531, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 527,
+	552, 556, 241, 615, 550, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	616, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 984, 0, 0, 0, 547, 0,
+	0, 0, 372, 0, 0, 599, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 551, 0, 328, 310,
+	612, 492, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 984,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 597, 306, 0, 0, 611, 592, 594, 595,
+	598, 602, 603, 604, 605, 606, 608, 610, 614, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 613, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 555, 296,
+	297, 298, 299, 600, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 622, 596, 621,
+	623, 624, 620, 625, 626, 607, 510, 0, 559, 618,
+	617, 619, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 516, 214, 0, 278, 119, 320,
+	253, 585, 564, 565, 566, 509, 567, 562, 563, 586,
+	557, 582, 583, 535, 560, 568, 581, 569, 584, 587,
+	588, 627, 628, 575, 629, 572, 589, 580, 579, 570,
+	558, 590, 591, 542, 537, 573, 574, 561, 576, 538,
+	539, 540, 541, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 545,
+	340, 336, 349, 553, 402, 404, 405, 245, 0, 0,
+	0, 211, 0, 308, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 507, 0, 0, 248,
+	0, 0, 277, 0, 0, 0, 543, 0, 0, 341,
+	291, 0, 0, 0, 0, 601, 609, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 500, 0, 0,
+	533, 578, 577, 520, 529, 0, 0, 229, 164, 521,
+	0, 528, 522, 526, 525, 523, 524, 0, 593, 0,
+	0, 0, 0, 0, 0, 491, 504, 2790, 508, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 501, 502, 0, 0, 0, 0, 554, 0,
+	503, 0, 0, 549, 530, 531, 0, 0, 220, 346,
+	362, 230, 334, 375, 235, 344, 225, 307, 330, 0,
+	0, 222, 360, 343, 288, 271, 272, 221, 0, 325,
+	246, 263, 242, 305, 527, 552, 556, 241, 615, 550,
+	370, 224, 0, 369, 304, 356, 361, 289, 283, 223,
+	358, 287, 282, 275, 250, 616, 267, 316, 281, 317,
+	268, 294, 293, 295, 0, 0, 0, 0, 0, 399,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 547, 0, 0, 0, 372, 0, 0,
+	599, 0, 0, 0, 345, 0, 0, 276, 0, 0,
+	0, 551, 0, 328, 310, 612, 492, 0, 326, 279,
+	357, 318, 363, 347, 371, 322, 319, 215, 348, 244,
+	290, 226, 228, 240, 247, 249, 251, 252, 300, 301,
+	313, 333, 350, 351, 352, 243, 236, 327, 237, 265,
+	238, 216, 337, 239, 218, 314, 355, 0, 257, 258,
+	259, 260, 261, 323, 286, 219, 285, 315, 354, 353,
+	227, 379, 385, 386, 391, 0, 392, 0, 0, 0,
+	400, 408, 409, 410, 412, 413, 414, 0, 415, 416,
+	0, 0, 0, 0, 0, 394, 0, 0, 0, 0,
+	0, 0, 384, 255, 208, 212, 367, 597, 306, 0,
+	0, 611, 592, 594, 595, 598, 602, 603, 604, 605,
+	606, 608, 610, 614, 331, 0, 0, 0, 0, 0,
+	270, 312, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 342, 365, 377, 395,
+	398, 0, 0, 0, 217, 397, 0, 2791, 0, 0,
+	0, 2792, 0, 613, 0, 0, 0, 376, 0, 0,
+	0, 0, 0, 555, 296, 297, 298, 299, 600, 0,
+	234, 396, 321, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 389,
+	390, 254, 264, 411, 266, 233, 311, 256, 374, 273,
+	0, 401, 0, 0, 0, 0, 0, 303, 269, 338,
+	274, 280, 324, 373, 309, 329, 231, 364, 339, 284,
+	0, 0, 622, 596, 621, 623, 624, 620, 625, 626,
+	607, 510, 0, 559, 618, 617, 619, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 516,
+	214, 0, 278, 0, 320, 253, 585, 564, 565, 566,
+	509, 567, 562, 563, 586, 557, 582, 583, 535, 560,
+	568, 581, 569, 584, 587, 588, 627, 628, 575, 629,
+	572, 589, 580, 579, 570, 558, 590, 591, 542, 537,
+	573, 574, 561, 576, 538, 539, 540, 541, 0, 0,
+	403, 0, 0, 380, 381, 382, 407, 366, 0, 292,
+	0, 213, 335, 0, 545, 340, 336, 349, 553, 402,
+	404, 405, 245, 0, 0, 0, 211, 0, 308, 0,
+	0, 0, 210, 0, 0, 0, 0, 0, 0, 0,
+	209, 507, 0, 0, 248, 0, 0, 277, 0, 0,
+	0, 543, 0, 0, 341, 291, 0, 0, 0, 0,
+	601, 609, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 500, 0, 0, 533, 578, 577, 520, 529,
+	0, 0, 229, 164, 521, 0, 528, 522, 526, 525,
+	523, 524, 0, 593, 0, 0, 0, 0, 0, 0,
+	491, 504, 0, 508, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 501, 502, 0,
+	0, 0, 0, 554, 0, 503, 0, 0, 549, 530,
+	531, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 527,
+	552, 556, 241, 615, 550, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	616, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 547, 0,
+	0, 0, 372, 0, 0, 599, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 551, 0, 328, 310,
+	612, 492, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 1336, 1335, 1337, 384, 255, 208,
+	212, 367, 597, 306, 0, 0, 611, 592, 594, 595,
+	598, 602, 603, 604, 605, 606, 608, 610, 614, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 613, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 555, 296,
+	297, 298, 299, 600, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 622, 596, 621,
+	623, 624, 620, 625, 626, 607, 510, 0, 559, 618,
+	617, 619, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 516, 214, 0, 278, 0, 320,
+	253, 585, 564, 565, 566, 509, 567, 562, 563, 586,
+	557, 582, 583, 535, 560, 568, 581, 569, 584, 587,
+	588, 627, 628, 575, 629, 572, 589, 580, 579, 570,
+	558, 590, 591, 542, 537, 573, 574, 561, 576, 538,
+	539, 540, 541, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 545,
+	340, 336, 349, 553, 402, 404, 405, 245, 0, 0,
+	0, 211, 0, 308, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 507, 0, 0, 248,
+	0, 0, 277, 0, 0, 0, 543, 0, 0, 341,
+	291, 0, 0, 0, 0, 601, 609, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 500, 0, 0,
+	533, 578, 577, 520, 529, 0, 0, 229, 164, 521,
+	0, 528, 522, 526, 525, 523, 524, 0, 593, 0,
+	0, 0, 0, 0, 0, 491, 504, 0, 508, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 501, 502, 0, 0, 0, 0, 554, 0,
+	503, 0, 0, 549, 530, 531, 0, 0, 220, 346,
+	362, 230, 334, 375, 235, 344, 225, 307, 330, 0,
+	0, 222, 360, 343, 288, 271, 272, 221, 0, 325,
+	246, 263, 242, 305, 527, 552, 556, 241, 615, 550,
+	370, 224, 0, 369, 304, 356, 361, 289, 283, 223,
+	358, 287, 282, 275, 250, 616, 267, 316, 281, 317,
+	268, 294, 293, 295, 0, 0, 0, 0, 0, 399,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 547, 0, 0, 0, 372, 0, 0,
+	599, 0, 0, 0, 345, 0, 0, 276, 0, 0,
+	0, 551, 0, 328, 310, 612, 492, 0, 326, 279,
+	357, 318, 363, 347, 371, 322, 319, 215, 348, 244,
+	290, 226, 228, 240, 247, 249, 251, 252, 300, 301,
+	313, 333, 350, 351, 352, 243, 236, 327, 237, 265,
+	238, 216, 337, 239, 218, 314, 355, 0, 257, 258,
+	259, 260, 261, 323, 286, 219, 285, 315, 354, 353,
+	227, 379, 385, 386, 391, 0, 392, 0, 0, 0,
+	400, 408, 409, 410, 412, 413, 414, 0, 415, 416,
+	0, 0, 0, 0, 0, 394, 0, 0, 0, 0,
+	0, 0, 384, 255, 208, 212, 367, 597, 306, 0,
+	0, 611, 592, 594, 595, 598, 602, 603, 604, 605,
+	606, 608, 610, 614, 331, 0, 0, 0, 0, 0,
+	270, 312, 0, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 342, 365, 377, 395,
+	398, 0, 0, 0, 217, 397, 0, 2791, 0, 0,
+	0, 2792, 0, 613, 0, 0, 0, 376, 0, 0,
+	0, 0, 0, 555, 296, 297, 298, 299, 600, 0,
+	234, 396, 321, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 389,
+	390, 254, 264, 411, 266, 233, 311, 256, 374, 273,
+	0, 401, 0, 0, 0, 0, 0, 303, 269, 338,
+	274, 280, 324, 373, 309, 329, 231, 364, 339, 284,
+	0, 0, 622, 596, 621, 623, 624, 620, 625, 626,
+	607, 510, 0, 559, 618, 617, 619, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 516,
-	0, 0, 0, 349, 0, 0, 568, 0, 0, 0,
-	322, 0, 0, 257, 0, 0, 0, 520, 0, 308,
-	290, 581, 463, 0, 306, 260, 334, 298, 340, 324,
-	348, 302, 299, 200, 325, 229, 271, 211, 213, 225,
-	232, 234, 236, 237, 280, 281, 293, 313, 327, 328,
-	329, 228, 221, 307, 222, 246, 223, 201, 315, 224,
-	203, 294, 332, 0, 242, 303, 267, 204, 266, 295,
-	331, 330, 212, 356, 362, 363, 368, 0, 369, 0,
-	0, 0, 377, 381, 382, 383, 385, 386, 387, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 0, 0,
-	0, 361, 240, 197, 198, 344, 566, 286, 0, 0,
-	580, 561, 563, 564, 567, 571, 572, 573, 574, 575,
-	577, 579, 583, 311, 0, 0, 0, 0, 0, 251,
-	292, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 319, 342, 354, 372, 375,
-	0, 0, 0, 202, 374, 0, 0, 0, 0, 0,
-	0, 0, 582, 0, 0, 0, 353, 0, 0, 0,
-	0, 0, 524, 276, 277, 278, 279, 569, 0, 219,
-	373, 301, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 366, 367,
-	239, 245, 384, 247, 218, 291, 241, 351, 254, 0,
-	378, 0, 0, 0, 0, 0, 283, 250, 316, 255,
-	261, 304, 350, 289, 309, 216, 341, 317, 265, 0,
-	0, 591, 565, 590, 592, 593, 589, 594, 595, 576,
-	481, 0, 528, 587, 586, 588, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 199,
-	0, 259, 0, 300, 238, 554, 533, 534, 535, 480,
-	536, 531, 532, 555, 526, 551, 552, 505, 529, 537,
-	550, 538, 553, 556, 557, 596, 597, 544, 598, 541,
-	558, 549, 548, 539, 527, 559, 560, 512, 507, 542,
-	543, 530, 545, 508, 509, 510, 511, 0, 0, 0,
-	357, 358, 359, 380, 343, 0, 230, 326, 522, 0,
-	0, 1590, 0, 0, 0, 0, 0, 0, 288, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 478, 0, 0, 0, 233, 0, 0, 258, 0,
-	0, 0, 513, 0, 0, 318, 272, 0, 0, 0,
-	0, 570, 578, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 471, 0, 0, 503, 547, 546, 490,
-	499, 0, 0, 214, 153, 491, 0, 498, 492, 496,
-	495, 493, 494, 0, 562, 0, 0, 0, 0, 0,
-	0, 462, 475, 0, 479, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 472, 473, 0,
-	0, 0, 0, 523, 0, 474, 0, 0, 518, 500,
-	501, 0, 0, 205, 323, 339, 215, 314, 352, 220,
-	321, 210, 287, 310, 0, 0, 207, 337, 320, 269,
-	252, 253, 206, 0, 305, 231, 244, 227, 285, 497,
-	521, 525, 226, 584, 519, 347, 209, 0, 346, 284,
-	333, 338, 270, 264, 208, 335, 268, 263, 256, 235,
-	585, 248, 296, 262, 297, 249, 274, 273, 275, 0,
-	0, 0, 0, 0, 376, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 516, 0,
-	0, 0, 349, 0, 0, 568, 0, 0, 0, 322,
-	0, 0, 257, 0, 0, 0, 520, 0, 308, 290,
-	581, 463, 0, 306, 260, 334, 298, 340, 324, 348,
-	302, 299, 200, 325, 229, 271, 211, 213, 225, 232,
-	234, 236, 237, 280, 281, 293, 313, 327, 328, 329,
-	228, 221, 307, 222, 246, 223, 201, 315, 224, 203,
-	294, 332, 0, 242, 303, 267, 204, 266, 295, 331,
-	330, 212, 356, 362, 363, 368, 0, 369, 0, 0,
-	0, 377, 381, 382, 383, 385, 386, 387, 0, 0,
-	0, 0, 0, 371, 0, 0, 0, 0, 0, 0,
-	361, 240, 197, 198, 344, 566, 286, 0, 0, 580,
-	561, 563, 564, 567, 571, 572, 573, 574, 575, 577,
-	579, 583, 311, 0, 0, 0, 0, 0, 251, 292,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 319, 342, 354, 372, 375, 0,
-	0, 0, 202, 374, 0, 0, 0, 0, 0, 0,
-	0, 582, 0, 0, 0, 353, 0, 0, 0, 0,
-	0, 524, 276, 277, 278, 279, 569, 0, 219, 373,
-	301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 366, 367, 239,
-	245, 384, 247, 218, 291, 241, 351, 254, 0, 378,
-	0, 0, 0, 0, 0, 283, 250, 316, 255, 261,
-	304, 350, 289, 309, 216, 341, 317, 265, 0, 0,
-	591, 565, 590, 592, 593, 589, 594, 595, 576, 481,
-	0, 528, 587, 586, 588, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 199, 0,
-	259, 0, 300, 238, 554, 533, 534, 535, 480, 536,
-	531, 532, 555, 526, 551, 552, 505, 529, 537, 550,
-	538, 553, 556, 557, 596, 597, 544, 598, 541, 558,
-	549, 548, 539, 527, 559, 560, 512, 507, 542, 543,
-	530, 545, 508, 509, 510, 511, 326, 522, 0, 357,
-	358, 359, 380, 343, 0, 230, 0, 288, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	478, 0, 0, 0, 233, 0, 0, 258, 0, 0,
-	0, 513, 0, 0, 318, 272, 0, 0, 0, 0,
-	570, 578, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 471, 0, 0, 503, 547, 546, 490, 499,
-	0, 0, 214, 153, 491, 0, 498, 492, 496, 495,
-	493, 494, 0, 562, 0, 0, 0, 0, 0, 0,
-	462, 475, 0, 479, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 472, 473, 0, 0,
-	0, 0, 523, 0, 474, 0, 0, 518, 500, 501,
-	0, 0, 205, 323, 339, 215, 314, 352, 220, 321,
-	210, 287, 310, 0, 0, 207, 337, 320, 269, 252,
-	253, 206, 0, 305, 231, 244, 227, 285, 497, 521,
-	525, 226, 584, 519, 347, 209, 0, 346, 284, 333,
-	338, 270, 264, 208, 335, 268, 263, 256, 235, 585,
-	248, 296, 262, 297, 249, 274, 273, 275, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 516, 0, 0,
-	0, 349, 0, 0, 568, 0, 0, 0, 322, 0,
-	0, 257, 0, 0, 0, 520, 0, 308, 290, 581,
-	463, 0, 306, 260, 334, 298, 340, 324, 348, 302,
-	299, 200, 325, 229, 271, 211, 213, 225, 232, 234,
-	236, 237, 280, 281, 293, 313, 327, 328, 329, 228,
-	221, 307, 222, 246, 223, 201, 315, 224, 203, 294,
-	332, 0, 242, 303, 267, 204, 266, 295, 331, 330,
-	212, 356, 362, 363, 368, 0, 369, 0, 0, 0,
-	377, 381, 382, 383, 385, 386, 387, 0, 0, 0,
-	0, 0, 371, 0, 0, 0, 0, 0, 0, 361,
-	240, 197, 198, 344, 566, 286, 0, 0, 580, 561,
-	563, 564, 567, 571, 572, 573, 574, 575, 577, 579,
-	583, 311, 0, 0, 0, 0, 0, 251, 292, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 319, 342, 354, 372, 375, 0, 0,
-	0, 202, 374, 0, 0, 0, 0, 0, 0, 0,
-	582, 0, 0, 0, 353, 0, 0, 0, 0, 0,
-	524, 276, 277, 278, 279, 569, 0, 219, 373, 301,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 366, 367, 239, 245,
-	384, 247, 218, 291, 241, 351, 254, 0, 378, 0,
-	0, 0, 0, 0, 283, 250, 316, 255, 261, 304,
-	350, 289, 309, 216, 341, 317, 265, 0, 0, 591,
-	565, 590, 592, 593, 589, 594, 595, 576, 481, 0,
-	528, 587, 586, 588, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 199, 0, 259,
-	0, 300, 238, 554, 533, 534, 535, 480, 536, 531,
-	532, 555, 526, 551, 552, 505, 529, 537, 550, 538,
-	553, 556, 557, 596, 597, 544, 598, 541, 558, 549,
-	548, 539, 527, 559, 560, 512, 507, 542, 543, 530,
-	545, 508, 509, 510, 511, 326, 522, 0, 357, 358,
-	359, 380, 343, 0, 230, 0, 288, 0, 0, 0,
-	0, 0, 0, 0, 0, 1191, 0, 0, 0, 478,
-	0, 0, 0, 233, 0, 0, 258, 0, 0, 0,
-	513, 0, 0, 318, 272, 0, 0, 0, 0, 570,
-	578, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 471, 0, 0, 503, 547, 546, 490, 499, 0,
-	0, 214, 153, 491, 0, 498, 492, 496, 495, 493,
-	494, 0, 562, 0, 0, 0, 0, 0, 0, 0,
-	475, 0, 479, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 472, 473, 0, 0, 0,
-	0, 523, 0, 474, 0, 0, 518, 500, 501, 0,
-	0, 205, 323, 339, 215, 314, 352, 220, 321, 210,
-	287, 310, 0, 0, 207, 337, 320, 269, 252, 253,
-	206, 0, 305, 231, 244, 227, 285, 497, 521, 525,
-	226, 584, 519, 347, 209, 0, 346, 284, 333, 338,
-	270, 264, 208, 335, 268, 263, 256, 235, 585, 248,
-	296, 262, 297, 249, 274, 273, 275, 0, 0, 0,
-	0, 0, 376, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 516, 0, 0, 0,
-	349, 0, 0, 568, 0, 0, 0, 322, 0, 0,
-	257, 0, 0, 0, 520, 0, 308, 290, 581, 0,
-	0, 306, 260, 334, 298, 340, 324, 348, 302, 299,
-	200, 325, 229, 271, 211, 213, 225, 232, 234, 236,
-	237, 280, 281, 293, 313, 327, 328, 329, 228, 221,
-	307, 222, 246, 223, 201, 315, 224, 203, 294, 332,
-	0, 242, 303, 267, 204, 266, 295, 331, 330, 212,
-	356, 1192, 1193, 368, 0, 369, 0, 0, 0, 377,
-	381, 382, 383, 385, 386, 387, 0, 0, 0, 0,
-	0, 371, 0, 0, 0, 0, 0, 0, 361, 240,
-	197, 198, 344, 566, 286, 0, 0, 580, 561, 563,
-	564, 567, 571, 572, 573, 574, 575, 577, 579, 583,
-	311, 0, 0, 0, 0, 0, 251, 292, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 319, 342, 354, 372, 375, 0, 0, 0,
-	202, 374, 0, 0, 0, 0, 0, 0, 0, 582,
-	0, 0, 0, 353, 0, 0, 0, 0, 0, 524,
-	276, 277, 278, 279, 569, 0, 219, 373, 301, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 366, 367, 239, 245, 384,
-	247, 218, 291, 241, 351, 254, 0, 378, 0, 0,
-	0, 0, 0, 283, 250, 316, 255, 261, 304, 350,
-	289, 309, 216, 341, 317, 265, 0, 0, 591, 565,
-	590, 592, 593, 589, 594, 595, 576, 481, 0, 528,
-	587, 586, 588, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 199, 0, 259, 0,
-	300, 238, 554, 533, 534, 535, 480, 536, 531, 532,
-	555, 526, 551, 552, 505, 529, 537, 550, 538, 553,
-	556, 557, 596, 597, 544, 598, 541, 558, 549, 548,
-	539, 527, 559, 560, 512, 507, 542, 543, 530, 545,
-	508, 509, 510, 511, 326, 522, 0, 357, 358, 359,
-	380, 343, 0, 230, 0, 288, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 478, 0,
-	0, 0, 233, 0, 0, 258, 0, 0, 0, 513,
-	0, 0, 318, 272, 0, 0, 0, 0, 570, 578,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 503, 547, 546, 490, 499, 0, 0,
-	214, 153, 491, 0, 498, 492, 496, 495, 493, 494,
-	0, 562, 0, 0, 0, 0, 0, 0, 462, 475,
-	0, 479, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 472, 473, 0, 0, 0, 0,
-	523, 0, 474, 0, 0, 518, 500, 501, 0, 0,
-	205, 323, 339, 215, 314, 352, 220, 321, 210, 287,
-	310, 0, 0, 207, 337, 320, 269, 252, 253, 206,
-	0, 305, 231, 244, 227, 285, 497, 521, 525, 226,
-	584, 519, 347, 209, 0, 346, 284, 333, 338, 270,
-	264, 208, 335, 268, 263, 256, 235, 585, 248, 296,
-	262, 297, 249, 274, 273, 275, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 516, 0, 0, 0, 349,
-	0, 0, 568, 0, 0, 0, 322, 0, 0, 257,
-	0, 0, 0, 520, 0, 308, 290, 581, 463, 0,
-	306, 260, 334, 298, 340, 324, 348, 302, 299, 200,
-	325, 229, 271, 211, 213, 225, 232, 234, 236, 237,
-	280, 281, 293, 313, 327, 328, 329, 228, 221, 307,
-	222, 246, 223, 201, 315, 224, 203, 294, 332, 0,
-	242, 303, 267, 204, 266, 295, 331, 330, 212, 356,
-	362, 363, 368, 0, 369, 0, 0, 0, 377, 381,
-	382, 383, 385, 386, 387, 0, 0, 0, 0, 0,
-	371, 0, 0, 0, 0, 0, 0, 361, 240, 197,
-	198, 344, 566, 286, 0, 0, 580, 561, 563, 564,
-	567, 571, 572, 573, 574, 575, 577, 579, 583, 311,
-	0, 0, 0, 0, 0, 251, 292, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 319, 342, 354, 372, 375, 0, 0, 0, 202,
-	374, 0, 0, 0, 0, 0, 0, 0, 582, 0,
-	0, 0, 353, 0, 0, 0, 0, 0, 524, 276,
-	277, 278, 279, 569, 0, 219, 373, 301, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 366, 367, 239, 245, 384, 247,
-	218, 291, 241, 351, 254, 0, 378, 0, 0, 0,
-	0, 0, 283, 250, 316, 255, 261, 304, 350, 289,
-	309, 216, 341, 317, 265, 0, 0, 591, 565, 590,
-	592, 593, 589, 594, 595, 576, 481, 0, 528, 587,
-	586, 588, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 199, 0, 259, 0, 300,
-	238, 554, 533, 534, 535, 480, 536, 531, 532, 555,
-	526, 551, 552, 505, 529, 537, 550, 538, 553, 556,
-	557, 596, 597, 544, 598, 541, 558, 549, 548, 539,
-	527, 559, 560, 512, 507, 542, 543, 530, 545, 508,
-	509, 510, 511, 326, 522, 0, 357, 358, 359, 380,
-	343, 0, 230, 0, 288, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 478, 0, 0,
-	0, 233, 0, 0, 258, 0, 0, 0, 513, 0,
-	0, 318, 272, 0, 0, 0, 0, 570, 578, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 471,
-	0, 0, 503, 547, 546, 490, 499, 0, 0, 214,
-	153, 491, 0, 498, 492, 496, 495, 493, 494, 0,
-	562, 0, 0, 0, 0, 0, 0, 0, 475, 0,
-	479, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 472, 473, 0, 0, 0, 0, 523,
-	0, 474, 0, 0, 518, 500, 501, 0, 0, 205,
-	323, 339, 215, 314, 352, 220, 321, 210, 287, 310,
-	0, 0, 207, 337, 320, 269, 252, 253, 206, 0,
-	305, 231, 244, 227, 285, 497, 521, 525, 226, 584,
-	519, 347, 209, 0, 346, 284, 333, 338, 270, 264,
-	208, 335, 268, 263, 256, 235, 585, 248, 296, 262,
-	297, 249, 274, 273, 275, 0, 0, 0, 0, 0,
-	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 516, 0, 0, 0, 349, 0,
-	0, 568, 0, 0, 0, 322, 0, 0, 257, 0,
-	0, 0, 520, 0, 308, 290, 581, 0, 0, 306,
-	260, 334, 298, 340, 324, 348, 302, 299, 200, 325,
-	229, 271, 211, 213, 225, 232, 234, 236, 237, 280,
-	281, 293, 313, 327, 328, 329, 228, 221, 307, 222,
-	246, 223, 201, 315, 224, 203, 294, 332, 0, 242,
-	303, 267, 204, 266, 295, 331, 330, 212, 356, 362,
-	363, 368, 0, 369, 0, 0, 0, 377, 381, 382,
-	383, 385, 386, 387, 0, 0, 0, 0, 0, 371,
-	0, 0, 0, 0, 0, 0, 361, 240, 197, 198,
-	344, 566, 286, 0, 0, 580, 561, 563, 564, 567,
-	571, 572, 573, 574, 575, 577, 579, 583, 311, 0,
-	0, 0, 0, 0, 251, 292, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	319, 342, 354, 372, 375, 0, 0, 0, 202, 374,
-	0, 0, 0, 0, 0, 0, 0, 582, 0, 0,
-	0, 353, 0, 0, 0, 0, 0, 524, 276, 277,
-	278, 279, 569, 0, 219, 373, 301, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 366, 367, 239, 245, 384, 247, 218,
-	291, 241, 351, 254, 0, 378, 0, 0, 0, 0,
-	0, 283, 250, 316, 255, 261, 304, 350, 289, 309,
-	216, 341, 317, 265, 0, 0, 591, 565, 590, 592,
-	593, 589, 594, 595, 576, 481, 0, 528, 587, 586,
-	588, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 199, 0, 259, 0, 300, 238,
-	554, 533, 534, 535, 480, 536, 531, 532, 555, 526,
-	551, 552, 505, 529, 537, 550, 538, 553, 556, 557,
-	596, 597, 544, 598, 541, 558, 549, 548, 539, 527,
-	559, 560, 512, 507, 542, 543, 530, 545, 508, 509,
-	510, 511, 0, 0, 0, 357, 358, 359, 380, 343,
-	0, 230, 145, 326, 39, 133, 111, 0, 0, 0,
-	0, 0, 0, 0, 288, 397, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 0, 0, 258, 0, 0, 0, 0, 0,
-	0, 318, 272, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 402,
-	0, 0, 152, 0, 0, 0, 0, 0, 0, 214,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
-	323, 339, 215, 314, 352, 220, 321, 210, 287, 310,
-	0, 0, 207, 337, 320, 269, 252, 253, 206, 0,
-	305, 231, 244, 227, 285, 0, 336, 364, 226, 355,
-	0, 347, 209, 0, 346, 284, 333, 338, 270, 264,
-	208, 335, 268, 263, 256, 235, 379, 248, 296, 262,
-	297, 249, 274, 273, 275, 0, 0, 0, 0, 0,
-	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 0, 0, 0, 0, 322, 0, 0, 257, 0,
-	0, 0, 365, 0, 308, 290, 0, 0, 0, 306,
-	260, 334, 298, 340, 324, 348, 302, 299, 200, 325,
-	229, 271, 211, 213, 225, 232, 234, 236, 237, 280,
-	281, 293, 313, 327, 328, 329, 228, 221, 307, 222,
-	246, 223, 201, 315, 224, 203, 294, 332, 0, 242,
-	303, 267, 204, 266, 295, 331, 330, 212, 356, 362,
-	363, 368, 0, 369, 0, 0, 0, 377, 381, 382,
-	383, 385, 386, 387, 0, 0, 0, 0, 0, 371,
-	0, 0, 0, 0, 0, 0, 361, 240, 197, 198,
-	344, 0, 286, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 282, 360, 0, 0, 0, 0, 311, 0,
-	0, 0, 0, 0, 251, 292, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	319, 342, 354, 372, 375, 0, 0, 0, 202, 374,
-	0, 0, 0, 0, 0, 0, 0, 345, 0, 0,
-	0, 353, 0, 0, 0, 0, 0, 370, 276, 277,
-	278, 279, 398, 400, 219, 373, 301, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 366, 367, 239, 245, 384, 247, 218,
-	291, 241, 351, 254, 0, 378, 0, 0, 0, 0,
-	0, 283, 250, 316, 255, 261, 304, 350, 289, 309,
-	216, 341, 317, 265, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 40, 0, 0, 192, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 199, 0, 259, 112, 300, 238,
-	156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 167, 168, 169, 170, 171, 172, 173, 174, 175,
-	176, 177, 0, 178, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 188, 189, 190, 191, 0, 193, 194,
-	195, 196, 326, 0, 0, 357, 358, 359, 380, 343,
-	0, 230, 0, 288, 0, 0, 0, 0, 0, 0,
-	0, 934, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 0, 0, 258, 0, 0, 0, 0, 0, 0,
-	318, 272, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 152, 0, 0, 0, 0, 0, 0, 214, 153,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 922, 0, 0, 0, 0, 205, 323,
-	339, 215, 314, 352, 220, 321, 210, 287, 310, 0,
-	0, 1668, 1670, 1671, 1672, 1673, 1674, 1675, 0, 1679,
-	1676, 1677, 1678, 285, 0, 1663, 1664, 1665, 1666, 920,
-	1649, 1669, 0, 1650, 284, 1651, 1652, 1653, 1654, 1655,
-	1656, 1657, 1658, 1659, 1660, 1661, 1667, 296, 262, 297,
-	249, 274, 273, 275, 945, 947, 949, 951, 954, 376,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 349, 0, 0,
-	0, 0, 0, 0, 322, 0, 0, 257, 0, 0,
-	0, 1662, 0, 308, 290, 0, 0, 0, 306, 260,
-	334, 298, 340, 324, 348, 302, 299, 200, 325, 229,
-	271, 211, 213, 225, 232, 234, 236, 237, 280, 281,
-	293, 313, 327, 328, 329, 228, 221, 307, 222, 246,
-	223, 201, 315, 224, 203, 294, 332, 0, 242, 303,
-	267, 204, 266, 295, 331, 330, 212, 356, 362, 363,
-	368, 0, 369, 0, 0, 0, 377, 381, 382, 383,
-	385, 386, 387, 0, 0, 0, 0, 0, 371, 0,
-	0, 0, 0, 0, 0, 361, 240, 197, 198, 344,
-	0, 286, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 282, 360, 0, 0, 0, 0, 311, 0, 0,
-	0, 0, 0, 251, 292, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 319,
-	342, 354, 372, 375, 0, 0, 0, 202, 374, 0,
-	0, 0, 0, 0, 0, 0, 345, 0, 0, 0,
-	353, 0, 0, 0, 0, 0, 370, 276, 277, 278,
-	279, 243, 0, 219, 373, 301, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 366, 367, 239, 245, 384, 247, 218, 291,
-	241, 351, 254, 0, 378, 0, 0, 0, 0, 0,
-	283, 250, 316, 255, 261, 304, 350, 289, 309, 216,
-	341, 317, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 199, 944, 259, 0, 300, 238, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
-	177, 0, 178, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 188, 189, 190, 191, 0, 193, 194, 195,
-	196, 326, 0, 0, 357, 358, 359, 380, 343, 0,
-	230, 0, 288, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	0, 0, 258, 0, 0, 0, 0, 0, 0, 318,
-	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	152, 0, 0, 0, 0, 0, 0, 214, 153, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 1733,
-	1736, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 205, 323, 339,
-	215, 314, 352, 220, 321, 210, 287, 310, 0, 0,
-	207, 337, 320, 269, 252, 253, 206, 0, 305, 231,
-	244, 227, 285, 0, 336, 364, 226, 355, 0, 347,
-	209, 0, 346, 284, 333, 338, 270, 264, 208, 335,
-	268, 263, 256, 235, 379, 248, 296, 262, 297, 249,
-	274, 273, 275, 0, 0, 0, 0, 0, 376, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1737, 349, 0, 0, 0,
-	1730, 0, 1729, 322, 1731, 1734, 257, 0, 0, 0,
-	365, 0, 308, 290, 0, 0, 0, 306, 260, 334,
-	298, 340, 324, 348, 302, 299, 200, 325, 229, 271,
-	211, 213, 225, 232, 234, 236, 237, 280, 281, 293,
-	313, 327, 328, 329, 228, 221, 307, 222, 246, 223,
-	201, 315, 224, 203, 294, 332, 1735, 242, 303, 267,
-	204, 266, 295, 331, 330, 212, 356, 362, 363, 368,
-	0, 369, 0, 0, 0, 377, 381, 382, 383, 385,
-	386, 387, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 0, 0, 0, 361, 240, 197, 198, 344, 0,
-	286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	282, 360, 0, 0, 0, 0, 311, 0, 0, 0,
-	0, 0, 251, 292, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 319, 342,
-	354, 372, 375, 0, 0, 0, 202, 374, 0, 0,
-	0, 0, 0, 0, 0, 345, 0, 0, 0, 353,
-	0, 0, 0, 0, 0, 370, 276, 277, 278, 279,
-	243, 0, 219, 373, 301, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 366, 367, 239, 245, 384, 247, 218, 291, 241,
-	351, 254, 0, 378, 0, 0, 0, 0, 0, 283,
-	250, 316, 255, 261, 304, 350, 289, 309, 216, 341,
-	317, 265, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 199, 0, 259, 0, 300, 238, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
+	214, 0, 278, 0, 320, 253, 585, 564, 565, 566,
+	509, 567, 562, 563, 586, 557, 582, 583, 535, 560,
+	568, 581, 569, 584, 587, 588, 627, 628, 575, 629,
+	572, 589, 580, 579, 570, 558, 590, 591, 542, 537,
+	573, 574, 561, 576, 538, 539, 540, 541, 0, 0,
+	403, 0, 0, 380, 381, 382, 407, 366, 0, 292,
+	0, 213, 335, 0, 545, 340, 336, 349, 553, 402,
+	404, 405, 245, 0, 0, 0, 211, 0, 308, 0,
+	0, 0, 210, 0, 0, 0, 0, 0, 0, 0,
+	209, 507, 0, 0, 248, 1404, 0, 277, 0, 0,
+	0, 543, 0, 0, 341, 291, 0, 0, 0, 0,
+	601, 609, 0, 0, 0, 0, 0, 0, 0, 1552,
+	0, 0, 500, 0, 0, 533, 578, 577, 520, 529,
+	0, 0, 229, 164, 521, 0, 528, 522, 526, 525,
+	523, 524, 0, 593, 0, 0, 0, 0, 0, 0,
+	491, 504, 0, 508, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 501, 502, 0,
+	0, 0, 0, 554, 0, 503, 0, 0, 1553, 530,
+	531, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 527,
+	552, 556, 241, 615, 550, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	616, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 547, 0,
+	0, 0, 372, 0, 0, 599, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 551, 0, 328, 310,
+	612, 492, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 597, 306, 0, 0, 611, 592, 594, 595,
+	598, 602, 603, 604, 605, 606, 608, 610, 614, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 613, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 555, 296,
+	297, 298, 299, 600, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 622, 596, 621,
+	623, 624, 620, 625, 626, 607, 510, 0, 559, 618,
+	617, 619, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 516, 214, 0, 278, 0, 320,
+	253, 585, 564, 565, 566, 509, 567, 562, 563, 586,
+	557, 582, 583, 535, 560, 568, 581, 569, 584, 587,
+	588, 627, 628, 575, 629, 572, 589, 580, 579, 570,
+	558, 590, 591, 542, 537, 573, 574, 561, 576, 538,
+	539, 540, 541, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 545,
+	340, 336, 0, 0, 402, 404, 405, 245, 156, 349,
+	553, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	308, 0, 0, 0, 0, 209, 0, 0, 0, 0,
+	0, 0, 0, 507, 0, 0, 248, 0, 0, 277,
+	0, 0, 0, 891, 0, 0, 341, 291, 0, 0,
+	0, 0, 601, 609, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 500, 0, 0, 533, 578, 577,
+	520, 529, 0, 0, 229, 164, 521, 0, 528, 522,
+	526, 525, 523, 524, 0, 593, 0, 0, 0, 0,
+	0, 0, 491, 504, 0, 508, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 501,
+	502, 0, 0, 0, 0, 554, 0, 503, 0, 0,
+	549, 530, 531, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 527, 552, 556, 241, 615, 550, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 616, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	547, 0, 0, 0, 372, 0, 0, 599, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 551, 0,
+	328, 310, 612, 492, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 597, 306, 0, 0, 611, 592,
+	594, 595, 598, 602, 603, 604, 605, 606, 608, 610,
+	614, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	613, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	555, 296, 297, 298, 299, 600, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 622,
+	596, 621, 623, 624, 620, 625, 626, 607, 510, 0,
+	559, 618, 617, 619, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 516, 214, 0, 278,
+	119, 320, 253, 585, 564, 565, 566, 509, 567, 562,
+	563, 586, 557, 582, 583, 535, 560, 568, 581, 569,
+	584, 587, 588, 627, 628, 575, 629, 572, 589, 580,
+	579, 570, 558, 590, 591, 542, 537, 573, 574, 561,
+	576, 538, 539, 540, 541, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 545, 340, 336, 349, 553, 402, 404, 405, 245,
+	0, 0, 0, 211, 0, 308, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 507, 0,
+	0, 248, 3122, 0, 277, 0, 0, 0, 543, 0,
+	0, 341, 291, 0, 0, 0, 0, 601, 609, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 500,
+	0, 0, 533, 578, 577, 520, 529, 0, 0, 229,
+	164, 521, 0, 528, 522, 526, 525, 523, 524, 0,
+	593, 0, 0, 0, 0, 0, 0, 491, 504, 0,
+	508, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 501, 502, 0, 0, 0, 0,
+	554, 0, 503, 0, 0, 549, 530, 531, 0, 0,
+	220, 346, 362, 230, 334, 375, 235, 344, 225, 307,
+	330, 0, 0, 222, 360, 343, 288, 271, 272, 221,
+	0, 325, 246, 263, 242, 305, 527, 552, 556, 241,
+	615, 550, 370, 224, 0, 369, 304, 356, 361, 289,
+	283, 223, 358, 287, 282, 275, 250, 616, 267, 316,
+	281, 317, 268, 294, 293, 295, 0, 0, 0, 0,
+	0, 399, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 547, 0, 0, 0, 372,
+	0, 0, 599, 0, 0, 0, 345, 0, 0, 276,
+	0, 0, 0, 551, 0, 328, 310, 612, 492, 0,
+	326, 279, 357, 318, 363, 347, 371, 322, 319, 215,
+	348, 244, 290, 226, 228, 240, 247, 249, 251, 252,
+	300, 301, 313, 333, 350, 351, 352, 243, 236, 327,
+	237, 265, 238, 216, 337, 239, 218, 314, 355, 0,
+	257, 258, 259, 260, 261, 323, 286, 219, 285, 315,
+	354, 353, 227, 379, 385, 386, 391, 0, 392, 0,
+	0, 0, 400, 408, 409, 410, 412, 413, 414, 0,
+	415, 416, 0, 0, 0, 0, 0, 394, 0, 0,
+	0, 0, 0, 0, 384, 255, 208, 212, 367, 597,
+	306, 0, 0, 611, 592, 594, 595, 598, 602, 603,
+	604, 605, 606, 608, 610, 614, 331, 0, 0, 0,
+	0, 0, 270, 312, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 342, 365,
+	377, 395, 398, 0, 0, 0, 217, 397, 0, 0,
+	0, 0, 0, 0, 0, 613, 0, 0, 0, 376,
+	0, 0, 0, 0, 0, 555, 296, 297, 298, 299,
+	600, 0, 234, 396, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 389, 390, 254, 264, 411, 266, 233, 311, 256,
+	374, 273, 0, 401, 0, 0, 0, 0, 0, 303,
+	269, 338, 274, 280, 324, 373, 309, 329, 231, 364,
+	339, 284, 0, 0, 622, 596, 621, 623, 624, 620,
+	625, 626, 607, 510, 0, 559, 618, 617, 619, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 516, 214, 0, 278, 0, 320, 253, 585, 564,
+	565, 566, 509, 567, 562, 563, 586, 557, 582, 583,
+	535, 560, 568, 581, 569, 584, 587, 588, 627, 628,
+	575, 629, 572, 589, 580, 579, 570, 558, 590, 591,
+	542, 537, 573, 574, 561, 576, 538, 539, 540, 541,
+	0, 0, 403, 0, 0, 380, 381, 382, 407, 366,
+	0, 292, 0, 213, 335, 0, 545, 340, 336, 349,
+	553, 402, 404, 405, 245, 0, 0, 0, 211, 0,
+	308, 0, 0, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 209, 507, 0, 0, 248, 0, 0, 277,
+	0, 0, 0, 543, 0, 0, 341, 291, 0, 0,
+	0, 0, 601, 609, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 500, 0, 0, 533, 578, 577,
+	520, 529, 0, 0, 229, 164, 521, 0, 528, 522,
+	526, 525, 523, 524, 0, 593, 0, 0, 0, 0,
+	0, 0, 491, 504, 0, 508, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 501,
+	502, 0, 0, 0, 0, 554, 0, 503, 0, 0,
+	549, 530, 531, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 527, 552, 556, 241, 615, 550, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 616, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	547, 0, 0, 0, 372, 0, 0, 599, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 551, 0,
+	328, 310, 612, 492, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 597, 306, 0, 0, 611, 592,
+	594, 595, 598, 602, 603, 604, 605, 606, 608, 610,
+	614, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	613, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	555, 296, 297, 298, 299, 600, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 622,
+	596, 621, 623, 624, 620, 625, 626, 607, 510, 0,
+	559, 618, 617, 619, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 516, 214, 0, 278,
+	0, 320, 253, 585, 564, 565, 566, 509, 567, 562,
+	563, 586, 557, 582, 583, 535, 560, 568, 581, 569,
+	584, 587, 588, 627, 628, 575, 629, 572, 589, 580,
+	579, 570, 558, 590, 591, 542, 537, 573, 574, 561,
+	576, 538, 539, 540, 541, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 2853,
+	2854, 2855, 340, 336, 349, 553, 402, 404, 405, 245,
+	0, 0, 0, 211, 0, 308, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 507, 0,
+	0, 248, 1404, 0, 277, 0, 0, 0, 543, 0,
+	0, 341, 291, 0, 0, 0, 0, 601, 609, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 500,
+	0, 0, 533, 578, 577, 520, 529, 0, 0, 229,
+	164, 521, 0, 528, 522, 526, 525, 523, 524, 0,
+	593, 0, 0, 0, 0, 0, 0, 491, 504, 0,
+	508, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 501, 502, 0, 0, 0, 0,
+	554, 0, 503, 0, 0, 549, 530, 531, 0, 0,
+	220, 346, 362, 230, 334, 375, 235, 344, 225, 307,
+	330, 0, 0, 222, 360, 343, 288, 271, 272, 221,
+	0, 325, 246, 263, 242, 305, 527, 552, 556, 241,
+	615, 550, 370, 224, 0, 369, 304, 356, 361, 289,
+	283, 223, 358, 287, 282, 275, 250, 616, 267, 316,
+	281, 317, 268, 294, 293, 295, 0, 0, 0, 0,
+	0, 399, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 547, 0, 0, 0, 372,
+	0, 0, 599, 0, 0, 0, 345, 0, 0, 276,
+	0, 0, 0, 551, 0, 328, 310, 612, 492, 0,
+	326, 279, 357, 318, 363, 347, 371, 322, 319, 215,
+	348, 244, 290, 226, 228, 240, 247, 249, 251, 252,
+	300, 301, 313, 333, 350, 351, 352, 243, 236, 327,
+	237, 265, 238, 216, 337, 239, 218, 314, 355, 0,
+	257, 258, 259, 260, 261, 323, 286, 219, 285, 315,
+	354, 353, 227, 379, 385, 386, 391, 0, 392, 0,
+	0, 0, 400, 408, 409, 410, 412, 413, 414, 0,
+	415, 416, 0, 0, 0, 0, 0, 394, 0, 0,
+	0, 0, 0, 0, 384, 255, 208, 212, 367, 597,
+	306, 0, 0, 611, 592, 594, 595, 598, 602, 603,
+	604, 605, 606, 608, 610, 614, 331, 0, 0, 0,
+	0, 0, 270, 312, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 342, 365,
+	377, 395, 398, 0, 0, 0, 217, 397, 0, 0,
+	0, 0, 0, 0, 0, 613, 0, 0, 0, 376,
+	0, 0, 0, 0, 0, 555, 296, 297, 298, 299,
+	600, 0, 234, 396, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 389, 390, 254, 264, 411, 266, 233, 311, 256,
+	374, 273, 0, 401, 0, 0, 0, 0, 0, 303,
+	269, 338, 274, 280, 324, 373, 309, 329, 231, 364,
+	339, 284, 0, 0, 622, 596, 621, 623, 624, 620,
+	625, 626, 607, 510, 0, 559, 618, 617, 619, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 516, 214, 0, 278, 0, 320, 253, 585, 564,
+	565, 566, 509, 567, 562, 563, 586, 557, 582, 583,
+	535, 560, 568, 581, 569, 584, 587, 588, 627, 628,
+	575, 629, 572, 589, 580, 579, 570, 558, 590, 591,
+	542, 537, 573, 574, 561, 576, 538, 539, 540, 541,
+	0, 0, 403, 0, 0, 380, 381, 382, 407, 366,
+	0, 292, 0, 213, 335, 0, 545, 340, 336, 349,
+	553, 402, 404, 405, 245, 0, 0, 0, 211, 0,
+	308, 0, 0, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 209, 507, 0, 0, 248, 0, 0, 277,
+	0, 0, 0, 543, 0, 0, 341, 291, 0, 0,
+	0, 0, 601, 609, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 500, 0, 0, 533, 578, 577,
+	520, 529, 0, 0, 229, 164, 521, 0, 528, 522,
+	526, 525, 523, 524, 0, 593, 0, 0, 0, 0,
+	0, 0, 491, 504, 0, 508, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 501,
+	502, 1580, 0, 0, 0, 554, 0, 503, 0, 0,
+	549, 530, 531, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 527, 552, 556, 241, 615, 550, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 616, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	547, 0, 0, 0, 372, 0, 0, 599, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 551, 0,
+	328, 310, 612, 492, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 597, 306, 0, 0, 611, 592,
+	594, 595, 598, 602, 603, 604, 605, 606, 608, 610,
+	614, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	613, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	555, 296, 297, 298, 299, 600, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 622,
+	596, 621, 623, 624, 620, 625, 626, 607, 510, 0,
+	559, 618, 617, 619, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 516, 214, 0, 278,
+	0, 320, 253, 585, 564, 565, 566, 509, 567, 562,
+	563, 586, 557, 582, 583, 535, 560, 568, 581, 569,
+	584, 587, 588, 627, 628, 575, 629, 572, 589, 580,
+	579, 570, 558, 590, 591, 542, 537, 573, 574, 561,
+	576, 538, 539, 540, 541, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 545, 340, 336, 0, 0, 402, 404, 405, 245,
+	349, 553, 0, 211, 1672, 0, 0, 0, 0, 210,
+	0, 308, 0, 0, 0, 0, 0, 209, 0, 0,
+	0, 0, 0, 0, 507, 0, 0, 248, 0, 0,
+	277, 0, 0, 0, 543, 0, 0, 341, 291, 0,
+	0, 0, 0, 601, 609, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 500, 0, 0, 533, 578,
+	577, 520, 529, 0, 0, 229, 164, 521, 0, 528,
+	522, 526, 525, 523, 524, 0, 593, 0, 0, 0,
+	0, 0, 0, 491, 504, 0, 508, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	501, 502, 0, 0, 0, 0, 554, 0, 503, 0,
+	0, 549, 530, 531, 0, 0, 220, 346, 362, 230,
+	334, 375, 235, 344, 225, 307, 330, 0, 0, 222,
+	360, 343, 288, 271, 272, 221, 0, 325, 246, 263,
+	242, 305, 527, 552, 556, 241, 615, 550, 370, 224,
+	0, 369, 304, 356, 361, 289, 283, 223, 358, 287,
+	282, 275, 250, 616, 267, 316, 281, 317, 268, 294,
+	293, 295, 0, 0, 0, 0, 0, 399, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 547, 0, 0, 0, 372, 0, 0, 599, 0,
+	0, 0, 345, 0, 0, 276, 0, 0, 0, 551,
+	0, 328, 310, 612, 492, 0, 326, 279, 357, 318,
+	363, 347, 371, 322, 319, 215, 348, 244, 290, 226,
+	228, 240, 247, 249, 251, 252, 300, 301, 313, 333,
+	350, 351, 352, 243, 236, 327, 237, 265, 238, 216,
+	337, 239, 218, 314, 355, 0, 257, 258, 259, 260,
+	261, 323, 286, 219, 285, 315, 354, 353, 227, 379,
+	385, 386, 391, 0, 392, 0, 0, 0, 400, 408,
+	409, 410, 412, 413, 414, 0, 415, 416, 0, 0,
+	0, 0, 0, 394, 0, 0, 0, 0, 0, 0,
+	384, 255, 208, 212, 367, 597, 306, 0, 0, 611,
+	592, 594, 595, 598, 602, 603, 604, 605, 606, 608,
+	610, 614, 331, 0, 0, 0, 0, 0, 270, 312,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 342, 365, 377, 395, 398, 0,
+	0, 0, 217, 397, 0, 0, 0, 0, 0, 0,
+	0, 613, 0, 0, 0, 376, 0, 0, 0, 0,
+	0, 555, 296, 297, 298, 299, 600, 0, 234, 396,
+	321, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 389, 390, 254,
+	264, 411, 266, 233, 311, 256, 374, 273, 0, 401,
+	0, 0, 0, 0, 0, 303, 269, 338, 274, 280,
+	324, 373, 309, 329, 231, 364, 339, 284, 0, 0,
+	622, 596, 621, 623, 624, 620, 625, 626, 607, 510,
+	0, 559, 618, 617, 619, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 214, 0,
+	278, 0, 320, 253, 585, 564, 565, 566, 509, 567,
+	562, 563, 586, 557, 582, 583, 535, 560, 568, 581,
+	569, 584, 587, 588, 627, 628, 575, 629, 572, 589,
+	580, 579, 570, 558, 590, 591, 542, 537, 573, 574,
+	561, 576, 538, 539, 540, 541, 0, 0, 403, 0,
+	0, 380, 381, 382, 407, 366, 0, 292, 0, 213,
+	335, 0, 545, 340, 336, 349, 553, 402, 404, 405,
+	245, 0, 0, 0, 211, 0, 308, 0, 0, 0,
+	210, 0, 0, 0, 0, 0, 0, 0, 209, 507,
+	0, 0, 248, 0, 0, 277, 0, 0, 0, 543,
+	0, 0, 341, 291, 0, 0, 0, 0, 601, 609,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	500, 0, 0, 533, 578, 577, 520, 529, 0, 0,
+	229, 164, 521, 0, 528, 522, 526, 525, 523, 524,
+	0, 593, 0, 0, 0, 0, 0, 0, 491, 504,
+	0, 508, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 501, 502, 0, 0, 0,
+	0, 554, 0, 503, 0, 0, 549, 530, 531, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 527, 552, 556,
+	241, 615, 550, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 616, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 547, 0, 0, 0,
+	372, 0, 0, 599, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 551, 0, 328, 310, 612, 492,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	597, 306, 0, 0, 611, 592, 594, 595, 598, 602,
+	603, 604, 605, 606, 608, 610, 614, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 613, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 555, 296, 297, 298,
+	299, 600, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 622, 596, 621, 623, 624,
+	620, 625, 626, 607, 510, 0, 559, 618, 617, 619,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 516, 214, 0, 278, 0, 320, 253, 585,
+	564, 565, 566, 509, 567, 562, 563, 586, 557, 582,
+	583, 535, 560, 568, 581, 569, 584, 587, 588, 627,
+	628, 575, 629, 572, 589, 580, 579, 570, 558, 590,
+	591, 542, 537, 573, 574, 561, 576, 538, 539, 540,
+	541, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 545, 340, 336,
+	349, 553, 402, 404, 405, 245, 0, 0, 0, 211,
+	0, 308, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 507, 0, 0, 248, 0, 0,
+	277, 0, 0, 0, 543, 0, 0, 341, 291, 0,
+	0, 0, 0, 601, 609, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 3060, 0, 0, 533, 578,
+	577, 520, 529, 0, 0, 229, 164, 521, 0, 528,
+	522, 526, 525, 523, 524, 0, 593, 0, 0, 0,
+	0, 0, 0, 491, 504, 0, 508, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	501, 502, 0, 0, 0, 0, 554, 0, 503, 0,
+	0, 549, 530, 531, 0, 0, 220, 346, 362, 230,
+	334, 375, 235, 344, 225, 307, 330, 0, 0, 222,
+	360, 343, 288, 271, 272, 221, 0, 325, 246, 263,
+	242, 305, 527, 552, 556, 241, 615, 550, 370, 224,
+	0, 369, 304, 356, 361, 289, 283, 223, 358, 287,
+	282, 275, 250, 616, 267, 316, 281, 317, 268, 294,
+	293, 295, 0, 0, 0, 0, 0, 399, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 547, 0, 0, 0, 372, 0, 0, 599, 0,
+	0, 0, 345, 0, 0, 276, 0, 0, 0, 551,
+	0, 328, 310, 612, 492, 0, 326, 279, 357, 318,
+	363, 347, 371, 322, 319, 215, 348, 244, 290, 226,
+	228, 240, 247, 249, 251, 252, 300, 301, 313, 333,
+	350, 351, 352, 243, 236, 327, 237, 265, 238, 216,
+	337, 239, 218, 314, 355, 0, 257, 258, 259, 260,
+	261, 323, 286, 219, 285, 315, 354, 353, 227, 379,
+	385, 386, 391, 0, 392, 0, 0, 0, 400, 408,
+	409, 410, 412, 413, 414, 0, 415, 416, 0, 0,
+	0, 0, 0, 394, 0, 0, 0, 0, 0, 0,
+	384, 255, 208, 212, 367, 597, 306, 0, 0, 611,
+	592, 594, 595, 598, 602, 603, 604, 605, 606, 608,
+	610, 614, 331, 0, 0, 0, 0, 0, 270, 312,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 342, 365, 377, 395, 398, 0,
+	0, 0, 217, 397, 0, 0, 0, 0, 0, 0,
+	0, 613, 0, 0, 0, 376, 0, 0, 0, 0,
+	0, 555, 296, 297, 298, 299, 600, 0, 234, 396,
+	321, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 389, 390, 254,
+	264, 411, 266, 233, 311, 256, 374, 273, 0, 401,
+	0, 0, 0, 0, 0, 303, 269, 338, 274, 280,
+	324, 373, 309, 329, 231, 364, 339, 284, 0, 0,
+	622, 596, 621, 623, 624, 620, 625, 626, 607, 510,
+	0, 559, 618, 617, 619, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 214, 0,
+	278, 0, 320, 253, 585, 564, 565, 566, 509, 567,
+	562, 563, 586, 557, 582, 583, 535, 560, 568, 581,
+	569, 584, 587, 588, 627, 628, 575, 629, 572, 589,
+	580, 579, 570, 558, 590, 591, 542, 537, 573, 574,
+	561, 576, 538, 539, 540, 541, 0, 0, 403, 0,
+	0, 380, 381, 382, 407, 366, 0, 292, 0, 213,
+	335, 0, 545, 340, 336, 349, 553, 402, 404, 405,
+	245, 0, 0, 0, 211, 0, 308, 0, 0, 0,
+	210, 0, 0, 0, 0, 1250, 0, 0, 209, 507,
+	0, 0, 248, 0, 0, 277, 0, 0, 0, 543,
+	0, 0, 341, 291, 0, 0, 0, 0, 601, 609,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	500, 0, 0, 533, 578, 577, 520, 529, 0, 0,
+	229, 164, 521, 0, 528, 522, 526, 525, 523, 524,
+	0, 593, 0, 0, 0, 0, 0, 0, 0, 504,
+	0, 508, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 501, 502, 0, 0, 0,
+	0, 554, 0, 503, 0, 0, 549, 530, 531, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 527, 552, 556,
+	241, 615, 550, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 616, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 547, 0, 0, 0,
+	372, 0, 0, 599, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 551, 0, 328, 310, 612, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 1251, 1252, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	597, 306, 0, 0, 611, 592, 594, 595, 598, 602,
+	603, 604, 605, 606, 608, 610, 614, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 613, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 555, 296, 297, 298,
+	299, 600, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 622, 596, 621, 623, 624,
+	620, 625, 626, 607, 510, 0, 559, 618, 617, 619,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 516, 214, 0, 278, 0, 320, 253, 585,
+	564, 565, 566, 509, 567, 562, 563, 586, 557, 582,
+	583, 535, 560, 568, 581, 569, 584, 587, 588, 627,
+	628, 575, 629, 572, 589, 580, 579, 570, 558, 590,
+	591, 542, 537, 573, 574, 561, 576, 538, 539, 540,
+	541, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 545, 340, 336,
+	349, 553, 402, 404, 405, 245, 0, 0, 0, 211,
+	0, 308, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 507, 0, 0, 248, 0, 0,
+	277, 0, 0, 0, 543, 0, 0, 341, 291, 0,
+	0, 0, 0, 601, 609, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 533, 578,
+	577, 520, 529, 0, 0, 229, 164, 521, 0, 528,
+	522, 526, 525, 523, 524, 0, 593, 0, 0, 0,
+	0, 0, 0, 491, 504, 0, 508, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	501, 502, 0, 0, 0, 0, 554, 0, 503, 0,
+	0, 549, 530, 531, 0, 0, 220, 346, 362, 230,
+	334, 375, 235, 344, 225, 307, 330, 0, 0, 222,
+	360, 343, 288, 271, 272, 221, 0, 325, 246, 263,
+	242, 305, 527, 552, 556, 241, 615, 550, 370, 224,
+	0, 369, 304, 356, 361, 289, 283, 223, 358, 287,
+	282, 275, 250, 616, 267, 316, 281, 317, 268, 294,
+	293, 295, 0, 0, 0, 0, 0, 399, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 547, 0, 0, 0, 372, 0, 0, 599, 0,
+	0, 0, 345, 0, 0, 276, 0, 0, 0, 551,
+	0, 328, 310, 612, 492, 0, 326, 279, 357, 318,
+	363, 347, 371, 322, 319, 215, 348, 244, 290, 226,
+	228, 240, 247, 249, 251, 252, 300, 301, 313, 333,
+	350, 351, 352, 243, 236, 327, 237, 265, 238, 216,
+	337, 239, 218, 314, 355, 0, 257, 258, 259, 260,
+	261, 323, 286, 219, 285, 315, 354, 353, 227, 379,
+	385, 386, 391, 0, 392, 0, 0, 0, 400, 408,
+	409, 410, 412, 413, 414, 0, 415, 416, 0, 0,
+	0, 0, 0, 394, 0, 0, 0, 0, 0, 0,
+	384, 255, 208, 212, 367, 597, 306, 0, 0, 611,
+	592, 594, 595, 598, 602, 603, 604, 605, 606, 608,
+	610, 614, 331, 0, 0, 0, 0, 0, 270, 312,
+	0, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 342, 365, 377, 395, 398, 0,
+	0, 0, 217, 397, 0, 0, 0, 0, 0, 0,
+	0, 613, 0, 0, 0, 376, 0, 0, 0, 0,
+	0, 555, 296, 297, 298, 299, 600, 0, 234, 396,
+	321, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 389, 390, 254,
+	264, 411, 266, 233, 311, 256, 374, 273, 0, 401,
+	0, 0, 0, 0, 0, 303, 269, 338, 274, 280,
+	324, 373, 309, 329, 231, 364, 339, 284, 0, 0,
+	622, 596, 621, 623, 624, 620, 625, 626, 607, 510,
+	0, 559, 618, 617, 619, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 214, 0,
+	278, 0, 320, 253, 585, 564, 565, 566, 509, 567,
+	562, 563, 586, 557, 582, 583, 535, 560, 568, 581,
+	569, 584, 587, 588, 627, 628, 575, 629, 572, 589,
+	580, 579, 570, 558, 590, 591, 542, 537, 573, 574,
+	561, 576, 538, 539, 540, 541, 0, 0, 403, 0,
+	0, 380, 381, 382, 407, 366, 0, 292, 0, 213,
+	335, 0, 545, 340, 336, 349, 553, 402, 404, 405,
+	245, 0, 0, 0, 211, 0, 308, 0, 0, 0,
+	210, 0, 0, 0, 0, 0, 0, 0, 209, 507,
+	0, 0, 248, 0, 0, 277, 0, 0, 0, 543,
+	0, 0, 341, 291, 0, 0, 0, 0, 601, 609,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	500, 0, 0, 533, 578, 577, 520, 529, 0, 0,
+	229, 164, 521, 0, 528, 522, 526, 525, 523, 524,
+	0, 593, 0, 0, 0, 0, 0, 0, 0, 504,
+	0, 508, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 501, 502, 0, 0, 0,
+	0, 554, 0, 503, 0, 0, 549, 530, 531, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 527, 552, 556,
+	241, 615, 550, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 616, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 547, 0, 0, 0,
+	372, 0, 0, 599, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 551, 0, 328, 310, 612, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	597, 306, 0, 0, 611, 592, 594, 595, 598, 602,
+	603, 604, 605, 606, 608, 610, 614, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 613, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 555, 296, 297, 298,
+	299, 600, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 622, 596, 621, 623, 624,
+	620, 625, 626, 607, 510, 0, 559, 618, 617, 619,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 516, 214, 0, 278, 0, 320, 253, 585,
+	564, 565, 566, 509, 567, 562, 563, 586, 557, 582,
+	583, 535, 560, 568, 581, 569, 584, 587, 588, 627,
+	628, 575, 629, 572, 589, 580, 579, 570, 558, 590,
+	591, 542, 537, 573, 574, 561, 576, 538, 539, 540,
+	541, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 545, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 0, 211,
+	156, 349, 40, 142, 118, 210, 0, 0, 0, 0,
+	0, 0, 308, 209, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 104, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3034, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2397, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 117, 141, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 2399,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 46, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 2402,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 2406, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 0, 340, 336, 0, 0, 402, 404,
+	405, 2409, 2405, 103, 0, 211, 0, 0, 0, 0,
+	0, 210, 0, 2400, 2401, 2403, 2404, 0, 2407, 2408,
+	156, 349, 40, 142, 118, 0, 0, 0, 0, 0,
+	0, 0, 308, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 104, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2981, 2397, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 117, 141, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 2399,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 46, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 2402,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 2406, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 0, 340, 336, 0, 0, 402, 404,
+	405, 2409, 2405, 103, 0, 211, 0, 0, 0, 0,
+	0, 210, 0, 2400, 2401, 2403, 2404, 0, 2407, 2408,
+	156, 349, 40, 142, 118, 0, 0, 0, 0, 0,
+	0, 0, 308, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 104, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2897, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2397, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 117, 141, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 2399,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 46, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 2402,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 2406, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 0, 340, 336, 0, 0, 402, 404,
+	405, 2409, 2405, 103, 0, 211, 0, 0, 0, 0,
+	0, 210, 0, 2400, 2401, 2403, 2404, 0, 2407, 2408,
+	156, 349, 40, 142, 118, 0, 0, 0, 0, 0,
+	0, 0, 308, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 104, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2397, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 117, 141, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 2399,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 46, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 2402,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 2406, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 0, 340, 336, 0, 0, 402, 404,
+	405, 2409, 2405, 103, 0, 211, 0, 0, 0, 0,
+	0, 210, 0, 2400, 2401, 2403, 2404, 2895, 2407, 2408,
+	156, 349, 40, 142, 118, 0, 0, 0, 0, 0,
+	0, 0, 308, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 104, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2894, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2397, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 117, 141, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 2399,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 46, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 2402,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 2406, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 0, 340, 336, 0, 0, 402, 404,
+	405, 2409, 2405, 103, 0, 211, 0, 0, 0, 0,
+	0, 210, 0, 2400, 2401, 2403, 2404, 0, 2407, 2408,
+	156, 349, 40, 142, 118, 0, 0, 0, 0, 0,
+	0, 0, 308, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 104, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2892, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2397, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 117, 141, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 2399,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 46, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 2402,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 2406, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 0, 340, 336, 0, 0, 402, 404,
+	405, 2409, 2405, 103, 0, 211, 0, 0, 0, 0,
+	0, 210, 0, 2400, 2401, 2403, 2404, 0, 2407, 2408,
+	156, 349, 40, 142, 118, 0, 0, 0, 0, 0,
+	0, 0, 308, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 104, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2397, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 117, 141, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 2399,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 46, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 2402,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 2406, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 0, 340, 336, 0, 0, 402, 404,
+	405, 2409, 2405, 103, 0, 211, 0, 0, 0, 0,
+	0, 210, 0, 2400, 2401, 2403, 2404, 2725, 2407, 2408,
+	156, 349, 40, 142, 118, 0, 0, 0, 0, 0,
+	0, 0, 308, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 104, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2724, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2397, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 117, 141, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 2399,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 46, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 2402,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 2406, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 0, 340, 336, 0, 0, 402, 404,
+	405, 2409, 2405, 103, 0, 211, 0, 0, 0, 0,
+	0, 210, 0, 2400, 2401, 2403, 2404, 0, 2407, 2408,
+	156, 349, 40, 142, 118, 0, 0, 0, 0, 0,
+	0, 0, 308, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 104, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2717, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2397, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 117, 141, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 2399,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 46, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 2402,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 2406, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 0, 340, 336, 0, 0, 402, 404,
+	405, 2409, 2405, 103, 0, 211, 0, 0, 0, 0,
+	0, 210, 0, 2400, 2401, 2403, 2404, 0, 2407, 2408,
+	156, 349, 40, 142, 118, 0, 0, 0, 0, 0,
+	0, 0, 308, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 104, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2397, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 117, 141, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 2399,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 46, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 2402,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 2406, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 0, 340, 336, 0, 0, 402, 404,
+	405, 2409, 2405, 103, 0, 211, 0, 0, 0, 0,
+	0, 210, 0, 2400, 2401, 2403, 2404, 0, 2407, 2408,
+	156, 349, 40, 142, 118, 0, 0, 0, 0, 0,
+	0, 0, 308, 426, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 431, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 427, 429, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 119, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 349, 340, 336, 0, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 974, 0, 0, 0, 0, 0, 0, 209,
+	248, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 0, 0, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 962, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 1753, 1755, 1756, 1757, 1758, 1759, 1760, 0,
+	1764, 1761, 1762, 1763, 305, 0, 1748, 1749, 1750, 1751,
+	960, 1734, 1754, 0, 1735, 304, 1736, 1737, 1738, 1739,
+	1740, 1741, 1742, 1743, 1744, 1745, 1746, 1752, 316, 281,
+	317, 268, 294, 293, 295, 985, 987, 989, 991, 994,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 0, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 1747, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 984, 278, 0, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 0, 349, 340, 336, 0, 0,
+	402, 404, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 209, 248, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 0, 0, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 1829, 1832, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 387,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1833,
+	372, 0, 0, 0, 1826, 1820, 1825, 345, 1827, 1830,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	1831, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 278, 0, 320, 253, 167,
 	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
-	0, 178, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 188, 189, 190, 191, 0, 193, 194, 195, 196,
-	326, 0, 0, 357, 358, 359, 380, 343, 0, 230,
-	0, 288, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1500, 0, 0, 0, 0, 233, 0,
-	0, 258, 0, 0, 0, 0, 0, 0, 318, 272,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 152,
-	0, 0, 1501, 0, 0, 0, 214, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 0,
-	819, 820, 821, 818, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 205, 323, 339, 215,
-	314, 352, 220, 321, 210, 287, 310, 0, 0, 207,
-	337, 320, 269, 252, 253, 206, 0, 305, 231, 244,
-	227, 285, 0, 336, 364, 226, 355, 0, 347, 209,
-	0, 346, 284, 333, 338, 270, 264, 208, 335, 268,
-	263, 256, 235, 379, 248, 296, 262, 297, 249, 274,
-	273, 275, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 349, 0, 0, 0, 0,
-	0, 0, 322, 0, 0, 257, 0, 0, 0, 365,
-	0, 308, 290, 0, 0, 0, 306, 260, 334, 298,
-	340, 324, 348, 302, 299, 200, 325, 229, 271, 211,
-	213, 225, 232, 234, 236, 237, 280, 281, 293, 313,
-	327, 328, 329, 228, 221, 307, 222, 246, 223, 201,
-	315, 224, 203, 294, 332, 0, 242, 303, 267, 204,
-	266, 295, 331, 330, 212, 356, 362, 363, 368, 0,
-	369, 0, 0, 0, 377, 381, 382, 383, 385, 386,
-	387, 0, 0, 0, 0, 0, 371, 0, 0, 0,
-	0, 0, 0, 361, 240, 197, 198, 344, 0, 286,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
-	360, 0, 0, 0, 0, 311, 0, 0, 0, 0,
-	0, 251, 292, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 319, 342, 354,
-	372, 375, 0, 0, 0, 202, 374, 0, 0, 0,
-	0, 0, 0, 0, 345, 0, 0, 0, 353, 0,
-	0, 0, 0, 0, 370, 276, 277, 278, 279, 243,
-	0, 219, 373, 301, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	366, 367, 239, 245, 384, 247, 218, 291, 241, 351,
-	254, 0, 378, 0, 0, 0, 0, 0, 283, 250,
-	316, 255, 261, 304, 350, 289, 309, 216, 341, 317,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 199, 0, 259, 0, 300, 238, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 175, 176, 177, 0,
 	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 189, 190, 191, 0, 193, 194, 195, 196, 326,
-	0, 0, 357, 358, 359, 380, 343, 0, 230, 0,
-	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 701, 0,
-	258, 0, 0, 0, 0, 0, 0, 318, 272, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 152, 709,
-	710, 0, 0, 0, 0, 214, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 713, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 205, 323, 339, 215, 314,
-	352, 220, 321, 210, 287, 310, 0, 0, 207, 337,
-	320, 269, 252, 253, 206, 0, 305, 231, 244, 227,
-	285, 0, 336, 364, 226, 355, 683, 347, 209, 682,
-	346, 284, 333, 338, 270, 264, 208, 335, 268, 263,
-	256, 235, 379, 248, 296, 262, 297, 249, 274, 273,
-	275, 0, 0, 0, 0, 0, 376, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 0, 0, 0,
-	0, 322, 0, 0, 257, 0, 0, 0, 365, 0,
-	308, 290, 0, 0, 0, 306, 260, 334, 298, 340,
-	324, 348, 699, 299, 200, 325, 229, 271, 211, 213,
-	225, 232, 234, 236, 237, 280, 281, 293, 313, 327,
-	328, 329, 228, 221, 307, 222, 246, 223, 201, 315,
-	224, 203, 294, 332, 0, 242, 303, 267, 204, 266,
-	295, 331, 330, 212, 356, 362, 363, 368, 0, 369,
-	0, 0, 0, 377, 381, 382, 383, 385, 386, 387,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 0,
-	0, 0, 361, 240, 197, 198, 344, 0, 286, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 282, 360,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	251, 292, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 319, 342, 354, 372,
-	375, 0, 0, 0, 202, 374, 0, 0, 0, 0,
-	0, 0, 700, 345, 0, 0, 0, 353, 0, 0,
-	0, 0, 0, 703, 276, 277, 278, 279, 243, 0,
-	219, 373, 301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 366,
-	367, 239, 245, 384, 247, 218, 291, 241, 351, 254,
-	0, 378, 0, 0, 0, 0, 0, 711, 706, 707,
-	255, 261, 304, 350, 289, 309, 216, 341, 317, 708,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	199, 0, 259, 0, 300, 238, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 171, 172, 173, 174, 175, 176, 177, 0, 178,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
-	189, 190, 191, 0, 193, 194, 195, 196, 145, 326,
-	0, 357, 358, 359, 380, 343, 0, 230, 0, 0,
-	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
-	258, 0, 0, 0, 99, 0, 0, 318, 272, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 142, 1521, 0, 152, 0,
-	0, 0, 0, 0, 0, 214, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 205, 323, 339, 215, 314,
-	352, 220, 321, 210, 287, 310, 0, 0, 207, 337,
-	320, 269, 252, 253, 206, 0, 305, 231, 244, 227,
-	285, 0, 336, 364, 226, 355, 0, 347, 209, 0,
-	346, 284, 333, 338, 270, 264, 208, 335, 268, 263,
-	256, 235, 379, 248, 296, 262, 297, 249, 274, 273,
-	275, 0, 0, 0, 0, 0, 376, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 0, 0, 0,
-	0, 322, 0, 0, 257, 0, 0, 0, 365, 0,
-	308, 290, 0, 0, 0, 306, 260, 334, 298, 340,
-	324, 348, 302, 299, 200, 325, 229, 271, 211, 213,
-	225, 232, 234, 236, 237, 280, 281, 293, 313, 327,
-	328, 329, 228, 221, 307, 222, 246, 223, 201, 315,
-	224, 203, 294, 332, 0, 242, 303, 267, 204, 266,
-	295, 331, 330, 212, 356, 362, 363, 368, 0, 369,
-	0, 0, 0, 377, 381, 382, 383, 385, 386, 387,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 0,
-	0, 0, 361, 240, 197, 198, 344, 0, 286, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 282, 360,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	251, 292, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 319, 342, 354, 372,
-	375, 0, 0, 0, 202, 374, 0, 0, 0, 0,
-	0, 0, 0, 345, 0, 0, 0, 353, 0, 0,
-	0, 0, 0, 370, 276, 277, 278, 279, 243, 0,
-	219, 373, 301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 366,
-	367, 239, 245, 384, 247, 218, 291, 241, 351, 254,
-	0, 378, 0, 0, 0, 0, 0, 283, 250, 316,
-	255, 261, 304, 350, 289, 309, 216, 341, 317, 265,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	199, 0, 259, 112, 300, 238, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 171, 172, 173, 174, 175, 176, 177, 0, 178,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
-	189, 190, 191, 0, 193, 194, 195, 196, 145, 326,
-	0, 357, 358, 359, 380, 343, 0, 230, 0, 0,
-	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
-	258, 0, 0, 0, 99, 0, 0, 318, 272, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 142, 1512, 0, 152, 0,
-	0, 0, 0, 0, 0, 214, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 205, 323, 339, 215, 314,
-	352, 220, 321, 210, 287, 310, 0, 0, 207, 337,
-	320, 269, 252, 253, 206, 0, 305, 231, 244, 227,
-	285, 0, 336, 364, 226, 355, 0, 347, 209, 0,
-	346, 284, 333, 338, 270, 264, 208, 335, 268, 263,
-	256, 235, 379, 248, 296, 262, 297, 249, 274, 273,
-	275, 0, 0, 0, 0, 0, 376, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 0, 0, 0,
-	0, 322, 0, 0, 257, 0, 0, 0, 365, 0,
-	308, 290, 0, 0, 0, 306, 260, 334, 298, 340,
-	324, 348, 302, 299, 200, 325, 229, 271, 211, 213,
-	225, 232, 234, 236, 237, 280, 281, 293, 313, 327,
-	328, 329, 228, 221, 307, 222, 246, 223, 201, 315,
-	224, 203, 294, 332, 0, 242, 303, 267, 204, 266,
-	295, 331, 330, 212, 356, 362, 363, 368, 0, 369,
-	0, 0, 0, 377, 381, 382, 383, 385, 386, 387,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 0,
-	0, 0, 361, 240, 197, 198, 344, 0, 286, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 282, 360,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	251, 292, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 319, 342, 354, 372,
-	375, 0, 0, 0, 202, 374, 0, 0, 0, 0,
-	0, 0, 0, 345, 0, 0, 0, 353, 0, 0,
-	0, 0, 0, 370, 276, 277, 278, 279, 243, 0,
-	219, 373, 301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 366,
-	367, 239, 245, 384, 247, 218, 291, 241, 351, 254,
-	0, 378, 0, 0, 0, 0, 0, 283, 250, 316,
-	255, 261, 304, 350, 289, 309, 216, 341, 317, 265,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	199, 0, 259, 112, 300, 238, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 171, 172, 173, 174, 175, 176, 177, 0, 178,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
-	189, 190, 191, 0, 193, 194, 195, 196, 145, 326,
-	0, 357, 358, 359, 380, 343, 0, 230, 0, 0,
-	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
-	258, 0, 0, 0, 99, 0, 0, 318, 272, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1447, 0, 0, 152, 0,
-	0, 0, 0, 0, 0, 214, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 205, 323, 339, 215, 314,
-	352, 220, 321, 210, 287, 310, 0, 0, 207, 337,
-	320, 269, 252, 253, 206, 0, 305, 231, 244, 227,
-	285, 0, 336, 364, 226, 355, 0, 347, 209, 0,
-	346, 284, 333, 338, 270, 264, 208, 335, 268, 263,
-	256, 235, 379, 248, 296, 262, 297, 249, 274, 273,
-	275, 0, 0, 0, 0, 0, 376, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 0, 0, 0,
-	0, 322, 0, 0, 257, 0, 0, 0, 365, 0,
-	308, 290, 0, 0, 0, 306, 260, 334, 298, 340,
-	324, 348, 302, 299, 200, 325, 229, 271, 211, 213,
-	225, 232, 234, 236, 237, 280, 281, 293, 313, 327,
-	328, 329, 228, 221, 307, 222, 246, 223, 201, 315,
-	224, 203, 294, 332, 0, 242, 303, 267, 204, 266,
-	295, 331, 330, 212, 356, 362, 363, 368, 0, 369,
-	0, 0, 0, 377, 381, 382, 383, 385, 386, 387,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 0,
-	0, 0, 361, 240, 197, 198, 344, 0, 286, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 282, 360,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	251, 292, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 319, 342, 354, 372,
-	375, 0, 0, 0, 202, 374, 0, 0, 0, 0,
-	0, 0, 0, 345, 0, 0, 0, 353, 0, 0,
-	0, 0, 0, 370, 276, 277, 278, 279, 243, 0,
-	219, 373, 301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 366,
-	367, 239, 245, 384, 247, 218, 291, 241, 351, 254,
-	0, 378, 0, 0, 0, 0, 0, 283, 250, 316,
-	255, 261, 304, 350, 289, 309, 216, 341, 317, 265,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	199, 0, 259, 112, 300, 238, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 171, 172, 173, 174, 175, 176, 177, 0, 178,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
-	189, 190, 191, 0, 193, 194, 195, 196, 326, 0,
-	0, 357, 358, 359, 380, 343, 0, 230, 0, 288,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 0, 0, 258,
-	0, 0, 0, 0, 0, 0, 318, 272, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 152, 709, 710,
-	0, 0, 0, 0, 214, 153, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 713, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 205, 323, 339, 215, 314, 352,
-	220, 321, 210, 287, 310, 0, 0, 207, 337, 320,
-	269, 252, 253, 206, 0, 305, 231, 244, 227, 285,
-	0, 336, 364, 226, 355, 683, 347, 209, 682, 346,
-	284, 333, 338, 270, 264, 208, 335, 268, 263, 256,
-	235, 379, 248, 296, 262, 297, 249, 274, 273, 275,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 349, 0, 0, 0, 0, 0, 0,
-	322, 0, 0, 257, 0, 0, 0, 365, 0, 308,
-	290, 0, 0, 0, 306, 260, 334, 298, 340, 324,
-	348, 302, 299, 200, 325, 229, 271, 211, 213, 225,
-	232, 234, 236, 237, 280, 281, 293, 313, 327, 328,
-	329, 228, 221, 307, 222, 246, 223, 201, 315, 224,
-	203, 294, 332, 0, 242, 303, 267, 204, 266, 295,
-	331, 330, 212, 356, 362, 363, 368, 0, 369, 0,
-	0, 0, 377, 381, 382, 383, 385, 386, 387, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 0, 0,
-	0, 361, 240, 197, 198, 344, 0, 286, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 282, 360, 0,
-	0, 0, 0, 311, 0, 0, 0, 0, 0, 251,
-	292, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 319, 342, 354, 372, 375,
-	0, 0, 0, 202, 374, 0, 0, 0, 0, 0,
-	0, 0, 345, 0, 0, 0, 353, 0, 0, 0,
-	0, 0, 370, 276, 277, 278, 279, 243, 0, 219,
-	373, 301, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 366, 367,
-	239, 245, 384, 247, 218, 291, 241, 351, 254, 0,
-	378, 0, 0, 0, 0, 0, 711, 706, 707, 255,
-	261, 304, 350, 289, 309, 216, 341, 317, 708, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 199,
-	0, 259, 0, 300, 238, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 175, 176, 177, 0, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
-	190, 191, 0, 193, 194, 195, 196, 326, 0, 0,
-	357, 358, 359, 380, 343, 0, 230, 0, 288, 0,
-	0, 0, 0, 0, 0, 0, 0, 2053, 0, 0,
-	0, 0, 0, 0, 0, 233, 0, 0, 258, 0,
-	0, 0, 0, 0, 0, 318, 272, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 152, 0, 0, 0,
-	0, 0, 0, 214, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 205, 323, 339, 215, 314, 352, 220,
-	321, 210, 287, 310, 0, 0, 207, 337, 320, 269,
-	252, 253, 206, 0, 305, 231, 244, 227, 285, 0,
-	336, 364, 226, 355, 0, 347, 209, 0, 346, 284,
-	333, 338, 270, 264, 208, 335, 268, 263, 256, 235,
-	379, 248, 296, 262, 297, 249, 274, 273, 275, 0,
-	0, 0, 0, 0, 376, 0, 0, 0, 0, 0,
-	0, 0, 0, 2056, 0, 0, 2055, 0, 0, 0,
-	0, 0, 349, 0, 0, 0, 0, 0, 0, 322,
-	0, 0, 257, 0, 0, 0, 365, 0, 308, 290,
-	0, 0, 0, 306, 260, 334, 298, 340, 324, 348,
-	302, 299, 200, 325, 229, 271, 211, 213, 225, 232,
-	234, 236, 237, 280, 281, 293, 313, 327, 328, 329,
-	228, 221, 307, 222, 246, 223, 201, 315, 224, 203,
-	294, 332, 0, 242, 303, 267, 204, 266, 295, 331,
-	330, 212, 356, 362, 363, 368, 0, 369, 0, 0,
-	0, 377, 381, 382, 383, 385, 386, 387, 0, 0,
-	0, 0, 0, 371, 0, 0, 0, 0, 0, 0,
-	361, 240, 197, 198, 344, 0, 286, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 282, 360, 0, 0,
-	0, 0, 311, 0, 0, 0, 0, 0, 251, 292,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 319, 342, 354, 372, 375, 0,
-	0, 0, 202, 374, 0, 0, 0, 0, 0, 0,
-	0, 345, 0, 0, 0, 353, 0, 0, 0, 0,
-	0, 370, 276, 277, 278, 279, 243, 0, 219, 373,
-	301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 366, 367, 239,
-	245, 384, 247, 218, 291, 241, 351, 254, 0, 378,
-	0, 0, 0, 0, 0, 283, 250, 316, 255, 261,
-	304, 350, 289, 309, 216, 341, 317, 265, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 199, 0,
-	259, 0, 300, 238, 156, 157, 158, 159, 160, 161,
-	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
-	172, 173, 174, 175, 176, 177, 0, 178, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 188, 189, 190,
-	191, 0, 193, 194, 195, 196, 326, 0, 0, 357,
-	358, 359, 380, 343, 0, 230, 0, 288, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 1086, 0, 258, 0, 0,
-	0, 0, 0, 0, 318, 272, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 152, 0, 0, 1084, 0,
-	0, 0, 214, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1082, 0, 0,
-	0, 0, 205, 323, 339, 215, 314, 352, 220, 321,
-	210, 287, 310, 0, 0, 207, 337, 320, 269, 252,
-	253, 206, 0, 305, 231, 244, 227, 285, 0, 336,
-	364, 226, 355, 0, 347, 209, 0, 346, 284, 333,
-	338, 270, 264, 208, 335, 268, 263, 256, 235, 379,
-	248, 296, 262, 297, 249, 274, 273, 275, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 349, 0, 0, 0, 0, 0, 0, 322, 0,
-	0, 257, 0, 0, 0, 365, 0, 308, 290, 0,
-	0, 0, 306, 260, 334, 298, 340, 324, 348, 302,
-	299, 200, 325, 229, 271, 211, 213, 225, 232, 234,
-	236, 237, 280, 281, 293, 313, 327, 328, 329, 228,
-	221, 307, 222, 246, 223, 201, 315, 224, 203, 294,
-	332, 0, 242, 303, 267, 204, 266, 295, 331, 330,
-	212, 356, 362, 363, 368, 0, 369, 0, 0, 0,
-	377, 381, 382, 383, 385, 386, 387, 0, 0, 0,
-	0, 0, 371, 0, 0, 0, 0, 0, 0, 361,
-	240, 197, 198, 344, 0, 286, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 282, 360, 0, 0, 0,
-	0, 311, 0, 0, 0, 0, 0, 251, 292, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 319, 342, 354, 372, 375, 0, 0,
-	0, 202, 374, 0, 0, 0, 0, 0, 0, 0,
-	345, 0, 0, 0, 353, 0, 0, 0, 0, 0,
-	370, 276, 277, 278, 279, 243, 0, 219, 373, 301,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 366, 367, 239, 245,
-	384, 247, 218, 291, 241, 351, 254, 0, 378, 0,
-	0, 0, 0, 0, 283, 250, 316, 255, 261, 304,
-	350, 289, 309, 216, 341, 317, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 199, 0, 259,
-	0, 300, 238, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 175, 176, 177, 0, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 189, 190, 191,
-	0, 193, 194, 195, 196, 326, 0, 0, 357, 358,
-	359, 380, 343, 0, 230, 0, 288, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 1080, 0, 258, 0, 0, 0,
-	0, 0, 0, 318, 272, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 152, 0, 0, 1084, 0, 0,
-	0, 214, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1082, 0, 0, 0,
-	0, 205, 323, 339, 215, 314, 352, 220, 321, 210,
-	287, 310, 0, 0, 207, 337, 320, 269, 252, 253,
-	206, 0, 305, 231, 244, 227, 285, 0, 336, 364,
-	226, 355, 0, 347, 209, 0, 346, 284, 333, 338,
-	270, 264, 208, 335, 268, 263, 256, 235, 379, 248,
-	296, 262, 297, 249, 274, 273, 275, 0, 0, 0,
-	0, 0, 376, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	349, 0, 0, 0, 0, 0, 0, 322, 0, 0,
-	257, 0, 0, 0, 365, 0, 308, 290, 0, 0,
-	0, 306, 260, 334, 298, 340, 324, 348, 302, 299,
-	200, 325, 229, 271, 211, 213, 225, 232, 234, 236,
-	237, 280, 281, 293, 313, 327, 328, 329, 228, 221,
-	307, 222, 246, 223, 201, 315, 224, 203, 294, 332,
-	0, 242, 303, 267, 204, 266, 295, 331, 330, 212,
-	356, 362, 363, 368, 0, 369, 0, 0, 0, 377,
-	381, 382, 383, 385, 386, 387, 0, 0, 0, 0,
-	0, 371, 0, 0, 0, 0, 0, 0, 361, 240,
-	197, 198, 344, 0, 286, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 282, 360, 0, 0, 0, 0,
-	311, 0, 0, 0, 0, 0, 251, 292, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 319, 342, 354, 372, 375, 0, 0, 0,
-	202, 374, 0, 0, 0, 0, 0, 0, 0, 345,
-	0, 0, 0, 353, 0, 0, 0, 0, 0, 370,
-	276, 277, 278, 279, 243, 0, 219, 373, 301, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 366, 367, 239, 245, 384,
-	247, 218, 291, 241, 351, 254, 0, 378, 0, 0,
-	0, 0, 0, 283, 250, 316, 255, 261, 304, 350,
-	289, 309, 216, 341, 317, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 199, 0, 259, 0,
-	300, 238, 156, 157, 158, 159, 160, 161, 162, 163,
-	164, 165, 166, 167, 168, 169, 170, 171, 172, 173,
-	174, 175, 176, 177, 0, 178, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 188, 189, 190, 191, 0,
-	193, 194, 195, 196, 326, 0, 0, 357, 358, 359,
-	380, 343, 0, 230, 0, 288, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 0, 0, 258, 0, 0, 0, 0,
-	0, 0, 318, 272, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2667, 0, 152, 547, 0, 0, 0, 0, 0,
-	214, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	205, 323, 339, 215, 314, 352, 220, 321, 210, 287,
-	310, 0, 0, 207, 337, 320, 269, 252, 253, 206,
-	0, 305, 231, 244, 227, 285, 0, 336, 364, 226,
-	355, 0, 347, 209, 0, 346, 284, 333, 338, 270,
-	264, 208, 335, 268, 263, 256, 235, 379, 248, 296,
-	262, 297, 249, 274, 273, 275, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 349,
-	0, 0, 0, 0, 0, 0, 322, 0, 0, 257,
-	0, 0, 0, 365, 0, 308, 290, 0, 0, 0,
-	306, 260, 334, 298, 340, 324, 348, 302, 299, 200,
-	325, 229, 271, 211, 213, 225, 232, 234, 236, 237,
-	280, 281, 293, 313, 327, 328, 329, 228, 221, 307,
-	222, 246, 223, 201, 315, 224, 203, 294, 332, 0,
-	242, 303, 267, 204, 266, 295, 331, 330, 212, 356,
-	362, 363, 368, 0, 369, 0, 0, 0, 377, 381,
-	382, 383, 385, 386, 387, 0, 0, 0, 0, 0,
-	371, 0, 0, 0, 0, 0, 0, 361, 240, 197,
-	198, 344, 0, 286, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 282, 360, 0, 0, 0, 0, 311,
-	0, 0, 0, 0, 0, 251, 292, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 319, 342, 354, 372, 375, 0, 0, 0, 202,
-	374, 0, 0, 0, 0, 0, 0, 0, 345, 0,
-	0, 0, 353, 0, 0, 0, 0, 0, 370, 276,
-	277, 278, 279, 243, 0, 219, 373, 301, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 366, 367, 239, 245, 384, 247,
-	218, 291, 241, 351, 254, 0, 378, 0, 0, 0,
-	0, 0, 283, 250, 316, 255, 261, 304, 350, 289,
-	309, 216, 341, 317, 265, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 199, 0, 259, 0, 300,
-	238, 156, 157, 158, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	175, 176, 177, 0, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 189, 190, 191, 0, 193,
-	194, 195, 196, 326, 0, 0, 357, 358, 359, 380,
-	343, 0, 230, 0, 288, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 0, 0, 258, 0, 0, 0, 0, 0,
-	0, 318, 272, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 152, 0, 0, 1084, 0, 0, 0, 214,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2391, 0, 0, 0, 0, 205,
-	323, 339, 215, 314, 352, 220, 321, 210, 287, 310,
-	0, 0, 207, 337, 320, 269, 252, 253, 206, 0,
-	305, 231, 244, 227, 285, 0, 336, 364, 226, 355,
-	0, 347, 209, 0, 346, 284, 333, 338, 270, 264,
-	208, 335, 268, 263, 256, 235, 379, 248, 296, 262,
-	297, 249, 274, 273, 275, 0, 0, 0, 0, 0,
-	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 0, 0, 0, 0, 322, 0, 0, 257, 0,
-	0, 0, 365, 0, 308, 290, 0, 0, 0, 306,
-	260, 334, 298, 340, 324, 348, 302, 299, 200, 325,
-	229, 271, 211, 213, 225, 232, 234, 236, 237, 280,
-	281, 293, 313, 327, 328, 329, 228, 221, 307, 222,
-	246, 223, 201, 315, 224, 203, 294, 332, 0, 242,
-	303, 267, 204, 266, 295, 331, 330, 212, 356, 362,
-	363, 368, 0, 369, 0, 0, 0, 377, 381, 382,
-	383, 385, 386, 387, 0, 0, 0, 0, 0, 371,
-	0, 0, 0, 0, 0, 0, 361, 240, 197, 198,
-	344, 0, 286, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 282, 360, 0, 0, 0, 0, 311, 0,
-	0, 0, 0, 0, 251, 292, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	319, 342, 354, 372, 375, 0, 0, 0, 202, 374,
-	0, 0, 0, 0, 0, 0, 0, 345, 0, 0,
-	0, 353, 0, 0, 0, 0, 0, 370, 276, 277,
-	278, 279, 243, 0, 219, 373, 301, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 366, 367, 239, 245, 384, 247, 218,
-	291, 241, 351, 254, 0, 378, 0, 0, 0, 0,
-	0, 283, 250, 316, 255, 261, 304, 350, 289, 309,
-	216, 341, 317, 265, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 192, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 199, 0, 259, 0, 300, 238,
-	156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 167, 168, 169, 170, 171, 172, 173, 174, 175,
-	176, 177, 0, 178, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 188, 189, 190, 191, 0, 193, 194,
-	195, 196, 326, 0, 0, 357, 358, 359, 380, 343,
-	0, 230, 0, 288, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 0, 0, 258, 0, 0, 0, 0, 0, 0,
-	318, 272, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 152, 0, 0, 1084, 0, 0, 0, 214, 153,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1082, 0, 0, 0, 0, 205, 323,
-	339, 215, 314, 352, 220, 321, 210, 287, 310, 0,
-	0, 207, 337, 320, 269, 252, 253, 206, 0, 305,
-	231, 244, 227, 285, 0, 336, 364, 226, 355, 0,
-	347, 209, 0, 346, 284, 333, 338, 270, 264, 208,
-	335, 268, 263, 256, 235, 379, 248, 296, 262, 297,
-	249, 274, 273, 275, 0, 0, 0, 0, 0, 376,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 349, 0, 0,
-	0, 0, 0, 0, 322, 0, 0, 257, 0, 0,
-	0, 365, 0, 308, 290, 0, 0, 0, 306, 260,
-	334, 298, 340, 324, 348, 302, 299, 200, 325, 229,
-	271, 211, 213, 225, 232, 234, 236, 237, 280, 281,
-	293, 313, 327, 328, 329, 228, 221, 307, 222, 246,
-	223, 201, 315, 224, 203, 294, 332, 0, 242, 303,
-	267, 204, 266, 295, 331, 330, 212, 356, 362, 363,
-	368, 0, 369, 0, 0, 0, 377, 381, 382, 383,
-	385, 386, 387, 0, 0, 0, 0, 0, 371, 0,
-	0, 0, 0, 0, 0, 361, 240, 197, 198, 344,
-	0, 286, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 282, 360, 0, 0, 0, 0, 311, 0, 0,
-	0, 0, 0, 251, 292, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 319,
-	342, 354, 372, 375, 0, 0, 0, 202, 374, 0,
-	0, 0, 0, 0, 0, 0, 345, 0, 0, 0,
-	353, 0, 0, 0, 0, 0, 370, 276, 277, 278,
-	279, 243, 0, 219, 373, 301, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 366, 367, 239, 245, 384, 247, 218, 291,
-	241, 351, 254, 0, 378, 0, 0, 0, 0, 0,
-	283, 250, 316, 255, 261, 304, 350, 289, 309, 216,
-	341, 317, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 199, 0, 259, 0, 300, 238, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
-	177, 0, 178, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 188, 189, 190, 191, 0, 193, 194, 195,
-	196, 326, 0, 0, 357, 358, 359, 380, 343, 0,
-	230, 0, 288, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1795, 0, 0, 0, 0, 233,
-	0, 0, 258, 0, 0, 0, 0, 0, 0, 318,
-	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	152, 0, 0, 1797, 0, 0, 0, 214, 153, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 205, 323, 339,
-	215, 314, 352, 220, 321, 210, 287, 310, 0, 0,
-	207, 337, 320, 269, 252, 253, 206, 0, 305, 231,
-	244, 227, 285, 0, 336, 364, 226, 355, 0, 347,
-	209, 0, 346, 284, 333, 338, 270, 264, 208, 335,
-	268, 263, 256, 235, 379, 248, 296, 262, 297, 249,
-	274, 273, 275, 0, 0, 0, 0, 0, 376, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 0,
-	0, 0, 0, 322, 0, 0, 257, 0, 0, 0,
-	365, 0, 308, 290, 0, 0, 0, 306, 260, 334,
-	298, 340, 324, 348, 302, 299, 200, 325, 229, 271,
-	211, 213, 225, 232, 234, 236, 237, 280, 281, 293,
-	313, 327, 328, 329, 228, 221, 307, 222, 246, 223,
-	201, 315, 224, 203, 294, 332, 0, 242, 303, 267,
-	204, 266, 295, 331, 330, 212, 356, 362, 363, 368,
-	0, 369, 0, 0, 0, 377, 381, 382, 383, 385,
-	386, 387, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 0, 0, 0, 361, 240, 197, 198, 344, 0,
-	286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	282, 360, 0, 0, 0, 0, 311, 0, 0, 0,
-	0, 0, 251, 292, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 319, 342,
-	354, 372, 375, 0, 0, 0, 202, 374, 0, 0,
-	0, 0, 0, 0, 0, 345, 0, 0, 0, 353,
-	0, 0, 0, 0, 0, 370, 276, 277, 278, 279,
-	243, 0, 219, 373, 301, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 366, 367, 239, 245, 384, 247, 218, 291, 241,
-	351, 254, 0, 378, 0, 0, 0, 0, 0, 283,
-	250, 316, 255, 261, 304, 350, 289, 309, 216, 341,
-	317, 265, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 199, 0, 259, 0, 300, 238, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 349, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 308, 211,
+	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 248, 0, 0, 277, 0, 0,
+	0, 0, 0, 0, 341, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 0, 0, 0,
+	0, 0, 229, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 232, 1829, 1832, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 0,
+	359, 387, 241, 378, 0, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	406, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1833, 372, 0, 0, 0, 1826, 0, 1825, 345,
+	1827, 1830, 276, 0, 0, 0, 388, 0, 328, 310,
+	0, 0, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 1831, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 383, 0, 0, 0, 0, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 368, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 393, 296,
+	297, 298, 299, 262, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 278, 0, 320,
+	253, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 0, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 0, 204,
+	205, 206, 207, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 349,
+	340, 336, 0, 0, 402, 404, 405, 245, 0, 0,
+	308, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	0, 0, 1935, 0, 0, 209, 248, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 341, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 0,
+	1936, 0, 0, 0, 229, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 854, 855,
+	856, 853, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 0, 359, 387, 241, 378, 0, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 406, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 388, 0,
+	328, 310, 0, 0, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 0, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 383, 0, 0, 0,
+	0, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	368, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	393, 296, 297, 298, 299, 262, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 278,
+	0, 320, 253, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 0, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	0, 204, 205, 206, 207, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 349, 340, 336, 0, 0, 402, 404, 405, 245,
+	0, 0, 308, 211, 0, 0, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 248, 743,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	749, 750, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 753, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 346, 738,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 718, 370,
+	224, 717, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 741, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 742, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 745, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 751, 739, 740, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 748, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 0, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 156, 349, 340, 336, 0, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 209,
+	248, 0, 0, 277, 0, 0, 0, 104, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1519, 0,
+	0, 163, 0, 0, 0, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 222, 360, 343, 288, 271, 272, 221, 0,
+	325, 246, 263, 242, 305, 0, 359, 387, 241, 378,
+	0, 370, 224, 0, 369, 304, 356, 361, 289, 283,
+	223, 358, 287, 282, 275, 250, 406, 267, 316, 281,
+	317, 268, 294, 293, 295, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 0, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 388, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 278, 119, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 156, 349, 340, 336, 0, 1523,
+	402, 404, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 209, 248, 0, 0, 277, 0, 0, 0, 104,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	153, 1601, 0, 163, 0, 0, 0, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 387,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 278, 119, 320, 253, 167,
 	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
-	0, 178, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 188, 189, 190, 191, 0, 193, 194, 195, 196,
-	326, 0, 0, 357, 358, 359, 380, 343, 0, 230,
-	0, 288, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 1814,
-	0, 258, 0, 0, 0, 0, 0, 0, 318, 272,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 152,
-	0, 0, 1084, 0, 0, 0, 214, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 205, 323, 339, 215,
-	314, 352, 220, 321, 210, 287, 310, 0, 0, 207,
-	337, 320, 269, 252, 253, 206, 0, 305, 231, 244,
-	227, 285, 0, 336, 364, 226, 355, 0, 347, 209,
-	0, 346, 284, 333, 338, 270, 264, 208, 335, 268,
-	263, 256, 235, 379, 248, 296, 262, 297, 249, 274,
-	273, 275, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 349, 0, 0, 0, 0,
-	0, 0, 322, 0, 0, 257, 0, 0, 0, 365,
-	0, 308, 290, 0, 0, 0, 306, 260, 334, 298,
-	340, 324, 348, 302, 299, 200, 325, 229, 271, 211,
-	213, 225, 232, 234, 236, 237, 280, 281, 293, 313,
-	327, 328, 329, 228, 221, 307, 222, 246, 223, 201,
-	315, 224, 203, 294, 332, 0, 242, 303, 267, 204,
-	266, 295, 331, 330, 212, 356, 362, 363, 368, 0,
-	369, 0, 0, 0, 377, 381, 382, 383, 385, 386,
-	387, 0, 0, 0, 0, 0, 371, 0, 0, 0,
-	0, 0, 0, 361, 240, 197, 198, 344, 0, 286,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
-	360, 0, 0, 0, 0, 311, 0, 0, 0, 0,
-	0, 251, 292, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 319, 342, 354,
-	372, 375, 0, 0, 0, 202, 374, 0, 0, 0,
-	0, 0, 0, 0, 345, 0, 0, 0, 353, 0,
-	0, 0, 0, 0, 370, 276, 277, 278, 279, 243,
-	0, 219, 373, 301, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	366, 367, 239, 245, 384, 247, 218, 291, 241, 351,
-	254, 0, 378, 0, 0, 0, 0, 0, 283, 250,
-	316, 255, 261, 304, 350, 289, 309, 216, 341, 317,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 199, 0, 259, 0, 300, 238, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 175, 176, 177, 0,
 	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 189, 190, 191, 0, 193, 194, 195, 196, 326,
-	0, 0, 357, 358, 359, 380, 343, 0, 230, 0,
-	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
-	258, 0, 0, 0, 0, 0, 0, 318, 272, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2745, 0, 152, 0,
-	0, 0, 0, 0, 0, 214, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 205, 323, 339, 215, 314,
-	352, 220, 321, 210, 287, 310, 0, 0, 207, 337,
-	320, 269, 252, 253, 206, 0, 305, 231, 244, 227,
-	285, 0, 336, 364, 226, 355, 0, 347, 209, 0,
-	346, 284, 333, 338, 270, 264, 208, 335, 268, 263,
-	256, 235, 379, 248, 296, 262, 297, 249, 274, 273,
-	275, 0, 0, 0, 0, 0, 376, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 0, 0, 0,
-	0, 322, 0, 0, 257, 0, 0, 0, 365, 0,
-	308, 290, 0, 0, 0, 306, 260, 334, 298, 340,
-	324, 348, 302, 299, 200, 325, 229, 271, 211, 213,
-	225, 232, 234, 236, 237, 280, 281, 293, 313, 327,
-	328, 329, 228, 221, 307, 222, 246, 223, 201, 315,
-	224, 203, 294, 332, 0, 242, 303, 267, 204, 266,
-	295, 331, 330, 212, 356, 362, 363, 368, 0, 369,
-	0, 0, 0, 377, 381, 382, 383, 385, 386, 387,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 0,
-	0, 0, 361, 240, 197, 198, 344, 0, 286, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 282, 360,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	251, 292, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 319, 342, 354, 372,
-	375, 0, 0, 0, 202, 374, 0, 0, 0, 0,
-	0, 0, 0, 345, 0, 0, 0, 353, 0, 0,
-	0, 0, 0, 370, 276, 277, 278, 279, 243, 0,
-	219, 373, 301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 366,
-	367, 239, 245, 384, 247, 218, 291, 241, 351, 254,
-	0, 378, 0, 0, 0, 0, 0, 283, 250, 316,
-	255, 261, 304, 350, 289, 309, 216, 341, 317, 265,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	199, 0, 259, 0, 300, 238, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 171, 172, 173, 174, 175, 176, 177, 0, 178,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
-	189, 190, 191, 0, 193, 194, 195, 196, 326, 0,
-	0, 357, 358, 359, 380, 343, 0, 230, 0, 288,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 0, 0, 258,
-	0, 0, 0, 0, 0, 0, 318, 272, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 152, 547, 0,
-	0, 0, 0, 0, 214, 153, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 205, 323, 339, 215, 314, 352,
-	220, 321, 210, 287, 310, 0, 0, 207, 337, 320,
-	269, 252, 253, 206, 0, 305, 231, 244, 227, 285,
-	0, 336, 364, 226, 355, 0, 347, 209, 0, 346,
-	284, 333, 338, 270, 264, 208, 335, 268, 263, 256,
-	235, 379, 248, 296, 262, 297, 249, 274, 273, 275,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 349, 0, 0, 0, 0, 0, 0,
-	322, 0, 0, 257, 0, 0, 0, 365, 0, 308,
-	290, 0, 0, 0, 306, 260, 334, 298, 340, 324,
-	348, 302, 299, 200, 325, 229, 271, 211, 213, 225,
-	232, 234, 236, 237, 280, 281, 293, 313, 327, 328,
-	329, 228, 221, 307, 222, 246, 223, 201, 315, 224,
-	203, 294, 332, 0, 242, 303, 267, 204, 266, 295,
-	331, 330, 212, 356, 362, 363, 368, 0, 369, 0,
-	0, 0, 377, 381, 382, 383, 385, 386, 387, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 0, 0,
-	0, 361, 240, 197, 198, 344, 0, 286, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 282, 360, 0,
-	0, 0, 0, 311, 0, 0, 0, 0, 0, 251,
-	292, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 319, 342, 354, 372, 375,
-	0, 0, 0, 202, 374, 0, 0, 0, 0, 0,
-	0, 0, 345, 0, 0, 0, 353, 0, 0, 0,
-	0, 0, 370, 276, 277, 278, 279, 243, 0, 219,
-	373, 301, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 366, 367,
-	239, 245, 384, 247, 218, 291, 241, 351, 254, 0,
-	378, 0, 0, 0, 0, 0, 283, 250, 316, 255,
-	261, 304, 350, 289, 309, 216, 341, 317, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 199,
-	0, 259, 0, 300, 238, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 175, 176, 177, 0, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
-	190, 191, 0, 193, 194, 195, 196, 326, 0, 0,
-	357, 358, 359, 380, 343, 0, 230, 0, 288, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 0, 0, 258, 0,
-	0, 0, 0, 0, 0, 318, 272, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2682, 0, 0, 152, 0, 0, 0,
-	0, 0, 0, 214, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 205, 323, 339, 215, 314, 352, 220,
-	321, 210, 287, 310, 0, 0, 207, 337, 320, 269,
-	252, 253, 206, 0, 305, 231, 244, 227, 285, 0,
-	336, 364, 226, 355, 0, 347, 209, 0, 346, 284,
-	333, 338, 270, 264, 208, 335, 268, 263, 256, 235,
-	379, 248, 296, 262, 297, 249, 274, 273, 275, 0,
-	0, 0, 0, 0, 376, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 349, 0, 0, 0, 0, 0, 0, 322,
-	0, 0, 257, 0, 0, 0, 365, 0, 308, 290,
-	0, 0, 0, 306, 260, 334, 298, 340, 324, 348,
-	302, 299, 200, 325, 229, 271, 211, 213, 225, 232,
-	234, 236, 237, 280, 281, 293, 313, 327, 328, 329,
-	228, 221, 307, 222, 246, 223, 201, 315, 224, 203,
-	294, 332, 0, 242, 303, 267, 204, 266, 295, 331,
-	330, 212, 356, 362, 363, 368, 0, 369, 0, 0,
-	0, 377, 381, 382, 383, 385, 386, 387, 0, 0,
-	0, 0, 0, 371, 0, 0, 0, 0, 0, 0,
-	361, 240, 197, 198, 344, 0, 286, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 282, 360, 0, 0,
-	0, 0, 311, 0, 0, 0, 0, 0, 251, 292,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 319, 342, 354, 372, 375, 0,
-	0, 0, 202, 374, 0, 0, 0, 0, 0, 0,
-	0, 345, 0, 0, 0, 353, 0, 0, 0, 0,
-	0, 370, 276, 277, 278, 279, 243, 0, 219, 373,
-	301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 366, 367, 239,
-	245, 384, 247, 218, 291, 241, 351, 254, 0, 378,
-	0, 0, 0, 0, 0, 283, 250, 316, 255, 261,
-	304, 350, 289, 309, 216, 341, 317, 265, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 199, 0,
-	259, 0, 300, 238, 156, 157, 158, 159, 160, 161,
-	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
-	172, 173, 174, 175, 176, 177, 0, 178, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 188, 189, 190,
-	191, 0, 193, 194, 195, 196, 326, 0, 0, 357,
-	358, 359, 380, 343, 0, 230, 0, 288, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 0, 0, 258, 0, 0,
-	0, 0, 0, 0, 318, 272, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 152, 0, 0, 0, 0,
-	0, 0, 214, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 205, 323, 339, 215, 314, 352, 220, 321,
-	210, 287, 310, 0, 0, 207, 337, 320, 269, 252,
-	253, 206, 0, 305, 231, 244, 227, 285, 0, 336,
-	364, 226, 355, 0, 347, 209, 0, 346, 284, 333,
-	338, 270, 264, 208, 335, 268, 263, 256, 235, 379,
-	248, 296, 262, 297, 249, 274, 273, 275, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 349, 0, 0, 0, 2623, 0, 0, 322, 0,
-	0, 257, 0, 0, 0, 365, 0, 308, 290, 0,
-	0, 0, 306, 260, 334, 298, 340, 324, 348, 302,
-	299, 200, 325, 229, 271, 211, 213, 225, 232, 234,
-	236, 237, 280, 281, 293, 313, 327, 328, 329, 228,
-	221, 307, 222, 246, 223, 201, 315, 224, 203, 294,
-	332, 0, 242, 303, 267, 204, 266, 295, 331, 330,
-	212, 356, 362, 363, 368, 0, 369, 0, 0, 0,
-	377, 381, 382, 383, 385, 386, 387, 0, 0, 0,
-	0, 0, 371, 0, 0, 0, 0, 0, 0, 361,
-	240, 197, 198, 344, 0, 286, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 282, 360, 0, 0, 0,
-	0, 311, 0, 0, 0, 0, 0, 251, 292, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 319, 342, 354, 372, 375, 0, 0,
-	0, 202, 374, 0, 0, 0, 0, 0, 0, 0,
-	345, 0, 0, 0, 353, 0, 0, 0, 0, 0,
-	370, 276, 277, 278, 279, 243, 0, 219, 373, 301,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 366, 367, 239, 245,
-	384, 247, 218, 291, 241, 351, 254, 0, 378, 0,
-	0, 0, 0, 0, 283, 250, 316, 255, 261, 304,
-	350, 289, 309, 216, 341, 317, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 199, 0, 259,
-	0, 300, 238, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 175, 176, 177, 0, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 189, 190, 191,
-	0, 193, 194, 195, 196, 326, 0, 0, 357, 358,
-	359, 380, 343, 0, 230, 0, 288, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 0, 0, 258, 0, 0, 0,
-	0, 0, 0, 318, 272, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2468, 0, 0, 152, 0, 0, 0, 0, 0,
-	0, 214, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 205, 323, 339, 215, 314, 352, 220, 321, 210,
-	287, 310, 0, 0, 207, 337, 320, 269, 252, 253,
-	206, 0, 305, 231, 244, 227, 285, 0, 336, 364,
-	226, 355, 0, 347, 209, 0, 346, 284, 333, 338,
-	270, 264, 208, 335, 268, 263, 256, 235, 379, 248,
-	296, 262, 297, 249, 274, 273, 275, 0, 0, 0,
-	0, 0, 376, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	349, 0, 0, 0, 0, 0, 0, 322, 0, 0,
-	257, 0, 0, 0, 365, 0, 308, 290, 0, 0,
-	0, 306, 260, 334, 298, 340, 324, 348, 302, 299,
-	200, 325, 229, 271, 211, 213, 225, 232, 234, 236,
-	237, 280, 281, 293, 313, 327, 328, 329, 228, 221,
-	307, 222, 246, 223, 201, 315, 224, 203, 294, 332,
-	0, 242, 303, 267, 204, 266, 295, 331, 330, 212,
-	356, 362, 363, 368, 0, 369, 0, 0, 0, 377,
-	381, 382, 383, 385, 386, 387, 0, 0, 0, 0,
-	0, 371, 0, 0, 0, 0, 0, 0, 361, 240,
-	197, 198, 344, 0, 286, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 282, 360, 0, 0, 0, 0,
-	311, 0, 0, 0, 0, 0, 251, 292, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 319, 342, 354, 372, 375, 0, 0, 0,
-	202, 374, 0, 0, 0, 0, 0, 0, 0, 345,
-	0, 0, 0, 353, 0, 0, 0, 0, 0, 370,
-	276, 277, 278, 279, 243, 0, 219, 373, 301, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 366, 367, 239, 245, 384,
-	247, 218, 291, 241, 351, 254, 0, 378, 0, 0,
-	0, 0, 0, 283, 250, 316, 255, 261, 304, 350,
-	289, 309, 216, 341, 317, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 199, 0, 259, 0,
-	300, 238, 156, 157, 158, 159, 160, 161, 162, 163,
-	164, 165, 166, 167, 168, 169, 170, 171, 172, 173,
-	174, 175, 176, 177, 0, 178, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 188, 189, 190, 191, 0,
-	193, 194, 195, 196, 326, 0, 0, 357, 358, 359,
-	380, 343, 0, 230, 0, 288, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 0, 0, 258, 0, 0, 0, 0,
-	0, 0, 318, 272, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 152, 0, 0, 0, 0, 0, 0,
-	214, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	205, 323, 339, 215, 314, 352, 220, 321, 210, 287,
-	310, 0, 0, 207, 337, 320, 269, 252, 253, 206,
-	0, 305, 231, 244, 227, 285, 0, 336, 364, 226,
-	355, 0, 347, 209, 0, 346, 284, 333, 338, 270,
-	264, 208, 335, 268, 263, 256, 235, 379, 248, 296,
-	262, 297, 249, 274, 273, 275, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 349,
-	0, 0, 0, 2511, 0, 0, 322, 0, 0, 257,
-	0, 0, 0, 365, 0, 308, 290, 0, 0, 0,
-	306, 260, 334, 298, 340, 324, 348, 302, 299, 200,
-	325, 229, 271, 211, 213, 225, 232, 234, 236, 237,
-	280, 281, 293, 313, 327, 328, 329, 228, 221, 307,
-	222, 246, 223, 201, 315, 224, 203, 294, 332, 0,
-	242, 303, 267, 204, 266, 295, 331, 330, 212, 356,
-	362, 363, 368, 0, 369, 0, 0, 0, 377, 381,
-	382, 383, 385, 386, 387, 0, 0, 0, 0, 0,
-	371, 0, 0, 0, 0, 0, 0, 361, 240, 197,
-	198, 344, 0, 286, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 282, 360, 0, 0, 0, 0, 311,
-	0, 0, 0, 0, 0, 251, 292, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 319, 342, 354, 372, 375, 0, 0, 0, 202,
-	374, 0, 0, 0, 0, 0, 0, 0, 345, 0,
-	0, 0, 353, 0, 0, 0, 0, 0, 370, 276,
-	277, 278, 279, 243, 0, 219, 373, 301, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 366, 367, 239, 245, 384, 247,
-	218, 291, 241, 351, 254, 0, 378, 0, 0, 0,
-	0, 0, 283, 250, 316, 255, 261, 304, 350, 289,
-	309, 216, 341, 317, 265, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 199, 0, 259, 0, 300,
-	238, 156, 157, 158, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	175, 176, 177, 0, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 189, 190, 191, 0, 193,
-	194, 195, 196, 326, 0, 0, 357, 358, 359, 380,
-	343, 0, 230, 0, 288, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 0, 0, 258, 0, 0, 0, 0, 0,
-	0, 318, 272, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 152, 0, 0, 0, 0, 0, 0, 214,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
-	323, 339, 215, 314, 352, 220, 321, 210, 287, 310,
-	0, 0, 207, 337, 320, 269, 252, 253, 206, 0,
-	305, 231, 244, 227, 285, 0, 336, 364, 226, 355,
-	0, 347, 209, 0, 346, 284, 333, 338, 270, 264,
-	208, 335, 268, 263, 256, 235, 379, 248, 296, 262,
-	297, 249, 274, 273, 275, 0, 0, 0, 0, 0,
-	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 349, 0,
-	0, 0, 0, 0, 0, 322, 0, 0, 257, 0,
-	0, 0, 365, 0, 308, 290, 0, 0, 0, 306,
-	260, 334, 298, 340, 324, 348, 302, 299, 200, 325,
-	229, 271, 211, 213, 225, 232, 234, 236, 237, 280,
-	281, 293, 313, 327, 328, 329, 228, 221, 307, 222,
-	246, 223, 201, 315, 224, 203, 294, 332, 0, 242,
-	303, 267, 204, 266, 295, 331, 330, 212, 356, 362,
-	363, 368, 0, 369, 0, 0, 0, 377, 381, 382,
-	383, 385, 386, 387, 0, 0, 0, 0, 0, 371,
-	0, 0, 0, 0, 0, 0, 361, 240, 197, 198,
-	344, 0, 286, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 282, 360, 0, 0, 0, 0, 311, 0,
-	0, 0, 0, 0, 251, 292, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	319, 342, 354, 372, 375, 0, 0, 0, 202, 374,
-	0, 0, 0, 0, 0, 0, 0, 345, 0, 0,
-	0, 353, 0, 0, 0, 0, 0, 370, 276, 277,
-	278, 279, 243, 0, 219, 373, 301, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 366, 367, 239, 245, 384, 247, 218,
-	291, 241, 351, 254, 0, 378, 0, 0, 0, 0,
-	0, 283, 250, 316, 255, 261, 304, 350, 289, 309,
-	216, 341, 317, 265, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 192, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 199, 0, 259, 0, 300, 238,
-	156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 167, 168, 169, 170, 171, 172, 173, 174, 175,
-	176, 177, 0, 178, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 188, 189, 190, 191, 0, 193, 194,
-	195, 196, 326, 0, 0, 357, 358, 359, 380, 343,
-	0, 230, 0, 288, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 0, 0, 258, 0, 0, 0, 0, 0, 0,
-	318, 272, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1447, 0,
-	0, 152, 0, 0, 0, 0, 0, 0, 214, 153,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 205, 323,
-	339, 215, 314, 352, 220, 321, 210, 287, 310, 0,
-	0, 207, 337, 320, 269, 252, 253, 206, 0, 305,
-	231, 244, 227, 285, 0, 336, 364, 226, 355, 0,
-	347, 209, 0, 346, 284, 333, 338, 270, 264, 208,
-	335, 268, 263, 256, 235, 379, 248, 296, 262, 297,
-	249, 274, 273, 275, 0, 0, 0, 0, 0, 376,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 349, 0, 0,
-	0, 0, 0, 0, 322, 0, 0, 257, 0, 0,
-	0, 365, 0, 308, 290, 0, 0, 0, 306, 260,
-	334, 298, 340, 324, 348, 302, 299, 200, 325, 229,
-	271, 211, 213, 225, 232, 234, 236, 237, 280, 281,
-	293, 313, 327, 328, 329, 228, 221, 307, 222, 246,
-	223, 201, 315, 224, 203, 294, 332, 0, 242, 303,
-	267, 204, 266, 295, 331, 330, 212, 356, 362, 363,
-	368, 0, 369, 0, 0, 0, 377, 381, 382, 383,
-	385, 386, 387, 0, 0, 0, 0, 0, 371, 0,
-	0, 0, 0, 0, 0, 361, 240, 197, 198, 344,
-	0, 286, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 282, 360, 0, 0, 0, 0, 311, 0, 0,
-	0, 0, 0, 251, 292, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 319,
-	342, 354, 372, 375, 0, 0, 0, 202, 374, 0,
-	0, 0, 0, 0, 0, 0, 345, 0, 0, 0,
-	353, 0, 0, 0, 0, 0, 370, 276, 277, 278,
-	279, 243, 0, 219, 373, 301, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 366, 367, 239, 245, 384, 247, 218, 291,
-	241, 351, 254, 0, 378, 0, 0, 0, 0, 0,
-	283, 250, 316, 255, 261, 304, 350, 289, 309, 216,
-	341, 317, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 199, 0, 259, 0, 300, 238, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
-	177, 0, 178, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 188, 189, 190, 191, 0, 193, 194, 195,
-	196, 326, 0, 0, 357, 358, 359, 380, 343, 0,
-	230, 0, 288, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	0, 0, 258, 0, 0, 0, 0, 0, 0, 318,
-	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	152, 0, 0, 0, 0, 0, 0, 214, 153, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2315, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 205, 323, 339,
-	215, 314, 352, 220, 321, 210, 287, 310, 0, 0,
-	207, 337, 320, 269, 252, 253, 206, 0, 305, 231,
-	244, 227, 285, 0, 336, 364, 226, 355, 0, 347,
-	209, 0, 346, 284, 333, 338, 270, 264, 208, 335,
-	268, 263, 256, 235, 379, 248, 296, 262, 297, 249,
-	274, 273, 275, 0, 0, 0, 0, 0, 376, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 0,
-	0, 0, 0, 322, 0, 0, 257, 0, 0, 0,
-	365, 0, 308, 290, 0, 0, 0, 306, 260, 334,
-	298, 340, 324, 348, 302, 299, 200, 325, 229, 271,
-	211, 213, 225, 232, 234, 236, 237, 280, 281, 293,
-	313, 327, 328, 329, 228, 221, 307, 222, 246, 223,
-	201, 315, 224, 203, 294, 332, 0, 242, 303, 267,
-	204, 266, 295, 331, 330, 212, 356, 362, 363, 368,
-	0, 369, 0, 0, 0, 377, 381, 382, 383, 385,
-	386, 387, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 0, 0, 0, 361, 240, 197, 198, 344, 0,
-	286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	282, 360, 0, 0, 0, 0, 311, 0, 0, 0,
-	0, 0, 251, 292, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 319, 342,
-	354, 372, 375, 0, 0, 0, 202, 374, 0, 0,
-	0, 0, 0, 0, 0, 345, 0, 0, 0, 353,
-	0, 0, 0, 0, 0, 370, 276, 277, 278, 279,
-	243, 0, 219, 373, 301, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 366, 367, 239, 245, 384, 247, 218, 291, 241,
-	351, 254, 0, 378, 0, 0, 0, 0, 0, 283,
-	250, 316, 255, 261, 304, 350, 289, 309, 216, 341,
-	317, 265, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 199, 0, 259, 0, 300, 238, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 156, 349, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 308, 211,
+	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 248, 0, 0, 277, 0, 0,
+	0, 104, 0, 0, 341, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 153, 1592, 0, 163, 0, 0, 0, 0,
+	0, 0, 229, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 0,
+	359, 387, 241, 378, 0, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	406, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 372, 0, 0, 0, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 388, 0, 328, 310,
+	0, 0, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 383, 0, 0, 0, 0, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 368, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 393, 296,
+	297, 298, 299, 262, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 278, 119, 320,
+	253, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 0, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 0, 204,
+	205, 206, 207, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 349,
+	340, 336, 0, 0, 402, 404, 405, 245, 0, 0,
+	308, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 248, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 341, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 749, 750,
+	0, 0, 0, 0, 229, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 753, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 0, 359, 387, 241, 378, 718, 370, 224, 717,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 406, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 388, 0,
+	328, 310, 0, 0, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 0, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 383, 0, 0, 0,
+	0, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	368, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	393, 296, 297, 298, 299, 262, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 751, 1545, 1546, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 748, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 278,
+	0, 320, 253, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 0, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	0, 204, 205, 206, 207, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 349, 340, 336, 0, 0, 402, 404, 405, 245,
+	0, 0, 308, 211, 0, 0, 0, 0, 0, 210,
+	0, 2176, 0, 0, 0, 0, 0, 209, 248, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 2179, 0, 0,
+	2178, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 0, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 349, 340, 336, 0, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 209,
+	248, 1140, 0, 277, 0, 0, 0, 0, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 0, 1138, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1136, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 222, 360, 343, 288, 271, 272, 221, 0,
+	325, 246, 263, 242, 305, 0, 359, 387, 241, 378,
+	0, 370, 224, 0, 369, 304, 356, 361, 289, 283,
+	223, 358, 287, 282, 275, 250, 406, 267, 316, 281,
+	317, 268, 294, 293, 295, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 0, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 388, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 278, 0, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 0, 349, 340, 336, 0, 0,
+	402, 404, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 209, 248, 1134, 0, 277, 0, 0, 0, 0,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 0, 1138, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1136, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 387,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 278, 0, 320, 253, 167,
 	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
-	0, 178, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 188, 189, 190, 191, 0, 193, 194, 195, 196,
-	326, 0, 0, 357, 358, 359, 380, 343, 0, 230,
-	0, 288, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
-	0, 258, 0, 0, 0, 0, 0, 0, 318, 272,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 152,
-	0, 0, 2191, 0, 0, 0, 214, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 205, 323, 339, 215,
-	314, 352, 220, 321, 210, 287, 310, 0, 0, 207,
-	337, 320, 269, 252, 253, 206, 0, 305, 231, 244,
-	227, 285, 0, 336, 364, 226, 355, 0, 347, 209,
-	0, 346, 284, 333, 338, 270, 264, 208, 335, 268,
-	263, 256, 235, 379, 248, 296, 262, 297, 249, 274,
-	273, 275, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 349, 0, 0, 0, 0,
-	0, 0, 322, 0, 0, 257, 0, 0, 0, 365,
-	0, 308, 290, 0, 0, 0, 306, 260, 334, 298,
-	340, 324, 348, 302, 299, 200, 325, 229, 271, 211,
-	213, 225, 232, 234, 236, 237, 280, 281, 293, 313,
-	327, 328, 329, 228, 221, 307, 222, 246, 223, 201,
-	315, 224, 203, 294, 332, 0, 242, 303, 267, 204,
-	266, 295, 331, 330, 212, 356, 362, 363, 368, 0,
-	369, 0, 0, 0, 377, 381, 382, 383, 385, 386,
-	387, 0, 0, 0, 0, 0, 371, 0, 0, 0,
-	0, 0, 0, 361, 240, 197, 198, 344, 0, 286,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
-	360, 0, 0, 0, 0, 311, 0, 0, 0, 0,
-	0, 251, 292, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 319, 342, 354,
-	372, 375, 0, 0, 0, 202, 374, 0, 0, 0,
-	0, 0, 0, 0, 345, 0, 0, 0, 353, 0,
-	0, 0, 0, 0, 370, 276, 277, 278, 279, 243,
-	0, 219, 373, 301, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	366, 367, 239, 245, 384, 247, 218, 291, 241, 351,
-	254, 0, 378, 0, 0, 0, 0, 0, 283, 250,
-	316, 255, 261, 304, 350, 289, 309, 216, 341, 317,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 199, 0, 259, 0, 300, 238, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 175, 176, 177, 0,
 	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 189, 190, 191, 0, 193, 194, 195, 196, 326,
-	0, 0, 357, 358, 359, 380, 343, 0, 230, 0,
-	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
-	258, 0, 0, 0, 0, 0, 0, 318, 272, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 152, 0,
-	0, 0, 0, 0, 0, 214, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2122, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 205, 323, 339, 215, 314,
-	352, 220, 321, 210, 287, 310, 0, 0, 207, 337,
-	320, 269, 252, 253, 206, 0, 305, 231, 244, 227,
-	285, 0, 336, 364, 226, 355, 0, 347, 209, 0,
-	346, 284, 333, 338, 270, 264, 208, 335, 268, 263,
-	256, 235, 379, 248, 296, 262, 297, 249, 274, 273,
-	275, 0, 0, 0, 0, 0, 376, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 0, 0, 0,
-	0, 322, 0, 0, 257, 0, 0, 0, 365, 0,
-	308, 290, 0, 0, 0, 306, 260, 334, 298, 340,
-	324, 348, 302, 299, 200, 325, 229, 271, 211, 213,
-	225, 232, 234, 236, 237, 280, 281, 293, 313, 327,
-	328, 329, 228, 221, 307, 222, 246, 223, 201, 315,
-	224, 203, 294, 332, 0, 242, 303, 267, 204, 266,
-	295, 331, 330, 212, 356, 362, 363, 368, 0, 369,
-	0, 0, 0, 377, 381, 382, 383, 385, 386, 387,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 0,
-	0, 0, 361, 240, 197, 198, 344, 0, 286, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 282, 360,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	251, 292, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 319, 342, 354, 372,
-	375, 0, 0, 0, 202, 374, 0, 0, 0, 0,
-	0, 0, 0, 345, 0, 0, 0, 353, 0, 0,
-	0, 0, 0, 370, 276, 277, 278, 279, 243, 0,
-	219, 373, 301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 366,
-	367, 239, 245, 384, 247, 218, 291, 241, 351, 254,
-	0, 378, 0, 0, 0, 0, 0, 283, 250, 316,
-	255, 261, 304, 350, 289, 309, 216, 341, 317, 265,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	199, 0, 259, 0, 300, 238, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 171, 172, 173, 174, 175, 176, 177, 0, 178,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
-	189, 190, 191, 0, 193, 194, 195, 196, 326, 0,
-	0, 357, 358, 359, 380, 343, 0, 230, 0, 288,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 0, 0, 258,
-	0, 0, 0, 0, 0, 0, 318, 272, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 152, 0, 0,
-	1084, 0, 0, 0, 214, 153, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 205, 323, 339, 215, 314, 352,
-	220, 321, 210, 287, 310, 0, 0, 207, 337, 320,
-	269, 252, 253, 206, 0, 305, 231, 244, 227, 285,
-	0, 336, 364, 226, 355, 0, 347, 209, 0, 346,
-	284, 333, 338, 270, 264, 208, 335, 268, 263, 256,
-	235, 379, 248, 296, 262, 297, 249, 274, 273, 275,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 349, 0, 0, 0, 0, 0, 0,
-	322, 0, 0, 257, 0, 0, 0, 365, 0, 308,
-	290, 0, 0, 0, 306, 260, 334, 298, 340, 324,
-	348, 302, 299, 200, 325, 229, 271, 211, 213, 225,
-	232, 234, 236, 237, 280, 281, 293, 313, 327, 328,
-	329, 228, 221, 307, 222, 246, 223, 201, 315, 224,
-	203, 294, 332, 0, 242, 303, 267, 204, 266, 295,
-	331, 330, 212, 356, 362, 363, 368, 0, 369, 0,
-	0, 0, 377, 381, 382, 383, 385, 386, 387, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 0, 0,
-	0, 361, 240, 197, 198, 344, 0, 286, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 282, 360, 0,
-	0, 0, 0, 311, 0, 0, 0, 0, 0, 251,
-	292, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 319, 342, 354, 372, 375,
-	0, 0, 0, 202, 374, 0, 0, 0, 0, 0,
-	0, 0, 345, 0, 0, 0, 353, 0, 0, 0,
-	0, 0, 370, 276, 277, 278, 279, 243, 0, 219,
-	373, 301, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 366, 367,
-	239, 245, 384, 247, 218, 291, 241, 351, 254, 0,
-	378, 0, 0, 0, 0, 0, 283, 250, 316, 255,
-	261, 304, 350, 289, 309, 216, 341, 317, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 199,
-	0, 259, 0, 300, 238, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 175, 176, 177, 0, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
-	190, 191, 0, 193, 194, 195, 196, 326, 0, 0,
-	357, 358, 359, 380, 343, 0, 230, 0, 288, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 0, 0, 258, 0,
-	0, 0, 0, 0, 0, 318, 272, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 152, 0, 0, 1797,
-	0, 0, 0, 214, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 205, 323, 339, 215, 314, 352, 220,
-	321, 210, 287, 310, 0, 0, 207, 337, 320, 269,
-	252, 253, 206, 0, 305, 231, 244, 227, 285, 0,
-	336, 364, 226, 355, 0, 347, 209, 0, 346, 284,
-	333, 338, 270, 264, 208, 335, 268, 263, 256, 235,
-	379, 248, 296, 262, 297, 249, 274, 273, 275, 0,
-	0, 0, 0, 0, 376, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 349, 0, 0, 0, 0, 0, 0, 322,
-	0, 0, 257, 0, 0, 0, 365, 0, 308, 290,
-	0, 0, 0, 306, 260, 334, 298, 340, 324, 348,
-	302, 299, 200, 325, 229, 271, 211, 213, 225, 232,
-	234, 236, 237, 280, 281, 293, 313, 327, 328, 329,
-	228, 221, 307, 222, 246, 223, 201, 315, 224, 203,
-	294, 332, 0, 242, 303, 267, 204, 266, 295, 331,
-	330, 212, 356, 362, 363, 368, 0, 369, 0, 0,
-	0, 377, 381, 382, 383, 385, 386, 387, 0, 0,
-	0, 0, 0, 371, 0, 0, 0, 0, 0, 0,
-	361, 240, 197, 198, 344, 0, 286, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 282, 360, 0, 0,
-	0, 0, 311, 0, 0, 0, 0, 0, 251, 292,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 319, 342, 354, 372, 375, 0,
-	0, 0, 202, 374, 0, 0, 0, 0, 0, 0,
-	0, 345, 0, 0, 0, 353, 0, 0, 0, 0,
-	0, 370, 276, 277, 278, 279, 243, 0, 219, 373,
-	301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 366, 367, 239,
-	245, 384, 247, 218, 291, 241, 351, 254, 0, 378,
-	0, 0, 0, 0, 0, 283, 250, 316, 255, 261,
-	304, 350, 289, 309, 216, 341, 317, 265, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 199, 0,
-	259, 0, 300, 238, 156, 157, 158, 159, 160, 161,
-	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
-	172, 173, 174, 175, 176, 177, 0, 178, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 188, 189, 190,
-	191, 0, 193, 194, 195, 196, 326, 0, 0, 357,
-	358, 359, 380, 343, 0, 230, 0, 288, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 0, 0, 258, 0, 0,
-	0, 0, 0, 0, 318, 272, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 152, 0, 0, 0, 0,
-	0, 0, 214, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1536, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 205, 323, 339, 215, 314, 352, 220, 321,
-	210, 287, 310, 0, 0, 207, 337, 320, 269, 252,
-	253, 206, 0, 305, 231, 244, 227, 285, 0, 336,
-	364, 226, 355, 0, 347, 209, 0, 346, 284, 333,
-	338, 270, 264, 208, 335, 268, 263, 256, 235, 379,
-	248, 296, 262, 297, 249, 274, 273, 275, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 349, 0, 0, 0, 0, 0, 0, 322, 0,
-	0, 257, 0, 0, 0, 365, 0, 308, 290, 0,
-	0, 0, 306, 260, 334, 298, 340, 324, 348, 302,
-	299, 200, 325, 229, 271, 211, 213, 225, 232, 234,
-	236, 237, 280, 281, 293, 313, 327, 328, 329, 228,
-	221, 307, 222, 246, 223, 201, 315, 224, 203, 294,
-	332, 0, 242, 303, 267, 204, 266, 295, 331, 330,
-	212, 356, 362, 363, 368, 0, 369, 0, 0, 0,
-	377, 381, 382, 383, 385, 386, 387, 0, 0, 0,
-	0, 0, 371, 0, 0, 0, 0, 0, 0, 361,
-	240, 197, 198, 344, 0, 286, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 282, 360, 0, 0, 0,
-	0, 311, 0, 0, 0, 0, 0, 251, 292, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 319, 342, 354, 372, 375, 0, 0,
-	0, 202, 374, 0, 0, 0, 0, 0, 0, 0,
-	345, 0, 0, 0, 353, 0, 0, 0, 0, 0,
-	370, 276, 277, 278, 279, 243, 0, 219, 373, 301,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 366, 367, 239, 245,
-	384, 247, 218, 291, 241, 351, 254, 0, 378, 0,
-	0, 0, 0, 0, 283, 250, 316, 255, 261, 304,
-	350, 289, 309, 216, 341, 317, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 199, 0, 259,
-	0, 300, 238, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 175, 176, 177, 0, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 189, 190, 191,
-	0, 193, 194, 195, 196, 326, 0, 0, 357, 358,
-	359, 380, 343, 0, 230, 0, 288, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 0, 0, 258, 0, 0, 0,
-	0, 0, 0, 318, 272, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 152, 0, 0, 0, 0, 0,
-	0, 214, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1828, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 205, 323, 339, 215, 314, 352, 220, 321, 210,
-	287, 310, 0, 0, 207, 337, 320, 269, 252, 253,
-	206, 0, 305, 231, 244, 227, 285, 0, 336, 364,
-	226, 355, 0, 347, 209, 0, 346, 284, 333, 338,
-	270, 264, 208, 335, 268, 263, 256, 235, 379, 248,
-	296, 262, 297, 249, 274, 273, 275, 0, 0, 0,
-	0, 0, 376, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	349, 0, 0, 0, 0, 0, 0, 322, 0, 0,
-	257, 0, 0, 0, 365, 0, 308, 290, 0, 0,
-	0, 306, 260, 334, 298, 340, 324, 348, 302, 299,
-	200, 325, 229, 271, 211, 213, 225, 232, 234, 236,
-	237, 280, 281, 293, 313, 327, 328, 329, 228, 221,
-	307, 222, 246, 223, 201, 315, 224, 203, 294, 332,
-	0, 242, 303, 267, 204, 266, 295, 331, 330, 212,
-	356, 362, 363, 368, 0, 369, 0, 0, 0, 377,
-	381, 382, 383, 385, 386, 387, 0, 0, 0, 0,
-	0, 371, 0, 0, 0, 0, 0, 0, 361, 240,
-	197, 198, 344, 0, 286, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 282, 360, 0, 0, 0, 0,
-	311, 0, 0, 0, 0, 0, 251, 292, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 319, 342, 354, 372, 375, 0, 0, 0,
-	202, 374, 0, 0, 0, 0, 0, 0, 0, 345,
-	0, 0, 0, 353, 0, 0, 0, 0, 0, 370,
-	276, 277, 278, 279, 243, 0, 219, 373, 301, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 366, 367, 239, 245, 384,
-	247, 218, 291, 241, 351, 254, 0, 378, 0, 0,
-	0, 0, 0, 283, 250, 316, 255, 261, 304, 350,
-	289, 309, 216, 341, 317, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 199, 0, 259, 0,
-	300, 238, 156, 157, 158, 159, 160, 161, 162, 163,
-	164, 165, 166, 167, 168, 169, 170, 171, 172, 173,
-	174, 175, 176, 177, 0, 178, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 188, 189, 190, 191, 0,
-	193, 194, 195, 196, 326, 0, 0, 357, 358, 359,
-	380, 343, 0, 230, 0, 288, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 0, 0, 258, 0, 0, 0, 0,
-	0, 0, 318, 272, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 152, 0, 0, 1826, 0, 0, 0,
-	214, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	205, 323, 339, 215, 314, 352, 220, 321, 210, 287,
-	310, 0, 0, 207, 337, 320, 269, 252, 253, 206,
-	0, 305, 231, 244, 227, 285, 0, 336, 364, 226,
-	355, 0, 347, 209, 0, 346, 284, 333, 338, 270,
-	264, 208, 335, 268, 263, 256, 235, 379, 248, 296,
-	262, 297, 249, 274, 273, 275, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 349,
-	0, 0, 0, 0, 0, 0, 322, 0, 0, 257,
-	0, 0, 0, 365, 0, 308, 290, 0, 0, 0,
-	306, 260, 334, 298, 340, 324, 348, 302, 299, 200,
-	325, 229, 271, 211, 213, 225, 232, 234, 236, 237,
-	280, 281, 293, 313, 327, 328, 329, 228, 221, 307,
-	222, 246, 223, 201, 315, 224, 203, 294, 332, 0,
-	242, 303, 267, 204, 266, 295, 331, 330, 212, 356,
-	362, 363, 368, 0, 369, 0, 0, 0, 377, 381,
-	382, 383, 385, 386, 387, 0, 0, 0, 0, 0,
-	371, 0, 0, 0, 0, 0, 0, 361, 240, 197,
-	198, 344, 0, 286, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 282, 360, 0, 0, 0, 0, 311,
-	0, 0, 0, 0, 0, 251, 292, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 319, 342, 354, 372, 375, 0, 0, 0, 202,
-	374, 0, 0, 0, 0, 0, 0, 0, 345, 0,
-	0, 0, 353, 0, 0, 0, 0, 0, 370, 276,
-	277, 278, 279, 243, 0, 219, 373, 301, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 366, 367, 239, 245, 384, 247,
-	218, 291, 241, 351, 254, 0, 378, 0, 0, 0,
-	0, 0, 283, 250, 316, 255, 261, 304, 350, 289,
-	309, 216, 341, 317, 265, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 199, 0, 259, 0, 300,
-	238, 156, 157, 158, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	175, 176, 177, 0, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 189, 190, 191, 0, 193,
-	194, 195, 196, 0, 0, 0, 357, 358, 359, 380,
-	343, 326, 230, 0, 0, 1699, 0, 0, 0, 0,
-	0, 0, 288, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	0, 0, 258, 0, 0, 0, 0, 0, 0, 318,
-	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	152, 0, 0, 0, 0, 0, 0, 214, 153, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 205, 323, 339,
-	215, 314, 352, 220, 321, 210, 287, 310, 0, 0,
-	207, 337, 320, 269, 252, 253, 206, 0, 305, 231,
-	244, 227, 285, 0, 336, 364, 226, 355, 0, 347,
-	209, 0, 346, 284, 333, 338, 270, 264, 208, 335,
-	268, 263, 256, 235, 379, 248, 296, 262, 297, 249,
-	274, 273, 275, 0, 0, 0, 0, 0, 376, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 0,
-	0, 0, 0, 322, 0, 0, 257, 0, 0, 0,
-	365, 0, 308, 290, 0, 0, 0, 306, 260, 334,
-	298, 340, 324, 348, 302, 299, 200, 325, 229, 271,
-	211, 213, 225, 232, 234, 236, 237, 280, 281, 293,
-	313, 327, 328, 329, 228, 221, 307, 222, 246, 223,
-	201, 315, 224, 203, 294, 332, 0, 242, 303, 267,
-	204, 266, 295, 331, 330, 212, 356, 362, 363, 368,
-	0, 369, 0, 0, 0, 377, 381, 382, 383, 385,
-	386, 387, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 0, 0, 0, 361, 240, 197, 198, 344, 0,
-	286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	282, 360, 0, 0, 0, 0, 311, 0, 0, 0,
-	0, 0, 251, 292, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 319, 342,
-	354, 372, 375, 0, 0, 0, 202, 374, 0, 0,
-	0, 0, 0, 0, 0, 345, 0, 0, 0, 353,
-	0, 0, 0, 0, 0, 370, 276, 277, 278, 279,
-	243, 0, 219, 373, 301, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 366, 367, 239, 245, 384, 247, 218, 291, 241,
-	351, 254, 0, 378, 0, 0, 0, 0, 0, 283,
-	250, 316, 255, 261, 304, 350, 289, 309, 216, 341,
-	317, 265, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 199, 0, 259, 0, 300, 238, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 349, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 308, 211,
+	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 248, 3138, 0, 277, 0, 0,
+	0, 0, 0, 0, 341, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 0, 0, 0,
+	0, 0, 229, 164, 0, 0, 0, 0, 0, 3136,
+	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 0,
+	359, 387, 241, 378, 0, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	406, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 372, 0, 0, 0, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 388, 0, 328, 310,
+	0, 0, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 383, 0, 0, 0, 0, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 368, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 393, 296,
+	297, 298, 299, 262, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 278, 0, 320,
+	253, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 0, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 0, 204,
+	205, 206, 207, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 349,
+	340, 336, 0, 0, 402, 404, 405, 245, 0, 0,
+	308, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 248, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 341, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 3009, 0, 163, 578, 0,
+	0, 0, 0, 0, 229, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 0, 359, 387, 241, 378, 0, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 406, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 388, 0,
+	328, 310, 0, 0, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 0, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 383, 0, 0, 0,
+	0, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	368, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	393, 296, 297, 298, 299, 262, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 278,
+	0, 320, 253, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 0, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	0, 204, 205, 206, 207, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 349, 340, 336, 0, 0, 402, 404, 405, 245,
+	0, 0, 308, 211, 0, 0, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 248, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 1138, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2609, 0, 0, 0, 0, 220, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 0, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 349, 340, 336, 0, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 209,
+	248, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 0, 1138, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1136, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 222, 360, 343, 288, 271, 272, 221, 0,
+	325, 246, 263, 242, 305, 0, 359, 387, 241, 378,
+	0, 370, 224, 0, 369, 304, 356, 361, 289, 283,
+	223, 358, 287, 282, 275, 250, 406, 267, 316, 281,
+	317, 268, 294, 293, 295, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 0, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 388, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 278, 0, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 0, 349, 340, 336, 0, 0,
+	402, 404, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 209, 248, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1519, 0, 0, 163, 0, 0, 0, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 387,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 278, 0, 320, 253, 167,
 	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
-	0, 178, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 188, 189, 190, 191, 0, 193, 194, 195, 196,
-	326, 0, 0, 357, 358, 359, 380, 343, 0, 230,
-	0, 288, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
-	0, 258, 0, 0, 0, 0, 0, 0, 318, 272,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 152,
-	0, 0, 1084, 0, 0, 0, 214, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 205, 323, 339, 215,
-	314, 352, 220, 321, 210, 287, 310, 0, 0, 207,
-	337, 320, 269, 252, 253, 206, 0, 305, 231, 244,
-	227, 285, 0, 336, 364, 226, 355, 0, 347, 209,
-	0, 346, 284, 333, 338, 270, 264, 208, 335, 268,
-	263, 256, 235, 379, 248, 296, 262, 297, 249, 274,
-	273, 275, 0, 0, 0, 0, 0, 376, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 349, 0, 0, 0, 0,
-	0, 0, 322, 0, 0, 257, 0, 0, 0, 365,
-	0, 308, 290, 0, 0, 0, 306, 260, 334, 298,
-	340, 324, 348, 1375, 299, 200, 325, 229, 271, 211,
-	213, 225, 232, 234, 236, 237, 280, 281, 293, 313,
-	327, 328, 329, 228, 221, 307, 222, 246, 223, 201,
-	315, 224, 203, 294, 332, 0, 242, 303, 267, 204,
-	266, 295, 331, 330, 212, 356, 362, 363, 368, 0,
-	369, 0, 0, 0, 377, 381, 382, 383, 385, 386,
-	387, 0, 0, 0, 0, 0, 371, 0, 0, 0,
-	0, 0, 0, 361, 240, 197, 198, 344, 0, 286,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
-	360, 0, 0, 0, 0, 311, 0, 0, 0, 0,
-	0, 251, 292, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 319, 342, 354,
-	372, 375, 0, 0, 0, 202, 374, 0, 0, 0,
-	0, 0, 0, 0, 345, 0, 0, 0, 353, 0,
-	0, 0, 0, 0, 370, 276, 277, 278, 279, 243,
-	0, 219, 373, 301, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	366, 367, 239, 245, 384, 247, 218, 291, 241, 351,
-	254, 0, 378, 0, 0, 0, 0, 0, 283, 250,
-	316, 255, 261, 304, 350, 289, 309, 216, 341, 317,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 199, 0, 259, 0, 300, 238, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 175, 176, 177, 0,
 	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 189, 190, 191, 0, 193, 194, 195, 196, 326,
-	0, 0, 357, 358, 359, 380, 343, 0, 230, 0,
-	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
-	258, 0, 0, 0, 0, 0, 0, 318, 272, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 152, 0,
-	0, 0, 0, 0, 0, 214, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 205, 323, 339, 215, 314,
-	352, 220, 321, 210, 287, 310, 0, 0, 207, 337,
-	320, 269, 252, 253, 206, 0, 305, 231, 244, 227,
-	285, 0, 336, 364, 226, 355, 0, 347, 209, 0,
-	346, 284, 333, 338, 270, 264, 208, 335, 268, 263,
-	256, 235, 379, 248, 296, 262, 297, 249, 274, 273,
-	275, 0, 0, 0, 0, 0, 376, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 349, 0, 0, 0, 0, 0,
-	0, 322, 0, 0, 257, 0, 0, 0, 365, 0,
-	308, 290, 0, 0, 0, 306, 260, 334, 298, 340,
-	324, 348, 302, 299, 200, 325, 229, 271, 211, 213,
-	225, 232, 234, 236, 237, 280, 281, 293, 313, 327,
-	328, 329, 228, 221, 307, 222, 246, 223, 201, 315,
-	224, 203, 294, 332, 0, 242, 303, 267, 204, 266,
-	295, 331, 330, 212, 356, 362, 363, 368, 0, 369,
-	0, 0, 0, 377, 381, 382, 383, 385, 386, 387,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 0,
-	0, 0, 361, 240, 197, 198, 344, 0, 286, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 282, 360,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	251, 292, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 319, 342, 354, 372,
-	375, 0, 0, 0, 202, 374, 0, 0, 0, 0,
-	0, 0, 0, 345, 0, 0, 0, 353, 0, 0,
-	0, 0, 0, 370, 276, 277, 278, 279, 243, 0,
-	219, 373, 301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 366,
-	367, 239, 245, 384, 247, 218, 291, 241, 351, 254,
-	0, 378, 0, 0, 0, 0, 0, 283, 250, 316,
-	255, 261, 304, 350, 289, 309, 216, 341, 317, 265,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 641, 0, 0, 0,
-	199, 0, 259, 0, 300, 238, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 171, 172, 173, 174, 175, 176, 177, 0, 178,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
-	189, 190, 191, 0, 193, 194, 195, 196, 326, 0,
-	0, 357, 358, 359, 380, 343, 0, 230, 0, 288,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 0, 0, 258,
-	0, 0, 0, 0, 0, 0, 318, 272, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 152, 0, 0,
-	0, 0, 0, 0, 214, 153, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 205, 323, 339, 215, 314, 352,
-	220, 321, 210, 287, 310, 0, 0, 207, 337, 320,
-	269, 252, 253, 206, 0, 305, 231, 244, 227, 285,
-	0, 336, 364, 226, 355, 0, 347, 209, 0, 346,
-	284, 333, 338, 270, 264, 208, 335, 268, 263, 256,
-	235, 379, 248, 296, 262, 297, 249, 274, 273, 275,
-	0, 0, 0, 0, 0, 376, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 349, 0, 0, 0, 0, 0, 0,
-	322, 0, 0, 257, 0, 0, 0, 365, 0, 308,
-	290, 0, 0, 0, 306, 260, 334, 298, 340, 324,
-	348, 416, 299, 200, 325, 229, 271, 211, 213, 225,
-	232, 234, 236, 237, 280, 281, 293, 313, 327, 328,
-	329, 228, 221, 307, 222, 246, 223, 201, 315, 224,
-	203, 294, 332, 0, 242, 303, 267, 204, 266, 295,
-	331, 330, 212, 356, 362, 363, 368, 0, 369, 0,
-	0, 0, 377, 381, 382, 383, 385, 386, 387, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 0, 0,
-	0, 361, 240, 197, 198, 344, 0, 286, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 282, 360, 0,
-	0, 0, 0, 311, 0, 0, 0, 0, 0, 251,
-	292, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 319, 342, 354, 372, 375,
-	0, 0, 0, 202, 374, 0, 0, 0, 0, 0,
-	0, 417, 345, 0, 0, 0, 353, 0, 0, 0,
-	0, 0, 370, 276, 277, 278, 279, 243, 0, 219,
-	373, 301, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 366, 367,
-	239, 245, 384, 247, 218, 291, 241, 351, 254, 0,
-	378, 0, 0, 0, 0, 0, 283, 250, 316, 255,
-	261, 304, 350, 289, 309, 216, 341, 317, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 199,
-	0, 259, 0, 300, 238, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 175, 176, 177, 0, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
-	190, 191, 0, 193, 194, 195, 196, 326, 0, 0,
-	357, 358, 359, 380, 343, 0, 230, 0, 288, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 0, 0, 258, 0,
-	0, 0, 0, 0, 0, 318, 272, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 152, 0, 0, 0,
-	0, 0, 0, 214, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 205, 323, 339, 215, 314, 352, 220,
-	321, 210, 287, 310, 0, 0, 207, 337, 320, 269,
-	252, 253, 206, 0, 305, 231, 244, 227, 285, 0,
-	336, 364, 226, 355, 0, 347, 209, 0, 346, 284,
-	333, 338, 270, 264, 208, 335, 268, 263, 256, 235,
-	379, 248, 296, 262, 297, 249, 274, 273, 275, 0,
-	0, 0, 0, 0, 376, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 395,
-	0, 0, 349, 0, 0, 0, 0, 0, 0, 322,
-	0, 0, 257, 0, 0, 0, 365, 0, 308, 290,
-	0, 0, 0, 306, 260, 334, 298, 340, 324, 348,
-	302, 299, 200, 325, 229, 271, 211, 213, 225, 232,
-	234, 236, 237, 280, 281, 293, 313, 327, 328, 329,
-	228, 221, 307, 222, 246, 223, 201, 315, 224, 203,
-	294, 332, 0, 242, 303, 267, 204, 266, 295, 331,
-	330, 212, 356, 362, 363, 368, 0, 369, 0, 0,
-	0, 377, 381, 382, 383, 385, 386, 387, 0, 0,
-	0, 0, 0, 371, 0, 0, 0, 0, 0, 0,
-	361, 240, 197, 198, 344, 0, 286, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 282, 360, 0, 0,
-	0, 0, 311, 0, 0, 0, 0, 0, 251, 292,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 319, 342, 354, 372, 375, 0,
-	0, 0, 202, 374, 0, 0, 0, 0, 0, 0,
-	0, 345, 0, 0, 0, 353, 0, 0, 0, 0,
-	0, 370, 276, 277, 278, 279, 243, 0, 219, 373,
-	301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 366, 367, 239,
-	245, 384, 247, 218, 291, 241, 351, 254, 0, 378,
-	0, 0, 0, 0, 0, 283, 250, 316, 255, 261,
-	304, 350, 289, 309, 216, 341, 317, 265, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 199, 0,
-	259, 0, 300, 238, 156, 157, 158, 159, 160, 161,
-	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
-	172, 173, 174, 175, 176, 177, 0, 178, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 188, 189, 190,
-	191, 0, 193, 194, 195, 196, 326, 0, 0, 357,
-	358, 359, 380, 343, 0, 230, 0, 288, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 149, 233, 0, 0, 258, 0, 0,
-	0, 0, 0, 0, 318, 272, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 152, 0, 0, 0, 0,
-	0, 0, 214, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 205, 323, 339, 215, 314, 352, 220, 321,
-	210, 287, 310, 0, 0, 207, 337, 320, 269, 252,
-	253, 206, 0, 305, 231, 244, 227, 285, 0, 336,
-	364, 226, 355, 0, 347, 209, 0, 346, 284, 333,
-	338, 270, 264, 208, 335, 268, 263, 256, 235, 379,
-	248, 296, 262, 297, 249, 274, 273, 275, 0, 0,
-	0, 0, 0, 376, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 349, 0, 0, 0, 0, 0, 0, 322, 0,
-	0, 257, 0, 0, 0, 365, 0, 308, 290, 0,
-	0, 0, 306, 260, 334, 298, 340, 324, 348, 302,
-	299, 200, 325, 229, 271, 211, 213, 225, 232, 234,
-	236, 237, 280, 281, 293, 313, 327, 328, 329, 228,
-	221, 307, 222, 246, 223, 201, 315, 224, 203, 294,
-	332, 0, 242, 303, 267, 204, 266, 295, 331, 330,
-	212, 356, 362, 363, 368, 0, 369, 0, 0, 0,
-	377, 381, 382, 383, 385, 386, 387, 0, 0, 0,
-	0, 0, 371, 0, 0, 0, 0, 0, 0, 361,
-	240, 197, 198, 344, 0, 286, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 282, 360, 0, 0, 0,
-	0, 311, 0, 0, 0, 0, 0, 251, 292, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 319, 342, 354, 372, 375, 0, 0,
-	0, 202, 374, 0, 0, 0, 0, 0, 0, 0,
-	345, 0, 0, 0, 353, 0, 0, 0, 0, 0,
-	370, 276, 277, 278, 279, 243, 0, 219, 373, 301,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 366, 367, 239, 245,
-	384, 247, 218, 291, 241, 351, 254, 0, 378, 0,
-	0, 0, 0, 0, 283, 250, 316, 255, 261, 304,
-	350, 289, 309, 216, 341, 317, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 199, 0, 259,
-	0, 300, 238, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 175, 176, 177, 0, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 189, 190, 191,
-	0, 193, 194, 195, 196, 326, 0, 0, 357, 358,
-	359, 380, 343, 0, 230, 0, 288, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 0, 0, 258, 0, 0, 0,
-	0, 0, 0, 318, 272, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 152, 0, 0, 0, 0, 0,
-	0, 214, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 205, 323, 339, 215, 314, 352, 220, 321, 210,
-	287, 310, 0, 0, 207, 337, 320, 269, 252, 253,
-	206, 0, 305, 231, 244, 227, 285, 0, 336, 364,
-	226, 355, 0, 347, 209, 0, 346, 284, 333, 338,
-	270, 264, 208, 335, 268, 263, 256, 235, 379, 248,
-	296, 262, 297, 249, 274, 273, 275, 0, 0, 0,
-	0, 0, 376, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	349, 0, 0, 0, 0, 0, 0, 322, 0, 0,
-	257, 0, 0, 0, 365, 0, 308, 290, 0, 0,
-	0, 306, 260, 334, 298, 340, 324, 348, 302, 299,
-	200, 325, 229, 271, 211, 213, 225, 232, 234, 236,
-	237, 280, 281, 293, 313, 327, 328, 329, 228, 221,
-	307, 222, 246, 223, 201, 315, 224, 203, 294, 332,
-	0, 242, 303, 267, 204, 266, 295, 331, 330, 212,
-	356, 362, 363, 368, 0, 369, 0, 0, 0, 377,
-	381, 382, 383, 385, 386, 387, 0, 0, 0, 0,
-	0, 371, 0, 0, 0, 0, 0, 0, 361, 240,
-	197, 198, 344, 0, 286, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 282, 360, 0, 0, 0, 0,
-	311, 0, 0, 0, 0, 0, 251, 292, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 319, 342, 354, 372, 375, 0, 0, 0,
-	202, 374, 0, 0, 0, 0, 0, 0, 0, 345,
-	0, 0, 0, 353, 0, 0, 0, 0, 0, 370,
-	276, 277, 278, 279, 243, 0, 219, 373, 301, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 366, 367, 239, 245, 384,
-	247, 218, 291, 241, 351, 254, 0, 378, 0, 0,
-	0, 0, 0, 283, 250, 316, 255, 261, 304, 350,
-	289, 309, 216, 341, 317, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 199, 0, 259, 0,
-	300, 238, 156, 157, 158, 159, 160, 161, 162, 163,
-	164, 165, 166, 167, 168, 169, 170, 171, 172, 173,
-	174, 175, 176, 177, 0, 178, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 188, 189, 190, 191, 0,
-	193, 194, 195, 196, 326, 0, 0, 357, 358, 359,
-	380, 343, 0, 230, 0, 288, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 0, 0, 258, 0, 0, 0, 0,
-	0, 0, 318, 272, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 152, 0, 0, 0, 0, 0, 0,
-	214, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	205, 323, 339, 215, 314, 352, 220, 321, 210, 287,
-	310, 0, 0, 207, 337, 320, 269, 252, 253, 206,
-	0, 305, 231, 244, 227, 285, 0, 336, 364, 226,
-	355, 0, 347, 209, 0, 346, 284, 333, 338, 270,
-	264, 208, 335, 268, 263, 256, 235, 379, 248, 296,
-	262, 297, 249, 274, 273, 275, 0, 0, 0, 0,
-	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 349,
-	0, 0, 0, 0, 0, 0, 322, 0, 0, 257,
-	0, 0, 0, 365, 0, 308, 290, 0, 0, 0,
-	306, 260, 334, 298, 340, 324, 348, 302, 299, 200,
-	325, 229, 271, 211, 213, 457, 232, 234, 236, 237,
-	280, 281, 293, 313, 327, 328, 329, 228, 221, 307,
-	222, 246, 223, 201, 315, 224, 203, 294, 332, 0,
-	242, 303, 267, 204, 266, 295, 331, 330, 212, 356,
-	362, 363, 368, 0, 369, 0, 0, 0, 377, 381,
-	382, 383, 385, 386, 387, 0, 0, 0, 0, 0,
-	371, 0, 0, 0, 0, 0, 0, 361, 240, 197,
-	198, 344, 0, 286, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 282, 360, 0, 0, 0, 0, 311,
-	0, 0, 0, 0, 0, 251, 292, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 319, 342, 354, 372, 375, 0, 0, 0, 202,
-	374, 0, 0, 0, 0, 0, 0, 0, 345, 0,
-	0, 0, 353, 0, 0, 0, 0, 0, 370, 276,
-	277, 278, 279, 243, 0, 219, 373, 301, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 366, 367, 239, 245, 384, 247,
-	218, 291, 241, 351, 254, 0, 378, 0, 0, 0,
-	0, 934, 283, 250, 316, 255, 261, 304, 350, 289,
-	309, 216, 341, 317, 265, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 199, 0, 259, 934, 300,
-	238, 156, 157, 158, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	175, 176, 177, 1248, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 189, 190, 191, 0, 193,
-	194, 195, 196, 922, 0, 0, 357, 358, 359, 380,
-	343, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 942, 946, 948, 950, 952, 953, 955, 645, 959,
-	956, 957, 958, 0, 0, 937, 938, 939, 940, 920,
-	921, 943, 0, 923, 0, 924, 925, 926, 927, 928,
-	929, 930, 931, 932, 933, 935, 941, 0, 0, 0,
-	922, 0, 0, 0, 945, 947, 949, 951, 954, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 942, 946,
-	948, 950, 952, 953, 955, 0, 959, 956, 957, 958,
-	681, 0, 937, 938, 939, 940, 920, 921, 943, 0,
-	923, 936, 924, 925, 926, 927, 928, 929, 930, 931,
-	932, 933, 935, 941, 0, 0, 0, 0, 0, 0,
-	0, 945, 947, 949, 951, 954, 0, 0, 819, 820,
-	821, 818, 0, 0, 0, 1244, 0, 0, 0, 1241,
-	0, 0, 0, 1243, 1240, 1242, 1246, 1247, 0, 0,
-	0, 1245, 0, 0, 0, 0, 0, 0, 936, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	683, 0, 0, 682, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1248, 0, 0, 668, 0, 0,
-	0, 0, 0, 0, 0, 646, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1878,
-	1879, 0, 0, 0, 1431, 0, 0, 0, 0, 0,
-	0, 0, 673, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1433, 0,
-	0, 0, 0, 0, 0, 1229, 1230, 1231, 1232, 1233,
-	1234, 1235, 1236, 1237, 1238, 1239, 1251, 1252, 1253, 1254,
-	1255, 1256, 1249, 1250, 0, 0, 0, 0, 0, 0,
-	0, 0, 667, 666, 0, 1413, 0, 1431, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 665,
-	0, 0, 0, 0, 0, 0, 0, 0, 644, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 647,
-	676, 1433, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 944, 0, 1244, 0, 0, 0,
-	1241, 0, 0, 671, 1243, 1240, 1242, 1246, 1247, 0,
-	0, 0, 1245, 0, 0, 0, 0, 0, 1413, 1431,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 672, 677, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 944, 662, 1433, 660, 664, 680, 0, 0, 0,
-	661, 658, 657, 0, 663, 648, 649, 650, 651, 652,
-	653, 0, 678, 679, 0, 1406, 0, 1744, 1405, 0,
-	0, 0, 0, 1417, 674, 675, 0, 0, 0, 0,
-	1413, 0, 0, 0, 1421, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1410, 0, 0, 0, 1412, 1414,
-	1416, 669, 1418, 1419, 1420, 1422, 1423, 1424, 1426, 1427,
-	1428, 1429, 0, 0, 0, 0, 1229, 1230, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1251, 1252, 1253,
-	1254, 1255, 1256, 1249, 1250, 0, 1417, 0, 436, 0,
-	435, 442, 432, 0, 0, 0, 0, 1421, 0, 0,
-	1432, 0, 439, 440, 0, 441, 445, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 1410, 0, 450,
-	0, 1412, 1414, 1416, 0, 1418, 1419, 1420, 1422, 1423,
-	1424, 1426, 1427, 1428, 1429, 0, 0, 1430, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 454, 0,
-	0, 456, 0, 0, 1409, 0, 455, 436, 1417, 435,
-	442, 432, 0, 0, 0, 0, 0, 0, 0, 1421,
-	0, 439, 440, 1432, 441, 445, 0, 0, 427, 0,
-	0, 0, 0, 1425, 0, 0, 0, 0, 450, 1410,
-	1415, 0, 0, 1412, 1414, 1416, 0, 1418, 1419, 1420,
-	1422, 1423, 1424, 1426, 1427, 1428, 1429, 0, 0, 0,
-	1430, 0, 0, 0, 0, 0, 0, 454, 0, 436,
-	456, 435, 442, 432, 0, 455, 0, 1409, 0, 0,
-	0, 0, 0, 439, 440, 0, 441, 445, 0, 0,
-	427, 0, 0, 0, 0, 1432, 0, 0, 0, 0,
-	450, 0, 0, 0, 0, 0, 1425, 0, 0, 0,
-	0, 0, 0, 1415, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1430, 428, 430, 429, 0, 0, 0, 0,
-	0, 0, 0, 434, 0, 0, 0, 0, 0, 1409,
-	0, 0, 0, 0, 0, 438, 0, 0, 0, 0,
-	0, 0, 453, 0, 0, 0, 0, 0, 0, 431,
-	0, 0, 0, 422, 0, 0, 0, 0, 1425, 0,
-	0, 0, 0, 0, 0, 1415, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 428, 430, 429, 0, 0, 0, 0, 0,
-	0, 0, 434, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 438, 0, 0, 0, 0, 0,
-	0, 453, 0, 0, 0, 0, 0, 0, 431, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 428, 430, 429, 0, 433, 437,
-	443, 0, 444, 446, 434, 0, 447, 448, 449, 0,
-	0, 451, 452, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 453, 0, 0, 0, 0, 0, 0,
-	431, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 433, 437, 443,
-	0, 444, 446, 0, 0, 447, 448, 449, 0, 0,
-	451, 452, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 433,
-	437, 443, 0, 444, 446, 0, 0, 447, 448, 449,
-	0, 0, 451, 452,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 349, 340, 336,
+	0, 1523, 402, 404, 405, 245, 0, 0, 308, 211,
+	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
+	1893, 0, 0, 209, 248, 0, 0, 277, 0, 0,
+	0, 0, 0, 0, 341, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 0, 1895, 0,
+	0, 0, 229, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 0,
+	359, 387, 241, 378, 0, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	406, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 372, 0, 0, 0, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 388, 0, 328, 310,
+	0, 0, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 383, 0, 0, 0, 0, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 368, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 393, 296,
+	297, 298, 299, 262, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 278, 0, 320,
+	253, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 0, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 0, 204,
+	205, 206, 207, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 349,
+	340, 336, 0, 0, 402, 404, 405, 245, 0, 0,
+	308, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 248, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 341, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 0,
+	0, 0, 0, 0, 229, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 0, 359, 387, 241, 378, 0, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 406, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 388, 0,
+	328, 310, 0, 0, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 0, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 383, 0, 0, 0,
+	0, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	368, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	393, 296, 297, 298, 299, 262, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 278,
+	0, 320, 253, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 0, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	0, 204, 205, 206, 207, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 349, 340, 336, 0, 0, 402, 404, 405, 245,
+	0, 0, 308, 211, 2588, 2589, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 248, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 1895, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 0, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 349, 340, 336, 2209, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 209,
+	248, 1921, 0, 277, 0, 0, 0, 0, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 0, 1138, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 222, 360, 343, 288, 271, 272, 221, 0,
+	325, 246, 263, 242, 305, 0, 359, 387, 241, 378,
+	0, 370, 224, 0, 369, 304, 356, 361, 289, 283,
+	223, 358, 287, 282, 275, 250, 406, 267, 316, 281,
+	317, 268, 294, 293, 295, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 0, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 388, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 278, 0, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 0, 349, 340, 336, 0, 0,
+	402, 404, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 1902, 0,
+	0, 209, 248, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 0, 1895, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 387,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 278, 0, 320, 253, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 349, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 308, 211,
+	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 248, 0, 0, 277, 0, 0,
+	0, 0, 0, 0, 341, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 3131, 0, 163, 0, 0, 0, 0,
+	0, 0, 229, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 0,
+	359, 387, 241, 378, 0, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	406, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 372, 0, 0, 0, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 388, 0, 328, 310,
+	0, 0, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 383, 0, 0, 0, 0, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 368, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 393, 296,
+	297, 298, 299, 262, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 278, 0, 320,
+	253, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 0, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 0, 204,
+	205, 206, 207, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 349,
+	340, 336, 0, 0, 402, 404, 405, 245, 0, 0,
+	308, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 248, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 341, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 578, 0,
+	0, 0, 0, 0, 229, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 0, 359, 387, 241, 378, 0, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 406, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 388, 0,
+	328, 310, 0, 0, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 0, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 383, 0, 0, 0,
+	0, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	368, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	393, 296, 297, 298, 299, 262, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 278,
+	0, 320, 253, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 0, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	0, 204, 205, 206, 207, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 349, 340, 336, 0, 0, 402, 404, 405, 245,
+	0, 0, 308, 211, 0, 0, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 248, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3025, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 0, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 349, 340, 336, 0, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 209,
+	248, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 0, 0, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 222, 360, 343, 288, 271, 272, 221, 0,
+	325, 246, 263, 242, 305, 0, 359, 387, 241, 378,
+	0, 370, 224, 0, 369, 304, 356, 361, 289, 283,
+	223, 358, 287, 282, 275, 250, 406, 267, 316, 281,
+	317, 268, 294, 293, 295, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 2928, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 388, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 278, 0, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 0, 349, 340, 336, 0, 0,
+	402, 404, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 209, 248, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 2231, 0, 0, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 387,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 278, 0, 320, 253, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 349, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 308, 211,
+	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 248, 0, 0, 277, 0, 0,
+	0, 0, 0, 0, 341, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2700, 0, 0, 163, 0, 0, 0, 0,
+	0, 0, 229, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 0,
+	359, 387, 241, 378, 0, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	406, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 372, 0, 0, 0, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 388, 0, 328, 310,
+	0, 0, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 383, 0, 0, 0, 0, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 368, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 393, 296,
+	297, 298, 299, 262, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 278, 0, 320,
+	253, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 0, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 0, 204,
+	205, 206, 207, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 349,
+	340, 336, 0, 0, 402, 404, 405, 245, 0, 0,
+	308, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 248, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 341, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 0,
+	0, 0, 0, 0, 229, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 0, 359, 387, 241, 378, 0, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 406, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 2761, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 388, 0,
+	328, 310, 0, 0, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 0, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 383, 0, 0, 0,
+	0, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	368, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	393, 296, 297, 298, 299, 262, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 278,
+	0, 320, 253, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 0, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	0, 204, 205, 206, 207, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 349, 340, 336, 0, 0, 402, 404, 405, 245,
+	0, 0, 308, 211, 0, 0, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 248, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2662, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 0, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 349, 340, 336, 0, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 209,
+	248, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 0, 0, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2418, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 222, 360, 343, 288, 271, 272, 221, 0,
+	325, 246, 263, 242, 305, 0, 359, 387, 241, 378,
+	0, 370, 224, 0, 369, 304, 356, 361, 289, 283,
+	223, 358, 287, 282, 275, 250, 406, 267, 316, 281,
+	317, 268, 294, 293, 295, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 0, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 388, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 278, 0, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 0, 349, 340, 336, 0, 0,
+	402, 404, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 209, 248, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 0, 2333, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 387,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 278, 0, 320, 253, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 349, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 308, 211,
+	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 248, 0, 0, 277, 0, 0,
+	0, 0, 0, 0, 341, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 0, 0, 0,
+	0, 0, 229, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2261,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 0,
+	359, 387, 241, 378, 0, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	406, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 372, 0, 0, 0, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 388, 0, 328, 310,
+	0, 0, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 383, 0, 0, 0, 0, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 368, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 393, 296,
+	297, 298, 299, 262, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 278, 0, 320,
+	253, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 0, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 0, 204,
+	205, 206, 207, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 349,
+	340, 336, 0, 0, 402, 404, 405, 245, 0, 0,
+	308, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 248, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 341, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 0,
+	1138, 0, 0, 0, 229, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 0, 359, 387, 241, 378, 0, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 406, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 388, 0,
+	328, 310, 0, 0, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 0, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 383, 0, 0, 0,
+	0, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	368, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	393, 296, 297, 298, 299, 262, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 278,
+	0, 320, 253, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 0, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	0, 204, 205, 206, 207, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 349, 340, 336, 0, 0, 402, 404, 405, 245,
+	0, 0, 308, 211, 0, 0, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 248, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2235, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 0, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 349, 340, 336, 0, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 209,
+	248, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 0, 2233, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 222, 360, 343, 288, 271, 272, 221, 0,
+	325, 246, 263, 242, 305, 0, 359, 387, 241, 378,
+	0, 370, 224, 0, 369, 304, 356, 361, 289, 283,
+	223, 358, 287, 282, 275, 250, 406, 267, 316, 281,
+	317, 268, 294, 293, 295, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 0, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 388, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 278, 0, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 0, 349, 340, 336, 0, 0,
+	402, 404, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 209, 248, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 0, 1895, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 387,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 278, 0, 320, 253, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 349, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 308, 211,
+	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 248, 0, 0, 277, 0, 0,
+	0, 0, 0, 0, 341, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 0, 0, 0,
+	0, 0, 229, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1616,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 0,
+	359, 387, 241, 378, 0, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	406, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 372, 0, 0, 0, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 388, 0, 328, 310,
+	0, 0, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 383, 0, 0, 0, 0, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 368, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 393, 296,
+	297, 298, 299, 262, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 278, 0, 320,
+	253, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 0, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 0, 204,
+	205, 206, 207, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 349,
+	340, 336, 0, 1791, 402, 404, 405, 245, 0, 0,
+	308, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 248, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 341, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 0,
+	0, 0, 0, 0, 229, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 0, 359, 387, 241, 378, 0, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 406, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 388, 0,
+	328, 310, 0, 0, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 0, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 383, 0, 0, 0,
+	0, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	368, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	393, 296, 297, 298, 299, 262, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 278,
+	0, 320, 253, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 0, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	0, 204, 205, 206, 207, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 349, 340, 336, 0, 0, 402, 404, 405, 245,
+	0, 0, 308, 211, 0, 0, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 248, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 1138, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 1443, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 0, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 349, 340, 336, 0, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 209,
+	248, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 0, 0, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 222, 360, 343, 288, 271, 272, 221, 0,
+	325, 246, 263, 242, 305, 0, 359, 387, 241, 378,
+	0, 370, 224, 0, 369, 304, 356, 361, 289, 283,
+	223, 358, 287, 282, 275, 250, 406, 267, 316, 281,
+	317, 268, 294, 293, 295, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 0, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 388, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 676, 0, 0,
+	0, 214, 0, 278, 0, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 0, 349, 340, 336, 0, 0,
+	402, 404, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 209, 248, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 0, 0, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 387,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 445, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 446, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 278, 0, 320, 253, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 349, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 308, 211,
+	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 248, 0, 0, 277, 0, 0,
+	0, 0, 0, 0, 341, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 0, 0, 0,
+	0, 0, 229, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 0,
+	359, 387, 241, 378, 0, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	406, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 424,
+	0, 0, 372, 0, 0, 0, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 388, 0, 328, 310,
+	0, 0, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 383, 0, 0, 0, 0, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 368, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 393, 296,
+	297, 298, 299, 262, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 278, 0, 320,
+	253, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 0, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 0, 204,
+	205, 206, 207, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 160, 292, 0, 213, 335, 0, 349,
+	340, 336, 0, 0, 402, 404, 405, 245, 0, 0,
+	308, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 248, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 341, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 0,
+	0, 0, 0, 0, 229, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 346, 362, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 0, 359, 387, 241, 378, 0, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 406, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 388, 0,
+	328, 310, 0, 0, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 0, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 383, 0, 0, 0,
+	0, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	368, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	393, 296, 297, 298, 299, 262, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 278,
+	0, 320, 253, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 0, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	0, 204, 205, 206, 207, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 349, 340, 336, 0, 0, 402, 404, 405, 245,
+	0, 0, 308, 211, 0, 0, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 248, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 346, 362,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 0, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 349, 340, 336, 0, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 209,
+	248, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 0, 0, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 222, 360, 343, 288, 271, 272, 221, 0,
+	325, 246, 263, 242, 305, 0, 359, 387, 241, 378,
+	0, 370, 224, 0, 369, 304, 356, 361, 289, 283,
+	223, 358, 287, 282, 275, 250, 406, 267, 316, 281,
+	317, 268, 294, 293, 295, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 0, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 388, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 278, 0, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 0, 349, 340, 336, 0, 0,
+	402, 2932, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 209, 248, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 0, 0, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 2506,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 240, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 278, 0, 320, 253, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 349, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 308, 211,
+	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 248, 0, 0, 277, 0, 0,
+	0, 0, 0, 0, 341, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 0, 0, 0,
+	0, 0, 229, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 346, 362, 230, 334, 375, 235,
+	344, 225, 307, 330, 0, 0, 222, 360, 343, 288,
+	271, 272, 221, 0, 325, 246, 263, 242, 305, 0,
+	359, 387, 241, 378, 0, 370, 224, 0, 369, 304,
+	356, 361, 289, 283, 223, 358, 287, 282, 275, 250,
+	406, 267, 316, 281, 317, 268, 294, 293, 295, 0,
+	0, 0, 0, 0, 399, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 372, 0, 0, 0, 0, 0, 0, 345,
+	0, 0, 276, 0, 0, 0, 388, 0, 328, 310,
+	0, 0, 0, 326, 279, 357, 318, 363, 347, 371,
+	322, 319, 215, 348, 244, 290, 226, 228, 240, 247,
+	249, 251, 252, 300, 301, 313, 333, 350, 351, 352,
+	243, 236, 327, 237, 265, 238, 216, 337, 239, 218,
+	314, 355, 0, 257, 258, 259, 260, 261, 323, 286,
+	219, 285, 315, 354, 353, 227, 379, 385, 386, 391,
+	0, 392, 0, 0, 0, 400, 408, 409, 410, 412,
+	413, 414, 0, 415, 416, 0, 0, 0, 0, 0,
+	394, 0, 0, 0, 0, 0, 0, 384, 255, 208,
+	212, 367, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 383, 0, 0, 0, 0, 331,
+	0, 0, 0, 0, 0, 270, 312, 0, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 342, 365, 377, 395, 398, 0, 0, 0, 217,
+	397, 0, 0, 0, 0, 0, 0, 0, 368, 0,
+	0, 0, 376, 0, 0, 0, 0, 0, 393, 296,
+	297, 298, 299, 262, 0, 234, 396, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 389, 390, 254, 264, 411, 266,
+	233, 311, 256, 374, 273, 0, 401, 0, 0, 0,
+	0, 0, 303, 269, 338, 274, 280, 324, 373, 309,
+	329, 231, 364, 339, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 278, 0, 320,
+	253, 167, 168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 0, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 0, 204,
+	205, 206, 207, 0, 0, 403, 0, 0, 380, 381,
+	382, 407, 366, 0, 292, 0, 213, 335, 0, 349,
+	340, 1533, 0, 0, 402, 404, 405, 245, 0, 0,
+	308, 211, 0, 0, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 209, 248, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 341, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 0,
+	0, 0, 0, 0, 229, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 346, 1119, 230, 334,
+	375, 235, 344, 225, 307, 330, 0, 0, 222, 360,
+	343, 288, 271, 272, 221, 0, 325, 246, 263, 242,
+	305, 0, 359, 387, 241, 378, 0, 370, 224, 0,
+	369, 304, 356, 361, 289, 283, 223, 358, 287, 282,
+	275, 250, 406, 267, 316, 281, 317, 268, 294, 293,
+	295, 0, 0, 0, 0, 0, 399, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 372, 0, 0, 0, 0, 0,
+	0, 345, 0, 0, 276, 0, 0, 0, 388, 0,
+	328, 310, 0, 0, 0, 326, 279, 357, 318, 363,
+	347, 371, 322, 319, 215, 348, 244, 290, 226, 228,
+	240, 247, 249, 251, 252, 300, 301, 313, 333, 350,
+	351, 352, 243, 236, 327, 237, 265, 238, 216, 337,
+	239, 218, 314, 355, 0, 257, 258, 259, 260, 261,
+	323, 286, 219, 285, 315, 354, 353, 227, 379, 385,
+	386, 391, 0, 392, 0, 0, 0, 400, 408, 409,
+	410, 412, 413, 414, 0, 415, 416, 0, 0, 0,
+	0, 0, 394, 0, 0, 0, 0, 0, 0, 384,
+	255, 208, 212, 367, 0, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 383, 0, 0, 0,
+	0, 331, 0, 0, 0, 0, 0, 270, 312, 0,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 342, 365, 377, 395, 398, 0, 0,
+	0, 217, 397, 0, 0, 0, 0, 0, 0, 0,
+	368, 0, 0, 0, 376, 0, 0, 0, 0, 0,
+	393, 296, 297, 298, 299, 262, 0, 234, 396, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 389, 390, 254, 264,
+	411, 266, 233, 311, 256, 374, 273, 0, 401, 0,
+	0, 0, 0, 0, 303, 269, 338, 274, 280, 324,
+	373, 309, 329, 231, 364, 339, 284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 278,
+	0, 320, 253, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 0, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	0, 204, 205, 206, 207, 0, 0, 403, 0, 0,
+	380, 381, 382, 407, 366, 0, 292, 0, 213, 335,
+	0, 349, 340, 336, 0, 0, 402, 404, 405, 245,
+	0, 0, 308, 211, 0, 0, 0, 0, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 209, 248, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 341, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 229, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 346, 1117,
+	230, 334, 375, 235, 344, 225, 307, 330, 0, 0,
+	222, 360, 343, 288, 271, 272, 221, 0, 325, 246,
+	263, 242, 305, 0, 359, 387, 241, 378, 0, 370,
+	224, 0, 369, 304, 356, 361, 289, 283, 223, 358,
+	287, 282, 275, 250, 406, 267, 316, 281, 317, 268,
+	294, 293, 295, 0, 0, 0, 0, 0, 399, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 345, 0, 0, 276, 0, 0, 0,
+	388, 0, 328, 310, 0, 0, 0, 326, 279, 357,
+	318, 363, 347, 371, 322, 319, 215, 348, 244, 290,
+	226, 228, 240, 247, 249, 251, 252, 300, 301, 313,
+	333, 350, 351, 352, 243, 236, 327, 237, 265, 238,
+	216, 337, 239, 218, 314, 355, 0, 257, 258, 259,
+	260, 261, 323, 286, 219, 285, 315, 354, 353, 227,
+	379, 385, 386, 391, 0, 392, 0, 0, 0, 400,
+	408, 409, 410, 412, 413, 414, 0, 415, 416, 0,
+	0, 0, 0, 0, 394, 0, 0, 0, 0, 0,
+	0, 384, 255, 208, 212, 367, 0, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 383, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 270,
+	312, 0, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 365, 377, 395, 398,
+	0, 0, 0, 217, 397, 0, 0, 0, 0, 0,
+	0, 0, 368, 0, 0, 0, 376, 0, 0, 0,
+	0, 0, 393, 296, 297, 298, 299, 262, 0, 234,
+	396, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 390,
+	254, 264, 411, 266, 233, 311, 256, 374, 273, 0,
+	401, 0, 0, 0, 0, 0, 303, 269, 338, 274,
+	280, 324, 373, 309, 329, 231, 364, 339, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 278, 0, 320, 253, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 0, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 0, 204, 205, 206, 207, 0, 0, 403,
+	0, 0, 380, 381, 382, 407, 366, 0, 292, 0,
+	213, 335, 0, 349, 340, 336, 0, 0, 402, 404,
+	405, 245, 0, 0, 308, 211, 0, 0, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 209,
+	248, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	341, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 0, 0, 0, 0, 0, 229, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	346, 362, 230, 334, 375, 235, 344, 225, 307, 330,
+	0, 0, 222, 360, 343, 288, 271, 272, 221, 0,
+	325, 246, 263, 242, 305, 0, 359, 387, 241, 378,
+	0, 370, 224, 0, 369, 304, 356, 361, 289, 283,
+	223, 358, 287, 282, 275, 250, 406, 267, 316, 281,
+	317, 268, 294, 293, 295, 0, 0, 0, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 372, 0,
+	0, 0, 0, 0, 0, 345, 0, 0, 276, 0,
+	0, 0, 388, 0, 328, 310, 0, 0, 0, 326,
+	279, 357, 318, 363, 347, 371, 322, 319, 215, 348,
+	244, 290, 226, 228, 240, 247, 249, 251, 252, 300,
+	301, 313, 333, 350, 351, 352, 243, 236, 327, 237,
+	265, 238, 216, 337, 239, 218, 314, 355, 0, 257,
+	258, 259, 260, 261, 323, 286, 219, 285, 315, 354,
+	353, 227, 379, 385, 386, 391, 0, 392, 0, 0,
+	0, 400, 408, 409, 410, 412, 413, 414, 0, 415,
+	416, 0, 0, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 384, 255, 208, 212, 367, 0, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	383, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 270, 312, 0, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 342, 365, 377,
+	395, 398, 0, 0, 0, 217, 397, 0, 0, 0,
+	0, 0, 0, 0, 368, 0, 0, 0, 376, 0,
+	0, 0, 0, 0, 393, 296, 297, 298, 299, 262,
+	0, 234, 396, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	389, 390, 254, 264, 411, 266, 233, 311, 256, 374,
+	273, 0, 401, 0, 0, 0, 0, 0, 303, 269,
+	338, 274, 280, 324, 373, 309, 329, 231, 364, 339,
+	284, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 278, 0, 320, 253, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 0,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 0, 204, 205, 206, 207, 0,
+	0, 403, 0, 0, 380, 381, 382, 407, 366, 0,
+	292, 0, 213, 335, 0, 349, 340, 1113, 0, 0,
+	402, 404, 405, 245, 0, 0, 308, 211, 0, 0,
+	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 209, 248, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 341, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 0, 0, 0, 0, 0,
+	229, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 346, 362, 230, 334, 375, 235, 344, 225,
+	307, 330, 0, 0, 222, 360, 343, 288, 271, 272,
+	221, 0, 325, 246, 263, 242, 305, 0, 359, 387,
+	241, 378, 0, 370, 224, 0, 369, 304, 356, 361,
+	289, 283, 223, 358, 287, 282, 275, 250, 406, 267,
+	316, 281, 317, 268, 294, 293, 295, 0, 0, 0,
+	0, 0, 399, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 345, 0, 0,
+	276, 0, 0, 0, 388, 0, 328, 310, 0, 0,
+	0, 326, 279, 357, 318, 363, 347, 371, 322, 319,
+	215, 348, 244, 290, 226, 228, 486, 247, 249, 251,
+	252, 300, 301, 313, 333, 350, 351, 352, 243, 236,
+	327, 237, 265, 238, 216, 337, 239, 218, 314, 355,
+	0, 257, 258, 259, 260, 261, 323, 286, 219, 285,
+	315, 354, 353, 227, 379, 385, 386, 391, 0, 392,
+	0, 0, 0, 400, 408, 409, 410, 412, 413, 414,
+	0, 415, 416, 0, 0, 0, 0, 0, 394, 0,
+	0, 0, 0, 0, 0, 384, 255, 208, 212, 367,
+	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 383, 0, 0, 0, 0, 331, 0, 0,
+	0, 0, 0, 270, 312, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
+	365, 377, 395, 398, 0, 0, 0, 217, 397, 0,
+	0, 0, 0, 0, 0, 0, 368, 0, 0, 0,
+	376, 0, 0, 0, 0, 0, 393, 296, 297, 298,
+	299, 262, 0, 234, 396, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 389, 390, 254, 264, 411, 266, 233, 311,
+	256, 374, 273, 0, 401, 0, 0, 0, 0, 0,
+	303, 269, 338, 274, 280, 324, 373, 309, 329, 231,
+	364, 339, 284, 0, 0, 0, 465, 0, 464, 471,
+	461, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	468, 469, 0, 470, 474, 0, 0, 456, 0, 0,
+	0, 0, 0, 0, 0, 0, 479, 0, 0, 0,
+	0, 974, 0, 214, 0, 278, 0, 320, 253, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
+	188, 0, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 0, 204, 205, 206,
+	207, 0, 0, 403, 0, 0, 380, 381, 382, 407,
+	366, 0, 292, 0, 213, 335, 0, 0, 340, 336,
+	0, 0, 402, 404, 405, 245, 0, 0, 156, 211,
+	40, 142, 118, 0, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 209, 0, 0, 0, 0, 149, 0,
+	1503, 974, 0, 962, 0, 135, 0, 0, 150, 0,
+	0, 0, 0, 104, 0, 0, 0, 0, 0, 0,
+	0, 982, 986, 988, 990, 992, 993, 995, 85, 999,
+	996, 997, 998, 1505, 153, 977, 978, 979, 980, 960,
+	961, 983, 0, 963, 0, 964, 965, 966, 967, 968,
+	969, 970, 971, 972, 973, 975, 981, 0, 0, 0,
+	3158, 457, 459, 458, 985, 987, 989, 991, 994, 0,
+	1485, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 680, 0, 467, 0, 0, 0, 0, 0, 0,
+	482, 0, 0, 0, 0, 0, 0, 460, 0, 0,
+	0, 976, 0, 962, 0, 143, 144, 0, 145, 146,
+	0, 0, 0, 0, 0, 148, 0, 0, 0, 0,
+	0, 982, 986, 988, 990, 992, 993, 995, 0, 999,
+	996, 997, 998, 0, 0, 977, 978, 979, 980, 960,
+	961, 983, 716, 963, 0, 964, 965, 966, 967, 968,
+	969, 970, 971, 972, 973, 975, 981, 0, 0, 0,
+	0, 0, 0, 0, 985, 987, 989, 991, 994, 0,
+	0, 0, 0, 117, 141, 154, 0, 83, 0, 0,
+	0, 0, 0, 0, 854, 855, 856, 853, 0, 0,
+	0, 0, 0, 0, 0, 140, 134, 133, 0, 0,
+	0, 976, 46, 462, 466, 472, 0, 473, 475, 1489,
+	0, 476, 477, 478, 0, 0, 480, 481, 0, 0,
+	1493, 0, 0, 718, 0, 0, 717, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1988, 1989, 0, 0,
+	1482, 0, 0, 0, 1484, 1486, 1488, 0, 1490, 1491,
+	1492, 1494, 1495, 1496, 1498, 1499, 1500, 1501, 0, 0,
+	703, 1311, 0, 0, 136, 137, 138, 0, 681, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 151, 0, 708, 0, 0, 0, 0,
+	0, 0, 0, 1504, 0, 0, 0, 0, 0, 0,
+	0, 98, 0, 0, 0, 139, 465, 99, 464, 471,
+	461, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	468, 469, 0, 470, 474, 0, 0, 456, 0, 1503,
+	1502, 0, 0, 0, 0, 0, 479, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1481, 0, 702,
+	701, 984, 0, 0, 0, 0, 0, 0, 0, 0,
+	100, 0, 1505, 0, 0, 483, 0, 1503, 485, 700,
+	39, 0, 0, 484, 0, 0, 1497, 0, 679, 0,
+	0, 0, 0, 1487, 0, 0, 0, 0, 0, 682,
+	711, 0, 0, 0, 0, 0, 0, 0, 0, 1485,
+	1505, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1307, 0, 0, 706, 1304, 0, 0, 41, 1306, 1303,
+	1305, 1309, 1310, 0, 0, 0, 1308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1485, 0, 0,
+	0, 0, 0, 0, 0, 707, 712, 0, 0, 0,
+	119, 984, 0, 0, 0, 0, 0, 0, 0, 1816,
+	0, 0, 697, 0, 695, 699, 715, 0, 0, 0,
+	696, 693, 692, 0, 698, 683, 684, 685, 686, 687,
+	688, 0, 713, 714, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 709, 710, 0, 0, 0, 0,
+	0, 457, 459, 458, 0, 0, 0, 0, 0, 0,
+	0, 463, 0, 147, 0, 0, 0, 0, 0, 101,
+	102, 103, 0, 467, 0, 0, 0, 0, 0, 0,
+	482, 704, 0, 0, 0, 0, 0, 460, 1489, 0,
+	107, 451, 0, 0, 0, 0, 0, 0, 0, 1493,
+	1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301,
+	1302, 1314, 1315, 1316, 1317, 1318, 1319, 1312, 1313, 1482,
+	0, 0, 0, 1484, 1486, 1488, 1489, 1490, 1491, 1492,
+	1494, 1495, 1496, 1498, 1499, 1500, 1501, 1493, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1482, 0, 0,
+	0, 1484, 1486, 1488, 0, 1490, 1491, 1492, 1494, 1495,
+	1496, 1498, 1499, 1500, 1501, 0, 0, 0, 0, 0,
+	0, 0, 1504, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 462, 466, 472, 0, 473, 475, 0,
+	0, 476, 477, 478, 0, 0, 480, 481, 465, 0,
+	464, 471, 461, 0, 0, 0, 0, 0, 0, 1502,
+	1504, 0, 468, 469, 0, 470, 474, 0, 0, 456,
+	0, 0, 0, 0, 0, 0, 1481, 0, 479, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1502, 0, 0,
+	0, 0, 0, 0, 0, 1497, 0, 483, 0, 0,
+	485, 0, 1487, 0, 1481, 484, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1497, 0, 0, 0, 0, 0, 0,
+	1487, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 457, 459, 458, 0, 0, 0, 0,
+	0, 0, 0, 463, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 467, 0, 0, 0, 0,
+	0, 0, 482, 0, 0, 0, 0, 0, 0, 460,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 462, 466, 472, 0, 473,
+	475, 0, 0, 476, 477, 478, 0, 0, 480, 481,
 }
 
 var yyPact = [...]int{
-	305, -1000, -322, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	46786, -1000, -324, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -320,
+	41896, 41896, -1000, -1000, 2331, -1000, 41354, 18048, 42438, 338,
+	336, 42438, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 601, -1000, 40812, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 564, 47124,
+	46232, 9612, 42438, 42438, -219, -1000, 2862, -65, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 3041, 791, 40270,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -320, 29445,
-	29445, -1000, -1000, 1761, -1000, 28926, 9712, 29964, 203, 202,
-	29964, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	46873, 241, 791, 842, 859, 1038, 834, 42438, -257, 20758,
+	71, 70, 2862, 377, 1078, -1000, 308, 46786, 1961, 504,
+	42438, -1000, 1319, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 446, -1000, 28407, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 396, 31518, 30483, 7625, 29964, -299,
-	-1000, 2348, -155, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	2023, 480, 27888, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 31012, 76, 480, 605, 619, 749, 749, 11788, -62,
-	-66, 2348, 263, 155, -1000, 937, 305, 1665, 373, 29964,
-	-1000, 1083, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4703,305 +6382,348 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 373, -1000,
-	-1000, -1000, 2348, 2348, -1000, 29964, 29964, 15, 1213, -1000,
-	253, 238, 267, 1080, -1000, -1000, -1000, -1000, -1000, 2392,
-	-1000, 29964, 29964, 2077, 29964, -1000, 1424, 342, 31587, 2222,
-	1030, 478, 2106, -1000, -1000, 2059, -1000, 118, 113, 142,
-	362, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 95, -1000,
-	2283, -1000, -1000, 110, -1000, -1000, 91, -1000, -1000, -1000,
-	-79, -1000, -1000, -1000, -1000, -1000, -1000, -166, -1000, -1000,
-	632, 1476, 7625, -1000, 1449, -1000, 2820, -1000, -1000, -1000,
-	-1000, 5020, 9182, 9182, 9182, 9182, -1000, -1000, 1895, 7625,
-	2056, 2055, -1000, -1000, -1000, -1000, -1000, 1078, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 504, -1000, -1000,
+	-1000, 2862, 2862, -1000, 42438, 42438, 122, 1430, -1000, 360,
+	372, 344, 1312, -1000, -1000, -1000, -1000, -1000, 2841, -1000,
+	42438, 42438, 2482, 42438, -1000, 1654, 467, 47476, 2632, 1223,
+	696, 2512, -1000, -1000, 2476, -1000, 237, 201, 205, 552,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 263, -1000, 2735,
+	-1000, -1000, 229, -1000, -1000, 224, -1000, -1000, -1000, 67,
+	-1000, -1000, -1000, -1000, -1000, -1000, -33, -1000, -1000, 889,
+	1822, 9612, -1000, 2349, -1000, 2268, -1000, -214, -1000, -1000,
+	6336, 11792, 11792, 11792, 11792, -1000, -1000, 2310, 9612, 2472,
+	2471, -1000, -1000, -1000, -1000, -1000, 2470, 1301, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1664, 8663, -1000, 2050, 2049, 2048, 2047, 2046, 2044, 2029,
-	2019, 2018, 2017, 2015, 2010, 2007, 2006, 1806, 1885, 2005,
-	1663, 1658, 2004, 2003, 1979, 1652, 1806, 1806, 1975, 1974,
-	1973, 1971, 1968, 1966, 1964, 1963, 1962, 1951, 1942, 1941,
-	1935, 1932, 1929, 1923, 1922, 1921, -1000, -1000, -1000, -1000,
+	1951, 11247, -1000, 2455, 2454, 2453, 2449, 2442, 2441, 2436,
+	2432, 2430, 2428, 2423, 2422, 2421, 2419, 2418, 2197, 2283,
+	2417, 1949, 1942, 2415, 2412, 2411, 1941, 2197, 2197, 2396,
+	2395, 2384, 2383, 2382, 2381, 2380, 2378, 2377, 2376, 2375,
+	2374, 2373, 2372, 2369, 2354, 2353, 2350, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2654,
-	-1000, 1076, 873, -1000, 1919, 2213, 2308, 1833, 2373, 2282,
-	2279, 2264, 2260, 1524, -1000, -1000, 29964, 29964, 394, 394,
-	394, 394, 394, 397, 394, 441, 433, 431, 394, -1000,
-	-1000, -1000, -1000, -1000, -1000, 517, -1000, -1000, -1000, -1000,
-	850, 29964, -1000, 1858, 1121, 2301, 359, 353, 240, -1000,
-	1265, 1265, 1265, 1265, 1121, 246, 349, 2308, 2308, -63,
-	1265, -113, 1121, 1121, -113, 1121, 1121, 1121, 83, -1000,
-	-1000, -1000, 1265, 346, 1265, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 2290, 2289, 396, 29964, 41, 29964, 396, 396,
-	404, -1000, -188, -1000, -1000, 720, -1000, 534, -1000, 1424,
-	332, 324, 876, 1242, -1000, 1201, 29964, 29964, 29964, 1201,
-	1201, 15424, 14905, -1000, 29964, -1000, 2308, 1833, -1000, 1781,
-	2194, 1779, 1833, 396, 396, 396, 396, 396, 396, 396,
-	396, 29964, 29964, 396, 6577, 6577, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2215, 261, 1917, -1000, 29964, 254,
-	2308, 2213, 2308, -1000, -1000, 1075, 1521, 1651, -1000, -1000,
-	253, 1154, -1000, 755, -1000, -1000, -1000, -1000, 29964, 243,
-	-1000, -1000, 1643, 1916, -1000, 393, 959, 1071, -1000, 120,
-	31649, 24247, 1424, 24247, 29964, -1000, -1000, -1000, -1000, -1000,
-	-1000, -84, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 117, -1000, 7625, 7625, 7625,
-	7625, 7625, -1000, 440, 8144, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 9182, 9182, 9182, 9182, 9182, 9182, 9182, 9182,
-	9182, 9182, 9182, 9182, 1894, 1291, 9182, 9182, 9182, 9182,
-	2194, 2341, 872, 163, -1000, -1000, -1000, -1000, -1000, 1157,
-	1476, 7625, 7625, 29964, -1000, 31047, 7625, 7625, 30826, 7625,
-	2242, 7625, 7625, 7625, 1770, 3462, 29964, 7625, -1000, 1768,
-	1766, -1000, -1000, 1323, 7625, 7625, -1000, -1000, 7625, 9182,
-	7625, -1000, -1000, -1000, 75, 2242, 2242, 7625, 7625, 2242,
-	2242, 2242, 1219, 2242, 2242, 2242, 2242, 2242, 2242, 2242,
-	2242, 1764, 29964, 2196, 101, -1000, -1000, -1000, 1807, -1000,
-	1856, 1856, 1856, 1856, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1873, 1915, -1000, -1000, 1854, 1854, 1854,
-	1807, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1870, 1870, 1872, 1870,
-	29964, 2308, -299, 6058, -1000, -304, 2213, 7625, -1000, -1000,
-	7625, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1647,
-	2237, -1000, 1905, 1052, 29964, 1194, 29964, 24247, 29964, 1424,
-	29964, 29964, 394, 394, 394, 29964, 404, -1000, 29964, 850,
-	2235, 29964, 2379, 9182, 9182, 27369, 1265, 1121, -1000, 29964,
-	-1000, -1000, -1000, 1265, 29964, 1265, 1265, 2379, 1265, -1000,
-	-1000, -1000, 1121, 1121, -1000, -1000, -1000, -1000, 1265, 1265,
-	-1000, -1000, 2379, 29964, -97, 2379, 2379, -94, -1000, -1000,
-	-1000, 29964, 29964, 394, 29964, 29964, -1000, 29964, -1000, -1000,
-	29964, 31238, 29964, 29964, 2286, -1000, 24247, 29964, 22171, -1000,
-	-156, 599, 550, 544, -1000, -1000, 391, 399, 13867, 344,
-	24247, 4500, -1000, -1000, 1201, 1201, 1201, 4500, 4500, 898,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 769, -1000, 88,
-	2213, -1000, -1000, -1000, -1000, -1000, 29964, 29964, 24247, 1424,
-	29964, 29964, 29964, 29964, -1000, 1897, 29964, 1063, -1000, -1000,
-	11269, 1044, 1063, 1646, 2098, -300, -1000, 12828, 29964, 29964,
-	-1000, -1000, -300, -1000, 12308, 29964, 2213, -1000, 2213, 29964,
-	-1000, 2300, -1000, 253, 191, -1000, -1000, -1000, -1000, -1000,
-	-1000, 986, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 737, -1000, 29964, -1000, -1000, 120, 24247, 25285, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 108, -1000, -1000, 149,
-	-1000, 416, 42, 1152, -1000, -1000, 52, 140, 462, 1476,
-	-1000, 1274, 1274, 1233, -1000, 456, -1000, -1000, -1000, -1000,
-	1895, -1000, -1000, -1000, 1232, 2160, -1000, 1271, 1271, 1098,
-	1098, 1098, 1098, 1098, 1338, 1338, -1000, -1000, -1000, 5020,
-	1894, 9182, 9182, 9182, 9182, 380, 380, 2849, 3291, -1000,
-	7625, 1244, -1000, 7625, 1635, 946, 982, -1000, -1000, -1000,
+	3427, -1000, 1298, 2343, 1295, 1202, -1000, 2340, 2879, 2773,
+	2148, 2807, 2727, 2724, 2717, 2715, 1706, -1000, -1000, 303,
+	42438, 42438, 561, 561, 561, 561, 561, 537, 561, 595,
+	591, 588, 561, 42438, -1000, -1000, -1000, -1000, -1000, -1000,
+	674, -1000, -1000, -1000, -1000, 1074, 42438, -1000, 2241, 1346,
+	2764, 478, 471, 383, -1000, 1463, 1463, 1463, 1463, 1346,
+	405, 474, 2773, 2773, 45, 1463, 55, 1346, 1346, 55,
+	1346, 1346, 1346, 217, -1000, -1000, -1000, 1463, 452, 1463,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 2723, 2720, 564,
+	42438, 177, 42438, 564, 564, 572, -1000, -111, -1000, -1000,
+	841, -1000, 720, -1000, 45690, 582, -1000, 42438, -111, 45148,
+	44606, 1654, 465, 464, 1120, 1474, -1000, 1417, 42438, 1417,
+	1417, 24552, 24010, -1000, 42438, -1000, 2773, 2148, -1000, 2096,
+	2855, 2094, 2148, 302, 564, 564, 564, 564, 564, 564,
+	564, 564, 42438, 42438, 564, 564, 973, -1000, -1000, 2880,
+	361, 2335, -1000, 42438, 345, 2773, 2879, 2773, -1000, -1000,
+	1291, 1705, 1934, -1000, -1000, 360, 1377, -1000, 857, -1000,
+	-1000, -1000, -1000, 42438, 328, -1000, -1000, 1909, 2334, -1000,
+	521, 1204, 1132, -1000, 219, 46674, 36476, 1654, 36476, 42438,
+	-1000, -1000, -1000, -1000, -1000, -1000, 66, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1759, 632, 1757,
-	1520, 2387, 1756, 7625, -1000, -1000, 1146, 1141, 1140, -1000,
-	1610, 7106, -1000, -1000, -1000, 1755, 978, 1751, -1000, -1000,
-	-1000, 1749, 1137, 814, 1745, 1293, 1744, 735, 7625, 7625,
-	1136, 1135, 7625, 7625, 7625, 7625, 1742, 7625, 7625, 7625,
-	7625, 7625, 7625, 7625, 7625, -56, -1000, 7625, 71, -1000,
-	-1000, -1000, -1000, 1516, -1000, 1513, -1000, -1000, -1000, 1644,
-	1644, -1000, 1495, -1000, -1000, -1000, -1000, 1494, -1000, -1000,
-	1486, -1000, -1000, -1000, -1000, 1134, -1000, 1476, -1000, 1639,
-	-1000, 886, 723, -1000, 1454, -1000, -1000, 29964, 10231, 29964,
-	1858, 2219, 63, -1000, 943, -1000, 42, -176, 662, 2076,
-	2386, 29964, 29964, 29964, 2231, 26850, -1000, 1889, 1127, -1000,
-	-1000, 7625, -1000, -1000, 2062, 29964, 29964, 2379, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 29964, 2379, 2379, 1121, 1265,
-	-1000, -1000, 1265, -1000, -1000, 1265, -1000, 977, -1000, 29964,
-	-1000, -1000, -1000, 1858, -1000, 10750, 669, 31311, -1000, 1144,
-	1144, 610, 1144, 1144, 1144, 1144, 291, 283, 1144, 1144,
-	1144, 1144, 1144, 1144, 1144, 1144, 1144, 1144, 1144, 1144,
-	1144, 1144, 1888, -1000, 58, 2275, 151, 943, 167, 2406,
-	732, -1000, -1000, -1000, -1000, 17500, 17500, 13348, 17500, -1000,
-	1158, -1000, -1000, 562, 528, 703, -1000, -1000, 410, -1000,
-	-1000, 662, -1000, -1000, -1000, 1887, 1211, -1000, -1000, 1885,
-	-1000, 4500, 4500, 4500, -1000, -1000, 18019, 29964, -1000, -167,
-	-1000, -159, -1000, 711, -1000, -1000, 717, 662, 2092, 711,
-	711, -1000, 10231, -1000, 2379, 6577, -1000, 22171, -1000, -1000,
-	26323, -1000, 25804, 2379, -121, -1000, 2195, 668, -1000, 1884,
-	-1000, 1116, 2145, -1000, 699, -1000, 1205, 1108, -1000, 668,
-	1107, 2074, 699, -1000, -1000, 970, 9, -1000, -1000, 29964,
-	1643, 1104, 25285, 683, -1000, 409, 948, 940, -1000, 24247,
-	102, 24247, -1000, 24247, -1000, -1000, 241, -1000, 29964, 2210,
-	-1000, -1000, -1000, 1598, -326, -1000, -1000, -1000, -1000, -1000,
-	1102, -1000, 380, 380, 2849, 3221, -1000, 9182, -1000, 9182,
-	2312, 1172, -1000, 7625, 1379, 30881, 504, 16981, 29964, -1000,
-	-1000, 7625, 7625, -1000, 2291, -1000, -1000, -1000, -1000, 7625,
-	7625, 1363, -1000, 29964, -1000, -1000, -1000, -1000, 16981, -1000,
-	9182, -1000, 7625, 823, 2285, -56, -56, 2278, 2207, 2149,
-	1096, -56, 2139, 2129, 2084, 2072, 2067, 2057, 2053, 2000,
-	-1000, 1881, 1476, -1000, -1000, 1740, 1739, 1088, -1000, 1064,
-	1737, 1042, 1038, 6058, -1000, -121, 7625, 7625, 7625, 2163,
-	-1000, -1000, 82, 1733, 689, -1000, -1000, -1000, 30938, 1856,
-	1856, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1873, -1000, -1000, 1854, 1854, 1854, 1807, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1870, 1870, 1872, 1870,
-	-1000, 2230, -1000, -71, 1144, 379, 24247, 320, -1000, 29964,
-	2091, 264, 2171, 29964, 1868, 1866, 1865, 197, 30938, 29964,
-	1047, -1000, 935, 305, -1000, 29964, 1476, -1000, 1424, -1000,
-	1121, -1000, 2379, 1057, -1000, -1000, 2379, 1121, 1121, 1265,
-	29964, -1000, 2229, -1000, -1000, -1000, 30938, 602, -1000, 29964,
-	1294, 584, 394, 584, 1290, 1863, -1000, -1000, -1000, 29964,
-	29964, 29964, 1285, 1284, 31383, -1000, 1483, -1000, 1482, 1144,
-	1144, 1472, 1638, 1637, 1632, 1144, 1144, 1462, 1624, 24766,
-	1458, 1450, 1445, 1496, 1622, 564, 1464, 1459, 1448, 29964,
-	1860, 1573, -71, 1144, 146, 1199, 379, 1451, 14386, 29964,
-	22171, 22171, 22171, 22171, -1000, 2130, 2128, -1000, 2127, 2126,
-	2137, 29964, 22171, 1858, -1000, 24766, -1000, -1000, -1000, 2194,
-	1024, 2425, 569, 7625, -1000, -1000, -1000, 522, 24247, 1620,
-	344, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 29964,
-	29964, 1731, 2377, -1000, 687, -1000, -1000, 915, -1000, 2377,
-	-43, -6, 1282, -300, 6058, 235, 29964, -300, 29964, 6058,
-	-1000, 29964, 226, -300, 29964, 1441, -1000, -1000, -1000, 2384,
-	24247, 1424, 1106, 23728, -1000, 98, -1000, 105, 367, 1619,
-	-1000, 425, 79, -1000, 1197, 1598, -1000, -1000, -1000, 9182,
-	-1000, -1000, -1000, -1000, 1476, 7625, 1727, -1000, 679, 679,
-	1726, -1000, 1856, 1856, -1000, 1807, 1854, 1807, 679, 679,
-	1723, -1000, 1574, 1987, -1000, 1960, 1912, 7625, -1000, 1719,
-	2927, 1050, -192, -56, -1000, -1000, -1000, -56, -56, -56,
-	-56, -1000, -56, -56, -56, -56, -56, -56, -56, -56,
-	374, -1000, -1000, -1000, 1612, -1000, -1000, -1000, 1439, -1000,
-	1437, -1000, -43, 1476, 1476, -1000, -1000, 2142, 1611, 437,
-	10231, 2179, 258, 1527, -1000, -1000, 23209, 358, -1000, -1000,
-	-1000, 435, 99, 1434, 354, -1000, 29964, 166, 29964, -1000,
-	-1000, -1000, -1000, -1000, 2171, -1000, 508, 188, 10750, 10750,
-	10750, 192, 1225, -1000, 373, 750, 912, 22171, 29964, -1000,
-	21652, 1708, -1000, 662, 2379, -1000, 29964, -1000, 2379, 2379,
-	1121, -1000, 258, 2523, -1000, -1000, 29964, 29964, -1000, 29964,
-	29964, 394, 7625, -1000, -1000, -1000, 29964, -1000, -1000, -1000,
-	-1000, 16981, 16981, -1000, -1000, -1000, -1000, 1608, 1606, -1000,
+	222, -1000, 9612, 9612, 9612, 9612, 9612, -1000, 643, 10702,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 11792, 11792, 11792,
+	11792, 11792, 11792, 11792, 11792, 11792, 11792, 11792, 11792, 2309,
+	1476, 11792, 11792, 11792, -261, 11792, 1925, 1924, 2855, 2767,
+	1113, 249, -1000, -1000, -1000, -1000, -1000, 1429, 1822, 9612,
+	9612, 42438, 42438, -1000, 46912, 9612, 9612, 1771, 9612, 2704,
+	9612, 9612, 9612, 2086, 4694, 42438, 9612, 9612, -1000, 2077,
+	2072, -1000, -1000, 1543, 9612, 9612, -1000, -1000, 9612, 11792,
+	9612, -1000, -1000, -1000, 160, 2704, 2704, 9612, 9612, 2704,
+	2704, 2704, 1424, 2704, 2704, 2704, 2704, 2704, 2704, 2704,
+	2704, 2071, 42438, 2618, 208, -1000, -1000, -1000, 2198, -1000,
+	2240, 2240, 2240, 2240, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2271, 2333, -1000, -1000, 2239, 2239, 2239,
+	2198, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2255, 2255, 2270, 2255,
+	42438, 9612, 42438, 2773, -219, 7971, -1000, -231, 2879, 9612,
+	-1000, -1000, 9612, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1920, 2763, 2686, -1000, 2316, 1288, 42438, 1446, 42438,
+	36476, 42438, 1654, 42438, 42438, 561, 561, 561, 42438, 2312,
+	572, -1000, 42438, 1074, 2684, 42438, 2826, 11792, 11792, 39728,
+	1463, 1346, -1000, 42438, -1000, -1000, -1000, 1463, 42438, 1463,
+	1463, 2826, 1463, -1000, -1000, -1000, 1346, 1346, -1000, -1000,
+	-1000, -1000, 1463, 1463, -1000, -1000, 2826, 42438, 64, 2826,
+	2826, 41, -1000, -1000, -1000, 42438, 42438, 561, 42438, 42438,
+	-1000, 42438, -1000, -1000, 42438, 2936, 42438, 42438, 2714, -1000,
+	36476, 42438, 27262, -1000, 1023, -1000, 18, 846, 1, 785,
+	793, -1000, -1000, 42438, 44064, -1000, 1023, -111, 1417, -111,
+	1417, -1000, 507, 544, 22926, 450, 36476, 5784, -1000, -1000,
+	1417, 5784, 5784, 1293, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1073, -1000, 235, 2879, -1000, -1000, -1000, -1000, -1000,
+	564, 42438, 42438, 36476, 1654, 42438, 42438, 42438, 42438, -1000,
+	2311, 42438, 42438, 8516, 8516, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1914, 2508, -221, -1000, 22384, 42438, 42438, -1000,
+	-1000, -221, -1000, 21842, 42438, 2879, -1000, 2879, 42438, -1000,
+	2750, -1000, 360, 317, -1000, -1000, -1000, -1000, -1000, -1000,
+	1286, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1069, -1000, 42438, -1000, -1000, 219, 36476, 38644, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 256, -1000, -1000, 188, -1000,
+	581, 153, 1368, -1000, -1000, 189, 183, 623, 1822, -1000,
+	1461, 1461, 1482, -1000, 545, -1000, -1000, -1000, -1000, 2310,
+	-1000, -1000, -1000, 2304, 1565, -1000, 1539, 1539, 1311, 1311,
+	1311, 1311, 1311, 2058, 2058, -1000, -1000, -1000, 6336, 2309,
+	11792, 11792, 11792, 11792, 523, 523, 2406, 2303, 3270, -1000,
+	-1000, -1000, 9612, 1448, -1000, 9612, 2400, 1243, 1367, 1285,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 314, 29964, 997, -1000, 1183, 1527, 23209, 1162, 1603,
-	358, -1000, 1599, -1000, 650, 29964, 29964, -1000, 865, -1000,
-	1149, 2073, 2089, 2073, -1000, -1000, -1000, -1000, 2120, -1000,
-	2118, -1000, -1000, 865, -1000, -1000, -1000, -1000, -1000, 569,
-	-1000, 2298, 584, 584, 584, 1705, -1000, 683, 1701, -1000,
-	-1000, -1000, -1000, -1000, 2343, 2372, 22690, 2343, -306, -14,
-	2371, 2369, 2408, -1000, 1698, 674, -300, -1000, -1000, 668,
-	-1000, -1000, -1000, -300, -1000, 668, -1000, -1000, 1424, -1000,
-	104, -1000, -1000, -1000, -1000, -1000, -1000, 26, -1000, 29964,
-	-1000, 1598, 1591, 78, -1000, 1476, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 7625, -1000, -1000, -1000, 1882, -1000, -1000, 7625, 1697,
-	1581, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2313, -1000, 2368, -1000, 1696, 1691,
-	-306, -1000, -1000, -1000, 30938, -1000, 870, -1000, -1000, 1579,
-	40, -1000, -1000, -1000, 1578, 1577, 1432, -1000, -1000, 1444,
-	751, 56, -1000, -1000, -1000, -1000, -1000, -1000, 1451, 29964,
-	1853, -1000, 1144, 1144, 1144, 29964, 1676, 670, -1000, 1675,
-	1671, 368, 1185, 1180, -1000, 1428, 17500, 22171, 21652, 773,
-	-1000, 901, -1000, -1000, -1000, 2379, -1000, -1000, 2379, -1000,
-	-1000, 2523, -1000, -1000, 1297, 9182, -1000, -1000, 1575, 16462,
-	537, 543, 1851, -1000, 255, 2403, -1000, 1279, 1243, -1000,
-	29964, -1000, 1843, 1984, 211, 1840, -1000, 1839, 1838, 29964,
-	1874, -1000, -1000, -1000, -1000, -1000, -1000, 336, 993, -1000,
-	1573, 1572, -1000, 40, 1570, -1000, -1000, -1000, 29964, 650,
-	650, 2313, 29964, 6058, -1000, -1000, 7625, 1832, -1000, 7625,
-	-1000, -1000, -1000, -1000, -1000, 1829, 2249, -1000, -1000, -1000,
-	-1000, -1000, -1000, 7625, 7625, -1000, -1000, -309, 1564, -1000,
-	-1000, 2367, 1563, 1534, 29964, -1000, 668, 668, 662, -1000,
-	-1000, -94, -1000, -1000, -1000, 1641, -1000, 1567, -56, -1000,
-	46, 7625, -1000, -1000, -309, -237, -1000, -1000, -1000, -1000,
-	214, -1000, -1000, 161, -1000, -1000, 1413, 383, -1000, -1000,
-	650, 20614, 16981, 16462, 1560, -1000, 31383, 10750, 117, 31383,
-	606, 1175, -1000, 1426, -1000, 1421, -1000, 2379, 773, 901,
-	-1000, -1000, 1105, -1000, -1000, -1000, -1000, 2849, -1000, -1000,
+	2070, 889, 2065, 1938, 2839, 2060, 9612, -1000, -1000, 1352,
+	1351, 1350, -1000, 1751, 9067, -1000, -1000, -1000, 2059, 1262,
+	2056, 1349, -1000, -1000, -1000, 2051, 1344, 986, 2048, 2206,
+	2043, 1065, 9612, 9612, 1343, 1336, 9612, 9612, 9612, 9612,
+	2036, 9612, 9612, 9612, 9612, 9612, 9612, 9612, 9612, 80,
+	-1000, 9612, 187, -1000, -1000, -1000, -1000, 1702, -1000, 1701,
+	-1000, -1000, -1000, 1912, 1912, -1000, 1700, -1000, -1000, -1000,
+	-1000, 1696, -1000, -1000, 1689, -1000, -1000, 2035, -1000, -1000,
+	-1000, 1335, -1000, 1822, -1000, 1911, -1000, 981, 1060, -1000,
+	1762, -1000, -1000, 378, 42438, 18590, 42438, 2241, 2623, 173,
+	-1000, 985, -1000, 153, -47, 904, 2208, 2838, 42438, 42438,
+	42438, 2683, 149, 39186, -1000, 2301, 1331, -1000, -1000, 9612,
+	-1000, -1000, 2153, 42438, 42438, 2826, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 42438, 2826, 2826, 1346, 1463, -1000, -1000,
+	1463, -1000, -1000, 1463, -1000, 1256, -1000, 42438, -1000, -1000,
+	-1000, 2241, 1056, 47151, -1000, -1000, 19132, 535, 535, 534,
+	883, 1393, 1393, 1059, 1393, 1393, 1393, 1393, 432, 429,
+	1393, 1393, 1393, 1393, 1393, 1393, 1393, 1393, 1393, 1393,
+	1393, 1393, 1393, 1393, 2300, -1000, 169, 2707, 267, 985,
+	277, 2849, 1076, -1000, -1000, -1000, -1000, 27804, 27804, 21300,
+	29972, -1000, 1387, 2297, -111, -1000, -1000, 826, 11, 702,
+	828, -1000, -1000, 42438, 1023, 5784, 1023, 5784, -1000, -1000,
+	580, -1000, -1000, 904, -1000, 42438, 42438, -1000, -1000, 2285,
+	1426, -1000, -1000, 2283, -1000, 5784, -1000, -1000, 29430, 42438,
+	-1000, -36, -1000, -11, -1000, 2749, 1002, -1000, -1000, 1013,
+	904, 2507, 1002, 1002, -1000, 18590, -1000, -1000, 1176, -1000,
+	-1000, 20216, 1241, 1176, 52, -1000, 2658, 918, -1000, 2277,
+	-1000, 1329, 2576, -1000, 990, -1000, 1425, 1326, -1000, 918,
+	1323, 2564, 990, -1000, -1000, 1238, 119, -1000, -1000, 42438,
+	1909, 1315, 38644, 961, -1000, 577, 1233, 1210, -1000, 36476,
+	209, 36476, -1000, 36476, -1000, -1000, 330, -1000, 42438, 2878,
+	-1000, -1000, -1000, 1851, -330, -1000, -1000, -1000, -1000, -1000,
+	1313, -1000, 523, 523, 2406, 3170, -1000, 11792, -1000, 9612,
+	11792, 2748, 1434, -1000, 9612, 1647, 46689, 1341, 26720, -112,
+	42438, 80, -1000, 9612, 9612, -1000, 2740, -1000, -1000, -1000,
+	-1000, 9612, 9612, 1757, -1000, 42438, -1000, -1000, -1000, -1000,
+	-1000, 26720, -1000, 11792, -1000, 9612, 1095, 2718, 80, 80,
+	2700, 2690, 2685, 1305, 80, 2620, 2611, 2607, 2601, 2589,
+	2571, 2552, 2525, -1000, 2272, 1822, -1000, -1000, 2032, 2031,
+	1304, -1000, 1302, 2028, 1289, 1259, -1000, 7971, -1000, 52,
+	9612, 9612, 9612, 2587, -1000, -1000, -1000, 210, 2026, 988,
+	-1000, -1000, -1000, 46789, 2240, 2240, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 2271, -1000, -1000, 2239, 2239,
+	2239, 2198, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 2255, 2255, 2270, 2255, -1000, 2682, -1000, 79, 1393,
+	525, 36476, 448, -1000, 42438, 2506, 472, 2609, 42438, 2251,
+	2250, 2249, 331, 46789, 2023, 984, -1000, 42438, -1000, -1000,
+	-1000, 42438, 1052, -1000, 1209, 46786, -1000, 42438, 1822, -1000,
+	1654, -1000, 1346, -1000, 2826, 1207, -1000, -1000, 2826, 1346,
+	1346, 1463, 42438, -1000, 2674, 106, 47189, -1000, -1000, 259,
+	42438, -1000, 46789, 724, -1000, 42438, 1497, 714, 561, 714,
+	1495, 2246, -1000, -1000, 42438, -1000, 42438, 42438, -1000, 42438,
+	42438, 42438, 1494, 1493, 1687, -1000, 1685, 1393, 1393, 1684,
+	1904, 1902, 1897, 1393, 1393, 1680, 1890, 38102, 1678, 1675,
+	1673, 1693, 1883, 868, 1683, 1617, 1600, 42438, 2245, 1821,
+	79, 1393, 251, 1420, 525, 1526, 23468, 42438, 27262, 27262,
+	27262, 27262, -1000, 2541, 2535, -1000, 2568, 2551, 2542, 42438,
+	27262, 2241, -1000, 38102, -1000, -1000, -1000, 2855, 1252, 2499,
+	765, 27804, 28888, 9612, 9612, -1000, -1000, -1000, -26, -25,
+	-1000, -1000, -1000, 682, -1000, -1000, -1000, 36476, 1882, 450,
+	-1000, -1000, -1000, -1000, -1000, -1000, 378, 42438, 42438, 2021,
+	2835, 8516, 32682, -1000, -1000, 37560, -1000, 37018, 2835, 98,
+	121, 1490, -221, 7971, 368, 42438, -221, 42438, 7971, -1000,
+	42438, 359, -221, 42438, 1672, -1000, -1000, -1000, 2834, 36476,
+	1654, 1327, 35934, -1000, 206, -1000, 243, 500, 1879, -1000,
+	587, 118, -1000, 1412, 1851, -1000, -1000, -1000, 11792, -1000,
+	2521, -1000, -1000, -1000, 1822, 9612, 253, -1000, 798, 798,
+	2020, -1000, 2240, 2240, -1000, 2198, 2239, 2198, 798, 798,
+	2016, 2238, -1000, -1000, 1737, 2509, -1000, 2413, 2281, 9612,
+	-1000, 2015, 3083, 1271, -78, 80, -1000, -1000, -1000, 80,
+	80, 80, 80, -1000, 80, 80, 80, 80, 80, 80,
+	80, 80, 510, -1000, -1000, -1000, 1876, -1000, -1000, -1000,
+	1671, -1000, 1667, -1000, 98, 1822, 1822, -1000, -1000, 2580,
+	1875, 599, 18590, 2613, 378, 1547, -1000, -1000, 35392, 495,
+	-1000, -1000, -1000, 549, 223, 1665, 468, -1000, 42438, 276,
+	42438, -1000, -1000, -1000, -1000, -1000, 2609, -1000, 948, 314,
+	19674, 19674, 19674, 316, 1456, -1000, 17478, 149, 46789, 504,
+	947, 1195, 27262, 42438, -1000, 34850, 2003, -1000, 904, 2826,
+	-1000, 42438, -1000, 2826, 2826, 1346, -1000, 378, -1000, -1000,
+	-1000, -1000, 42438, 259, 3186, -1000, -1000, 42438, 42438, -1000,
+	42438, 42438, 561, 9612, 259, 42438, 576, -1000, -1000, -1000,
+	42438, -1000, -1000, -1000, 26720, 26720, -1000, -1000, -1000, -1000,
+	1871, 1865, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1416, 1827, -107, -1000, -1000, 1826, 20614, 247, 247,
-	20614, 20614, 20614, 1812, 479, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2308, -1000, -1000, 1476, 29964, 1476, 21133,
-	-1000, 2366, 2355, 1476, 632, -311, 1408, -1000, 1547, -19,
-	-1000, -1000, 644, -313, -46, -21, -1000, -1000, -1000, 1648,
-	-1000, 2943, -1000, -1000, -1000, 632, -311, 29964, 366, 1544,
-	-1000, -1000, 159, -1000, -1000, 992, -1000, 1807, 7625, -1000,
-	-1000, -1000, 372, 31311, -1000, -1000, -94, 372, 343, 164,
-	-1000, 1396, -1000, -1000, 2313, -1000, 1615, 7625, 1806, -223,
-	20614, 973, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 964,
-	960, 952, 20614, -1000, -1000, -1000, 250, -1000, 951, 949,
-	-1000, -1000, -1000, -1000, -1000, 1805, -1000, -1000, 2353, -1000,
-	1543, 401, -16, -21, -1000, 2346, -32, 2345, 2328, -1000,
-	-1000, 3981, -302, -44, 296, -1000, 2226, -1000, -1000, 24,
-	-1000, -1000, -1000, 20614, 2208, 1537, 213, 2325, -1000, 213,
-	-1000, 194, -1000, 1170, -1000, 1390, -1000, 2308, -1000, 1530,
-	-1000, 2068, -1000, 87, 930, -1000, -1000, -1000, -1000, 916,
-	-1000, -1000, -1000, 20095, 29964, 1534, -1000, 1803, 1388, -14,
-	-36, 2323, -1000, 1534, 2320, 1534, 1534, 1145, -1000, -1000,
-	-1000, -1000, -1000, 1542, -1000, 158, -1000, -1000, 2208, -1000,
-	2318, 281, -1000, -1000, -1000, 1365, -1000, -1000, 479, -1000,
-	2060, 1576, 2383, -1000, -1000, -1000, -1000, 158, 158, 158,
-	158, 90, -1000, -1000, 913, -1000, -1000, 2273, 15943, -29,
-	-1000, -1000, -1000, 1536, -1000, 1534, -1000, -1000, 3981, -1000,
-	-1000, 1144, 1533, 183, -1000, -1000, -1000, 19576, 233, 239,
-	218, -1000, 395, -1000, -1000, -1000, -1000, 2402, -1000, 2396,
-	692, 692, -1000, -1000, 29964, -1000, 29964, -1000, 908, -1000,
-	-1000, -1000, 896, -1000, -1000, -1000, -1000, -1000, 1344, -1000,
-	29964, -1000, 29964, 224, 1308, 9182, 1801, 9182, 1800, 249,
-	1799, -1000, -1000, -1000, 1361, 270, -1000, -1000, 629, -1000,
-	1131, -1000, 19057, 29964, -1000, -1000, 899, 1795, 2315, -1000,
-	1982, 29964, 1763, 29964, 1793, 1126, 9182, -1000, -1000, -1000,
-	29964, 5539, -1000, 879, -1000, -1000, 371, 237, -1000, 894,
-	-1000, 890, 18538, 1306, 1630, -1000, -1000, 1476, 29964, 877,
-	-1000, 29964, 216, -1000, -1000, -1000, 829, -1000, -1000, -1000,
-	-1000, 371, 1981, -1000, 1304, -1000, -1000, 2651, 502, -1000,
-	-1000, 2651, 219, -1000, 363, 1786, -1000, -1000, 771, -1000,
-	29964, 538, 7625, -1000, 219, 31383, -1000, 7625, 734, -1000,
-	31383, 725, -1000, -1000,
+	-1000, -1000, -1000, -1000, 441, 42438, 1250, -1000, 1411, 1547,
+	35392, 1407, 1861, 495, -1000, 1852, -1000, 900, 42438, 42438,
+	-1000, 1126, -1000, 1395, 2480, 2503, 2480, -1000, -1000, -1000,
+	-1000, 2527, -1000, 2526, -1000, -1000, 1126, -1000, -1000, -1000,
+	-1000, -1000, 765, -1000, 2745, 714, 714, 714, 765, 43522,
+	2001, 1107, -1000, -1000, -1000, -1000, 961, 1997, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2826, -1000, 27262, -1000, 872, -1000,
+	-1000, -1000, -1000, -1000, 1188, -1000, 2826, -233, 107, 2805,
+	2797, 2860, -1000, 1994, 955, -221, -1000, -1000, 918, -1000,
+	-1000, -1000, -221, -1000, 918, -1000, -1000, 1654, -1000, 232,
+	-1000, -1000, -1000, -1000, -1000, -1000, 140, -1000, 42438, -1000,
+	1851, 1849, 117, -1000, -1000, 1822, -1000, 1993, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 11792, -1000, 9612, -1000, -1000, -1000, 2189, -1000, -1000,
+	9612, 1989, 1848, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2809, -1000, 2794, -1000,
+	1982, 1980, -233, -1000, -1000, -1000, 46789, -1000, 2151, -1000,
+	-1000, 1846, 151, -1000, -1000, -1000, 1845, 1833, 1664, -1000,
+	-1000, 1592, 1249, 161, -1000, -1000, -1000, -1000, -1000, -1000,
+	1526, 42438, 2237, -1000, 1393, 1393, 1393, 42438, 1974, 946,
+	-1000, -1000, 1972, 1971, 508, 1404, 1403, -1000, 1663, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -337, 9612,
+	9612, -1000, -1000, 42438, 42438, 28346, 42438, 2591, 42438, 9612,
+	-1000, -1000, 27804, 27262, 34850, 1102, -1000, 1170, -1000, -1000,
+	-1000, 2826, -1000, -1000, 2826, -1000, -1000, -1000, -1000, -1000,
+	3186, -1000, -1000, 1515, 11792, -1000, -1000, 1832, 26178, 660,
+	673, 1831, 2230, -1000, 394, 2859, -1000, 2229, 389, 1460,
+	1452, -1000, 42438, -1000, 2228, 2061, 355, 2227, -1000, 2226,
+	2219, 42438, 2129, -1000, 259, 42438, -1000, -1000, -1000, -1000,
+	-1000, -1000, 449, 1248, -1000, 1821, 1818, -1000, 151, 1815,
+	-1000, -1000, -1000, 42438, 900, 900, 2809, 42438, 7971, -1000,
+	-1000, 9612, 2210, -1000, 9612, -1000, -1000, -1000, -1000, -1000,
+	2209, 2667, -1000, -1000, -1000, -1000, 11792, 1814, -1000, 1802,
+	-1000, 2819, 922, 32682, 34308, 2819, -235, 1795, -1000, -1000,
+	2792, 1793, 1725, 42438, -1000, 918, 918, 904, -1000, -1000,
+	41, -1000, -1000, -1000, -1000, 239, 2064, -1000, 1817, 80,
+	-1000, 199, 9612, -1000, -1000, -235, -115, -1000, -1000, -1000,
+	-1000, 324, -1000, -1000, 266, -1000, -1000, 1570, 531, -1000,
+	-1000, 900, 33224, 26720, 26178, 1787, -1000, 47189, 19674, 222,
+	47189, 858, 1399, -1000, 1655, -1000, 1651, -1000, 16908, 133,
+	1561, 200, 16338, 15768, -1000, -1000, 146, -287, -1000, -1000,
+	-1000, 42438, -1000, 2833, -1000, 2826, 1102, 1170, -1000, -1000,
+	1325, -1000, -1000, -1000, -1000, 2406, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -4, -1000,
+	1649, 2202, -56, 9612, 2673, -1000, -1000, 2200, 33224, 288,
+	288, 33224, 33224, 33224, 2199, 597, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2773, -1000, -1000, 1822,
+	42438, 1822, 33766, -1000, 2786, 2785, 2406, -1000, 50, 2817,
+	2784, -1000, -1000, 2817, -238, 1621, -1000, 1785, 104, -1000,
+	-1000, 898, -240, 94, 109, 1968, 289, -157, -1000, -1000,
+	-1000, 1827, -1000, 4149, -1000, -1000, -1000, 889, -238, 42438,
+	485, 1760, -1000, -1000, 260, -1000, -1000, 1242, -1000, 2198,
+	9612, -1000, -1000, -1000, 498, 47151, -1000, -1000, 41, 498,
+	480, 271, -1000, 1603, -1000, -1000, -325, -1000, -1000, 9612,
+	-1000, -1000, -1000, -1000, -308, 9612, 2743, 2742, 2875, -1000,
+	2809, -1000, -1000, 1601, 1767, 9612, 2197, 1805, 2193, -108,
+	33224, 1239, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1230,
+	1215, 1205, 33224, -1000, -1000, -1000, 401, -1000, 1192, 1185,
+	-1000, -1000, -1000, -1000, 2192, -1000, 9612, 7426, -1000, -1000,
+	2191, -1000, -1000, 2783, -1000, 1748, 546, 97, 109, -1000,
+	2782, 100, 2780, 2779, -1000, -145, 2556, -168, -1000, -1000,
+	5239, -223, 77, 458, -1000, 2671, -1000, -1000, 137, -1000,
+	-1000, -1000, 33224, 2629, 1778, 346, 2778, -1000, 346, -1000,
+	326, -1000, 1390, -1000, 1598, -1000, -1000, 15198, 184, 14628,
+	14058, 17478, 13488, -1000, 1524, 378, 207, 32682, 2773, -1000,
+	-1000, 1770, -1000, 393, 9612, 2502, -1000, 203, 1178, -1000,
+	-1000, -1000, -1000, 1174, -1000, -1000, -1000, 32140, 42980, 1822,
+	195, -1000, 1822, 2150, 2143, 230, 42438, 1725, -1000, 2137,
+	1591, 107, 101, 2777, -1000, 1725, 2775, 1725, 1725, 2555,
+	-1000, -1000, 1480, -1000, -1000, -1000, -1000, -1000, 1740, -1000,
+	257, -1000, -1000, 2629, -1000, 2768, 376, -1000, -1000, -1000,
+	1569, -1000, 42438, -1000, -309, 9612, 172, -302, 254, -1000,
+	12918, -1000, 1744, -1000, -1000, -1000, -298, 872, -1000, 597,
+	-1000, -1000, -1000, 1715, -1000, 2496, 2485, 2830, -1000, -1000,
+	-1000, -1000, 257, 257, 257, 257, 225, -1000, -1000, 1172,
+	-1000, 3322, 214, -252, 7426, 9612, 9612, 2134, 1165, -1000,
+	-1000, 2692, 25636, 90, -1000, -1000, -1000, 1731, -1000, 1725,
+	-1000, -1000, -147, 5239, -1000, -1000, 1393, 1723, 296, -1000,
+	-1000, -1000, 31598, 374, 421, 382, -1000, 533, -1000, -1000,
+	-1000, -1000, 12348, 42438, 1503, 1405, 9612, -1000, -1000, -1000,
+	-1000, 207, -1000, 1721, -1000, -1000, 393, -1000, 2853, -1000,
+	2831, 665, 665, -1000, 1756, 42980, -270, 247, 1720, 49,
+	-1000, -1000, 1159, 1127, 10157, -1000, 42438, -1000, 42438, -1000,
+	1119, -1000, -1000, -1000, 1154, -1000, -1000, -1000, -1000, -164,
+	-1000, 1567, -1000, 42438, -1000, 42438, 369, 1555, 11792, 2132,
+	11792, 2125, 381, 2123, -306, -1000, 250, 548, 1545, 17478,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1560, 406, -1000, -1000,
+	-1000, -1000, 1716, -273, 42, 2121, -1000, -1000, 1112, -1000,
+	3604, 1822, -1000, 866, -1000, 1360, -1000, 31056, 42438, -169,
+	-1000, -1000, 1100, 2109, 2751, -1000, 2931, 42438, 2606, 42438,
+	2107, 1355, 11792, 42438, 42438, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1713, 2101, 42980, -1000, 10157, -1000, 42438, 6881, -1000,
+	1150, -1000, -1000, -1000, 489, 379, -1000, 1098, -1000, 1097,
+	30514, 1554, 2348, -1000, -1000, 17478, 25094, -1000, 42980, 1081,
+	-1000, -1000, -1000, 1822, 42438, 1022, -1000, 42438, 363, -1000,
+	-1000, -1000, 1008, -1000, -1000, 2284, -1000, -1000, 1653, 971,
+	-1000, -1000, -1000, 489, 2562, -1000, 1548, -1000, 42438, -1000,
+	-1000, -1000, 46812, 678, -1000, -1000, -1000, 46812, 365, -1000,
+	484, 2100, -1000, -1000, 956, -1000, 42438, 712, 9612, -1000,
+	365, 47189, -1000, 9612, 950, -1000, 47189, 942, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 131, 2869, 204, 157, 140, 203, 2868, 2865, 2215,
-	2212, 2860, 2859, 2858, 2857, 2856, 2855, 2854, 2853, 2852,
-	2846, 2845, 2844, 2843, 2842, 2841, 2840, 2827, 2825, 2817,
-	2816, 2811, 2803, 202, 2802, 2801, 2800, 2799, 2797, 2796,
-	2795, 2794, 2791, 2789, 2788, 2787, 2785, 2783, 2778, 2776,
-	2774, 2773, 2772, 2768, 2765, 2764, 2762, 2761, 2760, 2756,
-	2754, 136, 2753, 2194, 2752, 2751, 2750, 2749, 2748, 2747,
-	2746, 178, 2744, 2741, 2738, 2735, 2732, 2730, 2727, 2726,
-	2725, 2724, 2723, 2722, 2720, 2717, 2713, 2711, 2710, 161,
-	2709, 2708, 151, 2707, 2706, 2702, 2701, 200, 184, 53,
-	2700, 35, 2699, 2698, 2697, 2696, 2695, 67, 2694, 2693,
-	102, 160, 210, 1080, 216, 206, 148, 133, 66, 2692,
-	2208, 2689, 120, 188, 123, 20, 2688, 138, 2687, 115,
-	34, 28, 207, 116, 42, 117, 90, 2686, 185, 62,
-	2685, 79, 2682, 2680, 205, 154, 2679, 91, 2678, 2674,
-	2673, 2672, 164, 93, 2671, 52, 2669, 130, 88, 83,
-	86, 2666, 2664, 2663, 73, 2662, 2661, 2660, 2659, 146,
-	2658, 2656, 105, 65, 2652, 2650, 2649, 49, 190, 63,
-	2647, 50, 2646, 2645, 2644, 2643, 55, 2642, 2639, 14,
-	19, 21, 2638, 18, 2637, 2636, 1, 2635, 181, 98,
-	76, 114, 2634, 395, 2630, 2628, 2627, 126, 2626, 680,
-	2625, 2624, 2623, 2622, 2621, 22, 2620, 168, 39, 2617,
-	72, 92, 94, 172, 173, 2616, 2615, 2614, 11, 70,
-	54, 0, 2611, 118, 2610, 2609, 2608, 208, 2606, 191,
-	167, 193, 145, 218, 132, 2603, 2598, 64, 2597, 121,
-	68, 107, 81, 2596, 177, 2590, 36, 144, 2589, 171,
-	2588, 127, 2, 112, 2585, 2584, 37, 233, 2583, 2582,
-	2581, 95, 2580, 2579, 96, 104, 2576, 2572, 2571, 31,
-	2570, 29, 26, 2569, 77, 2567, 211, 2566, 159, 109,
-	149, 135, 119, 187, 189, 59, 56, 2564, 837, 111,
-	78, 24, 2562, 186, 2561, 239, 192, 2560, 183, 2559,
-	201, 282, 175, 2558, 147, 7, 33, 27, 2557, 9,
-	2556, 106, 174, 2553, 2552, 16, 2551, 17, 2550, 2549,
-	2547, 2546, 5, 2545, 2543, 2542, 3, 6, 2541, 4,
-	182, 2540, 2539, 2538, 2537, 38, 153, 2536, 124, 197,
-	2535, 2533, 71, 2532, 2531, 2530, 594, 2529, 2527, 2525,
-	2523, 2521, 2520, 2518, 2517, 2516, 2515, 75, 44, 2514,
-	2513, 2510, 2509, 57, 108, 2508, 2507, 2504, 2502, 30,
-	142, 2501, 15, 2500, 25, 23, 32, 2499, 101, 2498,
-	12, 155, 2497, 2495, 13, 2494, 2493, 10, 8, 2492,
-	2490, 89, 2489, 61, 43, 125, 84, 2487, 60, 180,
-	110, 2486, 2483, 199, 198, 170, 2474, 165, 195, 209,
-	2473, 179, 2472, 2471, 2469, 2467, 2464, 2457, 1231, 2456,
-	2455, 196, 45, 69, 80, 2454, 2451, 2450, 58, 122,
-	87, 82, 169, 2449, 163, 2447, 2446, 74, 2441, 2440,
-	2438, 2437, 2431, 156, 2429, 2427, 2424, 194, 143, 2423,
+	0, 147, 3407, 176, 179, 162, 151, 197, 195, 2672,
+	2671, 3404, 3403, 3402, 3388, 3386, 3385, 3384, 3382, 3381,
+	3380, 3379, 3378, 3377, 3376, 3375, 3373, 3372, 3371, 3370,
+	3369, 3368, 3367, 243, 3363, 3362, 3361, 3360, 3359, 3357,
+	3356, 3355, 3353, 3352, 3351, 3349, 3348, 3345, 3344, 3343,
+	3341, 3340, 3338, 3335, 3334, 3333, 3331, 3329, 3328, 3327,
+	3326, 156, 3325, 2628, 3324, 3323, 3321, 3320, 3318, 3317,
+	3316, 3315, 3314, 218, 191, 3313, 3312, 3310, 3309, 3308,
+	3307, 3306, 3305, 3304, 3303, 3302, 3288, 3286, 3285, 3281,
+	3280, 3279, 166, 187, 183, 3278, 3275, 181, 29, 3274,
+	3273, 3272, 3270, 3269, 3268, 3263, 3261, 3260, 3258, 3256,
+	3254, 3253, 21, 3252, 228, 116, 3250, 3249, 3248, 3246,
+	13, 3245, 22, 3244, 173, 32, 3243, 3242, 3241, 3240,
+	241, 222, 68, 3239, 57, 3238, 3237, 3235, 3234, 3229,
+	3226, 3225, 3224, 87, 3223, 3221, 112, 20, 254, 410,
+	266, 224, 128, 145, 85, 3220, 2649, 3219, 139, 226,
+	138, 35, 3218, 164, 3217, 140, 50, 40, 259, 129,
+	60, 136, 107, 3216, 223, 83, 3215, 98, 3214, 3191,
+	248, 178, 3187, 117, 3186, 3185, 3182, 3181, 211, 125,
+	3180, 67, 3177, 143, 244, 109, 110, 106, 3172, 3171,
+	43, 3170, 3169, 3168, 3166, 165, 3165, 3163, 124, 89,
+	3162, 3159, 3158, 64, 238, 79, 3157, 65, 3156, 3154,
+	3151, 3147, 66, 3144, 3142, 3141, 3140, 17, 30, 34,
+	3139, 28, 154, 3138, 3137, 146, 76, 2, 3136, 141,
+	175, 59, 72, 3135, 14, 3132, 27, 3129, 3126, 16,
+	3123, 5, 3122, 482, 3120, 3118, 3115, 137, 3114, 621,
+	3112, 3107, 3105, 3104, 3102, 23, 3100, 207, 58, 3096,
+	92, 123, 130, 209, 212, 3092, 3091, 3089, 219, 88,
+	77, 0, 3087, 153, 3082, 3079, 3077, 250, 3076, 233,
+	206, 231, 158, 263, 202, 3075, 3074, 81, 3073, 149,
+	91, 127, 12, 3072, 200, 3071, 638, 172, 3067, 215,
+	3066, 313, 9, 134, 3065, 3064, 54, 306, 3063, 3062,
+	3048, 118, 3047, 3046, 105, 115, 3045, 3044, 3042, 45,
+	3037, 51, 37, 3035, 84, 3034, 252, 3033, 240, 126,
+	169, 159, 132, 225, 230, 78, 69, 3032, 964, 234,
+	201, 3031, 135, 94, 31, 3030, 19, 232, 3029, 96,
+	229, 71, 3028, 39, 194, 3027, 242, 431, 217, 3025,
+	170, 10, 55, 44, 3023, 7, 3022, 122, 171, 3021,
+	3020, 24, 3019, 25, 3018, 3016, 3015, 3013, 6, 3011,
+	3009, 3008, 1, 4, 3005, 3, 216, 3003, 3002, 3001,
+	2998, 56, 168, 2997, 133, 281, 2995, 2992, 93, 2988,
+	2985, 2984, 296, 2983, 2982, 2981, 2980, 2979, 2972, 2971,
+	2970, 2969, 2968, 95, 62, 2967, 2966, 2965, 2964, 73,
+	121, 2961, 2960, 2959, 2957, 46, 155, 2956, 26, 2954,
+	38, 36, 53, 2953, 119, 2952, 15, 185, 2951, 2949,
+	18, 2948, 2942, 8, 11, 2940, 2937, 108, 2936, 80,
+	61, 144, 114, 2935, 75, 221, 131, 2934, 2933, 237,
+	245, 213, 2931, 82, 239, 251, 2929, 220, 2928, 2927,
+	2926, 2925, 2923, 2922, 1012, 2921, 2920, 235, 63, 74,
+	100, 2918, 2917, 2916, 70, 142, 102, 99, 208, 2914,
+	193, 2913, 2912, 97, 2911, 90, 2902, 2898, 2897, 189,
+	2896, 2891, 2890, 205, 249, 282, 2889, 2888,
 }
 
-//line mysql_sql.y:8952
+//line mysql_sql.y:9919
 type yySymType struct {
-	union interface{}
-	id    int
-	str   string
-	item  interface{}
-	yys   int
+	union  interface{}
+	id     int
+	str    string
+	item   interface{}
+	offset int
+	yys    int
 }
 
 func (st *yySymType) accountAuthOptionUnion() tree.AccountAuthOption {
@@ -5064,6 +6786,11 @@ func (st *yySymType) alterTableOptionsUnion() tree.AlterTableOptions {
 	return v
 }
 
+func (st *yySymType) asOfClauseUnion() *tree.AsOfClause {
+	v, _ := st.union.(*tree.AsOfClause)
+	return v
+}
+
 func (st *yySymType) assignmentUnion() *tree.Assignment {
 	v, _ := st.union.(*tree.Assignment)
 	return v
@@ -5109,6 +6836,11 @@ func (st *yySymType) columnAttributesUnion() []tree.ColumnAttribute {
 	return v
 }
 
+func (st *yySymType) columnPositionUnion() *tree.ColumnPosition {
+	v, _ := st.union.(*tree.ColumnPosition)
+	return v
+}
+
 func (st *yySymType) columnTableDefUnion() *tree.ColumnTableDef {
 	v, _ := st.union.(*tree.ColumnTableDef)
 	return v
@@ -5174,6 +6906,11 @@ func (st *yySymType) duplicateKeyUnion() tree.DuplicateKey {
 	return v
 }
 
+func (st *yySymType) elseIfStmtsUnion() []*tree.ElseIfStmt {
+	v, _ := st.union.([]*tree.ElseIfStmt)
+	return v
+}
+
 func (st *yySymType) epxlainOptionUnion() tree.OptionElem {
 	v, _ := st.union.(tree.OptionElem)
 	return v
@@ -5219,6 +6956,11 @@ func (st *yySymType) fullOptUnion() bool {
 	return v
 }
 
+func (st *yySymType) fullTextSearchTypeUnion() tree.FullTextSearchType {
+	v, _ := st.union.(tree.FullTextSearchType)
+	return v
+}
+
 func (st *yySymType) funcArgUnion() tree.FunctionArg {
 	v, _ := st.union.(tree.FunctionArg)
 	return v
@@ -5259,6 +7001,21 @@ func (st *yySymType) groupByUnion() tree.GroupBy {
 	return v
 }
 
+func (st *yySymType) handlerActionUnion() tree.HandlerAction {
+	v, _ := st.union.(tree.HandlerAction)
+	return v
+}
+
+func (st *yySymType) handlerConditionUnion() *tree.HandlerCondition {
+	v, _ := st.union.(*tree.HandlerCondition)
+	return v
+}
+
+func (st *yySymType) handlerConditionsUnion() []*tree.HandlerCondition {
+	v, _ := st.union.([]*tree.HandlerCondition)
+	return v
+}
+
 func (st *yySymType) identifierListUnion() tree.IdentifierList {
 	v, _ := st.union.(tree.IdentifierList)
 	return v
@@ -5319,6 +7076,11 @@ func (st *yySymType) int64ValUnion() int64 {
 	return v
 }
 
+func (st *yySymType) isolationLevelUnion() tree.IsolationLevel {
+	v, _ := st.union.(tree.IsolationLevel)
+	return v
+}
+
 func (st *yySymType) joinCondUnion() tree.JoinCond {
 	v, _ := st.union.(tree.JoinCond)
 	return v
@@ -5329,6 +7091,21 @@ func (st *yySymType) joinTableExprUnion() *tree.JoinTableExpr {
 	return v
 }
 
+func (st *yySymType) jsonTableColumnUnion() *tree.JsonTableColumn {
+	v, _ := st.union.(*tree.JsonTableColumn)
+	return v
+}
+
+func (st *yySymType) jsonTableColumnsUnion() []*tree.JsonTableColumn {
+	v, _ := st.union.([]*tree.JsonTableColumn)
+	return v
+}
+
+func (st *yySymType) jsonTableResponseUnion() *tree.JsonTableResponse {
+	v, _ := st.union.(*tree.JsonTableResponse)
+	return v
+}
+
 func (st *yySymType) keyPartUnion() *tree.KeyPart {
 	v, _ := st.union.(*tree.KeyPart)
 	return v
@@ -5409,6 +7186,11 @@ func (st *yySymType) objectTypeUnion() tree.ObjectType {
 	return v
 }
 
+func (st *yySymType) optimizerHintsUnion() tree.OptimizerHints {
+	v, _ := st.union.(tree.OptimizerHints)
+	return v
+}
+
 func (st *yySymType) orderUnion() *tree.Order {
 	v, _ := st.union.(*tree.Order)
 	return v
@@ -5464,6 +7246,26 @@ func (st *yySymType) privilegesUnion() []*tree.Privilege {
 	return v
 }
 
+func (st *yySymType) procedureArgUnion() *tree.ProcedureArgDecl {
+	v, _ := st.union.(*tree.ProcedureArgDecl)
+	return v
+}
+
+func (st *yySymType) procedureArgTypeUnion() tree.InOutArgType {
+	v, _ := st.union.(tree.InOutArgType)
+	return v
+}
+
+func (st *yySymType) procedureArgsUnion() tree.ProcedureArgs {
+	v, _ := st.union.(tree.ProcedureArgs)
+	return v
+}
+
+func (st *yySymType) procedureNameUnion() *tree.ProcedureName {
+	v, _ := st.union.(*tree.ProcedureName)
+	return v
+}
+
 func (st *yySymType) propertiesUnion() []tree.Property {
 	v, _ := st.union.([]tree.Property)
 	return v
@@ -5674,6 +7476,11 @@ func (st *yySymType) tupleUnion() *tree.Tuple {
 	return v
 }
 
+func (st *yySymType) txnModesUnion() tree.TransactionModes {
+	v, _ := st.union.(tree.TransactionModes)
+	return v
+}
+
 func (st *yySymType) unionTypeRecordUnion() *tree.UnionTypeRecord {
 	v, _ := st.union.(*tree.UnionTypeRecord)
 	return v
@@ -5810,312 +7617,349 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 452, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 508, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 95, 450, 450, 450, 451, 451,
-	93, 82, 94, 400, 400, 399, 399, 398, 398, 353,
-	353, 397, 397, 397, 396, 396, 396, 395, 395, 394,
-	394, 393, 393, 391, 391, 392, 390, 389, 389, 389,
-	387, 387, 387, 383, 383, 385, 384, 384, 386, 378,
-	378, 381, 381, 379, 379, 379, 379, 382, 377, 377,
-	377, 376, 376, 81, 81, 81, 300, 300, 80, 80,
-	314, 314, 314, 314, 314, 312, 312, 312, 312, 312,
-	312, 311, 311, 310, 310, 315, 315, 313, 313, 313,
-	313, 313, 313, 313, 313, 313, 313, 313, 313, 313,
-	313, 313, 313, 313, 313, 313, 313, 313, 313, 313,
-	313, 313, 313, 313, 313, 313, 313, 313, 313, 313,
-	313, 313, 313, 313, 313, 313, 313, 313, 313, 313,
-	313, 313, 313, 313, 313, 313, 313, 72, 72, 72,
-	72, 75, 75, 75, 76, 309, 309, 309, 73, 74,
-	74, 299, 299, 304, 304, 303, 303, 303, 303, 303,
-	303, 303, 303, 303, 303, 303, 303, 308, 308, 308,
-	306, 306, 305, 305, 307, 307, 66, 66, 66, 69,
-	68, 298, 298, 298, 298, 298, 298, 298, 298, 298,
-	67, 67, 67, 67, 67, 67, 62, 62, 62, 62,
-	62, 61, 61, 63, 63, 296, 296, 295, 77, 77,
-	78, 454, 454, 453, 455, 455, 455, 455, 79, 85,
-	85, 85, 85, 85, 85, 85, 84, 84, 87, 87,
-	86, 88, 71, 71, 71, 71, 71, 70, 70, 70,
-	70, 70, 70, 70, 70, 70, 424, 424, 424, 426,
-	426, 235, 236, 456, 238, 234, 234, 234, 420, 420,
-	421, 422, 423, 423, 423, 83, 7, 7, 7, 7,
-	7, 7, 60, 65, 194, 194, 194, 195, 195, 195,
-	195, 195, 58, 64, 64, 437, 437, 59, 444, 444,
-	356, 356, 249, 249, 248, 248, 248, 248, 248, 248,
-	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
-	360, 361, 245, 33, 33, 33, 33, 33, 33, 33,
+	1, 1, 1, 1, 1, 128, 506, 506, 506, 507,
+	507, 126, 85, 127, 456, 456, 455, 455, 454, 454,
+	409, 409, 453, 453, 453, 452, 452, 452, 451, 451,
+	450, 450, 449, 449, 447, 447, 448, 446, 445, 445,
+	445, 443, 443, 443, 439, 439, 441, 440, 440, 442,
+	434, 434, 437, 437, 435, 435, 435, 435, 438, 433,
+	433, 433, 432, 432, 84, 84, 84, 353, 353, 83,
+	83, 370, 370, 370, 370, 370, 368, 368, 368, 368,
+	368, 368, 367, 367, 366, 366, 371, 371, 369, 369,
+	369, 369, 369, 369, 369, 369, 369, 369, 369, 369,
+	369, 369, 369, 369, 369, 369, 369, 369, 369, 369,
+	369, 369, 369, 369, 369, 369, 369, 369, 369, 369,
+	369, 369, 369, 369, 369, 369, 369, 369, 369, 369,
+	369, 369, 369, 369, 369, 369, 369, 369, 74, 74,
+	74, 74, 74, 79, 79, 79, 77, 77, 77, 78,
+	365, 365, 365, 75, 76, 76, 352, 352, 358, 358,
+	357, 357, 357, 357, 357, 357, 357, 357, 357, 357,
+	357, 357, 364, 364, 364, 360, 360, 359, 359, 362,
+	362, 361, 361, 363, 363, 66, 66, 66, 66, 66,
+	69, 69, 69, 69, 69, 70, 71, 68, 348, 348,
+	348, 348, 348, 348, 348, 348, 348, 67, 67, 67,
+	67, 349, 349, 350, 350, 350, 350, 351, 351, 351,
+	351, 62, 62, 62, 62, 62, 61, 61, 63, 63,
+	346, 346, 345, 80, 80, 81, 510, 510, 509, 511,
+	511, 511, 511, 82, 88, 88, 88, 88, 88, 88,
+	88, 87, 87, 90, 90, 89, 91, 73, 73, 73,
+	73, 73, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 480, 480, 480, 482, 482, 285, 286, 512, 288,
+	284, 284, 284, 476, 476, 477, 478, 479, 479, 479,
+	86, 86, 7, 7, 7, 7, 7, 7, 60, 65,
+	65, 233, 233, 232, 232, 232, 232, 232, 232, 232,
+	513, 513, 236, 236, 235, 235, 234, 234, 234, 234,
+	234, 58, 64, 64, 493, 493, 59, 500, 500, 412,
+	412, 299, 299, 298, 298, 298, 298, 298, 298, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 416,
+	417, 295, 33, 33, 33, 33, 33, 33, 33, 33,
 	33, 33, 33, 33, 33, 33, 33, 33, 33, 33,
-	33, 33, 33, 33, 33, 33, 33, 33, 40, 39,
-	39, 39, 285, 285, 38, 457, 457, 224, 224, 48,
-	49, 50, 51, 52, 53, 54, 37, 47, 47, 47,
-	47, 47, 47, 47, 47, 47, 57, 57, 372, 372,
-	459, 459, 459, 55, 56, 355, 355, 355, 46, 45,
-	44, 43, 42, 42, 36, 36, 35, 35, 41, 105,
-	106, 242, 242, 242, 244, 244, 240, 458, 458, 327,
-	327, 243, 243, 34, 34, 34, 34, 241, 241, 223,
-	239, 239, 239, 8, 8, 6, 11, 11, 11, 11,
-	11, 11, 11, 11, 11, 11, 11, 18, 19, 21,
-	293, 293, 290, 20, 14, 13, 16, 12, 15, 17,
-	5, 5, 5, 5, 9, 9, 10, 117, 117, 159,
-	159, 432, 432, 428, 428, 429, 429, 429, 430, 430,
-	431, 431, 89, 366, 366, 366, 366, 366, 366, 4,
-	140, 140, 139, 139, 365, 365, 365, 365, 365, 365,
-	297, 297, 409, 409, 409, 410, 138, 138, 133, 133,
-	367, 367, 263, 411, 411, 375, 375, 374, 374, 373,
-	373, 136, 136, 137, 137, 120, 120, 98, 98, 380,
-	380, 380, 380, 388, 388, 352, 352, 186, 186, 218,
-	218, 219, 219, 110, 110, 111, 111, 111, 111, 111,
-	111, 417, 417, 419, 419, 418, 135, 135, 131, 131,
-	132, 132, 132, 130, 130, 129, 128, 128, 127, 125,
-	125, 125, 126, 126, 126, 113, 113, 113, 112, 112,
-	112, 112, 112, 203, 203, 203, 203, 203, 203, 203,
-	203, 203, 203, 203, 203, 114, 114, 425, 425, 425,
-	357, 357, 357, 363, 363, 200, 200, 201, 201, 199,
-	199, 115, 115, 116, 116, 116, 116, 198, 198, 197,
-	118, 118, 124, 123, 123, 119, 119, 119, 119, 208,
-	208, 207, 207, 207, 207, 92, 96, 96, 97, 143,
-	143, 206, 205, 205, 205, 142, 142, 141, 141, 134,
-	134, 122, 122, 122, 122, 204, 121, 202, 449, 449,
-	448, 448, 447, 445, 445, 445, 446, 446, 446, 446,
-	402, 402, 402, 402, 402, 229, 229, 229, 233, 233,
-	232, 232, 232, 232, 232, 237, 3, 3, 3, 3,
-	3, 25, 25, 25, 25, 25, 25, 25, 31, 151,
-	152, 30, 144, 144, 145, 145, 146, 146, 147, 148,
-	148, 148, 150, 149, 29, 22, 433, 436, 434, 434,
-	438, 438, 438, 439, 439, 439, 440, 440, 23, 102,
-	107, 107, 104, 109, 109, 109, 109, 109, 103, 435,
-	441, 441, 441, 294, 294, 291, 292, 292, 289, 288,
-	288, 288, 443, 443, 442, 442, 442, 230, 230, 24,
-	284, 284, 286, 287, 287, 287, 278, 278, 278, 278,
-	28, 282, 282, 283, 283, 283, 283, 283, 279, 279,
-	281, 281, 277, 277, 277, 277, 277, 27, 108, 108,
-	276, 276, 274, 274, 272, 272, 273, 273, 271, 271,
-	271, 275, 275, 26, 26, 26, 100, 99, 99, 99,
-	221, 221, 220, 220, 101, 32, 171, 171, 341, 341,
-	341, 341, 341, 359, 359, 359, 342, 342, 342, 343,
-	343, 343, 344, 344, 344, 344, 344, 358, 358, 316,
-	316, 317, 317, 317, 320, 320, 333, 333, 334, 334,
-	332, 332, 339, 339, 338, 338, 337, 337, 336, 336,
-	335, 335, 335, 335, 330, 330, 329, 329, 318, 318,
-	318, 318, 318, 319, 319, 319, 328, 328, 331, 331,
-	177, 177, 178, 178, 178, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
-	196, 196, 196, 196, 407, 407, 408, 180, 180, 180,
-	184, 184, 184, 184, 184, 184, 179, 179, 181, 181,
-	160, 160, 158, 158, 153, 153, 154, 154, 155, 155,
-	156, 156, 157, 157, 157, 157, 157, 157, 302, 302,
-	405, 405, 406, 406, 401, 401, 401, 404, 404, 404,
-	404, 404, 403, 403, 161, 216, 216, 216, 231, 231,
-	231, 231, 215, 215, 215, 176, 176, 175, 175, 173,
-	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
-	173, 173, 173, 173, 301, 301, 246, 246, 247, 247,
-	193, 192, 192, 192, 192, 192, 190, 191, 189, 189,
-	189, 189, 189, 188, 188, 187, 187, 187, 280, 280,
-	185, 185, 183, 183, 183, 182, 182, 182, 340, 252,
-	252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
-	252, 252, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 214, 255, 255, 260, 260, 416, 416,
-	415, 162, 162, 162, 163, 163, 163, 163, 163, 163,
-	163, 163, 163, 172, 172, 172, 325, 325, 325, 325,
-	325, 326, 326, 326, 323, 323, 324, 324, 264, 265,
-	265, 364, 364, 321, 321, 322, 213, 213, 213, 213,
-	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
-	213, 213, 213, 371, 371, 371, 210, 210, 210, 210,
-	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
-	210, 210, 427, 427, 427, 412, 412, 412, 413, 413,
-	413, 413, 413, 413, 413, 413, 413, 413, 413, 413,
-	414, 414, 414, 414, 414, 414, 414, 414, 414, 414,
-	414, 414, 414, 414, 414, 414, 414, 212, 212, 212,
-	211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
-	211, 211, 211, 266, 266, 267, 267, 368, 368, 368,
-	368, 368, 368, 369, 369, 370, 370, 370, 370, 362,
-	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
-	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
-	362, 362, 362, 362, 362, 362, 362, 362, 253, 209,
-	209, 209, 268, 261, 261, 262, 262, 256, 256, 256,
-	256, 256, 256, 256, 258, 258, 258, 258, 258, 258,
-	258, 258, 258, 258, 258, 251, 251, 251, 251, 251,
-	251, 251, 251, 251, 251, 251, 257, 257, 259, 259,
-	270, 270, 270, 269, 269, 269, 269, 269, 269, 269,
-	174, 174, 174, 174, 250, 250, 250, 250, 250, 250,
-	250, 250, 250, 250, 250, 164, 164, 164, 164, 168,
-	168, 170, 170, 170, 170, 170, 170, 170, 170, 170,
-	170, 170, 170, 170, 170, 169, 169, 169, 169, 167,
-	167, 167, 167, 167, 165, 165, 165, 165, 165, 165,
-	165, 165, 165, 165, 165, 165, 165, 165, 165, 165,
-	90, 91, 91, 166, 217, 217, 345, 345, 348, 348,
-	346, 346, 347, 349, 349, 349, 350, 350, 350, 351,
-	351, 351, 354, 354, 222, 222, 222, 228, 228, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
-	225, 225, 225, 225, 225, 225, 225, 225, 225, 225,
-	225, 225, 225, 225, 225, 225, 225, 225, 225, 225,
-	225, 225, 225, 225, 225, 225, 225, 225, 225, 225,
-	225, 225, 225, 225, 225, 225, 225, 225, 225, 225,
-	225,
+	33, 33, 33, 33, 33, 33, 33, 40, 39, 39,
+	39, 335, 335, 38, 514, 514, 274, 274, 48, 49,
+	50, 51, 52, 53, 54, 37, 47, 47, 47, 47,
+	47, 47, 47, 47, 47, 57, 57, 428, 428, 516,
+	516, 516, 55, 56, 411, 411, 411, 46, 45, 44,
+	43, 42, 42, 36, 36, 35, 35, 41, 141, 142,
+	292, 292, 292, 294, 294, 290, 515, 515, 383, 383,
+	293, 293, 34, 34, 34, 34, 291, 291, 273, 289,
+	289, 289, 8, 8, 6, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 11, 136, 18,
+	19, 21, 343, 343, 340, 20, 14, 13, 16, 12,
+	15, 17, 5, 5, 5, 5, 9, 9, 10, 153,
+	153, 195, 195, 488, 488, 484, 484, 485, 485, 485,
+	486, 486, 487, 487, 92, 422, 422, 422, 422, 422,
+	422, 4, 176, 176, 175, 175, 421, 421, 421, 421,
+	421, 421, 347, 347, 465, 465, 465, 466, 174, 174,
+	169, 169, 423, 423, 313, 467, 467, 431, 431, 430,
+	430, 429, 429, 172, 172, 173, 173, 156, 156, 131,
+	131, 436, 436, 436, 436, 444, 444, 408, 408, 222,
+	222, 268, 268, 269, 269, 146, 146, 147, 147, 147,
+	147, 147, 147, 473, 473, 475, 475, 474, 171, 171,
+	167, 167, 168, 168, 168, 166, 166, 165, 164, 164,
+	163, 161, 161, 161, 162, 162, 162, 149, 149, 149,
+	148, 148, 148, 148, 148, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 150, 150, 137,
+	137, 124, 124, 481, 481, 481, 413, 413, 413, 419,
+	419, 241, 241, 242, 242, 242, 243, 243, 246, 246,
+	246, 246, 245, 245, 244, 244, 240, 240, 151, 151,
+	152, 152, 152, 152, 239, 239, 238, 154, 154, 160,
+	159, 159, 155, 155, 155, 155, 258, 258, 257, 257,
+	257, 257, 125, 129, 129, 130, 179, 179, 256, 255,
+	255, 255, 178, 178, 177, 177, 170, 170, 158, 158,
+	158, 158, 254, 157, 157, 251, 251, 249, 249, 249,
+	249, 249, 250, 250, 252, 252, 252, 247, 247, 248,
+	248, 505, 505, 504, 504, 503, 501, 501, 501, 502,
+	502, 502, 502, 458, 458, 458, 458, 458, 279, 279,
+	279, 283, 283, 282, 282, 282, 282, 282, 287, 3,
+	3, 3, 3, 3, 25, 25, 25, 25, 25, 25,
+	25, 25, 25, 135, 31, 187, 188, 30, 180, 180,
+	181, 181, 182, 182, 183, 184, 184, 184, 186, 185,
+	29, 22, 489, 492, 490, 490, 494, 494, 494, 495,
+	495, 495, 496, 496, 23, 138, 143, 143, 140, 145,
+	145, 145, 145, 145, 139, 491, 497, 497, 497, 344,
+	344, 341, 342, 342, 339, 338, 338, 338, 499, 499,
+	498, 498, 498, 280, 280, 24, 334, 334, 336, 337,
+	337, 337, 328, 328, 328, 328, 28, 332, 332, 333,
+	333, 333, 333, 333, 329, 329, 331, 331, 327, 327,
+	327, 327, 327, 27, 144, 144, 326, 326, 324, 324,
+	322, 322, 323, 323, 321, 321, 321, 325, 325, 26,
+	26, 26, 133, 132, 132, 132, 271, 271, 270, 270,
+	134, 32, 207, 207, 397, 397, 397, 397, 397, 415,
+	415, 415, 398, 398, 398, 399, 399, 399, 400, 400,
+	400, 400, 400, 414, 414, 372, 372, 373, 373, 373,
+	376, 376, 389, 389, 390, 390, 388, 388, 395, 395,
+	394, 394, 393, 393, 392, 392, 391, 391, 391, 391,
+	386, 386, 385, 385, 374, 374, 374, 374, 374, 375,
+	375, 375, 384, 384, 387, 387, 213, 213, 214, 214,
+	214, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	463, 463, 464, 216, 216, 216, 220, 220, 220, 220,
+	220, 220, 215, 215, 217, 217, 196, 196, 194, 194,
+	189, 189, 190, 190, 191, 191, 192, 192, 193, 193,
+	193, 193, 193, 193, 355, 355, 461, 461, 462, 462,
+	457, 457, 457, 460, 460, 460, 460, 460, 459, 459,
+	197, 266, 266, 266, 281, 281, 281, 281, 265, 265,
+	265, 212, 212, 211, 211, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 356, 356, 356, 354, 354, 296, 296,
+	297, 297, 231, 230, 230, 230, 230, 230, 228, 229,
+	227, 227, 227, 227, 227, 225, 225, 224, 224, 224,
+	330, 330, 221, 221, 223, 223, 219, 219, 219, 218,
+	218, 218, 396, 302, 302, 302, 302, 302, 302, 302,
+	302, 302, 302, 302, 302, 302, 304, 304, 304, 304,
+	304, 304, 304, 304, 304, 304, 304, 304, 304, 304,
+	304, 304, 304, 304, 304, 304, 304, 304, 304, 304,
+	304, 226, 226, 226, 226, 226, 264, 264, 305, 305,
+	310, 310, 472, 472, 471, 198, 198, 198, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 208, 208, 208,
+	381, 381, 381, 381, 381, 382, 382, 382, 379, 379,
+	380, 380, 314, 315, 315, 420, 420, 377, 377, 378,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 427, 427, 427,
+	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
+	260, 260, 260, 260, 260, 260, 483, 483, 483, 468,
+	468, 468, 469, 469, 469, 469, 469, 469, 469, 469,
+	469, 469, 469, 469, 470, 470, 470, 470, 470, 470,
+	470, 470, 470, 470, 470, 470, 470, 470, 470, 470,
+	470, 262, 262, 262, 261, 261, 261, 261, 261, 261,
+	261, 261, 261, 261, 261, 261, 261, 261, 316, 316,
+	317, 317, 424, 424, 424, 424, 424, 424, 425, 425,
+	426, 426, 426, 426, 418, 418, 418, 418, 418, 418,
+	418, 418, 418, 418, 418, 418, 418, 418, 418, 418,
+	418, 418, 418, 418, 418, 418, 418, 418, 418, 418,
+	418, 418, 418, 303, 259, 259, 259, 318, 311, 311,
+	312, 312, 306, 306, 306, 306, 306, 306, 306, 308,
+	308, 308, 308, 308, 308, 308, 308, 308, 308, 308,
+	301, 301, 301, 301, 301, 301, 301, 301, 301, 301,
+	301, 301, 307, 307, 309, 309, 320, 320, 320, 319,
+	319, 319, 319, 319, 319, 319, 210, 210, 210, 210,
+	300, 300, 300, 300, 300, 300, 300, 300, 300, 300,
+	300, 200, 200, 200, 200, 204, 204, 206, 206, 206,
+	206, 206, 206, 206, 206, 206, 206, 206, 206, 206,
+	206, 205, 205, 205, 205, 203, 203, 203, 203, 203,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 93, 94, 94, 97,
+	97, 95, 96, 114, 114, 116, 116, 117, 117, 115,
+	118, 118, 118, 118, 112, 112, 98, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 120, 120, 100, 100, 101, 119, 119,
+	113, 113, 102, 102, 103, 103, 104, 104, 105, 106,
+	107, 108, 121, 121, 123, 123, 122, 122, 122, 122,
+	122, 122, 109, 110, 517, 517, 517, 111, 202, 267,
+	267, 401, 401, 404, 404, 402, 402, 403, 405, 405,
+	405, 406, 406, 406, 407, 407, 407, 410, 410, 272,
+	272, 272, 278, 278, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 277, 277, 277, 277, 277, 277, 277,
+	277, 277, 277, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 275, 275, 275, 275, 275, 275, 275,
+	275, 275, 275, 275, 275, 275, 275, 275, 275, 275,
+	275, 275, 275, 275, 275, 275, 275, 275, 275, 275,
+	275, 275, 275, 275, 275, 275, 275, 275, 275, 275,
+	275, 275, 275, 275,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 4, 0, 1, 1, 0, 1,
-	10, 10, 2, 0, 2, 1, 3, 3, 3, 0,
-	2, 1, 3, 5, 0, 2, 3, 1, 3, 1,
-	1, 1, 3, 1, 1, 1, 1, 0, 3, 3,
-	0, 3, 3, 0, 1, 3, 0, 1, 3, 0,
-	2, 1, 2, 3, 4, 3, 3, 1, 0, 1,
-	1, 0, 1, 8, 5, 7, 0, 3, 8, 5,
-	1, 3, 3, 3, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 1, 4, 1, 3, 1, 2, 2,
-	2, 2, 2, 2, 1, 2, 2, 2, 2, 1,
-	1, 2, 2, 1, 1, 1, 1, 1, 2, 2,
-	2, 1, 2, 1, 2, 2, 1, 2, 1, 1,
-	2, 2, 1, 1, 1, 3, 2, 2, 2, 2,
-	2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 3, 4, 4, 6, 1, 1, 1, 2, 4,
-	6, 1, 4, 1, 3, 3, 4, 4, 4, 3,
-	3, 2, 4, 4, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 1, 3, 1, 1, 1, 2,
-	2, 0, 1, 4, 2, 4, 1, 5, 3, 2,
-	1, 2, 2, 4, 4, 5, 2, 1, 3, 4,
-	4, 1, 2, 9, 7, 1, 3, 3, 1, 1,
-	3, 1, 3, 2, 1, 2, 1, 2, 2, 1,
-	1, 1, 1, 1, 1, 1, 4, 4, 2, 4,
-	3, 3, 1, 1, 1, 1, 1, 2, 3, 4,
-	7, 2, 3, 3, 4, 5, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	2, 1, 1, 1, 1, 6, 1, 1, 1, 1,
-	1, 1, 7, 4, 2, 2, 1, 2, 2, 2,
-	3, 2, 7, 7, 8, 0, 4, 7, 0, 3,
-	0, 2, 0, 1, 1, 1, 1, 4, 2, 2,
-	3, 3, 4, 5, 3, 4, 4, 2, 2, 2,
-	3, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 0, 4, 0, 1, 1, 0,
+	1, 10, 10, 2, 0, 2, 1, 3, 3, 3,
+	0, 2, 1, 3, 5, 0, 2, 3, 1, 3,
+	1, 1, 1, 3, 1, 1, 1, 1, 0, 3,
+	3, 0, 3, 3, 0, 1, 3, 0, 1, 3,
+	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
+	1, 1, 0, 1, 8, 5, 7, 0, 3, 8,
+	5, 1, 3, 3, 3, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 1, 4, 1, 3, 1, 2,
+	2, 2, 2, 2, 2, 1, 2, 2, 2, 2,
+	1, 1, 2, 2, 1, 1, 1, 1, 1, 2,
+	2, 2, 1, 2, 1, 2, 2, 1, 2, 1,
+	1, 2, 2, 1, 1, 1, 3, 2, 2, 2,
+	2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 4, 4, 3, 4, 4, 6,
+	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
+	3, 4, 4, 4, 3, 3, 2, 4, 4, 2,
+	2, 2, 1, 1, 1, 1, 1, 1, 3, 1,
+	3, 1, 1, 1, 3, 1, 1, 1, 1, 1,
+	2, 3, 4, 4, 5, 2, 3, 2, 0, 1,
+	4, 2, 4, 1, 5, 3, 2, 1, 2, 2,
+	3, 1, 3, 2, 2, 3, 3, 2, 2, 2,
+	1, 2, 1, 3, 4, 4, 1, 2, 9, 7,
+	1, 3, 3, 1, 1, 3, 1, 3, 2, 1,
+	2, 1, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 4, 4, 2, 4, 3, 3, 1, 1, 1,
+	1, 1, 2, 3, 4, 7, 2, 3, 3, 4,
+	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 3, 2, 1, 1, 1, 1,
+	6, 3, 1, 1, 1, 1, 1, 1, 7, 4,
+	4, 1, 3, 2, 3, 4, 4, 5, 5, 2,
+	0, 1, 0, 1, 1, 2, 2, 2, 2, 3,
+	2, 7, 7, 8, 0, 4, 7, 0, 3, 0,
+	2, 0, 1, 1, 1, 1, 4, 2, 2, 3,
+	3, 4, 5, 3, 4, 4, 2, 2, 2, 3,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 4, 2,
-	5, 5, 0, 2, 7, 0, 1, 0, 1, 5,
-	3, 2, 4, 4, 4, 4, 4, 1, 1, 1,
-	3, 2, 3, 1, 1, 1, 6, 8, 0, 1,
-	1, 1, 1, 5, 5, 0, 1, 1, 3, 3,
-	3, 4, 6, 7, 4, 4, 7, 8, 3, 3,
-	3, 0, 2, 2, 0, 2, 2, 1, 1, 1,
-	1, 0, 1, 4, 4, 5, 4, 1, 3, 1,
-	1, 3, 5, 2, 3, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 4, 4, 4,
-	1, 3, 1, 4, 6, 4, 4, 4, 3, 6,
-	1, 1, 2, 2, 11, 8, 9, 1, 3, 2,
-	4, 0, 2, 0, 1, 1, 1, 1, 0, 1,
-	0, 1, 4, 2, 1, 5, 4, 4, 2, 5,
-	0, 2, 1, 3, 2, 1, 5, 4, 4, 2,
-	0, 5, 0, 1, 3, 3, 1, 3, 1, 3,
-	1, 3, 4, 0, 1, 0, 1, 1, 3, 1,
-	1, 0, 4, 1, 3, 2, 1, 0, 8, 0,
-	4, 7, 4, 0, 2, 0, 2, 0, 2, 0,
-	4, 1, 3, 1, 1, 4, 3, 4, 5, 4,
-	5, 2, 3, 1, 3, 6, 0, 3, 0, 1,
-	2, 4, 4, 0, 1, 3, 1, 3, 3, 0,
-	1, 1, 0, 2, 2, 3, 3, 3, 1, 3,
-	3, 3, 3, 1, 2, 2, 1, 2, 2, 1,
-	2, 2, 1, 2, 2, 7, 7, 1, 1, 1,
-	0, 1, 1, 1, 1, 0, 2, 0, 3, 0,
-	2, 1, 3, 1, 2, 3, 5, 0, 1, 2,
-	1, 3, 1, 1, 1, 4, 4, 4, 3, 2,
-	2, 2, 3, 2, 3, 4, 1, 3, 4, 0,
-	2, 1, 1, 2, 2, 0, 1, 2, 4, 1,
-	3, 1, 3, 2, 3, 1, 4, 3, 0, 1,
-	1, 2, 5, 2, 2, 2, 0, 2, 3, 3,
-	0, 1, 3, 1, 3, 0, 1, 2, 1, 1,
-	0, 1, 2, 1, 2, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 7, 1,
-	1, 12, 1, 3, 0, 1, 1, 3, 1, 1,
-	2, 4, 1, 1, 7, 7, 1, 4, 1, 1,
-	3, 4, 3, 0, 1, 1, 0, 2, 7, 8,
-	0, 2, 6, 0, 2, 2, 3, 3, 4, 1,
-	0, 2, 2, 1, 3, 2, 1, 3, 2, 1,
-	3, 2, 0, 1, 3, 4, 3, 1, 1, 4,
-	1, 3, 1, 1, 1, 1, 0, 1, 1, 1,
-	11, 0, 2, 3, 2, 3, 1, 1, 1, 3,
-	3, 4, 0, 2, 2, 2, 2, 6, 0, 4,
-	1, 1, 0, 3, 0, 1, 1, 2, 4, 4,
-	4, 0, 1, 11, 9, 11, 2, 2, 4, 5,
-	1, 3, 0, 3, 5, 10, 0, 2, 0, 3,
-	2, 4, 3, 0, 2, 1, 0, 2, 3, 0,
-	2, 3, 0, 3, 2, 4, 3, 0, 1, 0,
-	6, 0, 3, 5, 0, 4, 0, 3, 1, 3,
-	4, 5, 0, 3, 1, 3, 2, 3, 1, 2,
-	0, 4, 6, 5, 0, 2, 0, 2, 4, 5,
-	4, 5, 1, 5, 6, 5, 0, 3, 0, 1,
-	0, 1, 1, 3, 2, 3, 3, 4, 4, 3,
-	3, 3, 3, 4, 4, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 4, 5, 4, 1, 3, 3, 0, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 4, 2, 5,
+	5, 0, 2, 7, 0, 1, 0, 1, 5, 3,
+	2, 4, 4, 4, 4, 4, 1, 1, 1, 3,
+	2, 3, 1, 1, 1, 6, 8, 0, 1, 1,
+	1, 1, 5, 5, 0, 1, 1, 3, 3, 3,
+	4, 6, 7, 4, 4, 7, 8, 3, 3, 3,
+	0, 2, 2, 0, 2, 2, 1, 1, 1, 1,
+	0, 1, 4, 4, 5, 4, 1, 3, 1, 1,
+	3, 5, 2, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 6, 4,
+	4, 4, 1, 3, 1, 4, 6, 4, 4, 4,
+	3, 6, 1, 1, 2, 2, 11, 8, 9, 1,
+	3, 2, 4, 0, 2, 0, 1, 1, 1, 1,
+	0, 1, 0, 1, 4, 2, 1, 5, 4, 4,
+	2, 5, 0, 2, 1, 3, 2, 1, 5, 4,
+	4, 2, 0, 5, 0, 1, 3, 3, 1, 3,
+	1, 3, 1, 3, 4, 0, 1, 0, 1, 1,
+	3, 1, 1, 0, 4, 1, 3, 2, 1, 0,
+	8, 0, 4, 7, 4, 0, 2, 0, 2, 0,
+	2, 0, 4, 1, 3, 1, 1, 4, 3, 4,
+	5, 4, 5, 2, 3, 1, 3, 6, 0, 3,
+	0, 1, 2, 4, 4, 0, 1, 3, 1, 3,
+	3, 0, 1, 1, 0, 2, 2, 3, 3, 3,
+	1, 3, 3, 3, 3, 1, 2, 2, 1, 2,
+	2, 1, 2, 2, 1, 2, 2, 9, 9, 0,
+	1, 0, 2, 1, 1, 1, 0, 1, 1, 1,
+	1, 0, 2, 0, 3, 5, 1, 3, 1, 4,
+	4, 5, 1, 3, 2, 1, 0, 2, 1, 3,
+	1, 2, 3, 5, 0, 1, 2, 1, 3, 1,
+	1, 1, 4, 4, 4, 3, 2, 2, 2, 3,
+	2, 3, 4, 1, 3, 4, 0, 2, 1, 1,
+	2, 2, 0, 1, 2, 4, 1, 3, 1, 3,
+	2, 3, 1, 4, 10, 1, 3, 3, 5, 5,
+	7, 6, 0, 4, 1, 1, 2, 3, 4, 4,
+	4, 0, 1, 1, 2, 5, 2, 2, 2, 0,
+	2, 3, 3, 0, 1, 3, 1, 3, 0, 1,
+	2, 1, 1, 0, 1, 2, 1, 2, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 3, 0, 1, 1, 3, 1, 1,
-	2, 1, 7, 7, 7, 7, 8, 5, 0, 1,
-	0, 1, 1, 1, 1, 3, 3, 1, 1, 1,
-	1, 1, 0, 1, 3, 1, 3, 5, 1, 1,
-	1, 1, 1, 3, 5, 0, 1, 1, 2, 1,
-	2, 2, 1, 1, 2, 2, 2, 2, 2, 1,
-	5, 6, 4, 1, 1, 2, 0, 1, 1, 2,
-	5, 0, 1, 1, 2, 2, 3, 3, 1, 1,
-	2, 2, 2, 0, 1, 2, 2, 2, 0, 3,
-	0, 3, 1, 1, 1, 1, 1, 1, 1, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 1, 1, 1, 1, 3, 5, 2, 2, 2,
-	2, 1, 1, 2, 5, 6, 6, 6, 1, 1,
-	1, 1, 1, 4, 0, 2, 0, 1, 1, 2,
-	4, 1, 2, 2, 1, 2, 2, 1, 2, 2,
-	2, 2, 2, 0, 1, 1, 2, 2, 2, 2,
-	2, 1, 1, 1, 2, 5, 0, 1, 3, 0,
-	1, 0, 2, 0, 1, 6, 8, 6, 5, 5,
-	6, 6, 6, 6, 5, 6, 6, 6, 6, 6,
-	6, 6, 6, 1, 1, 1, 4, 4, 6, 8,
-	6, 4, 5, 4, 4, 4, 3, 4, 6, 6,
-	7, 4, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 5, 7, 1, 1, 12, 1, 3,
+	0, 1, 1, 3, 1, 1, 2, 4, 1, 1,
+	7, 7, 1, 4, 1, 1, 3, 4, 3, 0,
+	1, 1, 0, 2, 7, 8, 0, 2, 6, 0,
+	2, 2, 3, 3, 4, 1, 0, 2, 2, 1,
+	3, 2, 1, 3, 2, 1, 3, 2, 0, 1,
+	3, 4, 3, 1, 1, 4, 1, 3, 1, 1,
+	1, 1, 0, 1, 1, 1, 11, 0, 2, 3,
+	2, 3, 1, 1, 1, 3, 3, 4, 0, 2,
+	2, 2, 2, 6, 0, 4, 1, 1, 0, 3,
+	0, 1, 1, 2, 4, 4, 4, 0, 1, 11,
+	9, 11, 2, 2, 4, 5, 1, 3, 0, 3,
+	5, 10, 0, 2, 0, 3, 2, 4, 3, 0,
+	2, 1, 0, 2, 3, 0, 2, 3, 0, 3,
+	2, 4, 3, 0, 1, 0, 6, 0, 3, 5,
+	0, 4, 0, 3, 1, 3, 4, 5, 0, 3,
+	1, 3, 2, 3, 1, 2, 0, 4, 6, 5,
+	0, 2, 0, 2, 4, 5, 4, 5, 1, 5,
+	6, 5, 0, 3, 0, 1, 0, 1, 1, 3,
+	2, 3, 3, 4, 4, 3, 3, 3, 3, 4,
+	4, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 4, 5, 4,
+	1, 3, 3, 0, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 3,
+	0, 1, 1, 3, 1, 1, 2, 1, 7, 7,
+	7, 7, 8, 5, 0, 1, 0, 1, 1, 1,
+	1, 3, 3, 1, 1, 1, 1, 1, 0, 1,
+	3, 1, 3, 5, 1, 1, 1, 1, 1, 3,
+	5, 0, 1, 1, 2, 1, 2, 2, 1, 1,
+	2, 2, 2, 2, 3, 2, 1, 5, 6, 4,
+	1, 5, 7, 0, 1, 1, 1, 2, 0, 1,
+	1, 2, 5, 0, 1, 1, 2, 2, 3, 3,
+	1, 1, 2, 2, 2, 0, 1, 2, 2, 2,
+	0, 3, 0, 3, 0, 2, 1, 1, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 1, 1, 1, 1, 3,
+	3, 3, 5, 2, 2, 2, 2, 1, 1, 2,
+	5, 6, 7, 6, 6, 1, 1, 1, 1, 1,
+	9, 0, 4, 7, 3, 3, 4, 5, 0, 2,
+	0, 1, 1, 2, 4, 1, 2, 2, 1, 2,
+	2, 1, 2, 2, 2, 2, 2, 0, 1, 1,
+	2, 2, 2, 2, 2, 1, 1, 1, 2, 5,
+	0, 1, 3, 0, 1, 0, 2, 0, 1, 6,
+	8, 6, 5, 5, 6, 6, 6, 6, 5, 6,
+	6, 6, 6, 6, 6, 6, 6, 1, 1, 1,
+	4, 4, 6, 8, 6, 4, 5, 4, 4, 4,
+	3, 4, 6, 6, 7, 4, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 8, 4, 4, 2, 3, 2, 4,
+	4, 6, 2, 2, 4, 6, 4, 2, 0, 1,
+	2, 3, 1, 1, 1, 1, 1, 1, 0, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 8,
-	4, 2, 3, 2, 4, 4, 6, 2, 2, 4,
-	6, 4, 2, 0, 1, 2, 3, 1, 1, 1,
-	1, 1, 1, 0, 2, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 0, 1, 1, 3, 0, 1,
+	1, 3, 3, 3, 3, 3, 2, 1, 1, 3,
+	4, 3, 4, 3, 4, 3, 4, 3, 4, 1,
+	3, 4, 4, 5, 4, 5, 3, 4, 6, 5,
+	6, 1, 0, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
-	1, 1, 3, 0, 1, 1, 3, 3, 3, 3,
-	3, 2, 1, 1, 3, 4, 3, 4, 3, 4,
-	3, 4, 3, 4, 1, 3, 4, 4, 5, 4,
-	5, 3, 4, 5, 6, 1, 0, 2, 1, 1,
+	2, 3, 1, 1, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 1, 2, 2, 2, 2,
+	2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 4, 4, 1, 2, 3, 5, 2,
+	5, 7, 4, 1, 3, 0, 1, 1, 3, 3,
+	0, 1, 1, 1, 0, 3, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 3, 1, 1, 1, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 2, 2, 1,
-	2, 2, 2, 2, 2, 2, 2, 2, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 4, 4, 1,
-	2, 3, 5, 1, 1, 3, 0, 1, 0, 3,
-	0, 3, 3, 0, 3, 5, 0, 3, 5, 0,
-	1, 1, 0, 1, 1, 2, 2, 0, 1, 1,
+	1, 1, 1, 0, 1, 3, 6, 8, 0, 5,
+	0, 2, 6, 9, 4, 7, 6, 9, 2, 2,
+	5, 6, 1, 1, 1, 3, 2, 3, 1, 1,
+	1, 2, 2, 5, 0, 1, 2, 2, 1, 1,
+	3, 0, 1, 0, 3, 0, 3, 3, 0, 3,
+	5, 0, 3, 5, 0, 1, 1, 0, 1, 1,
+	2, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -6140,587 +7984,666 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -452, -2, -1, -3, -93, -4, -89, -5, -6,
-	-8, -70, -84, -86, -88, -87, -33, -7, -83, -61,
-	-62, -66, -72, -77, -80, -81, -82, -94, -90, -91,
-	-92, -110, -95, -25, -24, -23, -22, -102, 405, 12,
-	452, -9, -10, -417, -11, 234, -234, -235, -236, -238,
-	-456, -34, -35, -36, -42, -43, -44, -45, -46, -55,
-	-56, -57, -37, -38, -39, -40, -48, -49, -50, -51,
-	-52, -53, -54, -41, -105, -106, -59, -58, -64, -60,
-	-65, -104, 209, -63, 61, -67, -68, -69, -73, -74,
-	-75, -76, -78, -79, 336, 342, 395, 539, 540, 46,
-	-111, -113, 541, -26, -27, -28, -29, -30, -31, -32,
-	205, 14, 485, -12, -15, -13, -16, -14, -20, -21,
-	-19, -17, -18, -103, 229, 228, 37, 292, 293, 294,
-	340, 227, 206, 13, 137, 138, 140, 141, 30, 41,
-	318, -112, 67, 207, -114, 10, 543, 542, -120, 38,
-	-158, -231, 70, 78, -225, -227, 488, 489, 490, 491,
-	492, 493, 494, 495, 496, 497, 498, 499, 500, 501,
-	502, 503, 504, 505, 506, 507, 508, 509, 511, 512,
-	513, 514, 515, 516, 517, 518, 519, 520, 521, 522,
-	523, 524, 455, 526, 527, 528, 529, 316, 317, 482,
-	246, 270, 366, 273, 280, 137, 156, 150, 178, 170,
-	145, 250, 285, 251, 77, 140, 438, 88, 417, 392,
-	143, 265, 267, 269, 272, 252, 166, 161, 264, 248,
-	539, 159, 253, 39, 254, 183, 255, 256, 487, 413,
-	315, 419, 277, 390, 160, 414, 268, 416, 185, 189,
-	430, 342, 154, 155, 421, 432, 182, 226, 42, 484,
-	238, 433, 187, 181, 177, 441, 281, 279, 180, 153,
-	176, 249, 50, 191, 190, 192, 386, 387, 388, 389,
-	257, 258, 330, 429, 173, 162, 320, 146, 22, 436,
-	233, 418, 343, 259, 274, 282, 186, 188, 240, 245,
-	486, 394, 244, 278, 434, 158, 237, 266, 232, 437,
-	147, 336, 345, 260, 141, 271, 431, 440, 49, 358,
-	152, 144, 223, 138, 242, 247, 11, 261, 262, 263,
-	284, 283, 275, 174, 239, 179, 164, 151, 175, 139,
-	241, 439, 359, 537, 318, 375, 172, 169, 243, 216,
-	435, 420, 142, 379, 360, 167, 286, 533, 534, 535,
-	331, 314, 287, 288, 165, 230, 411, 412, 289, 291,
-	385, 307, 361, 393, 367, 362, 198, 295, 423, 184,
-	536, 296, 297, 298, 415, 299, 300, 301, -120, -63,
-	-10, -9, -112, -113, -158, 213, -239, 23, 390, -71,
-	391, 209, 67, -231, -5, -4, -89, -61, -110, -237,
-	-231, 292, 292, -237, 213, -231, 244, 374, -356, 219,
-	-311, -284, 245, -310, -286, -313, -287, 31, 205, 207,
-	206, 241, 14, 340, 215, 12, 10, 341, 227, 24,
-	25, 27, 13, 342, 344, 28, 345, 348, 349, 350,
-	41, 353, 354, 234, 70, 78, 73, 252, -152, -231,
-	-262, -256, 95, 235, -258, -251, -252, -254, -397, -391,
-	-250, 67, 121, 122, 129, 96, -253, -340, 35, 98,
-	492, 453, -210, -211, -212, -213, -214, -231, -392, -390,
-	73, 79, 82, 85, 86, 84, 83, 163, 81, 74,
-	133, 134, -113, 70, -412, 500, -226, 521, 526, 527,
-	528, 529, 520, 46, -362, -370, 212, -368, 132, 168,
-	230, 164, 12, 127, 385, 165, 497, 517, 455, 501,
-	524, 494, 495, 489, 490, 491, 493, 502, 504, 516,
-	-371, 512, 522, 523, 510, 525, 72, 71, 515, 514,
-	503, 498, 499, 505, 488, 496, 506, 507, 513, 518,
-	519, 324, 88, 325, 326, 445, 319, 327, 219, 390,
-	55, 328, 329, 330, 331, 332, 452, 333, 56, 334,
-	323, 234, 375, 335, 167, 184, 457, 456, 458, 449,
-	446, 444, 447, 448, 450, 451, 508, 509, 511, -307,
-	-305, -231, -96, -97, 531, -129, -130, -203, 19, 6,
-	7, 8, 9, -450, 392, 486, 295, 337, 216, 316,
-	375, 299, 244, -278, -276, -358, 289, 285, 214, 224,
-	223, 89, 445, 212, 351, -428, -429, 202, 203, 204,
-	-419, 478, -418, -231, 326, 26, 213, 337, 423, 424,
-	425, 426, 427, 428, -47, -372, -355, 420, 419, -243,
-	412, 418, 410, 422, 413, 317, 301, 300, 205, 479,
-	-222, 361, 393, 240, 442, 443, 338, 394, 430, 431,
-	414, 88, 171, 168, 216, 213, 316, 445, 375, 299,
-	-428, 142, 139, -298, 142, 94, 147, 146, -298, 244,
-	374, 40, -304, 385, -303, -305, 430, 431, 441, 71,
-	72, 429, -222, 88, 411, 411, -130, -203, -129, -111,
-	-113, -92, -417, 214, 316, 375, 244, 215, 213, 216,
-	445, 292, 337, 299, -357, -425, 31, -363, 199, 200,
-	201, 32, 33, -1, 73, -136, 236, -158, 135, -136,
-	-130, -129, -130, -158, -215, -231, 392, 104, -71, -71,
-	391, -420, -421, -422, -424, 209, 391, 390, 135, 15,
-	-237, -237, 65, -158, -286, 244, -311, -284, 35, 64,
-	136, 217, 136, 64, 67, 338, 316, 375, 339, 445,
-	213, 351, 216, 244, 352, 316, 375, 213, 216, 445,
-	244, 316, 213, 216, 375, 244, 352, 410, 411, 216,
-	26, 343, 346, 347, 411, -376, 441, 136, 94, 91,
-	92, 93, -256, 111, -269, 104, 105, 106, 107, 108,
-	109, 110, 118, 117, 128, 121, 122, 123, 124, 125,
-	126, 127, 119, 120, 114, 95, 112, 116, 113, 97,
-	-113, -256, -262, 46, -254, -254, -254, -254, -340, -260,
-	-256, 67, 67, 135, 73, -256, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, -369, 67,
-	67, -266, -267, 67, 67, 67, 73, 73, 67, 67,
-	67, 73, -267, -267, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 136, -164, -168, -165, -167, -166, -170, -169,
-	168, 169, 132, 172, 174, 175, 176, 177, 178, 179,
-	180, 181, 182, 183, 30, 184, 230, 164, 165, 166,
-	167, 185, 150, 170, 483, 193, 151, 194, 152, 195,
-	153, 196, 154, 155, 197, 156, 159, 160, 161, 158,
-	135, -130, 136, 67, -98, 38, -132, 21, -114, -113,
-	20, 31, 32, 31, 32, 31, 32, 31, 32, 79,
-	-151, -231, -144, -231, -274, 219, -274, -274, -274, -274,
-	215, -274, 213, 213, 213, -274, -430, 149, 136, -419,
-	-135, 67, -242, 112, 116, 23, 242, 242, 279, -457,
-	-458, 15, 114, -240, -458, -240, -240, -242, -459, 215,
-	421, 42, 243, 242, -131, -132, -131, 415, 411, -327,
-	416, 417, -244, -458, -243, -242, -242, -243, -242, -242,
-	-242, 213, 216, 445, 299, -457, 242, -457, 30, 30,
-	-356, -158, -356, 479, -223, -231, -356, -356, -431, 218,
-	434, 485, 145, 146, 147, -286, 244, 244, 136, 104,
-	23, -306, 104, 115, -305, -305, -305, -306, -306, -179,
-	40, -230, 132, -231, 73, -179, 40, -454, -453, -158,
-	-131, -114, -113, 68, 68, 68, -356, -356, -356, -356,
-	-356, -356, -356, -356, -237, -144, -356, -115, -116, 123,
-	-256, -231, -115, 38, -365, 46, -110, 67, 30, 67,
-	-231, -366, 46, -110, 67, 30, -131, -98, -132, 135,
-	79, 73, -71, 68, 136, -423, 85, 86, -426, 182,
-	174, -231, -85, 73, -3, -4, -5, -6, -33, -61,
-	-110, -393, -391, 67, 31, 384, 64, 15, -312, 213,
-	445, 337, 240, 216, 316, -310, -293, -290, -288, -230,
-	-286, -289, -288, -315, -215, 411, -99, 396, 291, -256,
-	-256, -256, -256, -256, 84, 95, 314, 85, 86, -251,
-	-270, 31, 287, 288, -252, -252, -252, -252, -252, -252,
-	-252, -252, -252, -252, -252, -252, -259, -268, -340, 67,
-	114, 112, 116, 113, 97, -254, -254, -252, -252, 68,
-	136, -416, -415, 99, -256, -256, -231, -413, -414, 459,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	328, 323, 329, 327, 319, 335, 330, 331, 167, 476,
-	477, 470, 471, 472, 473, 474, 475, -261, -262, -261,
-	-256, -413, -261, -209, 32, 31, -262, -262, -262, 68,
-	-256, -427, 312, 311, 313, -133, -231, -261, 68, 68,
-	68, 79, -262, -262, -261, -252, -261, -414, -209, -209,
-	-262, -262, -209, -209, -209, -209, 123, -209, -209, -209,
-	-209, -209, -209, -209, -209, 68, -305, 40, -351, 359,
-	358, -345, -347, 67, -346, 67, -346, -346, -346, 67,
-	67, -348, 67, -348, -348, -345, -349, 67, -349, -350,
-	67, -349, -231, -131, -97, -374, -373, -256, 40, 532,
-	-98, -256, -128, -127, -256, -451, 73, 34, 67, 135,
-	-158, 95, -433, -231, -294, -291, -288, -231, -284, -231,
-	-231, -274, -274, -274, -158, -431, -418, 34, -134, -231,
-	-199, 16, -254, -254, -288, 244, -457, -242, -224, -223,
-	-244, -239, -244, -244, -199, -458, -242, -242, -244, -240,
-	-199, -231, 411, -199, -199, -327, -241, -231, -241, -274,
-	-223, -224, -224, -158, -194, 210, 207, -178, -196, 356,
-	246, -275, 250, 77, 251, 392, 252, 215, 254, 255,
-	256, 226, 257, 258, 259, 385, 260, 261, 262, 263,
-	339, 6, 302, 40, -433, -433, 30, -294, -231, -123,
-	-118, -122, -119, -124, -202, -204, -121, 67, -158, -113,
-	-231, 435, 436, 143, 146, 145, 31, 384, -309, 384,
-	31, -284, -303, -299, 73, 385, -289, -308, 64, 132,
-	-373, -306, -306, -306, -308, -308, 131, 136, -455, 434,
-	435, 202, -98, -160, -158, -433, -293, -284, -231, -160,
-	-160, -231, 67, -231, -198, 136, -197, 15, -232, -231,
-	34, 73, 135, -198, 73, -297, 64, -367, -263, -411,
-	531, -138, 68, -133, -409, -410, -133, -137, -231, -367,
-	-138, 68, -409, -98, -98, -231, 23, -71, -421, 135,
-	136, -134, -312, -292, -289, -314, 123, -231, -300, 136,
-	485, 544, 71, 217, -443, -442, 376, 68, 136, -377,
-	218, 452, 73, 545, 198, 84, 314, 85, 86, -340,
-	-262, -259, -254, -254, -252, -252, -257, 231, -257, 94,
-	-256, -255, -415, 101, -256, 34, 136, 65, 135, 68,
-	68, 15, 15, 68, -256, 68, 68, 68, 68, 15,
-	15, -256, 68, 135, 68, 68, 68, 68, 65, 68,
-	136, 68, 136, -262, -256, 68, 68, -256, -256, -256,
-	-262, 68, -256, -256, -256, -256, -256, -256, -256, -256,
-	-322, 406, -256, -354, 360, 79, 79, -217, 73, -217,
-	79, 79, 79, 136, 68, 73, 136, 22, 136, -125,
-	36, 37, -152, -145, -146, -147, -148, -164, -215, 169,
-	172, 174, 175, 176, 177, 178, 179, 180, 181, 182,
-	183, 184, 230, 164, 165, 166, 167, 185, 150, 170,
-	151, 152, 153, 154, 155, 156, 159, 160, 161, 158,
-	-231, -135, 35, -436, 363, -444, 136, 40, -442, 445,
-	-277, 65, -108, 15, -158, -158, -158, -171, 34, 15,
-	-117, -159, -231, 67, 68, 136, -256, -285, 65, -231,
-	-224, -199, -158, -231, -199, -199, -242, -244, -244, -240,
-	135, -223, -135, -155, -161, -156, -215, -247, -157, 222,
-	220, 224, -406, 89, 225, 276, 90, 215, -195, 215,
-	90, 221, 225, 220, 136, -196, -228, 104, -228, -222,
-	131, -228, -228, -228, -228, 253, 253, -228, -228, -228,
-	-228, -228, -228, -228, -228, -228, -228, -228, -228, -228,
-	-228, 67, -437, 363, 30, 322, -444, -109, 316, 30,
-	-205, -206, -207, -208, 53, 57, 59, 54, 55, 56,
-	60, 30, 136, -229, -233, 34, -231, 73, -229, -113,
-	-118, -123, -229, 67, 144, 147, 147, 146, 217, 67,
-	104, -308, -308, -308, 40, -230, -453, 441, 435, 136,
-	64, -145, -199, -116, -118, -231, 73, -231, 123, -199,
-	-380, 416, 39, 136, 67, 68, 136, 46, 136, 104,
-	68, 136, 68, 46, 135, 392, -231, -391, 68, -314,
-	136, 217, 135, 135, -290, 342, -230, -292, 20, 485,
-	-215, 38, -221, -220, 73, 545, 68, -257, -257, 94,
-	-254, -251, 68, 102, -256, 100, -162, -164, 358, 359,
-	-163, -169, 132, 168, 230, 167, 166, 164, 358, 359,
-	-179, -231, -256, -256, 68, -256, -256, 15, -231, -179,
-	-252, -256, -130, 68, -321, -322, -321, 68, 68, 68,
-	68, -321, 68, 68, 68, 68, 68, 68, 68, 68,
-	67, 68, 68, 68, 136, 68, 68, 68, 136, 68,
-	136, -373, -380, -256, -256, -127, -126, 43, 350, 68,
-	136, -164, 34, -439, 418, 365, -228, -249, -248, 318,
-	41, -360, 385, 371, 372, -291, 244, -231, 64, 306,
-	307, 308, 309, -272, -273, -271, -275, -433, 67, 67,
-	67, -341, 296, -164, -158, -117, -231, 15, 136, -432,
-	135, -1, -231, -284, -242, -199, -458, -199, -242, -242,
-	-244, -231, 34, -164, -157, -231, 90, -405, -406, -274,
-	-405, 90, 67, -231, -231, -231, 90, 90, -196, 79,
-	79, -228, -228, 79, 73, 73, 73, -228, -228, 79,
-	73, -233, 79, 79, 79, 79, 40, 73, -184, 40,
-	264, 268, 265, 266, 267, 79, 40, 79, 40, 79,
-	40, -231, 67, -407, -408, 73, -439, -228, 322, 104,
-	-249, -107, 77, 31, -139, 210, 207, -433, -296, -295,
-	-215, -122, -122, -122, -122, 53, 53, 53, 58, 53,
-	58, 53, -207, -296, -124, -135, -233, 68, -449, -448,
-	-447, -445, 61, 218, 62, -261, 147, -292, -245, 73,
-	-299, -158, -158, 68, -201, 17, 135, -201, -388, 402,
-	397, 399, 90, -263, -375, -374, 46, -110, -133, -367,
-	-410, -373, -231, 46, -110, -367, -231, 79, 15, -289,
-	-284, 123, 123, -231, 343, -300, 73, 364, 73, 213,
-	546, 136, 104, -221, -251, -256, 68, -172, 155, 154,
-	-172, 68, -346, -346, -345, -348, -345, -172, -172, 68,
-	68, 23, 68, 68, 68, -256, 68, 68, 136, -364,
-	454, -321, -321, -321, -321, -321, -321, -321, -321, -321,
-	-321, -321, -321, -321, -265, -264, 236, 73, 79, 79,
-	-388, 44, 45, 73, 211, -147, 40, -110, -440, 77,
-	-434, 73, -231, -441, 77, 366, 134, 320, 40, 367,
-	368, 382, 315, 79, 79, 373, -435, -231, -140, 316,
-	-158, -271, -222, 131, 255, 299, -153, -154, -155, -153,
-	-153, -342, 298, 20, 79, 122, -136, 65, 135, -118,
-	-159, -231, 123, 68, -199, -231, -199, -199, -242, -110,
-	-176, -175, -173, 84, 95, 40, 356, -174, 77, 131,
-	269, 247, 270, -193, -246, 64, 362, 220, 89, 90,
-	344, -247, -401, -403, -231, -403, -231, -401, -401, -274,
-	-256, -231, -179, -179, 73, 73, -180, 247, -160, 68,
-	136, 104, -440, -434, 104, 73, -441, 73, 136, -139,
-	-139, -199, 136, 104, -142, -141, 64, 65, -143, 64,
-	-141, 53, 53, -199, -447, -446, 23, -406, -406, -406,
-	68, 68, -200, 18, 20, 123, -200, -352, 533, -384,
-	-386, 397, 20, 20, 13, 68, -367, -367, -284, -300,
-	385, -158, -220, 73, 546, -256, 68, -256, 68, 73,
-	-130, 20, 68, 68, -352, -149, -164, -250, 73, -438,
-	376, 73, 73, 79, 40, 79, 134, 369, -361, -107,
-	-139, 67, -228, -228, -228, -231, 68, 136, 68, 68,
-	-343, 235, 79, 122, 79, 122, 79, -229, -118, -231,
-	-199, -432, 135, -199, -199, -173, 84, -252, 73, -181,
-	-230, 132, -182, 40, 268, 264, -183, 40, 248, 249,
-	-185, 67, 276, 13, 90, 90, -158, 67, 65, 286,
-	67, 67, 67, -403, 68, 248, 249, 68, -408, 73,
-	-438, 73, -433, -130, -295, -373, -256, 67, -256, 67,
-	53, 19, 17, -256, -262, -186, 534, 73, 20, 73,
-	-382, 73, -296, -101, -378, -327, 68, 68, -321, -324,
-	-323, -326, 403, 278, 409, -262, -186, 484, 20, 485,
-	319, 40, 79, 40, 370, -279, -281, -215, 67, -179,
-	-181, 73, -177, -178, -155, -100, -99, -177, -344, 138,
-	79, 122, 79, 79, -199, -199, 79, 67, -368, -280,
-	67, -279, -404, 306, 307, 308, 310, 309, -404, -279,
-	-279, -279, 67, -302, -301, 277, 95, -131, -134, -402,
-	-231, 220, 20, 20, -218, 535, 79, 73, 399, -353,
-	536, -387, 402, -381, -379, 397, 398, 399, 400, 68,
-	-325, 97, 369, 373, -256, -218, -150, -231, 73, 364,
-	73, 319, 68, 136, -345, -256, -316, 236, -101, -316,
-	-359, 146, 297, 485, 79, 122, 79, -130, 68, -256,
-	-266, -188, -187, 481, -279, 68, 68, 68, 68, -279,
-	277, 68, 68, 136, 67, 20, 73, -389, 218, -385,
-	-386, 401, -379, 20, 399, 20, 20, -325, 531, 407,
-	408, 407, 408, 34, 385, -282, -281, -125, 68, -317,
-	285, 20, -317, 297, 79, 122, 79, -131, 68, -192,
-	-190, -191, 64, 414, 274, 275, 68, -282, -282, -282,
-	-282, 68, -231, 220, -219, -231, -382, -396, 67, 79,
-	-384, -383, -385, 20, -382, 20, -382, -382, 94, 73,
-	-283, 226, 77, 485, 304, 305, -125, 20, -318, 278,
-	279, -319, -331, 281, 79, -301, -191, 64, -190, 64,
-	14, 13, -193, 68, 136, -400, 30, 68, -395, -394,
-	-216, -390, -231, 402, 403, 73, -382, -325, -228, 73,
-	303, -215, 67, -329, 282, 67, -327, 67, -327, 90,
-	307, -189, 271, 272, 30, 146, -189, -231, -399, -398,
-	-397, 68, 136, 135, 79, -231, -315, -320, 283, 79,
-	-252, 67, -252, 67, -328, 280, 67, 84, 40, 273,
-	136, 104, -394, -231, 68, -333, 67, 20, 68, -315,
-	68, -315, 67, 104, -252, -398, 40, -256, 135, -334,
-	-332, 236, -319, 68, 68, 68, -315, 79, 68, -231,
-	68, 136, -231, -330, 284, 68, -332, -335, 46, 79,
-	-339, -336, 67, -196, 238, 114, -339, -196, -338, -337,
-	283, 239, 67, 68, 136, -231, 235, 67, -262, -337,
-	-336, -262, 68, 68,
+	-1000, -508, -2, -1, -3, -126, -4, -92, -5, -6,
+	-8, -72, -87, -89, -91, -90, -33, -7, -86, -61,
+	-62, -66, -74, -80, -83, -84, -85, -127, -93, -94,
+	-97, -125, -146, -128, -25, -24, -23, -22, -138, 414,
+	14, 461, -9, -10, -473, -11, 236, -284, -285, -286,
+	-288, -512, -34, -35, -36, -42, -43, -44, -45, -46,
+	-55, -56, -57, -37, -38, -39, -40, -48, -49, -50,
+	-51, -52, -53, -54, -41, -141, -142, -59, -58, -64,
+	-60, -65, -140, 211, -63, 62, -67, -68, -69, -70,
+	-71, -75, -76, -77, -78, -79, -81, -82, 345, 351,
+	404, 563, 564, 565, 47, -147, -149, 584, -26, -27,
+	-28, -29, -30, -95, -31, -32, -135, 207, 16, 494,
+	-12, -15, -13, -16, -14, -20, -21, -19, -17, -96,
+	-18, -139, -136, 231, 230, 39, 298, 299, 300, 349,
+	229, 208, 15, 139, 140, 142, 143, 557, 149, 32,
+	42, 327, -148, 68, 209, -150, 12, 586, 585, -156,
+	7, -194, -281, 71, 79, -275, -277, 497, 498, 499,
+	500, 501, 502, 503, 504, 505, 506, 507, 508, 509,
+	510, 511, 512, 513, 514, 515, 516, 517, 518, 520,
+	521, 522, 523, 524, 525, 526, 527, 528, 529, 530,
+	531, 532, 533, 464, 535, 536, 537, 538, 325, 581,
+	573, 567, 326, 552, 491, 248, 272, 375, 275, 286,
+	139, 158, 152, 180, 172, 147, 252, 291, 253, 78,
+	142, 447, 89, 426, 401, 145, 267, 269, 271, 274,
+	254, 168, 163, 266, 250, 563, 161, 255, 40, 256,
+	185, 257, 258, 496, 422, 324, 428, 279, 280, 281,
+	282, 283, 399, 162, 423, 270, 425, 187, 191, 439,
+	351, 156, 157, 430, 441, 184, 228, 43, 493, 240,
+	442, 189, 183, 179, 450, 287, 285, 182, 155, 178,
+	251, 51, 550, 193, 192, 194, 395, 396, 397, 398,
+	259, 260, 339, 438, 175, 164, 329, 148, 24, 445,
+	235, 427, 352, 261, 276, 288, 188, 190, 242, 247,
+	495, 403, 246, 284, 443, 160, 239, 268, 234, 446,
+	149, 345, 354, 262, 143, 553, 557, 273, 440, 449,
+	556, 50, 367, 154, 146, 225, 140, 244, 249, 13,
+	263, 264, 265, 290, 289, 277, 176, 241, 181, 166,
+	153, 177, 141, 243, 448, 368, 548, 327, 384, 174,
+	171, 245, 218, 444, 429, 144, 388, 369, 169, 292,
+	544, 545, 546, 340, 323, 293, 294, 167, 232, 420,
+	421, 295, 297, 394, 316, 370, 402, 376, 371, 200,
+	301, 432, 560, 541, 561, 562, 186, 547, 302, 303,
+	304, 424, 305, 306, 307, 309, 310, -156, -63, -10,
+	-9, -148, -149, -194, 215, -289, 25, 399, -73, 400,
+	211, 68, -281, -5, -4, -92, -61, -146, -287, -281,
+	298, 298, -287, 215, -281, 246, 383, -412, 221, -367,
+	-334, 247, -366, -336, -369, -337, 33, 207, 209, 208,
+	243, 16, 349, 217, 14, 12, 350, 229, 26, 27,
+	29, 15, 351, 353, 30, 354, 357, 358, 359, 42,
+	362, 363, 236, 71, 79, 74, 254, -188, -281, -312,
+	-306, 96, 237, -308, -301, -302, -304, -453, -447, -300,
+	68, 123, 124, 131, 97, -303, -396, 37, 99, 501,
+	462, -260, -261, -262, -263, -264, 490, -281, -448, -446,
+	74, 80, 83, 86, 87, 85, 84, 165, 82, 75,
+	135, 136, -149, 71, -468, 509, -276, 530, 535, 536,
+	537, 538, 529, 47, -418, 555, -426, 214, -424, 134,
+	170, 232, 166, 14, 129, 394, 167, 506, 526, 464,
+	510, 533, 503, 504, 498, 499, 500, 502, 511, 513,
+	525, -427, 521, 531, 532, 519, 534, 73, 72, 524,
+	523, 512, 507, 508, 514, 497, 505, 515, 516, 522,
+	527, 528, 333, 89, 334, 335, 454, 328, 336, 221,
+	399, 56, 337, 338, 339, 340, 341, 461, 342, 57,
+	343, 332, 236, 384, 344, 169, 186, 466, 465, 467,
+	458, 455, 453, 456, 457, 459, 460, 517, 518, 520,
+	-362, -359, -281, -114, -281, -129, -130, 542, -165, -166,
+	-253, 21, 8, 9, 10, 11, -506, 401, 495, 309,
+	301, 346, 218, 325, 384, 305, 246, -328, -326, -414,
+	295, 291, 216, 242, 226, 225, 90, 454, 214, 360,
+	-484, -485, 204, 205, 206, -475, 487, -474, -281, 335,
+	28, 215, 346, 432, 433, 434, 435, 436, 437, -47,
+	-428, -411, 429, 428, -293, 421, 427, 419, 431, 422,
+	326, 307, 306, 207, 488, -272, 370, 402, 242, 451,
+	452, 347, 403, 439, 440, 423, 89, 173, 170, 218,
+	215, 325, 454, 384, 305, -484, 144, 141, -348, 144,
+	95, 149, 148, -348, 219, 144, -281, 557, 141, 439,
+	440, 246, 383, 41, -358, 394, -357, -359, 450, 72,
+	73, 438, -272, 89, 420, 420, -166, -253, -165, -147,
+	-149, -125, -473, 309, 216, 325, 384, 246, 217, 215,
+	218, 454, 298, 346, 305, 242, -137, 308, -1, 74,
+	-172, 238, -194, 137, -172, -166, -165, -166, -194, -265,
+	-281, 401, 105, -73, -73, 400, -476, -477, -478, -480,
+	211, 400, 399, 137, 17, -287, -287, 66, -194, -336,
+	246, -367, -334, 37, 65, 138, 219, 138, 65, 68,
+	347, 325, 384, 348, 454, 215, 360, 218, 246, 361,
+	325, 384, 215, 218, 454, 246, 325, 215, 218, 384,
+	246, 361, 419, 420, 218, 28, 352, 355, 356, 420,
+	-432, 450, 138, 95, 92, 93, 94, -306, 112, -319,
+	105, 106, 107, 108, 109, 110, 111, 120, 119, 130,
+	123, 124, 125, 126, 127, 128, 129, 121, 122, 115,
+	96, 113, 117, 114, 118, 98, 539, 540, -149, -306,
+	-312, 47, -304, -304, -304, -304, -396, -310, -306, 68,
+	68, 68, 137, 74, -306, 68, 68, 68, 68, 68,
+	68, 68, 68, 68, 68, 68, 68, 68, -425, 68,
+	68, -316, -317, 68, 68, 68, 74, 74, 68, 68,
+	68, 74, -317, -317, 68, 68, 68, 68, 68, 68,
+	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
+	68, 68, 138, -200, -204, -201, -203, -202, -206, -205,
+	170, 171, 134, 174, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 32, 186, 232, 166, 167, 168,
+	169, 187, 152, 172, 492, 195, 153, 196, 154, 197,
+	155, 198, 156, 157, 199, 158, 161, 162, 163, 160,
+	137, 68, 137, -166, 138, 68, -131, 7, -168, 23,
+	-150, -149, 22, 33, 34, 33, 34, 33, 34, 33,
+	34, 80, 310, -187, -281, -180, -281, -324, 221, -324,
+	-324, -324, -324, 217, -324, 215, 215, 215, -324, -114,
+	-486, 151, 138, -475, -171, 68, -292, 113, 117, 25,
+	244, 244, 285, -514, -515, 17, 115, -290, -515, -290,
+	-290, -292, -516, 217, 430, 43, 245, 244, -167, -168,
+	-167, 424, 420, -383, 425, 426, -294, -515, -293, -292,
+	-292, -293, -292, -292, -292, 215, 218, 454, 305, -514,
+	244, -514, 32, 32, -412, -194, -412, 488, -273, -281,
+	-412, -412, -487, 220, -349, -350, 443, 494, 441, 147,
+	148, 149, -281, 557, 219, -281, -349, 141, -359, 141,
+	-359, -336, 246, 246, 138, 105, 25, -360, 105, 116,
+	-359, -360, -360, -215, 41, -280, 134, -281, 74, -215,
+	41, -510, -509, -194, -167, -150, -149, 69, 69, 69,
+	310, -412, -412, -412, -412, -412, -412, -412, -412, -287,
+	-180, -412, -412, -413, -481, 33, -419, 201, 202, 203,
+	34, 35, 7, -421, 47, -146, 68, 32, 68, -281,
+	-422, 47, -146, 68, 32, -167, -131, -168, 137, 80,
+	74, -73, 69, 138, -479, 86, 87, -482, 184, 176,
+	-281, -88, 74, -3, -4, -5, -6, -33, -61, -146,
+	-449, -447, 68, 33, 393, 65, 17, -368, 215, 454,
+	346, 242, 218, 325, -366, -343, -340, -338, -280, -336,
+	-339, -338, -371, -265, 420, -132, 405, 297, -306, -306,
+	-306, -306, -306, 85, 96, 323, 86, 87, -301, -320,
+	33, 293, 294, -302, -302, -302, -302, -302, -302, -302,
+	-302, -302, -302, -302, -302, -309, -318, -396, 68, 115,
+	113, 117, 114, 98, -304, -304, -302, 558, -302, 74,
+	74, 69, 138, -472, -471, 100, -306, -306, -371, -281,
+	-469, -470, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 477, 478, 337, 332, 338, 336, 328, 344, 339,
+	340, 169, 485, 486, 479, 480, 481, 482, 483, 484,
+	-311, -312, -311, -306, -469, -311, -259, 34, 33, -312,
+	-312, -312, 69, -306, -483, 321, 320, 322, -169, -281,
+	-311, -312, 69, 69, 69, 80, -312, -312, -311, -302,
+	-311, -470, -259, -259, -312, -312, -259, -259, -259, -259,
+	125, -259, -259, -259, -259, -259, -259, -259, -259, 69,
+	-359, 41, -407, 368, 367, -401, -403, 68, -402, 68,
+	-402, -402, -402, 68, 68, -404, 68, -404, -404, -401,
+	-405, 68, -405, -406, 68, -405, -281, -311, -281, -167,
+	-130, -430, -429, -306, 41, 543, -131, -306, -164, -163,
+	-306, -507, 74, 25, 36, 68, 137, -194, 96, -489,
+	-281, -344, -341, -338, -281, -334, -281, -281, -324, -324,
+	-324, -194, 68, -487, -474, 36, -170, -281, -240, 18,
+	-304, -304, -338, 246, -514, -292, -274, -273, -294, -289,
+	-294, -294, -240, -515, -292, -292, -294, -290, -240, -281,
+	420, -240, -240, -383, -291, -281, -291, -324, -273, -274,
+	-274, -194, -233, -214, -232, -237, 212, 550, 551, 210,
+	209, 365, 248, -325, 252, 78, 253, 401, 254, 217,
+	256, 257, 258, 228, 259, 260, 261, 394, 262, 263,
+	264, 265, 348, 8, 311, 41, -489, -489, 32, -344,
+	-281, -159, -154, -158, -155, -160, -247, -254, -157, 68,
+	-194, -149, -281, 559, 138, 444, 445, 145, 442, 148,
+	147, -281, -281, 557, -349, -360, -349, -360, 33, 393,
+	-365, 393, 33, -334, -357, 439, 440, -352, 74, 394,
+	-339, -364, 65, 134, -429, -360, -364, -364, 133, 138,
+	-511, 443, 444, 204, -131, -412, -196, -194, -489, -343,
+	-334, -281, -196, -196, -281, 68, -281, -114, -151, -152,
+	125, -306, -281, -151, 74, -347, 65, -423, -313, -467,
+	542, -174, 69, -169, -465, -466, -169, -173, -281, -423,
+	-174, 69, -465, -131, -131, -281, 25, -73, -477, 137,
+	138, -170, -368, -342, -339, -370, 125, -281, -353, 138,
+	494, 587, 72, 219, -499, -498, 385, 69, 138, -433,
+	220, 461, 74, 588, 200, 85, 323, 86, 87, -396,
+	-312, -309, -304, -304, -302, -302, -307, 233, -307, 68,
+	95, -306, -305, -471, 102, -306, 36, 138, 66, 69,
+	137, 69, 69, 17, 17, 69, -306, 69, 69, 69,
+	69, 17, 17, -306, 69, 137, 69, 69, 69, 69,
+	69, 66, 69, 138, 69, 138, -312, -306, 69, 69,
+	-306, -306, -306, -312, 69, -306, -306, -306, -306, -306,
+	-306, -306, -306, -378, 415, -306, -410, 369, 80, 80,
+	-267, 74, -267, 80, 80, 80, 69, 138, 69, 74,
+	138, 24, 138, -161, 38, 39, -146, -188, -181, -182,
+	-183, -184, -200, -265, 171, 174, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 232, 166, 167,
+	168, 169, 187, 152, 172, 153, 154, 155, 156, 157,
+	158, 161, 162, 163, 160, -281, -171, 37, -492, 372,
+	-500, 138, 41, -498, 454, -327, 66, -144, 17, -194,
+	-194, -194, -207, 36, -116, -117, -115, -118, 115, 582,
+	583, 17, -153, -195, -281, 68, 69, 138, -306, -335,
+	66, -281, -274, -240, -194, -281, -240, -240, -292, -294,
+	-294, -290, 137, -273, -171, 138, 138, -237, -191, -197,
+	223, -192, -265, -297, -193, 224, 222, 226, -462, 90,
+	227, 278, 91, 217, -513, 223, -513, 223, -234, 217,
+	91, 223, 227, 222, -278, 105, -278, -272, 133, -278,
+	-278, -278, -278, 255, 255, -278, -278, -278, -278, -278,
+	-278, -278, -278, -278, -278, -278, -278, -278, -278, 68,
+	-493, 372, 32, 331, -500, -145, 325, 32, -255, -256,
+	-257, -258, 54, 58, 60, 55, 56, 57, 61, 32,
+	138, -279, -283, 36, -281, 74, -279, -149, -154, -159,
+	-279, -248, 36, 68, 68, -350, 146, -351, 443, 446,
+	449, 149, 149, 148, -281, -364, -364, 219, 68, 105,
+	-364, 41, -280, -509, 450, 444, 25, 138, 65, -181,
+	-124, 138, 7, -282, -281, 36, 74, 137, -124, -436,
+	425, 40, 138, 68, 69, 138, 47, 138, 105, 69,
+	138, 69, 47, 137, 401, -281, -447, 69, -370, 138,
+	219, 137, 137, -340, 351, -280, -342, 22, 494, -265,
+	7, -271, -270, 74, 588, 69, -307, -307, 95, -304,
+	-306, -301, 69, 103, -306, 101, -198, -200, 367, 368,
+	-199, -205, 134, 170, 232, 169, 168, 166, 367, 368,
+	-215, 491, -281, -378, -306, -306, 69, -306, -306, 17,
+	-281, -215, -302, -306, -166, 69, -377, -378, -377, 69,
+	69, 69, 69, -377, 69, 69, 69, 69, 69, 69,
+	69, 69, 68, 69, 69, 69, 138, 69, 69, 69,
+	138, 69, 138, -429, -436, -306, -306, -163, -162, 44,
+	359, 69, 138, -200, 36, -495, 427, 374, -278, -299,
+	-298, 327, 42, -416, 394, 380, 381, -341, 246, -281,
+	65, 315, 316, 317, 318, -322, -323, -321, -325, -489,
+	68, 68, 68, -397, 302, -200, 69, 138, -265, -194,
+	-153, -281, 17, 138, -488, 137, -1, -281, -334, -292,
+	-240, -515, -240, -292, -292, -294, -281, 36, -232, -237,
+	-235, 45, 552, -197, -200, -193, -281, 91, -461, -462,
+	-324, -461, 91, 68, -197, -281, -281, -281, -281, -281,
+	91, 91, 80, 80, -278, -278, 80, 74, 74, 74,
+	-278, -278, 80, 74, -283, 80, 80, 80, 80, 41,
+	74, -220, 41, 266, 270, 267, 268, 269, 80, 41,
+	80, 41, 80, 41, -281, 68, -463, -464, 74, -495,
+	-278, 331, 105, -299, -143, 78, 33, -175, 212, 209,
+	-489, -346, -345, -265, -158, -158, -158, -158, 54, 54,
+	54, 59, 54, 59, 54, -257, -346, -160, -171, -283,
+	69, -505, -504, -503, -501, 62, 220, 63, -279, 558,
+	-311, -306, 448, 447, 443, 149, -342, -295, 74, -352,
+	-146, -194, -194, 69, -239, -238, 17, -152, -363, -361,
+	-359, 72, -281, 74, -281, 125, -239, -444, 411, 406,
+	408, 91, -313, -431, -430, 47, -146, -169, -423, -466,
+	-429, -281, 47, -146, -423, -281, 80, 17, -339, -334,
+	125, 125, -281, 352, -353, 74, 373, 74, 215, 589,
+	138, 105, -271, -301, 69, -306, 69, 541, -208, 157,
+	156, -208, 69, -402, -402, -401, -404, -401, -208, -208,
+	69, 68, 69, 25, 69, 69, 69, -306, 69, 69,
+	138, -420, 463, -377, -377, -377, -377, -377, -377, -377,
+	-377, -377, -377, -377, -377, -377, -315, -314, 238, 74,
+	80, 80, -444, 45, 46, 74, 213, -183, 41, -146,
+	-496, 78, -490, 74, -281, -497, 78, 375, 136, 329,
+	41, 376, 377, 391, 324, 80, 80, 382, -491, -281,
+	-176, 325, -194, -321, -272, 133, 257, 305, -189, -190,
+	-191, -197, -189, -189, -398, 304, 22, 80, 124, -98,
+	-99, -100, -101, -102, -103, -104, -105, -106, -107, -108,
+	-109, -110, -111, -97, -3, -4, -92, -5, -61, -6,
+	-8, -7, -74, -93, -94, -125, -146, 139, -281, 221,
+	575, 576, 341, 577, 578, 564, 427, 580, 581, 563,
+	-115, -200, -172, 66, 137, -154, -195, -281, 125, 69,
+	-240, -281, -240, -240, -292, -146, -281, -236, -235, -212,
+	-211, -209, 85, 96, 41, 365, -210, 78, 133, 271,
+	249, 253, 272, -231, -296, 65, 371, 36, 280, 222,
+	90, 91, 353, -297, -457, -459, -281, -459, -281, -457,
+	-457, -324, -306, -236, -197, 219, -281, -215, -215, 74,
+	74, -216, 249, -196, 69, 138, 105, -496, -490, 105,
+	74, -497, 74, 138, -175, -175, -240, 138, 105, -178,
+	-177, 65, 66, -179, 65, -177, 54, 54, -240, -503,
+	-502, 25, -462, -462, -462, -505, 167, -281, 69, 138,
+	69, -240, -154, 138, 137, -240, -408, 544, -440, -442,
+	406, 22, 22, 15, 69, -423, -423, -334, -353, 394,
+	-194, -270, 74, 589, 69, -302, -306, 69, -306, 69,
+	74, -166, 22, 69, 69, -408, -185, -200, -300, 74,
+	-494, 385, 74, 74, 80, 41, 80, 136, 378, -417,
+	-143, -175, 68, -278, -278, -278, -281, 69, 138, 69,
+	69, -399, 237, 80, 124, 80, 124, 80, -112, 590,
+	-306, -306, -112, -112, -281, -281, -281, -121, 568, 569,
+	-281, -517, 17, 48, -281, -279, -154, -281, -240, -488,
+	137, -240, -240, -209, 85, -302, 74, -217, -280, 134,
+	-218, 41, 270, 266, -219, 41, 250, 251, 74, -221,
+	68, 278, 15, 68, 281, 91, 91, -194, 68, 66,
+	292, 68, 68, 68, -459, 69, -236, -281, 250, 251,
+	69, -464, 74, -494, 74, -489, -166, -345, -429, -306,
+	68, -306, 68, 54, 21, 19, -302, 74, 74, -242,
+	19, -361, 125, -242, -222, 545, 74, 22, 74, -438,
+	74, -346, -134, -434, -383, -226, 115, 494, 69, 69,
+	-377, -380, -379, -382, 412, 284, 418, -312, -222, 493,
+	22, 494, 328, 41, 80, 41, 379, -329, -331, -265,
+	68, -215, -217, 74, -213, -214, -191, -133, -132, -213,
+	-400, 140, 80, 124, 80, 80, -98, 103, 139, 575,
+	576, 341, 101, 563, 103, 579, 566, 567, -281, 17,
+	-240, -240, -223, 442, 80, 68, -424, -306, 36, -330,
+	68, -329, -460, 315, 316, 317, 319, 318, -460, -329,
+	-329, -329, 68, -355, -354, 279, 96, -167, -170, -458,
+	-281, 222, 22, 22, 426, -241, 20, 22, -241, -268,
+	546, 80, 74, 408, -409, 547, -443, 411, -437, -435,
+	406, 407, 408, 409, 69, 61, 492, 495, 69, -381,
+	98, 378, 382, -306, -268, -186, -281, 74, 373, 74,
+	328, 69, 138, -401, -306, -372, 238, -134, -372, -415,
+	148, 303, 494, 80, 124, 80, 586, -112, -306, -112,
+	-112, -112, -112, 576, -306, 25, 25, 7, -166, 80,
+	69, -306, -316, 69, 68, -225, -224, 490, -329, 69,
+	69, 69, 69, -329, 279, 69, 69, 138, 68, -306,
+	-243, -246, -306, 553, 554, 555, 68, 22, 74, -445,
+	220, -441, -442, 410, -435, 22, 408, 22, 22, 493,
+	51, 496, -381, 542, 416, 417, 416, 417, 36, 394,
+	-332, -331, -161, 69, -373, 291, 22, -373, 303, 80,
+	124, 80, 103, 563, 103, 579, -119, 103, 103, -146,
+	-123, -122, 572, 80, 570, 571, 96, -363, -167, 69,
+	-356, 283, 282, -306, -230, -228, -229, 65, 423, 276,
+	277, 69, -332, -332, -332, -332, 69, -281, 222, -251,
+	-249, -281, 561, 494, 138, 68, 68, 556, -269, -281,
+	-438, -452, 68, 80, -440, -439, -441, 22, -438, 22,
+	-438, -438, 51, 95, 74, -333, 228, 78, 494, 313,
+	314, -161, 22, -374, 284, 285, -375, -387, 287, 80,
+	-120, -281, -112, 576, -306, -113, 574, 102, 575, 341,
+	-98, 138, 74, 49, 573, -354, 69, -229, 65, -228,
+	65, 16, 15, -231, 69, 138, 25, -200, 562, 74,
+	553, -246, -312, -312, 68, 69, 138, -456, 32, 69,
+	-451, -450, -266, -446, -281, 411, 412, 74, -438, 494,
+	-381, -278, 74, 312, -265, 68, -385, 288, 68, -383,
+	68, -383, 91, 316, 103, -120, 103, 103, -306, -112,
+	-122, 74, -356, -227, 273, 274, 32, 148, -227, 69,
+	-249, 560, 562, 37, 74, 426, 69, 69, -245, -244,
+	68, -306, -281, -455, -454, -453, 69, 138, 137, 495,
+	80, -281, -371, -376, 289, 80, -302, 68, -302, 68,
+	-384, 286, 68, 575, 341, 221, 101, 85, 41, 275,
+	74, 562, 426, 68, 69, 138, 69, 138, 105, -450,
+	-281, 496, 69, -389, 68, 22, 69, -371, 69, -371,
+	68, 105, -302, -120, -120, -112, -250, 74, 68, -251,
+	-244, -454, 41, -306, 137, -390, -388, 238, -375, 69,
+	69, 69, -371, 80, 69, -252, 85, -281, 41, -251,
+	69, -281, 69, 138, -281, -386, 290, 69, 65, 74,
+	69, -388, -391, 47, 80, -281, -395, -392, 68, -237,
+	240, 115, -395, -237, -394, -393, 289, 241, 68, 69,
+	138, -281, 237, 68, -312, -393, -392, -312, 69, 69,
 }
 
 var yyDef = [...]int{
-	33, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	34, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
 	20, 21, 22, 23, 24, 25, 26, 27, 28, 29,
-	30, 31, 32, 696, 697, 698, 699, 700, 0, 0,
-	0, 470, 471, 0, 445, 0, 0, 0, 0, 0,
-	0, 343, 344, 345, 346, 347, 348, 349, 350, 351,
-	352, 353, 354, 355, 356, 357, 358, 359, 360, 361,
-	362, 363, 364, 365, 366, 367, 296, 297, 298, 299,
-	300, 301, 0, 231, 227, 206, 207, 208, 167, 168,
-	169, 170, 238, 239, 320, 0, 0, 0, 0, 0,
-	553, -2, 35, 701, 702, 703, 704, 705, 706, 707,
-	-2, 483, 0, 446, 447, 448, 449, 450, 451, 452,
-	453, 454, 455, 456, 285, 286, 287, 281, 282, 284,
-	283, -2, 0, 483, 220, 0, 211, 211, 0, 0,
-	0, 573, 0, 0, 588, 610, 33, 0, 531, 0,
-	536, 942, 978, 979, 980, 981, 1590, 1591, 1592, 1593,
-	1594, 1595, 1596, 1597, 1598, 1599, 1600, 1601, 1602, 1603,
-	1604, 1605, 1606, 1607, 1608, 1609, 1610, 1611, 1612, 1613,
-	1614, 1615, 1616, 1617, 1618, 1619, 1620, 1621, 1622, 1623,
-	1624, 1625, 1626, 1627, 1628, 1629, 1630, 1389, 1390, 1391,
-	1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401,
-	1402, 1403, 1404, 1405, 1406, 1407, 1408, 1409, 1410, 1411,
-	1412, 1413, 1414, 1415, 1416, 1417, 1418, 1419, 1420, 1421,
-	1422, 1423, 1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431,
-	1432, 1433, 1434, 1435, 1436, 1437, 1438, 1439, 1440, 1441,
-	1442, 1443, 1444, 1445, 1446, 1447, 1448, 1449, 1450, 1451,
-	1452, 1453, 1454, 1455, 1456, 1457, 1458, 1459, 1460, 1461,
-	1462, 1463, 1464, 1465, 1466, 1467, 1468, 1469, 1470, 1471,
-	1472, 1473, 1474, 1475, 1476, 1477, 1478, 1479, 1480, 1481,
-	1482, 1483, 1484, 1485, 1486, 1487, 1488, 1489, 1490, 1491,
-	1492, 1493, 1494, 1495, 1496, 1497, 1498, 1499, 1500, 1501,
-	1502, 1503, 1504, 1505, 1506, 1507, 1508, 1509, 1510, 1511,
-	1512, 1513, 1514, 1515, 1516, 1517, 1518, 1519, 1520, 1521,
-	1522, 1523, 1524, 1525, 1526, 1527, 1528, 1529, 1530, 1531,
-	1532, 1533, 1534, 1535, 1536, 1537, 1538, 1539, 1540, 1541,
-	1542, 1543, 1544, 1545, 1546, 1547, 1548, 1549, 1550, 1551,
-	1552, 1553, 1554, 1555, 1556, 1557, 1558, 1559, 1560, 1561,
-	1562, 1563, 1564, 1565, 1566, 1567, 1568, 1569, 1570, 1571,
-	1572, 1573, 1574, 1575, 1576, 1577, 1578, 1579, 531, 232,
-	472, 473, 573, 573, 443, 0, 267, 0, 1435, 271,
-	0, 0, 0, 440, 262, 263, 264, 265, 266, 0,
-	695, 0, 0, 258, 0, 226, 1494, 0, 0, 0,
-	0, 0, 0, 111, 770, 113, 772, 117, 124, 0,
-	0, 129, 130, 133, 134, 135, 136, 137, 0, 141,
-	0, 143, 146, 0, 148, 149, 0, 152, 153, 154,
-	0, 164, 165, 166, 773, 774, 775, -2, 42, 710,
-	1360, 1255, 0, 1262, 1263, 1274, 1285, 1051, 1052, 1053,
-	1054, 0, 0, 0, 0, 0, 1061, 1062, 0, 1076,
-	1594, 0, 1068, 1069, 1070, 1071, 1072, 51, 63, 64,
-	1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313,
-	0, 1228, 1038, 978, 0, 1602, 0, 1622, 1627, 1628,
-	1629, 1630, 1621, 0, 0, 1213, 0, 1203, 0, 0,
-	-2, -2, 0, 0, 1562, -2, 1599, 1618, 1626, 1603,
-	1625, 1596, 1597, 1591, 1592, 1593, 1595, 1604, 1606, 1617,
-	0, 1613, 1623, 1624, 0, 0, 65, 66, -2, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, 1219, -2, 1221, 1222, 1224, 1225, 1226, 1227, -2,
-	1230, 1231, 1232, -2, -2, 1235, 1236, 1237, 1238, 1239,
-	1240, 1243, -2, 1245, -2, -2, 1215, 1216, 1217, 1218,
-	1207, 1208, 1209, 1210, 1211, 1212, -2, -2, -2, 0,
-	204, 202, 573, 646, 0, -2, 0, 0, 0, 593,
-	596, 599, 602, 0, 36, 37, 0, 0, 802, 802,
-	802, 802, 802, 0, 802, 0, 0, 0, 802, 777,
-	778, 779, 800, 801, 848, 488, 484, 485, 486, 487,
-	561, 0, 563, 566, 421, 369, 0, 0, 0, 381,
-	375, 0, 0, 0, 421, 0, 0, 568, 568, 0,
-	424, 431, 421, 421, -2, 421, 421, 421, 0, 387,
-	388, 389, 375, 0, 375, 393, 394, 395, 406, 407,
-	432, 1384, 0, 0, 320, 0, 320, 0, 320, 320,
-	490, 221, 222, 210, 212, 0, 216, 0, 209, 1494,
-	0, 0, 178, 1562, 183, 0, 1442, 1508, 1457, 0,
-	0, 1475, 0, -2, 0, 248, 568, 0, 574, 0,
-	573, 0, 0, 320, 320, 320, 320, 320, 320, 320,
-	320, 0, 0, 320, 0, 0, 611, 612, 607, 608,
-	609, 613, 614, 3, 0, 0, 0, 535, 0, 0,
-	568, -2, 0, 444, 268, 982, 0, 0, 272, 273,
-	0, 0, 288, 0, 291, 276, 277, 278, 0, 0,
-	260, 261, 0, 0, 228, 0, 0, 0, 321, 0,
-	0, 0, 0, 0, 0, 121, 118, 125, 128, 138,
-	145, 0, 157, 159, 162, 119, 126, 131, 132, 139,
-	160, 120, 122, 123, 127, 161, 163, 140, 144, 158,
-	142, 147, 150, 151, 156, 0, 92, 0, 0, 0,
-	0, 0, 1261, 0, 0, 1293, 1294, 1295, 1296, 1297,
-	1298, 1299, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	-2, 1255, 0, 0, 1057, 1058, 1059, 1060, 1063, 0,
-	1077, 0, 0, 0, 1314, 0, 1253, 1253, 0, 1253,
-	1249, 0, 0, 0, 0, 0, 0, 1253, 1191, 0,
-	0, 1193, 1204, 0, 0, 0, 1197, 1198, 1253, 0,
-	1253, 1202, 1187, 1188, 0, 1249, 1249, 0, 0, 1249,
-	1249, 1249, 1249, 1249, 1249, 1249, 1249, 1249, 1249, 1249,
-	1249, 0, 0, 1361, 1379, 1316, 1317, 1318, 1366, 1320,
-	1370, 1370, 1370, 1370, 1348, 1349, 1350, 1351, 1352, 1353,
-	1354, 1355, 1356, 0, 0, 1359, 1339, 1368, 1368, 1368,
-	1366, 1363, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328,
-	1329, 1330, 1331, 1332, 1333, 1334, 1373, 1373, 1376, 1373,
-	0, 568, 0, 0, 556, 0, 537, 0, 590, 592,
-	0, 594, 595, 597, 598, 600, 601, 603, 604, 38,
-	0, 709, 0, 712, 0, 0, 0, 0, 0, 0,
-	0, 0, 802, 802, 802, 0, 490, 489, 0, 562,
-	0, 0, 619, 0, 0, 0, 375, 421, 380, 377,
-	376, 427, 428, 424, 0, 424, 424, 619, 0, 400,
-	401, 402, 421, 421, 408, 569, 409, 410, 424, 0,
-	429, 430, 619, 0, 0, 619, 619, 0, 418, 419,
-	420, 0, 0, 802, 0, 377, 391, 377, 1385, 1386,
-	0, 811, 0, 0, 0, 439, 0, 0, 0, 491,
-	0, 0, 214, 0, 219, 171, 0, 0, 0, 0,
-	0, 0, 200, 201, 0, 0, 0, 0, 0, 191,
-	194, 936, 937, 767, 768, 195, 196, 240, 241, 0,
-	537, 589, 591, 585, 586, 587, 0, 0, 0, 0,
-	0, 0, 0, 0, 468, 0, 0, 627, 621, 623,
-	690, 51, 627, 0, 510, 523, 505, 0, 512, 0,
-	943, 492, 523, 494, 0, 512, 537, 559, 537, 0,
-	269, 0, 274, 0, 0, 290, 292, 293, 294, 279,
-	280, 441, 256, 257, 249, 250, 251, 252, 253, 254,
-	255, 259, 61, 0, 229, 230, 0, 0, 0, 105,
-	106, 107, 108, 109, 110, 112, 96, 460, 462, 759,
-	771, 0, 762, 0, 115, 155, 88, 0, 0, 1256,
-	1257, 1258, 1259, 1260, 1264, 0, 1266, 1268, 1270, 1272,
-	0, 1290, -2, -2, 1039, 1040, 1041, 1042, 1043, 1044,
-	1045, 1046, 1047, 1048, 1049, 1050, 1275, 1288, 1289, 0,
-	0, 0, 0, 0, 0, 1286, 1286, 1281, 0, 1055,
-	0, 1074, 1078, 0, 0, 0, 52, 1248, 1158, 1159,
-	1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169,
-	1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179,
-	1180, 1181, 1182, 1183, 1184, 1185, 1186, 0, 1254, 0,
-	1255, 0, 0, 0, 1250, 1251, 0, 0, 0, 1146,
-	0, 0, 1152, 1153, 1154, 0, 518, 0, 1214, 1192,
-	1205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 205, 0, 1382, 1380,
-	1381, 1319, 1367, 0, 1344, 0, 1345, 1346, 1347, 0,
-	0, 1340, 0, 1341, 1342, 1343, 1335, 0, 1336, 1337,
-	0, 1338, 203, 645, 647, 0, 527, 529, 530, 0,
-	557, 570, 575, 576, 579, 34, 39, 0, 714, 0,
-	566, 0, 0, 726, 318, 753, 0, 0, 769, 792,
-	798, 0, 0, 0, 826, 0, 564, 0, 0, 659,
-	368, 0, 422, 423, 372, 1494, 377, 619, 382, 378,
-	383, 426, 384, 385, 386, 0, 619, 619, 421, 424,
-	411, 425, 424, 414, 415, 0, 433, 437, 434, 0,
-	436, 390, 392, 566, 303, 0, 0, -2, 892, 1387,
-	1387, 0, 1387, 1387, 1387, 1387, 0, 0, 1387, 1387,
-	1387, 1387, 1387, 1387, 1387, 1387, 1387, 1387, 1387, 1387,
-	1387, 1387, 0, 812, 315, 0, 0, 318, 743, 632,
-	0, 633, 634, 630, 661, 685, 685, 0, 685, 665,
-	942, 223, 224, 0, 0, 218, 172, 173, 0, 175,
-	176, 177, 184, 179, 181, 0, 0, 185, 197, 198,
-	199, 0, 0, 0, 189, 190, 0, 0, 243, 244,
-	246, 0, 555, 457, 940, 458, 459, 463, 0, 465,
-	466, 467, 714, 748, 619, 0, 628, 0, 624, 691,
-	0, 693, 0, 619, 539, 499, 0, 504, 520, 0,
-	524, 0, 0, 516, 509, 513, 0, 0, 533, 493,
-	0, 0, 498, 558, 560, 983, 0, 275, 289, 0,
-	0, 0, 0, 99, 756, 0, 100, 104, 94, 0,
-	0, 0, 761, 0, 758, 763, 0, 114, 0, 0,
-	89, 90, 817, 822, 0, 1265, 1267, 1269, 1271, 1273,
-	0, 1276, 1286, 1286, 1282, 0, 1277, 0, 1279, 0,
-	1256, 0, 1079, 0, 0, 0, 0, 0, 0, 1136,
-	1137, 0, 0, 1141, 0, 1143, 1144, 1145, 1147, 0,
-	0, 0, 1151, 0, 1190, 1206, 1194, 1195, 0, 1199,
-	0, 1201, 0, 573, 0, 1113, 1113, 0, 0, 0,
-	0, 1113, 0, 0, 0, 0, 0, 0, 0, 0,
-	1073, 0, 1362, 1315, 1383, 0, 0, 0, 1364, 0,
-	0, 0, 0, 0, 648, 539, 0, 0, 0, 582,
-	580, 581, 0, 0, 715, 716, 718, 719, 0, -2,
+	30, 31, 32, 33, 769, 770, 771, 772, 773, 0,
+	0, 0, 512, 513, 0, 484, 0, 0, 0, 0,
+	0, 0, 382, 383, 384, 385, 386, 387, 388, 389,
+	390, 391, 392, 393, 394, 395, 396, 397, 398, 399,
+	400, 401, 402, 403, 404, 405, 406, 322, 323, 324,
+	325, 326, 327, 0, 256, 252, 215, 216, 217, 218,
+	219, 168, 169, 170, 171, 172, 263, 264, 359, 0,
+	0, 0, 0, 0, 0, 595, -2, 36, 774, 775,
+	776, 777, 778, 779, 780, 781, 782, -2, 525, 0,
+	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 496, 497, 310, 311, 312, 306, 307, 309, 308,
+	-2, 0, 525, 237, 0, 228, 228, 0, 0, 0,
+	0, 0, 615, 0, 0, 630, 649, 34, 0, 573,
+	0, 578, 1018, 1054, 1055, 1056, 1057, 1783, 1784, 1785,
+	1786, 1787, 1788, 1789, 1790, 1791, 1792, 1793, 1794, 1795,
+	1796, 1797, 1798, 1799, 1800, 1801, 1802, 1803, 1804, 1805,
+	1806, 1807, 1808, 1809, 1810, 1811, 1812, 1813, 1814, 1815,
+	1816, 1817, 1818, 1819, 1820, 1821, 1822, 1823, 1564, 1565,
+	1566, 1567, 1568, 1569, 1570, 1571, 1572, 1573, 1574, 1575,
+	1576, 1577, 1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585,
+	1586, 1587, 1588, 1589, 1590, 1591, 1592, 1593, 1594, 1595,
+	1596, 1597, 1598, 1599, 1600, 1601, 1602, 1603, 1604, 1605,
+	1606, 1607, 1608, 1609, 1610, 1611, 1612, 1613, 1614, 1615,
+	1616, 1617, 1618, 1619, 1620, 1621, 1622, 1623, 1624, 1625,
+	1626, 1627, 1628, 1629, 1630, 1631, 1632, 1633, 1634, 1635,
+	1636, 1637, 1638, 1639, 1640, 1641, 1642, 1643, 1644, 1645,
+	1646, 1647, 1648, 1649, 1650, 1651, 1652, 1653, 1654, 1655,
+	1656, 1657, 1658, 1659, 1660, 1661, 1662, 1663, 1664, 1665,
+	1666, 1667, 1668, 1669, 1670, 1671, 1672, 1673, 1674, 1675,
+	1676, 1677, 1678, 1679, 1680, 1681, 1682, 1683, 1684, 1685,
+	1686, 1687, 1688, 1689, 1690, 1691, 1692, 1693, 1694, 1695,
+	1696, 1697, 1698, 1699, 1700, 1701, 1702, 1703, 1704, 1705,
+	1706, 1707, 1708, 1709, 1710, 1711, 1712, 1713, 1714, 1715,
+	1716, 1717, 1718, 1719, 1720, 1721, 1722, 1723, 1724, 1725,
+	1726, 1727, 1728, 1729, 1730, 1731, 1732, 1733, 1734, 1735,
+	1736, 1737, 1738, 1739, 1740, 1741, 1742, 1743, 1744, 1745,
+	1746, 1747, 1748, 1749, 1750, 1751, 1752, 1753, 1754, 1755,
+	1756, 1757, 1758, 1759, 1760, 1761, 1762, 1763, 1764, 1765,
+	1766, 1767, 1768, 1769, 1770, 1771, 1772, 573, 257, 514,
+	515, 615, 615, 482, 0, 292, 0, 1618, 296, 0,
+	0, 0, 479, 287, 288, 289, 290, 291, 0, 768,
+	0, 0, 283, 0, 251, 1678, 0, 0, 0, 0,
+	0, 0, 112, 846, 114, 848, 118, 125, 0, 0,
+	130, 131, 134, 135, 136, 137, 138, 0, 142, 0,
+	144, 147, 0, 149, 150, 0, 153, 154, 155, 0,
+	165, 166, 167, 849, 850, 851, -2, 43, 786, 1456,
+	1350, 0, 1357, 1358, 1369, 1381, 1135, 1136, 1137, 1138,
+	0, 0, 0, 0, 0, 1147, 1148, 0, 1170, 1787,
+	0, 1155, 1156, 1157, 1158, 1159, 0, 52, 64, 65,
+	1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408, 1409,
+	0, 1323, 1122, 1054, 0, 1795, 0, 1815, 1820, 1821,
+	1822, 1823, 1814, 0, 0, 0, 1308, 0, 1298, 0,
+	0, -2, -2, 0, 0, 1749, -2, 1792, 1811, 1819,
+	1796, 1818, 1789, 1790, 1784, 1785, 1786, 1788, 1797, 1799,
+	1810, 0, 1806, 1816, 1817, 0, 0, 66, 67, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	1427, -2, -2, -2, -2, -2, -2, -2, -2, -2,
+	-2, -2, 1314, -2, 1316, 1317, 1319, 1320, 1321, 1322,
+	-2, 1325, 1326, 1327, -2, -2, 1330, 1331, 1332, 1333,
+	1334, 1335, 1338, -2, 1340, -2, -2, 1310, 1311, 1312,
+	1313, 1302, 1303, 1304, 1305, 1306, 1307, -2, -2, -2,
+	0, 209, 207, 1459, 1463, 615, 703, 0, -2, 0,
+	0, 0, 635, 638, 641, 644, 0, 37, 38, 0,
+	0, 0, 878, 878, 878, 878, 878, 0, 878, 0,
+	0, 0, 878, 0, 853, 854, 855, 876, 877, 924,
+	530, 526, 527, 528, 529, 603, 0, 605, 608, 460,
+	408, 0, 0, 0, 420, 414, 0, 0, 0, 460,
+	0, 0, 610, 610, 0, 463, 470, 460, 460, -2,
+	460, 460, 460, 0, 426, 427, 428, 414, 0, 414,
+	432, 433, 434, 445, 446, 471, 1559, 0, 0, 359,
+	0, 359, 0, 359, 359, 532, 238, 239, 227, 229,
+	0, 233, 0, 220, 0, 229, 225, 0, 1718, 1625,
+	1694, 1678, 0, 0, 183, 1749, 188, 0, 1640, 0,
+	0, 1659, 0, -2, 0, 273, 610, 0, 616, 0,
+	615, 0, 0, 0, 359, 359, 359, 359, 359, 359,
+	359, 359, 0, 0, 359, 359, 656, 650, 3, 0,
+	0, 0, 577, 0, 0, 610, -2, 0, 483, 293,
+	1058, 0, 0, 297, 298, 0, 0, 313, 0, 316,
+	301, 302, 303, 0, 0, 285, 286, 0, 321, 253,
+	0, 0, 0, 360, 0, 0, 0, 0, 0, 0,
+	122, 119, 126, 129, 139, 146, 0, 158, 160, 163,
+	120, 127, 132, 133, 140, 161, 121, 123, 124, 128,
+	162, 164, 141, 145, 159, 143, 148, 151, 152, 157,
+	0, 93, 0, 0, 0, 0, 0, 1356, 0, 0,
+	1389, 1390, 1391, 1392, 1393, 1394, 1395, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, -2, 1350,
+	0, 0, 1143, 1144, 1145, 1146, 1149, 0, 1171, 0,
+	0, 0, 0, 1410, 0, 1348, 1348, 0, 1348, 1344,
+	0, 0, 0, 0, 0, 0, 1348, 0, 1286, 0,
+	0, 1288, 1299, 0, 0, 0, 1292, 1293, 1348, 0,
+	1348, 1297, 1281, 1282, 0, 1344, 1344, 0, 0, 1344,
+	1344, 1344, 1344, 1344, 1344, 1344, 1344, 1344, 1344, 1344,
+	1344, 0, 0, 1457, 1554, 1412, 1413, 1414, 1541, 1416,
+	1545, 1545, 1545, 1545, 1444, 1445, 1446, 1447, 1448, 1449,
+	1450, 1451, 1452, 0, 0, 1455, 1435, 1543, 1543, 1543,
+	1541, 1538, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424,
+	1425, 1426, 1427, 1428, 1429, 1430, 1548, 1548, 1551, 1548,
+	0, 1348, 0, 610, 0, 0, 598, 0, 579, 0,
+	632, 634, 0, 636, 637, 639, 640, 642, 643, 645,
+	646, 39, 0, 0, 785, 0, 788, 0, 0, 0,
+	0, 0, 0, 0, 0, 878, 878, 878, 0, 0,
+	532, 531, 0, 604, 0, 0, 676, 0, 0, 0,
+	414, 460, 419, 416, 415, 466, 467, 463, 0, 463,
+	463, 676, 0, 439, 440, 441, 460, 460, 447, 611,
+	448, 449, 463, 0, 468, 469, 676, 0, 0, 676,
+	676, 0, 457, 458, 459, 0, 0, 878, 0, 416,
+	430, 416, 1560, 1561, 0, 887, 0, 0, 0, 478,
+	0, 0, 0, 533, 240, 241, 0, 0, 0, 231,
+	0, 236, 221, 1692, 0, 226, 173, 1718, 0, 1718,
+	0, 176, 0, 0, 0, 0, 0, 0, 205, 206,
+	0, 0, 0, 196, 199, 1012, 1013, 843, 844, 200,
+	201, 265, 266, 0, 579, 631, 633, 627, 628, 629,
+	359, 0, 0, 0, 0, 0, 0, 0, 0, 510,
+	0, 0, 0, 0, 0, 657, 658, 653, 654, 655,
+	659, 660, 0, 552, 565, 547, 0, 554, 0, 1019,
+	534, 565, 536, 0, 554, 579, 601, 579, 0, 294,
+	0, 299, 0, 0, 315, 317, 318, 319, 304, 305,
+	480, 281, 282, 274, 275, 276, 277, 278, 279, 280,
+	284, 62, 0, 254, 255, 0, 0, 0, 106, 107,
+	108, 109, 110, 111, 113, 97, 502, 504, 835, 847,
+	0, 838, 0, 116, 156, 89, 0, 0, 1351, 1352,
+	1353, 1354, 1355, 1359, 0, 1361, 1363, 1365, 1367, 0,
+	1386, -2, -2, 1123, 1124, 1125, 1126, 1127, 1128, 1129,
+	1130, 1131, 1132, 1133, 1134, 1370, 1384, 1385, 0, 0,
+	0, 0, 0, 0, 1382, 1382, 1376, 0, 0, 1139,
+	1140, 1141, 0, 1168, 1172, 0, 0, 0, 0, 53,
+	1343, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
+	1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270,
+	1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280,
+	0, 1349, 0, 1350, 0, 0, 0, 1345, 1346, 0,
+	0, 0, 1240, 0, 0, 1246, 1247, 1248, 0, 560,
+	0, 0, 1309, 1287, 1300, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	210, 0, 1557, 1555, 1556, 1415, 1542, 0, 1440, 0,
+	1441, 1442, 1443, 0, 0, 1436, 0, 1437, 1438, 1439,
+	1431, 0, 1432, 1433, 0, 1434, 208, 0, 1464, 702,
+	704, 0, 569, 571, 572, 0, 599, 612, 617, 618,
+	621, 35, 40, 0, 0, 790, 0, 608, 0, 0,
+	802, 357, 829, 0, 0, 845, 868, 874, 0, 0,
+	0, 902, -2, 0, 606, 0, 0, 716, 407, 0,
+	461, 462, 411, 1678, 416, 676, 421, 417, 422, 465,
+	423, 424, 425, 0, 676, 676, 460, 463, 450, 464,
+	463, 453, 454, 0, 472, 476, 473, 0, 475, 429,
+	431, 608, 329, -2, 331, 968, 0, 340, 340, 0,
+	0, 1562, 1562, 0, 1562, 1562, 1562, 1562, 0, 0,
+	1562, 1562, 1562, 1562, 1562, 1562, 1562, 1562, 1562, 1562,
+	1562, 1562, 1562, 1562, 0, 888, 354, 0, 0, 357,
+	819, 689, 0, 690, 691, 687, 718, 758, 758, 0,
+	758, 722, 1018, 0, 0, 243, 244, 0, 0, 0,
+	235, 222, 223, 1692, 174, 0, 175, 0, 177, 178,
+	0, 180, 181, 182, 189, 1625, 1694, 184, 186, 0,
+	0, 190, 202, 203, 204, 0, 194, 195, 0, 0,
+	268, 269, 271, 0, 597, 0, 499, 1016, 500, 501,
+	505, 0, 507, 508, 509, 790, 824, 1462, 651, 678,
+	680, 763, 52, 651, 581, 541, 0, 546, 562, 0,
+	566, 0, 0, 558, 551, 555, 0, 0, 575, 535,
+	0, 0, 540, 600, 602, 1059, 0, 300, 314, 0,
+	0, 0, 0, 100, 832, 0, 101, 105, 95, 0,
+	0, 0, 837, 0, 834, 839, 0, 115, 0, 0,
+	90, 91, 893, 898, 0, 1360, 1362, 1364, 1366, 1368,
+	0, 1371, 1382, 1382, 1377, 0, 1372, 0, 1374, 0,
+	0, 1351, 0, 1173, 0, 0, 0, 0, 0, 0,
+	0, 1230, 1231, 0, 0, 1235, 0, 1237, 1238, 1239,
+	1241, 0, 0, 0, 1245, 0, 1284, 1285, 1301, 1289,
+	1290, 0, 1294, 0, 1296, 0, 615, 0, 1207, 1207,
+	0, 0, 0, 0, 1207, 0, 0, 0, 0, 0,
+	0, 0, 0, 1166, 0, 1458, 1411, 1558, 0, 0,
+	0, 1539, 0, 0, 0, 0, 1460, 0, 705, 581,
+	0, 0, 0, 624, 622, 623, 783, 0, 0, 791,
+	792, 794, 795, 0, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, -2, 1606, -2, -2, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	713, 0, 803, 733, 1387, 322, 0, 0, 755, 0,
-	0, 0, -2, 0, 0, 0, 0, 828, 0, 0,
-	0, 477, 481, 33, 567, 0, 620, 370, 0, 371,
-	421, 379, 619, 942, 403, 404, 619, 421, 421, 424,
-	0, 435, 0, 304, 948, 949, 0, 0, 951, 1008,
-	0, 960, 802, 960, 0, 0, 962, 963, 305, 0,
-	0, 0, 0, 0, 811, 894, 0, 1388, 0, 1387,
-	1387, 0, 0, 0, 0, 1387, 1387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 733, 1387, 0, 0, 322, 740, 0, 0,
-	0, 0, 0, 0, 652, 0, 0, 651, 0, 0,
-	0, 0, 0, 566, 686, 0, 688, 689, 663, -2,
-	0, 632, 668, 1253, 225, 213, 215, 0, 0, 0,
-	0, 186, 187, 188, 192, 193, 242, 245, 247, 0,
-	0, 0, 617, 622, 629, 692, 694, 52, 625, 617,
-	543, 0, 0, 523, 525, 0, 0, 523, 0, 0,
-	532, 0, 0, 523, 0, 0, 442, 62, 295, 0,
-	0, 0, 0, 0, 461, 0, 760, 96, 0, 0,
-	116, 0, 0, 820, 0, 822, 1252, 1278, 1280, 0,
-	1287, 1283, 1056, 1064, 1075, 0, 0, 1081, 1093, 1093,
-	0, 1084, 1370, 1370, 1087, 1366, 1368, 1366, 1093, 1093,
-	0, 53, 0, 0, 1142, 0, 0, 0, 519, 0,
-	0, 0, 1111, 1113, 1118, 1114, 1119, 1113, 1113, 1113,
-	1113, 1124, 1113, 1113, 1113, 1113, 1113, 1113, 1113, 1113,
-	1109, 1372, 1371, 1357, 0, 1358, 1369, 1374, 0, 1377,
-	0, 528, 543, 571, 572, 577, 578, 0, 0, 0,
-	0, 720, 0, 736, 734, 735, 0, 750, 323, 324,
-	325, 326, 0, 0, 0, 754, 0, 500, 0, 793,
-	794, 795, 796, 797, -2, 806, 0, 0, 944, 944,
-	944, 836, 0, 827, 531, 0, -2, 0, 0, 479,
-	0, 0, 660, 373, 619, 396, 0, 412, 619, 619,
-	421, 438, 0, -2, 950, 1009, 972, 972, 961, 972,
-	972, 802, 0, 307, 308, 309, 0, 311, 893, 895,
-	896, 0, 0, 899, 900, 901, 902, 0, 0, 905,
-	906, 907, 908, 909, 910, 911, 912, 913, 914, 930,
-	931, 932, 933, 934, 935, 915, 916, 917, 918, 919,
-	920, 927, 0, 0, 924, 0, 736, 0, 0, 0,
-	750, 742, 0, 744, 745, 0, 0, 502, 619, 235,
-	0, 655, 649, 0, 638, 653, 654, 641, 0, 643,
-	0, 639, 640, 619, 631, 662, 687, 664, 667, 669,
-	670, 676, 0, 0, 0, 0, 217, 174, 0, 342,
-	180, 941, 464, 469, 615, 0, 0, 615, 545, 76,
-	0, 0, 0, 521, 0, 526, 523, 508, 517, 507,
-	514, 515, 534, 523, 497, 496, 984, 270, 0, 757,
-	96, 101, 102, 103, 97, 95, 764, 0, 766, 0,
-	818, 822, 0, 0, 1284, 1080, 1065, 1082, 1094, 1095,
-	1083, 1066, 1085, 1086, 1088, 1089, 1090, 1091, 1092, 1067,
-	1138, 0, 1140, 1148, 1149, 0, 1196, 1200, 0, 0,
-	0, 1117, 1120, 1121, 1122, 1123, 1125, 1126, 1127, 1128,
-	1129, 1130, 1131, 1132, 573, 1110, 0, 1365, 0, 0,
-	545, 583, 584, 708, 0, 717, 0, 724, 725, 0,
-	0, 728, 729, 738, 0, 0, 0, 328, 329, 0,
-	0, 0, 341, 337, 338, 339, 319, 749, 740, 0,
-	0, 807, 1387, 1387, 1387, 0, 0, 945, 946, 0,
-	0, 839, 0, 0, 830, 0, 685, 0, 0, 619,
-	478, 481, 482, 565, 374, 619, 416, 413, 619, 302,
-	974, -2, 987, 989, 0, 0, 992, 993, 0, 0,
-	0, 0, 1030, 999, 0, 0, 1003, 0, 1302, 1303,
-	0, 1007, 0, 964, 973, 0, 973, 0, 0, 972,
-	0, 310, 897, 898, 903, 904, 921, 0, 0, 923,
-	0, 0, 312, 0, 0, 313, 317, 741, 0, 746,
-	747, 573, 0, 0, 635, 656, 0, 0, 636, 0,
-	637, 642, 644, 234, 671, 0, 0, 673, 674, 675,
-	666, 182, 605, 0, 0, 626, 606, 547, 0, 544,
-	77, 0, 0, 0, 0, 522, 506, 495, 98, 93,
-	765, 79, 821, 823, 819, 0, 1150, 0, 1113, 1112,
-	1106, 0, 1375, 1378, 547, 0, 723, 721, 737, 727,
-	0, 751, 752, 0, 330, 331, 0, 334, 340, 739,
-	501, 0, 0, 0, 0, 799, -2, 0, 0, -2,
-	842, 0, 837, 0, 829, 0, 832, 619, 619, -2,
-	475, 480, 0, 397, 417, 988, 990, 991, 994, 995,
-	938, 939, 996, 1035, 1036, 1037, 997, 1032, 1033, 1034,
-	998, 0, 0, 0, 1300, 1301, 1028, 0, 0, 0,
-	0, 0, 0, 0, 958, 928, 929, 922, 925, 926,
-	316, 314, 503, 568, 236, 237, 657, 0, 650, 680,
-	677, 0, 0, 616, 618, 549, 0, 546, 0, 540,
-	542, 87, 511, 49, 70, 0, 1139, 1189, 1116, 0,
-	1107, 0, 1101, 1102, 1103, 1108, 549, 0, 0, 0,
-	327, 332, 0, 335, 336, 0, 788, 1366, 0, 808,
-	809, 810, 849, -2, 947, 814, 79, 849, 833, 0,
-	840, 0, 838, 831, 573, 476, 0, 0, 1203, 1023,
-	0, 0, 965, 967, 968, 969, 970, 971, 966, 0,
-	0, 0, 0, 957, 959, 1004, 0, 233, 0, 0,
-	681, 683, 678, 679, 40, 0, 548, 78, 0, 41,
-	0, 67, 0, 80, 81, 0, 0, 0, 0, 1115,
-	1104, 0, 0, 0, 0, 538, 0, 722, 730, 0,
-	732, 333, 781, 0, 579, 0, 851, 0, 816, 851,
-	825, 0, 835, 0, 844, 0, 841, 568, 1031, 0,
-	1002, 1011, 1024, 0, 0, 781, 781, 781, 781, 0,
-	1005, 658, 672, 0, 0, 0, 50, 54, 0, 76,
-	73, 0, 82, 0, 0, 0, 0, 0, 1096, 1097,
-	1099, 1098, 1100, 0, 731, 780, 789, 790, 579, 813,
-	0, 888, 815, 834, 843, 0, 846, 474, 1000, 1010,
-	1012, 1013, 0, 1025, 1026, 1027, 1029, 952, 953, 954,
-	955, 0, 682, 684, 0, 551, 541, 43, 0, 0,
-	71, 72, 74, 0, 83, 0, 85, 86, 0, 711,
-	782, 1387, 0, 0, 786, 787, 791, 0, 876, 0,
-	0, 882, 0, 889, 845, 1001, 1014, 0, 1015, 0,
-	0, 0, 956, 550, 0, 824, 0, 55, 0, 57,
-	59, 60, 975, 68, 69, 75, 84, 1105, 0, 784,
-	0, 852, 0, 854, 0, 0, 0, 0, 0, 886,
-	0, 1016, 1018, 1019, 0, 0, 1017, 552, 44, 45,
-	0, 56, 0, 0, 783, 785, 0, 856, 0, 877,
-	0, 0, 0, 0, 0, 0, 0, 1020, 1022, 1021,
-	0, 0, 58, 976, 853, 850, 0, 888, 878, 0,
-	880, 0, 0, 0, 0, 46, 47, 48, 0, 0,
-	858, 0, 874, 879, 881, 883, 0, 887, 885, 977,
-	857, 0, 870, 855, 0, 884, 859, -2, 0, 875,
-	860, -2, 0, 868, 0, 0, 861, 869, 0, 864,
-	0, 0, 0, 863, 0, -2, 871, 0, 0, 865,
-	-2, 0, 873, 872,
+	-2, -2, -2, -2, -2, 789, 0, 879, 809, 1562,
+	361, 0, 0, 831, 0, 0, 0, -2, 0, 0,
+	0, 0, 904, 0, 0, 1466, 1467, 0, 1471, 1472,
+	1473, 0, 0, 519, 523, 34, 609, 0, 677, 409,
+	0, 410, 460, 418, 676, 1018, 442, 443, 676, 460,
+	460, 463, 0, 474, 0, 0, 887, 970, 333, 1024,
+	0, 1025, 0, 0, 1027, 1090, 0, 1036, 878, 1036,
+	0, 0, 1038, 1039, 0, 341, 0, 0, 339, 0,
+	0, 0, 0, 0, 0, 1563, 0, 1562, 1562, 0,
+	0, 0, 0, 1562, 1562, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	809, 1562, 0, 0, 361, 816, 0, 0, 0, 0,
+	0, 0, 709, 0, 0, 708, 0, 0, 0, 0,
+	0, 608, 759, 0, 761, 762, 720, -2, 0, 689,
+	741, 758, 0, 1348, 0, 242, 245, 246, 0, 0,
+	250, 230, 232, 0, 224, 191, 192, 0, 0, 0,
+	193, 197, 198, 267, 270, 272, 0, 0, 0, 0,
+	684, 0, 0, 681, 764, 0, 766, 0, 684, 585,
+	0, 0, 565, 567, 0, 0, 565, 0, 0, 574,
+	0, 0, 565, 0, 0, 481, 63, 320, 0, 0,
+	0, 0, 0, 503, 0, 836, 97, 0, 0, 117,
+	0, 0, 896, 0, 898, 1347, 1373, 1375, 0, 1383,
+	0, 1379, 1142, 1150, 1169, 0, 0, 1175, 1187, 1187,
+	0, 1178, 1545, 1545, 1181, 1541, 1543, 1541, 1187, 1187,
+	0, 0, 54, 1167, 0, 0, 1236, 0, 0, 0,
+	561, 0, 0, 0, 1205, 1207, 1212, 1208, 1213, 1207,
+	1207, 1207, 1207, 1218, 1207, 1207, 1207, 1207, 1207, 1207,
+	1207, 1207, 1203, 1547, 1546, 1453, 0, 1454, 1544, 1549,
+	0, 1552, 0, 570, 585, 613, 614, 619, 620, 0,
+	0, 0, 0, 796, 0, 812, 810, 811, 0, 826,
+	362, 363, 364, 365, 0, 0, 0, 830, 0, 542,
+	0, 869, 870, 871, 872, 873, -2, 882, 0, 0,
+	1020, 1020, 1020, 912, 0, 903, 0, 1470, 0, 573,
+	0, -2, 0, 0, 521, 0, 0, 717, 412, 676,
+	435, 0, 451, 676, 676, 460, 477, 0, 332, 969,
+	334, 344, 0, 342, -2, 1026, 1091, 1048, 1048, 1037,
+	1048, 1048, 878, 0, 342, 0, 0, 346, 347, 348,
+	0, 350, 971, 972, 0, 0, 975, 976, 977, 978,
+	0, 0, 981, 982, 983, 984, 985, 986, 987, 988,
+	989, 990, 1006, 1007, 1008, 1009, 1010, 1011, 991, 992,
+	993, 994, 995, 996, 1003, 0, 0, 1000, 0, 812,
+	0, 0, 0, 826, 818, 0, 820, 821, 0, 0,
+	544, 676, 260, 0, 712, 706, 0, 695, 710, 711,
+	698, 0, 700, 0, 696, 697, 676, 688, 719, 760,
+	721, 737, 742, 743, 749, 0, 0, 0, 741, 0,
+	0, 0, 247, 248, 249, 234, 179, 0, 381, 185,
+	498, 1017, 506, 511, 676, 685, 0, 679, 652, 213,
+	211, 212, 765, 767, 53, 682, 676, 587, 77, 0,
+	0, 0, 563, 0, 568, 565, 550, 559, 549, 556,
+	557, 576, 565, 539, 538, 1060, 295, 0, 833, 97,
+	102, 103, 104, 98, 96, 840, 0, 842, 0, 894,
+	898, 0, 0, 1380, 1378, 1174, 1151, 0, 1176, 1188,
+	1189, 1177, 1153, 1179, 1180, 1182, 1183, 1184, 1185, 1186,
+	1154, 0, 1232, 0, 1234, 1242, 1243, 0, 1291, 1295,
+	0, 0, 0, 1211, 1214, 1215, 1216, 1217, 1219, 1220,
+	1221, 1222, 1223, 1224, 1225, 1226, 615, 1204, 0, 1540,
+	0, 0, 587, 625, 626, 784, 0, 793, 0, 800,
+	801, 0, 0, 804, 805, 814, 0, 0, 0, 367,
+	368, 0, 0, 0, 380, 376, 377, 378, 358, 825,
+	816, 0, 0, 883, 1562, 1562, 1562, 0, 0, 1021,
+	1022, 1024, 0, 0, 915, 0, 0, 906, 0, 1461,
+	1476, 1477, 1478, 1479, 1480, 1481, 1482, 1483, 1484, 1485,
+	1486, 1487, 1488, 1489, 1490, 1491, 1492, 1493, 1494, 1495,
+	1496, 1497, 1498, 1499, 1500, 1501, 1502, -2, 0, 0,
+	0, 1474, 1474, 0, 0, 0, 1667, 1534, 1565, 1601,
+	1468, 1469, 758, 0, 0, 676, 520, 523, 524, 607,
+	413, 676, 455, 452, 676, 328, 345, 335, 343, 1050,
+	-2, 1063, 1065, 0, 0, 1068, 1069, 0, 0, 0,
+	0, 0, 1112, 1076, 0, 0, 1080, 0, 0, 0,
+	1398, 1399, 0, 1089, 0, 1040, 1049, 0, 1049, 0,
+	0, 1048, 0, 336, 342, 0, 349, 973, 974, 979,
+	980, 997, 0, 0, 999, 0, 0, 351, 0, 0,
+	352, 356, 817, 0, 822, 823, 615, 0, 0, 692,
+	713, 0, 0, 693, 0, 694, 699, 701, 259, 744,
+	0, 0, 746, 747, 748, 738, 0, 0, 723, 0,
+	187, 663, 686, 0, 0, 663, 589, 0, 586, 78,
+	0, 0, 0, 0, 564, 548, 537, 99, 94, 841,
+	80, 897, 899, 895, 1152, 1161, 0, 1244, 0, 1207,
+	1206, 1200, 0, 1550, 1553, 589, 0, 799, 797, 813,
+	803, 0, 827, 828, 0, 369, 370, 0, 373, 379,
+	815, 543, 0, 0, 0, 0, 875, -2, 0, 0,
+	-2, 918, 0, 913, 0, 905, 0, 908, 0, 0,
+	0, 0, 0, 0, 1518, 1519, 207, 0, 1522, 1523,
+	1532, 0, 1535, 0, 1537, 676, 676, -2, 517, 522,
+	0, 436, 456, 1064, 1066, 1067, 1070, 1071, 1014, 1015,
+	1072, 1119, 1120, 1121, 1073, 1116, 1117, 1118, 1114, 1075,
+	0, 0, 0, 0, 0, 1396, 1397, 1110, 0, 0,
+	0, 0, 0, 0, 0, 1034, 337, 338, 1004, 1005,
+	998, 1001, 1002, 355, 353, 545, 610, 261, 262, 714,
+	0, 707, 753, 750, 0, 0, 739, 740, 0, 661,
+	0, 214, 683, 661, 591, 0, 588, 0, 582, 584,
+	88, 553, 50, 71, 0, 0, 0, 0, 1233, 1283,
+	1210, 0, 1201, 0, 1195, 1196, 1197, 1202, 591, 0,
+	0, 0, 366, 371, 0, 374, 375, 0, 864, 1541,
+	0, 884, 885, 886, 925, -2, 1023, 890, 80, 925,
+	909, 0, 916, 0, 914, 907, 0, 1505, 1474, 0,
+	1474, 1474, 1474, 1474, 0, 0, 0, 0, 0, 1536,
+	615, 518, 1074, 0, 0, 0, 1298, 0, 0, 1105,
+	0, 0, 1041, 1043, 1044, 1045, 1046, 1047, 1042, 0,
+	0, 0, 0, 1033, 1035, 1086, 0, 258, 0, 0,
+	754, 756, 751, 752, 0, 647, 0, 0, 648, 41,
+	0, 590, 79, 0, 42, 0, 68, 0, 81, 82,
+	0, 0, 0, 0, 1160, 0, 0, 0, 1209, 1198,
+	0, 0, 0, 0, 580, 0, 798, 806, 0, 808,
+	372, 857, 0, 621, 0, 927, 0, 892, 927, 901,
+	0, 911, 0, 920, 0, 917, 1475, 0, 0, 0,
+	0, 1508, 0, 1514, 0, 0, 0, 0, 610, 1115,
+	1113, 0, 1079, 1083, 0, 1093, 1106, 0, 0, 857,
+	857, 857, 857, 0, 1087, 715, 745, 0, 0, 662,
+	664, 666, 668, 1691, 0, 0, 0, 0, 51, 55,
+	0, 77, 74, 0, 83, 0, 0, 0, 0, 0,
+	1164, 1165, 0, 1190, 1191, 1193, 1192, 1194, 0, 807,
+	856, 865, 866, 621, 889, 0, 964, 891, 910, 919,
+	0, 922, 1503, 1474, 0, 0, 1510, 0, 0, 1520,
+	0, 1524, 0, 1528, 1529, 1530, 0, 1533, 516, 1077,
+	1081, 1084, 1085, 0, 1092, 1094, 1095, 0, 1107, 1108,
+	1109, 1111, 1028, 1029, 1030, 1031, 0, 755, 757, 0,
+	725, 0, 1760, 0, 0, 0, 0, 0, 0, 593,
+	583, 44, 0, 0, 72, 73, 75, 0, 84, 0,
+	86, 87, 1162, 0, 787, 858, 1562, 0, 0, 862,
+	863, 867, 0, 952, 0, 0, 958, 0, 965, 921,
+	1506, 1504, 0, 1503, 0, 0, 0, 1474, 1512, 1516,
+	1521, 0, 1526, 0, 1531, 1078, 1083, 1096, 0, 1097,
+	0, 0, 0, 1032, 0, 0, 0, 0, 0, 0,
+	665, 667, 0, 0, 0, 592, 0, 900, 0, 56,
+	0, 58, 60, 61, 1051, 69, 70, 76, 85, 0,
+	1199, 0, 860, 0, 928, 0, 930, 0, 0, 0,
+	0, 0, 962, 0, 0, 1515, 0, 0, 0, 1511,
+	1525, 1527, 1082, 1098, 1100, 1101, 0, 0, 1099, 724,
+	726, 727, 0, 0, 0, 0, 669, 670, 0, 672,
+	0, 675, 594, 45, 46, 0, 57, 0, 0, 0,
+	859, 861, 0, 932, 0, 953, 0, 0, 0, 0,
+	0, 0, 0, 1503, 1503, 1507, 1474, 1102, 1104, 1103,
+	732, 0, 0, 0, 671, 0, 674, 0, 0, 59,
+	1052, 1163, 929, 926, 0, 964, 954, 0, 956, 0,
+	0, 0, 0, 1513, 1517, 1509, 728, 729, 0, 0,
+	673, 47, 48, 49, 0, 0, 934, 0, 950, 955,
+	957, 959, 0, 963, 961, 0, 734, 735, 0, 0,
+	731, 1053, 933, 0, 946, 931, 0, 960, 0, 736,
+	730, 935, -2, 0, 951, 733, 936, -2, 0, 944,
+	0, 0, 937, 945, 0, 940, 0, 0, 0, 939,
+	0, -2, 947, 0, 0, 941, -2, 0, 949, 948,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 96, 3, 3, 3, 126, 118, 3,
-	67, 68, 123, 121, 136, 122, 135, 124, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 543,
-	105, 104, 106, 3, 544, 3, 3, 3, 3, 3,
+	3, 3, 3, 97, 3, 3, 3, 128, 120, 3,
+	68, 69, 125, 123, 138, 124, 137, 126, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 590, 586,
+	106, 105, 107, 3, 587, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 128, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 130, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 545, 117, 546, 129,
+	3, 3, 3, 588, 119, 589, 131,
 }
 
 var yyTok2 = [...]int{
@@ -6730,12 +8653,12 @@ var yyTok2 = [...]int{
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
-	62, 63, 64, 65, 66, 69, 70, 71, 72, 73,
+	62, 63, 64, 65, 66, 67, 70, 71, 72, 73,
 	74, 75, 76, 77, 78, 79, 80, 81, 82, 83,
 	84, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 97, 98, 99, 100, 101, 102, 103, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 119,
-	120, 125, 127, 130, 131, 132, 133, 134, 137, 138,
+	94, 95, 96, 98, 99, 100, 101, 102, 103, 104,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 121, 122, 127, 129, 132, 133, 134, 135, 136,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
@@ -6806,7 +8729,16 @@ var yyTok3 = [...]int{
 	57850, 525, 57851, 526, 57852, 527, 57853, 528, 57854, 529,
 	57855, 530, 57856, 531, 57857, 532, 57858, 533, 57859, 534,
 	57860, 535, 57861, 536, 57862, 537, 57863, 538, 57864, 539,
-	57865, 540, 57866, 541, 57867, 542, 0,
+	57865, 540, 57866, 541, 57867, 542, 57868, 543, 57869, 544,
+	57870, 545, 57871, 546, 57872, 547, 57873, 548, 57874, 549,
+	57875, 550, 57876, 551, 57877, 552, 57878, 553, 57879, 554,
+	57880, 555, 57881, 556, 57882, 557, 57883, 558, 57884, 559,
+	57885, 560, 57886, 561, 57887, 562, 57888, 563, 57889, 564,
+	57890, 565, 57891, 566, 57892, 567, 57893, 568, 57894, 569,
+	57895, 570, 57896, 571, 57897, 572, 57898, 573, 57899, 574,
+	57900, 575, 57901, 576, 57902, 577, 57903, 578, 57904, 579,
+	57905, 580, 57906, 581, 57907, 582, 57908, 583, 57909, 584,
+	57910, 585, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -7156,7 +9088,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:660
+//line mysql_sql.y:728
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -7164,32 +9096,32 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:666
+//line mysql_sql.y:734
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 31:
+	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:701
+//line mysql_sql.y:770
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:706
+//line mysql_sql.y:775
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:712
+//line mysql_sql.y:781
 		{
 			var connectionId uint64
 			switch v := yyDollar[3].item.(type) {
@@ -7209,20 +9141,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:732
+//line mysql_sql.y:801
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:738
+//line mysql_sql.y:807
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7230,10 +9162,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:745
+//line mysql_sql.y:814
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7241,20 +9173,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:753
+//line mysql_sql.y:822
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:759
+//line mysql_sql.y:828
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist:       true,
@@ -7262,10 +9194,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:768
+//line mysql_sql.y:837
 		{
 			ep := &tree.ExportParam{
 				Outfile:     true,
@@ -7282,10 +9214,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:789
+//line mysql_sql.y:858
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -7297,52 +9229,52 @@ yydefault:
 			yyLOCAL.(*tree.Load).Param.Parallel = yyDollar[10].unsignedOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:802
+//line mysql_sql.y:871
 		{
 			yyLOCAL = &tree.LoadExtension{
 				Name: tree.Identifier(yyDollar[2].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:809
+//line mysql_sql.y:878
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:813
+//line mysql_sql.y:882
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:819
+//line mysql_sql.y:888
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:823
+//line mysql_sql.y:892
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:829
+//line mysql_sql.y:898
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -7350,10 +9282,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:836
+//line mysql_sql.y:905
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -7361,18 +9293,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:844
+//line mysql_sql.y:913
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:848
+//line mysql_sql.y:917
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -7385,58 +9317,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:862
+//line mysql_sql.y:931
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:866
+//line mysql_sql.y:935
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 54:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:870
+//line mysql_sql.y:939
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 55:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:875
+//line mysql_sql.y:944
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 56:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:879
+//line mysql_sql.y:948
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:883
+//line mysql_sql.y:952
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:889
+//line mysql_sql.y:958
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -7446,10 +9378,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:898
+//line mysql_sql.y:967
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -7459,58 +9391,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:909
+//line mysql_sql.y:978
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:913
+//line mysql_sql.y:982
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:919
+//line mysql_sql.y:988
 		{
 			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:923
+//line mysql_sql.y:992
 		{
 			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:929
+//line mysql_sql.y:998
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:933
+//line mysql_sql.y:1002
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:939
+//line mysql_sql.y:1008
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -7533,10 +9465,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:963
+//line mysql_sql.y:1032
 		{
 			//        vs := strings.Split($1, ".")
 			//        var r string
@@ -7555,42 +9487,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 68:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:982
+//line mysql_sql.y:1051
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:986
+//line mysql_sql.y:1055
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 70:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:990
+//line mysql_sql.y:1059
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 71:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:995
+//line mysql_sql.y:1064
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:999
+//line mysql_sql.y:1068
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -7598,10 +9530,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1006
+//line mysql_sql.y:1075
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[3].str,
@@ -7609,42 +9541,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 74:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1014
+//line mysql_sql.y:1083
 		{
 			yyVAL.str = ""
 		}
-	case 75:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1021
+//line mysql_sql.y:1090
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 76:
+	case 77:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1026
+//line mysql_sql.y:1095
 		{
 			yyVAL.str = "\n"
 		}
-	case 78:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1033
+//line mysql_sql.y:1102
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 79:
+	case 80:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1038
+//line mysql_sql.y:1107
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1042
+//line mysql_sql.y:1111
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -7667,36 +9599,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1066
+//line mysql_sql.y:1135
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1070
+//line mysql_sql.y:1139
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1076
+//line mysql_sql.y:1145
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 85:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1082
+//line mysql_sql.y:1151
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -7715,10 +9647,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1100
+//line mysql_sql.y:1169
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -7736,10 +9668,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1117
+//line mysql_sql.y:1186
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -7757,50 +9689,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 89:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1140
+//line mysql_sql.y:1209
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1144
+//line mysql_sql.y:1213
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1148
+//line mysql_sql.y:1217
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 92:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1153
+//line mysql_sql.y:1222
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1157
+//line mysql_sql.y:1226
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 94:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1163
+//line mysql_sql.y:1232
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypePrivilege,
@@ -7814,10 +9746,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 95:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1176
+//line mysql_sql.y:1245
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeRole,
@@ -7829,10 +9761,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 96:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1187
+//line mysql_sql.y:1256
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeProxy,
@@ -7845,26 +9777,26 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 97:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1200
+//line mysql_sql.y:1269
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1204
+//line mysql_sql.y:1273
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 99:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1214
+//line mysql_sql.y:1283
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypePrivilege,
@@ -7878,10 +9810,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 100:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1227
+//line mysql_sql.y:1296
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypeRole,
@@ -7893,30 +9825,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1240
+//line mysql_sql.y:1309
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1246
+//line mysql_sql.y:1315
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1252
+//line mysql_sql.y:1321
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR,
@@ -7924,10 +9856,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1259
+//line mysql_sql.y:1328
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
@@ -7936,10 +9868,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1267
+//line mysql_sql.y:1336
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -7947,74 +9879,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1276
+//line mysql_sql.y:1345
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1280
+//line mysql_sql.y:1349
 		{
 			yyLOCAL = tree.OBJECT_TYPE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1284
+//line mysql_sql.y:1353
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1288
+//line mysql_sql.y:1357
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1292
+//line mysql_sql.y:1361
 		{
 			yyLOCAL = tree.OBJECT_TYPE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1296
+//line mysql_sql.y:1365
 		{
 			yyLOCAL = tree.OBJECT_TYPE_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1303
+//line mysql_sql.y:1372
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 113:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1307
+//line mysql_sql.y:1376
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1313
+//line mysql_sql.y:1382
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -8022,10 +9954,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 115:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1320
+//line mysql_sql.y:1389
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -8033,426 +9965,450 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1329
+//line mysql_sql.y:1398
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 117:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1333
+//line mysql_sql.y:1402
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1339
+//line mysql_sql.y:1408
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1343
+//line mysql_sql.y:1412
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1347
+//line mysql_sql.y:1416
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1351
+//line mysql_sql.y:1420
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 121:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1355
+//line mysql_sql.y:1424
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1359
+//line mysql_sql.y:1428
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1363
+//line mysql_sql.y:1432
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1367
+//line mysql_sql.y:1436
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1371
+//line mysql_sql.y:1440
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1375
+//line mysql_sql.y:1444
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1379
+//line mysql_sql.y:1448
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1383
+//line mysql_sql.y:1452
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1387
+//line mysql_sql.y:1456
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1391
+//line mysql_sql.y:1460
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 132:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1395
+//line mysql_sql.y:1464
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 133:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1399
+//line mysql_sql.y:1468
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1403
+//line mysql_sql.y:1472
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1407
+//line mysql_sql.y:1476
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1411
+//line mysql_sql.y:1480
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1415
+//line mysql_sql.y:1484
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1419
+//line mysql_sql.y:1488
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 139:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1423
+//line mysql_sql.y:1492
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 140:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1427
+//line mysql_sql.y:1496
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 141:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1431
+//line mysql_sql.y:1500
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1435
+//line mysql_sql.y:1504
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CONNECT
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1439
+//line mysql_sql.y:1508
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_MANAGE_GRANTS
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1443
+//line mysql_sql.y:1512
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_OWNERSHIP
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1447
+//line mysql_sql.y:1516
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1451
+//line mysql_sql.y:1520
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1455
+//line mysql_sql.y:1524
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 148:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1459
+//line mysql_sql.y:1528
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1463
+//line mysql_sql.y:1532
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1467
+//line mysql_sql.y:1536
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCE
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1471
+//line mysql_sql.y:1540
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1475
+//line mysql_sql.y:1544
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1479
+//line mysql_sql.y:1548
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1483
+//line mysql_sql.y:1552
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1487
+//line mysql_sql.y:1556
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1491
+//line mysql_sql.y:1560
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1495
+//line mysql_sql.y:1564
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1499
+//line mysql_sql.y:1568
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1503
+//line mysql_sql.y:1572
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1507
+//line mysql_sql.y:1576
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 160:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1511
+//line mysql_sql.y:1580
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 161:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1515
+//line mysql_sql.y:1584
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 162:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1519
+//line mysql_sql.y:1588
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 163:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1523
+//line mysql_sql.y:1592
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1527
+//line mysql_sql.y:1596
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1531
+//line mysql_sql.y:1600
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1535
+//line mysql_sql.y:1604
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRUNCATE
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 173:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1617
+		{
+			yyLOCAL = &tree.SetTransaction{Modes: yyDollar[3].txnModesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 174:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1621
+		{
+			yyLOCAL = &tree.SetTransaction{Global: true, Modes: yyDollar[4].txnModesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 175:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1625
+		{
+			yyLOCAL = &tree.SetTransaction{Session: true, Modes: yyDollar[4].txnModesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 176:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1547
+//line mysql_sql.y:1631
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole: false,
@@ -8460,10 +10416,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1554
+//line mysql_sql.y:1638
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -8471,10 +10427,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 178:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1561
+//line mysql_sql.y:1645
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -8482,90 +10438,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 179:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1570
+//line mysql_sql.y:1654
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1600
+//line mysql_sql.y:1684
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1604
+//line mysql_sql.y:1688
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1608
+//line mysql_sql.y:1692
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1614
+//line mysql_sql.y:1698
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1620
+//line mysql_sql.y:1704
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 185:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1624
+//line mysql_sql.y:1708
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 187:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1631
+//line mysql_sql.y:1715
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 183:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1637
+//line mysql_sql.y:1721
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1641
+//line mysql_sql.y:1725
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 185:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1647
+//line mysql_sql.y:1731
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -8574,10 +10530,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 186:
+	case 191:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1655
+//line mysql_sql.y:1739
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -8587,10 +10543,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 187:
+	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1664
+//line mysql_sql.y:1748
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -8599,10 +10555,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 193:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1672
+//line mysql_sql.y:1756
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -8611,10 +10567,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1680
+//line mysql_sql.y:1764
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -8638,10 +10594,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1703
+//line mysql_sql.y:1787
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -8665,10 +10621,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1726
+//line mysql_sql.y:1810
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -8676,10 +10632,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 197:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1733
+//line mysql_sql.y:1817
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -8687,10 +10643,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 198:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1740
+//line mysql_sql.y:1824
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     strings.ToLower(yyDollar[1].str),
@@ -8699,10 +10655,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1748
+//line mysql_sql.y:1832
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -8710,10 +10666,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1755
+//line mysql_sql.y:1839
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -8721,10 +10677,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1762
+//line mysql_sql.y:1846
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -8732,212 +10688,336 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1771
+//line mysql_sql.y:1855
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1775
+//line mysql_sql.y:1859
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1779
+//line mysql_sql.y:1863
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 200:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1785
+//line mysql_sql.y:1869
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 201:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1789
+//line mysql_sql.y:1873
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 202:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1795
+//line mysql_sql.y:1879
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 203:
+	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1799
+//line mysql_sql.y:1883
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare() + "." + yyDollar[3].cstrUnion().Compare()
 		}
-	case 204:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:1805
+//line mysql_sql.y:1889
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:1809
+//line mysql_sql.y:1893
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 209:
+	case 212:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1900
+		{
+			yyVAL.str = "@" + yyDollar[1].str
+		}
+	case 213:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []string
+//line mysql_sql.y:1906
+		{
+			yyLOCAL = []string{yyDollar[1].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 214:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []string
+//line mysql_sql.y:1910
+		{
+			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1820
+//line mysql_sql.y:1923
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 221:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1826
+//line mysql_sql.y:1927
 		{
-			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
+			yyLOCAL = &tree.RollbackToSavePoint{Name: tree.Identifier(strings.ToLower(yyDollar[3].cstrUnion().Origin()))}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1831
+	case 222:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1931
 		{
-			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
+			yyLOCAL = &tree.RollbackToSavePoint{Name: tree.Identifier(strings.ToLower(yyDollar[4].cstrUnion().Origin()))}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1835
+	case 223:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1935
 		{
-			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
+			yyLOCAL = &tree.RollbackToSavePoint{Name: tree.Identifier(strings.ToLower(yyDollar[4].cstrUnion().Origin()))}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 224:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1939
+		{
+			yyLOCAL = &tree.RollbackToSavePoint{Name: tree.Identifier(strings.ToLower(yyDollar[5].cstrUnion().Origin()))}
+		}
+		yyVAL.union = yyLOCAL
+	case 225:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1945
+		{
+			yyLOCAL = &tree.SavePoint{Name: tree.Identifier(strings.ToLower(yyDollar[2].cstrUnion().Origin()))}
+		}
+		yyVAL.union = yyLOCAL
+	case 226:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1951
+		{
+			yyLOCAL = &tree.ReleaseSavePoint{Name: tree.Identifier(strings.ToLower(yyDollar[3].cstrUnion().Origin()))}
+		}
+		yyVAL.union = yyLOCAL
+	case 227:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1957
+		{
+			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 228:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.CompletionType
+//line mysql_sql.y:1962
+		{
+			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
+		}
+		yyVAL.union = yyLOCAL
+	case 229:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.CompletionType
+//line mysql_sql.y:1966
+		{
+			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
+		}
+		yyVAL.union = yyLOCAL
+	case 230:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1839
+//line mysql_sql.y:1970
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1843
+//line mysql_sql.y:1974
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 232:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1847
+//line mysql_sql.y:1978
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1851
+//line mysql_sql.y:1982
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 234:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1855
+//line mysql_sql.y:1986
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 235:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1859
+//line mysql_sql.y:1990
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 236:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1863
+//line mysql_sql.y:1994
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1869
+//line mysql_sql.y:2000
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 238:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1873
+//line mysql_sql.y:2004
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1877
+//line mysql_sql.y:2008
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 240:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1881
+//line mysql_sql.y:2012
 		{
-			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
-			yyLOCAL = &tree.BeginTransaction{Modes: m}
+			yyLOCAL = &tree.BeginTransaction{Modes: yyDollar[3].txnModesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL tree.Statement
-//line mysql_sql.y:1886
+	case 242:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TransactionModes
+//line mysql_sql.y:2019
 		{
-			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
-			yyLOCAL = &tree.BeginTransaction{Modes: m}
+			yyLOCAL = yyDollar[1].txnModesUnion().Merge(yyDollar[3].txnModesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 225:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL tree.Statement
-//line mysql_sql.y:1891
+	case 243:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TransactionModes
+//line mysql_sql.y:2025
 		{
-			yyLOCAL = &tree.BeginTransaction{}
+			yyLOCAL = tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 		}
 		yyVAL.union = yyLOCAL
-	case 226:
+	case 244:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TransactionModes
+//line mysql_sql.y:2029
+		{
+			yyLOCAL = tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
+		}
+		yyVAL.union = yyLOCAL
+	case 245:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TransactionModes
+//line mysql_sql.y:2033
+		{
+			yyLOCAL = tree.TransactionModes{}
+		}
+		yyVAL.union = yyLOCAL
+	case 246:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TransactionModes
+//line mysql_sql.y:2037
+		{
+			yyLOCAL = tree.TransactionModes{Isolation: yyDollar[3].isolationLevelUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 247:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IsolationLevel
+//line mysql_sql.y:2043
+		{
+			yyLOCAL = tree.ISOLATION_LEVEL_READ_UNCOMMITTED
+		}
+		yyVAL.union = yyLOCAL
+	case 248:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IsolationLevel
+//line mysql_sql.y:2047
+		{
+			yyLOCAL = tree.ISOLATION_LEVEL_READ_COMMITTED
+		}
+		yyVAL.union = yyLOCAL
+	case 249:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IsolationLevel
+//line mysql_sql.y:2051
+		{
+			yyLOCAL = tree.ISOLATION_LEVEL_REPEATABLE_READ
+		}
+		yyVAL.union = yyLOCAL
+	case 250:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IsolationLevel
+//line mysql_sql.y:2055
+		{
+			yyLOCAL = tree.ISOLATION_LEVEL_SERIALIZABLE
+		}
+		yyVAL.union = yyLOCAL
+	case 251:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1897
+//line mysql_sql.y:2061
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -8945,20 +11025,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 227:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1904
+//line mysql_sql.y:2068
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 228:
+	case 253:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1910
+//line mysql_sql.y:2074
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -8966,10 +11046,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 254:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1917
+//line mysql_sql.y:2081
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -8977,10 +11057,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 255:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1924
+//line mysql_sql.y:2088
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -8988,19 +11068,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1934
+//line mysql_sql.y:2098
 		{
 			yyDollar[2].statementUnion().(*tree.Update).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 233:
+	case 258:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1941
+//line mysql_sql.y:2105
 		{
 			// Single-table syntax
 			yyLOCAL = &tree.Update{
@@ -9012,10 +11092,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 259:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1952
+//line mysql_sql.y:2116
 		{
 			// Multiple-table syntax
 			yyLOCAL = &tree.Update{
@@ -9025,210 +11105,210 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1963
+//line mysql_sql.y:2127
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1967
+//line mysql_sql.y:2131
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1973
+//line mysql_sql.y:2137
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 240:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1983
+//line mysql_sql.y:2147
 		{
 			yyLOCAL = &tree.LockTableStmt{TableLocks: yyDollar[3].tableLocksUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:1989
+//line mysql_sql.y:2153
 		{
 			yyLOCAL = []tree.TableLock{yyDollar[1].tableLockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:1993
+//line mysql_sql.y:2157
 		{
 			yyLOCAL = append(yyDollar[1].tableLocksUnion(), yyDollar[3].tableLockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 268:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLock
-//line mysql_sql.y:1999
+//line mysql_sql.y:2163
 		{
 			yyLOCAL = tree.TableLock{*yyDollar[1].tableNameUnion(), yyDollar[2].tableLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2005
+//line mysql_sql.y:2169
 		{
 			yyLOCAL = tree.TableLockRead
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 270:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2009
+//line mysql_sql.y:2173
 		{
 			yyLOCAL = tree.TableLockReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2013
+//line mysql_sql.y:2177
 		{
 			yyLOCAL = tree.TableLockWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2017
+//line mysql_sql.y:2181
 		{
 			yyLOCAL = tree.TableLockLowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 273:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2023
+//line mysql_sql.y:2187
 		{
 			yyLOCAL = &tree.UnLockTableStmt{}
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2035
+//line mysql_sql.y:2199
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2041
+//line mysql_sql.y:2205
 		{
 			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].statementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2045
+//line mysql_sql.y:2209
 		{
 			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2051
+//line mysql_sql.y:2215
 		{
 			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 284:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2055
+//line mysql_sql.y:2219
 		{
 			yyLOCAL = tree.NewExecuteWithVariables(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2061
+//line mysql_sql.y:2225
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2067
+//line mysql_sql.y:2231
 		{
 			yyLOCAL = tree.NewReset(tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2077
+//line mysql_sql.y:2241
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2083
+//line mysql_sql.y:2247
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2087
+//line mysql_sql.y:2251
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2091
+//line mysql_sql.y:2255
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 295:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2095
+//line mysql_sql.y:2259
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 296:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2099
+//line mysql_sql.y:2263
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2103
+//line mysql_sql.y:2267
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -9237,10 +11317,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2111
+//line mysql_sql.y:2275
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -9249,10 +11329,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 299:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2119
+//line mysql_sql.y:2283
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -9263,10 +11343,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 300:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2129
+//line mysql_sql.y:2293
 		{
 			if tree.IsContainAnalyze(yyDollar[3].epxlainOptionsUnion()) {
 				explainStmt := tree.NewExplainAnalyze(yyDollar[5].statementUnion(), "text")
@@ -9279,66 +11359,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2170
+//line mysql_sql.y:2334
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2174
+//line mysql_sql.y:2338
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:2180
+//line mysql_sql.y:2344
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2186
+//line mysql_sql.y:2350
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 292:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2191
+//line mysql_sql.y:2355
 		{
 			yyVAL.str = "true"
 		}
-	case 293:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2192
+//line mysql_sql.y:2356
 		{
 			yyVAL.str = "false"
 		}
-	case 294:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2193
+//line mysql_sql.y:2357
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 295:
+	case 320:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2198
+//line mysql_sql.y:2362
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2366
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 328:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2213
+//line mysql_sql.y:2381
 		{
 			yyLOCAL = &tree.AlterView{
 				Name:     yyDollar[4].tableNameUnion(),
@@ -9348,10 +11436,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 329:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2224
+//line mysql_sql.y:2392
 		{
 			yyLOCAL = &tree.AlterTable{
 				Table:   yyDollar[3].tableNameUnion(),
@@ -9359,10 +11447,33 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 330:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2399
+		{
+			opts := make([]tree.AlterTableOption, len(yyDollar[4].tableOptionsUnion()))
+			for i, opt := range yyDollar[4].tableOptionsUnion() {
+				opts[i] = tree.AlterTableOption(opt)
+			}
+			yyLOCAL = &tree.AlterTable{
+				Table:   yyDollar[3].tableNameUnion(),
+				Options: opts,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 332:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:2413
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionsUnion()...)
+		}
+		yyVAL.union = yyLOCAL
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2233
+//line mysql_sql.y:2419
 		{
 			opt := &tree.AlterOptionAdd{
 				Def: yyDollar[2].tableDefUnion(),
@@ -9370,84 +11481,174 @@ yydefault:
 			yyLOCAL = []tree.AlterTableOption{tree.AlterTableOption(opt)}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 334:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2240
+//line mysql_sql.y:2426
 		{
-			yyLOCAL = []tree.AlterTableOption{tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())}
+			opt := &tree.AlterOptionAddColumn{
+				Column:   yyDollar[2].columnTableDefUnion(),
+				Position: yyDollar[3].columnPositionUnion(),
+			}
+			yyLOCAL = []tree.AlterTableOption{tree.AlterTableOption(opt)}
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 335:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2244
+//line mysql_sql.y:2434
 		{
-			opts := make([]tree.AlterTableOption, len(yyDollar[1].tableOptionsUnion()))
-			for i, opt := range yyDollar[1].tableOptionsUnion() {
-				opts[i] = tree.AlterTableOption(opt)
+			opt := &tree.AlterOptionAddColumn{
+				Column:   yyDollar[3].columnTableDefUnion(),
+				Position: yyDollar[4].columnPositionUnion(),
 			}
-			yyLOCAL = opts
+			yyLOCAL = []tree.AlterTableOption{tree.AlterTableOption(opt)}
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2254
+	case 336:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:2442
 		{
-			yyLOCAL = &tree.AlterOptionDrop{
-				Typ:  tree.AlterTableDropIndex,
-				Name: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			opt := &tree.AlterOptionModifyColumn{
+				Column:   yyDollar[3].columnTableDefUnion(),
+				Position: yyDollar[4].columnPositionUnion(),
 			}
+			yyLOCAL = []tree.AlterTableOption{tree.AlterTableOption(opt)}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2261
+	case 337:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:2450
 		{
-			yyLOCAL = &tree.AlterOptionDrop{
-				Typ:  tree.AlterTableDropKey,
-				Name: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			opt := &tree.AlterOptionChangeColumn{
+				OldName:  tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+				Column:   yyDollar[4].columnTableDefUnion(),
+				Position: yyDollar[5].columnPositionUnion(),
 			}
+			yyLOCAL = []tree.AlterTableOption{tree.AlterTableOption(opt)}
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2268
+	case 338:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:2459
 		{
-			yyLOCAL = &tree.AlterOptionDrop{
-				Typ:  tree.AlterTableDropColumn,
-				Name: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			opt := &tree.AlterOptionRenameColumn{
+				OldName: tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+				NewName: tree.Identifier(yyDollar[5].cstrUnion().Compare()),
 			}
+			yyLOCAL = []tree.AlterTableOption{tree.AlterTableOption(opt)}
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2275
+	case 339:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:2467
 		{
-			yyLOCAL = &tree.AlterOptionDrop{
-				Typ:  tree.AlterTableDropForeignKey,
-				Name: tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+			yyLOCAL = []tree.AlterTableOption{tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())}
+		}
+		yyVAL.union = yyLOCAL
+	case 340:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2472
+		{
+		}
+	case 341:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:2474
+		{
+		}
+	case 342:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2477
+		{
+			yyLOCAL = &tree.ColumnPosition{
+				Typ: tree.ColumnPositionNone,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2486
+		{
+			yyLOCAL = &tree.ColumnPosition{
+				Typ: tree.ColumnPositionFirst,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 345:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2492
+		{
+			yyLOCAL = &tree.ColumnPosition{
+				Typ:            tree.ColumnPositionAfter,
+				RelativeColumn: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 346:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2501
+		{
+			yyLOCAL = &tree.AlterOptionDrop{
+				Typ:  tree.AlterTableDropIndex,
+				Name: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2282
+//line mysql_sql.y:2508
+		{
+			yyLOCAL = &tree.AlterOptionDrop{
+				Typ:  tree.AlterTableDropKey,
+				Name: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 348:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2515
+		{
+			yyLOCAL = &tree.AlterOptionDrop{
+				Typ:  tree.AlterTableDropColumn,
+				Name: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 349:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2522
+		{
+			yyLOCAL = &tree.AlterOptionDrop{
+				Typ:  tree.AlterTableDropForeignKey,
+				Name: tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 350:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2529
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ: tree.AlterTableDropPrimaryKey,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 351:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2291
+//line mysql_sql.y:2538
 		{
 			yyLOCAL = &tree.AlterAccount{
 				IfExists:     yyDollar[3].boolValUnion(),
@@ -9458,10 +11659,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 352:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2303
+//line mysql_sql.y:2550
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				DbName:         yyDollar[3].str,
@@ -9470,10 +11671,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 353:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2311
+//line mysql_sql.y:2558
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				AccountName:    yyDollar[4].str,
@@ -9482,20 +11683,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2320
+//line mysql_sql.y:2567
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 355:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2326
+//line mysql_sql.y:2573
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist:          true,
@@ -9505,10 +11706,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 356:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2337
+//line mysql_sql.y:2584
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:           yyDollar[3].boolValUnion(),
@@ -9519,222 +11720,222 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 357:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2348
+//line mysql_sql.y:2595
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2352
+//line mysql_sql.y:2599
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 359:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2357
+//line mysql_sql.y:2604
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2361
+//line mysql_sql.y:2608
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 361:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2366
+//line mysql_sql.y:2613
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2370
+//line mysql_sql.y:2617
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2386
+//line mysql_sql.y:2633
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2390
+//line mysql_sql.y:2637
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2394
+//line mysql_sql.y:2641
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 366:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2398
+//line mysql_sql.y:2645
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2402
+//line mysql_sql.y:2649
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2406
+//line mysql_sql.y:2653
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2410
+//line mysql_sql.y:2657
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2414
+//line mysql_sql.y:2661
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryCount{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 371:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2418
+//line mysql_sql.y:2665
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 372:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2422
+//line mysql_sql.y:2669
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalCount{Value: yyDollar[4].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2426
+//line mysql_sql.y:2673
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 374:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2430
+//line mysql_sql.y:2677
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 375:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2434
+//line mysql_sql.y:2681
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentOptional{}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2438
+//line mysql_sql.y:2685
 		{
 			yyLOCAL = &tree.UserMiscOptionFailedLoginAttempts{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2442
+//line mysql_sql.y:2689
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeCount{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2446
+//line mysql_sql.y:2693
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeUnbounded{}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2452
+//line mysql_sql.y:2699
 		{
 			yyVAL.item = nil
 		}
-	case 341:
+	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2457
+//line mysql_sql.y:2704
 		{
 			yyVAL.item = nil
 		}
-	case 368:
+	case 407:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2493
+//line mysql_sql.y:2740
 		{
 			yyLOCAL = &tree.ShowCollation{}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2498
+//line mysql_sql.y:2745
 		{
 			yyLOCAL = &tree.ShowGrants{ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 409:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2502
+//line mysql_sql.y:2749
 		{
 			yyLOCAL = &tree.ShowGrants{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname, Roles: yyDollar[5].rolesUnion(), ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 410:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2506
+//line mysql_sql.y:2753
 		{
 			s := &tree.ShowGrants{}
 			roles := []*tree.Role{tree.NewRole(yyDollar[5].cstrUnion().Compare())}
@@ -9743,44 +11944,44 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 411:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2515
+//line mysql_sql.y:2762
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2519
+//line mysql_sql.y:2766
 		{
 			yyLOCAL = yyDollar[2].rolesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 413:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2525
+//line mysql_sql.y:2772
 		{
 			yyLOCAL = &tree.ShowTableStatus{DbName: yyDollar[5].str, Like: yyDollar[6].comparisionExprUnion(), Where: yyDollar[7].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 414:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2530
+//line mysql_sql.y:2777
 		{
 		}
-	case 377:
+	case 416:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2534
+//line mysql_sql.y:2781
 		{
 		}
-	case 379:
+	case 418:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2539
+//line mysql_sql.y:2786
 		{
 			yyLOCAL = &tree.ShowFunctionStatus{
 				Like:  yyDollar[4].comparisionExprUnion(),
@@ -9788,58 +11989,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2548
+//line mysql_sql.y:2795
 		{
 			yyLOCAL = &tree.ShowNodeList{}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2554
+//line mysql_sql.y:2801
 		{
 			yyLOCAL = &tree.ShowLocks{}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2560
+//line mysql_sql.y:2807
 		{
 			yyLOCAL = &tree.ShowTableNumber{DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2566
+//line mysql_sql.y:2813
 		{
 			yyLOCAL = &tree.ShowColumnNumber{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 423:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2572
+//line mysql_sql.y:2819
 		{
 			yyLOCAL = &tree.ShowTableValues{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 424:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2578
+//line mysql_sql.y:2825
 		{
 			yyLOCAL = &tree.ShowTableSize{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 425:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2584
+//line mysql_sql.y:2831
 		{
 			s := yyDollar[2].statementUnion().(*tree.ShowTarget)
 			s.Like = yyDollar[3].comparisionExprUnion()
@@ -9847,82 +12048,82 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2593
+//line mysql_sql.y:2840
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowConfig}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2597
+//line mysql_sql.y:2844
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 428:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2601
+//line mysql_sql.y:2848
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowEngines}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2605
+//line mysql_sql.y:2852
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowTriggers}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2609
+//line mysql_sql.y:2856
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProcedureStatus}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2613
+//line mysql_sql.y:2860
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowEvents}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2617
+//line mysql_sql.y:2864
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPlugins}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2621
+//line mysql_sql.y:2868
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPrivileges}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2625
+//line mysql_sql.y:2872
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProfiles}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 435:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2631
+//line mysql_sql.y:2878
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[5].tableNameUnion(),
@@ -9930,10 +12131,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 436:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2638
+//line mysql_sql.y:2885
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[7].cstrUnion().Compare()), ExplicitSchema: true}
 			tbl := tree.NewTableName(tree.Identifier(yyDollar[5].cstrUnion().Compare()), prefix)
@@ -9943,20 +12144,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 437:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2648
+//line mysql_sql.y:2895
 		{
 		}
-	case 399:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2650
+//line mysql_sql.y:2897
 		{
 		}
-	case 403:
+	case 442:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2659
+//line mysql_sql.y:2906
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -9965,10 +12166,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 443:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2669
+//line mysql_sql.y:2916
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -9977,58 +12178,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 444:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2678
+//line mysql_sql.y:2925
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2682
+//line mysql_sql.y:2929
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2686
+//line mysql_sql.y:2933
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2692
+//line mysql_sql.y:2939
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2698
+//line mysql_sql.y:2945
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2704
+//line mysql_sql.y:2951
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2710
+//line mysql_sql.y:2957
 		{
 			yyLOCAL = &tree.ShowSequences{
 				DBName: yyDollar[3].str,
@@ -10036,10 +12237,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 451:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2719
+//line mysql_sql.y:2966
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -10050,10 +12251,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 452:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2729
+//line mysql_sql.y:2976
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -10064,26 +12265,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 453:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2741
+//line mysql_sql.y:2988
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2745
+//line mysql_sql.y:2992
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 455:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2751
+//line mysql_sql.y:2998
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -10096,10 +12297,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 456:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2763
+//line mysql_sql.y:3010
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -10112,188 +12313,199 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2777
+//line mysql_sql.y:3024
 		{
 			yyLOCAL = &tree.ShowAccounts{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 458:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2783
+//line mysql_sql.y:3030
 		{
 			yyLOCAL = &tree.ShowPublications{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2790
+//line mysql_sql.y:3037
 		{
 			yyLOCAL = &tree.ShowSubscriptions{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 460:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:2795
+//line mysql_sql.y:3042
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:2799
+//line mysql_sql.y:3046
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:2803
+//line mysql_sql.y:3050
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.ILIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 463:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2808
+//line mysql_sql.y:3055
 		{
 			yyVAL.str = ""
 		}
-	case 425:
+	case 464:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2812
+//line mysql_sql.y:3059
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 426:
+	case 465:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2818
+//line mysql_sql.y:3065
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 470:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2831
+//line mysql_sql.y:3078
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2835
+//line mysql_sql.y:3082
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 472:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2841
+//line mysql_sql.y:3088
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2846
+//line mysql_sql.y:3093
 		{
 			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 474:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2850
+//line mysql_sql.y:3097
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 475:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2854
+//line mysql_sql.y:3101
 		{
 			yyLOCAL = &tree.ShowCreatePublications{Name: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2860
+//line mysql_sql.y:3107
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2864
+//line mysql_sql.y:3111
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2870
+//line mysql_sql.y:3117
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 440:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2876
+//line mysql_sql.y:3123
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2880
+//line mysql_sql.y:3127
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 481:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2884
+//line mysql_sql.y:3131
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2890
+//line mysql_sql.y:3137
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[2].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2894
+//line mysql_sql.y:3141
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 498:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3165
+		{
+			yyLOCAL = &tree.DropPlanBaseline{
+				IfExists: yyDollar[4].boolValUnion(),
+				Stmt:     yyDollar[6].selectUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 499:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2916
+//line mysql_sql.y:3174
 		{
 			yyLOCAL = &tree.DropSequence{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -10301,10 +12513,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 500:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2925
+//line mysql_sql.y:3183
 		{
 			yyLOCAL = &tree.DropAccount{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -10312,10 +12524,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 501:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2934
+//line mysql_sql.y:3192
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -10323,26 +12535,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2943
+//line mysql_sql.y:3201
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2947
+//line mysql_sql.y:3205
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2953
+//line mysql_sql.y:3211
 		{
 			yyLOCAL = &tree.User{
 				Username: yyDollar[1].usernameRecordUnion().Username,
@@ -10350,10 +12562,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 505:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2962
+//line mysql_sql.y:3220
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -10361,10 +12573,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 506:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2971
+//line mysql_sql.y:3229
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].cstrUnion().Compare()),
@@ -10373,42 +12585,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 507:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2981
+//line mysql_sql.y:3239
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 508:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2987
+//line mysql_sql.y:3245
 		{
 			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 509:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2993
+//line mysql_sql.y:3251
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].cstrUnion().Compare()), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2999
+//line mysql_sql.y:3257
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 511:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3005
+//line mysql_sql.y:3263
 		{
 			yyLOCAL = &tree.DropFunction{
 				Name: yyDollar[3].functionNameUnion(),
@@ -10416,28 +12628,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3016
+//line mysql_sql.y:3274
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3021
+//line mysql_sql.y:3279
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 516:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3028
+//line mysql_sql.y:3286
 		{
 			// Single-Table Syntax
 			t := &tree.AliasedTableExpr{
@@ -10454,10 +12666,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 517:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3044
+//line mysql_sql.y:3302
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -10467,10 +12679,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 518:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3057
+//line mysql_sql.y:3315
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -10480,69 +12692,69 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3068
+//line mysql_sql.y:3326
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3072
+//line mysql_sql.y:3330
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3078
+//line mysql_sql.y:3336
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 522:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3083
+//line mysql_sql.y:3341
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 523:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3090
+//line mysql_sql.y:3348
 		{
 		}
-	case 482:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3092
+//line mysql_sql.y:3350
 		{
 		}
-	case 483:
+	case 525:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3095
+//line mysql_sql.y:3353
 		{
 		}
-	case 488:
+	case 530:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3104
+//line mysql_sql.y:3362
 		{
 		}
-	case 490:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3108
+//line mysql_sql.y:3366
 		{
 		}
-	case 492:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3113
+//line mysql_sql.y:3371
 		{
 			rep := yyDollar[4].replaceUnion()
 			rep.Table = yyDollar[2].tableExprUnion()
@@ -10550,10 +12762,10 @@ yydefault:
 			yyLOCAL = rep
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3122
+//line mysql_sql.y:3380
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -10561,20 +12773,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3129
+//line mysql_sql.y:3387
 		{
 			yyLOCAL = &tree.Replace{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3135
+//line mysql_sql.y:3393
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -10583,10 +12795,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3143
+//line mysql_sql.y:3401
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -10594,10 +12806,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3150
+//line mysql_sql.y:3408
 		{
 			yyLOCAL = &tree.Replace{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -10605,10 +12817,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 540:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3157
+//line mysql_sql.y:3415
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of replace can not be empty")
@@ -10627,10 +12839,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 541:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3177
+//line mysql_sql.y:3435
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -10639,42 +12851,42 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 542:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3186
+//line mysql_sql.y:3444
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 543:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3190
+//line mysql_sql.y:3448
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3196
+//line mysql_sql.y:3454
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3200
+//line mysql_sql.y:3458
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3206
+//line mysql_sql.y:3464
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -10682,20 +12894,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3213
+//line mysql_sql.y:3471
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 548:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3219
+//line mysql_sql.y:3477
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -10704,10 +12916,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 549:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3227
+//line mysql_sql.y:3485
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -10715,10 +12927,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 550:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3234
+//line mysql_sql.y:3492
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -10726,10 +12938,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3241
+//line mysql_sql.y:3499
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -10748,50 +12960,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 552:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3260
+//line mysql_sql.y:3518
 		{
 			yyLOCAL = []*tree.UpdateExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 553:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3264
+//line mysql_sql.y:3522
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 554:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3269
+//line mysql_sql.y:3527
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3273
+//line mysql_sql.y:3531
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3277
+//line mysql_sql.y:3535
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:3283
+//line mysql_sql.y:3541
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -10799,155 +13011,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3292
+//line mysql_sql.y:3550
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 559:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3296
+//line mysql_sql.y:3554
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3302
+//line mysql_sql.y:3560
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 519:
+	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3306
+//line mysql_sql.y:3564
 		{
 			yyVAL.str = yyDollar[3].cstrUnion().Compare()
 		}
-	case 520:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3312
+//line mysql_sql.y:3570
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3316
+//line mysql_sql.y:3574
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 564:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3322
+//line mysql_sql.y:3580
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 565:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3327
+//line mysql_sql.y:3585
 		{
 		}
-	case 525:
+	case 567:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3331
+//line mysql_sql.y:3589
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3338
+//line mysql_sql.y:3596
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3342
+//line mysql_sql.y:3600
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3349
+//line mysql_sql.y:3607
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 573:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3354
+//line mysql_sql.y:3612
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 574:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3358
+//line mysql_sql.y:3616
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3364
+//line mysql_sql.y:3622
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3368
+//line mysql_sql.y:3626
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 577:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3374
+//line mysql_sql.y:3632
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3378
+//line mysql_sql.y:3636
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3383
+//line mysql_sql.y:3641
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 580:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3387
+//line mysql_sql.y:3645
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -10960,10 +13172,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 581:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3400
+//line mysql_sql.y:3658
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -10971,10 +13183,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 582:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3407
+//line mysql_sql.y:3665
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -10982,10 +13194,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 583:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3414
+//line mysql_sql.y:3672
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -11004,10 +13216,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 584:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3432
+//line mysql_sql.y:3690
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -11026,38 +13238,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3451
+//line mysql_sql.y:3709
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3457
+//line mysql_sql.y:3715
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3464
+//line mysql_sql.y:3722
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 588:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3468
+//line mysql_sql.y:3726
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -11070,115 +13282,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 589:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3481
+//line mysql_sql.y:3739
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3485
+//line mysql_sql.y:3743
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 591:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3490
+//line mysql_sql.y:3748
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 592:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3494
+//line mysql_sql.y:3752
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3501
+//line mysql_sql.y:3759
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3506
+//line mysql_sql.y:3764
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3513
+//line mysql_sql.y:3771
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3519
+//line mysql_sql.y:3777
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3523
+//line mysql_sql.y:3781
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3527
+//line mysql_sql.y:3785
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 600:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3531
+//line mysql_sql.y:3789
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 601:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3535
+//line mysql_sql.y:3793
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 602:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3539
+//line mysql_sql.y:3797
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 603:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3545
+//line mysql_sql.y:3803
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -11186,10 +13398,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3552
+//line mysql_sql.y:3810
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -11197,26 +13409,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3561
+//line mysql_sql.y:3819
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3565
+//line mysql_sql.y:3823
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 607:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:3571
+//line mysql_sql.y:3829
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Cols: yyDollar[2].identifierListUnion()},
@@ -11224,178 +13436,178 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 608:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3579
+//line mysql_sql.y:3837
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3583
+//line mysql_sql.y:3841
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 610:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3588
+//line mysql_sql.y:3846
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3592
+//line mysql_sql.y:3850
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3598
+//line mysql_sql.y:3856
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 613:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3602
+//line mysql_sql.y:3860
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3606
+//line mysql_sql.y:3864
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 615:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3611
+//line mysql_sql.y:3869
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3615
+//line mysql_sql.y:3873
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3621
+//line mysql_sql.y:3879
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3627
+//line mysql_sql.y:3885
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3631
+//line mysql_sql.y:3889
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:3637
+//line mysql_sql.y:3895
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion(), NullsPosition: yyDollar[3].nullsPositionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 621:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3642
+//line mysql_sql.y:3900
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3646
+//line mysql_sql.y:3904
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3650
+//line mysql_sql.y:3908
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 624:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3655
+//line mysql_sql.y:3913
 		{
 			yyLOCAL = tree.DefaultNullsPosition
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3659
+//line mysql_sql.y:3917
 		{
 			yyLOCAL = tree.NullsFirst
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3663
+//line mysql_sql.y:3921
 		{
 			yyLOCAL = tree.NullsLast
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 627:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3669
+//line mysql_sql.y:3927
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3673
+//line mysql_sql.y:3931
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3677
+//line mysql_sql.y:3935
 		{
 			valuesStmt := yyDollar[2].statementUnion().(*tree.ValuesStatement)
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{
@@ -11408,18 +13620,18 @@ yydefault:
 			}}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3691
+//line mysql_sql.y:3949
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3695
+//line mysql_sql.y:3953
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -11430,10 +13642,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3705
+//line mysql_sql.y:3963
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -11444,10 +13656,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3715
+//line mysql_sql.y:3973
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -11458,10 +13670,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3725
+//line mysql_sql.y:3983
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -11472,10 +13684,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3737
+//line mysql_sql.y:3995
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -11484,10 +13696,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3745
+//line mysql_sql.y:4003
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -11496,10 +13708,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3753
+//line mysql_sql.y:4011
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -11508,10 +13720,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3762
+//line mysql_sql.y:4020
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -11520,10 +13732,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 639:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3770
+//line mysql_sql.y:4028
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -11532,10 +13744,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3778
+//line mysql_sql.y:4036
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -11544,10 +13756,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3786
+//line mysql_sql.y:4044
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -11556,10 +13768,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 642:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3794
+//line mysql_sql.y:4052
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -11568,10 +13780,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 643:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3802
+//line mysql_sql.y:4060
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -11580,10 +13792,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 644:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3810
+//line mysql_sql.y:4068
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -11592,10 +13804,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3818
+//line mysql_sql.y:4076
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -11604,10 +13816,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 646:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3826
+//line mysql_sql.y:4084
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -11616,180 +13828,303 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 647:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3836
+//line mysql_sql.y:4094
 		{
 			yyLOCAL = &tree.SelectClause{
-				Distinct: yyDollar[2].boolValUnion(),
-				Exprs:    yyDollar[3].selectExprsUnion(),
-				From:     yyDollar[4].fromUnion(),
-				Where:    yyDollar[5].whereUnion(),
-				GroupBy:  yyDollar[6].groupByUnion(),
-				Having:   yyDollar[7].whereUnion(),
+				Hints:    yyDollar[2].optimizerHintsUnion(),
+				Distinct: yyDollar[3].boolValUnion(),
+				Exprs:    yyDollar[4].selectExprsUnion(),
+				IntoVars: yyDollar[5].strsUnion(),
+				From:     yyDollar[6].fromUnion(),
+				Where:    yyDollar[7].whereUnion(),
+				GroupBy:  yyDollar[8].groupByUnion(),
+				Having:   yyDollar[9].whereUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 648:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3847
+//line mysql_sql.y:4107
 		{
 			yyLOCAL = &tree.SelectClause{
+				Hints:    yyDollar[2].optimizerHintsUnion(),
 				Distinct: false,
-				Exprs:    yyDollar[3].selectExprsUnion(),
-				From:     yyDollar[4].fromUnion(),
-				Where:    yyDollar[5].whereUnion(),
-				GroupBy:  yyDollar[6].groupByUnion(),
-				Having:   yyDollar[7].whereUnion(),
-				Option:   yyDollar[2].str,
+				Exprs:    yyDollar[4].selectExprsUnion(),
+				IntoVars: yyDollar[5].strsUnion(),
+				From:     yyDollar[6].fromUnion(),
+				Where:    yyDollar[7].whereUnion(),
+				GroupBy:  yyDollar[8].groupByUnion(),
+				Having:   yyDollar[9].whereUnion(),
+				Option:   yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3861
+	case 649:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.OptimizerHints
+//line mysql_sql.y:4123
 		{
-			yyVAL.str = strings.ToLower(yyDollar[1].str)
+			yyLOCAL = nil
 		}
-	case 608:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3865
+		yyVAL.union = yyLOCAL
+	case 650:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.OptimizerHints
+//line mysql_sql.y:4127
+		{
+			yyLOCAL = ParseOptimizerHints(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 651:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []string
+//line mysql_sql.y:4135
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 652:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []string
+//line mysql_sql.y:4139
+		{
+			yyLOCAL = yyDollar[2].strsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 653:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:4145
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 609:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3869
+//line mysql_sql.y:4149
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 610:
+	case 655:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:4153
+		{
+			yyVAL.str = strings.ToLower(yyDollar[1].str)
+		}
+	case 656:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3874
+//line mysql_sql.y:4158
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3878
+//line mysql_sql.y:4162
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3882
+//line mysql_sql.y:4166
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3891
+//line mysql_sql.y:4175
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3895
+//line mysql_sql.y:4179
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 663:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:3900
+//line mysql_sql.y:4184
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:3904
+//line mysql_sql.y:4188
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 665:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line mysql_sql.y:4192
+		{
+			yyLOCAL = tree.GroupBy{&tree.RollupExpr{Exprs: yyDollar[3].exprsUnion(), WithRollup: true}}
+		}
+		yyVAL.union = yyLOCAL
+	case 666:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4198
+		{
+			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 667:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4202
+		{
+			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 669:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4209
+		{
+			yyLOCAL = &tree.RollupExpr{Exprs: yyDollar[3].exprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 670:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4213
+		{
+			yyLOCAL = &tree.CubeExpr{Exprs: yyDollar[3].exprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 671:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4217
+		{
+			yyLOCAL = &tree.GroupingSetsExpr{Sets: yyDollar[4].rowsExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 672:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.Exprs
+//line mysql_sql.y:4223
+		{
+			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 673:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.Exprs
+//line mysql_sql.y:4227
+		{
+			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 674:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4233
+		{
+			yyLOCAL = tree.Exprs{}
+		}
+		yyVAL.union = yyLOCAL
+	case 675:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4237
+		{
+			switch e := yyDollar[1].exprUnion().(type) {
+			case *tree.Tuple:
+				yyLOCAL = e.Exprs
+			case *tree.ParenExpr:
+				yyLOCAL = tree.Exprs{e.Expr}
+			default:
+				yyLOCAL = tree.Exprs{e}
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 676:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3909
+//line mysql_sql.y:4249
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 677:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3913
+//line mysql_sql.y:4253
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:3919
+//line mysql_sql.y:4259
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:3923
+//line mysql_sql.y:4263
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3929
+//line mysql_sql.y:4269
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 681:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3933
+//line mysql_sql.y:4273
 		{
 			yyDollar[2].cstrUnion().SetConfig(0)
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].cstrUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 682:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3938
+//line mysql_sql.y:4278
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 683:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3942
+//line mysql_sql.y:4282
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 684:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3947
+//line mysql_sql.y:4287
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier(""), prefix)
@@ -11798,28 +14133,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3955
+//line mysql_sql.y:4295
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3961
+//line mysql_sql.y:4301
 		{
 			yyLOCAL = &tree.From{
 				Tables: tree.TableExprs{yyDollar[2].joinTableExprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:3969
+//line mysql_sql.y:4309
 		{
 			if t, ok := yyDollar[1].tableExprUnion().(*tree.JoinTableExpr); ok {
 				yyLOCAL = t
@@ -11828,26 +14163,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:3977
+//line mysql_sql.y:4317
 		{
 			yyLOCAL = &tree.JoinTableExpr{Left: yyDollar[1].joinTableExprUnion(), Right: yyDollar[3].tableExprUnion(), JoinType: tree.JOIN_TYPE_CROSS}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3987
+//line mysql_sql.y:4327
 		{
 			yyLOCAL = yyDollar[1].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 692:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:3993
+//line mysql_sql.y:4333
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -11857,10 +14192,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 693:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4002
+//line mysql_sql.y:4342
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -11870,10 +14205,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 694:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4011
+//line mysql_sql.y:4351
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -11883,10 +14218,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4020
+//line mysql_sql.y:4360
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -11895,15 +14230,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4030
+//line mysql_sql.y:4370
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 640:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4034
+//line mysql_sql.y:4374
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -11911,34 +14246,34 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 641:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4044
+//line mysql_sql.y:4384
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 642:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4048
+//line mysql_sql.y:4388
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 643:
+	case 700:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4052
+//line mysql_sql.y:4392
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 644:
+	case 701:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4056
+//line mysql_sql.y:4396
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 645:
+	case 702:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4062
+//line mysql_sql.y:4402
 		{
 			yyLOCAL = &tree.ValuesStatement{
 				Rows:    yyDollar[2].rowsExprsUnion(),
@@ -11947,130 +14282,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4072
+//line mysql_sql.y:4412
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4076
+//line mysql_sql.y:4416
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 705:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4082
+//line mysql_sql.y:4422
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 706:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4088
+//line mysql_sql.y:4428
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4092
+//line mysql_sql.y:4432
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4098
+//line mysql_sql.y:4438
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 652:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4104
+//line mysql_sql.y:4444
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 653:
+	case 710:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4108
+//line mysql_sql.y:4448
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 654:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4112
+//line mysql_sql.y:4452
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 655:
+	case 712:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4118
+//line mysql_sql.y:4458
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4122
+//line mysql_sql.y:4462
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4128
+//line mysql_sql.y:4468
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 715:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4132
+//line mysql_sql.y:4472
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 716:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4138
+//line mysql_sql.y:4478
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 717:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4142
+//line mysql_sql.y:4482
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4148
+//line mysql_sql.y:4488
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 719:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4152
+//line mysql_sql.y:4492
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -12081,10 +14416,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4162
+//line mysql_sql.y:4502
 		{
 			if yyDollar[2].str != "" {
 				yyLOCAL = &tree.AliasedTableExpr{
@@ -12098,26 +14433,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4175
+//line mysql_sql.y:4515
 		{
 			yyLOCAL = yyDollar[2].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:4181
+//line mysql_sql.y:4521
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[1].selectStatementUnion().(*tree.ParenSelect).Select}
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 723:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4187
+//line mysql_sql.y:4527
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].cstrUnion().Compare()))
 			yyLOCAL = &tree.TableFunction{
@@ -12129,226 +14464,433 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:4200
+	case 724:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:4538
 		{
-			yyLOCAL = &tree.AliasedTableExpr{
-				Expr: yyDollar[1].tableNameUnion(),
-				As: tree.AliasClause{
-					Alias: tree.Identifier(yyDollar[2].str),
+			name := tree.SetUnresolvedName("json_table")
+			yyLOCAL = &tree.TableFunction{
+				Func: &tree.FuncExpr{
+					Func:  tree.FuncName2ResolvableFunctionReference(name),
+					Exprs: tree.Exprs{yyDollar[3].exprUnion()},
+					Type:  tree.FUNC_TYPE_TABLE,
+				},
+				JsonTable: &tree.JsonTableDef{
+					Path:    yyDollar[5].str,
+					Columns: yyDollar[8].jsonTableColumnsUnion(),
 				},
-				IndexHints: yyDollar[3].indexHintListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4211
+	case 725:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.JsonTableColumn
+//line mysql_sql.y:4555
 		{
-			yyLOCAL = nil
+			yyLOCAL = []*tree.JsonTableColumn{yyDollar[1].jsonTableColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4218
+	case 726:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.JsonTableColumn
+//line mysql_sql.y:4559
 		{
-			yyLOCAL = []*tree.IndexHint{yyDollar[1].indexHintUnion()}
+			yyLOCAL = append(yyDollar[1].jsonTableColumnsUnion(), yyDollar[3].jsonTableColumnUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4222
+	case 727:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4565
 		{
-			yyLOCAL = append(yyDollar[1].indexHintListUnion(), yyDollar[2].indexHintUnion())
+			yyLOCAL = &tree.JsonTableColumn{
+				Type: tree.JSON_TABLE_COLUMN_ORDINALITY,
+				Name: tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+			}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 728:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.IndexHint
-//line mysql_sql.y:4228
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4572
 		{
-			yyLOCAL = &tree.IndexHint{
-				IndexNames: yyDollar[4].strsUnion(),
-				HintType:   yyDollar[1].indexHintTypeUnion(),
-				HintScope:  yyDollar[2].indexHintScopeUnion(),
-			}
+			yyLOCAL = yyDollar[5].jsonTableColumnUnion()
+			yyLOCAL.Type = tree.JSON_TABLE_COLUMN_PATH
+			yyLOCAL.Name = tree.Identifier(yyDollar[1].cstrUnion().Compare())
+			yyLOCAL.ColType = yyDollar[2].columnTypeUnion()
+			yyLOCAL.Path = yyDollar[4].str
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4238
+	case 729:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4580
 		{
-			yyLOCAL = tree.HintUse
+			yyLOCAL = &tree.JsonTableColumn{
+				Type:    tree.JSON_TABLE_COLUMN_EXISTS,
+				Name:    tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+				ColType: yyDollar[2].columnTypeUnion(),
+				Path:    yyDollar[5].str,
+			}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 730:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4589
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Type:    tree.JSON_TABLE_COLUMN_NESTED,
+				Path:    yyDollar[3].str,
+				Columns: yyDollar[6].jsonTableColumnsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 731:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4597
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Type:    tree.JSON_TABLE_COLUMN_NESTED,
+				Path:    yyDollar[2].str,
+				Columns: yyDollar[5].jsonTableColumnsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 732:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4606
+		{
+			yyLOCAL = &tree.JsonTableColumn{}
+		}
+		yyVAL.union = yyLOCAL
+	case 733:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:4610
+		{
+			yyLOCAL = yyDollar[1].jsonTableColumnUnion()
+			switch strings.ToLower(yyDollar[4].cstrUnion().Compare()) {
+			case "empty":
+				if yyLOCAL.OnEmpty != nil || yyLOCAL.OnError != nil {
+					yylex.Error("ON EMPTY must be specified once and before ON ERROR")
+					return 1
+				}
+				yyLOCAL.OnEmpty = yyDollar[2].jsonTableResponseUnion()
+			case "error":
+				if yyLOCAL.OnError != nil {
+					yylex.Error("ON ERROR must be specified once")
+					return 1
+				}
+				yyLOCAL.OnError = yyDollar[2].jsonTableResponseUnion()
+			default:
+				yylex.Error("json_table column requires ON EMPTY or ON ERROR")
+				return 1
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 734:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.JsonTableResponse
+//line mysql_sql.y:4633
+		{
+			yyLOCAL = &tree.JsonTableResponse{Type: tree.JSON_TABLE_RESPONSE_NULL}
+		}
+		yyVAL.union = yyLOCAL
+	case 735:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.JsonTableResponse
+//line mysql_sql.y:4637
+		{
+			if strings.ToLower(yyDollar[1].cstrUnion().Compare()) != "error" {
+				yylex.Error("json_table column response must be NULL, ERROR or DEFAULT")
+				return 1
+			}
+			yyLOCAL = &tree.JsonTableResponse{Type: tree.JSON_TABLE_RESPONSE_ERROR}
+		}
+		yyVAL.union = yyLOCAL
+	case 736:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.JsonTableResponse
+//line mysql_sql.y:4645
+		{
+			yyLOCAL = &tree.JsonTableResponse{Type: tree.JSON_TABLE_RESPONSE_DEFAULT, Default: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 737:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line mysql_sql.y:4651
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[2].str),
+				},
+				IndexHints: yyDollar[3].indexHintListUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 738:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line mysql_sql.y:4661
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[3].str),
+				},
+				IndexHints: yyDollar[4].indexHintListUnion(),
+				AsOf:       yyDollar[2].asOfClauseUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 739:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.AsOfClause
+//line mysql_sql.y:4674
+		{
+			yyLOCAL = &tree.AsOfClause{
+				Type: tree.AsOfTimestamp,
+				Expr: yyDollar[4].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 740:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.AsOfClause
+//line mysql_sql.y:4681
+		{
+			if strings.ToLower(yyDollar[3].cstrUnion().Compare()) != "ts" {
+				yylex.Error("AS OF requires TIMESTAMP or TS")
+				return 1
+			}
+			yyLOCAL = &tree.AsOfClause{
+				Type: tree.AsOfTS,
+				Expr: tree.NewNumValWithType(constant.MakeString(yyDollar[4].str), yyDollar[4].str, false, tree.P_char),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 741:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.IndexHint
+//line mysql_sql.y:4693
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 743:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.IndexHint
+//line mysql_sql.y:4700
+		{
+			yyLOCAL = []*tree.IndexHint{yyDollar[1].indexHintUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 744:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []*tree.IndexHint
+//line mysql_sql.y:4704
+		{
+			yyLOCAL = append(yyDollar[1].indexHintListUnion(), yyDollar[2].indexHintUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 745:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.IndexHint
+//line mysql_sql.y:4710
+		{
+			yyLOCAL = &tree.IndexHint{
+				IndexNames: yyDollar[4].strsUnion(),
+				HintType:   yyDollar[1].indexHintTypeUnion(),
+				HintScope:  yyDollar[2].indexHintScopeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 746:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IndexHintType
+//line mysql_sql.y:4720
+		{
+			yyLOCAL = tree.HintUse
+		}
+		yyVAL.union = yyLOCAL
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4242
+//line mysql_sql.y:4724
 		{
 			yyLOCAL = tree.HintIgnore
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 748:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4246
+//line mysql_sql.y:4728
 		{
 			yyLOCAL = tree.HintForce
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 749:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4251
+//line mysql_sql.y:4733
 		{
 			yyLOCAL = tree.HintForScan
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4255
+//line mysql_sql.y:4737
 		{
 			yyLOCAL = tree.HintForJoin
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4259
+//line mysql_sql.y:4741
 		{
 			yyLOCAL = tree.HintForOrderBy
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4263
+//line mysql_sql.y:4745
 		{
 			yyLOCAL = tree.HintForGroupBy
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 753:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4268
+//line mysql_sql.y:4750
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4272
+//line mysql_sql.y:4754
 		{
 			yyLOCAL = []string{yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4276
+//line mysql_sql.y:4758
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 756:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4280
+//line mysql_sql.y:4762
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4284
+//line mysql_sql.y:4766
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 758:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4289
+//line mysql_sql.y:4771
 		{
 			yyVAL.str = ""
 		}
-	case 686:
+	case 759:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4293
+//line mysql_sql.y:4775
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 687:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4297
+//line mysql_sql.y:4779
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 688:
+	case 761:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4303
+//line mysql_sql.y:4785
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 690:
+	case 763:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4309
+//line mysql_sql.y:4791
 		{
 			yyLOCAL = tree.NewCStr("", yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 764:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4313
+//line mysql_sql.y:4795
 		{
 			yyLOCAL = yyDollar[1].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 765:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4317
+//line mysql_sql.y:4799
 		{
 			yyLOCAL = yyDollar[2].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 766:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4321
+//line mysql_sql.y:4803
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 767:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4325
+//line mysql_sql.y:4807
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[2].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4331
+//line mysql_sql.y:4813
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 708:
+	case 783:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:4847
+		{
+			yyLOCAL = &tree.CreatePlanBaseline{
+				Stmt: yyDollar[5].selectUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 784:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4363
+//line mysql_sql.y:4855
 		{
 			yyLOCAL = &tree.CreateExtension{
 				Language: yyDollar[3].str,
@@ -12357,22 +14899,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 785:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4373
+//line mysql_sql.y:4865
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 710:
+	case 786:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4379
+//line mysql_sql.y:4871
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 711:
+	case 787:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4386
+//line mysql_sql.y:4878
 		{
 			yyLOCAL = &tree.CreateFunction{
 				Name:       yyDollar[3].functionNameUnion(),
@@ -12383,98 +14925,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 788:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4398
+//line mysql_sql.y:4890
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 789:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4403
+//line mysql_sql.y:4895
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 790:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4409
+//line mysql_sql.y:4901
 		{
 			yyLOCAL = tree.FunctionArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4416
+//line mysql_sql.y:4908
 		{
 			yyLOCAL = tree.FunctionArgs{yyDollar[1].funcArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 793:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4420
+//line mysql_sql.y:4912
 		{
 			yyLOCAL = append(yyDollar[1].funcArgsUnion(), yyDollar[3].funcArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 794:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArg
-//line mysql_sql.y:4426
+//line mysql_sql.y:4918
 		{
 			yyLOCAL = tree.FunctionArg(yyDollar[1].funcArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 795:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4432
+//line mysql_sql.y:4924
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(nil, yyDollar[1].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4436
+//line mysql_sql.y:4928
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 797:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4440
+//line mysql_sql.y:4932
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 798:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4446
+//line mysql_sql.y:4938
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 723:
+	case 799:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReturnType
-//line mysql_sql.y:4452
+//line mysql_sql.y:4944
 		{
 			yyLOCAL = tree.NewReturnType(yyDollar[1].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 800:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4458
+//line mysql_sql.y:4950
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[4].tableNameUnion(),
@@ -12484,10 +15026,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 801:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4469
+//line mysql_sql.y:4961
 		{
 			yyLOCAL = &tree.CreateAccount{
 				IfNotExists:  yyDollar[3].ifNotExistsUnion(),
@@ -12498,16 +15040,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 802:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4481
+//line mysql_sql.y:4973
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 727:
+	case 803:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountAuthOption
-//line mysql_sql.y:4487
+//line mysql_sql.y:4979
 		{
 			yyLOCAL = tree.AccountAuthOption{
 				Equal:          yyDollar[2].str,
@@ -12516,22 +15058,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4497
+//line mysql_sql.y:4989
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 729:
+	case 805:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4501
+//line mysql_sql.y:4993
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 730:
+	case 806:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4507
+//line mysql_sql.y:4999
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -12539,20 +15081,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 807:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4514
+//line mysql_sql.y:5006
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 808:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4520
+//line mysql_sql.y:5012
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -12560,20 +15102,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 809:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4528
+//line mysql_sql.y:5020
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 810:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4534
+//line mysql_sql.y:5026
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -12581,10 +15123,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 811:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4541
+//line mysql_sql.y:5033
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -12592,20 +15134,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 812:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4549
+//line mysql_sql.y:5041
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 813:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4555
+//line mysql_sql.y:5047
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist:   true,
@@ -12613,10 +15155,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 814:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4564
+//line mysql_sql.y:5056
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -12627,10 +15169,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 815:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4576
+//line mysql_sql.y:5068
 		{
 			yyLOCAL = &tree.CreatePublication{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -12641,22 +15183,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 816:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4587
+//line mysql_sql.y:5079
 		{
 			yyVAL.str = ""
 		}
-	case 741:
+	case 817:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4591
+//line mysql_sql.y:5083
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 742:
+	case 818:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4597
+//line mysql_sql.y:5089
 		{
 			yyLOCAL = &tree.AlterPublication{
 				IfExists:    yyDollar[3].boolValUnion(),
@@ -12666,58 +15208,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 819:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4607
+//line mysql_sql.y:5099
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 820:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4611
+//line mysql_sql.y:5103
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				All: true,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 821:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4617
+//line mysql_sql.y:5109
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				SetAccounts: yyDollar[2].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 822:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4623
+//line mysql_sql.y:5115
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				AddAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 823:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4629
+//line mysql_sql.y:5121
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				DropAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 824:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4638
+//line mysql_sql.y:5130
 		{
 			yyLOCAL = &tree.DropPublication{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -12725,26 +15267,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 825:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4647
+//line mysql_sql.y:5139
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 750:
+	case 826:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:4652
+//line mysql_sql.y:5144
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 827:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:4658
+//line mysql_sql.y:5150
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -12753,10 +15295,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 828:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:4666
+//line mysql_sql.y:5158
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -12765,26 +15307,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:4772
+//line mysql_sql.y:5264
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 830:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:4776
+//line mysql_sql.y:5268
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 831:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:4782
+//line mysql_sql.y:5274
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -12793,26 +15335,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 832:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:4792
+//line mysql_sql.y:5284
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 833:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:4796
+//line mysql_sql.y:5288
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 834:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:4802
+//line mysql_sql.y:5294
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -12821,50 +15363,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 835:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:4812
+//line mysql_sql.y:5304
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 836:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:4816
+//line mysql_sql.y:5308
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 837:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:4820
+//line mysql_sql.y:5312
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 838:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:4825
+//line mysql_sql.y:5317
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 839:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:4829
+//line mysql_sql.y:5321
 		{
 			yyLOCAL = yyDollar[1].userIdentifiedUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 840:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:4835
+//line mysql_sql.y:5327
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -12872,20 +15414,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 841:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:4842
+//line mysql_sql.y:5334
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 842:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:4848
+//line mysql_sql.y:5340
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -12893,16 +15435,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4857
+//line mysql_sql.y:5349
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 769:
+	case 845:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4864
+//line mysql_sql.y:5356
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -12910,90 +15452,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:4873
+//line mysql_sql.y:5365
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 847:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:4877
+//line mysql_sql.y:5369
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 848:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:4883
+//line mysql_sql.y:5375
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4897
+//line mysql_sql.y:5389
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 850:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4901
+//line mysql_sql.y:5393
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 851:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4905
+//line mysql_sql.y:5397
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 852:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:4910
+//line mysql_sql.y:5402
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 853:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:4914
+//line mysql_sql.y:5406
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:4918
+//line mysql_sql.y:5410
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:4922
+//line mysql_sql.y:5414
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 856:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4928
+//line mysql_sql.y:5420
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -13012,18 +15554,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 857:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4947
+//line mysql_sql.y:5439
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 858:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4951
+//line mysql_sql.y:5443
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -13044,123 +15586,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 859:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4973
+//line mysql_sql.y:5465
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4977
+//line mysql_sql.y:5469
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 861:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4981
+//line mysql_sql.y:5473
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4985
+//line mysql_sql.y:5477
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4989
+//line mysql_sql.y:5481
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4995
+//line mysql_sql.y:5487
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 865:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4999
+//line mysql_sql.y:5491
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 866:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:5005
+//line mysql_sql.y:5497
 		{
 			// Order is parsed but just ignored as MySQL dtree.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 867:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:5010
+//line mysql_sql.y:5502
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 868:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5015
+//line mysql_sql.y:5507
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 869:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5019
+//line mysql_sql.y:5511
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 870:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5023
+//line mysql_sql.y:5515
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 871:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5027
+//line mysql_sql.y:5519
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5031
+//line mysql_sql.y:5523
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 873:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5037
+//line mysql_sql.y:5529
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -13170,114 +15712,114 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 874:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5048
+//line mysql_sql.y:5540
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 875:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5052
+//line mysql_sql.y:5544
 		{
 			yyLOCAL = &tree.SubscriptionOption{From: tree.Identifier(yyDollar[2].str), Publication: tree.Identifier(yyDollar[4].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 878:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5062
+//line mysql_sql.y:5554
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 879:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5066
+//line mysql_sql.y:5558
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 880:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5071
+//line mysql_sql.y:5563
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5075
+//line mysql_sql.y:5567
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5081
+//line mysql_sql.y:5573
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 883:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5085
+//line mysql_sql.y:5577
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 884:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5091
+//line mysql_sql.y:5583
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 885:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5095
+//line mysql_sql.y:5587
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 886:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5099
+//line mysql_sql.y:5591
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 887:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5104
+//line mysql_sql.y:5596
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5108
+//line mysql_sql.y:5600
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 889:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5114
+//line mysql_sql.y:5606
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -13290,10 +15832,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 890:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5126
+//line mysql_sql.y:5618
 		{
 			yyLOCAL = &tree.CreateTable{
 				IfNotExists: yyDollar[4].ifNotExistsUnion(),
@@ -13303,10 +15845,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 891:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5135
+//line mysql_sql.y:5627
 		{
 			yyLOCAL = &tree.CreateTable{
 				IsClusterTable:  true,
@@ -13319,19 +15861,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 892:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5148
+//line mysql_sql.y:5640
 		{
 			yyLOCAL = yyDollar[1].loadParamUnion()
 			yyLOCAL.Tail = yyDollar[2].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 893:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5155
+//line mysql_sql.y:5647
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -13342,10 +15884,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 894:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5165
+//line mysql_sql.y:5657
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -13354,10 +15896,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 895:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5173
+//line mysql_sql.y:5665
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -13367,43 +15909,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 896:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5184
+//line mysql_sql.y:5676
 		{
 			yyLOCAL = yyDollar[1].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5188
+//line mysql_sql.y:5680
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].strsUnion()...)
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 898:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5193
+//line mysql_sql.y:5685
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 899:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5197
+//line mysql_sql.y:5689
 		{
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 			yyLOCAL = append(yyLOCAL, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 900:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.TailParameter
-//line mysql_sql.y:5204
+//line mysql_sql.y:5696
 		{
 			yyLOCAL = &tree.TailParameter{
 				Fields:       yyDollar[1].fieldsUnion(),
@@ -13414,10 +15956,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 901:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5215
+//line mysql_sql.y:5707
 		{
 			yyLOCAL = &tree.CreateSequence{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -13431,10 +15973,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 902:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5228
+//line mysql_sql.y:5720
 		{
 			locale := ""
 			fstr := "bigint"
@@ -13449,26 +15991,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 903:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5242
+//line mysql_sql.y:5734
 		{
 			yyLOCAL = yyDollar[2].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 904:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5246
+//line mysql_sql.y:5738
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 905:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5250
+//line mysql_sql.y:5742
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -13476,10 +16018,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 906:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5257
+//line mysql_sql.y:5749
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -13487,10 +16029,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 907:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5264
+//line mysql_sql.y:5756
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -13498,10 +16040,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 908:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5271
+//line mysql_sql.y:5763
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -13509,42 +16051,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 909:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5278
+//line mysql_sql.y:5770
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 910:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5282
+//line mysql_sql.y:5774
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 911:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5286
+//line mysql_sql.y:5778
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 912:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5290
+//line mysql_sql.y:5782
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 913:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5294
+//line mysql_sql.y:5786
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: false,
@@ -13552,10 +16094,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 914:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5301
+//line mysql_sql.y:5793
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: true,
@@ -13563,18 +16105,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 915:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5308
+//line mysql_sql.y:5800
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 916:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5312
+//line mysql_sql.y:5804
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: false,
@@ -13582,10 +16124,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5319
+//line mysql_sql.y:5811
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: true,
@@ -13593,18 +16135,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 918:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5326
+//line mysql_sql.y:5818
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 919:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5330
+//line mysql_sql.y:5822
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -13612,10 +16154,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 920:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5337
+//line mysql_sql.y:5829
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -13623,10 +16165,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 921:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5344
+//line mysql_sql.y:5836
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -13634,10 +16176,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 922:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5351
+//line mysql_sql.y:5843
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -13645,34 +16187,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 923:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5358
+//line mysql_sql.y:5850
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 924:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5362
+//line mysql_sql.y:5854
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 925:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5367
+//line mysql_sql.y:5859
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 926:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5371
+//line mysql_sql.y:5863
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -13682,18 +16224,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 927:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5381
+//line mysql_sql.y:5873
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 928:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5385
+//line mysql_sql.y:5877
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: []*tree.UnresolvedName{yyDollar[3].unresolvedNameUnion()},
@@ -13701,28 +16243,28 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 929:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5392
+//line mysql_sql.y:5884
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: yyDollar[4].unresolveNamesUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 930:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5399
+//line mysql_sql.y:5891
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 931:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5403
+//line mysql_sql.y:5895
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -13731,42 +16273,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 932:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5412
+//line mysql_sql.y:5904
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 933:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5416
+//line mysql_sql.y:5908
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5422
+//line mysql_sql.y:5914
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 935:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5426
+//line mysql_sql.y:5918
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 936:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5432
+//line mysql_sql.y:5924
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -13776,10 +16318,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 937:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5441
+//line mysql_sql.y:5933
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -13789,42 +16331,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 938:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5451
+//line mysql_sql.y:5943
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 939:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5455
+//line mysql_sql.y:5947
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5461
+//line mysql_sql.y:5953
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 941:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5465
+//line mysql_sql.y:5957
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 942:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5471
+//line mysql_sql.y:5963
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -13832,10 +16374,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 943:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5478
+//line mysql_sql.y:5970
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -13843,67 +16385,67 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5487
+//line mysql_sql.y:5979
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 945:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5491
+//line mysql_sql.y:5983
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 946:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5496
+//line mysql_sql.y:5988
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 947:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5500
+//line mysql_sql.y:5992
 		{
 			expr := tree.NewMaxValue()
 			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{expr}}
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 948:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5505
+//line mysql_sql.y:5997
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 949:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5509
+//line mysql_sql.y:6001
 		{
 			yyLOCAL = &tree.ValuesIn{ValueList: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 950:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5514
+//line mysql_sql.y:6006
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 951:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5518
+//line mysql_sql.y:6010
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -13913,18 +16455,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 952:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5528
+//line mysql_sql.y:6020
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 953:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5532
+//line mysql_sql.y:6024
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -13934,10 +16476,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 954:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5543
+//line mysql_sql.y:6035
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -13946,10 +16488,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 955:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5551
+//line mysql_sql.y:6043
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -13958,10 +16500,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 956:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5559
+//line mysql_sql.y:6051
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -13970,10 +16512,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 957:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5567
+//line mysql_sql.y:6059
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -13982,10 +16524,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 959:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5578
+//line mysql_sql.y:6070
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -13995,10 +16537,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 960:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5587
+//line mysql_sql.y:6079
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -14009,10 +16551,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 961:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5597
+//line mysql_sql.y:6089
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -14022,479 +16564,479 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 962:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5607
+//line mysql_sql.y:6099
 		{
 			yyLOCAL = 2
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 963:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5611
+//line mysql_sql.y:6103
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 964:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5616
+//line mysql_sql.y:6108
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5620
+//line mysql_sql.y:6112
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 966:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5625
+//line mysql_sql.y:6117
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5629
+//line mysql_sql.y:6121
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5635
+//line mysql_sql.y:6127
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 969:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5639
+//line mysql_sql.y:6131
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 970:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5643
+//line mysql_sql.y:6135
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 971:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5649
+//line mysql_sql.y:6141
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 972:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5653
+//line mysql_sql.y:6145
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 973:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5657
+//line mysql_sql.y:6149
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 974:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5661
+//line mysql_sql.y:6153
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 975:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5665
+//line mysql_sql.y:6157
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 976:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5669
+//line mysql_sql.y:6161
 		{
 			str := util.DealCommentString(yyDollar[3].str)
 			yyLOCAL = tree.NewTableOptionComment(str)
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 977:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5674
+//line mysql_sql.y:6166
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 978:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5678
+//line mysql_sql.y:6170
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 979:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5682
+//line mysql_sql.y:6174
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 980:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5686
+//line mysql_sql.y:6178
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 981:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5690
+//line mysql_sql.y:6182
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 982:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5694
+//line mysql_sql.y:6186
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 983:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5698
+//line mysql_sql.y:6190
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 984:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5702
+//line mysql_sql.y:6194
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 985:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5706
+//line mysql_sql.y:6198
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 986:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5710
+//line mysql_sql.y:6202
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 987:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5714
+//line mysql_sql.y:6206
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 988:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5718
+//line mysql_sql.y:6210
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 989:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5722
+//line mysql_sql.y:6214
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 990:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5726
+//line mysql_sql.y:6218
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 991:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5730
+//line mysql_sql.y:6222
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 992:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5734
+//line mysql_sql.y:6226
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 993:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5738
+//line mysql_sql.y:6230
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 994:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5742
+//line mysql_sql.y:6234
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 995:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5746
+//line mysql_sql.y:6238
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 996:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5750
+//line mysql_sql.y:6242
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 997:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5754
+//line mysql_sql.y:6246
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].cstrUnion().Compare(), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 998:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5758
+//line mysql_sql.y:6250
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 999:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5762
+//line mysql_sql.y:6254
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 1000:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:5769
+//line mysql_sql.y:6261
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 1001:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:5773
+//line mysql_sql.y:6265
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 1002:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:5779
+//line mysql_sql.y:6271
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 1003:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5784
+//line mysql_sql.y:6276
 		{
 			yyVAL.str = ""
 		}
-	case 928:
+	case 1004:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5788
+//line mysql_sql.y:6280
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 929:
+	case 1005:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5792
+//line mysql_sql.y:6284
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 930:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5798
+//line mysql_sql.y:6290
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5802
+//line mysql_sql.y:6294
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5806
+//line mysql_sql.y:6298
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5810
+//line mysql_sql.y:6302
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5814
+//line mysql_sql.y:6306
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 1011:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5818
+//line mysql_sql.y:6310
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 1016:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:5832
+//line mysql_sql.y:6324
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 1017:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:5836
+//line mysql_sql.y:6328
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:5845
+//line mysql_sql.y:6337
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 1019:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:5850
+//line mysql_sql.y:6342
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 1020:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:5856
+//line mysql_sql.y:6348
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1022:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:5863
+//line mysql_sql.y:6355
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 1023:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:5867
+//line mysql_sql.y:6359
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1024:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5873
+//line mysql_sql.y:6365
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 1025:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5877
+//line mysql_sql.y:6369
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1026:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5883
+//line mysql_sql.y:6375
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -14502,23 +17044,25 @@ yydefault:
 					v.Name = yyDollar[1].str
 				case *tree.ForeignKey:
 					v.Name = yyDollar[1].str
+				case *tree.CheckIndex:
+					v.Name = yyDollar[1].str
 				}
 			}
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1027:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5895
+//line mysql_sql.y:6389
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1028:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5901
+//line mysql_sql.y:6395
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -14528,10 +17072,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1029:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5910
+//line mysql_sql.y:6404
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -14541,10 +17085,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1030:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5919
+//line mysql_sql.y:6413
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -14568,10 +17112,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1031:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5942
+//line mysql_sql.y:6436
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -14581,10 +17125,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1032:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5951
+//line mysql_sql.y:6445
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -14595,10 +17139,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1033:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5961
+//line mysql_sql.y:6455
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -14606,297 +17150,305 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1034:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5969
+//line mysql_sql.y:6463
 		{
-			yyLOCAL = false
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1036:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5975
+//line mysql_sql.y:6469
 		{
 			yyVAL.str = ""
 		}
-	case 961:
+	case 1037:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5979
+//line mysql_sql.y:6473
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 964:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5989
+//line mysql_sql.y:6483
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1041:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5995
+//line mysql_sql.y:6489
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1042:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6001
+//line mysql_sql.y:6495
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].cstrUnion().Compare()
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1048:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6015
+//line mysql_sql.y:6509
 		{
 			yyVAL.str = ""
 		}
-	case 973:
+	case 1049:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6019
+//line mysql_sql.y:6513
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 974:
+	case 1050:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:6025
+//line mysql_sql.y:6519
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1051:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6031
+//line mysql_sql.y:6525
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1052:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6035
+//line mysql_sql.y:6529
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1053:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6039
+//line mysql_sql.y:6533
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1054:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6045
+//line mysql_sql.y:6539
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1055:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6049
+//line mysql_sql.y:6543
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1056:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6053
+//line mysql_sql.y:6547
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1057:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6057
+//line mysql_sql.y:6551
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1058:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6063
+//line mysql_sql.y:6557
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1059:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6067
+//line mysql_sql.y:6561
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1060:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6071
+//line mysql_sql.y:6565
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1061:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6076
+//line mysql_sql.y:6570
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1062:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6080
+//line mysql_sql.y:6574
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1063:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6086
+//line mysql_sql.y:6580
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1064:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6090
+//line mysql_sql.y:6584
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1065:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6096
+//line mysql_sql.y:6590
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1066:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6100
+//line mysql_sql.y:6594
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1067:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6104
+//line mysql_sql.y:6598
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1068:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6108
+//line mysql_sql.y:6602
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1069:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6112
+//line mysql_sql.y:6606
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1070:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6116
+//line mysql_sql.y:6610
 		{
 			str := util.DealCommentString(yyDollar[2].str)
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(str), str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1071:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6121
+//line mysql_sql.y:6615
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 1072:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6125
+//line mysql_sql.y:6619
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 997:
+	case 1073:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6129
+//line mysql_sql.y:6623
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1074:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:6627
+		{
+			yyLOCAL = tree.NewAttributeCompression(yyDollar[2].str, yyDollar[3].int64ValUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1075:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6133
+//line mysql_sql.y:6631
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1076:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6137
+//line mysql_sql.y:6635
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1077:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6141
+//line mysql_sql.y:6639
 		{
-			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
+			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), true, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1078:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6145
+//line mysql_sql.y:6643
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1079:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6149
+//line mysql_sql.y:6647
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			var es tree.Exprs = nil
@@ -14910,58 +17462,98 @@ yydefault:
 			yyLOCAL = tree.NewAttributeOnUpdate(expr)
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1080:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6162
+//line mysql_sql.y:6660
 		{
 			yyLOCAL = tree.NewAttributeLowCardinality()
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1081:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:6664
+		{
+			yyLOCAL = tree.NewAttributeGeneratedAlways(yyDollar[3].exprUnion(), yyDollar[5].boolValUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1082:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:6668
+		{
+			yyLOCAL = tree.NewAttributeGeneratedAlways(yyDollar[5].exprUnion(), yyDollar[7].boolValUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1083:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:6673
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 1084:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:6677
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 1085:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:6681
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 1086:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6168
+//line mysql_sql.y:6687
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1087:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6172
+//line mysql_sql.y:6691
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1088:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6177
+//line mysql_sql.y:6696
 		{
 			yyVAL.str = ""
 		}
-	case 1007:
+	case 1089:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6181
+//line mysql_sql.y:6700
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1008:
+	case 1090:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6187
+//line mysql_sql.y:6706
 		{
 			yyVAL.str = ""
 		}
-	case 1009:
+	case 1091:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6191
+//line mysql_sql.y:6710
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 1010:
+	case 1092:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:6197
+//line mysql_sql.y:6716
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -14972,10 +17564,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1093:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6209
+//line mysql_sql.y:6728
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -14983,10 +17575,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1094:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6216
+//line mysql_sql.y:6735
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -14994,10 +17586,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1095:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6223
+//line mysql_sql.y:6742
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -15005,10 +17597,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1096:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6230
+//line mysql_sql.y:6749
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -15016,10 +17608,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1097:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6237
+//line mysql_sql.y:6756
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -15027,339 +17619,388 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1098:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6246
+//line mysql_sql.y:6765
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1099:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6252
+//line mysql_sql.y:6771
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6258
+//line mysql_sql.y:6777
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6262
+//line mysql_sql.y:6781
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1102:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6266
+//line mysql_sql.y:6785
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1103:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6270
+//line mysql_sql.y:6789
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6274
+//line mysql_sql.y:6793
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1105:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6279
+//line mysql_sql.y:6798
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1107:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6286
+//line mysql_sql.y:6805
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6290
+//line mysql_sql.y:6809
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1109:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6294
+//line mysql_sql.y:6813
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1110:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6299
+//line mysql_sql.y:6818
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1029:
+	case 1111:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6303
+//line mysql_sql.y:6822
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1112:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6308
+//line mysql_sql.y:6827
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 1031:
+	case 1113:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6312
+//line mysql_sql.y:6831
+		{
+			yyLOCAL = yyDollar[2].item.(int64)
+		}
+		yyVAL.union = yyLOCAL
+	case 1114:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL int64
+//line mysql_sql.y:6836
+		{
+			yyLOCAL = 0
+		}
+		yyVAL.union = yyLOCAL
+	case 1115:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL int64
+//line mysql_sql.y:6840
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1038:
+	case 1122:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:6328
+//line mysql_sql.y:6856
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 1039:
+	case 1123:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6334
+//line mysql_sql.y:6862
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1040:
+	case 1124:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6338
+//line mysql_sql.y:6866
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1041:
+	case 1125:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6342
+//line mysql_sql.y:6870
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1042:
+	case 1126:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6346
+//line mysql_sql.y:6874
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1127:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6350
+//line mysql_sql.y:6878
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1044:
+	case 1128:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6354
+//line mysql_sql.y:6882
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1129:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6358
+//line mysql_sql.y:6886
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1130:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6362
+//line mysql_sql.y:6890
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1131:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6366
+//line mysql_sql.y:6894
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1132:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6370
+//line mysql_sql.y:6898
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1133:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6374
+//line mysql_sql.y:6902
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1134:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6378
+//line mysql_sql.y:6906
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6382
+//line mysql_sql.y:6910
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6388
+//line mysql_sql.y:6916
 		{
+			yylex.(*Lexer).addIdentRef(yyDollar[1].unresolvedNameUnion(), yyDollar[1].offset)
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6392
+//line mysql_sql.y:6921
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6396
+//line mysql_sql.y:6925
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1139:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:6929
+		{
+			yylex.(*Lexer).addIdentRef(yyDollar[1].unresolvedNameUnion(), yyDollar[1].offset)
+			name := tree.SetUnresolvedName("json_extract")
+			path := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), path},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1140:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:6939
+		{
+			yylex.(*Lexer).addIdentRef(yyDollar[1].unresolvedNameUnion(), yyDollar[1].offset)
+			name := tree.SetUnresolvedName("json_extract")
+			path := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
+			extract := &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), path},
+			}
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_unquote")),
+				Exprs: tree.Exprs{extract},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1141:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6400
+//line mysql_sql.y:6953
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1142:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6404
+//line mysql_sql.y:6957
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1143:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6408
+//line mysql_sql.y:6961
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1144:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6412
+//line mysql_sql.y:6965
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1145:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6416
+//line mysql_sql.y:6969
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1146:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6420
+//line mysql_sql.y:6973
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6424
+//line mysql_sql.y:6977
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6428
+//line mysql_sql.y:6981
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1149:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6432
+//line mysql_sql.y:6985
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1150:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6437
+//line mysql_sql.y:6990
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -15368,26 +18009,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1151:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6445
+//line mysql_sql.y:6998
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1152:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:7002
+		{
+			yyLOCAL = &tree.CastExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].columnTypeUnion(), Array: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 1153:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6450
+//line mysql_sql.y:7007
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1154:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6454
+//line mysql_sql.y:7011
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -15397,50 +18046,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6463
+//line mysql_sql.y:7020
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6467
+//line mysql_sql.y:7024
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1157:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6471
+//line mysql_sql.y:7028
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6475
+//line mysql_sql.y:7032
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1159:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6479
+//line mysql_sql.y:7036
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1160:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:7040
+		{
+			yyLOCAL = tree.NewFullTextMatchExpr(yyDollar[3].unresolveNamesUnion(), yyDollar[7].exprUnion(), yyDollar[8].fullTextSearchTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1161:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:7045
+		{
+			yyLOCAL = tree.FULLTEXT_DEFAULT
+		}
+		yyVAL.union = yyLOCAL
+	case 1162:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:7049
+		{
+			yyLOCAL = tree.FULLTEXT_NATURAL_LANGUAGE
+		}
+		yyVAL.union = yyLOCAL
+	case 1163:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:7053
+		{
+			yyLOCAL = tree.FULLTEXT_NATURAL_LANGUAGE_QUERY_EXPANSION
+		}
+		yyVAL.union = yyLOCAL
+	case 1164:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:7057
+		{
+			yyLOCAL = tree.FULLTEXT_BOOLEAN
+		}
+		yyVAL.union = yyLOCAL
+	case 1165:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:7061
+		{
+			yyLOCAL = tree.FULLTEXT_QUERY_EXPANSION
+		}
+		yyVAL.union = yyLOCAL
+	case 1166:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6485
+//line mysql_sql.y:7067
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15449,58 +18146,71 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1074:
+	case 1167:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:7075
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1168:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6494
+//line mysql_sql.y:7085
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1075:
+	case 1169:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6498
+//line mysql_sql.y:7089
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1076:
+	case 1170:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6503
+//line mysql_sql.y:7094
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1077:
+	case 1171:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6507
+//line mysql_sql.y:7098
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1078:
+	case 1172:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6513
+//line mysql_sql.y:7104
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1079:
+	case 1173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6517
+//line mysql_sql.y:7108
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1174:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:6523
+//line mysql_sql.y:7114
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -15508,10 +18218,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6533
+//line mysql_sql.y:7124
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -15529,10 +18239,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6550
+//line mysql_sql.y:7141
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15547,10 +18257,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1179:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6567
+//line mysql_sql.y:7158
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15564,10 +18274,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6580
+//line mysql_sql.y:7171
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15581,10 +18291,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6593
+//line mysql_sql.y:7184
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15597,10 +18307,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6605
+//line mysql_sql.y:7196
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15615,10 +18325,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6619
+//line mysql_sql.y:7210
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15634,10 +18344,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6634
+//line mysql_sql.y:7225
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15653,10 +18363,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1091:
+	case 1185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6649
+//line mysql_sql.y:7240
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -15674,10 +18384,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1092:
+	case 1186:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6666
+//line mysql_sql.y:7257
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15692,83 +18402,83 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1187:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6681
+//line mysql_sql.y:7272
 		{
 		}
-	case 1096:
+	case 1190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:6687
+//line mysql_sql.y:7278
 		{
 			yyLOCAL = &tree.WindowFrameBoundCurrentRow{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1191:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:6691
+//line mysql_sql.y:7282
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1192:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:6695
+//line mysql_sql.y:7286
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1193:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:6701
+//line mysql_sql.y:7292
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1100:
+	case 1194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:6705
+//line mysql_sql.y:7296
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1101:
+	case 1195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:6713
+//line mysql_sql.y:7304
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_ROWS
 		}
 		yyVAL.union = yyLOCAL
-	case 1102:
+	case 1196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:6717
+//line mysql_sql.y:7308
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_RANGE
 		}
 		yyVAL.union = yyLOCAL
-	case 1103:
+	case 1197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:6721
+//line mysql_sql.y:7312
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_GROUPS
 		}
 		yyVAL.union = yyLOCAL
-	case 1104:
+	case 1198:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:6727
+//line mysql_sql.y:7318
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -15776,10 +18486,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1105:
+	case 1199:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:6734
+//line mysql_sql.y:7325
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -15788,70 +18498,70 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1200:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:6743
+//line mysql_sql.y:7334
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1107:
+	case 1201:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:6747
+//line mysql_sql.y:7338
 		{
 			yyLOCAL = yyDollar[1].windowFrameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1202:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:6754
+//line mysql_sql.y:7345
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1203:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:6759
+//line mysql_sql.y:7350
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1204:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:6763
+//line mysql_sql.y:7354
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1205:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6768
+//line mysql_sql.y:7359
 		{
 			yyVAL.str = ","
 		}
-	case 1112:
+	case 1206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6772
+//line mysql_sql.y:7363
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1113:
+	case 1207:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:6777
+//line mysql_sql.y:7368
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1209:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:6784
+//line mysql_sql.y:7375
 		{
 			yyLOCAL = &tree.WindowSpec{
 				PartitionBy: yyDollar[3].exprsUnion(),
@@ -15860,10 +18570,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1210:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6794
+//line mysql_sql.y:7385
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15875,10 +18585,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1211:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6805
+//line mysql_sql.y:7396
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15889,10 +18599,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1212:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6815
+//line mysql_sql.y:7406
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15902,10 +18612,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1213:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6824
+//line mysql_sql.y:7415
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15915,10 +18625,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1120:
+	case 1214:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6833
+//line mysql_sql.y:7424
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15929,10 +18639,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1121:
+	case 1215:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6843
+//line mysql_sql.y:7434
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15943,10 +18653,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1122:
+	case 1216:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6853
+//line mysql_sql.y:7444
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15957,10 +18667,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1123:
+	case 1217:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6863
+//line mysql_sql.y:7454
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15971,10 +18681,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1124:
+	case 1218:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6873
+//line mysql_sql.y:7464
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -15985,10 +18695,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1125:
+	case 1219:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6883
+//line mysql_sql.y:7474
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15999,10 +18709,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1126:
+	case 1220:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6893
+//line mysql_sql.y:7484
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16013,10 +18723,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1127:
+	case 1221:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6903
+//line mysql_sql.y:7494
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16027,10 +18737,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1128:
+	case 1222:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6913
+//line mysql_sql.y:7504
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16041,10 +18751,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1129:
+	case 1223:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6923
+//line mysql_sql.y:7514
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16055,10 +18765,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1130:
+	case 1224:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6933
+//line mysql_sql.y:7524
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16069,10 +18779,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1131:
+	case 1225:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6943
+//line mysql_sql.y:7534
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16083,10 +18793,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1132:
+	case 1226:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6953
+//line mysql_sql.y:7544
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16097,10 +18807,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1136:
+	case 1230:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6970
+//line mysql_sql.y:7561
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16109,10 +18819,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1137:
+	case 1231:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6978
+//line mysql_sql.y:7569
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16121,10 +18831,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1138:
+	case 1232:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6986
+//line mysql_sql.y:7577
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16133,10 +18843,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1139:
+	case 1233:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6994
+//line mysql_sql.y:7585
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16145,10 +18855,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1140:
+	case 1234:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7002
+//line mysql_sql.y:7593
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -16159,10 +18869,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1141:
+	case 1235:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7012
+//line mysql_sql.y:7603
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16171,10 +18881,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1142:
+	case 1236:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7020
+//line mysql_sql.y:7611
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16184,10 +18894,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1143:
+	case 1237:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7029
+//line mysql_sql.y:7620
 		{
 			name := tree.SetUnresolvedName("nextval")
 			yyLOCAL = &tree.FuncExpr{
@@ -16196,10 +18906,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1144:
+	case 1238:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7037
+//line mysql_sql.y:7628
 		{
 			name := tree.SetUnresolvedName("setval")
 			yyLOCAL = &tree.FuncExpr{
@@ -16208,10 +18918,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1145:
+	case 1239:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7045
+//line mysql_sql.y:7636
 		{
 			name := tree.SetUnresolvedName("currval")
 			yyLOCAL = &tree.FuncExpr{
@@ -16220,10 +18930,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1146:
+	case 1240:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7053
+//line mysql_sql.y:7644
 		{
 			name := tree.SetUnresolvedName("lastval")
 			yyLOCAL = &tree.FuncExpr{
@@ -16232,10 +18942,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1147:
+	case 1241:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7061
+//line mysql_sql.y:7652
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64)
@@ -16247,10 +18957,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1148:
+	case 1242:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7072
+//line mysql_sql.y:7663
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)
@@ -16261,10 +18971,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1149:
+	case 1243:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7082
+//line mysql_sql.y:7673
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(2), "2", false, tree.P_int64)
@@ -16277,10 +18987,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1150:
+	case 1244:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7094
+//line mysql_sql.y:7685
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(3), "3", false, tree.P_int64)
@@ -16292,10 +19002,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1151:
+	case 1245:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7105
+//line mysql_sql.y:7696
 		{
 			column := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
@@ -16305,16 +19015,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1158:
+	case 1252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:7127
+//line mysql_sql.y:7718
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1187:
+	case 1281:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7163
+//line mysql_sql.y:7754
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -16327,10 +19037,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1188:
+	case 1282:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7175
+//line mysql_sql.y:7766
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -16343,10 +19053,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1189:
+	case 1283:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7187
+//line mysql_sql.y:7778
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -16357,10 +19067,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1190:
+	case 1284:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7198
+//line mysql_sql.y:7789
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16369,10 +19079,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1191:
+	case 1285:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:7797
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1286:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7206
+//line mysql_sql.y:7805
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16380,10 +19102,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1192:
+	case 1287:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7213
+//line mysql_sql.y:7812
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16391,10 +19113,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1193:
+	case 1288:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7220
+//line mysql_sql.y:7819
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -16407,10 +19129,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1194:
+	case 1289:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7232
+//line mysql_sql.y:7831
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -16419,10 +19141,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1195:
+	case 1290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7240
+//line mysql_sql.y:7839
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -16431,10 +19153,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1196:
+	case 1291:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7248
+//line mysql_sql.y:7847
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -16446,10 +19168,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1197:
+	case 1292:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7259
+//line mysql_sql.y:7858
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -16459,10 +19181,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1198:
+	case 1293:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7268
+//line mysql_sql.y:7867
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -16472,10 +19194,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1199:
+	case 1294:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7277
+//line mysql_sql.y:7876
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -16484,10 +19206,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1200:
+	case 1295:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7285
+//line mysql_sql.y:7884
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -16498,10 +19220,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1201:
+	case 1296:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7295
+//line mysql_sql.y:7894
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -16510,10 +19232,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1202:
+	case 1297:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7303
+//line mysql_sql.y:7902
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -16523,34 +19245,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1203:
+	case 1298:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7313
+//line mysql_sql.y:7912
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1204:
+	case 1299:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7317
+//line mysql_sql.y:7916
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1205:
+	case 1300:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7323
+//line mysql_sql.y:7922
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1206:
+	case 1301:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7327
+//line mysql_sql.y:7926
 		{
 			ival, errStr := util.GetInt64(yyDollar[2].item)
 			if errStr != "" {
@@ -16561,20 +19283,20 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(ival), str, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1213:
+	case 1308:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7346
+//line mysql_sql.y:7945
 		{
 		}
-	case 1214:
+	case 1309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7348
+//line mysql_sql.y:7947
 		{
 		}
-	case 1248:
+	case 1343:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7389
+//line mysql_sql.y:7988
 		{
 			name := tree.SetUnresolvedName("interval")
 			str := strings.ToLower(yyDollar[3].str)
@@ -16585,90 +19307,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1249:
+	case 1344:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7400
+//line mysql_sql.y:7999
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1250:
+	case 1345:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7404
+//line mysql_sql.y:8003
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 1251:
+	case 1346:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7408
+//line mysql_sql.y:8007
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1252:
+	case 1347:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:7414
+//line mysql_sql.y:8013
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1253:
+	case 1348:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7419
+//line mysql_sql.y:8018
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1254:
+	case 1349:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7423
+//line mysql_sql.y:8022
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1255:
+	case 1350:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7429
+//line mysql_sql.y:8028
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1256:
+	case 1351:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7433
+//line mysql_sql.y:8032
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1257:
+	case 1352:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7440
+//line mysql_sql.y:8039
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1258:
+	case 1353:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7444
+//line mysql_sql.y:8043
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1259:
+	case 1354:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7448
+//line mysql_sql.y:8047
 		{
 			name := tree.SetUnresolvedName(strings.ToLower("concat"))
 			yyLOCAL = &tree.FuncExpr{
@@ -16677,355 +19399,363 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1260:
+	case 1355:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7456
+//line mysql_sql.y:8055
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1261:
+	case 1356:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7460
+//line mysql_sql.y:8059
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1262:
+	case 1357:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7464
+//line mysql_sql.y:8063
 		{
 			yyLOCAL = tree.NewMaxValue()
 		}
 		yyVAL.union = yyLOCAL
-	case 1263:
+	case 1358:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7468
+//line mysql_sql.y:8067
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1264:
+	case 1359:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7474
+//line mysql_sql.y:8073
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1265:
+	case 1360:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7478
+//line mysql_sql.y:8077
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1266:
+	case 1361:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7482
+//line mysql_sql.y:8081
 		{
 			yyLOCAL = tree.NewIsUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1267:
+	case 1362:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7486
+//line mysql_sql.y:8085
 		{
 			yyLOCAL = tree.NewIsNotUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1268:
+	case 1363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7490
+//line mysql_sql.y:8089
 		{
 			yyLOCAL = tree.NewIsTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1269:
+	case 1364:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7494
+//line mysql_sql.y:8093
 		{
 			yyLOCAL = tree.NewIsNotTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1270:
+	case 1365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7498
+//line mysql_sql.y:8097
 		{
 			yyLOCAL = tree.NewIsFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1271:
+	case 1366:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7502
+//line mysql_sql.y:8101
 		{
 			yyLOCAL = tree.NewIsNotFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1272:
+	case 1367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7506
+//line mysql_sql.y:8105
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1273:
+	case 1368:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7510
+//line mysql_sql.y:8109
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1275:
+	case 1370:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7518
+//line mysql_sql.y:8117
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1276:
+	case 1371:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7522
+//line mysql_sql.y:8121
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1277:
+	case 1372:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7526
+//line mysql_sql.y:8125
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1278:
+	case 1373:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7530
+//line mysql_sql.y:8129
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1279:
+	case 1374:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7534
+//line mysql_sql.y:8133
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.ILIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1280:
+	case 1375:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7538
+//line mysql_sql.y:8137
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_ILIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1281:
+	case 1376:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7542
+//line mysql_sql.y:8141
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1282:
+	case 1377:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7546
+//line mysql_sql.y:8145
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1283:
+	case 1378:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:8149
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.MEMBER_OF, yyDollar[1].exprUnion(), tree.NewParenExpr(yyDollar[5].exprUnion()))
+		}
+		yyVAL.union = yyLOCAL
+	case 1379:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7550
+//line mysql_sql.y:8153
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1284:
+	case 1380:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7554
+//line mysql_sql.y:8157
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1286:
+	case 1382:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7560
+//line mysql_sql.y:8163
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1287:
+	case 1383:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7564
+//line mysql_sql.y:8167
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1288:
+	case 1384:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7570
+//line mysql_sql.y:8173
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1289:
+	case 1385:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7574
+//line mysql_sql.y:8177
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1290:
+	case 1386:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7581
+//line mysql_sql.y:8184
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1291:
+	case 1387:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7585
+//line mysql_sql.y:8188
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 1292:
+	case 1388:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7589
+//line mysql_sql.y:8192
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 1293:
+	case 1389:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7595
+//line mysql_sql.y:8198
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1294:
+	case 1390:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7599
+//line mysql_sql.y:8202
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1295:
+	case 1391:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7603
+//line mysql_sql.y:8206
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1296:
+	case 1392:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7607
+//line mysql_sql.y:8210
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1297:
+	case 1393:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7611
+//line mysql_sql.y:8214
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1298:
+	case 1394:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7615
+//line mysql_sql.y:8218
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1299:
+	case 1395:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7619
+//line mysql_sql.y:8222
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1300:
+	case 1396:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7625
+//line mysql_sql.y:8228
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1301:
+	case 1397:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7629
+//line mysql_sql.y:8232
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1302:
+	case 1398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7633
+//line mysql_sql.y:8236
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 1303:
+	case 1399:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7637
+//line mysql_sql.y:8240
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1304:
+	case 1400:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7643
+//line mysql_sql.y:8246
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 1305:
+	case 1401:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7647
+//line mysql_sql.y:8250
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -17039,59 +19769,59 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1306:
+	case 1402:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7660
+//line mysql_sql.y:8263
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1307:
+	case 1403:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7665
+//line mysql_sql.y:8268
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1308:
+	case 1404:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7669
+//line mysql_sql.y:8272
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1309:
+	case 1405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7673
+//line mysql_sql.y:8276
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 1310:
+	case 1406:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7677
+//line mysql_sql.y:8280
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_hexnum)
 		}
 		yyVAL.union = yyLOCAL
-	case 1311:
+	case 1407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7681
+//line mysql_sql.y:8284
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1312:
+	case 1408:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7685
+//line mysql_sql.y:8288
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -17106,53 +19836,53 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1313:
+	case 1409:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7699
+//line mysql_sql.y:8302
 		{
 			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
 		}
 		yyVAL.union = yyLOCAL
-	case 1314:
+	case 1410:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7703
+//line mysql_sql.y:8306
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_ScoreBinary)
 		}
 		yyVAL.union = yyLOCAL
-	case 1315:
+	case 1411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7710
+//line mysql_sql.y:8313
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1319:
+	case 1415:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7721
+//line mysql_sql.y:8324
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1320:
+	case 1416:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7726
+//line mysql_sql.y:8329
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1321:
+	case 1417:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7732
+//line mysql_sql.y:8335
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17165,10 +19895,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1322:
+	case 1418:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7744
+//line mysql_sql.y:8347
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17181,10 +19911,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1323:
+	case 1419:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7756
+//line mysql_sql.y:8359
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17197,10 +19927,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1324:
+	case 1420:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7768
+//line mysql_sql.y:8371
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17214,10 +19944,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1325:
+	case 1421:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7781
+//line mysql_sql.y:8384
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17231,10 +19961,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1326:
+	case 1422:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7794
+//line mysql_sql.y:8397
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17248,10 +19978,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1327:
+	case 1423:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7807
+//line mysql_sql.y:8410
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17265,10 +19995,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1328:
+	case 1424:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7820
+//line mysql_sql.y:8423
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17282,10 +20012,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1329:
+	case 1425:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7833
+//line mysql_sql.y:8436
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17299,10 +20029,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1330:
+	case 1426:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7846
+//line mysql_sql.y:8449
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17316,10 +20046,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1331:
+	case 1427:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7859
+//line mysql_sql.y:8462
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17333,10 +20063,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1332:
+	case 1428:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7872
+//line mysql_sql.y:8475
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17350,10 +20080,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1333:
+	case 1429:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7885
+//line mysql_sql.y:8488
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17367,10 +20097,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1334:
+	case 1430:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7898
+//line mysql_sql.y:8501
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17384,10 +20114,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1335:
+	case 1431:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7913
+//line mysql_sql.y:8516
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -17415,10 +20145,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1336:
+	case 1432:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7940
+//line mysql_sql.y:8543
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -17460,10 +20190,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1337:
+	case 1433:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7982
+//line mysql_sql.y:8585
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Scale != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Scale > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -17500,10 +20230,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1338:
+	case 1434:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8030
+//line mysql_sql.y:8633
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17519,10 +20249,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1339:
+	case 1435:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8047
+//line mysql_sql.y:8650
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17535,10 +20265,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1340:
+	case 1436:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8059
+//line mysql_sql.y:8662
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -17559,10 +20289,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1341:
+	case 1437:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8079
+//line mysql_sql.y:8682
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -17583,10 +20313,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1342:
+	case 1438:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8099
+//line mysql_sql.y:8702
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -17607,10 +20337,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1343:
+	case 1439:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8119
+//line mysql_sql.y:8722
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17625,10 +20355,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1344:
+	case 1440:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8135
+//line mysql_sql.y:8738
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17642,10 +20372,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1345:
+	case 1441:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8148
+//line mysql_sql.y:8751
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17659,10 +20389,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1346:
+	case 1442:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8161
+//line mysql_sql.y:8764
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17676,10 +20406,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1347:
+	case 1443:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8174
+//line mysql_sql.y:8777
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17693,10 +20423,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1348:
+	case 1444:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8187
+//line mysql_sql.y:8790
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17709,10 +20439,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1349:
+	case 1445:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8199
+//line mysql_sql.y:8802
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17725,10 +20455,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1350:
+	case 1446:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8211
+//line mysql_sql.y:8814
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17741,10 +20471,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1351:
+	case 1447:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8223
+//line mysql_sql.y:8826
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17757,10 +20487,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1352:
+	case 1448:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8235
+//line mysql_sql.y:8838
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17773,10 +20503,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1353:
+	case 1449:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8247
+//line mysql_sql.y:8850
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17789,10 +20519,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1354:
+	case 1450:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8259
+//line mysql_sql.y:8862
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17805,10 +20535,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1355:
+	case 1451:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8271
+//line mysql_sql.y:8874
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17821,10 +20551,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1356:
+	case 1452:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8283
+//line mysql_sql.y:8886
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17837,10 +20567,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1357:
+	case 1453:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8295
+//line mysql_sql.y:8898
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17854,10 +20584,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1358:
+	case 1454:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8308
+//line mysql_sql.y:8911
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17871,10 +20601,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1359:
+	case 1455:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8321
+//line mysql_sql.y:8924
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17888,20 +20618,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1360:
+	case 1456:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8336
+//line mysql_sql.y:8939
 		{
 			yyLOCAL = &tree.Do{
 				Exprs: yyDollar[2].exprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1361:
+	case 1457:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8344
+//line mysql_sql.y:8947
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -17910,10 +20640,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1362:
+	case 1458:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8353
+//line mysql_sql.y:8956
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -17922,10 +20652,481 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1363:
+	case 1459:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:8966
+		{
+			yyLOCAL = &tree.CallStmt{Name: yyDollar[2].procedureNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1460:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:8970
+		{
+			yyLOCAL = &tree.CallStmt{Name: yyDollar[2].procedureNameUnion(), Args: yyDollar[4].exprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1461:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:8976
+		{
+			yylex.(*Lexer).endProcedureBody()
+			yyLOCAL = &tree.CreateProcedure{
+				Name: yyDollar[3].procedureNameUnion(),
+				Args: yyDollar[5].procedureArgsUnion(),
+				Body: yyDollar[7].statementUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1462:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:8987
+		{
+			yyLOCAL = &tree.DropProcedure{
+				Name:     yyDollar[4].procedureNameUnion(),
+				IfExists: yyDollar[3].boolValUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1463:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.ProcedureName
+//line mysql_sql.y:8996
+		{
+			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 1464:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.ProcedureName
+//line mysql_sql.y:9001
+		{
+			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
+			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 1465:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.ProcedureArgs
+//line mysql_sql.y:9007
+		{
+			yyLOCAL = tree.ProcedureArgs(nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 1467:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ProcedureArgs
+//line mysql_sql.y:9014
+		{
+			yyLOCAL = tree.ProcedureArgs{yyDollar[1].procedureArgUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1468:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.ProcedureArgs
+//line mysql_sql.y:9018
+		{
+			yyLOCAL = append(yyDollar[1].procedureArgsUnion(), yyDollar[3].procedureArgUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1469:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.ProcedureArgDecl
+//line mysql_sql.y:9024
+		{
+			yyLOCAL = tree.NewProcedureArgDecl(yyDollar[1].procedureArgTypeUnion(), yyDollar[2].unresolvedNameUnion(), yyDollar[3].columnTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1470:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.InOutArgType
+//line mysql_sql.y:9029
+		{
+			yyLOCAL = tree.TYPE_IN
+		}
+		yyVAL.union = yyLOCAL
+	case 1471:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.InOutArgType
+//line mysql_sql.y:9033
+		{
+			yyLOCAL = tree.TYPE_IN
+		}
+		yyVAL.union = yyLOCAL
+	case 1472:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.InOutArgType
+//line mysql_sql.y:9037
+		{
+			yyLOCAL = tree.TYPE_OUT
+		}
+		yyVAL.union = yyLOCAL
+	case 1473:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.InOutArgType
+//line mysql_sql.y:9041
+		{
+			yyLOCAL = tree.TYPE_INOUT
+		}
+		yyVAL.union = yyLOCAL
+	case 1474:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.Statement
+//line mysql_sql.y:9046
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 1475:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.Statement
+//line mysql_sql.y:9050
+		{
+			yylex.(*Lexer).endProcedureStmts(yyDollar[3].offset)
+			yyLOCAL = append(yyDollar[1].statementsUnion(), yyDollar[2].statementUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1476:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9057
+		{
+			yyLOCAL = yylex.(*Lexer).beginProcedureStmt(yyDollar[1].statementUnion(), yyDollar[1].offset)
+		}
+		yyVAL.union = yyLOCAL
+	case 1502:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9088
+		{
+			yyLOCAL = yyDollar[1].selectUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 1503:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:9093
+		{
+			yyVAL.str = ""
+		}
+	case 1504:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:9097
+		{
+			yyVAL.str = yyDollar[1].cstrUnion().Compare()
+		}
+	case 1505:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9103
+		{
+			yyLOCAL = &tree.CompoundStmt{Stmts: yyDollar[2].statementsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1506:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9107
+		{
+			if yyDollar[6].str != "" && yyDollar[6].str != yyDollar[1].cstrUnion().Compare() {
+				yylex.Error(fmt.Sprintf("End-label %s without match", yyDollar[6].str))
+				return 1
+			}
+			yyLOCAL = &tree.CompoundStmt{Label: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Stmts: yyDollar[4].statementsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1507:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9117
+		{
+			yyLOCAL = &tree.IfStmt{
+				Cond:       yyDollar[2].exprUnion(),
+				CondSource: yylex.(*Lexer).procedureSource(yyDollar[2].offset, yyDollar[3].offset),
+				Body:       yyDollar[4].statementsUnion(),
+				Elifs:      yyDollar[5].elseIfStmtsUnion(),
+				Else:       yyDollar[6].statementsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1508:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.ElseIfStmt
+//line mysql_sql.y:9128
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 1509:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL []*tree.ElseIfStmt
+//line mysql_sql.y:9132
+		{
+			yyLOCAL = append(yyDollar[1].elseIfStmtsUnion(), &tree.ElseIfStmt{
+				Cond:       yyDollar[3].exprUnion(),
+				CondSource: yylex.(*Lexer).procedureSource(yyDollar[3].offset, yyDollar[4].offset),
+				Body:       yyDollar[5].statementsUnion(),
+			})
+		}
+		yyVAL.union = yyLOCAL
+	case 1510:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.Statement
+//line mysql_sql.y:9141
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 1511:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.Statement
+//line mysql_sql.y:9145
+		{
+			yyLOCAL = yyDollar[2].statementsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 1512:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9151
+		{
+			yyLOCAL = &tree.WhileStmt{
+				Cond:       yyDollar[2].exprUnion(),
+				CondSource: yylex.(*Lexer).procedureSource(yyDollar[2].offset, yyDollar[3].offset),
+				Body:       yyDollar[4].statementsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1513:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9159
+		{
+			if yyDollar[9].str != "" && yyDollar[9].str != yyDollar[1].cstrUnion().Compare() {
+				yylex.Error(fmt.Sprintf("End-label %s without match", yyDollar[9].str))
+				return 1
+			}
+			yyLOCAL = &tree.WhileStmt{
+				Label:      tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+				Cond:       yyDollar[4].exprUnion(),
+				CondSource: yylex.(*Lexer).procedureSource(yyDollar[4].offset, yyDollar[5].offset),
+				Body:       yyDollar[6].statementsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1514:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9174
+		{
+			yyLOCAL = &tree.LoopStmt{Body: yyDollar[2].statementsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1515:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9178
+		{
+			if yyDollar[7].str != "" && yyDollar[7].str != yyDollar[1].cstrUnion().Compare() {
+				yylex.Error(fmt.Sprintf("End-label %s without match", yyDollar[7].str))
+				return 1
+			}
+			yyLOCAL = &tree.LoopStmt{Label: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Body: yyDollar[4].statementsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1516:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9188
+		{
+			yyLOCAL = &tree.RepeatStmt{
+				Body:       yyDollar[2].statementsUnion(),
+				Cond:       yyDollar[4].exprUnion(),
+				CondSource: yylex.(*Lexer).procedureSource(yyDollar[4].offset, yyDollar[5].offset),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1517:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9196
+		{
+			if yyDollar[9].str != "" && yyDollar[9].str != yyDollar[1].cstrUnion().Compare() {
+				yylex.Error(fmt.Sprintf("End-label %s without match", yyDollar[9].str))
+				return 1
+			}
+			yyLOCAL = &tree.RepeatStmt{
+				Label:      tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+				Body:       yyDollar[4].statementsUnion(),
+				Cond:       yyDollar[6].exprUnion(),
+				CondSource: yylex.(*Lexer).procedureSource(yyDollar[6].offset, yyDollar[7].offset),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1518:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9211
+		{
+			yyLOCAL = &tree.LeaveStmt{Label: tree.Identifier(yyDollar[2].cstrUnion().Compare())}
+		}
+		yyVAL.union = yyLOCAL
+	case 1519:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9217
+		{
+			yyLOCAL = &tree.IterateStmt{Label: tree.Identifier(yyDollar[2].cstrUnion().Compare())}
+		}
+		yyVAL.union = yyLOCAL
+	case 1520:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9223
+		{
+			yyLOCAL = &tree.DeclareCursor{Name: tree.Identifier(yyDollar[2].cstrUnion().Compare()), Select: yyDollar[5].selectUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1521:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9229
+		{
+			yyLOCAL = &tree.DeclareHandler{Action: yyDollar[2].handlerActionUnion(), Conditions: yyDollar[5].handlerConditionsUnion(), Stmt: yyDollar[6].statementUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1522:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.HandlerAction
+//line mysql_sql.y:9235
+		{
+			yyLOCAL = tree.HANDLER_CONTINUE
+		}
+		yyVAL.union = yyLOCAL
+	case 1523:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.HandlerAction
+//line mysql_sql.y:9239
+		{
+			yyLOCAL = tree.HANDLER_EXIT
+		}
+		yyVAL.union = yyLOCAL
+	case 1524:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.HandlerCondition
+//line mysql_sql.y:9245
+		{
+			yyLOCAL = []*tree.HandlerCondition{yyDollar[1].handlerConditionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1525:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.HandlerCondition
+//line mysql_sql.y:9249
+		{
+			yyLOCAL = append(yyDollar[1].handlerConditionsUnion(), yyDollar[3].handlerConditionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1526:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.HandlerCondition
+//line mysql_sql.y:9255
+		{
+			yyLOCAL = &tree.HandlerCondition{Type: tree.HANDLER_SQLSTATE, SqlState: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 1527:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.HandlerCondition
+//line mysql_sql.y:9259
+		{
+			yyLOCAL = &tree.HandlerCondition{Type: tree.HANDLER_SQLSTATE, SqlState: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 1528:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.HandlerCondition
+//line mysql_sql.y:9263
+		{
+			code, ok := yyDollar[1].item.(int64)
+			if !ok || code <= 0 || code > 0xffff {
+				yylex.Error("invalid error code")
+				return 1
+			}
+			yyLOCAL = &tree.HandlerCondition{Type: tree.HANDLER_ERROR_CODE, ErrorCode: uint16(code)}
+		}
+		yyVAL.union = yyLOCAL
+	case 1529:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.HandlerCondition
+//line mysql_sql.y:9272
+		{
+			yyLOCAL = &tree.HandlerCondition{Type: tree.HANDLER_SQLEXCEPTION}
+		}
+		yyVAL.union = yyLOCAL
+	case 1530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.HandlerCondition
+//line mysql_sql.y:9276
+		{
+			yyLOCAL = &tree.HandlerCondition{Type: tree.HANDLER_SQLWARNING}
+		}
+		yyVAL.union = yyLOCAL
+	case 1531:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.HandlerCondition
+//line mysql_sql.y:9280
+		{
+			yyLOCAL = &tree.HandlerCondition{Type: tree.HANDLER_NOT_FOUND}
+		}
+		yyVAL.union = yyLOCAL
+	case 1532:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9286
+		{
+			yyLOCAL = &tree.OpenCursor{Name: tree.Identifier(yyDollar[2].cstrUnion().Compare())}
+		}
+		yyVAL.union = yyLOCAL
+	case 1533:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9292
+		{
+			yyLOCAL = &tree.FetchCursor{Name: tree.Identifier(yyDollar[3].cstrUnion().Compare()), Vars: yyDollar[5].strsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 1534:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:9297
+		{
+		}
+	case 1535:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:9299
+		{
+		}
+	case 1536:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:9301
+		{
+		}
+	case 1537:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:9305
+		{
+			yyLOCAL = &tree.CloseCursor{Name: tree.Identifier(yyDollar[2].cstrUnion().Compare())}
+		}
+		yyVAL.union = yyLOCAL
+	case 1538:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8363
+//line mysql_sql.y:9311
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17938,75 +21139,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1364:
+	case 1539:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8386
+//line mysql_sql.y:9334
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1365:
+	case 1540:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8391
+//line mysql_sql.y:9339
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1366:
+	case 1541:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8397
+//line mysql_sql.y:9345
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1368:
+	case 1543:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8404
+//line mysql_sql.y:9352
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1369:
+	case 1544:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8408
+//line mysql_sql.y:9356
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1370:
+	case 1545:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8413
+//line mysql_sql.y:9361
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1371:
+	case 1546:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8417
+//line mysql_sql.y:9365
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1372:
+	case 1547:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8423
+//line mysql_sql.y:9371
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1373:
+	case 1548:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8429
+//line mysql_sql.y:9377
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -18014,10 +21215,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1374:
+	case 1549:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8436
+//line mysql_sql.y:9384
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18025,10 +21226,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1375:
+	case 1550:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8443
+//line mysql_sql.y:9391
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18036,10 +21237,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1376:
+	case 1551:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8452
+//line mysql_sql.y:9400
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 38, // this is the default precision for decimal
@@ -18047,10 +21248,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1377:
+	case 1552:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8459
+//line mysql_sql.y:9407
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18058,10 +21259,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1378:
+	case 1553:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8466
+//line mysql_sql.y:9414
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18069,52 +21270,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1379:
+	case 1554:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8475
+//line mysql_sql.y:9423
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1380:
+	case 1555:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8479
+//line mysql_sql.y:9427
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1381:
+	case 1556:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8483
+//line mysql_sql.y:9431
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1382:
+	case 1557:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8489
+//line mysql_sql.y:9437
 		{
 		}
-	case 1383:
+	case 1558:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8491
+//line mysql_sql.y:9439
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1387:
+	case 1562:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8501
+//line mysql_sql.y:9449
 		{
 			yyVAL.str = ""
 		}
-	case 1388:
+	case 1563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:8505
+//line mysql_sql.y:9453
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
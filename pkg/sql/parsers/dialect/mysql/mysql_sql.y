@@ -30,6 +30,7 @@ import (
     id  int
     str string
     item interface{}
+    offset int
 }
 
 %union {
@@ -39,6 +40,7 @@ import (
     alterTable tree.AlterTable
     alterTableOptions tree.AlterTableOptions
     alterTableOption tree.AlterTableOption
+    columnPosition *tree.ColumnPosition
 
     tableDef tree.TableDef
     tableDefs tree.TableDefs
@@ -49,8 +51,13 @@ import (
     tableOptions []tree.TableOption
     tableExprs tree.TableExprs
     tableExpr tree.TableExpr
+    asOfClause *tree.AsOfClause
+    jsonTableColumn *tree.JsonTableColumn
+    jsonTableColumns []*tree.JsonTableColumn
+    jsonTableResponse *tree.JsonTableResponse
     rowFormatType tree.RowFormatType
     matchType tree.MatchType
+    fullTextSearchType tree.FullTextSearchType
     attributeReference *tree.AttributeReference
     loadParam *tree.ExternParam
     tailParam *tree.TailParameter
@@ -61,6 +68,15 @@ import (
     funcArgDecl *tree.FunctionArgDecl
     funcReturn *tree.ReturnType
 
+    procedureName *tree.ProcedureName
+    procedureArg *tree.ProcedureArgDecl
+    procedureArgs tree.ProcedureArgs
+    procedureArgType tree.InOutArgType
+    elseIfStmts []*tree.ElseIfStmt
+    handlerAction tree.HandlerAction
+    handlerCondition *tree.HandlerCondition
+    handlerConditions []*tree.HandlerCondition
+
     from *tree.From
     where *tree.Where
     groupBy tree.GroupBy
@@ -112,6 +128,8 @@ import (
     updateExpr *tree.UpdateExpr
     updateExprs tree.UpdateExprs
     completionType tree.CompletionType
+    txnModes tree.TransactionModes
+    isolationLevel tree.IsolationLevel
     varAssignmentExpr *tree.VarAssignmentExpr
     varAssignmentExprs []*tree.VarAssignmentExpr
     setRole *tree.SetRole
@@ -212,15 +230,18 @@ import (
     startWithOption *tree.StartWithOption 
     subscriptionOption *tree.SubscriptionOption
     accountsSetOption *tree.AccountsSetOption
+    optimizerHints tree.OptimizerHints
 }
 
 %token LEX_ERROR
 %nonassoc EMPTY
+%nonassoc LOWER_THAN_INTO
+%nonassoc <str> INTO
 %left <str> UNION EXCEPT INTERSECT MINUS
 %token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR CONNECT MANAGE GRANTS OWNERSHIP REFERENCE
 %nonassoc LOWER_THAN_SET
 %nonassoc <str> SET
-%token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT LOCK KEYS NULLS FIRST LAST
+%token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC DUPLICATE DEFAULT LOCK KEYS NULLS FIRST LAST
 %token <str> VALUES
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE
@@ -244,7 +265,7 @@ import (
 %right <str> NOT '!'
 %left <str> BETWEEN CASE WHEN THEN ELSE END
 %nonassoc LOWER_THAN_EQ
-%left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN ASSIGNMENT ILIKE
+%left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN ASSIGNMENT ILIKE MEMBER
 %left <str> '|'
 %left <str> '&'
 %left <str> SHIFT_LEFT SHIFT_RIGHT
@@ -285,6 +306,7 @@ import (
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
 %token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM
 %token <str> RESTRICT CASCADE ACTION PARTIAL SIMPLE CHECK ENFORCED
+%token <str> GENERATED ALWAYS STORED VIRTUAL
 %token <str> RANGE LIST ALGORITHM LINEAR PARTITIONS SUBPARTITION SUBPARTITIONS CLUSTER
 %token <str> TYPE ANY SOME EXTERNAL LOCALFILE URL
 %token <str> PREPARE DEALLOCATE RESET
@@ -294,6 +316,8 @@ import (
 %token <str> INCREMENT CYCLE MINVALUE
 // publication
 %token <str> PUBLICATION SUBSCRIPTIONS PUBLICATIONS
+// optimizer hints and plan baselines
+%token <str> OPTIMIZER_HINTS PLAN BASELINE
 
 
 // MO table option
@@ -375,19 +399,36 @@ import (
 %token <str> NEXTVAL SETVAL CURRVAL LASTVAL
 
 //JSON function
-%token <str> ARROW
+%token <str> ARROW LONG_ARROW ARRAY
 
 // Insert
 %token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE PARALLEL
 
 %token <str> UNUSED BINDINGS
 
+// Alter table column
+%token <str> MODIFY CHANGE AFTER
+
+// Group by
+%token <str> ROLLUP CUBE GROUPING SETS
+
+// Savepoint
+%token <str> SAVEPOINT
+%token <str> OF
+
+// JSON_TABLE
+%token <str> JSON_TABLE ORDINALITY NESTED PATH
+
 // Do
 %token <str> DO
 
 // Declare
 %token <str> DECLARE
 
+// Stored procedure
+%token <str> CALL CURSOR HANDLER CONTINUE EXIT SQLEXCEPTION SQLWARNING SQLSTATE FOUND
+%token <str> ELSEIF WHILE LOOP LEAVE ITERATE UNTIL FETCH CLOSE OUT INOUT
+
 %type <statement> stmt
 %type <statements> stmt_list
 %type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt truncate_table_stmt
@@ -402,9 +443,9 @@ import (
 %type <statement> show_table_num_stmt show_column_num_stmt show_table_values_stmt show_table_size_stmt
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
 %type <statement> alter_account_stmt alter_user_stmt alter_view_stmt update_stmt use_stmt update_no_with_stmt alter_database_config_stmt alter_table_stmt
-%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
+%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt savepoint_stmt release_savepoint_stmt
 %type <statement> explain_stmt explainable_stmt
-%type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
+%type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt set_transaction_stmt
 %type <statement> lock_stmt lock_table_stmt unlock_table_stmt
 %type <statement> revoke_stmt grant_stmt
 %type <statement> load_data_stmt
@@ -413,6 +454,20 @@ import (
 %type <statement> replace_stmt
 %type <statement> do_stmt
 %type <statement> declare_stmt
+%type <statement> create_procedure_stmt drop_procedure_stmt call_stmt
+%type <statement> proc_stmt proc_stmt_impl compound_stmt if_stmt while_stmt loop_stmt repeat_stmt leave_stmt iterate_stmt
+%type <statement> declare_cursor_stmt declare_handler_stmt open_cursor_stmt fetch_cursor_stmt close_cursor_stmt
+%type <statements> proc_stmt_list proc_else_opt
+%type <procedureName> proc_name
+%type <procedureArg> proc_arg
+%type <procedureArgs> proc_args_list_opt proc_args_list
+%type <procedureArgType> proc_arg_in_out_type
+%type <elseIfStmts> elseif_list_opt
+%type <str> proc_label_opt
+%type <handlerAction> handler_action
+%type <handlerCondition> handler_condition
+%type <handlerConditions> handler_condition_list
+%type <strs> select_into_opt
 %type <statement> values_stmt
 %type <statement> mo_dump_stmt
 %type <statement> load_extension_stmt
@@ -422,6 +477,8 @@ import (
 %type <exportParm> export_data_param_opt
 %type <loadParam> load_param_opt load_param_opt_2
 %type <tailParam> tail_param_opt
+%type <statement> create_plan_baseline_stmt drop_plan_baseline_stmt
+%type <optimizerHints> select_hints_opt
 %type <statement>  create_publication_stmt drop_publication_stmt alter_publication_stmt show_publications_stmt show_subscriptions_stmt
 %type <str> comment_opt
 %type <subscriptionOption> subcription_opt
@@ -463,19 +520,28 @@ import (
 %type <tableOptions> table_option_list_opt table_option_list
 %type <str> charset_name storage_opt collate_name column_format storage_media
 %type <rowFormatType> row_format_options
-%type <int64Val> field_length_opt max_file_size_opt
+%type <int64Val> field_length_opt max_file_size_opt compression_level_opt
 %type <matchType> match match_opt
+%type <fullTextSearchType> fulltext_search_opt
 %type <referenceOptionType> ref_opt on_delete on_update
 %type <referenceOnRecord> on_delete_update_opt
 %type <attributeReference> references_def
-%type <alterTableOptions> alter_options
+%type <alterTableOptions> alter_options alter_option_list
 %type <alterTableOption> alter_table_drop
+%type <columnPosition> column_position column_position_opt
 
 %type <tableOption> table_option
 %type <from> from_clause from_opt
 %type <where> where_expression_opt having_opt
 %type <groupBy> group_by_opt
+%type <exprs> group_by_list grouping_set
+%type <rowsExprs> grouping_set_list
+%type <expr> group_by_item
 %type <aliasedTableExpr> aliased_table_name
+%type <asOfClause> as_of_clause
+%type <jsonTableColumn> json_table_column json_table_on_response_opt
+%type <jsonTableColumns> json_table_column_list
+%type <jsonTableResponse> json_table_response
 %type <unionTypeRecord> union_op
 %type <parenTableExpr> table_subquery
 %type <str> inner_join straight_join outer_join natural_join
@@ -536,13 +602,15 @@ import (
 %type <updateExpr> update_value
 %type <updateExprs> update_list on_duplicate_key_update_opt
 %type <completionType> completion_type
+%type <txnModes> txn_characteristic_list txn_characteristic
+%type <isolationLevel> isolation_level
 %type <str> password_opt
-%type <boolVal> grant_option_opt enforce enforce_opt
+%type <boolVal> grant_option_opt enforce enforce_opt generated_stored_opt
 
 %type <varAssignmentExpr> var_assignment
 %type <varAssignmentExprs> var_assignment_list
-%type <str> var_name equal_or_assignment
-%type <strs> var_name_list
+%type <str> var_name equal_or_assignment into_var_name
+%type <strs> var_name_list into_var_name_list
 %type <expr> set_expr
 //%type <setRole> set_role_opt
 %type <setDefaultRole> set_default_role_opt
@@ -696,6 +764,7 @@ stmt:
 |   load_extension_stmt
 |   do_stmt
 |   declare_stmt
+|   call_stmt
 |   values_stmt
 |   select_stmt
     {
@@ -1541,6 +1610,21 @@ set_stmt:
 |   set_password_stmt
 |   set_role_stmt
 |   set_default_role_stmt
+|   set_transaction_stmt
+
+set_transaction_stmt:
+    SET TRANSACTION txn_characteristic_list
+    {
+        $$ = &tree.SetTransaction{Modes: $3}
+    }
+|   SET GLOBAL TRANSACTION txn_characteristic_list
+    {
+        $$ = &tree.SetTransaction{Global: true, Modes: $4}
+    }
+|   SET SESSION TRANSACTION txn_characteristic_list
+    {
+        $$ = &tree.SetTransaction{Session: true, Modes: $4}
+    }
 
 set_role_stmt:
     SET ROLE role_spec
@@ -1810,16 +1894,63 @@ var_name_list:
         $$ = append($1, $3)
     }
 
+into_var_name:
+    var_name
+|   AT_ID
+    {
+        $$ = "@" + $1
+    }
+
+into_var_name_list:
+    into_var_name
+    {
+        $$ = []string{$1}
+    }
+|   into_var_name_list ',' into_var_name
+    {
+        $$ = append($1, $3)
+    }
+
 transaction_stmt:
     begin_stmt
 |   commit_stmt
 |   rollback_stmt
+|   savepoint_stmt
+|   release_savepoint_stmt
 
 rollback_stmt:
     ROLLBACK completion_type
     {
         $$ = &tree.RollbackTransaction{Type: $2}
     }
+|   ROLLBACK TO ident
+    {
+        $$ = &tree.RollbackToSavePoint{Name: tree.Identifier(strings.ToLower($3.Origin()))}
+    }
+|   ROLLBACK TO SAVEPOINT ident
+    {
+        $$ = &tree.RollbackToSavePoint{Name: tree.Identifier(strings.ToLower($4.Origin()))}
+    }
+|   ROLLBACK WORK TO ident
+    {
+        $$ = &tree.RollbackToSavePoint{Name: tree.Identifier(strings.ToLower($4.Origin()))}
+    }
+|   ROLLBACK WORK TO SAVEPOINT ident
+    {
+        $$ = &tree.RollbackToSavePoint{Name: tree.Identifier(strings.ToLower($5.Origin()))}
+    }
+
+savepoint_stmt:
+    SAVEPOINT ident
+    {
+        $$ = &tree.SavePoint{Name: tree.Identifier(strings.ToLower($2.Origin()))}
+    }
+
+release_savepoint_stmt:
+    RELEASE SAVEPOINT ident
+    {
+        $$ = &tree.ReleaseSavePoint{Name: tree.Identifier(strings.ToLower($3.Origin()))}
+    }
 
 commit_stmt:
     COMMIT completion_type
@@ -1877,19 +2008,52 @@ begin_stmt:
     {
         $$ = &tree.BeginTransaction{}
     }
-|   START TRANSACTION READ WRITE
+|   START TRANSACTION txn_characteristic_list
     {
-        m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
-        $$ = &tree.BeginTransaction{Modes: m}
+        $$ = &tree.BeginTransaction{Modes: $3}
     }
-|   START TRANSACTION READ ONLY
+
+txn_characteristic_list:
+    txn_characteristic
+|   txn_characteristic_list ',' txn_characteristic
     {
-        m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
-        $$ = &tree.BeginTransaction{Modes: m}
+        $$ = $1.Merge($3)
     }
-|   START TRANSACTION WITH CONSISTENT SNAPSHOT
+
+txn_characteristic:
+    READ WRITE
     {
-        $$ = &tree.BeginTransaction{}
+        $$ = tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
+    }
+|   READ ONLY
+    {
+        $$ = tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
+    }
+|   WITH CONSISTENT SNAPSHOT
+    {
+        $$ = tree.TransactionModes{}
+    }
+|   ISOLATION LEVEL isolation_level
+    {
+        $$ = tree.TransactionModes{Isolation: $3}
+    }
+
+isolation_level:
+    READ UNCOMMITTED
+    {
+        $$ = tree.ISOLATION_LEVEL_READ_UNCOMMITTED
+    }
+|   READ COMMITTED
+    {
+        $$ = tree.ISOLATION_LEVEL_READ_COMMITTED
+    }
+|   REPEATABLE READ
+    {
+        $$ = tree.ISOLATION_LEVEL_REPEATABLE_READ
+    }
+|   SERIALIZABLE
+    {
+        $$ = tree.ISOLATION_LEVEL_SERIALIZABLE
     }
 
 use_stmt:
@@ -2198,6 +2362,10 @@ analyze_stmt:
     {
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
+|   ANALYZE TABLE table_name
+    {
+        $$ = tree.NewAnalyzeStmt($3, nil)
+    }
 
 alter_stmt:
     alter_user_stmt
@@ -2220,13 +2388,31 @@ alter_view_stmt:
     }
 
 alter_table_stmt:
-    ALTER TABLE table_name alter_options
+    ALTER TABLE table_name alter_option_list
     {
         $$ = &tree.AlterTable{
             Table: $3,
             Options: $4,
         }
     }
+|   ALTER TABLE table_name table_option_list
+    {
+        opts := make([]tree.AlterTableOption, len($4))
+        for i, opt := range $4 {
+            opts[i] = tree.AlterTableOption(opt)
+        }
+        $$ = &tree.AlterTable{
+            Table: $3,
+            Options: opts,
+        }
+    }
+
+alter_option_list:
+    alter_options
+|   alter_option_list ',' alter_options
+    {
+        $$ = append($1, $3...)
+    }
 
 alter_options:
 ADD table_elem
@@ -2236,17 +2422,78 @@ ADD table_elem
         }
         $$ = []tree.AlterTableOption{tree.AlterTableOption(opt)}
     }
+|   ADD column_def column_position
+    {
+        opt := &tree.AlterOptionAddColumn{
+            Column: $2,
+            Position: $3,
+        }
+        $$ = []tree.AlterTableOption{tree.AlterTableOption(opt)}
+    }
+|   ADD COLUMN column_def column_position_opt
+    {
+        opt := &tree.AlterOptionAddColumn{
+            Column: $3,
+            Position: $4,
+        }
+        $$ = []tree.AlterTableOption{tree.AlterTableOption(opt)}
+    }
+|   MODIFY column_keyword_opt column_def column_position_opt
+    {
+        opt := &tree.AlterOptionModifyColumn{
+            Column: $3,
+            Position: $4,
+        }
+        $$ = []tree.AlterTableOption{tree.AlterTableOption(opt)}
+    }
+|   CHANGE column_keyword_opt ident column_def column_position_opt
+    {
+        opt := &tree.AlterOptionChangeColumn{
+            OldName: tree.Identifier($3.Compare()),
+            Column: $4,
+            Position: $5,
+        }
+        $$ = []tree.AlterTableOption{tree.AlterTableOption(opt)}
+    }
+|   RENAME COLUMN ident TO ident
+    {
+        opt := &tree.AlterOptionRenameColumn{
+            OldName: tree.Identifier($3.Compare()),
+            NewName: tree.Identifier($5.Compare()),
+        }
+        $$ = []tree.AlterTableOption{tree.AlterTableOption(opt)}
+    }
 |   DROP alter_table_drop
     {
         $$ = []tree.AlterTableOption{tree.AlterTableOption($2)}
     }
-| table_option_list
+
+column_keyword_opt:
+    {}
+|   COLUMN
+    {}
+
+column_position_opt:
     {
-        opts := make([]tree.AlterTableOption, len($1))
-        for i, opt := range $1 {
-            opts[i] = tree.AlterTableOption(opt)
+        $$ = &tree.ColumnPosition{
+            Typ: tree.ColumnPositionNone,
+        }
+    }
+|   column_position
+
+column_position:
+    FIRST
+    {
+        $$ = &tree.ColumnPosition{
+            Typ: tree.ColumnPositionFirst,
+        }
+    }
+|   AFTER ident
+    {
+        $$ = &tree.ColumnPosition{
+            Typ: tree.ColumnPositionAfter,
+            RelativeColumn: tree.Identifier($2.Compare()),
         }
-        $$ =  opts
     }
 
 alter_table_drop:
@@ -2908,8 +3155,19 @@ drop_ddl_stmt:
 |   drop_user_stmt
 |   drop_account_stmt
 |   drop_function_stmt
+|   drop_procedure_stmt
 |   drop_sequence_stmt
 |   drop_publication_stmt
+|   drop_plan_baseline_stmt
+
+drop_plan_baseline_stmt:
+    DROP PLAN BASELINE exists_opt FOR select_stmt
+    {
+        $$ = &tree.DropPlanBaseline{
+            IfExists: $4,
+            Stmt: $6,
+        }
+    }
 
 drop_sequence_stmt:
     DROP SEQUENCE exists_opt table_name_list
@@ -3832,30 +4090,56 @@ union_op:
     }
 
 simple_select_clause:
-    SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+    SELECT select_hints_opt distinct_opt select_expression_list select_into_opt from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
-            Distinct: $2,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
+            Hints: $2,
+            Distinct: $3,
+            Exprs: $4,
+            IntoVars: $5,
+            From: $6,
+            Where: $7,
+            GroupBy: $8,
+            Having: $9,
         }
     }
-|    SELECT select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+|    SELECT select_hints_opt select_option_opt select_expression_list select_into_opt from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
+            Hints: $2,
             Distinct: false,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
-            Option: $2,
+            Exprs: $4,
+            IntoVars: $5,
+            From: $6,
+            Where: $7,
+            GroupBy: $8,
+            Having: $9,
+            Option: $3,
         }
     }
 
+// The scanner returns the comment /*+ ... */ right after SELECT as OPTIMIZER_HINTS.
+select_hints_opt:
+    {
+        $$ = nil
+    }
+|   OPTIMIZER_HINTS
+    {
+        $$ = ParseOptimizerHints($1)
+    }
+
+// The INTO before FROM is taken as SELECT ... INTO var_list, so SELECT ... INTO OUTFILE
+// needs a FROM clause.
+select_into_opt:
+    %prec LOWER_THAN_INTO
+    {
+        $$ = nil
+    }
+|   INTO into_var_name_list
+    {
+        $$ = $2
+    }
+
 select_option_opt:
     SQL_SMALL_RESULT
     {
@@ -3900,10 +4184,66 @@ group_by_opt:
     {
         $$ = nil
     }
-|   GROUP BY expression_list
+|   GROUP BY group_by_list
     {
         $$ = tree.GroupBy($3)
     }
+|   GROUP BY group_by_list WITH ROLLUP
+    {
+        $$ = tree.GroupBy{&tree.RollupExpr{Exprs: $3, WithRollup: true}}
+    }
+
+group_by_list:
+    group_by_item
+    {
+        $$ = tree.Exprs{$1}
+    }
+|   group_by_list ',' group_by_item
+    {
+        $$ = append($1, $3)
+    }
+
+group_by_item:
+    expression
+|   ROLLUP '(' expression_list ')'
+    {
+        $$ = &tree.RollupExpr{Exprs: $3}
+    }
+|   CUBE '(' expression_list ')'
+    {
+        $$ = &tree.CubeExpr{Exprs: $3}
+    }
+|   GROUPING SETS '(' grouping_set_list ')'
+    {
+        $$ = &tree.GroupingSetsExpr{Sets: $4}
+    }
+
+grouping_set_list:
+    grouping_set
+    {
+        $$ = []tree.Exprs{$1}
+    }
+|   grouping_set_list ',' grouping_set
+    {
+        $$ = append($1, $3)
+    }
+
+grouping_set:
+    '(' ')'
+    {
+        $$ = tree.Exprs{}
+    }
+|   expression
+    {
+        switch e := $1.(type) {
+        case *tree.Tuple:
+            $$ = e.Exprs
+        case *tree.ParenExpr:
+            $$ = tree.Exprs{e.Expr}
+        default:
+            $$ = tree.Exprs{e}
+        }
+    }
 
 where_expression_opt:
     {
@@ -4194,6 +4534,117 @@ table_function:
             },
         }
     }
+|   JSON_TABLE '(' expression ',' STRING COLUMNS '(' json_table_column_list ')' ')'
+    {
+        name := tree.SetUnresolvedName("json_table")
+        $$ = &tree.TableFunction{
+            Func: &tree.FuncExpr{
+                Func: tree.FuncName2ResolvableFunctionReference(name),
+                Exprs: tree.Exprs{$3},
+                Type: tree.FUNC_TYPE_TABLE,
+            },
+            JsonTable: &tree.JsonTableDef{
+                Path: $5,
+                Columns: $8,
+            },
+        }
+    }
+
+json_table_column_list:
+    json_table_column
+    {
+        $$ = []*tree.JsonTableColumn{$1}
+    }
+|   json_table_column_list ',' json_table_column
+    {
+        $$ = append($1, $3)
+    }
+
+json_table_column:
+    ident FOR ORDINALITY
+    {
+        $$ = &tree.JsonTableColumn{
+            Type: tree.JSON_TABLE_COLUMN_ORDINALITY,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
+|   ident column_type PATH STRING json_table_on_response_opt
+    {
+        $$ = $5
+        $$.Type = tree.JSON_TABLE_COLUMN_PATH
+        $$.Name = tree.Identifier($1.Compare())
+        $$.ColType = $2
+        $$.Path = $4
+    }
+|   ident column_type EXISTS PATH STRING
+    {
+        $$ = &tree.JsonTableColumn{
+            Type: tree.JSON_TABLE_COLUMN_EXISTS,
+            Name: tree.Identifier($1.Compare()),
+            ColType: $2,
+            Path: $5,
+        }
+    }
+|   NESTED PATH STRING COLUMNS '(' json_table_column_list ')'
+    {
+        $$ = &tree.JsonTableColumn{
+            Type: tree.JSON_TABLE_COLUMN_NESTED,
+            Path: $3,
+            Columns: $6,
+        }
+    }
+|   NESTED STRING COLUMNS '(' json_table_column_list ')'
+    {
+        $$ = &tree.JsonTableColumn{
+            Type: tree.JSON_TABLE_COLUMN_NESTED,
+            Path: $2,
+            Columns: $5,
+        }
+    }
+
+json_table_on_response_opt:
+    {
+        $$ = &tree.JsonTableColumn{}
+    }
+|   json_table_on_response_opt json_table_response ON ident
+    {
+        $$ = $1
+        switch strings.ToLower($4.Compare()) {
+        case "empty":
+            if $$.OnEmpty != nil || $$.OnError != nil {
+                yylex.Error("ON EMPTY must be specified once and before ON ERROR")
+                return 1
+            }
+            $$.OnEmpty = $2
+        case "error":
+            if $$.OnError != nil {
+                yylex.Error("ON ERROR must be specified once")
+                return 1
+            }
+            $$.OnError = $2
+        default:
+            yylex.Error("json_table column requires ON EMPTY or ON ERROR")
+            return 1
+        }
+    }
+
+json_table_response:
+    NULL
+    {
+        $$ = &tree.JsonTableResponse{Type: tree.JSON_TABLE_RESPONSE_NULL}
+    }
+|   ident
+    {
+        if strings.ToLower($1.Compare()) != "error" {
+            yylex.Error("json_table column response must be NULL, ERROR or DEFAULT")
+            return 1
+        }
+        $$ = &tree.JsonTableResponse{Type: tree.JSON_TABLE_RESPONSE_ERROR}
+    }
+|   DEFAULT STRING
+    {
+        $$ = &tree.JsonTableResponse{Type: tree.JSON_TABLE_RESPONSE_DEFAULT, Default: $2}
+    }
 
 aliased_table_name:
     table_name as_opt_id index_hint_list_opt
@@ -4206,6 +4657,37 @@ aliased_table_name:
             IndexHints: $3,
         }
     }
+|   table_name as_of_clause as_opt_id index_hint_list_opt
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($3),
+            },
+            IndexHints: $4,
+            AsOf: $2,
+        }
+    }
+
+as_of_clause:
+    AS OF TIMESTAMP bit_expr
+    {
+        $$ = &tree.AsOfClause{
+            Type: tree.AsOfTimestamp,
+            Expr: $4,
+        }
+    }
+|   AS OF ident STRING
+    {
+        if strings.ToLower($3.Compare()) != "ts" {
+            yylex.Error("AS OF requires TIMESTAMP or TS")
+            return 1
+        }
+        $$ = &tree.AsOfClause{
+            Type: tree.AsOfTS,
+            Expr: tree.NewNumValWithType(constant.MakeString($4), $4, false, tree.P_char),
+        }
+    }
 
 index_hint_list_opt:
 	{
@@ -4355,8 +4837,18 @@ create_ddl_stmt:
 |   create_index_stmt
 |    create_view_stmt
 |   create_function_stmt
+|   create_procedure_stmt
 |   create_extension_stmt
 |   create_sequence_stmt
+|   create_plan_baseline_stmt
+
+create_plan_baseline_stmt:
+    CREATE PLAN BASELINE FOR select_stmt
+    {
+        $$ = &tree.CreatePlanBaseline{
+            Stmt: $5,
+        }
+    }
 
 create_extension_stmt:
     CREATE EXTENSION extension_lang AS extension_name FILE STRING
@@ -5887,6 +6379,8 @@ constaint_def:
                 v.Name = $1
             case *tree.ForeignKey:
                 v.Name = $1
+            case *tree.CheckIndex:
+                v.Name = $1
             }
         }
         $$ = $2
@@ -5967,7 +6461,7 @@ constraint_elem:
 
 enforce_opt:
     {
-        $$ = false
+        $$ = true
     }
 |    enforce
 
@@ -6129,6 +6623,10 @@ column_attribute_elem:
     {
         $$ = tree.NewAttributeStorage($2)
     }
+|   COMPRESSION STRING compression_level_opt
+    {
+        $$ = tree.NewAttributeCompression($2, $3)
+    }
 |   AUTO_RANDOM field_length_opt
     {
         $$ = tree.NewAttributeAutoRandom(int($2))
@@ -6139,7 +6637,7 @@ column_attribute_elem:
     }
 |   constraint_keyword_opt CHECK '(' expression ')'
     {
-        $$ = tree.NewAttributeCheck($4, false, $1)
+        $$ = tree.NewAttributeCheck($4, true, $1)
     }
 |   constraint_keyword_opt CHECK '(' expression ')' enforce
     {
@@ -6162,6 +6660,27 @@ column_attribute_elem:
     {
 	$$ = tree.NewAttributeLowCardinality()
     }
+|   AS '(' expression ')' generated_stored_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($3, $5)
+    }
+|   GENERATED ALWAYS AS '(' expression ')' generated_stored_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($5, $7)
+    }
+
+generated_stored_opt:
+    {
+        $$ = false
+    }
+|   VIRTUAL
+    {
+        $$ = false
+    }
+|   STORED
+    {
+        $$ = true
+    }
 
 enforce:
     ENFORCED
@@ -6313,6 +6832,15 @@ field_length_opt:
         $$ = $2.(int64)
     }
 
+compression_level_opt:
+    {
+        $$ = 0
+    }
+|   LEVEL INTEGRAL
+    {
+        $$ = $2.(int64)
+    }
+
 storage_media:
     DEFAULT
 |   DISK
@@ -6386,6 +6914,7 @@ bit_expr:
 simple_expr:
     normal_ident
     {
+        yylex.(*Lexer).addIdentRef($1, $<offset>1)
         $$ = $1
     }
 |   variable
@@ -6396,6 +6925,30 @@ simple_expr:
     {
         $$ = $1
     }
+|   normal_ident ARROW STRING
+    {
+        yylex.(*Lexer).addIdentRef($1, $<offset>1)
+        name := tree.SetUnresolvedName("json_extract")
+        path := tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, path},
+        }
+    }
+|   normal_ident LONG_ARROW STRING
+    {
+        yylex.(*Lexer).addIdentRef($1, $<offset>1)
+        name := tree.SetUnresolvedName("json_extract")
+        path := tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)
+        extract := &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, path},
+        }
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_unquote")),
+            Exprs: tree.Exprs{extract},
+        }
+    }
 |   '(' expression ')'
     {
         $$ = tree.NewParenExpr($2)
@@ -6445,6 +6998,10 @@ simple_expr:
     {
         $$ = tree.NewCastExpr($3, $5)
     }
+|   CAST '(' expression AS mo_cast_type ARRAY ')'
+    {
+        $$ = &tree.CastExpr{Expr: $3, Type: $5, Array: true}
+    }
 
 |   CONVERT '(' expression ',' mysql_cast_type ')'
     {
@@ -6479,6 +7036,31 @@ simple_expr:
     {
         $$ = $1
     }
+|   MATCH '(' column_name_list ')' AGAINST '(' bit_expr fulltext_search_opt ')'
+    {
+        $$ = tree.NewFullTextMatchExpr($3, $7, $8)
+    }
+
+fulltext_search_opt:
+    {
+        $$ = tree.FULLTEXT_DEFAULT
+    }
+|   IN NATURAL LANGUAGE MODE
+    {
+        $$ = tree.FULLTEXT_NATURAL_LANGUAGE
+    }
+|   IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_NATURAL_LANGUAGE_QUERY_EXPANSION
+    }
+|   IN BOOLEAN MODE
+    {
+        $$ = tree.FULLTEXT_BOOLEAN
+    }
+|   WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_QUERY_EXPANSION
+    }
 
 function_call_window:
 	RANK '(' ')' window_spec
@@ -6489,6 +7071,15 @@ function_call_window:
             WindowSpec: $4,
         }
     }
+|   ID '(' expression_list_opt ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
 
 else_opt:
     {
@@ -7202,6 +7793,14 @@ function_call_keyword:
             Exprs: $3,
         }
     }
+|   GROUPING '(' expression_list ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+        }
+    }
 |   name_braces braces_opt
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
@@ -7546,6 +8145,10 @@ predicate:
     {
         $$ = tree.NewComparisonExpr(tree.NOT_REG_MATCH, $1, $4)
     }
+|   bit_expr MEMBER OF '(' expression ')'
+    {
+        $$ = tree.NewComparisonExpr(tree.MEMBER_OF, $1, tree.NewParenExpr($5))
+    }
 |   bit_expr BETWEEN bit_expr AND predicate
     {
         $$ = tree.NewRangeCond(false, $1, $3, $5)
@@ -8358,6 +8961,351 @@ declare_stmt:
         }
     }
 
+call_stmt:
+    CALL proc_name
+    {
+        $$ = &tree.CallStmt{Name: $2}
+    }
+|   CALL proc_name '(' expression_list_opt ')'
+    {
+        $$ = &tree.CallStmt{Name: $2, Args: $4}
+    }
+
+create_procedure_stmt:
+    CREATE PROCEDURE proc_name '(' proc_args_list_opt ')' proc_stmt
+    {
+        yylex.(*Lexer).endProcedureBody()
+        $$ = &tree.CreateProcedure{
+            Name: $3,
+            Args: $5,
+            Body: $7,
+        }
+    }
+
+drop_procedure_stmt:
+    DROP PROCEDURE exists_opt proc_name
+    {
+        $$ = &tree.DropProcedure{
+            Name: $4,
+            IfExists: $3,
+        }
+    }
+
+proc_name:
+    ident
+    {
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        $$ = tree.NewProcedureName(tree.Identifier($1.Compare()), prefix)
+    }
+|   ident '.' ident
+    {
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier($1.Compare()), ExplicitSchema: true}
+        $$ = tree.NewProcedureName(tree.Identifier($3.Compare()), prefix)
+    }
+
+proc_args_list_opt:
+    {
+        $$ = tree.ProcedureArgs(nil)
+    }
+|   proc_args_list
+
+proc_args_list:
+    proc_arg
+    {
+        $$ = tree.ProcedureArgs{$1}
+    }
+|   proc_args_list ',' proc_arg
+    {
+        $$ = append($1, $3)
+    }
+
+proc_arg:
+    proc_arg_in_out_type column_name column_type
+    {
+        $$ = tree.NewProcedureArgDecl($1, $2, $3)
+    }
+
+proc_arg_in_out_type:
+    {
+        $$ = tree.TYPE_IN
+    }
+|   IN
+    {
+        $$ = tree.TYPE_IN
+    }
+|   OUT
+    {
+        $$ = tree.TYPE_OUT
+    }
+|   INOUT
+    {
+        $$ = tree.TYPE_INOUT
+    }
+
+proc_stmt_list:
+    {
+        $$ = nil
+    }
+|   proc_stmt_list proc_stmt ';'
+    {
+        yylex.(*Lexer).endProcedureStmts($<offset>3)
+        $$ = append($1, $2)
+    }
+
+proc_stmt:
+    proc_stmt_impl
+    {
+        $$ = yylex.(*Lexer).beginProcedureStmt($1, $<offset>1)
+    }
+
+proc_stmt_impl:
+    compound_stmt
+|   if_stmt
+|   while_stmt
+|   loop_stmt
+|   repeat_stmt
+|   leave_stmt
+|   iterate_stmt
+|   declare_cursor_stmt
+|   declare_handler_stmt
+|   open_cursor_stmt
+|   fetch_cursor_stmt
+|   close_cursor_stmt
+|   call_stmt
+|   create_stmt
+|   insert_stmt
+|   replace_stmt
+|   delete_stmt
+|   update_stmt
+|   drop_stmt
+|   truncate_table_stmt
+|   alter_stmt
+|   set_stmt
+|   do_stmt
+|   declare_stmt
+|   values_stmt
+|   select_stmt
+    {
+        $$ = $1
+    }
+
+proc_label_opt:
+    {
+        $$ = ""
+    }
+|   ident
+    {
+        $$ = $1.Compare()
+    }
+
+compound_stmt:
+    BEGIN proc_stmt_list END
+    {
+        $$ = &tree.CompoundStmt{Stmts: $2}
+    }
+|   ident ':' BEGIN proc_stmt_list END proc_label_opt
+    {
+        if $6 != "" && $6 != $1.Compare() {
+            yylex.Error(fmt.Sprintf("End-label %s without match", $6))
+            return 1
+        }
+        $$ = &tree.CompoundStmt{Label: tree.Identifier($1.Compare()), Stmts: $4}
+    }
+
+if_stmt:
+    IF expression THEN proc_stmt_list elseif_list_opt proc_else_opt END IF
+    {
+        $$ = &tree.IfStmt{
+            Cond: $2,
+            CondSource: yylex.(*Lexer).procedureSource($<offset>2, $<offset>3),
+            Body: $4,
+            Elifs: $5,
+            Else: $6,
+        }
+    }
+
+elseif_list_opt:
+    {
+        $$ = nil
+    }
+|   elseif_list_opt ELSEIF expression THEN proc_stmt_list
+    {
+        $$ = append($1, &tree.ElseIfStmt{
+            Cond: $3,
+            CondSource: yylex.(*Lexer).procedureSource($<offset>3, $<offset>4),
+            Body: $5,
+        })
+    }
+
+proc_else_opt:
+    {
+        $$ = nil
+    }
+|   ELSE proc_stmt_list
+    {
+        $$ = $2
+    }
+
+while_stmt:
+    WHILE expression DO proc_stmt_list END WHILE
+    {
+        $$ = &tree.WhileStmt{
+            Cond: $2,
+            CondSource: yylex.(*Lexer).procedureSource($<offset>2, $<offset>3),
+            Body: $4,
+        }
+    }
+|   ident ':' WHILE expression DO proc_stmt_list END WHILE proc_label_opt
+    {
+        if $9 != "" && $9 != $1.Compare() {
+            yylex.Error(fmt.Sprintf("End-label %s without match", $9))
+            return 1
+        }
+        $$ = &tree.WhileStmt{
+            Label: tree.Identifier($1.Compare()),
+            Cond: $4,
+            CondSource: yylex.(*Lexer).procedureSource($<offset>4, $<offset>5),
+            Body: $6,
+        }
+    }
+
+loop_stmt:
+    LOOP proc_stmt_list END LOOP
+    {
+        $$ = &tree.LoopStmt{Body: $2}
+    }
+|   ident ':' LOOP proc_stmt_list END LOOP proc_label_opt
+    {
+        if $7 != "" && $7 != $1.Compare() {
+            yylex.Error(fmt.Sprintf("End-label %s without match", $7))
+            return 1
+        }
+        $$ = &tree.LoopStmt{Label: tree.Identifier($1.Compare()), Body: $4}
+    }
+
+repeat_stmt:
+    REPEAT proc_stmt_list UNTIL expression END REPEAT
+    {
+        $$ = &tree.RepeatStmt{
+            Body: $2,
+            Cond: $4,
+            CondSource: yylex.(*Lexer).procedureSource($<offset>4, $<offset>5),
+        }
+    }
+|   ident ':' REPEAT proc_stmt_list UNTIL expression END REPEAT proc_label_opt
+    {
+        if $9 != "" && $9 != $1.Compare() {
+            yylex.Error(fmt.Sprintf("End-label %s without match", $9))
+            return 1
+        }
+        $$ = &tree.RepeatStmt{
+            Label: tree.Identifier($1.Compare()),
+            Body: $4,
+            Cond: $6,
+            CondSource: yylex.(*Lexer).procedureSource($<offset>6, $<offset>7),
+        }
+    }
+
+leave_stmt:
+    LEAVE ident
+    {
+        $$ = &tree.LeaveStmt{Label: tree.Identifier($2.Compare())}
+    }
+
+iterate_stmt:
+    ITERATE ident
+    {
+        $$ = &tree.IterateStmt{Label: tree.Identifier($2.Compare())}
+    }
+
+declare_cursor_stmt:
+    DECLARE ident CURSOR FOR select_stmt
+    {
+        $$ = &tree.DeclareCursor{Name: tree.Identifier($2.Compare()), Select: $5}
+    }
+
+declare_handler_stmt:
+    DECLARE handler_action HANDLER FOR handler_condition_list proc_stmt
+    {
+        $$ = &tree.DeclareHandler{Action: $2, Conditions: $5, Stmt: $6}
+    }
+
+handler_action:
+    CONTINUE
+    {
+        $$ = tree.HANDLER_CONTINUE
+    }
+|   EXIT
+    {
+        $$ = tree.HANDLER_EXIT
+    }
+
+handler_condition_list:
+    handler_condition
+    {
+        $$ = []*tree.HandlerCondition{$1}
+    }
+|   handler_condition_list ',' handler_condition
+    {
+        $$ = append($1, $3)
+    }
+
+handler_condition:
+    SQLSTATE STRING
+    {
+        $$ = &tree.HandlerCondition{Type: tree.HANDLER_SQLSTATE, SqlState: $2}
+    }
+|   SQLSTATE VALUE STRING
+    {
+        $$ = &tree.HandlerCondition{Type: tree.HANDLER_SQLSTATE, SqlState: $3}
+    }
+|   INTEGRAL
+    {
+        code, ok := $1.(int64)
+        if !ok || code <= 0 || code > 0xffff {
+            yylex.Error("invalid error code")
+            return 1
+        }
+        $$ = &tree.HandlerCondition{Type: tree.HANDLER_ERROR_CODE, ErrorCode: uint16(code)}
+    }
+|   SQLEXCEPTION
+    {
+        $$ = &tree.HandlerCondition{Type: tree.HANDLER_SQLEXCEPTION}
+    }
+|   SQLWARNING
+    {
+        $$ = &tree.HandlerCondition{Type: tree.HANDLER_SQLWARNING}
+    }
+|   NOT FOUND
+    {
+        $$ = &tree.HandlerCondition{Type: tree.HANDLER_NOT_FOUND}
+    }
+
+open_cursor_stmt:
+    OPEN ident
+    {
+        $$ = &tree.OpenCursor{Name: tree.Identifier($2.Compare())}
+    }
+
+fetch_cursor_stmt:
+    FETCH fetch_from_opt ident INTO into_var_name_list
+    {
+        $$ = &tree.FetchCursor{Name: tree.Identifier($3.Compare()), Vars: $5}
+    }
+
+fetch_from_opt:
+    {}
+|   FROM
+    {}
+|   NEXT FROM
+    {}
+
+close_cursor_stmt:
+    CLOSE ident
+    {
+        $$ = &tree.CloseCursor{Name: tree.Identifier($2.Compare())}
+    }
+
 spatial_type:
     GEOMETRY
     {
@@ -8595,6 +9543,7 @@ equal_opt:
 //|   IMPORT
 //|   MATCH
 //|   MAXVALUE
+//|   MEMBER
 //|   MOD
 //|   MICROSECOND
 //|   MINUTE
@@ -8698,7 +9647,11 @@ equal_opt:
 
 non_reserved_keyword:
     ACCOUNT
+|   CLOSE
+|   FOUND
+|   HANDLER
 |   ACCOUNTS
+|   AFTER
 |   AGAINST
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
@@ -8743,6 +9696,10 @@ non_reserved_keyword:
 |   EXPIRE
 |   ERRORS
 |   ENFORCED
+|   GENERATED
+|   ALWAYS
+|   STORED
+|   VIRTUAL
 |   FORMAT
 |   FLOAT_TYPE
 |   FULL
@@ -8773,6 +9730,7 @@ non_reserved_keyword:
 |   MEDIUMTEXT
 |   MEMORY
 |   MODE
+|   MODIFY
 |   MULTILINESTRING
 |   MULTIPOINT
 |   MULTIPOLYGON
@@ -8815,9 +9773,12 @@ non_reserved_keyword:
 |   REPLICATION
 |   ROW_FORMAT
 |   ROLLBACK
+|   ROLLUP
+|   SAVEPOINT
 |   RESTRICT
 |   SESSION
 |   SERIALIZABLE
+|   SETS
 |   SHARE
 |   SIGNED
 |   SMALLINT
@@ -8879,6 +9840,10 @@ non_reserved_keyword:
 |   S3OPTION
 |   EXTENSION
 |   NODE
+|   ORDINALITY
+|   ARRAY
+|   NESTED
+|   PATH
 |   UUID
 |   PARALLEL
 |   INCREMENT
@@ -8888,6 +9853,8 @@ non_reserved_keyword:
 |   PUBLICATION
 |   SUBSCRIPTIONS
 |   PUBLICATIONS
+|   PLAN
+|   BASELINE
 
 func_not_keyword:
     DATE_ADD
@@ -16,6 +16,7 @@ package mysql
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -133,6 +134,15 @@ var (
 		output: "select algo_alarm_record.* from algo_alarm_record inner join (select id from algo_alarm_record use index(algo_alarm_record_algo_id_first_id_created_at_index) where first_id = 0 and created_at >= 2022-09-18 00:00:00 and created_at <= 2022-10-18 00:00:00 and algo_id not in (9808, 9809) order by id desc limit 10 offset 0) as e on e.id = algo_alarm_record.id order by algo_alarm_record.id desc",
 	}, {
 		input: "select a from t1 use index(b)",
+	}, {
+		input:  "select a from t1 as of timestamp '2023-03-01 10:00:00' where a > 1",
+		output: "select a from t1 as of timestamp 2023-03-01 10:00:00 where a > 1",
+	}, {
+		input:  "select t.a from db1.t1 as of timestamp now() - interval 1 hour as t join t2 on t.a = t2.a",
+		output: "select t.a from db1.t1 as of timestamp now() - interval(1, hour) as t inner join t2 on t.a = t2.a",
+	}, {
+		input:  "select a from t1 as of ts '1679000000000000000-1' t use index(b)",
+		output: "select a from t1 as of ts 1679000000000000000-1 as t use index(b)",
 	}, {
 		input:  "SELECT   id,cid,status,ip,stream   FROM camera     WHERE (cid_type = ?)",
 		output: "select id, cid, status, ip, stream from camera where (cid_type = ?)",
@@ -647,6 +657,9 @@ var (
 		output: "create table t (a int) properties(a = b)",
 	}, {
 		input: "create table t (a int, b char, check (1 + 1) enforced)",
+	}, {
+		input:  "create table t (a int check (a > 0), b char, constraint c1 check (a < b) not enforced, check (b != 'x'))",
+		output: "create table t (a int constraint check (a > 0) enforced, b char, constraint c1 check (a < b) not enforced, check (b != x) enforced)",
 	}, {
 		input: "create table t (a int, b char, foreign key sdf (a, b) references b(a asc, b desc))",
 	}, {
@@ -661,6 +674,39 @@ var (
 		input: "create table t (a int, b char, index if not exists idx (a, b))",
 	}, {
 		input: "create table t (a int, b char, fulltext idx (a, b))",
+	}, {
+		input:  "create table t (a int, b text, fulltext idx (b) with parser ngram)",
+		output: "create table t (a int, b text, fulltext idx (b) with parser ngram )",
+	}, {
+		input:  "select * from t where match(a, t.b) against('disk error')",
+		output: "select * from t where match(a, t.b) against(disk error)",
+	}, {
+		input:  "select match (a) against ('+disk -error' IN BOOLEAN MODE) as score from t",
+		output: "select match(a) against(+disk -error in boolean mode) as score from t",
+	}, {
+		input:  "select * from t where match(a) against('disk' in natural language mode with query expansion)",
+		output: "select * from t where match(a) against(disk in natural language mode with query expansion)",
+	}, {
+		input:  "select /*+ join_order(t1, (t2 t3)) NO_HASH_JOIN(t2) */ * from t1 cross join t2 cross join t3",
+		output: "select /*+ JOIN_ORDER(t1, (t2, t3)) NO_HASH_JOIN(t2) */ * from t1 cross join t2 cross join t3",
+	}, {
+		input:  "select /*+ broadcast(t2) */ distinct a from t1 join t2 on t1.a = t2.a",
+		output: "select /*+ BROADCAST(t2) */ distinct a from t1 inner join t2 on t1.a = t2.a",
+	}, {
+		input:  "select a /*+ hash_join(t1) */ from t1",
+		output: "select a from t1",
+	}, {
+		input:  "select /* comment */ a from t1",
+		output: "select a from t1",
+	}, {
+		input:  "create plan baseline for select /*+ JOIN_ORDER(t2, t1) */ * from t1, t2 where t1.a = t2.a and t1.b = 1",
+		output: "create plan baseline for select /*+ JOIN_ORDER(t2, t1) */ * from t1 cross join t2 where t1.a = t2.a and t1.b = 1",
+	}, {
+		input: "drop plan baseline if exists for select * from t1 where a = 1",
+	}, {
+		input: "drop plan baseline for select * from t1 where a = 1",
+	}, {
+		input: "select plan, baseline from t1",
 	}, {
 		input:  "create table t (a int, b char, constraint p1 primary key idx using hash (a, b))",
 		output: "create table t (a int, b char, primary key p1 using none (a, b))",
@@ -1190,6 +1236,22 @@ var (
 		}, {
 			input:  "rollback and chain no release",
 			output: "rollback",
+		}, {
+			input: "savepoint sp1",
+		}, {
+			input:  "SAVEPOINT Sp1",
+			output: "savepoint sp1",
+		}, {
+			input:  "rollback to sp1",
+			output: "rollback to savepoint sp1",
+		}, {
+			input:  "rollback work to savepoint sp1",
+			output: "rollback to savepoint sp1",
+		}, {
+			input:  "rollback to savepoint savepoint",
+			output: "rollback to savepoint savepoint",
+		}, {
+			input: "release savepoint sp1",
 		}, {
 			input:  "commit and chain no release",
 			output: "commit",
@@ -1201,6 +1263,22 @@ var (
 			input: "start transaction read write",
 		}, {
 			input: "start transaction",
+		}, {
+			input:  "start transaction with consistent snapshot",
+			output: "start transaction",
+		}, {
+			input:  "start transaction isolation level repeatable read, read write",
+			output: "start transaction isolation level repeatable read, read write",
+		}, {
+			input:  "start transaction read only, isolation level read committed",
+			output: "start transaction isolation level read committed, read only",
+		}, {
+			input: "set transaction isolation level serializable",
+		}, {
+			input: "set session transaction isolation level read uncommitted",
+		}, {
+			input:  "set global transaction read write, isolation level read committed",
+			output: "set global transaction isolation level read committed, read write",
 		}, {
 			input: "use db1",
 		}, {
@@ -1466,6 +1544,9 @@ var (
 		}, {
 			input:  "analyze table part (a,b )",
 			output: "analyze table part(a, b)",
+		}, {
+			input:  "analyze table db.part",
+			output: "analyze table db.part",
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test'",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
@@ -1663,6 +1744,18 @@ var (
 			input: `create table t2 (a uuid primary key, b varchar(10))`,
 		}, {
 			input: `create table t3 (a int, b uuid, primary key idx (a, b))`,
+		}, {
+			input:  `create table t4 (a enum('x','y''s'), b set('p', 'q') not null)`,
+			output: `create table t4 (a enum('x', 'y\'s'), b set('p', 'q') not null)`,
+		}, {
+			input:  `create table t5 (a int, b int generated always as (a + 1) stored, c varchar(10) as (concat(b, 'x')) not null)`,
+			output: `create table t5 (a int, b int generated always as (a + 1) stored, c varchar(10) generated always as (concat(b, x)) virtual not null)`,
+		}, {
+			input:  `alter table t5 add column d int as (a * 2) virtual`,
+			output: `alter table t5 add column d int generated always as (a * 2) virtual`,
+		}, {
+			input:  `create table t6 (a int compression 'zstd' level 19, b varchar(10) compression 'none') compression = 'zstd'`,
+			output: `create table t6 (a int compression zstd level 19, b varchar(10) compression none) compression = zstd`,
 		}, {
 			input:  `DO SLEEP(5)`,
 			output: `do sleep(5)`,
@@ -1736,6 +1829,30 @@ var (
 			input:  `select * from unnest(t.a, "$.b", true) as f`,
 			output: `select * from unnest(t.a, $.b, true) as f`,
 		},
+		{
+			input:  `select * from json_table('[{"a":1},{"a":2}]', '$[*]' columns (id for ordinality, a int path '$.a')) as jt`,
+			output: `select * from json_table([{"a":1},{"a":2}], '$[*]' columns (id for ordinality, a int path '$.a')) as jt`,
+		},
+		{
+			input:  `select jt.* from t1, json_table(t1.doc, '$[*]' COLUMNS (a varchar(10) PATH '$.a' DEFAULT '"x"' ON EMPTY ERROR ON ERROR, b int EXISTS PATH '$.b', NESTED PATH '$.c[*]' COLUMNS (c json PATH '$'))) as jt`,
+			output: `select jt.* from t1 cross join json_table(t1.doc, '$[*]' columns (a varchar(10) path '$.a' default '"x"' on empty error on error, b int exists path '$.b', nested path '$.c[*]' columns (c json path '$'))) as jt`,
+		},
+		{
+			input:  `select * from json_table(@j, '$' columns (a int path '$.a' null on error, nested '$.b' columns (b int path '$'))) as jt`,
+			output: `select * from json_table(@j, '$' columns (a int path '$.a' null on error, nested path '$.b' columns (b int path '$'))) as jt`,
+		},
+		{
+			input:  `select doc->'$.a', doc->>'$.b' from t1 where 5 member of (t1.doc->'$.c')`,
+			output: `select json_extract(doc, $.a), json_unquote(json_extract(doc, $.b)) from t1 where 5 member of (json_extract(t1.doc, $.c))`,
+		},
+		{
+			input:  `create index idx on t1 ((cast(doc->'$.tags' as unsigned array)))`,
+			output: `create index idx on t1 ((cast(json_extract(doc, $.tags) as unsigned array)))`,
+		},
+		{
+			input:  `create table t1 (id int primary key, doc json, index idx ((cast(doc->>'$.name' as char(10)))))`,
+			output: `create table t1 (id int primary key, doc json, index idx ((cast(json_unquote(json_extract(doc, $.name)) as char(10)))))`,
+		},
 		{
 			input:  `select * from generate_series('1', '10', '1')`,
 			output: `select * from generate_series(1, 10, 1)`,
@@ -1780,6 +1897,38 @@ var (
 		{
 			input: `select count(a) over (partition by col1, col2 order by col3 desc range unbounded preceding) from t1`,
 		},
+		{
+			input: `select row_number() over (partition by col1 order by col2) from t1`,
+		},
+		{
+			input: `select dense_rank() over (order by col2 desc) from t1`,
+		},
+		{
+			input: `select lag(a, 2, 0) over (partition by col1 order by col2) from t1`,
+		},
+		{
+			input: `select first_value(a) over (partition by col1 order by col2 rows between unbounded preceding and unbounded following) from t1`,
+		},
+		{
+			input:  "select a, b, sum(c) from t1 group by a, b with rollup",
+			output: "select a, b, sum(c) from t1 group by a, b with rollup",
+		},
+		{
+			input:  "select a, b, sum(c), grouping(a, b) from t1 group by rollup(a, b)",
+			output: "select a, b, sum(c), grouping(a, b) from t1 group by rollup(a, b)",
+		},
+		{
+			input:  "select a, b, sum(c) from t1 group by a, cube(b, d)",
+			output: "select a, b, sum(c) from t1 group by a, cube(b, d)",
+		},
+		{
+			input:  "select a, b, sum(c) from t1 group by grouping sets ((a, b), a, (b), ())",
+			output: "select a, b, sum(c) from t1 group by grouping sets ((a, b), (a), (b), ())",
+		},
+		{
+			input:  "select sets, rollup from t1 group by sets, rollup",
+			output: "select sets, rollup from t1 group by sets, rollup",
+		},
 		{
 			input: "alter account if exists abc",
 		},
@@ -1856,6 +2005,23 @@ var (
 			input:  `drop function helloworld ()`,
 			output: `drop function helloworld ()`,
 		},
+		{
+			input: "create procedure p1 (in a int, out b varchar(10), inout c int) begin declare x int default 0; set x = a + 1; select x, a into b, @c from t where id = x; end",
+		},
+		{
+			input:  "create procedure p2 () l1: begin declare done int default 0; declare cur cursor for select id from t; declare continue handler for not found set done = 1; declare exit handler for sqlexception, sqlstate '23000', 1062 begin end; open cur; l2: loop fetch next from cur into a; if done then leave l2; elseif a > 1 then iterate l2; else select a; end if; end loop l2; close cur; while done > 0 do set done = done - 1; end while; repeat set done = done + 1; until done > 3 end repeat; end l1",
+			output: "create procedure p2 () l1: begin declare done int default 0; declare cur cursor for select id from t; declare continue handler for not found set done = 1; declare exit handler for sqlexception, sqlstate '23000', 1062 begin end; open cur; l2: loop fetch cur into a; if done then leave l2; elseif a > 1 then iterate l2; else select a; end if; end loop; close cur; while done > 0 do set done = done - 1; end while; repeat set done = done + 1; until done > 3 end repeat; end",
+		},
+		{
+			input: "call p1(1, @b, @c)",
+		},
+		{
+			input:  "call db1.p1",
+			output: "call db1.p1()",
+		},
+		{
+			input: "drop procedure if exists db1.p1",
+		},
 		{
 			input:  `drop function charat (int)`,
 			output: `drop function charat (int)`,
@@ -1983,6 +2149,30 @@ var (
 		{
 			input: "alter table tbl1 add foreign key sdf (a, b) references b(a asc, b desc)",
 		},
+		{
+			input:  "alter table tbl1 add col2 int default 1 after col1",
+			output: "alter table tbl1 add column col2 int default 1 after col1",
+		},
+		{
+			input: "alter table tbl1 add column col2 varchar(10) first",
+		},
+		{
+			input:  "alter table tbl1 modify col1 bigint not null",
+			output: "alter table tbl1 modify column col1 bigint not null",
+		},
+		{
+			input: "alter table tbl1 change column col1 col2 bigint after col3",
+		},
+		{
+			input: "alter table tbl1 rename column col1 to col2",
+		},
+		{
+			input:  "alter table tbl1 add column col2 int, drop column col1, modify col3 int",
+			output: "alter table tbl1 add column col2 int, drop column col1, modify column col3 int",
+		},
+		{
+			input: "alter table tbl1 add index idx1 (col1)",
+		},
 		{
 			input:  "alter table tbl1 checksum = 0, COMMENT = 'asdf'",
 			output: "alter table tbl1 checksum = 0, comment = asdf",
@@ -2126,3 +2316,73 @@ func TestMulti(t *testing.T) {
 		}
 	}
 }
+
+func TestProcedureSource(t *testing.T) {
+	sql := "create procedure p (in a int) begin\n  declare x int default a;\n  if x > 1 then select x, t.a, `a` from t; end if;\nend"
+	ast, err := ParseOne(context.TODO(), sql, 1)
+	if err != nil {
+		t.Fatalf("Parse(%q) err: %v", sql, err)
+	}
+	body := ast.(*tree.CreateProcedure).Body.(*tree.ProcedureStmt)
+	if body.Source.Text != sql[strings.Index(sql, "begin"):] {
+		t.Errorf("unexpected body source %q", body.Source.Text)
+	}
+
+	stmts := body.Stmt.(*tree.CompoundStmt).Stmts
+	declare := stmts[0].(*tree.ProcedureStmt).Source
+	if declare.Text != "declare x int default a" || len(declare.Idents) != 1 || declare.Text[declare.Idents[0]:] != "a" {
+		t.Errorf("unexpected declare source %q %v", declare.Text, declare.Idents)
+	}
+
+	ifStmt := stmts[1].(*tree.ProcedureStmt).Stmt.(*tree.IfStmt)
+	if ifStmt.CondSource.Text != "x > 1" || len(ifStmt.CondSource.Idents) != 1 || ifStmt.CondSource.Idents[0] != 0 {
+		t.Errorf("unexpected condition source %q %v", ifStmt.CondSource.Text, ifStmt.CondSource.Idents)
+	}
+	// the qualified and the quoted column names are not local variables
+	sel := ifStmt.Body[0].(*tree.ProcedureStmt).Source
+	if sel.Text != "select x, t.a, `a` from t" || len(sel.Idents) != 1 || sel.Idents[0] != 7 {
+		t.Errorf("unexpected select source %q %v", sel.Text, sel.Idents)
+	}
+}
+
+func TestParseOptimizerHints(t *testing.T) {
+	cases := []struct {
+		input  string
+		output string
+	}{
+		{"/*+ JOIN_ORDER(t1, (t2, `T3`)) */", "/*+ JOIN_ORDER(t1, (t2, T3)) */"},
+		{"use_index(t1 idx1, idx2) shuffle()", "/*+ USE_INDEX(t1, idx1, idx2) SHUFFLE() */"},
+		// the hints are ignored from the syntax error on
+		{"HASH_JOIN(t1) NO_HASH_JOIN(t2", "/*+ HASH_JOIN(t1) */"},
+		{"HASH_JOIN(t1) JOIN_ORDER(t1, ())", "/*+ HASH_JOIN(t1) */"},
+		{"BROADCAST t1", ""},
+	}
+	for _, c := range cases {
+		hints := ParseOptimizerHints(c.input)
+		out := ""
+		if len(hints) > 0 {
+			out = tree.String(hints, dialect.MYSQL)
+		}
+		if out != c.output {
+			t.Errorf("ParseOptimizerHints(%q) = %q, want %q", c.input, out, c.output)
+		}
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	sqls := []string{
+		"select /*+ JOIN_ORDER(t2, t1) */ * from t1, t2 where t1.a = t2.a and t1.b = 1 limit 10",
+		"select * from t1, t2 where t1.a = t2.a and t1.b = 'x' limit 5",
+	}
+	var prints []string
+	for _, sql := range sqls {
+		ast, err := ParseOne(context.TODO(), sql, 1)
+		if err != nil {
+			t.Fatalf("Parse(%q) err: %v", sql, err)
+		}
+		prints = append(prints, tree.Fingerprint(ast))
+	}
+	if prints[0] != "select * from t1 cross join t2 where t1.a = t2.a and t1.b = ? limit ?" || prints[0] != prints[1] {
+		t.Errorf("unexpected fingerprints %q", prints)
+	}
+}
@@ -36,7 +36,11 @@ type Scanner struct {
 	Line   int
 	Col    int
 	PrePos int
-	buf    string
+	// TokenStart is the offset of the first character of the last scanned token.
+	TokenStart int
+	buf        string
+	// allowHints is set right after SELECT, the comment /*+ ... */ there is the optimizer hints
+	allowHints bool
 }
 
 func NewScanner(dialectType dialect.DialectType, sql string) *Scanner {
@@ -57,6 +61,7 @@ func (s *Scanner) Scan() (int, string) {
 	}
 	s.PrePos = s.Pos
 	s.skipBlank()
+	s.TokenStart = s.Pos
 	switch ch := s.cur(); {
 	case ch == '@':
 		tokenID := AT_ID
@@ -122,6 +127,11 @@ func (s *Scanner) Scan() (int, string) {
 			s.incN(2)
 			return ASSIGNMENT, ""
 		}
+		// the ':' following a label in stored procedures
+		if next := s.peek(1); next != ':' && !isLetter(next) {
+			s.inc()
+			return ':', ""
+		}
 		// Like mysql -h ::1 ?
 		return s.scanBindVar()
 	case ch == ';':
@@ -145,6 +155,12 @@ func (s *Scanner) Scan() (int, string) {
 			case s.cur() == '!' && s.dialectType == dialect.MYSQL:
 				// TODO: ExtractMysqlComment
 				return s.scanMySQLSpecificComment()
+			case s.cur() == '+' && s.allowHints:
+				id, str := s.scanCommentTypeBlock()
+				if id == LEX_ERROR {
+					return id, str
+				}
+				return OPTIMIZER_HINTS, str
 			default:
 				id, str := s.scanCommentTypeBlock()
 				if id == LEX_ERROR {
@@ -204,6 +220,10 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 			}
 		case '>':
 			s.inc()
+			if s.cur() == '>' {
+				s.inc()
+				return LONG_ARROW, ""
+			}
 			return ARROW, ""
 		}
 		return int(ch), ""
@@ -20,21 +20,32 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-func Parse(ctx context.Context, sql string) ([]tree.Statement, error) {
+// Parse parses the sql by the postgresql grammar. The statements the grammar
+// does not cover are rewritten by ToMySQL and parsed by the mysql grammar.
+func Parse(ctx context.Context, sql string, lower int64) ([]tree.Statement, error) {
 	lexer := NewLexer(dialect.POSTGRESQL, sql)
 	if yyParse(lexer) != 0 {
-		return nil, lexer.scanner.LastError
+		mysqlSql, _, err := ToMySQL(ctx, sql)
+		if err != nil {
+			return nil, err
+		}
+		return mysql.Parse(ctx, mysqlSql, lower)
 	}
 	return lexer.stmts, nil
 }
 
-func ParseOne(ctx context.Context, sql string) (tree.Statement, error) {
+func ParseOne(ctx context.Context, sql string, lower int64) (tree.Statement, error) {
 	lexer := NewLexer(dialect.POSTGRESQL, sql)
 	if yyParse(lexer) != 0 {
-		return nil, lexer.scanner.LastError
+		mysqlSql, _, err := ToMySQL(ctx, sql)
+		if err != nil {
+			return nil, err
+		}
+		return mysql.ParseOne(ctx, mysqlSql, lower)
 	}
 	if len(lexer.stmts) != 1 {
 		return nil, moerr.NewInternalError(ctx, "syntax Error, or too many sql to parse")
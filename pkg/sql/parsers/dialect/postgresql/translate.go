@@ -0,0 +1,460 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresql
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	tokSpace = iota // blanks and comments
+	tokWord         // keywords, identifiers and numbers
+	tokQuotedIdent
+	tokString
+	tokParam
+	tokPunct
+)
+
+type pgToken struct {
+	kind int
+	// text is written into the mysql sql
+	text string
+	// val is the value of the string, the quoted identifier and the parameter
+	val string
+}
+
+// ToMySQL rewrites the postgresql specific syntax in the sql into its mysql
+// counterpart, so that the statements beyond the postgresql grammar can be
+// parsed by the mysql grammar. It also returns the number n of the $n that
+// every ? of the rewritten sql comes from.
+//
+// The rewritten syntax:
+//   - "ident" to `ident`
+//   - the backslashes in 'str' are literal as standard_conforming_strings is on,
+//     E'str' keeps the backslash escapes
+//   - $$str$$ and $tag$str$tag$ to 'str'
+//   - $n to ?
+//   - SET name TO value to SET name = value
+//   - COPY table [(columns)] FROM STDIN [options] to LOAD DATA LOCAL INFILE 'stdin'
+func ToMySQL(ctx context.Context, sql string) (string, []int, error) {
+	var buf strings.Builder
+	var params []int
+	tokens := tokenize(sql)
+	for len(tokens) > 0 {
+		end := 0
+		for end < len(tokens) && !(tokens[end].kind == tokPunct && tokens[end].text == ";") {
+			end++
+		}
+		stmt := tokens[:end]
+		switch firstWord(stmt) {
+		case "COPY":
+			s, ok, err := rewriteCopy(ctx, stmt)
+			if err != nil {
+				return "", nil, err
+			}
+			if ok {
+				buf.WriteString(s)
+			} else {
+				writeTokens(&buf, stmt)
+			}
+		case "SET":
+			depth := 0
+			for i, tok := range stmt {
+				if tok.kind == tokPunct && tok.text == "(" {
+					depth++
+				} else if tok.kind == tokPunct && tok.text == ")" {
+					depth--
+				} else if depth == 0 && tok.kind == tokWord && strings.EqualFold(tok.text, "TO") {
+					stmt[i].text = "="
+					break
+				}
+			}
+			fallthrough
+		default:
+			for _, tok := range stmt {
+				if tok.kind == tokParam {
+					n, err := strconv.Atoi(tok.val)
+					if err != nil || n <= 0 {
+						return "", nil, moerr.NewParseError(ctx, "invalid parameter $%s", tok.val)
+					}
+					params = append(params, n)
+				}
+			}
+			writeTokens(&buf, stmt)
+		}
+		if end < len(tokens) {
+			buf.WriteString(";")
+			end++
+		}
+		tokens = tokens[end:]
+	}
+	return buf.String(), params, nil
+}
+
+func writeTokens(buf *strings.Builder, tokens []pgToken) {
+	for _, tok := range tokens {
+		buf.WriteString(tok.text)
+	}
+}
+
+func firstWord(tokens []pgToken) string {
+	for _, tok := range tokens {
+		if tok.kind == tokSpace {
+			continue
+		}
+		if tok.kind == tokWord {
+			return strings.ToUpper(tok.text)
+		}
+		break
+	}
+	return ""
+}
+
+func tokenize(sql string) []pgToken {
+	var tokens []pgToken
+	for i := 0; i < len(sql); {
+		start := i
+		ch := sql[i]
+		switch {
+		case isBlank(ch):
+			for i < len(sql) && isBlank(sql[i]) {
+				i++
+			}
+			tokens = append(tokens, pgToken{kind: tokSpace, text: sql[start:i]})
+		case ch == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			for i < len(sql) && sql[i] != '\n' {
+				i++
+			}
+			tokens = append(tokens, pgToken{kind: tokSpace, text: sql[start:i]})
+		case ch == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			if end := strings.Index(sql[i+2:], "*/"); end >= 0 {
+				i += end + 4
+			} else {
+				i = len(sql)
+			}
+			tokens = append(tokens, pgToken{kind: tokSpace, text: sql[start:i]})
+		case ch == '\'':
+			raw, next := scanQuoted(sql, i, '\'', false)
+			i = next
+			tokens = append(tokens, pgToken{
+				kind: tokString,
+				text: "'" + strings.ReplaceAll(raw, `\`, `\\`) + "'",
+				val:  strings.ReplaceAll(raw, "''", "'"),
+			})
+		case (ch == 'e' || ch == 'E') && i+1 < len(sql) && sql[i+1] == '\'':
+			raw, next := scanQuoted(sql, i+1, '\'', true)
+			i = next
+			tokens = append(tokens, pgToken{kind: tokString, text: "'" + raw + "'", val: unescape(raw)})
+		case ch == '"':
+			raw, next := scanQuoted(sql, i, '"', false)
+			i = next
+			val := strings.ReplaceAll(raw, `""`, `"`)
+			tokens = append(tokens, pgToken{kind: tokQuotedIdent, text: "`" + strings.ReplaceAll(val, "`", "``") + "`", val: val})
+		case ch == '`':
+			raw, next := scanQuoted(sql, i, '`', false)
+			i = next
+			tokens = append(tokens, pgToken{kind: tokQuotedIdent, text: sql[start:i], val: strings.ReplaceAll(raw, "``", "`")})
+		case ch == '$' && i+1 < len(sql) && isDigit(uint16(sql[i+1])):
+			i++
+			for i < len(sql) && isDigit(uint16(sql[i])) {
+				i++
+			}
+			tokens = append(tokens, pgToken{kind: tokParam, text: "?", val: sql[start+1 : i]})
+		case ch == '$':
+			tag := dollarTag(sql, i)
+			if tag == "" {
+				i++
+				tokens = append(tokens, pgToken{kind: tokPunct, text: sql[start:i]})
+				break
+			}
+			i += len(tag)
+			end := strings.Index(sql[i:], tag)
+			if end < 0 {
+				end = len(sql) - i
+			}
+			val := sql[i : i+end]
+			i += end + len(tag)
+			if i > len(sql) {
+				i = len(sql)
+			}
+			tokens = append(tokens, pgToken{kind: tokString, text: quoteString(val), val: val})
+		case isWordChar(ch):
+			for i < len(sql) && (isWordChar(sql[i]) || sql[i] == '$') {
+				i++
+			}
+			tokens = append(tokens, pgToken{kind: tokWord, text: sql[start:i]})
+		default:
+			i++
+			tokens = append(tokens, pgToken{kind: tokPunct, text: sql[start:i]})
+		}
+	}
+	return tokens
+}
+
+// scanQuoted scans the quoted text starting at sql[pos], the doubled quote is a quote
+// in the text. It returns the raw text between the quotes and the position after the end quote.
+func scanQuoted(sql string, pos int, quote byte, backslash bool) (string, int) {
+	i := pos + 1
+	for i < len(sql) {
+		if backslash && sql[i] == '\\' {
+			i += 2
+			continue
+		}
+		if sql[i] == quote {
+			if i+1 < len(sql) && sql[i+1] == quote {
+				i += 2
+				continue
+			}
+			return sql[pos+1 : i], i + 1
+		}
+		i++
+	}
+	return sql[pos+1:], len(sql)
+}
+
+// dollarTag returns the $tag$ starting at sql[pos] or "" if there is no tag
+func dollarTag(sql string, pos int) string {
+	i := pos + 1
+	for i < len(sql) && sql[i] != '$' {
+		if !isWordChar(sql[i]) || isDigit(uint16(sql[i])) && i == pos+1 {
+			return ""
+		}
+		i++
+	}
+	if i == len(sql) {
+		return ""
+	}
+	return sql[pos : i+1]
+}
+
+// unescape resolves the backslash escapes of the E'str'
+func unescape(raw string) string {
+	var buf strings.Builder
+	for i := 0; i < len(raw); i++ {
+		ch := raw[i]
+		if ch == '\'' && i+1 < len(raw) && raw[i+1] == '\'' {
+			i++
+		} else if ch == '\\' && i+1 < len(raw) {
+			i++
+			switch raw[i] {
+			case 'b':
+				ch = '\b'
+			case 'f':
+				ch = '\f'
+			case 'n':
+				ch = '\n'
+			case 'r':
+				ch = '\r'
+			case 't':
+				ch = '\t'
+			default:
+				ch = raw[i]
+			}
+		}
+		buf.WriteByte(ch)
+	}
+	return buf.String()
+}
+
+// quoteString quotes the str as a mysql string constant
+func quoteString(str string) string {
+	str = strings.ReplaceAll(str, `\`, `\\`)
+	return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
+
+func isBlank(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == '\f'
+}
+
+func isWordChar(ch byte) bool {
+	return ch == '_' || ch >= 0x80 || isLetter(uint16(ch)) || isDigit(uint16(ch))
+}
+
+// rewriteCopy rewrites the COPY FROM STDIN into the LOAD DATA LOCAL INFILE
+// that reads the data from the client. It returns false if the tokens are
+// not a COPY FROM STDIN.
+//
+//	COPY table [(column [, ...])] FROM STDIN [[WITH] (option [, ...]) | legacy options]
+func rewriteCopy(ctx context.Context, stmt []pgToken) (string, bool, error) {
+	var tokens []pgToken
+	for _, tok := range stmt {
+		if tok.kind != tokSpace {
+			tokens = append(tokens, tok)
+		}
+	}
+	isPunct := func(i int, p string) bool {
+		return i < len(tokens) && tokens[i].kind == tokPunct && tokens[i].text == p
+	}
+	isWord := func(i int, w string) bool {
+		return i < len(tokens) && tokens[i].kind == tokWord && strings.EqualFold(tokens[i].text, w)
+	}
+	isName := func(i int) bool {
+		return i < len(tokens) && (tokens[i].kind == tokWord || tokens[i].kind == tokQuotedIdent)
+	}
+
+	// table name
+	i := 1
+	if !isName(i) {
+		return "", false, nil
+	}
+	table := tokens[i].text
+	for i++; isPunct(i, ".") && isName(i+1); i += 2 {
+		table += "." + tokens[i+1].text
+	}
+
+	// column list
+	var columns []string
+	if isPunct(i, "(") {
+		for i++; isName(i); i++ {
+			columns = append(columns, tokens[i].text)
+			if i++; !isPunct(i, ",") {
+				break
+			}
+		}
+		if !isPunct(i, ")") {
+			return "", false, nil
+		}
+		i++
+	}
+	if !isWord(i, "FROM") || !isWord(i+1, "STDIN") {
+		return "", false, nil
+	}
+	i += 2
+
+	format, delimiter, null, quote := "text", "", "", ""
+	header := false
+	setOption := func(name, val string) error {
+		switch strings.ToUpper(name) {
+		case "FORMAT":
+			format = strings.ToLower(val)
+		case "DELIMITER":
+			delimiter = val
+		case "NULL":
+			null = val
+		case "QUOTE":
+			quote = val
+		case "HEADER":
+			header = val == "" || strings.EqualFold(val, "true") || strings.EqualFold(val, "on") || val == "1"
+		case "ENCODING":
+			if !strings.EqualFold(val, "utf8") && !strings.EqualFold(val, "utf-8") {
+				return moerr.NewNotSupported(ctx, "COPY with encoding %s", val)
+			}
+		case "ESCAPE":
+			if val != `"` && val != `\` {
+				return moerr.NewNotSupported(ctx, "COPY with escape %s", val)
+			}
+		default:
+			return moerr.NewNotSupported(ctx, "COPY option %s", name)
+		}
+		return nil
+	}
+	if isWord(i, "WITH") {
+		i++
+	}
+	if isPunct(i, "(") {
+		for i++; isName(i); i++ {
+			name, val := tokens[i].text, ""
+			if i++; i < len(tokens) && tokens[i].kind != tokPunct {
+				val = tokens[i].val
+				if tokens[i].kind == tokWord {
+					val = tokens[i].text
+				}
+				i++
+			}
+			if err := setOption(name, val); err != nil {
+				return "", false, err
+			}
+			if !isPunct(i, ",") {
+				break
+			}
+		}
+		if !isPunct(i, ")") {
+			return "", false, moerr.NewParseError(ctx, "invalid COPY options")
+		}
+		i++
+	} else {
+		for ; i < len(tokens); i++ {
+			var err error
+			switch name := strings.ToUpper(tokens[i].text); name {
+			case "BINARY", "CSV":
+				err = setOption("FORMAT", name)
+			case "HEADER":
+				err = setOption(name, "")
+			case "DELIMITER", "NULL", "QUOTE", "ESCAPE", "ENCODING":
+				if isWord(i+1, "AS") {
+					i++
+				}
+				if i+1 >= len(tokens) || tokens[i+1].kind != tokString {
+					return "", false, moerr.NewParseError(ctx, "invalid COPY option %s", name)
+				}
+				i++
+				err = setOption(name, tokens[i].val)
+			default:
+				return "", false, moerr.NewNotSupported(ctx, "COPY option %s", tokens[i].text)
+			}
+			if err != nil {
+				return "", false, err
+			}
+		}
+	}
+	if i < len(tokens) {
+		return "", false, moerr.NewParseError(ctx, "invalid COPY options")
+	}
+
+	var buf strings.Builder
+	buf.WriteString("LOAD DATA LOCAL INFILE 'stdin' INTO TABLE ")
+	buf.WriteString(table)
+	switch format {
+	case "text":
+		if delimiter == "" {
+			delimiter = "\t"
+		}
+		if null != "" && null != `\N` {
+			return "", false, moerr.NewNotSupported(ctx, "COPY with null string %s", null)
+		}
+		buf.WriteString(" FIELDS TERMINATED BY ")
+		buf.WriteString(quoteString(delimiter))
+	case "csv":
+		if delimiter == "" {
+			delimiter = ","
+		}
+		if quote == "" {
+			quote = `"`
+		}
+		if null != "" && null != `\N` {
+			return "", false, moerr.NewNotSupported(ctx, "COPY with null string %s", null)
+		}
+		buf.WriteString(" FIELDS TERMINATED BY ")
+		buf.WriteString(quoteString(delimiter))
+		buf.WriteString(" ENCLOSED BY ")
+		buf.WriteString(quoteString(quote))
+	default:
+		return "", false, moerr.NewNotSupported(ctx, "COPY with format %s", format)
+	}
+	buf.WriteString(" LINES TERMINATED BY '\\n'")
+	if header {
+		buf.WriteString(" IGNORE 1 LINES")
+	}
+	if len(columns) > 0 {
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(columns, ", "))
+		buf.WriteString(")")
+	}
+	return buf.String(), true, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresql
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func TestToMySQL(t *testing.T) {
+	testcases := []struct {
+		in     string
+		out    string
+		params []int
+	}{{
+		in:  `select "A"."b""c" from "T"`,
+		out: "select `A`.`b\"c` from `T`",
+	}, {
+		in:  `select 'a\b', 'it''s', E'a\tb\\c'`,
+		out: `select 'a\\b', 'it''s', 'a\tb\\c'`,
+	}, {
+		in:  `select $$it's \n$$, $tag$a$$b$tag$`,
+		out: `select 'it''s \\n', 'a$$b'`,
+	}, {
+		in:     "select * from t where a = $2 and b = $1 or c = $2",
+		out:    "select * from t where a = ? and b = ? or c = ?",
+		params: []int{2, 1, 2},
+	}, {
+		in:  "select a$1 from t -- $1 'x\n/* \"y\" */",
+		out: "select a$1 from t -- $1 'x\n/* \"y\" */",
+	}, {
+		in:  "set search_path TO public; SET x = 'y'",
+		out: "set search_path = public; SET x = 'y'",
+	}, {
+		in:  "copy t from stdin",
+		out: "LOAD DATA LOCAL INFILE 'stdin' INTO TABLE t FIELDS TERMINATED BY '\t' LINES TERMINATED BY '\\n'",
+	}, {
+		in:  `COPY db."T" (a, "B") FROM STDIN WITH (FORMAT csv, HEADER true, DELIMITER '|')`,
+		out: "LOAD DATA LOCAL INFILE 'stdin' INTO TABLE db.`T` FIELDS TERMINATED BY '|' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' IGNORE 1 LINES (a, `B`)",
+	}, {
+		in:  `copy t from stdin csv header delimiter as ';'`,
+		out: "LOAD DATA LOCAL INFILE 'stdin' INTO TABLE t FIELDS TERMINATED BY ';' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' IGNORE 1 LINES",
+	}, {
+		in:  "copy t to stdout",
+		out: "copy t to stdout",
+	}}
+
+	for _, tcase := range testcases {
+		out, params, err := ToMySQL(context.TODO(), tcase.in)
+		if err != nil {
+			t.Errorf("ToMySQL(%q) err: %v", tcase.in, err)
+			continue
+		}
+		if out != tcase.out {
+			t.Errorf("ToMySQL(%q):\n%s, want\n%s", tcase.in, out, tcase.out)
+		}
+		if !reflect.DeepEqual(params, tcase.params) {
+			t.Errorf("ToMySQL(%q) params: %v, want %v", tcase.in, params, tcase.params)
+		}
+	}
+
+	for _, in := range []string{
+		"copy t from stdin (format binary)",
+		"copy t from stdin with (null 'NULL')",
+		"select $0",
+	} {
+		if _, _, err := ToMySQL(context.TODO(), in); err == nil {
+			t.Errorf("ToMySQL(%q) should fail", in)
+		}
+	}
+}
+
+func TestParseByMySQL(t *testing.T) {
+	stmts, err := Parse(context.TODO(), `select "a" from t where b = $1; insert into "T" values ('x\y')`, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stmts) != 2 {
+		t.Fatalf("got %d statements", len(stmts))
+	}
+	if _, ok := stmts[0].(*tree.Select); !ok {
+		t.Errorf("got %T, want *tree.Select", stmts[0])
+	}
+	// the backslash is literal
+	want, err := mysql.ParseOne(context.TODO(), `insert into t values ('x\\y')`, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out := tree.String(stmts[1], dialect.MYSQL); out != tree.String(want, dialect.MYSQL) {
+		t.Errorf("got %s, want %s", out, tree.String(want, dialect.MYSQL))
+	}
+
+	stmt, err := ParseOne(context.TODO(), "copy t from stdin", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if load, ok := stmt.(*tree.Load); !ok || !load.Local {
+		t.Errorf("got %v, want a local load", stmt)
+	}
+}
@@ -213,6 +213,84 @@ func (node *AlterOptionAdd) Format(ctx *FmtCtx) {
 	node.Def.Format(ctx)
 }
 
+type ColumnPositionType int
+
+const (
+	// ColumnPositionNone means the column is placed at the end of the table for ADD, or where it was for MODIFY and CHANGE.
+	ColumnPositionNone ColumnPositionType = iota
+	ColumnPositionFirst
+	ColumnPositionAfter
+)
+
+// ColumnPosition is the FIRST or AFTER clause of ALTER TABLE ADD/MODIFY/CHANGE COLUMN.
+type ColumnPosition struct {
+	NodeFormatter
+	Typ            ColumnPositionType
+	RelativeColumn Identifier
+}
+
+func (node *ColumnPosition) Format(ctx *FmtCtx) {
+	switch node.Typ {
+	case ColumnPositionFirst:
+		ctx.WriteString(" first")
+	case ColumnPositionAfter:
+		ctx.WriteString(" after ")
+		node.RelativeColumn.Format(ctx)
+	}
+}
+
+type AlterOptionAddColumn struct {
+	alterOptionImpl
+	Column   *ColumnTableDef
+	Position *ColumnPosition
+}
+
+func (node *AlterOptionAddColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("add column ")
+	node.Column.Format(ctx)
+	node.Position.Format(ctx)
+}
+
+type AlterOptionModifyColumn struct {
+	alterOptionImpl
+	Column   *ColumnTableDef
+	Position *ColumnPosition
+}
+
+func (node *AlterOptionModifyColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("modify column ")
+	node.Column.Format(ctx)
+	node.Position.Format(ctx)
+}
+
+type AlterOptionChangeColumn struct {
+	alterOptionImpl
+	OldName  Identifier
+	Column   *ColumnTableDef
+	Position *ColumnPosition
+}
+
+func (node *AlterOptionChangeColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("change column ")
+	node.OldName.Format(ctx)
+	ctx.WriteString(" ")
+	node.Column.Format(ctx)
+	node.Position.Format(ctx)
+}
+
+type AlterOptionRenameColumn struct {
+	alterOptionImpl
+	OldName Identifier
+	NewName Identifier
+}
+
+func (node *AlterOptionRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	node.OldName.Format(ctx)
+	ctx.WriteString(" to ")
+	node.NewName.Format(ctx)
+}
+
 type AlterTableDropType int
 
 const (
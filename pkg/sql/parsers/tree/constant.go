@@ -59,6 +59,10 @@ type NumVal struct {
 }
 
 func (n *NumVal) Format(ctx *FmtCtx) {
+	if ctx.fingerprint {
+		ctx.WriteByte('?')
+		return
+	}
 	if n.origString != "" {
 		ctx.WriteValue(n.ValType, FormatString(n.origString))
 		return
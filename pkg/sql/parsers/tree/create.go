@@ -483,6 +483,29 @@ func NewAttributeStorage(s string) *AttributeStorage {
 	}
 }
 
+type AttributeCompression struct {
+	columnAttributeImpl
+	Compression string
+	// Level is the level of the compression, 0 means the default level
+	Level int64
+}
+
+func (node *AttributeCompression) Format(ctx *FmtCtx) {
+	ctx.WriteString("compression ")
+	ctx.WriteString(node.Compression)
+	if node.Level != 0 {
+		ctx.WriteString(" level ")
+		ctx.WriteString(strconv.FormatInt(node.Level, 10))
+	}
+}
+
+func NewAttributeCompression(c string, level int64) *AttributeCompression {
+	return &AttributeCompression{
+		Compression: c,
+		Level:       level,
+	}
+}
+
 type AttributeCheckConstraint struct {
 	columnAttributeImpl
 	Name     string
@@ -523,7 +546,14 @@ type AttributeGeneratedAlways struct {
 }
 
 func (node *AttributeGeneratedAlways) Format(ctx *FmtCtx) {
+	ctx.WriteString("generated always as (")
 	node.Expr.Format(ctx)
+	ctx.WriteString(")")
+	if node.Stored {
+		ctx.WriteString(" stored")
+	} else {
+		ctx.WriteString(" virtual")
+	}
 }
 
 func NewAttributeGeneratedAlways(e Expr, s bool) *AttributeGeneratedAlways {
@@ -1030,21 +1060,30 @@ func NewFullTextIndex(k []*KeyPart, n string, e bool, io *IndexOption) *FullText
 
 type CheckIndex struct {
 	tableDefImpl
+	Name     string
 	Expr     Expr
 	Enforced bool
 }
 
 func (node *CheckIndex) Format(ctx *FmtCtx) {
+	if node.Name != "" {
+		ctx.WriteString("constraint ")
+		ctx.WriteString(node.Name)
+		ctx.WriteByte(' ')
+	}
 	ctx.WriteString("check (")
 	node.Expr.Format(ctx)
 	ctx.WriteByte(')')
 	if node.Enforced {
 		ctx.WriteString(" enforced")
+	} else {
+		ctx.WriteString(" not enforced")
 	}
 }
 
-func NewCheckIndex(e Expr, en bool) *CheckIndex {
+func NewCheckIndex(e Expr, en bool, n string) *CheckIndex {
 	return &CheckIndex{
+		Name:     n,
 		Expr:     e,
 		Enforced: en,
 	}
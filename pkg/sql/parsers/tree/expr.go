@@ -198,6 +198,7 @@ const (
 	IS_DISTINCT_FROM
 	IS_NOT_DISTINCT_FROM
 	NULL_SAFE_EQUAL // <=>
+	MEMBER_OF       // MEMBER OF
 	//reference: https://dev.mysql.com/doc/refman/8.0/en/all-subqueries.html
 	//subquery with ANY,SOME,ALL
 	//operand comparison_operator [ANY | SOME | ALL] (subquery)
@@ -238,6 +239,8 @@ func (op ComparisonOp) ToString() string {
 		return "is not distinct from"
 	case NULL_SAFE_EQUAL:
 		return "<=>"
+	case MEMBER_OF:
+		return "member of"
 	case ANY:
 		return "any"
 	case SOME:
@@ -820,6 +823,8 @@ type CastExpr struct {
 	exprImpl
 	Expr Expr
 	Type ResolvableTypeReference
+	// CAST(... AS type ARRAY), only used by the multi-valued index
+	Array bool
 }
 
 func (node *CastExpr) Format(ctx *FmtCtx) {
@@ -827,6 +832,9 @@ func (node *CastExpr) Format(ctx *FmtCtx) {
 	node.Expr.Format(ctx)
 	ctx.WriteString(" as ")
 	node.Type.(*T).InternalType.Format(ctx)
+	if node.Array {
+		ctx.WriteString(" array")
+	}
 	ctx.WriteByte(')')
 }
 
@@ -837,6 +845,61 @@ func NewCastExpr(e Expr, t ResolvableTypeReference) *CastExpr {
 	}
 }
 
+type FullTextSearchType int
+
+const (
+	FULLTEXT_DEFAULT FullTextSearchType = iota
+	FULLTEXT_NATURAL_LANGUAGE
+	FULLTEXT_NATURAL_LANGUAGE_QUERY_EXPANSION
+	FULLTEXT_BOOLEAN
+	FULLTEXT_QUERY_EXPANSION
+)
+
+var fullTextSearchTypeName = map[FullTextSearchType]string{
+	FULLTEXT_DEFAULT:                          "",
+	FULLTEXT_NATURAL_LANGUAGE:                 "in natural language mode",
+	FULLTEXT_NATURAL_LANGUAGE_QUERY_EXPANSION: "in natural language mode with query expansion",
+	FULLTEXT_BOOLEAN:                          "in boolean mode",
+	FULLTEXT_QUERY_EXPANSION:                  "with query expansion",
+}
+
+func (t FullTextSearchType) String() string {
+	return fullTextSearchTypeName[t]
+}
+
+// the MATCH (col1, col2, ...) AGAINST (expr [search_modifier]) expression
+type FullTextMatchExpr struct {
+	exprImpl
+	KeyParts []*UnresolvedName
+	Pattern  Expr
+	Mode     FullTextSearchType
+}
+
+func (node *FullTextMatchExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("match(")
+	for i, k := range node.KeyParts {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		k.Format(ctx)
+	}
+	ctx.WriteString(") against(")
+	node.Pattern.Format(ctx)
+	if node.Mode != FULLTEXT_DEFAULT {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.Mode.String())
+	}
+	ctx.WriteByte(')')
+}
+
+func NewFullTextMatchExpr(k []*UnresolvedName, p Expr, m FullTextSearchType) *FullTextMatchExpr {
+	return &FullTextMatchExpr{
+		KeyParts: k,
+		Pattern:  p,
+		Mode:     m,
+	}
+}
+
 // the parenthesized list of expressions.
 type Tuple struct {
 	exprImpl
@@ -28,6 +28,8 @@ type FmtCtx struct {
 	// quoteString string
 	quoteString       bool
 	singleQuoteString bool
+	// fingerprint replaces the constants by '?' and skips the optimizer hints
+	fingerprint bool
 }
 
 func NewFmtCtx(dialectType dialect.DialectType, opts ...FmtCtxOption) *FmtCtx {
@@ -58,6 +60,12 @@ func WithSingleQuoteString() FmtCtxOption {
 	})
 }
 
+func withFingerprint() FmtCtxOption {
+	return FmtCtxOption(func(ctx *FmtCtx) {
+		ctx.fingerprint = true
+	})
+}
+
 // NodeFormatter for formatted output of the node.
 type NodeFormatter interface {
 	Format(ctx *FmtCtx)
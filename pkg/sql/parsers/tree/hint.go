@@ -0,0 +1,159 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+)
+
+// the optimizer hints in the comment /*+ ... */ after SELECT
+const (
+	HintJoinOrder   = "JOIN_ORDER"
+	HintHashJoin    = "HASH_JOIN"
+	HintNoHashJoin  = "NO_HASH_JOIN"
+	HintUseIndex    = "USE_INDEX"
+	HintIgnoreIndex = "IGNORE_INDEX"
+	HintBroadcast   = "BROADCAST"
+	HintShuffle     = "SHUFFLE"
+)
+
+// HintArg is an argument of the optimizer hint, it is a name or a parenthesized group of arguments.
+type HintArg struct {
+	Name  string
+	Group []*HintArg
+}
+
+func (node *HintArg) Format(ctx *FmtCtx) {
+	if node.Group == nil {
+		ctx.WriteString(node.Name)
+		return
+	}
+	ctx.WriteByte('(')
+	formatHintArgs(ctx, node.Group)
+	ctx.WriteByte(')')
+}
+
+func formatHintArgs(ctx *FmtCtx, args []*HintArg) {
+	for i, arg := range args {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		arg.Format(ctx)
+	}
+}
+
+// OptimizerHint is a hint like JOIN_ORDER(t1, (t2, t3)).
+type OptimizerHint struct {
+	Name string
+	Args []*HintArg
+}
+
+func (node *OptimizerHint) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Name)
+	ctx.WriteByte('(')
+	formatHintArgs(ctx, node.Args)
+	ctx.WriteByte(')')
+}
+
+// Names returns the names of the arguments, the groups are flattened.
+func (node *OptimizerHint) Names() []string {
+	var names []string
+	var walk func(args []*HintArg)
+	walk = func(args []*HintArg) {
+		for _, arg := range args {
+			if arg.Group == nil {
+				names = append(names, arg.Name)
+			} else {
+				walk(arg.Group)
+			}
+		}
+	}
+	walk(node.Args)
+	return names
+}
+
+type OptimizerHints []*OptimizerHint
+
+func (node OptimizerHints) Format(ctx *FmtCtx) {
+	ctx.WriteString("/*+ ")
+	for _, hint := range node {
+		hint.Format(ctx)
+		ctx.WriteByte(' ')
+	}
+	ctx.WriteString("*/")
+}
+
+// FirstSelectClause returns the first query block of the statement, the hints of the statement
+// are written there. It returns nil for VALUES.
+func FirstSelectClause(stmt SelectStatement) *SelectClause {
+	switch s := stmt.(type) {
+	case *SelectClause:
+		return s
+	case *ParenSelect:
+		return FirstSelectClause(s.Select.Select)
+	case *UnionClause:
+		return FirstSelectClause(s.Left)
+	}
+	return nil
+}
+
+// Fingerprint is the text of the statement without the constants and the optimizer hints,
+// the statements differing only in them have the same fingerprint.
+func Fingerprint(stmt NodeFormatter) string {
+	ctx := NewFmtCtx(dialect.MYSQL, withFingerprint())
+	stmt.Format(ctx)
+	return ctx.String()
+}
+
+// Digest is the sha256 of the fingerprint of the statement.
+func Digest(fingerprint string) string {
+	sum := sha256.Sum256([]byte(fingerprint))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreatePlanBaseline captures the plan of the statement, the statements with the same fingerprint use it.
+type CreatePlanBaseline struct {
+	statementImpl
+	Stmt *Select
+}
+
+func (node *CreatePlanBaseline) Format(ctx *FmtCtx) {
+	ctx.WriteString("create plan baseline for ")
+	node.Stmt.Format(ctx)
+}
+
+func (node *CreatePlanBaseline) GetStatementType() string { return "Create Plan Baseline" }
+func (node *CreatePlanBaseline) GetQueryType() string     { return QueryTypeDDL }
+
+type DropPlanBaseline struct {
+	statementImpl
+	IfExists bool
+	Stmt     *Select
+}
+
+func (node *DropPlanBaseline) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop plan baseline ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	ctx.WriteString("for ")
+	node.Stmt.Format(ctx)
+}
+
+func (node *DropPlanBaseline) GetStatementType() string { return "Drop Plan Baseline" }
+func (node *DropPlanBaseline) GetQueryType() string     { return QueryTypeDDL }
@@ -0,0 +1,131 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+// JsonTableDef is the part of JSON_TABLE(expr, path COLUMNS(...)) after the json document
+type JsonTableDef struct {
+	Path    string
+	Columns []*JsonTableColumn
+}
+
+type JsonTableColumnType int
+
+const (
+	JSON_TABLE_COLUMN_PATH JsonTableColumnType = iota
+	JSON_TABLE_COLUMN_ORDINALITY
+	JSON_TABLE_COLUMN_EXISTS
+	JSON_TABLE_COLUMN_NESTED
+)
+
+type JsonTableResponseType int
+
+const (
+	JSON_TABLE_RESPONSE_NULL JsonTableResponseType = iota
+	JSON_TABLE_RESPONSE_ERROR
+	JSON_TABLE_RESPONSE_DEFAULT
+)
+
+// JsonTableResponse is the behavior of ON EMPTY or ON ERROR
+type JsonTableResponse struct {
+	Type    JsonTableResponseType
+	Default string
+}
+
+func (node *JsonTableResponse) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case JSON_TABLE_RESPONSE_NULL:
+		ctx.WriteString("null")
+	case JSON_TABLE_RESPONSE_ERROR:
+		ctx.WriteString("error")
+	case JSON_TABLE_RESPONSE_DEFAULT:
+		ctx.WriteString("default ")
+		formatJsonTableString(ctx, node.Default)
+	}
+}
+
+// JsonTableColumn is a column definition in the COLUMNS clause of JSON_TABLE:
+//
+//	name FOR ORDINALITY
+//	name type PATH string [response ON EMPTY] [response ON ERROR]
+//	name type EXISTS PATH string
+//	NESTED [PATH] string COLUMNS (column_list)
+type JsonTableColumn struct {
+	Type    JsonTableColumnType
+	Name    Identifier
+	ColType ResolvableTypeReference
+	Path    string
+	OnEmpty *JsonTableResponse
+	OnError *JsonTableResponse
+	Columns []*JsonTableColumn
+}
+
+func (node *JsonTableColumn) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case JSON_TABLE_COLUMN_ORDINALITY:
+		node.Name.Format(ctx)
+		ctx.WriteString(" for ordinality")
+	case JSON_TABLE_COLUMN_PATH, JSON_TABLE_COLUMN_EXISTS:
+		node.Name.Format(ctx)
+		ctx.WriteByte(' ')
+		node.ColType.(*T).InternalType.Format(ctx)
+		if node.Type == JSON_TABLE_COLUMN_EXISTS {
+			ctx.WriteString(" exists")
+		}
+		ctx.WriteString(" path ")
+		formatJsonTableString(ctx, node.Path)
+		if node.OnEmpty != nil {
+			ctx.WriteByte(' ')
+			node.OnEmpty.Format(ctx)
+			ctx.WriteString(" on empty")
+		}
+		if node.OnError != nil {
+			ctx.WriteByte(' ')
+			node.OnError.Format(ctx)
+			ctx.WriteString(" on error")
+		}
+	case JSON_TABLE_COLUMN_NESTED:
+		ctx.WriteString("nested path ")
+		formatJsonTableString(ctx, node.Path)
+		formatJsonTableColumns(ctx, node.Columns)
+	}
+}
+
+func (t *TableFunction) formatJsonTable(ctx *FmtCtx) {
+	ctx.WriteString("json_table(")
+	t.Func.Exprs.Format(ctx)
+	ctx.WriteString(", ")
+	formatJsonTableString(ctx, t.JsonTable.Path)
+	formatJsonTableColumns(ctx, t.JsonTable.Columns)
+	ctx.WriteByte(')')
+}
+
+func formatJsonTableColumns(ctx *FmtCtx, cols []*JsonTableColumn) {
+	ctx.WriteString(" columns (")
+	for i, col := range cols {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		col.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+func formatJsonTableString(ctx *FmtCtx, s string) {
+	ctx.WriteByte('\'')
+	ctx.WriteString(strings.ReplaceAll(s, "'", "\\'"))
+	ctx.WriteByte('\'')
+}
@@ -0,0 +1,454 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strconv"
+
+type InOutArgType int
+
+const (
+	TYPE_IN InOutArgType = iota
+	TYPE_OUT
+	TYPE_INOUT
+)
+
+func (t InOutArgType) String() string {
+	switch t {
+	case TYPE_OUT:
+		return "out"
+	case TYPE_INOUT:
+		return "inout"
+	default:
+		return "in"
+	}
+}
+
+type ProcedureName struct {
+	Name objName
+}
+
+func (node *ProcedureName) Format(ctx *FmtCtx) {
+	if node.Name.ExplicitSchema {
+		ctx.WriteString(string(node.Name.SchemaName))
+		ctx.WriteByte('.')
+	}
+	ctx.WriteString(string(node.Name.ObjectName))
+}
+
+func (node *ProcedureName) HasNoNameQualifier() bool {
+	return !node.Name.ExplicitCatalog && !node.Name.ExplicitSchema
+}
+
+func NewProcedureName(name Identifier, prefix ObjectNamePrefix) *ProcedureName {
+	return &ProcedureName{
+		Name: objName{
+			ObjectName:       name,
+			ObjectNamePrefix: prefix,
+		},
+	}
+}
+
+type ProcedureArgDecl struct {
+	Name      *UnresolvedName
+	Type      ResolvableTypeReference
+	InOutType InOutArgType
+}
+
+func (node *ProcedureArgDecl) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.InOutType.String())
+	ctx.WriteByte(' ')
+	node.Name.Format(ctx)
+	ctx.WriteByte(' ')
+	node.Type.(*T).InternalType.Format(ctx)
+}
+
+func NewProcedureArgDecl(t InOutArgType, n *UnresolvedName, typ ResolvableTypeReference) *ProcedureArgDecl {
+	return &ProcedureArgDecl{
+		Name:      n,
+		Type:      typ,
+		InOutType: t,
+	}
+}
+
+// container holding list of parameters of a stored procedure
+type ProcedureArgs []*ProcedureArgDecl
+
+// ProcedureSource is the original text of a statement or an expression in the body of a
+// stored procedure, the local variables are substituted in it before it is executed.
+type ProcedureSource struct {
+	Text string
+	// Idents are the offsets in Text of the unqualified column names, which refer to the
+	// local variables of the same names if declared.
+	Idents []int
+}
+
+// ProcedureStmt is a statement in the body of a stored procedure.
+type ProcedureStmt struct {
+	statementImpl
+	Stmt   Statement
+	Source *ProcedureSource
+}
+
+func (node *ProcedureStmt) Format(ctx *FmtCtx) {
+	node.Stmt.Format(ctx)
+}
+
+func (node *ProcedureStmt) GetStatementType() string { return node.Stmt.GetStatementType() }
+func (node *ProcedureStmt) GetQueryType() string     { return node.Stmt.GetQueryType() }
+
+type CreateProcedure struct {
+	statementImpl
+	Name *ProcedureName
+	Args ProcedureArgs
+	Body Statement
+}
+
+func (node *CreateProcedure) Format(ctx *FmtCtx) {
+	ctx.WriteString("create procedure ")
+	node.Name.Format(ctx)
+	ctx.WriteString(" (")
+	for i, arg := range node.Args {
+		if i != 0 {
+			ctx.WriteString(", ")
+		}
+		arg.Format(ctx)
+	}
+	ctx.WriteString(") ")
+	node.Body.Format(ctx)
+}
+
+func (node *CreateProcedure) GetStatementType() string { return "CreateProcedure" }
+func (node *CreateProcedure) GetQueryType() string     { return QueryTypeDDL }
+
+type DropProcedure struct {
+	statementImpl
+	Name     *ProcedureName
+	IfExists bool
+}
+
+func (node *DropProcedure) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop procedure ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropProcedure) GetStatementType() string { return "DropProcedure" }
+func (node *DropProcedure) GetQueryType() string     { return QueryTypeDDL }
+
+type CallStmt struct {
+	statementImpl
+	Name *ProcedureName
+	Args Exprs
+}
+
+func (node *CallStmt) Format(ctx *FmtCtx) {
+	ctx.WriteString("call ")
+	node.Name.Format(ctx)
+	ctx.WriteByte('(')
+	node.Args.Format(ctx)
+	ctx.WriteByte(')')
+}
+
+func (node *CallStmt) GetStatementType() string { return "Call" }
+func (node *CallStmt) GetQueryType() string     { return QueryTypeOth }
+
+func formatProcedureStmts(ctx *FmtCtx, stmts []Statement) {
+	for _, stmt := range stmts {
+		ctx.WriteByte(' ')
+		stmt.Format(ctx)
+		ctx.WriteByte(';')
+	}
+}
+
+func formatLabel(ctx *FmtCtx, label Identifier) {
+	if len(label) > 0 {
+		ctx.WriteString(string(label))
+		ctx.WriteString(": ")
+	}
+}
+
+// CompoundStmt is BEGIN ... END in a stored procedure.
+type CompoundStmt struct {
+	statementImpl
+	Label Identifier
+	Stmts []Statement
+}
+
+func (node *CompoundStmt) Format(ctx *FmtCtx) {
+	formatLabel(ctx, node.Label)
+	ctx.WriteString("begin")
+	formatProcedureStmts(ctx, node.Stmts)
+	ctx.WriteString(" end")
+}
+
+func (node *CompoundStmt) GetStatementType() string { return "Compound" }
+func (node *CompoundStmt) GetQueryType() string     { return QueryTypeOth }
+
+type ElseIfStmt struct {
+	Cond       Expr
+	CondSource *ProcedureSource
+	Body       []Statement
+}
+
+type IfStmt struct {
+	statementImpl
+	Cond       Expr
+	CondSource *ProcedureSource
+	Body       []Statement
+	Elifs      []*ElseIfStmt
+	Else       []Statement
+}
+
+func (node *IfStmt) Format(ctx *FmtCtx) {
+	ctx.WriteString("if ")
+	node.Cond.Format(ctx)
+	ctx.WriteString(" then")
+	formatProcedureStmts(ctx, node.Body)
+	for _, elif := range node.Elifs {
+		ctx.WriteString(" elseif ")
+		elif.Cond.Format(ctx)
+		ctx.WriteString(" then")
+		formatProcedureStmts(ctx, elif.Body)
+	}
+	if node.Else != nil {
+		ctx.WriteString(" else")
+		formatProcedureStmts(ctx, node.Else)
+	}
+	ctx.WriteString(" end if")
+}
+
+func (node *IfStmt) GetStatementType() string { return "If" }
+func (node *IfStmt) GetQueryType() string     { return QueryTypeOth }
+
+type WhileStmt struct {
+	statementImpl
+	Label      Identifier
+	Cond       Expr
+	CondSource *ProcedureSource
+	Body       []Statement
+}
+
+func (node *WhileStmt) Format(ctx *FmtCtx) {
+	formatLabel(ctx, node.Label)
+	ctx.WriteString("while ")
+	node.Cond.Format(ctx)
+	ctx.WriteString(" do")
+	formatProcedureStmts(ctx, node.Body)
+	ctx.WriteString(" end while")
+}
+
+func (node *WhileStmt) GetStatementType() string { return "While" }
+func (node *WhileStmt) GetQueryType() string     { return QueryTypeOth }
+
+type LoopStmt struct {
+	statementImpl
+	Label Identifier
+	Body  []Statement
+}
+
+func (node *LoopStmt) Format(ctx *FmtCtx) {
+	formatLabel(ctx, node.Label)
+	ctx.WriteString("loop")
+	formatProcedureStmts(ctx, node.Body)
+	ctx.WriteString(" end loop")
+}
+
+func (node *LoopStmt) GetStatementType() string { return "Loop" }
+func (node *LoopStmt) GetQueryType() string     { return QueryTypeOth }
+
+type RepeatStmt struct {
+	statementImpl
+	Label      Identifier
+	Body       []Statement
+	Cond       Expr
+	CondSource *ProcedureSource
+}
+
+func (node *RepeatStmt) Format(ctx *FmtCtx) {
+	formatLabel(ctx, node.Label)
+	ctx.WriteString("repeat")
+	formatProcedureStmts(ctx, node.Body)
+	ctx.WriteString(" until ")
+	node.Cond.Format(ctx)
+	ctx.WriteString(" end repeat")
+}
+
+func (node *RepeatStmt) GetStatementType() string { return "Repeat" }
+func (node *RepeatStmt) GetQueryType() string     { return QueryTypeOth }
+
+type LeaveStmt struct {
+	statementImpl
+	Label Identifier
+}
+
+func (node *LeaveStmt) Format(ctx *FmtCtx) {
+	ctx.WriteString("leave ")
+	ctx.WriteString(string(node.Label))
+}
+
+func (node *LeaveStmt) GetStatementType() string { return "Leave" }
+func (node *LeaveStmt) GetQueryType() string     { return QueryTypeOth }
+
+type IterateStmt struct {
+	statementImpl
+	Label Identifier
+}
+
+func (node *IterateStmt) Format(ctx *FmtCtx) {
+	ctx.WriteString("iterate ")
+	ctx.WriteString(string(node.Label))
+}
+
+func (node *IterateStmt) GetStatementType() string { return "Iterate" }
+func (node *IterateStmt) GetQueryType() string     { return QueryTypeOth }
+
+type DeclareCursor struct {
+	statementImpl
+	Name   Identifier
+	Select *Select
+}
+
+func (node *DeclareCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("declare ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" cursor for ")
+	node.Select.Format(ctx)
+}
+
+func (node *DeclareCursor) GetStatementType() string { return "Declare Cursor" }
+func (node *DeclareCursor) GetQueryType() string     { return QueryTypeOth }
+
+type OpenCursor struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *OpenCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("open ")
+	ctx.WriteString(string(node.Name))
+}
+
+func (node *OpenCursor) GetStatementType() string { return "Open Cursor" }
+func (node *OpenCursor) GetQueryType() string     { return QueryTypeOth }
+
+type FetchCursor struct {
+	statementImpl
+	Name Identifier
+	Vars []string
+}
+
+func (node *FetchCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("fetch ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" into ")
+	formatIntoVars(ctx, node.Vars)
+}
+
+func (node *FetchCursor) GetStatementType() string { return "Fetch Cursor" }
+func (node *FetchCursor) GetQueryType() string     { return QueryTypeOth }
+
+type CloseCursor struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *CloseCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("close ")
+	ctx.WriteString(string(node.Name))
+}
+
+func (node *CloseCursor) GetStatementType() string { return "Close Cursor" }
+func (node *CloseCursor) GetQueryType() string     { return QueryTypeOth }
+
+func formatIntoVars(ctx *FmtCtx, vars []string) {
+	for i, v := range vars {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteString(v)
+	}
+}
+
+type HandlerAction int
+
+const (
+	HANDLER_CONTINUE HandlerAction = iota
+	HANDLER_EXIT
+)
+
+type HandlerConditionType int
+
+const (
+	HANDLER_SQLSTATE HandlerConditionType = iota
+	HANDLER_ERROR_CODE
+	HANDLER_SQLEXCEPTION
+	HANDLER_SQLWARNING
+	HANDLER_NOT_FOUND
+)
+
+type HandlerCondition struct {
+	Type HandlerConditionType
+	// SqlState is the value of SQLSTATE 'value'.
+	SqlState string
+	// ErrorCode is the MySQL error code.
+	ErrorCode uint16
+}
+
+func (node *HandlerCondition) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case HANDLER_SQLSTATE:
+		ctx.WriteString("sqlstate '")
+		ctx.WriteString(node.SqlState)
+		ctx.WriteByte('\'')
+	case HANDLER_ERROR_CODE:
+		ctx.WriteString(strconv.Itoa(int(node.ErrorCode)))
+	case HANDLER_SQLEXCEPTION:
+		ctx.WriteString("sqlexception")
+	case HANDLER_SQLWARNING:
+		ctx.WriteString("sqlwarning")
+	case HANDLER_NOT_FOUND:
+		ctx.WriteString("not found")
+	}
+}
+
+type DeclareHandler struct {
+	statementImpl
+	Action     HandlerAction
+	Conditions []*HandlerCondition
+	Stmt       Statement
+}
+
+func (node *DeclareHandler) Format(ctx *FmtCtx) {
+	ctx.WriteString("declare ")
+	if node.Action == HANDLER_EXIT {
+		ctx.WriteString("exit")
+	} else {
+		ctx.WriteString("continue")
+	}
+	ctx.WriteString(" handler for ")
+	for i, cond := range node.Conditions {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		cond.Format(ctx)
+	}
+	ctx.WriteByte(' ')
+	node.Stmt.Format(ctx)
+}
+
+func (node *DeclareHandler) GetStatementType() string { return "Declare Handler" }
+func (node *DeclareHandler) GetQueryType() string     { return QueryTypeOth }
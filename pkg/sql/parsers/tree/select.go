@@ -200,10 +200,18 @@ type SelectClause struct {
 	GroupBy  GroupBy
 	Having   *Where
 	Option   string
+	// Hints are the optimizer hints in /*+ ... */ after SELECT.
+	Hints OptimizerHints
+	// IntoVars are the variables of SELECT ... INTO var_list in stored procedures.
+	IntoVars []string
 }
 
 func (node *SelectClause) Format(ctx *FmtCtx) {
 	ctx.WriteString("select ")
+	if len(node.Hints) > 0 && !ctx.fingerprint {
+		node.Hints.Format(ctx)
+		ctx.WriteByte(' ')
+	}
 	if node.Distinct {
 		ctx.WriteString("distinct ")
 	}
@@ -212,6 +220,10 @@ func (node *SelectClause) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 	}
 	node.Exprs.Format(ctx)
+	if len(node.IntoVars) > 0 {
+		ctx.WriteString(" into ")
+		formatIntoVars(ctx, node.IntoVars)
+	}
 	if len(node.From.Tables) > 0 {
 		canFrom := true
 		als, ok := node.From.Tables[0].(*AliasedTableExpr)
@@ -305,6 +317,57 @@ func (node *GroupBy) Format(ctx *FmtCtx) {
 	}
 }
 
+// RollupExpr is ROLLUP(a, b, ...) in GROUP BY, or GROUP BY a, b, ... WITH ROLLUP.
+// It groups by (a, b, ...), (a, ...), ... and ().
+type RollupExpr struct {
+	exprImpl
+	Exprs Exprs
+	// WithRollup is true for GROUP BY ... WITH ROLLUP.
+	WithRollup bool
+}
+
+func (node *RollupExpr) Format(ctx *FmtCtx) {
+	if node.WithRollup {
+		node.Exprs.Format(ctx)
+		ctx.WriteString(" with rollup")
+		return
+	}
+	ctx.WriteString("rollup(")
+	node.Exprs.Format(ctx)
+	ctx.WriteByte(')')
+}
+
+// CubeExpr is CUBE(a, b, ...) in GROUP BY, it groups by all the subsets of (a, b, ...).
+type CubeExpr struct {
+	exprImpl
+	Exprs Exprs
+}
+
+func (node *CubeExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("cube(")
+	node.Exprs.Format(ctx)
+	ctx.WriteByte(')')
+}
+
+// GroupingSetsExpr is GROUPING SETS((a, b), (a), ...) in GROUP BY.
+type GroupingSetsExpr struct {
+	exprImpl
+	Sets []Exprs
+}
+
+func (node *GroupingSetsExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("grouping sets (")
+	for i, set := range node.Sets {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteByte('(')
+		set.Format(ctx)
+		ctx.WriteByte(')')
+	}
+	ctx.WriteByte(')')
+}
+
 const (
 	JOIN_TYPE_FULL          = "FULL"
 	JOIN_TYPE_LEFT          = "LEFT"
@@ -453,10 +516,16 @@ type AliasedTableExpr struct {
 	Expr       TableExpr
 	As         AliasClause
 	IndexHints []*IndexHint
+	// AsOf reads the table at the snapshot of the past
+	AsOf *AsOfClause
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 	node.Expr.Format(ctx)
+	if node.AsOf != nil {
+		ctx.WriteByte(' ')
+		node.AsOf.Format(ctx)
+	}
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
 		node.As.Format(ctx)
@@ -471,6 +540,30 @@ func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 	}
 }
 
+type AsOfType int
+
+const (
+	// AsOfTimestamp is the time in the session time zone, AS OF TIMESTAMP '2023-03-01 10:00:00'
+	AsOfTimestamp AsOfType = iota
+	// AsOfTS is the timestamp of the txn, AS OF TS '1679000000000000000-1'
+	AsOfTS
+)
+
+// AsOfClause is the time travel clause of the table reference
+type AsOfClause struct {
+	Type AsOfType
+	Expr Expr
+}
+
+func (node *AsOfClause) Format(ctx *FmtCtx) {
+	if node.Type == AsOfTS {
+		ctx.WriteString("as of ts ")
+	} else {
+		ctx.WriteString("as of timestamp ")
+	}
+	node.Expr.Format(ctx)
+}
+
 func NewAliasedTableExpr(e TableExpr, a AliasClause) *AliasedTableExpr {
 	return &AliasedTableExpr{
 		Expr: e,
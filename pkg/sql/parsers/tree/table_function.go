@@ -18,9 +18,15 @@ type TableFunction struct {
 	statementImpl
 	Func       *FuncExpr
 	SelectStmt *Select
+	// JsonTable is the row path and the columns of JSON_TABLE
+	JsonTable *JsonTableDef
 }
 
 func (t *TableFunction) Format(ctx *FmtCtx) {
+	if t.JsonTable != nil {
+		t.formatJsonTable(ctx)
+		return
+	}
 	if t.Func != nil {
 		t.Func.Format(ctx)
 	}
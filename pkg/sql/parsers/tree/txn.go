@@ -14,6 +14,8 @@
 
 package tree
 
+import "strings"
+
 // the read and write mode for a transaction.
 type ReadWriteMode int
 
@@ -23,12 +25,46 @@ const (
 	READ_WRITE_MODE_READ_WRITE
 )
 
+// the isolation level for a transaction.
+type IsolationLevel int
+
+const (
+	ISOLATION_LEVEL_NONE IsolationLevel = iota
+	ISOLATION_LEVEL_READ_UNCOMMITTED
+	ISOLATION_LEVEL_READ_COMMITTED
+	ISOLATION_LEVEL_REPEATABLE_READ
+	ISOLATION_LEVEL_SERIALIZABLE
+)
+
+// String returns the level in the format of the transaction_isolation variable
+func (level IsolationLevel) String() string {
+	switch level {
+	case ISOLATION_LEVEL_READ_UNCOMMITTED:
+		return "READ-UNCOMMITTED"
+	case ISOLATION_LEVEL_READ_COMMITTED:
+		return "READ-COMMITTED"
+	case ISOLATION_LEVEL_REPEATABLE_READ:
+		return "REPEATABLE-READ"
+	case ISOLATION_LEVEL_SERIALIZABLE:
+		return "SERIALIZABLE"
+	}
+	return ""
+}
+
 // modes for a transaction
 type TransactionModes struct {
-	RwMode ReadWriteMode
+	RwMode    ReadWriteMode
+	Isolation IsolationLevel
 }
 
 func (node *TransactionModes) Format(ctx *FmtCtx) {
+	if node.Isolation != ISOLATION_LEVEL_NONE {
+		ctx.WriteString("isolation level ")
+		ctx.WriteString(strings.ToLower(strings.ReplaceAll(node.Isolation.String(), "-", " ")))
+		if node.RwMode != READ_WRITE_MODE_NONE {
+			ctx.WriteString(", ")
+		}
+	}
 	switch node.RwMode {
 	case READ_WRITE_MODE_READ_ONLY:
 		ctx.WriteString("read only")
@@ -37,6 +73,21 @@ func (node *TransactionModes) Format(ctx *FmtCtx) {
 	}
 }
 
+func (node *TransactionModes) IsEmpty() bool {
+	return node.RwMode == READ_WRITE_MODE_NONE && node.Isolation == ISOLATION_LEVEL_NONE
+}
+
+// Merge overrides the modes with the modes set in the other
+func (node TransactionModes) Merge(other TransactionModes) TransactionModes {
+	if other.RwMode != READ_WRITE_MODE_NONE {
+		node.RwMode = other.RwMode
+	}
+	if other.Isolation != ISOLATION_LEVEL_NONE {
+		node.Isolation = other.Isolation
+	}
+	return node
+}
+
 func MakeTransactionModes(rwm ReadWriteMode) TransactionModes {
 	return TransactionModes{RwMode: rwm}
 }
@@ -49,7 +100,7 @@ type BeginTransaction struct {
 
 func (node *BeginTransaction) Format(ctx *FmtCtx) {
 	ctx.WriteString("start transaction")
-	if node.Modes.RwMode != READ_WRITE_MODE_NONE {
+	if !node.Modes.IsEmpty() {
 		ctx.WriteByte(' ')
 		node.Modes.Format(ctx)
 	}
@@ -62,6 +113,30 @@ func NewBeginTransaction(m TransactionModes) *BeginTransaction {
 	return &BeginTransaction{Modes: m}
 }
 
+// SetTransaction statement
+type SetTransaction struct {
+	statementImpl
+	// Global and Session is the scope of the modes, the modes only apply to
+	// the next transaction of the session without them.
+	Global  bool
+	Session bool
+	Modes   TransactionModes
+}
+
+func (node *SetTransaction) Format(ctx *FmtCtx) {
+	ctx.WriteString("set ")
+	if node.Global {
+		ctx.WriteString("global ")
+	} else if node.Session {
+		ctx.WriteString("session ")
+	}
+	ctx.WriteString("transaction ")
+	node.Modes.Format(ctx)
+}
+
+func (node *SetTransaction) GetStatementType() string { return "Set Transaction" }
+func (node *SetTransaction) GetQueryType() string     { return QueryTypeTCL }
+
 type CompletionType int
 
 const (
@@ -103,3 +178,45 @@ func (node *RollbackTransaction) GetQueryType() string     { return QueryTypeTCL
 func NewRollbackTransaction(t CompletionType) *RollbackTransaction {
 	return &RollbackTransaction{Type: t}
 }
+
+// SavePoint statement
+type SavePoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *SavePoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *SavePoint) GetStatementType() string { return "Savepoint" }
+func (node *SavePoint) GetQueryType() string     { return QueryTypeTCL }
+
+// RollbackToSavePoint statement
+type RollbackToSavePoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *RollbackToSavePoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("rollback to savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *RollbackToSavePoint) GetStatementType() string { return "Rollback To Savepoint" }
+func (node *RollbackToSavePoint) GetQueryType() string     { return QueryTypeTCL }
+
+// ReleaseSavePoint statement
+type ReleaseSavePoint struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *ReleaseSavePoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("release savepoint ")
+	node.Name.Format(ctx)
+}
+
+func (node *ReleaseSavePoint) GetStatementType() string { return "Release Savepoint" }
+func (node *ReleaseSavePoint) GetQueryType() string     { return QueryTypeTCL }
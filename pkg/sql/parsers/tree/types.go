@@ -141,6 +141,16 @@ func (node *InternalType) Format(ctx *FmtCtx) {
 
 	switch fs {
 	case "set", "enum":
+		ctx.WriteByte('(')
+		for i, v := range node.EnumValues {
+			if i > 0 {
+				ctx.WriteString(", ")
+			}
+			ctx.WriteByte('\'')
+			ctx.WriteString(strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v))
+			ctx.WriteByte('\'')
+		}
+		ctx.WriteByte(')')
 	case "char":
 		if node.DisplayWith >= 0 {
 			ctx.WriteByte('(')
@@ -93,6 +93,9 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 		expr, err = b.impl.BindColRef(exprImpl, depth, isRoot)
 
 	case *tree.CastExpr:
+		if exprImpl.Array {
+			return nil, moerr.NewNotSupported(b.GetContext(), "CAST(... AS ... ARRAY) outside of the multi-valued index")
+		}
 		expr, err = b.impl.BindExpr(exprImpl.Expr, depth, false)
 		if err != nil {
 			return
@@ -104,6 +107,9 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 		}
 		expr, err = appendCastBeforeExpr(b.GetContext(), expr, typ)
 
+	case *tree.FullTextMatchExpr:
+		expr, err = b.bindFullTextMatchExpr(exprImpl, depth)
+
 	case *tree.IsNullExpr:
 		expr, err = b.bindFuncExprImplByAstExpr("isnull", []tree.Expr{exprImpl.Expr}, depth)
 
@@ -255,6 +261,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	relPos := NotFound
 	colPos := NotFound
 	var typ *plan.Type
+	var colBinding *Binding
 	localErrCtx := errutil.ContextWithNoReport(b.GetContext(), true)
 
 	if len(table) == 0 {
@@ -264,6 +271,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 				colPos = binding.colIdByName[col]
 				typ = binding.types[colPos]
 				table = binding.table
+				colBinding = binding
 			} else {
 				return nil, moerr.NewInvalidInput(b.GetContext(), "ambiguous column reference '%v'", name)
 			}
@@ -279,6 +287,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 			if colPos != NotFound {
 				typ = binding.types[colPos]
 				relPos = binding.tag
+				colBinding = binding
 			} else {
 				err = moerr.NewInvalidInput(localErrCtx, "column '%s' does not exist", name)
 			}
@@ -290,6 +299,12 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	if colPos != NotFound {
 		b.boundCols = append(b.boundCols, table+"."+col)
 
+		// the virtual generated column is not stored, compute it from the
+		// columns it depends on
+		if expr, err = b.bindVirtualColumn(colBinding, colPos, depth); expr != nil || err != nil {
+			return
+		}
+
 		expr = &plan.Expr{
 			Typ: typ,
 		}
@@ -337,6 +352,95 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	return
 }
 
+// bindVirtualColumn returns the expression of the virtual generated column
+// bound to the columns of the same table, or nil if the column is not one.
+func (b *baseBinder) bindVirtualColumn(binding *Binding, colPos int32, depth int32) (*Expr, error) {
+	if b.builder == nil || binding == nil {
+		return nil, nil
+	}
+	node := b.builder.qry.Nodes[binding.nodeId]
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil || int(colPos) >= len(node.TableDef.Cols) {
+		return nil, nil
+	}
+	generated := node.TableDef.Cols[colPos].Generated
+	if generated == nil || generated.Stored {
+		return nil, nil
+	}
+	name2pos := make(map[string]int32, len(node.TableDef.Cols))
+	for i, col := range node.TableDef.Cols {
+		name2pos[col.Name] = int32(i)
+	}
+	return bindGeneratedColRefs(b.GetContext(), DeepCopyExpr(generated.Expr), binding.tag, name2pos, depth)
+}
+
+// bindFullTextMatchExpr binds MATCH (col1, col2, ...) AGAINST (search_string search_modifier)
+// to match_against(search_string, search_mode, parser, doc_count, doc_freqs, col1, col2, ...).
+func (b *baseBinder) bindFullTextMatchExpr(astExpr *tree.FullTextMatchExpr, depth int32) (*Expr, error) {
+	if b.builder == nil || b.ctx == nil {
+		return nil, moerr.NewNotSupported(b.GetContext(), "MATCH ... AGAINST in this context")
+	}
+	switch astExpr.Pattern.(type) {
+	case *tree.NumVal, *tree.ParamExpr, *tree.VarExpr:
+	default:
+		return nil, moerr.NewInvalidInput(b.GetContext(), "incorrect arguments to AGAINST")
+	}
+	mode, err := getFullTextSearchMode(b.GetContext(), astExpr.Mode)
+	if err != nil {
+		return nil, err
+	}
+	_, node, indexDef, err := findFullTextIndex(b.builder, b.ctx, astExpr.KeyParts)
+	if err != nil {
+		return nil, err
+	}
+	docCount, docFreqs, err := fullTextDocFreqExprs(b.builder, node, indexDef, astExpr.Pattern, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []tree.Expr{
+		astExpr.Pattern,
+		tree.NewNumValWithType(constant.MakeInt64(int64(mode)), strconv.FormatInt(int64(mode), 10), false, tree.P_int64),
+		tree.NewNumValWithType(constant.MakeString(indexDef.Parser), indexDef.Parser, false, tree.P_char),
+		docCount,
+		docFreqs,
+	}
+	for _, keyPart := range astExpr.KeyParts {
+		args = append(args, keyPart)
+	}
+	return b.bindFuncExprImplByAstExpr("match_against", args, depth)
+}
+
+func bindGeneratedColRefs(ctx context.Context, expr *Expr, tag int32, name2pos map[string]int32, depth int32) (*Expr, error) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		colPos, ok := name2pos[exprImpl.Col.Name]
+		if !ok {
+			return nil, moerr.NewInternalError(ctx, "column '%s' of generated column not found", exprImpl.Col.Name)
+		}
+		if depth == 0 {
+			exprImpl.Col.RelPos = tag
+			exprImpl.Col.ColPos = colPos
+		} else {
+			expr.Expr = &plan.Expr_Corr{
+				Corr: &plan.CorrColRef{
+					RelPos: tag,
+					ColPos: colPos,
+					Depth:  depth,
+				},
+			}
+		}
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			newArg, err := bindGeneratedColRefs(ctx, arg, tag, name2pos, depth)
+			if err != nil {
+				return nil, err
+			}
+			exprImpl.F.Args[i] = newArg
+		}
+	}
+	return expr, nil
+}
+
 func (b *baseBinder) baseBindSubquery(astExpr *tree.Subquery, isRoot bool) (*Expr, error) {
 	if b.ctx == nil {
 		return nil, moerr.NewInvalidInput(b.GetContext(), "field reference doesn't support SUBQUERY")
@@ -593,6 +697,8 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 		op = "reg_match"
 	case tree.NOT_REG_MATCH:
 		op = "not_reg_match"
+	case tree.MEMBER_OF:
+		op = "member_of"
 	default:
 		return nil, moerr.NewNYI(b.GetContext(), "'%v'", astExpr)
 	}
@@ -650,10 +756,14 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 	}
 	funcName := funcRef.Parts[0]
 
-	if function.GetFunctionIsAggregateByName(funcName) {
-		return b.impl.BindAggFunc(funcName, astExpr, depth, isRoot)
-	} else if function.GetFunctionIsWinfunByName(funcName) {
+	if funcName == "grouping" {
+		return b.bindGroupingFunc(astExpr)
+	}
+
+	if astExpr.WindowSpec != nil || function.GetFunctionIsWinfunByName(funcName) {
 		return b.impl.BindWinFunc(funcName, astExpr, depth, isRoot)
+	} else if function.GetFunctionIsAggregateByName(funcName) {
+		return b.impl.BindAggFunc(funcName, astExpr, depth, isRoot)
 	}
 
 	return b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
@@ -710,6 +820,24 @@ func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr,
 		}
 	case "trim":
 		astArgs = astArgs[1:]
+	case "json_arrayagg":
+		// rewrite 'json_arrayagg(expr)' to the aggregate over 'json_array(expr)'
+		if len(astArgs) != 1 {
+			return nil, moerr.NewInvalidArg(b.GetContext(), "json_arrayagg function need one arg", len(astArgs))
+		}
+		astArgs = []tree.Expr{&tree.FuncExpr{
+			Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_array")),
+			Exprs: astArgs,
+		}}
+	case "json_objectagg":
+		// rewrite 'json_objectagg(key, value)' to the aggregate over 'json_object(key, value)'
+		if len(astArgs) != 2 {
+			return nil, moerr.NewInvalidArg(b.GetContext(), "json_objectagg function need two args", len(astArgs))
+		}
+		astArgs = []tree.Expr{&tree.FuncExpr{
+			Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_object")),
+			Exprs: astArgs,
+		}}
 	}
 	// bind ast function's args
 	args := make([]*Expr, len(astArgs))
@@ -805,6 +933,15 @@ func bindFuncExprAndConstFold(ctx context.Context, proc *process.Process, name s
 func bindFuncExprImplByPlanExpr(ctx context.Context, name string, args []*Expr) (*plan.Expr, error) {
 	var err error
 
+	// the values of ENUM and SET take part in the functions as their members
+	for i := range args {
+		if isEnumPlan2Type(args[i].Typ) {
+			if args[i], err = makeEnumToStringExpr(ctx, args[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// deal with some special function
 	switch name {
 	case "date":
@@ -895,6 +1032,12 @@ func bindFuncExprImplByPlanExpr(ctx context.Context, name string, args []*Expr)
 		} else if args[0].Typ.Id == int32(types.T_interval) && args[1].Typ.Id == int32(types.T_datetime) {
 			name = "date_add"
 			args, err = resetDateFunctionArgs(ctx, args[1], args[0])
+		} else if args[0].Typ.Id == int32(types.T_timestamp) && args[1].Typ.Id == int32(types.T_interval) {
+			name = "date_add"
+			args, err = resetDateFunctionArgs(ctx, args[0], args[1])
+		} else if args[0].Typ.Id == int32(types.T_interval) && args[1].Typ.Id == int32(types.T_timestamp) {
+			name = "date_add"
+			args, err = resetDateFunctionArgs(ctx, args[1], args[0])
 		} else if args[0].Typ.Id == int32(types.T_varchar) && args[1].Typ.Id == int32(types.T_interval) {
 			name = "date_add"
 			args, err = resetDateFunctionArgs(ctx, args[0], args[1])
@@ -924,6 +1067,9 @@ func bindFuncExprImplByPlanExpr(ctx context.Context, name string, args []*Expr)
 		} else if args[0].Typ.Id == int32(types.T_datetime) && args[1].Typ.Id == int32(types.T_interval) {
 			name = "date_sub"
 			args, err = resetDateFunctionArgs(ctx, args[0], args[1])
+		} else if args[0].Typ.Id == int32(types.T_timestamp) && args[1].Typ.Id == int32(types.T_interval) {
+			name = "date_sub"
+			args, err = resetDateFunctionArgs(ctx, args[0], args[1])
 		} else if args[0].Typ.Id == int32(types.T_varchar) && args[1].Typ.Id == int32(types.T_interval) {
 			name = "date_sub"
 			args, err = resetDateFunctionArgs(ctx, args[0], args[1])
@@ -1348,6 +1494,9 @@ func appendCastBeforeExpr(ctx context.Context, expr *Expr, toType *Type, isBin .
 	if expr.Typ.Id == int32(types.T_any) {
 		return expr, nil
 	}
+	if isEnumPlan2Type(toType) || isEnumPlan2Type(expr.Typ) {
+		return makePlan2CastExpr(ctx, expr, toType)
+	}
 	toType.NotNullable = expr.Typ.NotNullable
 	argsType := []types.Type{
 		makeTypeByPlan2Expr(expr),
@@ -27,6 +27,7 @@ func NewBindContext(builder *QueryBuilder, parent *BindContext) *BindContext {
 	bc := &BindContext{
 		groupByAst:     make(map[string]int32),
 		aggregateByAst: make(map[string]int32),
+		windowByAst:    make(map[string]int32),
 		projectByExpr:  make(map[string]int32),
 		aliasMap:       make(map[string]int32),
 		bindingByTag:   make(map[int32]*Binding),
@@ -308,6 +309,22 @@ func (bc *BindContext) qualifyColumnNames(astExpr tree.Expr, selectList tree.Sel
 			}
 		}
 
+		if exprImpl.WindowSpec != nil {
+			for i := range exprImpl.WindowSpec.PartitionBy {
+				exprImpl.WindowSpec.PartitionBy[i], err = bc.qualifyColumnNames(exprImpl.WindowSpec.PartitionBy[i], selectList, expandAlias)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			for _, order := range exprImpl.WindowSpec.OrderBy {
+				order.Expr, err = bc.qualifyColumnNames(order.Expr, selectList, expandAlias)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
 	case *tree.RangeCond:
 		exprImpl.Left, err = bc.qualifyColumnNames(exprImpl.Left, selectList, expandAlias)
 		if err != nil {
@@ -344,6 +361,14 @@ func (bc *BindContext) qualifyColumnNames(astExpr tree.Expr, selectList tree.Sel
 	case *tree.CastExpr:
 		exprImpl.Expr, err = bc.qualifyColumnNames(exprImpl.Expr, selectList, expandAlias)
 
+	case *tree.FullTextMatchExpr:
+		for _, keyPart := range exprImpl.KeyParts {
+			if _, err = bc.qualifyColumnNames(keyPart, nil, false); err != nil {
+				return nil, err
+			}
+		}
+		exprImpl.Pattern, err = bc.qualifyColumnNames(exprImpl.Pattern, selectList, expandAlias)
+
 	case *tree.IsNullExpr:
 		exprImpl.Expr, err = bc.qualifyColumnNames(exprImpl.Expr, selectList, expandAlias)
 
@@ -155,6 +155,13 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 		}
 	}
 
+	for alias, columns := range usedTbl {
+		tblDef := tblInfo.tableDefs[tblInfo.alias[alias]]
+		if err = appendGeneratedUpdateKeys(ctx.GetContext(), tblDef, columns); err != nil {
+			return nil, err
+		}
+	}
+
 	// remove unused table
 	newTblInfo := &dmlTableInfo{
 		nameToIdx: make(map[string]int),
@@ -177,7 +184,7 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 				newTblInfo.haveConstraint = true
 			} else {
 				for _, indexdef := range tblDef.Indexes {
-					if indexdef.Unique {
+					if indexdef.Unique || util.IsSecondaryIndexTable(indexdef) {
 						newTblInfo.haveConstraint = true
 						break
 					}
@@ -194,6 +201,33 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 	return newTblInfo, nil
 }
 
+// appendGeneratedUpdateKeys checks that the generated columns are not set to a value,
+// and appends the stored generated columns which depend on the updated columns
+// to the update keys, they are recomputed with their indexes in the update pipeline.
+func appendGeneratedUpdateKeys(ctx context.Context, tableDef *TableDef, updateKeys map[string]tree.Expr) error {
+	for _, col := range tableDef.Cols {
+		if col.Generated == nil {
+			continue
+		}
+		if expr, ok := updateKeys[col.Name]; ok {
+			if _, isDefault := expr.(*tree.DefaultVal); !isDefault {
+				return moerr.NewInvalidInput(ctx, "the value specified for generated column '%s' in table '%s' is not allowed", col.Name, tableDef.Name)
+			}
+			continue
+		}
+		if !col.Generated.Stored {
+			continue
+		}
+		for name := range updateKeys {
+			if exprRefersToColumn(col.Generated.Expr, name) {
+				updateKeys[col.Name] = &tree.DefaultVal{}
+				break
+			}
+		}
+	}
+	return nil
+}
+
 func setTableExprToDmlTableInfo(ctx CompilerContext, tbl tree.TableExpr, tblInfo *dmlTableInfo, aliasMap map[string][2]string, withMap map[string]struct{}) error {
 	var tblName, dbName, alias string
 
@@ -264,7 +298,7 @@ func setTableExprToDmlTableInfo(ctx CompilerContext, tbl tree.TableExpr, tblInfo
 			tblInfo.haveConstraint = true
 		} else {
 			for _, indexdef := range tableDef.Indexes {
-				if indexdef.Unique {
+				if indexdef.Unique || util.IsSecondaryIndexTable(indexdef) {
 					tblInfo.haveConstraint = true
 					break
 				}
@@ -419,10 +453,21 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 			if _, ok := colToIdx[colName]; !ok {
 				return moerr.NewBadFieldError(builder.GetContext(), colName, tableDef.Name)
 			}
+			if tableDef.Cols[colToIdx[colName]].Generated != nil {
+				return moerr.NewInvalidInput(builder.GetContext(), "the value specified for generated column '%s' in table '%s' is not allowed", colName, tableDef.Name)
+			}
 			insertColumns = append(insertColumns, colName)
 		}
 	}
 
+	if _, ok := stmt.Rows.Select.(*tree.ValuesClause); !ok && !syntaxHasColumnNames {
+		for _, col := range tableDef.Cols {
+			if col.Generated != nil {
+				return moerr.NewInvalidInput(builder.GetContext(), "the value specified for generated column '%s' in table '%s' is not allowed", col.Name, tableDef.Name)
+			}
+		}
+	}
+
 	var astSlt *tree.Select
 	switch slt := stmt.Rows.Select.(type) {
 	// rewrite 'insert into tbl values (1,1)' to 'insert into tbl select * from (values row(1,1))'
@@ -443,6 +488,12 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 				if len(row) != colCount {
 					return moerr.NewWrongValueCountOnRow(builder.GetContext(), j+1)
 				}
+				// only DEFAULT can be given to the generated columns
+				for i, colName := range insertColumns {
+					if _, ok := row[i].(*tree.DefaultVal); !ok && tableDef.Cols[colToIdx[colName]].Generated != nil {
+						return moerr.NewInvalidInput(builder.GetContext(), "the value specified for generated column '%s' in table '%s' is not allowed", colName, tableDef.Name)
+					}
+				}
 			}
 		}
 
@@ -565,6 +616,11 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 	// rewrite to : select _t.*, t1.a, t1.b，t1.c, t1.row_id from
 	//				(select * from values (1,1,3),(2,2,3)) _t(a,b,c) left join t1 on _t.a=t1.a or _t.b=t1.b
 	if len(stmt.OnDuplicateUpdate) > 0 {
+		for _, col := range tableDef.Cols {
+			if col.Generated != nil {
+				return moerr.NewNotSupported(builder.GetContext(), "INSERT ... ON DUPLICATE KEY UPDATE for table with generated column '%s'", col.Name)
+			}
+		}
 
 		rightTableDef := DeepCopyTableDef(tableDef)
 		rightObjRef := DeepCopyObjectRef(tableObjRef)
@@ -828,6 +884,10 @@ func initDeleteStmt(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelect
 }
 
 func checkNotNull(ctx context.Context, expr *Expr, tableDef *TableDef, col *ColDef) error {
+	// the generated column is computed after the projection
+	if col.Generated != nil {
+		return nil
+	}
 	isConstantNull := false
 	if ef, ok := expr.Expr.(*plan.Expr_C); ok {
 		isConstantNull = ef.C.Isnull
@@ -861,6 +921,9 @@ func forceCastExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr, er
 	if targetType.Id == 0 {
 		return expr, nil
 	}
+	if isEnumPlan2Type(targetType) || isEnumPlan2Type(expr.Typ) {
+		return makePlan2CastExpr(ctx, expr, targetType)
+	}
 	t1, t2 := makeTypeByPlan2Expr(expr), makeTypeByPlan2Type(targetType)
 	if t1.Eq(t2) {
 		return expr, nil
@@ -976,6 +1039,107 @@ func initUpdateStmt(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelect
 	return nil
 }
 
+// rewriteDmlSecondaryIndex joins the rows to delete or update with the rows of the secondary index table
+// on the primary key, the rowids of the index rows are projected for deletion, the new index
+// rows of the updated rows are written again by the update operator.
+func rewriteDmlSecondaryIndex(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelectInfo, tableDef *TableDef,
+	indexdef *IndexDef, baseNodeId int32, oldColPosMap map[string]int, typMap map[string]*plan.Type) error {
+	var pkParts []string
+	if tableDef.CompositePkey != nil {
+		pkParts = tableDef.Pkey.Names
+	} else if pkName := getTablePriKeyName(tableDef.Pkey); pkName != "" {
+		pkParts = []string{pkName}
+	}
+	if len(pkParts) == 0 {
+		// the index table is written only for the table with primary key
+		return nil
+	}
+	for _, part := range pkParts {
+		if _, ok := oldColPosMap[part]; !ok {
+			return nil
+		}
+	}
+
+	idxRef := &plan.ObjectRef{
+		SchemaName: builder.compCtx.DefaultDatabase(),
+		ObjName:    indexdef.IndexTableName,
+	}
+
+	joinCtx := NewBindContext(builder, bindCtx)
+	rightCtx := NewBindContext(builder, joinCtx)
+	astTblName := tree.NewTableName(tree.Identifier(indexdef.IndexTableName), tree.ObjectNamePrefix{})
+	rightId, err := builder.buildTable(astTblName, rightCtx)
+	if err != nil {
+		return err
+	}
+	rightTag := builder.qry.Nodes[rightId].BindingTags[0]
+	baseTag := builder.qry.Nodes[baseNodeId].BindingTags[0]
+	rightTableDef := builder.qry.Nodes[rightId].TableDef
+	rightRowIdPos := int32(len(rightTableDef.Cols)) - 1
+	rightPkPos := rightTableDef.Name2ColIndex[catalog.IndexTablePrimaryColName]
+
+	info.projectList = append(info.projectList, &plan.Expr{
+		Typ: rightTableDef.Cols[rightRowIdPos].Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: rightTag,
+				ColPos: rightRowIdPos,
+			},
+		},
+	})
+
+	rightExpr := &plan.Expr{
+		Typ: rightTableDef.Cols[rightPkPos].Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: rightTag,
+				ColPos: rightPkPos,
+			},
+		},
+	}
+	args := make([]*Expr, len(pkParts))
+	for i, column := range pkParts {
+		args[i] = &plan.Expr{
+			Typ: typMap[column],
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: baseTag,
+					ColPos: int32(oldColPosMap[column]),
+				},
+			},
+		}
+	}
+	leftExpr := args[0]
+	if len(args) > 1 {
+		leftExpr, err = bindFuncExprImplByPlanExpr(builder.GetContext(), "serial", args)
+		if err != nil {
+			return err
+		}
+	}
+	condExpr, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{leftExpr, rightExpr})
+	if err != nil {
+		return err
+	}
+
+	leftCtx := builder.ctxByNode[info.rootId]
+	err = joinCtx.mergeContexts(builder.GetContext(), leftCtx, rightCtx)
+	if err != nil {
+		return err
+	}
+	newRootId := builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{info.rootId, rightId},
+		JoinType: plan.Node_LEFT,
+		OnList:   []*Expr{condExpr},
+	}, joinCtx)
+	bindCtx.binder = NewTableBinder(builder, bindCtx)
+	info.rootId = newRootId
+	info.onIdxTbl = append(info.onIdxTbl, idxRef)
+	info.onIdx = append(info.onIdx, info.idx)
+	info.idx = info.idx + 1
+	return nil
+}
+
 func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelectInfo, tableDef *TableDef, baseNodeId int32, rewriteIdx int) error {
 	// posMap := make(map[string]int32)
 	typMap := make(map[string]*plan.Type)
@@ -1120,6 +1284,18 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 		}
 	}
 
+	// rewrite secondary, fulltext and functional index, to get rows of index table to delete
+	if info.typ != "insert" {
+		for _, indexdef := range tableDef.Indexes {
+			if util.IsSecondaryIndexTable(indexdef) {
+				err := rewriteDmlSecondaryIndex(builder, bindCtx, info, tableDef, indexdef, baseNodeId, oldColPosMap, typMap)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	// check child table
 	if info.typ != "insert" {
 		for _, tableId := range tableDef.RefChildTbls {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -30,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/operator"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/fulltext"
 )
 
 func genViewTableDef(ctx CompilerContext, stmt *tree.Select) (*plan.TableDef, error) {
@@ -337,10 +339,12 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionCompression:
+			// the compression of the table is set to its columns by buildTableDefs
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
 		// 	*tree.TableOptionCollate, *tree.TableOptionAutoIncrement, *tree.TableOptionComment,
-		// 	*tree.TableOptionAvgRowLength, *tree.TableOptionChecksum, *tree.TableOptionCompression,
+		// 	*tree.TableOptionAvgRowLength, *tree.TableOptionChecksum,
 		// 	*tree.TableOptionConnection, *tree.TableOptionPassword, *tree.TableOptionKeyBlockSize,
 		// 	*tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionDelayKeyWrite,
 		// 	*tree.TableOptionRowFormat, *tree.TableOptionStatsPersistent, *tree.TableOptionStatsAutoRecalc,
@@ -471,6 +475,20 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 	colMap := make(map[string]*ColDef)
 	uniqueIndexInfos := make([]*tree.UniqueIndex, 0)
 	secondaryIndexInfos := make([]*tree.Index, 0)
+	var fullTextIndexInfos []*tree.FullTextIndex
+	var fullTextParts []string
+	generatedDefs := make(map[string]*tree.AttributeGeneratedAlways)
+	var checkDefs []*checkConstraintDef
+	// the compression of the table is the default one of its columns
+	tableAlg, tableLevel := plan.CompressType_Lz4, int32(0)
+	for _, option := range stmt.Options {
+		if opt, ok := option.(*tree.TableOptionCompression); ok {
+			var err error
+			if tableAlg, tableLevel, err = getCompression(ctx.GetContext(), opt.Compression, 0); err != nil {
+				return err
+			}
+		}
+	}
 	for _, item := range stmt.Defs {
 		switch def := item.(type) {
 		case *tree.ColumnTableDef:
@@ -487,6 +505,8 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			var pks []string
 			var comment string
 			var auto_incr bool
+			var generated *tree.AttributeGeneratedAlways
+			alg, level := tableAlg, tableLevel
 			for _, attr := range def.Attributes {
 				switch attribute := attr.(type) {
 				case *tree.AttributePrimaryKey, *tree.AttributeKey:
@@ -520,6 +540,19 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 						Name: def.Name.Parts[0],
 					})
 					indexs = append(indexs, def.Name.Parts[0])
+				case *tree.AttributeGeneratedAlways:
+					generated = attribute
+				case *tree.AttributeCompression:
+					if alg, level, err = getCompression(ctx.GetContext(), attribute.Compression, attribute.Level); err != nil {
+						return err
+					}
+				case *tree.AttributeCheckConstraint:
+					checkDefs = append(checkDefs, &checkConstraintDef{
+						name:     attribute.Name,
+						expr:     attribute.Expr,
+						enforced: attribute.Enforced,
+						colName:  def.Name.Parts[0],
+					})
 				}
 			}
 			if len(pks) > 0 {
@@ -536,6 +569,12 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			if auto_incr && defaultValue.Expr != nil {
 				return moerr.NewInvalidInput(ctx.GetContext(), "invalid default value for '%s'", def.Name.Parts[0])
 			}
+			if generated != nil {
+				if err = checkGeneratedColDef(ctx.GetContext(), def.Name.Parts[0], auto_incr, defaultValue); err != nil {
+					return err
+				}
+				generatedDefs[def.Name.Parts[0]] = generated
+			}
 
 			onUpdateExpr, err := buildOnUpdate(def, colType, ctx.GetProcess())
 			if err != nil {
@@ -548,12 +587,13 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 
 			colType.AutoIncr = auto_incr
 			col := &ColDef{
-				Name:     def.Name.Parts[0],
-				Alg:      plan.CompressType_Lz4,
-				Typ:      colType,
-				Default:  defaultValue,
-				OnUpdate: onUpdateExpr,
-				Comment:  comment,
+				Name:          def.Name.Parts[0],
+				Alg:           alg,
+				CompressLevel: level,
+				Typ:           colType,
+				Default:       defaultValue,
+				OnUpdate:      onUpdateExpr,
+				Comment:       comment,
 			}
 			colMap[col.Name] = col
 			createTable.TableDef.Cols = append(createTable.TableDef.Cols, col)
@@ -561,6 +601,9 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			if len(primaryKeys) > 0 {
 				return moerr.NewInvalidInput(ctx.GetContext(), "more than one primary key defined")
 			}
+			if isFunctionalIndex(def.KeyParts) {
+				return moerr.NewNotSupported(ctx.GetContext(), "functional primary key")
+			}
 			pksMap := map[string]bool{}
 			for _, key := range def.KeyParts {
 				name := key.ColName.Parts[0] // name of primary key column
@@ -574,11 +617,18 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		case *tree.Index:
 			secondaryIndexInfos = append(secondaryIndexInfos, def)
 			for _, key := range def.KeyParts {
+				// the expression of a functional index is checked in buildJsonIndexTable
+				if key.ColName == nil {
+					continue
+				}
 				name := key.ColName.Parts[0]
 				indexs = append(indexs, name)
 			}
 
 		case *tree.UniqueIndex:
+			if isFunctionalIndex(def.KeyParts) {
+				return moerr.NewNotSupported(ctx.GetContext(), "functional unique index")
+			}
 			uniqueIndexInfos = append(uniqueIndexInfos, def)
 			for _, key := range def.KeyParts {
 				name := key.ColName.Parts[0]
@@ -594,14 +644,44 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			createTable.FkCols = append(createTable.FkCols, fkData.Cols)
 			createTable.TableDef.Fkeys = append(createTable.TableDef.Fkeys, fkData.Def)
 
-		case *tree.CheckIndex, *tree.FullTextIndex:
-			// unsupport in plan. will support in next version.
-			return moerr.NewNYI(ctx.GetContext(), "table def: '%v'", def)
+		case *tree.CheckIndex:
+			checkDefs = append(checkDefs, &checkConstraintDef{
+				name:     def.Name,
+				expr:     def.Expr,
+				enforced: def.Enforced,
+			})
+		case *tree.FullTextIndex:
+			if isFunctionalIndex(def.KeyParts) {
+				return moerr.NewNotSupported(ctx.GetContext(), "functional fulltext index")
+			}
+			fullTextIndexInfos = append(fullTextIndexInfos, def)
+			for _, key := range def.KeyParts {
+				fullTextParts = append(fullTextParts, key.ColName.Parts[0])
+			}
 		default:
 			return moerr.NewNYI(ctx.GetContext(), "table def: '%v'", def)
 		}
 	}
 
+	if len(generatedDefs) > 0 {
+		for _, names := range [][]string{indexs, primaryKeys, fullTextParts} {
+			for _, name := range names {
+				if def, ok := generatedDefs[name]; ok && !def.Stored {
+					return moerr.NewNotSupported(ctx.GetContext(), "index or primary key on virtual generated column '%s'", name)
+				}
+			}
+		}
+		if err := buildGeneratedColumns(ctx, createTable.TableDef, generatedDefs); err != nil {
+			return err
+		}
+	}
+
+	if len(checkDefs) > 0 {
+		if err := buildCheckConstraints(ctx, createTable.TableDef, checkDefs); err != nil {
+			return err
+		}
+	}
+
 	//add cluster table attribute
 	if stmt.IsClusterTable {
 		if _, ok := colMap[util.GetClusterTableAttributeName()]; ok {
@@ -760,6 +840,20 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		return err
 	}
 
+	// the functional indexes on json paths have index tables, the other secondary indexes do not
+	var jsonIndexInfos []*tree.Index
+	if len(secondaryIndexInfos) != 0 {
+		indexInfos := secondaryIndexInfos[:0:0]
+		for _, indexInfo := range secondaryIndexInfos {
+			if isFunctionalIndex(indexInfo.KeyParts) {
+				jsonIndexInfos = append(jsonIndexInfos, indexInfo)
+			} else {
+				indexInfos = append(indexInfos, indexInfo)
+			}
+		}
+		secondaryIndexInfos = indexInfos
+	}
+
 	// build index table
 	if len(uniqueIndexInfos) != 0 {
 		err = buildUniqueIndexTable(createTable, uniqueIndexInfos, colMap, pkeyName, ctx)
@@ -768,7 +862,19 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		}
 	}
 	if len(secondaryIndexInfos) != 0 {
-		err = buildSecondaryIndexDef(createTable, secondaryIndexInfos, colMap, ctx)
+		err = buildSecondaryIndexDef(createTable, secondaryIndexInfos, colMap, pkeyName, ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if len(fullTextIndexInfos) != 0 {
+		err = buildFullTextIndexTable(createTable, fullTextIndexInfos, colMap, pkeyName, ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if len(jsonIndexInfos) != 0 {
+		err = buildJsonIndexTable(createTable, jsonIndexInfos, colMap, pkeyName, ctx)
 		if err != nil {
 			return err
 		}
@@ -845,7 +951,9 @@ func setEmptyUniqueIndexName(namesMap map[string]bool, indexConstr *tree.UniqueI
 func setEmptyIndexName(namesMap map[string]bool, indexConstr *tree.Index) {
 	if indexConstr.Name == "" && len(indexConstr.KeyParts) > 0 {
 		var colName string
-		if colName == "" {
+		if indexConstr.KeyParts[0].ColName == nil {
+			colName = jsonIndexName
+		} else {
 			colName = indexConstr.KeyParts[0].ColName.Parts[0]
 		}
 		constrName := colName
@@ -983,7 +1091,11 @@ func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Uni
 	return nil
 }
 
-func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.Index, colMap map[string]*ColDef, ctx CompilerContext) error {
+// buildSecondaryIndexDef builds the KEY and INDEX secondary indexes. The index of a table with
+// primary key has an index table, which stores a row (key, primary key) for every row whose parts
+// are not null, the key is the part or serial(parts...) like the key of a unique index. The index
+// of a table without primary key has no index table.
+func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.Index, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
 	nameCount := make(map[string]int)
 
 	for _, indexInfo := range indexInfos {
@@ -1029,6 +1141,138 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 		} else {
 			indexDef.Comment = ""
 		}
+		if pkeyName != "" {
+			indexTableName, err := util.BuildIndexTableName(ctx.GetContext(), false)
+			if err != nil {
+				return err
+			}
+			keyTyp := &Type{
+				Id:    int32(types.T_varchar),
+				Width: types.MaxVarcharLen,
+			}
+			if len(indexParts) == 1 {
+				partTyp := colMap[indexParts[0]].Typ
+				keyTyp = &Type{
+					Id:    partTyp.Id,
+					Width: partTyp.Width,
+					Scale: partTyp.Scale,
+				}
+			}
+			tableDef := &TableDef{
+				Name: indexTableName,
+				Cols: []*ColDef{
+					{
+						Name: catalog.IndexTableIndexColName,
+						Alg:  plan.CompressType_Lz4,
+						Typ:  keyTyp,
+						Default: &plan.Default{
+							NullAbility: false,
+						},
+					},
+					{
+						Name: catalog.IndexTablePrimaryColName,
+						Alg:  plan.CompressType_Lz4,
+						Typ:  colMap[pkeyName].Typ,
+						Default: &plan.Default{
+							NullAbility: false,
+						},
+					},
+				},
+			}
+			indexDef.IndexTableName = indexTableName
+			indexDef.TableExist = true
+			createTable.IndexTables = append(createTable.IndexTables, tableDef)
+		}
+		createTable.TableDef.Indexes = append(createTable.TableDef.Indexes, indexDef)
+	}
+	return nil
+}
+
+// buildFullTextIndexTable builds the index tables of the fulltext indexes, an index table
+// stores a row (word, primary key) for every word of the indexed columns of a row.
+func buildFullTextIndexTable(createTable *plan.CreateTable, indexInfos []*tree.FullTextIndex, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
+	if pkeyName == "" {
+		return moerr.NewNotSupported(ctx.GetContext(), "fulltext index on the table without primary key")
+	}
+	namesMap := make(map[string]bool)
+	for _, indexDef := range createTable.TableDef.Indexes {
+		namesMap[indexDef.IndexName] = true
+	}
+
+	for _, indexInfo := range indexInfos {
+		indexDef := &plan.IndexDef{}
+		indexDef.Fulltext = true
+
+		indexParts := make([]string, 0, len(indexInfo.KeyParts))
+		for _, keyPart := range indexInfo.KeyParts {
+			name := keyPart.ColName.Parts[0]
+			col, ok := colMap[name]
+			if !ok {
+				return moerr.NewInvalidInput(ctx.GetContext(), "column '%s' is not exist", name)
+			}
+			switch types.T(col.Typ.Id) {
+			case types.T_char, types.T_varchar, types.T_text:
+			default:
+				return moerr.NewNotSupported(ctx.GetContext(), "column '%s' cannot be part of FULLTEXT index", name)
+			}
+			indexParts = append(indexParts, name)
+		}
+
+		indexName := indexInfo.Name
+		if indexName == "" {
+			indexName = indexParts[0]
+			for i := 2; namesMap[indexName]; i++ {
+				indexName = fmt.Sprintf("%s_%d", indexParts[0], i)
+			}
+		} else if namesMap[indexName] {
+			return moerr.NewDuplicateKey(ctx.GetContext(), indexName)
+		}
+		namesMap[indexName] = true
+
+		if indexInfo.IndexOption != nil && indexInfo.IndexOption.ParserName != "" {
+			indexDef.Parser = strings.ToLower(indexInfo.IndexOption.ParserName)
+			if _, ok := fulltext.GetTokenizer(indexDef.Parser); !ok {
+				return moerr.NewNotSupported(ctx.GetContext(), "fulltext parser '%s'", indexInfo.IndexOption.ParserName)
+			}
+		}
+
+		indexTableName, err := util.BuildFullTextIndexTableName(ctx.GetContext())
+		if err != nil {
+			return err
+		}
+		tableDef := &TableDef{
+			Name: indexTableName,
+			Cols: []*ColDef{
+				{
+					Name: catalog.IndexTableIndexColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ: &Type{
+						Id:    int32(types.T_varchar),
+						Width: types.MaxVarcharLen,
+					},
+					Default: &plan.Default{
+						NullAbility: false,
+					},
+				},
+				{
+					Name: catalog.IndexTablePrimaryColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ:  colMap[pkeyName].Typ,
+					Default: &plan.Default{
+						NullAbility: false,
+					},
+				},
+			},
+		}
+
+		indexDef.IndexName = indexName
+		indexDef.IndexTableName = indexTableName
+		indexDef.Parts = indexParts
+		indexDef.TableExist = true
+		if indexInfo.IndexOption != nil {
+			indexDef.Comment = indexInfo.IndexOption.Comment
+		}
+		createTable.IndexTables = append(createTable.IndexTables, tableDef)
 		createTable.TableDef.Indexes = append(createTable.TableDef.Indexes, indexDef)
 	}
 	return nil
@@ -1268,8 +1512,12 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 	// build index
 	var uIdx *tree.UniqueIndex
 	var sIdx *tree.Index
+	var fIdx *tree.FullTextIndex
 	switch stmt.IndexCat {
 	case tree.INDEX_CATEGORY_UNIQUE:
+		if isFunctionalIndex(stmt.KeyParts) {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "functional unique index")
+		}
 		uIdx = &tree.UniqueIndex{
 			Name:        indexName,
 			KeyParts:    stmt.KeyParts,
@@ -1281,6 +1529,16 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 			KeyParts:    stmt.KeyParts,
 			IndexOption: stmt.IndexOption,
 		}
+	case tree.INDEX_CATEGORY_FULLTEXT:
+		if isFunctionalIndex(stmt.KeyParts) {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "functional fulltext index")
+		}
+		fIdx = &tree.FullTextIndex{
+			Name:        indexName,
+			KeyParts:    stmt.KeyParts,
+			Empty:       true,
+			IndexOption: stmt.IndexOption,
+		}
 	default:
 		return nil, moerr.NewNotSupported(ctx.GetContext(), "statement: '%v'", tree.String(stmt, dialect.MYSQL))
 	}
@@ -1288,6 +1546,15 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 	for _, col := range tableDef.Cols {
 		colMap[col.Name] = col
 	}
+	for _, keyPart := range stmt.KeyParts {
+		if keyPart.ColName == nil {
+			continue
+		}
+		name := keyPart.ColName.Parts[0]
+		if col, ok := colMap[name]; ok && col.Generated != nil && !col.Generated.Stored {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "index or primary key on virtual generated column '%s'", name)
+		}
+	}
 	// index.TableDef.Defs store info of index need to be modified
 	// index.IndexTables store index table need to be created
 	oriPriKeyName := getTablePriKeyName(tableDef.Pkey)
@@ -1300,11 +1567,22 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 		}
 		createIndex.TableExist = true
 	}
-	if sIdx != nil {
-		if err := buildSecondaryIndexDef(index, []*tree.Index{sIdx}, colMap, ctx); err != nil {
+	if sIdx != nil && isFunctionalIndex(sIdx.KeyParts) {
+		if err := buildJsonIndexTable(index, []*tree.Index{sIdx}, colMap, oriPriKeyName, ctx); err != nil {
 			return nil, err
 		}
-		createIndex.TableExist = false
+		createIndex.TableExist = true
+	} else if sIdx != nil {
+		if err := buildSecondaryIndexDef(index, []*tree.Index{sIdx}, colMap, oriPriKeyName, ctx); err != nil {
+			return nil, err
+		}
+		createIndex.TableExist = len(index.IndexTables) > 0
+	}
+	if fIdx != nil {
+		if err := buildFullTextIndexTable(index, []*tree.FullTextIndex{fIdx}, colMap, oriPriKeyName, ctx); err != nil {
+			return nil, err
+		}
+		createIndex.TableExist = true
 	}
 	createIndex.Index = index
 	createIndex.Table = tableName
@@ -1428,9 +1706,7 @@ func buildAlterView(stmt *tree.AlterView, ctx CompilerContext) (*Plan, error) {
 
 func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error) {
 	tableName := string(stmt.Table.ObjectName)
-	alterTable := &plan.AlterTable{
-		Actions: make([]*plan.AlterTable_Action, len(stmt.Options)),
-	}
+	alterTable := &plan.AlterTable{}
 
 	databaseName := string(stmt.Table.SchemaName)
 	if databaseName == "" {
@@ -1451,7 +1727,10 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 	}
 	alterTable.TableDef = tableDef
 
-	for i, option := range stmt.Options {
+	columns := newAlterTableColumns(tableDef)
+	var addIndexes []*plan.CreateIndex
+	for _, option := range stmt.Options {
+		var err error
 		switch opt := option.(type) {
 		case *tree.AlterOptionDrop:
 			name := string(opt.Name)
@@ -1459,13 +1738,11 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 			typ := plan.AlterTableDrop_COLUMN
 			switch opt.Typ {
 			case tree.AlterTableDropColumn:
-				typ = plan.AlterTableDrop_COLUMN
-				for _, col := range tableDef.Cols {
-					if col.Name == name {
-						name_not_found = false
-						break
-					}
+				// the column is dropped by copying the rows of the table without it
+				if err = columns.drop(ctx, name); err != nil {
+					return nil, err
 				}
+				continue
 			case tree.AlterTableDropIndex:
 				typ = plan.AlterTableDrop_INDEX
 			case tree.AlterTableDropKey:
@@ -1488,14 +1765,14 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 			if name_not_found {
 				return nil, moerr.NewInternalError(ctx.GetContext(), "Can't DROP '%s'; check that column/key exists", name)
 			}
-			alterTable.Actions[i] = &plan.AlterTable_Action{
+			alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
 				Action: &plan.AlterTable_Action_Drop{
 					Drop: &plan.AlterTableDrop{
 						Typ:  typ,
 						Name: name,
 					},
 				},
-			}
+			})
 
 		case *tree.AlterOptionAdd:
 			switch def := opt.Def.(type) {
@@ -1504,7 +1781,7 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 				if err != nil {
 					return nil, err
 				}
-				alterTable.Actions[i] = &plan.AlterTable_Action{
+				alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
 					Action: &plan.AlterTable_Action_AddFk{
 						AddFk: &plan.AlterTableAddFk{
 							DbName:    fkData.DbName,
@@ -1513,11 +1790,62 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 							Fkey:      fkData.Def,
 						},
 					},
-				}
+				})
+			case *tree.ColumnTableDef:
+				err = columns.add(ctx, def, nil)
+			case *tree.Index:
+				addIndexes, err = appendAlterTableIndex(ctx, addIndexes, stmt, tree.INDEX_CATEGORY_NONE, def.Name, def.KeyParts, def.IndexOption)
+			case *tree.UniqueIndex:
+				addIndexes, err = appendAlterTableIndex(ctx, addIndexes, stmt, tree.INDEX_CATEGORY_UNIQUE, def.Name, def.KeyParts, def.IndexOption)
+			case *tree.FullTextIndex:
+				addIndexes, err = appendAlterTableIndex(ctx, addIndexes, stmt, tree.INDEX_CATEGORY_FULLTEXT, def.Name, def.KeyParts, def.IndexOption)
+			default:
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "statement: '%v'", tree.String(stmt, dialect.MYSQL))
 			}
+
+		case *tree.AlterOptionAddColumn:
+			err = columns.add(ctx, opt.Column, opt.Position)
+		case *tree.AlterOptionModifyColumn:
+			err = columns.modify(ctx, opt.Column.Name.Parts[0], opt.Column, opt.Position)
+		case *tree.AlterOptionChangeColumn:
+			err = columns.modify(ctx, string(opt.OldName), opt.Column, opt.Position)
+		case *tree.AlterOptionRenameColumn:
+			err = columns.rename(ctx, string(opt.OldName), string(opt.NewName))
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 
+	// the columns are changed before the indexes are added, so the index tables are built from the new rows
+	if columns.changed {
+		// the other changes would copy the rows into a new table, which loses the id of the table
+		if !columns.inPlace() {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "change the columns of table '%s' other than renaming them or appending the columns with a constant default", tableDef.Name)
+		}
+		for _, action := range alterTable.Actions {
+			if _, ok := action.Action.(*plan.AlterTable_Action_AddFk); ok {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "add foreign key and change the columns in one statement")
+			}
+		}
+		alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+			Action: &plan.AlterTable_Action_Columns{
+				Columns: &plan.AlterTableColumns{
+					Cols:    columns.cols,
+					Exprs:   columns.exprs,
+					InPlace: columns.inPlace(),
+				},
+			},
+		})
+	}
+	for _, createIndex := range addIndexes {
+		alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+			Action: &plan.AlterTable_Action_AddIndex{
+				AddIndex: createIndex,
+			},
+		})
+	}
+
 	return &Plan{
 		Plan: &plan.Plan_Ddl{
 			Ddl: &plan.DataDefinition{
@@ -1530,6 +1858,347 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 	}, nil
 }
 
+// appendAlterTableIndex builds the index added by ALTER TABLE ADD INDEX like CREATE INDEX does.
+func appendAlterTableIndex(ctx CompilerContext, indexes []*plan.CreateIndex, stmt *tree.AlterTable, cat tree.IndexCategory,
+	name string, keyParts []*tree.KeyPart, option *tree.IndexOption) ([]*plan.CreateIndex, error) {
+	for _, createIndex := range indexes {
+		if name != "" && createIndex.Index.TableDef.Indexes[0].IndexName == name {
+			return nil, moerr.NewDuplicateKey(ctx.GetContext(), name)
+		}
+	}
+	p, err := buildCreateIndex(&tree.CreateIndex{
+		Name:        tree.Identifier(name),
+		Table:       *tree.NewTableName(stmt.Table.ObjectName, stmt.Table.ObjectNamePrefix),
+		IndexCat:    cat,
+		KeyParts:    keyParts,
+		IndexOption: option,
+	}, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(indexes, p.GetDdl().GetCreateIndex()), nil
+}
+
+// alterTableColumns keeps the columns of a table while the ALTER TABLE options are applied.
+// exprs[i] computes cols[i] from a row of the table before the change.
+type alterTableColumns struct {
+	tableDef *TableDef
+	cols     []*ColDef
+	exprs    []*plan.Expr
+	changed  bool
+	// origin is exprs before the change, and modified is set once a column is modified or dropped
+	origin   []*plan.Expr
+	modified bool
+}
+
+func newAlterTableColumns(tableDef *TableDef) *alterTableColumns {
+	columns := &alterTableColumns{tableDef: tableDef}
+	for i, col := range tableDef.Cols {
+		if col.Name == catalog.Row_ID {
+			continue
+		}
+		columns.cols = append(columns.cols, DeepCopyColDef(col))
+		columns.exprs = append(columns.exprs, &plan.Expr{
+			Typ: DeepCopyTyp(col.Typ),
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: 0,
+					ColPos: int32(i),
+					Name:   col.Name,
+				},
+			},
+		})
+	}
+	columns.origin = append(columns.origin, columns.exprs...)
+	return columns
+}
+
+// inPlace reports whether the change only renames the columns or appends the columns
+// with a constant default, the engine could change the schema of the table without copying the rows then.
+func (columns *alterTableColumns) inPlace() bool {
+	if columns.modified || len(columns.cols) < len(columns.origin) {
+		return false
+	}
+	for i, expr := range columns.origin {
+		if columns.exprs[i] != expr {
+			return false
+		}
+	}
+	for _, col := range columns.cols[len(columns.origin):] {
+		_, isNull, ok := catalog.GetConstDefault(col.Default, makeTypeByPlan2Type(col.Typ))
+		if !ok || (isNull && col.Typ.NotNullable) {
+			return false
+		}
+	}
+	return true
+}
+
+func (columns *alterTableColumns) find(name string) int {
+	for i, col := range columns.cols {
+		if col.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// change checks whether the columns of the table can be changed, and the column name if it is not empty.
+// The keys and the expressions which refer to the column must not be changed.
+func (columns *alterTableColumns) change(ctx CompilerContext, name string) error {
+	tableDef := columns.tableDef
+	if len(tableDef.Fkeys) > 0 || len(tableDef.RefChildTbls) > 0 {
+		return moerr.NewNotSupported(ctx.GetContext(), "change the columns of table '%s' which has foreign keys", tableDef.Name)
+	}
+	if tableDef.Partition != nil {
+		return moerr.NewNotSupported(ctx.GetContext(), "change the columns of partitioned table '%s'", tableDef.Name)
+	}
+	if tableDef.TableType == catalog.SystemExternalRel {
+		return moerr.NewNotSupported(ctx.GetContext(), "change the columns of external table '%s'", tableDef.Name)
+	}
+	columns.changed = true
+	if name == "" {
+		return nil
+	}
+
+	i := columns.find(name)
+	if i < 0 {
+		return moerr.NewBadFieldError(ctx.GetContext(), name, tableDef.Name)
+	}
+	col := columns.cols[i]
+	isKey := col.Primary || col.ClusterBy || col.Typ.AutoIncr
+	if tableDef.Pkey != nil {
+		for _, part := range tableDef.Pkey.Names {
+			isKey = isKey || part == name
+		}
+	}
+	for _, index := range tableDef.Indexes {
+		for _, part := range index.Parts {
+			isKey = isKey || part == name
+		}
+	}
+	if tableDef.ClusterBy != nil && util.JudgeIsCompositeClusterByColumn(tableDef.ClusterBy.Name) {
+		for _, part := range util.SplitCompositeClusterByColumnName(tableDef.ClusterBy.Name) {
+			isKey = isKey || part == name
+		}
+	}
+	if isKey {
+		return moerr.NewNotSupported(ctx.GetContext(), "change the key or auto_increment column '%s'", name)
+	}
+	for _, other := range columns.cols {
+		if other.Generated != nil && exprRefersToColumn(other.Generated.Expr, name) {
+			return moerr.NewNotSupported(ctx.GetContext(), "change the column '%s' which generated column '%s' depends on", name, other.Name)
+		}
+	}
+	for _, check := range tableDef.Checks {
+		if exprRefersToColumn(check.Check, name) {
+			return moerr.NewNotSupported(ctx.GetContext(), "change the column '%s' which check constraint '%s' depends on", name, check.Name)
+		}
+	}
+	return nil
+}
+
+// exprRefersToColumn reports whether the expression of a generated column or
+// a check constraint refers to the column.
+func exprRefersToColumn(expr *plan.Expr, name string) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return exprImpl.Col.Name == name
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if exprRefersToColumn(arg, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// insert inserts col at the position, the position is relative to the columns without col.
+func (columns *alterTableColumns) insert(ctx CompilerContext, col *ColDef, expr *plan.Expr, pos *tree.ColumnPosition, at int) error {
+	if pos != nil {
+		switch pos.Typ {
+		case tree.ColumnPositionFirst:
+			at = 0
+		case tree.ColumnPositionAfter:
+			if at = columns.find(string(pos.RelativeColumn)); at < 0 {
+				return moerr.NewBadFieldError(ctx.GetContext(), string(pos.RelativeColumn), columns.tableDef.Name)
+			}
+			at++
+		}
+	}
+	columns.cols = append(columns.cols[:at], append([]*ColDef{col}, columns.cols[at:]...)...)
+	columns.exprs = append(columns.exprs[:at], append([]*plan.Expr{expr}, columns.exprs[at:]...)...)
+	return nil
+}
+
+func (columns *alterTableColumns) remove(i int) {
+	columns.cols = append(columns.cols[:i], columns.cols[i+1:]...)
+	columns.exprs = append(columns.exprs[:i], columns.exprs[i+1:]...)
+}
+
+func (columns *alterTableColumns) add(ctx CompilerContext, def *tree.ColumnTableDef, pos *tree.ColumnPosition) error {
+	if err := columns.change(ctx, ""); err != nil {
+		return err
+	}
+	col, err := buildAlterTableColDef(ctx, def)
+	if err != nil {
+		return err
+	}
+	if columns.find(col.Name) >= 0 {
+		return moerr.NewInvalidInput(ctx.GetContext(), "Duplicate column name '%s'", col.Name)
+	}
+	// the existing rows get the default value of the new column
+	expr := col.Default.Expr
+	if expr == nil {
+		expr = &plan.Expr{
+			Typ: DeepCopyTyp(col.Typ),
+			Expr: &plan.Expr_C{
+				C: &Const{
+					Isnull: true,
+				},
+			},
+		}
+	} else {
+		expr = DeepCopyExpr(expr)
+	}
+	return columns.insert(ctx, col, expr, pos, len(columns.cols))
+}
+
+func (columns *alterTableColumns) modify(ctx CompilerContext, name string, def *tree.ColumnTableDef, pos *tree.ColumnPosition) error {
+	if err := columns.change(ctx, name); err != nil {
+		return err
+	}
+	col, err := buildAlterTableColDef(ctx, def)
+	if err != nil {
+		return err
+	}
+	if col.Name != name && columns.find(col.Name) >= 0 {
+		return moerr.NewInvalidInput(ctx.GetContext(), "Duplicate column name '%s'", col.Name)
+	}
+	i := columns.find(name)
+	if columns.cols[i].Generated != nil {
+		return moerr.NewNotSupported(ctx.GetContext(), "modify the generated column '%s'", name)
+	}
+	expr := columns.exprs[i]
+	columns.remove(i)
+	columns.modified = true
+	// the values are cast to the new type, and the ALTER TABLE fails if a value can not be cast
+	if expr.Typ.Id != col.Typ.Id || expr.Typ.Width != col.Typ.Width || expr.Typ.Scale != col.Typ.Scale {
+		if expr, err = appendCastBeforeExpr(ctx.GetContext(), expr, DeepCopyTyp(col.Typ)); err != nil {
+			return err
+		}
+	}
+	return columns.insert(ctx, col, expr, pos, i)
+}
+
+func (columns *alterTableColumns) rename(ctx CompilerContext, oldName, newName string) error {
+	if err := columns.change(ctx, oldName); err != nil {
+		return err
+	}
+	if !checkTableColumnNameValid(newName) {
+		return moerr.NewInvalidInput(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", newName)
+	}
+	if oldName != newName && columns.find(newName) >= 0 {
+		return moerr.NewInvalidInput(ctx.GetContext(), "Duplicate column name '%s'", newName)
+	}
+	if columns.cols[columns.find(oldName)].Generated != nil {
+		return moerr.NewNotSupported(ctx.GetContext(), "rename the generated column '%s'", oldName)
+	}
+	columns.cols[columns.find(oldName)].Name = newName
+	return nil
+}
+
+func (columns *alterTableColumns) drop(ctx CompilerContext, name string) error {
+	if columns.find(name) < 0 {
+		return moerr.NewInternalError(ctx.GetContext(), "Can't DROP '%s'; check that column/key exists", name)
+	}
+	if err := columns.change(ctx, name); err != nil {
+		return err
+	}
+	if len(columns.cols) == 1 {
+		return moerr.NewInvalidInput(ctx.GetContext(), "You can't delete all columns with ALTER TABLE; use DROP TABLE instead")
+	}
+	columns.remove(columns.find(name))
+	columns.modified = true
+	return nil
+}
+
+// buildAlterTableColDef builds the column added or modified by ALTER TABLE,
+// the keys of the table can not be changed by the column definition.
+func buildAlterTableColDef(ctx CompilerContext, def *tree.ColumnTableDef) (*ColDef, error) {
+	name := def.Name.Parts[0]
+	colType, err := getTypeFromAst(ctx.GetContext(), def.Type)
+	if err != nil {
+		return nil, err
+	}
+	if colType.Id == int32(types.T_char) || colType.Id == int32(types.T_varchar) ||
+		colType.Id == int32(types.T_binary) || colType.Id == int32(types.T_varbinary) {
+		if colType.GetWidth() > types.MaxStringSize {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "string width (%d) is too long", colType.GetWidth())
+		}
+	}
+	var comment string
+	alg, level := plan.CompressType_Lz4, int32(0)
+	for _, attr := range def.Attributes {
+		switch attribute := attr.(type) {
+		case *tree.AttributePrimaryKey, *tree.AttributeKey, *tree.AttributeUnique, *tree.AttributeUniqueKey, *tree.AttributeAutoIncrement:
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "add or modify the key or auto_increment column '%s'", name)
+		case *tree.AttributeGeneratedAlways:
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "add or modify the generated column '%s'", name)
+		case *tree.AttributeCheckConstraint:
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "add or modify the check constraint of column '%s'", name)
+		case *tree.AttributeComment:
+			comment = attribute.CMT.String()
+			if getNumOfCharacters(comment) > maxLengthOfColumnComment {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "comment for column '%s' is too long", name)
+			}
+		case *tree.AttributeCompression:
+			if alg, level, err = getCompression(ctx.GetContext(), attribute.Compression, attribute.Level); err != nil {
+				return nil, err
+			}
+		}
+	}
+	defaultValue, err := buildDefaultExpr(def, colType, ctx.GetProcess())
+	if err != nil {
+		return nil, err
+	}
+	onUpdateExpr, err := buildOnUpdate(def, colType, ctx.GetProcess())
+	if err != nil {
+		return nil, err
+	}
+	if !checkTableColumnNameValid(name) {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", name)
+	}
+	colType.NotNullable = !defaultValue.NullAbility
+	return &ColDef{
+		Name:          name,
+		Alg:           alg,
+		CompressLevel: level,
+		Typ:           colType,
+		Default:       defaultValue,
+		OnUpdate:      onUpdateExpr,
+		Comment:       comment,
+	}, nil
+}
+
+// getCompression returns the compression algorithm of the name and its level,
+// only zstd has the level.
+func getCompression(ctx context.Context, name string, level int64) (plan.CompressType, int32, error) {
+	alg, ok := compress.Algorithms[strings.ToLower(name)]
+	if !ok {
+		return 0, 0, moerr.NewNotSupported(ctx, "compression '%s'", name)
+	}
+	if level != 0 {
+		if alg != compress.Zstd {
+			return 0, 0, moerr.NewInvalidInput(ctx, "compression '%s' has no level", name)
+		}
+		if level < compress.MinZstdLevel || level > compress.MaxZstdLevel {
+			return 0, 0, moerr.NewInvalidInput(ctx, "the level of compression '%s' should be in [%d, %d]", name, compress.MinZstdLevel, compress.MaxZstdLevel)
+		}
+	}
+	return plan.CompressType(alg), int32(level), nil
+}
+
 func buildLockTables(stmt *tree.LockTableStmt, ctx CompilerContext) (*Plan, error) {
 	lockTables := make([]*plan.TableLockInfo, 0, len(stmt.TableLocks))
 	uniqueTableName := make(map[string]bool)
@@ -29,6 +29,7 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 		return nil, err
 	}
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	builder.isDML = true
 	bindCtx := NewBindContext(builder, nil)
 
 	rewriteInfo := &dmlSelectInfo{
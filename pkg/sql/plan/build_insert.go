@@ -45,6 +45,7 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool) (p *Pla
 	}
 
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	builder.isDML = true
 	bindCtx := NewBindContext(builder, nil)
 	bindCtx.groupTag = builder.genNewTag()
 	bindCtx.aggregateTag = builder.genNewTag()
@@ -147,12 +147,33 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 		if types.IsFloat(typ.Oid) && col.Typ.Scale != -1 {
 			typeStr += fmt.Sprintf("(%d,%d)", col.Typ.Width, col.Typ.Scale)
 		}
+		if typ.Oid == types.T_enum || typ.Oid == types.T_set {
+			typeStr += fmt.Sprintf("(%s)", col.Typ.Enumvalues)
+		}
+		if col.Generated != nil {
+			typeStr += fmt.Sprintf(" GENERATED ALWAYS AS (%s)", col.Generated.OriginString)
+			if col.Generated.Stored {
+				typeStr += " STORED"
+			} else {
+				typeStr += " VIRTUAL"
+			}
+			if col.Default.NullAbility {
+				nullOrNot = "NULL"
+			}
+		}
 
 		updateOpt := ""
 		if col.OnUpdate != nil && col.OnUpdate.Expr != nil {
 			updateOpt = " ON UPDATE " + col.OnUpdate.OriginString
 		}
-		createStr += fmt.Sprintf("`%s` %s %s%s%s", colName, typeStr, nullOrNot, updateOpt, hasAttrComment)
+		compressOpt := ""
+		if col.Alg != plan.CompressType_Lz4 {
+			compressOpt = fmt.Sprintf(" COMPRESSION '%s'", strings.ToLower(col.Alg.String()))
+			if col.CompressLevel != 0 {
+				compressOpt += fmt.Sprintf(" LEVEL %d", col.CompressLevel)
+			}
+		}
+		createStr += fmt.Sprintf("`%s` %s %s%s%s%s", colName, typeStr, nullOrNot, updateOpt, hasAttrComment, compressOpt)
 		rowCount++
 		if col.Primary {
 			pkDefs = append(pkDefs, colName)
@@ -185,18 +206,28 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 			var indexStr string
 			if indexdef.Unique {
 				indexStr = "UNIQUE KEY "
+			} else if indexdef.Fulltext {
+				indexStr = "FULLTEXT KEY "
 			} else {
 				indexStr = "KEY "
 			}
 			indexStr += fmt.Sprintf("`%s` (", indexdef.IndexName)
-			for num, part := range indexdef.Parts {
-				if num == len(indexdef.Parts)-1 {
-					indexStr += fmt.Sprintf("`%s`", part)
-				} else {
-					indexStr += fmt.Sprintf("`%s`,", part)
+			if indexdef.JsonPath != "" {
+				// the functional index on a json path
+				indexStr += fmt.Sprintf("(%s)", indexdef.OriginString)
+			} else {
+				for num, part := range indexdef.Parts {
+					if num == len(indexdef.Parts)-1 {
+						indexStr += fmt.Sprintf("`%s`", part)
+					} else {
+						indexStr += fmt.Sprintf("`%s`,", part)
+					}
 				}
 			}
 			indexStr += ")"
+			if indexdef.Fulltext && indexdef.Parser != "" {
+				indexStr += " WITH PARSER " + indexdef.Parser
+			}
 			if indexdef.Comment != "" {
 				indexdef.Comment = strings.Replace(indexdef.Comment, "'", "\\'", -1)
 				indexStr += fmt.Sprintf(" COMMENT '%s'", indexdef.Comment)
@@ -230,6 +261,16 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 			fk.Name, strings.Join(colNames, "`,`"), fkTableDef.Name, strings.Join(fkColNames, "`,`"), fk.OnDelete.String(), fk.OnUpdate.String())
 	}
 
+	for _, check := range tableDef.Checks {
+		if rowCount != 0 {
+			createStr += ",\n"
+		}
+		createStr += fmt.Sprintf("CONSTRAINT `%s` CHECK (%s)", check.Name, check.OriginString)
+		if !check.Enforced {
+			createStr += " NOT ENFORCED"
+		}
+	}
+
 	if rowCount != 0 {
 		createStr += "\n"
 	}
@@ -444,10 +485,10 @@ func buildShowTables(stmt *tree.ShowTables, ctx CompilerContext) (*Plan, error)
 		clusterTable := fmt.Sprintf(" or relkind = '%s'", catalog.SystemClusterRel)
 		accountClause := fmt.Sprintf("account_id = %v or (account_id = 0 and (%s))", accountId, mustShowTable+clusterTable)
 		sql = fmt.Sprintf("SELECT relname as `Tables_in_%s` %s FROM %s.mo_tables WHERE reldatabase = '%s' and relname != '%s' and relname not like '%s' and (%s)",
-			dbName, tableType, MO_CATALOG_DB_NAME, dbName, catalog.AutoIncrTableName, catalog.IndexTableNamePrefix+"%", accountClause)
+			dbName, tableType, MO_CATALOG_DB_NAME, dbName, catalog.AutoIncrTableName, catalog.PrefixIndexTableName+"%", accountClause)
 	} else {
 		sql = fmt.Sprintf("SELECT relname as `Tables_in_%s` %s FROM %s.mo_tables WHERE reldatabase = '%s' and relname != '%s' and relname not like '%s'",
-			dbName, tableType, MO_CATALOG_DB_NAME, dbName, catalog.AutoIncrTableName, catalog.IndexTableNamePrefix+"%")
+			dbName, tableType, MO_CATALOG_DB_NAME, dbName, catalog.AutoIncrTableName, catalog.PrefixIndexTableName+"%")
 	}
 
 	// Do not show sequences.
@@ -488,10 +529,10 @@ func buildShowTableNumber(stmt *tree.ShowTableNumber, ctx CompilerContext) (*Pla
 		clusterTable := fmt.Sprintf(" or relkind = '%s'", catalog.SystemClusterRel)
 		accountClause := fmt.Sprintf("account_id = %v or (account_id = 0 and (%s))", accountId, mustShowTable+clusterTable)
 		sql = fmt.Sprintf("SELECT count(relname) `Number of tables in %s`  FROM %s.mo_tables WHERE reldatabase = '%s' and relname != '%s' and relname not like '%s' and (%s)",
-			dbName, MO_CATALOG_DB_NAME, dbName, catalog.AutoIncrTableName, catalog.IndexTableNamePrefix+"%", accountClause)
+			dbName, MO_CATALOG_DB_NAME, dbName, catalog.AutoIncrTableName, catalog.PrefixIndexTableName+"%", accountClause)
 	} else {
 		sql = "SELECT count(relname) `Number of tables in %s` FROM %s.mo_tables WHERE reldatabase = '%s' and relname != '%s' and relname not like '%s'"
-		sql = fmt.Sprintf(sql, dbName, MO_CATALOG_DB_NAME, dbName, catalog.AutoIncrTableName, catalog.IndexTableNamePrefix+"%")
+		sql = fmt.Sprintf(sql, dbName, MO_CATALOG_DB_NAME, dbName, catalog.AutoIncrTableName, catalog.PrefixIndexTableName+"%")
 	}
 
 	return returnByRewriteSQL(ctx, sql, ddlType)
@@ -672,7 +713,7 @@ func buildShowTableStatus(stmt *tree.ShowTableStatus, ctx CompilerContext) (*Pla
 	accountClause := fmt.Sprintf("account_id = %v or (account_id = 0 and (%s))", accountId, mustShowTable)
 	sql := "select relname as `Name`, 'Tae' as `Engine`, 'Dynamic' as `Row_format`, 0 as `Rows`, 0 as `Avg_row_length`, 0 as `Data_length`, 0 as `Max_data_length`, 0 as `Index_length`, 'NULL' as `Data_free`, 0 as `Auto_increment`, created_time as `Create_time`, 'NULL' as `Update_time`, 'NULL' as `Check_time`, 'utf-8' as `Collation`, 'NULL' as `Checksum`, '' as `Create_options`, rel_comment as `Comment` from %s.mo_tables where reldatabase = '%s' and relname != '%s' and relname not like '%s' and (%s)"
 
-	sql = fmt.Sprintf(sql, MO_CATALOG_DB_NAME, dbName, catalog.AutoIncrTableName, catalog.IndexTableNamePrefix+"%", accountClause)
+	sql = fmt.Sprintf(sql, MO_CATALOG_DB_NAME, dbName, catalog.AutoIncrTableName, catalog.PrefixIndexTableName+"%", accountClause)
 
 	if stmt.Where != nil {
 		return returnByWhereAndBaseSQL(ctx, sql, stmt.Where, ddlType)
@@ -697,6 +738,8 @@ func buildShowTarget(stmt *tree.ShowTarget, ctx CompilerContext) (*Plan, error)
 		sql = "select '' as `Charset`, '' as `Description`, '' as `Default collation`, '' as `Maxlen` where 0"
 	case tree.ShowTriggers:
 		return buildShowTriggers(stmt, ctx)
+	case tree.ShowProcedureStatus:
+		return buildShowProcedureStatus(stmt, ctx)
 	default:
 		sql = "select 1 where 0"
 	}
@@ -724,7 +767,7 @@ func buildShowFunctionStatus(stmt *tree.ShowFunctionStatus, ctx CompilerContext)
 		return nil, moerr.NewSyntaxError(ctx.GetContext(), "like clause and where clause cannot exist at the same time")
 	}
 
-	sql := fmt.Sprintf("SELECT db as `Db`, name as `Name`, type as `Type`, definer as `Definer`, modified_time as `Modified`, created_time as `Created`, security_type as `Security_type`, comment as `Comment`, character_set_client, collation_connection, database_collation as `Database Collation` FROM %s.mo_user_defined_function", MO_CATALOG_DB_NAME)
+	sql := fmt.Sprintf("SELECT db as `Db`, name as `Name`, type as `Type`, definer as `Definer`, modified_time as `Modified`, created_time as `Created`, security_type as `Security_type`, comment as `Comment`, character_set_client, collation_connection, database_collation as `Database Collation` FROM %s.mo_user_defined_function WHERE type = 'FUNCTION'", MO_CATALOG_DB_NAME)
 
 	if stmt.Where != nil {
 		return returnByWhereAndBaseSQL(ctx, sql, stmt.Where, ddlType)
@@ -740,6 +783,28 @@ func buildShowFunctionStatus(stmt *tree.ShowFunctionStatus, ctx CompilerContext)
 	return returnByRewriteSQL(ctx, sql, ddlType)
 }
 
+func buildShowProcedureStatus(stmt *tree.ShowTarget, ctx CompilerContext) (*Plan, error) {
+	if stmt.Like != nil && stmt.Where != nil {
+		return nil, moerr.NewSyntaxError(ctx.GetContext(), "like clause and where clause cannot exist at the same time")
+	}
+
+	ddlType := plan.DataDefinition_SHOW_TARGET
+	sql := fmt.Sprintf("SELECT db as `Db`, name as `Name`, type as `Type`, definer as `Definer`, modified_time as `Modified`, created_time as `Created`, security_type as `Security_type`, comment as `Comment`, character_set_client, collation_connection, database_collation as `Database Collation` FROM %s.mo_user_defined_function WHERE type = 'PROCEDURE'", MO_CATALOG_DB_NAME)
+
+	if stmt.Where != nil {
+		return returnByWhereAndBaseSQL(ctx, sql, stmt.Where, ddlType)
+	}
+
+	if stmt.Like != nil {
+		// append filter [AND name like stmt.Like] to WHERE clause
+		likeExpr := stmt.Like
+		likeExpr.Left = tree.SetUnresolvedName("name")
+		return returnByLikeAndSQL(ctx, sql, likeExpr, ddlType)
+	}
+
+	return returnByRewriteSQL(ctx, sql, ddlType)
+}
+
 func buildShowTriggers(stmt *tree.ShowTarget, ctx CompilerContext) (*Plan, error) {
 	if stmt.Like != nil && stmt.Where != nil {
 		return nil, moerr.NewSyntaxError(ctx.GetContext(), "like clause and where clause cannot exist at the same time")
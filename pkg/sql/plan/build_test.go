@@ -21,11 +21,16 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // only use in developing
@@ -579,6 +584,12 @@ func TestCTESqlBuilder(t *testing.T) {
 		SELECT (WITH qn AS (SELECT "inner" as a) SELECT a from qn),
 		qn.a
 		FROM qn`,
+
+		"with recursive qn as (select 1 as a) select * from qn",
+		"with recursive c(n) as (select 1 union all select n + 1 from c where n < 5) select * from c where n > 2",
+		`with recursive c as (select n_nationkey, n_regionkey from nation where n_regionkey = 0
+		union select n.n_nationkey, n.n_regionkey from nation n join c on n.n_regionkey = c.n_nationkey)
+		select count(*) from c`,
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -591,6 +602,12 @@ func TestCTESqlBuilder(t *testing.T) {
 		`WITH qn2 AS (SELECT a FROM qn WHERE a IS NULL or a>0),
 		qn AS (SELECT b as a FROM qn2)
 		SELECT qn.a  FROM qn`,
+
+		"with qn as (select * from qn) select * from qn",
+		"with recursive c(n) as (select n + 1 from c) select * from c",
+		"with recursive c(n) as (select n from c union all select 1) select * from c",
+		"with recursive c(n) as (select 1 union all select c1.n from c c1, c c2) select * from c",
+		"with recursive c(n) as (select 1 union all select count(*) from c) select * from c",
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -642,6 +659,420 @@ func TestUpdate(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestEnumAndSet(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	// should pass
+	sqls := []string{
+		"select color, tags from enum_test where color = 'red' order by color",
+		"select color, count(*) from enum_test group by color",
+		"select color from enum_test union select tags from enum_test",
+		"select cast(color as char(10)), concat(tags, ',d') from enum_test",
+		"update enum_test set color = 'blue', tags = '' where id = 1",
+		"create table t1 (a enum('x', 'y'), b set('p', 'q') default 'p,q')",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+	sqls = []string{
+		"insert into enum_test values (1, 'green', 'a,c')",
+		"insert into enum_test (id, color, tags) values (2, 2, 5)",
+		"insert into enum_test select id + 10, color, tags from enum_test",
+	}
+	runTestShouldPass(NewMockOptimizer(false), t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"create table t1 (a enum('x', 'X'))",        // duplicate member
+		"create table t1 (a set('p', 'p,q'))",       // comma in the member of set
+		"create table t1 (a enum('x') default 'y')", // default value is not a member
+	}
+	runTestShouldError(mock, t, sqls)
+
+	// the results are converted to the members only at last
+	logicPlan, err := runOneStmt(mock, t, "select color from enum_test order by color")
+	assert.NoError(t, err)
+	query := logicPlan.GetQuery()
+	root := query.Nodes[query.Steps[0]]
+	assert.Equal(t, plan.Node_PROJECT, root.NodeType)
+	assert.Equal(t, "enum_to_string", root.ProjectList[0].GetF().Func.ObjName)
+	assert.Equal(t, int32(types.T_varchar), root.ProjectList[0].Typ.Id)
+	node := query.Nodes[root.Children[0]]
+	for node.NodeType == plan.Node_PROJECT {
+		node = query.Nodes[node.Children[0]]
+	}
+	assert.Equal(t, plan.Node_SORT, node.NodeType)
+}
+
+func TestGeneratedColumn(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	// should pass
+	sqls := []string{
+		"select total, doubled from gen_test where doubled > 10",
+		"select * from gen_test",
+		"select id from gen_test where id in (select doubled from gen_test t2 where t2.doubled = gen_test.doubled)",
+		"update gen_test set price = 10 where id = 1",
+		"update gen_test set total = default where id = 1",
+		"create table t1 (a int, b int generated always as (a * 2) stored, c varchar(20) as (concat(b, 'x')), d int as (b + 1) virtual not null)",
+		"create table t1 (a int, b int as (a + 1) stored, unique key(b))",
+		"create table t1 (a int, b int as (a + 1) stored primary key)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+	sqls = []string{
+		"insert into gen_test (id, price, qty) values (1, 2, 3)",
+		"insert into gen_test values (1, 2, 3, default, default)",
+	}
+	runTestShouldPass(NewMockOptimizer(false), t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"create table t1 (a int, b int as (c + 1), c int as (a + 1))",      // refer to the later generated column
+		"create table t1 (a int, b int as (b + 1))",                        // refer to itself
+		"create table t1 (a int auto_increment, b int as (a + 1))",         // refer to auto_increment column
+		"create table t1 (a int, b int as (a + 1) default 1)",              // default value
+		"create table t1 (a int, b int as (a + rand()))",                   // non-deterministic function
+		"create table t1 (a int, b int as ((select 1)))",                   // subquery
+		"create table t1 (a int, b int as (a + 1) virtual, unique key(b))", // index on virtual column
+		"create table t1 (a int, b int as (a + 1) primary key)",            // primary key on virtual column
+		"update gen_test set total = 1 where id = 1",
+	}
+	runTestShouldError(mock, t, sqls)
+	sqls = []string{
+		"insert into gen_test (id, total) values (1, 2)",
+		"insert into gen_test values (1, 2, 3, 4, default)",
+		"insert into gen_test select * from gen_test",
+	}
+	runTestShouldError(NewMockOptimizer(false), t, sqls)
+
+	// the virtual column is computed from the columns it depends on
+	logicPlan, err := runOneStmt(mock, t, "select doubled from gen_test")
+	assert.NoError(t, err)
+	query := logicPlan.GetQuery()
+	root := query.Nodes[query.Steps[0]]
+	assert.Equal(t, "+", root.ProjectList[0].GetF().GetFunc().GetObjName())
+	node := root
+	for node.NodeType != plan.Node_TABLE_SCAN {
+		node = query.Nodes[node.Children[0]]
+	}
+	for _, col := range node.TableDef.Cols {
+		assert.NotEqual(t, "doubled", col.Name)
+	}
+
+	// the stored column depending on the updated column is updated as well
+	logicPlan, err = runOneStmt(mock, t, "update gen_test set price = 10 where id = 1")
+	assert.NoError(t, err)
+	updateCtx := logicPlan.GetQuery().Nodes[logicPlan.GetQuery().Steps[0]].UpdateCtx
+	_, ok := updateCtx.UpdateCol[0].Map["total"]
+	assert.True(t, ok)
+}
+
+func TestCheckConstraint(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"create table t1 (a int check (a > 0), b int, check (a < b))",
+		"create table t1 (a int, b varchar(10), constraint c1 check (b in ('x', 'y')) not enforced)",
+		"create table t1 (a int, b int as (a + 1), check (b > 1))",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"create table t1 (a int auto_increment, check (a > 0))",                             // refer to auto_increment column
+		"create table t1 (a int check (a > b), b int)",                                      // column check refers to other column
+		"create table t1 (a int, constraint c1 check (a > 0), constraint c1 check (a < 9))", // duplicate name
+		"create table t1 (a int, check (a > rand()))",                                       // non-deterministic function
+		"create table t1 (a int, check (a > (select 1)))",                                   // subquery
+		"create table t1 (a int, check (c > 0))",                                            // unknown column
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "create table t1 (a int check (a > 0), b int, constraint t1_chk_1 check (a < b) not enforced)")
+	assert.NoError(t, err)
+	checks := logicPlan.GetDdl().GetCreateTable().GetTableDef().GetChecks()
+	assert.Equal(t, 2, len(checks))
+	assert.Equal(t, "t1_chk_2", checks[0].Name)
+	assert.Equal(t, "a > 0", checks[0].OriginString)
+	assert.True(t, checks[0].Enforced)
+	assert.Equal(t, "t1_chk_1", checks[1].Name)
+	assert.False(t, checks[1].Enforced)
+}
+
+func TestFullTextIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"create table t1 (a int primary key, b text, fulltext (b))",
+		"create table t1 (a int, b varchar(100), c text, primary key (a, b), fulltext ft (b, c) with parser ngram)",
+		"create fulltext index ft on ft_logs (host)",
+		"alter table ft_logs add fulltext ft (host)",
+		"select id, match(msg) against('disk error') as score from ft_logs where match(msg) against('disk error')",
+		"select * from ft_logs where match(msg, host) against('+数据库 -warn* \"no space\"' in boolean mode) and id > 10",
+		"select * from ft_logs l where match(l.msg) against('disk' in natural language mode) > 1 order by match(l.msg) against('disk') desc",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"create table t1 (a int, b text, fulltext (b))",            // no primary key
+		"create table t1 (a int primary key, b int, fulltext (b))", // not a string column
+		"create table t1 (a int primary key, b text, fulltext (b) with parser unknown)",
+		"create table t1 (a int primary key, b text, c text as (b), fulltext (c))", // virtual generated column
+		"select * from ft_logs where match(host) against('disk')",                  // no fulltext index on host
+		"select * from ft_logs where match(msg) against('disk' with query expansion)",
+		"select * from ft_logs where match(msg) against(host)",
+		"select * from ft_logs where match(msg) against('+(disk error)' in boolean mode)",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "create table t1 (a int primary key, b text, c text, fulltext (b), fulltext (b, c) with parser NGRAM)")
+	assert.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	indexes := createTable.GetTableDef().GetIndexes()
+	assert.Equal(t, 2, len(indexes))
+	assert.Equal(t, "b", indexes[0].IndexName)
+	assert.True(t, indexes[0].Fulltext)
+	assert.Equal(t, "", indexes[0].Parser)
+	assert.Equal(t, "b_2", indexes[1].IndexName)
+	assert.Equal(t, "ngram", indexes[1].Parser)
+	assert.Equal(t, 2, len(createTable.GetIndexTables()))
+
+	// the candidate rows are looked up in the index table
+	logicPlan, err = runOneStmt(mock, t, "select * from ft_logs where match(msg) against('disk error')")
+	assert.NoError(t, err)
+	found := false
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_TABLE_SCAN && strings.HasPrefix(node.TableDef.Name, catalog.PrefixIndexTableName) {
+			found = true
+		}
+	}
+	assert.True(t, found)
+
+	// the document frequencies of the terms are counted in the index table
+	logicPlan, err = runOneStmt(mock, t, "select match(msg) against('disk error') from ft_logs")
+	assert.NoError(t, err)
+	found = false
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_TABLE_SCAN && strings.HasPrefix(node.TableDef.Name, catalog.PrefixIndexTableName) {
+			found = true
+		}
+	}
+	assert.True(t, found)
+	// the index rows of the deleted and updated rows are deleted
+	logicPlan, err = runOneStmt(mock, t, "delete from ft_logs where id = 1")
+	assert.NoError(t, err)
+	var idxRefs []*ObjectRef
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_DELETE {
+			idxRefs = node.DeleteCtx.IdxRef
+		}
+	}
+	assert.Equal(t, 2, len(idxRefs))
+	logicPlan, err = runOneStmt(mock, t, "update ft_logs set msg = 'disk full' where id = 1")
+	assert.NoError(t, err)
+	idxRefs = nil
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_UPDATE {
+			idxRefs = node.UpdateCtx.IdxRef
+		}
+	}
+	assert.Equal(t, 2, len(idxRefs))
+}
+
+func TestSecondaryIndexTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	// the secondary index of a table with primary key has an index table
+	logicPlan, err := runOneStmt(mock, t, "create table t1 (a int primary key, b int, c varchar(10), key (b), index idx_bc (b, c))")
+	assert.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	indexes := createTable.GetTableDef().GetIndexes()
+	assert.Equal(t, 2, len(indexes))
+	assert.Equal(t, 2, len(createTable.GetIndexTables()))
+	for i, indexDef := range indexes {
+		assert.False(t, indexDef.Unique)
+		assert.True(t, indexDef.TableExist)
+		assert.Equal(t, createTable.GetIndexTables()[i].Name, indexDef.IndexTableName)
+	}
+	assert.Equal(t, int32(types.T_int32), createTable.GetIndexTables()[0].Cols[0].Typ.Id)
+	assert.Equal(t, int32(types.T_varchar), createTable.GetIndexTables()[1].Cols[0].Typ.Id)
+
+	// the table without primary key has no index table
+	logicPlan, err = runOneStmt(mock, t, "create table t1 (a int, b int, key (b))")
+	assert.NoError(t, err)
+	createTable = logicPlan.GetDdl().GetCreateTable()
+	assert.Equal(t, 1, len(createTable.GetTableDef().GetIndexes()))
+	assert.False(t, createTable.GetTableDef().GetIndexes()[0].TableExist)
+	assert.Equal(t, 0, len(createTable.GetIndexTables()))
+
+	logicPlan, err = runOneStmt(mock, t, "create index idx on users (bio)")
+	assert.NoError(t, err)
+	createIndex := logicPlan.GetDdl().GetCreateIndex()
+	assert.True(t, createIndex.TableExist)
+	assert.Equal(t, 1, len(createIndex.GetIndex().GetIndexTables()))
+
+	// the index rows of the deleted and updated rows are deleted
+	logicPlan, err = runOneStmt(mock, t, "delete from users where id = 1")
+	assert.NoError(t, err)
+	var idxRefs []*ObjectRef
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_DELETE {
+			idxRefs = node.DeleteCtx.IdxRef
+		}
+	}
+	assert.Equal(t, 5, len(idxRefs))
+}
+
+func TestJsonIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"create table t1 (a int primary key, doc json, index idx ((cast(doc->'$.user_id' as unsigned))))",
+		"create table t1 (a int primary key, doc json, index ((cast(doc->>'$.name' as char(10)))), index ((cast(doc->'$.tags' as unsigned array))))",
+		"create index idx on json_docs ((cast(json_extract(doc, '$.age') as signed)))",
+		"alter table json_docs add index idx ((cast(doc->'$.tags' as char(10) array)))",
+		"select * from json_docs where cast(doc->'$.user_id' as unsigned) = 10",
+		"select * from json_docs d where 3 member of (d.doc->'$.tags') and id > 10",
+		"select * from json_docs where doc->>'$.name' = 'it''s'",
+		"show create table json_docs",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"create table t1 (a int, doc json, index ((cast(doc->'$.a' as unsigned))))",                            // no primary key
+		"create table t1 (a int primary key, b int, index ((cast(b->'$.a' as unsigned))))",                     // not a json column
+		"create table t1 (a int primary key, doc json, index ((doc->'$.a')))",                                  // no cast
+		"create table t1 (a int primary key, doc json, index ((cast(doc->'$[*]' as unsigned))))",               // wildcard path
+		"create table t1 (a int primary key, doc json, index ((cast(doc->'$.a' as json))))",                    // json key type
+		"create table t1 (a int primary key, doc json, unique key ((cast(doc->'$.a' as unsigned))))",           // unique
+		"create table t1 (a int primary key, doc json, c json as (doc), index ((cast(c->'$.a' as unsigned))))", // virtual generated column
+		"create table t1 (a int primary key, doc json, b int, index ((cast(doc->'$.a' as unsigned)), b))",      // more than one key part
+		"create table t1 (a int primary key, doc json, index ((cast(doc->>'$.a' as unsigned array))))",         // unquoted multi-valued
+		"select cast(doc->'$.tags' as unsigned array) from json_docs",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "create table t1 (a int primary key, doc json, index ((cast(doc->'$.\"user_id\"' as unsigned))), index ((cast(doc->'$.tags' as unsigned array))))")
+	assert.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	indexes := createTable.GetTableDef().GetIndexes()
+	assert.Equal(t, 2, len(indexes))
+	assert.Equal(t, "functional_index", indexes[0].IndexName)
+	assert.Equal(t, `$."user_id"`, indexes[0].JsonPath)
+	assert.Equal(t, int32(types.T_uint64), indexes[0].KeyType.Id)
+	assert.False(t, indexes[0].MultiValued)
+	assert.Equal(t, "cast(json_extract(doc, '$.\"user_id\"') as unsigned)", indexes[0].OriginString)
+	assert.Equal(t, "functional_index_2", indexes[1].IndexName)
+	assert.True(t, indexes[1].MultiValued)
+	assert.Equal(t, 2, len(createTable.GetIndexTables()))
+
+	// the candidate rows are looked up in the index table
+	for _, sql := range []string{
+		"select * from json_docs where cast(doc->'$.user_id' as unsigned) = 10",
+		"select * from json_docs where 3 member of (doc->'$.tags')",
+	} {
+		logicPlan, err = runOneStmt(mock, t, sql)
+		assert.NoError(t, err)
+		found := false
+		for _, node := range logicPlan.GetQuery().GetNodes() {
+			if node.NodeType == plan.Node_TABLE_SCAN && strings.HasPrefix(node.TableDef.Name, catalog.PrefixIndexTableName) {
+				assert.NotNil(t, node.IndexScanInfo, sql)
+				found = true
+			}
+			// the index table is joined to the table instead of a subquery on the primary key
+			assert.NotEqual(t, plan.Node_SEMI, node.JoinType, sql)
+		}
+		assert.True(t, found, sql)
+	}
+
+	// the index rows of the deleted and updated rows are deleted
+	logicPlan, err = runOneStmt(mock, t, "delete from json_docs where id = 1")
+	assert.NoError(t, err)
+	var idxRefs []*ObjectRef
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_DELETE {
+			idxRefs = node.DeleteCtx.IdxRef
+		}
+	}
+	assert.Equal(t, 5, len(idxRefs))
+	logicPlan, err = runOneStmt(mock, t, "update json_docs set doc = '{}' where id = 1")
+	assert.NoError(t, err)
+	idxRefs = nil
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_UPDATE {
+			idxRefs = node.UpdateCtx.IdxRef
+		}
+	}
+	assert.Equal(t, 5, len(idxRefs))
+
+	// the index is not used if the constant does not match the key type
+	logicPlan, err = runOneStmt(mock, t, "select * from json_docs where 'a' member of (doc->'$.tags')")
+	assert.NoError(t, err)
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			assert.False(t, strings.HasPrefix(node.TableDef.Name, catalog.PrefixIndexTableName))
+		}
+	}
+
+	// the index whose key type is the type of the cast is used, the scale of the decimal included
+	indexTables := map[string]string{
+		"idx_price":   catalog.PrefixIndexTableName + "secondary_3c1a70f4-7b4f-11ee-9a41-0242ac120002",
+		"idx_price_4": catalog.PrefixIndexTableName + "secondary_3c1a71c6-7b4f-11ee-9a41-0242ac120002",
+	}
+	for _, c := range []struct {
+		sql   string
+		table string
+	}{
+		{"select * from json_docs where cast(doc->'$.price' as decimal(10, 2)) = 1.5", indexTables["idx_price"]},
+		{"select * from json_docs where cast(doc->'$.price' as decimal(10, 4)) = 1.5", indexTables["idx_price_4"]},
+		{"select * from json_docs where cast(doc->'$.price' as decimal(10, 3)) = 1.5", ""},
+		// idx_name is on doc->>'$.name', the unquoted string
+		{"select * from json_docs where doc->>'$.name' = 'x'", catalog.PrefixIndexTableName + "secondary_3c1a6f46-7b4f-11ee-9a41-0242ac120002"},
+		{"select * from json_docs where cast(doc->'$.name' as varchar(32)) = 'x'", ""},
+	} {
+		logicPlan, err = runOneStmt(mock, t, c.sql)
+		assert.NoError(t, err)
+		var tables []string
+		for _, node := range logicPlan.GetQuery().GetNodes() {
+			if node.NodeType == plan.Node_TABLE_SCAN && strings.HasPrefix(node.TableDef.Name, catalog.PrefixIndexTableName) {
+				tables = append(tables, node.TableDef.Name)
+			}
+		}
+		if c.table == "" {
+			assert.Empty(t, tables, c.sql)
+		} else {
+			assert.Equal(t, []string{c.table}, tables, c.sql)
+		}
+	}
+}
+
+func TestColumnCompression(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	// should pass
+	sqls := []string{
+		"create table t1 (a int compression 'zstd' level 19, b varchar(10) compression 'none', c int) compression = 'zstd'",
+		"alter table nation add column n_extra int compression 'zstd' level 3",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"create table t1 (a int compression 'zlib')",
+		"create table t1 (a int compression 'lz4' level 3)",
+		"create table t1 (a int compression 'zstd' level 100)",
+		"create table t1 (a int) compression = 'zlib'",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	// the compression of the table is the default one of the columns
+	logicPlan, err := runOneStmt(mock, t, "create table t1 (a int compression 'zstd' level 19, b varchar(10) compression 'none', c int) compression = 'zstd'")
+	assert.NoError(t, err)
+	cols := logicPlan.GetDdl().GetCreateTable().GetTableDef().GetCols()
+	assert.Equal(t, plan.CompressType_Zstd, cols[0].Alg)
+	assert.Equal(t, int32(19), cols[0].CompressLevel)
+	assert.Equal(t, plan.CompressType_None, cols[1].Alg)
+	assert.Equal(t, plan.CompressType_Zstd, cols[2].Alg)
+	assert.Equal(t, int32(0), cols[2].CompressLevel)
+}
+
 func TestDelete(t *testing.T) {
 	mock := NewMockOptimizer(true)
 	// should pass
@@ -704,6 +1135,104 @@ func TestSubQuery(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestWindowFunction(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"SELECT n_name, row_number() over (partition by n_regionkey order by n_nationkey) FROM NATION",
+		"SELECT n_name, rank() over (order by n_regionkey desc), dense_rank() over (order by n_regionkey desc) FROM NATION",
+		"SELECT n_name, lag(n_name, 2, 'none') over (order by n_nationkey), lead(n_nationkey) over (order by n_nationkey) FROM NATION",
+		"SELECT first_value(n_name) over (partition by n_regionkey order by n_nationkey rows between unbounded preceding and unbounded following) FROM NATION",
+		"SELECT last_value(n_name) over (partition by n_regionkey order by n_nationkey rows between 1 preceding and 1 following) FROM NATION",
+		"SELECT n_nationkey, sum(n_nationkey) over (order by n_nationkey range between 2 preceding and current row) FROM NATION",
+		"SELECT n_nationkey, count(*) over (partition by n_regionkey), avg(n_nationkey) over () FROM NATION",
+		"SELECT n_regionkey, sum(count(*)) over (order by n_regionkey) FROM NATION group by n_regionkey",
+		"SELECT n_name FROM NATION order by rank() over (order by n_nationkey)",
+		"SELECT * FROM (SELECT n_name, rank() over (partition by n_regionkey order by n_nationkey) as r FROM NATION) t where r = 1",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT row_number() FROM NATION",                                                                                  // missing OVER
+		"SELECT abs(n_nationkey) over () FROM NATION",                                                                      // not a window function
+		"SELECT n_name FROM NATION where rank() over (order by n_nationkey) = 1",                                           // window in WHERE
+		"SELECT n_regionkey FROM NATION group by n_regionkey having rank() over () > 1",                                    // window in HAVING
+		"SELECT sum(rank() over (order by n_nationkey)) FROM NATION",                                                       // window in aggregate
+		"SELECT lag(rank() over (order by n_nationkey)) over () FROM NATION",                                               // nested window
+		"SELECT sum(n_nationkey) over (order by n_nationkey rows between unbounded following and current row) FROM NATION", // bad frame
+		"SELECT sum(n_nationkey) over (order by n_name range between 1 preceding and current row) FROM NATION",             // range offset on string
+		"SELECT sum(n_nationkey) over (order by n_nationkey groups between 1 preceding and current row) FROM NATION",       // groups
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestGroupingSets(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"SELECT n_regionkey, n_name, count(*) FROM NATION group by n_regionkey, n_name with rollup",
+		"SELECT n_regionkey, n_name, count(*), grouping(n_regionkey, n_name) FROM NATION group by rollup(n_regionkey, n_name)",
+		"SELECT n_regionkey, n_name, sum(n_nationkey) FROM NATION group by cube(n_regionkey, n_name)",
+		"SELECT n_regionkey, n_name, count(*) FROM NATION group by grouping sets ((n_regionkey, n_name), n_regionkey, ())",
+		"SELECT n_regionkey, n_name, count(*) FROM NATION group by n_regionkey, rollup(n_name)",
+		"SELECT n_regionkey, count(*) FROM NATION group by grouping sets ((n_regionkey), (n_regionkey))",
+		"SELECT n_regionkey, count(*) FROM NATION group by rollup(n_regionkey) having grouping(n_regionkey) = 0 and n_regionkey > 1",
+		"SELECT n_regionkey, count(*) FROM NATION group by rollup(n_regionkey) order by grouping(n_regionkey), n_regionkey",
+		"SELECT n_regionkey, grouping(n_regionkey) FROM NATION group by n_regionkey",
+		"SELECT * FROM (SELECT n_regionkey, count(*) c FROM NATION group by rollup(n_regionkey)) t where n_regionkey is null",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT grouping(n_regionkey) FROM NATION",                                                    // no GROUP BY
+		"SELECT n_regionkey, grouping(n_name) FROM NATION group by rollup(n_regionkey)",               // not a group column
+		"SELECT n_regionkey FROM NATION where grouping(n_regionkey) = 0 group by rollup(n_regionkey)", // GROUPING in WHERE
+		"SELECT sum(grouping(n_regionkey)) FROM NATION group by rollup(n_regionkey)",                  // GROUPING in aggregate
+		"SELECT n_regionkey FROM NATION group by rollup(count(*))",                                    // aggregate in GROUP BY
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestAsOf(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"SELECT n_name FROM NATION AS OF TIMESTAMP '2023-03-01 10:00:00'",
+		"SELECT n_name FROM NATION AS OF TIMESTAMP now() - interval 1 hour n",
+		"SELECT n_name FROM NATION AS OF TS '1677664800000000000-1' n join REGION r on n.n_regionkey = r.r_regionkey",
+		"SELECT * FROM NATION AS OF TIMESTAMP '2023-03-01 10:00:00' n1, NATION AS OF TIMESTAMP '2023-03-02 10:00:00' n2 where n1.n_nationkey = n2.n_nationkey",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	logicPlan, err := runOneStmt(mock, t, "SELECT n_name FROM NATION AS OF TIMESTAMP '2023-03-01 10:00:00' n join REGION r on n.n_regionkey = r.r_regionkey")
+	require.NoError(t, err)
+	var snapshots []*timestamp.Timestamp
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			if node.SnapshotTs != nil {
+				require.True(t, node.NotCacheable)
+			}
+			snapshots = append(snapshots, node.SnapshotTs)
+		}
+	}
+	require.Equal(t, 2, len(snapshots))
+	require.Nil(t, snapshots[1])
+	expect, _ := time.Parse("2006-01-02 15:04:05", "2023-03-01 10:00:00")
+	require.Equal(t, expect.UnixNano(), snapshots[0].PhysicalTime)
+
+	// should error
+	sqls = []string{
+		"SELECT n_name FROM NATION AS OF TIMESTAMP '2999-01-01 00:00:00'",                   // in the future
+		"SELECT n_name FROM NATION AS OF TIMESTAMP n_name",                                  // not a constant
+		"SELECT n_name FROM NATION AS OF TS 'abc'",                                          // invalid ts
+		"SELECT n_name FROM v1 AS OF TIMESTAMP '2023-03-01 10:00:00'",                       // view
+		"SELECT * FROM (SELECT n_name FROM NATION AS OF TIMESTAMP '2999-01-01 00:00:00') t", // in the future
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestMysqlCompatibilityMode(t *testing.T) {
 	mock := NewMockOptimizer(false)
 
@@ -771,6 +1300,12 @@ func TestDdl(t *testing.T) {
 		"unlock tables",
 		"alter table emp drop foreign key fk1",
 		"alter table nation add FOREIGN KEY fk_t1(n_nationkey) REFERENCES nation2(n_nationkey)",
+		"alter table nation add column n_extra int default 1",
+		"alter table nation add n_extra varchar(20)",
+		"alter table nation rename column n_comment to n_desc",
+		"alter table nation add column n_extra int, rename column n_comment to n_desc",
+		"alter table nation add index idx1 (n_name)",
+		"alter table nation add unique index idx1 (n_name)",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -790,6 +1325,21 @@ func TestDdl(t *testing.T) {
 		"alter table nation drop foreign key fk1", //key not exists
 		"alter table nation add FOREIGN KEY fk_t1(col_not_exist) REFERENCES nation2(n_nationkey)",
 		"alter table nation add FOREIGN KEY fk_t1(n_nationkey) REFERENCES nation2(col_not_exist)",
+		"alter table nation add column n_name int",                      //column exists
+		"alter table nation add column n_extra int after col_not_exist", //column not exists
+		"alter table nation add column n_extra int primary key",         //key column
+		"alter table nation modify n_nationkey bigint",                  //primary key
+		"alter table nation rename column n_comment to n_name",          //column exists
+		"alter table nation drop column col_not_exist",                  //column not exists
+		"alter table constraint_test.emp add column c int",              //table has foreign keys
+		"alter table nation add index idx1 (col_not_exist)",
+		"alter table nation add column n_extra int default 1 after n_name", //not in place
+		"alter table nation add n_extra varchar(20) first",
+		"alter table nation add column n_extra int not null",
+		"alter table nation modify n_comment varchar(200) not null",
+		"alter table nation change column n_comment n_desc text after n_nationkey",
+		"alter table nation drop column n_comment",
+		"alter table nation add column n_extra int, drop column n_comment",
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -828,6 +1378,8 @@ func TestShow(t *testing.T) {
 		"show grants for ROLE role1",
 		"show function status",
 		"show function status like '%ff'",
+		"show procedure status",
+		"show procedure status like '%pp'",
 		// "show grants",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
@@ -930,6 +1482,42 @@ func TestBuildUnnest(t *testing.T) {
 	runTestShouldError(mock, t, errSqls)
 }
 
+func TestBuildJsonTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		`select * from json_table('[{"a":1},{"a":2}]', '$[*]' columns (id for ordinality, a int path '$.a')) as jt`,
+		`select a, c from json_table('{"a":"x","c":[1,2]}', '$' columns (a varchar(10) path '$.a' default '"y"' on empty error on error, b int exists path '$.b', nested path '$.c[*]' columns (c int path '$'))) as jt where c > 1`,
+		`select n_name, jt.k from nation, json_table(json_object('k', n_nationkey), '$' columns (k int path '$.k')) as jt`,
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+	errSqls := []string{
+		`select * from json_table('[]', '$[*]' columns (a int path '$.a', a int path '$.b')) as jt`,
+		`select * from json_table('[]', 'a' columns (a int path '$.a')) as jt`,
+		`select * from json_table('[]', '$' columns (a int path 'b')) as jt`,
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
+func TestBuildJsonFunctions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		`select json_set('{"a":1}', '$.a', 2, '$.b', n_name) from nation`,
+		`select json_insert('{"a":1}', '$.b', n_nationkey), json_replace('{"a":1}', '$.a', null), json_remove('{"a":1}', '$.a') from nation`,
+		`select json_array(n_nationkey, n_name), json_object('k', n_nationkey) from nation`,
+		`select json_contains('[1,2]', '1'), json_contains('{"a":[1]}', '1', '$.a'), json_contains_path('{"a":1}', 'one', '$.a', '$.b')`,
+		`select json_keys('{"a":1}'), json_keys('{"a":{"b":1}}', '$.a'), json_length('[1,2]'), json_length('{"a":[1]}', '$.a')`,
+		`select n_regionkey, json_arrayagg(n_name), json_objectagg(n_nationkey, n_name) from nation group by n_regionkey`,
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+	errSqls := []string{
+		`select json_set('{"a":1}', '$.a')`,
+		`select json_object('k')`,
+		`select json_objectagg(n_name) from nation`,
+		`select json_length(1)`,
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
 func TestVisitRule(t *testing.T) {
 	sql := "select * from nation where n_nationkey > ? or n_nationkey=@int_var or abs(-1) > 1"
 	mock := NewMockOptimizer(false)
@@ -30,6 +30,7 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 		tblInfo: tblInfo,
 	}
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	builder.isDML = true
 	bindCtx := NewBindContext(builder, nil)
 	bindCtx.groupTag = builder.genNewTag()
 	bindCtx.aggregateTag = builder.genNewTag()
@@ -152,7 +153,7 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 }
 
 func isSameColumnType(t1 *Type, t2 *Type) bool {
-	if t1.Id != t2.Id {
+	if t1.Id != t2.Id || t1.Enumvalues != t2.Enumvalues {
 		return false
 	}
 	if t1.Width == t2.Width && t1.Scale == t2.Scale {
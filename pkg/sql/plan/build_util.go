@@ -156,6 +156,8 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (*pla
 			return &plan.Type{Id: int32(types.T_blob)}, nil
 		case defines.MYSQL_TYPE_LONG_BLOB:
 			return &plan.Type{Id: int32(types.T_blob)}, nil
+		case defines.MYSQL_TYPE_ENUM, defines.MYSQL_TYPE_SET:
+			return getEnumTypeFromAst(ctx, n)
 		default:
 			return nil, moerr.NewNYI(ctx, "data type: '%s'", tree.String(&n.InternalType, dialect.MYSQL))
 		}
@@ -163,6 +165,28 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (*pla
 	return nil, moerr.NewInternalError(ctx, "unknown data type")
 }
 
+func getEnumTypeFromAst(ctx context.Context, n *tree.T) (*plan.Type, error) {
+	typ, maxLen := types.T_enum, types.MaxEnumLen
+	if defines.MysqlType(n.InternalType.Oid) == defines.MYSQL_TYPE_SET {
+		typ, maxLen = types.T_set, types.MaxSetLen
+	}
+	values := n.InternalType.EnumValues
+	if len(values) > maxLen {
+		return nil, moerr.NewInvalidInput(ctx, "too many members for %s, the max is %d", typ.String(), maxLen)
+	}
+	for i, v := range values {
+		if typ == types.T_set && strings.Contains(v, ",") {
+			return nil, moerr.NewInvalidInput(ctx, "illegal member '%s' for SET", v)
+		}
+		for _, prev := range values[:i] {
+			if strings.EqualFold(strings.TrimRight(prev, " "), strings.TrimRight(v, " ")) {
+				return nil, moerr.NewInvalidInput(ctx, "duplicate member '%s' for %s", v, typ.String())
+			}
+		}
+	}
+	return &plan.Type{Id: int32(typ), Enumvalues: types.EncodeEnumValues(values)}, nil
+}
+
 func buildDefaultExpr(col *tree.ColumnTableDef, typ *plan.Type, proc *process.Process) (*plan.Default, error) {
 	nullAbility := true
 	var expr tree.Expr = nil
@@ -271,6 +295,175 @@ func buildOnUpdate(col *tree.ColumnTableDef, typ *plan.Type, proc *process.Proce
 	return ret, nil
 }
 
+func checkGeneratedColDef(ctx context.Context, name string, autoIncr bool, defaultValue *plan.Default) error {
+	if autoIncr {
+		return moerr.NewInvalidInput(ctx, "generated column '%s' cannot be auto_increment", name)
+	}
+	if defaultValue != nil && defaultValue.Expr != nil {
+		return moerr.NewInvalidInput(ctx, "generated column '%s' cannot have default value", name)
+	}
+	return nil
+}
+
+// buildGeneratedColumns binds the expressions of the generated columns over the
+// other columns of the table. A generated column can refer to the generated
+// columns defined before it, but not to itself, the later ones or the
+// auto_increment columns.
+// bindTableDef makes a bind context in which the expressions can refer to the
+// columns of the table being created.
+func bindTableDef(ctx CompilerContext, tableDef *TableDef) (*QueryBuilder, *BindContext, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return nil, nil, err
+	}
+	return builder, bindContext, nil
+}
+
+func buildGeneratedColumns(ctx CompilerContext, tableDef *TableDef, generatedDefs map[string]*tree.AttributeGeneratedAlways) error {
+	builder, bindContext, err := bindTableDef(ctx, tableDef)
+	if err != nil {
+		return err
+	}
+
+	binder := NewGeneratedBinder(builder, bindContext)
+	for i, col := range tableDef.Cols {
+		def, ok := generatedDefs[col.Name]
+		if !ok {
+			continue
+		}
+		expr, err := binder.BindExpr(def.Expr, 0, true)
+		if err != nil {
+			return err
+		}
+		if err = checkGeneratedColRefs(ctx.GetContext(), expr, tableDef, i, generatedDefs); err != nil {
+			return err
+		}
+		expr, err = makePlan2CastExpr(ctx.GetContext(), expr, DeepCopyTyp(col.Typ))
+		if err != nil {
+			return err
+		}
+		fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithSingleQuoteString())
+		def.Expr.Format(fmtCtx)
+		col.Generated = &plan.GeneratedCol{
+			Expr:         expr,
+			OriginString: fmtCtx.String(),
+			Stored:       def.Stored,
+		}
+	}
+	return nil
+}
+
+// checkGeneratedColRefs checks the columns referenced by the expression of the
+// idx-th column and records their names, the expression is evaluated by name.
+func checkGeneratedColRefs(ctx context.Context, expr *Expr, tableDef *TableDef, idx int, generatedDefs map[string]*tree.AttributeGeneratedAlways) error {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		col := tableDef.Cols[exprImpl.Col.ColPos]
+		if _, ok := generatedDefs[col.Name]; ok && int(exprImpl.Col.ColPos) >= idx {
+			return moerr.NewInvalidInput(ctx, "generated column '%s' cannot refer to itself or a generated column defined after it", tableDef.Cols[idx].Name)
+		}
+		if col.Typ.AutoIncr {
+			return moerr.NewInvalidInput(ctx, "generated column '%s' cannot refer to auto-increment column '%s'", tableDef.Cols[idx].Name, col.Name)
+		}
+		exprImpl.Col.Name = col.Name
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if err := checkGeneratedColRefs(ctx, arg, tableDef, idx, generatedDefs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkConstraintDef is a check constraint of CREATE TABLE, colName is the
+// column of a column check constraint and empty for a table check constraint.
+type checkConstraintDef struct {
+	name     string
+	expr     tree.Expr
+	enforced bool
+	colName  string
+}
+
+func buildCheckConstraints(ctx CompilerContext, tableDef *TableDef, checkDefs []*checkConstraintDef) error {
+	builder, bindContext, err := bindTableDef(ctx, tableDef)
+	if err != nil {
+		return err
+	}
+
+	binder := NewCheckBinder(builder, bindContext)
+	names := make(map[string]bool, len(checkDefs))
+	for _, def := range checkDefs {
+		if def.name != "" {
+			if names[def.name] {
+				return moerr.NewInvalidInput(ctx.GetContext(), "Duplicate check constraint name '%s'.", def.name)
+			}
+			names[def.name] = true
+		}
+	}
+	// the anonymous constraints are named as MySQL does, <table>_chk_<n>
+	n := 0
+	for _, def := range checkDefs {
+		name := def.name
+		if name == "" {
+			for name == "" || names[name] {
+				n++
+				name = fmt.Sprintf("%s_chk_%d", tableDef.Name, n)
+			}
+			names[name] = true
+		}
+		expr, err := binder.BindExpr(def.expr, 0, true)
+		if err != nil {
+			return err
+		}
+		if err = checkCheckConstraintRefs(ctx.GetContext(), expr, tableDef, name, def.colName); err != nil {
+			return err
+		}
+		expr, err = makePlan2CastExpr(ctx.GetContext(), expr, &plan.Type{Id: int32(types.T_bool)})
+		if err != nil {
+			return err
+		}
+		fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithSingleQuoteString())
+		def.expr.Format(fmtCtx)
+		tableDef.Checks = append(tableDef.Checks, &plan.CheckDef{
+			Name:         name,
+			Check:        expr,
+			OriginString: fmtCtx.String(),
+			Enforced:     def.enforced,
+		})
+	}
+	return nil
+}
+
+// checkCheckConstraintRefs checks the columns referenced by the expression of
+// the check constraint and records their names, the expression is evaluated by name.
+func checkCheckConstraintRefs(ctx context.Context, expr *Expr, tableDef *TableDef, name string, colName string) error {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		col := tableDef.Cols[exprImpl.Col.ColPos]
+		if col.Typ.AutoIncr {
+			return moerr.NewInvalidInput(ctx, "Check constraint '%s' cannot refer to an auto-increment column.", name)
+		}
+		if colName != "" && col.Name != colName {
+			return moerr.NewInvalidInput(ctx, "Column check constraint '%s' references other column.", name)
+		}
+		exprImpl.Col.Name = col.Name
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if err := checkCheckConstraintRefs(ctx, arg, tableDef, name, colName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func isNullExpr(expr *plan.Expr) bool {
 	if expr == nil {
 		return false
@@ -382,10 +575,11 @@ func getAccountInfoOfClusterTable(ctx CompilerContext, accounts tree.IdentifierL
 }
 
 func getDefaultExpr(ctx context.Context, d *plan.ColDef) (*Expr, error) {
-	if !d.Default.NullAbility && d.Default.Expr == nil && !d.Typ.AutoIncr {
+	// the generated column is computed by the insert pipeline
+	if d.Generated == nil && !d.Default.NullAbility && d.Default.Expr == nil && !d.Typ.AutoIncr {
 		return nil, moerr.NewInvalidInput(ctx, "invalid default value")
 	}
-	if d.Default.Expr == nil {
+	if d.Generated != nil || d.Default.Expr == nil {
 		return &Expr{
 			Expr: &plan.Expr_C{
 				C: &Const{
@@ -0,0 +1,68 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func NewCheckBinder(builder *QueryBuilder, ctx *BindContext) *CheckBinder {
+	b := &CheckBinder{}
+	b.sysCtx = builder.GetContext()
+	b.builder = builder
+	b.ctx = ctx
+	b.impl = b
+	return b
+}
+
+// From: https://dev.mysql.com/doc/refman/8.0/en/create-table-check-constraints.html
+// the check constraint expressions have the same restrictions as the generated column expressions.
+func (b *CheckBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	switch exprImpl := astExpr.(type) {
+	case *tree.FuncExpr:
+		funcRef, ok := exprImpl.Func.FunctionReference.(*tree.UnresolvedName)
+		if !ok {
+			return nil, moerr.NewNYI(b.GetContext(), "invalid function expr '%v'", exprImpl)
+		}
+		funcName := strings.ToLower(funcRef.Parts[0])
+		if _, ok := nonDeterministicFunctions[funcName]; ok {
+			return nil, moerr.NewInvalidInput(b.GetContext(), "An expression of a check constraint contains disallowed function: %s.", funcName)
+		}
+	case *tree.VarExpr, *tree.ParamExpr:
+		return nil, moerr.NewInvalidInput(b.GetContext(), "An expression of a check constraint contains disallowed variable '%s'.", tree.String(astExpr, dialect.MYSQL))
+	}
+	return b.baseBindExpr(astExpr, depth, isRoot)
+}
+
+func (b *CheckBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32, isRoot bool) (*plan.Expr, error) {
+	return b.baseBindColRef(astExpr, depth, isRoot)
+}
+
+func (b *CheckBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(b.GetContext(), "aggregate functions not allowed in check constraint expression")
+}
+
+func (b *CheckBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(b.GetContext(), "window functions not allowed in check constraint expression")
+}
+
+func (b *CheckBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(b.GetContext(), "subquery not allowed in check constraint expression")
+}
@@ -0,0 +1,390 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	hll "github.com/axiomhq/hyperloglog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	// HistogramBucketNum is the max number of buckets in the histogram of a column
+	HistogramBucketNum = 64
+	// McvNum is the max number of the most common values of a column
+	McvNum = 16
+	// maxTrackedValues limits the memory used to count the frequency of the values in the sample
+	maxTrackedValues = 100000
+)
+
+// HistogramBucket is a bucket of the equi-depth histogram.
+// Count is the fraction of the not null rows in the bucket.
+type HistogramBucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count float64 `json:"count"`
+	Ndv   float64 `json:"ndv"`
+}
+
+// McvItem is one of the most common values of a column.
+// Freq is the fraction of all rows having the value.
+type McvItem struct {
+	Value string  `json:"value"`
+	Freq  float64 `json:"freq"`
+}
+
+// ColumnStats is the statistics of a column collected by ANALYZE TABLE.
+// The histogram is only built for the types which can be ordered as float64.
+type ColumnStats struct {
+	RowCount  float64
+	NullCount float64
+	Ndv       float64
+	Buckets   []HistogramBucket
+	Mcv       []McvItem
+}
+
+func (cs *ColumnStats) nullFraction() float64 {
+	if cs.RowCount <= 0 {
+		return 0
+	}
+	return math.Min(cs.NullCount/cs.RowCount, 1)
+}
+
+// EqualSelectivity returns the fraction of rows equal to the value of the key.
+func (cs *ColumnStats) EqualSelectivity(key string) float64 {
+	rest := 1 - cs.nullFraction()
+	for _, item := range cs.Mcv {
+		if item.Value == key {
+			return item.Freq
+		}
+		rest -= item.Freq
+	}
+	restNdv := cs.Ndv - float64(len(cs.Mcv))
+	if restNdv < 1 {
+		restNdv = 1
+	}
+	if rest <= 0 {
+		// the value is not a common one, assume only a few rows have it
+		return 1 / math.Max(cs.RowCount, 1)
+	}
+	return rest / restNdv
+}
+
+// RangeSelectivity returns the fraction of rows satisfying "col funcName val", it returns -1
+// if there is no histogram.
+func (cs *ColumnStats) RangeSelectivity(funcName string, val float64) float64 {
+	if len(cs.Buckets) == 0 {
+		return -1
+	}
+	var less, equal float64
+	for _, b := range cs.Buckets {
+		switch {
+		case b.Upper < val:
+			less += b.Count
+		case b.Lower > val:
+		case b.Upper == b.Lower:
+			equal += b.Count
+		default:
+			ndv := math.Max(b.Ndv, 1)
+			equal += b.Count / ndv
+			less += (b.Count - b.Count/ndv) * (val - b.Lower) / (b.Upper - b.Lower)
+		}
+	}
+	var sel float64
+	switch funcName {
+	case "<":
+		sel = less
+	case "<=":
+		sel = less + equal
+	case ">":
+		sel = 1 - less - equal
+	case ">=":
+		sel = 1 - less
+	default:
+		return -1
+	}
+	return math.Max(sel, 0) * (1 - cs.nullFraction())
+}
+
+// statsKeyOfFloat is the key of the value of the ordered types in the most common values
+func statsKeyOfFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// GetConstStatsKey returns the key used by the most common values and the value used by the
+// histogram of a constant, ok is false if the type of the constant is not supported.
+func GetConstStatsKey(c *plan.Const) (key string, val float64, ordered, ok bool) {
+	switch v := c.Value.(type) {
+	case *plan.Const_I8Val:
+		val, ordered = float64(v.I8Val), true
+	case *plan.Const_I16Val:
+		val, ordered = float64(v.I16Val), true
+	case *plan.Const_I32Val:
+		val, ordered = float64(v.I32Val), true
+	case *plan.Const_I64Val:
+		val, ordered = float64(v.I64Val), true
+	case *plan.Const_U8Val:
+		val, ordered = float64(v.U8Val), true
+	case *plan.Const_U16Val:
+		val, ordered = float64(v.U16Val), true
+	case *plan.Const_U32Val:
+		val, ordered = float64(v.U32Val), true
+	case *plan.Const_U64Val:
+		val, ordered = float64(v.U64Val), true
+	case *plan.Const_Fval:
+		val, ordered = float64(v.Fval), true
+	case *plan.Const_Dval:
+		val, ordered = v.Dval, true
+	case *plan.Const_Bval:
+		if v.Bval {
+			val = 1
+		}
+		ordered = true
+	case *plan.Const_Dateval:
+		val, ordered = float64(v.Dateval), true
+	case *plan.Const_Timeval:
+		val, ordered = float64(v.Timeval), true
+	case *plan.Const_Datetimeval:
+		val, ordered = float64(v.Datetimeval), true
+	case *plan.Const_Timestampval:
+		val, ordered = float64(v.Timestampval), true
+	case *plan.Const_Sval:
+		return v.Sval, 0, false, true
+	default:
+		return "", 0, false, false
+	}
+	return statsKeyOfFloat(val), val, true, true
+}
+
+// ColumnStatsBuilder collects the values of a column from the sampled blocks, the type of the
+// column must be supported by SupportColumnStats.
+type ColumnStatsBuilder struct {
+	typ       types.Type
+	rows      float64
+	nulls     float64
+	sketch    *hll.Sketch
+	counts    map[string]float64
+	overflow  bool
+	orderKeys []float64
+}
+
+func NewColumnStatsBuilder(typ types.Type) *ColumnStatsBuilder {
+	return &ColumnStatsBuilder{
+		typ:    typ,
+		sketch: hll.New(),
+		counts: make(map[string]float64),
+	}
+}
+
+// IsOrderedType reports whether the values of the type can be ordered as float64
+func IsOrderedType(t types.T) bool {
+	switch t {
+	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+		types.T_enum, types.T_set:
+		return true
+	}
+	return false
+}
+
+// SupportColumnStats reports whether ANALYZE TABLE can collect the statistics of the type
+func SupportColumnStats(typ types.Type) bool {
+	return IsOrderedType(typ.Oid) || typ.IsVarlen() || typ.Oid == types.T_uuid
+}
+
+func vectorFloatAt(vec *vector.Vector, i int) float64 {
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool:
+		if vector.GetFixedAt[bool](vec, i) {
+			return 1
+		}
+		return 0
+	case types.T_int8:
+		return float64(vector.GetFixedAt[int8](vec, i))
+	case types.T_int16:
+		return float64(vector.GetFixedAt[int16](vec, i))
+	case types.T_int32:
+		return float64(vector.GetFixedAt[int32](vec, i))
+	case types.T_int64:
+		return float64(vector.GetFixedAt[int64](vec, i))
+	case types.T_uint8:
+		return float64(vector.GetFixedAt[uint8](vec, i))
+	case types.T_uint16, types.T_enum:
+		return float64(vector.GetFixedAt[uint16](vec, i))
+	case types.T_uint32:
+		return float64(vector.GetFixedAt[uint32](vec, i))
+	case types.T_uint64, types.T_set:
+		return float64(vector.GetFixedAt[uint64](vec, i))
+	case types.T_float32:
+		return float64(vector.GetFixedAt[float32](vec, i))
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, i)
+	case types.T_decimal64:
+		return types.Decimal64ToFloat64(vector.GetFixedAt[types.Decimal64](vec, i), typ.Scale)
+	case types.T_decimal128:
+		return types.Decimal128ToFloat64(vector.GetFixedAt[types.Decimal128](vec, i), typ.Scale)
+	case types.T_date:
+		return float64(vector.GetFixedAt[types.Date](vec, i))
+	case types.T_time:
+		return float64(vector.GetFixedAt[types.Time](vec, i))
+	case types.T_datetime:
+		return float64(vector.GetFixedAt[types.Datetime](vec, i))
+	case types.T_timestamp:
+		return float64(vector.GetFixedAt[types.Timestamp](vec, i))
+	}
+	return 0
+}
+
+// Add collects the values of the vector
+func (b *ColumnStatsBuilder) Add(vec *vector.Vector) {
+	ordered := IsOrderedType(b.typ.Oid)
+	for i := 0; i < vec.Length(); i++ {
+		b.rows++
+		if vec.IsConstNull() || vec.GetNulls().Contains(uint64(i)) {
+			b.nulls++
+			continue
+		}
+		var key string
+		if ordered {
+			f := vectorFloatAt(vec, i)
+			b.orderKeys = append(b.orderKeys, f)
+			key = statsKeyOfFloat(f)
+		} else if b.typ.IsVarlen() {
+			key = vec.GetStringAt(i)
+		} else {
+			key = vector.GetFixedAt[types.Uuid](vec, i).ToString()
+		}
+		b.sketch.Insert([]byte(key))
+		if _, ok := b.counts[key]; ok || len(b.counts) < maxTrackedValues {
+			b.counts[key]++
+		} else {
+			b.overflow = true
+		}
+	}
+}
+
+// Build builds the statistics of the column, tableRows is the number of rows in the table and
+// the collected rows are a sample of them if they are less.
+func (b *ColumnStatsBuilder) Build(tableRows float64) *ColumnStats {
+	if tableRows < b.rows {
+		tableRows = b.rows
+	}
+	cs := &ColumnStats{RowCount: tableRows}
+	if b.rows == 0 {
+		return cs
+	}
+	scale := tableRows / b.rows
+	cs.NullCount = b.nulls * scale
+	notNull := b.rows - b.nulls
+	if notNull == 0 {
+		return cs
+	}
+
+	// the distinct values in the sample
+	d := math.Min(float64(b.sketch.Estimate()), notNull)
+	if !b.overflow && len(b.counts) > 0 {
+		d = float64(len(b.counts))
+	}
+	switch {
+	case scale <= 1:
+		cs.Ndv = d
+	case b.overflow:
+		// too many distinct values, assume the column is nearly unique
+		cs.Ndv = d * scale
+	default:
+		// Duj1 estimator: n*d / (n - f1 + f1*n/N)
+		var f1 float64
+		for _, cnt := range b.counts {
+			if cnt == 1 {
+				f1++
+			}
+		}
+		cs.Ndv = notNull * d / (notNull - f1 + f1*b.rows/tableRows)
+	}
+	cs.Ndv = math.Max(math.Min(cs.Ndv, tableRows-cs.NullCount), 1)
+
+	cs.Mcv = b.buildMcv(notNull)
+	cs.Buckets = b.buildHistogram()
+	return cs
+}
+
+// buildMcv keeps the values appearing more often than the average
+func (b *ColumnStatsBuilder) buildMcv(notNull float64) []McvItem {
+	if len(b.counts) == 0 {
+		return nil
+	}
+	avg := notNull / float64(len(b.counts))
+	items := make([]McvItem, 0, McvNum)
+	for key, cnt := range b.counts {
+		if cnt > 1 && cnt > avg*1.25 {
+			items = append(items, McvItem{Value: key, Freq: cnt})
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Freq != items[j].Freq {
+			return items[i].Freq > items[j].Freq
+		}
+		return items[i].Value < items[j].Value
+	})
+	if len(items) > McvNum {
+		items = items[:McvNum]
+	}
+	for i := range items {
+		items[i].Freq /= b.rows
+	}
+	return items
+}
+
+// buildHistogram builds the equi-depth histogram, the same values are always in the same bucket
+func (b *ColumnStatsBuilder) buildHistogram() []HistogramBucket {
+	keys := b.orderKeys
+	if len(keys) == 0 {
+		return nil
+	}
+	sort.Float64s(keys)
+	depth := (len(keys) + HistogramBucketNum - 1) / HistogramBucketNum
+	buckets := make([]HistogramBucket, 0, HistogramBucketNum)
+	for start := 0; start < len(keys); {
+		end := start + depth
+		if end > len(keys) {
+			end = len(keys)
+		}
+		for end < len(keys) && keys[end] == keys[end-1] {
+			end++
+		}
+		ndv := 1.0
+		for i := start + 1; i < end; i++ {
+			if keys[i] != keys[i-1] {
+				ndv++
+			}
+		}
+		buckets = append(buckets, HistogramBucket{
+			Lower: keys[start],
+			Upper: keys[end-1],
+			Count: float64(end-start) / float64(len(keys)),
+			Ndv:   ndv,
+		})
+		start = end
+	}
+	return buckets
+}
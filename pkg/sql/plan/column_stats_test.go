@@ -0,0 +1,143 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+// buildSkewedColumnStats builds the statistics of 10000 rows, half of them are 1 and the others are 2, 3, ... 5001
+func buildSkewedColumnStats(t *testing.T) *ColumnStats {
+	mp := mpool.MustNewZero()
+	vec := vector.NewVec(types.T_int64.ToType())
+	defer vec.Free(mp)
+	for i := 0; i < 10000; i++ {
+		v := int64(1)
+		if i%2 == 1 {
+			v = int64(i/2 + 2)
+		}
+		require.NoError(t, vector.AppendFixed(vec, v, false, mp))
+	}
+	require.NoError(t, vector.AppendFixed(vec, int64(0), true, mp))
+
+	builder := NewColumnStatsBuilder(types.T_int64.ToType())
+	builder.Add(vec)
+	return builder.Build(10001)
+}
+
+func makeColumnFilterForTest(funcName string, val int64) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_bool)},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &ObjectRef{ObjName: funcName},
+				Args: []*plan.Expr{
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "a"}},
+					},
+					makePlan2Int64ConstExprWithType(val),
+				},
+			},
+		},
+	}
+}
+
+func TestColumnStatsBuilder(t *testing.T) {
+	cs := buildSkewedColumnStats(t)
+	require.Equal(t, float64(10001), cs.RowCount)
+	require.Equal(t, float64(1), cs.NullCount)
+	require.Equal(t, float64(5001), cs.Ndv)
+	require.Len(t, cs.Mcv, 1)
+	require.Equal(t, "1", cs.Mcv[0].Value)
+	require.InDelta(t, 0.5, cs.Mcv[0].Freq, 0.001)
+	require.LessOrEqual(t, len(cs.Buckets), HistogramBucketNum)
+
+	// the value 1 is in only one bucket
+	var sum float64
+	for i, b := range cs.Buckets {
+		if i > 0 {
+			require.Less(t, cs.Buckets[i-1].Upper, b.Lower)
+		}
+		sum += b.Count
+	}
+	require.InDelta(t, 1, sum, 0.0001)
+
+	require.InDelta(t, 0.5, cs.EqualSelectivity("1"), 0.001)
+	require.InDelta(t, 1.0/10000, cs.EqualSelectivity("100"), 0.00001)
+	require.InDelta(t, 0.75, cs.RangeSelectivity("<", 2501), 0.01)
+	require.InDelta(t, 0.25, cs.RangeSelectivity(">=", 2501), 0.01)
+	require.InDelta(t, 0, cs.RangeSelectivity("<", 1), 0.0001)
+	require.InDelta(t, 0.5, cs.RangeSelectivity("<=", 1), 0.0001)
+	require.Equal(t, float64(-1), cs.RangeSelectivity("=", 1))
+}
+
+func TestColumnStatsBuilderSample(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vec := vector.NewVec(types.T_varchar.ToType())
+	defer vec.Free(mp)
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, vector.AppendBytes(vec, []byte(fmt.Sprintf("v%d", i)), false, mp))
+	}
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, vector.AppendBytes(vec, []byte("common"), false, mp))
+	}
+
+	builder := NewColumnStatsBuilder(types.T_varchar.ToType())
+	builder.Add(vec)
+	// the collected rows are a tenth of the table
+	cs := builder.Build(20000)
+	require.Nil(t, cs.Buckets)
+	require.Len(t, cs.Mcv, 1)
+	require.Equal(t, "common", cs.Mcv[0].Value)
+	// the values appearing once in the sample are more in the table, n*d / (n - f1 + f1*n/N)
+	require.InDelta(t, 2000*1001/(2000-1000+1000*0.1), cs.Ndv, 0.001)
+}
+
+func TestEstimateOutCntWithColumnStats(t *testing.T) {
+	s := NewStatsInfoMap()
+	s.TableCnt = 10001
+	s.NdvMap["a"] = 10001
+	s.DataTypeMap["a"] = types.T_int64
+	s.MinValMap["a"] = 1
+	s.MaxValMap["a"] = 5001
+
+	// the estimation by ndv and min/max values is bad for the skewed column
+	require.InDelta(t, 1, EstimateOutCnt(makeColumnFilterForTest("=", 1), "", 10001, 10001, s), 0.001)
+	require.InDelta(t, 5000, EstimateOutCnt(makeColumnFilterForTest("<", 2501), "", 10001, 10001, s), 10)
+
+	s.ColumnStats = map[string]*ColumnStats{"a": buildSkewedColumnStats(t)}
+	require.InDelta(t, 5000, EstimateOutCnt(makeColumnFilterForTest("=", 1), "", 10001, 10001, s), 10)
+	require.InDelta(t, 1, EstimateOutCnt(makeColumnFilterForTest("=", 100), "", 10001, 10001, s), 0.1)
+	require.InDelta(t, 7500, EstimateOutCnt(makeColumnFilterForTest("<", 2501), "", 10001, 10001, s), 100)
+}
+
+func TestGetHighNDVColumnsWithColumnStats(t *testing.T) {
+	s := NewStatsInfoMap()
+	s.TableCnt = 10001
+	s.NdvMap["a"] = 10001
+	b := NewBinding(0, 1, "t", []string{"a"}, []*plan.Type{{Id: int32(types.T_int64)}}, false)
+	require.Equal(t, []int32{0}, GetHighNDVColumns(s, b))
+
+	s.ColumnStats = map[string]*ColumnStats{"a": buildSkewedColumnStats(t)}
+	require.Empty(t, GetHighNDVColumns(s, b))
+}
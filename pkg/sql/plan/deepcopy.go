@@ -35,6 +35,60 @@ func DeepCopyOrderBy(orderBy *plan.OrderBySpec) *plan.OrderBySpec {
 	}
 }
 
+func DeepCopyRuntimeFilterSpec(spec *plan.RuntimeFilterSpec) *plan.RuntimeFilterSpec {
+	if spec == nil {
+		return nil
+	}
+	return &plan.RuntimeFilterSpec{
+		Tag:        spec.Tag,
+		Expr:       DeepCopyExpr(spec.Expr),
+		UpperLimit: spec.UpperLimit,
+	}
+}
+
+func DeepCopyWindowSpec(spec *plan.WindowSpec) *plan.WindowSpec {
+	if spec == nil {
+		return nil
+	}
+	newSpec := &plan.WindowSpec{
+		PartitionBy: make([]*plan.Expr, len(spec.PartitionBy)),
+		OrderBy:     make([]*plan.OrderBySpec, len(spec.OrderBy)),
+		Lead:        spec.Lead,
+		Lag:         spec.Lag,
+		WindowFunc:  DeepCopyExpr(spec.WindowFunc),
+		Frame:       DeepCopyFrameClause(spec.Frame),
+	}
+	for idx, pb := range spec.PartitionBy {
+		newSpec.PartitionBy[idx] = DeepCopyExpr(pb)
+	}
+	for idx, orderBy := range spec.OrderBy {
+		newSpec.OrderBy[idx] = DeepCopyOrderBy(orderBy)
+	}
+	return newSpec
+}
+
+func DeepCopyFrameClause(frame *plan.FrameClause) *plan.FrameClause {
+	if frame == nil {
+		return nil
+	}
+	return &plan.FrameClause{
+		Type:  frame.Type,
+		Start: DeepCopyFrameBound(frame.Start),
+		End:   DeepCopyFrameBound(frame.End),
+	}
+}
+
+func DeepCopyFrameBound(bound *plan.FrameBound) *plan.FrameBound {
+	if bound == nil {
+		return nil
+	}
+	return &plan.FrameBound{
+		Type:      bound.Type,
+		Unbounded: bound.Unbounded,
+		Val:       DeepCopyExpr(bound.Val),
+	}
+}
+
 func DeepCopyObjectRef(ref *plan.ObjectRef) *plan.ObjectRef {
 	if ref == nil {
 		return nil
@@ -290,6 +344,7 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		TblFuncExprList: make([]*plan.Expr, len(node.TblFuncExprList)),
 		ClusterTable:    DeepCopyClusterTable(node.GetClusterTable()),
 		InsertCtx:       DeepCopyInsertCtx(node.InsertCtx),
+		NestedLoopJoin:  node.NestedLoopJoin,
 	}
 
 	copy(newNode.Children, node.Children)
@@ -340,17 +395,47 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 	newNode.ObjRef = DeepCopyObjectRef(node.ObjRef)
 
 	if node.WinSpec != nil {
-		newNode.WinSpec = &plan.WindowSpec{
-			PartitionBy: make([]*plan.Expr, len(node.WinSpec.PartitionBy)),
-			OrderBy:     make([]*plan.OrderBySpec, len(node.WinSpec.OrderBy)),
-			Lead:        node.WinSpec.Lead,
-			Lag:         node.WinSpec.Lag,
+		newNode.WinSpec = DeepCopyWindowSpec(node.WinSpec)
+	}
+
+	if len(node.WinSpecList) > 0 {
+		newNode.WinSpecList = make([]*plan.WindowSpec, len(node.WinSpecList))
+		for idx, spec := range node.WinSpecList {
+			newNode.WinSpecList[idx] = DeepCopyWindowSpec(spec)
 		}
-		for idx, pb := range node.WinSpec.PartitionBy {
-			newNode.WinSpec.PartitionBy[idx] = DeepCopyExpr(pb)
+	}
+
+	if len(node.RuntimeFilterProbeList) > 0 {
+		newNode.RuntimeFilterProbeList = make([]*plan.RuntimeFilterSpec, len(node.RuntimeFilterProbeList))
+		for idx, spec := range node.RuntimeFilterProbeList {
+			newNode.RuntimeFilterProbeList[idx] = DeepCopyRuntimeFilterSpec(spec)
+		}
+	}
+
+	if len(node.RuntimeFilterBuildList) > 0 {
+		newNode.RuntimeFilterBuildList = make([]*plan.RuntimeFilterSpec, len(node.RuntimeFilterBuildList))
+		for idx, spec := range node.RuntimeFilterBuildList {
+			newNode.RuntimeFilterBuildList[idx] = DeepCopyRuntimeFilterSpec(spec)
+		}
+	}
+
+	if node.IndexScanInfo != nil {
+		newNode.IndexScanInfo = &plan.IndexScanInfo{
+			IndexName: node.IndexScanInfo.IndexName,
+			TableName: node.IndexScanInfo.TableName,
+			Covering:  node.IndexScanInfo.Covering,
 		}
-		for idx, orderBy := range node.WinSpec.OrderBy {
-			newNode.WinSpec.OrderBy[idx] = DeepCopyOrderBy(orderBy)
+	}
+
+	if node.SnapshotTs != nil {
+		ts := *node.SnapshotTs
+		newNode.SnapshotTs = &ts
+	}
+
+	if node.RecursiveCte != nil {
+		newNode.RecursiveCte = &plan.RecursiveCte{
+			MaxDepth: node.RecursiveCte.MaxDepth,
+			Distinct: node.RecursiveCte.Distinct,
 		}
 	}
 
@@ -395,6 +480,7 @@ func DeepCopyTyp(typ *plan.Type) *plan.Type {
 		Width:       typ.Width,
 		Scale:       typ.Scale,
 		AutoIncr:    typ.AutoIncr,
+		Enumvalues:  typ.Enumvalues,
 	}
 }
 
@@ -403,16 +489,18 @@ func DeepCopyColDef(col *plan.ColDef) *plan.ColDef {
 		return nil
 	}
 	return &plan.ColDef{
-		ColId:     col.ColId,
-		Name:      col.Name,
-		Alg:       col.Alg,
-		Typ:       DeepCopyTyp(col.Typ),
-		Default:   DeepCopyDefault(col.Default),
-		Primary:   col.Primary,
-		Pkidx:     col.Pkidx,
-		Comment:   col.Comment,
-		OnUpdate:  DeepCopyOnUpdate(col.OnUpdate),
-		ClusterBy: col.ClusterBy,
+		ColId:         col.ColId,
+		Name:          col.Name,
+		Alg:           col.Alg,
+		CompressLevel: col.CompressLevel,
+		Typ:           DeepCopyTyp(col.Typ),
+		Default:       DeepCopyDefault(col.Default),
+		Primary:       col.Primary,
+		Pkidx:         col.Pkidx,
+		Comment:       col.Comment,
+		OnUpdate:      DeepCopyOnUpdate(col.OnUpdate),
+		ClusterBy:     col.ClusterBy,
+		Generated:     DeepCopyGeneratedCol(col.Generated),
 	}
 }
 
@@ -439,6 +527,13 @@ func DeepCopyIndexDef(indexDef *plan.IndexDef) *plan.IndexDef {
 		TableExist:     indexDef.TableExist,
 		IndexTableName: indexDef.IndexTableName,
 		Comment:        indexDef.Comment,
+		Fulltext:       indexDef.Fulltext,
+		Parser:         indexDef.Parser,
+		JsonPath:       indexDef.JsonPath,
+		KeyType:        DeepCopyTyp(indexDef.KeyType),
+		MultiValued:    indexDef.MultiValued,
+		OriginString:   indexDef.OriginString,
+		JsonUnquote:    indexDef.JsonUnquote,
 	}
 
 	newParts := make([]string, len(indexDef.Parts))
@@ -457,6 +552,17 @@ func DeepCopyOnUpdate(old *plan.OnUpdate) *plan.OnUpdate {
 	}
 }
 
+func DeepCopyGeneratedCol(old *plan.GeneratedCol) *plan.GeneratedCol {
+	if old == nil {
+		return nil
+	}
+	return &plan.GeneratedCol{
+		Expr:         DeepCopyExpr(old.Expr),
+		OriginString: old.OriginString,
+		Stored:       old.Stored,
+	}
+}
+
 func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 	if table == nil {
 		return nil
@@ -472,6 +578,7 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		OriginCols:    make([]*plan.ColDef, len(table.OriginCols)),
 		Indexes:       make([]*IndexDef, len(table.Indexes)),
 		Fkeys:         make([]*plan.ForeignKeyDef, len(table.Fkeys)),
+		Checks:        make([]*plan.CheckDef, len(table.Checks)),
 	}
 
 	for idx, col := range table.Cols {
@@ -482,6 +589,10 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		newTable.Fkeys[idx] = DeepCopyFkey(fkey)
 	}
 
+	for idx, check := range table.Checks {
+		newTable.Checks[idx] = DeepCopyCheck(check)
+	}
+
 	for idx, col := range table.OriginCols {
 		newTable.OriginCols[idx] = DeepCopyColDef(col)
 	}
@@ -496,7 +607,8 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 
 	if table.Pkey != nil {
 		newTable.Pkey = &plan.PrimaryKeyDef{
-			Names: make([]string, len(table.Pkey.Names)),
+			Names:       make([]string, len(table.Pkey.Names)),
+			PkeyColName: table.Pkey.PkeyColName,
 		}
 		copy(newTable.Pkey.Names, table.Pkey.Names)
 	}
@@ -731,6 +843,26 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 				AlterTable.Actions[i] = &plan.AlterTable_Action{
 					Action: AddFk,
 				}
+			case *plan.AlterTable_Action_Columns:
+				Columns := &plan.AlterTableColumns{
+					Cols:    make([]*plan.ColDef, len(act.Columns.Cols)),
+					Exprs:   DeepCopyExprList(act.Columns.Exprs),
+					InPlace: act.Columns.InPlace,
+				}
+				for j, col := range act.Columns.Cols {
+					Columns.Cols[j] = DeepCopyColDef(col)
+				}
+				AlterTable.Actions[i] = &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_Columns{
+						Columns: Columns,
+					},
+				}
+			case *plan.AlterTable_Action_AddIndex:
+				AlterTable.Actions[i] = &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_AddIndex{
+						AddIndex: DeepCopyCreateIndex(act.AddIndex),
+					},
+				}
 			}
 		}
 
@@ -750,17 +882,7 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 
 	case *plan.DataDefinition_CreateIndex:
 		newDf.Definition = &plan.DataDefinition_CreateIndex{
-			CreateIndex: &plan.CreateIndex{
-				Database: df.CreateIndex.Database,
-				Table:    df.CreateIndex.Table,
-				Index: &plan.CreateTable{
-					IfNotExists: df.CreateIndex.Index.IfNotExists,
-					Temporary:   df.CreateIndex.Index.Temporary,
-					Database:    df.CreateIndex.Index.Database,
-					TableDef:    DeepCopyTableDef(df.CreateIndex.Index.TableDef),
-				},
-				OriginTablePrimaryKey: df.CreateIndex.OriginTablePrimaryKey,
-			},
+			CreateIndex: DeepCopyCreateIndex(df.CreateIndex),
 		}
 
 	case *plan.DataDefinition_AlterIndex:
@@ -822,6 +944,21 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 	return newDf
 }
 
+func DeepCopyCreateIndex(createIndex *plan.CreateIndex) *plan.CreateIndex {
+	return &plan.CreateIndex{
+		Database: createIndex.Database,
+		Table:    createIndex.Table,
+		Index: &plan.CreateTable{
+			IfNotExists: createIndex.Index.IfNotExists,
+			Temporary:   createIndex.Index.Temporary,
+			Database:    createIndex.Index.Database,
+			TableDef:    DeepCopyTableDef(createIndex.Index.TableDef),
+		},
+		OriginTablePrimaryKey: createIndex.OriginTablePrimaryKey,
+		TableExist:            createIndex.TableExist,
+	}
+}
+
 func DeepCopyFkey(fkey *ForeignKeyDef) *ForeignKeyDef {
 	def := &ForeignKeyDef{
 		Name:        fkey.Name,
@@ -836,6 +973,15 @@ func DeepCopyFkey(fkey *ForeignKeyDef) *ForeignKeyDef {
 	return def
 }
 
+func DeepCopyCheck(check *CheckDef) *CheckDef {
+	return &CheckDef{
+		Name:         check.Name,
+		Check:        DeepCopyExpr(check.Check),
+		OriginString: check.OriginString,
+		Enforced:     check.Enforced,
+	}
+}
+
 func DeepCopyExpr(expr *Expr) *Expr {
 	if expr == nil {
 		return nil
@@ -17,6 +17,7 @@ package explain
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -35,8 +36,22 @@ func NewNodeDescriptionImpl(node *plan.Node) *NodeDescribeImpl {
 }
 
 const TableScan = "Table Scan"
+const IndexScan = "Index Scan"
+const IndexOnlyScan = "Index Only Scan"
 const ExternalScan = "External Scan"
 
+// tableScanName returns the name of a table scan node, which is an index scan if the
+// table scanned is the index table of a secondary index
+func tableScanName(node *plan.Node) string {
+	if node.IndexScanInfo == nil {
+		return TableScan
+	}
+	if node.IndexScanInfo.Covering {
+		return IndexOnlyScan
+	}
+	return IndexScan
+}
+
 func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	var result string
 	var pname string /* node type name for text output */
@@ -48,7 +63,7 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *Ex
 	case plan.Node_VALUE_SCAN:
 		pname = "Values Scan"
 	case plan.Node_TABLE_SCAN:
-		pname = TableScan
+		pname = tableScanName(ndesc.Node)
 	case plan.Node_EXTERNAL_SCAN:
 		pname = ExternalScan
 	case plan.Node_MATERIAL_SCAN:
@@ -119,9 +134,11 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *Ex
 		switch ndesc.Node.NodeType {
 		case plan.Node_VALUE_SCAN:
 			result += " \"*VALUES*\" "
-		case plan.Node_TABLE_SCAN, plan.Node_FUNCTION_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_INSERT:
+		case plan.Node_TABLE_SCAN, plan.Node_FUNCTION_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_SINK_SCAN, plan.Node_INSERT:
 			result += " on "
-			if ndesc.Node.ObjRef != nil {
+			if info := ndesc.Node.IndexScanInfo; info != nil {
+				result += ndesc.Node.ObjRef.GetSchemaName() + "." + info.TableName + " using " + info.IndexName
+			} else if ndesc.Node.ObjRef != nil {
 				result += ndesc.Node.ObjRef.GetSchemaName() + "." + ndesc.Node.ObjRef.GetObjName()
 			} else if ndesc.Node.TableDef != nil {
 				result += ndesc.Node.TableDef.GetName()
@@ -244,6 +261,22 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, joinOnInfo)
 	}
 
+	// Get Runtime filter info
+	if options.Verbose && len(ndesc.Node.RuntimeFilterBuildList) > 0 {
+		buildInfo, err := ndesc.GetRuntimeFilterInfo(ctx, "Runtime Filter Build: ", ndesc.Node.RuntimeFilterBuildList, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, buildInfo)
+	}
+	if options.Verbose && len(ndesc.Node.RuntimeFilterProbeList) > 0 {
+		probeInfo, err := ndesc.GetRuntimeFilterInfo(ctx, "Runtime Filter Probe: ", ndesc.Node.RuntimeFilterProbeList, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, probeInfo)
+	}
+
 	// Get Group key info
 	if len(ndesc.Node.GroupBy) > 0 {
 		groupByInfo, err := ndesc.GetGroupByInfo(ctx, options)
@@ -253,6 +286,15 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, groupByInfo)
 	}
 
+	// Get Grouping sets info
+	if len(ndesc.Node.GroupingSet) > 0 {
+		groupingSetsInfo, err := ndesc.GetGroupingSetsInfo(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, groupingSetsInfo)
+	}
+
 	// Get Aggregate function info
 	if len(ndesc.Node.AggList) > 0 {
 		aggListInfo, err := ndesc.GetAggregationInfo(ctx, options)
@@ -262,6 +304,15 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, aggListInfo)
 	}
 
+	// Get Window function info
+	if len(ndesc.Node.WinSpecList) > 0 {
+		windowInfo, err := ndesc.GetWindowInfo(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, windowInfo)
+	}
+
 	// Get Filter list info
 	if len(ndesc.Node.FilterList) > 0 {
 		filterInfo, err := ndesc.GetFilterConditionInfo(ctx, options)
@@ -329,6 +380,20 @@ func (ndesc *NodeDescribeImpl) GetJoinConditionInfo(ctx context.Context, options
 	return result, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetRuntimeFilterInfo(ctx context.Context, result string, specs []*plan.RuntimeFilterSpec, options *ExplainOptions) (string, error) {
+	for i, spec := range specs {
+		if i > 0 {
+			result += ", "
+		}
+		descV, err := describeExpr(ctx, spec.Expr, options)
+		if err != nil {
+			return result, err
+		}
+		result += "#" + strconv.Itoa(int(spec.Tag)) + " " + descV
+	}
+	return result, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetFilterConditionInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	result := "Filter Cond: "
 	if options.Format == EXPLAIN_FORMAT_TEXT {
@@ -356,7 +421,12 @@ func (ndesc *NodeDescribeImpl) GetGroupByInfo(ctx context.Context, options *Expl
 	result := "Group Key: "
 	if options.Format == EXPLAIN_FORMAT_TEXT {
 		first := true
-		for _, v := range ndesc.Node.GetGroupBy() {
+		groupBy := ndesc.Node.GetGroupBy()
+		if len(ndesc.Node.GroupingSet) > 0 {
+			// the last one is the grouping id
+			groupBy = groupBy[:len(groupBy)-1]
+		}
+		for _, v := range groupBy {
 			if !first {
 				result += ", "
 			}
@@ -375,6 +445,42 @@ func (ndesc *NodeDescribeImpl) GetGroupByInfo(ctx context.Context, options *Expl
 	return result, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetGroupingSetsInfo(ctx context.Context, options *ExplainOptions) (string, error) {
+	result := "Grouping Sets: "
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		groupBy := ndesc.Node.GetGroupBy()
+		for i, set := range ndesc.Node.GetGroupingSet() {
+			if i > 0 {
+				result += ", "
+			}
+			// the bits of the grouping id are the group keys not grouped by the set
+			gid := set.GetC().GetI64Val()
+			result += "("
+			first := true
+			for j, v := range groupBy[:len(groupBy)-1] {
+				if gid&(1<<j) != 0 {
+					continue
+				}
+				if !first {
+					result += ", "
+				}
+				first = false
+				descV, err := describeExpr(ctx, v, options)
+				if err != nil {
+					return result, err
+				}
+				result += descV
+			}
+			result += ")"
+		}
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return result, moerr.NewNYI(ctx, "explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return result, moerr.NewNYI(ctx, "explain format dot")
+	}
+	return result, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetAggregationInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	result := "Aggregate Functions: "
 	if options.Format == EXPLAIN_FORMAT_TEXT {
@@ -398,6 +504,32 @@ func (ndesc *NodeDescribeImpl) GetAggregationInfo(ctx context.Context, options *
 	return result, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetWindowInfo(ctx context.Context, options *ExplainOptions) (string, error) {
+	result := "Window Functions: "
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		first := true
+		for _, v := range ndesc.Node.GetWinSpecList() {
+			if !first {
+				result += ", "
+			}
+			first = false
+			winSpecDescImpl := &WinSpecDescribeImpl{
+				WinSpec: v,
+			}
+			descV, err := winSpecDescImpl.GetDescription(ctx, options)
+			if err != nil {
+				return result, err
+			}
+			result += descV
+		}
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return result, moerr.NewNYI(ctx, "explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return result, moerr.NewNYI(ctx, "explain format dot")
+	}
+	return result, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetOrderByInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	var result string
 	if options.Format == EXPLAIN_FORMAT_TEXT {
@@ -545,8 +677,70 @@ type WinSpecDescribeImpl struct {
 }
 
 func (w *WinSpecDescribeImpl) GetDescription(ctx context.Context, options *ExplainOptions) (string, error) {
-	// TODO implement me
-	panic("implement me")
+	if options.Format == EXPLAIN_FORMAT_DOT {
+		return "", moerr.NewNYI(ctx, "explain format dot")
+	}
+
+	result, err := describeExpr(ctx, w.WinSpec.WindowFunc, options)
+	if err != nil {
+		return result, err
+	}
+	result += " OVER ("
+
+	if len(w.WinSpec.PartitionBy) > 0 {
+		result += "PARTITION BY "
+		first := true
+		for _, v := range w.WinSpec.PartitionBy {
+			if !first {
+				result += ", "
+			}
+			first = false
+			descV, err := describeExpr(ctx, v, options)
+			if err != nil {
+				return result, err
+			}
+			result += descV
+		}
+		result += " "
+	}
+
+	if len(w.WinSpec.OrderBy) > 0 {
+		orderByDescImpl := NewOrderByDescribeImpl(w.WinSpec.OrderBy)
+		describe, err := orderByDescImpl.GetDescription(ctx, options)
+		if err != nil {
+			return result, err
+		}
+		result += "ORDER BY " + describe + " "
+	}
+
+	if frame := w.WinSpec.Frame; frame != nil {
+		start, err := describeFrameBound(ctx, frame.Start, options)
+		if err != nil {
+			return result, err
+		}
+		end, err := describeFrameBound(ctx, frame.End, options)
+		if err != nil {
+			return result, err
+		}
+		result += frame.Type.String() + " BETWEEN " + start + " AND " + end
+	}
+
+	result = strings.TrimSuffix(result, " ") + ")"
+	return result, nil
+}
+
+func describeFrameBound(ctx context.Context, bound *plan.FrameBound, options *ExplainOptions) (string, error) {
+	if bound.Type == plan.FrameBound_CURRENT_ROW {
+		return "CURRENT ROW", nil
+	}
+	if bound.Unbounded {
+		return "UNBOUNDED " + bound.Type.String(), nil
+	}
+	offset, err := describeExpr(ctx, bound.Val, options)
+	if err != nil {
+		return "", err
+	}
+	return offset + " " + bound.Type.String(), nil
 }
 
 type RowsetDataDescribeImpl struct {
@@ -240,7 +240,7 @@ func PreOrderPlan(ctx context.Context, node *plan.Node, Nodes []*plan.Node, grap
 func (d *ExplainData) StatisticsRead() (rows int64, size int64) {
 	for _, step := range d.Steps {
 		for _, node := range step.GraphData.Nodes {
-			if node.Name != TableScan && node.Name != ExternalScan {
+			if node.Name != TableScan && node.Name != IndexScan && node.Name != IndexOnlyScan && node.Name != ExternalScan {
 				continue
 			}
 			for _, s := range node.Statistics.Throughput {
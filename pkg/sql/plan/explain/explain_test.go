@@ -200,6 +200,26 @@ func TestCollectionQuery(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestWindowQuery(t *testing.T) {
+	sqls := []string{
+		"explain verbose SELECT N_NAME, row_number() over (partition by N_REGIONKEY order by N_NATIONKEY) FROM NATION",
+		"explain verbose SELECT N_NAME, rank() over (order by N_REGIONKEY desc), lag(N_NAME, 1, 'none') over (order by N_NATIONKEY) FROM NATION",
+		"explain verbose SELECT N_NATIONKEY, sum(N_NATIONKEY) over (order by N_NATIONKEY rows between 1 preceding and 1 following) FROM NATION",
+		"explain SELECT * FROM (SELECT N_NAME, rank() over (partition by N_REGIONKEY order by N_NATIONKEY) as r FROM NATION) t where r = 1",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
+func TestGroupingSetsQuery(t *testing.T) {
+	sqls := []string{
+		"explain verbose SELECT N_REGIONKEY, N_NAME, count(*), grouping(N_REGIONKEY) FROM NATION group by rollup(N_REGIONKEY, N_NAME)",
+		"explain SELECT N_REGIONKEY, count(*) FROM NATION group by grouping sets ((N_REGIONKEY), ())",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
 func TestDMLInsert(t *testing.T) {
 	sqls := []string{
 		//"explain INSERT NATION VALUES (1, 'NAME1',21, 'COMMENT1'), (2, 'NAME2', 22, 'COMMENT2')",
@@ -378,3 +398,32 @@ func runOneStmt(opt plan.Optimizer, t *testing.T, sql string) error {
 	}
 	return nil
 }
+
+func TestIndexScanExplain(t *testing.T) {
+	cases := []struct {
+		sql  string
+		want string
+	}{
+		{"select * from users where name = 'a'", "Index Scan on tpch.users using idx_name"},
+		{"select id, name from users where name = 'a'", "Index Only Scan on tpch.users using idx_name"},
+	}
+	mock := plan.NewMockOptimizer(false)
+	for _, c := range cases {
+		stmts, err := mysql.Parse(mock.CurrentContext().GetContext(), c.sql, 1)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		logicPlan, err := plan.BuildPlan(mock.CurrentContext(), stmts[0])
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		buffer := NewExplainDataBuffer()
+		err = NewExplainQueryImpl(logicPlan.GetQuery()).ExplainPlan(context.TODO(), buffer, NewExplainDefaultOptions())
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if out := strings.Join(buffer.Lines, "\n"); !strings.Contains(out, c.want) {
+			t.Errorf("%s: expected %q in\n%s", c.sql, c.want, out)
+		}
+	}
+}
@@ -93,7 +93,7 @@ func (m MarshalNodeImpl) GetNodeName(ctx context.Context) (string, error) {
 	case plan.Node_VALUE_SCAN:
 		name = "Values Scan"
 	case plan.Node_TABLE_SCAN:
-		name = "Table Scan"
+		name = tableScanName(m.node)
 	case plan.Node_FUNCTION_SCAN:
 		name = "Function Scan"
 	case plan.Node_EXTERNAL_SCAN:
@@ -239,6 +239,13 @@ func (m MarshalNodeImpl) GetNodeTitle(ctx context.Context, options *ExplainOptio
 		if err != nil {
 			return result, err
 		}
+	case plan.Node_WINDOW:
+		//"title" : "RANK() OVER (PARTITION BY T.A ORDER BY T.B ASC RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)",
+		value, err := GetWinSpecsLabelValue(ctx, m.node.WinSpecList, options)
+		if err != nil {
+			return result, err
+		}
+		result = strings.Join(value, ",")
 	default:
 		return "", moerr.NewInternalError(ctx, "Unsupported node type when plan is serialized to json")
 	}
@@ -429,6 +436,15 @@ func (m MarshalNodeImpl) GetNodeLabels(ctx context.Context, options *ExplainOpti
 			Name:  "Sort keys",
 			Value: result,
 		})
+	case plan.Node_WINDOW:
+		value, err := GetWinSpecsLabelValue(ctx, m.node.WinSpecList, options)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, Label{
+			Name:  "Window functions",
+			Value: value,
+		})
 	case plan.Node_VALUE_SCAN:
 		value, err := GetExprsLabelValue(ctx, m.node.ProjectList, options)
 		if err != nil {
@@ -660,6 +676,21 @@ func GetExprsLabelValue(ctx context.Context, exprList []*plan.Expr, options *Exp
 	return result, nil
 }
 
+func GetWinSpecsLabelValue(ctx context.Context, winSpecList []*plan.WindowSpec, options *ExplainOptions) ([]string, error) {
+	result := make([]string, 0)
+	for _, v := range winSpecList {
+		winSpecDescImpl := &WinSpecDescribeImpl{
+			WinSpec: v,
+		}
+		descV, err := winSpecDescImpl.GetDescription(ctx, options)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, descV)
+	}
+	return result, nil
+}
+
 func GettOrderByLabelValue(ctx context.Context, orderbyList []*plan.OrderBySpec, options *ExplainOptions) ([]string, error) {
 	if orderbyList == nil {
 		return make([]string, 0), nil
@@ -200,6 +200,16 @@ func TestCollectionQueryToJson(t *testing.T) {
 	buildPlanMarshalTest(mock, t, sqls)
 }
 
+func TestWindowQueryToJson(t *testing.T) {
+	sqls := []string{
+		"SELECT N_NAME, row_number() over (partition by N_REGIONKEY order by N_NATIONKEY) FROM NATION",
+		"SELECT N_NATIONKEY, avg(N_NATIONKEY) over (order by N_NATIONKEY range between 2 preceding and current row) FROM NATION",
+	}
+
+	mock := plan.NewMockOptimizer(false)
+	buildPlanMarshalTest(mock, t, sqls)
+}
+
 func TestDerivedTableQueryToJson(t *testing.T) {
 	// should pass
 	sqls := []string{
@@ -419,6 +419,10 @@ func (builder *QueryBuilder) pullupCorrelatedPredicates(nodeID int32, ctx *BindC
 
 	switch node.NodeType {
 	case plan.Node_AGG:
+		if len(node.GroupingSet) > 0 && len(preds) > 0 {
+			return 0, nil, moerr.NewNYI(builder.GetContext(), "correlated columns under grouping sets")
+		}
+
 		groupTag := node.BindingTags[0]
 		for _, pred := range preds {
 			builder.pullupThroughAgg(ctx, node, groupTag, pred)
@@ -430,6 +434,11 @@ func (builder *QueryBuilder) pullupCorrelatedPredicates(nodeID int32, ctx *BindC
 			builder.pullupThroughProj(ctx, node, projectTag, pred)
 		}
 
+	case plan.Node_WINDOW:
+		if len(preds) > 0 {
+			return 0, nil, moerr.NewNYI(builder.GetContext(), "correlated columns under window functions")
+		}
+
 	case plan.Node_FILTER:
 		var newFilterList []*plan.Expr
 		for _, cond := range node.FilterList {
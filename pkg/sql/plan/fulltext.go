@@ -0,0 +1,211 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"go/constant"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/fulltext"
+)
+
+func getFullTextSearchMode(ctx context.Context, typ tree.FullTextSearchType) (fulltext.SearchMode, error) {
+	switch typ {
+	case tree.FULLTEXT_DEFAULT, tree.FULLTEXT_NATURAL_LANGUAGE:
+		return fulltext.NaturalLanguageMode, nil
+	case tree.FULLTEXT_BOOLEAN:
+		return fulltext.BooleanMode, nil
+	default:
+		return 0, moerr.NewNotSupported(ctx, "fulltext search with query expansion")
+	}
+}
+
+// findFullTextIndex returns the table and the fulltext index of the columns of MATCH (col1, col2, ...),
+// the columns must be all the parts of a fulltext index.
+func findFullTextIndex(builder *QueryBuilder, ctx *BindContext, keyParts []*tree.UnresolvedName) (*Binding, *plan.Node, *IndexDef, error) {
+	var binding *Binding
+	cols := make(map[string]bool, len(keyParts))
+	for _, keyPart := range keyParts {
+		col, table := keyPart.Parts[0], keyPart.Parts[1]
+		var colBinding *Binding
+		if len(table) == 0 {
+			colBinding = ctx.bindingByCol[col]
+		} else if b, ok := ctx.bindingByTable[table]; ok && b.FindColumn(col) != NotFound {
+			colBinding = b
+		}
+		if colBinding == nil || (binding != nil && binding != colBinding) {
+			return nil, nil, nil, moerr.NewInvalidInput(builder.GetContext(), "incorrect arguments to MATCH")
+		}
+		binding = colBinding
+		cols[col] = true
+	}
+
+	node := builder.qry.Nodes[binding.nodeId]
+	if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef != nil {
+		for _, indexDef := range node.TableDef.Indexes {
+			if !indexDef.Fulltext || len(indexDef.Parts) != len(cols) {
+				continue
+			}
+			matched := true
+			for _, part := range indexDef.Parts {
+				matched = matched && cols[part]
+			}
+			if matched {
+				return binding, node, indexDef, nil
+			}
+		}
+	}
+	return nil, nil, nil, moerr.NewInvalidInput(builder.GetContext(), "can't find FULLTEXT index matching the column list")
+}
+
+// appendFullTextFilters adds a filter on the primary key for every MATCH ... AGAINST in the
+// conjunctions of the WHERE clause, the primary keys are looked up in the fulltext index table
+// by the words of which a matched row contains at least one.
+// A row containing one of the words may not match the whole search string, so MATCH ... AGAINST
+// itself is still evaluated to filter it out.
+func (builder *QueryBuilder) appendFullTextFilters(astWhere tree.Expr, ctx *BindContext) (tree.Expr, error) {
+	for _, cond := range splitAstConjunction(astWhere) {
+		for {
+			if parenExpr, ok := cond.(*tree.ParenExpr); ok {
+				cond = parenExpr.Expr
+			} else {
+				break
+			}
+		}
+		matchExpr, ok := cond.(*tree.FullTextMatchExpr)
+		if !ok {
+			continue
+		}
+		pattern, ok := matchExpr.Pattern.(*tree.NumVal)
+		if !ok || pattern.ValType != tree.P_char {
+			continue
+		}
+		mode, err := getFullTextSearchMode(builder.GetContext(), matchExpr.Mode)
+		if err != nil {
+			return nil, err
+		}
+		binding, node, indexDef, err := findFullTextIndex(builder, ctx, matchExpr.KeyParts)
+		if err != nil {
+			return nil, err
+		}
+		pkName := getTablePriKeyName(node.TableDef.Pkey)
+		if pkName == "" || binding.FindColumn(pkName) == NotFound {
+			continue
+		}
+		// MATCH ... AGAINST still works without the index, it is just slow
+		if !builder.indexAllowed(node, indexDef.IndexName) {
+			continue
+		}
+		tokenizer, ok := fulltext.GetTokenizer(indexDef.Parser)
+		if !ok {
+			return nil, moerr.NewInternalError(builder.GetContext(), "unknown fulltext parser '%s'", indexDef.Parser)
+		}
+		query, err := fulltext.ParseQuery(builder.GetContext(), pattern.String(), mode, tokenizer)
+		if err != nil {
+			return nil, err
+		}
+		words, prefixes := query.Candidates()
+		if len(words) == 0 && len(prefixes) == 0 {
+			continue
+		}
+
+		var conds []string
+		if len(words) > 0 {
+			quoted := make([]string, len(words))
+			for i, word := range words {
+				quoted[i] = quoteFullTextWord(word)
+			}
+			conds = append(conds, fmt.Sprintf("%s in (%s)", catalog.IndexTableIndexColName, strings.Join(quoted, ", ")))
+		}
+		for _, prefix := range prefixes {
+			conds = append(conds, fullTextWordCond(prefix, true))
+		}
+		sql := fmt.Sprintf("select `%s`.`%s` in (select %s from `%s`.`%s` where %s)",
+			binding.table, pkName, catalog.IndexTablePrimaryColName,
+			node.ObjRef.SchemaName, indexDef.IndexTableName, strings.Join(conds, " or "))
+		stmts, err := parsers.Parse(builder.GetContext(), dialect.MYSQL, sql, 1)
+		if err != nil {
+			return nil, err
+		}
+		astWhere = tree.NewAndExpr(astWhere, stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr)
+	}
+	return astWhere, nil
+}
+
+// fullTextDocFreqExprs returns the scalar subqueries of the number of the rows of the table and
+// the space separated numbers of the rows containing each term of the search string, which are
+// counted in the fulltext index table to weight the terms by the idf. Both are 0 if the search
+// string is not a literal, the table has no primary key or the index is ignored by the hints.
+func fullTextDocFreqExprs(builder *QueryBuilder, node *plan.Node, indexDef *IndexDef, pattern tree.Expr, mode fulltext.SearchMode) (tree.Expr, tree.Expr, error) {
+	noStats := func() (tree.Expr, tree.Expr, error) {
+		return tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64),
+			tree.NewNumValWithType(constant.MakeString(""), "", false, tree.P_char), nil
+	}
+	numVal, ok := pattern.(*tree.NumVal)
+	pkName := getTablePriKeyName(node.TableDef.Pkey)
+	if !ok || numVal.ValType != tree.P_char || pkName == "" || !builder.indexAllowed(node, indexDef.IndexName) {
+		return noStats()
+	}
+	tokenizer, ok := fulltext.GetTokenizer(indexDef.Parser)
+	if !ok {
+		return nil, nil, moerr.NewInternalError(builder.GetContext(), "unknown fulltext parser '%s'", indexDef.Parser)
+	}
+	query, err := fulltext.ParseQuery(builder.GetContext(), numVal.String(), mode, tokenizer)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, prefix := query.DocFreqKeys()
+	if len(keys) == 0 {
+		return noStats()
+	}
+
+	counts := make([]string, len(keys))
+	conds := make([]string, len(keys))
+	for i, key := range keys {
+		conds[i] = fullTextWordCond(key, prefix[i])
+		counts[i] = fmt.Sprintf("cast(count(distinct case when %s then %s end) as varchar)", conds[i], catalog.IndexTablePrimaryColName)
+	}
+	sql := fmt.Sprintf("select (select count(*) from `%s`.`%s`), (select concat_ws(' ', %s) from `%s`.`%s` where %s)",
+		node.ObjRef.SchemaName, node.ObjRef.ObjName,
+		strings.Join(counts, ", "), node.ObjRef.SchemaName, indexDef.IndexTableName, strings.Join(conds, " or "))
+	stmts, err := parsers.Parse(builder.GetContext(), dialect.MYSQL, sql, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	exprs := stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs
+	return exprs[0].Expr, exprs[1].Expr, nil
+}
+
+// fullTextWordCond returns the condition on the word column of the fulltext index table
+// of a word, or of the words starting with it if prefix is true.
+func fullTextWordCond(word string, prefix bool) string {
+	if !prefix {
+		return fmt.Sprintf("%s = %s", catalog.IndexTableIndexColName, quoteFullTextWord(word))
+	}
+	word = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(word)
+	return fmt.Sprintf("%s like %s", catalog.IndexTableIndexColName, quoteFullTextWord(word+"%"))
+}
+
+func quoteFullTextWord(word string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(word) + "'"
+}
@@ -986,4 +986,34 @@ var aggregates = map[int]Functions{
 			},
 		},
 	},
+	// json_arrayagg(x) and json_objectagg(k, v) are bound to the aggregates over
+	// json_array(x) and json_object(k, v), so they only take a json parameter here.
+	JSON_ARRAYAGG: {
+		Id:          JSON_ARRAYAGG,
+		Flag:        plan.Function_AGG,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: generalTypeCheckForUnaryAggregate,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Args:          []types.T{types.T_json},
+				ReturnTyp:     types.T_json,
+				AggregateInfo: agg.AggregateJsonArrayAgg,
+			},
+		},
+	},
+	JSON_OBJECTAGG: {
+		Id:          JSON_OBJECTAGG,
+		Flag:        plan.Function_AGG,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: generalTypeCheckForUnaryAggregate,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Args:          []types.T{types.T_json},
+				ReturnTyp:     types.T_json,
+				AggregateInfo: agg.AggregateJsonObjectAgg,
+			},
+		},
+	},
 }
@@ -147,6 +147,7 @@ func getTableAutoIncrCol(engineDefs []engine.TableDef, tableName string) (bool,
 					Width:       attr.Attr.Type.Width,
 					Scale:       attr.Attr.Type.Scale,
 					AutoIncr:    attr.Attr.AutoIncrement,
+					Enumvalues:  attr.Attr.EnumValues,
 					Table:       tableName,
 					NotNullable: attr.Attr.Default != nil && !attr.Attr.Default.NullAbility,
 				},
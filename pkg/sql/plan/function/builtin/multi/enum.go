@@ -0,0 +1,140 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The first parameter of the functions below is the members of the ENUM or SET type,
+// encoded by types.EncodeEnumValues, it is the same for all the rows.
+
+func enumValues(proc *process.Process, vec *vector.Vector) ([]string, error) {
+	if vec.IsConstNull() || vec.GetNulls().Contains(0) {
+		return nil, moerr.NewInvalidArg(proc.Ctx, "enum values", "null")
+	}
+	return types.DecodeEnumValues(vec.GetStringAt(0))
+}
+
+// EnumToString converts the ordinal of an ENUM value to its member.
+func EnumToString(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	values, err := enumValues(proc, parameters[0])
+	if err != nil {
+		return err
+	}
+	p := vector.GenerateFunctionFixedTypeParameter[uint16](parameters[1])
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := p.GetValue(i)
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		s, err := types.EnumToString(values, v)
+		if err != nil {
+			return err
+		}
+		if err = rs.AppendBytes([]byte(s), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StringToEnum converts a string to the ordinal of the ENUM member.
+func StringToEnum(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	values, err := enumValues(proc, parameters[0])
+	if err != nil {
+		return err
+	}
+	p := vector.GenerateFunctionStrParameter(parameters[1])
+	rs := vector.MustFunctionResult[uint16](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		s, null := p.GetStrValue(i)
+		if null {
+			if err = rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		v, err := types.ParseEnum(values, string(s))
+		if err != nil {
+			return err
+		}
+		if err = rs.Append(v, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetToString converts the bitmask of a SET value to its members separated by comma.
+func SetToString(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	values, err := enumValues(proc, parameters[0])
+	if err != nil {
+		return err
+	}
+	p := vector.GenerateFunctionFixedTypeParameter[uint64](parameters[1])
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := p.GetValue(i)
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		s, err := types.SetToString(values, v)
+		if err != nil {
+			return err
+		}
+		if err = rs.AppendBytes([]byte(s), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StringToSet converts the comma separated members to the bitmask of the SET value.
+func StringToSet(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	values, err := enumValues(proc, parameters[0])
+	if err != nil {
+		return err
+	}
+	p := vector.GenerateFunctionStrParameter(parameters[1])
+	rs := vector.MustFunctionResult[uint64](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		s, null := p.GetStrValue(i)
+		if null {
+			if err = rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		v, err := types.ParseSet(values, string(s))
+		if err != nil {
+			return err
+		}
+		if err = rs.Append(v, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
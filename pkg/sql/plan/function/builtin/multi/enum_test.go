@@ -0,0 +1,83 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnum(t *testing.T) {
+	proc := testutil.NewProc()
+	values := []string{"'red','green','blue'", "'red','green','blue'", "'red','green','blue'"}
+
+	kase := testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), values, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"Green", "3", ""}, []bool{false, false, true}),
+		},
+		testutil.NewFunctionTestResult(types.T_enum.ToType(), false, []uint16{2, 3, 0}, []bool{false, false, true}),
+		StringToEnum)
+	s, info := kase.Run()
+	require.True(t, s, info)
+
+	kase = testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), values, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"red", "black", "blue"}, nil),
+		},
+		testutil.NewFunctionTestResult(types.T_enum.ToType(), true, nil, nil),
+		StringToEnum)
+	s, info = kase.Run()
+	require.True(t, s, info)
+
+	kase = testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), values, nil),
+			testutil.NewFunctionTestInput(types.T_enum.ToType(), []uint16{1, 0, 3}, nil),
+		},
+		testutil.NewFunctionTestResult(types.T_varchar.ToType(), false, []string{"red", "", "blue"}, nil),
+		EnumToString)
+	s, info = kase.Run()
+	require.True(t, s, info)
+}
+
+func TestSet(t *testing.T) {
+	proc := testutil.NewProc()
+	values := []string{"'a','b','c'", "'a','b','c'", "'a','b','c'"}
+
+	kase := testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), values, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"c,A", "", "5"}, nil),
+		},
+		testutil.NewFunctionTestResult(types.T_set.ToType(), false, []uint64{5, 0, 5}, nil),
+		StringToSet)
+	s, info := kase.Run()
+	require.True(t, s, info)
+
+	kase = testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), values, nil),
+			testutil.NewFunctionTestInput(types.T_set.ToType(), []uint64{5, 0, 7}, nil),
+		},
+		testutil.NewFunctionTestResult(types.T_varchar.ToType(), false, []string{"a,c", "", "a,b,c"}, nil),
+		SetToString)
+	s, info = kase.Run()
+	require.True(t, s, info)
+}
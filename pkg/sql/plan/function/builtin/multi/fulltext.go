@@ -0,0 +1,88 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// MatchAgainst returns the relevance of the indexed columns to the search string of
+// MATCH (col1, col2, ...) AGAINST (search_string search_modifier).
+// The parameters are the search string, the search mode, the parser of the fulltext
+// index, the number of the rows of the table, the space separated numbers of the rows
+// containing each term of the search string and the indexed columns, the first five
+// are the same for all the rows. The terms are weighted by the idf if the number of the
+// rows is greater than 0.
+func MatchAgainst(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	if length == 0 {
+		return nil
+	}
+	for _, vec := range parameters[:3] {
+		if vec.IsConstNull() || vec.GetNulls().Contains(0) {
+			return moerr.NewInvalidArg(proc.Ctx, "match against", "null")
+		}
+	}
+	pattern := parameters[0].GetStringAt(0)
+	mode := vector.MustFixedCol[int64](parameters[1])[0]
+	tokenizer, ok := fulltext.GetTokenizer(parameters[2].GetStringAt(0))
+	if !ok {
+		return moerr.NewInvalidArg(proc.Ctx, "fulltext parser", parameters[2].GetStringAt(0))
+	}
+	query, err := fulltext.ParseQuery(proc.Ctx, pattern, fulltext.SearchMode(mode), tokenizer)
+	if err != nil {
+		return err
+	}
+
+	if !parameters[3].IsConstNull() && !parameters[3].GetNulls().Contains(0) {
+		if docCount := vector.MustFixedCol[int64](parameters[3])[0]; docCount > 0 {
+			var docFreqs []int64
+			for _, field := range strings.Fields(parameters[4].GetStringAt(0)) {
+				df, err := strconv.ParseInt(field, 10, 64)
+				if err != nil {
+					return moerr.NewInvalidArg(proc.Ctx, "fulltext document frequency", field)
+				}
+				docFreqs = append(docFreqs, df)
+			}
+			query.SetDocFreqs(docCount, docFreqs)
+		}
+	}
+
+	cols := make([]vector.FunctionParameterWrapper[types.Varlena], len(parameters)-5)
+	for i := range cols {
+		cols[i] = vector.GenerateFunctionStrParameter(parameters[i+5])
+	}
+	rs := vector.MustFunctionResult[float64](result)
+	var doc []string
+	for i := uint64(0); i < uint64(length); i++ {
+		doc = doc[:0]
+		for _, col := range cols {
+			s, null := col.GetStrValue(i)
+			if !null {
+				doc = append(doc, tokenizer.Tokenize(string(s))...)
+			}
+		}
+		if err = rs.Append(query.Score(doc), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchAgainst(t *testing.T) {
+	proc := testutil.NewProc()
+
+	kase := testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"+disk -warn", "+disk -warn", "+disk -warn"}, nil),
+			testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{int64(fulltext.BooleanMode), int64(fulltext.BooleanMode), int64(fulltext.BooleanMode)}, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"", "", ""}, nil),
+			testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{0, 0, 0}, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"", "", ""}, nil),
+			testutil.NewFunctionTestInput(types.T_text.ToType(), []string{"Disk full", "WARN: disk", ""}, []bool{false, false, true}),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"node1", "node2", "disk"}, nil),
+		},
+		testutil.NewFunctionTestResult(types.T_float64.ToType(), false, []float64{1, 0, 1}, nil),
+		MatchAgainst)
+	s, info := kase.Run()
+	require.True(t, s, info)
+
+	kase = testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"disk (full)", "disk (full)"}, nil),
+			testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{int64(fulltext.BooleanMode), int64(fulltext.BooleanMode)}, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"", ""}, nil),
+			testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{0, 0}, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"", ""}, nil),
+			testutil.NewFunctionTestInput(types.T_text.ToType(), []string{"disk full", "disk"}, nil),
+		},
+		testutil.NewFunctionTestResult(types.T_float64.ToType(), true, nil, nil),
+		MatchAgainst)
+	s, info = kase.Run()
+	require.True(t, s, info)
+
+	// error is in 1 of the 4 rows, disk in all of them
+	kase = testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"disk error", "disk error"}, nil),
+			testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{int64(fulltext.NaturalLanguageMode), int64(fulltext.NaturalLanguageMode)}, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"", ""}, nil),
+			testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{4, 4}, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"4 1", "4 1"}, nil),
+			testutil.NewFunctionTestInput(types.T_text.ToType(), []string{"disk", "error"}, nil),
+		},
+		testutil.NewFunctionTestResult(types.T_float64.ToType(), false, []float64{1, 1 + math.Log(4)}, nil),
+		MatchAgainst)
+	s, info = kase.Run()
+	require.True(t, s, info)
+}
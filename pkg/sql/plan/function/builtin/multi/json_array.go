@@ -0,0 +1,78 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// JsonArray is JSON_ARRAY, it returns the json array of the parameters
+func JsonArray(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	elems := make([]bytejson.ByteJson, len(parameters))
+	for i := uint64(0); i < uint64(length); i++ {
+		for j, vec := range parameters {
+			var err error
+			if elems[j], err = jsonValueAt(vec, i, proc); err != nil {
+				return err
+			}
+		}
+		if err := appendJson(rs, bytejson.CreateByteJsonArray(elems)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonObject is JSON_OBJECT, the parameters are the pairs of the key and the value
+func JsonObject(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	if len(parameters)%2 != 0 {
+		return moerr.NewInvalidArg(proc.Ctx, "json_object function arguments", len(parameters))
+	}
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	keys := make([]string, len(parameters)/2)
+	vals := make([]bytejson.ByteJson, len(parameters)/2)
+	for i := uint64(0); i < uint64(length); i++ {
+		for j := range keys {
+			key, err := jsonValueAt(parameters[2*j], i, proc)
+			if err != nil {
+				return err
+			}
+			if key.IsNull() {
+				return moerr.NewInvalidInput(proc.Ctx, "json documents may not contain null member names")
+			}
+			if key.Type == bytejson.TpCodeString {
+				keys[j] = string(key.GetString())
+			} else {
+				keys[j] = key.String()
+			}
+			if vals[j], err = jsonValueAt(parameters[2*j+1], i, proc); err != nil {
+				return err
+			}
+		}
+		obj, err := bytejson.CreateByteJsonObject(keys, vals)
+		if err != nil {
+			return err
+		}
+		if err = appendJson(rs, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonArray(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1, 2}, []bool{false, true}),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"a", "b"}, nil),
+		testutil.NewFunctionTestInput(types.T_float64.ToType(), []float64{1.5, 2}, nil),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+		[]string{encodedJson(`[1,"a",1.5]`), encodedJson(`[null,"b",2.0]`)}, nil)
+	kaseNow := testutil.NewFunctionTestCase(proc, inputs, expect, JsonArray)
+	s, info := kaseNow.Run()
+	require.True(t, s, info)
+}
+
+func TestJsonObject(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"a", "a"}, nil),
+		testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1, 2}, nil),
+		testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{10, 20}, nil),
+		testutil.NewFunctionTestInput(types.T_bool.ToType(), []bool{true, false}, []bool{false, true}),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+		[]string{encodedJson(`{"a":1,"10":true}`), encodedJson(`{"a":2,"20":null}`)}, nil)
+	kaseNow := testutil.NewFunctionTestCase(proc, inputs, expect, JsonObject)
+	s, info := kaseNow.Run()
+	require.True(t, s, info)
+
+	// the key of json object can not be null
+	inputs = []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"a"}, []bool{true}),
+		testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1}, nil),
+	}
+	expect = testutil.NewFunctionTestResult(types.T_varchar.ToType(), true, []string{""}, nil)
+	kaseNow = testutil.NewFunctionTestCase(proc, inputs, expect, JsonObject)
+	s, info = kaseNow.Run()
+	require.True(t, s, info)
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// JsonContains is JSON_CONTAINS(target, candidate[, path]), it returns whether the candidate
+// is contained in the target, or in the value at the path of the target.
+func JsonContains(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	targetWrapper := vector.GenerateFunctionStrParameter(parameters[0])
+	candidateWrapper := vector.GenerateFunctionStrParameter(parameters[1])
+	var pathWrappers []vector.FunctionParameterWrapper[types.Varlena]
+	if len(parameters) > 2 {
+		pathWrappers = []vector.FunctionParameterWrapper[types.Varlena]{vector.GenerateFunctionStrParameter(parameters[2])}
+	}
+	rs := vector.MustFunctionResult[bool](result)
+	paths := make([]*bytejson.Path, len(pathWrappers))
+	for i := uint64(0); i < uint64(length); i++ {
+		target, isNull, err := jsonDocAt(parameters[0], targetWrapper, i)
+		if err != nil {
+			return err
+		}
+		var candidate bytejson.ByteJson
+		if !isNull {
+			if candidate, isNull, err = jsonDocAt(parameters[1], candidateWrapper, i); err != nil {
+				return err
+			}
+		}
+		if !isNull {
+			if paths, isNull, err = jsonPathsAt(pathWrappers, i, paths); err != nil {
+				return err
+			}
+		}
+		if !isNull && len(paths) > 0 {
+			if paths[0].IsWildcard() {
+				return moerr.NewInvalidInput(proc.Ctx, "path expressions may not contain the * and ** tokens or an array range in this situation")
+			}
+			target, isNull = target.Lookup(paths[0])
+			isNull = !isNull
+		}
+		if isNull {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.Append(target.Contains(candidate), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonContainsPath is JSON_CONTAINS_PATH(json_doc, one_or_all, path[, path] ...), it returns
+// whether the document contains any or all of the paths.
+func JsonContainsPath(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	jsonWrapper := vector.GenerateFunctionStrParameter(parameters[0])
+	oneOrAllWrapper := vector.GenerateFunctionStrParameter(parameters[1])
+	pathWrappers := make([]vector.FunctionParameterWrapper[types.Varlena], len(parameters)-2)
+	for i := range pathWrappers {
+		pathWrappers[i] = vector.GenerateFunctionStrParameter(parameters[i+2])
+	}
+	rs := vector.MustFunctionResult[bool](result)
+	paths := make([]*bytejson.Path, len(pathWrappers))
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, isNull, err := jsonDocAt(parameters[0], jsonWrapper, i)
+		if err != nil {
+			return err
+		}
+		var oneOrAll []byte
+		if !isNull {
+			oneOrAll, isNull = oneOrAllWrapper.GetStrValue(i)
+		}
+		if !isNull {
+			if paths, isNull, err = jsonPathsAt(pathWrappers, i, paths); err != nil {
+				return err
+			}
+		}
+		if isNull {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		var all bool
+		switch strings.ToLower(string(oneOrAll)) {
+		case "one":
+		case "all":
+			all = true
+		default:
+			return moerr.NewInvalidInput(proc.Ctx, "the oneOrAll argument to json_contains_path may take these values: 'one' or 'all'")
+		}
+		contains := all
+		for _, path := range paths {
+			if bj.Exists(path) != all {
+				contains = !all
+				break
+			}
+		}
+		if err = rs.Append(contains, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MemberOf is value MEMBER OF(json_array), it returns whether the value is an element of the json array.
+// a string value is taken as a json string, use CAST(value AS JSON) to take it as a json document.
+func MemberOf(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	arrayWrapper := vector.GenerateFunctionStrParameter(parameters[1])
+	rs := vector.MustFunctionResult[bool](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		array, isNull, err := jsonDocAt(parameters[1], arrayWrapper, i)
+		if err != nil {
+			return err
+		}
+		value := parameters[0]
+		if isNull || value.IsConstNull() || (!value.IsConst() && value.GetNulls().Contains(i)) {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		bj, err := jsonValueAt(value, i, proc)
+		if err != nil {
+			return err
+		}
+		if err = rs.Append(array.IsMember(bj), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
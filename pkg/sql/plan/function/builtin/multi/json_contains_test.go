@@ -0,0 +1,101 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonContains(t *testing.T) {
+	proc := testutil.NewProc()
+	kases := []struct {
+		target    string
+		candidate string
+		path      string
+		want      bool
+		wantNull  bool
+	}{
+		{target: `[1,2,[3,4]]`, candidate: `[1,3]`, want: true},
+		{target: `[1,2]`, candidate: `[1,5]`, want: false},
+		{target: `{"a":1,"b":{"c":[1,2]}}`, candidate: `{"b":{"c":2}}`, want: true},
+		{target: `{"a":1,"b":{"c":[1,2]}}`, candidate: `1`, path: `$.a`, want: true},
+		{target: `{"a":1.0}`, candidate: `1`, path: `$.a`, want: true},
+		{target: `{"a":1}`, candidate: `1`, path: `$.b`, wantNull: true},
+	}
+	for i, kase := range kases {
+		inputs := []testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{kase.target}, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{kase.candidate}, nil),
+		}
+		if kase.path != "" {
+			inputs = append(inputs, testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{kase.path}, nil))
+		}
+		expect := testutil.NewFunctionTestResult(types.T_bool.ToType(), false, []bool{kase.want}, []bool{kase.wantNull})
+		kaseNow := testutil.NewFunctionTestCase(proc, inputs, expect, JsonContains)
+		s, info := kaseNow.Run()
+		require.True(t, s, fmt.Sprintf("case %d, err info is '%s'", i, info))
+	}
+}
+
+func TestJsonContainsPath(t *testing.T) {
+	proc := testutil.NewProc()
+	kases := []struct {
+		oneOrAll string
+		want     bool
+		wantErr  bool
+	}{
+		{oneOrAll: "one", want: true},
+		{oneOrAll: "ALL", want: false},
+		{oneOrAll: "any", wantErr: true},
+	}
+	for i, kase := range kases {
+		inputs := []testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a":1,"b":[1,2]}`}, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{kase.oneOrAll}, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`$.b[1]`}, nil),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`$.c`}, nil),
+		}
+		expect := testutil.NewFunctionTestResult(types.T_bool.ToType(), kase.wantErr, []bool{kase.want}, nil)
+		kaseNow := testutil.NewFunctionTestCase(proc, inputs, expect, JsonContainsPath)
+		s, info := kaseNow.Run()
+		require.True(t, s, fmt.Sprintf("case %d, err info is '%s'", i, info))
+	}
+}
+
+func TestMemberOf(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{2, 3, 5, 0}, []bool{false, false, false, true}),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`[1,2.0]`, `["3"]`, `5`, `[1]`}, nil),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_bool.ToType(), false, []bool{true, false, true, false}, []bool{false, false, false, true})
+	kaseNow := testutil.NewFunctionTestCase(proc, inputs, expect, MemberOf)
+	s, info := kaseNow.Run()
+	require.True(t, s, info)
+
+	inputs = []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"a", "b"}, nil),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`["a","c"]`, `null`}, []bool{false, true}),
+	}
+	expect = testutil.NewFunctionTestResult(types.T_bool.ToType(), false, []bool{true, false}, []bool{false, true})
+	kaseNow = testutil.NewFunctionTestCase(proc, inputs, expect, MemberOf)
+	s, info = kaseNow.Run()
+	require.True(t, s, info)
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// JsonKeys is JSON_KEYS(json_doc[, path]), it returns the keys of the object at the path
+// as a json array, or null if the value is not an object.
+func JsonKeys(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	return jsonAtPath(parameters, proc, length, func(bj bytejson.ByteJson, isNull bool) error {
+		var keys bytejson.ByteJson
+		if !isNull {
+			keys, isNull = bj.Keys()
+			isNull = !isNull
+		}
+		if isNull {
+			return rs.AppendBytes(nil, true)
+		}
+		return appendJson(rs, keys)
+	})
+}
+
+// JsonLength is JSON_LENGTH(json_doc[, path]), it returns the length of the value at the path,
+// or null if the path does not exist.
+func JsonLength(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[int64](result)
+	return jsonAtPath(parameters, proc, length, func(bj bytejson.ByteJson, isNull bool) error {
+		if isNull {
+			return rs.Append(0, true)
+		}
+		return rs.Append(int64(bj.Length()), false)
+	})
+}
+
+// jsonAtPath calls fn with the value at the optional path of the json document for each row,
+// isNull is true if the document or the path is null or the path does not exist.
+func jsonAtPath(parameters []*vector.Vector, proc *process.Process, length int, fn func(bj bytejson.ByteJson, isNull bool) error) error {
+	jsonWrapper := vector.GenerateFunctionStrParameter(parameters[0])
+	var pathWrappers []vector.FunctionParameterWrapper[types.Varlena]
+	if len(parameters) > 1 {
+		pathWrappers = []vector.FunctionParameterWrapper[types.Varlena]{vector.GenerateFunctionStrParameter(parameters[1])}
+	}
+	paths := make([]*bytejson.Path, len(pathWrappers))
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, isNull, err := jsonDocAt(parameters[0], jsonWrapper, i)
+		if err != nil {
+			return err
+		}
+		if !isNull {
+			if paths, isNull, err = jsonPathsAt(pathWrappers, i, paths); err != nil {
+				return err
+			}
+		}
+		if !isNull && len(paths) > 0 {
+			if paths[0].IsWildcard() {
+				return moerr.NewInvalidInput(proc.Ctx, "path expressions may not contain the * and ** tokens or an array range in this situation")
+			}
+			bj, isNull = bj.Lookup(paths[0])
+			isNull = !isNull
+		}
+		if err = fn(bj, isNull); err != nil {
+			return err
+		}
+	}
+	return nil
+}
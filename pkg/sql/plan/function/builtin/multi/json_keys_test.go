@@ -0,0 +1,77 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonKeys(t *testing.T) {
+	proc := testutil.NewProc()
+	kases := []struct {
+		json string
+		path string
+		want string
+	}{
+		{json: `{"a":1,"b":{"c":2}}`, want: `["a","b"]`},
+		{json: `{"a":1,"b":{"c":2}}`, path: `$.b`, want: `["c"]`},
+		{json: `{"a":1}`, path: `$.a`, want: `null`},
+		{json: `[1,2]`, want: `null`},
+	}
+	for i, kase := range kases {
+		inputs := []testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{kase.json}, nil),
+		}
+		if kase.path != "" {
+			inputs = append(inputs, testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{kase.path}, nil))
+		}
+		expect := testutil.NewFunctionTestResult(types.T_varchar.ToType(), false, []string{encodedJson(kase.want)}, []bool{kase.want == "null"})
+		kaseNow := testutil.NewFunctionTestCase(proc, inputs, expect, JsonKeys)
+		s, info := kaseNow.Run()
+		require.True(t, s, fmt.Sprintf("case %d, err info is '%s'", i, info))
+	}
+}
+
+func TestJsonLength(t *testing.T) {
+	proc := testutil.NewProc()
+	kases := []struct {
+		json     string
+		path     string
+		want     int64
+		wantNull bool
+	}{
+		{json: `{"a":1,"b":{"c":2}}`, want: 2},
+		{json: `[1,2,[3]]`, path: `$[2]`, want: 1},
+		{json: `"abc"`, want: 1},
+		{json: `{"a":1}`, path: `$.b`, wantNull: true},
+	}
+	for i, kase := range kases {
+		inputs := []testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{kase.json}, nil),
+		}
+		if kase.path != "" {
+			inputs = append(inputs, testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{kase.path}, nil))
+		}
+		expect := testutil.NewFunctionTestResult(types.T_int64.ToType(), false, []int64{kase.want}, []bool{kase.wantNull})
+		kaseNow := testutil.NewFunctionTestCase(proc, inputs, expect, JsonLength)
+		s, info := kaseNow.Run()
+		require.True(t, s, fmt.Sprintf("case %d, err info is '%s'", i, info))
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func JsonSet(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, proc, length, bytejson.JsonModifySet)
+}
+
+func JsonInsert(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, proc, length, bytejson.JsonModifyInsert)
+}
+
+func JsonReplace(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, proc, length, bytejson.JsonModifyReplace)
+}
+
+// jsonModify is JSON_SET, JSON_INSERT and JSON_REPLACE, the parameters are the json document
+// and the pairs of the path and the value.
+func jsonModify(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, modifyType bytejson.JsonModifyType) error {
+	if len(parameters) < 3 || len(parameters)%2 == 0 {
+		return moerr.NewInvalidArg(proc.Ctx, "json modify function arguments", len(parameters))
+	}
+	jsonVec := parameters[0]
+	jsonWrapper := vector.GenerateFunctionStrParameter(jsonVec)
+	pathWrappers := make([]vector.FunctionParameterWrapper[types.Varlena], len(parameters)/2)
+	for i := range pathWrappers {
+		pathWrappers[i] = vector.GenerateFunctionStrParameter(parameters[2*i+1])
+	}
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	paths := make([]*bytejson.Path, len(pathWrappers))
+	vals := make([]bytejson.ByteJson, len(pathWrappers))
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, isNull, err := jsonDocAt(jsonVec, jsonWrapper, i)
+		if err != nil {
+			return err
+		}
+		if !isNull {
+			paths, isNull, err = jsonPathsAt(pathWrappers, i, paths)
+			if err != nil {
+				return err
+			}
+		}
+		if isNull {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		for j := range vals {
+			if vals[j], err = jsonValueAt(parameters[2*j+2], i, proc); err != nil {
+				return err
+			}
+		}
+		out, err := bj.Modify(paths, vals, modifyType)
+		if err != nil {
+			return err
+		}
+		if err = appendJson(rs, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonRemove is JSON_REMOVE, the parameters are the json document and the paths to remove
+func JsonRemove(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	jsonVec := parameters[0]
+	jsonWrapper := vector.GenerateFunctionStrParameter(jsonVec)
+	pathWrappers := make([]vector.FunctionParameterWrapper[types.Varlena], len(parameters)-1)
+	for i := range pathWrappers {
+		pathWrappers[i] = vector.GenerateFunctionStrParameter(parameters[i+1])
+	}
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	paths := make([]*bytejson.Path, len(pathWrappers))
+	for i := uint64(0); i < uint64(length); i++ {
+		bj, isNull, err := jsonDocAt(jsonVec, jsonWrapper, i)
+		if err != nil {
+			return err
+		}
+		if !isNull {
+			paths, isNull, err = jsonPathsAt(pathWrappers, i, paths)
+			if err != nil {
+				return err
+			}
+		}
+		if isNull {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		out, err := bj.Remove(paths)
+		if err != nil {
+			return err
+		}
+		if err = appendJson(rs, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonDocAt returns the json document at the row of the json or string parameter
+func jsonDocAt(vec *vector.Vector, wrapper vector.FunctionParameterWrapper[types.Varlena], row uint64) (bj bytejson.ByteJson, isNull bool, err error) {
+	data, isNull := wrapper.GetStrValue(row)
+	if isNull {
+		return
+	}
+	if vec.GetType().Oid == types.T_json {
+		return types.DecodeJson(data), false, nil
+	}
+	bj, err = types.ParseSliceToByteJson(data)
+	return
+}
+
+// jsonPathsAt parses the paths at the row, isNull is true if any of the paths is null
+func jsonPathsAt(wrappers []vector.FunctionParameterWrapper[types.Varlena], row uint64, paths []*bytejson.Path) ([]*bytejson.Path, bool, error) {
+	for j, wrapper := range wrappers {
+		pathBytes, isNull := wrapper.GetStrValue(row)
+		if isNull {
+			return paths, true, nil
+		}
+		p, err := types.ParseStringToPath(string(pathBytes))
+		if err != nil {
+			return paths, false, err
+		}
+		paths[j] = &p
+	}
+	return paths, false, nil
+}
+
+// jsonValueAt converts the value at the row of the vector to a json value, a string is
+// taken as a json string and a null is taken as the json null literal.
+func jsonValueAt(vec *vector.Vector, row uint64, proc *process.Process) (bytejson.ByteJson, error) {
+	if vec.IsConstNull() {
+		return bytejson.Null, nil
+	}
+	if vec.IsConst() {
+		row = 0
+	}
+	if vec.GetNulls().Contains(row) {
+		return bytejson.Null, nil
+	}
+	var val interface{}
+	i := int(row)
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_any:
+		return bytejson.Null, nil
+	case types.T_json:
+		return types.DecodeJson(vec.GetBytesAt(i)), nil
+	case types.T_bool:
+		val = vector.GetFixedAt[bool](vec, i)
+	case types.T_int8:
+		val = int64(vector.GetFixedAt[int8](vec, i))
+	case types.T_int16:
+		val = int64(vector.GetFixedAt[int16](vec, i))
+	case types.T_int32:
+		val = int64(vector.GetFixedAt[int32](vec, i))
+	case types.T_int64:
+		val = vector.GetFixedAt[int64](vec, i)
+	case types.T_uint8:
+		val = uint64(vector.GetFixedAt[uint8](vec, i))
+	case types.T_uint16:
+		val = uint64(vector.GetFixedAt[uint16](vec, i))
+	case types.T_uint32:
+		val = uint64(vector.GetFixedAt[uint32](vec, i))
+	case types.T_uint64:
+		val = vector.GetFixedAt[uint64](vec, i)
+	case types.T_float32:
+		val = float64(vector.GetFixedAt[float32](vec, i))
+	case types.T_float64:
+		val = vector.GetFixedAt[float64](vec, i)
+	case types.T_decimal64:
+		val = json.Number(vector.GetFixedAt[types.Decimal64](vec, i).Format(typ.Scale))
+	case types.T_decimal128:
+		val = json.Number(vector.GetFixedAt[types.Decimal128](vec, i).Format(typ.Scale))
+	case types.T_date:
+		val = vector.GetFixedAt[types.Date](vec, i).String()
+	case types.T_datetime:
+		val = vector.GetFixedAt[types.Datetime](vec, i).String2(typ.Scale)
+	case types.T_time:
+		val = vector.GetFixedAt[types.Time](vec, i).String2(typ.Scale)
+	case types.T_timestamp:
+		val = vector.GetFixedAt[types.Timestamp](vec, i).String2(proc.SessionInfo.TimeZone, typ.Scale)
+	case types.T_uuid:
+		val = vector.GetFixedAt[types.Uuid](vec, i).ToString()
+	default:
+		if !typ.IsString() {
+			return bytejson.Null, moerr.NewInvalidArg(proc.Ctx, "json value type", typ.String())
+		}
+		val = vec.GetStringAt(i)
+	}
+	var bj bytejson.ByteJson
+	err := bj.UnmarshalObject(val)
+	return bj, err
+}
+
+func appendJson(rs *vector.FunctionResult[types.Varlena], bj bytejson.ByteJson) error {
+	dt, err := bj.Marshal()
+	if err != nil {
+		return err
+	}
+	return rs.AppendBytes(dt, false)
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// encodedJson returns the encoded json of the string, or the empty string for null
+func encodedJson(s string) string {
+	if s == "null" {
+		return ""
+	}
+	bj, _ := types.ParseStringToByteJson(s)
+	dt, _ := bj.Marshal()
+	return string(dt)
+}
+
+func TestJsonModify(t *testing.T) {
+	proc := testutil.NewProc()
+	kases := []struct {
+		fn      func(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error
+		json    string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{fn: JsonSet, json: `{"a":1}`, args: []string{`$.a`, `2`, `$.b`, `3`}, want: `{"a":"2","b":"3"}`},
+		{fn: JsonSet, json: `[1,2]`, args: []string{`$[5]`, `x`}, want: `[1,2,"x"]`},
+		{fn: JsonInsert, json: `{"a":1}`, args: []string{`$.a`, `2`, `$.b`, `3`}, want: `{"a":1,"b":"3"}`},
+		{fn: JsonReplace, json: `{"a":1}`, args: []string{`$.a`, `2`, `$.b`, `3`}, want: `{"a":"2"}`},
+		{fn: JsonSet, json: `{"a":1}`, args: []string{`$.*`, `2`}, wantErr: true},
+		{fn: JsonRemove, json: `{"a":1,"b":[1,2]}`, args: []string{`$.a`, `$.b[0]`, `$.c`}, want: `{"b":[2]}`},
+		{fn: JsonRemove, json: `{"a":1}`, args: []string{`$`}, wantErr: true},
+	}
+	for i, kase := range kases {
+		inputs := []testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{kase.json}, nil),
+		}
+		for _, arg := range kase.args {
+			inputs = append(inputs, testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{arg}, nil))
+		}
+		expect := testutil.NewFunctionTestResult(types.T_varchar.ToType(), kase.wantErr, []string{encodedJson(kase.want)}, nil)
+		kaseNow := testutil.NewFunctionTestCase(proc, inputs, expect, kase.fn)
+		s, info := kaseNow.Run()
+		require.True(t, s, fmt.Sprintf("case %d, err info is '%s'", i, info))
+	}
+}
@@ -29,15 +29,40 @@ import (
 // for example:
 // input vec is [[1, 1, 1], [2, 2, null], [3, 3, 3]]
 // result vec is [serial(1, 2, 3), serial(1, 2, 3), null]
+// the constants are supported only if all the vectors are constant, which is the key of a composite
+// index compared in a filter
 func Serial(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	constNum := 0
 	for _, v := range vectors {
 		if v.IsConst() {
-			return nil, moerr.NewConstraintViolation(proc.Ctx, "serial function don't support constant value")
+			constNum++
 		}
 	}
+	if constNum == len(vectors) {
+		return serialConst(vectors, proc)
+	}
+	if constNum > 0 {
+		return nil, moerr.NewConstraintViolation(proc.Ctx, "serial function don't support constant value")
+	}
 	return SerialWithSomeCols(vectors, proc)
 }
 
+func serialConst(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vct := types.T_varchar.ToType()
+	length := vectors[0].Length()
+	for _, v := range vectors {
+		if v.IsConstNull() {
+			return vector.NewConstNull(vct, length, proc.Mp()), nil
+		}
+	}
+	vec, err := SerialWithSomeCols(vectors, proc)
+	if err != nil {
+		return nil, err
+	}
+	defer vec.Free(proc.Mp())
+	return vector.NewConstBytes(vct, vec.GetBytesAt(0), length, proc.Mp()), nil
+}
+
 func SerialWithSomeCols(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	length := vectors[0].Length()
 	vct := types.T_varchar.ToType()
@@ -321,3 +321,28 @@ func randStringType() []byte {
 	rand2.Read(b)
 	return b
 }
+
+func TestSerialConst(t *testing.T) {
+	proc := testutil.NewProc()
+	vs := []*vector.Vector{
+		vector.NewConstBytes(types.T_varchar.ToType(), []byte("x"), 3, proc.Mp()),
+		vector.NewConstFixed(types.T_int32.ToType(), int32(3), 3, proc.Mp()),
+	}
+	vec, err := Serial(vs, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsConst())
+	require.Equal(t, 3, vec.Length())
+	tuple, err := types.Unpack(vec.GetBytesAt(0))
+	require.NoError(t, err)
+	require.Equal(t, types.Tuple{[]byte("x"), int32(3)}, tuple)
+
+	vs[1] = vector.NewConstNull(types.T_int32.ToType(), 3, proc.Mp())
+	vec, err = Serial(vs, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsConstNull())
+
+	// the constants are not supported with the columns
+	vs[1] = vector.NewVec(types.T_int32.ToType())
+	_, err = Serial(vs, proc)
+	require.Error(t, err)
+}
@@ -1903,6 +1903,189 @@ var builtins = map[int]Functions{
 		},
 	},
 
+	JSON_SET: {
+		Id:          JSON_SET,
+		Flag:        plan.Function_STRICT,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: jsonFunctionTypeCheck(func(n int) bool { return n >= 3 && n%2 == 1 }, 1, func(i int) bool { return i%2 == 1 }),
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonSet,
+			},
+		},
+	},
+	JSON_INSERT: {
+		Id:          JSON_INSERT,
+		Flag:        plan.Function_STRICT,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: jsonFunctionTypeCheck(func(n int) bool { return n >= 3 && n%2 == 1 }, 1, func(i int) bool { return i%2 == 1 }),
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonInsert,
+			},
+		},
+	},
+	JSON_REPLACE: {
+		Id:          JSON_REPLACE,
+		Flag:        plan.Function_STRICT,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: jsonFunctionTypeCheck(func(n int) bool { return n >= 3 && n%2 == 1 }, 1, func(i int) bool { return i%2 == 1 }),
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonReplace,
+			},
+		},
+	},
+	JSON_REMOVE: {
+		Id:          JSON_REMOVE,
+		Flag:        plan.Function_STRICT,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: jsonFunctionTypeCheck(func(n int) bool { return n >= 2 }, 1, func(i int) bool { return true }),
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonRemove,
+			},
+		},
+	},
+	JSON_ARRAY: {
+		Id:          JSON_ARRAY,
+		Flag:        plan.Function_NONE,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: jsonFunctionTypeCheck(func(n int) bool { return true }, 0, nil),
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonArray,
+			},
+		},
+	},
+	JSON_OBJECT: {
+		Id:          JSON_OBJECT,
+		Flag:        plan.Function_NONE,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: jsonFunctionTypeCheck(func(n int) bool { return n%2 == 0 }, 0, nil),
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonObject,
+			},
+		},
+	},
+	JSON_CONTAINS: {
+		Id:          JSON_CONTAINS,
+		Flag:        plan.Function_STRICT,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: jsonFunctionTypeCheck(func(n int) bool { return n == 2 || n == 3 }, 2, func(i int) bool { return true }),
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_bool,
+				UseNewFramework: true,
+				NewFn:           multi.JsonContains,
+			},
+		},
+	},
+	JSON_CONTAINS_PATH: {
+		Id:          JSON_CONTAINS_PATH,
+		Flag:        plan.Function_STRICT,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: jsonFunctionTypeCheck(func(n int) bool { return n >= 3 }, 1, func(i int) bool { return true }),
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_bool,
+				UseNewFramework: true,
+				NewFn:           multi.JsonContainsPath,
+			},
+		},
+	},
+	JSON_KEYS: {
+		Id:          JSON_KEYS,
+		Flag:        plan.Function_STRICT,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: jsonFunctionTypeCheck(func(n int) bool { return n == 1 || n == 2 }, 1, func(i int) bool { return true }),
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonKeys,
+			},
+		},
+	},
+	JSON_LENGTH: {
+		Id:          JSON_LENGTH,
+		Flag:        plan.Function_STRICT,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: jsonFunctionTypeCheck(func(n int) bool { return n == 1 || n == 2 }, 1, func(i int) bool { return true }),
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_int64,
+				UseNewFramework: true,
+				NewFn:           multi.JsonLength,
+			},
+		},
+	},
+	MEMBER_OF: {
+		Id:     MEMBER_OF,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			// value MEMBER OF(json_array), the value may be of any type
+			if len(inputs) != 2 || (inputs[1] != types.T_json && !types.IsString(inputs[1]) && inputs[1] != types.T_any) {
+				return wrongFunctionParameters, nil
+			}
+			return 0, nil
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_bool,
+				UseNewFramework: true,
+				NewFn:           multi.MemberOf,
+			},
+		},
+	},
+
 	ENABLE_FAULT_INJECTION: {
 		Id:     ENABLE_FAULT_INJECTION,
 		Flag:   plan.Function_INTERNAL,
@@ -3102,4 +3285,101 @@ var builtins = map[int]Functions{
 			},
 		},
 	},
+	ENUM_TO_STRING: {
+		Id:     ENUM_TO_STRING,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_varchar, types.T_enum},
+				ReturnTyp:       types.T_varchar,
+				UseNewFramework: true,
+				NewFn:           multi.EnumToString,
+			},
+		},
+	},
+	STRING_TO_ENUM: {
+		Id:     STRING_TO_ENUM,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp:       types.T_enum,
+				UseNewFramework: true,
+				NewFn:           multi.StringToEnum,
+			},
+		},
+	},
+	SET_TO_STRING: {
+		Id:     SET_TO_STRING,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_varchar, types.T_set},
+				ReturnTyp:       types.T_varchar,
+				UseNewFramework: true,
+				NewFn:           multi.SetToString,
+			},
+		},
+	},
+	STRING_TO_SET: {
+		Id:     STRING_TO_SET,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp:       types.T_set,
+				UseNewFramework: true,
+				NewFn:           multi.StringToSet,
+			},
+		},
+	},
+	MATCH_AGAINST: {
+		Id:     MATCH_AGAINST,
+		Flag:   plan.Function_PRODUCE_NO_NULL,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			// search string, search mode, parser, document count, document frequencies, indexed columns...
+			if len(inputs) < 6 || inputs[1] != types.T_int64 || inputs[3] != types.T_int64 {
+				return wrongFunctionParameters, nil
+			}
+			ret := make([]types.T, len(inputs))
+			convert := false
+			for i, t := range inputs {
+				if i == 1 || i == 3 {
+					ret[i] = t
+					continue
+				}
+				if t != types.T_char && t != types.T_varchar && t != types.T_text {
+					if castTable[t][types.T_varchar] {
+						ret[i] = types.T_varchar
+						convert = true
+						continue
+					}
+					return wrongFunctionParameters, nil
+				}
+				ret[i] = t
+			}
+			if convert {
+				return int32(0), ret
+			}
+			return int32(0), nil
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_float64,
+				UseNewFramework: true,
+				NewFn:           multi.MatchAgainst,
+			},
+		},
+	},
 }
@@ -309,6 +309,30 @@ const (
 	CURRVAL
 	LASTVAL
 
+	// enum and set conversion
+	ENUM_TO_STRING
+	STRING_TO_ENUM
+	SET_TO_STRING
+	STRING_TO_SET
+
+	// fulltext search
+	MATCH_AGAINST
+
+	// json functions
+	JSON_SET
+	JSON_INSERT
+	JSON_REPLACE
+	JSON_REMOVE
+	JSON_ARRAY
+	JSON_OBJECT
+	JSON_CONTAINS
+	JSON_CONTAINS_PATH
+	JSON_KEYS
+	JSON_LENGTH
+	JSON_ARRAYAGG
+	JSON_OBJECTAGG
+	MEMBER_OF
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -383,6 +407,14 @@ var functionIdRegister = map[string]int32{
 	"approx_count_distinct": APPROX_COUNT_DISTINCT,
 	"any_value":             ANY_VALUE,
 	"median":                MEDIAN,
+	// window
+	"row_number":  ROW_NUMBER,
+	"rank":        RANK,
+	"dense_rank":  DENSE_RANK,
+	"lag":         LAG,
+	"lead":        LEAD,
+	"first_value": FIRST_VALUE,
+	"last_value":  LAST_VALUE,
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
@@ -536,6 +568,24 @@ var functionIdRegister = map[string]int32{
 	"setval":                         SETVAL,
 	"currval":                        CURRVAL,
 	"lastval":                        LASTVAL,
+	"enum_to_string":                 ENUM_TO_STRING,
+	"string_to_enum":                 STRING_TO_ENUM,
+	"set_to_string":                  SET_TO_STRING,
+	"string_to_set":                  STRING_TO_SET,
+	"match_against":                  MATCH_AGAINST,
+	"json_set":                       JSON_SET,
+	"json_insert":                    JSON_INSERT,
+	"json_replace":                   JSON_REPLACE,
+	"json_remove":                    JSON_REMOVE,
+	"json_array":                     JSON_ARRAY,
+	"json_object":                    JSON_OBJECT,
+	"json_contains":                  JSON_CONTAINS,
+	"json_contains_path":             JSON_CONTAINS_PATH,
+	"json_keys":                      JSON_KEYS,
+	"json_length":                    JSON_LENGTH,
+	"json_arrayagg":                  JSON_ARRAYAGG,
+	"json_objectagg":                 JSON_OBJECTAGG,
+	"member_of":                      MEMBER_OF,
 }
 
 func GetFunctionIsWinfunByName(name string) bool {
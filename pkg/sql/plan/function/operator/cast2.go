@@ -26,6 +26,7 @@ import (
 	"unsafe"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/builtin/binary"
@@ -299,6 +300,11 @@ var supportedTypeCast = map[types.T][]types.T{
 	},
 
 	types.T_json: {
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64,
+		types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_datetime, types.T_time,
 		types.T_char, types.T_varchar, types.T_text,
 	},
 
@@ -402,7 +408,7 @@ func NewCast(parameters []*vector.Vector, result vector.FunctionResultWrapper, p
 		err = rowidToOthers(proc.Ctx, s, *toType, result, length)
 	case types.T_json:
 		s := vector.GenerateFunctionStrParameter(from)
-		err = jsonToOthers(proc.Ctx, s, *toType, result, length)
+		err = jsonToOthers(proc, s, *toType, result, length)
 	default:
 		// XXX we set the function here to adapt to the BVT cases.
 		err = formatCastError(proc.Ctx, from, *toType, "")
@@ -1438,15 +1444,65 @@ func rowidToOthers(ctx context.Context,
 	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from rowid to %s", toType))
 }
 
-func jsonToOthers(ctx context.Context,
+func jsonToOthers(proc *process.Process,
 	source vector.FunctionParameterWrapper[types.Varlena],
 	toType types.Type, result vector.FunctionResultWrapper, length int) error {
 	switch toType.Oid {
 	case types.T_char, types.T_varchar, types.T_text:
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return jsonToStr(source, rs, length)
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_datetime, types.T_time:
+		return jsonScalarToOthers(proc, source, toType, result, length)
+	}
+	return moerr.NewInternalError(proc.Ctx, fmt.Sprintf("unsupported cast from json to %s", toType))
+}
+
+// jsonScalarToOthers casts the json scalars as their strings are cast, a json string is unquoted,
+// true and false are 1 and 0, and a json null is null. The json arrays and objects can not be cast.
+func jsonScalarToOthers(proc *process.Process,
+	source vector.FunctionParameterWrapper[types.Varlena],
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	strs := vector.NewVec(types.T_varchar.ToType())
+	defer strs.Free(proc.Mp())
+	var i uint64
+	for i = 0; i < uint64(length); i++ {
+		v, null := source.GetStrValue(i)
+		if null {
+			if err := vector.AppendBytes(strs, nil, true, proc.Mp()); err != nil {
+				return err
+			}
+			continue
+		}
+		bj := types.DecodeJson(v)
+		var str string
+		switch bj.Type {
+		case bytejson.TpCodeObject, bytejson.TpCodeArray:
+			return moerr.NewInvalidArg(proc.Ctx, fmt.Sprintf("cast json to %s", toType), bj.String())
+		case bytejson.TpCodeString:
+			str = string(bj.GetString())
+		case bytejson.TpCodeLiteral:
+			switch {
+			case bj.IsNull():
+				if err := vector.AppendBytes(strs, nil, true, proc.Mp()); err != nil {
+					return err
+				}
+				continue
+			case bj.String() == "true":
+				str = "1"
+			default:
+				str = "0"
+			}
+		default:
+			str = bj.String()
+		}
+		if err := vector.AppendBytes(strs, []byte(str), false, proc.Mp()); err != nil {
+			return err
+		}
 	}
-	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from json to %s", toType))
+	return strTypeToOthers(proc, vector.GenerateFunctionStrParameter(strs), toType, result, length)
 }
 
 func integerToFixFloat[T1, T2 constraints.Integer | constraints.Float](
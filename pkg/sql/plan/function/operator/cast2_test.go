@@ -1471,6 +1471,57 @@ func initCastTestCase() []tcTemp {
 		},
 	}
 
+	encodeJson := func(strs ...string) []string {
+		rs := make([]string, len(strs))
+		for i, str := range strs {
+			bj, _ := types.ParseStringToByteJson(str)
+			dt, _ := types.EncodeJson(bj)
+			rs[i] = string(dt)
+		}
+		return rs
+	}
+	castJsonToOthers := []tcTemp{
+		{
+			info: "json to uint64",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(
+					types.T_json.ToType(),
+					encodeJson(`12`, `"34"`, `true`, `null`), nil),
+				testutil.NewFunctionTestInput(
+					types.T_uint64.ToType(), []uint64{}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(
+				types.T_uint64.ToType(), false,
+				[]uint64{12, 34, 1, 0}, []bool{false, false, false, true}),
+		},
+		{
+			info: "json to date",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(
+					types.T_json.ToType(),
+					encodeJson(`"2004-04-03"`), nil),
+				testutil.NewFunctionTestInput(
+					types.T_date.ToType(), []types.Date{}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(
+				types.T_date.ToType(), false,
+				[]types.Date{s01date}, nil),
+		},
+		{
+			info: "json array to int64",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(
+					types.T_json.ToType(),
+					encodeJson(`[1, 2]`), nil),
+				testutil.NewFunctionTestInput(
+					types.T_int64.ToType(), []int64{}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(
+				types.T_int64.ToType(), true,
+				[]int64{}, nil),
+		},
+	}
+
 	// init the testCases
 	testCases = append(testCases, castToSameTypeCases...)
 	testCases = append(testCases, castInt8ToOthers...)
@@ -1486,6 +1537,7 @@ func initCastTestCase() []tcTemp {
 	testCases = append(testCases, castStrToOthers...)
 	testCases = append(testCases, castDecToOthers...)
 	testCases = append(testCases, castTimestampToOthers...)
+	testCases = append(testCases, castJsonToOthers...)
 
 	return testCases
 }
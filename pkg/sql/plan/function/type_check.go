@@ -563,6 +563,31 @@ func generalTypeCheckForUnaryAggregate(overloads []Function, inputs []types.T) (
 	return wrongFuncParamForAgg, nil
 }
 
+// jsonFunctionTypeCheck returns a type check function for json functions.
+// the first docArgs parameters should be json documents (json or string), parameters
+// that isPath returns true for will be cast to varchar, and the others keep their types.
+func jsonFunctionTypeCheck(validArgs func(n int) bool, docArgs int, isPath func(i int) bool) func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	return func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+		if !validArgs(len(inputs)) {
+			return wrongFunctionParameters, nil
+		}
+		ts = make([]types.T, len(inputs))
+		for i, t := range inputs {
+			ts[i] = t
+			if i < docArgs {
+				if t != types.T_json && !types.IsString(t) && t != types.T_any { //any: null scalar
+					return wrongFunctionParameters, nil
+				}
+				continue
+			}
+			if isPath != nil && isPath(i) && !types.IsString(t) && t != types.T_any {
+				ts[i] = types.T_varchar
+			}
+		}
+		return 0, ts
+	}
+}
+
 // tryToMatch checks whether the types of the two input parameters match directly
 // or can be matched by implicit type conversion.
 // If the match is successful,
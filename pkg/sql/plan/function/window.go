@@ -0,0 +1,175 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func initWindowFunction() {
+	var err error
+
+	for fid, fs := range windows {
+		err = appendFunction(context.Background(), fid, fs)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// windows contains the window function indexed by function id.
+// the functions only carry the signature used by the binder,
+// evaluation is done by the window operator.
+var windows = map[int]Functions{
+	ROW_NUMBER: {
+		Id:          ROW_NUMBER,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: typeCheckForRankWindow,
+		Overloads: []Function{
+			{
+				Index:             0,
+				ReturnTyp:         types.T_int64,
+				ResultWillNotNull: true,
+			},
+		},
+	},
+	RANK: {
+		Id:          RANK,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: typeCheckForRankWindow,
+		Overloads: []Function{
+			{
+				Index:             0,
+				ReturnTyp:         types.T_int64,
+				ResultWillNotNull: true,
+			},
+		},
+	},
+	DENSE_RANK: {
+		Id:          DENSE_RANK,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: typeCheckForRankWindow,
+		Overloads: []Function{
+			{
+				Index:             0,
+				ReturnTyp:         types.T_int64,
+				ResultWillNotNull: true,
+			},
+		},
+	},
+	LAG: {
+		Id:          LAG,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: typeCheckForOffsetWindow,
+		Overloads: []Function{
+			{
+				Index:              0,
+				FlexibleReturnType: firstParameterType,
+			},
+		},
+	},
+	LEAD: {
+		Id:          LEAD,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: typeCheckForOffsetWindow,
+		Overloads: []Function{
+			{
+				Index:              0,
+				FlexibleReturnType: firstParameterType,
+			},
+		},
+	},
+	FIRST_VALUE: {
+		Id:          FIRST_VALUE,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: typeCheckForValueWindow,
+		Overloads: []Function{
+			{
+				Index:              0,
+				FlexibleReturnType: firstParameterType,
+			},
+		},
+	},
+	LAST_VALUE: {
+		Id:          LAST_VALUE,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: typeCheckForValueWindow,
+		Overloads: []Function{
+			{
+				Index:              0,
+				FlexibleReturnType: firstParameterType,
+			},
+		},
+	},
+}
+
+func firstParameterType(parameters []types.Type) types.Type {
+	return parameters[0]
+}
+
+// typeCheckForRankWindow checks row_number(), rank() and dense_rank(), they take no arguments.
+func typeCheckForRankWindow(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) == 0 {
+		return 0, nil
+	}
+	return wrongFunctionParameters, nil
+}
+
+// typeCheckForValueWindow checks first_value(expr) and last_value(expr).
+func typeCheckForValueWindow(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) == 1 {
+		return 0, nil
+	}
+	return wrongFunctionParameters, nil
+}
+
+// typeCheckForOffsetWindow checks lag(expr [, offset [, default]]) and lead(expr [, offset [, default]]).
+// offset will be converted to int64, and default will be converted to the type of expr.
+func typeCheckForOffsetWindow(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) < 1 || len(inputs) > 3 {
+		return wrongFunctionParameters, nil
+	}
+	needCast := false
+	targets := make([]types.T, len(inputs))
+	copy(targets, inputs)
+	if len(inputs) > 1 && inputs[1] != types.T_int64 && inputs[1] != ScalarNull {
+		if !types.IsInteger(inputs[1]) {
+			return wrongFunctionParameters, nil
+		}
+		targets[1] = types.T_int64
+		needCast = true
+	}
+	if len(inputs) > 2 && inputs[2] != inputs[0] && inputs[2] != ScalarNull && inputs[0] != ScalarNull {
+		if !castTable[inputs[2]][inputs[0]] {
+			return wrongFunctionParameters, nil
+		}
+		targets[2] = inputs[0]
+		needCast = true
+	}
+	if needCast {
+		return 0, targets
+	}
+	return 0, nil
+}
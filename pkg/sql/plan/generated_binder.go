@@ -0,0 +1,102 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func NewGeneratedBinder(builder *QueryBuilder, ctx *BindContext) *GeneratedBinder {
+	b := &GeneratedBinder{}
+	b.sysCtx = builder.GetContext()
+	b.builder = builder
+	b.ctx = ctx
+	b.impl = b
+	return b
+}
+
+// From: https://dev.mysql.com/doc/refman/8.0/en/create-table-generated-columns.html
+// the functions whose result may differ between two calls with the same arguments.
+var nonDeterministicFunctions = map[string]int{
+	"connection_id":     0,
+	"current_date":      0,
+	"current_role":      0,
+	"current_time":      0,
+	"current_timestamp": 0,
+	"current_user":      0,
+	"curdate":           0,
+	"curtime":           0,
+	"database":          0,
+	"found_rows":        0,
+	"last_insert_id":    0,
+	"localtime":         0,
+	"localtimestamp":    0,
+	"now":               0,
+	"rand":              0,
+	"row_count":         0,
+	"schema":            0,
+	"sleep":             0,
+	"sysdate":           0,
+	"unix_timestamp":    0,
+	"user":              0,
+	"utc_date":          0,
+	"utc_time":          0,
+	"utc_timestamp":     0,
+	"uuid":              0,
+	"version":           0,
+	"nextval":           0,
+	"setval":            0,
+	"currval":           0,
+	"lastval":           0,
+	"load_file":         0,
+}
+
+func (b *GeneratedBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	switch exprImpl := astExpr.(type) {
+	case *tree.FuncExpr:
+		funcRef, ok := exprImpl.Func.FunctionReference.(*tree.UnresolvedName)
+		if !ok {
+			return nil, moerr.NewNYI(b.GetContext(), "invalid function expr '%v'", exprImpl)
+		}
+		funcName := strings.ToLower(funcRef.Parts[0])
+		if _, ok := nonDeterministicFunctions[funcName]; ok {
+			return nil, moerr.NewInvalidInput(b.GetContext(), "function %s is not allowed in the generated column expression", funcName)
+		}
+	case *tree.VarExpr, *tree.ParamExpr:
+		return nil, moerr.NewInvalidInput(b.GetContext(), "variable '%s' is not allowed in the generated column expression", tree.String(astExpr, dialect.MYSQL))
+	}
+	return b.baseBindExpr(astExpr, depth, isRoot)
+}
+
+func (b *GeneratedBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32, isRoot bool) (*plan.Expr, error) {
+	return b.baseBindColRef(astExpr, depth, isRoot)
+}
+
+func (b *GeneratedBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(b.GetContext(), "aggregate functions not allowed in generated column expression")
+}
+
+func (b *GeneratedBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(b.GetContext(), "window functions not allowed in generated column expression")
+}
+
+func (b *GeneratedBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(b.GetContext(), "subquery not allowed in generated column expression")
+}
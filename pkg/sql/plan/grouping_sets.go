@@ -0,0 +1,215 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	// MaxGroupingColumns is the max number of distinct columns in ROLLUP, CUBE and GROUPING SETS,
+	// each of them takes one bit of the grouping id.
+	MaxGroupingColumns = 32
+	// MaxGroupingSets is the max number of grouping sets after expanding ROLLUP, CUBE and GROUPING SETS.
+	MaxGroupingSets = 4096
+)
+
+// expandGroupingSets expands ROLLUP, CUBE and GROUPING SETS in the GROUP BY clause
+// into the cross product of their grouping sets. It returns nil if there is none of them.
+func expandGroupingSets(ctx context.Context, groupBy tree.GroupBy) ([]tree.Exprs, error) {
+	hasGroupingSets := false
+	for _, item := range groupBy {
+		switch item.(type) {
+		case *tree.RollupExpr, *tree.CubeExpr, *tree.GroupingSetsExpr:
+			hasGroupingSets = true
+		}
+	}
+	if !hasGroupingSets {
+		return nil, nil
+	}
+
+	sets := []tree.Exprs{{}}
+	for _, item := range groupBy {
+		var itemSets []tree.Exprs
+		switch e := item.(type) {
+		case *tree.RollupExpr:
+			for i := len(e.Exprs); i >= 0; i-- {
+				itemSets = append(itemSets, e.Exprs[:i])
+			}
+
+		case *tree.CubeExpr:
+			if len(e.Exprs) >= MaxGroupingColumns {
+				return nil, moerr.NewNotSupported(ctx, "more than %d columns in CUBE", MaxGroupingColumns)
+			}
+			// the bits of mask are the columns not grouped, so the set of all columns comes first
+			for mask := 0; mask < 1<<len(e.Exprs); mask++ {
+				var set tree.Exprs
+				for i, expr := range e.Exprs {
+					if mask&(1<<i) == 0 {
+						set = append(set, expr)
+					}
+				}
+				itemSets = append(itemSets, set)
+			}
+
+		case *tree.GroupingSetsExpr:
+			itemSets = e.Sets
+
+		default:
+			itemSets = []tree.Exprs{{item}}
+		}
+
+		if len(sets)*len(itemSets) > MaxGroupingSets {
+			return nil, moerr.NewNotSupported(ctx, "more than %d grouping sets", MaxGroupingSets)
+		}
+		newSets := make([]tree.Exprs, 0, len(sets)*len(itemSets))
+		for _, set := range sets {
+			for _, itemSet := range itemSets {
+				newSet := make(tree.Exprs, 0, len(set)+len(itemSet))
+				newSet = append(newSet, set...)
+				newSet = append(newSet, itemSet...)
+				newSets = append(newSets, newSet)
+			}
+		}
+		sets = newSets
+	}
+
+	return sets, nil
+}
+
+// bindGroupingSets binds the columns of the grouping sets as the GROUP BY columns, and appends the grouping id
+// as the last one. The grouping id of a set has the bits of the columns not grouped by the set, and the ordinal
+// of the set among the same sets in the high 32 bits, so that duplicate sets produce duplicate rows.
+// The AGG node replaces the grouping id with the one of each set and the columns not grouped with NULL.
+func (builder *QueryBuilder) bindGroupingSets(ctx *BindContext, groupBinder *GroupBinder, sets []tree.Exprs) error {
+	poses := make([][]int32, len(sets))
+	for i, set := range sets {
+		for _, expr := range set {
+			expr, err := ctx.qualifyColumnNames(expr, nil, false)
+			if err != nil {
+				return err
+			}
+
+			if _, err = groupBinder.BindExpr(expr, 0, true); err != nil {
+				return err
+			}
+			poses[i] = append(poses[i], ctx.groupByAst[tree.String(expr, dialect.MYSQL)])
+		}
+	}
+
+	if len(ctx.groups) > MaxGroupingColumns {
+		return moerr.NewNotSupported(builder.GetContext(), "more than %d columns in grouping sets", MaxGroupingColumns)
+	}
+
+	allColumns := int64(1)<<len(ctx.groups) - 1
+	ordinals := make(map[int64]int64)
+	ctx.groupingSets = make([]int64, len(sets))
+	for i := range sets {
+		mask := allColumns
+		for _, pos := range poses[i] {
+			mask &^= 1 << pos
+		}
+		ctx.groupingSets[i] = mask | ordinals[mask]<<MaxGroupingColumns
+		ordinals[mask]++
+	}
+
+	// the columns become NULL in the sets not grouping them
+	for _, expr := range ctx.groups {
+		expr.Typ = DeepCopyTyp(expr.Typ)
+		expr.Typ.NotNullable = false
+	}
+	ctx.groups = append(ctx.groups, makePlan2Int64ConstExprWithType(0))
+
+	return nil
+}
+
+// makeGroupingSets returns the grouping ids of the grouping sets for the AGG node.
+func makeGroupingSets(groupingSets []int64) []*plan.Expr {
+	if len(groupingSets) == 0 {
+		return nil
+	}
+
+	exprs := make([]*plan.Expr, len(groupingSets))
+	for i, gid := range groupingSets {
+		exprs[i] = makePlan2Int64ConstExprWithType(gid)
+	}
+	return exprs
+}
+
+// bindGroupingFunc binds GROUPING(a, b, ...), which returns a bit mask of whether each argument is not grouped
+// by the grouping set of the row, with the bit of the last argument as the lowest bit.
+func (b *baseBinder) bindGroupingFunc(astExpr *tree.FuncExpr) (*plan.Expr, error) {
+	switch impl := b.impl.(type) {
+	case *ProjectionBinder:
+	case *HavingBinder:
+		if impl.insideAgg {
+			return nil, moerr.NewInvalidInput(b.GetContext(), "GROUPING function can not be used inside aggregate functions")
+		}
+	default:
+		return nil, moerr.NewInvalidInput(b.GetContext(), "GROUPING function can only be used in SELECT list, HAVING or ORDER BY clause")
+	}
+	if len(b.ctx.groupByAst) == 0 && len(b.ctx.groupingSets) == 0 {
+		return nil, moerr.NewInvalidInput(b.GetContext(), "GROUPING function requires GROUP BY clause")
+	}
+
+	ctx := b.GetContext()
+	gidPos := int32(len(b.ctx.groups) - 1)
+	var ret *plan.Expr
+	for i, arg := range astExpr.Exprs {
+		colPos, ok := b.ctx.groupByAst[tree.String(arg, dialect.MYSQL)]
+		if !ok {
+			return nil, moerr.NewInvalidInput(ctx, "argument #%d of GROUPING function is not in GROUP BY", i+1)
+		}
+
+		bit := makePlan2Int64ConstExprWithType(0)
+		if len(b.ctx.groupingSets) > 0 {
+			gid := &plan.Expr{
+				Typ: b.ctx.groups[gidPos].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: b.ctx.groupTag,
+						ColPos: gidPos,
+					},
+				},
+			}
+			shifted, err := bindFuncExprImplByPlanExpr(ctx, ">>", []*plan.Expr{gid, makePlan2Int64ConstExprWithType(int64(colPos))})
+			if err != nil {
+				return nil, err
+			}
+			if bit, err = bindFuncExprImplByPlanExpr(ctx, "&", []*plan.Expr{shifted, makePlan2Int64ConstExprWithType(1)}); err != nil {
+				return nil, err
+			}
+		}
+
+		if ret == nil {
+			ret = bit
+			continue
+		}
+		shifted, err := bindFuncExprImplByPlanExpr(ctx, "<<", []*plan.Expr{ret, makePlan2Int64ConstExprWithType(1)})
+		if err != nil {
+			return nil, err
+		}
+		if ret, err = bindFuncExprImplByPlanExpr(ctx, "|", []*plan.Expr{shifted, bit}); err != nil {
+			return nil, err
+		}
+	}
+
+	return ret, nil
+}
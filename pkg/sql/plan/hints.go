@@ -0,0 +1,305 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// The optimizer hints of all the query blocks are applied to the whole statement, the tables
+// in them are the names or the aliases of the tables in FROM. The hints of the first query block
+// of the statement are collected before anything is built, so a plan baseline only needs to
+// replace them.
+
+func (builder *QueryBuilder) collectRootHints(stmt *tree.Select) {
+	if builder.rootClause != nil {
+		return
+	}
+	if clause := tree.FirstSelectClause(stmt.Select); clause != nil {
+		builder.rootClause = clause
+		builder.hints = append(builder.hints, clause.Hints...)
+	}
+}
+
+func (builder *QueryBuilder) collectHints(clause *tree.SelectClause) {
+	if clause != builder.rootClause {
+		builder.hints = append(builder.hints, clause.Hints...)
+	}
+}
+
+// leafTableName returns the name of the table scanned by the leaf of the join, empty if the
+// leaf is not a single table, like an outer join.
+func (builder *QueryBuilder) leafTableName(leaf *plan.Node) string {
+	if leaf.NodeType == plan.Node_JOIN || len(leaf.BindingTags) == 0 {
+		return ""
+	}
+	return builder.tableByTag[leaf.BindingTags[0]]
+}
+
+func hintHasTable(hint *tree.OptimizerHint, table string) bool {
+	for _, name := range hint.Names() {
+		if name == table {
+			return true
+		}
+	}
+	return false
+}
+
+// exchangeHint returns how the hints BROADCAST and SHUFFLE want the table to be exchanged
+func (builder *QueryBuilder) exchangeHint(table string) joinExchange {
+	if table == "" {
+		return exchangeAny
+	}
+	for _, hint := range builder.hints {
+		switch hint.Name {
+		case tree.HintBroadcast:
+			if hintHasTable(hint, table) {
+				return exchangeBroadcast
+			}
+		case tree.HintShuffle:
+			if hintHasTable(hint, table) {
+				return exchangeShuffle
+			}
+		}
+	}
+	return exchangeAny
+}
+
+// joinOrderHint returns the join order forced by the first unused JOIN_ORDER whose tables are all
+// leaves of the join, nil if there is no such one. JOIN_ORDER(t1, t2, (t3, t4)) joins the tables
+// from left to right, t1 probes the hashmap of t2, and the group in the parentheses is joined first.
+func (builder *QueryBuilder) joinOrderHint(e *joinEnumerator, names []string) *joinPlan {
+	relByName := make(map[string]int, len(names))
+	for i, name := range names {
+		if name != "" {
+			relByName[name] = i
+		}
+	}
+	for _, hint := range builder.hints {
+		if hint.Name != tree.HintJoinOrder || builder.usedHints[hint] || len(hint.Names()) < 2 {
+			continue
+		}
+		var used uint64
+		if p := e.forcedPlan(hint.Args, relByName, &used); p != nil {
+			builder.usedHints[hint] = true
+			return p
+		}
+	}
+	return nil
+}
+
+// forcedPlan builds the join tree of the arguments of JOIN_ORDER, nil if a table is unknown or
+// appears more than once.
+func (e *joinEnumerator) forcedPlan(args []*tree.HintArg, relByName map[string]int, used *uint64) *joinPlan {
+	var p *joinPlan
+	for _, arg := range args {
+		var next *joinPlan
+		if arg.Group != nil {
+			next = e.forcedPlan(arg.Group, relByName, used)
+		} else if i, ok := relByName[arg.Name]; ok && *used&(1<<i) == 0 {
+			*used |= 1 << i
+			next = e.leafPlan(i)
+		}
+		if next == nil {
+			return nil
+		}
+		if p == nil {
+			p = next
+		} else {
+			p = e.makeJoin(p, next, e.cardinality(p.rels|next.rels))
+		}
+	}
+	return p
+}
+
+// recordJoinOrder adds the join order decided to the outline of the plan, it is only recorded
+// if all the leaves are tables.
+func (builder *QueryBuilder) recordJoinOrder(p *joinPlan, names []string) {
+	if p.left == nil {
+		return
+	}
+	for _, name := range names {
+		if name == "" {
+			return
+		}
+	}
+	var toArgs func(p *joinPlan) []*tree.HintArg
+	toArgs = func(p *joinPlan) []*tree.HintArg {
+		if p.left == nil {
+			return []*tree.HintArg{{Name: names[p.relID]}}
+		}
+		args := toArgs(p.left)
+		if p.right.left == nil {
+			return append(args, &tree.HintArg{Name: names[p.right.relID]})
+		}
+		return append(args, &tree.HintArg{Group: toArgs(p.right)})
+	}
+	builder.outline = append(builder.outline, &tree.OptimizerHint{
+		Name: tree.HintJoinOrder,
+		Args: toArgs(p),
+	})
+}
+
+// nestedLoopJoinHint returns true if the join of the tables is not a hash join by the hints
+// HASH_JOIN and NO_HASH_JOIN, the first one naming one of the tables or without any table wins.
+func (builder *QueryBuilder) nestedLoopJoinHint(tables []string) bool {
+	for _, hint := range builder.hints {
+		if hint.Name != tree.HintHashJoin && hint.Name != tree.HintNoHashJoin {
+			continue
+		}
+		matched := len(hint.Args) == 0
+		for _, table := range tables {
+			matched = matched || (table != "" && hintHasTable(hint, table))
+		}
+		if matched {
+			return hint.Name == tree.HintNoHashJoin
+		}
+	}
+	return false
+}
+
+// applyJoinMethodHints marks the joins which are not hash joins by the hints. A right join is
+// turned into a left join since there is no nested loop right join.
+func (builder *QueryBuilder) applyJoinMethodHints(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.applyJoinMethodHints(childID)
+	}
+	if node.NodeType != plan.Node_JOIN || len(builder.hints) == 0 {
+		return
+	}
+	switch node.JoinType {
+	case plan.Node_INNER, plan.Node_LEFT, plan.Node_RIGHT, plan.Node_SEMI, plan.Node_ANTI, plan.Node_SINGLE:
+	default:
+		return
+	}
+	tables := make([]string, len(node.Children))
+	for i, childID := range node.Children {
+		tables[i] = builder.leafTableName(builder.qry.Nodes[childID])
+	}
+	if !builder.nestedLoopJoinHint(tables) {
+		return
+	}
+	node.NestedLoopJoin = true
+	if node.JoinType == plan.Node_RIGHT {
+		node.Children[0], node.Children[1] = node.Children[1], node.Children[0]
+		node.JoinType = plan.Node_LEFT
+	}
+}
+
+// indexAllowed returns false if the index of the table scanned by the node is excluded by the
+// index hints in FROM or by the hints USE_INDEX and IGNORE_INDEX. USE_INDEX(t) without any index
+// allows all of them and IGNORE_INDEX(t) excludes all of them.
+func (builder *QueryBuilder) indexAllowed(node *plan.Node, index string) bool {
+	for _, hint := range builder.indexHintsByNode[node.NodeId] {
+		found := false
+		for _, name := range hint.IndexNames {
+			found = found || strings.EqualFold(name, index)
+		}
+		if hint.HintType == tree.HintIgnore && found || hint.HintType != tree.HintIgnore && !found {
+			return false
+		}
+	}
+
+	table := builder.leafTableName(node)
+	if table == "" {
+		return true
+	}
+	for _, hint := range builder.hints {
+		if hint.Name != tree.HintUseIndex && hint.Name != tree.HintIgnoreIndex {
+			continue
+		}
+		names := hint.Names()
+		if len(names) == 0 || names[0] != table {
+			continue
+		}
+		found := len(names) == 1
+		for _, name := range names[1:] {
+			found = found || strings.EqualFold(name, index)
+		}
+		if hint.Name == tree.HintIgnoreIndex && found || hint.Name == tree.HintUseIndex && !found {
+			return false
+		}
+	}
+	return true
+}
+
+// indexHinted returns true if the index of the table scanned by the node is named by USE INDEX or
+// FORCE INDEX in FROM or by the hint USE_INDEX, the index is used then even if it's not selective.
+func (builder *QueryBuilder) indexHinted(node *plan.Node, index string) bool {
+	for _, hint := range builder.indexHintsByNode[node.NodeId] {
+		if hint.HintType == tree.HintIgnore {
+			continue
+		}
+		for _, name := range hint.IndexNames {
+			if strings.EqualFold(name, index) {
+				return true
+			}
+		}
+	}
+
+	table := builder.leafTableName(node)
+	if table == "" {
+		return false
+	}
+	for _, hint := range builder.hints {
+		if hint.Name != tree.HintUseIndex {
+			continue
+		}
+		names := hint.Names()
+		if len(names) == 0 || names[0] != table {
+			continue
+		}
+		for _, name := range names[1:] {
+			if strings.EqualFold(name, index) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// BuildPlanOutline builds the plan of the query and returns the hints reproducing it: the join
+// orders decided and the other hints of the query.
+func BuildPlanOutline(ctx CompilerContext, stmt *tree.Select) (tree.OptimizerHints, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindCtx := NewBindContext(builder, nil)
+	rootId, err := builder.buildSelect(stmt, bindCtx, true)
+	if err != nil {
+		return nil, err
+	}
+	builder.qry.Steps = append(builder.qry.Steps, rootId)
+	if _, err = builder.createQuery(); err != nil {
+		return nil, err
+	}
+
+	outline := builder.outline
+	seen := make(map[string]bool)
+	for _, hint := range builder.hints {
+		if hint.Name == tree.HintJoinOrder {
+			continue
+		}
+		text := tree.String(hint, dialect.MYSQL)
+		if !seen[text] {
+			seen[text] = true
+			outline = append(outline, hint)
+		}
+	}
+	return outline, nil
+}
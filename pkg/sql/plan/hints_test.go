@@ -0,0 +1,131 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+// joinShape prints the join tree of the query like ((a b) c), the left child probes the right one
+func joinShape(qry *plan.Query, nodeID int32) string {
+	node := qry.Nodes[nodeID]
+	switch {
+	case node.NodeType == plan.Node_JOIN:
+		return "(" + joinShape(qry, node.Children[0]) + " " + joinShape(qry, node.Children[1]) + ")"
+	case node.NodeType == plan.Node_TABLE_SCAN:
+		return node.TableDef.Name
+	case len(node.Children) > 0:
+		return joinShape(qry, node.Children[0])
+	}
+	return ""
+}
+
+func buildQueryForTest(t *testing.T, mock *MockOptimizer, sql string) *plan.Query {
+	logicPlan, err := runOneStmt(mock, t, sql)
+	require.NoError(t, err)
+	qry := logicPlan.GetQuery()
+	return qry
+}
+
+func TestJoinOrderHint(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	query := " * from nation n, region r, supplier s where n.n_regionkey = r.r_regionkey and s.s_nationkey = n.n_nationkey"
+
+	qry := buildQueryForTest(t, mock, "select /*+ JOIN_ORDER(r, n, s) */"+query)
+	require.Equal(t, "((region nation) supplier)", joinShape(qry, qry.Steps[0]))
+
+	qry = buildQueryForTest(t, mock, "select /*+ JOIN_ORDER(s, (r, n)) */"+query)
+	require.Equal(t, "(supplier (region nation))", joinShape(qry, qry.Steps[0]))
+
+	// the other tables are joined by the cost
+	qry = buildQueryForTest(t, mock, "select /*+ JOIN_ORDER(s, n) */"+query)
+	require.Contains(t, joinShape(qry, qry.Steps[0]), "(supplier nation)")
+
+	// the broadcast table builds the hashmap
+	qry = buildQueryForTest(t, mock, "select"+query)
+	require.Equal(t, "(supplier (nation region))", joinShape(qry, qry.Steps[0]))
+	qry = buildQueryForTest(t, mock, "select /*+ BROADCAST(s) */"+query)
+	require.Equal(t, "((nation region) supplier)", joinShape(qry, qry.Steps[0]))
+
+	// the hint with unknown tables is ignored
+	_, err := runOneStmt(mock, t, "select /*+ JOIN_ORDER(x, n) */"+query)
+	require.NoError(t, err)
+}
+
+func TestNoHashJoinHint(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	query := " * from nation n join region r on n.n_regionkey = r.r_regionkey left join supplier s on s.s_nationkey = n.n_nationkey"
+
+	nestedLoopJoins := func(qry *plan.Query) int {
+		cnt := 0
+		for _, node := range qry.Nodes {
+			if node.NodeType == plan.Node_JOIN && node.NestedLoopJoin {
+				require.NotEqual(t, plan.Node_RIGHT, node.JoinType)
+				cnt++
+			}
+		}
+		return cnt
+	}
+	require.Equal(t, 0, nestedLoopJoins(buildQueryForTest(t, mock, "select"+query)))
+	require.Equal(t, 2, nestedLoopJoins(buildQueryForTest(t, mock, "select /*+ NO_HASH_JOIN() */"+query)))
+	require.Equal(t, 1, nestedLoopJoins(buildQueryForTest(t, mock, "select /*+ NO_HASH_JOIN(s) */"+query)))
+	require.Equal(t, 1, nestedLoopJoins(buildQueryForTest(t, mock, "select /*+ HASH_JOIN(s) NO_HASH_JOIN() */"+query)))
+}
+
+func TestIndexHint(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	usesIndexTable := func(sql string) bool {
+		qry := buildQueryForTest(t, mock, sql)
+		for _, node := range qry.Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN && strings.HasPrefix(node.TableDef.Name, catalog.PrefixIndexTableName) {
+				return true
+			}
+		}
+		return false
+	}
+	require.True(t, usesIndexTable("select * from ft_logs where match(msg) against('disk')"))
+	require.True(t, usesIndexTable("select /*+ USE_INDEX(ft_logs ft_msg) */ * from ft_logs where match(msg) against('disk')"))
+	require.False(t, usesIndexTable("select /*+ IGNORE_INDEX(ft_logs ft_msg) */ * from ft_logs where match(msg) against('disk')"))
+	require.False(t, usesIndexTable("select /*+ USE_INDEX(l ft_host_msg) */ * from ft_logs l where match(msg) against('disk')"))
+	require.False(t, usesIndexTable("select * from ft_logs ignore index (ft_msg) where match(msg) against('disk')"))
+	require.True(t, usesIndexTable("select * from ft_logs use index (FT_MSG) where match(msg) against('disk')"))
+}
+
+func TestBuildPlanOutline(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "select /*+ NO_HASH_JOIN(s) */ * from nation n, region r, supplier s where n.n_regionkey = r.r_regionkey and s.s_nationkey = n.n_nationkey"
+	stmt, err := mysql.ParseOne(mock.CurrentContext().GetContext(), sql, 1)
+	require.NoError(t, err)
+	qry := buildQueryForTest(t, mock, sql)
+
+	outline, err := BuildPlanOutline(mock.CurrentContext(), stmt.(*tree.Select))
+	require.NoError(t, err)
+	require.Len(t, outline, 2)
+	require.Equal(t, tree.HintJoinOrder, outline[0].Name)
+	require.Equal(t, "NO_HASH_JOIN(s)", tree.String(outline[1], dialect.MYSQL))
+
+	// the outline reproduces the plan
+	text := tree.String(outline, dialect.MYSQL)
+	pinned := buildQueryForTest(t, mock, "select "+text+sql[strings.Index(sql, "*/")+2:])
+	require.Equal(t, joinShape(qry, qry.Steps[0]), joinShape(pinned, pinned.Steps[0]))
+}
@@ -0,0 +1,746 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// A table scan filtered by the parts of a secondary index is rewritten into a scan of the index
+// table, whose key is the part for a single column index and serial(parts...) for a composite
+// index. The primary keys found are joined back to the table, the join builds the hashmap from
+// the index side and its runtime filter makes the table scan read only the blocks of the keys.
+// If the query uses no column of the table but the part and the primary key, the index covers
+// the query and the table is not read at all.
+//
+// A single column index is used for an equality, an IN list or a range on the part, a composite
+// index for the equalities on all the parts, or on its leading parts with an optional range on the
+// next part. A range or a prefix is used only if it's selective or the index is named by the index
+// hints.
+//
+// A secondary index declared by KEY or INDEX is used the same way, its index table has the keys of
+// a unique index but a key may be found for many rows, so it's used only if it's selective or
+// hinted, and a unique index is preferred to it. A secondary index of a table without primary key
+// has no index table and is never used.
+//
+// A functional index on a json path is used for the keys collected from the WHERE clause by
+// collectJsonIndexKeys, if no unique index is used. Its index table is always joined to the table
+// as the key is not a column of the table.
+
+// IndexRangeSelectivity is the max ratio of the rows of a table returned by a range on an index,
+// the table is scanned instead for a range less selective.
+const IndexRangeSelectivity = 0.1
+
+const (
+	// indexKeyPoint is the equalities on all the parts
+	indexKeyPoint = iota
+	// indexKeyIn is an IN list on the part of a single column index
+	indexKeyIn
+	// indexKeyRange is the comparisons on the part of a single column index
+	indexKeyRange
+	// indexKeyPrefix is the equalities on the leading parts of a composite index and the
+	// comparisons on the next part
+	indexKeyPrefix
+)
+
+type indexCandidate struct {
+	indexDef *IndexDef
+	objRef   *ObjectRef
+	// tableDef is the index table, idxPos and priPos are the positions of its columns, priPos is
+	// -1 if the table has no primary key
+	tableDef *TableDef
+	idxPos   int32
+	priPos   int32
+	kind     int
+	// the filters of the table scan on the parts, the first eqNum filters are the equalities on the
+	// leading parts of a composite index
+	filters []*Expr
+	eqNum   int
+	// keyValue is the key looked up in a functional index, the filters are empty
+	keyValue *Expr
+	hinted   bool
+}
+
+// applyIndices rewrites the table scans of the tree by the secondary indexes, it returns the new
+// id of the node. refs is the columns referenced by the whole query.
+func (builder *QueryBuilder) applyIndices(nodeID int32, refs map[[2]int32]bool) (int32, error) {
+	node := builder.qry.Nodes[nodeID]
+	for i, childID := range node.Children {
+		newChildID, err := builder.applyIndices(childID, refs)
+		if err != nil {
+			return 0, err
+		}
+		node.Children[i] = newChildID
+	}
+	if node.NodeType != plan.Node_TABLE_SCAN || len(node.FilterList) == 0 || node.ObjRef == nil ||
+		node.TableDef == nil || node.TableDef.Partition != nil || node.IndexScanInfo != nil {
+		return nodeID, nil
+	}
+
+	var candidates []*indexCandidate
+	for _, indexDef := range node.TableDef.Indexes {
+		if !indexDef.TableExist || indexDef.Fulltext || indexDef.JsonPath != "" || indexDef.IndexTableName == "" {
+			continue
+		}
+		if !builder.indexAllowed(node, indexDef.IndexName) {
+			continue
+		}
+		if c := builder.matchIndex(node, indexDef); c != nil {
+			candidates = append(candidates, c)
+		}
+	}
+	// the hinted indexes first, then the point lookups, the unique indexes and the longest keys
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ci, cj := candidates[i], candidates[j]
+		if ci.hinted != cj.hinted {
+			return ci.hinted
+		}
+		if ci.kind != cj.kind {
+			return ci.kind < cj.kind
+		}
+		if ci.indexDef.Unique != cj.indexDef.Unique {
+			return ci.indexDef.Unique
+		}
+		return len(ci.filters) > len(cj.filters)
+	})
+
+	for _, c := range candidates {
+		mayScanMany := c.kind == indexKeyRange || c.kind == indexKeyPrefix || !c.indexDef.Unique
+		if mayScanMany && !c.hinted && !builder.selectiveRange(node, c) {
+			continue
+		}
+		if builder.indexCovers(node, c, refs) {
+			if err := builder.coverByIndex(node, c); err != nil {
+				return 0, err
+			}
+			builder.recordIndex(node, c)
+			return nodeID, nil
+		}
+		if c.priPos < 0 {
+			continue
+		}
+		joinID, err := builder.joinIndex(node, c)
+		if err != nil {
+			return 0, err
+		}
+		builder.recordIndex(node, c)
+		return joinID, nil
+	}
+
+	for _, key := range builder.jsonIndexKeys[nodeID] {
+		c := builder.matchJsonIndex(node, key)
+		if c == nil {
+			continue
+		}
+		joinID, err := builder.joinIndex(node, c)
+		if err != nil {
+			return 0, err
+		}
+		builder.recordIndex(node, c)
+		return joinID, nil
+	}
+	return nodeID, nil
+}
+
+// matchIndex returns the index candidate if the filters of the table scan can be looked up in
+// the index, or nil.
+func (builder *QueryBuilder) matchIndex(node *plan.Node, indexDef *IndexDef) *indexCandidate {
+	objRef, tableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, indexDef.IndexTableName)
+	if tableDef == nil {
+		return nil
+	}
+	c := &indexCandidate{
+		indexDef: indexDef,
+		objRef:   objRef,
+		tableDef: tableDef,
+		idxPos:   -1,
+		priPos:   -1,
+		hinted:   builder.indexHinted(node, indexDef.IndexName),
+	}
+	for i, col := range tableDef.Cols {
+		switch col.Name {
+		case catalog.IndexTableIndexColName:
+			c.idxPos = int32(i)
+		case catalog.IndexTablePrimaryColName:
+			c.priPos = int32(i)
+		}
+	}
+	if c.idxPos < 0 {
+		return nil
+	}
+
+	tag := node.BindingTags[0]
+	if len(indexDef.Parts) == 1 {
+		pos := findColumnPos(node.TableDef, indexDef.Parts[0])
+		if pos < 0 {
+			return nil
+		}
+		// the key of a single column index is the part itself
+		colTyp, idxTyp := node.TableDef.Cols[pos].Typ, tableDef.Cols[c.idxPos].Typ
+		if colTyp.Id != idxTyp.Id || colTyp.Scale != idxTyp.Scale {
+			return nil
+		}
+		var eq, in *Expr
+		var ranges []*Expr
+		for _, filter := range node.FilterList {
+			colPos, fn, ok := indexKeyOfFilter(filter, tag)
+			if !ok || colPos != pos {
+				continue
+			}
+			switch fn {
+			case "=":
+				eq = filter
+			case "in":
+				in = filter
+			default:
+				ranges = append(ranges, filter)
+			}
+		}
+		switch {
+		case eq != nil:
+			c.kind, c.filters = indexKeyPoint, []*Expr{eq}
+		case in != nil:
+			c.kind, c.filters = indexKeyIn, []*Expr{in}
+		case len(ranges) > 0:
+			c.kind, c.filters = indexKeyRange, ranges
+		default:
+			return nil
+		}
+		return c
+	}
+
+	c.kind = indexKeyPoint
+	for i, part := range indexDef.Parts {
+		pos := findColumnPos(node.TableDef, part)
+		if pos < 0 {
+			return nil
+		}
+		var eq *Expr
+		var ranges []*Expr
+		for _, filter := range node.FilterList {
+			colPos, fn, ok := indexKeyOfFilter(filter, tag)
+			if !ok || colPos != pos {
+				continue
+			}
+			if fn == "=" {
+				eq = filter
+				break
+			}
+			if fn != "in" {
+				ranges = append(ranges, filter)
+			}
+		}
+		if eq != nil {
+			c.filters = append(c.filters, eq)
+			continue
+		}
+		if i == 0 {
+			return nil
+		}
+		// a row with a null part isn't in the index table, so the parts after the key must not be
+		// null, a range on the next part already skips the rows where it's null
+		rest := indexDef.Parts[i:]
+		if len(ranges) > 0 {
+			rest = rest[1:]
+		}
+		for _, name := range rest {
+			if colNullable(node.TableDef, name) {
+				return nil
+			}
+		}
+		c.kind, c.eqNum = indexKeyPrefix, len(c.filters)
+		c.filters = append(c.filters, ranges...)
+		break
+	}
+	if c.kind == indexKeyPoint {
+		c.eqNum = len(c.filters)
+	}
+	return c
+}
+
+// colNullable returns true if the column of the table may be null
+func colNullable(tableDef *TableDef, name string) bool {
+	pos := findColumnPos(tableDef, name)
+	if pos < 0 {
+		return true
+	}
+	col := tableDef.Cols[pos]
+	if col.Primary || col.NotNull {
+		return false
+	}
+	return col.Default == nil || col.Default.NullAbility
+}
+
+// indexFilters returns the filters of the index scan on the key of the index
+func (builder *QueryBuilder) indexFilters(node *plan.Node, c *indexCandidate, tag int32) ([]*Expr, error) {
+	ctx := builder.GetContext()
+	idxCol := c.tableDef.Cols[c.idxPos]
+	keyExpr := &Expr{
+		Typ: DeepCopyTyp(idxCol.Typ),
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: tag,
+				ColPos: c.idxPos,
+				Name:   c.tableDef.Name + "." + idxCol.Name,
+			},
+		},
+	}
+
+	if c.keyValue != nil {
+		filter, err := bindFuncExprImplByPlanExpr(ctx, "=", []*Expr{keyExpr, DeepCopyExpr(c.keyValue)})
+		if err != nil {
+			return nil, err
+		}
+		return []*Expr{filter}, nil
+	}
+
+	if len(c.indexDef.Parts) > 1 {
+		return builder.compositeIndexFilters(node, c, keyExpr)
+	}
+
+	filters := make([]*Expr, len(c.filters))
+	for i, filter := range c.filters {
+		_, fn, _ := indexKeyOfFilter(filter, node.BindingTags[0])
+		val := indexKeyValue(filter)
+		expr, err := bindFuncExprImplByPlanExpr(ctx, fn, []*Expr{DeepCopyExpr(keyExpr), DeepCopyExpr(val)})
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = expr
+	}
+	return filters, nil
+}
+
+// compositeIndexFilters returns the filters on the key of a composite index. The key is encoded
+// so that the keys of the rows with the same leading parts are the keys in
+// [serial(parts...), serial(parts...) || 0xff), a range on the next part narrows the lower bound
+// to serial(parts..., low) and the upper bound to serial(parts..., high) || 0xff. The keys found
+// may be more than the rows matched, which are filtered again by the table scan.
+func (builder *QueryBuilder) compositeIndexFilters(node *plan.Node, c *indexCandidate, keyExpr *Expr) ([]*Expr, error) {
+	ctx := builder.GetContext()
+	tag := node.BindingTags[0]
+	args := make([]*Expr, 0, c.eqNum+1)
+	for _, filter := range c.filters[:c.eqNum] {
+		colPos, _, _ := indexKeyOfFilter(filter, tag)
+		arg, err := appendCastBeforeExpr(ctx, DeepCopyExpr(indexKeyValue(filter)), node.TableDef.Cols[colPos].Typ)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	// bound returns the filter comparing the key with the bound of the keys which have the parts,
+	// and the part last if it's not nil
+	bound := func(fn string, last *Expr) (*Expr, error) {
+		keyArgs := make([]*Expr, 0, len(args)+1)
+		for _, arg := range args {
+			keyArgs = append(keyArgs, DeepCopyExpr(arg))
+		}
+		if last != nil {
+			keyArgs = append(keyArgs, last)
+		}
+		key, err := bindFuncExprImplByPlanExpr(ctx, "serial", keyArgs)
+		if err != nil {
+			return nil, err
+		}
+		if fn == "<" {
+			key, err = bindFuncExprImplByPlanExpr(ctx, "concat", []*Expr{key, makePlan2StringConstExprWithType("\xff", true)})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return bindFuncExprImplByPlanExpr(ctx, fn, []*Expr{DeepCopyExpr(keyExpr), key})
+	}
+
+	if c.kind == indexKeyPoint {
+		filter, err := bound("=", nil)
+		if err != nil {
+			return nil, err
+		}
+		return []*Expr{filter}, nil
+	}
+
+	var filters []*Expr
+	var lower, upper bool
+	for _, filter := range c.filters[c.eqNum:] {
+		colPos, fn, _ := indexKeyOfFilter(filter, tag)
+		val, err := appendCastBeforeExpr(ctx, DeepCopyExpr(indexKeyValue(filter)), node.TableDef.Cols[colPos].Typ)
+		if err != nil {
+			return nil, err
+		}
+		cmp := ">="
+		if fn == "<" || fn == "<=" {
+			cmp, upper = "<", true
+		} else {
+			lower = true
+		}
+		expr, err := bound(cmp, val)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, expr)
+	}
+	if !lower {
+		expr, err := bound(">=", nil)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, expr)
+	}
+	if !upper {
+		expr, err := bound("<", nil)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, expr)
+	}
+	return filters, nil
+}
+
+// selectiveRange returns true if the range on the index returns few rows of the table
+func (builder *QueryBuilder) selectiveRange(node *plan.Node, c *indexCandidate) bool {
+	if node.Stats == nil || node.Stats.TableCnt <= 0 {
+		return false
+	}
+	stats, err := builder.indexScanStats(node, c)
+	if err != nil {
+		return false
+	}
+	return stats.Outcnt <= node.Stats.TableCnt*IndexRangeSelectivity
+}
+
+// indexScanStats returns the stats of the index table scanned by the key, a key is found at most
+// once in a unique index, and many times in a secondary index.
+func (builder *QueryBuilder) indexScanStats(node *plan.Node, c *indexCandidate) (*Stats, error) {
+	filters, err := builder.indexFilters(node, c, 0)
+	if err != nil {
+		return nil, err
+	}
+	expr, _ := HandleFiltersForZM(filters, builder.compCtx.GetProcess())
+	stats := builder.compCtx.Stats(c.objRef, expr)
+	if stats == nil {
+		stats = DefaultStats()
+	}
+	if c.kind == indexKeyRange || c.kind == indexKeyPrefix || c.keyValue != nil || !c.indexDef.Unique {
+		return stats, nil
+	}
+	keys := 1.0
+	if c.kind == indexKeyIn {
+		keys = float64(len(c.filters[0].GetF().Args[1].GetList().List))
+	}
+	if stats.Outcnt <= keys {
+		return stats, nil
+	}
+	selectivity := stats.Selectivity
+	if stats.TableCnt > 0 {
+		selectivity = keys / stats.TableCnt
+	}
+	return &plan.Stats{
+		Outcnt:      keys,
+		Cost:        keys,
+		TableCnt:    stats.TableCnt,
+		BlockNum:    stats.BlockNum,
+		Selectivity: selectivity,
+	}, nil
+}
+
+// indexCovers returns true if the columns of the table used by the query are all in the index
+// table, the key of a composite index can't be decoded so it never covers.
+func (builder *QueryBuilder) indexCovers(node *plan.Node, c *indexCandidate, refs map[[2]int32]bool) bool {
+	if len(c.indexDef.Parts) > 1 {
+		return false
+	}
+	tag := node.BindingTags[0]
+	partPos := findColumnPos(node.TableDef, c.indexDef.Parts[0])
+	pkPos := int32(-1)
+	if c.priPos >= 0 {
+		pkPos = findColumnPos(node.TableDef, getTablePriKeyName(node.TableDef.Pkey))
+	}
+	for ref := range refs {
+		if ref[0] == tag && ref[1] != partPos && (pkPos < 0 || ref[1] != pkPos) {
+			return false
+		}
+	}
+	return true
+}
+
+// coverByIndex makes the table scan read the index table, the columns of the index table take
+// the positions of the part and the primary key, so the column references are unchanged and the
+// filters on the part are evaluated on the key.
+func (builder *QueryBuilder) coverByIndex(node *plan.Node, c *indexCandidate) error {
+	stats, err := builder.indexScanStats(node, c)
+	if err != nil {
+		return err
+	}
+	partPos := findColumnPos(node.TableDef, c.indexDef.Parts[0])
+	pkPos := int32(-1)
+	if c.priPos >= 0 {
+		pkPos = findColumnPos(node.TableDef, getTablePriKeyName(node.TableDef.Pkey))
+	}
+
+	tableDef := DeepCopyTableDef(c.tableDef)
+	tableDef.Cols = make([]*ColDef, len(node.TableDef.Cols))
+	tableDef.Name2ColIndex = make(map[string]int32)
+	for i, col := range node.TableDef.Cols {
+		switch int32(i) {
+		case partPos:
+			col = DeepCopyColDef(c.tableDef.Cols[c.idxPos])
+		case pkPos:
+			col = DeepCopyColDef(c.tableDef.Cols[c.priPos])
+		}
+		tableDef.Cols[i] = col
+		tableDef.Name2ColIndex[col.Name] = int32(i)
+	}
+
+	node.IndexScanInfo = &plan.IndexScanInfo{
+		IndexName: c.indexDef.IndexName,
+		TableName: node.TableDef.Name,
+		Covering:  true,
+	}
+	node.ObjRef = c.objRef
+	node.TableDef = tableDef
+	node.Stats = stats
+	return nil
+}
+
+// joinIndex scans the index table by the key and joins the primary keys found to the table scan,
+// it returns the id of the join.
+func (builder *QueryBuilder) joinIndex(node *plan.Node, c *indexCandidate) (int32, error) {
+	ctx := builder.GetContext()
+	pkPos := findColumnPos(node.TableDef, getTablePriKeyName(node.TableDef.Pkey))
+	if pkPos < 0 {
+		return node.NodeId, nil
+	}
+	stats, err := builder.indexScanStats(node, c)
+	if err != nil {
+		return 0, err
+	}
+	tag := builder.genNewTag()
+	filters, err := builder.indexFilters(node, c, tag)
+	if err != nil {
+		return 0, err
+	}
+	for i, col := range c.tableDef.Cols {
+		builder.nameByColRef[[2]int32{tag, int32(i)}] = c.tableDef.Name + "." + col.Name
+	}
+	scanID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		ObjRef:      c.objRef,
+		TableDef:    c.tableDef,
+		FilterList:  filters,
+		BindingTags: []int32{tag},
+		IndexScanInfo: &plan.IndexScanInfo{
+			IndexName: c.indexDef.IndexName,
+			TableName: node.TableDef.Name,
+		},
+		SnapshotTs: node.SnapshotTs,
+	}, builder.ctxByNode[node.NodeId])
+	builder.qry.Nodes[scanID].Stats = stats
+
+	priCol := c.tableDef.Cols[c.priPos]
+	cond, err := bindFuncExprImplByPlanExpr(ctx, "=", []*Expr{
+		{
+			Typ: DeepCopyTyp(node.TableDef.Cols[pkPos].Typ),
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: node.BindingTags[0],
+					ColPos: pkPos,
+					Name:   builder.nameByColRef[[2]int32{node.BindingTags[0], pkPos}],
+				},
+			},
+		},
+		{
+			Typ: DeepCopyTyp(priCol.Typ),
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: tag,
+					ColPos: c.priPos,
+					Name:   c.tableDef.Name + "." + priCol.Name,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	joinID := builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		JoinType: plan.Node_INNER,
+		Children: []int32{node.NodeId, scanID},
+		OnList:   []*Expr{cond},
+	}, builder.ctxByNode[node.NodeId])
+	ReCalcNodeStats(joinID, builder, false)
+	return joinID, nil
+}
+
+// recordIndex adds the index used to the outline of the plan
+func (builder *QueryBuilder) recordIndex(node *plan.Node, c *indexCandidate) {
+	table := builder.leafTableName(node)
+	if table == "" || c.indexDef.IndexName == "" {
+		return
+	}
+	builder.outline = append(builder.outline, &tree.OptimizerHint{
+		Name: tree.HintUseIndex,
+		Args: []*tree.HintArg{{Name: table}, {Name: c.indexDef.IndexName}},
+	})
+}
+
+// indexKeyOfFilter returns the column compared by the filter and the comparison with the column
+// on the left, the filter must compare a column of the tag with constants.
+func indexKeyOfFilter(filter *Expr, tag int32) (int32, string, bool) {
+	f, ok := filter.Expr.(*plan.Expr_F)
+	if !ok || len(f.F.Args) != 2 {
+		return 0, "", false
+	}
+	fn := f.F.Func.ObjName
+	left, right := f.F.Args[0], f.F.Args[1]
+	switch fn {
+	case "in":
+		list, ok := right.Expr.(*plan.Expr_List)
+		if !ok {
+			return 0, "", false
+		}
+		for _, item := range list.List.List {
+			if !isIndexKeyConst(item) {
+				return 0, "", false
+			}
+		}
+	case "=", "<", "<=", ">", ">=":
+		if _, ok := left.Expr.(*plan.Expr_Col); !ok {
+			left, right = right, left
+			fn = flipComparison(fn)
+		}
+		if !isIndexKeyConst(right) {
+			return 0, "", false
+		}
+	default:
+		return 0, "", false
+	}
+	col, ok := left.Expr.(*plan.Expr_Col)
+	if !ok || col.Col.RelPos != tag {
+		return 0, "", false
+	}
+	return col.Col.ColPos, fn, true
+}
+
+// indexKeyValue returns the constant side of the filter matched by indexKeyOfFilter
+func indexKeyValue(filter *Expr) *Expr {
+	f := filter.GetF()
+	if _, ok := f.Args[0].Expr.(*plan.Expr_Col); ok {
+		return f.Args[1]
+	}
+	return f.Args[0]
+}
+
+func flipComparison(fn string) string {
+	switch fn {
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	}
+	return fn
+}
+
+// isIndexKeyConst returns true if the expr is a constant, a parameter or a variable, which may be
+// cast.
+func isIndexKeyConst(expr *Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C, *plan.Expr_P, *plan.Expr_V:
+		return true
+	case *plan.Expr_F:
+		if !strings.EqualFold(e.F.Func.ObjName, "cast") {
+			return false
+		}
+		if _, ok := e.F.Args[1].Expr.(*plan.Expr_T); !ok {
+			return false
+		}
+		return isIndexKeyConst(e.F.Args[0])
+	}
+	return false
+}
+
+func findColumnPos(tableDef *TableDef, name string) int32 {
+	if name == "" {
+		return -1
+	}
+	for i, col := range tableDef.Cols {
+		if col.Name == name {
+			return int32(i)
+		}
+	}
+	return -1
+}
+
+// collectColRefs adds the columns referenced by the tree to refs
+func (builder *QueryBuilder) collectColRefs(nodeID int32, refs map[[2]int32]bool) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.collectColRefs(childID, refs)
+	}
+	var visit func(expr *Expr)
+	visit = func(expr *Expr) {
+		if expr == nil {
+			return
+		}
+		switch e := expr.Expr.(type) {
+		case *plan.Expr_Col:
+			refs[[2]int32{e.Col.RelPos, e.Col.ColPos}] = true
+		case *plan.Expr_F:
+			for _, arg := range e.F.Args {
+				visit(arg)
+			}
+		case *plan.Expr_List:
+			for _, item := range e.List.List {
+				visit(item)
+			}
+		}
+	}
+	visitWindow := func(spec *plan.WindowSpec) {
+		if spec == nil {
+			return
+		}
+		visit(spec.WindowFunc)
+		for _, expr := range spec.PartitionBy {
+			visit(expr)
+		}
+		for _, orderBy := range spec.OrderBy {
+			visit(orderBy.Expr)
+		}
+	}
+	for _, list := range [][]*Expr{node.ProjectList, node.OnList, node.FilterList, node.GroupBy,
+		node.GroupingSet, node.AggList, node.TblFuncExprList} {
+		for _, expr := range list {
+			visit(expr)
+		}
+	}
+	for _, orderBy := range node.OrderBy {
+		visit(orderBy.Expr)
+	}
+	visitWindow(node.WinSpec)
+	for _, spec := range node.WinSpecList {
+		visitWindow(spec)
+	}
+	visit(node.Limit)
+	visit(node.Offset)
+}
@@ -0,0 +1,231 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+// indexScans returns the scans of index tables in the plan
+func indexScans(qry *plan.Query) []*plan.Node {
+	var scans []*plan.Node
+	for _, scan := range findNodes(qry, plan.Node_TABLE_SCAN) {
+		if scan.IndexScanInfo != nil {
+			scans = append(scans, scan)
+		}
+	}
+	return scans
+}
+
+func TestIndexLookup(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	cases := []struct {
+		sql     string
+		index   string
+		outcnt  float64
+		filters int
+	}{
+		{"select * from users where name = 'a'", "idx_name", 1, 1},
+		{"select * from users where 'a' = name and age > 10", "idx_name", 1, 1},
+		{"select * from users where name in ('a', 'b', 'c')", "idx_name", 3, 1},
+		{"select * from users where age = 3 and city = 'x'", "idx_city_age", 1, 1},
+	}
+	for _, c := range cases {
+		qry := buildQueryForTest(t, mock, c.sql)
+		scans := indexScans(qry)
+		require.Len(t, scans, 1, c.sql)
+		scan := scans[0]
+		require.Equal(t, c.index, scan.IndexScanInfo.IndexName, c.sql)
+		require.Equal(t, "users", scan.IndexScanInfo.TableName, c.sql)
+		require.False(t, scan.IndexScanInfo.Covering, c.sql)
+		require.Equal(t, c.outcnt, scan.Stats.Outcnt, c.sql)
+		require.Len(t, scan.FilterList, c.filters, c.sql)
+
+		// the rows found are joined back to the table, which is filtered by the keys found
+		joins := findNodes(qry, plan.Node_JOIN)
+		require.Len(t, joins, 1, c.sql)
+		require.Equal(t, plan.Node_INNER, joins[0].JoinType, c.sql)
+		require.Same(t, scan, qry.Nodes[joins[0].Children[1]], c.sql)
+		require.Len(t, joins[0].RuntimeFilterBuildList, 1, c.sql)
+		base := qry.Nodes[joins[0].Children[0]]
+		require.Equal(t, "users", base.TableDef.Name, c.sql)
+		require.Len(t, base.RuntimeFilterProbeList, 1, c.sql)
+	}
+
+	// the mock has no histograms, so a prefix of a composite index isn't known to be selective
+	qry := buildQueryForTest(t, mock, "select * from users where city = 'x' and age > 3")
+	require.Empty(t, indexScans(qry))
+}
+
+func TestIndexPrefix(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	cases := []struct {
+		sql     string
+		index   string
+		filters int
+	}{
+		// the equality on city and the range on age bound the keys
+		{"select * from users use index (idx_city_age) where city = 'x' and age > 3", "idx_city_age", 2},
+		{"select * from users use index (idx_city_age) where city = 'x' and age between 3 and 5", "idx_city_age", 2},
+		// id is never null, so the keys of city are all the rows of city
+		{"select * from users use index (idx_city_id) where city = 'x'", "idx_city_id", 2},
+		{"select * from users use index (idx_city_id) where city = 'x' and id <= 10", "idx_city_id", 2},
+	}
+	for _, c := range cases {
+		qry := buildQueryForTest(t, mock, c.sql)
+		scans := indexScans(qry)
+		require.Len(t, scans, 1, c.sql)
+		require.Equal(t, c.index, scans[0].IndexScanInfo.IndexName, c.sql)
+		require.False(t, scans[0].IndexScanInfo.Covering, c.sql)
+		require.Len(t, scans[0].FilterList, c.filters, c.sql)
+
+		// the keys found are more than the rows matched, the table scan keeps its filters
+		joins := findNodes(qry, plan.Node_JOIN)
+		require.Len(t, joins, 1, c.sql)
+		base := qry.Nodes[joins[0].Children[0]]
+		require.NotEmpty(t, base.FilterList, c.sql)
+	}
+
+	for _, sql := range []string{
+		// the rows with a null age aren't in idx_city_age
+		"select * from users use index (idx_city_age) where city = 'x'",
+		// age isn't a leading part
+		"select * from users use index (idx_city_age) where age = 3",
+	} {
+		qry := buildQueryForTest(t, mock, sql)
+		require.Empty(t, indexScans(qry), sql)
+	}
+}
+
+func TestIndexRange(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	// the mock has no histograms, so a range isn't known to be selective
+	qry := buildQueryForTest(t, mock, "select * from users where name between 'a' and 'b'")
+	require.Empty(t, indexScans(qry))
+
+	for _, sql := range []string{
+		"select * from users use index (idx_name) where name between 'a' and 'b'",
+		"select * from users force index (idx_name) where name > 'a'",
+		"select /*+ USE_INDEX(users idx_name) */ * from users where name < 'b'",
+	} {
+		qry = buildQueryForTest(t, mock, sql)
+		scans := indexScans(qry)
+		require.Len(t, scans, 1, sql)
+		require.Equal(t, "idx_name", scans[0].IndexScanInfo.IndexName, sql)
+	}
+}
+
+func TestIndexOnlyScan(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	qry := buildQueryForTest(t, mock, "select id, name from users where name = 'a'")
+	require.Empty(t, findNodes(qry, plan.Node_JOIN))
+	scans := indexScans(qry)
+	require.Len(t, scans, 1)
+	require.True(t, scans[0].IndexScanInfo.Covering)
+	require.Equal(t, "users", scans[0].IndexScanInfo.TableName)
+
+	// city isn't in idx_name
+	qry = buildQueryForTest(t, mock, "select id, name, city from users where name = 'a'")
+	scans = indexScans(qry)
+	require.Len(t, scans, 1)
+	require.False(t, scans[0].IndexScanInfo.Covering)
+}
+
+func TestSecondaryIndexLookup(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	// the mock has no histograms, so a key of a secondary index isn't known to be selective
+	qry := buildQueryForTest(t, mock, "select * from users where age = 3")
+	require.Empty(t, indexScans(qry))
+
+	cases := []struct {
+		sql     string
+		index   string
+		filters int
+	}{
+		{"select * from users use index (idx_age) where age = 3", "idx_age", 1},
+		{"select * from users use index (idx_age) where age in (3, 4)", "idx_age", 1},
+		{"select * from users use index (idx_age) where age > 3", "idx_age", 1},
+		{"select * from users use index (idx_bio_age) where bio = 'x' and age = 3", "idx_bio_age", 1},
+		{"select * from users use index (idx_bio_age) where bio = 'x' and age > 3", "idx_bio_age", 2},
+	}
+	for _, c := range cases {
+		qry := buildQueryForTest(t, mock, c.sql)
+		scans := indexScans(qry)
+		require.Len(t, scans, 1, c.sql)
+		require.Equal(t, c.index, scans[0].IndexScanInfo.IndexName, c.sql)
+		require.False(t, scans[0].IndexScanInfo.Covering, c.sql)
+		require.Len(t, scans[0].FilterList, c.filters, c.sql)
+		// a key is found for many rows
+		require.Equal(t, float64(100000), scans[0].Stats.Outcnt, c.sql)
+		joins := findNodes(qry, plan.Node_JOIN)
+		require.Len(t, joins, 1, c.sql)
+		require.Same(t, scans[0], qry.Nodes[joins[0].Children[1]], c.sql)
+	}
+
+	// the index covers the query
+	qry = buildQueryForTest(t, mock, "select id, age from users use index (idx_age) where age = 3")
+	require.Empty(t, findNodes(qry, plan.Node_JOIN))
+	scans := indexScans(qry)
+	require.Len(t, scans, 1)
+	require.True(t, scans[0].IndexScanInfo.Covering)
+
+	// a unique index is preferred for the same key
+	qry = buildQueryForTest(t, mock, "select * from users where city = 'x' and age = 3")
+	scans = indexScans(qry)
+	require.Len(t, scans, 1)
+	require.Equal(t, "idx_city_age", scans[0].IndexScanInfo.IndexName)
+}
+
+func TestIgnoreIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	qry := buildQueryForTest(t, mock, "select * from users ignore index (idx_name) where name = 'a'")
+	require.Empty(t, indexScans(qry))
+
+	qry = buildQueryForTest(t, mock, "select /*+ IGNORE_INDEX(users idx_name) */ * from users where name = 'a'")
+	require.Empty(t, indexScans(qry))
+
+	// dml keeps scanning the table
+	qry = buildQueryForTest(t, mock, "delete from users where name = 'a'")
+	require.Empty(t, indexScans(qry))
+}
+
+func TestIndexOutline(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "select * from users where name = 'a'"
+	stmt, err := mysql.ParseOne(mock.CurrentContext().GetContext(), sql, 1)
+	require.NoError(t, err)
+
+	outline, err := BuildPlanOutline(mock.CurrentContext(), stmt.(*tree.Select))
+	require.NoError(t, err)
+	var found bool
+	for _, hint := range outline {
+		if tree.String(hint, dialect.MYSQL) == "USE_INDEX(users, idx_name)" {
+			found = true
+		}
+	}
+	require.True(t, found, tree.String(outline, dialect.MYSQL))
+}
@@ -0,0 +1,453 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	// dpJoinRelationLimit is the max number of relations whose join order is enumerated
+	// by dynamic programming, the join order of more relations is decided greedily.
+	dpJoinRelationLimit = 10
+	// maxJoinRelations is the max number of relations the enumeration can handle,
+	// the sets of relations are bitmaps in uint64.
+	maxJoinRelations = 64
+
+	// nonEquiJoinSelectivity is the selectivity of the join conditions which are not
+	// the equality of two columns
+	nonEquiJoinSelectivity = 0.3
+	// hashBuildCostFactor is the cost of building the hashmap for one byte
+	// compared with probing the hashmap
+	hashBuildCostFactor = 2
+	// defaultRowWidth is the width of the rows whose columns are unknown
+	defaultRowWidth = 64
+)
+
+// joinParallelism is the number of the pipelines probing the hashmap. The hashmap is
+// either broadcast to all of them or both sides are shuffled by the join keys.
+var joinParallelism = 4.0
+
+// joinExchange is how a relation is sent to the pipelines of the join, by the hints BROADCAST
+// and SHUFFLE.
+type joinExchange int
+
+const (
+	exchangeAny joinExchange = iota
+	exchangeBroadcast
+	exchangeShuffle
+)
+
+// joinRelation is a leaf of the join enumeration, a table scan or a subtree whose join order
+// is decided separately, like the outer joins.
+type joinRelation struct {
+	nodeID   int32
+	rows     float64
+	width    float64
+	exchange joinExchange
+}
+
+// joinPredicate is a join condition, rels is the set of the relations it references.
+type joinPredicate struct {
+	rels uint64
+	sel  float64
+}
+
+// joinPlan is a join tree of a set of relations. The right child builds the hashmap and
+// the left child probes it. The leaves have no children.
+type joinPlan struct {
+	rels        uint64
+	left, right *joinPlan
+	relID       int
+
+	rows  float64
+	width float64
+	cost  float64
+}
+
+type joinEnumerator struct {
+	rels  []*joinRelation
+	preds []*joinPredicate
+	// forced is the join tree of some relations required by the hint JOIN_ORDER
+	forced *joinPlan
+}
+
+func (e *joinEnumerator) leafPlan(i int) *joinPlan {
+	return &joinPlan{
+		rels:  1 << i,
+		relID: i,
+		rows:  e.rels[i].rows,
+		width: e.rels[i].width,
+	}
+}
+
+// cardinality estimates the output rows of joining the set of relations, it doesn't
+// depend on the join order.
+func (e *joinEnumerator) cardinality(s uint64) float64 {
+	rows := 1.0
+	for i := range e.rels {
+		if s&(1<<i) != 0 {
+			rows *= e.rels[i].rows
+		}
+	}
+	for _, pred := range e.preds {
+		if pred.rels&s == pred.rels {
+			rows *= pred.sel
+		}
+	}
+	return math.Max(rows, 1)
+}
+
+// connected returns true if there is a join condition between the two sets of relations.
+func (e *joinEnumerator) connected(l, r uint64) bool {
+	for _, pred := range e.preds {
+		if pred.rels&l != 0 && pred.rels&r != 0 && pred.rels&^(l|r) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// exchangeOf returns the exchange hinted for the plan, only the leaves can be hinted
+func (e *joinEnumerator) exchangeOf(p *joinPlan) joinExchange {
+	if p.left != nil {
+		return exchangeAny
+	}
+	return e.rels[p.relID].exchange
+}
+
+// makeJoin costs the hash join of the two plans. Besides reading both sides and building the
+// hashmap, the cheaper one of broadcasting the hashmap and shuffling both sides is counted,
+// unless one of them is hinted. Only the build side can be broadcast.
+func (e *joinEnumerator) makeJoin(left, right *joinPlan, rows float64) *joinPlan {
+	probe := left.rows * left.width
+	build := right.rows * right.width
+	var exchange float64
+	switch {
+	case e.exchangeOf(left) == exchangeBroadcast:
+		exchange = math.Inf(1)
+	case e.exchangeOf(right) == exchangeBroadcast:
+		exchange = build * (joinParallelism - 1)
+	case e.exchangeOf(left) == exchangeShuffle || e.exchangeOf(right) == exchangeShuffle:
+		exchange = probe + build
+	default:
+		exchange = math.Min(build*(joinParallelism-1), probe+build)
+	}
+	width := left.width + right.width
+	return &joinPlan{
+		rels:  left.rels | right.rels,
+		left:  left,
+		right: right,
+		rows:  rows,
+		width: width,
+		cost:  left.cost + right.cost + probe + build*hashBuildCostFactor + exchange + rows*width,
+	}
+}
+
+// enumerateDP finds the cheapest bushy join tree by dynamic programming over all the subsets
+// of the relations. The cross products are avoided unless the relations can't be joined without
+// them, like the disconnected join graphs.
+func (e *joinEnumerator) enumerateDP() *joinPlan {
+	if p := e.enumerateSubsets(false); p != nil {
+		return p
+	}
+	return e.enumerateSubsets(true)
+}
+
+// enumerateSubsets finds the cheapest plans of the subsets in the order of the size. If allowCross
+// is true, the cross products are considered for the subsets which can't be split into two
+// connected parts.
+func (e *joinEnumerator) enumerateSubsets(allowCross bool) *joinPlan {
+	full := uint64(1)<<len(e.rels) - 1
+	best := make([]*joinPlan, full+1)
+	for i := range e.rels {
+		best[1<<i] = e.leafPlan(i)
+	}
+	if e.forced != nil {
+		best[e.forced.rels] = e.forced
+	}
+
+	// the subsets of a set are always smaller than it
+	for s := uint64(1); s <= full; s++ {
+		if bits.OnesCount64(s) < 2 || e.breaksForced(s) {
+			continue
+		}
+		rows := e.cardinality(s)
+		for _, cross := range []bool{false, true} {
+			if cross && !allowCross {
+				break
+			}
+			for l := (s - 1) & s; l > 0; l = (l - 1) & s {
+				r := s ^ l
+				if best[l] == nil || best[r] == nil {
+					continue
+				}
+				if !cross && !e.connected(l, r) {
+					continue
+				}
+				p := e.makeJoin(best[l], best[r], rows)
+				if best[s] == nil || p.cost < best[s].cost {
+					best[s] = p
+				}
+			}
+			if best[s] != nil {
+				break
+			}
+		}
+	}
+
+	return best[full]
+}
+
+// breaksForced returns true if the subset contains a part of the forced relations, or all of
+// them but it is exactly the forced set whose plan is given.
+func (e *joinEnumerator) breaksForced(s uint64) bool {
+	if e.forced == nil || s&e.forced.rels == 0 {
+		return false
+	}
+	return s&e.forced.rels != e.forced.rels || s == e.forced.rels
+}
+
+// enumerateGreedy joins the pair of plans with the lowest cost until there is only one plan
+// left. It prefers the connected pairs to the cross products.
+func (e *joinEnumerator) enumerateGreedy() *joinPlan {
+	plans := make([]*joinPlan, 0, len(e.rels))
+	if e.forced != nil {
+		plans = append(plans, e.forced)
+	}
+	for i := range e.rels {
+		if e.forced == nil || e.forced.rels&(1<<i) == 0 {
+			plans = append(plans, e.leafPlan(i))
+		}
+	}
+
+	for len(plans) > 1 {
+		var best *joinPlan
+		var bestCost float64
+		var bi, bj int
+		for _, allowCross := range []bool{false, true} {
+			for i := range plans {
+				for j := range plans {
+					if i == j {
+						continue
+					}
+					if !allowCross && !e.connected(plans[i].rels, plans[j].rels) {
+						continue
+					}
+					p := e.makeJoin(plans[i], plans[j], e.cardinality(plans[i].rels|plans[j].rels))
+					cost := p.cost - plans[i].cost - plans[j].cost
+					if best == nil || cost < bestCost {
+						best, bestCost, bi, bj = p, cost, i, j
+					}
+				}
+			}
+			if best != nil {
+				break
+			}
+		}
+
+		if bi > bj {
+			bi, bj = bj, bi
+		}
+		plans[bi] = best
+		plans = append(plans[:bj], plans[bj+1:]...)
+	}
+
+	return plans[0]
+}
+
+func (e *joinEnumerator) enumerate() *joinPlan {
+	if len(e.rels) <= dpJoinRelationLimit {
+		return e.enumerateDP()
+	}
+	return e.enumerateGreedy()
+}
+
+// enumerateJoinOrder decides the join order of the leaves by the cost and builds the join tree,
+// the join conditions are not pushed down into the tree yet.
+func (builder *QueryBuilder) enumerateJoinOrder(leaves []*plan.Node, conds []*plan.Expr) int32 {
+	if len(leaves) > maxJoinRelations {
+		nodeID := leaves[0].NodeId
+		for _, leaf := range leaves[1:] {
+			nodeID = builder.appendNode(&plan.Node{
+				NodeType: plan.Node_JOIN,
+				Children: []int32{nodeID, leaf.NodeId},
+				JoinType: plan.Node_INNER,
+			}, nil)
+		}
+		return nodeID
+	}
+
+	e := &joinEnumerator{
+		rels: make([]*joinRelation, len(leaves)),
+	}
+	names := make([]string, len(leaves))
+	relByTag := make(map[int32]int)
+	scanByTag := make(map[int32]*plan.Node)
+	for i, leaf := range leaves {
+		stats := leaf.Stats
+		if stats == nil {
+			stats = DefaultStats()
+		}
+		names[i] = builder.leafTableName(leaf)
+		e.rels[i] = &joinRelation{
+			nodeID:   leaf.NodeId,
+			rows:     math.Max(stats.Outcnt, 1),
+			width:    builder.estimateRowWidth(leaf.NodeId),
+			exchange: builder.exchangeHint(names[i]),
+		}
+		for _, tag := range builder.enumerateTags(leaf.NodeId) {
+			relByTag[tag] = i
+		}
+		builder.gatherScansByTag(leaf.NodeId, scanByTag)
+	}
+
+	for _, cond := range conds {
+		var rels uint64
+		getJoinRelationsFromExpr(cond, relByTag, &rels)
+		if bits.OnesCount64(rels) < 2 {
+			continue
+		}
+		pred := &joinPredicate{
+			rels: rels,
+			sel:  nonEquiJoinSelectivity,
+		}
+		if f, ok := cond.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "=" {
+			leftCol, lok := f.F.Args[0].Expr.(*plan.Expr_Col)
+			rightCol, rok := f.F.Args[1].Expr.(*plan.Expr_Col)
+			if lok && rok {
+				leftRel := e.rels[relByTag[leftCol.Col.RelPos]]
+				rightRel := e.rels[relByTag[rightCol.Col.RelPos]]
+				leftNdv := builder.getJoinColumnNdv(scanByTag[leftCol.Col.RelPos], leftCol.Col, leftRel.rows)
+				rightNdv := builder.getJoinColumnNdv(scanByTag[rightCol.Col.RelPos], rightCol.Col, rightRel.rows)
+				pred.sel = 1 / math.Max(leftNdv, rightNdv)
+			}
+		}
+		e.preds = append(e.preds, pred)
+	}
+
+	e.forced = builder.joinOrderHint(e, names)
+	p := e.enumerate()
+	builder.recordJoinOrder(p, names)
+	return builder.buildJoinTree(p, e.rels)
+}
+
+func (builder *QueryBuilder) buildJoinTree(p *joinPlan, rels []*joinRelation) int32 {
+	if p.left == nil {
+		return rels[p.relID].nodeID
+	}
+	left := builder.buildJoinTree(p.left, rels)
+	right := builder.buildJoinTree(p.right, rels)
+	return builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{left, right},
+		JoinType: plan.Node_INNER,
+	}, nil)
+}
+
+// getJoinColumnNdv returns the ndv of the join column, the number of the rows if it is unknown.
+// It is no more than the number of the rows of the relation after filtering.
+func (builder *QueryBuilder) getJoinColumnNdv(scan *plan.Node, col *plan.ColRef, rows float64) float64 {
+	if scan == nil || scan.TableDef == nil {
+		return rows
+	}
+	binding := builder.ctxByNode[scan.NodeId].bindingByTag[col.RelPos]
+	if binding == nil || int(col.ColPos) >= len(binding.cols) {
+		return rows
+	}
+	colName := binding.cols[col.ColPos]
+
+	var ndv float64
+	s := builder.compCtx.GetStatsCache().GetStatsInfoMap(scan.TableDef.TblId)
+	if cs := s.ColumnStats[colName]; cs != nil {
+		ndv = cs.Ndv
+	} else if s.NdvMap[colName] > 0 {
+		ndv = s.NdvMap[colName]
+	} else if scan.ObjRef != nil && scan.Stats != nil {
+		pkDef := builder.compCtx.GetPrimaryKeyDef(scan.ObjRef.SchemaName, scan.ObjRef.ObjName)
+		if len(pkDef) == 1 && pkDef[0].Name == colName {
+			ndv = scan.Stats.TableCnt
+		}
+	}
+	if ndv <= 0 || ndv > rows {
+		ndv = rows
+	}
+	return ndv
+}
+
+func (builder *QueryBuilder) gatherScansByTag(nodeID int32, scanByTag map[int32]*plan.Node) {
+	node := builder.qry.Nodes[nodeID]
+	if node.NodeType == plan.Node_TABLE_SCAN && len(node.BindingTags) > 0 {
+		scanByTag[node.BindingTags[0]] = node
+		return
+	}
+	for _, childID := range node.Children {
+		builder.gatherScansByTag(childID, scanByTag)
+	}
+}
+
+// estimateRowWidth estimates the average size of the output rows of the node
+func (builder *QueryBuilder) estimateRowWidth(nodeID int32) float64 {
+	node := builder.qry.Nodes[nodeID]
+	var width float64
+	switch {
+	case node.NodeType == plan.Node_TABLE_SCAN && node.TableDef != nil:
+		for _, col := range node.TableDef.Cols {
+			width += typeWidth(col.Typ)
+		}
+	case len(node.ProjectList) > 0:
+		for _, expr := range node.ProjectList {
+			width += typeWidth(expr.Typ)
+		}
+	case node.NodeType == plan.Node_JOIN:
+		for _, childID := range node.Children {
+			width += builder.estimateRowWidth(childID)
+		}
+	case len(node.Children) > 0:
+		width = builder.estimateRowWidth(node.Children[0])
+	}
+	if width <= 0 {
+		width = defaultRowWidth
+	}
+	return width
+}
+
+func typeWidth(typ *plan.Type) float64 {
+	if typ == nil {
+		return 8
+	}
+	if size := types.T(typ.Id).ToType().TypeSize(); size > 0 {
+		return float64(size)
+	}
+	return 8
+}
+
+func getJoinRelationsFromExpr(expr *plan.Expr, relByTag map[int32]int, rels *uint64) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if i, ok := relByTag[exprImpl.Col.RelPos]; ok {
+			*rels |= 1 << i
+		}
+
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			getJoinRelationsFromExpr(arg, relByTag, rels)
+		}
+	}
+}
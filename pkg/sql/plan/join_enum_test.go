@@ -0,0 +1,196 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newJoinEnumeratorForTest(rows []float64, preds map[[2]int]float64) *joinEnumerator {
+	e := &joinEnumerator{}
+	for i, r := range rows {
+		e.rels = append(e.rels, &joinRelation{nodeID: int32(i), rows: r, width: 8})
+	}
+	for pair, sel := range preds {
+		e.preds = append(e.preds, &joinPredicate{rels: 1<<pair[0] | 1<<pair[1], sel: sel})
+	}
+	return e
+}
+
+// leftDeepCost is the lowest cost of the left deep trees, the relations are probed in the order of perm
+func leftDeepCost(e *joinEnumerator, perm []int, used uint64, p *joinPlan) float64 {
+	if len(perm) == bits.OnesCount64(used) {
+		return p.cost
+	}
+	best := -1.0
+	for _, i := range perm {
+		if used&(1<<i) != 0 {
+			continue
+		}
+		var next *joinPlan
+		if p == nil {
+			next = e.leafPlan(i)
+		} else {
+			leaf := e.leafPlan(i)
+			rows := e.cardinality(p.rels | leaf.rels)
+			next = e.makeJoin(p, leaf, rows)
+			if other := e.makeJoin(leaf, p, rows); other.cost < next.cost {
+				next = other
+			}
+		}
+		if cost := leftDeepCost(e, perm, used|1<<i, next); best < 0 || cost < best {
+			best = cost
+		}
+	}
+	return best
+}
+
+func findSubPlan(p *joinPlan, rels uint64) *joinPlan {
+	if p.rels == rels {
+		return p
+	}
+	if p.left == nil {
+		return nil
+	}
+	if sub := findSubPlan(p.left, rels); sub != nil {
+		return sub
+	}
+	return findSubPlan(p.right, rels)
+}
+
+func isBushy(p *joinPlan) bool {
+	if p.left == nil {
+		return false
+	}
+	if p.left.left != nil && p.right.left != nil {
+		return true
+	}
+	return isBushy(p.left) || isBushy(p.right)
+}
+
+func TestJoinEnumerateStar(t *testing.T) {
+	// the fact table joins 3 dimension tables, the first one is filtered heavily
+	e := newJoinEnumeratorForTest([]float64{1e6, 10, 1e5, 1000}, map[[2]int]float64{
+		{0, 1}: 1e-3,
+		{0, 2}: 1e-5,
+		{0, 3}: 1e-3,
+	})
+	p := e.enumerateDP()
+	require.Equal(t, uint64(0xf), p.rels)
+
+	// the fact table is joined with the most selective dimension table first and probes it
+	sub := findSubPlan(p, 0x3)
+	require.NotNil(t, sub)
+	require.Equal(t, 0, sub.left.relID)
+	require.Equal(t, 1, sub.right.relID)
+}
+
+func TestJoinEnumerateBushy(t *testing.T) {
+	// a-b and c-d are very selective while b-c is not
+	e := newJoinEnumeratorForTest([]float64{1e6, 1e6, 1e6, 1e6}, map[[2]int]float64{
+		{0, 1}: 1e-9,
+		{1, 2}: 1e-3,
+		{2, 3}: 1e-9,
+	})
+	p := e.enumerateDP()
+	require.True(t, isBushy(p))
+	require.Less(t, p.cost, leftDeepCost(e, []int{0, 1, 2, 3}, 0, nil))
+	require.NotNil(t, findSubPlan(p, 0x3))
+	require.NotNil(t, findSubPlan(p, 0xc))
+}
+
+func TestJoinEnumerateCrossProduct(t *testing.T) {
+	// there is no condition between {0, 1} and {2}
+	e := newJoinEnumeratorForTest([]float64{100, 1000, 10}, map[[2]int]float64{
+		{0, 1}: 1e-2,
+	})
+	p := e.enumerateDP()
+	require.Equal(t, uint64(0x7), p.rels)
+	require.Equal(t, float64(100*1000*1e-2*10), p.rows)
+	require.Nil(t, e.enumerateSubsets(false))
+
+	// the relations are joined by the condition on all of them
+	e = newJoinEnumeratorForTest([]float64{100, 1000, 10}, nil)
+	e.preds = append(e.preds, &joinPredicate{rels: 0x7, sel: 1e-3})
+	p = e.enumerateDP()
+	require.Equal(t, uint64(0x7), p.rels)
+	require.Equal(t, float64(1000), p.rows)
+}
+
+func newChainJoinEnumeratorForTest(n int) *joinEnumerator {
+	rows := make([]float64, n)
+	preds := make(map[[2]int]float64)
+	for i := range rows {
+		rows[i] = float64(1000 * (i + 1))
+		if i > 0 {
+			preds[[2]int{i - 1, i}] = 1 / rows[i]
+		}
+	}
+	return newJoinEnumeratorForTest(rows, preds)
+}
+
+func TestJoinEnumerateGreedy(t *testing.T) {
+	e := newChainJoinEnumeratorForTest(dpJoinRelationLimit + 2)
+	p := e.enumerate()
+	require.Equal(t, uint64(1)<<(dpJoinRelationLimit+2)-1, p.rels)
+
+	// every join of the chain has a condition
+	var check func(p *joinPlan)
+	check = func(p *joinPlan) {
+		if p.left == nil {
+			return
+		}
+		require.True(t, e.connected(p.left.rels, p.right.rels))
+		check(p.left)
+		check(p.right)
+	}
+	check(p)
+
+	// the dynamic programming is never worse than the greedy enumeration
+	e = newChainJoinEnumeratorForTest(dpJoinRelationLimit)
+	require.LessOrEqual(t, e.enumerateDP().cost, e.enumerateGreedy().cost)
+}
+
+func TestJoinEnumerateForced(t *testing.T) {
+	e := newJoinEnumeratorForTest([]float64{1e6, 10, 1e5, 1000}, map[[2]int]float64{
+		{0, 1}: 1e-3,
+		{0, 2}: 1e-5,
+		{0, 3}: 1e-3,
+	})
+	free := e.enumerateDP()
+
+	// 3 probes 2 first, though it is a cross product
+	e.forced = e.makeJoin(e.leafPlan(3), e.leafPlan(2), e.cardinality(0xc))
+	for _, p := range []*joinPlan{e.enumerateDP(), e.enumerateGreedy()} {
+		require.Equal(t, uint64(0xf), p.rels)
+		require.Same(t, e.forced, findSubPlan(p, 0xc))
+		require.Nil(t, findSubPlan(p, 0x5))
+		require.GreaterOrEqual(t, p.cost, free.cost)
+	}
+}
+
+func TestJoinEnumerateExchangeHint(t *testing.T) {
+	e := newJoinEnumeratorForTest([]float64{1000, 1e6}, map[[2]int]float64{{0, 1}: 1e-6})
+	p := e.enumerateDP()
+	require.Equal(t, 0, p.right.relID)
+
+	// the big relation is broadcast
+	e.rels[1].exchange = exchangeBroadcast
+	p = e.enumerateDP()
+	require.Equal(t, 1, p.right.relID)
+}
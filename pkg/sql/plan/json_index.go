@@ -0,0 +1,446 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// jsonIndexName is the name of a functional index created without a name
+const jsonIndexName = "functional_index"
+
+// isFunctionalIndex returns true if any key part of the index is an expression
+func isFunctionalIndex(keyParts []*tree.KeyPart) bool {
+	for _, keyPart := range keyParts {
+		if keyPart.Expr != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func stripParenExpr(expr tree.Expr) tree.Expr {
+	for {
+		parenExpr, ok := expr.(*tree.ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = parenExpr.Expr
+	}
+}
+
+func funcExprName(expr tree.Expr) (*tree.FuncExpr, string) {
+	funcExpr, ok := expr.(*tree.FuncExpr)
+	if !ok {
+		return nil, ""
+	}
+	name, ok := funcExpr.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return nil, ""
+	}
+	return funcExpr, strings.ToLower(name.Parts[0])
+}
+
+// getJsonPathExpr returns the column and the normalized path of json_extract(col, 'path')
+// or json_unquote(json_extract(col, 'path')), unquote is set for the latter, which is doc->>'path'.
+func getJsonPathExpr(expr tree.Expr) (col *tree.UnresolvedName, path string, unquote bool, ok bool) {
+	funcExpr, name := funcExprName(stripParenExpr(expr))
+	if name == "json_unquote" && len(funcExpr.Exprs) == 1 {
+		funcExpr, name = funcExprName(stripParenExpr(funcExpr.Exprs[0]))
+		unquote = true
+	}
+	if name != "json_extract" || len(funcExpr.Exprs) != 2 {
+		return nil, "", false, false
+	}
+	col, ok = funcExpr.Exprs[0].(*tree.UnresolvedName)
+	if !ok || col.Star {
+		return nil, "", false, false
+	}
+	pathVal, ok := funcExpr.Exprs[1].(*tree.NumVal)
+	if !ok || pathVal.ValType != tree.P_char {
+		return nil, "", false, false
+	}
+	jsonPath, err := types.ParseStringToPath(pathVal.String())
+	if err != nil || jsonPath.IsWildcard() {
+		return nil, "", false, false
+	}
+	return col, jsonPath.String(), unquote, true
+}
+
+// isJsonIndexKeyType returns true if the type can be the key type of a functional index on a json path
+func isJsonIndexKeyType(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_char, types.T_varchar, types.T_date, types.T_datetime, types.T_time:
+		return true
+	}
+	return false
+}
+
+// buildJsonIndexTable builds the index tables of the functional indexes, the key part must be
+// CAST(doc->'path' AS type) or CAST(doc->'path' AS type ARRAY) on a json column. An index table
+// stores a row (value, primary key) for the value at the path of a row, or for every element of
+// the json array at the path of a row if the index is multi-valued.
+func buildJsonIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Index, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
+	if pkeyName == "" {
+		return moerr.NewNotSupported(ctx.GetContext(), "functional index on the table without primary key")
+	}
+	namesMap := make(map[string]bool)
+	for _, indexDef := range createTable.TableDef.Indexes {
+		namesMap[indexDef.IndexName] = true
+	}
+
+	for _, indexInfo := range indexInfos {
+		if len(indexInfo.KeyParts) != 1 || indexInfo.KeyParts[0].Expr == nil {
+			return moerr.NewNotSupported(ctx.GetContext(), "functional index with more than one key part")
+		}
+		expr := stripParenExpr(indexInfo.KeyParts[0].Expr)
+		castExpr, ok := expr.(*tree.CastExpr)
+		if !ok {
+			return moerr.NewNotSupported(ctx.GetContext(), "functional index on '%s', only CAST of a json path is supported", tree.String(expr, dialect.MYSQL))
+		}
+		colName, path, unquote, ok := getJsonPathExpr(castExpr.Expr)
+		if !ok {
+			return moerr.NewNotSupported(ctx.GetContext(), "functional index on '%s', only CAST of a json path is supported", tree.String(expr, dialect.MYSQL))
+		}
+		if unquote && castExpr.Array {
+			return moerr.NewNotSupported(ctx.GetContext(), "multi-valued index on the unquoted json path '%s'", path)
+		}
+		name := colName.Parts[0]
+		col, ok := colMap[name]
+		if !ok {
+			return moerr.NewInvalidInput(ctx.GetContext(), "column '%s' is not exist", name)
+		}
+		if col.Typ.Id != int32(types.T_json) {
+			return moerr.NewNotSupported(ctx.GetContext(), "functional index on the non-json column '%s'", name)
+		}
+		if col.Generated != nil && !col.Generated.Stored {
+			return moerr.NewNotSupported(ctx.GetContext(), "index or primary key on virtual generated column '%s'", name)
+		}
+		keyType, err := getTypeFromAst(ctx.GetContext(), castExpr.Type)
+		if err != nil {
+			return err
+		}
+		if !isJsonIndexKeyType(types.T(keyType.Id)) {
+			return moerr.NewNotSupported(ctx.GetContext(), "functional index of type %s", types.T(keyType.Id).String())
+		}
+
+		indexName := indexInfo.Name
+		if indexName == "" {
+			indexName = jsonIndexName
+			for i := 2; namesMap[indexName]; i++ {
+				indexName = fmt.Sprintf("%s_%d", jsonIndexName, i)
+			}
+		} else if namesMap[indexName] {
+			return moerr.NewDuplicateKey(ctx.GetContext(), indexName)
+		}
+		namesMap[indexName] = true
+
+		indexTableName, err := util.BuildIndexTableName(ctx.GetContext(), false)
+		if err != nil {
+			return err
+		}
+		tableDef := &TableDef{
+			Name: indexTableName,
+			Cols: []*ColDef{
+				{
+					Name: catalog.IndexTableIndexColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ:  keyType,
+					Default: &plan.Default{
+						NullAbility: false,
+					},
+				},
+				{
+					Name: catalog.IndexTablePrimaryColName,
+					Alg:  plan.CompressType_Lz4,
+					Typ:  colMap[pkeyName].Typ,
+					Default: &plan.Default{
+						NullAbility: false,
+					},
+				},
+			},
+		}
+
+		fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithSingleQuoteString())
+		castExpr.Format(fmtCtx)
+		indexDef := &plan.IndexDef{
+			IndexName:      indexName,
+			IndexTableName: indexTableName,
+			Parts:          []string{name},
+			TableExist:     true,
+			JsonPath:       path,
+			KeyType:        keyType,
+			MultiValued:    castExpr.Array,
+			OriginString:   fmtCtx.String(),
+			JsonUnquote:    unquote,
+		}
+		if indexInfo.IndexOption != nil {
+			indexDef.Comment = indexInfo.IndexOption.Comment
+		}
+		createTable.IndexTables = append(createTable.IndexTables, tableDef)
+		createTable.TableDef.Indexes = append(createTable.TableDef.Indexes, indexDef)
+	}
+	return nil
+}
+
+// jsonIndexCond is a conjunction of the WHERE clause which can be answered by a functional index:
+// CAST(doc->'path' AS type) = const, doc->'path' = const or const MEMBER OF (doc->'path').
+type jsonIndexCond struct {
+	col         *tree.UnresolvedName
+	path        string
+	unquote     bool
+	castType    *plan.Type
+	value       *tree.NumVal
+	multiValued bool
+}
+
+func getJsonIndexCond(builder *QueryBuilder, cond tree.Expr) (*jsonIndexCond, bool) {
+	cmpExpr, ok := stripParenExpr(cond).(*tree.ComparisonExpr)
+	if !ok {
+		return nil, false
+	}
+	switch cmpExpr.Op {
+	case tree.MEMBER_OF:
+		value, ok := cmpExpr.Left.(*tree.NumVal)
+		if !ok {
+			return nil, false
+		}
+		col, path, unquote, ok := getJsonPathExpr(cmpExpr.Right)
+		if !ok {
+			return nil, false
+		}
+		return &jsonIndexCond{col: col, path: path, unquote: unquote, value: value, multiValued: true}, true
+	case tree.EQUAL:
+		expr, value := cmpExpr.Left, cmpExpr.Right
+		if _, ok := stripParenExpr(expr).(*tree.NumVal); ok {
+			expr, value = value, expr
+		}
+		numVal, ok := stripParenExpr(value).(*tree.NumVal)
+		if !ok {
+			return nil, false
+		}
+		var castType *plan.Type
+		expr = stripParenExpr(expr)
+		if castExpr, ok := expr.(*tree.CastExpr); ok {
+			if castExpr.Array {
+				return nil, false
+			}
+			typ, err := getTypeFromAst(builder.GetContext(), castExpr.Type)
+			if err != nil {
+				return nil, false
+			}
+			expr, castType = castExpr.Expr, typ
+		}
+		col, path, unquote, ok := getJsonPathExpr(expr)
+		if !ok {
+			return nil, false
+		}
+		return &jsonIndexCond{col: col, path: path, unquote: unquote, castType: castType, value: numVal}, true
+	}
+	return nil, false
+}
+
+// jsonIndexValueMatched returns true if the constant can be compared with the key of the index
+// without an error, so that looking up the index never fails where the condition itself does not.
+func jsonIndexValueMatched(value *tree.NumVal, keyType *plan.Type) bool {
+	switch types.T(keyType.Id) {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		v, ok := constant.Int64Val(constant.ToInt(value.Value))
+		if !ok || (value.ValType != tree.P_int64 && value.ValType != tree.P_uint64) {
+			return false
+		}
+		switch types.T(keyType.Id) {
+		case types.T_int8:
+			return v >= math.MinInt8 && v <= math.MaxInt8
+		case types.T_int16:
+			return v >= math.MinInt16 && v <= math.MaxInt16
+		case types.T_int32:
+			return v >= math.MinInt32 && v <= math.MaxInt32
+		}
+		return true
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		v, ok := constant.Uint64Val(constant.ToInt(value.Value))
+		if !ok || (value.ValType != tree.P_int64 && value.ValType != tree.P_uint64) {
+			return false
+		}
+		switch types.T(keyType.Id) {
+		case types.T_uint8:
+			return v <= math.MaxUint8
+		case types.T_uint16:
+			return v <= math.MaxUint16
+		case types.T_uint32:
+			return v <= math.MaxUint32
+		}
+		return true
+	case types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128:
+		switch value.ValType {
+		case tree.P_int64, tree.P_uint64, tree.P_decimal, tree.P_float64:
+			return true
+		}
+	case types.T_char, types.T_varchar:
+		return value.ValType == tree.P_char
+	case types.T_date:
+		if value.ValType == tree.P_char {
+			_, err := types.ParseDateCast(value.String())
+			return err == nil
+		}
+	case types.T_datetime:
+		if value.ValType == tree.P_char {
+			_, err := types.ParseDatetime(value.String(), keyType.Scale)
+			return err == nil
+		}
+	case types.T_time:
+		if value.ValType == tree.P_char {
+			_, err := types.ParseTime(value.String(), keyType.Scale)
+			return err == nil
+		}
+	}
+	return false
+}
+
+// jsonIndexKeyTypeMatched returns true if the cast of the condition is the cast of the index key,
+// CAST(... AS DECIMAL(10, 2)) and CAST(... AS DECIMAL(10, 4)) round the same value differently,
+// and CAST(... AS CHAR(10)) truncates the strings which CAST(... AS CHAR(32)) keeps.
+func jsonIndexKeyTypeMatched(castType, keyType *plan.Type) bool {
+	if castType.Id != keyType.Id {
+		return false
+	}
+	switch types.T(keyType.Id) {
+	case types.T_decimal64, types.T_decimal128:
+		return castType.Width == keyType.Width && castType.Scale == keyType.Scale
+	case types.T_char, types.T_varchar:
+		return castType.Width == keyType.Width
+	case types.T_datetime, types.T_time:
+		return castType.Scale == keyType.Scale
+	}
+	return true
+}
+
+// findJsonIndex returns the table and the functional index of the json path of the condition
+func findJsonIndex(builder *QueryBuilder, ctx *BindContext, cond *jsonIndexCond) (*Binding, *plan.Node, *IndexDef) {
+	colName, table := cond.col.Parts[0], cond.col.Parts[1]
+	var binding *Binding
+	if len(table) == 0 {
+		binding = ctx.bindingByCol[colName]
+	} else if b, ok := ctx.bindingByTable[table]; ok && b.FindColumn(colName) != NotFound {
+		binding = b
+	}
+	if binding == nil {
+		return nil, nil, nil
+	}
+	node := builder.qry.Nodes[binding.nodeId]
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil {
+		return nil, nil, nil
+	}
+	for _, indexDef := range node.TableDef.Indexes {
+		if indexDef.JsonPath != cond.path || indexDef.JsonUnquote != cond.unquote || indexDef.MultiValued != cond.multiValued ||
+			len(indexDef.Parts) != 1 || indexDef.Parts[0] != colName {
+			continue
+		}
+		if cond.castType != nil && !jsonIndexKeyTypeMatched(cond.castType, indexDef.KeyType) {
+			continue
+		}
+		if !jsonIndexValueMatched(cond.value, indexDef.KeyType) {
+			continue
+		}
+		return binding, node, indexDef
+	}
+	return nil, nil, nil
+}
+
+// jsonIndexKey is a constant looked up in a functional index of a table scan
+type jsonIndexKey struct {
+	indexDef *IndexDef
+	value    *Expr
+}
+
+// collectJsonIndexKeys finds the equalities on a json path and the MEMBER OF in the conjunctions of
+// the WHERE clause for which a functional index exists, the constants are looked up in the index
+// by applyIndices, which joins the primary keys found to the table scan. The conditions themselves
+// are still evaluated on the rows of the table.
+func (builder *QueryBuilder) collectJsonIndexKeys(astWhere tree.Expr, ctx *BindContext) error {
+	for _, cond := range splitAstConjunction(astWhere) {
+		indexCond, ok := getJsonIndexCond(builder, cond)
+		if !ok {
+			continue
+		}
+		binding, node, indexDef := findJsonIndex(builder, ctx, indexCond)
+		if indexDef == nil {
+			continue
+		}
+		pkName := getTablePriKeyName(node.TableDef.Pkey)
+		if pkName == "" || binding.FindColumn(pkName) == NotFound {
+			continue
+		}
+		if !builder.indexAllowed(node, indexDef.IndexName) {
+			continue
+		}
+
+		binder := NewDefaultBinder(builder.GetContext(), nil, nil, nil, nil)
+		value, err := binder.BindExpr(indexCond.value, 0, true)
+		if err != nil {
+			return err
+		}
+		builder.jsonIndexKeys[node.NodeId] = append(builder.jsonIndexKeys[node.NodeId], &jsonIndexKey{
+			indexDef: indexDef,
+			value:    value,
+		})
+	}
+	return nil
+}
+
+// matchJsonIndex returns the index candidate to look up the key in the functional index, or nil.
+func (builder *QueryBuilder) matchJsonIndex(node *plan.Node, key *jsonIndexKey) *indexCandidate {
+	objRef, tableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, key.indexDef.IndexTableName)
+	if tableDef == nil {
+		return nil
+	}
+	c := &indexCandidate{
+		indexDef: key.indexDef,
+		objRef:   objRef,
+		tableDef: tableDef,
+		idxPos:   -1,
+		priPos:   -1,
+		kind:     indexKeyPoint,
+		keyValue: key.value,
+		hinted:   builder.indexHinted(node, key.indexDef.IndexName),
+	}
+	for i, col := range tableDef.Cols {
+		switch col.Name {
+		case catalog.IndexTableIndexColName:
+			c.idxPos = int32(i)
+		case catalog.IndexTablePrimaryColName:
+			c.priPos = int32(i)
+		}
+	}
+	if c.idxPos < 0 || c.priPos < 0 {
+		return nil
+	}
+	return c
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// JsonTableParam is the param of the json_table table function, it is encoded
+// as json into the TableFunction of the FUNCTION_SCAN node.
+type JsonTableParam struct {
+	Path    string                  `json:"path"`
+	Columns []*JsonTableColumnParam `json:"columns"`
+}
+
+type JsonTableColumnParam struct {
+	Type    tree.JsonTableColumnType `json:"type"`
+	Name    string                   `json:"name,omitempty"`
+	Path    string                   `json:"path,omitempty"`
+	OnEmpty *tree.JsonTableResponse  `json:"onEmpty,omitempty"`
+	OnError *tree.JsonTableResponse  `json:"onError,omitempty"`
+	Columns []*JsonTableColumnParam  `json:"columns,omitempty"`
+}
+
+func (builder *QueryBuilder) buildJsonTable(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if tbl.JsonTable == nil || len(exprs) != 1 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "json_table: incorrect arguments")
+	}
+	param := &JsonTableParam{Path: tbl.JsonTable.Path}
+	if _, err := types.ParseStringToPath(param.Path); err != nil {
+		return 0, err
+	}
+	var colDefs []*plan.ColDef
+	var err error
+	names := make(map[string]struct{})
+	if param.Columns, colDefs, err = builder.buildJsonTableColumns(tbl.JsonTable.Columns, colDefs, names); err != nil {
+		return 0, err
+	}
+	dt, err := json.Marshal(param)
+	if err != nil {
+		return 0, err
+	}
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  "json_table",
+				Param: dt,
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: exprs,
+		Children:        []int32{childId},
+	}
+	return builder.appendNode(node, ctx), nil
+}
+
+// buildJsonTableColumns flattens the columns, the columns of the nested paths
+// are output in the order they appear.
+func (builder *QueryBuilder) buildJsonTableColumns(cols []*tree.JsonTableColumn, colDefs []*plan.ColDef, names map[string]struct{}) ([]*JsonTableColumnParam, []*plan.ColDef, error) {
+	params := make([]*JsonTableColumnParam, 0, len(cols))
+	for _, col := range cols {
+		param := &JsonTableColumnParam{
+			Type:    col.Type,
+			Name:    string(col.Name),
+			Path:    col.Path,
+			OnEmpty: col.OnEmpty,
+			OnError: col.OnError,
+		}
+		if col.Type != tree.JSON_TABLE_COLUMN_ORDINALITY {
+			if _, err := types.ParseStringToPath(col.Path); err != nil {
+				return nil, nil, err
+			}
+		}
+		if col.Type == tree.JSON_TABLE_COLUMN_NESTED {
+			var err error
+			if param.Columns, colDefs, err = builder.buildJsonTableColumns(col.Columns, colDefs, names); err != nil {
+				return nil, nil, err
+			}
+			params = append(params, param)
+			continue
+		}
+		if _, ok := names[param.Name]; ok {
+			return nil, nil, moerr.NewInvalidInput(builder.GetContext(), "json_table: duplicate column name '%s'", param.Name)
+		}
+		names[param.Name] = struct{}{}
+		typ := &plan.Type{Id: int32(types.T_uint32), Width: 32}
+		if col.Type != tree.JSON_TABLE_COLUMN_ORDINALITY {
+			var err error
+			if typ, err = getTypeFromAst(builder.GetContext(), col.ColType); err != nil {
+				return nil, nil, err
+			}
+		}
+		colDefs = append(colDefs, &plan.ColDef{
+			Name: param.Name,
+			Typ:  typ,
+		})
+		params = append(params, param)
+	}
+	return params, colDefs, nil
+}
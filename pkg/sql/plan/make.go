@@ -256,11 +256,21 @@ func makePlan2CastExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr
 		return expr, nil
 	}
 	targetType.NotNullable = expr.Typ.NotNullable
-	t1, t2 := makeTypeByPlan2Expr(expr), makeTypeByPlan2Type(targetType)
 	if types.T(expr.Typ.Id) == types.T_any {
 		expr.Typ = targetType
 		return expr, nil
 	}
+	if isEnumPlan2Type(targetType) {
+		return makeStringToEnumExpr(ctx, expr, targetType)
+	}
+	if isEnumPlan2Type(expr.Typ) {
+		strExpr, err := makeEnumToStringExpr(ctx, expr)
+		if err != nil {
+			return nil, err
+		}
+		return makePlan2CastExpr(ctx, strExpr, targetType)
+	}
+	t1, t2 := makeTypeByPlan2Expr(expr), makeTypeByPlan2Type(targetType)
 	id, _, _, err := function.GetFunctionByName(ctx, "cast", []types.Type{t1, t2})
 	if err != nil {
 		return nil, err
@@ -284,6 +294,67 @@ func makePlan2CastExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr
 	}, nil
 }
 
+func isEnumPlan2Type(typ *Type) bool {
+	return typ.Id == int32(types.T_enum) || typ.Id == int32(types.T_set)
+}
+
+// makeEnumToStringExpr converts the ENUM or SET value to its members.
+func makeEnumToStringExpr(ctx context.Context, expr *Expr) (*Expr, error) {
+	name := "enum_to_string"
+	if expr.Typ.Id == int32(types.T_set) {
+		name = "set_to_string"
+	}
+	return makeEnumFuncExpr(ctx, name, expr, &plan.Type{
+		Id:          int32(types.T_varchar),
+		NotNullable: expr.Typ.NotNullable,
+		Width:       types.MaxVarcharLen,
+	}, expr.Typ.Enumvalues)
+}
+
+// makeStringToEnumExpr converts the value to the ENUM or SET type by its members, other
+// types than the strings are converted to the strings first.
+func makeStringToEnumExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr, error) {
+	var err error
+	if isEnumPlan2Type(expr.Typ) {
+		expr, err = makeEnumToStringExpr(ctx, expr)
+	} else if !types.T(expr.Typ.Id).ToType().IsString() {
+		expr, err = makePlan2CastExpr(ctx, expr, &plan.Type{
+			Id:    int32(types.T_varchar),
+			Width: types.MaxVarcharLen,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	name := "string_to_enum"
+	if targetType.Id == int32(types.T_set) {
+		name = "string_to_set"
+	}
+	typ := *targetType
+	typ.NotNullable = expr.Typ.NotNullable
+	return makeEnumFuncExpr(ctx, name, expr, &typ, targetType.Enumvalues)
+}
+
+func makeEnumFuncExpr(ctx context.Context, name string, expr *Expr, typ *Type, values string) (*Expr, error) {
+	valuesExpr := makePlan2StringConstExprWithType(values)
+	id, _, _, err := function.GetFunctionByName(ctx, name, []types.Type{
+		makeTypeByPlan2Expr(valuesExpr),
+		makeTypeByPlan2Expr(expr),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &plan.Expr{
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: getFunctionObjRef(id, name),
+				Args: []*Expr{valuesExpr, expr},
+			},
+		},
+		Typ: typ,
+	}, nil
+}
+
 // if typ is decimal128 and decimal64 without scalar and width
 // set a default value for it.
 func rewriteDecimalTypeIfNecessary(typ *plan.Type) *plan.Type {
@@ -87,6 +87,15 @@ type index struct {
 	parts      []string
 	cols       []col
 	tableExist bool
+	fulltext   bool
+	parser     string
+	// the secondary index declared by KEY or INDEX
+	secondary bool
+	// the functional index on a json path
+	jsonPath    string
+	keyType     *plan.Type
+	multiValued bool
+	jsonUnquote bool
 }
 
 // NewEmptyCompilerContext for test create/drop statement
@@ -105,6 +114,8 @@ type Schema struct {
 	fks       []*ForeignKeyDef
 	clusterby *ClusterByDef
 	outcnt    float64
+	enums     map[string]string // the members of the ENUM and SET columns
+	generated map[string]*plan.GeneratedCol
 }
 
 const SF float64 = 1
@@ -151,6 +162,214 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 		pks:    []int{0},
 		outcnt: 25,
 	}
+	tpchSchema["enum_test"] = &Schema{
+		cols: []col{
+			{"id", types.T_int32, false, 0, 0},
+			{"color", types.T_enum, true, 0, 0},
+			{"tags", types.T_set, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		enums: map[string]string{
+			"color": "'red','green','blue'",
+			"tags":  "'a','b','c'",
+		},
+		outcnt: 10,
+	}
+	tpchSchema["gen_test"] = &Schema{
+		cols: []col{
+			{"id", types.T_int64, false, 0, 0},
+			{"price", types.T_int64, true, 0, 0},
+			{"qty", types.T_int64, true, 0, 0},
+			{"total", types.T_int64, true, 0, 0},
+			{"doubled", types.T_int64, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		generated: map[string]*plan.GeneratedCol{
+			"total":   mockGeneratedCol("*", true, "price", "qty"),
+			"doubled": mockGeneratedCol("+", false, "price", "price"),
+		},
+		outcnt: 10,
+	}
+	tpchSchema["ft_logs"] = &Schema{
+		cols: []col{
+			{"id", types.T_int64, false, 0, 0},
+			{"host", types.T_varchar, true, 64, 0},
+			{"msg", types.T_text, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		idxs: []index{
+			{
+				indexName: "ft_msg",
+				tableName: catalog.PrefixIndexTableName + "fulltext_7a3e1c42-6f1d-11ee-8c90-0242ac120002",
+				parts:     []string{"msg"},
+				fulltext:  true,
+			},
+			{
+				indexName: "ft_host_msg",
+				tableName: catalog.PrefixIndexTableName + "fulltext_7a3e1d50-6f1d-11ee-8c90-0242ac120002",
+				parts:     []string{"host", "msg"},
+				fulltext:  true,
+				parser:    "ngram",
+			},
+		},
+		outcnt: 1000,
+	}
+	for _, idx := range tpchSchema["ft_logs"].idxs {
+		tpchSchema[idx.tableName] = &Schema{
+			cols: []col{
+				{catalog.IndexTableIndexColName, types.T_varchar, false, types.MaxVarcharLen, 0},
+				{catalog.IndexTablePrimaryColName, types.T_int64, false, 0, 0},
+				{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+			},
+			outcnt: 10000,
+		}
+	}
+	tpchSchema["json_docs"] = &Schema{
+		cols: []col{
+			{"id", types.T_int64, false, 0, 0},
+			{"doc", types.T_json, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		idxs: []index{
+			{
+				indexName: "idx_user_id",
+				tableName: catalog.PrefixIndexTableName + "secondary_3c1a6e2e-7b4f-11ee-9a41-0242ac120002",
+				parts:     []string{"doc"},
+				jsonPath:  `$."user_id"`,
+				keyType:   &plan.Type{Id: int32(types.T_uint64)},
+			},
+			{
+				indexName:   "idx_name",
+				tableName:   catalog.PrefixIndexTableName + "secondary_3c1a6f46-7b4f-11ee-9a41-0242ac120002",
+				parts:       []string{"doc"},
+				jsonPath:    `$."name"`,
+				keyType:     &plan.Type{Id: int32(types.T_varchar), Width: 32},
+				jsonUnquote: true,
+			},
+			{
+				indexName:   "idx_tags",
+				tableName:   catalog.PrefixIndexTableName + "secondary_3c1a7022-7b4f-11ee-9a41-0242ac120002",
+				parts:       []string{"doc"},
+				jsonPath:    `$."tags"`,
+				keyType:     &plan.Type{Id: int32(types.T_uint64)},
+				multiValued: true,
+			},
+			{
+				indexName: "idx_price",
+				tableName: catalog.PrefixIndexTableName + "secondary_3c1a70f4-7b4f-11ee-9a41-0242ac120002",
+				parts:     []string{"doc"},
+				jsonPath:  `$."price"`,
+				keyType:   &plan.Type{Id: int32(types.T_decimal64), Width: 10, Scale: 2},
+			},
+			{
+				indexName: "idx_price_4",
+				tableName: catalog.PrefixIndexTableName + "secondary_3c1a71c6-7b4f-11ee-9a41-0242ac120002",
+				parts:     []string{"doc"},
+				jsonPath:  `$."price"`,
+				keyType:   &plan.Type{Id: int32(types.T_decimal64), Width: 10, Scale: 4},
+			},
+		},
+		outcnt: 1000,
+	}
+	for _, idx := range tpchSchema["json_docs"].idxs {
+		tpchSchema[idx.tableName] = &Schema{
+			cols: []col{
+				{catalog.IndexTableIndexColName, types.T(idx.keyType.Id), false, idx.keyType.Width, idx.keyType.Scale},
+				{catalog.IndexTablePrimaryColName, types.T_int64, false, 0, 0},
+				{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+			},
+			outcnt: 10000,
+		}
+	}
+	tpchSchema["users"] = &Schema{
+		cols: []col{
+			{"id", types.T_int64, false, 0, 0},
+			{"name", types.T_varchar, true, 32, 0},
+			{"city", types.T_varchar, true, 32, 0},
+			{"age", types.T_int32, true, 0, 0},
+			{"bio", types.T_varchar, true, 255, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		idxs: []index{
+			{
+				indexName: "idx_name",
+				tableName: catalog.PrefixIndexTableName + "unique_5c1d2e6a-7b3f-11ee-b962-0242ac120002",
+				parts:     []string{"name"},
+			},
+			{
+				indexName: "idx_city_age",
+				tableName: catalog.PrefixIndexTableName + "unique_5c1d31e4-7b3f-11ee-b962-0242ac120002",
+				parts:     []string{"city", "age"},
+			},
+			{
+				indexName: "idx_city_id",
+				tableName: catalog.PrefixIndexTableName + "unique_5c1d33a8-7b3f-11ee-b962-0242ac120002",
+				parts:     []string{"city", "id"},
+			},
+			{
+				indexName: "idx_age",
+				tableName: catalog.PrefixIndexTableName + "secondary_5c1d3560-7b3f-11ee-b962-0242ac120002",
+				parts:     []string{"age"},
+				secondary: true,
+			},
+			{
+				indexName: "idx_bio_age",
+				tableName: catalog.PrefixIndexTableName + "secondary_5c1d3722-7b3f-11ee-b962-0242ac120002",
+				parts:     []string{"bio", "age"},
+				secondary: true,
+			},
+		},
+		outcnt: 100000,
+	}
+	tpchSchema[tpchSchema["users"].idxs[0].tableName] = &Schema{
+		cols: []col{
+			{catalog.IndexTableIndexColName, types.T_varchar, false, 32, 0},
+			{catalog.IndexTablePrimaryColName, types.T_int64, false, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 100000,
+	}
+	tpchSchema[tpchSchema["users"].idxs[1].tableName] = &Schema{
+		cols: []col{
+			{catalog.IndexTableIndexColName, types.T_varchar, false, types.MaxVarcharLen, 0},
+			{catalog.IndexTablePrimaryColName, types.T_int64, false, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 100000,
+	}
+	tpchSchema[tpchSchema["users"].idxs[2].tableName] = &Schema{
+		cols: []col{
+			{catalog.IndexTableIndexColName, types.T_varchar, false, types.MaxVarcharLen, 0},
+			{catalog.IndexTablePrimaryColName, types.T_int64, false, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 100000,
+	}
+	// the secondary index tables have no primary key, a key is found for many rows
+	tpchSchema[tpchSchema["users"].idxs[3].tableName] = &Schema{
+		cols: []col{
+			{catalog.IndexTableIndexColName, types.T_int32, false, 0, 0},
+			{catalog.IndexTablePrimaryColName, types.T_int64, false, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		outcnt: 100000,
+	}
+	tpchSchema[tpchSchema["users"].idxs[4].tableName] = &Schema{
+		cols: []col{
+			{catalog.IndexTableIndexColName, types.T_varchar, false, types.MaxVarcharLen, 0},
+			{catalog.IndexTablePrimaryColName, types.T_int64, false, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		outcnt: 100000,
+	}
 	tpchSchema["region"] = &Schema{
 		cols: []col{
 			{"r_regionkey", types.T_int32, false, 0, 0},
@@ -545,6 +764,7 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 						NotNullable: !col.Nullable,
 						Width:       col.Width,
 						Scale:       col.Scale,
+						Enumvalues:  table.enums[col.Name],
 					},
 					Name:    col.Name,
 					Primary: idx == 0,
@@ -552,6 +772,7 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 					Default: &plan.Default{
 						NullAbility: col.Nullable,
 					},
+					Generated: table.generated[col.Name],
 				})
 			}
 
@@ -574,14 +795,28 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 				Indexes:   make([]*IndexDef, len(table.idxs)),
 			}
 
+			if len(table.pks) == 1 && len(table.idxs) > 0 {
+				pkName := table.cols[table.pks[0]].Name
+				tableDef.Pkey = &PrimaryKeyDef{
+					Names:       []string{pkName},
+					PkeyColName: pkName,
+				}
+			}
+
 			if table.idxs != nil {
 				for i, idx := range table.idxs {
 					indexdef := &plan.IndexDef{
 						IndexName:      idx.indexName,
 						Parts:          idx.parts,
-						Unique:         true,
+						Unique:         !idx.fulltext && idx.jsonPath == "" && !idx.secondary,
 						IndexTableName: idx.tableName,
 						TableExist:     true,
+						Fulltext:       idx.fulltext,
+						Parser:         idx.parser,
+						JsonPath:       idx.jsonPath,
+						KeyType:        idx.keyType,
+						MultiValued:    idx.multiValued,
+						JsonUnquote:    idx.jsonUnquote,
 					}
 					tableDef.Indexes[i] = indexdef
 				}
@@ -804,3 +1039,25 @@ func (moc *MockOptimizer) Optimize(stmt tree.Statement) (*Query, error) {
 func (moc *MockOptimizer) CurrentContext() CompilerContext {
 	return &moc.ctxt
 }
+
+// mockGeneratedCol makes the generated column computed by the function of the int64 columns.
+func mockGeneratedCol(name string, stored bool, cols ...string) *plan.GeneratedCol {
+	args := make([]*Expr, len(cols))
+	for i, col := range cols {
+		args[i] = &Expr{
+			Typ: &plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{Name: col},
+			},
+		}
+	}
+	expr, err := bindFuncExprImplByPlanExpr(context.TODO(), name, args)
+	if err != nil {
+		panic(err)
+	}
+	return &plan.GeneratedCol{
+		Expr:         expr,
+		OriginString: strings.Join(cols, " "+name+" "),
+		Stored:       stored,
+	}
+}
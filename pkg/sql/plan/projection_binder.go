@@ -16,9 +16,11 @@ package plan
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 )
 
 func NewProjectionBinder(builder *QueryBuilder, ctx *BindContext, havingBinder *HavingBinder) *ProjectionBinder {
@@ -72,7 +74,233 @@ func (b *ProjectionBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr,
 }
 
 func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
-	return nil, moerr.NewNYI(b.GetContext(), "window functions")
+	if astExpr.WindowSpec == nil {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window function %s requires an OVER clause", funcName)
+	}
+	if b.insideWin {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window function calls cannot be nested")
+	}
+	if b.ctx.windowTag == 0 {
+		return nil, moerr.NewNYI(b.GetContext(), "window function %s here", funcName)
+	}
+
+	astStr := tree.String(astExpr, dialect.MYSQL)
+	if colPos, ok := b.ctx.windowByAst[astStr]; ok {
+		return &plan.Expr{
+			Typ: b.ctx.windows[colPos].WindowFunc.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: b.ctx.windowTag,
+					ColPos: colPos,
+				},
+			},
+		}, nil
+	}
+
+	if function.GetFunctionIsAggregateByName(funcName) {
+		if astExpr.Type == tree.FUNC_TYPE_DISTINCT {
+			return nil, moerr.NewNYI(b.GetContext(), "DISTINCT in window aggregate function %s", funcName)
+		}
+		if funcName == "group_concat" {
+			return nil, moerr.NewNYI(b.GetContext(), "window aggregate function %s", funcName)
+		}
+	} else if !function.GetFunctionIsWinfunByName(funcName) {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "function %s is not a window function", funcName)
+	}
+
+	b.insideWin = true
+	defer func() {
+		b.insideWin = false
+	}()
+
+	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &plan.WindowSpec{
+		WindowFunc: expr,
+	}
+
+	for _, partition := range astExpr.WindowSpec.PartitionBy {
+		partExpr, err := b.BindExpr(partition, depth, false)
+		if err != nil {
+			return nil, err
+		}
+		spec.PartitionBy = append(spec.PartitionBy, partExpr)
+	}
+
+	for _, order := range astExpr.WindowSpec.OrderBy {
+		orderExpr, err := b.BindExpr(order.Expr, depth, false)
+		if err != nil {
+			return nil, err
+		}
+
+		orderBy := &plan.OrderBySpec{
+			Expr: orderExpr,
+			Flag: plan.OrderBySpec_INTERNAL,
+		}
+
+		switch order.Direction {
+		case tree.Ascending:
+			orderBy.Flag |= plan.OrderBySpec_ASC
+		case tree.Descending:
+			orderBy.Flag |= plan.OrderBySpec_DESC
+		}
+
+		switch order.NullsPosition {
+		case tree.NullsFirst:
+			orderBy.Flag |= plan.OrderBySpec_NULLS_FIRST
+		case tree.NullsLast:
+			orderBy.Flag |= plan.OrderBySpec_NULLS_LAST
+		}
+
+		spec.OrderBy = append(spec.OrderBy, orderBy)
+	}
+
+	spec.Frame, err = b.bindWindowFrame(astExpr.WindowSpec, spec.OrderBy, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	colPos := int32(len(b.ctx.windows))
+	b.ctx.windowByAst[astStr] = colPos
+	b.ctx.windows = append(b.ctx.windows, spec)
+
+	return &plan.Expr{
+		Typ: expr.Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: b.ctx.windowTag,
+				ColPos: colPos,
+			},
+		},
+	}, nil
+}
+
+// bindWindowFrame binds the frame clause of a window,
+// the frame defaults to RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW if the window is ordered,
+// and to ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING otherwise.
+func (b *ProjectionBinder) bindWindowFrame(astSpec *tree.WindowSpec, orderBy []*plan.OrderBySpec, depth int32) (*plan.FrameClause, error) {
+	astFrame := astSpec.WindowFrame
+	if astFrame == nil {
+		if len(orderBy) > 0 {
+			return &plan.FrameClause{
+				Type:  plan.FrameClause_RANGE,
+				Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Unbounded: true},
+				End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+			}, nil
+		}
+		return &plan.FrameClause{
+			Type:  plan.FrameClause_ROWS,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Unbounded: true},
+			End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Unbounded: true},
+		}, nil
+	}
+
+	frame := &plan.FrameClause{}
+	switch astFrame.Unit {
+	case tree.WIN_FRAME_UNIT_ROWS:
+		frame.Type = plan.FrameClause_ROWS
+	case tree.WIN_FRAME_UNIT_RANGE:
+		frame.Type = plan.FrameClause_RANGE
+	default:
+		return nil, moerr.NewNYI(b.GetContext(), "GROUPS unit in window frame")
+	}
+
+	var err error
+	frame.Start, err = b.bindFrameBound(astFrame.StartBound, frame.Type, orderBy, depth)
+	if err != nil {
+		return nil, err
+	}
+	if astFrame.EndBound == nil {
+		frame.End = &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW}
+	} else {
+		frame.End, err = b.bindFrameBound(astFrame.EndBound, frame.Type, orderBy, depth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if frame.Start.Type == plan.FrameBound_FOLLOWING && frame.Start.Unbounded {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame cannot start from UNBOUNDED FOLLOWING")
+	}
+	if frame.End.Type == plan.FrameBound_PRECEDING && frame.End.Unbounded {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame cannot end with UNBOUNDED PRECEDING")
+	}
+	if frame.Start.Type > frame.End.Type {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame starts after it ends")
+	}
+
+	return frame, nil
+}
+
+func (b *ProjectionBinder) bindFrameBound(astBound tree.WindowFrameBound, frameType plan.FrameClause_FrameType, orderBy []*plan.OrderBySpec, depth int32) (*plan.FrameBound, error) {
+	bound := &plan.FrameBound{}
+	var astOffset tree.Expr
+
+	switch boundImpl := astBound.(type) {
+	case *tree.WindowFrameBoundCurrentRow:
+		bound.Type = plan.FrameBound_CURRENT_ROW
+		return bound, nil
+
+	case *tree.WindowFrameBoundPreceding:
+		bound.Type = plan.FrameBound_PRECEDING
+		astOffset = boundImpl.Expr
+
+	case *tree.WindowFrameBoundFollowing:
+		bound.Type = plan.FrameBound_FOLLOWING
+		astOffset = boundImpl.Expr
+
+	default:
+		return nil, moerr.NewNYI(b.GetContext(), "window frame bound '%v'", astBound)
+	}
+
+	if astOffset == nil {
+		bound.Unbounded = true
+		return bound, nil
+	}
+
+	offset, err := b.BindExpr(astOffset, depth, false)
+	if err != nil {
+		return nil, err
+	}
+	c, ok := offset.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame offset must be a non-negative constant")
+	}
+	if val, ok := c.C.Value.(*plan.Const_I64Val); ok && val.I64Val < 0 {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame offset must be a non-negative constant")
+	}
+
+	targetType := types.T_int64.ToType()
+	if frameType == plan.FrameClause_ROWS {
+		if !types.IsInteger(types.T(offset.Typ.Id)) {
+			return nil, moerr.NewSyntaxError(b.GetContext(), "ROWS window frame offset must be an integer")
+		}
+	} else {
+		if len(orderBy) != 1 {
+			return nil, moerr.NewSyntaxError(b.GetContext(), "RANGE window frame with offset needs exactly one ORDER BY expression")
+		}
+		orderTyp := types.T(orderBy[0].Expr.Typ.Id)
+		if !types.IsInteger(orderTyp) && !types.IsFloat(orderTyp) {
+			return nil, moerr.NewNYI(b.GetContext(), "RANGE window frame with offset on ORDER BY type %s", orderTyp.String())
+		}
+		if !types.IsInteger(types.T(offset.Typ.Id)) && !types.IsFloat(types.T(offset.Typ.Id)) && !types.IsDecimal(types.T(offset.Typ.Id)) {
+			return nil, moerr.NewSyntaxError(b.GetContext(), "RANGE window frame offset must be numeric")
+		}
+		targetType = types.T_float64.ToType()
+	}
+
+	if offset.Typ.Id != int32(targetType.Oid) {
+		offset, err = appendCastBeforeExpr(b.GetContext(), offset, makePlan2Type(&targetType))
+		if err != nil {
+			return nil, err
+		}
+	}
+	bound.Val = offset
+
+	return bound, nil
 }
 
 func (b *ProjectionBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
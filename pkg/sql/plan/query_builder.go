@@ -50,11 +50,15 @@ func NewQueryBuilder(queryType plan.Query_StatementType, ctx CompilerContext) *Q
 		qry: &Query{
 			StmtType: queryType,
 		},
-		compCtx:         ctx,
-		ctxByNode:       []*BindContext{},
-		nameByColRef:    make(map[[2]int32]string),
-		nextTag:         0,
-		mysqlCompatible: mysqlCompatible,
+		compCtx:          ctx,
+		ctxByNode:        []*BindContext{},
+		nameByColRef:     make(map[[2]int32]string),
+		nextTag:          0,
+		mysqlCompatible:  mysqlCompatible,
+		usedHints:        make(map[*tree.OptimizerHint]bool),
+		tableByTag:       make(map[int32]string),
+		indexHintsByNode: make(map[int32][]*tree.IndexHint),
+		jsonIndexKeys:    make(map[int32][]*jsonIndexKey),
 	}
 }
 
@@ -209,7 +213,8 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 
 	case plan.Node_INTERSECT, plan.Node_INTERSECT_ALL,
 		plan.Node_UNION, plan.Node_UNION_ALL,
-		plan.Node_MINUS, plan.Node_MINUS_ALL:
+		plan.Node_MINUS, plan.Node_MINUS_ALL,
+		plan.Node_RECURSIVE_CTE:
 
 		thisTag := node.BindingTags[0]
 		leftID := node.Children[0]
@@ -523,6 +528,105 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			})
 		}
 
+	case plan.Node_WINDOW:
+		for _, win := range node.WinSpecList {
+			increaseRefCnt(win.WindowFunc, colRefCnt)
+			for _, expr := range win.PartitionBy {
+				increaseRefCnt(expr, colRefCnt)
+			}
+			for _, orderBy := range win.OrderBy {
+				increaseRefCnt(orderBy.Expr, colRefCnt)
+			}
+		}
+
+		childRemapping, err := builder.remapAllColRefs(node.Children[0], colRefCnt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, win := range node.WinSpecList {
+			decreaseRefCnt(win.WindowFunc, colRefCnt)
+			err := builder.remapExpr(win.WindowFunc, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, expr := range win.PartitionBy {
+				decreaseRefCnt(expr, colRefCnt)
+				err := builder.remapExpr(expr, childRemapping.globalToLocal)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			for _, orderBy := range win.OrderBy {
+				decreaseRefCnt(orderBy.Expr, colRefCnt)
+				err := builder.remapExpr(orderBy.Expr, childRemapping.globalToLocal)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		childProjList := builder.qry.Nodes[node.Children[0]].ProjectList
+		for i, globalRef := range childRemapping.localToGlobal {
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: childProjList[i].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		// the window operator appends one column for each window after the child's columns
+		windowTag := node.BindingTags[0]
+		childSize := int32(len(childProjList))
+		for idx, win := range node.WinSpecList {
+			globalRef := [2]int32{windowTag, int32(idx)}
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: win.WindowFunc.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: -1,
+						ColPos: int32(idx) + childSize,
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		if len(node.ProjectList) == 0 {
+			globalRef := [2]int32{windowTag, 0}
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: node.WinSpecList[0].WindowFunc.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: -1,
+						ColPos: childSize,
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
 	case plan.Node_FILTER:
 		for _, expr := range node.FilterList {
 			increaseRefCnt(expr, colRefCnt)
@@ -655,6 +759,25 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 
 		remapping = childRemapping
 
+	case plan.Node_SINK_SCAN:
+		// the rows of the previous iteration always have all the columns of the recursive CTE
+		tag := node.BindingTags[0]
+		for i, col := range node.TableDef.Cols {
+			globalRef := [2]int32{tag, int32(i)}
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: col.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
 	case plan.Node_VALUE_SCAN:
 		// VALUE_SCAN always have one column now
 		if node.TableDef == nil { // like select 1,2
@@ -712,6 +835,15 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		rootId = builder.determineJoinOrder(rootId)
 		SortFilterListByStats(builder.GetContext(), rootId, builder)
 		rootId = builder.pushdownSemiAntiJoins(rootId)
+		builder.applyJoinMethodHints(rootId)
+		if builder.qry.StmtType == plan.Query_SELECT && !builder.isDML {
+			refs := make(map[[2]int32]bool)
+			builder.collectColRefs(rootId, refs)
+			var err error
+			if rootId, err = builder.applyIndices(rootId, refs); err != nil {
+				return nil, err
+			}
+		}
 		builder.qry.Steps[i] = rootId
 
 		colRefCnt := make(map[[2]int32]int)
@@ -725,6 +857,7 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		if err != nil {
 			return nil, err
 		}
+		builder.generateRuntimeFilters(rootId)
 	}
 	return builder.qry, nil
 }
@@ -798,6 +931,11 @@ func (builder *QueryBuilder) buildUnion(stmt *tree.UnionClause, astOrderBy tree.
 		if err != nil {
 			return 0, err
 		}
+		// the members of ENUM and SET may differ between the selects, so they are unioned as strings
+		nodeID, err = builder.appendEnumToStringProject(nodeID, subCtx)
+		if err != nil {
+			return 0, err
+		}
 
 		if idx == 0 {
 			projectLength = len(builder.qry.Nodes[nodeID].ProjectList)
@@ -1063,6 +1201,10 @@ func (builder *QueryBuilder) buildUnion(stmt *tree.UnionClause, astOrderBy tree.
 }
 
 func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, isRoot bool) (int32, error) {
+	if isRoot {
+		builder.collectRootHints(stmt)
+	}
+
 	// preprocess CTEs
 	if stmt.With != nil {
 		ctx.cteByName = make(map[string]*CTERef)
@@ -1085,31 +1227,32 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 				}
 			}
 
+			// only a CTE of WITH RECURSIVE can refer to itself,
+			// otherwise the name refers to a table.
+			if !stmt.With.IsRecursive {
+				if maskedCTEs == nil {
+					maskedCTEs = make(map[string]any)
+				}
+				maskedCTEs[name] = nil
+			}
+
 			maskedNames = append(maskedNames, name)
 
 			ctx.cteByName[name] = &CTERef{
-				ast:        cte,
-				maskedCTEs: maskedCTEs,
+				ast:         cte,
+				maskedCTEs:  maskedCTEs,
+				isRecursive: stmt.With.IsRecursive,
 			}
 		}
 
 		// Try to do binding for CTE at declaration
 		for _, cte := range stmt.With.CTEs {
+			name := string(cte.Name.Alias)
+			cteRef := ctx.cteByName[name]
 			subCtx := NewBindContext(builder, ctx)
-			subCtx.maskedCTEs = ctx.cteByName[string(cte.Name.Alias)].maskedCTEs
-
-			var err error
-			switch stmt := cte.Stmt.(type) {
-			case *tree.Select:
-				_, err = builder.buildSelect(stmt, subCtx, false)
-
-			case *tree.ParenSelect:
-				_, err = builder.buildSelect(stmt.Select, subCtx, false)
-
-			default:
-				err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
-			}
+			subCtx.maskedCTEs = cteRef.maskedCTEs
 
+			_, err := builder.buildCTE(cteRef, name, subCtx)
 			if err != nil {
 				return 0, err
 			}
@@ -1258,6 +1401,8 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			return 0, err
 		}
 	} else {
+		builder.collectHints(clause)
+
 		// build FROM clause
 		nodeID, err = builder.buildFrom(clause.From.Tables, ctx)
 		if err != nil {
@@ -1350,7 +1495,15 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		}
 
 		if clause.Where != nil {
-			whereList, err := splitAndBindCondition(clause.Where.Expr, ctx)
+			astWhere, err := builder.appendFullTextFilters(clause.Where.Expr, ctx)
+			if err != nil {
+				return 0, err
+			}
+			if err = builder.collectJsonIndexKeys(astWhere, ctx); err != nil {
+				return 0, err
+			}
+
+			whereList, err := splitAndBindCondition(astWhere, ctx)
 			if err != nil {
 				return 0, err
 			}
@@ -1376,20 +1529,33 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 
 		ctx.groupTag = builder.genNewTag()
 		ctx.aggregateTag = builder.genNewTag()
+		ctx.windowTag = builder.genNewTag()
 		ctx.projectTag = builder.genNewTag()
 
 		// bind GROUP BY clause
 		if clause.GroupBy != nil {
 			groupBinder := NewGroupBinder(builder, ctx)
-			for _, group := range clause.GroupBy {
-				group, err = ctx.qualifyColumnNames(group, nil, false)
+			groupingSets, err := expandGroupingSets(builder.GetContext(), clause.GroupBy)
+			if err != nil {
+				return 0, err
+			}
+
+			if groupingSets != nil {
+				err = builder.bindGroupingSets(ctx, groupBinder, groupingSets)
 				if err != nil {
 					return 0, err
 				}
+			} else {
+				for _, group := range clause.GroupBy {
+					group, err = ctx.qualifyColumnNames(group, nil, false)
+					if err != nil {
+						return 0, err
+					}
 
-				_, err = groupBinder.BindExpr(group, 0, true)
-				if err != nil {
-					return 0, err
+					_, err = groupBinder.BindExpr(group, 0, true)
+					if err != nil {
+						return 0, err
+					}
 				}
 			}
 		}
@@ -1506,6 +1672,16 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		for i, proj := range ctx.projects {
 			ctx.projects[i] = builder.wrapBareColRefsInAnyValue(proj, ctx)
 		}
+
+		for _, win := range ctx.windows {
+			win.WindowFunc = builder.wrapBareColRefsInAnyValue(win.WindowFunc, ctx)
+			for i, part := range win.PartitionBy {
+				win.PartitionBy[i] = builder.wrapBareColRefsInAnyValue(part, ctx)
+			}
+			for _, order := range win.OrderBy {
+				order.Expr = builder.wrapBareColRefsInAnyValue(order.Expr, ctx)
+			}
+		}
 	}
 
 	// FIXME: delete this when SINGLE join is ready
@@ -1519,6 +1695,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			NodeType:    plan.Node_AGG,
 			Children:    []int32{nodeID},
 			GroupBy:     ctx.groups,
+			GroupingSet: makeGroupingSets(ctx.groupingSets),
 			AggList:     ctx.aggregates,
 			BindingTags: []int32{ctx.groupTag, ctx.aggregateTag},
 		}, ctx)
@@ -1552,6 +1729,41 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		}
 	}
 
+	// append WINDOW node
+	if len(ctx.windows) > 0 {
+		for _, win := range ctx.windows {
+			nodeID, win.WindowFunc, err = builder.flattenSubqueries(nodeID, win.WindowFunc, ctx)
+			if err != nil {
+				return 0, err
+			}
+
+			for i, part := range win.PartitionBy {
+				nodeID, win.PartitionBy[i], err = builder.flattenSubqueries(nodeID, part, ctx)
+				if err != nil {
+					return 0, err
+				}
+			}
+
+			for _, order := range win.OrderBy {
+				nodeID, order.Expr, err = builder.flattenSubqueries(nodeID, order.Expr, ctx)
+				if err != nil {
+					return 0, err
+				}
+			}
+		}
+
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:    plan.Node_WINDOW,
+			Children:    []int32{nodeID},
+			WinSpecList: ctx.windows,
+			BindingTags: []int32{ctx.windowTag},
+		}, ctx)
+
+		for name, id := range ctx.windowByAst {
+			builder.nameByColRef[[2]int32{ctx.windowTag, id}] = name
+		}
+	}
+
 	// append PROJECT node
 	for i, proj := range ctx.projects {
 		nodeID, proj, err = builder.flattenSubqueries(nodeID, proj, ctx)
@@ -1620,12 +1832,65 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	}
 
 	if isRoot {
+		nodeID, err = builder.appendEnumToStringProject(nodeID, ctx)
+		if err != nil {
+			return 0, err
+		}
 		builder.qry.Headings = append(builder.qry.Headings, ctx.headings...)
 	}
 
 	return nodeID, nil
 }
 
+// appendEnumToStringProject appends a PROJECT node which converts the ENUM and SET values in
+// the results to their members. The values are kept as is until then, so that they are sorted
+// and grouped by the ordinals.
+func (builder *QueryBuilder) appendEnumToStringProject(nodeID int32, ctx *BindContext) (int32, error) {
+	hasEnum := false
+	for _, expr := range ctx.results {
+		if isEnumPlan2Type(expr.Typ) {
+			hasEnum = true
+			break
+		}
+	}
+	if !hasEnum {
+		return nodeID, nil
+	}
+
+	var err error
+	tag := builder.qry.Nodes[nodeID].BindingTags[0]
+	ctx.resultTag = builder.genNewTag()
+	results := make([]*plan.Expr, len(ctx.results))
+	for i, expr := range ctx.results {
+		results[i] = &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: tag,
+					ColPos: int32(i),
+				},
+			},
+		}
+		if isEnumPlan2Type(expr.Typ) {
+			results[i], err = makeEnumToStringExpr(builder.GetContext(), results[i])
+			if err != nil {
+				return 0, err
+			}
+		}
+		if i < len(ctx.headings) {
+			builder.nameByColRef[[2]int32{ctx.resultTag, int32(i)}] = ctx.headings[i]
+		}
+	}
+	ctx.results = results
+
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: results,
+		Children:    []int32{nodeID},
+		BindingTags: []int32{ctx.resultTag},
+	}, ctx), nil
+}
+
 func (builder *QueryBuilder) appendNode(node *plan.Node, ctx *BindContext) int32 {
 	nodeID := int32(len(builder.qry.Nodes))
 	node.NodeId = nodeID
@@ -1708,6 +1973,23 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 
 		if len(schema) == 0 {
 			cteRef := ctx.findCTE(table)
+			if cteRef != nil && cteRef.sinkScan != nil {
+				// the recursive CTE is referenced in its recursive query block
+				cteRef.sinkScanRefs++
+				nodeID = builder.appendNode(&plan.Node{
+					NodeType:    plan.Node_SINK_SCAN,
+					TableDef:    DeepCopyTableDef(cteRef.sinkScan),
+					BindingTags: []int32{builder.genNewTag()},
+				}, ctx)
+
+				break
+			}
+			if cteRef != nil && cteRef.building {
+				if recursiveCTEUnion(cteRef.ast) != nil {
+					return 0, moerr.NewCTERecursiveRequiresNonRecursiveFirst(builder.GetContext(), table)
+				}
+				return 0, moerr.NewCTERecursiveRequiresUnion(builder.GetContext(), table)
+			}
 			if cteRef != nil {
 				subCtx := NewBindContext(builder, ctx)
 				subCtx.maskedCTEs = cteRef.maskedCTEs
@@ -1717,17 +1999,7 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 					subCtx.defaultDatabase = cteRef.defaultDatabase
 				}
 
-				switch stmt := cteRef.ast.Stmt.(type) {
-				case *tree.Select:
-					nodeID, err = builder.buildSelect(stmt, subCtx, false)
-
-				case *tree.ParenSelect:
-					nodeID, err = builder.buildSelect(stmt.Select, subCtx, false)
-
-				default:
-					err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
-				}
-
+				nodeID, err = builder.buildCTE(cteRef, table, subCtx)
 				if err != nil {
 					return
 				}
@@ -1881,6 +2153,20 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 		}
 
 		err = builder.addBinding(nodeID, tbl.As, ctx)
+		if len(tbl.IndexHints) > 0 {
+			builder.indexHintsByNode[nodeID] = tbl.IndexHints
+		}
+		if tbl.AsOf != nil {
+			node := builder.qry.Nodes[nodeID]
+			if node.NodeType != plan.Node_TABLE_SCAN {
+				return 0, moerr.NewNotSupported(builder.GetContext(), "AS OF on views, subqueries, external or temporary tables")
+			}
+			if node.SnapshotTs, err = builder.buildSnapshotTs(tbl.AsOf); err != nil {
+				return 0, err
+			}
+			// the snapshot of the relative time changes every time the query runs
+			node.NotCacheable = true
+		}
 
 		//tableDef := builder.qry.Nodes[nodeID].GetTableDef()
 		midNode := builder.qry.Nodes[nodeID]
@@ -1963,7 +2249,7 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 	var binding *Binding
 	var table string
 
-	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN || node.NodeType == plan.Node_EXTERNAL_SCAN || node.NodeType == plan.Node_FUNCTION_SCAN || node.NodeType == plan.Node_VALUE_SCAN {
+	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN || node.NodeType == plan.Node_EXTERNAL_SCAN || node.NodeType == plan.Node_FUNCTION_SCAN || node.NodeType == plan.Node_VALUE_SCAN || node.NodeType == plan.Node_SINK_SCAN {
 		if node.NodeType == plan.Node_VALUE_SCAN && node.TableDef == nil {
 			return nil
 		}
@@ -2044,6 +2330,7 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 
 	ctx.bindings = append(ctx.bindings, binding)
 	ctx.bindingByTag[binding.tag] = binding
+	builder.tableByTag[binding.tag] = binding.table
 	ctx.bindingByTable[binding.table] = binding
 
 	for _, col := range binding.cols {
@@ -2175,7 +2462,8 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 		aggregateTag := node.BindingTags[1]
 
 		for _, filter := range filters {
-			if !containsTag(filter, aggregateTag) {
+			// the group columns are NULL in the rows of the grouping sets not grouping them
+			if len(node.GroupingSet) == 0 && !containsTag(filter, aggregateTag) {
 				canPushdown = append(canPushdown, replaceColRefs(filter, groupTag, node.GroupBy))
 			} else {
 				cantPushdown = append(cantPushdown, filter)
@@ -2194,6 +2482,22 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 
 		node.Children[0] = childID
 
+	case plan.Node_WINDOW:
+		// filters can't go through a window, which would change the rows the windows are computed on
+		cantPushdown = filters
+
+		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], nil, separateNonEquiConds)
+
+		if len(cantPushdownChild) > 0 {
+			childID = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{node.Children[0]},
+				FilterList: cantPushdownChild,
+			}, nil)
+		}
+
+		node.Children[0] = childID
+
 	case plan.Node_FILTER:
 		canPushdown = filters
 		for _, filter := range node.FilterList {
@@ -2453,6 +2757,13 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 		}
 		node.Children[1] = childID
 
+	case plan.Node_RECURSIVE_CTE:
+		// filters can't be pushed into either part, they would change the rows fed to the next iteration
+		cantPushdown = filters
+		for i, childID := range node.Children {
+			node.Children[i], _ = builder.pushdownFilters(childID, nil, separateNonEquiConds)
+		}
+
 	case plan.Node_PROJECT:
 		child := builder.qry.Nodes[node.Children[0]]
 		if (child.NodeType == plan.Node_VALUE_SCAN || child.NodeType == plan.Node_EXTERNAL_SCAN) && child.RowsetData == nil {
@@ -2547,6 +2858,8 @@ func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *Bi
 		nodeId, err = builder.buildMetaScan(tbl, ctx, exprs, childId)
 	case "current_account":
 		nodeId, err = builder.buildCurrentAccount(tbl, ctx, exprs, childId)
+	case "json_table":
+		nodeId, err = builder.buildJsonTable(tbl, ctx, exprs, childId)
 	default:
 		err = moerr.NewNotSupported(builder.GetContext(), "table function '%s' not supported", id)
 	}
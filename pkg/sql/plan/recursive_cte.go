@@ -0,0 +1,245 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// DefaultCTEMaxRecursionDepth is used when @@cte_max_recursion_depth can not be resolved.
+const DefaultCTEMaxRecursionDepth = 1000
+
+// buildCTE builds the query of a CTE in subCtx.
+func (builder *QueryBuilder) buildCTE(cteRef *CTERef, name string, subCtx *BindContext) (int32, error) {
+	if cteRef.isRecursive {
+		cteRef.building = true
+		defer func() {
+			cteRef.building = false
+		}()
+
+		nodeID, ok, err := builder.buildRecursiveCTE(cteRef, name, subCtx)
+		if err != nil || ok {
+			return nodeID, err
+		}
+	}
+
+	switch stmt := cteRef.ast.Stmt.(type) {
+	case *tree.Select:
+		return builder.buildSelect(stmt, subCtx, false)
+
+	case *tree.ParenSelect:
+		return builder.buildSelect(stmt.Select, subCtx, false)
+
+	default:
+		return 0, moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
+	}
+}
+
+// recursiveCTEUnion returns the UNION of the CTE's query, or nil if the query is not a UNION.
+func recursiveCTEUnion(cte *tree.CTE) *tree.UnionClause {
+	var stmt *tree.Select
+	switch s := cte.Stmt.(type) {
+	case *tree.Select:
+		stmt = s
+	case *tree.ParenSelect:
+		stmt = s.Select
+	default:
+		return nil
+	}
+
+	for {
+		switch s := stmt.Select.(type) {
+		case *tree.UnionClause:
+			if s.Type == tree.UNION {
+				return s
+			}
+			return nil
+		case *tree.ParenSelect:
+			stmt = s.Select
+		default:
+			return nil
+		}
+	}
+}
+
+// buildRecursiveCTE builds a CTE whose query is a UNION and the last query block of the UNION refers to the CTE.
+// The first part of the UNION is built as the non-recursive part, and the last query block is built as
+// the recursive part, in which the CTE is scanned by a SINK_SCAN node.
+// ok is false if the CTE does not refer to itself, it should be built as an ordinary CTE then.
+func (builder *QueryBuilder) buildRecursiveCTE(cteRef *CTERef, name string, ctx *BindContext) (nodeID int32, ok bool, err error) {
+	union := recursiveCTEUnion(cteRef.ast)
+	if union == nil {
+		return 0, false, nil
+	}
+
+	// build the non-recursive part, the CTE can not be referenced here
+	anchorCtx := NewBindContext(builder, ctx)
+	var anchorStmt *tree.Select
+	if paren, ok := union.Left.(*tree.ParenSelect); ok {
+		anchorStmt = paren.Select
+	} else {
+		anchorStmt = &tree.Select{Select: union.Left}
+	}
+	anchorID, err := builder.buildSelect(anchorStmt, anchorCtx, false)
+	if err != nil {
+		return 0, false, err
+	}
+
+	cols := cteRef.ast.Name.Cols
+	if len(cols) > len(anchorCtx.headings) {
+		return 0, false, moerr.NewSyntaxError(builder.GetContext(), "table %q has %d columns available but %d columns specified", name, len(anchorCtx.headings), len(cols))
+	}
+
+	anchorNode := builder.qry.Nodes[anchorID]
+	sinkScan := &TableDef{
+		Name: name,
+		Cols: make([]*ColDef, len(anchorNode.ProjectList)),
+	}
+	for i, expr := range anchorNode.ProjectList {
+		colName := anchorCtx.headings[i]
+		if i < len(cols) {
+			colName = string(cols[i])
+		}
+		sinkScan.Cols[i] = &ColDef{
+			Name: strings.ToLower(colName),
+			Typ:  expr.Typ,
+		}
+	}
+
+	// build the recursive part, the CTE is scanned by a SINK_SCAN node here.
+	// ctx may hide the CTE from its children, so the CTE is registered in the new context.
+	recursiveCtx := NewBindContext(builder, ctx)
+	recursiveCtx.cteByName = map[string]*CTERef{name: cteRef}
+	var recursiveStmt *tree.Select
+	if paren, ok := union.Right.(*tree.ParenSelect); ok {
+		recursiveStmt = paren.Select
+	} else {
+		recursiveStmt = &tree.Select{Select: union.Right}
+	}
+	cteRef.sinkScan = sinkScan
+	cteRef.sinkScanRefs = 0
+	recursiveID, err := builder.buildSelect(recursiveStmt, recursiveCtx, false)
+	cteRef.sinkScan = nil
+	if err != nil {
+		return 0, false, err
+	}
+
+	switch {
+	case cteRef.sinkScanRefs == 0:
+		return 0, false, nil
+	case cteRef.sinkScanRefs > 1:
+		return 0, false, moerr.NewCTERecursiveRequiresSingleReference(builder.GetContext(), name)
+	case len(recursiveCtx.groups) > 0 || len(recursiveCtx.aggregates) > 0 || len(recursiveCtx.windows) > 0:
+		return 0, false, moerr.NewCTERecursiveForbidsAggregation(builder.GetContext(), name)
+	case recursiveCtx.isDistinct || recursiveStmt.OrderBy != nil || recursiveStmt.Limit != nil:
+		return 0, false, moerr.NewNYI(builder.GetContext(), "DISTINCT, ORDER BY or LIMIT in recursive query block of CTE '%s'", name)
+	}
+
+	recursiveNode := builder.qry.Nodes[recursiveID]
+	if len(recursiveNode.ProjectList) != len(anchorNode.ProjectList) {
+		return 0, false, moerr.NewParseError(builder.GetContext(), "SELECT statements have different number of columns")
+	}
+
+	// the types of the CTE's columns are decided by the non-recursive part
+	for i, expr := range recursiveNode.ProjectList {
+		typ := anchorNode.ProjectList[i].Typ
+		if typ.Id == int32(types.T_any) || makeTypeByPlan2Expr(expr).Eq(makeTypeByPlan2Expr(anchorNode.ProjectList[i])) {
+			continue
+		}
+		recursiveNode.ProjectList[i], err = appendCastBeforeExpr(builder.GetContext(), expr, typ)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	// the outer query reads the CTE through ctx like a subquery
+	anchorTag := anchorNode.BindingTags[0]
+	cteTag := builder.genNewTag()
+	projectList := make([]*plan.Expr, len(anchorNode.ProjectList))
+	for i, expr := range anchorNode.ProjectList {
+		projectList[i] = &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: anchorTag,
+					ColPos: int32(i),
+				},
+			},
+		}
+		builder.nameByColRef[[2]int32{cteTag, int32(i)}] = sinkScan.Cols[i].Name
+	}
+
+	nodeID = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_RECURSIVE_CTE,
+		Children:    []int32{anchorID, recursiveID},
+		BindingTags: []int32{cteTag},
+		ProjectList: projectList,
+		RecursiveCte: &plan.RecursiveCte{
+			MaxDepth: builder.getCTEMaxRecursionDepth(),
+			Distinct: !union.All,
+		},
+	}, ctx)
+
+	ctx.headings = append(ctx.headings, anchorCtx.headings...)
+	ctx.groupTag = builder.genNewTag()
+	ctx.aggregateTag = builder.genNewTag()
+	ctx.projectTag = builder.genNewTag()
+	for i, v := range ctx.headings {
+		ctx.aliasMap[v] = int32(i)
+		builder.nameByColRef[[2]int32{ctx.projectTag, int32(i)}] = v
+	}
+	for i, expr := range projectList {
+		ctx.projects = append(ctx.projects, &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: cteTag,
+					ColPos: int32(i),
+				},
+			},
+		})
+	}
+	ctx.results = ctx.projects
+
+	nodeID = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: ctx.projects,
+		Children:    []int32{nodeID},
+		BindingTags: []int32{ctx.projectTag},
+	}, ctx)
+
+	return nodeID, true, nil
+}
+
+func (builder *QueryBuilder) getCTEMaxRecursionDepth() int64 {
+	val, err := builder.compCtx.ResolveVariable("cte_max_recursion_depth", true, false)
+	if err != nil {
+		return DefaultCTEMaxRecursionDepth
+	}
+	switch v := val.(type) {
+	case int64:
+		return v
+	case uint64:
+		return int64(v)
+	default:
+		return DefaultCTEMaxRecursionDepth
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	// RuntimeFilterBuildLimit is the most rows of the build side estimated to build the runtime filters
+	RuntimeFilterBuildLimit = 1 << 20
+	// RuntimeFilterInLimit is the most distinct keys of an IN list runtime filter
+	RuntimeFilterInLimit = 1024
+)
+
+// The hash build of an inner or semi join sends a runtime filter built from its keys to the scan
+// of the table on the probe side, which skips the blocks and the rows can't be joined. The scan
+// must be on the left spine of the join, it runs in the same pipelines as the hash build on every
+// CN, and the scan waits for the filter before reading anything.
+
+// generateRuntimeFilters is called after the column references are remapped, the runtime filter
+// specs refer to the columns the same as the pipelines.
+func (builder *QueryBuilder) generateRuntimeFilters(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	// the joins of a recursive CTE build their hashmaps again in every iteration
+	if node.NodeType == plan.Node_RECURSIVE_CTE {
+		return
+	}
+	for _, childID := range node.Children {
+		builder.generateRuntimeFilters(childID)
+	}
+
+	if node.NodeType != plan.Node_JOIN || node.NestedLoopJoin || !IsEquiJoin(node.OnList) {
+		return
+	}
+	if node.JoinType != plan.Node_INNER && node.JoinType != plan.Node_SEMI {
+		return
+	}
+	build := builder.qry.Nodes[node.Children[1]]
+	if build.Stats == nil || build.Stats.Outcnt > RuntimeFilterBuildLimit {
+		return
+	}
+
+	for _, expr := range node.OnList {
+		f, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || !SupportedJoinCondition(f.F.Func.GetObj()) {
+			continue
+		}
+		probeExpr, buildExpr := f.F.Args[0], f.F.Args[1]
+		if HasColExpr(probeExpr, -1) == 1 {
+			probeExpr, buildExpr = buildExpr, probeExpr
+		}
+		if HasColExpr(probeExpr, 0) != 0 || HasColExpr(buildExpr, 1) != 1 {
+			continue
+		}
+		if probeExpr.Typ.Id != buildExpr.Typ.Id || probeExpr.Typ.Scale != buildExpr.Typ.Scale || !runtimeFilterSupported(types.T(probeExpr.Typ.Id)) {
+			continue
+		}
+		scan, col := builder.runtimeFilterProbe(node.Children[0], probeExpr)
+		if scan == nil || scan.Stats == nil || scan.Stats.Outcnt <= build.Stats.Outcnt {
+			continue
+		}
+
+		tag := builder.genNewTag()
+		node.RuntimeFilterBuildList = append(node.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{
+			Tag:        tag,
+			Expr:       DeepCopyExpr(buildExpr),
+			UpperLimit: RuntimeFilterInLimit,
+		})
+		scan.RuntimeFilterProbeList = append(scan.RuntimeFilterProbeList, &plan.RuntimeFilterSpec{
+			Tag:  tag,
+			Expr: col,
+		})
+	}
+}
+
+// runtimeFilterProbe returns the table scan on the left spine whose column is the expr, the
+// column references are followed through the project lists. The column returned is named by
+// the attribute of the table.
+func (builder *QueryBuilder) runtimeFilterProbe(nodeID int32, expr *plan.Expr) (*plan.Node, *plan.Expr) {
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok || col.Col.RelPos != 0 {
+		return nil, nil
+	}
+	node := builder.qry.Nodes[nodeID]
+	if int(col.Col.ColPos) >= len(node.ProjectList) {
+		return nil, nil
+	}
+	projected, ok := node.ProjectList[col.Col.ColPos].Expr.(*plan.Expr_Col)
+	if !ok {
+		return nil, nil
+	}
+
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN:
+		if int(projected.Col.ColPos) >= len(node.TableDef.Cols) {
+			return nil, nil
+		}
+		probe := DeepCopyExpr(node.ProjectList[col.Col.ColPos])
+		probe.GetCol().Name = node.TableDef.Cols[projected.Col.ColPos].Name
+		return node, probe
+	case plan.Node_FILTER, plan.Node_PROJECT:
+		return builder.runtimeFilterProbe(node.Children[0], node.ProjectList[col.Col.ColPos])
+	case plan.Node_JOIN:
+		// the right join is run by buckets, its probe side is not in the same pipelines
+		if node.JoinType == plan.Node_RIGHT {
+			return nil, nil
+		}
+		return builder.runtimeFilterProbe(node.Children[0], node.ProjectList[col.Col.ColPos])
+	}
+	return nil, nil
+}
+
+func runtimeFilterSupported(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+		types.T_char, types.T_varchar, types.T_text, types.T_uuid:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+// findNodes returns the nodes of the type in the plan, the nodes replaced while optimizing are
+// still in qry.Nodes but not in the plan.
+func findNodes(qry *plan.Query, typ plan.Node_NodeType) []*plan.Node {
+	var nodes []*plan.Node
+	var visit func(nodeID int32)
+	visit = func(nodeID int32) {
+		node := qry.Nodes[nodeID]
+		if node.NodeType == typ {
+			nodes = append(nodes, node)
+		}
+		for _, childID := range node.Children {
+			visit(childID)
+		}
+	}
+	for _, step := range qry.Steps {
+		visit(step)
+	}
+	return nodes
+}
+
+func TestRuntimeFilter(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	qry := buildQueryForTest(t, mock, "select s_name from supplier s, nation n where s.s_nationkey = n.n_nationkey and n.n_name = 'CHINA'")
+	joins := findNodes(qry, plan.Node_JOIN)
+	require.Equal(t, 1, len(joins))
+	require.Equal(t, 1, len(joins[0].RuntimeFilterBuildList))
+	build := joins[0].RuntimeFilterBuildList[0]
+	require.Equal(t, int32(RuntimeFilterInLimit), build.UpperLimit)
+
+	var probes []*plan.RuntimeFilterSpec
+	for _, scan := range findNodes(qry, plan.Node_TABLE_SCAN) {
+		if len(scan.RuntimeFilterProbeList) > 0 {
+			require.Equal(t, "supplier", scan.TableDef.Name)
+			probes = append(probes, scan.RuntimeFilterProbeList...)
+		}
+	}
+	require.Equal(t, 1, len(probes))
+	require.Equal(t, build.Tag, probes[0].Tag)
+	require.Equal(t, "s_nationkey", probes[0].Expr.GetCol().GetName())
+
+	// the filters are pushed through the joins on the left spine
+	qry = buildQueryForTest(t, mock, "select /*+ JOIN_ORDER(l, s, n) */ l_orderkey from lineitem l, supplier s, nation n "+
+		"where l.l_suppkey = s.s_suppkey and s.s_nationkey = n.n_nationkey and n.n_name = 'CHINA'")
+	tables := make(map[string]int)
+	for _, scan := range findNodes(qry, plan.Node_TABLE_SCAN) {
+		tables[scan.TableDef.Name] += len(scan.RuntimeFilterProbeList)
+	}
+	require.Equal(t, 1, tables["lineitem"])
+	require.Equal(t, 0, tables["nation"])
+
+	// no filters for the outer joins and the nested loop joins
+	for _, sql := range []string{
+		"select s_name from supplier s left join nation n on s.s_nationkey = n.n_nationkey",
+		"select /*+ NO_HASH_JOIN(s, n) */ s_name from supplier s, nation n where s.s_nationkey = n.n_nationkey",
+	} {
+		qry = buildQueryForTest(t, mock, sql)
+		for _, node := range append(findNodes(qry, plan.Node_JOIN), findNodes(qry, plan.Node_TABLE_SCAN)...) {
+			require.Empty(t, node.RuntimeFilterBuildList, sql)
+			require.Empty(t, node.RuntimeFilterProbeList, sql)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
+)
+
+// buildSnapshotTs evaluates the AS OF clause of the table reference to the snapshot
+// timestamp the table is read at.
+func (builder *QueryBuilder) buildSnapshotTs(asOf *tree.AsOfClause) (*timestamp.Timestamp, error) {
+	var ts timestamp.Timestamp
+	ctx := builder.GetContext()
+
+	switch asOf.Type {
+	case tree.AsOfTS:
+		numVal, ok := asOf.Expr.(*tree.NumVal)
+		if !ok {
+			return nil, moerr.NewInvalidInput(ctx, "AS OF TS requires a string like '<physical>-<logical>'")
+		}
+		var err error
+		if ts, err = timestamp.ParseTimestamp(numVal.String()); err != nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid AS OF TS '%s'", numVal.String())
+		}
+
+	default:
+		typ := makePlan2Type(&types.Type{Oid: types.T_timestamp, Scale: 6})
+		binder := NewDefaultBinder(ctx, builder, nil, typ, []string{})
+		expr, err := binder.BindExpr(asOf.Expr, 0, true)
+		if err != nil {
+			return nil, err
+		}
+		if expr, err = makePlan2CastExpr(ctx, expr, typ); err != nil {
+			return nil, err
+		}
+		if !isSnapshotExpr(expr) {
+			return nil, moerr.NewInvalidInput(ctx, "AS OF TIMESTAMP requires a constant timestamp")
+		}
+		// evaluate it once, now() is not folded by the constant folding
+		proc := builder.compCtx.GetProcess()
+		bat := batch.NewWithSize(0)
+		bat.Zs = []int64{1}
+		vec, err := colexec.EvalExpr(bat, proc, expr)
+		if err != nil {
+			return nil, err
+		}
+		c := rule.GetConstantValue(vec, false)
+		vec.Free(proc.Mp())
+		if c == nil || c.Isnull {
+			return nil, moerr.NewInvalidInput(ctx, "AS OF TIMESTAMP requires a constant timestamp")
+		}
+		val, ok := c.Value.(*plan.Const_Timestampval)
+		if !ok {
+			return nil, moerr.NewInvalidInput(ctx, "AS OF TIMESTAMP requires a constant timestamp")
+		}
+		// the timestamp is the microseconds since 0001-01-01 in UTC
+		micros := val.Timestampval - int64(types.UnixMicroToTimestamp(0))
+		if micros > time.Now().UnixMicro() {
+			return nil, moerr.NewInvalidInput(ctx, "AS OF snapshot %s is in the future", types.Timestamp(val.Timestampval).String2(time.UTC, 6))
+		}
+		ts = timestamp.Timestamp{PhysicalTime: micros * int64(time.Microsecond)}
+	}
+
+	if ts.IsEmpty() {
+		return nil, moerr.NewInvalidInput(ctx, "invalid AS OF snapshot")
+	}
+	if ts.PhysicalTime > time.Now().UnixNano() {
+		return nil, moerr.NewInvalidInput(ctx, "AS OF snapshot %s is in the future", ts.DebugString())
+	}
+	return &ts, nil
+}
+
+// isSnapshotExpr returns true if the expr can be evaluated without any input
+func isSnapshotExpr(expr *plan.Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C, *plan.Expr_T:
+		return true
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			if !isSnapshotExpr(arg) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
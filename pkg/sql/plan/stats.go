@@ -46,6 +46,10 @@ type StatsInfoMap struct {
 	BlockNumber int //detect if block number changes , update stats info map
 	TableCnt    float64
 	tableName   string
+	// ColumnStats is collected by ANALYZE TABLE, nil if it is not loaded yet
+	ColumnStats map[string]*ColumnStats
+	// ColumnStatsVersion is the version of the statistics of the table when ColumnStats is loaded
+	ColumnStatsVersion uint64
 }
 
 func NewStatsInfoMap() *StatsInfoMap {
@@ -104,6 +108,13 @@ func NewInfoFromZoneMap(lenCols, blockNumTotal int) *InfoFromZoneMap {
 func GetHighNDVColumns(s *StatsInfoMap, b *Binding) []int32 {
 	cols := make([]int32, 0)
 	for colName, ndv := range s.NdvMap {
+		if cs := s.ColumnStats[colName]; cs != nil {
+			//the analyzed ndv is more accurate than the one from zonemap
+			if notNull := cs.RowCount - cs.NullCount; notNull > 0 && cs.Ndv/notNull > 0.99 {
+				cols = append(cols, b.FindColumn(colName))
+			}
+			continue
+		}
 		if ndv/s.TableCnt > 0.99 {
 			cols = append(cols, b.FindColumn(colName))
 		}
@@ -138,13 +149,13 @@ func UpdateStatsInfoMap(info *InfoFromZoneMap, columns []int, blockNumTotal int,
 		case types.T_uint8:
 			s.MinValMap[colName] = float64(info.MinVal[i].(uint8))
 			s.MaxValMap[colName] = float64(info.MaxVal[i].(uint8))
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			s.MinValMap[colName] = float64(info.MinVal[i].(uint16))
 			s.MaxValMap[colName] = float64(info.MaxVal[i].(uint16))
 		case types.T_uint32:
 			s.MinValMap[colName] = float64(info.MinVal[i].(uint32))
 			s.MaxValMap[colName] = float64(info.MaxVal[i].(uint32))
-		case types.T_uint64:
+		case types.T_uint64, types.T_set:
 			s.MinValMap[colName] = float64(info.MinVal[i].(uint64))
 			s.MaxValMap[colName] = float64(info.MaxVal[i].(uint64))
 		case types.T_date:
@@ -183,26 +194,58 @@ func estimateOutCntBySortOrder(tableCnt, cost float64, sortOrder int) float64 {
 
 }
 
-func getExprNdv(expr *plan.Expr, ndvMap map[string]float64) float64 {
+func getExprNdv(expr *plan.Expr, s *StatsInfoMap) float64 {
 	switch exprImpl := expr.Expr.(type) {
 	case *plan.Expr_F:
 		funcName := exprImpl.F.Func.ObjName
 		switch funcName {
 		case "=", ">", ">=", "<=", "<":
 			//assume col is on the left side
-			return getExprNdv(exprImpl.F.Args[0], ndvMap)
+			return getExprNdv(exprImpl.F.Args[0], s)
 		case "year":
-			return getExprNdv(exprImpl.F.Args[0], ndvMap) / 365
+			return getExprNdv(exprImpl.F.Args[0], s) / 365
 		default:
 			return -1
 		}
 	case *plan.Expr_Col:
-		return ndvMap[exprImpl.Col.Name]
+		if cs := s.ColumnStats[exprImpl.Col.Name]; cs != nil {
+			return cs.Ndv
+		}
+		return s.NdvMap[exprImpl.Col.Name]
 	}
 	return -1
 }
 
-func estimateOutCntForEquality(expr *plan.Expr, sortKeyName string, tableCnt, cost float64, ndvMap map[string]float64) float64 {
+// estimateOutCntByColumnStats estimates the output lines of filters like col=1 or col>1 with the
+// statistics collected by ANALYZE TABLE, it returns -1 if there is no statistics for the filter.
+func estimateOutCntByColumnStats(expr *plan.Expr, tableCnt float64, s *StatsInfoMap) float64 {
+	ret, col, constExpr, funcName := CheckStrictFilter(expr)
+	if !ret || constExpr.Isnull {
+		return -1
+	}
+	cs := s.ColumnStats[col.Name]
+	if cs == nil {
+		return -1
+	}
+	key, val, ordered, ok := GetConstStatsKey(constExpr)
+	if !ok {
+		return -1
+	}
+	switch funcName {
+	case "=":
+		return cs.EqualSelectivity(key) * tableCnt
+	default:
+		if !ordered {
+			return -1
+		}
+		if sel := cs.RangeSelectivity(funcName, val); sel >= 0 {
+			return sel * tableCnt
+		}
+	}
+	return -1
+}
+
+func estimateOutCntForEquality(expr *plan.Expr, sortKeyName string, tableCnt, cost float64, s *StatsInfoMap) float64 {
 	// only filter like func(col)>1 , or (col=1) or (col=2) can estimate outcnt
 	// and only 1 colRef is allowd in the filter. otherwise, no good method to calculate
 	ret, col := CheckFilter(expr)
@@ -215,7 +258,10 @@ func estimateOutCntForEquality(expr *plan.Expr, sortKeyName string, tableCnt, co
 	if sortOrder != -1 {
 		return estimateOutCntBySortOrder(tableCnt, cost, sortOrder)
 	} else {
-		ndv := getExprNdv(expr, ndvMap)
+		if outcnt := estimateOutCntByColumnStats(expr, tableCnt, s); outcnt >= 0 {
+			return outcnt
+		}
+		ndv := getExprNdv(expr, s)
 		if ndv > 0 {
 			return tableCnt / ndv
 		}
@@ -246,6 +292,9 @@ func estimateOutCntForNonEquality(expr *plan.Expr, funcName, sortKeyName string,
 	if sortOrder != -1 {
 		return estimateOutCntBySortOrder(tableCnt, cost, sortOrder)
 	} else {
+		if outcnt := estimateOutCntByColumnStats(expr, tableCnt, s); outcnt >= 0 {
+			return outcnt
+		}
 		//check strict filter, otherwise can not estimate outcnt by min/max val
 		ret, col, constExpr, _ := CheckStrictFilter(expr)
 		if ret {
@@ -279,7 +328,7 @@ func EstimateOutCnt(expr *plan.Expr, sortKeyName string, tableCnt, cost float64,
 		funcName := exprImpl.F.Func.ObjName
 		switch funcName {
 		case "=":
-			outcnt = estimateOutCntForEquality(expr, sortKeyName, tableCnt, cost, s.NdvMap)
+			outcnt = estimateOutCntForEquality(expr, sortKeyName, tableCnt, cost, s)
 		case ">", "<", ">=", "<=":
 			//for filters like a>1, no good way to estimate, return 3 * equality
 			outcnt = estimateOutCntForNonEquality(expr, funcName, sortKeyName, tableCnt, cost, s)
@@ -364,11 +413,11 @@ func calcNdvUsingMinMax(minVal, maxVal any, t types.Type) float64 {
 		return float64(maxVal.(int64)-minVal.(int64)) + 1
 	case types.T_uint8:
 		return float64(maxVal.(uint8)-minVal.(uint8)) + 1
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return float64(maxVal.(uint16)-minVal.(uint16)) + 1
 	case types.T_uint32:
 		return float64(maxVal.(uint32)-minVal.(uint32)) + 1
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return float64(maxVal.(uint64)-minVal.(uint64)) + 1
 	case types.T_decimal64:
 		return types.Decimal64ToFloat64(maxVal.(types.Decimal64), t.Scale) - types.Decimal64ToFloat64(minVal.(types.Decimal64), t.Scale) + 1
@@ -581,6 +630,13 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool) {
 			Cost:        leftStats.Outcnt + rightStats.Outcnt,
 			Selectivity: 1,
 		}
+	case plan.Node_RECURSIVE_CTE:
+		// the number of iterations is unknown before execution
+		node.Stats = &plan.Stats{
+			Outcnt:      leftStats.Outcnt + rightStats.Outcnt,
+			Cost:        leftStats.Outcnt + rightStats.Outcnt,
+			Selectivity: 1,
+		}
 	case plan.Node_INTERSECT:
 		node.Stats = &plan.Stats{
 			Outcnt:      math.Min(leftStats.Outcnt, rightStats.Outcnt) * 0.5,
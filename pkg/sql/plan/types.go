@@ -57,9 +57,11 @@ type ClusterByDef = plan.ClusterByDef
 type OrderBySpec = plan.OrderBySpec
 type FkColName = plan.FkColName
 type ForeignKeyDef = plan.ForeignKeyDef
+type CheckDef = plan.CheckDef
 type ClusterTable = plan.ClusterTable
 type PrimaryKeyDef = plan.PrimaryKeyDef
 type IndexDef = plan.IndexDef
+type RuntimeFilterSpec = plan.RuntimeFilterSpec
 
 type CompilerContext interface {
 	// Default database/schema in context
@@ -150,12 +152,39 @@ type QueryBuilder struct {
 	nextTag int32
 
 	mysqlCompatible bool
+
+	// hints are the optimizer hints of the statement, see hints.go
+	hints      []*tree.OptimizerHint
+	rootClause *tree.SelectClause
+	// usedHints are the JOIN_ORDER hints already applied to a join
+	usedHints map[*tree.OptimizerHint]bool
+	// outline is the join orders decided, the plan is reproduced by them
+	outline tree.OptimizerHints
+	// tableByTag is the name or the alias of the tables and the derived tables
+	tableByTag map[int32]string
+	// indexHintsByNode is the index hints in FROM of the table scans
+	indexHintsByNode map[int32][]*tree.IndexHint
+	// jsonIndexKeys is the keys of the functional indexes found in WHERE of the table scans
+	jsonIndexKeys map[int32][]*jsonIndexKey
+	// isDML is set if the query reads the rows changed by INSERT, UPDATE or DELETE,
+	// the tables changed are read directly instead of through their indexes.
+	isDML bool
 }
 
 type CTERef struct {
 	defaultDatabase string
 	ast             *tree.CTE
 	maskedCTEs      map[string]any
+
+	// isRecursive is set for the CTEs of WITH RECURSIVE
+	isRecursive bool
+	// building is set while the query of a recursive CTE is being built,
+	// a reference to the CTE is only allowed in its recursive query block.
+	building bool
+	// sinkScan is set while the recursive query block is being built,
+	// references to the CTE there scan the rows produced by the previous iteration.
+	sinkScan     *TableDef
+	sinkScanRefs int
 }
 
 type BindContext struct {
@@ -169,16 +198,23 @@ type BindContext struct {
 
 	groupTag     int32
 	aggregateTag int32
+	windowTag    int32
 	projectTag   int32
 	resultTag    int32
 
 	groups     []*plan.Expr
 	aggregates []*plan.Expr
+	windows    []*plan.WindowSpec
 	projects   []*plan.Expr
 	results    []*plan.Expr
 
+	// groupingSets are the grouping ids of ROLLUP, CUBE and GROUPING SETS,
+	// the grouping id is the last one of groups.
+	groupingSets []int64
+
 	groupByAst     map[string]int32
 	aggregateByAst map[string]int32
+	windowByAst    map[string]int32
 	projectByExpr  map[string]int32
 
 	aliasMap map[string]int32
@@ -264,6 +300,7 @@ type HavingBinder struct {
 type ProjectionBinder struct {
 	baseBinder
 	havingBinder *HavingBinder
+	insideWin    bool
 }
 
 type OrderBinder struct {
@@ -279,6 +316,14 @@ type PartitionBinder struct {
 	baseBinder
 }
 
+type GeneratedBinder struct {
+	baseBinder
+}
+
+type CheckBinder struct {
+	baseBinder
+}
+
 var _ Binder = (*TableBinder)(nil)
 var _ Binder = (*WhereBinder)(nil)
 var _ Binder = (*GroupBinder)(nil)
@@ -286,6 +331,8 @@ var _ Binder = (*HavingBinder)(nil)
 var _ Binder = (*ProjectionBinder)(nil)
 var _ Binder = (*LimitBinder)(nil)
 var _ Binder = (*PartitionBinder)(nil)
+var _ Binder = (*GeneratedBinder)(nil)
+var _ Binder = (*CheckBinder)(nil)
 var _ Binder = (*UpdateBinder)(nil)
 
 var Sequence_cols_name = []string{"last_seq_num", "min_value", "max_value", "start_value", "increment_value", "cycle", "is_called"}
@@ -1227,7 +1227,7 @@ func InitInfileParam(param *tree.ExternParam) error {
 			param.CompressType = param.Option[i+1]
 		case "format":
 			format := strings.ToLower(param.Option[i+1])
-			if format != tree.CSV && format != tree.JSONLINE {
+			if format != tree.CSV && format != tree.JSONLINE && format != tree.PARQUET {
 				return moerr.NewBadConfig(param.Ctx, "the format '%s' is not supported", format)
 			}
 			param.Format = format
@@ -1248,10 +1248,21 @@ func InitInfileParam(param *tree.ExternParam) error {
 	if param.Format == tree.JSONLINE && len(param.JsonData) == 0 {
 		return moerr.NewBadConfig(param.Ctx, "the jsondata must be specified")
 	}
-	if len(param.Format) == 0 {
+	initFormat(param)
+	return nil
+}
+
+// initFormat sets the format of the files when it is not specified,
+// files with the .parquet suffix are read as parquet and the others as csv.
+func initFormat(param *tree.ExternParam) {
+	if len(param.Format) != 0 {
+		return
+	}
+	if strings.HasSuffix(strings.ToLower(param.Filepath), "."+tree.PARQUET) {
+		param.Format = tree.PARQUET
+	} else {
 		param.Format = tree.CSV
 	}
-	return nil
 }
 
 func InitS3Param(param *tree.ExternParam) error {
@@ -1280,7 +1291,7 @@ func InitS3Param(param *tree.ExternParam) error {
 			param.S3Param.ExternalId = param.Option[i+1]
 		case "format":
 			format := strings.ToLower(param.Option[i+1])
-			if format != tree.CSV && format != tree.JSONLINE {
+			if format != tree.CSV && format != tree.JSONLINE && format != tree.PARQUET {
 				return moerr.NewBadConfig(param.Ctx, "the format '%s' is not supported", format)
 			}
 			param.Format = format
@@ -1299,9 +1310,7 @@ func InitS3Param(param *tree.ExternParam) error {
 	if param.Format == tree.JSONLINE && len(param.JsonData) == 0 {
 		return moerr.NewBadConfig(param.Ctx, "the jsondata must be specified")
 	}
-	if len(param.Format) == 0 {
-		param.Format = tree.CSV
-	}
+	initFormat(param)
 	return nil
 }
 
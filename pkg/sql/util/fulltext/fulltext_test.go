@@ -0,0 +1,113 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizer(t *testing.T) {
+	tk, ok := GetTokenizer("")
+	require.True(t, ok)
+	require.Equal(t, []string{"error", "disk_full", "on", "node1"}, tk.Tokenize("ERROR: disk_full on node1!"))
+
+	tk, ok = GetTokenizer("NGRAM")
+	require.True(t, ok)
+	require.Equal(t, []string{"数据", "据库", "mo", "中"}, tk.Tokenize("数据库 MO中"))
+
+	_, ok = GetTokenizer("unknown")
+	require.False(t, ok)
+}
+
+func TestNaturalLanguageMode(t *testing.T) {
+	ctx := context.Background()
+	tk, _ := GetTokenizer(DefaultParser)
+	q, err := ParseQuery(ctx, "disk error", NaturalLanguageMode, tk)
+	require.NoError(t, err)
+
+	words, prefixes := q.Candidates()
+	require.Equal(t, []string{"disk", "error"}, words)
+	require.Empty(t, prefixes)
+
+	require.Equal(t, 0.0, q.Score(tk.Tokenize("all good")))
+	one := q.Score(tk.Tokenize("disk is full"))
+	two := q.Score(tk.Tokenize("disk error"))
+	require.Greater(t, one, 0.0)
+	require.Greater(t, two, one)
+	require.Greater(t, q.Score(tk.Tokenize("disk disk")), one)
+}
+
+func TestBooleanMode(t *testing.T) {
+	ctx := context.Background()
+	tk, _ := GetTokenizer(DefaultParser)
+
+	q, err := ParseQuery(ctx, `+disk -warn err* "no space"`, BooleanMode, tk)
+	require.NoError(t, err)
+	words, prefixes := q.Candidates()
+	require.Equal(t, []string{"disk"}, words)
+	require.Empty(t, prefixes)
+
+	require.Equal(t, 0.0, q.Score(tk.Tokenize("error no space")))
+	require.Equal(t, 0.0, q.Score(tk.Tokenize("warn disk")))
+	require.Greater(t, q.Score(tk.Tokenize("disk")), 0.0)
+	require.Greater(t, q.Score(tk.Tokenize("disk errors, no space left")), q.Score(tk.Tokenize("disk space no")))
+
+	q, err = ParseQuery(ctx, `>disk <error ~info net*`, BooleanMode, tk)
+	require.NoError(t, err)
+	words, prefixes = q.Candidates()
+	require.Equal(t, []string{"disk", "error", "info"}, words)
+	require.Equal(t, []string{"net"}, prefixes)
+	require.Greater(t, q.Score(tk.Tokenize("disk")), q.Score(tk.Tokenize("error")))
+	require.Less(t, q.Score(tk.Tokenize("info")), 0.0)
+	require.Greater(t, q.Score(tk.Tokenize("network")), 0.0)
+	require.Equal(t, 0.0, q.Score(tk.Tokenize("other")))
+
+	_, err = ParseQuery(ctx, `+(disk error)`, BooleanMode, tk)
+	require.Error(t, err)
+	_, err = ParseQuery(ctx, `disk`, QueryExpansionMode, tk)
+	require.Error(t, err)
+}
+
+func TestDocFreqs(t *testing.T) {
+	ctx := context.Background()
+	tk, _ := GetTokenizer(DefaultParser)
+	q, err := ParseQuery(ctx, `disk "no space" err*`, BooleanMode, tk)
+	require.NoError(t, err)
+
+	keys, prefix := q.DocFreqKeys()
+	require.Equal(t, []string{"disk", "no", "err"}, keys)
+	require.Equal(t, []bool{false, false, true}, prefix)
+
+	before := q.Score(tk.Tokenize("disk"))
+	require.Equal(t, before, q.Score(tk.Tokenize("no space")))
+
+	// disk is in 90 of 100 documents, the phrase in 2 of them
+	q.SetDocFreqs(100, []int64{90, 2, 0})
+	require.Greater(t, q.Score(tk.Tokenize("no space")), q.Score(tk.Tokenize("disk")))
+	require.Greater(t, q.Score(tk.Tokenize("disk")), 0.0)
+	require.Greater(t, q.Score(tk.Tokenize("errors")), q.Score(tk.Tokenize("no space")))
+}
+
+func TestNgramPhrase(t *testing.T) {
+	ctx := context.Background()
+	tk, _ := GetTokenizer(NgramParser)
+	q, err := ParseQuery(ctx, `+数据库`, BooleanMode, tk)
+	require.NoError(t, err)
+	require.Greater(t, q.Score(tk.Tokenize("分布式数据库系统")), 0.0)
+	require.Equal(t, 0.0, q.Score(tk.Tokenize("数据和据库")))
+}
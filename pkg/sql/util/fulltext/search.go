@@ -0,0 +1,273 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"context"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+type SearchMode int64
+
+const (
+	NaturalLanguageMode SearchMode = iota
+	BooleanMode
+	QueryExpansionMode
+)
+
+type termOp int
+
+const (
+	opOptional termOp = iota
+	opRequired        // +word
+	opExcluded        // -word
+	opNegative        // ~word
+	opIncrease        // >word
+	opDecrease        // <word
+)
+
+// weights of the operators of the boolean mode, the excluded term has no weight.
+var termWeights = map[termOp]float64{
+	opOptional: 1,
+	opRequired: 1,
+	opNegative: -0.5,
+	opIncrease: 1.5,
+	opDecrease: 0.5,
+}
+
+// term is a word or a phrase of the query, the last word of it is a prefix
+// if prefix is true. idf is the inverse document frequency of the term, it is
+// 1 until the document frequencies are set.
+type term struct {
+	op     termOp
+	words  []string
+	prefix bool
+	idf    float64
+}
+
+// Query is the parsed search string of MATCH() AGAINST().
+type Query struct {
+	mode  SearchMode
+	terms []term
+}
+
+// ParseQuery parses the search string, the words of it are split by the
+// tokenizer of the fulltext index.
+//
+// In the natural language mode, every word of the search string is an optional term.
+// In the boolean mode, the search string is a list of the terms separated by spaces,
+// a term is a word or a double quoted phrase, and may start with one of the operators
+// + - ~ < >, a word ending with '*' matches the words starting with it.
+func ParseQuery(ctx context.Context, pattern string, mode SearchMode, t Tokenizer) (*Query, error) {
+	q := &Query{mode: mode}
+	switch mode {
+	case NaturalLanguageMode:
+		seen := make(map[string]bool)
+		for _, word := range t.Tokenize(pattern) {
+			if !seen[word] {
+				seen[word] = true
+				q.terms = append(q.terms, term{op: opOptional, words: []string{word}, idf: 1})
+			}
+		}
+	case BooleanMode:
+		if err := q.parseBoolean(ctx, pattern, t); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, moerr.NewNotSupported(ctx, "fulltext search with query expansion")
+	}
+	return q, nil
+}
+
+func (q *Query) parseBoolean(ctx context.Context, pattern string, t Tokenizer) error {
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+		op := opOptional
+		for i < len(runes) && strings.ContainsRune("+-~<>", runes[i]) {
+			switch runes[i] {
+			case '+':
+				op = opRequired
+			case '-':
+				op = opExcluded
+			case '~':
+				op = opNegative
+			case '>':
+				op = opIncrease
+			case '<':
+				op = opDecrease
+			}
+			i++
+		}
+		if i == len(runes) {
+			break
+		}
+
+		var text string
+		prefix := false
+		switch runes[i] {
+		case '(', ')':
+			return moerr.NewNotSupported(ctx, "parentheses in the fulltext search string of boolean mode")
+		case '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			text = string(runes[i+1 : end])
+			i = end + 1
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune("\"()", runes[end]) {
+				end++
+			}
+			text = string(runes[i:end])
+			i = end
+			if strings.HasSuffix(text, "*") {
+				text = strings.TrimRight(text, "*")
+				prefix = true
+			}
+		}
+		if words := t.Tokenize(text); len(words) > 0 {
+			q.terms = append(q.terms, term{op: op, words: words, prefix: prefix, idf: 1})
+		}
+	}
+	return nil
+}
+
+// Candidates returns the words and the word prefixes of which every document
+// matched by the query contains at least one. Both are empty if the query
+// matches no document.
+func (q *Query) Candidates() (words []string, prefixes []string) {
+	add := func(t term) {
+		if t.prefix && len(t.words) == 1 {
+			prefixes = append(prefixes, t.words[0])
+		} else {
+			words = append(words, t.words[0])
+		}
+	}
+	for _, t := range q.terms {
+		if t.op == opRequired {
+			add(t)
+			return
+		}
+	}
+	for _, t := range q.terms {
+		if t.op != opExcluded {
+			add(t)
+		}
+	}
+	return
+}
+
+// DocFreqKeys returns the word looked up in the fulltext index for every term of
+// the query, to count the documents containing the term. The word is a prefix if
+// prefix[i] is true, and a phrase is looked up by its first word.
+func (q *Query) DocFreqKeys() (keys []string, prefix []bool) {
+	keys = make([]string, len(q.terms))
+	prefix = make([]bool, len(q.terms))
+	for i, t := range q.terms {
+		keys[i] = t.words[0]
+		prefix[i] = t.prefix && len(t.words) == 1
+	}
+	return
+}
+
+// SetDocFreqs sets the inverse document frequency of the terms by the number of
+// the documents and the number of the documents containing each key returned by
+// DocFreqKeys, the idf of a term is 1 + ln(docCount / docFreq).
+func (q *Query) SetDocFreqs(docCount int64, docFreqs []int64) {
+	for i := range q.terms {
+		if i >= len(docFreqs) {
+			break
+		}
+		n, df := docCount, docFreqs[i]
+		if df < 1 {
+			df = 1
+		}
+		if n < df {
+			n = df
+		}
+		q.terms[i].idf = 1 + math.Log(float64(n)/float64(df))
+	}
+}
+
+// Score returns the relevance of the document split into words, 0 means the
+// document is not matched.
+//
+// A term found tf times in the document adds (1 + ln(tf)) * idf * weight to the
+// relevance, the weight is 1 in the natural language mode and depends on the
+// operator in the boolean mode, so the rare terms count more than the common ones. In the boolean mode, a document is matched if
+// it contains all the + terms and none of the - terms, and at least one term
+// if there is no + term.
+func (q *Query) Score(doc []string) float64 {
+	score := 0.0
+	matched := false
+	hasRequired := false
+	for _, t := range q.terms {
+		tf := t.count(doc)
+		switch t.op {
+		case opRequired:
+			hasRequired = true
+			if tf == 0 {
+				return 0
+			}
+		case opExcluded:
+			if tf > 0 {
+				return 0
+			}
+			continue
+		}
+		if tf > 0 {
+			matched = true
+			score += (1 + math.Log(float64(tf))) * t.idf * termWeights[t.op]
+		}
+	}
+	if !matched && !hasRequired {
+		return 0
+	}
+	return score
+}
+
+// count returns the number of the occurrences of the term in the document.
+func (t term) count(doc []string) int {
+	n := 0
+	for i := 0; i+len(t.words) <= len(doc); i++ {
+		if t.matchAt(doc, i) {
+			n++
+		}
+	}
+	return n
+}
+
+func (t term) matchAt(doc []string, i int) bool {
+	last := len(t.words) - 1
+	for j, word := range t.words {
+		if j == last && t.prefix {
+			if !strings.HasPrefix(doc[i+j], word) {
+				return false
+			}
+		} else if doc[i+j] != word {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultParser splits the text into words by the characters which are
+	// not letters, digits or '_'.
+	DefaultParser = "default"
+	// NgramParser splits the CJK characters into ngrams of NgramTokenSize
+	// characters, the other characters are split like DefaultParser.
+	NgramParser = "ngram"
+
+	NgramTokenSize = 2
+)
+
+// Tokenizer splits a text into the words which are indexed and searched,
+// the words are lower case.
+type Tokenizer interface {
+	Tokenize(text string) []string
+}
+
+var tokenizers = struct {
+	sync.RWMutex
+	m map[string]Tokenizer
+}{
+	m: map[string]Tokenizer{
+		DefaultParser: defaultTokenizer{},
+		NgramParser:   ngramTokenizer{n: NgramTokenSize},
+	},
+}
+
+// RegisterTokenizer registers the tokenizer which is used by the fulltext
+// index created WITH PARSER name.
+func RegisterTokenizer(name string, t Tokenizer) {
+	tokenizers.Lock()
+	defer tokenizers.Unlock()
+	tokenizers.m[strings.ToLower(name)] = t
+}
+
+// GetTokenizer returns the tokenizer registered as name, the empty name is
+// the default tokenizer.
+func GetTokenizer(name string) (Tokenizer, bool) {
+	if name == "" {
+		name = DefaultParser
+	}
+	tokenizers.RLock()
+	defer tokenizers.RUnlock()
+	t, ok := tokenizers.m[strings.ToLower(name)]
+	return t, ok
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// isCJK reports whether r is a character of the languages which do not
+// separate the words by spaces.
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+type defaultTokenizer struct{}
+
+func (defaultTokenizer) Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !isWordRune(r)
+	})
+}
+
+type ngramTokenizer struct {
+	n int
+}
+
+func (t ngramTokenizer) Tokenize(text string) []string {
+	var words []string
+	for _, field := range (defaultTokenizer{}).Tokenize(text) {
+		start := 0
+		for start < len(field) {
+			r, _ := utf8.DecodeRuneInString(field[start:])
+			end := start
+			cjk := isCJK(r)
+			for end < len(field) {
+				r, size := utf8.DecodeRuneInString(field[end:])
+				if isCJK(r) != cjk {
+					break
+				}
+				end += size
+			}
+			if cjk {
+				words = append(words, t.ngrams(field[start:end])...)
+			} else {
+				words = append(words, field[start:end])
+			}
+			start = end
+		}
+	}
+	return words
+}
+
+// ngrams returns the ngrams of the CJK characters, the text shorter than n is
+// a single ngram.
+func (t ngramTokenizer) ngrams(text string) []string {
+	runes := []rune(text)
+	if len(runes) <= t.n {
+		return []string{text}
+	}
+	words := make([]string, 0, len(runes)-t.n+1)
+	for i := 0; i+t.n <= len(runes); i++ {
+		words = append(words, string(runes[i:i+t.n]))
+	}
+	return words
+}
@@ -21,9 +21,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/builtin/unary"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/operator"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -47,6 +52,161 @@ func BuildIndexTableName(ctx context.Context, unique bool) (string, error) {
 	return name, nil
 }
 
+// BuildFullTextIndexTableName returns the name of the index table which stores
+// the words of a fulltext index.
+func BuildFullTextIndexTableName(ctx context.Context) (string, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return "", moerr.NewInternalError(ctx, "newuuid failed")
+	}
+	return catalog.PrefixIndexTableName + "fulltext_" + id.String(), nil
+}
+
+// BuildFullTextIndexBatch splits the parts of every row into words by the tokenizer,
+// and returns the batch of (word, primary key) to be written into the fulltext index table.
+// A word appears only once for each row, and the null parts are ignored.
+func BuildFullTextIndexBatch(parts []*vector.Vector, pkVec *vector.Vector, tokenizer fulltext.Tokenizer, proc *process.Process) (*batch.Batch, error) {
+	b := batch.New(true, []string{catalog.IndexTableIndexColName, catalog.IndexTablePrimaryColName})
+	b.Vecs[0] = vector.NewVec(types.T_varchar.ToType())
+	b.Vecs[1] = vector.NewVec(*pkVec.GetType())
+
+	for i := 0; i < pkVec.Length(); i++ {
+		seen := make(map[string]struct{})
+		for _, vec := range parts {
+			if vec.IsConstNull() || (!vec.IsConst() && vec.GetNulls().Contains(uint64(i))) {
+				continue
+			}
+			for _, word := range tokenizer.Tokenize(vec.GetStringAt(i)) {
+				if _, ok := seen[word]; ok {
+					continue
+				}
+				seen[word] = struct{}{}
+				if err := vector.AppendBytes(b.Vecs[0], []byte(word), false, proc.Mp()); err != nil {
+					b.Clean(proc.Mp())
+					return nil, err
+				}
+				if err := b.Vecs[1].UnionOne(pkVec, int64(i), proc.Mp()); err != nil {
+					b.Clean(proc.Mp())
+					return nil, err
+				}
+			}
+		}
+	}
+	b.SetZs(b.Vecs[0].Length(), proc.Mp())
+	return b, nil
+}
+
+// BuildJsonIndexBatch looks up the json path of the functional index in every row, and returns the batch
+// of (value, primary key) to be written into the index table. The values are unquoted if the key part of
+// the index is CAST(doc->>'path' AS type), and cast to the key type by the functions of JSON_UNQUOTE and
+// CAST, so a key is the value the query computes from the row. For a multi-valued index every element of
+// the json array at the path is a value, and a value appears only once for each row. The json nulls and
+// the missing paths are not stored.
+func BuildJsonIndexBatch(vec *vector.Vector, pkVec *vector.Vector, indexDef *plan.IndexDef, proc *process.Process) (*batch.Batch, error) {
+	path, err := types.ParseStringToPath(indexDef.JsonPath)
+	if err != nil {
+		return nil, err
+	}
+	vals := vector.NewVec(types.T_json.ToType())
+	defer vals.Free(proc.Mp())
+	var rows []int64
+	for i := 0; i < pkVec.Length(); i++ {
+		if vec.IsConstNull() || (!vec.IsConst() && vec.GetNulls().Contains(uint64(i))) {
+			continue
+		}
+		bj := types.DecodeJson(vec.GetBytesAt(i))
+		var rowVals []bytejson.ByteJson
+		if indexDef.MultiValued {
+			for _, val := range bj.LookupAll(&path) {
+				rowVals = append(rowVals, val.ArrayElems()...)
+			}
+		} else if val, ok := bj.Lookup(&path); ok {
+			rowVals = append(rowVals, val)
+		}
+		seen := make(map[string]struct{})
+		for _, val := range rowVals {
+			if val.IsNull() {
+				continue
+			}
+			if _, ok := seen[val.String()]; ok {
+				continue
+			}
+			seen[val.String()] = struct{}{}
+			dt, err := types.EncodeJson(val)
+			if err != nil {
+				return nil, err
+			}
+			if err = vector.AppendBytes(vals, dt, false, proc.Mp()); err != nil {
+				return nil, err
+			}
+			rows = append(rows, int64(i))
+		}
+	}
+
+	keyType := types.New(types.T(indexDef.KeyType.Id), indexDef.KeyType.Width, indexDef.KeyType.Scale)
+	keys, err := castJsonIndexKeys(vals, keyType, indexDef.JsonUnquote, proc)
+	if err != nil {
+		return nil, moerr.NewInvalidInput(proc.Ctx, "invalid json value for functional index '%s': %s", indexDef.IndexName, err.Error())
+	}
+	b := batch.New(true, []string{catalog.IndexTableIndexColName, catalog.IndexTablePrimaryColName})
+	b.Vecs[0] = keys
+	b.Vecs[1] = vector.NewVec(*pkVec.GetType())
+	for _, row := range rows {
+		if err = b.Vecs[1].UnionOne(pkVec, row, proc.Mp()); err != nil {
+			b.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	b.SetZs(b.Vecs[0].Length(), proc.Mp())
+	return b, nil
+}
+
+// BuildSecondaryIndexBatch returns the batch of (key, primary key) to be written into the index
+// table of a KEY or INDEX secondary index, the key is the part or serial(parts...) like the key of
+// a unique index. The rows having a null part are ignored.
+func BuildSecondaryIndexBatch(parts []*vector.Vector, pkVec *vector.Vector, proc *process.Process) (*batch.Batch, error) {
+	b := batch.New(true, []string{catalog.IndexTableIndexColName, catalog.IndexTablePrimaryColName})
+	var bitMap *nulls.Nulls
+	if len(parts) == 1 {
+		b.Vecs[0], bitMap = compactSingleIndexCol(parts[0], proc)
+	} else {
+		b.Vecs[0], bitMap = serialWithCompacted(parts, proc)
+	}
+	b.Vecs[1] = compactPrimaryCol(pkVec, bitMap, proc)
+	b.SetZs(b.Vecs[0].Length(), proc.Mp())
+	return b, nil
+}
+
+// IsSecondaryIndexTable returns true if the index is a non-unique index having an index table, which
+// stores the rows (key, primary key) of the table: a KEY or INDEX secondary index of a table with
+// primary key, a fulltext index or a functional index on a json path.
+func IsSecondaryIndexTable(indexDef *plan.IndexDef) bool {
+	return !indexDef.Unique && indexDef.TableExist && indexDef.IndexTableName != ""
+}
+
+// castJsonIndexKeys computes CAST(JSON_UNQUOTE(vals) AS keyType), or CAST(vals AS keyType) if not unquote.
+func castJsonIndexKeys(vals *vector.Vector, keyType types.Type, unquote bool, proc *process.Process) (*vector.Vector, error) {
+	if unquote {
+		strs, err := unary.JsonUnquote([]*vector.Vector{vals}, proc)
+		if err != nil {
+			return nil, err
+		}
+		defer strs.Free(proc.Mp())
+		vals = strs
+	}
+	length := vals.Length()
+	toType := vector.NewConstNull(keyType, length, proc.Mp())
+	defer toType.Free(proc.Mp())
+	result := vector.NewFunctionResultWrapper(keyType, proc.Mp(), false, length)
+	if err := operator.NewCast([]*vector.Vector{vals, toType}, result, proc, length); err != nil {
+		result.Free()
+		return nil, err
+	}
+	keys := result.GetResultVector()
+	keys.SetLength(length)
+	return keys, nil
+}
+
 func BuildUniqueKeyBatch(vecs []*vector.Vector, attrs []string, parts []string, originTablePrimaryKey string, proc *process.Process) (*batch.Batch, int) {
 	var b *batch.Batch
 	if originTablePrimaryKey == "" {
@@ -184,7 +344,7 @@ func serialWithCompacted(vs []*vector.Vector, proc *process.Process) (*vector.Ve
 					ps[i].EncodeUint8(b)
 				}
 			}
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			s := vector.MustFixedCol[uint16](v)
 			for i, b := range s {
 				if nulls.Contains(v.GetNulls(), uint64(i)) {
@@ -202,7 +362,7 @@ func serialWithCompacted(vs []*vector.Vector, proc *process.Process) (*vector.Ve
 					ps[i].EncodeUint32(b)
 				}
 			}
-		case types.T_uint64:
+		case types.T_uint64, types.T_set:
 			s := vector.MustFixedCol[uint64](v)
 			for i, b := range s {
 				if nulls.Contains(v.GetNulls(), uint64(i)) {
@@ -372,7 +532,7 @@ func compactSingleIndexCol(v *vector.Vector, proc *process.Process) (*vector.Vec
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		s := vector.MustFixedCol[uint16](v)
 		ns := make([]uint16, 0, len(s)-nulls.Size(nsp))
 		for i, b := range s {
@@ -392,7 +552,7 @@ func compactSingleIndexCol(v *vector.Vector, proc *process.Process) (*vector.Vec
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		s := vector.MustFixedCol[uint64](v)
 		ns := make([]uint64, 0, len(s)-nulls.Size(nsp))
 		for i, b := range s {
@@ -560,7 +720,7 @@ func compactPrimaryCol(v *vector.Vector, bitMap *nulls.Nulls, proc *process.Proc
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		s := vector.MustFixedCol[uint16](v)
 		ns := make([]uint16, 0)
 		for i, b := range s {
@@ -580,7 +740,7 @@ func compactPrimaryCol(v *vector.Vector, bitMap *nulls.Nulls, proc *process.Proc
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		s := vector.MustFixedCol[uint64](v)
 		ns := make([]uint64, 0)
 		for i, b := range s {
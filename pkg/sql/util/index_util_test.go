@@ -22,7 +22,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/builtin/multi"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/operator"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 
@@ -139,3 +141,120 @@ func TestCompactUniqueKeyBatch(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildJsonIndexBatch(t *testing.T) {
+	proc := testutil.NewProcess()
+	docs := []string{`{"a":1,"b":[1,2,2]}`, ``, `{"a":null,"b":3}`, `{"b":[]}`, `{"a":"x"}`}
+	vec := vector.NewVec(types.T_json.ToType())
+	for _, doc := range docs {
+		if doc == "" {
+			require.NoError(t, vector.AppendBytes(vec, nil, true, proc.Mp()))
+			continue
+		}
+		bj, err := types.ParseStringToByteJson(doc)
+		require.NoError(t, err)
+		dt, err := types.EncodeJson(bj)
+		require.NoError(t, err)
+		require.NoError(t, vector.AppendBytes(vec, dt, false, proc.Mp()))
+	}
+	pkVec := testutil.NewVector(len(docs), types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3, 4, 5})
+
+	// every element of the array is stored once for each row, a non-array value is an array of one element
+	indexDef := &plan.IndexDef{
+		IndexName:   "idx_b",
+		JsonPath:    "$.b",
+		KeyType:     &plan.Type{Id: int32(types.T_int64)},
+		MultiValued: true,
+	}
+	b, err := BuildJsonIndexBatch(vec, pkVec, indexDef, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, vector.MustFixedCol[int64](b.Vecs[0]))
+	require.Equal(t, []int64{1, 1, 3}, vector.MustFixedCol[int64](b.Vecs[1]))
+	b.Clean(proc.Mp())
+
+	// the array can not be converted to the key type
+	indexDef.MultiValued = false
+	_, err = BuildJsonIndexBatch(vec, pkVec, indexDef, proc)
+	require.Error(t, err)
+
+	// the rows with a json null, a missing path or a null document are not stored,
+	// and the json strings are unquoted only for doc->>'path' as the query does
+	indexDef = &plan.IndexDef{
+		IndexName: "idx_a",
+		JsonPath:  "$.a",
+		KeyType:   &plan.Type{Id: int32(types.T_varchar), Width: 10},
+	}
+	b, err = BuildJsonIndexBatch(vec, pkVec, indexDef, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", `"x"`}, vector.MustStrCol(b.Vecs[0]))
+	require.Equal(t, []int64{1, 5}, vector.MustFixedCol[int64](b.Vecs[1]))
+	b.Clean(proc.Mp())
+	indexDef.JsonUnquote = true
+	b, err = BuildJsonIndexBatch(vec, pkVec, indexDef, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "x"}, vector.MustStrCol(b.Vecs[0]))
+	require.Equal(t, []int64{1, 5}, vector.MustFixedCol[int64](b.Vecs[1]))
+	b.Clean(proc.Mp())
+}
+
+func TestBuildJsonIndexBatchCast(t *testing.T) {
+	proc := testutil.NewProcess()
+	docs := []string{`{"a":1.005}`, `{"a":"2.5"}`, `{"a":true}`}
+	vec := vector.NewVec(types.T_json.ToType())
+	vals := vector.NewVec(types.T_json.ToType())
+	for _, doc := range docs {
+		bj, err := types.ParseStringToByteJson(doc)
+		require.NoError(t, err)
+		dt, err := types.EncodeJson(bj)
+		require.NoError(t, err)
+		require.NoError(t, vector.AppendBytes(vec, dt, false, proc.Mp()))
+		path, err := types.ParseStringToPath("$.a")
+		require.NoError(t, err)
+		val, _ := bj.Lookup(&path)
+		dt, err = types.EncodeJson(val)
+		require.NoError(t, err)
+		require.NoError(t, vector.AppendBytes(vals, dt, false, proc.Mp()))
+	}
+	pkVec := testutil.NewVector(len(docs), types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3})
+
+	// the keys are the values of CAST(doc->'$.a' AS DECIMAL(10, 2)) in a query
+	keyType := types.New(types.T_decimal64, 10, 2)
+	result := vector.NewFunctionResultWrapper(keyType, proc.Mp(), false, len(docs))
+	toType := vector.NewConstNull(keyType, len(docs), proc.Mp())
+	require.NoError(t, operator.NewCast([]*vector.Vector{vals, toType}, result, proc, len(docs)))
+	expected := result.GetResultVector()
+	expected.SetLength(len(docs))
+
+	indexDef := &plan.IndexDef{
+		IndexName: "idx_a",
+		JsonPath:  "$.a",
+		KeyType:   &plan.Type{Id: int32(types.T_decimal64), Width: 10, Scale: 2},
+	}
+	b, err := BuildJsonIndexBatch(vec, pkVec, indexDef, proc)
+	require.NoError(t, err)
+	require.Equal(t, vector.MustFixedCol[types.Decimal64](expected), vector.MustFixedCol[types.Decimal64](b.Vecs[0]))
+	require.Equal(t, []int64{1, 2, 3}, vector.MustFixedCol[int64](b.Vecs[1]))
+	b.Clean(proc.Mp())
+}
+
+func TestBuildSecondaryIndexBatch(t *testing.T) {
+	proc := testutil.NewProcess()
+	a := testutil.NewVector(4, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 1, 2, 2})
+	b := testutil.NewVector(4, types.T_int64.ToType(), proc.Mp(), false, []int64{3, 4, 5, 6})
+	nulls.Add(b.GetNulls(), 2)
+	pkVec := testutil.NewVector(4, types.T_int64.ToType(), proc.Mp(), false, []int64{10, 20, 30, 40})
+
+	// a key may be stored for many rows
+	bat, err := BuildSecondaryIndexBatch([]*vector.Vector{a}, pkVec, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 1, 2, 2}, vector.MustFixedCol[int64](bat.Vecs[0]))
+	require.Equal(t, []int64{10, 20, 30, 40}, vector.MustFixedCol[int64](bat.Vecs[1]))
+	bat.Clean(proc.Mp())
+
+	// the rows with a null part are not stored
+	bat, err = BuildSecondaryIndexBatch([]*vector.Vector{a, b}, pkVec, proc)
+	require.NoError(t, err)
+	require.Equal(t, 3, bat.Length())
+	require.Equal(t, []int64{10, 20, 40}, vector.MustFixedCol[int64](bat.Vecs[1]))
+	bat.Clean(proc.Mp())
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// AppendJsonValue converts the json value to the type of the vector and appends it,
+// a json string is unquoted, and a json number is rounded for the integer types.
+func AppendJsonValue(vec *vector.Vector, val bytejson.ByteJson, proc *process.Process) error {
+	typ := vec.GetType()
+	mp := proc.Mp()
+	if typ.Oid == types.T_json {
+		dt, err := types.EncodeJson(val)
+		if err != nil {
+			return err
+		}
+		return vector.AppendBytes(vec, dt, false, mp)
+	}
+	if typ.IsString() {
+		s := jsonScalarString(val)
+		if (typ.Oid == types.T_char || typ.Oid == types.T_varchar) && utf8.RuneCountInString(s) > int(typ.Width) {
+			return moerr.NewDataTruncatedNoCtx("json value", "value is too long for %s", typ.String())
+		}
+		return vector.AppendBytes(vec, []byte(s), false, mp)
+	}
+	if val.Type == bytejson.TpCodeObject || val.Type == bytejson.TpCodeArray {
+		return moerr.NewInvalidInputNoCtx("can not convert json %s to %s", val.String(), typ.String())
+	}
+	s := jsonScalarString(val)
+	switch typ.Oid {
+	case types.T_bool:
+		b, err := jsonToFloat(val, s)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, b != 0, false, mp)
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		f, err := jsonToFloat(val, s)
+		if err != nil {
+			return err
+		}
+		bits := typ.Size * 8
+		if f = math.Round(f); f < -math.Pow(2, float64(bits-1)) || f >= math.Pow(2, float64(bits-1)) {
+			return moerr.NewOutOfRangeNoCtx(typ.String(), "value '%s'", s)
+		}
+		switch typ.Oid {
+		case types.T_int8:
+			return vector.AppendFixed(vec, int8(f), false, mp)
+		case types.T_int16:
+			return vector.AppendFixed(vec, int16(f), false, mp)
+		case types.T_int32:
+			return vector.AppendFixed(vec, int32(f), false, mp)
+		}
+		if val.Type == bytejson.TpCodeInt64 {
+			return vector.AppendFixed(vec, val.GetInt64(), false, mp)
+		}
+		return vector.AppendFixed(vec, int64(f), false, mp)
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		f, err := jsonToFloat(val, s)
+		if err != nil {
+			return err
+		}
+		bits := typ.Size * 8
+		if f = math.Round(f); f < 0 || f >= math.Pow(2, float64(bits)) {
+			return moerr.NewOutOfRangeNoCtx(typ.String(), "value '%s'", s)
+		}
+		switch typ.Oid {
+		case types.T_uint8:
+			return vector.AppendFixed(vec, uint8(f), false, mp)
+		case types.T_uint16:
+			return vector.AppendFixed(vec, uint16(f), false, mp)
+		case types.T_uint32:
+			return vector.AppendFixed(vec, uint32(f), false, mp)
+		}
+		if val.Type == bytejson.TpCodeUint64 || val.Type == bytejson.TpCodeInt64 {
+			return vector.AppendFixed(vec, val.GetUint64(), false, mp)
+		}
+		return vector.AppendFixed(vec, uint64(f), false, mp)
+	case types.T_float32:
+		f, err := jsonToFloat(val, s)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, float32(f), false, mp)
+	case types.T_float64:
+		f, err := jsonToFloat(val, s)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, f, false, mp)
+	case types.T_decimal64:
+		d, err := types.ParseDecimal64(s, typ.Width, typ.Scale)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, d, false, mp)
+	case types.T_decimal128:
+		d, err := types.ParseDecimal128(s, typ.Width, typ.Scale)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, d, false, mp)
+	case types.T_date:
+		d, err := types.ParseDateCast(s)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, d, false, mp)
+	case types.T_datetime:
+		d, err := types.ParseDatetime(s, typ.Scale)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, d, false, mp)
+	case types.T_timestamp:
+		t, err := types.ParseTimestamp(proc.SessionInfo.TimeZone, s, typ.Scale)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, t, false, mp)
+	case types.T_time:
+		t, err := types.ParseTime(s, typ.Scale)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, t, false, mp)
+	case types.T_uuid:
+		u, err := types.ParseUuid(s)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, u, false, mp)
+	}
+	return moerr.NewNotSupportedNoCtx("convert json to %s", typ.String())
+}
+
+// jsonScalarString returns the string of the json value, a json string is unquoted
+func jsonScalarString(val bytejson.ByteJson) string {
+	if val.Type == bytejson.TpCodeString {
+		return string(val.GetString())
+	}
+	return val.String()
+}
+
+func jsonToFloat(val bytejson.ByteJson, s string) (float64, error) {
+	switch val.Type {
+	case bytejson.TpCodeInt64:
+		return float64(val.GetInt64()), nil
+	case bytejson.TpCodeUint64:
+		return float64(val.GetUint64()), nil
+	case bytejson.TpCodeFloat64:
+		return val.GetFloat64(), nil
+	case bytejson.TpCodeLiteral:
+		if s == "true" {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, moerr.NewInvalidInputNoCtx("can not convert '%s' to number", s)
+	}
+	return f, nil
+}
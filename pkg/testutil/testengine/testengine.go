@@ -35,6 +35,21 @@ func New(
 	eng engine.Engine,
 	client client.TxnClient,
 	compilerContext plan.CompilerContext,
+) {
+	e, client, txnOp, compilerContext := NewUnbound(ctx)
+	eng = e.Bind(txnOp)
+	return
+}
+
+// NewUnbound is like New, but the engine reads and writes in the txn passed to it instead of the
+// txn of the compiler context, which is returned as txnOp.
+func NewUnbound(
+	ctx context.Context,
+) (
+	e *memoryengine.Engine,
+	client client.TxnClient,
+	txnOp client.TxnOperator,
+	compilerContext plan.CompilerContext,
 ) {
 	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
 	ck := runtime.ProcessLevelRuntime().Clock()
@@ -70,7 +85,7 @@ func New(
 		},
 	)
 
-	e := memoryengine.New(
+	e = memoryengine.New(
 		ctx,
 		memoryengine.NewDefaultShardPolicy(
 			mpool.MustNewZeroNoFixed(),
@@ -79,18 +94,17 @@ func New(
 		clusterservice.GetMOCluster(),
 	)
 
-	txnOp, err := client.New()
+	txnOp, err = client.New()
 	if err != nil {
 		panic(err)
 	}
-	eng = e.Bind(txnOp)
 
-	err = eng.Create(ctx, "test", txnOp)
+	err = e.Create(ctx, "test", txnOp)
 	if err != nil {
 		panic(err)
 	}
 
-	db, err := eng.Database(ctx, "test", txnOp)
+	db, err := e.Database(ctx, "test", txnOp)
 	if err != nil {
 		panic(err)
 	}
@@ -114,7 +128,5 @@ func New(
 		panic(err)
 	}
 	compilerContext = e.NewCompilerContext(ctx, "test", txnOp)
-	eng = e.Bind(txnOp)
-
 	return
 }
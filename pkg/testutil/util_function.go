@@ -260,7 +260,7 @@ func (fc *FunctionTestCase) Run() (succeed bool, errInfo string) {
 					i+1, want, get)
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		r := vector.GenerateFunctionFixedTypeParameter[uint16](v)
 		s := vector.GenerateFunctionFixedTypeParameter[uint16](vExpected)
 		for i = 0; i < uint64(fc.fnLength); i++ {
@@ -296,7 +296,7 @@ func (fc *FunctionTestCase) Run() (succeed bool, errInfo string) {
 					i+1, want, get)
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		r := vector.GenerateFunctionFixedTypeParameter[uint64](v)
 		s := vector.GenerateFunctionFixedTypeParameter[uint64](vExpected)
 		for i = 0; i < uint64(fc.fnLength); i++ {
@@ -597,13 +597,13 @@ func newVectorByType(mp *mpool.MPool, typ types.Type, val any, nsp *nulls.Nulls)
 	case types.T_uint8:
 		values := val.([]uint8)
 		vector.AppendFixedList(vec, values, nil, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		values := val.([]uint16)
 		vector.AppendFixedList(vec, values, nil, mp)
 	case types.T_uint32:
 		values := val.([]uint32)
 		vector.AppendFixedList(vec, values, nil, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		values := val.([]uint64)
 		vector.AppendFixedList(vec, values, nil, mp)
 	case types.T_float32:
@@ -340,6 +340,22 @@ func (a *AttributeRow) AttrByName(handler *MemHandler, tx *Transaction, name str
 		ret.Value = a.ID.ToRowID()
 	case catalog.SystemColAttr_IsClusterBy:
 		ret.Value = boolToInt8(a.ClusterBy)
+	case catalog.SystemColAttr_EnumValues:
+		ret.Value = []byte(a.EnumValues)
+	case catalog.SystemColAttr_Generated:
+		if a.Generated != nil {
+			expr, err := types.Encode(a.Generated)
+			if err != nil {
+				return ret, nil
+			}
+			ret.Value = expr
+		} else {
+			ret.Value = []byte("")
+		}
+	case catalog.SystemColAttr_CompressAlg:
+		ret.Value = int8(a.Alg)
+	case catalog.SystemColAttr_CompressLevel:
+		ret.Value = int8(a.CompressLevel)
 	default:
 		panic(fmt.Sprintf("fixme: %s", name))
 	}
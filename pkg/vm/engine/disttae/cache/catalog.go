@@ -299,6 +299,10 @@ func (cc *CatalogCache) InsertColumns(bat *batch.Batch) {
 	updateExprs := vector.MustBytesCol(bat.GetVector(catalog.MO_COLUMNS_ATT_UPDATE_IDX + MO_OFF))
 	nums := vector.MustFixedCol[int32](bat.GetVector(catalog.MO_COLUMNS_ATTNUM_IDX + MO_OFF))
 	clusters := vector.MustFixedCol[int8](bat.GetVector(catalog.MO_COLUMNS_ATT_IS_CLUSTERBY + MO_OFF))
+	enumValues := vector.MustStrCol(bat.GetVector(catalog.MO_COLUMNS_ATT_ENUM_IDX + MO_OFF))
+	generateds := vector.MustBytesCol(bat.GetVector(catalog.MO_COLUMNS_ATT_GENERATED_IDX + MO_OFF))
+	compressAlgs := vector.MustFixedCol[int8](bat.GetVector(catalog.MO_COLUMNS_ATT_COMPRESS_ALG_IDX + MO_OFF))
+	compressLevels := vector.MustFixedCol[int8](bat.GetVector(catalog.MO_COLUMNS_ATT_COMPRESS_LEVEL_IDX + MO_OFF))
 	for i, account := range accounts {
 		key.AccountId = account
 		key.Name = tableNames[i]
@@ -321,10 +325,14 @@ func (cc *CatalogCache) InsertColumns(bat *batch.Batch) {
 				hasUpdate:       hasUpdates[i],
 				constraintType:  constraintTypes[i],
 				isClusterBy:     clusters[i],
+				enumValues:      enumValues[i],
+				compressAlg:     compressAlgs[i],
+				compressLevel:   compressLevels[i],
 			}
 			col.typ = append(col.typ, typs[i]...)
 			col.updateExpr = append(col.updateExpr, updateExprs[i]...)
 			col.defaultExpr = append(col.defaultExpr, defaultExprs[i]...)
+			col.generated = append(col.generated, generateds[i]...)
 			mp[tblKey] = append(mp[tblKey], col)
 		}
 	}
@@ -384,11 +392,13 @@ func genTableDefOfColumn(col column) engine.TableDef {
 
 	attr.Name = col.name
 	attr.ID = uint64(col.num)
-	attr.Alg = compress.Lz4
+	attr.Alg = compress.T(col.compressAlg)
+	attr.CompressLevel = int32(col.compressLevel)
 	attr.Comment = col.comment
 	attr.IsHidden = col.isHidden == 1
 	attr.ClusterBy = col.isClusterBy == 1
 	attr.AutoIncrement = col.isAutoIncrement == 1
+	attr.EnumValues = col.enumValues
 	if err := types.Decode(col.typ, &attr.Type); err != nil {
 		panic(err)
 	}
@@ -404,6 +414,12 @@ func genTableDefOfColumn(col column) engine.TableDef {
 			panic(err)
 		}
 	}
+	if len(col.generated) > 0 {
+		attr.Generated = new(plan.GeneratedCol)
+		if err := types.Decode(col.generated, attr.Generated); err != nil {
+			panic(err)
+		}
+	}
 	if col.constraintType == catalog.SystemColPKConstraint {
 		attr.Primary = true
 	}
@@ -423,15 +439,19 @@ func getTableDef(name string, defs []engine.TableDef) *plan.TableDef {
 				ColId: attr.Attr.ID,
 				Name:  attr.Attr.Name,
 				Typ: &plan.Type{
-					Id:       int32(attr.Attr.Type.Oid),
-					Width:    attr.Attr.Type.Width,
-					Scale:    attr.Attr.Type.Scale,
-					AutoIncr: attr.Attr.AutoIncrement,
+					Id:         int32(attr.Attr.Type.Oid),
+					Width:      attr.Attr.Type.Width,
+					Scale:      attr.Attr.Type.Scale,
+					AutoIncr:   attr.Attr.AutoIncrement,
+					Enumvalues: attr.Attr.EnumValues,
 				},
-				Primary:  attr.Attr.Primary,
-				Default:  attr.Attr.Default,
-				OnUpdate: attr.Attr.OnUpdate,
-				Comment:  attr.Attr.Comment,
+				Primary:       attr.Attr.Primary,
+				Default:       attr.Attr.Default,
+				OnUpdate:      attr.Attr.OnUpdate,
+				Comment:       attr.Attr.Comment,
+				Generated:     attr.Attr.Generated,
+				Alg:           plan.CompressType(attr.Attr.Alg),
+				CompressLevel: attr.Attr.CompressLevel,
 			})
 			i++
 		}
@@ -126,6 +126,10 @@ type column struct {
 	hasUpdate       int8
 	updateExpr      []byte
 	isClusterBy     int8
+	enumValues      string
+	generated       []byte
+	compressAlg     int8
+	compressLevel   int8
 }
 
 type columns []column
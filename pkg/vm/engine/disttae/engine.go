@@ -458,6 +458,34 @@ func (e *Engine) Rollback(ctx context.Context, op client.TxnOperator) error {
 	return nil
 }
 
+func (e *Engine) Savepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	logDebugf(op.Txn(), "Engine.Savepoint")
+	txn := e.getTransaction(op)
+	if txn == nil {
+		return moerr.NewTxnClosed(ctx, op.Txn().ID)
+	}
+	txn.Savepoint(name)
+	return nil
+}
+
+func (e *Engine) RollbackToSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	logDebugf(op.Txn(), "Engine.RollbackToSavepoint")
+	txn := e.getTransaction(op)
+	if txn == nil {
+		return moerr.NewTxnClosed(ctx, op.Txn().ID)
+	}
+	return txn.RollbackToSavepoint(ctx, name)
+}
+
+func (e *Engine) ReleaseSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	logDebugf(op.Txn(), "Engine.ReleaseSavepoint")
+	txn := e.getTransaction(op)
+	if txn == nil {
+		return moerr.NewTxnClosed(ctx, op.Txn().ID)
+	}
+	return txn.ReleaseSavepoint(ctx, name)
+}
+
 func (e *Engine) Nodes() (engine.Nodes, error) {
 	var nodes engine.Nodes
 	cluster := clusterservice.GetMOCluster()
@@ -550,6 +578,12 @@ func (e *Engine) delTransaction(txn *Transaction) {
 			txn.writes[i][j].bat.Clean(e.mp)
 		}
 	}
+	for _, sp := range txn.savepoints {
+		for _, saved := range sp.batches {
+			saved.bat.Clean(e.mp)
+		}
+	}
+	txn.savepoints = nil
 	txn.tableMap = nil
 	txn.createMap = nil
 	txn.databaseMap = nil
@@ -33,12 +33,15 @@ import (
 )
 
 type PartitionReader struct {
-	typsMap              map[string]types.Type
-	inserts              []*batch.Batch
-	deletes              map[types.Rowid]uint8
-	skipBlocks           map[uint64]uint8
-	iter                 partitionStateIter
-	sourceBatchNameIndex map[string]int
+	typsMap    map[string]types.Type
+	inserts    []*batch.Batch
+	deletes    map[types.Rowid]uint8
+	skipBlocks map[uint64]uint8
+	iter       partitionStateIter
+	tableDef   *plan.TableDef
+	// the default of the columns which are added to the table after the rows
+	// are written
+	defaultVecs map[string]*vector.Vector
 
 	// the following attributes are used to support cn2s3
 	procMPool       *mpool.MPool
@@ -81,6 +84,9 @@ var _ engine.Reader = new(PartitionReader)
 
 func (p *PartitionReader) Close() error {
 	p.iter.Close()
+	for _, vec := range p.defaultVecs {
+		vec.Free(p.procMPool)
+	}
 	return nil
 }
 
@@ -95,6 +101,22 @@ func (p *PartitionReader) getIdxs(colNames []string) (res []uint16) {
 	return
 }
 
+func (p *PartitionReader) getDefaultVec(name string) (*vector.Vector, error) {
+	if vec, ok := p.defaultVecs[name]; ok {
+		return vec, nil
+	}
+	if p.defaultVecs == nil {
+		p.defaultVecs = make(map[string]*vector.Vector)
+	}
+	col := p.tableDef.Cols[p.colIdxMp[name]]
+	vec, err := catalog.MakeDefaultVector(col.Default, p.typsMap[name], 1, p.procMPool)
+	if err != nil {
+		return nil, err
+	}
+	p.defaultVecs[name] = vec
+	return vec, nil
+}
+
 func (p *PartitionReader) Read(ctx context.Context, colNames []string, expr *plan.Expr, mp *mpool.MPool) (*batch.Batch, error) {
 	if p == nil {
 		return nil, nil
@@ -200,23 +222,24 @@ func (p *PartitionReader) Read(ctx context.Context, colNames []string, expr *pla
 				continue
 			}
 		}
-		if p.sourceBatchNameIndex == nil {
-			p.sourceBatchNameIndex = make(map[string]int)
-			for i, name := range entry.Batch.Attrs {
-				p.sourceBatchNameIndex[name] = i
-			}
-		}
 		for i, name := range b.Attrs {
 			if name == catalog.Row_ID {
 				if err := vector.AppendFixed(b.Vecs[i], entry.RowID, false, mp); err != nil {
 					return nil, err
 				}
+				continue
+			}
+			// the columns are renamed or added to the table only, so the
+			// rows written by the older schemas are read by the position
+			// of the columns, which follow the rowid and the commit ts
+			if idx := 2 + p.colIdxMp[name]; idx < len(entry.Batch.Vecs) {
+				appendFuncs[i](b.Vecs[i], entry.Batch.Vecs[idx], entry.Offset)
 			} else {
-				appendFuncs[i](
-					b.Vecs[i],
-					entry.Batch.Vecs[p.sourceBatchNameIndex[name]],
-					entry.Offset,
-				)
+				vec, err := p.getDefaultVec(name)
+				if err != nil {
+					return nil, err
+				}
+				appendFuncs[i](b.Vecs[i], vec, 0)
 			}
 		}
 		rows++
@@ -24,7 +24,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 )
 
 func (r *emptyReader) Close() error {
@@ -39,7 +41,14 @@ func (r *blockReader) Close() error {
 	return nil
 }
 
+func (r *blockReader) SetRuntimeFilters(attrs []string, filters []*colexec.RuntimeFilter) {
+	r.runtimeFilters = newBlockRuntimeFilters(r.tableDef, attrs, filters)
+}
+
 func (r *blockReader) Read(ctx context.Context, cols []string, _ *plan.Expr, m *mpool.MPool) (*batch.Batch, error) {
+	for len(r.blks) > 0 && r.runtimeFilters.skip(&r.blks[0], r.tableDef) {
+		r.blks = r.blks[1:]
+	}
 	if len(r.blks) == 0 {
 		return nil, nil
 	}
@@ -52,6 +61,7 @@ func (r *blockReader) Read(ctx context.Context, cols []string, _ *plan.Expr, m *
 			r.colIdxs = make([]uint16, len(cols))
 			r.colTypes = make([]types.Type, len(cols))
 			r.colNulls = make([]bool, len(cols))
+			r.colDefaults = make([]*plan.Default, len(cols))
 			r.pkidxInColIdxs = -1
 			for i, column := range cols {
 				// sometimes Name2ColIndex have no row_id， sometimes have one
@@ -73,6 +83,7 @@ func (r *blockReader) Read(ctx context.Context, cols []string, _ *plan.Expr, m *
 					if colDef.Default != nil {
 						r.colNulls[i] = colDef.Default.NullAbility
 					}
+					r.colDefaults[i] = colDef.Default
 				}
 			}
 		} else {
@@ -80,7 +91,7 @@ func (r *blockReader) Read(ctx context.Context, cols []string, _ *plan.Expr, m *
 		}
 	}
 
-	bat, err := blockio.BlockRead(r.ctx, info, r.colIdxs, r.colTypes, r.ts, r.fs, m)
+	bat, err := blockio.BlockRead(r.ctx, info, r.colIdxs, r.colTypes, r.colDefaults, r.ts, r.fs, m)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +121,14 @@ func (r *blockMergeReader) Close() error {
 	return nil
 }
 
+func (r *blockMergeReader) SetRuntimeFilters(attrs []string, filters []*colexec.RuntimeFilter) {
+	r.runtimeFilters = newBlockRuntimeFilters(r.tableDef, attrs, filters)
+}
+
 func (r *blockMergeReader) Read(ctx context.Context, cols []string, expr *plan.Expr, m *mpool.MPool) (*batch.Batch, error) {
+	for len(r.blks) > 0 && r.runtimeFilters.skip(&r.blks[0].meta, r.tableDef) {
+		r.blks = r.blks[1:]
+	}
 	if len(r.blks) == 0 {
 		r.sels = nil
 		return nil, nil
@@ -123,6 +141,7 @@ func (r *blockMergeReader) Read(ctx context.Context, cols []string, expr *plan.E
 			r.colIdxs = make([]uint16, len(cols))
 			r.colTypes = make([]types.Type, len(cols))
 			r.colNulls = make([]bool, len(cols))
+			r.colDefaults = make([]*plan.Default, len(cols))
 			for i, column := range cols {
 				// sometimes Name2ColIndex have no row_id， sometimes have one
 				if column == catalog.Row_ID {
@@ -139,6 +158,7 @@ func (r *blockMergeReader) Read(ctx context.Context, cols []string, expr *plan.E
 					if colDef.Default != nil {
 						r.colNulls[i] = colDef.Default.NullAbility
 					}
+					r.colDefaults[i] = colDef.Default
 				}
 			}
 		} else {
@@ -146,7 +166,7 @@ func (r *blockMergeReader) Read(ctx context.Context, cols []string, expr *plan.E
 		}
 	}
 
-	bat, err := blockio.BlockRead(r.ctx, info, r.colIdxs, r.colTypes, r.ts, r.fs, m)
+	bat, err := blockio.BlockRead(r.ctx, info, r.colIdxs, r.colTypes, r.colDefaults, r.ts, r.fs, m)
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +189,14 @@ func (r *mergeReader) Close() error {
 	return nil
 }
 
+func (r *mergeReader) SetRuntimeFilters(attrs []string, filters []*colexec.RuntimeFilter) {
+	for _, rd := range r.rds {
+		if fr, ok := rd.(colexec.RuntimeFilterReader); ok {
+			fr.SetRuntimeFilters(attrs, filters)
+		}
+	}
+}
+
 func (r *mergeReader) Read(ctx context.Context, cols []string, expr *plan.Expr, m *mpool.MPool) (*batch.Batch, error) {
 	if len(r.rds) == 0 {
 		return nil, nil
@@ -190,3 +218,32 @@ func (r *mergeReader) Read(ctx context.Context, cols []string, expr *plan.Expr,
 	}
 	return nil, nil
 }
+
+func newBlockRuntimeFilters(tableDef *plan.TableDef, attrs []string, filters []*colexec.RuntimeFilter) blockRuntimeFilters {
+	var f blockRuntimeFilters
+	for i, attr := range attrs {
+		if idx, ok := tableDef.Name2ColIndex[attr]; ok {
+			f.colIdxs = append(f.colIdxs, int(idx))
+			f.filters = append(f.filters, filters[i])
+		}
+	}
+	return f
+}
+
+// skip returns true if the zonemaps of the block tell no row can pass the runtime filters
+func (f *blockRuntimeFilters) skip(meta *BlockMeta, tableDef *plan.TableDef) bool {
+	for i, filter := range f.filters {
+		idx := f.colIdxs[i]
+		if idx >= len(meta.Zonemap) {
+			continue
+		}
+		zm := index.NewZoneMap(types.T(tableDef.Cols[idx].Typ.Id).ToType())
+		if err := zm.Unmarshal(meta.Zonemap[idx][:]); err != nil {
+			continue
+		}
+		if !filter.MayContain(zm) {
+			return true
+		}
+	}
+	return false
+}
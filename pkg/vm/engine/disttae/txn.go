@@ -26,6 +26,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
@@ -81,6 +84,20 @@ func (txn *Transaction) getTableMeta(
 	}, nil
 }
 
+// hasWritesOf returns true if the transaction has written to the table
+func (txn *Transaction) hasWritesOf(databaseId, tableId uint64) bool {
+	txn.Lock()
+	defer txn.Unlock()
+	for _, entries := range txn.writes {
+		for _, e := range entries {
+			if e.databaseId == databaseId && e.tableId == tableId {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // detecting whether a transaction is a read-only transaction
 func (txn *Transaction) ReadOnly() bool {
 	return txn.readOnly
@@ -140,11 +157,25 @@ func (txn *Transaction) WriteBatch(
 	return nil
 }
 
+// DumpBatch writes the inserted batches into blocks on S3 once they are too large.
+// If there are savepoints, only the writes after the last one are dumped, the savepoints
+// refer to the writes before it by their positions, and the last savepoint records the
+// dumped blocks so that they are removed if it is rolled back. Everything is dumped at the commit.
 func (txn *Transaction) DumpBatch(force bool) error {
+	var sp *savepoint
+	from, base := 0, uint64(0)
+	if !force && len(txn.savepoints) > 0 {
+		sp = txn.savepoints[len(txn.savepoints)-1]
+		from, base = int(sp.statementId), sp.workspaceSize
+	}
+	size := uint64(0)
+	if txn.workspaceSize > base {
+		size = txn.workspaceSize - base
+	}
 	// if txn.workspaceSize >= colexec.WriteS3Threshold {
-	if txn.workspaceSize >= colexec.WriteS3Threshold || force && txn.workspaceSize >= colexec.TagS3Size {
+	if size >= colexec.WriteS3Threshold || force && size >= colexec.TagS3Size {
 		mp := make(map[[2]string][]*batch.Batch)
-		for i := 0; i < len(txn.writes); i++ {
+		for i := from; i < len(txn.writes); i++ {
 			idx := -1
 			for j := 0; j < len(txn.writes[i]); j++ {
 				if txn.writes[i][j].typ == INSERT && txn.writes[i][j].fileName == "" {
@@ -182,12 +213,19 @@ func (txn *Transaction) DumpBatch(force bool) error {
 			metaLoc.Vecs = metaLoc.Vecs[lenVecs-1:]
 			metaLoc.Attrs = metaLoc.Attrs[lenVecs-1:]
 			metaLoc.SetZs(metaLoc.Vecs[0].Length(), txn.proc.GetMPool())
+			if sp != nil {
+				for _, loc := range vector.MustStrCol(metaLoc.Vecs[0]) {
+					if name := strings.Split(loc, ":")[0]; len(sp.dumped) == 0 || sp.dumped[len(sp.dumped)-1] != name {
+						sp.dumped = append(sp.dumped, name)
+					}
+				}
+			}
 			err = tbl.Write(txn.proc.Ctx, metaLoc)
 			if err != nil {
 				return err
 			}
 		}
-		txn.workspaceSize = 0
+		txn.workspaceSize = base
 	}
 	return nil
 }
@@ -297,10 +335,10 @@ func (txn *Transaction) checkPrimaryKey(
 			if err != nil {
 				return err
 			}
+			txn.saveRow(true, row)
 
 		case DELETE:
-			err := txn.workspace.Delete(tx, rowID)
-			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			if err := txn.deleteWorkspaceRow(tx, rowID); err != nil {
 				return err
 			}
 
@@ -353,8 +391,7 @@ func (txn *Transaction) deleteBatch(bat *batch.Batch,
 	for _, rowid := range rowids {
 		mp[rowid] = 0
 		// update workspace
-		err := txn.workspace.Delete(tx, RowID(rowid))
-		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		if err := txn.deleteWorkspaceRow(tx, RowID(rowid)); err != nil {
 			panic(err)
 		}
 	}
@@ -373,6 +410,9 @@ func (txn *Transaction) deleteBatch(bat *batch.Batch,
 					}
 				}
 				if len(sels) != len(vs) {
+					if err := txn.saveBatch(uint64(i), j); err != nil {
+						panic(err)
+					}
 					txn.writes[i][j].bat.Shrink(sels)
 				}
 			}
@@ -389,6 +429,232 @@ func (txn *Transaction) deleteBatch(bat *batch.Batch,
 	return bat
 }
 
+// deleteWorkspaceRow deletes the row from the workspace, and keeps it for the last savepoint
+func (txn *Transaction) deleteWorkspaceRow(tx *memorytable.Transaction, rowID RowID) error {
+	if len(txn.savepoints) > 0 {
+		row, err := txn.workspace.Get(tx, rowID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		txn.saveRow(false, row)
+	}
+	err := txn.workspace.Delete(tx, rowID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	return nil
+}
+
+// saveRow records a workspace row inserted or deleted after the last savepoint
+func (txn *Transaction) saveRow(inserted bool, row *workspaceRow) {
+	txn.Lock()
+	defer txn.Unlock()
+	if len(txn.savepoints) == 0 {
+		return
+	}
+	sp := txn.savepoints[len(txn.savepoints)-1]
+	sp.rows = append(sp.rows, savedRow{inserted: inserted, row: row})
+}
+
+// saveBatch is called before the batch of a write is shrunk by a delete. If the write is before
+// the last savepoint, its batch is kept by the savepoint and the write gets a copy of it instead.
+func (txn *Transaction) saveBatch(statementId uint64, idx int) error {
+	txn.Lock()
+	defer txn.Unlock()
+	if len(txn.savepoints) == 0 {
+		return nil
+	}
+	sp := txn.savepoints[len(txn.savepoints)-1]
+	if statementId >= sp.statementId {
+		return nil
+	}
+	for _, saved := range sp.batches {
+		if saved.statementId == statementId && saved.idx == idx {
+			return nil
+		}
+	}
+
+	bat := txn.writes[statementId][idx].bat
+	dup := batch.New(true, bat.Attrs)
+	dup.Cnt = 1
+	for i, vec := range bat.Vecs {
+		v, err := vec.Dup(txn.proc.Mp())
+		if err != nil {
+			dup.Clean(txn.proc.Mp())
+			return err
+		}
+		dup.Vecs[i] = v
+	}
+	dup.Zs = append([]int64{}, bat.Zs...)
+	txn.writes[statementId][idx].bat = dup
+	sp.batches = append(sp.batches, savedBatch{statementId: statementId, idx: idx, bat: bat})
+	return nil
+}
+
+// Savepoint sets a savepoint with the name, the writes after it go to a new statement.
+// A savepoint with the same name is removed first, which is what MySQL does.
+func (txn *Transaction) Savepoint(name string) {
+	txn.Lock()
+	defer txn.Unlock()
+	if i := txn.findSavepoint(name); i >= 0 {
+		txn.removeSavepoint(i)
+	}
+	txn.statementId++
+	txn.writes = append(txn.writes, make([]Entry, 0, 1))
+	txn.savepoints = append(txn.savepoints, &savepoint{
+		name:          name,
+		statementId:   txn.statementId,
+		workspaceSize: txn.workspaceSize,
+	})
+}
+
+// RollbackToSavepoint undoes the writes after the savepoint and removes the savepoints set after it,
+// the savepoint itself is kept. The locks acquired after the savepoint are held until the transaction
+// ends, which is the same as InnoDB.
+func (txn *Transaction) RollbackToSavepoint(ctx context.Context, name string) error {
+	txn.Lock()
+	defer txn.Unlock()
+	i := txn.findSavepoint(name)
+	if i < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+	sp := txn.savepoints[i]
+	for _, writes := range txn.writes[sp.statementId:] {
+		for _, e := range writes {
+			if e.databaseId == catalog.MO_CATALOG_ID {
+				return moerr.NewNotSupported(ctx, "rollback to savepoint %s after DDL", name)
+			}
+		}
+	}
+
+	// undo the changes in the reverse order
+	t := txn.nextLocalTS()
+	tx := memorytable.NewTransaction(t)
+	var dumped []string
+	for j := len(txn.savepoints) - 1; j >= i; j-- {
+		later := txn.savepoints[j]
+		dumped = append(dumped, later.dumped...)
+		for k := len(later.rows) - 1; k >= 0; k-- {
+			var err error
+			if later.rows[k].inserted {
+				err = txn.workspace.Delete(tx, later.rows[k].row.rowID)
+			} else {
+				err = txn.workspace.Insert(tx, later.rows[k].row)
+			}
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+		}
+		for k := len(later.batches) - 1; k >= 0; k-- {
+			saved := later.batches[k]
+			if saved.statementId >= sp.statementId {
+				saved.bat.Clean(txn.proc.Mp())
+				continue
+			}
+			txn.writes[saved.statementId][saved.idx].bat.Clean(txn.proc.Mp())
+			txn.writes[saved.statementId][saved.idx].bat = saved.bat
+		}
+		later.rows = nil
+		later.batches = nil
+		later.dumped = nil
+	}
+	if err := tx.Commit(t); err != nil {
+		return err
+	}
+
+	for _, writes := range txn.writes[sp.statementId:] {
+		for _, e := range writes {
+			if e.typ == INSERT && e.fileName == "" {
+				if size := uint64(e.bat.Size()); size < txn.workspaceSize {
+					txn.workspaceSize -= size
+				} else {
+					txn.workspaceSize = 0
+				}
+			}
+			e.bat.Clean(txn.proc.Mp())
+		}
+	}
+	txn.writes = append(txn.writes[:sp.statementId], make([]Entry, 0, 1))
+	txn.statementId = sp.statementId
+	txn.savepoints = txn.savepoints[:i+1]
+	txn.removeDumped(ctx, dumped)
+	return nil
+}
+
+// removeDumped removes the blocks dumped after a savepoint which is rolled back, nothing refers to
+// them after their writes are discarded, so a failure only leaves garbage and is not returned.
+func (txn *Transaction) removeDumped(ctx context.Context, names []string) {
+	if len(names) == 0 {
+		return
+	}
+	fs, err := fileservice.Get[fileservice.FileService](txn.proc.FileService, defines.SharedFileServiceName)
+	if err == nil {
+		err = fs.Delete(ctx, names...)
+	}
+	if err != nil {
+		logutil.Warnf("disttae: failed to remove the blocks %v dumped after the savepoint: %v", names, err)
+	}
+}
+
+// ReleaseSavepoint removes the savepoint and the savepoints set after it, the writes are kept.
+func (txn *Transaction) ReleaseSavepoint(ctx context.Context, name string) error {
+	txn.Lock()
+	defer txn.Unlock()
+	i := txn.findSavepoint(name)
+	if i < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+	for j := len(txn.savepoints) - 1; j >= i; j-- {
+		txn.removeSavepoint(j)
+	}
+	return nil
+}
+
+func (txn *Transaction) findSavepoint(name string) int {
+	for i, sp := range txn.savepoints {
+		if sp.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeSavepoint removes the i-th savepoint, the changes recorded by it are merged into
+// the previous savepoint, or dropped if there is none.
+func (txn *Transaction) removeSavepoint(i int) {
+	sp := txn.savepoints[i]
+	txn.savepoints = append(txn.savepoints[:i], txn.savepoints[i+1:]...)
+	if i == 0 {
+		for _, saved := range sp.batches {
+			saved.bat.Clean(txn.proc.Mp())
+		}
+		return
+	}
+
+	prev := txn.savepoints[i-1]
+	prev.rows = append(prev.rows, sp.rows...)
+	prev.dumped = append(prev.dumped, sp.dumped...)
+	for _, saved := range sp.batches {
+		// the previous savepoint needs the batch only if it is written before the previous savepoint
+		// and is not kept yet
+		kept := saved.statementId >= prev.statementId
+		for _, prevSaved := range prev.batches {
+			if prevSaved.statementId == saved.statementId && prevSaved.idx == saved.idx {
+				kept = true
+				break
+			}
+		}
+		if kept {
+			saved.bat.Clean(txn.proc.Mp())
+			continue
+		}
+		prev.batches = append(prev.batches, saved)
+	}
+}
+
 func (txn *Transaction) allocateID(ctx context.Context) (uint64, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Minute)
 	defer cancel()
@@ -272,16 +272,20 @@ func (tbl *txnTable) getTableDef() *plan.TableDef {
 					Name:  attr.Attr.Name,
 					ColId: attr.Attr.ID,
 					Typ: &plan.Type{
-						Id:       int32(attr.Attr.Type.Oid),
-						Width:    attr.Attr.Type.Width,
-						Scale:    attr.Attr.Type.Scale,
-						AutoIncr: attr.Attr.AutoIncrement,
+						Id:         int32(attr.Attr.Type.Oid),
+						Width:      attr.Attr.Type.Width,
+						Scale:      attr.Attr.Type.Scale,
+						AutoIncr:   attr.Attr.AutoIncrement,
+						Enumvalues: attr.Attr.EnumValues,
 					},
-					Primary:   attr.Attr.Primary,
-					Default:   attr.Attr.Default,
-					OnUpdate:  attr.Attr.OnUpdate,
-					Comment:   attr.Attr.Comment,
-					ClusterBy: attr.Attr.ClusterBy,
+					Primary:       attr.Attr.Primary,
+					Default:       attr.Attr.Default,
+					OnUpdate:      attr.Attr.OnUpdate,
+					Comment:       attr.Attr.Comment,
+					ClusterBy:     attr.Attr.ClusterBy,
+					Generated:     attr.Attr.Generated,
+					Alg:           plan.CompressType(attr.Attr.Alg),
+					CompressLevel: attr.Attr.CompressLevel,
 				})
 				i++
 			}
@@ -364,6 +368,75 @@ func (tbl *txnTable) UpdateConstraint(ctx context.Context, c *engine.ConstraintD
 	return nil
 }
 
+// AlterColumns replaces the columns of the table with the AttributeDefs of
+// defs by updating mo_columns, the data of the table is not rewritten
+func (tbl *txnTable) AlterColumns(ctx context.Context, defs []engine.TableDef) error {
+	if tbl.db.txn.hasWritesOf(tbl.db.databaseId, tbl.tableId) {
+		return moerr.NewNotSupported(ctx, "alter the columns of table %s written by the transaction in place", tbl.tableName)
+	}
+	attrs := make([]engine.TableDef, 0, len(defs)+1)
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Name != catalog.Row_ID {
+			attrs = append(attrs, def)
+		}
+	}
+	cols, err := genColumns(getAccountId(ctx), tbl.tableName, tbl.db.databaseName,
+		tbl.tableId, tbl.db.databaseId, attrs)
+	if err != nil {
+		return err
+	}
+	m := tbl.db.txn.proc.Mp()
+	var bat *batch.Batch
+	for _, col := range cols {
+		b, err := genCreateColumnTuple(col, m)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			bat = b
+			continue
+		}
+		bat, err = bat.Append(ctx, m, b)
+		b.Clean(m)
+		if err != nil {
+			return err
+		}
+	}
+	if err = tbl.db.txn.WriteBatch(UPDATE, catalog.MO_CATALOG_ID, catalog.MO_COLUMNS_ID,
+		catalog.MO_CATALOG, catalog.MO_COLUMNS, bat, tbl.db.txn.dnStores[0], -1); err != nil {
+		return err
+	}
+
+	// keep the defs other than the columns and the hidden rowid column
+	newDefs := make([]engine.TableDef, 0, len(tbl.defs)+len(attrs))
+	var rowid engine.TableDef
+	for _, def := range tbl.defs {
+		if attr, ok := def.(*engine.AttributeDef); !ok {
+			newDefs = append(newDefs, def)
+		} else if attr.Attr.Name == catalog.Row_ID {
+			rowid = def
+		}
+	}
+	newDefs = append(newDefs, attrs...)
+	if rowid != nil {
+		newDefs = append(newDefs, rowid)
+	}
+	tbl.defs = newDefs
+	tbl.primaryIdx = -1
+	tbl.clusterByIdx = -1
+	for i, col := range cols {
+		if col.constraintType == catalog.SystemColPKConstraint {
+			tbl.primaryIdx = i
+		}
+		if col.isClusterBy == 1 {
+			tbl.clusterByIdx = i
+		}
+	}
+	tbl.tableDef = nil
+	tbl.getTableDef()
+	return nil
+}
+
 func (tbl *txnTable) TableColumns(ctx context.Context) ([]*engine.Attribute, error) {
 	var attrs []*engine.Attribute
 	for _, def := range tbl.defs {
@@ -700,6 +773,7 @@ func (tbl *txnTable) newReader(
 		deletes:         deletes,
 		skipBlocks:      tbl.skipBlocks,
 		iter:            iter,
+		tableDef:        tbl.tableDef,
 		colIdxMp:        colIdxMp,
 		extendId2s3File: make(map[string]int),
 		s3FileService:   fs,
@@ -0,0 +1,179 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage/memorytable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavepoint(t *testing.T) {
+	ctx := context.TODO()
+	txn := newTransactionForTest()
+
+	require.NoError(t, txn.WriteBatch(INSERT, 1000, 1, "db", "t", newInt64BatchForTest(txn, 1, 2, 3), DNStore{}, 0))
+	txn.Savepoint("a")
+	require.NoError(t, txn.WriteBatch(INSERT, 1000, 1, "db", "t", newInt64BatchForTest(txn, 4), DNStore{}, 0))
+	txn.Savepoint("b")
+	// delete the first row written before the savepoints
+	rowids := vector.MustFixedCol[types.Rowid](txn.writes[0][0].bat.Vecs[0])
+	bat := txn.deleteBatch(newRowidBatchForTest(txn, rowids[0]), 1000, 1)
+	require.Equal(t, 0, bat.Length())
+	bat.Clean(txn.proc.Mp())
+	require.Equal(t, 2, txn.writes[0][0].bat.Length())
+
+	// roll back to b restores the deleted row
+	require.NoError(t, txn.RollbackToSavepoint(ctx, "b"))
+	require.Equal(t, 3, txn.writes[0][0].bat.Length())
+	require.Equal(t, 1, txn.writes[1][0].bat.Length())
+	err := txn.WriteBatch(INSERT, 1000, 1, "db", "t", newInt64BatchForTest(txn, 1), DNStore{}, 0)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDuplicateEntry))
+	txn.writes[txn.statementId][0].bat.Clean(txn.proc.Mp())
+	txn.writes[txn.statementId] = txn.writes[txn.statementId][:0]
+
+	// roll back to a discards the insert after it and removes b
+	require.NoError(t, txn.RollbackToSavepoint(ctx, "a"))
+	require.Equal(t, 2, len(txn.writes))
+	require.Equal(t, 1, len(txn.savepoints))
+	require.NoError(t, txn.WriteBatch(INSERT, 1000, 1, "db", "t", newInt64BatchForTest(txn, 4), DNStore{}, 0))
+	err = txn.RollbackToSavepoint(ctx, "b")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+
+	// release keeps the writes
+	require.NoError(t, txn.ReleaseSavepoint(ctx, "a"))
+	require.Equal(t, 0, len(txn.savepoints))
+	require.Equal(t, 1, txn.writes[1][0].bat.Length())
+	err = txn.ReleaseSavepoint(ctx, "a")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+
+	for i := range txn.writes {
+		for j := range txn.writes[i] {
+			txn.writes[i][j].bat.Clean(txn.proc.Mp())
+		}
+	}
+	require.Equal(t, int64(0), txn.proc.Mp().CurrNB())
+}
+
+func TestSavepointRelease(t *testing.T) {
+	ctx := context.TODO()
+	txn := newTransactionForTest()
+
+	require.NoError(t, txn.WriteBatch(INSERT, 1000, 1, "db", "t", newInt64BatchForTest(txn, 1, 2, 3), DNStore{}, 0))
+	txn.Savepoint("a")
+	rowids := vector.MustFixedCol[types.Rowid](txn.writes[0][0].bat.Vecs[0])
+	txn.deleteBatch(newRowidBatchForTest(txn, rowids[0]), 1000, 1).Clean(txn.proc.Mp())
+	txn.Savepoint("b")
+	rowids = vector.MustFixedCol[types.Rowid](txn.writes[0][0].bat.Vecs[0])
+	txn.deleteBatch(newRowidBatchForTest(txn, rowids[0]), 1000, 1).Clean(txn.proc.Mp())
+	require.Equal(t, 1, txn.writes[0][0].bat.Length())
+
+	// the changes after b are merged into a
+	require.NoError(t, txn.ReleaseSavepoint(ctx, "b"))
+	require.Equal(t, 1, len(txn.savepoints))
+	require.NoError(t, txn.RollbackToSavepoint(ctx, "a"))
+	require.Equal(t, 3, txn.writes[0][0].bat.Length())
+
+	// setting a savepoint with the same name replaces it
+	txn.Savepoint("a")
+	require.Equal(t, 1, len(txn.savepoints))
+	require.Equal(t, uint64(2), txn.savepoints[0].statementId)
+
+	require.NoError(t, txn.ReleaseSavepoint(ctx, "a"))
+	txn.writes[0][0].bat.Clean(txn.proc.Mp())
+	require.Equal(t, int64(0), txn.proc.Mp().CurrNB())
+}
+
+func TestSavepointDump(t *testing.T) {
+	ctx := context.TODO()
+	txn := newTransactionForTest()
+	fs, err := fileservice.Get[fileservice.FileService](txn.proc.FileService, defines.SharedFileServiceName)
+	require.NoError(t, err)
+
+	// the writes before the savepoint are not dumped
+	require.NoError(t, txn.WriteBatch(INSERT, 1000, 1, "db", "t", newInt64BatchForTest(txn, 1, 2, 3), DNStore{}, 0))
+	txn.workspaceSize = colexec.WriteS3Threshold
+	txn.Savepoint("a")
+	require.NoError(t, txn.DumpBatch(false))
+	require.Equal(t, 3, txn.writes[0][0].bat.Length())
+
+	// the blocks dumped after a savepoint are removed if it is rolled back, and kept if it is released
+	for _, name := range []string{"obj1", "obj2"} {
+		require.NoError(t, fs.Write(ctx, fileservice.IOVector{
+			FilePath: name,
+			Entries:  []fileservice.IOEntry{{Size: 1, Data: []byte{1}}},
+		}))
+	}
+	txn.savepoints[0].dumped = []string{"obj1"}
+	txn.Savepoint("b")
+	txn.savepoints[1].dumped = []string{"obj2"}
+	require.NoError(t, txn.ReleaseSavepoint(ctx, "b"))
+	require.Equal(t, []string{"obj1", "obj2"}, txn.savepoints[0].dumped)
+	require.NoError(t, txn.RollbackToSavepoint(ctx, "a"))
+	require.Empty(t, txn.savepoints[0].dumped)
+	for _, name := range []string{"obj1", "obj2"} {
+		_, err = fs.StatFile(ctx, name)
+		require.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+	}
+
+	require.NoError(t, txn.ReleaseSavepoint(ctx, "a"))
+	txn.writes[0][0].bat.Clean(txn.proc.Mp())
+	require.Equal(t, int64(0), txn.proc.Mp().CurrNB())
+}
+
+func newTransactionForTest() *Transaction {
+	workspace := memorytable.NewTable[RowID, *workspaceRow, *workspaceRow]()
+	workspace.DisableHistory()
+	txn := &Transaction{
+		proc:      testutil.NewProcessWithMPool(mpool.MustNewZero()),
+		readOnly:  true,
+		rowId:     [2]uint64{math.MaxUint64, 0},
+		workspace: workspace,
+	}
+	txn.writes = append(txn.writes, make([]Entry, 0, 1))
+	return txn
+}
+
+func newInt64BatchForTest(txn *Transaction, vs ...int64) *batch.Batch {
+	bat := batch.New(true, []string{"a"})
+	bat.Vecs[0] = testutil.NewInt64Vector(len(vs), types.T_int64.ToType(), txn.proc.Mp(), false, vs)
+	bat.SetZs(len(vs), txn.proc.Mp())
+	return bat
+}
+
+func newRowidBatchForTest(txn *Transaction, rowids ...types.Rowid) *batch.Batch {
+	bat := batch.New(true, []string{"rowid"})
+	bat.Cnt = 1
+	bat.Vecs[0] = vector.NewVec(types.T_Rowid.ToType())
+	for _, rowid := range rowids {
+		if err := vector.AppendFixed(bat.Vecs[0], rowid, false, txn.proc.Mp()); err != nil {
+			panic(err)
+		}
+	}
+	bat.SetZs(len(rowids), txn.proc.Mp())
+	return bat
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage/memorytable"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -129,6 +130,9 @@ type Transaction struct {
 	databaseMap *sync.Map
 	// use to cache created table
 	createMap *sync.Map
+
+	// savepoints are the savepoints set in the transaction, in the order of being set
+	savepoints []*savepoint
 }
 
 // Entry represents a delete/insert
@@ -145,6 +149,35 @@ type Entry struct {
 	dnStore DNStore
 }
 
+// savepoint records the changes done by the transaction after it is set,
+// so that they can be undone by rolling back to it
+type savepoint struct {
+	name string
+	// statementId is the first statement of the writes after the savepoint
+	statementId uint64
+	// batches keeps the batches of the writes before the savepoint
+	// that are shrunk by the deletes after it
+	batches []savedBatch
+	// rows keeps the workspace rows inserted or deleted after the savepoint
+	rows []savedRow
+	// workspaceSize is the size of the workspace when the savepoint is set
+	workspaceSize uint64
+	// dumped is the objects of the blocks dumped from the writes after the savepoint
+	dumped []string
+}
+
+type savedBatch struct {
+	statementId uint64
+	idx         int
+	bat         *batch.Batch
+}
+
+type savedRow struct {
+	// inserted is true if the row is inserted after the savepoint, otherwise it is deleted
+	inserted bool
+	row      *workspaceRow
+}
+
 // txnDatabase represents an opened database in a transaction
 type txnDatabase struct {
 	databaseId   uint64
@@ -220,6 +253,10 @@ type column struct {
 	isAutoIncrement int8
 	hasUpdate       int8
 	updateExpr      []byte
+	enumValues      string
+	generated       []byte
+	compressAlg     int8
+	compressLevel   int8
 }
 
 type blockReader struct {
@@ -235,8 +272,11 @@ type blockReader struct {
 	colIdxs        []uint16
 	colTypes       []types.Type
 	colNulls       []bool
+	colDefaults    []*plan.Default
 	pkidxInColIdxs int
 	pkName         string
+
+	runtimeFilters blockRuntimeFilters
 }
 
 type blockMergeReader struct {
@@ -248,9 +288,12 @@ type blockMergeReader struct {
 	tableDef *plan.TableDef
 
 	// cached meta data.
-	colIdxs  []uint16
-	colTypes []types.Type
-	colNulls []bool
+	colIdxs     []uint16
+	colTypes    []types.Type
+	colNulls    []bool
+	colDefaults []*plan.Default
+
+	runtimeFilters blockRuntimeFilters
 }
 
 type mergeReader struct {
@@ -260,6 +303,13 @@ type mergeReader struct {
 type emptyReader struct {
 }
 
+// blockRuntimeFilters are the runtime filters of the columns, colIdxs[i] is the column filtered
+// by filters[i].
+type blockRuntimeFilters struct {
+	colIdxs []int
+	filters []*colexec.RuntimeFilter
+}
+
 type BlockMeta struct {
 	Rows    int64
 	Info    catalog.BlockInfo
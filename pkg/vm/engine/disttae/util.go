@@ -63,6 +63,16 @@ func fetchZonemapAndRowsFromBlockInfo(
 		return nil, 0, err
 	}
 
+	// the columns added to the table after the block is written have no
+	// zonemap, which is left uninitialized
+	width, err := reader.(*blockio.BlockReader).LoadDataWidth(ctx, extent.Id(), blockInfo.EntryState)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(idxs) > width {
+		idxs = idxs[:width]
+	}
+
 	obs, err := reader.LoadZoneMaps(ctx, idxs, []uint32{extent.Id()}, m)
 	if err != nil {
 		return nil, 0, err
@@ -670,7 +680,7 @@ func findRowByPkValue(vec *vector.Vector, v any) int {
 		return sort.Search(vec.Length(), func(idx int) bool {
 			return rows[idx] >= val
 		})
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		rows := vector.MustFixedCol[uint16](vec)
 		val := v.(uint16)
 		return sort.Search(vec.Length(), func(idx int) bool {
@@ -682,7 +692,7 @@ func findRowByPkValue(vec *vector.Vector, v any) int {
 		return sort.Search(vec.Length(), func(idx int) bool {
 			return rows[idx] >= val
 		})
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		rows := vector.MustFixedCol[uint64](vec)
 		val := v.(uint64)
 		return sort.Search(vec.Length(), func(idx int) bool {
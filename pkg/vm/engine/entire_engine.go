@@ -53,6 +53,33 @@ func (e *EntireEngine) Rollback(ctx context.Context, op client.TxnOperator) erro
 	return err
 }
 
+func (e *EntireEngine) Savepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	err := e.Engine.Savepoint(ctx, op, name)
+	if err == nil && e.TempEngine != nil {
+		return e.TempEngine.Savepoint(ctx, op, name)
+	}
+	return err
+}
+
+func (e *EntireEngine) RollbackToSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	// the temporary engine goes first, it can not roll back the temporary tables written
+	// after the savepoint, and the writes of the main engine should be kept in that case
+	if e.TempEngine != nil {
+		if err := e.TempEngine.RollbackToSavepoint(ctx, op, name); err != nil {
+			return err
+		}
+	}
+	return e.Engine.RollbackToSavepoint(ctx, op, name)
+}
+
+func (e *EntireEngine) ReleaseSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	err := e.Engine.ReleaseSavepoint(ctx, op, name)
+	if err == nil && e.TempEngine != nil {
+		return e.TempEngine.ReleaseSavepoint(ctx, op, name)
+	}
+	return err
+}
+
 func (e *EntireEngine) Delete(ctx context.Context, databaseName string, op client.TxnOperator) error {
 	return e.Engine.Delete(ctx, databaseName, op)
 }
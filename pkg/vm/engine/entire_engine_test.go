@@ -40,6 +40,7 @@ const (
 const (
 	first_engine_then_tempengine = -2
 	only_tempengine              = 0
+	first_tempengine_then_engine = 0
 	only_engine                  = 2
 )
 
@@ -90,6 +91,39 @@ func TestEntireEngineRollback(t *testing.T) {
 	assert.Equal(t, first_engine_then_tempengine, ee.state)
 }
 
+func TestEntireEngineSavepoint(t *testing.T) {
+	ctx := context.TODO()
+	op := newtestOperator()
+	ee := buildEntireEngineWithoutTempEngine()
+	ee.Savepoint(ctx, op, "sp")
+	assert.Equal(t, only_engine, ee.state)
+	ee = buildEntireEngineWithTempEngine()
+	ee.Savepoint(ctx, op, "sp")
+	assert.Equal(t, first_engine_then_tempengine, ee.state)
+}
+
+func TestEntireEngineRollbackToSavepoint(t *testing.T) {
+	ctx := context.TODO()
+	op := newtestOperator()
+	ee := buildEntireEngineWithoutTempEngine()
+	ee.RollbackToSavepoint(ctx, op, "sp")
+	assert.Equal(t, only_engine, ee.state)
+	ee = buildEntireEngineWithTempEngine()
+	ee.RollbackToSavepoint(ctx, op, "sp")
+	assert.Equal(t, first_tempengine_then_engine, ee.state)
+}
+
+func TestEntireEngineReleaseSavepoint(t *testing.T) {
+	ctx := context.TODO()
+	op := newtestOperator()
+	ee := buildEntireEngineWithoutTempEngine()
+	ee.ReleaseSavepoint(ctx, op, "sp")
+	assert.Equal(t, only_engine, ee.state)
+	ee = buildEntireEngineWithTempEngine()
+	ee.ReleaseSavepoint(ctx, op, "sp")
+	assert.Equal(t, first_engine_then_tempengine, ee.state)
+}
+
 func TestEntireEngineDelete(t *testing.T) {
 	ctx := context.TODO()
 	op := newtestOperator()
@@ -222,6 +256,39 @@ func (e *testEngine) Rollback(_ context.Context, _ client.TxnOperator) error {
 	return nil
 }
 
+func (e *testEngine) Savepoint(_ context.Context, _ client.TxnOperator, _ string) error {
+	e.parent.step = e.parent.step + 1
+	if e.name == origin {
+		e.parent.state = e.parent.state + e.parent.step*e.parent.state
+	} else {
+		e.parent.state = e.parent.state - e.parent.step*e.parent.state
+	}
+
+	return nil
+}
+
+func (e *testEngine) RollbackToSavepoint(_ context.Context, _ client.TxnOperator, _ string) error {
+	e.parent.step = e.parent.step + 1
+	if e.name == origin {
+		e.parent.state = e.parent.state + e.parent.step*e.parent.state
+	} else {
+		e.parent.state = e.parent.state - e.parent.step*e.parent.state
+	}
+
+	return nil
+}
+
+func (e *testEngine) ReleaseSavepoint(_ context.Context, _ client.TxnOperator, _ string) error {
+	e.parent.step = e.parent.step + 1
+	if e.name == origin {
+		e.parent.state = e.parent.state + e.parent.step*e.parent.state
+	} else {
+		e.parent.state = e.parent.state - e.parent.step*e.parent.state
+	}
+
+	return nil
+}
+
 func (e *testEngine) Delete(ctx context.Context, name string, _ client.TxnOperator) error {
 	e.parent.step = e.parent.step + 1
 	if e.name == origin {
@@ -78,6 +78,18 @@ func (b *BindedEngine) Rollback(ctx context.Context, _ client.TxnOperator) error
 	return b.engine.Rollback(ctx, b.txnOp)
 }
 
+func (b *BindedEngine) Savepoint(ctx context.Context, _ client.TxnOperator, name string) error {
+	return b.engine.Savepoint(ctx, b.txnOp, name)
+}
+
+func (b *BindedEngine) RollbackToSavepoint(ctx context.Context, _ client.TxnOperator, name string) error {
+	return b.engine.RollbackToSavepoint(ctx, b.txnOp, name)
+}
+
+func (b *BindedEngine) ReleaseSavepoint(ctx context.Context, _ client.TxnOperator, name string) error {
+	return b.engine.ReleaseSavepoint(ctx, b.txnOp, name)
+}
+
 func (b *BindedEngine) GetNameById(ctx context.Context, op client.TxnOperator, tableId uint64) (dbName string, tblName string, err error) {
 	return b.engine.GetNameById(ctx, op, tableId)
 }
@@ -40,6 +40,7 @@ func (d *Database) Create(ctx context.Context, relName string, defs []engine.Tab
 		return err
 	}
 
+	d.engine.markWritten(d.txnOperator)
 	_, err = DoTxnRequest[CreateRelationResp](
 		ctx,
 		d.txnOperator,
@@ -73,6 +74,7 @@ func (d *Database) Truncate(ctx context.Context, relName string) (uint64, error)
 	}
 	oldId := rel.GetTableID(ctx)
 
+	d.engine.markWritten(d.txnOperator)
 	_, err = DoTxnRequest[TruncateRelationResp](
 		ctx,
 		d.txnOperator,
@@ -96,6 +98,7 @@ func (d *Database) Truncate(ctx context.Context, relName string) (uint64, error)
 
 func (d *Database) Delete(ctx context.Context, relName string) error {
 
+	d.engine.markWritten(d.txnOperator)
 	_, err := DoTxnRequest[DeleteRelationResp](
 		ctx,
 		d.txnOperator,
@@ -16,6 +16,7 @@ package memoryengine
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/clusterservice"
@@ -32,6 +33,34 @@ type Engine struct {
 	shardPolicy ShardPolicy
 	idGenerator IDGenerator
 	cluster     clusterservice.MOCluster
+
+	mu struct {
+		sync.Mutex
+		// txns records the writes of the active transactions, keyed by the txn id
+		txns map[string]*txnWrites
+	}
+}
+
+// txnWrites counts the write requests sent in a transaction, a savepoint records the
+// count when it is set, so rolling back to it is a no-op if nothing was written since
+type txnWrites struct {
+	writes     uint64
+	savepoints []txnSavepoint
+}
+
+type txnSavepoint struct {
+	name   string
+	writes uint64
+}
+
+// findSavepoint returns the index of the savepoint, or -1 if it is not set
+func (t *txnWrites) findSavepoint(name string) int {
+	for i := len(t.savepoints) - 1; i >= 0; i-- {
+		if t.savepoints[i].name == name {
+			return i
+		}
+	}
+	return -1
 }
 
 func New(
@@ -47,6 +76,7 @@ func New(
 		idGenerator: idGenerator,
 		cluster:     cluster,
 	}
+	engine.mu.txns = make(map[string]*txnWrites)
 
 	return engine
 }
@@ -57,14 +87,90 @@ func (e *Engine) New(_ context.Context, _ client.TxnOperator) error {
 	return nil
 }
 
-func (e *Engine) Commit(_ context.Context, _ client.TxnOperator) error {
+func (e *Engine) Commit(_ context.Context, op client.TxnOperator) error {
+	e.endTxn(op)
+	return nil
+}
+
+func (e *Engine) Rollback(_ context.Context, op client.TxnOperator) error {
+	e.endTxn(op)
+	return nil
+}
+
+func (e *Engine) Savepoint(_ context.Context, op client.TxnOperator, name string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	txn := e.getTxnLocked(op)
+	if i := txn.findSavepoint(name); i >= 0 {
+		txn.savepoints = append(txn.savepoints[:i], txn.savepoints[i+1:]...)
+	}
+	txn.savepoints = append(txn.savepoints, txnSavepoint{
+		name:   name,
+		writes: txn.writes,
+	})
+	return nil
+}
+
+// RollbackToSavepoint is only supported if nothing was written after the savepoint,
+// the writes are sent to the storage immediately and can not be discarded
+func (e *Engine) RollbackToSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	txn, ok := e.mu.txns[string(op.Txn().ID)]
+	if !ok {
+		return nil
+	}
+	// the engine may be created after the savepoint, count from the beginning then
+	var writes uint64
+	i := txn.findSavepoint(name)
+	if i >= 0 {
+		writes = txn.savepoints[i].writes
+	}
+	if txn.writes != writes {
+		return moerr.NewNotSupported(ctx, "rollback to savepoint in memory engine")
+	}
+	if i >= 0 {
+		txn.savepoints = txn.savepoints[:i+1]
+	}
 	return nil
 }
 
-func (e *Engine) Rollback(_ context.Context, _ client.TxnOperator) error {
+func (e *Engine) ReleaseSavepoint(_ context.Context, op client.TxnOperator, name string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	txn, ok := e.mu.txns[string(op.Txn().ID)]
+	if !ok {
+		return nil
+	}
+	if i := txn.findSavepoint(name); i >= 0 {
+		txn.savepoints = txn.savepoints[:i]
+	}
 	return nil
 }
 
+// markWritten must be called before a write request is sent in the transaction
+func (e *Engine) markWritten(op client.TxnOperator) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.getTxnLocked(op).writes++
+}
+
+func (e *Engine) getTxnLocked(op client.TxnOperator) *txnWrites {
+	key := string(op.Txn().ID)
+	txn, ok := e.mu.txns[key]
+	if !ok {
+		txn = new(txnWrites)
+		e.mu.txns[key] = txn
+	}
+	return txn
+}
+
+func (e *Engine) endTxn(op client.TxnOperator) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.mu.txns, string(op.Txn().ID))
+}
+
 func (e *Engine) NewBlockReader(_ context.Context, _ int, _ timestamp.Timestamp,
 	_ *plan.Expr, _ [][]byte, _ *plan.TableDef) ([]engine.Reader, error) {
 	return nil, nil
@@ -77,6 +183,7 @@ func (e *Engine) Create(ctx context.Context, dbName string, txnOperator client.T
 		return err
 	}
 
+	e.markWritten(txnOperator)
 	_, err = DoTxnRequest[CreateDatabaseResp](
 		ctx,
 		txnOperator,
@@ -146,6 +253,7 @@ func (e *Engine) Databases(ctx context.Context, txnOperator client.TxnOperator)
 
 func (e *Engine) Delete(ctx context.Context, dbName string, txnOperator client.TxnOperator) error {
 
+	e.markWritten(txnOperator)
 	_, err := DoTxnRequest[DeleteDatabaseResp](
 		ctx,
 		txnOperator,
@@ -0,0 +1,78 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memoryengine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/stretchr/testify/assert"
+)
+
+type testTxnOperator struct {
+	client.TxnOperator
+	id []byte
+}
+
+func (op *testTxnOperator) Txn() txn.TxnMeta {
+	return txn.TxnMeta{ID: op.id}
+}
+
+func TestRollbackToSavepoint(t *testing.T) {
+	ctx := context.Background()
+	e := New(ctx, nil, nil, nil)
+	op := &testTxnOperator{id: []byte("txn1")}
+
+	// nothing written in the txn
+	assert.NoError(t, e.RollbackToSavepoint(ctx, op, "sp1"))
+
+	e.markWritten(op)
+	assert.NoError(t, e.Savepoint(ctx, op, "sp1"))
+	assert.NoError(t, e.Savepoint(ctx, op, "sp2"))
+	// nothing written after the savepoints
+	assert.NoError(t, e.RollbackToSavepoint(ctx, op, "sp2"))
+	assert.NoError(t, e.RollbackToSavepoint(ctx, op, "sp1"))
+	// the engine is created after the savepoint
+	err := e.RollbackToSavepoint(ctx, op, "sp0")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+
+	assert.NoError(t, e.Savepoint(ctx, op, "sp2"))
+	e.markWritten(op)
+	assert.NoError(t, e.Savepoint(ctx, op, "sp3"))
+	err = e.RollbackToSavepoint(ctx, op, "sp2")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+	assert.NoError(t, e.RollbackToSavepoint(ctx, op, "sp3"))
+
+	// sp3 is released with sp2, sp1 is kept
+	assert.NoError(t, e.ReleaseSavepoint(ctx, op, "sp2"))
+	err = e.RollbackToSavepoint(ctx, op, "sp3")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+	err = e.RollbackToSavepoint(ctx, op, "sp1")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+	assert.Equal(t, 1, len(e.mu.txns[string(op.id)].savepoints))
+
+	// the writes of other txns are not counted
+	other := &testTxnOperator{id: []byte("txn2")}
+	assert.NoError(t, e.Savepoint(ctx, other, "sp1"))
+	e.markWritten(op)
+	assert.NoError(t, e.RollbackToSavepoint(ctx, other, "sp1"))
+
+	assert.NoError(t, e.Commit(ctx, op))
+	assert.NoError(t, e.Rollback(ctx, other))
+	assert.Empty(t, e.mu.txns)
+}
@@ -17,6 +17,7 @@ package memoryengine
 import (
 	"context"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 
@@ -71,6 +72,7 @@ func (t *Table) Size(ctx context.Context, columnName string) (int64, error) {
 
 func (t *Table) AddTableDef(ctx context.Context, def engine.TableDef) error {
 
+	t.engine.markWritten(t.txnOperator)
 	_, err := DoTxnRequest[AddTableDefResp](
 		ctx,
 		t.txnOperator,
@@ -93,6 +95,7 @@ func (t *Table) AddTableDef(ctx context.Context, def engine.TableDef) error {
 
 func (t *Table) DelTableDef(ctx context.Context, def engine.TableDef) error {
 
+	t.engine.markWritten(t.txnOperator)
 	_, err := DoTxnRequest[DelTableDefResp](
 		ctx,
 		t.txnOperator,
@@ -127,6 +130,7 @@ func (t *Table) Delete(ctx context.Context, bat *batch.Batch, colName string) er
 		return err
 	}
 
+	t.engine.markWritten(t.txnOperator)
 	for _, shard := range shards {
 		_, err := DoTxnRequest[DeleteResp](
 			ctx,
@@ -252,6 +256,10 @@ func (t *Table) UpdateConstraint(context.Context, *engine.ConstraintDef) error {
 	return nil
 }
 
+func (t *Table) AlterColumns(context.Context, []engine.TableDef) error {
+	return moerr.NewNotSupportedNoCtx("alter the columns of table in place")
+}
+
 func (t *Table) Update(ctx context.Context, data *batch.Batch) error {
 	data.InitZsOne(data.Length())
 	shards, err := t.engine.shardPolicy.Batch(
@@ -265,6 +273,7 @@ func (t *Table) Update(ctx context.Context, data *batch.Batch) error {
 		return err
 	}
 
+	t.engine.markWritten(t.txnOperator)
 	for _, shard := range shards {
 		_, err := DoTxnRequest[UpdateResp](
 			ctx,
@@ -300,6 +309,7 @@ func (t *Table) Write(ctx context.Context, data *batch.Batch) error {
 		return err
 	}
 
+	t.engine.markWritten(t.txnOperator)
 	for _, shard := range shards {
 		_, err := DoTxnRequest[WriteResp](
 			ctx,
@@ -343,6 +343,7 @@ func (catalog *Catalog) onReplayUpdateTable(cmd *EntryCommand, dataFactory DataF
 
 	un := cmd.entry.GetLatestNodeLocked().(*TableMVCCNode)
 	un.SetLogIndex(idx)
+	un.Schema = cmd.Table.GetSchema()
 	if un.Is1PC() {
 		if err := un.ApplyCommit(nil); err != nil {
 			panic(err)
@@ -362,6 +363,9 @@ func (catalog *Catalog) onReplayUpdateTable(cmd *EntryCommand, dataFactory DataF
 	tblun := tbl.SearchNode(un)
 	if tblun == nil {
 		tbl.Insert(un) //TODO isvalid
+		tbl.Lock()
+		tbl.applySchemaLocked(un)
+		tbl.Unlock()
 	}
 
 }
@@ -418,13 +422,17 @@ func (catalog *Catalog) onReplayCreateTable(dbid, tid uint64, schema *Schema, tx
 			},
 			TxnMVCCNode:       txnNode,
 			SchemaConstraints: string(schema.Constraint),
+			Schema:            schema,
 		}
 		tbl.Insert(un)
+		tbl.Lock()
+		tbl.applySchemaLocked(un)
+		tbl.Unlock()
 
 		return
 	}
 	tbl = NewReplayTableEntry()
-	tbl.schema = schema
+	tbl.schema.Store(schema)
 	tbl.db = db
 	tbl.ID = tid
 	tbl.tableData = dataFactory.MakeTableFactory()(tbl)
@@ -435,6 +443,7 @@ func (catalog *Catalog) onReplayCreateTable(dbid, tid uint64, schema *Schema, tx
 		},
 		TxnMVCCNode:       txnNode,
 		SchemaConstraints: string(schema.Constraint),
+		Schema:            schema,
 	}
 	tbl.Insert(un)
 }
@@ -464,6 +473,7 @@ func (catalog *Catalog) onReplayDeleteTable(dbid, tid uint64, txnNode *txnbase.T
 			DeletedAt: txnNode.End,
 		},
 		TxnMVCCNode: txnNode,
+		Schema:      prev.Schema,
 	}
 	tbl.Insert(un)
 
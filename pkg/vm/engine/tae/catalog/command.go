@@ -247,7 +247,7 @@ func (cmd *EntryCommand) VerboseString() string {
 	s := fmt.Sprintf("CmdName=%s;%s;TS=%s;CSN=%d;BaseEntry=%s", CmdName(cmd.cmdType), cmd.IDString(), cmd.GetTs().ToString(), cmd.ID, cmd.entry.String())
 	switch cmd.cmdType {
 	case CmdUpdateTable:
-		s = fmt.Sprintf("%s;Schema=%v", s, cmd.Table.schema.String())
+		s = fmt.Sprintf("%s;Schema=%v", s, cmd.Table.GetSchema().String())
 	}
 	return s
 }
@@ -291,7 +291,8 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		}
 		n += sn
 		var schemaBuf []byte
-		if schemaBuf, err = cmd.Table.schema.Marshal(); err != nil {
+		schema := cmd.Table.SchemaOf(cmd.Table.GetLatestNodeLocked().(*TableMVCCNode))
+		if schemaBuf, err = schema.Marshal(); err != nil {
 			return
 		}
 		if _, err = w.Write(schemaBuf); err != nil {
@@ -396,11 +397,12 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		n += sn
 		cmd.Table = NewReplayTableEntry()
 		cmd.Table.TableBaseEntry = cmd.entry.(*TableBaseEntry)
-		cmd.Table.schema = NewEmptySchema("")
-		if sn, err = cmd.Table.schema.ReadFrom(r); err != nil {
+		schema := NewEmptySchema("")
+		if sn, err = schema.ReadFrom(r); err != nil {
 			return
 		}
 		n += sn
+		cmd.Table.schema.Store(schema)
 	case CmdUpdateSegment:
 		entry := NewReplayMetaBaseEntry()
 		if err = binary.Read(r, binary.BigEndian, &entry.ID); err != nil {
@@ -381,7 +381,7 @@ func (e *DBEntry) RemoveEntry(table *TableEntry) (err error) {
 	defer func() {
 		if err == nil {
 			e.catalog.AddTableCnt(-1)
-			e.catalog.AddColumnCnt(-1 * len(table.schema.ColDefs))
+			e.catalog.AddColumnCnt(-1 * len(table.GetSchema().ColDefs))
 		}
 	}()
 	logutil.Info("[Catalog]", common.OperationField("remove"),
@@ -417,7 +417,7 @@ func (e *DBEntry) AddEntryLocked(table *TableEntry, txn txnif.TxnReader, skipDed
 	defer func() {
 		if err == nil {
 			e.catalog.AddTableCnt(1)
-			e.catalog.AddColumnCnt(len(table.schema.ColDefs))
+			e.catalog.AddColumnCnt(len(table.GetSchema().ColDefs))
 		}
 	}()
 	fullName := table.GetFullName()
@@ -27,7 +27,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
@@ -54,6 +56,11 @@ type ColDef struct {
 	ClusterBy     bool
 	Default       []byte
 	OnUpdate      []byte
+	EnumValues    string
+	Generated     []byte
+	// Compress and CompressLevel are the compression of the column in the objects
+	Compress      compress.T
+	CompressLevel int8
 }
 
 func (def *ColDef) GetName() string     { return def.Name }
@@ -67,6 +74,40 @@ func (def *ColDef) IsAutoIncrement() bool { return def.AutoIncrement }
 func (def *ColDef) IsSortKey() bool       { return def.SortKey }
 func (def *ColDef) IsClusterBy() bool     { return def.ClusterBy }
 
+// constDefault returns the value of the constant default of the column
+func (def *ColDef) constDefault() (val any, isNull bool, err error) {
+	if len(def.Default) == 0 {
+		return nil, true, nil
+	}
+	expr := new(plan.Default)
+	if err = types.Decode(def.Default, expr); err != nil {
+		return
+	}
+	val, isNull, ok := pkgcatalog.GetConstDefault(expr, def.Type)
+	if !ok {
+		err = moerr.NewNotSupportedNoCtx("non-constant default of column %s", def.Name)
+	}
+	return
+}
+
+// MakeDefaultVector returns a vector of the rows filled with the default of
+// the column, it fills the column for the rows written before the column was
+// added to the table
+func (def *ColDef) MakeDefaultVector(rows int) (vec containers.Vector, err error) {
+	val, isNull, err := def.constDefault()
+	if err != nil {
+		return
+	}
+	if isNull {
+		val = types.Null{}
+	}
+	vec = containers.MakeVector(def.Type, true)
+	for i := 0; i < rows; i++ {
+		vec.Append(val)
+	}
+	return
+}
+
 type SortKey struct {
 	Defs      []*ColDef
 	search    map[int]int
@@ -266,6 +307,29 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += int64(sn2)
+		if def.EnumValues, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+
+		length = uint64(0)
+		if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+			return
+		}
+		n += 8
+		def.Generated = make([]byte, length)
+		if sn2, err = r.Read(def.Generated); err != nil {
+			return
+		}
+		n += int64(sn2)
+		if err = binary.Read(r, binary.BigEndian, &def.Compress); err != nil {
+			return
+		}
+		n += 1
+		if err = binary.Read(r, binary.BigEndian, &def.CompressLevel); err != nil {
+			return
+		}
+		n += 1
 		if err = s.AppendColDef(def); err != nil {
 			return
 		}
@@ -357,6 +421,22 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if _, err = w.Write(def.OnUpdate); err != nil {
 			return
 		}
+		if _, err = common.WriteString(def.EnumValues, &w); err != nil {
+			return
+		}
+		length = uint64(len(def.Generated))
+		if err = binary.Write(&w, binary.BigEndian, length); err != nil {
+			return
+		}
+		if _, err = w.Write(def.Generated); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, def.Compress); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, def.CompressLevel); err != nil {
+			return
+		}
 	}
 	buf = w.Bytes()
 	return
@@ -391,6 +471,10 @@ func (s *Schema) ReadFromBatch(bat *containers.Batch, offset int, targetTid uint
 		def.Comment = string(bat.GetVectorByName((pkgcatalog.SystemColAttr_Comment)).Get(offset).([]byte))
 		def.OnUpdate = bat.GetVectorByName((pkgcatalog.SystemColAttr_Update)).Get(offset).([]byte)
 		def.Default = bat.GetVectorByName((pkgcatalog.SystemColAttr_DefaultExpr)).Get(offset).([]byte)
+		def.EnumValues = string(bat.GetVectorByName((pkgcatalog.SystemColAttr_EnumValues)).Get(offset).([]byte))
+		def.Generated = bat.GetVectorByName((pkgcatalog.SystemColAttr_Generated)).Get(offset).([]byte)
+		def.Compress = compress.T(bat.GetVectorByName((pkgcatalog.SystemColAttr_CompressAlg)).Get(offset).(int8))
+		def.CompressLevel = bat.GetVectorByName((pkgcatalog.SystemColAttr_CompressLevel)).Get(offset).(int8)
 		def.Idx = int(bat.GetVectorByName((pkgcatalog.SystemColAttr_Num)).Get(offset).(int32)) - 1
 		s.NameIndex[def.Name] = def.Idx
 		s.ColDefs = append(s.ColDefs, def)
@@ -427,16 +511,18 @@ func (s *Schema) AppendCol(name string, typ types.Type) error {
 		Type:        typ,
 		SortIdx:     -1,
 		NullAbility: true,
+		Compress:    compress.Lz4,
 	}
 	return s.AppendColDef(def)
 }
 
 func (s *Schema) AppendSortKey(name string, typ types.Type, idx int, isPrimary bool) error {
 	def := &ColDef{
-		Name:    name,
-		Type:    typ,
-		SortIdx: int8(idx),
-		SortKey: true,
+		Name:     name,
+		Type:     typ,
+		SortIdx:  int8(idx),
+		SortKey:  true,
+		Compress: compress.Lz4,
 	}
 	def.Primary = isPrimary
 	return s.AppendColDef(def)
@@ -450,6 +536,7 @@ func (s *Schema) AppendPKCol(name string, typ types.Type, idx int) error {
 		SortKey:     true,
 		Primary:     true,
 		NullAbility: false,
+		Compress:    compress.Lz4,
 	}
 	return s.AppendColDef(def)
 }
@@ -481,6 +568,10 @@ func ColDefFromAttribute(attr engine.Attribute) (*ColDef, error) {
 		ClusterBy:     attr.ClusterBy,
 		Default:       []byte(""),
 		OnUpdate:      []byte(""),
+		EnumValues:    attr.EnumValues,
+		Generated:     []byte(""),
+		Compress:      attr.Alg,
+		CompressLevel: int8(attr.CompressLevel),
 	}
 	if attr.Default != nil {
 		def.NullAbility = attr.Default.NullAbility
@@ -493,6 +584,11 @@ func ColDefFromAttribute(attr engine.Attribute) (*ColDef, error) {
 			return nil, err
 		}
 	}
+	if attr.Generated != nil {
+		if def.Generated, err = types.Encode(attr.Generated); err != nil {
+			return nil, err
+		}
+	}
 	return def, nil
 }
 
@@ -504,6 +600,62 @@ func (s *Schema) AppendColWithAttribute(attr engine.Attribute) error {
 	return s.AppendColDef(def)
 }
 
+// AlterColumns returns a copy of the schema whose data columns are attrs.
+// Only renaming the columns and appending columns with a constant default
+// are supported, the blocks written before the alter are read by the new
+// schema as is and the appended columns are filled with their defaults.
+func (s *Schema) AlterColumns(attrs []engine.Attribute) (ns *Schema, err error) {
+	ns = s.Clone()
+	olds := make([]*ColDef, 0, len(ns.ColDefs))
+	for _, def := range ns.ColDefs {
+		if !def.IsPhyAddr() {
+			olds = append(olds, def)
+		}
+	}
+	if len(attrs) < len(olds) {
+		return nil, moerr.NewNotSupportedNoCtx("drop the columns of table %s in place", s.Name)
+	}
+	ns.ColDefs = make([]*ColDef, 0, len(attrs)+1)
+	ns.NameIndex = make(map[string]int)
+	ns.SortKey = nil
+	ns.PhyAddrKey = nil
+	for i, attr := range attrs {
+		var def *ColDef
+		if i < len(olds) {
+			def = olds[i]
+			if !def.Type.Eq(attr.Type) {
+				return nil, moerr.NewNotSupportedNoCtx("change the type of column %s in place", def.Name)
+			}
+			if def.Name != attr.Name && (def.IsSortKey() || def.IsHidden()) {
+				return nil, moerr.NewNotSupportedNoCtx("rename column %s in place", def.Name)
+			}
+			def.Name = attr.Name
+			def.Comment = attr.Comment
+		} else {
+			if attr.Primary || attr.ClusterBy || attr.AutoIncrement || attr.IsHidden || attr.Generated != nil {
+				return nil, moerr.NewNotSupportedNoCtx("add column %s in place", attr.Name)
+			}
+			if def, err = ColDefFromAttribute(attr); err != nil {
+				return nil, err
+			}
+			var isNull bool
+			if _, isNull, err = def.constDefault(); err != nil {
+				return nil, err
+			}
+			if isNull && !def.NullAbility {
+				return nil, moerr.NewNotSupportedNoCtx("add column %s without default in place", attr.Name)
+			}
+		}
+		if err = ns.AppendColDef(def); err != nil {
+			return nil, err
+		}
+	}
+	if err = ns.Finalize(false); err != nil {
+		return nil, err
+	}
+	return
+}
+
 func (s *Schema) String() string {
 	buf, _ := json.Marshal(s)
 	return string(buf)
@@ -573,6 +725,16 @@ func (s *Schema) AllTypes() []types.Type {
 	return ts
 }
 
+// CompressOptions returns the options of the object writer to compress
+// the columns of the schema by their definitions
+func (s *Schema) CompressOptions() []objectio.WriterOptionFunc {
+	opts := make([]objectio.WriterOptionFunc, 0, len(s.ColDefs))
+	for _, def := range s.ColDefs {
+		opts = append(opts, objectio.WithColumnCompression(uint16(def.Idx), int(def.Compress), int(def.CompressLevel)))
+	}
+	return opts
+}
+
 func (s *Schema) AllNames() []string {
 	if len(s.ColDefs) == 0 {
 		return make([]string, 0)
@@ -598,6 +760,7 @@ func (s *Schema) Finalize(withoutPhyAddr bool) (err error) {
 			Hidden:      true,
 			NullAbility: false,
 			PhyAddr:     true,
+			Compress:    compress.Lz4,
 		}
 		if err = s.AppendColDef(phyAddrDef); err != nil {
 			return
@@ -94,11 +94,11 @@ func NewSysSegmentEntry(table *TableEntry, id uint64) *SegmentEntry {
 	}
 	e.CreateWithTS(types.SystemDBTS)
 	var bid uint64
-	if table.schema.Name == SystemTableSchema.Name {
+	if table.GetSchema().Name == SystemTableSchema.Name {
 		bid = SystemBlock_Table_ID
-	} else if table.schema.Name == SystemDBSchema.Name {
+	} else if table.GetSchema().Name == SystemDBSchema.Name {
 		bid = SystemBlock_DB_ID
-	} else if table.schema.Name == SystemColumnSchema.Name {
+	} else if table.GetSchema().Name == SystemColumnSchema.Name {
 		bid = SystemBlock_Columns_ID
 	} else {
 		panic("not supported")
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
 
 type TableDataFactory = func(meta *TableEntry) data.Table
@@ -40,7 +41,7 @@ func tableVisibilityFn[T *TableEntry](n *common.GenericDLNode[*TableEntry], ts t
 type TableEntry struct {
 	*TableBaseEntry
 	db      *DBEntry
-	schema  *Schema
+	schema  atomic.Pointer[Schema]
 	entries map[uint64]*common.GenericDLNode[*SegmentEntry]
 	//link.head and link.tail is nil when create tableEntry object.
 	link      *common.GenericSortedDList[*SegmentEntry]
@@ -72,10 +73,10 @@ func NewTableEntryWithTableId(db *DBEntry, schema *Schema, txnCtx txnif.AsyncTxn
 	e := &TableEntry{
 		TableBaseEntry: NewTableBaseEntry(tableId),
 		db:             db,
-		schema:         schema,
 		link:           common.NewGenericSortedDList(compareSegmentFn),
 		entries:        make(map[uint64]*common.GenericDLNode[*SegmentEntry]),
 	}
+	e.schema.Store(schema)
 	if dataFactory != nil {
 		e.tableData = dataFactory(e)
 	}
@@ -87,11 +88,12 @@ func NewSystemTableEntry(db *DBEntry, id uint64, schema *Schema) *TableEntry {
 	e := &TableEntry{
 		TableBaseEntry: NewTableBaseEntry(id),
 		db:             db,
-		schema:         schema,
 		link:           common.NewGenericSortedDList(compareSegmentFn),
 		entries:        make(map[uint64]*common.GenericDLNode[*SegmentEntry]),
 	}
+	e.schema.Store(schema)
 	e.CreateWithTS(types.SystemDBTS)
+	e.GetLatestNodeLocked().(*TableMVCCNode).Schema = schema
 	var sid uint64
 	if schema.Name == SystemTableSchema.Name {
 		sid = SystemSegment_Table_ID
@@ -117,21 +119,22 @@ func NewReplayTableEntry() *TableEntry {
 }
 
 func MockStaloneTableEntry(id uint64, schema *Schema) *TableEntry {
-	return &TableEntry{
+	e := &TableEntry{
 		TableBaseEntry: NewTableBaseEntry(id),
-		schema:         schema,
 		link:           common.NewGenericSortedDList(compareSegmentFn),
 		entries:        make(map[uint64]*common.GenericDLNode[*SegmentEntry]),
 	}
+	e.schema.Store(schema)
+	return e
 }
 
 func (entry *TableEntry) IsVirtual() bool {
 	if !entry.db.IsSystemDB() {
 		return false
 	}
-	return entry.schema.Name == pkgcatalog.MO_DATABASE ||
-		entry.schema.Name == pkgcatalog.MO_TABLES ||
-		entry.schema.Name == pkgcatalog.MO_COLUMNS
+	return entry.GetSchema().Name == pkgcatalog.MO_DATABASE ||
+		entry.GetSchema().Name == pkgcatalog.MO_TABLES ||
+		entry.GetSchema().Name == pkgcatalog.MO_COLUMNS
 }
 
 func (entry *TableEntry) GetRows() uint64 {
@@ -198,19 +201,67 @@ func (entry *TableEntry) deleteEntryLocked(segment *SegmentEntry) error {
 	return nil
 }
 
+// GetSchema returns the latest committed schema of the table
 func (entry *TableEntry) GetSchema() *Schema {
-	return entry.schema
+	return entry.schema.Load()
+}
+
+// GetVisibleSchema returns the schema of the table visible to the txn
+func (entry *TableEntry) GetVisibleSchema(txn txnif.TxnReader) *Schema {
+	return entry.GetSchemaAt(txn.GetStartTS())
+}
+
+// GetSchemaAt returns the schema of the table visible at ts
+func (entry *TableEntry) GetSchemaAt(ts types.TS) *Schema {
+	entry.RLock()
+	defer entry.RUnlock()
+	return entry.GetVisibleSchemaLocked(ts)
+}
+
+func (entry *TableEntry) GetVisibleSchemaLocked(ts types.TS) *Schema {
+	node := entry.GetVisibleNode(ts)
+	if node == nil {
+		return entry.GetSchema()
+	}
+	return entry.SchemaOf(node.(*TableMVCCNode))
+}
+
+// GetLatestSchema returns the schema of the latest node, which is the schema
+// an uncommitted alter of the table is going to commit
+func (entry *TableEntry) GetLatestSchema() *Schema {
+	entry.RLock()
+	defer entry.RUnlock()
+	return entry.SchemaOf(entry.GetLatestNodeLocked().(*TableMVCCNode))
+}
+
+// SchemaOf returns the schema of the table since the node
+func (entry *TableEntry) SchemaOf(node *TableMVCCNode) *Schema {
+	if node.Schema == nil {
+		return entry.GetSchema()
+	}
+	return node.Schema
+}
+
+// GetPrevSchemaLocked returns the schema of the table right before the txn
+// of the node started, nil if the table was not visible then. The node could
+// be a clone of the one in the chain
+func (entry *TableEntry) GetPrevSchemaLocked(node *TableMVCCNode) *Schema {
+	prev := entry.GetVisibleNode(node.Start.Prev())
+	if prev == nil {
+		return nil
+	}
+	return entry.SchemaOf(prev.(*TableMVCCNode))
 }
 
 func (entry *TableEntry) GetColDefs() []*ColDef {
-	colDefs := entry.schema.ColDefs
-	colDefs = append(colDefs, entry.schema.PhyAddrKey)
+	colDefs := entry.GetSchema().ColDefs
+	colDefs = append(colDefs, entry.GetSchema().PhyAddrKey)
 	return colDefs
 }
 
 func (entry *TableEntry) GetFullName() string {
 	if len(entry.fullName) == 0 {
-		entry.fullName = genTblFullName(entry.schema.AcInfo.TenantID, entry.schema.Name)
+		entry.fullName = genTblFullName(entry.GetSchema().AcInfo.TenantID, entry.GetSchema().Name)
 	}
 	return entry.fullName
 }
@@ -249,9 +300,9 @@ func (entry *TableEntry) StringWithLevel(level common.PPLevel) string {
 func (entry *TableEntry) StringLockedWithLevel(level common.PPLevel) string {
 	if level <= common.PPL1 {
 		return fmt.Sprintf("TBL[%d][name=%s][C@%s,D@%s]",
-			entry.ID, entry.schema.Name, entry.GetCreatedAt().ToString(), entry.GetDeleteAt().ToString())
+			entry.ID, entry.GetSchema().Name, entry.GetCreatedAt().ToString(), entry.GetDeleteAt().ToString())
 	}
-	return fmt.Sprintf("TBL%s[name=%s]", entry.TableBaseEntry.StringLocked(), entry.schema.Name)
+	return fmt.Sprintf("TBL%s[name=%s]", entry.TableBaseEntry.StringLocked(), entry.GetSchema().Name)
 }
 
 func (entry *TableEntry) StringLocked() string {
@@ -362,6 +413,30 @@ func (entry *TableEntry) RemoveEntry(segment *SegmentEntry) (err error) {
 	return entry.deleteEntryLocked(segment)
 }
 
+func (entry *TableEntry) ApplyCommit(index *wal.Index) (err error) {
+	entry.Lock()
+	defer entry.Unlock()
+	node := entry.GetLatestNodeLocked().(*TableMVCCNode)
+	if err = node.ApplyCommit(index); err != nil {
+		return
+	}
+	entry.applySchemaLocked(node)
+	return
+}
+
+// applySchemaLocked makes the schema of the committed node the latest
+// committed schema of the table
+func (entry *TableEntry) applySchemaLocked(node *TableMVCCNode) {
+	schema := node.Schema
+	if schema == nil || schema == entry.GetSchema() {
+		return
+	}
+	if prev := entry.GetSchema(); prev != nil && entry.db != nil {
+		entry.db.catalog.AddColumnCnt(len(schema.ColDefs) - len(prev.ColDefs))
+	}
+	entry.schema.Store(schema)
+}
+
 func (entry *TableEntry) PrepareRollback() (err error) {
 	var isEmpty bool
 	isEmpty, err = entry.TableBaseEntry.PrepareRollback()
@@ -381,7 +456,7 @@ func (entry *TableEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if n, err = entry.TableBaseEntry.WriteAllTo(w); err != nil {
 		return
 	}
-	buf, err := entry.schema.Marshal()
+	buf, err := entry.GetSchema().Marshal()
 	if err != nil {
 		return
 	}
@@ -395,11 +470,11 @@ func (entry *TableEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.TableBaseEntry.ReadAllFrom(r); err != nil {
 		return
 	}
-	if entry.schema == nil {
-		entry.schema = NewEmptySchema("")
+	if entry.GetSchema() == nil {
+		entry.schema.Store(NewEmptySchema(""))
 	}
 	sn := int64(0)
-	sn, err = entry.schema.ReadFrom(r)
+	sn, err = entry.GetSchema().ReadFrom(r)
 	n += sn
 	return
 }
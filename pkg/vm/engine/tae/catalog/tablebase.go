@@ -90,6 +90,7 @@ func (be *TableBaseEntry) CreateWithTxn(txn txnif.AsyncTxn, schema *Schema) {
 		},
 		TxnMVCCNode:       txnbase.NewTxnMVCCNodeWithTxn(txn),
 		SchemaConstraints: string(schema.Constraint),
+		Schema:            schema,
 	}
 	be.Insert(node)
 }
@@ -132,6 +133,26 @@ func (be *TableBaseEntry) UpdateConstraint(txn txnif.TxnReader, cstr []byte) (is
 	return
 }
 
+// UpdateSchema makes schema the schema of the table since the txn commits
+func (be *TableBaseEntry) UpdateSchema(txn txnif.TxnReader, schema *Schema) (isNewNode bool, err error) {
+	be.Lock()
+	defer be.Unlock()
+	needWait, txnToWait := be.NeedWaitCommitting(txn.GetStartTS())
+	if needWait {
+		be.Unlock()
+		txnToWait.GetTxnState(true)
+		be.Lock()
+	}
+	err = be.CheckConflict(txn)
+	if err != nil {
+		return
+	}
+	var entry *TableMVCCNode
+	isNewNode, entry = be.getOrSetUpdateNode(txn)
+	entry.Schema = schema
+	return
+}
+
 func (be *TableBaseEntry) DeleteBefore(ts types.TS) bool {
 	createAt := be.GetDeleteAt()
 	if createAt.IsEmpty() {
@@ -67,11 +67,11 @@ func CompareGeneric(a, b any, t types.Type) int64 {
 		return CompareOrdered[int64](a, b)
 	case types.T_uint8:
 		return CompareOrdered[uint8](a, b)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return CompareOrdered[uint16](a, b)
 	case types.T_uint32:
 		return CompareOrdered[uint32](a, b)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return CompareOrdered[uint64](a, b)
 	case types.T_decimal64:
 		return int64(a.(types.Decimal64).Compare(b.(types.Decimal64)))
@@ -187,11 +187,11 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 		return GetOffsetOfOrdered[int64](data.Slice(), v, skipmask)
 	case types.T_uint8:
 		return GetOffsetOfOrdered[uint8](data.Slice(), v, skipmask)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return GetOffsetOfOrdered[uint16](data.Slice(), v, skipmask)
 	case types.T_uint32:
 		return GetOffsetOfOrdered[uint32](data.Slice(), v, skipmask)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return GetOffsetOfOrdered[uint64](data.Slice(), v, skipmask)
 	case types.T_float32:
 		return GetOffsetOfOrdered[float32](data.Slice(), v, skipmask)
@@ -34,11 +34,11 @@ func MakeVector(typ types.Type, nullable bool, opts ...Options) (vec Vector) {
 		vec = NewVector[int64](typ, nullable, opts...)
 	case types.T_uint8:
 		vec = NewVector[uint8](typ, nullable, opts...)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vec = NewVector[uint16](typ, nullable, opts...)
 	case types.T_uint32:
 		vec = NewVector[uint32](typ, nullable, opts...)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		vec = NewVector[uint64](typ, nullable, opts...)
 	case types.T_decimal64:
 		vec = NewVector[types.Decimal64](typ, nullable, opts...)
@@ -145,11 +145,11 @@ func NewVectorWithSharedMemory(v *movec.Vector, nullable bool) Vector {
 		bs = movecToBytes[int64](v)
 	case types.T_uint8:
 		bs = movecToBytes[uint8](v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		bs = movecToBytes[uint16](v)
 	case types.T_uint32:
 		bs = movecToBytes[uint32](v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		bs = movecToBytes[uint64](v)
 	case types.T_float32:
 		bs = movecToBytes[float32](v)
@@ -284,7 +284,7 @@ func MockVec(typ types.Type, rows int, offset int) *movec.Vector {
 			data = append(data, uint8(i+offset))
 		}
 		_ = movec.AppendFixedList(vec, data, nil, mockMp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data := make([]uint16, 0)
 		for i := 0; i < rows; i++ {
 			data = append(data, uint16(i+offset))
@@ -296,7 +296,7 @@ func MockVec(typ types.Type, rows int, offset int) *movec.Vector {
 			data = append(data, uint32(i+offset))
 		}
 		_ = movec.AppendFixedList(vec, data, nil, mockMp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		data := make([]uint64, 0)
 		for i := 0; i < rows; i++ {
 			data = append(data, uint64(i+offset))
@@ -434,11 +434,11 @@ func AppendValue(vec *movec.Vector, v any) {
 		AppendFixedValue[int64](vec, v)
 	case types.T_uint8:
 		AppendFixedValue[uint8](vec, v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		AppendFixedValue[uint16](vec, v)
 	case types.T_uint32:
 		AppendFixedValue[uint32](vec, v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		AppendFixedValue[uint64](vec, v)
 	case types.T_decimal64:
 		AppendFixedValue[types.Decimal64](vec, v)
@@ -487,11 +487,11 @@ func GetValue(col *movec.Vector, row uint32) any {
 		return movec.GetFixedAt[int64](col, int(row))
 	case types.T_uint8:
 		return movec.GetFixedAt[uint8](col, int(row))
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return movec.GetFixedAt[uint16](col, int(row))
 	case types.T_uint32:
 		return movec.GetFixedAt[uint32](col, int(row))
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return movec.GetFixedAt[uint64](col, int(row))
 	case types.T_decimal64:
 		return movec.GetFixedAt[types.Decimal64](col, int(row))
@@ -537,11 +537,11 @@ func UpdateValue(col *movec.Vector, row uint32, val any) {
 		GenericUpdateFixedValue[int64](col, row, val)
 	case types.T_uint8:
 		GenericUpdateFixedValue[uint8](col, row, val)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		GenericUpdateFixedValue[uint16](col, row, val)
 	case types.T_uint32:
 		GenericUpdateFixedValue[uint32](col, row, val)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		GenericUpdateFixedValue[uint64](col, row, val)
 	case types.T_decimal64:
 		GenericUpdateFixedValue[types.Decimal64](col, row, val)
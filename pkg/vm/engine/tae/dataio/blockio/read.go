@@ -21,31 +21,35 @@ import (
 
 	"github.com/RoaringBitmap/roaring"
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
-	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 )
 
-// BlockRead read block data from storage and apply deletes according given timestamp. Caller make sure metaloc is not empty
+// BlockRead read block data from storage and apply deletes according given timestamp. Caller make sure metaloc is not empty.
+// colDefaults are the defaults of the columns, which fill the columns added to the table after the block is written
 func BlockRead(
 	ctx context.Context,
 	info *pkgcatalog.BlockInfo,
 	colIdxes []uint16,
 	colTypes []types.Type,
+	colDefaults []*plan.Default,
 	ts timestamp.Timestamp,
 	fs fileservice.FileService,
 	pool *mpool.MPool) (*batch.Batch, error) {
 
 	// read
 	columnBatch, err := BlockReadInner(
-		ctx, info, colIdxes, colTypes,
+		ctx, info, colIdxes, colTypes, colDefaults,
 		types.TimestampToTS(ts), fs, pool,
 	)
 	if err != nil {
@@ -73,10 +77,11 @@ func BlockReadInner(
 	info *pkgcatalog.BlockInfo,
 	colIdxes []uint16,
 	colTypes []types.Type,
+	colDefaults []*plan.Default,
 	ts types.TS,
 	fs fileservice.FileService,
 	pool *mpool.MPool) (*containers.Batch, error) {
-	columnBatch, err := readBlockData(ctx, colIdxes, colTypes, info, ts,
+	columnBatch, err := readBlockData(ctx, colIdxes, colTypes, colDefaults, info, ts,
 		fs, pool)
 	if err != nil {
 		return nil, err
@@ -126,11 +131,28 @@ func getRowsIdIndex(colIndexes []uint16, colTypes []types.Type) (bool, uint16, [
 	return found, uint16(idx), idxes
 }
 
+// makeDefaultVector returns the vector of the rows of a column which is added
+// to the table after the block is written
+func makeDefaultVector(def *plan.Default, typ types.Type, rows int) (containers.Vector, error) {
+	val, isNull, ok := pkgcatalog.GetConstDefault(def, typ)
+	if !ok {
+		return nil, moerr.NewNotSupportedNoCtx("non-constant default of the column")
+	}
+	if isNull {
+		val = types.Null{}
+	}
+	vec := containers.MakeVector(typ, true)
+	for i := 0; i < rows; i++ {
+		vec.Append(val)
+	}
+	return vec, nil
+}
+
 func readBlockData(ctx context.Context, colIndexes []uint16,
-	colTypes []types.Type, info *pkgcatalog.BlockInfo, ts types.TS,
+	colTypes []types.Type, colDefaults []*plan.Default, info *pkgcatalog.BlockInfo, ts types.TS,
 	fs fileservice.FileService, m *mpool.MPool) (*containers.Batch, error) {
 	ok, _, idxes := getRowsIdIndex(colIndexes, colTypes)
-	_, id, extent, rows, err := DecodeLocation(info.MetaLoc)
+	_, id, _, rows, err := DecodeLocation(info.MetaLoc)
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +160,19 @@ func readBlockData(ctx context.Context, colIndexes []uint16,
 	if err != nil {
 		return nil, err
 	}
+	width, err := reader.(*BlockReader).LoadDataWidth(ctx, id, info.EntryState)
+	if err != nil {
+		return nil, err
+	}
+	// the columns added to the table after the block is written are not in
+	// the block
+	written := idxes[:0:0]
+	for _, idx := range idxes {
+		if int(idx) < width {
+			written = append(written, idx)
+		}
+	}
+	idxes = written
 	var rowIdVec containers.Vector
 	bat := containers.NewBatch()
 	defer func() {
@@ -166,19 +201,32 @@ func readBlockData(ctx context.Context, colIndexes []uint16,
 	}
 
 	loadBlock := func(idxes []uint16) ([]*batch.Batch, error) {
-		if len(idxes) == 0 && ok {
+		if len(idxes) == 0 && ok && len(colTypes) == 1 {
 			// only read rowid column on non appendable block, return early
 			bat.AddVector(catalog.AttrRowID, rowIdVec)
 			return nil, nil
 		}
-		bats, err := reader.LoadColumns(ctx, idxes, []uint32{id}, m)
-		if err != nil {
-			return nil, err
+		var bats []*batch.Batch
+		var entry []*vector.Vector
+		if len(idxes) > 0 {
+			if bats, err = reader.LoadColumns(ctx, idxes, []uint32{id}, m); err != nil {
+				return nil, err
+			}
+			entry = bats[0].Vecs
 		}
-		entry := bats[0].Vecs
 		for i, typ := range colTypes {
 			if typ.Oid == types.T_Rowid {
 				bat.AddVector(fmt.Sprintf("%d", i), rowIdVec)
+			} else if int(colIndexes[i]) >= width {
+				var def *plan.Default
+				if i < len(colDefaults) {
+					def = colDefaults[i]
+				}
+				vec, err := makeDefaultVector(def, typ, int(rows))
+				if err != nil {
+					return nil, err
+				}
+				bat.AddVector(fmt.Sprintf("%d", i), vec)
 			} else {
 				bat.AddVector(fmt.Sprintf("%d", i),
 					containers.NewVectorWithSharedMemory(entry[0], true))
@@ -190,14 +238,8 @@ func readBlockData(ctx context.Context, colIndexes []uint16,
 
 	loadAppendBlock := func() error {
 		// appendable block should be filtered by committs
-		blocks, err := reader.(*BlockReader).reader.ReadMeta(ctx, []objectio.Extent{extent}, m, LoadZoneMapFunc)
-		if err != nil {
-			return err
-		}
-
-		colCount := blocks[0].GetColumnCount()
-		idxes = append(idxes, colCount-2) // committs
-		idxes = append(idxes, colCount-1) // aborted
+		idxes = append(idxes, uint16(width+1)) // committs
+		idxes = append(idxes, uint16(width+2)) // aborted
 		bats, err := loadBlock(idxes)
 		if err != nil {
 			return err
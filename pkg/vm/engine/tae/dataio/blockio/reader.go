@@ -20,7 +20,6 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
-	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -167,6 +166,32 @@ func (r *BlockReader) LoadBlocksMeta(ctx context.Context, m *mpool.MPool) ([]obj
 	return r.reader.ReadMeta(ctx, locs, m, LoadZoneMapFunc)
 }
 
+// LoadColumnCount returns the count of the columns written to the block id,
+// the blocks written before the columns are added to the table have less
+// columns than the schema of the table
+func (r *BlockReader) LoadColumnCount(ctx context.Context, id uint32, m *mpool.MPool) (uint16, error) {
+	blocks, err := r.reader.ReadMeta(ctx, []objectio.Extent{r.meta}, m, LoadZoneMapFunc)
+	if err != nil {
+		return 0, err
+	}
+	return blocks[id].GetColumnCount(), nil
+}
+
+// LoadDataWidth returns the count of the data columns written to the block
+// id. The data of an appendable block is followed by the physical address,
+// the commit ts and the aborted columns.
+func (r *BlockReader) LoadDataWidth(ctx context.Context, id uint32, appendable bool) (width int, err error) {
+	cnt, err := r.LoadColumnCount(ctx, id, nil)
+	if err != nil {
+		return
+	}
+	width = int(cnt)
+	if appendable {
+		width -= 3
+	}
+	return
+}
+
 func (r *BlockReader) LoadAllBlocks(ctx context.Context, size int64, m *mpool.MPool) ([]objectio.BlockObject, error) {
 	blocks, err := r.reader.ReadAllMeta(ctx, size, m, LoadZoneMapFunc)
 	if err != nil {
@@ -239,46 +264,22 @@ func LoadZoneMapFunc(buf []byte, typ types.Type) (any, error) {
 
 func LoadBloomFilterFunc(size int64) objectio.ToObjectFunc {
 	return func(reader io.Reader, data []byte) (any, int64, error) {
-		// decompress
-		var err error
-		if len(data) == 0 {
-			data, err = io.ReadAll(reader)
-			if err != nil {
-				return nil, 0, err
-			}
-		}
-		decompressed := make([]byte, size)
-		decompressed, err = compress.Decompress(data, decompressed, compress.Lz4)
-		if err != nil {
-			return nil, 0, err
-		}
-		bf, err := index.NewBinaryFuseFilterFromSource(decompressed)
+		// the data has been decompressed by objectio
+		bf, err := index.NewBinaryFuseFilterFromSource(data)
 		if err != nil {
 			return nil, 0, err
 		}
-		return bf, int64(len(decompressed)), nil
+		return bf, int64(len(data)), nil
 	}
 }
 
 func LoadColumnFunc(size int64) objectio.ToObjectFunc {
 	return func(reader io.Reader, data []byte) (any, int64, error) {
-		// decompress
-		var err error
-		if len(data) == 0 {
-			data, err = io.ReadAll(reader)
-			if err != nil {
-				return nil, 0, err
-			}
-		}
-		decompressed := make([]byte, size)
-		decompressed, err = compress.Decompress(data, decompressed, compress.Lz4)
-		if err != nil {
-			return nil, 0, err
-		}
+		// the data has been decompressed and decoded by objectio
 		vec := vector.NewVec(types.Type{})
-		if err = vec.UnmarshalBinary(decompressed); err != nil {
+		if err := vec.UnmarshalBinary(data); err != nil {
 			return nil, 0, err
 		}
-		return vec, int64(len(decompressed)), nil
+		return vec, int64(len(data)), nil
 	}
 }
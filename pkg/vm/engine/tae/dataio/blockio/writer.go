@@ -35,8 +35,8 @@ type BlockWriter struct {
 	name    string
 }
 
-func NewBlockWriter(fs fileservice.FileService, name string) (*BlockWriter, error) {
-	writer, err := objectio.NewObjectWriter(name, fs)
+func NewBlockWriter(fs fileservice.FileService, name string, opts ...objectio.WriterOptionFunc) (*BlockWriter, error) {
+	writer, err := objectio.NewObjectWriter(name, fs, opts...)
 	if err != nil {
 		return nil, err
 	}
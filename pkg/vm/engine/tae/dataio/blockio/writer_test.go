@@ -21,11 +21,13 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/stretchr/testify/assert"
@@ -90,3 +92,34 @@ func TestWriter_WriteBlockAndZoneMap(t *testing.T) {
 	res = zm.Contains(int32(100000))
 	require.False(t, res)
 }
+
+func TestWriter_WriteBlockWithCompression(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	dir := testutils.InitTestEnv(ModuleName, t)
+	dir = path.Join(dir, "/local")
+	name := "compress.blk"
+	bat := newBatch()
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(c, nil)
+	assert.Nil(t, err)
+	schema := catalog.MockSchema(len(bat.Vecs), 0)
+	schema.ColDefs[1].Compress = compress.Zstd
+	schema.ColDefs[1].CompressLevel = 19
+	schema.ColDefs[2].Compress = compress.None
+	writer, err := NewBlockWriter(service, name, schema.CompressOptions()...)
+	assert.Nil(t, err)
+	_, err = writer.WriteBatch(bat)
+	assert.Nil(t, err)
+	blocks, _, err := writer.Sync(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(blocks))
+	for idx, alg := range []int{compress.Lz4, compress.Zstd, compress.None, compress.Lz4} {
+		col, err := blocks[0].GetColumn(uint16(idx))
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(alg), col.GetMeta().GetAlg())
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
@@ -38,6 +39,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
 
@@ -2215,6 +2217,90 @@ func TestSnapshotIsolation2(t *testing.T) {
 	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrTxnWWConflict))
 }
 
+// Testing Steps
+// 1. Start txn1 with RC isolation
+// 2. Start txn2 and append one row and commit
+// 3. Txn1 try to append the row. Duplicate instead of W-W. Rollback
+func TestReadCommittedDedup(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 12)
+	schema.BlockMaxRows = 100
+	bat := catalog.MockBatch(schema, 1)
+	defer bat.Close()
+
+	createRelation(t, tae, "db", schema, true)
+
+	// Step 1
+	txn1, rel1 := getDefaultRelation(t, tae, schema.Name)
+	txn1.SetIsolation(txnif.IsolationRC)
+
+	// Step 2
+	txn2, rel2 := getDefaultRelation(t, tae, schema.Name)
+	err := rel2.Append(bat)
+	assert.NoError(t, err)
+	assert.NoError(t, txn2.Commit())
+
+	// Step 3
+	err = rel1.Append(bat)
+	assert.NoError(t, err)
+	err = txn1.Commit()
+	t.Log(err)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrDuplicateEntry))
+}
+
+// Testing Steps
+// 1. Append 9 rows into an appendable block
+// 2. Start txn1 with RC isolation
+// 3. Start txn2 and append the 10th row and commit
+// 4. Compact the full block, its data is persisted
+// 5. Txn1 try to append the 10th row. W-W conflict against the persisted block
+func TestReadCommittedPersistedConflict(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 12)
+	schema.BlockMaxRows = 10
+	bat := catalog.MockBatch(schema, 10)
+	defer bat.Close()
+	bats := bat.Split(10)
+
+	// Step 1
+	createRelationAndAppend(t, 0, tae, "db", schema, bats[0], true)
+	txn, rel := getDefaultRelation(t, tae, schema.Name)
+	for _, b := range bats[1:9] {
+		assert.NoError(t, rel.Append(b))
+	}
+	assert.NoError(t, txn.Commit())
+
+	// Step 2
+	txn1, rel1 := getDefaultRelation(t, tae, schema.Name)
+	txn1.SetIsolation(txnif.IsolationRC)
+
+	// Step 3
+	txn2, rel2 := getDefaultRelation(t, tae, schema.Name)
+	assert.NoError(t, rel2.Append(bats[9]))
+	assert.NoError(t, txn2.Commit())
+
+	// Step 4
+	txn, rel = getDefaultRelation(t, tae, schema.Name)
+	meta := getOneBlockMeta(rel)
+	assert.NoError(t, txn.Commit())
+	compactBlocks(t, 0, tae, "db", schema, false)
+	assert.True(t, meta.HasDropCommitted())
+
+	// Step 5
+	err := rel1.Append(bats[9])
+	t.Log(err)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrTxnWWConflict))
+	assert.NoError(t, txn1.Rollback())
+}
+
 // 1. Append 3 blocks and delete last 5 rows of the 1st block
 // 2. Merge blocks
 // 3. Check rows and col[0]
@@ -4040,7 +4126,7 @@ func TestBlockRead(t *testing.T) {
 	pool, err := mpool.NewMPool("test", 0, mpool.NoFixed)
 	assert.NoError(t, err)
 	b1, err := blockio.BlockReadInner(
-		context.Background(), info, colIdxs, colTyps,
+		context.Background(), info, colIdxs, colTyps, nil,
 		beforeDel, fs, pool,
 	)
 	assert.NoError(t, err)
@@ -4049,14 +4135,14 @@ func TestBlockRead(t *testing.T) {
 	assert.Equal(t, 20, b1.Vecs[0].Length())
 
 	b2, err := blockio.BlockReadInner(
-		context.Background(), info, colIdxs, colTyps,
+		context.Background(), info, colIdxs, colTyps, nil,
 		afterFirstDel, fs, pool,
 	)
 	assert.NoError(t, err)
 	defer b2.Close()
 	assert.Equal(t, 19, b2.Vecs[0].Length())
 	b3, err := blockio.BlockReadInner(
-		context.Background(), info, colIdxs, colTyps,
+		context.Background(), info, colIdxs, colTyps, nil,
 		afterSecondDel, fs, pool,
 	)
 	assert.NoError(t, err)
@@ -4069,6 +4155,7 @@ func TestBlockRead(t *testing.T) {
 		context.Background(), info,
 		[]uint16{2},
 		[]types.Type{types.T_Rowid.ToType()},
+		nil,
 		afterSecondDel, fs, pool,
 	)
 	assert.NoError(t, err)
@@ -4082,6 +4169,7 @@ func TestBlockRead(t *testing.T) {
 		context.Background(), info,
 		[]uint16{2},
 		[]types.Type{types.T_Rowid.ToType()},
+		nil,
 		afterSecondDel, fs, pool,
 	)
 	assert.NoError(t, err)
@@ -5008,6 +5096,100 @@ func TestUpdateCstr(t *testing.T) {
 	assert.Equal(t, api.Entry_Delete, resp.Commands[1].EntryType)
 }
 
+func TestAlterColumns(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(3, 1)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 20)
+	defer bat.Close()
+	bats := bat.Split(2)
+
+	// one persisted block and one appendable block written with the old schema
+	tae.createRelAndAppend(bats[0], true)
+	tae.compactBlocks(false)
+	tae.DoAppend(bats[1])
+
+	// rename the first column and add a column with the default 7
+	var attrs []engine.Attribute
+	for _, def := range schema.ColDefs {
+		if def.IsPhyAddr() {
+			continue
+		}
+		attr := engine.Attribute{Name: def.Name, Type: def.Type}
+		if def.Idx == 0 {
+			attr.Name = "renamed"
+		}
+		attrs = append(attrs, attr)
+	}
+	attrs = append(attrs, engine.Attribute{
+		Name: "added",
+		Type: types.T_int32.ToType(),
+		Default: &plan.Default{
+			NullAbility: true,
+			Expr: &plan.Expr{
+				Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I32Val{I32Val: 7}}},
+			},
+		},
+	})
+	txn, rel := tae.getRelation()
+	assert.NoError(t, rel.AlterColumns(attrs))
+	assert.NoError(t, txn.Commit())
+
+	check := func() {
+		txn, rel := tae.getRelation()
+		newSchema := rel.Schema().(*catalog.Schema)
+		assert.Equal(t, []string{"renamed", schema.ColDefs[1].Name, schema.ColDefs[2].Name, "added"}, newSchema.Attrs())
+		rows := 0
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blk := it.GetBlock()
+			view, err := blk.GetColumnDataByName("added")
+			assert.NoError(t, err)
+			for i := 0; i < view.Length(); i++ {
+				assert.Equal(t, int32(7), view.GetData().Get(i))
+			}
+			rows += view.Length()
+			view.Close()
+			view, err = blk.GetColumnDataByName("renamed")
+			assert.NoError(t, err)
+			assert.Equal(t, blk.Rows(), view.Length())
+			view.Close()
+			it.Next()
+		}
+		assert.Equal(t, 20, rows)
+		assert.NoError(t, txn.Commit())
+	}
+	check()
+
+	// the blocks compacted after the change are written with the new schema
+	tae.compactBlocks(false)
+	check()
+
+	// the renamed column is deleted from mo_columns
+	tots := func(ts types.TS) *timestamp.Timestamp {
+		return &timestamp.Timestamp{PhysicalTime: types.DecodeInt64(ts[4:12]), LogicalTime: types.DecodeUint32(ts[:4])}
+	}
+	resp, _ := logtail.HandleSyncLogTailReq(context.Background(), new(dummyCpkGetter), tae.LogtailMgr, tae.Catalog, api.SyncLogTailReq{
+		CnHave: tots(types.BuildTS(0, 0)),
+		CnWant: tots(types.MaxTs()),
+		Table:  &api.TableID{DbId: pkgcatalog.MO_CATALOG_ID, TbId: pkgcatalog.MO_COLUMNS_ID},
+	}, true)
+	assert.Equal(t, 2, len(resp.Commands))
+	assert.Equal(t, api.Entry_Insert, resp.Commands[0].EntryType)
+	assert.Equal(t, api.Entry_Delete, resp.Commands[1].EntryType)
+	delBat, _ := batch.ProtoBatchToBatch(resp.Commands[1].Bat)
+	assert.Equal(t, 1, delBat.Vecs[0].Length())
+
+	tae.restart()
+	check()
+}
+
 func TestGlobalCheckpoint1(t *testing.T) {
 	defer testutils.AfterTest(t)()
 	testutils.EnsureNoLeak(t)
@@ -167,8 +167,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	db.DiskCleaner.AddChecker(
 		func(item any) bool {
 			checkpoint := item.(*checkpoint.CheckpointEntry)
-			ts := types.BuildTS(time.Now().UTC().UnixNano()-int64(opts.GCCfg.GCTTL), 0)
-			return !checkpoint.GetEnd().GreaterEq(ts)
+			return checkpoint.GetEnd().Less(gcTTLTS(opts))
 		})
 	// Init gc manager at last
 	// TODO: clean-try-gc requires configuration parameters
@@ -199,7 +198,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 				if consumed == nil {
 					return nil
 				}
-				return db.BGCheckpointRunner.GCByTS(ctx, consumed.GetEnd())
+				return db.BGCheckpointRunner.GCByTS(ctx, minGCTS(consumed.GetEnd(), opts))
 			}),
 		gc.WithCronJob(
 			"catalog-gc",
@@ -212,7 +211,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 				if consumed == nil {
 					return nil
 				}
-				db.Catalog.GCByTS(ctx, consumed.GetEnd())
+				db.Catalog.GCByTS(ctx, minGCTS(consumed.GetEnd(), opts))
 				return nil
 			}),
 		gc.WithCronJob(
@@ -234,3 +233,17 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	// logutil.Info(db.Catalog.SimplePPString(common.PPL2))
 	return
 }
+
+// gcTTLTS returns the ts before which the data can be collected, the ttl
+// covers the snapshot retention of the AS OF queries.
+func gcTTLTS(opts *options.Options) types.TS {
+	return types.BuildTS(time.Now().UTC().UnixNano()-int64(opts.GCCfg.GCTTL), 0)
+}
+
+// minGCTS bounds the ts a gc job collects to by the gc ttl
+func minGCTS(ts types.TS, opts *options.Options) types.TS {
+	if ttl := gcTTLTS(opts); ttl.Less(ts) {
+		return ttl
+	}
+	return ts
+}
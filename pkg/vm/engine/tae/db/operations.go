@@ -74,6 +74,7 @@ type Request interface {
 		CreateRelationReq |
 		DropOrTruncateRelationReq |
 		UpdateConstraintReq |
+		AlterColumnsReq |
 		WriteReq |
 		apipb.SyncLogTailReq
 }
@@ -84,6 +85,7 @@ type Response interface {
 		CreateRelationResp |
 		DropOrTruncateRelationResp |
 		UpdateConstraintResp |
+		AlterColumnsResp |
 		WriteResp |
 		apipb.SyncLogTailResp
 }
@@ -157,6 +159,16 @@ type UpdateConstraintReq struct {
 
 type UpdateConstraintResp struct{}
 
+type AlterColumnsReq struct {
+	TableId      uint64
+	TableName    string
+	DatabaseId   uint64
+	DatabaseName string
+	Defs         []engine.TableDef
+}
+
+type AlterColumnsResp struct{}
+
 type CreateRelationResp struct {
 	ID uint64
 }
@@ -15,6 +15,7 @@
 package handle
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio"
 	"io"
 
@@ -31,6 +32,7 @@ type Relation interface {
 	GetCardinality(attr string) int64
 	Schema() any
 	UpdateConstraint([]byte) error
+	AlterColumns([]engine.Attribute) error
 	MakeSegmentIt() SegmentIt
 	MakeSegmentItOnSnap() SegmentIt
 	MakeBlockIt() BlockIt
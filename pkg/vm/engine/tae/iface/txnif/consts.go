@@ -56,6 +56,18 @@ const (
 	PKDedupSkipSnapshot
 )
 
+// IsolationLevel is the isolation of the txn initiated by CN, it decides whether the
+// rows committed after the start of the txn are w-w conflicts at commit.
+type IsolationLevel uint8
+
+const (
+	// IsolationSI the first committer wins, the rows committed after the start of the
+	// txn are w-w conflicts
+	IsolationSI IsolationLevel = iota
+	// IsolationRC the rows committed before the dedup are visible, they are duplicates
+	IsolationRC
+)
+
 func TxnStrState(state TxnState) string {
 	switch state {
 	case TxnStateActive:
@@ -50,6 +50,7 @@ type TxnReader interface {
 	IsReplay() bool
 	Is2PC() bool
 	GetPKDedupSkip() PKDedupSkipScope
+	GetIsolation() IsolationLevel
 	GetID() string
 	GetCtx() []byte
 	GetStartTS() types.TS
@@ -105,6 +106,7 @@ type TxnChanger interface {
 	Rollback() error
 	SetCommitTS(cts types.TS) error
 	SetPKDedupSkip(skip PKDedupSkipScope)
+	SetIsolation(isolation IsolationLevel)
 	SetParticipants(ids []uint64) error
 	SetError(error)
 
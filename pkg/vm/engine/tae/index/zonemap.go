@@ -331,7 +331,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[uint8](buf[:1])
 		return nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		zm.min = types.DecodeFixed[uint16](buf[:2])
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[uint16](buf[:2])
@@ -341,7 +341,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[uint32](buf[:4])
 		return nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		zm.min = types.DecodeFixed[uint64](buf[:8])
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[uint64](buf[:8])
@@ -450,7 +450,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		zm.min = types.DecodeFixed[uint8](min[:1])
 		zm.max = types.DecodeFixed[uint8](max[:1])
 		return nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		zm.min = types.DecodeFixed[uint16](min[:2])
 		zm.max = types.DecodeFixed[uint16](max[:2])
 		return nil
@@ -459,7 +459,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		//buf = buf[32:]
 		zm.max = types.DecodeFixed[uint32](max[:4])
 		return nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		zm.min = types.DecodeFixed[uint64](min[:8])
 		zm.max = types.DecodeFixed[uint64](max[:8])
 		return nil
@@ -262,19 +262,31 @@ func (b *CatalogLogtailRespBuilder) VisitTbl(entry *catalog.TableEntry) error {
 		return nil
 	}
 	mvccNodes := entry.ClonePreparedInRange(b.start, b.end)
+	prevSchemas := make([]*catalog.Schema, len(mvccNodes))
+	for i, node := range mvccNodes {
+		prevSchemas[i] = entry.GetPrevSchemaLocked(node.(*catalog.TableMVCCNode))
+	}
 	entry.RUnlock()
-	for _, node := range mvccNodes {
+	for i, node := range mvccNodes {
 		if node.IsAborted() {
 			continue
 		}
 		tblNode := node.(*catalog.TableMVCCNode)
 		if b.scope == ScopeColumns {
+			schema := entry.SchemaOf(tblNode)
+			tableID := entry.GetID()
+			commitTs := tblNode.GetEnd()
 			var dstBatch *containers.Batch
 			if !tblNode.HasDropCommitted() {
 				dstBatch = b.insBatch
 				// fill unique syscol fields if inserting
 				for _, syscol := range catalog.SystemColumnSchema.ColDefs {
-					txnimpl.FillColumnRow(entry, syscol.Name, b.insBatch.GetVectorByName(syscol.Name))
+					txnimpl.FillColumnRow(entry, schema, syscol.Name, b.insBatch.GetVectorByName(syscol.Name))
+				}
+				// the columns renamed by the node are deleted
+				for _, name := range droppedColumnNames(prevSchemas[i], schema) {
+					b.delBatch.GetVectorByName(catalog.AttrRowID).Append(bytesToRowID([]byte(fmt.Sprintf("%d-%s", tableID, name))))
+					b.delBatch.GetVectorByName(catalog.AttrCommitTs).Append(commitTs)
 				}
 			} else {
 				dstBatch = b.delBatch
@@ -283,9 +295,7 @@ func (b *CatalogLogtailRespBuilder) VisitTbl(entry *catalog.TableEntry) error {
 			// fill common syscol fields for every user column
 			rowidVec := dstBatch.GetVectorByName(catalog.AttrRowID)
 			commitVec := dstBatch.GetVectorByName(catalog.AttrCommitTs)
-			tableID := entry.GetID()
-			commitTs := tblNode.GetEnd()
-			for _, usercol := range entry.GetSchema().ColDefs {
+			for _, usercol := range schema.ColDefs {
 				rowidVec.Append(bytesToRowID([]byte(fmt.Sprintf("%d-%s", tableID, usercol.Name))))
 				commitVec.Append(commitTs)
 			}
@@ -96,6 +96,20 @@ func bytesToRowID(bs []byte) types.Rowid {
 	return rowid
 }
 
+// droppedColumnNames returns the names of the columns in prev which are not
+// in cur anymore, e.g. the old name of a renamed column
+func droppedColumnNames(prev, cur *catalog.Schema) (names []string) {
+	if prev == nil || prev == cur {
+		return
+	}
+	for _, def := range prev.ColDefs {
+		if _, ok := cur.NameIndex[def.Name]; !ok {
+			names = append(names, def.Name)
+		}
+	}
+	return
+}
+
 // make batch, append necessary field like commit ts
 func makeRespBatchFromSchema(schema *catalog.Schema) *containers.Batch {
 	bat := containers.NewBatch()
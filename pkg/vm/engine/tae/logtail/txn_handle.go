@@ -151,13 +151,14 @@ func (b *TxnLogtailRespBuilder) visitTable(itbl any) {
 		catalogEntry2Batch(b.batches[tblDelBatch], tbl, DelSchema, txnimpl.FillTableRow, u64ToRowID(tbl.GetID()), b.txn.GetPrepareTS(), b.txn.GetStartTS())
 	}
 	if node.CreatedAt.Equal(txnif.UncommitTS) {
+		schema := tbl.SchemaOf(node)
 		if b.batches[columnInsBatch] == nil {
 			b.batches[columnInsBatch] = makeRespBatchFromSchema(catalog.SystemColumnSchema)
 		}
 		for _, syscol := range catalog.SystemColumnSchema.ColDefs {
-			txnimpl.FillColumnRow(tbl, syscol.Name, b.batches[columnInsBatch].GetVectorByName(syscol.Name))
+			txnimpl.FillColumnRow(tbl, schema, syscol.Name, b.batches[columnInsBatch].GetVectorByName(syscol.Name))
 		}
-		for _, usercol := range tbl.GetSchema().ColDefs {
+		for _, usercol := range schema.ColDefs {
 			b.batches[columnInsBatch].GetVectorByName(catalog.AttrRowID).Append(bytesToRowID([]byte(fmt.Sprintf("%d-%s", tbl.ID, usercol.Name))))
 			b.batches[columnInsBatch].GetVectorByName(catalog.AttrCommitTs).Append(b.txn.GetPrepareTS())
 		}
@@ -166,15 +167,25 @@ func (b *TxnLogtailRespBuilder) visitTable(itbl any) {
 		}
 		catalogEntry2Batch(b.batches[tblInsBatch], tbl, catalog.SystemTableSchema, txnimpl.FillTableRow, u64ToRowID(tbl.GetID()), b.txn.GetPrepareTS(), b.txn.GetStartTS())
 	}
-	// update table constraint
+	// update table constraint or columns
 	if !node.CreatedAt.Equal(txnif.UncommitTS) && !node.DeletedAt.Equal(txnif.UncommitTS) {
+		schema := tbl.SchemaOf(node)
+		if dropped := droppedColumnNames(tbl.GetPrevSchemaLocked(node), schema); len(dropped) > 0 {
+			if b.batches[columnDelBatch] == nil {
+				b.batches[columnDelBatch] = makeRespBatchFromSchema(DelSchema)
+			}
+			for _, name := range dropped {
+				b.batches[columnDelBatch].GetVectorByName(catalog.AttrRowID).Append(bytesToRowID([]byte(fmt.Sprintf("%d-%s", tbl.ID, name))))
+				b.batches[columnDelBatch].GetVectorByName(catalog.AttrCommitTs).Append(b.txn.GetPrepareTS())
+			}
+		}
 		if b.batches[columnInsBatch] == nil {
 			b.batches[columnInsBatch] = makeRespBatchFromSchema(catalog.SystemColumnSchema)
 		}
 		for _, syscol := range catalog.SystemColumnSchema.ColDefs {
-			txnimpl.FillColumnRow(tbl, syscol.Name, b.batches[columnInsBatch].GetVectorByName(syscol.Name))
+			txnimpl.FillColumnRow(tbl, schema, syscol.Name, b.batches[columnInsBatch].GetVectorByName(syscol.Name))
 		}
-		for _, usercol := range tbl.GetSchema().ColDefs {
+		for _, usercol := range schema.ColDefs {
 			b.batches[columnInsBatch].GetVectorByName(catalog.AttrRowID).Append(bytesToRowID([]byte(fmt.Sprintf("%d-%s", tbl.ID, usercol.Name))))
 			b.batches[columnInsBatch].GetVectorByName(catalog.AttrCommitTs).Append(b.txn.GetPrepareTS())
 		}
@@ -696,31 +696,44 @@ func (collector *BaseCollector) VisitTable(entry *catalog.TableEntry) (err error
 	}
 	entry.RLock()
 	mvccNodes := entry.ClonePreparedInRange(collector.start, collector.end)
+	prevSchemas := make([]*catalog.Schema, len(mvccNodes))
+	for i, node := range mvccNodes {
+		prevSchemas[i] = entry.GetPrevSchemaLocked(node.(*catalog.TableMVCCNode))
+	}
 	entry.RUnlock()
-	for _, node := range mvccNodes {
+	for i, node := range mvccNodes {
 		if node.IsAborted() {
 			continue
 		}
 		tblNode := node.(*catalog.TableMVCCNode)
+		schema := entry.SchemaOf(tblNode)
 		if !tblNode.HasDropCommitted() {
 			for _, syscol := range catalog.SystemColumnSchema.ColDefs {
 				txnimpl.FillColumnRow(
 					entry,
+					schema,
 					syscol.Name,
 					collector.data.bats[TBLColInsertIDX].GetVectorByName(syscol.Name),
 				)
 			}
 			rowidVec := collector.data.bats[TBLColInsertIDX].GetVectorByName(catalog.AttrRowID)
 			commitVec := collector.data.bats[TBLColInsertIDX].GetVectorByName(catalog.AttrCommitTs)
-			for _, usercol := range entry.GetSchema().ColDefs {
+			for _, usercol := range schema.ColDefs {
 				rowidVec.Append(bytesToRowID([]byte(fmt.Sprintf("%d-%s", entry.GetID(), usercol.Name))))
 				commitVec.Append(tblNode.GetEnd())
 			}
+			// the columns renamed by the node are deleted
+			for _, name := range droppedColumnNames(prevSchemas[i], schema) {
+				collector.data.bats[TBLColDeleteIDX].GetVectorByName(catalog.AttrRowID).Append(
+					bytesToRowID([]byte(fmt.Sprintf("%d-%s", entry.GetID(), name))),
+				)
+				collector.data.bats[TBLColDeleteIDX].GetVectorByName(catalog.AttrCommitTs).Append(tblNode.GetEnd())
+			}
 
 			collector.data.bats[TBLInsertTxnIDX].GetVectorByName(
-				SnapshotAttr_BlockMaxRow).Append(entry.GetSchema().BlockMaxRows)
+				SnapshotAttr_BlockMaxRow).Append(schema.BlockMaxRows)
 			collector.data.bats[TBLInsertTxnIDX].GetVectorByName(
-				SnapshotAttr_SegmentMaxBlock).Append(entry.GetSchema().SegmentMaxBlocks)
+				SnapshotAttr_SegmentMaxBlock).Append(schema.SegmentMaxBlocks)
 
 			catalogEntry2Batch(
 				collector.data.bats[TBLInsertIDX],
@@ -741,7 +754,7 @@ func (collector *BaseCollector) VisitTable(entry *catalog.TableEntry) (err error
 
 			rowidVec := collector.data.bats[TBLColDeleteIDX].GetVectorByName(catalog.AttrRowID)
 			commitVec := collector.data.bats[TBLColDeleteIDX].GetVectorByName(catalog.AttrCommitTs)
-			for _, usercol := range entry.GetSchema().ColDefs {
+			for _, usercol := range schema.ColDefs {
 				rowidVec.Append(
 					bytesToRowID([]byte(fmt.Sprintf("%d-%s", entry.GetID(), usercol.Name))),
 				)
@@ -84,11 +84,11 @@ func SortBlockColumns(cols []containers.Vector, pk int) ([]uint32, error) {
 		Sort(cols[pk], numericLess[int64], sortedIdx)
 	case types.T_uint8:
 		Sort(cols[pk], numericLess[uint8], sortedIdx)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		Sort(cols[pk], numericLess[uint16], sortedIdx)
 	case types.T_uint32:
 		Sort(cols[pk], numericLess[uint32], sortedIdx)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		Sort(cols[pk], numericLess[uint64], sortedIdx)
 	case types.T_float32:
 		Sort(cols[pk], numericLess[float32], sortedIdx)
@@ -189,11 +189,11 @@ func MergeSortedColumn(column []containers.Vector, sortedIdx *[]uint32, fromLayo
 		ret, mapping = Merge(column, sortedIdx, numericLess[int64], fromLayout, toLayout)
 	case types.T_uint8:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint8], fromLayout, toLayout)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint16], fromLayout, toLayout)
 	case types.T_uint32:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint32], fromLayout, toLayout)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint64], fromLayout, toLayout)
 	case types.T_float32:
 		ret, mapping = Merge(column, sortedIdx, numericLess[float32], fromLayout, toLayout)
@@ -54,6 +54,18 @@ func (e *txnEngine) Rollback(_ context.Context, _ client.TxnOperator) error {
 	return nil
 }
 
+func (e *txnEngine) Savepoint(ctx context.Context, _ client.TxnOperator, _ string) error {
+	return moerr.NewNotSupported(ctx, "savepoint in tae engine")
+}
+
+func (e *txnEngine) RollbackToSavepoint(ctx context.Context, _ client.TxnOperator, _ string) error {
+	return moerr.NewNotSupported(ctx, "rollback to savepoint in tae engine")
+}
+
+func (e *txnEngine) ReleaseSavepoint(ctx context.Context, _ client.TxnOperator, _ string) error {
+	return moerr.NewNotSupported(ctx, "release savepoint in tae engine")
+}
+
 func (e *txnEngine) NewBlockReader(_ context.Context, _ int, _ timestamp.Timestamp,
 	_ *plan.Expr, _ [][]byte, _ *plan.TableDef) ([]engine.Reader, error) {
 	return nil, nil
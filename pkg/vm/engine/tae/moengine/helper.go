@@ -68,6 +68,14 @@ func AttrFromColDef(col *catalog.ColDef) (attrs *engine.Attribute, err error) {
 		}
 	}
 
+	var generated *plan.GeneratedCol
+	if len(col.Generated) > 0 {
+		generated = new(plan.GeneratedCol)
+		if err := types.Decode(col.Generated, generated); err != nil {
+			return nil, err
+		}
+	}
+
 	attr := &engine.Attribute{
 		Name:          col.Name,
 		Type:          col.Type,
@@ -79,6 +87,10 @@ func AttrFromColDef(col *catalog.ColDef) (attrs *engine.Attribute, err error) {
 		OnUpdate:      onUpdate,
 		AutoIncrement: col.IsAutoIncrement(),
 		ClusterBy:     col.IsClusterBy(),
+		EnumValues:    col.EnumValues,
+		Generated:     generated,
+		Alg:           col.Compress,
+		CompressLevel: int32(col.CompressLevel),
 	}
 	return attr, nil
 }
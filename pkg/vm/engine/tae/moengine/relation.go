@@ -70,6 +70,16 @@ func (rel *baseRelation) UpdateConstraintWithBin(_ context.Context, bin []byte)
 	return rel.handle.UpdateConstraint(bin)
 }
 
+func (rel *baseRelation) AlterColumns(_ context.Context, defs []engine.TableDef) error {
+	attrs := make([]engine.Attribute, 0, len(defs))
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Name != catalog.PhyAddrColumnName {
+			attrs = append(attrs, attr.Attr)
+		}
+	}
+	return rel.handle.AlterColumns(attrs)
+}
+
 func (rel *baseRelation) TableColumns(_ context.Context) ([]*engine.Attribute, error) {
 	colDefs := rel.handle.GetMeta().(*catalog.TableEntry).GetColDefs()
 	cols, _ := ColDefsToAttrs(colDefs)
@@ -88,6 +88,10 @@ func (s *sysRelation) UpdateConstraintWithBin(context.Context, []byte) error {
 	return ErrReadOnly
 }
 
+func (s *sysRelation) AlterColumns(context.Context, []engine.TableDef) error {
+	return ErrReadOnly
+}
+
 func (s *sysRelation) TableColumns(_ context.Context) ([]*engine.Attribute, error) {
 	colDefs := s.handle.GetMeta().(*catalog.TableEntry).GetSchema().ColDefs
 	cols, _ := ColDefsToAttrs(colDefs)
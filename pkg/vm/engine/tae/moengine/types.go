@@ -41,6 +41,7 @@ type Txn interface {
 	SetCommitTS(cts types.TS) error
 	SetParticipants(ids []uint64) error
 	SetPKDedupSkip(skip txnif.PKDedupSkipScope)
+	SetIsolation(isolation txnif.IsolationLevel)
 	Prepare() (types.TS, error)
 	Committing() error
 	Commit() error
@@ -58,6 +59,8 @@ type Relation interface {
 
 	UpdateConstraintWithBin(context.Context, []byte) error
 
+	AlterColumns(context.Context, []engine.TableDef) error
+
 	//Write just append data into txn's workspace, instead of applying data into state machine.
 	Write(context.Context, *batch.Batch) error
 
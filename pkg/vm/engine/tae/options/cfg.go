@@ -55,6 +55,9 @@ type CheckpointCfg struct {
 type GCCfg struct {
 	GCTTL          time.Duration
 	ScanGCInterval time.Duration
+	// SnapshotRetention is how long the data of the past snapshots is kept
+	// for the AS OF queries, GCTTL is raised to it if it's shorter.
+	SnapshotRetention time.Duration
 }
 
 type CatalogCfg struct {
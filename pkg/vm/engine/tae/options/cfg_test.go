@@ -30,3 +30,15 @@ func TestLogtailServerCfg(t *testing.T) {
 	require.Equal(t, defaults.ResponseSendTimeout, validated.ResponseSendTimeout)
 	require.Equal(t, defaults.MaxLogtailFetchFailure, validated.MaxLogtailFetchFailure)
 }
+
+func TestGCCfgSnapshotRetention(t *testing.T) {
+	opts := new(Options)
+	opts.GCCfg = &GCCfg{SnapshotRetention: 2 * DefaultGCTTL}
+	opts = opts.FillDefaults(t.TempDir())
+	require.Equal(t, 2*DefaultGCTTL, opts.GCCfg.GCTTL)
+
+	opts = new(Options)
+	opts.GCCfg = &GCCfg{GCTTL: 3 * DefaultGCTTL, SnapshotRetention: DefaultGCTTL}
+	opts = opts.FillDefaults(t.TempDir())
+	require.Equal(t, 3*DefaultGCTTL, opts.GCCfg.GCTTL)
+}
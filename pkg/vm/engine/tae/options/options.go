@@ -180,6 +180,10 @@ func (o *Options) FillDefaults(dirname string) *Options {
 	if o.GCCfg.GCTTL <= 0 {
 		o.GCCfg.GCTTL = DefaultGCTTL
 	}
+	// the data of the snapshots within the retention must not be collected by any gc
+	if o.GCCfg.GCTTL < o.GCCfg.SnapshotRetention {
+		o.GCCfg.GCTTL = o.GCCfg.SnapshotRetention
+	}
 
 	if o.GCCfg.ScanGCInterval <= 0 {
 		o.GCCfg.ScanGCInterval = DefaultScanGCInterval
@@ -235,6 +235,10 @@ type column struct {
 	hasUpdate       int8
 	updateExpr      []byte
 	clusterBy       int8
+	enumValues      string
+	generated       []byte
+	compressAlg     int8
+	compressLevel   int8
 }
 
 func genColumns(accountId uint32, tableName, databaseName string,
@@ -313,6 +317,16 @@ func genColumns(accountId uint32, tableName, databaseName string,
 		} else {
 			col.constraintType = catalog.SystemColNoConstraint
 		}
+		col.enumValues = attrDef.Attr.EnumValues
+		if attrDef.Attr.Generated != nil {
+			expr, err := types.Encode(attrDef.Attr.Generated)
+			if err != nil {
+				return nil, err
+			}
+			col.generated = expr
+		}
+		col.compressAlg = int8(attrDef.Attr.Alg)
+		col.compressLevel = int8(attrDef.Attr.CompressLevel)
 		cols = append(cols, col)
 		num++
 	}
@@ -534,6 +548,30 @@ func genCreateColumnTuple(
 			return nil, err
 		}
 
+		idx = catalog.MO_COLUMNS_ATT_ENUM_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoColumnsTypes[idx]) // att_enum
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(col.enumValues), false, m); err != nil {
+			return nil, err
+		}
+
+		idx = catalog.MO_COLUMNS_ATT_GENERATED_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoColumnsTypes[idx]) // att_generated
+		if err := vector.AppendBytes(bat.Vecs[idx], col.generated, false, m); err != nil {
+			return nil, err
+		}
+
+		idx = catalog.MO_COLUMNS_ATT_COMPRESS_ALG_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoColumnsTypes[idx]) // att_compress_alg
+		if err := vector.AppendFixed(bat.Vecs[idx], col.compressAlg, false, m); err != nil {
+			return nil, err
+		}
+
+		idx = catalog.MO_COLUMNS_ATT_COMPRESS_LEVEL_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoColumnsTypes[idx]) // att_compress_level
+		if err := vector.AppendFixed(bat.Vecs[idx], col.compressLevel, false, m); err != nil {
+			return nil, err
+		}
+
 	}
 	return bat, nil
 }
@@ -146,6 +146,13 @@ func (h *Handle) HandleCommit(
 					req,
 					&db.UpdateConstraintResp{},
 				)
+			case *db.AlterColumnsReq:
+				err = h.HandleAlterColumns(
+					ctx,
+					meta,
+					req,
+					&db.AlterColumnsResp{},
+				)
 			case *db.WriteReq:
 				err = h.HandleWrite(
 					ctx,
@@ -261,6 +268,13 @@ func (h *Handle) HandlePrepare(
 					req,
 					&db.UpdateConstraintResp{},
 				)
+			case *db.AlterColumnsReq:
+				err = h.HandleAlterColumns(
+					ctx,
+					meta,
+					req,
+					&db.AlterColumnsResp{},
+				)
 			case *db.WriteReq:
 				err = h.HandleWrite(
 					ctx,
@@ -610,6 +624,20 @@ func (h *Handle) HandlePreCommitWrite(
 					return err
 				}
 			}
+		case []catalog.AlterColumns:
+			for _, cmd := range cmds {
+				req := &db.AlterColumnsReq{
+					TableName:    cmd.TableName,
+					TableId:      cmd.TableId,
+					DatabaseName: cmd.DatabaseName,
+					DatabaseId:   cmd.DatabaseId,
+					Defs:         cmd.Defs,
+				}
+				if err = h.CacheTxnRequest(ctx, meta, req,
+					new(db.AlterColumnsResp)); err != nil {
+					return err
+				}
+			}
 		case []catalog.DropDatabase:
 			for _, cmd := range cmds {
 				req := &db.DropDatabaseReq{
@@ -819,6 +847,9 @@ func (h *Handle) HandleWrite(
 		return
 	}
 	txn.SetPKDedupSkip(txnif.PKDedupSkipWorkSpace)
+	if meta.IsRCIsolation() {
+		txn.SetIsolation(txnif.IsolationRC)
+	}
 
 	logutil.Infof("[precommit] handle write typ: %v, %d-%s, %d-%s\n txn: %s\n",
 		req.Type, req.TableID,
@@ -905,6 +936,33 @@ func (h *Handle) HandleUpdateConstraint(
 	return nil
 }
 
+func (h *Handle) HandleAlterColumns(
+	ctx context.Context,
+	meta txn.TxnMeta,
+	req *db.AlterColumnsReq,
+	resp *db.AlterColumnsResp) (err error) {
+	txn, err := h.eng.GetOrCreateTxnWithMeta(nil, meta.GetID(),
+		types.TimestampToTS(meta.GetSnapshotTS()))
+	if err != nil {
+		return err
+	}
+
+	logutil.Infof("[precommit] alter columns: %s.%s %d columns\n txn: %s\n",
+		req.DatabaseName, req.TableName, len(req.Defs), txn.String())
+
+	dbase, err := h.eng.GetDatabaseByID(ctx, req.DatabaseId, txn)
+	if err != nil {
+		return
+	}
+
+	tbl, err := dbase.GetRelationByID(ctx, req.TableId)
+	if err != nil {
+		return
+	}
+
+	return tbl.AlterColumns(ctx, req.Defs)
+}
+
 func vec2Str[T any](vec []T, v *vector.Vector) string {
 	var w bytes.Buffer
 	_, _ = w.WriteString(fmt.Sprintf("[%d]: ", v.Length()))
@@ -937,11 +995,11 @@ func moVec2String(v *vector.Vector, printN int) string {
 		return vec2Str(vector.MustFixedCol[int64](v)[:printN], v)
 	case types.T_uint8:
 		return vec2Str(vector.MustFixedCol[uint8](v)[:printN], v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return vec2Str(vector.MustFixedCol[uint16](v)[:printN], v)
 	case types.T_uint32:
 		return vec2Str(vector.MustFixedCol[uint32](v)[:printN], v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return vec2Str(vector.MustFixedCol[uint64](v)[:printN], v)
 	case types.T_float32:
 		return vec2Str(vector.MustFixedCol[float32](v)[:printN], v)
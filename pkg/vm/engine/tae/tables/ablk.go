@@ -96,6 +96,11 @@ func (blk *ablock) IsAppendable() bool {
 	if node.IsPersisted() {
 		return false
 	}
+	// the rows of the altered schema are appended to the blocks created
+	// since the alter
+	if node.MustMNode().schema != blk.meta.GetSchema() {
+		return false
+	}
 	return node.Rows() < blk.meta.GetSchema().BlockMaxRows
 }
 
@@ -122,8 +127,9 @@ func (blk *ablock) GetColumnDataByNames(
 	attrs []string,
 ) (view *model.BlockView, err error) {
 	colIdxes := make([]int, len(attrs))
+	schema := blk.schemaAt(txn.GetStartTS())
 	for i, attr := range attrs {
-		colIdxes[i] = blk.meta.GetSchema().GetColIdx(attr)
+		colIdxes[i] = schema.GetColIdx(attr)
 	}
 	return blk.GetColumnDataByIds(txn, colIdxes)
 }
@@ -132,7 +138,7 @@ func (blk *ablock) GetColumnDataByName(
 	txn txnif.AsyncTxn,
 	attr string,
 ) (view *model.ColumnView, err error) {
-	colIdx := blk.meta.GetSchema().GetColIdx(attr)
+	colIdx := blk.schemaAt(txn.GetStartTS()).GetColIdx(attr)
 	return blk.GetColumnDataById(txn, colIdx)
 }
 
@@ -183,6 +189,7 @@ func (blk *ablock) resolveColumnData(
 	ts types.TS,
 	colIdx int,
 	skipDeletes bool) (view *model.ColumnView, err error) {
+	def := blk.schemaAt(ts).ColDefs[colIdx]
 	node := blk.PinNode()
 	defer node.Unref()
 
@@ -190,13 +197,13 @@ func (blk *ablock) resolveColumnData(
 		return blk.resolveInMemoryColumnData(
 			node.MustMNode(),
 			ts,
-			colIdx,
+			def,
 			skipDeletes)
 	} else {
 		return blk.ResolvePersistedColumnData(
 			node.MustPNode(),
 			ts,
-			colIdx,
+			def,
 			skipDeletes,
 		)
 	}
@@ -208,6 +215,7 @@ func (blk *ablock) resolveInMemoryColumnDatas(
 	ts types.TS,
 	colIdxes []int,
 	skipDeletes bool) (view *model.BlockView, err error) {
+	schema := blk.schemaAt(ts)
 	blk.RLock()
 	defer blk.RUnlock()
 	maxRow, visible, deSels, err := blk.mvcc.GetVisibleRowLocked(ts)
@@ -216,7 +224,7 @@ func (blk *ablock) resolveInMemoryColumnDatas(
 		return
 	}
 
-	data, err := mnode.GetDataWindow(0, maxRow)
+	data, err := mnode.GetDataWindow(schema, 0, maxRow)
 	if err != nil {
 		return
 	}
@@ -248,7 +256,7 @@ func (blk *ablock) resolveInMemoryColumnDatas(
 func (blk *ablock) resolveInMemoryColumnData(
 	mnode *memoryNode,
 	ts types.TS,
-	colIdx int,
+	def *catalog.ColDef,
 	skipDeletes bool) (view *model.ColumnView, err error) {
 	blk.RLock()
 	defer blk.RUnlock()
@@ -258,12 +266,12 @@ func (blk *ablock) resolveInMemoryColumnData(
 		return
 	}
 
-	view = model.NewColumnView(ts, colIdx)
+	view = model.NewColumnView(ts, def.Idx)
 	var data containers.Vector
 	data, err = mnode.GetColumnDataWindow(
 		0,
 		maxRow,
-		colIdx)
+		def)
 	if err != nil {
 		// blk.RUnlock()
 		return
@@ -323,7 +331,8 @@ func (blk *ablock) getInMemoryValue(
 		err = moerr.NewNotFoundNoCtx()
 		return
 	}
-	view, err := blk.resolveInMemoryColumnData(mnode, ts, col, true)
+	def := blk.schemaAt(ts).ColDefs[col]
+	view, err := blk.resolveInMemoryColumnData(mnode, ts, def, true)
 	if err != nil {
 		return
 	}
@@ -366,7 +375,7 @@ func (blk *ablock) getPersistedRowByFilter(
 		return
 	}
 	sortKey, err := blk.LoadPersistedColumnData(
-		blk.meta.GetSchema().GetSingleSortKeyIdx())
+		blk.meta.GetSchema().GetSingleSortKey())
 	if err != nil {
 		return
 	}
@@ -543,7 +552,7 @@ func (blk *ablock) inMemoryBatchDedup(
 
 func (blk *ablock) dedupClosure(
 	vec containers.Vector,
-	ts types.TS,
+	conflictTS types.TS,
 	mask *roaring.Bitmap,
 	def *catalog.ColDef) func(any, int) error {
 	return func(v1 any, _ int) (err error) {
@@ -558,7 +567,7 @@ func (blk *ablock) dedupClosure(
 				}
 				defer commitTSVec.Close()
 				commiTs := commitTSVec.Get(row).(types.TS)
-				if commiTs.Greater(ts) {
+				if commiTs.Greater(conflictTS) {
 					return txnif.ErrTxnWWConflict
 				}
 				entry := common.TypeStringValue(vec.GetType(), v1)
@@ -583,14 +592,21 @@ func (blk *ablock) BatchDedup(
 	if precommit {
 		dedupTS = txn.GetPrepareTS()
 	}
+	conflictTS := txn.GetStartTS()
+	if txn.GetIsolation() == txnif.IsolationRC {
+		// the rows committed before the dedup are visible to the RC txn,
+		// they are duplicates instead of the w-w conflicts
+		conflictTS = dedupTS
+	}
 	node := blk.PinNode()
 	defer node.Unref()
 	if !node.IsPersisted() {
-		return blk.inMemoryBatchDedup(node.MustMNode(), dedupTS, txn.GetStartTS(), keys, rowmask)
+		return blk.inMemoryBatchDedup(node.MustMNode(), dedupTS, conflictTS, keys, rowmask)
 	} else {
 		return blk.PersistedBatchDedup(
 			node.MustPNode(),
 			dedupTS,
+			conflictTS,
 			keys,
 			rowmask,
 			blk.dedupClosure)
@@ -606,7 +622,7 @@ func (blk *ablock) persistedCollectAppendInRange(
 	minRow, maxRow, commitTSVec, abortVec, abortedMap :=
 		blk.mvcc.CollectAppendLocked(start, end)
 	blk.RUnlock()
-	if bat, err = pnode.GetDataWindow(minRow, maxRow); err != nil {
+	if bat, err = pnode.GetDataWindow(blk.meta.GetSchema(), minRow, maxRow); err != nil {
 		return
 	}
 	bat.AddVector(catalog.AttrCommitTs, commitTSVec)
@@ -626,7 +642,7 @@ func (blk *ablock) inMemoryCollectAppendInRange(
 	blk.RLock()
 	minRow, maxRow, commitTSVec, abortVec, abortedMap :=
 		blk.mvcc.CollectAppendLocked(start, end)
-	if bat, err = mnode.GetDataWindow(minRow, maxRow); err != nil {
+	if bat, err = mnode.GetDataWindow(blk.meta.GetSchema(), minRow, maxRow); err != nil {
 		blk.RUnlock()
 		return
 	}
@@ -90,16 +90,20 @@ func (appender *blockAppender) ApplyAppend(
 	node := n.MustMNode()
 	appender.blk.Lock()
 	defer appender.blk.Unlock()
-	from, err = node.ApplyAppend(bat, txn)
+	if from, err = node.ApplyAppend(bat, txn); err != nil {
+		return
+	}
 
-	schema := appender.blk.meta.GetSchema()
+	// the indexes are updated by the appended rows of the node, the columns
+	// of the batch may be less than the node
 	keysCtx := new(index.KeysCtx)
+	keysCtx.Start = from
 	keysCtx.Count = bat.Length()
-	for _, colDef := range schema.ColDefs {
+	for _, colDef := range node.schema.ColDefs {
 		if colDef.IsPhyAddr() {
 			continue
 		}
-		keysCtx.Keys = bat.Vecs[colDef.Idx]
+		keysCtx.Keys = node.data.Vecs[colDef.Idx]
 		if err = node.indexes[colDef.Idx].BatchUpsert(keysCtx, from); err != nil {
 			panic(err)
 		}
@@ -97,14 +97,15 @@ func (blk *baseBlock) GetColumnData(
 	to uint32,
 	colIdx int,
 ) (vec containers.Vector, err error) {
+	def := blk.meta.GetSchema().ColDefs[colIdx]
 	node := blk.PinNode()
 	defer node.Unref()
 	if !node.IsPersisted() {
 		blk.RLock()
 		defer blk.RUnlock()
-		return node.GetColumnDataWindow(from, to, colIdx)
+		return node.GetColumnDataWindow(from, to, def)
 	} else {
-		return node.GetColumnDataWindow(from, to, colIdx)
+		return node.GetColumnDataWindow(from, to, def)
 	}
 }
 
@@ -137,6 +138,12 @@ func (blk *baseBlock) TryUpgrade() (err error) {
 	return
 }
 
+// schemaAt returns the schema of the table visible at ts, the column indexes
+// of the reads at ts are the indexes of the columns of the schema
+func (blk *baseBlock) schemaAt(ts types.TS) *catalog.Schema {
+	return blk.meta.GetSegment().GetTable().GetSchemaAt(ts)
+}
+
 func (blk *baseBlock) GetMeta() any                 { return blk.meta }
 func (blk *baseBlock) GetBufMgr() base.INodeManager { return blk.bufMgr }
 func (blk *baseBlock) GetFs() *objectio.ObjectFS    { return blk.fs }
@@ -177,9 +184,14 @@ func (blk *baseBlock) LoadPersistedCommitTS() (vec containers.Vector, err error)
 	if err != nil {
 		return
 	}
+	width, err := reader.(*blockio.BlockReader).LoadDataWidth(context.Background(), id, true)
+	if err != nil {
+		return
+	}
+	// the commit ts follows the data and the physical address columns
 	bat, err := reader.LoadColumns(
 		context.Background(),
-		[]uint16{uint16(len(blk.meta.GetSchema().NameIndex))},
+		[]uint16{uint16(width + 1)},
 		[]uint32{id},
 		nil,
 	)
@@ -190,8 +202,7 @@ func (blk *baseBlock) LoadPersistedCommitTS() (vec containers.Vector, err error)
 	return
 }
 
-func (blk *baseBlock) LoadPersistedData() (bat *containers.Batch, err error) {
-	schema := blk.meta.GetSchema()
+func (blk *baseBlock) LoadPersistedData(schema *catalog.Schema) (bat *containers.Batch, err error) {
 	bat = containers.NewBatch()
 	defer func() {
 		if err != nil {
@@ -200,26 +211,26 @@ func (blk *baseBlock) LoadPersistedData() (bat *containers.Batch, err error) {
 	}()
 
 	var vec containers.Vector
-	for i, col := range schema.ColDefs {
-		vec, err = blk.LoadPersistedColumnData(i)
+	for _, def := range schema.ColDefs {
+		vec, err = blk.LoadPersistedColumnData(def)
 		if err != nil {
 			return
 		}
-		bat.AddVector(col.Name, vec)
+		bat.AddVector(def.Name, vec)
 	}
 	return
 }
 
-func (blk *baseBlock) LoadPersistedColumnData(colIdx int) (
+func (blk *baseBlock) LoadPersistedColumnData(def *catalog.ColDef) (
 	vec containers.Vector, err error) {
-	def := blk.meta.GetSchema().ColDefs[colIdx]
 	location := blk.meta.GetMetaLoc()
 	return LoadPersistedColumnData(
 		blk.bufMgr,
 		blk.fs,
 		blk.meta.AsCommonID(),
 		def,
-		location)
+		location,
+		blk.meta.IsAppendable())
 }
 
 func (blk *baseBlock) LoadPersistedDeletes() (bat *containers.Batch, err error) {
@@ -263,7 +274,7 @@ func (blk *baseBlock) ResolvePersistedColumnDatas(
 	ts types.TS,
 	colIdxs []int,
 	skipDeletes bool) (view *model.BlockView, err error) {
-	data, err := blk.LoadPersistedData()
+	data, err := blk.LoadPersistedData(blk.schemaAt(ts))
 	if err != nil {
 		return nil, err
 	}
@@ -302,10 +313,10 @@ func (blk *baseBlock) ResolvePersistedColumnDatas(
 func (blk *baseBlock) ResolvePersistedColumnData(
 	pnode *persistedNode,
 	ts types.TS,
-	colIdx int,
+	def *catalog.ColDef,
 	skipDeletes bool) (view *model.ColumnView, err error) {
-	view = model.NewColumnView(ts, colIdx)
-	vec, err := blk.LoadPersistedColumnData(colIdx)
+	view = model.NewColumnView(ts, def.Idx)
+	vec, err := blk.LoadPersistedColumnData(def)
 	if err != nil {
 		return
 	}
@@ -335,9 +346,13 @@ func (blk *baseBlock) ResolvePersistedColumnData(
 	return
 }
 
+// PersistedBatchDedup dedups the keys against the persisted data visible at
+// ts. conflictTS is passed to the dedupClosure, the rows committed after it
+// are the w-w conflicts instead of the duplicates.
 func (blk *baseBlock) PersistedBatchDedup(
 	pnode *persistedNode,
 	ts types.TS,
+	conflictTS types.TS,
 	keys containers.Vector,
 	rowmask *roaring.Bitmap,
 	dedupClosure func(
@@ -357,7 +372,7 @@ func (blk *baseBlock) PersistedBatchDedup(
 	view, err := blk.ResolvePersistedColumnData(
 		pnode,
 		ts,
-		def.Idx,
+		def,
 		false)
 	if err != nil {
 		return
@@ -370,7 +385,7 @@ func (blk *baseBlock) PersistedBatchDedup(
 		}
 	}
 	defer view.Close()
-	dedupFn := dedupClosure(view.GetData(), ts, view.DeleteMask, def)
+	dedupFn := dedupClosure(view.GetData(), conflictTS, view.DeleteMask, def)
 	err = keys.ForeachShallow(dedupFn, sels)
 	return
 }
@@ -396,7 +411,8 @@ func (blk *baseBlock) getPersistedValue(
 		err = moerr.NewNotFoundNoCtx()
 		return
 	}
-	view2, err := blk.ResolvePersistedColumnData(pnode, ts, col, true)
+	def := blk.schemaAt(ts).ColDefs[col]
+	view2, err := blk.ResolvePersistedColumnData(pnode, ts, def, true)
 	if err != nil {
 		return
 	}
@@ -83,7 +83,7 @@ func (blk *block) GetColumnDataByNames(
 	attrs []string,
 ) (view *model.BlockView, err error) {
 	colIdxes := make([]int, len(attrs))
-	schema := blk.meta.GetSchema()
+	schema := blk.schemaAt(txn.GetStartTS())
 	for i, attr := range attrs {
 		colIdxes[i] = schema.GetColIdx(attr)
 	}
@@ -94,7 +94,7 @@ func (blk *block) GetColumnDataByName(
 	txn txnif.AsyncTxn,
 	attr string,
 ) (view *model.ColumnView, err error) {
-	colIdx := blk.meta.GetSchema().GetColIdx(attr)
+	colIdx := blk.schemaAt(txn.GetStartTS()).GetColIdx(attr)
 	return blk.GetColumnDataById(txn, colIdx)
 }
 
@@ -118,12 +118,13 @@ func (blk *block) GetColumnDataById(
 	txn txnif.AsyncTxn,
 	colIdx int,
 ) (view *model.ColumnView, err error) {
+	def := blk.schemaAt(txn.GetStartTS()).ColDefs[colIdx]
 	node := blk.PinNode()
 	defer node.Unref()
 	return blk.ResolvePersistedColumnData(
 		node.MustPNode(),
 		txn.GetStartTS(),
-		colIdx,
+		def,
 		false)
 }
 
@@ -147,6 +148,7 @@ func (blk *block) BatchDedup(
 	return blk.PersistedBatchDedup(
 		node.MustPNode(),
 		ts,
+		ts,
 		keys,
 		rowmask,
 		blk.dedupClosure)
@@ -243,7 +245,7 @@ func (blk *block) getPersistedRowByFilter(
 	}
 	var sortKey containers.Vector
 	if sortKey, err = blk.LoadPersistedColumnData(
-		blk.meta.GetSchema().GetSingleSortKeyIdx(),
+		blk.meta.GetSchema().GetSingleSortKey(),
 	); err != nil {
 		return
 	}
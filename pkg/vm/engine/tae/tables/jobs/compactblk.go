@@ -91,7 +91,7 @@ func (task *compactBlockTask) PrepareData() (preparer *model.PreparedCompactedBl
 	preparer = model.NewPreparedCompactedBlockData()
 	preparer.Columns = containers.NewBatch()
 
-	schema := task.meta.GetSchema()
+	schema := task.meta.GetSegment().GetTable().GetVisibleSchema(task.txn)
 	var view *model.ColumnView
 	for _, def := range schema.ColDefs {
 		if def.IsPhyAddr() {
@@ -58,7 +58,7 @@ func (task *flushBlkTask) Scope() *common.ID { return task.meta.AsCommonID() }
 
 func (task *flushBlkTask) Execute() error {
 	name := blockio.EncodeObjectName()
-	writer, err := blockio.NewBlockWriter(task.fs.Service, name)
+	writer, err := blockio.NewBlockWriter(task.fs.Service, name, task.meta.GetSchema().CompressOptions()...)
 	if err != nil {
 		return err
 	}
@@ -194,7 +194,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 	}
 
-	schema := task.mergedBlks[0].GetSchema()
+	schema := task.mergedBlks[0].GetSegment().GetTable().GetVisibleSchema(task.txn)
 	var view *model.ColumnView
 	sortVecs := make([]containers.Vector, 0)
 	rows := make([]uint32, 0)
@@ -314,7 +314,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	}
 
 	name := blockio.EncodeObjectName()
-	writer, err := blockio.NewBlockWriter(task.mergedBlks[0].GetBlockData().GetFs().Service, name)
+	writer, err := blockio.NewBlockWriter(task.mergedBlks[0].GetBlockData().GetFs().Service, name, schema.CompressOptions()...)
 	if err != nil {
 		return err
 	}
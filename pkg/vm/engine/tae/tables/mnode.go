@@ -33,6 +33,9 @@ type memoryNode struct {
 	block  *baseBlock
 	data   *containers.Batch
 	prefix []byte
+	// schema is the schema of the table the node is created with, the
+	// columns added to the table later are filled with their defaults
+	schema *catalog.Schema
 
 	//index for primary key : Art tree + ZoneMap.
 	pkIndex indexwrapper.Index
@@ -46,6 +49,7 @@ func newMemoryNode(block *baseBlock) *memoryNode {
 	impl.prefix = block.meta.MakeKey()
 
 	schema := block.meta.GetSchema()
+	impl.schema = schema
 	opts := containers.Options{}
 	opts.Allocator = common.MutMemAllocator
 	impl.data = containers.BuildBatch(
@@ -118,22 +122,50 @@ func (node *memoryNode) Rows() uint32 {
 	return uint32(node.data.Length())
 }
 
+// getColumn returns the data of the column def, ok is false if the column
+// is added to the table after the node is created
+func (node *memoryNode) getColumn(def *catalog.ColDef) (vec containers.Vector, ok bool) {
+	if def.IsPhyAddr() {
+		return node.data.Vecs[node.schema.PhyAddrKey.Idx], true
+	}
+	if def.Idx >= node.schema.PhyAddrKey.Idx {
+		return nil, false
+	}
+	return node.data.Vecs[def.Idx], true
+}
+
 func (node *memoryNode) GetColumnDataWindow(
 	from uint32,
 	to uint32,
-	colIdx int,
+	def *catalog.ColDef,
 ) (vec containers.Vector, err error) {
-	data := node.data.Vecs[colIdx]
+	data, ok := node.getColumn(def)
+	if !ok {
+		return def.MakeDefaultVector(int(to - from))
+	}
 	vec = data.CloneWindow(int(from), int(to-from), common.DefaultAllocator)
 	return
 }
 
 func (node *memoryNode) GetDataWindow(
+	schema *catalog.Schema,
 	from, to uint32) (bat *containers.Batch, err error) {
-	bat = node.data.CloneWindow(
-		int(from),
-		int(to-from),
-		common.DefaultAllocator)
+	if schema == node.schema {
+		bat = node.data.CloneWindow(
+			int(from),
+			int(to-from),
+			common.DefaultAllocator)
+		return
+	}
+	bat = containers.NewBatch()
+	var vec containers.Vector
+	for _, def := range schema.ColDefs {
+		if vec, err = node.GetColumnDataWindow(from, to, def); err != nil {
+			bat.Close()
+			return nil, err
+		}
+		bat.AddVector(def.Name, vec)
+	}
 	return
 }
 
@@ -161,7 +193,7 @@ func (node *memoryNode) FillPhyAddrColumn(startRow, length uint32) (err error) {
 		return
 	}
 	defer col.Close()
-	vec := node.data.Vecs[node.block.meta.GetSchema().PhyAddrKey.Idx]
+	vec := node.data.Vecs[node.schema.PhyAddrKey.Idx]
 	vec.Extend(col)
 	return
 }
@@ -169,12 +201,44 @@ func (node *memoryNode) FillPhyAddrColumn(startRow, length uint32) (err error) {
 func (node *memoryNode) ApplyAppend(
 	bat *containers.Batch,
 	txn txnif.AsyncTxn) (from int, err error) {
-	schema := node.block.meta.GetSchema()
 	from = int(node.data.Length())
+	// the data columns of the schemas of a table are at the same positions,
+	// the columns renamed since the rows are written are appended by their
+	// positions and the columns added since are filled with the defaults
+	width := node.schema.PhyAddrKey.Idx
+	cnt := 0
 	for srcPos, attr := range bat.Attrs {
-		def := schema.ColDefs[schema.GetColIdx(attr)]
-		destVec := node.data.Vecs[def.Idx]
-		destVec.Extend(bat.Vecs[srcPos])
+		if attr == catalog.PhyAddrColumnName {
+			continue
+		}
+		if srcPos >= width {
+			err = moerr.NewInternalErrorNoCtx("append column %s to block of %d columns", attr, width)
+			return
+		}
+		cnt++
+	}
+	defaults := make([]containers.Vector, 0, width-cnt)
+	defer func() {
+		for _, vec := range defaults {
+			vec.Close()
+		}
+	}()
+	for _, def := range node.schema.ColDefs[cnt:width] {
+		var vec containers.Vector
+		if vec, err = def.MakeDefaultVector(bat.Length()); err != nil {
+			return
+		}
+		defaults = append(defaults, vec)
+	}
+	for srcPos, attr := range bat.Attrs {
+		if attr == catalog.PhyAddrColumnName {
+			node.data.Vecs[width].Extend(bat.Vecs[srcPos])
+			continue
+		}
+		node.data.Vecs[srcPos].Extend(bat.Vecs[srcPos])
+	}
+	for i, vec := range defaults {
+		node.data.Vecs[cnt+i].Extend(vec)
 	}
 	return
 }
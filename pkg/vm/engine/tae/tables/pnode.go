@@ -17,6 +17,7 @@ package tables
 import (
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
@@ -102,11 +103,11 @@ func (node *persistedNode) ContainsKey(key any) (ok bool, err error) {
 func (node *persistedNode) GetColumnDataWindow(
 	from uint32,
 	to uint32,
-	colIdx int,
+	def *catalog.ColDef,
 ) (vec containers.Vector, err error) {
 	var data containers.Vector
 	if data, err = node.block.LoadPersistedColumnData(
-		colIdx,
+		def,
 	); err != nil {
 		return
 	}
@@ -120,8 +121,9 @@ func (node *persistedNode) GetColumnDataWindow(
 }
 
 func (node *persistedNode) GetDataWindow(
+	schema *catalog.Schema,
 	from, to uint32) (bat *containers.Batch, err error) {
-	data, err := node.block.LoadPersistedData()
+	data, err := node.block.LoadPersistedData(schema)
 	if err != nil {
 		return
 	}
@@ -16,6 +16,7 @@ package tables
 
 import (
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
@@ -32,11 +33,14 @@ type NodeT interface {
 		txn txnif.AsyncTxn,
 	) (from int, err error)
 
-	GetDataWindow(from, to uint32) (bat *containers.Batch, err error)
+	// GetDataWindow returns the data of the rows [from, to) by the columns
+	// of the schema, the columns added to the table after the data is
+	// written are filled with their defaults
+	GetDataWindow(schema *catalog.Schema, from, to uint32) (bat *containers.Batch, err error)
 	GetColumnDataWindow(
 		from uint32,
 		to uint32,
-		colIdx int,
+		def *catalog.ColDef,
 	) (vec containers.Vector, err error)
 
 	GetValueByRow(row, col int) (v any)
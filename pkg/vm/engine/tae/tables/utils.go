@@ -44,6 +44,7 @@ func LoadPersistedColumnData(
 	id *common.ID,
 	def *catalog.ColDef,
 	location string,
+	appendable bool,
 ) (vec containers.Vector, err error) {
 	_, _, meta, rows, err := blockio.DecodeLocation(location)
 	if err != nil {
@@ -56,6 +57,14 @@ func LoadPersistedColumnData(
 	if err != nil {
 		return
 	}
+	width, err := reader.(*blockio.BlockReader).LoadDataWidth(context.Background(), meta.Id(), appendable)
+	if err != nil {
+		return
+	}
+	if def.Idx >= width {
+		// the column is added to the table after the block is written
+		return def.MakeDefaultVector(int(rows))
+	}
 	bat, err := reader.LoadColumns(context.Background(), []uint16{uint16(def.Idx)}, []uint32{meta.Id()}, nil)
 	if err != nil {
 		return
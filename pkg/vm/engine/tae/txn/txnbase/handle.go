@@ -15,6 +15,7 @@
 package txnbase
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio"
@@ -89,7 +90,8 @@ func (rel *TxnRelation) DeleteByFilter(filter *handle.Filter) (err error)
 func (rel *TxnRelation) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	return
 }
-func (rel *TxnRelation) UpdateConstraint(cstr []byte) (err error) { return }
+func (rel *TxnRelation) UpdateConstraint(cstr []byte) (err error)          { return }
+func (rel *TxnRelation) AlterColumns(attrs []engine.Attribute) (err error) { return }
 
 func (seg *TxnSegment) GetMeta() any                     { return nil }
 func (seg *TxnSegment) String() string                   { return "" }
@@ -81,6 +81,7 @@ type Txn struct {
 	TenantID, UserID, RoleID atomic.Uint32
 	isReplay                 bool
 	PKDedupSkip              txnif.PKDedupSkipScope
+	Isolation                txnif.IsolationLevel
 
 	PrepareCommitFn   func(txnif.AsyncTxn) error
 	PrepareRollbackFn func(txnif.AsyncTxn) error
@@ -132,6 +133,8 @@ func (txn *Txn) SetApplyCommitFn(fn func(txnif.AsyncTxn) error)     { txn.ApplyC
 func (txn *Txn) SetApplyRollbackFn(fn func(txnif.AsyncTxn) error)   { txn.ApplyRollbackFn = fn }
 func (txn *Txn) SetPKDedupSkip(skip txnif.PKDedupSkipScope)         { txn.PKDedupSkip = skip }
 func (txn *Txn) GetPKDedupSkip() txnif.PKDedupSkipScope             { return txn.PKDedupSkip }
+func (txn *Txn) SetIsolation(isolation txnif.IsolationLevel)        { txn.Isolation = isolation }
+func (txn *Txn) GetIsolation() txnif.IsolationLevel                 { return txn.Isolation }
 
 //The state transition of transaction is as follows:
 // 1PC: TxnStateActive--->TxnStatePreparing--->TxnStateCommitted/TxnStateRollbacked
@@ -197,7 +197,7 @@ func (n *memoryNode) PrepareAppend(data *containers.Batch, offset uint32) uint32
 }
 
 func (n *memoryNode) Append(data *containers.Batch, offset uint32) (an uint32, err error) {
-	schema := n.bnode.table.entry.GetSchema()
+	schema := n.bnode.table.GetSchema()
 	if n.data == nil {
 		opts := containers.Options{}
 		opts.Capacity = data.Length() - int(offset)
@@ -233,7 +233,7 @@ func (n *memoryNode) FillPhyAddrColumn(startRow, length uint32) (err error) {
 		return
 	}
 	defer col.Close()
-	vec := n.data.Vecs[n.bnode.table.entry.GetSchema().PhyAddrKey.Idx]
+	vec := n.data.Vecs[n.bnode.table.GetSchema().PhyAddrKey.Idx]
 	vec.Extend(col)
 	return
 }
@@ -363,12 +363,13 @@ func (n *baseNode) TryUpgrade() (err error) {
 }
 
 func (n *baseNode) LoadPersistedColumnData(colIdx int) (vec containers.Vector, err error) {
-	def := n.meta.GetSchema().ColDefs[colIdx]
+	def := n.table.GetSchema().ColDefs[colIdx]
 	location := n.meta.GetMetaLoc()
 	return tables.LoadPersistedColumnData(
 		n.bufMgr,
 		n.fs,
 		n.meta.AsCommonID(),
 		def,
-		location)
+		location,
+		n.meta.IsAppendable())
 }
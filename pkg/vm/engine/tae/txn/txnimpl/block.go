@@ -210,7 +210,7 @@ func (blk *txnBlock) GetColumnDataByNames(attrs []string) (*model.BlockView, err
 	if blk.isUncommitted {
 		attrIds := make([]int, len(attrs))
 		for i, attr := range attrs {
-			attrIds[i] = blk.table.entry.GetSchema().GetColIdx(attr)
+			attrIds[i] = blk.table.GetSchema().GetColIdx(attr)
 		}
 		return blk.table.localSegment.GetColumnDataByIds(blk.entry, attrIds)
 	}
@@ -225,7 +225,7 @@ func (blk *txnBlock) GetColumnDataById(colIdx int) (*model.ColumnView, error) {
 }
 func (blk *txnBlock) GetColumnDataByName(attr string) (*model.ColumnView, error) {
 	if blk.isUncommitted {
-		attrId := blk.table.entry.GetSchema().GetColIdx(attr)
+		attrId := blk.table.GetSchema().GetColIdx(attr)
 		return blk.table.localSegment.GetColumnDataById(blk.entry, attrId)
 	}
 	return blk.entry.GetBlockData().GetColumnDataByName(blk.Txn, attr)
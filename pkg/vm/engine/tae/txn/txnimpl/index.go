@@ -212,11 +212,11 @@ func (idx *simpleTableIndex) BatchInsert(
 		return InsertOp[int64](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_uint8:
 		return InsertOp[uint8](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return InsertOp[uint16](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_uint32:
 		return InsertOp[uint32](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return InsertOp[uint64](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_decimal64:
 		return InsertOp[types.Decimal64](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
@@ -294,13 +294,13 @@ func (idx *simpleTableIndex) BatchDedup(attr string, col containers.Vector) erro
 	case types.T_uint8:
 		vals := col.Slice()
 		return DedupOp[uint8](colType, attr, vals, idx.tree)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vals := col.Slice()
 		return DedupOp[uint16](colType, attr, vals, idx.tree)
 	case types.T_uint32:
 		vals := col.Slice()
 		return DedupOp[uint32](colType, attr, vals, idx.tree)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		vals := col.Slice()
 		return DedupOp[uint64](colType, attr, vals, idx.tree)
 	case types.T_decimal64:
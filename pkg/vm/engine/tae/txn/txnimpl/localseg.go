@@ -455,12 +455,12 @@ func (seg *localSegment) GetByFilter(filter *handle.Filter) (id *common.ID, offs
 }
 
 func (seg *localSegment) GetPKColumn() containers.Vector {
-	schema := seg.table.entry.GetSchema()
+	schema := seg.table.GetSchema()
 	return seg.index.KeyToVector(schema.GetSingleSortKeyType())
 }
 
 func (seg *localSegment) GetPKVecs() []containers.Vector {
-	schema := seg.table.entry.GetSchema()
+	schema := seg.table.GetSchema()
 	return seg.index.KeyToVectors(schema.GetSingleSortKeyType())
 }
 
@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
@@ -313,3 +314,11 @@ func (h *txnRelation) GetDB() (handle.Database, error) {
 func (h *txnRelation) UpdateConstraint(cstr []byte) (err error) {
 	return h.table.UpdateConstraint(cstr)
 }
+
+func (h *txnRelation) AlterColumns(attrs []engine.Attribute) (err error) {
+	schema, err := h.table.GetSchema().AlterColumns(attrs)
+	if err != nil {
+		return
+	}
+	return h.table.AlterColumns(schema)
+}
@@ -134,7 +134,7 @@ func (blk *txnSysBlock) processTable(entry *catalog.DBEntry, fn func(*catalog.Ta
 func (blk *txnSysBlock) columnRows() int {
 	rows := 0
 	fn := func(table *catalog.TableEntry) error {
-		rows += len(table.GetSchema().ColDefs)
+		rows += len(table.GetVisibleSchema(blk.Txn).ColDefs)
 		return nil
 	}
 	dbFn := func(db *catalog.DBEntry) error {
@@ -160,10 +160,11 @@ func (blk *txnSysBlock) Rows() int {
 	}
 }
 
-func FillColumnRow(table *catalog.TableEntry, attr string, colData containers.Vector) {
-	schema := table.GetSchema()
+// FillColumnRow fills the attr of the rows of mo_columns of the columns of
+// the schema, which is one of the schemas of the table
+func FillColumnRow(table *catalog.TableEntry, schema *catalog.Schema, attr string, colData containers.Vector) {
 	tableID := table.GetID()
-	for i, colDef := range table.GetSchema().ColDefs {
+	for i, colDef := range schema.ColDefs {
 		switch attr {
 		case pkgcatalog.SystemColAttr_UniqName:
 			colData.Append([]byte(fmt.Sprintf("%d-%s", tableID, colDef.Name)))
@@ -184,7 +185,7 @@ func FillColumnRow(table *catalog.TableEntry, attr string, colData containers.Ve
 		case pkgcatalog.SystemColAttr_RelID:
 			colData.Append(tableID)
 		case pkgcatalog.SystemColAttr_RelName:
-			colData.Append([]byte(table.GetSchema().Name))
+			colData.Append([]byte(schema.Name))
 		case pkgcatalog.SystemColAttr_ConstraintType:
 			if colDef.Primary {
 				colData.Append([]byte(pkgcatalog.SystemColPKConstraint))
@@ -220,6 +221,14 @@ func FillColumnRow(table *catalog.TableEntry, attr string, colData containers.Ve
 			colData.Append(bool2i8(colDef.IsClusterBy()))
 		case pkgcatalog.SystemColAttr_Update:
 			colData.Append(colDef.OnUpdate)
+		case pkgcatalog.SystemColAttr_EnumValues:
+			colData.Append([]byte(colDef.EnumValues))
+		case pkgcatalog.SystemColAttr_Generated:
+			colData.Append(colDef.Generated)
+		case pkgcatalog.SystemColAttr_CompressAlg:
+			colData.Append(int8(colDef.Compress))
+		case pkgcatalog.SystemColAttr_CompressLevel:
+			colData.Append(colDef.CompressLevel)
 		default:
 			panic("unexpected colname. if add new catalog def, fill it in this switch")
 		}
@@ -229,7 +238,7 @@ func (blk *txnSysBlock) getColumnTableVec(colIdx int) (colData containers.Vector
 	col := catalog.SystemColumnSchema.ColDefs[colIdx]
 	colData = containers.MakeVector(col.Type, col.Nullable())
 	tableFn := func(table *catalog.TableEntry) error {
-		FillColumnRow(table, col.Name, colData)
+		FillColumnRow(table, table.GetVisibleSchema(blk.Txn), col.Name, colData)
 		return nil
 	}
 	dbFn := func(db *catalog.DBEntry) error {
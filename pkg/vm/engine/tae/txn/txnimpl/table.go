@@ -103,6 +103,8 @@ type txnTable struct {
 
 	txnEntries *txnEntries
 	csnStart   uint32
+	// altered is true if the txn alters the columns of the table
+	altered bool
 
 	idx int
 }
@@ -111,7 +113,7 @@ func newTxnTable(store *txnStore, entry *catalog.TableEntry) *txnTable {
 	tbl := &txnTable{
 		store:       store,
 		entry:       entry,
-		schema:      entry.GetSchema(),
+		schema:      entry.GetVisibleSchema(store.txn),
 		deleteNodes: make(map[common.ID]*deleteNode),
 		logs:        make([]wal.LogEntry, 0),
 		txnEntries:  newTxnEntries(),
@@ -603,6 +605,9 @@ func (tbl *txnTable) AddDeleteNode(id *common.ID, node txnif.DeleteNode) error {
 }
 
 func (tbl *txnTable) Append(data *containers.Batch) (err error) {
+	if tbl.altered {
+		return moerr.NewNotSupportedNoCtx("append table %s altered in the txn", tbl.schema.Name)
+	}
 	if tbl.schema.HasPK() {
 		skip := tbl.store.txn.GetPKDedupSkip()
 		if skip == txnif.PKDedupSkipNone {
@@ -843,6 +848,27 @@ func (tbl *txnTable) UpdateConstraint(cstr []byte) (err error) {
 	return
 }
 
+// AlterColumns makes schema the schema of the table since the txn commits.
+// The rows of the txn are appended by the schema visible to the txn, so the
+// txn appending rows to the table is not allowed to alter it.
+func (tbl *txnTable) AlterColumns(schema *catalog.Schema) (err error) {
+	if tbl.UncommittedRows() != 0 {
+		return moerr.NewNotSupportedNoCtx("alter the columns of table %s appended in the txn", tbl.schema.Name)
+	}
+	tbl.store.IncreateWriteCnt()
+	tbl.store.txn.GetMemo().AddCatalogChange()
+	isNewNode, err := tbl.entry.UpdateSchema(tbl.store.txn, schema)
+	if err != nil {
+		return
+	}
+	if isNewNode {
+		tbl.txnEntries.Append(tbl.entry)
+	}
+	tbl.schema = schema
+	tbl.altered = true
+	return
+}
+
 func (tbl *txnTable) UncommittedRows() uint32 {
 	if tbl.localSegment == nil {
 		return 0
@@ -51,6 +51,8 @@ type Attribute struct {
 	Name string
 	// Alg compression algorithm
 	Alg compress.T
+	// CompressLevel is the level of Alg, 0 means the default level
+	CompressLevel int32
 	// Type attribute's type
 	Type types.Type
 	// DefaultExpr default value of this attribute
@@ -65,6 +67,10 @@ type Attribute struct {
 	Comment string
 	// AutoIncrement is auto incr or not
 	AutoIncrement bool
+	// EnumValues is the members of ENUM and SET, encoded by types.EncodeEnumValues
+	EnumValues string
+	// Generated is the expression of a generated column
+	Generated *plan.GeneratedCol
 }
 
 type PropertiesDef struct {
@@ -139,6 +145,10 @@ type PrimaryKeyDef struct {
 	Pkey *plan.PrimaryKeyDef
 }
 
+type CheckDef struct {
+	Checks []*plan.CheckDef
+}
+
 type RefChildTableDef struct {
 	Tables []uint64
 }
@@ -167,6 +177,7 @@ const (
 	RefChildTable
 	ForeignKey
 	PrimaryKey
+	Check
 )
 
 func (c *ConstraintDef) MarshalBinary() (data []byte, err error) {
@@ -234,6 +245,23 @@ func (c *ConstraintDef) MarshalBinary() (data []byte, err error) {
 				return nil, err
 			}
 			buf.Write(bytes)
+		case *CheckDef:
+			if err := binary.Write(buf, binary.BigEndian, Check); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Checks))); err != nil {
+				return nil, err
+			}
+			for _, check := range def.Checks {
+				bytes, err := check.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
 		}
 	}
 	return buf.Bytes(), nil
@@ -302,6 +330,24 @@ func (c *ConstraintDef) UnmarshalBinary(data []byte) error {
 			}
 			l += int(length)
 			c.Cts = append(c.Cts, &PrimaryKeyDef{pkey})
+
+		case Check:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			checks := make([]*plan.CheckDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				check := &plan.CheckDef{}
+				err := check.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				checks[i] = check
+			}
+			c.Cts = append(c.Cts, &CheckDef{checks})
 		}
 	}
 	return nil
@@ -326,6 +372,7 @@ func (*ForeignKeyDef) constraint()    {}
 func (*PrimaryKeyDef) constraint()    {}
 func (*RefChildTableDef) constraint() {}
 func (*IndexDef) constraint()         {}
+func (*CheckDef) constraint()         {}
 
 type Relation interface {
 	Statistics
@@ -351,6 +398,11 @@ type Relation interface {
 	// only ConstraintDef can be modified
 	UpdateConstraint(context.Context, *ConstraintDef) error
 
+	// AlterColumns changes the columns of the table to the AttributeDefs
+	// without rewriting the data, the columns can only be renamed or
+	// appended with a constant default
+	AlterColumns(context.Context, []TableDef) error
+
 	GetTableID(context.Context) uint64
 
 	// second argument is the number of reader, third argument is the filter extend, foruth parameter is the payload required by the engine
@@ -383,6 +435,13 @@ type Engine interface {
 	Commit(ctx context.Context, op client.TxnOperator) error
 	Rollback(ctx context.Context, op client.TxnOperator) error
 
+	// Savepoint sets a savepoint with the name in the transaction, a savepoint with the same name is replaced
+	Savepoint(ctx context.Context, op client.TxnOperator, name string) error
+	// RollbackToSavepoint discards the writes after the savepoint and the savepoints set after it
+	RollbackToSavepoint(ctx context.Context, op client.TxnOperator, name string) error
+	// ReleaseSavepoint removes the savepoint and the savepoints set after it
+	ReleaseSavepoint(ctx context.Context, op client.TxnOperator, name string) error
+
 	// Delete deletes a database
 	Delete(ctx context.Context, databaseName string, op client.TxnOperator) error
 
@@ -53,6 +53,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursivecte"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/semi"
@@ -60,6 +61,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -111,6 +113,9 @@ var stringFunc = [...]func(any, *bytes.Buffer){
 	HashBuild: hashbuild.String,
 
 	TableFunction: table_function.String,
+
+	Window:       window.String,
+	RecursiveCte: recursivecte.String,
 }
 
 var prepareFunc = [...]func(*process.Process, any) error{
@@ -161,6 +166,9 @@ var prepareFunc = [...]func(*process.Process, any) error{
 	HashBuild: hashbuild.Prepare,
 
 	TableFunction: table_function.Prepare,
+
+	Window:       window.Prepare,
+	RecursiveCte: recursivecte.Prepare,
 }
 
 var execFunc = [...]func(int, *process.Process, any, bool, bool) (bool, error){
@@ -212,4 +220,7 @@ var execFunc = [...]func(int, *process.Process, any, bool, bool) (bool, error){
 	HashBuild: hashbuild.Call,
 
 	TableFunction: table_function.Call,
+
+	Window:       window.Call,
+	RecursiveCte: recursivecte.Call,
 }
@@ -136,6 +136,12 @@ func (proc *Process) OperatorOutofMemory(size int64) bool {
 	return proc.Mp().Cap() < size
 }
 
+// ExceedMemoryLimit returns true if the memory allocated by the query is beyond the memory limit,
+// the operators spill their state to disk then.
+func (proc *Process) ExceedMemoryLimit() bool {
+	return proc.Lim.MemoryLimit > 0 && proc.Mp().CurrNB() > proc.Lim.MemoryLimit
+}
+
 func (proc *Process) SetInputBatch(bat *batch.Batch) {
 	proc.Reg.InputBatch = bat
 }
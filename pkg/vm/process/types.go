@@ -80,6 +80,10 @@ type Limitation struct {
 	ReaderSize int64
 	// MaxMessageSize max size for read messages from dn
 	MaxMsgSize uint64
+	// MemoryLimit, memory budget of a query, the operators spill to disk beyond it. 0 means no limit.
+	// The hash joins other than the inner join can't spill, the query fails if their build side
+	// exceeds it.
+	MemoryLimit int64
 }
 
 // SessionInfo session information
@@ -67,6 +67,8 @@ const (
 	Right
 	OnDuplicateKey
 	PreInsert
+	Window
+	RecursiveCte
 
 	// LastInstructionOp is not a true operator and must set at last.
 	// It was used by unit testing to ensure that
@@ -113,6 +115,8 @@ func (ins *Instruction) IsBrokenNode() bool {
 		return true
 	case Top, MergeTop:
 		return true
+	case Window, RecursiveCte:
+		return true
 	}
 	return false
 }